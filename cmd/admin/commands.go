@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/internal/infrastructure/database"
+	"ecom-golang-clean-architecture/internal/infrastructure/events"
+	"ecom-golang-clean-architecture/internal/infrastructure/payment"
+	"ecom-golang-clean-architecture/internal/infrastructure/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/google/uuid"
+)
+
+func createAdminUser(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("create-admin-user", flag.ExitOnError)
+	email := flags.String("email", "", "email address of the account (required)")
+	firstName := flags.String("first-name", "", "first name (required)")
+	lastName := flags.String("last-name", "", "last name (required)")
+	flags.Usage = commandUsage(flags, "The password is read from standard input.")
+	flags.Parse(args)
+	if err := requireFlags(flags, "email", "first-name", "last-name"); err != nil {
+		return err
+	}
+
+	password, err := readPassword("Password for " + *email)
+	if err != nil {
+		return err
+	}
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	user, err := env.operations().CreateAdminUser(ctx, usecases.CreateAdminUserRequest{
+		Email:     *email,
+		Password:  password,
+		FirstName: *firstName,
+		LastName:  *lastName,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created admin %s (%s)\n", user.Email, user.ID)
+	return nil
+}
+
+func resetPassword(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("reset-password", flag.ExitOnError)
+	email := flags.String("email", "", "email address of the user (required)")
+	flags.Usage = commandUsage(flags, "The new password is read from standard input. The user is signed out of every session.")
+	flags.Parse(args)
+	if err := requireFlags(flags, "email"); err != nil {
+		return err
+	}
+
+	password, err := readPassword("New password for " + *email)
+	if err != nil {
+		return err
+	}
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	if err := env.operations().ResetPassword(ctx, *email, password); err != nil {
+		return err
+	}
+	fmt.Printf("Reset the password of %s and signed them out\n", *email)
+	return nil
+}
+
+func grantRole(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("grant-role", flag.ExitOnError)
+	email := flags.String("email", "", "email address of the user (required)")
+	role := flags.String("role", "", "new role: customer, moderator or admin (required)")
+	flags.Usage = commandUsage(flags, "")
+	flags.Parse(args)
+	if err := requireFlags(flags, "email", "role"); err != nil {
+		return err
+	}
+
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	previous, err := env.operations().GrantRole(ctx, *email, entities.UserRole(*role))
+	if err != nil {
+		return err
+	}
+	if previous == entities.UserRole(*role) {
+		fmt.Printf("%s already has the %s role\n", *email, *role)
+		return nil
+	}
+	fmt.Printf("Changed the role of %s from %s to %s\n", *email, previous, *role)
+	return nil
+}
+
+func reissueWebhook(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("reissue-webhook", flag.ExitOnError)
+	eventID := flags.String("event", "", "provider event ID, e.g. evt_1NG8Du2eZvKYlo2C (required)")
+	provider := flags.String("provider", "stripe", "payment provider (stripe or paypal)")
+	flags.Usage = commandUsage(flags, "Processing is idempotent: payments that are already settled are left as they are.")
+	flags.Parse(args)
+	if err := requireFlags(flags, "event"); err != nil {
+		return err
+	}
+
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+	paymentUseCase, err := env.paymentUseCase()
+	if err != nil {
+		return err
+	}
+
+	if err := paymentUseCase.ReplayWebhookEvent(ctx, *provider, *eventID); err != nil {
+		return err
+	}
+	fmt.Printf("Processed %s event %s\n", *provider, *eventID)
+	return nil
+}
+
+func requeueNotification(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("requeue-notification", flag.ExitOnError)
+	id := flags.String("id", "", "ID of a failed or stuck notification")
+	failed := flags.Bool("failed", false, "requeue the oldest failed notifications instead")
+	limit := flags.Int("limit", 100, "how many failed notifications to requeue with -failed")
+	flags.Usage = commandUsage(flags, "Requeued notifications get a fresh set of retries and are sent by the running API.")
+	flags.Parse(args)
+	if (*id == "") == !*failed {
+		flags.Usage()
+		return fmt.Errorf("pass either -id or -failed")
+	}
+
+	var notificationID uuid.UUID
+	if *id != "" {
+		var err error
+		if notificationID, err = uuid.Parse(*id); err != nil {
+			return fmt.Errorf("invalid notification ID: %w", err)
+		}
+	}
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	if *failed {
+		requeued, err := env.operations().RequeueFailedNotifications(ctx, *limit)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Requeued %d failed notifications\n", requeued)
+		return nil
+	}
+	if err := env.operations().RequeueNotification(ctx, notificationID); err != nil {
+		return err
+	}
+	fmt.Printf("Requeued notification %s\n", notificationID)
+	return nil
+}
+
+func recalculateProductRatings(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("recalculate-product-ratings", flag.ExitOnError)
+	product := flags.String("product", "", "ID of the product; every reviewed product when omitted")
+	flags.Usage = commandUsage(flags, "")
+	flags.Parse(args)
+
+	var productID *uuid.UUID
+	if *product != "" {
+		id, err := uuid.Parse(*product)
+		if err != nil {
+			return fmt.Errorf("invalid product ID: %w", err)
+		}
+		productID = &id
+	}
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	if err := env.operations().RecalculateProductRatings(ctx, productID); err != nil {
+		return err
+	}
+	if productID != nil {
+		fmt.Printf("Recalculated the rating of product %s\n", productID)
+	} else {
+		fmt.Println("Recalculated the ratings of every reviewed product")
+	}
+	return nil
+}
+
+func expireStaleCheckouts(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("expire-stale-checkouts", flag.ExitOnError)
+	flags.Usage = commandUsage(flags, "")
+	flags.Parse(args)
+
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	expired, err := env.operations().ExpireStaleCheckouts(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Expired %d checkout sessions\n", expired)
+	return nil
+}
+
+func repairAggregates(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("repair-aggregates", flag.ExitOnError)
+	kinds := flags.String("kinds", "", "comma-separated aggregates: customer_metrics, product_ratings, review_votes; every aggregate when omitted")
+	dryRun := flags.Bool("dry-run", false, "only report discrepancies")
+	flags.Usage = commandUsage(flags, "The run is saved and shows up at /admin/aggregates/repair-runs.")
+	flags.Parse(args)
+
+	req := usecases.RepairAggregatesRequest{
+		DryRun:  *dryRun,
+		Trigger: entities.AggregateRepairTriggerCLI,
+	}
+	if *kinds != "" {
+		for _, kind := range strings.Split(*kinds, ",") {
+			req.Kinds = append(req.Kinds, entities.AggregateKind(strings.TrimSpace(kind)))
+		}
+	}
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	useCase := usecases.NewAggregateRepairUseCase(
+		database.NewAggregateRepairRepository(env.db),
+		services.NewUserMetricsService(database.NewUserRepository(env.db), database.NewOrderRepository(env.db)),
+		env.cfg.AggregateRepair.BatchSize,
+	)
+	run, err := useCase.RepairAggregates(ctx, req)
+	if run != nil {
+		for _, summary := range run.Summaries {
+			fmt.Printf("%-18s checked %d, off %d (%d fields), repaired %d\n",
+				summary.Kind, summary.RecordsChecked, summary.RecordsOff, summary.Discrepancies, summary.RecordsRepaired)
+		}
+		fmt.Printf("Run %s %s\n", run.ID, run.Status)
+	}
+	return err
+}
+
+func passwordHashReport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("password-hash-report", flag.ExitOnError)
+	flags.Usage = commandUsage(flags, "Outdated hashes are upgraded to PASSWORD_HASH_ALGORITHM when their users next sign in.")
+	flags.Parse(args)
+
+	env, err := openEnvironment()
+	if err != nil {
+		return err
+	}
+
+	report, err := env.operations().PasswordHashReport(ctx)
+	if err != nil {
+		return err
+	}
+
+	algorithms := make([]string, 0, len(report.ByAlgorithm))
+	for algorithm := range report.ByAlgorithm {
+		algorithms = append(algorithms, algorithm)
+	}
+	sort.Strings(algorithms)
+	for _, algorithm := range algorithms {
+		fmt.Printf("%-10s %d\n", algorithm, report.ByAlgorithm[algorithm])
+	}
+	fmt.Printf("%d password hashes, %d current, %d waiting for their users to sign in\n",
+		report.Total, report.Current, report.Outdated)
+	return nil
+}
+
+// operations builds the use case behind the user, notification, rating and checkout commands
+func (e *environment) operations() usecases.OperationsUseCase {
+	return usecases.NewOperationsUseCase(
+		database.NewUserRepository(e.db),
+		database.NewUserSessionRepository(e.db),
+		database.NewNotificationRepository(e.db),
+		database.NewProductRatingRepository(e.db),
+		repositories.NewCheckoutSessionRepository(e.db),
+		database.NewAuditRepository(e.db),
+		e.passwordService(),
+		e.actor,
+	)
+}
+
+// passwordService hashes passwords with the API's settings
+func (e *environment) passwordService() services.PasswordService {
+	return services.NewPasswordService(services.PasswordHashSettings{
+		Algorithm:         e.cfg.Password.HashAlgorithm,
+		BcryptCost:        e.cfg.Password.BcryptCost,
+		Argon2Memory:      uint32(e.cfg.Password.Argon2MemoryKB),
+		Argon2Iterations:  uint32(e.cfg.Password.Argon2Iterations),
+		Argon2Parallelism: uint8(e.cfg.Password.Argon2Parallelism),
+	})
+}
+
+// paymentUseCase builds the payment use case the way the API does, so replayed webhooks have
+// the same effects as delivered ones
+func (e *environment) paymentUseCase() (usecases.PaymentUseCase, error) {
+	if e.cfg.App.IsSandbox() {
+		return nil, fmt.Errorf("payments are simulated in sandbox mode, there are no provider events to fetch")
+	}
+	if e.cfg.Payment.StripeSecretKey == "" {
+		return nil, fmt.Errorf("STRIPE_SECRET_KEY is not configured")
+	}
+
+	db := e.db
+	userRepo := database.NewUserRepository(db)
+	orderRepo := database.NewOrderRepository(db)
+	paymentRepo := database.NewPaymentRepository(db)
+	inventoryRepo := database.NewInventoryRepository(db)
+	categoryRepo := database.NewCategoryRepository(db)
+	productRepo := database.NewProductRepository(db, services.NewCategoryHierarchyService(categoryRepo))
+
+	var eventRecorder services.EventRecorder
+	if e.cfg.EventBridge.IsEnabled() {
+		eventRecorder = services.NewEventRecorder(database.NewOutboxEventRepository(db))
+		productRepo = events.NewStockEventProductRepository(productRepo, eventRecorder)
+	}
+
+	// Notifications are stored for the API's queue processor to deliver
+	notificationUseCase := usecases.NewNotificationUseCase(
+		database.NewNotificationRepository(db), userRepo, orderRepo, paymentRepo, inventoryRepo,
+		database.NewReviewRepository(db), productRepo,
+		nil, nil, nil,
+		nil, nil,
+		usecases.PriceDisplaySettings{
+			TaxDisplay: entities.TaxDisplayRule{
+				Default:            e.cfg.Storefront.TaxDisplay,
+				InclusiveCountries: e.cfg.Storefront.TaxInclusiveCountries,
+				ExclusiveCountries: e.cfg.Storefront.TaxExclusiveCountries,
+			},
+			DefaultLocale: e.cfg.Localization.SourceLocale,
+		},
+	)
+
+	stripeSettings := resilience.Settings{
+		Timeout:          time.Duration(e.cfg.Resilience.PaymentTimeoutSeconds) * time.Second,
+		MaxConcurrent:    e.cfg.Resilience.MaxConcurrentCalls,
+		FailureThreshold: e.cfg.Resilience.BreakerFailureThreshold,
+		OpenDuration:     time.Duration(e.cfg.Resilience.BreakerOpenSeconds) * time.Second,
+		IsFailure:        payment.IsStripeProviderFailure,
+	}
+	stripeService := payment.NewBreakerGateway(
+		payment.NewStripeServiceWithWebhook(e.cfg.Payment.StripeSecretKey, e.cfg.Payment.StripeWebhookSecret),
+		resilience.NewRegistry().Breaker("stripe", stripeSettings),
+	)
+
+	orderEventService := services.NewOrderEventService(database.NewOrderEventRepository(db))
+	disputeUseCase := usecases.NewDisputeUseCase(
+		database.NewDisputeRepository(db), paymentRepo, orderRepo, orderEventService, notificationUseCase,
+	)
+
+	// PayPal only reports disputes by webhook, so it is optional here
+	var paypalService usecases.PaymentGatewayService
+	if e.cfg.Payment.PayPalClientID != "" {
+		paypalSettings := stripeSettings
+		paypalSettings.IsFailure = nil
+		paypalService = payment.NewBreakerGateway(
+			payment.NewPayPalService(e.cfg.Payment.PayPalClientID, e.cfg.Payment.PayPalClientSecret, e.cfg.Payment.PayPalSandbox),
+			resilience.NewRegistry().Breaker("paypal", paypalSettings),
+		)
+	}
+
+	return usecases.NewPaymentUseCase(
+		paymentRepo, database.NewPaymentMethodRepository(db), orderRepo, userRepo,
+		stripeService, paypalService,
+		notificationUseCase,
+		orderEventService,
+		services.NewUserMetricsService(userRepo, orderRepo),
+		database.NewTransactionManager(db),
+		services.NewSimpleStockService(productRepo, inventoryRepo),
+		eventRecorder,
+		database.NewPaymentLinkRepository(db),
+		disputeUseCase,
+		services.NewMarketplaceFeeService(
+			database.NewVendorRepository(db), database.NewVendorOrderRepository(db),
+			repositories.NewProductCategoryRepository(db), categoryRepo,
+			e.cfg.Marketplace.DefaultCommissionRate,
+		),
+	), nil
+}
+
+// commandUsage prints a command's flags followed by a note
+func commandUsage(flags *flag.FlagSet, note string) func() {
+	return func() {
+		fmt.Fprintf(flags.Output(), "Usage: admin %s [flags]\n\n", flags.Name())
+		if note != "" {
+			fmt.Fprintf(flags.Output(), "%s\n\n", note)
+		}
+		flags.PrintDefaults()
+	}
+}
+
+// requireFlags fails unless every named flag was given a value
+func requireFlags(flags *flag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if flags.Lookup(name).Value.String() == "" {
+			flags.Usage()
+			return fmt.Errorf("-%s is required", name)
+		}
+	}
+	return nil
+}
+
+// readPassword reads a password from the first line of standard input, so it doesn't end up in
+// shell history or the process list. It prompts when the input is a terminal.
+func readPassword(prompt string) (string, error) {
+	if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		fmt.Fprintf(os.Stderr, "%s: ", prompt)
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("the password is empty")
+	}
+	return password, nil
+}
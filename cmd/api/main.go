@@ -7,17 +7,24 @@ import (
 
 	"ecom-golang-clean-architecture/internal/delivery/http/handlers"
 	"ecom-golang-clean-architecture/internal/delivery/http/routes"
+	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/services"
 	"ecom-golang-clean-architecture/internal/domain/storage"
 	"ecom-golang-clean-architecture/internal/infrastructure/config"
 	"ecom-golang-clean-architecture/internal/infrastructure/database"
+	"ecom-golang-clean-architecture/internal/infrastructure/events"
 	"ecom-golang-clean-architecture/internal/infrastructure/oauth"
 	"ecom-golang-clean-architecture/internal/infrastructure/payment"
 	"ecom-golang-clean-architecture/internal/infrastructure/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	"ecom-golang-clean-architecture/internal/infrastructure/sandbox"
 	infraServices "ecom-golang-clean-architecture/internal/infrastructure/services"
 	localStorage "ecom-golang-clean-architecture/internal/infrastructure/storage"
+	"ecom-golang-clean-architecture/internal/infrastructure/warehouse"
 	"ecom-golang-clean-architecture/internal/infrastructure/websocket"
 	"ecom-golang-clean-architecture/internal/usecases"
+	"ecom-golang-clean-architecture/pkg/cache"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
 
 	"github.com/gin-gonic/gin"
 )
@@ -115,6 +122,7 @@ func main() {
 	couponRepo := database.NewCouponRepository(db)
 	wishlistRepo := database.NewWishlistRepository(db)
 	inventoryRepo := database.NewInventoryRepository(db)
+	inventorySnapshotRepo := database.NewInventorySnapshotRepository(db)
 	notificationRepo := database.NewNotificationRepository(db)
 	analyticsRepo := database.NewAnalyticsRepository(db)
 	addressRepo := database.NewAddressRepository(db)
@@ -122,25 +130,122 @@ func main() {
 	auditRepo := database.NewAuditRepository(db)
 	warehouseRepo := database.NewWarehouseRepository(db)
 	orderEventRepo := database.NewOrderEventRepository(db)
+	priceHistoryRepo := database.NewPriceHistoryRepository(db)
+	scheduledPriceChangeRepo := database.NewScheduledPriceChangeRepository(db)
+	bulkPriceUpdateRepo := database.NewBulkPriceUpdateRepository(db)
+	companyRepo := database.NewCompanyRepository(db)
+	orderApprovalRepo := database.NewOrderApprovalRepository(db)
+	companyInvoiceRepo := database.NewCompanyInvoiceRepository(db)
+	quoteRepo := database.NewQuoteRepository(db)
+	paymentLinkRepo := database.NewPaymentLinkRepository(db)
+	disputeRepo := database.NewDisputeRepository(db)
+	settlementRepo := database.NewSettlementRepository(db)
+	adminTaskRepo := database.NewAdminTaskRepository(db)
+	adminNoteRepo := database.NewAdminNoteRepository(db)
+	orderMessageRepo := database.NewOrderMessageRepository(db)
+	reviewIncentiveRepo := database.NewReviewIncentiveRepository(db)
+	reviewModerationRepo := database.NewReviewModerationRepository(db)
+	productTranslationRepo := database.NewProductTranslationRepository(db)
+	catalogChangesetRepo := database.NewCatalogChangesetRepository(db)
+	categoryAssignmentRepo := database.NewCategoryAssignmentRepository(db)
+	supplierRepo := database.NewSupplierRepository(db)
+	purchaseOrderRepo := database.NewPurchaseOrderRepository(db)
+	backInStockRepo := database.NewBackInStockRepository(db)
+	jwtSigningKeyRepo := database.NewJWTSigningKeyRepository(db)
+	catalogVisibilityRepo := database.NewCatalogVisibilityRepository(db)
+	searchRepo := database.NewSearchRepository(db)
+	recommendationRepo := database.NewRecommendationRepository(db)
+	orderNumberSequenceRepo := database.NewOrderNumberSequenceRepository(db)
+	vendorRepo := database.NewVendorRepository(db)
+	vendorOrderRepo := database.NewVendorOrderRepository(db)
+	vendorApplicationRepo := database.NewVendorApplicationRepository(db)
+	outboxEventRepo := database.NewOutboxEventRepository(db)
+	partnerRepo := database.NewPartnerRepository(db)
+	apiKeyRepo := database.NewAPIKeyRepository(db)
+	apiKeyUsageRepo := database.NewAPIKeyUsageRepository(db)
+	partnerFeedRepo := database.NewPartnerFeedRepository(db)
+	taxExemptionRepo := database.NewTaxExemptionRepository(db)
+	waitlistRepo := database.NewWaitlistRepository(db)
+
+	// Price and availability changes are recorded for the partner feed
+	productRepo = events.NewPartnerFeedProductRepository(productRepo, partnerFeedRepo)
+	scheduledPriceChangeRepo = events.NewPartnerFeedScheduledPriceChangeRepository(scheduledPriceChangeRepo, productRepo, partnerFeedRepo)
+
+	// Domain events are only written to the outbox while an event broker is configured
+	var eventRecorder services.EventRecorder
+	if cfg.EventBridge.IsEnabled() {
+		eventRecorder = services.NewEventRecorder(outboxEventRepo)
+		productRepo = events.NewStockEventProductRepository(productRepo, eventRecorder)
+	}
 
 	// Initialize transaction manager
 	txManager := database.NewTransactionManager(db)
 
+	// Order numbers keep the random ORD-YYYYMMDD-HHMMSS-XXXX format unless configured otherwise
+	orderStoreCodes := make(map[entities.OrderSource]string)
+	for source, code := range cfg.OrderNumbers.GetStoreCodes() {
+		orderStoreCodes[entities.OrderSource(source)] = code
+	}
+	orderNumbering := entities.OrderNumberFormat{
+		Strategy:       entities.OrderNumberStrategy(cfg.OrderNumbers.Strategy),
+		Prefix:         cfg.OrderNumbers.Prefix,
+		DateLayout:     cfg.OrderNumbers.GetDateLayout(),
+		Reset:          entities.OrderNumberReset(cfg.OrderNumbers.Reset),
+		SequenceDigits: cfg.OrderNumbers.SequenceDigits,
+		SequenceStart:  cfg.OrderNumbers.SequenceStart,
+		PerStore:       cfg.OrderNumbers.PerStore,
+		StoreCodes:     orderStoreCodes,
+		Checksum:       cfg.OrderNumbers.Checksum,
+		GapFree:        cfg.OrderNumbers.GapFree,
+	}
+	if err := orderNumbering.Validate(); err != nil {
+		log.Fatal("Invalid order number configuration:", err)
+	}
+	log.Printf("✅ Order numbers use the %s strategy, e.g. %s", orderNumbering.Strategy, orderNumbering.Example(time.Now()))
+
+	// Access tokens are signed with rotating keys; the static secret keeps verifying older tokens
+	jwtKeys := jwtkeys.NewKeySet(cfg.JWT.Secret)
+	jwtKeyUseCase := usecases.NewJWTKeyUseCase(jwtSigningKeyRepo, jwtKeys, usecases.JWTKeySettings{
+		Algorithm:        jwtkeys.Algorithm(cfg.JWT.SigningAlgorithm),
+		RotationInterval: cfg.JWT.GetKeyRotationInterval(),
+		GracePeriod:      cfg.JWT.GetKeyGracePeriod(),
+		Secret:           cfg.JWT.Secret,
+	})
+	if err := jwtKeyUseCase.LoadKeys(context.Background()); err != nil {
+		log.Fatal("Failed to load JWT signing keys:", err)
+	}
+	log.Printf("✅ JWT signing keys loaded, signing with %s", cfg.JWT.SigningAlgorithm)
+
 	// Initialize domain services
-	passwordService := services.NewPasswordService()
-	orderService := services.NewOrderService(orderRepo)
+	passwordService := services.NewPasswordService(services.PasswordHashSettings{
+		Algorithm:         cfg.Password.HashAlgorithm,
+		BcryptCost:        cfg.Password.BcryptCost,
+		Argon2Memory:      uint32(cfg.Password.Argon2MemoryKB),
+		Argon2Iterations:  uint32(cfg.Password.Argon2Iterations),
+		Argon2Parallelism: uint8(cfg.Password.Argon2Parallelism),
+	})
+	// Orders are split between the vendors of their products when they are placed
+	marketplaceFees := services.NewMarketplaceFeeService(vendorRepo, vendorOrderRepo, productCategoryRepo, categoryRepo, cfg.Marketplace.DefaultCommissionRate)
+	orderService := services.NewOrderService(orderRepo, orderNumberSequenceRepo, orderNumbering, eventRecorder, marketplaceFees)
 	simpleStockService := services.NewSimpleStockService(productRepo, inventoryRepo)
 	userMetricsService := services.NewUserMetricsService(userRepo, orderRepo)
 	_ = services.NewProductCategoryService(productCategoryRepo, productRepo, categoryRepo) // Will be used later
 	orderEventService := services.NewOrderEventService(orderEventRepo)
 
+	// Customers' personal data and files are kept in the data region of their country
+	dataResidency := services.NewDataResidencyService(userRepo, services.DataResidencySettings{
+		DefaultRegion:  cfg.DataResidency.DefaultRegion,
+		Regions:        cfg.DataResidency.GetRegions(),
+		CountryRegions: cfg.DataResidency.GetCountryRegions(),
+	})
+
 	// Initialize storage service
 	fileStorageConfig := config.LoadFileStorageConfig()
-	var storageProvider storage.StorageProvider
+	var storageProvider storage.RegionalStorage
 	var err2 error
 
-	// For now, use local storage. In production, this would be configurable
-	storageProvider, err2 = localStorage.NewLocalStorage(&fileStorageConfig.LocalConfig)
+	// For now, use local storage, a directory per data region. In production, this would be configurable
+	storageProvider, err2 = localStorage.NewLocalRegionalStorage(&fileStorageConfig.LocalConfig, dataResidency.DefaultRegion(), dataResidency.Regions())
 	if err2 != nil {
 		log.Fatal("Failed to initialize storage provider:", err2)
 	}
@@ -148,10 +253,44 @@ func main() {
 	// Initialize file security service
 	fileSecurityService := services.NewFileSecurityService()
 
-	fileService := services.NewFileService(storageProvider, fileRepo, fileSecurityService)
+	fileService := services.NewFileService(storageProvider, fileRepo, fileSecurityService, dataResidency)
+
+	// Users and files from before data regions, or created without one, get the default region
+	dataResidencyUseCase := usecases.NewDataResidencyUseCase(userRepo, fileRepo, dataResidency)
+	if tagged, err := dataResidencyUseCase.TagUntaggedRecords(context.Background()); err != nil {
+		log.Printf("⚠️ Failed to tag records with a data region: %v", err)
+	} else if tagged > 0 {
+		log.Printf("✅ Tagged %d users and files with the %s data region", tagged, dataResidency.DefaultRegion())
+	}
+
+	// Sandbox mode simulates payment gateways, captures outgoing email and allows clock control
+	var sandboxClock *sandbox.Clock
+	var sandboxMailbox *sandbox.Mailbox
+	if cfg.App.IsSandbox() {
+		sandboxClock = sandbox.NewClock()
+		sandboxMailbox = sandbox.NewMailbox(sandboxClock)
+		log.Printf("⚠️ SANDBOX MODE: payments are simulated and emails are captured at /api/v1/sandbox/mailbox")
+	} else if cfg.App.SandboxMode {
+		log.Printf("⚠️ SANDBOX_MODE is ignored in production")
+	}
+
+	// Circuit breakers keep a slow or failing external provider from tying up request workers
+	breakers := resilience.NewRegistry()
+	breakerSettings := func(timeoutSeconds int) resilience.Settings {
+		return resilience.Settings{
+			Timeout:          time.Duration(timeoutSeconds) * time.Second,
+			MaxConcurrent:    cfg.Resilience.MaxConcurrentCalls,
+			FailureThreshold: cfg.Resilience.BreakerFailureThreshold,
+			OpenDuration:     time.Duration(cfg.Resilience.BreakerOpenSeconds) * time.Second,
+		}
+	}
 
 	// Initialize Gmail service
 	gmailService := infraServices.NewGmailService(&cfg.Email)
+	gmailService.UseBreaker(breakers.Breaker("smtp", breakerSettings(cfg.Resilience.EmailTimeoutSeconds)))
+	if sandboxMailbox != nil {
+		gmailService.CaptureTo(sandboxMailbox)
+	}
 
 	// Validate Gmail configuration
 	if err := gmailService.ValidateConfiguration(); err != nil {
@@ -174,9 +313,50 @@ func main() {
 		passwordService,
 		gmailService,
 		nil, // notificationService - will be set later
-		cfg.JWT.Secret,
+		jwtKeys,
+		dataResidency,
+		eventRecorder,
+	)
+
+	// Catalog visibility rules are enforced by every customer-facing catalog read
+	catalogVisibilityUseCase := usecases.NewCatalogVisibilityUseCase(
+		catalogVisibilityRepo,
+		productRepo,
+		categoryRepo,
+		userRepo,
+		companyRepo,
+	)
+
+	// Personalized listing order; swap the ranker to experiment with other strategies
+	listingRanker := usecases.NewPreferenceListingRanker(
+		userPreferencesRepo,
+		searchRepo,
+		recommendationRepo,
+		productCategoryRepo,
 	)
 
+	// Translated product content, served in the reader's locale
+	productTranslationUseCase := usecases.NewProductTranslationUseCase(
+		productTranslationRepo,
+		productRepo,
+		cfg.Localization.SourceLocale,
+		cfg.Localization.SupportedLocales,
+	)
+
+	// Staged catalog changes, previewed on the storefront before they are published
+	catalogChangesetUseCase := usecases.NewCatalogChangesetUseCase(catalogChangesetRepo, productRepo, productTranslationUseCase)
+
+	// Bulk adding, removing and moving products across categories
+	categoryAssignmentUseCase := usecases.NewCategoryAssignmentUseCase(categoryAssignmentRepo, categoryRepo, productCategoryRepo, eventRecorder)
+
+	// Suppliers and purchase orders, whose expected deliveries are the restock estimates shown
+	// on out-of-stock products
+	var purchasingClock usecases.Clock
+	if sandboxClock != nil {
+		purchasingClock = sandboxClock
+	}
+	purchasingUseCase := usecases.NewPurchasingUseCase(supplierRepo, purchaseOrderRepo, productRepo, purchasingClock)
+
 	productUseCase := usecases.NewProductUseCase(
 		productRepo,
 		categoryRepo,
@@ -186,6 +366,22 @@ func main() {
 		cartRepo,
 		inventoryRepo,
 		warehouseRepo,
+		priceHistoryRepo,
+		orderRepo,
+		vendorRepo,
+		catalogVisibilityUseCase,
+		listingRanker,
+		productTranslationUseCase,
+		catalogChangesetUseCase,
+		purchasingUseCase,
+	)
+
+	pricingUseCase := usecases.NewPricingUseCase(
+		productRepo,
+		categoryRepo,
+		priceHistoryRepo,
+		scheduledPriceChangeRepo,
+		bulkPriceUpdateRepo,
 	)
 
 	categoryUseCase := usecases.NewCategoryUseCase(
@@ -193,30 +389,57 @@ func main() {
 		productRepo,
 		productCategoryRepo,
 		fileService,
+		catalogVisibilityUseCase,
 	)
 
 	brandUseCase := usecases.NewBrandUseCase(
 		brandRepo,
 	)
 
-	cartUseCase := usecases.NewCartUseCase(
-		cartRepo,
-		productRepo,
-		simpleStockService, // Use simple stock service instead
-	)
-
 	// Initialize WebSocket hub for real-time notifications
 	websocketHub := websocket.NewHub(context.Background())
 
 	// Start WebSocket hub in background
 	go websocketHub.Run()
 
+	// Storefront prices, order emails and invoices follow one tax display rule
+	priceDisplay := usecases.PriceDisplaySettings{
+		TaxDisplay: entities.TaxDisplayRule{
+			Default:            cfg.Storefront.TaxDisplay,
+			InclusiveCountries: cfg.Storefront.TaxInclusiveCountries,
+			ExclusiveCountries: cfg.Storefront.TaxExclusiveCountries,
+		},
+		DefaultLocale: cfg.Localization.SourceLocale,
+	}
+
+	// Initialize returns portal use case; delivery notifications link to it
+	var returnPortalClock usecases.Clock
+	if sandboxClock != nil {
+		returnPortalClock = sandboxClock
+	}
+	var autoApproveReasons []entities.ReturnReason
+	for _, reason := range cfg.ReturnPortal.AutoApproveReasons {
+		if returnReason := entities.ReturnReason(reason); returnReason.IsValid() {
+			autoApproveReasons = append(autoApproveReasons, returnReason)
+		}
+	}
+	returnPortalUseCase := usecases.NewReturnPortalUseCase(shippingRepo, orderRepo, usecases.ReturnPortalSettings{
+		Secret:             cfg.ReturnPortal.Secret,
+		BaseURL:            cfg.ReturnPortal.BaseURL,
+		Window:             time.Duration(cfg.ReturnPortal.WindowDays) * 24 * time.Hour,
+		AutoApproveReasons: autoApproveReasons,
+		Carrier:            cfg.ReturnPortal.Carrier,
+		ReturnAddress:      cfg.ReturnPortal.Address,
+	}, returnPortalClock)
+
 	// Initialize notification use case with WebSocket hub
 	notificationUseCase := usecases.NewNotificationUseCase(
 		notificationRepo, userRepo, orderRepo, paymentRepo, inventoryRepo,
 		reviewRepo, productRepo,
 		nil, nil, nil, // email, sms, push services - TODO: implement
 		websocketHub,  // WebSocket hub for real-time notifications
+		returnPortalUseCase,
+		priceDisplay,
 	)
 
 	// Re-initialize userUseCase with notificationUseCase
@@ -232,7 +455,9 @@ func main() {
 		passwordService,
 		gmailService,
 		notificationUseCase, // Now we have notificationUseCase
-		cfg.JWT.Secret,
+		jwtKeys,
+		dataResidency,
+		eventRecorder,
 	)
 
 	// Initialize notification queue processor
@@ -247,8 +472,33 @@ func main() {
 	)
 
 	// Initialize payment gateway services
-	stripeService := payment.NewStripeServiceWithWebhook(cfg.Payment.StripeSecretKey, cfg.Payment.StripeWebhookSecret)
-	paypalService := payment.NewPayPalService(cfg.Payment.PayPalClientID, cfg.Payment.PayPalClientSecret, cfg.Payment.PayPalSandbox)
+	var stripeService, paypalService usecases.PaymentGatewayService
+	var sandboxStripe, sandboxPayPal *payment.SandboxService
+	if cfg.App.IsSandbox() {
+		sandboxStripe = payment.NewSandboxService(payment.PaymentProviderStripe)
+		sandboxPayPal = payment.NewSandboxService(payment.PaymentProviderPayPal)
+		stripeService, paypalService = sandboxStripe, sandboxPayPal
+	} else {
+		stripeSettings := breakerSettings(cfg.Resilience.PaymentTimeoutSeconds)
+		stripeSettings.IsFailure = payment.IsStripeProviderFailure // declined cards are not provider failures
+		stripeService = payment.NewBreakerGateway(
+			payment.NewStripeServiceWithWebhook(cfg.Payment.StripeSecretKey, cfg.Payment.StripeWebhookSecret),
+			breakers.Breaker("stripe", stripeSettings),
+		)
+		paypalService = payment.NewBreakerGateway(
+			payment.NewPayPalService(cfg.Payment.PayPalClientID, cfg.Payment.PayPalClientSecret, cfg.Payment.PayPalSandbox),
+			breakers.Breaker("paypal", breakerSettings(cfg.Resilience.PaymentTimeoutSeconds)),
+		)
+	}
+
+	// Disputes and chargebacks arrive through the payment webhooks
+	disputeUseCase := usecases.NewDisputeUseCase(
+		disputeRepo,
+		paymentRepo,
+		orderRepo,
+		orderEventService,
+		notificationUseCase,
+	)
 
 	// Initialize payment use case
 	paymentUseCase := usecases.NewPaymentUseCase(
@@ -259,8 +509,45 @@ func main() {
 		userMetricsService,
 		txManager,
 		simpleStockService,
+		eventRecorder,
+		paymentLinkRepo,
+		disputeUseCase,
+		marketplaceFees,
+	)
+
+	// Settlements reconcile the gateway's balance transactions with recorded payments
+	settlementUseCase := usecases.NewSettlementUseCase(
+		settlementRepo,
+		paymentRepo,
+		disputeRepo,
+		stripeService,
+	)
+
+	// Company accounts apply spend limits and approvals to orders placed by company buyers
+	companyUseCase := usecases.NewCompanyUseCase(
+		companyRepo,
+		orderApprovalRepo,
+		companyInvoiceRepo,
+		orderRepo,
+		addressRepo,
+		userRepo,
+		simpleStockService,
+		orderEventService,
+		notificationUseCase,
+	)
+
+	// Verified tax exemption certificates take the tax off the items they exempt at checkout
+	taxExemptionUseCase := usecases.NewTaxExemptionUseCase(
+		taxExemptionRepo,
+		fileService,
+		orderService,
+		notificationUseCase,
+		usecases.TaxExemptionSettings{
+			ReminderDays: cfg.TaxExemption.ReminderDays,
+		},
 	)
 
+	orderMessageUseCase := usecases.NewOrderMessageUseCase(orderMessageRepo, orderRepo, fileService, notificationUseCase)
 	orderUseCase := usecases.NewOrderUseCase(
 		orderRepo,
 		cartRepo,
@@ -268,12 +555,18 @@ func main() {
 		paymentRepo,
 		inventoryRepo,
 		orderEventRepo,
+		addressRepo,
 		orderService,
 		simpleStockService,
 		orderEventService,
 		userMetricsService,
 		notificationUseCase, // Pass notification service
+		companyUseCase,
+		taxExemptionUseCase,
+		userUseCase,
 		txManager,
+		orderMessageUseCase,
+		priceDisplay,
 	)
 
 	checkoutUseCase := usecases.NewCheckoutUseCase(
@@ -281,23 +574,42 @@ func main() {
 		cartRepo,
 		orderRepo,
 		productRepo,
+		addressRepo,
 		simpleStockService,
 		orderService,
 		paymentUseCase,
+		companyUseCase,
+		taxExemptionUseCase,
+		userUseCase,
 		txManager,
 	)
 
+	// Quote requests convert into orders locked at the quoted prices
+	quoteUseCase := usecases.NewQuoteUseCase(
+		quoteRepo,
+		cartRepo,
+		orderUseCase,
+		companyUseCase,
+		notificationUseCase,
+	)
+
 	fileUseCase := usecases.NewFileUseCase(fileService)
 
 	// Initialize all use cases
 	couponUseCase := usecases.NewCouponUseCase(couponRepo, userRepo)
-	reviewUseCase := usecases.NewReviewUseCase(reviewRepo, reviewVoteRepo, productRatingRepo, productRepo, orderRepo, userRepo, notificationUseCase)
-	wishlistUseCase := usecases.NewWishlistUseCase(wishlistRepo, productRepo, productCategoryRepo)
-	inventoryUseCase := usecases.NewInventoryUseCase(inventoryRepo, productRepo, warehouseRepo, notificationUseCase)
+	reviewIncentiveUseCase := usecases.NewReviewIncentiveUseCase(reviewIncentiveRepo, reviewRepo, userRepo, notificationUseCase)
+	reviewModerationUseCase := usecases.NewReviewModerationUseCase(reviewModerationRepo)
+	reviewUseCase := usecases.NewReviewUseCase(reviewRepo, reviewVoteRepo, productRatingRepo, productRepo, orderRepo, userRepo, notificationUseCase, userUseCase, reviewIncentiveUseCase, reviewModerationUseCase)
+	wishlistUseCase := usecases.NewWishlistUseCase(wishlistRepo, productRepo, productCategoryRepo, userUseCase)
+	inventoryUseCase := usecases.NewInventoryUseCase(inventoryRepo, productRepo, warehouseRepo, inventorySnapshotRepo, notificationUseCase)
 	addressUseCase := usecases.NewAddressUseCase(addressRepo)
 
 	analyticsUseCase := usecases.NewAnalyticsUseCase(
 		analyticsRepo, orderRepo, productRepo, userRepo, inventoryRepo,
+		usecases.VisitorDataRetention{
+			AnonymizeAfterDays: cfg.Analytics.AnonymizeAfterDays,
+			RetentionDays:      cfg.Analytics.RetentionDays,
+		},
 	)
 
 
@@ -309,23 +621,127 @@ func main() {
 	compatibilityService := services.NewShippingCompatibilityService()
 
 	// Initialize shipping use case
-	shippingUseCase := usecases.NewShippingUseCase(shippingRepo, orderRepo, distanceService, compatibilityService)
+	shippingUseCase := usecases.NewShippingUseCase(
+		shippingRepo, orderRepo, cartRepo, productRepo, distanceService, compatibilityService,
+		cache.NewMemoryCache(),
+		usecases.ShippingEstimateSettings{
+			OriginAddress: cfg.Shipping.OriginAddress,
+			CacheTTL:      cfg.Shipping.GetEstimateCacheTTL(),
+		},
+	)
 
+	adminNoteUseCase := usecases.NewAdminNoteUseCase(adminNoteRepo, orderRepo, userRepo, notificationUseCase)
 	adminUseCase := usecases.NewAdminUseCase(
 		userRepo, orderRepo, productRepo, reviewRepo,
 		analyticsRepo, inventoryRepo, paymentRepo, auditRepo,
-		userLoginHistoryRepo, orderUseCase,
+		userLoginHistoryRepo, orderUseCase, adminNoteUseCase, orderMessageUseCase, reviewIncentiveUseCase,
+		database.NewSystemRepository(db),
 	)
 
-	// Initialize email use case (with nil repositories for now)
-	emailUseCase := usecases.NewEmailUseCase(
-		nil, nil, nil, nil, // email service, repo, template repo, subscription repo - TODO: implement
-		userRepo, orderRepo, productRepo,
-	)
+	// Initialize email use case (with nil repositories for now; sandbox mode delivers to the mailbox)
+	var emailUseCase usecases.EmailUseCase
+	if sandboxMailbox != nil {
+		emailRepo := database.NewEmailRepository(db)
+		emailSubscriptionRepo := database.NewEmailSubscriptionRepository(db)
+		emailUseCase = usecases.NewEmailUseCase(
+			services.NewEmailService(emailRepo, emailTemplateRepo, emailSubscriptionRepo, sandboxMailbox, cfg.Email.FromEmail, cfg.Email.FromName),
+			emailRepo, emailTemplateRepo, emailSubscriptionRepo,
+			userRepo, orderRepo, productRepo, priceDisplay,
+		)
+	} else {
+		emailUseCase = usecases.NewEmailUseCase(
+			nil, nil, nil, nil, // email service, repo, template repo, subscription repo - TODO: implement
+			userRepo, orderRepo, productRepo, priceDisplay,
+		)
+	}
 
 	// Initialize abandoned cart use case
+	var abandonedCartClock usecases.Clock
+	if sandboxClock != nil {
+		abandonedCartClock = sandboxClock
+	}
 	abandonedCartUseCase := usecases.NewAbandonedCartUseCase(
-		cartRepo, userRepo, emailUseCase, productRepo, orderRepo,
+		cartRepo, userRepo, emailUseCase, productRepo, orderRepo, abandonedCartClock,
+	)
+
+	// Back in stock notifications, batched to the restocked units and held while a trickle of
+	// stock waits for a purchase order that is about to arrive
+	backInStockUseCase := usecases.NewBackInStockUseCase(
+		backInStockRepo, productRepo, purchasingUseCase, notificationUseCase,
+		usecases.BackInStockSettings{
+			SubscribersPerUnit: cfg.BackInStock.SubscribersPerUnit,
+			HoldDays:           cfg.BackInStock.HoldDays,
+		},
+		purchasingClock,
+	)
+
+	// Pre-launch waitlists: customers on a product's waitlist are notified in waves when it
+	// launches, some segments and membership tiers early, and nobody else can buy it before then
+	var waitlistClock usecases.Clock
+	if sandboxClock != nil {
+		waitlistClock = sandboxClock
+	}
+	waitlistUseCase := usecases.NewWaitlistUseCase(
+		waitlistRepo, productRepo, userRepo, notificationUseCase,
+		usecases.WaitlistSettings{
+			DefaultWaveSize:            cfg.Waitlist.WaveSize,
+			DefaultWaveIntervalMinutes: cfg.Waitlist.WaveIntervalMinutes,
+		},
+		waitlistClock,
+	)
+
+	cartUseCase := usecases.NewCartUseCase(
+		cartRepo,
+		productRepo,
+		simpleStockService, // Use simple stock service instead
+		waitlistUseCase,
+	)
+
+	// Fulfillment SLA monitoring: orders must ship within a number of business days, and admins
+	// are alerted to orders about to miss it, late, or stuck in a status
+	var fulfillmentSLAClock usecases.Clock
+	if sandboxClock != nil {
+		fulfillmentSLAClock = sandboxClock
+	}
+	stuckAfter := make(map[entities.OrderStatus]time.Duration)
+	for status, limit := range cfg.FulfillmentSLA.GetStuckAfter() {
+		stuckAfter[entities.OrderStatus(status)] = limit
+	}
+	fulfillmentSLAUseCase := usecases.NewFulfillmentSLAUseCase(
+		database.NewOrderSLARepository(db), notificationUseCase,
+		usecases.FulfillmentSLASettings{
+			BusinessDays: cfg.FulfillmentSLA.BusinessDays,
+			Warning:      time.Duration(cfg.FulfillmentSLA.WarningHours) * time.Hour,
+			Calendar: entities.BusinessCalendar{
+				Location: cfg.FulfillmentSLA.GetLocation(),
+				Holidays: cfg.FulfillmentSLA.GetHolidays(),
+			},
+			StuckAfter: stuckAfter,
+		},
+		fulfillmentSLAClock,
+	)
+
+	// Partner feed: affiliates and price-comparison engines read price and availability changes
+	// with their API keys, and get them posted to their webhooks
+	var partnerFeedClock usecases.Clock
+	if sandboxClock != nil {
+		partnerFeedClock = sandboxClock
+	}
+	partnerFeedUseCase := usecases.NewPartnerFeedUseCase(
+		partnerRepo, apiKeyRepo, apiKeyUsageRepo, partnerFeedRepo,
+		events.NewPartnerWebhookClient(time.Duration(cfg.PartnerFeed.WebhookTimeoutSeconds)*time.Second),
+		usecases.PartnerFeedSettings{
+			Currency:    cfg.Storefront.BaseCurrency,
+			Retention:   time.Duration(cfg.PartnerFeed.RetentionDays) * 24 * time.Hour,
+			SettleDelay: time.Duration(cfg.PartnerFeed.SettleSeconds) * time.Second,
+			MaxBackoff:  time.Duration(cfg.PartnerFeed.MaxBackoffMinutes) * time.Minute,
+			RatePlans: map[entities.APIKeyPlan]entities.APIRatePlan{
+				entities.APIKeyPlanFree:     {RequestsPerMinute: cfg.APIPlans.FreeRequestsPerMinute, DailyQuota: cfg.APIPlans.FreeDailyQuota},
+				entities.APIKeyPlanPartner:  {RequestsPerMinute: cfg.APIPlans.PartnerRequestsPerMinute, DailyQuota: cfg.APIPlans.PartnerDailyQuota},
+				entities.APIKeyPlanInternal: {RequestsPerMinute: cfg.APIPlans.InternalRequestsPerMinute, DailyQuota: cfg.APIPlans.InternalDailyQuota},
+			},
+		},
+		partnerFeedClock,
 	)
 
 	// Initialize stock cleanup use case - DEPRECATED (using simple stock service now)
@@ -337,22 +753,24 @@ func main() {
 	// )
 
 	// Initialize JWT service
-	jwtService := infraServices.NewJWTService(cfg.JWT.Secret)
+	jwtService := infraServices.NewJWTService(jwtKeys)
 
 	// Initialize OAuth configuration and service
 	oauthConfig := config.NewOAuthConfig()
 	oauthService := oauth.NewService(oauthConfig)
+	oauthService.UseBreakers(
+		breakers.Breaker("oauth_google", breakerSettings(cfg.Resilience.OAuthTimeoutSeconds)),
+		breakers.Breaker("oauth_facebook", breakerSettings(cfg.Resilience.OAuthTimeoutSeconds)),
+	)
 
 	// Initialize OAuth use case
-	oauthUseCase := usecases.NewOAuthUseCase(userRepo, oauthService, jwtService)
+	oauthUseCase := usecases.NewOAuthUseCase(userRepo, oauthService, jwtService, dataResidency, eventRecorder)
 
-	// Initialize search repository and use case
-	searchRepo := database.NewSearchRepository(db)
-	searchUseCase := usecases.NewSearchUseCase(searchRepo, productRepo, productCategoryRepo)
+	// Initialize search use case
+	searchUseCase := usecases.NewSearchUseCase(searchRepo, productRepo, productCategoryRepo, catalogVisibilityUseCase)
 
-	// Initialize recommendation repository and use case
-	recommendationRepo := database.NewRecommendationRepository(db)
-	recommendationUseCase := usecases.NewRecommendationUseCase(recommendationRepo, productRepo, userRepo)
+	// Initialize recommendation use case
+	recommendationUseCase := usecases.NewRecommendationUseCase(recommendationRepo, productRepo, userRepo, catalogVisibilityUseCase)
 
 	// Initialize product comparison system
 	comparisonRepo := database.NewProductComparisonRepository(db)
@@ -360,14 +778,249 @@ func main() {
 
 	// Initialize advanced product filtering system
 	productFilterRepo := database.NewProductFilterRepository(db)
-	productFilterUseCase := usecases.NewProductFilterUseCase(productFilterRepo, productRepo, productCategoryRepo)
+	productFilterUseCase := usecases.NewProductFilterUseCase(productFilterRepo, productRepo, productCategoryRepo, catalogVisibilityUseCase)
+
+	// Stream domain events from the outbox to Kafka or NATS
+	var eventBridgeUseCase usecases.EventBridgeUseCase
+	if cfg.EventBridge.IsEnabled() {
+		publisher, err := events.NewPublisher(
+			cfg.EventBridge.Broker,
+			cfg.EventBridge.URL,
+			cfg.EventBridge.TopicPrefix,
+			cfg.EventBridge.Username,
+			cfg.EventBridge.Password,
+			time.Duration(cfg.EventBridge.TimeoutSeconds)*time.Second,
+		)
+		if err != nil {
+			log.Fatal("Invalid event bridge configuration:", err)
+		}
+		eventBridgeUseCase = usecases.NewEventBridgeUseCase(
+			outboxEventRepo,
+			events.NewBreakerPublisher(publisher, breakers.Breaker("event_broker", breakerSettings(cfg.EventBridge.TimeoutSeconds))),
+			cfg.EventBridge.Broker,
+			cfg.EventBridge.BatchSize,
+			cfg.EventBridge.RetentionDays,
+		)
+		log.Printf("✅ Domain events are published to %s", cfg.EventBridge.Broker)
+	}
+
+	// Anonymized analytics exports replace customer IDs with pseudonyms keyed by this secret
+	pseudonymizer := entities.NewPseudonymizer(cfg.Anonymization.Secret)
+
+	// Export orders, order items, customers and products to the data warehouse
+	var warehouseSyncUseCase usecases.WarehouseSyncUseCase
+	if cfg.Warehouse.IsEnabled() {
+		target, err := warehouse.NewTarget(context.Background(), &cfg.Warehouse)
+		if err != nil {
+			log.Fatal("Invalid warehouse configuration:", err)
+		}
+		warehouseSettings := breakerSettings(cfg.Warehouse.TimeoutSeconds)
+		warehouseSettings.IsFailure = warehouse.IsProviderFailure // schema conflicts are not outages
+		var warehousePseudonymizer *entities.Pseudonymizer
+		if cfg.Warehouse.Anonymize {
+			warehousePseudonymizer = pseudonymizer
+		}
+		warehouseSyncUseCase = usecases.NewWarehouseSyncUseCase(
+			database.NewWarehouseSyncRepository(db),
+			warehouse.NewBreakerTarget(target, breakers.Breaker("warehouse", warehouseSettings)),
+			cfg.Warehouse.Target,
+			cfg.Warehouse.BatchSize,
+			cfg.Warehouse.LagSeconds,
+			warehousePseudonymizer,
+		)
+		log.Printf("✅ Tables are exported to %s", cfg.Warehouse.Target)
+	}
+
+	// Compare denormalized aggregates with their source tables and repair the ones that drifted
+	aggregateRepairUseCase := usecases.NewAggregateRepairUseCase(
+		database.NewAggregateRepairRepository(db),
+		userMetricsService,
+		cfg.AggregateRepair.BatchSize,
+	)
+
+	// Move the items and events of old orders to the archive, leaving the orders as stubs
+	orderArchiveUseCase := usecases.NewOrderArchiveUseCase(
+		database.NewOrderArchiveRepository(db),
+		cfg.OrderArchive.AfterYears,
+		cfg.OrderArchive.BatchSize,
+	)
+
+	// Generated report files, downloaded through signed URLs
+	reportUseCase := usecases.NewReportUseCase(
+		database.NewReportRepository(db),
+		auditRepo,
+		userPreferencesRepo,
+		dataResidency,
+		cfg.Report.DownloadSecret,
+		time.Duration(cfg.Report.DownloadURLMinutes)*time.Minute,
+		cfg.Report.MaxRows,
+		pseudonymizer,
+	)
+
+	// Single-use payment links for orders, converted quotes or arbitrary amounts
+	paymentLinkUseCase := usecases.NewPaymentLinkUseCase(
+		paymentLinkRepo,
+		orderRepo,
+		quoteRepo,
+		paymentUseCase,
+		stripeService,
+		notificationUseCase,
+		cfg.PaymentLink.BaseURL,
+		time.Duration(cfg.PaymentLink.ExpiryHours)*time.Hour,
+	)
+
+	// The admin inbox is kept in line with what needs attention by the sync_admin_tasks job
+	adminTaskUseCase := usecases.NewAdminTaskUseCase(adminTaskRepo, userRepo, notificationUseCase)
+
+	vendorUseCase := usecases.NewVendorUseCase(vendorRepo, vendorOrderRepo, userRepo, categoryRepo, orderRepo, marketplaceFees)
+	vendorApplicationUseCase := usecases.NewVendorApplicationUseCase(vendorRepo, vendorApplicationRepo, fileService, notificationUseCase)
+
+	storefrontUseCase := usecases.NewStorefrontUseCase(userRepo, addressRepo, cartRepo, usecases.StorefrontSettings{
+		BaseCurrency:          cfg.Storefront.BaseCurrency,
+		DefaultLocale:         cfg.Localization.SourceLocale,
+		DefaultCountry:        cfg.Storefront.DefaultCountry,
+		Locales:               cfg.Localization.SupportedLocales,
+		CurrencyRates:         cfg.Storefront.CurrencyRates,
+		TaxRates:              cfg.Storefront.TaxRates,
+		TaxDisplay:            priceDisplay.TaxDisplay,
+	})
+
+	messageTemplateUseCase := usecases.NewMessageTemplateUseCase(userRepo, orderRepo, emailTemplateRepo)
+	platformCSVUseCase := usecases.NewPlatformCSVUseCase(productUseCase, productRepo, categoryRepo, productCategoryRepo, brandRepo, orderRepo, dataResidency)
+
+	// Initialize background job scheduler
+	jobScheduler := infraServices.NewJobScheduler()
+	jobScheduler.Register("apply_scheduled_price_changes", time.Minute, func(ctx context.Context) error {
+		_, err := pricingUseCase.ApplyDuePriceChanges(ctx)
+		return err
+	})
+	jobScheduler.Register("revert_expired_sales", time.Minute, func(ctx context.Context) error {
+		_, err := pricingUseCase.RevertExpiredSales(ctx)
+		return err
+	})
+	jobScheduler.Register("publish_scheduled_catalog_changesets", time.Minute, func(ctx context.Context) error {
+		_, err := catalogChangesetUseCase.PublishDueChangesets(ctx)
+		return err
+	})
+	jobScheduler.Register("run_category_assignments", 10*time.Second, func(ctx context.Context) error {
+		_, err := categoryAssignmentUseCase.RunPendingAssignments(ctx)
+		return err
+	})
+	// Also reloads keys rotated by other servers
+	jobScheduler.Register("rotate_jwt_keys", time.Minute, func(ctx context.Context) error {
+		_, err := jwtKeyUseCase.RotateDueKeys(ctx)
+		return err
+	})
+	jobScheduler.Register("tag_data_regions", 10*time.Minute, func(ctx context.Context) error {
+		_, err := dataResidencyUseCase.TagUntaggedRecords(ctx)
+		return err
+	})
+	jobScheduler.Register("notify_back_in_stock", time.Duration(cfg.BackInStock.IntervalMinutes)*time.Minute, func(ctx context.Context) error {
+		_, err := backInStockUseCase.NotifySubscribers(ctx)
+		return err
+	})
+	jobScheduler.Register("send_waitlist_waves", time.Duration(cfg.Waitlist.IntervalMinutes)*time.Minute, func(ctx context.Context) error {
+		_, err := waitlistUseCase.SendWaves(ctx)
+		return err
+	})
+	jobScheduler.Register("monitor_fulfillment_sla", time.Duration(cfg.FulfillmentSLA.IntervalMinutes)*time.Minute, func(ctx context.Context) error {
+		_, err := fulfillmentSLAUseCase.MonitorOrders(ctx)
+		return err
+	})
+	jobScheduler.Register("deliver_partner_feeds", time.Duration(cfg.PartnerFeed.DeliveryIntervalSeconds)*time.Second, func(ctx context.Context) error {
+		_, err := partnerFeedUseCase.DeliverWebhooks(ctx)
+		return err
+	})
+	jobScheduler.Register("purge_partner_feed_changes", 24*time.Hour, func(ctx context.Context) error {
+		_, err := partnerFeedUseCase.PurgeChanges(ctx)
+		return err
+	})
+	jobScheduler.Register("expire_quotes", 5*time.Minute, func(ctx context.Context) error {
+		_, err := quoteUseCase.ExpireQuotes(ctx)
+		return err
+	})
+	jobScheduler.Register("expire_payment_links", 5*time.Minute, func(ctx context.Context) error {
+		_, err := paymentLinkUseCase.ExpirePaymentLinks(ctx)
+		return err
+	})
+	jobScheduler.Register("send_dispute_evidence_reminders", time.Hour, func(ctx context.Context) error {
+		_, err := disputeUseCase.SendEvidenceReminders(ctx)
+		return err
+	})
+	// Reconciles the previous UTC day; the sandbox gateways have no balance transactions
+	if _, ok := stripeService.(usecases.BalanceTransactionLister); ok {
+		jobScheduler.Register("reconcile_settlements", 24*time.Hour, func(ctx context.Context) error {
+			_, err := settlementUseCase.ReconcileDay(ctx, "stripe", time.Now().UTC().AddDate(0, 0, -1))
+			return err
+		})
+	}
+	jobScheduler.Register("sync_admin_tasks", time.Minute, func(ctx context.Context) error {
+		_, _, err := adminTaskUseCase.SyncTasks(ctx)
+		return err
+	})
+	jobScheduler.Register("send_invoice_reminders", time.Hour, func(ctx context.Context) error {
+		_, err := companyUseCase.SendInvoiceReminders(ctx)
+		return err
+	})
+	jobScheduler.Register("send_tax_exemption_reminders", time.Hour, func(ctx context.Context) error {
+		_, err := taxExemptionUseCase.SendExpiryReminders(ctx)
+		return err
+	})
+	jobScheduler.Register("flush_email_queue", time.Minute, func(ctx context.Context) error {
+		_, err := gmailService.FlushQueue(ctx)
+		return err
+	})
+	// Re-captured hourly, so each day's snapshot holds stock as of the last run that day
+	jobScheduler.Register("snapshot_inventory", time.Hour, func(ctx context.Context) error {
+		_, err := inventoryUseCase.CaptureInventorySnapshot(ctx)
+		return err
+	})
+	jobScheduler.Register("apply_visitor_data_retention", 24*time.Hour, func(ctx context.Context) error {
+		_, err := analyticsUseCase.ApplyVisitorDataRetention(ctx)
+		return err
+	})
+	if eventBridgeUseCase != nil {
+		jobScheduler.Register("publish_outbox_events", 10*time.Second, func(ctx context.Context) error {
+			_, err := eventBridgeUseCase.PublishPendingEvents(ctx)
+			return err
+		})
+		jobScheduler.Register("purge_published_events", 24*time.Hour, func(ctx context.Context) error {
+			_, err := eventBridgeUseCase.PurgePublishedEvents(ctx)
+			return err
+		})
+	}
+	if warehouseSyncUseCase != nil {
+		jobScheduler.Register("sync_warehouse_tables", time.Duration(cfg.Warehouse.IntervalMinutes)*time.Minute, func(ctx context.Context) error {
+			_, err := warehouseSyncUseCase.SyncTables(ctx)
+			return err
+		})
+	}
+	if cfg.AggregateRepair.IntervalHours > 0 {
+		jobScheduler.Register("repair_aggregates", time.Duration(cfg.AggregateRepair.IntervalHours)*time.Hour, func(ctx context.Context) error {
+			_, err := aggregateRepairUseCase.RepairAggregates(ctx, usecases.RepairAggregatesRequest{
+				DryRun:  cfg.AggregateRepair.ReportOnly,
+				Trigger: entities.AggregateRepairTriggerScheduled,
+			})
+			return err
+		})
+	}
+	if cfg.OrderArchive.AfterYears > 0 && cfg.OrderArchive.IntervalHours > 0 {
+		jobScheduler.Register("archive_old_orders", time.Duration(cfg.OrderArchive.IntervalHours)*time.Hour, func(ctx context.Context) error {
+			_, err := orderArchiveUseCase.ArchiveOldOrders(ctx)
+			return err
+		})
+	}
+	if cfg.App.IsSandbox() {
+		// Reminder emails only have a delivery backend in sandbox mode, where they land in the mailbox
+		jobScheduler.Register("detect_abandoned_carts", time.Hour, abandonedCartUseCase.DetectAbandonedCarts)
+	}
 
 	// Initialize handlers
-	userHandler := handlers.NewUserHandler(userUseCase)
+	userHandler := handlers.NewUserHandler(userUseCase, analyticsUseCase)
 	productHandler := handlers.NewProductHandler(productUseCase)
 	categoryHandler := handlers.NewCategoryHandler(categoryUseCase)
 	brandHandler := handlers.NewBrandHandler(brandUseCase)
-	cartHandler := handlers.NewCartHandler(cartUseCase)
+	cartHandler := handlers.NewCartHandler(cartUseCase, analyticsUseCase)
 	orderHandler := handlers.NewOrderHandler(orderUseCase)
 	checkoutHandler := handlers.NewCheckoutHandler(checkoutUseCase)
 	fileHandler := handlers.NewFileHandler(fileUseCase)
@@ -382,13 +1035,78 @@ func main() {
 	paymentHandler := handlers.NewPaymentHandler(paymentUseCase)
 	shippingHandler := handlers.NewShippingHandler(shippingUseCase)
 	adminHandler := handlers.NewAdminHandler(adminUseCase)
-	oauthHandler := handlers.NewOAuthHandler(oauthUseCase)
+	oauthHandler := handlers.NewOAuthHandler(oauthUseCase, analyticsUseCase)
 	migrationHandler := handlers.NewMigrationHandler(db)
 	searchHandler := handlers.NewSearchHandler(searchUseCase)
 	recommendationHandler := handlers.NewRecommendationHandler(recommendationUseCase)
 	comparisonHandler := handlers.NewProductComparisonHandler(comparisonUseCase)
 	productFilterHandler := handlers.NewProductFilterHandler(productFilterUseCase)
 	abandonedCartHandler := handlers.NewAbandonedCartHandler(abandonedCartUseCase)
+	pricingHandler := handlers.NewPricingHandler(pricingUseCase)
+	companyHandler := handlers.NewCompanyHandler(companyUseCase)
+	quoteHandler := handlers.NewQuoteHandler(quoteUseCase)
+	catalogVisibilityHandler := handlers.NewCatalogVisibilityHandler(catalogVisibilityUseCase)
+	metricsHandler := handlers.NewMetricsHandler(breakers)
+
+	// The system status checks email delivery and, where supported, file storage live
+	statusProviders := []usecases.ProviderCheck{
+		{Name: "email", Breaker: "smtp", Checker: gmailService},
+	}
+	if checker, ok := storageProvider.(usecases.HealthChecker); ok {
+		statusProviders = append(statusProviders, usecases.ProviderCheck{Name: "storage", Checker: checker})
+	}
+	systemStatusUseCase := usecases.NewSystemStatusUseCase(
+		notificationRepo, outboxEventRepo, partnerRepo,
+		jobScheduler, gmailService, breakers, statusProviders,
+	)
+	systemStatusHandler := handlers.NewSystemStatusHandler(systemStatusUseCase)
+	adminNoteHandler := handlers.NewAdminNoteHandler(adminNoteUseCase)
+	orderMessageHandler := handlers.NewOrderMessageHandler(orderMessageUseCase)
+	reviewIncentiveHandler := handlers.NewReviewIncentiveHandler(reviewIncentiveUseCase)
+	reviewModerationHandler := handlers.NewReviewModerationHandler(reviewModerationUseCase)
+	productTranslationHandler := handlers.NewProductTranslationHandler(productTranslationUseCase)
+	catalogChangesetHandler := handlers.NewCatalogChangesetHandler(catalogChangesetUseCase)
+	aggregateRepairHandler := handlers.NewAggregateRepairHandler(aggregateRepairUseCase)
+	orderArchiveHandler := handlers.NewOrderArchiveHandler(orderArchiveUseCase)
+	reportHandler := handlers.NewReportHandler(reportUseCase)
+	paymentLinkHandler := handlers.NewPaymentLinkHandler(paymentLinkUseCase)
+	disputeHandler := handlers.NewDisputeHandler(disputeUseCase)
+	settlementHandler := handlers.NewSettlementHandler(settlementUseCase)
+	adminTaskHandler := handlers.NewAdminTaskHandler(adminTaskUseCase)
+	messageTemplateHandler := handlers.NewMessageTemplateHandler(messageTemplateUseCase)
+	platformCSVHandler := handlers.NewPlatformCSVHandler(platformCSVUseCase)
+	vendorHandler := handlers.NewVendorHandler(vendorUseCase)
+	vendorApplicationHandler := handlers.NewVendorApplicationHandler(vendorApplicationUseCase)
+	taxExemptionHandler := handlers.NewTaxExemptionHandler(taxExemptionUseCase)
+	storefrontHandler := handlers.NewStorefrontHandler(storefrontUseCase, cfg.Storefront.GetCookieMaxAge(), cfg.App.IsProduction())
+	categoryAssignmentHandler := handlers.NewCategoryAssignmentHandler(categoryAssignmentUseCase)
+	jwtKeyHandler := handlers.NewJWTKeyHandler(jwtKeyUseCase)
+	dataResidencyHandler := handlers.NewDataResidencyHandler(dataResidencyUseCase)
+	purchasingHandler := handlers.NewPurchasingHandler(purchasingUseCase)
+	backInStockHandler := handlers.NewBackInStockHandler(backInStockUseCase)
+	waitlistHandler := handlers.NewWaitlistHandler(waitlistUseCase)
+	fulfillmentSLAHandler := handlers.NewFulfillmentSLAHandler(fulfillmentSLAUseCase)
+	returnPortalHandler := handlers.NewReturnPortalHandler(returnPortalUseCase, fileUseCase)
+	partnerFeedHandler := handlers.NewPartnerFeedHandler(partnerFeedUseCase)
+
+	var eventBridgeHandler *handlers.EventBridgeHandler
+	if eventBridgeUseCase != nil {
+		eventBridgeHandler = handlers.NewEventBridgeHandler(eventBridgeUseCase)
+	}
+
+	var warehouseSyncHandler *handlers.WarehouseSyncHandler
+	if warehouseSyncUseCase != nil {
+		warehouseSyncHandler = handlers.NewWarehouseSyncHandler(warehouseSyncUseCase)
+	}
+
+	var sandboxHandler *handlers.SandboxHandler
+	if cfg.App.IsSandbox() {
+		sandboxUseCase := usecases.NewSandboxUseCase(
+			sandboxClock, sandboxMailbox, paymentUseCase, jobScheduler,
+			sandboxStripe, sandboxPayPal,
+		)
+		sandboxHandler = handlers.NewSandboxHandler(sandboxUseCase)
+	}
 
 	// Initialize Gin router
 	router := gin.New()
@@ -397,6 +1115,7 @@ func main() {
 	routes.SetupRoutes(
 		router,
 		cfg,
+		jwtKeys,
 		userHandler,
 		productHandler,
 		categoryHandler,
@@ -423,6 +1142,43 @@ func main() {
 		comparisonHandler,
 		productFilterHandler,
 		abandonedCartHandler,
+		pricingHandler,
+		companyHandler,
+		quoteHandler,
+		catalogVisibilityHandler,
+		sandboxHandler,
+		metricsHandler,
+		adminNoteHandler,
+		orderMessageHandler,
+		reviewIncentiveHandler,
+		productTranslationHandler,
+		catalogChangesetHandler,
+		eventBridgeHandler,
+		warehouseSyncHandler,
+		aggregateRepairHandler,
+		orderArchiveHandler,
+		reportHandler,
+		paymentLinkHandler,
+		disputeHandler,
+		settlementHandler,
+		adminTaskHandler,
+		messageTemplateHandler,
+		platformCSVHandler,
+		vendorHandler,
+		vendorApplicationHandler,
+		storefrontHandler,
+		categoryAssignmentHandler,
+		jwtKeyHandler,
+		dataResidencyHandler,
+		purchasingHandler,
+		backInStockHandler,
+		fulfillmentSLAHandler,
+		returnPortalHandler,
+		partnerFeedHandler,
+		reviewModerationHandler,
+		taxExemptionHandler,
+		systemStatusHandler,
+		waitlistHandler,
 	)
 
 	// Background cleanup scheduler removed - using simple stock service
@@ -435,6 +1191,11 @@ func main() {
 		}
 	}()
 
+	// Start background job scheduler
+	if err := jobScheduler.Start(context.Background()); err != nil {
+		log.Printf("Failed to start job scheduler: %v", err)
+	}
+
 	// Start server
 	log.Printf("Starting server on %s", cfg.App.GetAddress())
 	if err := router.Run(cfg.App.GetAddress()); err != nil {
@@ -0,0 +1,510 @@
+// Command openapi-gen turns the swagger annotations on HTTP handlers into the
+// operation documentation the router uses to serve /openapi.json.
+//
+// Usage (from the repository root):
+//
+//	go run ./cmd/openapi-gen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "ecom-golang-clean-architecture"
+
+// typePackages maps package names usable in annotations to their directories
+var typePackages = map[string]string{
+	"handlers":   "internal/delivery/http/handlers",
+	"usecases":   "internal/usecases",
+	"entities":   "internal/domain/entities",
+	"resilience": "internal/infrastructure/resilience",
+	"services":   "internal/domain/services",
+	"jwtkeys":    "pkg/jwtkeys",
+}
+
+// envelopeTypes are handler response wrappers that annotations may reference directly
+var envelopeTypes = map[string]bool{
+	"SuccessResponse":               true,
+	"PaginatedResponse":             true,
+	"PersonalizedPaginatedResponse": true,
+	"ErrorResponse":                 true,
+}
+
+var successStatuses = map[string]int{
+	"StatusOK":        200,
+	"StatusCreated":   201,
+	"StatusAccepted":  202,
+	"StatusNoContent": 204,
+}
+
+var (
+	paramPattern    = regexp.MustCompile(`^(\S+)\s+(\S+)\s+(\S+)\s+(true|false)(?:\s+"([^"]*)")?`)
+	responsePattern = regexp.MustCompile(`^(\d+)\s+\{(\w+)\}\s+(\S+)(?:\s+"([^"]*)")?`)
+	envelopePattern = regexp.MustCompile(`^([\w.]+)\{data=([\w.\[\]]+)\}$`)
+)
+
+// typeInfo records whether a declared type is a struct
+type typeInfo struct {
+	isStruct bool
+}
+
+type generator struct {
+	types    map[string]typeInfo // Keyed by "pkg.Name"
+	imports  map[string]bool
+	warnings []string
+}
+
+type operation struct {
+	key         string
+	summary     string
+	description string
+	tags        []string
+	secured     bool
+	params      []param
+	body        string
+	responses   []response
+}
+
+func (op *operation) hasResponse(status int) bool {
+	for _, r := range op.responses {
+		if r.status == status {
+			return true
+		}
+	}
+	return false
+}
+
+type param struct {
+	name, in, typ, description string
+	required                   bool
+}
+
+type response struct {
+	status      int
+	description string
+	body        string
+	data        string
+	file        bool
+}
+
+func main() {
+	output := flag.String("o", "internal/delivery/http/routes/openapi_operations_gen.go", "output file")
+	flag.Parse()
+
+	g := &generator{types: make(map[string]typeInfo), imports: make(map[string]bool)}
+	for pkg, dir := range typePackages {
+		if err := g.loadTypes(pkg, dir); err != nil {
+			log.Fatalf("Failed to load %s types: %v", pkg, err)
+		}
+	}
+
+	operations, err := g.parseHandlers(typePackages["handlers"])
+	if err != nil {
+		log.Fatalf("Failed to parse handlers: %v", err)
+	}
+
+	source, err := g.render(operations)
+	if err != nil {
+		log.Fatalf("Failed to render operations: %v", err)
+	}
+	if err := os.WriteFile(*output, source, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *output, err)
+	}
+
+	for _, warning := range g.warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+	fmt.Printf("Wrote %d operations to %s\n", len(operations), *output)
+}
+
+// loadTypes records the type declarations of a package
+func (g *generator) loadTypes(pkg, dir string) error {
+	files, err := parsePackage(dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				_, isStruct := typeSpec.Type.(*ast.StructType)
+				g.types[pkg+"."+typeSpec.Name.Name] = typeInfo{isStruct: isStruct}
+			}
+		}
+	}
+	return nil
+}
+
+// parseHandlers collects the documented exported methods of *XHandler types
+func (g *generator) parseHandlers(dir string) ([]*operation, error) {
+	files, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []*operation
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			receiver := receiverName(fn)
+			if !strings.HasSuffix(receiver, "Handler") {
+				continue
+			}
+			key := receiver + "." + fn.Name.Name
+			if fn.Doc == nil || !strings.Contains(fn.Doc.Text(), "@Router") {
+				g.warn("%s has no swagger annotations", key)
+				continue
+			}
+			operations = append(operations, g.parseOperation(key, fn))
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool { return operations[i].key < operations[j].key })
+	return operations, nil
+}
+
+func (g *generator) parseOperation(key string, fn *ast.FuncDecl) *operation {
+	op := &operation{key: key}
+	wrapped := successEnvelopes(fn)
+
+	for _, comment := range fn.Doc.List {
+		line := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		if !strings.HasPrefix(line, "@") {
+			continue
+		}
+		annotation, value, _ := strings.Cut(line, " ")
+		value = strings.TrimSpace(value)
+
+		switch annotation {
+		case "@Summary":
+			op.summary = value
+		case "@Description":
+			op.description = value
+		case "@Tags":
+			for _, tag := range strings.Split(value, ",") {
+				op.tags = append(op.tags, strings.TrimSpace(tag))
+			}
+		case "@Security":
+			op.secured = true
+		case "@Param":
+			match := paramPattern.FindStringSubmatch(value)
+			if match == nil {
+				g.warn("%s: unparsable @Param %q", key, value)
+				continue
+			}
+			if match[2] == "body" {
+				op.body = g.sample(key, match[3], "object")
+				continue
+			}
+			op.params = append(op.params, param{
+				name:        match[1],
+				in:          match[2],
+				typ:         match[3],
+				required:    match[4] == "true",
+				description: match[5],
+			})
+		case "@Success", "@Failure":
+			match := responsePattern.FindStringSubmatch(value)
+			if match == nil {
+				g.warn("%s: unparsable %s %q", key, annotation, value)
+				continue
+			}
+			status, _ := strconv.Atoi(match[1])
+			if op.hasResponse(status) {
+				continue
+			}
+			op.responses = append(op.responses, g.parseResponse(key, status, match[2], match[3], match[4], wrapped[status]))
+		}
+	}
+	return op
+}
+
+// parseResponse converts an annotated response, wrapping the annotated type in
+// SuccessResponse when the handler actually returns it as the data of one
+func (g *generator) parseResponse(key string, status int, kind, typeName, description, envelope string) response {
+	resp := response{status: status, description: description}
+	switch kind {
+	case "file":
+		resp.file = true
+		return resp
+	case "string":
+		// Plain strings document redirects and protocol switches, which carry no JSON body
+		return resp
+	}
+
+	if match := envelopePattern.FindStringSubmatch(typeName); match != nil {
+		envelopeName := match[1]
+		if !g.known(envelopeName) {
+			// Annotations sometimes name an envelope that does not exist; trust the handler body
+			envelopeName = "SuccessResponse"
+		}
+		resp.body = g.sample(key, envelopeName, "object")
+		resp.data = g.sample(key, match[2], kind)
+		return resp
+	}
+
+	switch {
+	case envelope == "gin.H":
+		// Ad hoc maps have no declared shape to validate against
+		resp.body = "map[string]interface{}(nil)"
+	case envelope == "SuccessResponse" && !envelopeTypes[typeName] && !strings.HasPrefix(typeName, "map["):
+		resp.body = g.sample(key, "SuccessResponse", "object")
+		resp.data = g.sample(key, typeName, kind)
+	default:
+		resp.body = g.sample(key, typeName, kind)
+	}
+	return resp
+}
+
+// sample returns a Go expression whose type documents an annotated type
+func (g *generator) sample(key, typeName, kind string) string {
+	expression := ""
+	switch {
+	case strings.HasPrefix(typeName, "map["):
+		expression = typeName + "(nil)"
+	case strings.HasPrefix(typeName, "[]"):
+		return g.sample(key, strings.TrimPrefix(typeName, "[]"), "array")
+	default:
+		qualified := typeName
+		if !strings.Contains(qualified, ".") {
+			qualified = "handlers." + qualified
+		}
+		info, ok := g.types[qualified]
+		if !ok {
+			if isBuiltin(typeName) {
+				if kind == "array" {
+					return "[]" + typeName + "(nil)"
+				}
+				return "*new(" + typeName + ")"
+			}
+			g.warn("%s: unknown type %s", key, typeName)
+			return ""
+		}
+		g.imports[strings.SplitN(qualified, ".", 2)[0]] = true
+		if kind == "array" {
+			return "[]" + qualified + "(nil)"
+		}
+		if info.isStruct {
+			return qualified + "{}"
+		}
+		return "*new(" + qualified + ")"
+	}
+	if kind == "array" {
+		return "[]" + strings.TrimSuffix(expression, "(nil)") + "(nil)"
+	}
+	return expression
+}
+
+func (g *generator) known(typeName string) bool {
+	if !strings.Contains(typeName, ".") {
+		typeName = "handlers." + typeName
+	}
+	_, ok := g.types[typeName]
+	return ok
+}
+
+func (g *generator) warn(format string, args ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, args...))
+}
+
+func (g *generator) render(operations []*operation) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/openapi-gen; DO NOT EDIT.\n\n")
+	buf.WriteString("package routes\n\nimport (\n")
+	g.imports["openapi"] = true
+	imports := map[string]string{
+		"handlers":   modulePath + "/internal/delivery/http/handlers",
+		"openapi":    modulePath + "/internal/delivery/http/openapi",
+		"usecases":   modulePath + "/internal/usecases",
+		"entities":   modulePath + "/internal/domain/entities",
+		"resilience": modulePath + "/internal/infrastructure/resilience",
+		"services":   modulePath + "/internal/domain/services",
+		"jwtkeys":    modulePath + "/pkg/jwtkeys",
+	}
+	var paths []string
+	for pkg := range g.imports {
+		paths = append(paths, imports[pkg])
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// openAPIOperations documents handlers by \"Handler.Method\", generated from their swagger annotations\n")
+	buf.WriteString("var openAPIOperations = map[string]openapi.OperationDoc{\n")
+
+	for _, op := range operations {
+		fmt.Fprintf(&buf, "%q: {\n", op.key)
+		if op.summary != "" {
+			fmt.Fprintf(&buf, "Summary: %q,\n", op.summary)
+		}
+		if op.description != "" {
+			fmt.Fprintf(&buf, "Description: %q,\n", op.description)
+		}
+		if len(op.tags) > 0 {
+			quoted := make([]string, len(op.tags))
+			for i, tag := range op.tags {
+				quoted[i] = strconv.Quote(tag)
+			}
+			fmt.Fprintf(&buf, "Tags: []string{%s},\n", strings.Join(quoted, ", "))
+		}
+		if op.secured {
+			buf.WriteString("Secured: true,\n")
+		}
+		if len(op.params) > 0 {
+			buf.WriteString("Params: []openapi.ParamDoc{\n")
+			for _, p := range op.params {
+				fields := []string{fmt.Sprintf("Name: %q, In: %q, Type: %q", p.name, p.in, p.typ)}
+				if p.required {
+					fields = append(fields, "Required: true")
+				}
+				if p.description != "" {
+					fields = append(fields, fmt.Sprintf("Description: %q", p.description))
+				}
+				fmt.Fprintf(&buf, "{%s},\n", strings.Join(fields, ", "))
+			}
+			buf.WriteString("},\n")
+		}
+		if op.body != "" {
+			fmt.Fprintf(&buf, "Body: %s,\n", op.body)
+		}
+		if len(op.responses) > 0 {
+			buf.WriteString("Responses: map[int]openapi.ResponseDoc{\n")
+			for _, r := range op.responses {
+				var fields []string
+				if r.description != "" {
+					fields = append(fields, fmt.Sprintf("Description: %q", r.description))
+				}
+				if r.body != "" {
+					fields = append(fields, "Body: "+r.body)
+				}
+				if r.data != "" {
+					fields = append(fields, "Data: "+r.data)
+				}
+				if r.file {
+					fields = append(fields, "File: true")
+				}
+				fmt.Fprintf(&buf, "%d: {%s},\n", r.status, strings.Join(fields, ", "))
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// successEnvelopes reports, per success status, how the handler body wraps its JSON response
+func successEnvelopes(fn *ast.FuncDecl) map[int]string {
+	envelopes := make(map[int]string)
+	if fn.Body == nil {
+		return envelopes
+	}
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (selector.Sel.Name != "JSON" && selector.Sel.Name != "AbortWithStatusJSON") {
+			return true
+		}
+		status := statusCode(call.Args[0])
+		if status == 0 {
+			return true
+		}
+		if _, seen := envelopes[status]; seen {
+			return true
+		}
+		if literal, ok := call.Args[1].(*ast.CompositeLit); ok {
+			envelopes[status] = exprString(literal.Type)
+		} else {
+			envelopes[status] = ""
+		}
+		return true
+	})
+	return envelopes
+}
+
+func statusCode(expr ast.Expr) int {
+	switch typed := expr.(type) {
+	case *ast.SelectorExpr:
+		return successStatuses[typed.Sel.Name]
+	case *ast.BasicLit:
+		code, _ := strconv.Atoi(typed.Value)
+		if code >= 200 && code < 300 {
+			return code
+		}
+	}
+	return 0
+}
+
+func exprString(expr ast.Expr) string {
+	switch typed := expr.(type) {
+	case *ast.Ident:
+		return typed.Name
+	case *ast.SelectorExpr:
+		return exprString(typed.X) + "." + typed.Sel.Name
+	}
+	return ""
+}
+
+func receiverName(fn *ast.FuncDecl) string {
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func isBuiltin(typeName string) bool {
+	switch typeName {
+	case "string", "bool", "int", "int64", "float64":
+		return true
+	}
+	return false
+}
+
+func parsePackage(dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// client calls the API of the instance under test
+type client struct {
+	baseURL string
+	http    *http.Client
+}
+
+func newClient(baseURL string) *client {
+	return &client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http: &http.Client{
+			Timeout: 30 * time.Second,
+			// Email verification answers with a redirect to the storefront, which tells whether it worked
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// envelope is the shape of the API's JSON responses
+type envelope struct {
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	Error   string          `json:"error"`
+	Details string          `json:"details"`
+}
+
+// call sends a request to path, relative to /api/v1 unless it is absolute, and decodes the
+// response's data into out. Responses other than the expected status are returned as errors
+// carrying the API's error message.
+func (c *client) call(ctx context.Context, method, path, token string, body, out interface{}, expected int) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	url := c.baseURL + path
+	if !strings.HasPrefix(path, "/health") {
+		url = c.baseURL + "/api/v1" + path
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var env envelope
+	_ = json.Unmarshal(raw, &env)
+	if resp.StatusCode != expected {
+		message := env.Error
+		if env.Details != "" {
+			message += ": " + env.Details
+		}
+		if message == "" {
+			message = strings.TrimSpace(string(raw))
+		}
+		return resp, fmt.Errorf("%s %s returned %d, expected %d: %s", method, path, resp.StatusCode, expected, message)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(env.Data, out); err != nil {
+			return resp, fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// step is one stage of the journey; later steps build on what earlier ones stored in the journey
+type step struct {
+	name string
+	run  func(ctx context.Context, j *journey) error
+}
+
+// steps is the scripted order journey
+var steps = []step{
+	{"health", checkHealth},
+	{"admin-login", adminLogin},
+	{"sandbox", checkSandbox},
+	{"register", register},
+	{"verify-email", verifyEmail},
+	{"login", login},
+	{"browse", browse},
+	{"add-to-cart", addToCart},
+	{"checkout", checkout},
+	{"capture-webhook", captureWebhook},
+	{"payment-captured", checkPaymentCaptured},
+	{"ship", ship},
+	{"deliver", deliver},
+	{"request-refund", requestRefund},
+	{"approve-refund", approveRefund},
+}
+
+// journey holds the customer and the order as they move through the steps
+type journey struct {
+	client *client
+	note   string // Shown next to the step that set it
+
+	adminEmail    string
+	adminPassword string
+	adminToken    string
+
+	customerEmail    string
+	customerPassword string
+	customerToken    string
+
+	productID       string
+	stripeSessionID string
+	orderID         string
+	orderTotal      float64
+	paymentID       string
+	refundID        string
+}
+
+func newJourney(c *client, adminEmail, adminPassword string) *journey {
+	suffix := time.Now().UTC().Format("20060102150405.000")
+	suffix = strings.ReplaceAll(suffix, ".", "")
+	return &journey{
+		client:           c,
+		adminEmail:       adminEmail,
+		adminPassword:    adminPassword,
+		customerEmail:    fmt.Sprintf("smoke+%s@example.com", suffix),
+		customerPassword: "Smoke-" + suffix + "!a",
+	}
+}
+
+// Responses are decoded into just the fields the journey checks
+
+type loginResponse struct {
+	Token string `json:"token"`
+}
+
+type productSummary struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	CurrentPrice float64 `json:"current_price"`
+	Stock        *int    `json:"stock"`
+	StockStatus  string  `json:"stock_status"`
+}
+
+type orderSummary struct {
+	ID            string  `json:"id"`
+	OrderNumber   string  `json:"order_number"`
+	Status        string  `json:"status"`
+	PaymentStatus string  `json:"payment_status"`
+	Total         float64 `json:"total"`
+}
+
+type paymentSummary struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Amount float64 `json:"amount"`
+}
+
+type refundSummary struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Amount float64 `json:"amount"`
+}
+
+func checkHealth(ctx context.Context, j *journey) error {
+	_, err := j.client.call(ctx, http.MethodGet, "/health", "", nil, nil, http.StatusOK)
+	return err
+}
+
+func checkSandbox(ctx context.Context, j *journey) error {
+	if _, err := j.client.call(ctx, http.MethodGet, "/sandbox", j.adminToken, nil, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("instance is not in sandbox mode: %w", err)
+	}
+	return nil
+}
+
+func register(ctx context.Context, j *journey) error {
+	_, err := j.client.call(ctx, http.MethodPost, "/auth/register", "", map[string]interface{}{
+		"email":      j.customerEmail,
+		"password":   j.customerPassword,
+		"first_name": "Smoke",
+		"last_name":  "Test",
+	}, nil, http.StatusCreated)
+	return err
+}
+
+// verificationLinkPattern finds the token in the verification email's link
+var verificationLinkPattern = regexp.MustCompile(`verify-email\?token=([A-Za-z0-9_\-]+)`)
+
+func verifyEmail(ctx context.Context, j *journey) error {
+	// The email may be sent after the registration response
+	var token string
+	for attempt := 0; attempt < 10 && token == ""; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, time.Second); err != nil {
+				return err
+			}
+		}
+		var mailbox struct {
+			Emails []struct {
+				BodyText string `json:"body_text"`
+				BodyHTML string `json:"body_html"`
+			} `json:"emails"`
+		}
+		if _, err := j.client.call(ctx, http.MethodGet, "/sandbox/mailbox?to="+url.QueryEscape(j.customerEmail), j.adminToken, nil, &mailbox, http.StatusOK); err != nil {
+			return err
+		}
+		for _, email := range mailbox.Emails {
+			if match := verificationLinkPattern.FindStringSubmatch(email.BodyText + email.BodyHTML); match != nil {
+				token = match[1]
+				break
+			}
+		}
+	}
+	if token == "" {
+		return fmt.Errorf("no verification email reached the sandbox mailbox")
+	}
+
+	resp, err := j.client.call(ctx, http.MethodGet, "/auth/verify-email?token="+url.QueryEscape(token), "", nil, nil, http.StatusFound)
+	if err != nil {
+		return err
+	}
+	if location := resp.Header.Get("Location"); !strings.Contains(location, "success=true") {
+		return fmt.Errorf("verification redirected to %s", location)
+	}
+	return nil
+}
+
+func login(ctx context.Context, j *journey) error {
+	var resp loginResponse
+	if _, err := j.client.call(ctx, http.MethodPost, "/auth/login", "", map[string]string{
+		"email":    j.customerEmail,
+		"password": j.customerPassword,
+	}, &resp, http.StatusOK); err != nil {
+		return err
+	}
+	j.customerToken = resp.Token
+	return nil
+}
+
+func adminLogin(ctx context.Context, j *journey) error {
+	var resp loginResponse
+	if _, err := j.client.call(ctx, http.MethodPost, "/auth/login", "", map[string]string{
+		"email":    j.adminEmail,
+		"password": j.adminPassword,
+	}, &resp, http.StatusOK); err != nil {
+		return err
+	}
+	j.adminToken = resp.Token
+	return nil
+}
+
+func browse(ctx context.Context, j *journey) error {
+	var products []productSummary
+	if _, err := j.client.call(ctx, http.MethodGet, "/products?page=1&limit=50", j.customerToken, nil, &products, http.StatusOK); err != nil {
+		return err
+	}
+	for _, product := range products {
+		if product.StockStatus != "in_stock" || product.CurrentPrice <= 0 || (product.Stock != nil && *product.Stock < 1) {
+			continue
+		}
+		// Open the product page, as a shopper would
+		var detail productSummary
+		if _, err := j.client.call(ctx, http.MethodGet, "/products/"+product.ID, j.customerToken, nil, &detail, http.StatusOK); err != nil {
+			return err
+		}
+		j.productID = detail.ID
+		j.note = fmt.Sprintf("%s at %.2f", detail.Name, detail.CurrentPrice)
+		return nil
+	}
+	return fmt.Errorf("none of the first %d products is in stock", len(products))
+}
+
+func addToCart(ctx context.Context, j *journey) error {
+	var cart struct {
+		Items []struct {
+			Product *productSummary `json:"product"`
+		} `json:"items"`
+	}
+	if _, err := j.client.call(ctx, http.MethodPost, "/cart/items", j.customerToken, map[string]interface{}{
+		"product_id": j.productID,
+		"quantity":   1,
+	}, &cart, http.StatusOK); err != nil {
+		return err
+	}
+	for _, item := range cart.Items {
+		if item.Product != nil && item.Product.ID == j.productID {
+			return nil
+		}
+	}
+	return fmt.Errorf("product %s is not in the cart", j.productID)
+}
+
+func checkout(ctx context.Context, j *journey) error {
+	address := map[string]string{
+		"first_name": "Smoke",
+		"last_name":  "Test",
+		"address1":   "1 Test Street",
+		"city":       "Springfield",
+		"state":      "IL",
+		"zip_code":   "62701",
+		"country":    "US",
+		"phone":      "2175550100",
+	}
+	var session struct {
+		SessionID       string  `json:"session_id"`
+		PaymentIntentID string  `json:"payment_intent_id"`
+		Total           float64 `json:"total"`
+	}
+	if _, err := j.client.call(ctx, http.MethodPost, "/checkout/session", j.customerToken, map[string]interface{}{
+		"shipping_address": address,
+		"payment_method":   "stripe",
+	}, &session, http.StatusCreated); err != nil {
+		return err
+	}
+	if session.PaymentIntentID == "" {
+		return fmt.Errorf("checkout session %s has no Stripe session", session.SessionID)
+	}
+	j.stripeSessionID = session.PaymentIntentID
+	j.note = fmt.Sprintf("%s, total %.2f", session.PaymentIntentID, session.Total)
+	return nil
+}
+
+// captureWebhook completes the Stripe checkout the way Stripe reports a card payment
+func captureWebhook(ctx context.Context, j *journey) error {
+	_, err := j.client.call(ctx, http.MethodPost, "/sandbox/webhooks/stripe", j.adminToken, map[string]interface{}{
+		"type": "checkout.session.completed",
+		"data": map[string]string{"session_id": j.stripeSessionID},
+	}, nil, http.StatusOK)
+	return err
+}
+
+func checkPaymentCaptured(ctx context.Context, j *journey) error {
+	var order orderSummary
+	if _, err := j.client.call(ctx, http.MethodGet, "/orders/by-session?session_id="+url.QueryEscape(j.stripeSessionID), j.customerToken, nil, &order, http.StatusOK); err != nil {
+		return err
+	}
+	if order.PaymentStatus != "paid" {
+		return fmt.Errorf("order %s has payment status %s after the webhook", order.OrderNumber, order.PaymentStatus)
+	}
+	j.orderID = order.ID
+	j.orderTotal = order.Total
+
+	var payments []paymentSummary
+	if _, err := j.client.call(ctx, http.MethodGet, "/orders/"+order.ID+"/payments", j.customerToken, nil, &payments, http.StatusOK); err != nil {
+		return err
+	}
+	for _, payment := range payments {
+		if payment.Status == "paid" {
+			j.paymentID = payment.ID
+			j.note = fmt.Sprintf("order %s, %s", order.OrderNumber, order.Status)
+			return nil
+		}
+	}
+	return fmt.Errorf("order %s has no paid payment", order.OrderNumber)
+}
+
+func ship(ctx context.Context, j *journey) error {
+	var order orderSummary
+	if _, err := j.client.call(ctx, http.MethodPut, "/admin/orders/"+j.orderID+"/shipping", j.adminToken, map[string]string{
+		"tracking_number": "SMOKE" + strings.ToUpper(j.orderID[:8]),
+		"carrier":         "smoke-test",
+	}, &order, http.StatusOK); err != nil {
+		return err
+	}
+	if order.Status != "shipped" {
+		return fmt.Errorf("order is %s after shipping", order.Status)
+	}
+	return nil
+}
+
+func deliver(ctx context.Context, j *journey) error {
+	var order orderSummary
+	if _, err := j.client.call(ctx, http.MethodPut, "/admin/orders/"+j.orderID+"/delivery", j.adminToken, map[string]string{
+		"status": "delivered",
+	}, &order, http.StatusOK); err != nil {
+		return err
+	}
+	if order.Status != "delivered" {
+		return fmt.Errorf("order is %s after delivery", order.Status)
+	}
+	return nil
+}
+
+func requestRefund(ctx context.Context, j *journey) error {
+	var refund refundSummary
+	if _, err := j.client.call(ctx, http.MethodPost, "/payments/refunds", j.customerToken, map[string]interface{}{
+		"payment_id":  j.paymentID,
+		"order_id":    j.orderID,
+		"amount":      j.orderTotal,
+		"reason":      "customer_request",
+		"type":        "full",
+		"description": "Smoke test refund",
+	}, &refund, http.StatusOK); err != nil {
+		return err
+	}
+	if refund.Status != "awaiting_approval" {
+		return fmt.Errorf("refund is %s, expected it to await approval", refund.Status)
+	}
+	j.refundID = refund.ID
+	return nil
+}
+
+func approveRefund(ctx context.Context, j *journey) error {
+	var refund refundSummary
+	if _, err := j.client.call(ctx, http.MethodPut, "/payments/refunds/"+j.refundID+"/approve", j.adminToken, nil, &refund, http.StatusOK); err != nil {
+		return err
+	}
+	if refund.Status != "completed" {
+		return fmt.Errorf("refund is %s after approval", refund.Status)
+	}
+	j.note = fmt.Sprintf("%.2f refunded", refund.Amount)
+	return nil
+}
+
+// sleep waits for d, or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+// Command smoke verifies a deployment by walking a customer through a whole order against a
+// running instance: it registers and verifies a new customer, browses the catalog, fills a cart,
+// checks out with a test card, captures the payment with a simulated Stripe webhook, ships and
+// delivers the order as an admin, and refunds it. Each step is reported as it finishes; the
+// remaining steps are skipped after a failure and the command exits with status 1.
+//
+// The instance must run in sandbox mode (SANDBOX_MODE=true), which provides the test gateway,
+// the webhook trigger and the mailbox the verification email is read from. The sandbox endpoints
+// are called as the admin, whose password is read from SMOKE_ADMIN_PASSWORD.
+//
+// Usage:
+//
+//	SMOKE_ADMIN_PASSWORD=... go run ./cmd/smoke -url https://staging.example.com -admin-email ops@example.com
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func main() {
+	baseURL := flag.String("url", "http://localhost:8080", "base URL of the running instance")
+	adminEmail := flag.String("admin-email", "", "email of the admin account that fulfills and refunds the order (required)")
+	timeout := flag.Duration("timeout", 2*time.Minute, "time allowed for the whole journey")
+	flag.Parse()
+
+	adminPassword := os.Getenv("SMOKE_ADMIN_PASSWORD")
+	if *adminEmail == "" || adminPassword == "" {
+		fmt.Fprintln(os.Stderr, "smoke: -admin-email and SMOKE_ADMIN_PASSWORD are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	j := newJourney(newClient(*baseURL), *adminEmail, adminPassword)
+	fmt.Printf("Running the order journey against %s as %s\n\n", *baseURL, j.customerEmail)
+	if !run(ctx, j, steps) {
+		os.Exit(1)
+	}
+}
+
+// run runs the steps in order, skipping the rest after the first failure, and reports whether
+// every step passed
+func run(ctx context.Context, j *journey, steps []step) bool {
+	started := time.Now()
+	passed := 0
+	var failed bool
+	for _, s := range steps {
+		if failed {
+			fmt.Printf("SKIP  %s\n", s.name)
+			continue
+		}
+
+		stepStarted := time.Now()
+		err := s.run(ctx, j)
+		elapsed := time.Since(stepStarted).Round(time.Millisecond)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				err = fmt.Errorf("%w (journey timed out)", err)
+			}
+			fmt.Printf("FAIL  %-20s %8s  %v\n", s.name, elapsed, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("PASS  %-20s %8s  %s\n", s.name, elapsed, j.note)
+		j.note = ""
+		passed++
+	}
+
+	fmt.Printf("\n%d of %d steps passed in %s\n", passed, len(steps), time.Since(started).Round(time.Millisecond))
+	return !failed
+}
@@ -21,6 +21,16 @@ func NewAbandonedCartHandler(abandonedCartUseCase usecases.AbandonedCartUseCase)
 }
 
 // GetAbandonedCarts gets list of abandoned carts
+// @Summary Get abandoned carts
+// @Description Gets list of abandoned carts
+// @Tags abandoned-carts
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {array} usecases.AbandonedCartResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/abandoned-carts [get]
 func (h *AbandonedCartHandler) GetAbandonedCarts(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -43,6 +53,15 @@ func (h *AbandonedCartHandler) GetAbandonedCarts(c *gin.Context) {
 }
 
 // GetAbandonedCartStats gets abandoned cart statistics
+// @Summary Get abandoned cart stats
+// @Description Gets abandoned cart statistics
+// @Tags abandoned-carts
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Days"
+// @Success 200 {object} usecases.AbandonedCartStats
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/abandoned-carts/stats [get]
 func (h *AbandonedCartHandler) GetAbandonedCartStats(c *gin.Context) {
 	daysStr := c.DefaultQuery("days", "30")
 	days, _ := strconv.Atoi(daysStr)
@@ -65,6 +84,14 @@ func (h *AbandonedCartHandler) GetAbandonedCartStats(c *gin.Context) {
 }
 
 // ProcessAbandonedCarts processes abandoned carts and sends reminder emails
+// @Summary Process abandoned carts
+// @Description Processes abandoned carts and sends reminder emails
+// @Tags abandoned-carts
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/abandoned-carts/process [post]
 func (h *AbandonedCartHandler) ProcessAbandonedCarts(c *gin.Context) {
 	// First detect abandoned carts
 	err := h.abandonedCartUseCase.DetectAbandonedCarts(c.Request.Context())
@@ -93,6 +120,16 @@ func (h *AbandonedCartHandler) ProcessAbandonedCarts(c *gin.Context) {
 }
 
 // SendReminderEmail sends reminder email for specific abandoned cart
+// @Summary Send reminder email
+// @Description Sends reminder email for specific abandoned cart
+// @Tags abandoned-carts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/abandoned-carts/{id}/reminder [post]
 func (h *AbandonedCartHandler) SendReminderEmail(c *gin.Context) {
 	cartID := c.Param("id")
 	if cartID == "" {
@@ -145,7 +145,7 @@ func (h *AddressHandler) GetAddresses(c *gin.Context) {
 // @Failure 404 {object} ErrorResponse
 // @Router /addresses/{id} [get]
 func (h *AddressHandler) GetAddress(c *gin.Context) {
-	userIDStr, exists := c.Get("user_id")
+	userIDInterface, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: "User ID not found in token",
@@ -153,10 +153,10 @@ func (h *AddressHandler) GetAddress(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "Invalid user ID",
+			Error: "Invalid user ID format",
 		})
 		return
 	}
@@ -197,7 +197,7 @@ func (h *AddressHandler) GetAddress(c *gin.Context) {
 // @Failure 404 {object} ErrorResponse
 // @Router /addresses/{id} [put]
 func (h *AddressHandler) UpdateAddress(c *gin.Context) {
-	userIDStr, exists := c.Get("user_id")
+	userIDInterface, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: "User ID not found in token",
@@ -205,10 +205,10 @@ func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "Invalid user ID",
+			Error: "Invalid user ID format",
 		})
 		return
 	}
@@ -258,7 +258,7 @@ func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 // @Failure 404 {object} ErrorResponse
 // @Router /addresses/{id} [delete]
 func (h *AddressHandler) DeleteAddress(c *gin.Context) {
-	userIDStr, exists := c.Get("user_id")
+	userIDInterface, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: "User ID not found in token",
@@ -266,10 +266,10 @@ func (h *AddressHandler) DeleteAddress(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "Invalid user ID",
+			Error: "Invalid user ID format",
 		})
 		return
 	}
@@ -310,7 +310,7 @@ func (h *AddressHandler) DeleteAddress(c *gin.Context) {
 // @Failure 404 {object} ErrorResponse
 // @Router /addresses/{id}/default [post]
 func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
-	userIDStr, exists := c.Get("user_id")
+	userIDInterface, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: "User ID not found in token",
@@ -318,10 +318,10 @@ func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "Invalid user ID",
+			Error: "Invalid user ID format",
 		})
 		return
 	}
@@ -373,7 +373,7 @@ func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
 // @Failure 404 {object} ErrorResponse
 // @Router /addresses/default [get]
 func (h *AddressHandler) GetDefaultAddress(c *gin.Context) {
-	userIDStr, exists := c.Get("user_id")
+	userIDInterface, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: "User ID not found in token",
@@ -381,10 +381,10 @@ func (h *AddressHandler) GetDefaultAddress(c *gin.Context) {
 		return
 	}
 
-	userID, err := uuid.Parse(userIDStr.(string))
-	if err != nil {
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "Invalid user ID",
+			Error: "Invalid user ID format",
 		})
 		return
 	}
@@ -417,3 +417,42 @@ func (h *AddressHandler) GetDefaultAddress(c *gin.Context) {
 		Data: address,
 	})
 }
+
+// GetCheckoutAddresses handles getting saved addresses for checkout pre-fill
+// @Summary Get checkout addresses
+// @Description Get default shipping and billing addresses plus all usable saved addresses for checkout
+// @Tags addresses
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.CheckoutAddressesResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /addresses/checkout [get]
+func (h *AddressHandler) GetCheckoutAddresses(c *gin.Context) {
+	userIDInterface, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	userID, ok := userIDInterface.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid user ID format",
+		})
+		return
+	}
+
+	addresses, err := h.addressUseCase.GetCheckoutAddresses(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: addresses,
+	})
+}
@@ -27,6 +27,15 @@ func NewAdminHandler(adminUseCase usecases.AdminUseCase) *AdminHandler {
 }
 
 // GetDashboard returns admin dashboard data
+// @Summary Get dashboard
+// @Description Returns admin dashboard data
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.AdminDashboardResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/dashboard [get]
 func (h *AdminHandler) GetDashboard(c *gin.Context) {
 	var req usecases.AdminDashboardRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -56,6 +65,14 @@ func (h *AdminHandler) GetDashboard(c *gin.Context) {
 }
 
 // GetSystemStats returns system statistics
+// @Summary Get system stats
+// @Description Size, table and connection counts of the database, and uptime and memory use of the serving instance. See /admin/system/status for queues, jobs and provider health.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SystemStatsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/dashboard/stats [get]
 func (h *AdminHandler) GetSystemStats(c *gin.Context) {
 	stats, err := h.adminUseCase.GetSystemStats(c.Request.Context())
 	if err != nil {
@@ -73,6 +90,17 @@ func (h *AdminHandler) GetSystemStats(c *gin.Context) {
 }
 
 // GetUsers returns paginated list of users
+// @Summary Get users
+// @Description Returns paginated list of users
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users [get]
 func (h *AdminHandler) GetUsers(c *gin.Context) {
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -123,6 +151,16 @@ func (h *AdminHandler) GetUsers(c *gin.Context) {
 }
 
 // UpdateUserStatus updates a user's status
+// @Summary Update user status
+// @Description Updates a user's status
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/{id}/status [put]
 func (h *AdminHandler) UpdateUserStatus(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := uuid.Parse(userIDStr)
@@ -159,6 +197,16 @@ func (h *AdminHandler) UpdateUserStatus(c *gin.Context) {
 }
 
 // UpdateUserRole updates a user's role
+// @Summary Update user role
+// @Description Updates a user's role
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/{id}/role [put]
 func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := uuid.Parse(userIDStr)
@@ -195,6 +243,16 @@ func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
 }
 
 // BulkUpdateUsers handles bulk user updates
+// @Summary Bulk update users
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkUserUpdateRequest true "Bulk user update"
+// @Success 200 {object} usecases.BulkUserUpdateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/update [post]
 func (h *AdminHandler) BulkUpdateUsers(c *gin.Context) {
 	var req usecases.BulkUserUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -221,6 +279,16 @@ func (h *AdminHandler) BulkUpdateUsers(c *gin.Context) {
 }
 
 // BulkDeleteUsers handles bulk user deletion
+// @Summary Bulk delete users
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkUserDeleteRequest true "Bulk user delete"
+// @Success 200 {object} usecases.BulkUserDeleteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/delete [post]
 func (h *AdminHandler) BulkDeleteUsers(c *gin.Context) {
 	var req usecases.BulkUserDeleteRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -247,6 +315,16 @@ func (h *AdminHandler) BulkDeleteUsers(c *gin.Context) {
 }
 
 // BulkActivateUsers handles bulk user activation
+// @Summary Bulk activate users
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkUserActivateRequest true "Bulk user activate"
+// @Success 200 {object} usecases.BulkUserActivateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/activate [post]
 func (h *AdminHandler) BulkActivateUsers(c *gin.Context) {
 	var req usecases.BulkUserActivateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -273,6 +351,16 @@ func (h *AdminHandler) BulkActivateUsers(c *gin.Context) {
 }
 
 // BulkDeactivateUsers handles bulk user deactivation
+// @Summary Bulk deactivate users
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkUserDeactivateRequest true "Bulk user deactivate"
+// @Success 200 {object} usecases.BulkUserDeactivateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/deactivate [post]
 func (h *AdminHandler) BulkDeactivateUsers(c *gin.Context) {
 	var req usecases.BulkUserDeactivateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -299,6 +387,16 @@ func (h *AdminHandler) BulkDeactivateUsers(c *gin.Context) {
 }
 
 // BulkUpdateUserRoles handles bulk user role updates
+// @Summary Bulk update user roles
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkUserRoleUpdateRequest true "Bulk user role update"
+// @Success 200 {object} usecases.BulkUserRoleUpdateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/roles [post]
 func (h *AdminHandler) BulkUpdateUserRoles(c *gin.Context) {
 	var req usecases.BulkUserRoleUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -325,6 +423,17 @@ func (h *AdminHandler) BulkUpdateUserRoles(c *gin.Context) {
 }
 
 // SendUserNotification handles sending notification to a user
+// @Summary Send user notification
+// @Description Title and message may use {{variable}} placeholders, filled in from the user and data. The notification is refused if any would go out unfilled; check it first with POST /admin/messages/preview.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.UserNotificationRequest true "User notification"
+// @Success 200 {object} usecases.UserNotificationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/notification [post]
 func (h *AdminHandler) SendUserNotification(c *gin.Context) {
 	var req usecases.UserNotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -337,7 +446,7 @@ func (h *AdminHandler) SendUserNotification(c *gin.Context) {
 
 	response, err := h.adminUseCase.SendUserNotification(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to send notification",
 			Details: err.Error(),
 		})
@@ -351,6 +460,17 @@ func (h *AdminHandler) SendUserNotification(c *gin.Context) {
 }
 
 // SendBulkNotification handles sending notifications to multiple users
+// @Summary Send bulk notification
+// @Description Title and message may use {{variable}} placeholders, filled in from each user and data. Users for whom any would go out unfilled fail; preview the message first with POST /admin/messages/preview.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkNotificationRequest true "Bulk notification"
+// @Success 200 {object} usecases.BulkNotificationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/notification [post]
 func (h *AdminHandler) SendBulkNotification(c *gin.Context) {
 	var req usecases.BulkNotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -363,7 +483,7 @@ func (h *AdminHandler) SendBulkNotification(c *gin.Context) {
 
 	response, err := h.adminUseCase.SendBulkNotification(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to send bulk notifications",
 			Details: err.Error(),
 		})
@@ -377,6 +497,17 @@ func (h *AdminHandler) SendBulkNotification(c *gin.Context) {
 }
 
 // SendUserEmail handles sending email to a user
+// @Summary Send user email
+// @Description Subject and body may use {{variable}} placeholders, filled in from the user and data. The email is refused if any would go out unfilled; check it first with POST /admin/messages/preview.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.UserEmailRequest true "User email"
+// @Success 200 {object} usecases.UserEmailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/email [post]
 func (h *AdminHandler) SendUserEmail(c *gin.Context) {
 	var req usecases.UserEmailRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -389,7 +520,7 @@ func (h *AdminHandler) SendUserEmail(c *gin.Context) {
 
 	response, err := h.adminUseCase.SendUserEmail(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to send email",
 			Details: err.Error(),
 		})
@@ -403,6 +534,17 @@ func (h *AdminHandler) SendUserEmail(c *gin.Context) {
 }
 
 // SendBulkEmail handles sending emails to multiple users
+// @Summary Send bulk email
+// @Description Subject and body may use {{variable}} placeholders, filled in from each user and data. Users for whom any would go out unfilled fail; preview the email first with POST /admin/messages/preview.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkEmailRequest true "Bulk email"
+// @Success 200 {object} usecases.BulkEmailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/bulk/email [post]
 func (h *AdminHandler) SendBulkEmail(c *gin.Context) {
 	var req usecases.BulkEmailRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -415,7 +557,7 @@ func (h *AdminHandler) SendBulkEmail(c *gin.Context) {
 
 	response, err := h.adminUseCase.SendBulkEmail(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to send bulk emails",
 			Details: err.Error(),
 		})
@@ -429,6 +571,16 @@ func (h *AdminHandler) SendBulkEmail(c *gin.Context) {
 }
 
 // CreateAnnouncement handles creating announcements
+// @Summary Create announcement
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.AnnouncementRequest true "Announcement"
+// @Success 201 {object} usecases.AnnouncementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/announcements [post]
 func (h *AdminHandler) CreateAnnouncement(c *gin.Context) {
 	var req usecases.AnnouncementRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -455,6 +607,14 @@ func (h *AdminHandler) CreateAnnouncement(c *gin.Context) {
 }
 
 // GetUserAuditLogs handles retrieving user audit logs
+// @Summary Get user audit logs
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.UserAuditLogsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/audit-logs [get]
 func (h *AdminHandler) GetUserAuditLogs(c *gin.Context) {
 	var req usecases.UserAuditLogsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -489,6 +649,16 @@ func (h *AdminHandler) GetUserAuditLogs(c *gin.Context) {
 }
 
 // GetUserActivity returns user activity
+// @Summary Get user activity
+// @Description Returns user activity
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.ActivityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users/{id}/activity [get]
 func (h *AdminHandler) GetUserActivity(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := uuid.Parse(userIDStr)
@@ -808,6 +978,26 @@ func (h *AdminHandler) GetCustomerLifetimeValue(c *gin.Context) {
 }
 
 // GetOrders returns paginated list of orders
+// @Summary Get orders
+// @Description Returns paginated list of orders
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status"
+// @Param payment_status query string false "Payment status"
+// @Param user_id query string false "User ID"
+// @Param date_from query string false "Date from"
+// @Param date_to query string false "Date to"
+// @Param search query string false "Search"
+// @Param sort_by query string false "Sort by"
+// @Param sort_order query string false "Sort order"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} usecases.AdminOrdersResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/orders [get]
 func (h *AdminHandler) GetOrders(c *gin.Context) {
 	var req usecases.AdminOrdersRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -854,6 +1044,17 @@ func (h *AdminHandler) GetOrders(c *gin.Context) {
 }
 
 // UpdateOrderStatus updates an order's status
+// @Summary Update order status
+// @Description Updates an order's status
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/orders/{id}/status [put]
+// @Router /admin/orders/{id}/status [patch]
 func (h *AdminHandler) UpdateOrderStatus(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -890,6 +1091,16 @@ func (h *AdminHandler) UpdateOrderStatus(c *gin.Context) {
 }
 
 // GetOrderDetails returns detailed order information
+// @Summary Get order details
+// @Description Returns detailed order information
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.AdminOrderDetailsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/orders/{id} [get]
 func (h *AdminHandler) GetOrderDetails(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -917,6 +1128,16 @@ func (h *AdminHandler) GetOrderDetails(c *gin.Context) {
 }
 
 // ProcessRefund processes a refund for an order
+// @Summary Process refund
+// @Description Processes a refund for an order
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/orders/{id}/refund [post]
 func (h *AdminHandler) ProcessRefund(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -1004,6 +1225,15 @@ func (h *AdminHandler) BulkUpdateProducts(c *gin.Context) {
 }
 
 // GetAuditLogs returns audit logs
+// @Summary Get audit logs
+// @Description Returns audit logs
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.AuditLogsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/system/audit [get]
 func (h *AdminHandler) GetAuditLogs(c *gin.Context) {
 	var req usecases.AuditLogsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -1030,6 +1260,15 @@ func (h *AdminHandler) GetAuditLogs(c *gin.Context) {
 }
 
 // ManageReviews returns paginated list of reviews for admin management
+// @Summary Manage reviews
+// @Description Returns paginated list of reviews for admin management
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.ManageReviewsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews [get]
 func (h *AdminHandler) ManageReviews(c *gin.Context) {
 	var req usecases.ManageReviewsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -1056,6 +1295,16 @@ func (h *AdminHandler) ManageReviews(c *gin.Context) {
 }
 
 // UpdateReviewStatus updates review status (approve/reject/hide)
+// @Summary Update review status
+// @Description Updates review status (approve/reject/hide)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews/{id}/status [put]
 func (h *AdminHandler) UpdateReviewStatus(c *gin.Context) {
 	reviewIDStr := c.Param("id")
 	reviewID, err := uuid.Parse(reviewIDStr)
@@ -1092,86 +1341,16 @@ func (h *AdminHandler) UpdateReviewStatus(c *gin.Context) {
 	})
 }
 
-// GenerateReport generates a new report
-func (h *AdminHandler) GenerateReport(c *gin.Context) {
-	var req usecases.GenerateReportRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid request body",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	report, err := h.adminUseCase.GenerateReport(c.Request.Context(), req)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to generate report",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	c.JSON(http.StatusCreated, SuccessResponse{
-		Message: "Report generated successfully",
-		Data:    report,
-	})
-}
-
-// GetReports returns paginated list of reports
-func (h *AdminHandler) GetReports(c *gin.Context) {
-	var req usecases.GetReportsRequest
-	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid query parameters",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	reports, err := h.adminUseCase.GetReports(c.Request.Context(), req)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to get reports",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, SuccessResponse{
-		Message: "Reports retrieved successfully",
-		Data:    reports,
-	})
-}
-
-// DownloadReport downloads a report
-func (h *AdminHandler) DownloadReport(c *gin.Context) {
-	reportIDStr := c.Param("id")
-	reportID, err := uuid.Parse(reportIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid report ID",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	download, err := h.adminUseCase.DownloadReport(c.Request.Context(), reportID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Failed to download report",
-			Details: err.Error(),
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, SuccessResponse{
-		Message: "Report download prepared successfully",
-		Data:    download,
-	})
-}
-
 // GetSystemLogs returns system logs
+// @Summary Get system logs
+// @Description Returns system logs
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SystemLogsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/system/logs [get]
 func (h *AdminHandler) GetSystemLogs(c *gin.Context) {
 	var req usecases.SystemLogsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -1198,6 +1377,14 @@ func (h *AdminHandler) GetSystemLogs(c *gin.Context) {
 }
 
 // BackupDatabase creates a database backup
+// @Summary Backup database
+// @Description Creates a database backup
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.BackupResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/system/backup [post]
 func (h *AdminHandler) BackupDatabase(c *gin.Context) {
 	backup, err := h.adminUseCase.BackupDatabase(c.Request.Context())
 	if err != nil {
@@ -1215,6 +1402,14 @@ func (h *AdminHandler) BackupDatabase(c *gin.Context) {
 }
 
 // GetRecentActivity returns recent admin activity
+// @Summary Get recent activity
+// @Description Returns recent admin activity
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Limit"
+// @Success 200 {object} SuccessResponse
+// @Router /admin/dashboard/activity [get]
 func (h *AdminHandler) GetRecentActivity(c *gin.Context) {
 	// Parse query parameters
 	limit := 5
@@ -1281,6 +1476,16 @@ func (h *AdminHandler) GetRecentActivity(c *gin.Context) {
 }
 
 // ReplyToReview allows admin to reply to a review
+// @Summary Reply to review
+// @Description Allows admin to reply to a review
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reviews/{id}/reply [post]
 func (h *AdminHandler) ReplyToReview(c *gin.Context) {
 	reviewIDStr := c.Param("id")
 	reviewID, err := uuid.Parse(reviewIDStr)
@@ -1318,6 +1523,13 @@ func (h *AdminHandler) ReplyToReview(c *gin.Context) {
 }
 
 // GetCleanupStats returns cleanup statistics - DEPRECATED
+// @Summary Get cleanup stats
+// @Description Returns cleanup statistics - DEPRECATED
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/system/cleanup/stats [get]
 func (h *AdminHandler) GetCleanupStats(c *gin.Context) {
 	// Stock cleanup removed - using simple stock service
 	c.JSON(http.StatusOK, SuccessResponse{
@@ -1330,6 +1542,13 @@ func (h *AdminHandler) GetCleanupStats(c *gin.Context) {
 }
 
 // TriggerCleanup manually triggers cleanup process - DEPRECATED
+// @Summary Trigger cleanup
+// @Description Manually triggers cleanup process - DEPRECATED
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/system/cleanup/trigger [post]
 func (h *AdminHandler) TriggerCleanup(c *gin.Context) {
 	// Stock cleanup removed - using simple stock service
 	c.JSON(http.StatusOK, SuccessResponse{
@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminNoteHandler handles internal admin notes on orders and customers
+type AdminNoteHandler struct {
+	adminNoteUseCase usecases.AdminNoteUseCase
+}
+
+// NewAdminNoteHandler creates a new admin note handler
+func NewAdminNoteHandler(adminNoteUseCase usecases.AdminNoteUseCase) *AdminNoteHandler {
+	return &AdminNoteHandler{
+		adminNoteUseCase: adminNoteUseCase,
+	}
+}
+
+// ListOrderNotes handles listing the internal notes on an order
+// @Summary List order internal notes
+// @Description Lists internal notes on an order as threads, pinned notes first. Notes are never shown to the customer.
+// @Tags admin-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {array} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/orders/{id}/internal-notes [get]
+func (h *AdminNoteHandler) ListOrderNotes(c *gin.Context) {
+	h.listNotes(c, entities.AdminNoteSubjectOrder, "id")
+}
+
+// AddOrderNote handles adding an internal note to an order
+// @Summary Add order internal note
+// @Description Adds an internal note or reply to an order. Admins mentioned with @email or @username are notified.
+// @Tags admin-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body usecases.CreateAdminNoteRequest true "Note"
+// @Success 201 {object} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/orders/{id}/internal-notes [post]
+func (h *AdminNoteHandler) AddOrderNote(c *gin.Context) {
+	h.addNote(c, entities.AdminNoteSubjectOrder, "id")
+}
+
+// ListCustomerNotes handles listing the internal notes on a customer
+// @Summary List customer internal notes
+// @Description Lists internal notes on a customer as threads, pinned notes first. Notes are never shown to the customer.
+// @Tags admin-notes
+// @Produce json
+// @Security BearerAuth
+// @Param customer_id path string true "Customer ID"
+// @Success 200 {array} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/customers/{customer_id}/internal-notes [get]
+func (h *AdminNoteHandler) ListCustomerNotes(c *gin.Context) {
+	h.listNotes(c, entities.AdminNoteSubjectCustomer, "customer_id")
+}
+
+// AddCustomerNote handles adding an internal note to a customer
+// @Summary Add customer internal note
+// @Description Adds an internal note or reply to a customer. Admins mentioned with @email or @username are notified.
+// @Tags admin-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param customer_id path string true "Customer ID"
+// @Param request body usecases.CreateAdminNoteRequest true "Note"
+// @Success 201 {object} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/customers/{customer_id}/internal-notes [post]
+func (h *AdminNoteHandler) AddCustomerNote(c *gin.Context) {
+	h.addNote(c, entities.AdminNoteSubjectCustomer, "customer_id")
+}
+
+// UpdateNote handles editing an internal note
+// @Summary Edit internal note
+// @Description Edits an internal note. Only the author can edit a note; newly mentioned admins are notified.
+// @Tags admin-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Note ID"
+// @Param request body usecases.UpdateAdminNoteRequest true "Note"
+// @Success 200 {object} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/internal-notes/{id} [put]
+func (h *AdminNoteHandler) UpdateNote(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	noteID, ok := parseAdminNoteID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdateAdminNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	note, err := h.adminNoteUseCase.UpdateNote(c.Request.Context(), *adminID, noteID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Note updated successfully",
+		Data:    note,
+	})
+}
+
+// DeleteNote handles deleting an internal note
+// @Summary Delete internal note
+// @Description Deletes an internal note together with its replies
+// @Tags admin-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Note ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/internal-notes/{id} [delete]
+func (h *AdminNoteHandler) DeleteNote(c *gin.Context) {
+	noteID, ok := parseAdminNoteID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.adminNoteUseCase.DeleteNote(c.Request.Context(), noteID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Note deleted successfully",
+	})
+}
+
+// PinNote handles pinning an internal note
+// @Summary Pin internal note
+// @Description Pins a note to the top of its order's or customer's notes. Replies cannot be pinned.
+// @Tags admin-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Note ID"
+// @Success 200 {object} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/internal-notes/{id}/pin [post]
+func (h *AdminNoteHandler) PinNote(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+// UnpinNote handles unpinning an internal note
+// @Summary Unpin internal note
+// @Tags admin-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Note ID"
+// @Success 200 {object} usecases.AdminNoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/internal-notes/{id}/pin [delete]
+func (h *AdminNoteHandler) UnpinNote(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+func (h *AdminNoteHandler) listNotes(c *gin.Context, subjectType entities.AdminNoteSubject, param string) {
+	subjectID, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid " + string(subjectType) + " ID format",
+		})
+		return
+	}
+
+	notes, err := h.adminNoteUseCase.ListNotes(c.Request.Context(), subjectType, subjectID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Notes retrieved successfully",
+		Data:    notes,
+	})
+}
+
+func (h *AdminNoteHandler) addNote(c *gin.Context, subjectType entities.AdminNoteSubject, param string) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	subjectID, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid " + string(subjectType) + " ID format",
+		})
+		return
+	}
+
+	var req usecases.CreateAdminNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	note, err := h.adminNoteUseCase.AddNote(c.Request.Context(), *adminID, subjectType, subjectID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Note added successfully",
+		Data:    note,
+	})
+}
+
+func (h *AdminNoteHandler) setPinned(c *gin.Context, pinned bool) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	noteID, ok := parseAdminNoteID(c)
+	if !ok {
+		return
+	}
+
+	note, err := h.adminNoteUseCase.SetNotePinned(c.Request.Context(), *adminID, noteID, pinned)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	message := "Note unpinned successfully"
+	if pinned {
+		message = "Note pinned successfully"
+	}
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: message,
+		Data:    note,
+	})
+}
+
+func parseAdminNoteID(c *gin.Context) (uuid.UUID, bool) {
+	noteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid note ID format",
+		})
+		return uuid.Nil, false
+	}
+	return noteID, true
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminTaskHandler handles the admin inbox of tasks raised from store activity
+type AdminTaskHandler struct {
+	adminTaskUseCase usecases.AdminTaskUseCase
+}
+
+// NewAdminTaskHandler creates a new admin task handler
+func NewAdminTaskHandler(adminTaskUseCase usecases.AdminTaskUseCase) *AdminTaskHandler {
+	return &AdminTaskHandler{
+		adminTaskUseCase: adminTaskUseCase,
+	}
+}
+
+// GetInbox handles getting the admin inbox
+// @Summary Get admin inbox
+// @Description Get the open task counts per type (dispute_evidence, order_verification, stock_reorder, review_moderation) with a page of tasks, most urgent first. Tasks are raised and resolved automatically every minute from disputes needing evidence, COD and bank transfer orders awaiting payment confirmation, stock at its reorder level and reviews awaiting moderation.
+// @Tags admin-inbox
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Task type"
+// @Param status query string false "Task status (open, completed)" default(open)
+// @Param assignee query string false "me, unassigned or an admin's user ID"
+// @Param overdue query bool false "Only tasks past their due date"
+// @Param limit query int false "Number of tasks to return" default(20)
+// @Param offset query int false "Number of tasks to skip" default(0)
+// @Success 200 {object} usecases.AdminInboxResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/inbox [get]
+func (h *AdminTaskHandler) GetInbox(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.GetAdminInboxRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	inbox, err := h.adminTaskUseCase.GetInbox(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: inbox,
+	})
+}
+
+// GetTask handles getting an inbox task
+// @Summary Get admin task
+// @Description Get an inbox task with its source_type and source_id, the review, order, inventory or dispute it is about
+// @Tags admin-inbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID"
+// @Success 200 {object} usecases.AdminTaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/inbox/tasks/{id} [get]
+func (h *AdminTaskHandler) GetTask(c *gin.Context) {
+	taskID, ok := parseAdminTaskID(c)
+	if !ok {
+		return
+	}
+
+	task, err := h.adminTaskUseCase.GetTask(c.Request.Context(), taskID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: task,
+	})
+}
+
+// AssignTask handles assigning an inbox task
+// @Summary Assign admin task
+// @Description Assign an open task to an active admin or moderator, who is notified unless they assigned it to themselves. Leave out assignee_id to unassign the task.
+// @Tags admin-inbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID"
+// @Param request body usecases.AssignAdminTaskRequest true "Assignee"
+// @Success 200 {object} usecases.AdminTaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/inbox/tasks/{id}/assign [post]
+func (h *AdminTaskHandler) AssignTask(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	taskID, ok := parseAdminTaskID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.AssignAdminTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	task, err := h.adminTaskUseCase.AssignTask(c.Request.Context(), *adminID, taskID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Task assigned successfully",
+		Data:    task,
+	})
+}
+
+// SetTaskDueDate handles rescheduling an inbox task
+// @Summary Set admin task due date
+// @Description Set or clear the due date of an open task. Dispute tasks are moved back to the provider's evidence deadline whenever the provider changes it.
+// @Tags admin-inbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID"
+// @Param request body usecases.SetAdminTaskDueDateRequest true "Due date"
+// @Success 200 {object} usecases.AdminTaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/inbox/tasks/{id}/due-date [put]
+func (h *AdminTaskHandler) SetTaskDueDate(c *gin.Context) {
+	taskID, ok := parseAdminTaskID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SetAdminTaskDueDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	task, err := h.adminTaskUseCase.SetTaskDueDate(c.Request.Context(), taskID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Task due date updated successfully",
+		Data:    task,
+	})
+}
+
+// CompleteTask handles completing an inbox task
+// @Summary Complete admin task
+// @Description Mark an open task done with an optional note. Tasks also complete on their own once their source no longer needs attention; a task completed while its source still does is raised again.
+// @Tags admin-inbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Task ID"
+// @Param request body usecases.CompleteAdminTaskRequest false "Completion note"
+// @Success 200 {object} usecases.AdminTaskResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/inbox/tasks/{id}/complete [post]
+func (h *AdminTaskHandler) CompleteTask(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	taskID, ok := parseAdminTaskID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.CompleteAdminTaskRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	task, err := h.adminTaskUseCase.CompleteTask(c.Request.Context(), *adminID, taskID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Task completed successfully",
+		Data:    task,
+	})
+}
+
+func parseAdminTaskID(c *gin.Context) (uuid.UUID, bool) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid task ID",
+		})
+		return uuid.Nil, false
+	}
+	return taskID, true
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AggregateRepairHandler handles checking and repairing denormalized aggregates
+type AggregateRepairHandler struct {
+	aggregateRepairUseCase usecases.AggregateRepairUseCase
+}
+
+// NewAggregateRepairHandler creates a new aggregate repair handler
+func NewAggregateRepairHandler(aggregateRepairUseCase usecases.AggregateRepairUseCase) *AggregateRepairHandler {
+	return &AggregateRepairHandler{
+		aggregateRepairUseCase: aggregateRepairUseCase,
+	}
+}
+
+// RepairAggregates handles manually triggering the aggregate repair job
+// @Summary Repair denormalized aggregates now
+// @Description Compare customer order totals, product rating summaries and review vote counts with their source tables and repair the records that drifted. With dry_run the discrepancies are only reported.
+// @Tags aggregates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.RepairAggregatesRequest false "Aggregates to check, every aggregate when empty"
+// @Success 200 {object} entities.AggregateRepairRun
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/aggregates/repair [post]
+func (h *AggregateRepairHandler) RepairAggregates(c *gin.Context) {
+	var req usecases.RepairAggregatesRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request body",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	run, err := h.aggregateRepairUseCase.RepairAggregates(c.Request.Context(), req)
+	if err != nil {
+		if run == nil {
+			c.JSON(getErrorStatusCode(err), ErrorResponse{
+				Error: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to repair aggregates",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	message := "Aggregates repaired successfully"
+	if run.DryRun {
+		message = "Aggregates checked successfully"
+	}
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: message,
+		Data:    run,
+	})
+}
+
+// ListRepairRuns handles listing past aggregate repair runs
+// @Summary List aggregate repair runs
+// @Description List aggregate repair runs with their per-aggregate counts, newest first
+// @Tags aggregates
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Number of runs to return" default(20)
+// @Param offset query int false "Number of runs to skip" default(0)
+// @Success 200 {object} usecases.AggregateRepairRunsResponse
+// @Router /admin/aggregates/repair-runs [get]
+func (h *AggregateRepairHandler) ListRepairRuns(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	runs, err := h.aggregateRepairUseCase.ListRepairRuns(c.Request.Context(), limit, offset)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: runs,
+	})
+}
+
+// GetRepairRun handles getting an aggregate repair run with its discrepancies
+// @Summary Get aggregate repair run
+// @Description Get an aggregate repair run with the discrepancies it found
+// @Tags aggregates
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Repair run ID"
+// @Success 200 {object} entities.AggregateRepairRun
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/aggregates/repair-runs/{id} [get]
+func (h *AggregateRepairHandler) GetRepairRun(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid repair run ID",
+		})
+		return
+	}
+
+	run, err := h.aggregateRepairUseCase.GetRepairRun(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: run,
+	})
+}
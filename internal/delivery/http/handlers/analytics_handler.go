@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/usecases"
@@ -23,6 +26,17 @@ func NewAnalyticsHandler(analyticsUseCase usecases.AnalyticsUseCase) *AnalyticsH
 }
 
 // TrackEvent tracks a custom event
+// @Summary Track event
+// @Description Tracks a custom event
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.TrackEventRequest true "Track event"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/events [post]
 func (h *AnalyticsHandler) TrackEvent(c *gin.Context) {
 	var req usecases.TrackEventRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -47,6 +61,16 @@ func (h *AnalyticsHandler) TrackEvent(c *gin.Context) {
 }
 
 // TrackPageView tracks a page view
+// @Summary Track page view
+// @Description Tracks a page view for the current visitor. The visitor session comes from the anonymous session cookie or X-Session-ID header and is attributed to the user when signed in.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param request body usecases.TrackPageViewRequest true "Page view"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /analytics/page-views [post]
 func (h *AnalyticsHandler) TrackPageView(c *gin.Context) {
 	var req usecases.TrackPageViewRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -56,6 +80,10 @@ func (h *AnalyticsHandler) TrackPageView(c *gin.Context) {
 		})
 		return
 	}
+	req.SessionID = getSessionIDFromContext(c)
+	req.UserID = getUserIDFromContext(c)
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
 
 	if err := h.analyticsUseCase.TrackPageView(c.Request.Context(), req); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -71,6 +99,15 @@ func (h *AnalyticsHandler) TrackPageView(c *gin.Context) {
 }
 
 // TrackProductView tracks a product view
+// @Summary Track product view
+// @Description Tracks a product view for the current visitor. The visitor session comes from the anonymous session cookie or X-Session-ID header and is attributed to the user when signed in.
+// @Tags analytics
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /analytics/products/{id}/views [post]
 func (h *AnalyticsHandler) TrackProductView(c *gin.Context) {
 	productIDStr := c.Param("id")
 	productID, err := uuid.Parse(productIDStr)
@@ -82,19 +119,7 @@ func (h *AnalyticsHandler) TrackProductView(c *gin.Context) {
 		return
 	}
 
-	var userID *uuid.UUID
-	if userIDStr := c.GetHeader("X-User-ID"); userIDStr != "" {
-		if id, err := uuid.Parse(userIDStr); err == nil {
-			userID = &id
-		}
-	}
-
-	sessionID := c.GetHeader("X-Session-ID")
-	if sessionID == "" {
-		sessionID = "anonymous"
-	}
-
-	if err := h.analyticsUseCase.TrackProductView(c.Request.Context(), productID, userID, sessionID); err != nil {
+	if err := h.analyticsUseCase.TrackProductView(c.Request.Context(), productID, getUserIDFromContext(c), getSessionIDFromContext(c)); err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error: "Failed to track product view",
 			Details: err.Error(),
@@ -142,6 +167,17 @@ func (h *AnalyticsHandler) GetDashboardMetrics(c *gin.Context) {
 }
 
 // GetSalesMetrics returns sales metrics
+// @Summary Get sales metrics
+// @Description Returns sales metrics
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Period"
+// @Param date_from query string false "Date from"
+// @Param date_to query string false "Date to"
+// @Success 200 {object} usecases.SalesMetricsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/sales [get]
 func (h *AnalyticsHandler) GetSalesMetrics(c *gin.Context) {
 	var req usecases.SalesMetricsRequest
 
@@ -176,6 +212,15 @@ func (h *AnalyticsHandler) GetSalesMetrics(c *gin.Context) {
 }
 
 // GetProductMetrics returns product metrics
+// @Summary Get product metrics
+// @Description Returns product metrics
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Limit"
+// @Success 200 {object} usecases.ProductMetricsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/products [get]
 func (h *AnalyticsHandler) GetProductMetrics(c *gin.Context) {
 	var req usecases.ProductMetricsRequest
 
@@ -202,6 +247,15 @@ func (h *AnalyticsHandler) GetProductMetrics(c *gin.Context) {
 }
 
 // GetUserMetrics returns user metrics
+// @Summary Get user metrics
+// @Description Returns user metrics
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Period"
+// @Success 200 {object} usecases.UserMetricsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/users [get]
 func (h *AnalyticsHandler) GetUserMetrics(c *gin.Context) {
 	var req usecases.UserMetricsRequest
 
@@ -226,6 +280,15 @@ func (h *AnalyticsHandler) GetUserMetrics(c *gin.Context) {
 }
 
 // GetTrafficMetrics returns traffic metrics
+// @Summary Get traffic metrics
+// @Description Returns traffic metrics
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Period"
+// @Success 200 {object} usecases.TrafficMetricsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/traffic [get]
 func (h *AnalyticsHandler) GetTrafficMetrics(c *gin.Context) {
 	var req usecases.TrafficMetricsRequest
 
@@ -250,6 +313,14 @@ func (h *AnalyticsHandler) GetTrafficMetrics(c *gin.Context) {
 }
 
 // GetRealTimeMetrics returns real-time metrics
+// @Summary Get real time metrics
+// @Description Returns real-time metrics
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.RealTimeMetricsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/dashboard/real-time [get]
 func (h *AnalyticsHandler) GetRealTimeMetrics(c *gin.Context) {
 	metrics, err := h.analyticsUseCase.GetRealTimeMetrics(c.Request.Context())
 	if err != nil {
@@ -267,6 +338,18 @@ func (h *AnalyticsHandler) GetRealTimeMetrics(c *gin.Context) {
 }
 
 // GetTopProducts returns top products
+// @Summary Get top products
+// @Description Returns top products
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Period"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/top-products [get]
 func (h *AnalyticsHandler) GetTopProducts(c *gin.Context) {
 	period := c.DefaultQuery("period", "30d")
 
@@ -300,6 +383,18 @@ func (h *AnalyticsHandler) GetTopProducts(c *gin.Context) {
 }
 
 // GetTopCategories returns top categories
+// @Summary Get top categories
+// @Description Returns top categories
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Period"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/top-categories [get]
 func (h *AnalyticsHandler) GetTopCategories(c *gin.Context) {
 	period := c.DefaultQuery("period", "30d")
 
@@ -331,3 +426,74 @@ func (h *AnalyticsHandler) GetTopCategories(c *gin.Context) {
 		Pagination: response.Pagination,
 	})
 }
+
+// GetVisitorFunnel returns a session-based conversion funnel
+// @Summary Get visitor funnel
+// @Description Returns how many visitor sessions, signed in or anonymous, reached each funnel step in order. Steps default to product_view, add_to_cart, checkout and purchase; dates default to the last 30 days.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param steps query string false "Comma-separated event types"
+// @Param date_from query string false "Date from (YYYY-MM-DD)"
+// @Param date_to query string false "Date to (YYYY-MM-DD)"
+// @Success 200 {object} usecases.VisitorFunnelResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/funnel [get]
+func (h *AnalyticsHandler) GetVisitorFunnel(c *gin.Context) {
+	var req usecases.VisitorFunnelRequest
+
+	if steps := c.Query("steps"); steps != "" {
+		for _, step := range strings.Split(steps, ",") {
+			if step = strings.TrimSpace(step); step != "" {
+				req.Steps = append(req.Steps, step)
+			}
+		}
+	}
+	if dateFrom := c.Query("date_from"); dateFrom != "" {
+		t, err := time.Parse("2006-01-02", dateFrom)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_from, expected YYYY-MM-DD",
+			})
+			return
+		}
+		req.DateFrom = t
+	}
+	if dateTo := c.Query("date_to"); dateTo != "" {
+		t, err := time.Parse("2006-01-02", dateTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_to, expected YYYY-MM-DD",
+			})
+			return
+		}
+		req.DateTo = t.AddDate(0, 0, 1)
+	}
+
+	funnel, err := h.analyticsUseCase.GetVisitorFunnel(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Visitor funnel retrieved successfully",
+		Data:    funnel,
+	})
+}
+
+// stitchVisitorSession attributes the current visitor session's anonymous tracking data to a user who just signed in or registered
+func stitchVisitorSession(c *gin.Context, analyticsUseCase usecases.AnalyticsUseCase, userID uuid.UUID) {
+	if analyticsUseCase == nil {
+		return
+	}
+	sessionID := getSessionIDFromContext(c)
+	go func() {
+		if err := analyticsUseCase.StitchSession(context.Background(), sessionID, userID); err != nil {
+			fmt.Printf("Failed to stitch visitor session: %v\n", err)
+		}
+	}()
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// BackInStockHandler handles customers asking to be told when products are back in stock
+type BackInStockHandler struct {
+	backInStockUseCase usecases.BackInStockUseCase
+}
+
+// NewBackInStockHandler creates a new back in stock handler
+func NewBackInStockHandler(backInStockUseCase usecases.BackInStockUseCase) *BackInStockHandler {
+	return &BackInStockHandler{
+		backInStockUseCase: backInStockUseCase,
+	}
+}
+
+// Subscribe handles subscribing to a product's restock
+// @Summary Get notified when a product is back in stock
+// @Description Subscribe to an out-of-stock product. The response includes the restock estimate when a purchase order for the product is on its way. Subscribers are notified oldest first, in batches sized to the restocked units.
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 201 {object} SuccessResponse{data=usecases.BackInStockSubscriptionResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/{id}/back-in-stock [post]
+func (h *BackInStockHandler) Subscribe(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	subscription, err := h.backInStockUseCase.Subscribe(c.Request.Context(), *userID, productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "You will be notified when the product is back in stock",
+		Data:    subscription,
+	})
+}
+
+// Unsubscribe handles cancelling a back in stock subscription
+// @Summary Stop back in stock notifications for a product
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /products/{id}/back-in-stock [delete]
+func (h *BackInStockHandler) Unsubscribe(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	if err := h.backInStockUseCase.Unsubscribe(c.Request.Context(), *userID, productID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Back in stock notifications stopped",
+	})
+}
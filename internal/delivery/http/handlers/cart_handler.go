@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"regexp"
 
@@ -11,13 +13,15 @@ import (
 
 // CartHandler handles cart-related HTTP requests
 type CartHandler struct {
-	cartUseCase usecases.CartUseCase
+	cartUseCase      usecases.CartUseCase
+	analyticsUseCase usecases.AnalyticsUseCase
 }
 
 // NewCartHandler creates a new cart handler
-func NewCartHandler(cartUseCase usecases.CartUseCase) *CartHandler {
+func NewCartHandler(cartUseCase usecases.CartUseCase, analyticsUseCase usecases.AnalyticsUseCase) *CartHandler {
 	return &CartHandler{
-		cartUseCase: cartUseCase,
+		cartUseCase:      cartUseCase,
+		analyticsUseCase: analyticsUseCase,
 	}
 }
 
@@ -60,7 +64,7 @@ func (h *CartHandler) GetCart(c *gin.Context) {
 	}
 
 	// Guest user - check for session ID
-	sessionID := c.GetHeader("X-Session-ID")
+	sessionID := getSessionIDFromContext(c)
 	if !validateSessionID(sessionID) {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error: "Valid session ID is required for guest cart",
@@ -124,6 +128,7 @@ func (h *CartHandler) AddToCart(c *gin.Context) {
 			})
 			return
 		}
+		h.trackAddToCart(c, &userID, req)
 
 		c.JSON(http.StatusOK, SuccessResponse{
 			Message: "Item added to cart successfully",
@@ -133,7 +138,7 @@ func (h *CartHandler) AddToCart(c *gin.Context) {
 	}
 
 	// Guest user - check for session ID
-	sessionID := c.GetHeader("X-Session-ID")
+	sessionID := getSessionIDFromContext(c)
 	if sessionID == "" {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error: "Session ID is required for guest cart",
@@ -148,6 +153,7 @@ func (h *CartHandler) AddToCart(c *gin.Context) {
 		})
 		return
 	}
+	h.trackAddToCart(c, nil, req)
 
 	c.JSON(http.StatusOK, SuccessResponse{
 		Message: "Item added to cart successfully",
@@ -483,3 +489,16 @@ func validateSessionID(sessionID string) bool {
 	matched, _ := regexp.MatchString(`^[a-zA-Z0-9\-_]+$`, sessionID)
 	return matched
 }
+
+// trackAddToCart records an add to cart event for the visitor session in the background
+func (h *CartHandler) trackAddToCart(c *gin.Context, userID *uuid.UUID, req usecases.AddToCartRequest) {
+	if h.analyticsUseCase == nil {
+		return
+	}
+	sessionID := getSessionIDFromContext(c)
+	go func() {
+		if err := h.analyticsUseCase.TrackAddToCart(context.Background(), req.ProductID, userID, sessionID, req.Quantity, 0); err != nil {
+			fmt.Printf("Failed to track add to cart: %v\n", err)
+		}
+	}()
+}
@@ -0,0 +1,458 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CatalogChangesetHandler handles staged catalog changes and their publishing
+type CatalogChangesetHandler struct {
+	catalogChangesetUseCase usecases.CatalogChangesetUseCase
+}
+
+// NewCatalogChangesetHandler creates a new catalog changeset handler
+func NewCatalogChangesetHandler(catalogChangesetUseCase usecases.CatalogChangesetUseCase) *CatalogChangesetHandler {
+	return &CatalogChangesetHandler{
+		catalogChangesetUseCase: catalogChangesetUseCase,
+	}
+}
+
+// CreateChangeset handles starting a catalog changeset
+// @Summary Create a catalog changeset
+// @Description Start an empty draft changeset to batch product edits in. Its preview token shows the storefront with the edits applied through the preview_token query parameter of the product endpoints.
+// @Tags catalog-changesets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateCatalogChangesetRequest true "Changeset"
+// @Success 201 {object} usecases.CatalogChangesetResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/catalog-changesets [post]
+func (h *CatalogChangesetHandler) CreateChangeset(c *gin.Context) {
+	var req usecases.CreateCatalogChangesetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	req.CreatedBy = getUserIDFromContext(c)
+
+	changeset, err := h.catalogChangesetUseCase.CreateChangeset(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Catalog changeset created successfully",
+		Data:    changeset,
+	})
+}
+
+// ListChangesets handles listing catalog changesets
+// @Summary List catalog changesets
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status filter (draft, scheduled, published, rolled_back, failed)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/catalog-changesets [get]
+func (h *CatalogChangesetHandler) ListChangesets(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListCatalogChangesetsRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if status := c.Query("status"); status != "" {
+		s := entities.CatalogChangesetStatus(status)
+		req.Status = &s
+	}
+
+	response, err := h.catalogChangesetUseCase.ListChangesets(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Changesets,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetChangeset handles getting a catalog changeset with its product edits
+// @Summary Get a catalog changeset
+// @Description Get a changeset with each product's staged edits. Until the changeset is published, before and after show the product now and with the edits applied, and error flags edits that no longer apply.
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id} [get]
+func (h *CatalogChangesetHandler) GetChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.GetChangeset(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset retrieved successfully",
+		Data:    changeset,
+	})
+}
+
+// UpdateChangeset handles renaming an unpublished catalog changeset
+// @Summary Update a catalog changeset
+// @Tags catalog-changesets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Param request body usecases.UpdateCatalogChangesetRequest true "Changeset details"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id} [put]
+func (h *CatalogChangesetHandler) UpdateChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdateCatalogChangesetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.UpdateChangeset(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset updated successfully",
+		Data:    changeset,
+	})
+}
+
+// DeleteChangeset handles discarding an unpublished catalog changeset
+// @Summary Delete a catalog changeset
+// @Description Discard an unpublished changeset and its staged edits. Published changesets are kept for rollback.
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id} [delete]
+func (h *CatalogChangesetHandler) DeleteChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.catalogChangesetUseCase.DeleteChangeset(c.Request.Context(), id); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset deleted successfully",
+	})
+}
+
+// SaveChangesetItem handles staging edits to a product
+// @Summary Stage product edits in a catalog changeset
+// @Description Stage edits to a product's content, pricing, featured flag, visibility or status, replacing the product's earlier edits in the changeset. Omitted fields are left unchanged.
+// @Tags catalog-changesets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Param product_id path string true "Product ID"
+// @Param request body entities.CatalogProductChanges true "Product edits"
+// @Success 200 {object} usecases.CatalogChangesetItemResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/products/{product_id} [put]
+func (h *CatalogChangesetHandler) SaveChangesetItem(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+	productID, err := uuid.Parse(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	var changes entities.CatalogProductChanges
+	if err := c.ShouldBindJSON(&changes); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	item, err := h.catalogChangesetUseCase.SaveChangesetItem(c.Request.Context(), id, productID, changes)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product edits staged successfully",
+		Data:    item,
+	})
+}
+
+// RemoveChangesetItem handles dropping a product's staged edits
+// @Summary Remove a product from a catalog changeset
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Param product_id path string true "Product ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/products/{product_id} [delete]
+func (h *CatalogChangesetHandler) RemoveChangesetItem(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+	productID, err := uuid.Parse(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	if err := h.catalogChangesetUseCase.RemoveChangesetItem(c.Request.Context(), id, productID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product removed from catalog changeset successfully",
+	})
+}
+
+// ScheduleChangeset handles scheduling a catalog changeset
+// @Summary Schedule a catalog changeset
+// @Description Publish the changeset automatically at the given time. If its edits no longer apply then, the changeset is marked failed and nothing is published.
+// @Tags catalog-changesets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Param request body usecases.ScheduleCatalogChangesetRequest true "Publish time"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/schedule [put]
+func (h *CatalogChangesetHandler) ScheduleChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.ScheduleCatalogChangesetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.ScheduleChangeset(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset scheduled successfully",
+		Data:    changeset,
+	})
+}
+
+// UnscheduleChangeset handles moving a scheduled catalog changeset back to draft
+// @Summary Unschedule a catalog changeset
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/schedule [delete]
+func (h *CatalogChangesetHandler) UnscheduleChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.UnscheduleChangeset(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset unscheduled successfully",
+		Data:    changeset,
+	})
+}
+
+// PublishChangeset handles publishing a catalog changeset now
+// @Summary Publish a catalog changeset
+// @Description Apply all of the changeset's edits in one transaction. Nothing is published if any product's edits no longer apply.
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/publish [post]
+func (h *CatalogChangesetHandler) PublishChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.PublishChangeset(c.Request.Context(), id, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset published successfully",
+		Data:    changeset,
+	})
+}
+
+// RollbackChangeset handles undoing a published catalog changeset
+// @Summary Roll back a catalog changeset
+// @Description Restore the products a published changeset edited to how they were before it. Products edited again since are skipped.
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Changeset ID"
+// @Success 200 {object} usecases.CatalogChangesetResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/catalog-changesets/{id}/rollback [post]
+func (h *CatalogChangesetHandler) RollbackChangeset(c *gin.Context) {
+	id, ok := parseCatalogChangesetID(c)
+	if !ok {
+		return
+	}
+
+	changeset, err := h.catalogChangesetUseCase.RollbackChangeset(c.Request.Context(), id, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Catalog changeset rolled back successfully",
+		Data:    changeset,
+	})
+}
+
+// PublishDueChangesets handles manually triggering the scheduled changeset job
+// @Summary Publish due catalog changesets now
+// @Tags catalog-changesets
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/catalog-changesets/publish-due [post]
+func (h *CatalogChangesetHandler) PublishDueChangesets(c *gin.Context) {
+	published, err := h.catalogChangesetUseCase.PublishDueChangesets(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to publish scheduled catalog changesets",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Scheduled catalog changesets published successfully",
+		Data: gin.H{
+			"published": published,
+		},
+	})
+}
+
+func parseCatalogChangesetID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid catalog changeset ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
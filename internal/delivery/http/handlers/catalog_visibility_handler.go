@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CatalogVisibilityHandler handles catalog visibility rule HTTP requests
+type CatalogVisibilityHandler struct {
+	visibilityUseCase usecases.CatalogVisibilityUseCase
+}
+
+// NewCatalogVisibilityHandler creates a new catalog visibility handler
+func NewCatalogVisibilityHandler(visibilityUseCase usecases.CatalogVisibilityUseCase) *CatalogVisibilityHandler {
+	return &CatalogVisibilityHandler{
+		visibilityUseCase: visibilityUseCase,
+	}
+}
+
+// CreateRule handles creating a visibility rule
+// @Summary Create catalog visibility rule
+// @Description Restrict a product, or a category and its subcategories, to an audience
+// @Tags admin-catalog-visibility
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateVisibilityRuleRequest true "Visibility rule"
+// @Success 201 {object} usecases.VisibilityRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/catalog-visibility [post]
+func (h *CatalogVisibilityHandler) CreateRule(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.CreateVisibilityRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	rule, err := h.visibilityUseCase.CreateRule(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Visibility rule created successfully",
+		Data:    rule,
+	})
+}
+
+// ListRules handles listing visibility rules
+// @Summary List catalog visibility rules
+// @Tags admin-catalog-visibility
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query string false "Filter by product ID"
+// @Param category_id query string false "Filter by category ID"
+// @Param audience query string false "Filter by audience"
+// @Param is_active query bool false "Filter by active state"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/catalog-visibility [get]
+func (h *CatalogVisibilityHandler) ListRules(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListVisibilityRulesRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if productIDStr := c.Query("product_id"); productIDStr != "" {
+		productID, err := uuid.Parse(productIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID format",
+			})
+			return
+		}
+		req.ProductID = &productID
+	}
+	if categoryIDStr := c.Query("category_id"); categoryIDStr != "" {
+		categoryID, err := uuid.Parse(categoryIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid category ID format",
+			})
+			return
+		}
+		req.CategoryID = &categoryID
+	}
+	if audience := c.Query("audience"); audience != "" {
+		visibilityAudience := entities.VisibilityAudience(audience)
+		req.Audience = &visibilityAudience
+	}
+	if isActiveStr := c.Query("is_active"); isActiveStr != "" {
+		isActive := isActiveStr == "true"
+		req.IsActive = &isActive
+	}
+
+	response, err := h.visibilityUseCase.ListRules(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Rules,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetRule handles getting a visibility rule
+// @Summary Get catalog visibility rule
+// @Tags admin-catalog-visibility
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Success 200 {object} usecases.VisibilityRuleResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/catalog-visibility/{id} [get]
+func (h *CatalogVisibilityHandler) GetRule(c *gin.Context) {
+	ruleID, ok := parseVisibilityRuleID(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.visibilityUseCase.GetRule(c.Request.Context(), ruleID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Visibility rule retrieved successfully",
+		Data:    rule,
+	})
+}
+
+// UpdateRule handles updating a visibility rule
+// @Summary Update catalog visibility rule
+// @Tags admin-catalog-visibility
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Param request body usecases.UpdateVisibilityRuleRequest true "Rule changes"
+// @Success 200 {object} usecases.VisibilityRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/catalog-visibility/{id} [put]
+func (h *CatalogVisibilityHandler) UpdateRule(c *gin.Context) {
+	ruleID, ok := parseVisibilityRuleID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdateVisibilityRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	rule, err := h.visibilityUseCase.UpdateRule(c.Request.Context(), ruleID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Visibility rule updated successfully",
+		Data:    rule,
+	})
+}
+
+// DeleteRule handles deleting a visibility rule
+// @Summary Delete catalog visibility rule
+// @Tags admin-catalog-visibility
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/catalog-visibility/{id} [delete]
+func (h *CatalogVisibilityHandler) DeleteRule(c *gin.Context) {
+	ruleID, ok := parseVisibilityRuleID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.visibilityUseCase.DeleteRule(c.Request.Context(), ruleID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Visibility rule deleted successfully",
+	})
+}
+
+// PreviewHiddenProducts handles previewing which products a customer cannot see
+// @Summary Preview hidden products
+// @Description List the products hidden from a customer, or from guests when user_id is omitted
+// @Tags admin-catalog-visibility
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string false "Customer ID"
+// @Success 200 {object} usecases.HiddenProductsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/catalog-visibility/preview [get]
+func (h *CatalogVisibilityHandler) PreviewHiddenProducts(c *gin.Context) {
+	var userID *uuid.UUID
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		parsed, err := uuid.Parse(userIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid user ID format",
+			})
+			return
+		}
+		userID = &parsed
+	}
+
+	response, err := h.visibilityUseCase.PreviewHiddenProducts(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Hidden products retrieved successfully",
+		Data:    response,
+	})
+}
+
+func parseVisibilityRuleID(c *gin.Context) (uuid.UUID, bool) {
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid rule ID format",
+		})
+		return uuid.Nil, false
+	}
+	return ruleID, true
+}
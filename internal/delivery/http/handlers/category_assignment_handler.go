@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CategoryAssignmentHandler handles bulk adding, removing and moving products across categories
+type CategoryAssignmentHandler struct {
+	categoryAssignmentUseCase usecases.CategoryAssignmentUseCase
+}
+
+// NewCategoryAssignmentHandler creates a new category assignment handler
+func NewCategoryAssignmentHandler(categoryAssignmentUseCase usecases.CategoryAssignmentUseCase) *CategoryAssignmentHandler {
+	return &CategoryAssignmentHandler{
+		categoryAssignmentUseCase: categoryAssignmentUseCase,
+	}
+}
+
+// PreviewAssignment handles a dry run of a bulk category assignment
+// @Summary Preview a bulk category assignment
+// @Description Count the products an assignment would match and change, with a sample of the changed products, without changing anything.
+// @Tags category-assignments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CategoryAssignmentRequest true "Assignment"
+// @Success 200 {object} SuccessResponse{data=usecases.CategoryAssignmentPreviewResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/categories/product-assignments/preview [post]
+func (h *CategoryAssignmentHandler) PreviewAssignment(c *gin.Context) {
+	var req usecases.CategoryAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	preview, err := h.categoryAssignmentUseCase.PreviewAssignment(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Category assignment previewed successfully",
+		Data:    preview,
+	})
+}
+
+// CreateAssignment handles running a bulk category assignment
+// @Summary Run a bulk category assignment
+// @Description Add products to a category, remove them from it, or move them from a source category to it. Products are selected by IDs or by filter. Assignments of up to 500 products are applied right away (201); larger ones are accepted (202) and run in the background, so poll the assignment for its status.
+// @Tags category-assignments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CategoryAssignmentRequest true "Assignment"
+// @Success 201 {object} SuccessResponse{data=entities.CategoryAssignment}
+// @Success 202 {object} SuccessResponse{data=entities.CategoryAssignment}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/categories/product-assignments [post]
+func (h *CategoryAssignmentHandler) CreateAssignment(c *gin.Context) {
+	var req usecases.CategoryAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	req.RequestedBy = getUserIDFromContext(c)
+
+	assignment, err := h.categoryAssignmentUseCase.CreateAssignment(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if assignment.Status == entities.CategoryAssignmentStatusPending {
+		c.JSON(http.StatusAccepted, SuccessResponse{
+			Message: "Category assignment queued to run in the background",
+			Data:    assignment,
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Category assignment completed successfully",
+		Data:    assignment,
+	})
+}
+
+// ListAssignments handles listing bulk category assignments
+// @Summary List bulk category assignments
+// @Tags category-assignments
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status filter (pending, running, completed, failed)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/categories/product-assignments [get]
+func (h *CategoryAssignmentHandler) ListAssignments(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListCategoryAssignmentsRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if status := c.Query("status"); status != "" {
+		s := entities.CategoryAssignmentStatus(status)
+		req.Status = &s
+	}
+
+	response, err := h.categoryAssignmentUseCase.ListAssignments(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Assignments,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetAssignment handles getting a bulk category assignment
+// @Summary Get a bulk category assignment
+// @Description Get an assignment with its status and the number of products it matched and changed.
+// @Tags category-assignments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Assignment ID"
+// @Success 200 {object} SuccessResponse{data=entities.CategoryAssignment}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/categories/product-assignments/{id} [get]
+func (h *CategoryAssignmentHandler) GetAssignment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid category assignment ID",
+		})
+		return
+	}
+
+	assignment, err := h.categoryAssignmentUseCase.GetAssignment(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Category assignment retrieved successfully",
+		Data:    assignment,
+	})
+}
@@ -100,9 +100,16 @@ func (h *CategoryHandler) GetCategory(c *gin.Context) {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"
+// @Param include query string false "Comma separated relations to return (parent, children, seo)"
 // @Success 200 {object} PaginatedResponse
 // @Router /categories [get]
 func (h *CategoryHandler) GetCategories(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, categoryFields)
+	if !ok {
+		return
+	}
+
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0")) // 0 means use default
@@ -133,7 +140,7 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Categories,
+		Data:       fields.apply(response.Categories),
 		Pagination: response.Pagination,
 	})
 }
@@ -166,9 +173,16 @@ func (h *CategoryHandler) GetCategoryTree(c *gin.Context) {
 // @Tags categories
 // @Accept json
 // @Produce json
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"
+// @Param include query string false "Comma separated relations to return (parent, children, seo)"
 // @Success 200 {array} usecases.CategoryResponse
 // @Router /categories/root [get]
 func (h *CategoryHandler) GetRootCategories(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, categoryFields)
+	if !ok {
+		return
+	}
+
 	categories, err := h.categoryUseCase.GetRootCategories(c.Request.Context())
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
@@ -178,7 +192,7 @@ func (h *CategoryHandler) GetRootCategories(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, SuccessResponse{
-		Data: categories,
+		Data: fields.apply(categories),
 	})
 }
 
@@ -189,10 +203,17 @@ func (h *CategoryHandler) GetRootCategories(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Parent Category ID"
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"
+// @Param include query string false "Comma separated relations to return (parent, children, seo)"
 // @Success 200 {array} usecases.CategoryResponse
 // @Failure 400 {object} ErrorResponse
 // @Router /categories/{id}/children [get]
 func (h *CategoryHandler) GetCategoryChildren(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, categoryFields)
+	if !ok {
+		return
+	}
+
 	categoryID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -210,7 +231,7 @@ func (h *CategoryHandler) GetCategoryChildren(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, SuccessResponse{
-		Data: categories,
+		Data: fields.apply(categories),
 	})
 }
 
@@ -407,6 +428,7 @@ func (h *CategoryHandler) GetCategoryLandingPage(c *gin.Context) {
 		IncludeSubcategoryProducts: includeSubcategoryProducts,
 		IncludeFeatured:            includeFeatured,
 		FeaturedLimit:              featuredLimit,
+		ViewerID:                   getUserIDFromContext(c),
 	}
 
 	response, err := h.categoryUseCase.GetCategoryLandingPage(c.Request.Context(), req)
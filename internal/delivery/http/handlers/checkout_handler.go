@@ -299,6 +299,11 @@ func validateCreateCheckoutSessionRequest(req *usecases.CreateNewCheckoutSession
 		return fmt.Errorf("discount amount cannot be negative, got: %.2f", req.DiscountAmount)
 	}
 
+	// Saved or default addresses are resolved and validated by the use case
+	if req.ShippingAddressID != nil || req.ShippingAddress == (usecases.AddressRequest{}) {
+		return nil
+	}
+
 	// Validate shipping address (required)
 	if req.ShippingAddress.FirstName == "" {
 		return fmt.Errorf("shipping address first name is required")
@@ -344,6 +349,11 @@ func validateCreateCODOrderRequest(req *usecases.CreateOrderRequest) error {
 		return fmt.Errorf("discount amount cannot be negative, got: %.2f", req.DiscountAmount)
 	}
 
+	// Saved or default addresses are resolved and validated by the use case
+	if req.ShippingAddressID != nil || req.ShippingAddress == (usecases.AddressRequest{}) {
+		return nil
+	}
+
 	// Validate shipping address (required)
 	if req.ShippingAddress.FirstName == "" {
 		return fmt.Errorf("shipping address first name is required")
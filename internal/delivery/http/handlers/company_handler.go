@@ -0,0 +1,897 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CompanyHandler handles company (B2B) account HTTP requests
+type CompanyHandler struct {
+	companyUseCase usecases.CompanyUseCase
+}
+
+// NewCompanyHandler creates a new company handler
+func NewCompanyHandler(companyUseCase usecases.CompanyUseCase) *CompanyHandler {
+	return &CompanyHandler{
+		companyUseCase: companyUseCase,
+	}
+}
+
+// CreateCompany handles creating a company account
+// @Summary Create company account
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateCompanyRequest true "Create company request"
+// @Success 201 {object} usecases.CompanyResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/companies [post]
+func (h *CompanyHandler) CreateCompany(c *gin.Context) {
+	var req usecases.CreateCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	company, err := h.companyUseCase.CreateCompany(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Company created successfully",
+		Data:    company,
+	})
+}
+
+// ListCompanies handles listing company accounts
+// @Summary List company accounts
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "Search by name or tax ID"
+// @Param is_active query bool false "Filter by active status"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/companies [get]
+func (h *CompanyHandler) ListCompanies(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListCompaniesRequest{
+		Search: c.Query("search"),
+		Page:   page,
+		Limit:  limit,
+	}
+
+	if isActiveStr := c.Query("is_active"); isActiveStr != "" {
+		isActive, err := strconv.ParseBool(isActiveStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid is_active value",
+			})
+			return
+		}
+		req.IsActive = &isActive
+	}
+
+	response, err := h.companyUseCase.ListCompanies(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Companies,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetCompany handles getting a company with its members
+// @Summary Get company account
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {object} usecases.CompanyResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/{id} [get]
+func (h *CompanyHandler) GetCompany(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	company, err := h.companyUseCase.GetCompany(c.Request.Context(), companyID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company retrieved successfully",
+		Data:    company,
+	})
+}
+
+// UpdateCompany handles updating a company account
+// @Summary Update company account
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body usecases.UpdateCompanyRequest true "Update company request"
+// @Success 200 {object} usecases.CompanyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/{id} [put]
+func (h *CompanyHandler) UpdateCompany(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	var req usecases.UpdateCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	company, err := h.companyUseCase.UpdateCompany(c.Request.Context(), companyID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company updated successfully",
+		Data:    company,
+	})
+}
+
+// AddMember handles adding a user to a company
+// @Summary Add company member
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body usecases.AddCompanyMemberRequest true "Add member request"
+// @Success 201 {object} usecases.CompanyMemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/companies/{id}/members [post]
+func (h *CompanyHandler) AddMember(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	var req usecases.AddCompanyMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	member, err := h.companyUseCase.AddMember(c.Request.Context(), companyID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Company member added successfully",
+		Data:    member,
+	})
+}
+
+// UpdateMember handles updating a company member
+// @Summary Update company member
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param member_id path string true "Member ID"
+// @Param request body usecases.UpdateCompanyMemberRequest true "Update member request"
+// @Success 200 {object} usecases.CompanyMemberResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/{id}/members/{member_id} [put]
+func (h *CompanyHandler) UpdateMember(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("member_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid member ID format",
+		})
+		return
+	}
+
+	var req usecases.UpdateCompanyMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	member, err := h.companyUseCase.UpdateMember(c.Request.Context(), companyID, memberID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company member updated successfully",
+		Data:    member,
+	})
+}
+
+// RemoveMember handles removing a user from a company
+// @Summary Remove company member
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param member_id path string true "Member ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/{id}/members/{member_id} [delete]
+func (h *CompanyHandler) RemoveMember(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("member_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid member ID format",
+		})
+		return
+	}
+
+	if err := h.companyUseCase.RemoveMember(c.Request.Context(), companyID, memberID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company member removed successfully",
+	})
+}
+
+// GenerateInvoice handles generating a consolidated invoice for a company
+// @Summary Generate consolidated company invoice
+// @Description Consolidate all uninvoiced company orders in the period into one invoice
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body usecases.GenerateCompanyInvoiceRequest true "Invoice period"
+// @Success 201 {object} usecases.CompanyInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/companies/{id}/invoices [post]
+func (h *CompanyHandler) GenerateInvoice(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	var req usecases.GenerateCompanyInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	invoice, err := h.companyUseCase.GenerateInvoice(c.Request.Context(), companyID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Company invoice generated successfully",
+		Data:    invoice,
+	})
+}
+
+// GetCompanyInvoices handles listing a company's invoices
+// @Summary List company invoices
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/companies/{id}/invoices [get]
+func (h *CompanyHandler) GetCompanyInvoices(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid company ID format",
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.companyUseCase.GetCompanyInvoices(c.Request.Context(), companyID, page, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Invoices,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetInvoice handles getting a consolidated invoice
+// @Summary Get company invoice
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} usecases.CompanyInvoiceResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/invoices/{invoice_id} [get]
+func (h *CompanyHandler) GetInvoice(c *gin.Context) {
+	invoiceID, err := uuid.Parse(c.Param("invoice_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid invoice ID format",
+		})
+		return
+	}
+
+	invoice, err := h.companyUseCase.GetInvoice(c.Request.Context(), invoiceID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company invoice retrieved successfully",
+		Data:    invoice,
+	})
+}
+
+// MarkInvoicePaid handles recording payment of a consolidated invoice
+// @Summary Mark company invoice as paid
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Param invoice_id path string true "Invoice ID"
+// @Success 200 {object} usecases.CompanyInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/invoices/{invoice_id}/paid [put]
+func (h *CompanyHandler) MarkInvoicePaid(c *gin.Context) {
+	invoiceID, err := uuid.Parse(c.Param("invoice_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid invoice ID format",
+		})
+		return
+	}
+
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	invoice, err := h.companyUseCase.MarkInvoicePaid(c.Request.Context(), *adminID, invoiceID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company invoice marked as paid",
+		Data:    invoice,
+	})
+}
+
+// RecordInvoicePayment handles recording an offline payment against a company invoice
+// @Summary Record company invoice payment
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param invoice_id path string true "Invoice ID"
+// @Param request body usecases.RecordInvoicePaymentRequest true "Payment details"
+// @Success 200 {object} usecases.CompanyInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/companies/invoices/{invoice_id}/payments [post]
+func (h *CompanyHandler) RecordInvoicePayment(c *gin.Context) {
+	invoiceID, err := uuid.Parse(c.Param("invoice_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid invoice ID format",
+		})
+		return
+	}
+
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.RecordInvoicePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	invoice, err := h.companyUseCase.RecordInvoicePayment(c.Request.Context(), *adminID, invoiceID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Invoice payment recorded successfully",
+		Data:    invoice,
+	})
+}
+
+// SendInvoiceReminders handles sending overdue reminders for company invoices
+// @Summary Send overdue invoice reminders
+// @Tags companies
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/companies/invoices/send-reminders [post]
+func (h *CompanyHandler) SendInvoiceReminders(c *gin.Context) {
+	reminded, err := h.companyUseCase.SendInvoiceReminders(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Overdue invoice reminders sent",
+		Data: map[string]interface{}{
+			"reminded": reminded,
+		},
+	})
+}
+
+// GetMyCompany handles getting the current user's company
+// @Summary Get my company
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.CompanyResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /company [get]
+func (h *CompanyHandler) GetMyCompany(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	company, err := h.companyUseCase.GetMyCompany(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company retrieved successfully",
+		Data:    company,
+	})
+}
+
+// GetCompanyAddresses handles listing the company's shared address book
+// @Summary Get company shared addresses
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} usecases.AddressResponse
+// @Router /company/addresses [get]
+func (h *CompanyHandler) GetCompanyAddresses(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	addresses, err := h.companyUseCase.GetCompanyAddresses(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company addresses retrieved successfully",
+		Data:    addresses,
+	})
+}
+
+// CreateCompanyAddress handles adding an address to the company's shared address book
+// @Summary Add company shared address
+// @Tags company
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateAddressRequest true "Address"
+// @Success 201 {object} usecases.AddressResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /company/addresses [post]
+func (h *CompanyHandler) CreateCompanyAddress(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.CreateAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	address, err := h.companyUseCase.CreateCompanyAddress(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Company address created successfully",
+		Data:    address,
+	})
+}
+
+// DeleteCompanyAddress handles removing an address from the company's shared address book
+// @Summary Delete company shared address
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Address ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /company/addresses/{id} [delete]
+func (h *CompanyHandler) DeleteCompanyAddress(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	addressID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid address ID format",
+		})
+		return
+	}
+
+	if err := h.companyUseCase.DeleteCompanyAddress(c.Request.Context(), *userID, addressID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company address deleted successfully",
+	})
+}
+
+// GetOrderApprovals handles listing the company's order approval requests
+// @Summary List company order approvals
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status filter (pending, approved, rejected)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /company/approvals [get]
+func (h *CompanyHandler) GetOrderApprovals(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListOrderApprovalsRequest{
+		Page:  page,
+		Limit: limit,
+	}
+
+	if status := c.Query("status"); status != "" {
+		s := entities.OrderApprovalStatus(status)
+		req.Status = &s
+	}
+
+	response, err := h.companyUseCase.GetOrderApprovals(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Approvals,
+		Pagination: response.Pagination,
+	})
+}
+
+// ApproveOrder handles approving a company order
+// @Summary Approve company order
+// @Tags company
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Approval ID"
+// @Param request body usecases.DecideOrderApprovalRequest false "Approval comment"
+// @Success 200 {object} usecases.OrderApprovalResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /company/approvals/{id}/approve [post]
+func (h *CompanyHandler) ApproveOrder(c *gin.Context) {
+	h.decideOrder(c, true)
+}
+
+// RejectOrder handles rejecting a company order
+// @Summary Reject company order
+// @Description Reject a company order over the buyer's spend limit; the order is cancelled
+// @Tags company
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Approval ID"
+// @Param request body usecases.DecideOrderApprovalRequest false "Rejection comment"
+// @Success 200 {object} usecases.OrderApprovalResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /company/approvals/{id}/reject [post]
+func (h *CompanyHandler) RejectOrder(c *gin.Context) {
+	h.decideOrder(c, false)
+}
+
+func (h *CompanyHandler) decideOrder(c *gin.Context, approve bool) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	approvalID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid approval ID format",
+		})
+		return
+	}
+
+	// The comment is optional, so an empty body is allowed
+	var req usecases.DecideOrderApprovalRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	var approval *usecases.OrderApprovalResponse
+	message := "Order approved successfully"
+	if approve {
+		approval, err = h.companyUseCase.ApproveOrder(c.Request.Context(), *userID, approvalID, req)
+	} else {
+		approval, err = h.companyUseCase.RejectOrder(c.Request.Context(), *userID, approvalID, req)
+		message = "Order rejected successfully"
+	}
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: message,
+		Data:    approval,
+	})
+}
+
+// GetMyCompanyInvoices handles listing the current user's company invoices
+// @Summary List my company invoices
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /company/invoices [get]
+func (h *CompanyHandler) GetMyCompanyInvoices(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.companyUseCase.GetMyCompanyInvoices(c.Request.Context(), *userID, page, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Invoices,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetMyCompanyInvoice handles getting an invoice of the current user's company
+// @Summary Get my company invoice
+// @Tags company
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Invoice ID"
+// @Success 200 {object} usecases.CompanyInvoiceResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /company/invoices/{id} [get]
+func (h *CompanyHandler) GetMyCompanyInvoice(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid invoice ID format",
+		})
+		return
+	}
+
+	invoice, err := h.companyUseCase.GetMyCompanyInvoice(c.Request.Context(), *userID, invoiceID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Company invoice retrieved successfully",
+		Data:    invoice,
+	})
+}
@@ -22,6 +22,17 @@ func NewCouponHandler(couponUseCase usecases.CouponUseCase) *CouponHandler {
 }
 
 // CreateCoupon creates a new coupon
+// @Summary Create coupon
+// @Description Creates a new coupon
+// @Tags coupons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateCouponRequest true "Create coupon"
+// @Success 201 {object} usecases.CouponResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/coupons [post]
 func (h *CouponHandler) CreateCoupon(c *gin.Context) {
 	var req usecases.CreateCouponRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -48,6 +59,16 @@ func (h *CouponHandler) CreateCoupon(c *gin.Context) {
 }
 
 // GetCoupon retrieves a coupon by ID
+// @Summary Get coupon
+// @Description Retrieves a coupon by ID
+// @Tags coupons
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.CouponResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/coupons/{id} [get]
 func (h *CouponHandler) GetCoupon(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -101,6 +122,18 @@ func (h *CouponHandler) GetCoupons(c *gin.Context) {
 }
 
 // UpdateCoupon updates a coupon
+// @Summary Update coupon
+// @Description Updates a coupon
+// @Tags coupons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.UpdateCouponRequest true "Update coupon"
+// @Success 200 {object} usecases.CouponResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/coupons/{id} [put]
 func (h *CouponHandler) UpdateCoupon(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -137,6 +170,16 @@ func (h *CouponHandler) UpdateCoupon(c *gin.Context) {
 }
 
 // DeleteCoupon deletes a coupon
+// @Summary Delete coupon
+// @Description Deletes a coupon
+// @Tags coupons
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/coupons/{id} [delete]
 func (h *CouponHandler) DeleteCoupon(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -162,6 +205,13 @@ func (h *CouponHandler) DeleteCoupon(c *gin.Context) {
 }
 
 // ValidateCoupon validates a coupon
+// @Summary Validate coupon
+// @Description Validates a coupon
+// @Tags coupons
+// @Produce json
+// @Success 200 {object} usecases.CouponValidationResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /coupons/validate [post]
 func (h *CouponHandler) ValidateCoupon(c *gin.Context) {
 	code := c.Param("code")
 	if code == "" {
@@ -227,6 +277,20 @@ func (h *CouponHandler) ApplyCoupon(c *gin.Context) {
 }
 
 // ListCoupons returns paginated list of coupons
+// @Summary List coupons
+// @Description Returns paginated list of coupons
+// @Tags coupons
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param search query string false "Search"
+// @Param sort_by query string false "Sort by"
+// @Param sort_order query string false "Sort order"
+// @Success 200 {object} usecases.CouponsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/coupons [get]
 func (h *CouponHandler) ListCoupons(c *gin.Context) {
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DataResidencyHandler handles the data regions of customers and admins' export access to them
+type DataResidencyHandler struct {
+	dataResidencyUseCase usecases.DataResidencyUseCase
+}
+
+// NewDataResidencyHandler creates a new data residency handler
+func NewDataResidencyHandler(dataResidencyUseCase usecases.DataResidencyUseCase) *DataResidencyHandler {
+	return &DataResidencyHandler{
+		dataResidencyUseCase: dataResidencyUseCase,
+	}
+}
+
+// GetRegions handles listing the data regions
+// @Summary List data regions
+// @Description List the data regions customers' personal data and files are kept in, and the default region.
+// @Tags data-residency
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=usecases.DataRegionsResponse}
+// @Router /admin/data-regions [get]
+func (h *DataResidencyHandler) GetRegions(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Data regions retrieved successfully",
+		Data:    h.dataResidencyUseCase.GetRegions(c.Request.Context()),
+	})
+}
+
+// SetUserRegion handles moving a user to another data region
+// @Summary Set a user's data region
+// @Description Set the data region a user's personal data is kept in. New uploads go to the new region's storage; files uploaded before stay where they are.
+// @Tags data-residency
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body usecases.SetUserDataRegionRequest true "Data region"
+// @Success 200 {object} SuccessResponse{data=entities.User}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/users/{id}/data-region [put]
+func (h *DataResidencyHandler) SetUserRegion(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid user ID",
+		})
+		return
+	}
+
+	var req usecases.SetUserDataRegionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	user, err := h.dataResidencyUseCase.SetUserRegion(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "User data region updated successfully",
+		Data:    user,
+	})
+}
+
+// SetExportAccess handles setting the regions an admin may export
+// @Summary Set an admin's data region access
+// @Description Set the data regions whose customer data an admin or moderator may export through order exports and reports. An empty list lets them export every region. Admins only grant regions they may export themselves.
+// @Tags data-residency
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body usecases.SetDataRegionAccessRequest true "Data regions"
+// @Success 200 {object} SuccessResponse{data=entities.User}
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/users/{id}/data-region-access [put]
+func (h *DataResidencyHandler) SetExportAccess(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid user ID",
+		})
+		return
+	}
+
+	var req usecases.SetDataRegionAccessRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	user, err := h.dataResidencyUseCase.SetExportAccess(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Data region access updated successfully",
+		Data:    user,
+	})
+}
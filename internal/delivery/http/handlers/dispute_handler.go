@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DisputeHandler handles reviewing payment disputes and chargebacks
+type DisputeHandler struct {
+	disputeUseCase usecases.DisputeUseCase
+}
+
+// NewDisputeHandler creates a new dispute handler
+func NewDisputeHandler(disputeUseCase usecases.DisputeUseCase) *DisputeHandler {
+	return &DisputeHandler{
+		disputeUseCase: disputeUseCase,
+	}
+}
+
+// GetDisputes handles listing disputes
+// @Summary Get disputes
+// @Description List disputes and chargebacks reported by the payment providers, most recently opened first
+// @Tags disputes
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Dispute status (needs_response, under_review, won, lost, closed)"
+// @Param gateway query string false "Payment provider (stripe, paypal)"
+// @Param order_id query string false "Order ID"
+// @Param limit query int false "Number of disputes to return" default(20)
+// @Param offset query int false "Number of disputes to skip" default(0)
+// @Success 200 {object} usecases.DisputesListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/disputes [get]
+func (h *DisputeHandler) GetDisputes(c *gin.Context) {
+	var req usecases.GetDisputesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	disputes, err := h.disputeUseCase.GetDisputes(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: disputes,
+	})
+}
+
+// GetDispute handles getting a dispute
+// @Summary Get dispute
+// @Description Get a dispute with its evidence deadline and the payment and order it was matched to
+// @Tags disputes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dispute ID"
+// @Success 200 {object} entities.Dispute
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/disputes/{id} [get]
+func (h *DisputeHandler) GetDispute(c *gin.Context) {
+	disputeID, ok := parseDisputeID(c)
+	if !ok {
+		return
+	}
+
+	dispute, err := h.disputeUseCase.GetDispute(c.Request.Context(), disputeID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: dispute,
+	})
+}
+
+// RecordDisputeEvidence handles recording that evidence was submitted for a dispute
+// @Summary Record dispute evidence
+// @Description Record that evidence was submitted to the payment provider for an open dispute, which stops evidence deadline reminders. The dispute stays open until the provider reports its outcome.
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dispute ID"
+// @Param request body usecases.RecordDisputeEvidenceRequest true "What was submitted"
+// @Success 200 {object} entities.Dispute
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/disputes/{id}/evidence [post]
+func (h *DisputeHandler) RecordDisputeEvidence(c *gin.Context) {
+	disputeID, ok := parseDisputeID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.RecordDisputeEvidenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	dispute, err := h.disputeUseCase.RecordEvidenceSubmitted(c.Request.Context(), disputeID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to record dispute evidence",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Dispute evidence recorded successfully",
+		Data:    dispute,
+	})
+}
+
+// GetDisputeMetrics handles dispute metrics for payment analytics
+// @Summary Get dispute metrics
+// @Description Count the disputes opened during a period with their dispute rate (disputes per paid payment) and win rate (won out of decided), as percentages. The period defaults to the last 30 days.
+// @Tags disputes
+// @Produce json
+// @Security BearerAuth
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Success 200 {object} usecases.DisputeMetricsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/disputes/metrics [get]
+func (h *DisputeHandler) GetDisputeMetrics(c *gin.Context) {
+	var req usecases.DisputeMetricsRequest
+	if value := c.Query("date_from"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_from, expected YYYY-MM-DD",
+			})
+			return
+		}
+		req.DateFrom = &date
+	}
+	if value := c.Query("date_to"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_to, expected YYYY-MM-DD",
+			})
+			return
+		}
+		// Include the whole last day
+		date = date.AddDate(0, 0, 1)
+		req.DateTo = &date
+	}
+
+	metrics, err := h.disputeUseCase.GetDisputeMetrics(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Dispute metrics retrieved successfully",
+		Data:    metrics,
+	})
+}
+
+func parseDisputeID(c *gin.Context) (uuid.UUID, bool) {
+	disputeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid dispute ID",
+		})
+		return uuid.Nil, false
+	}
+	return disputeID, true
+}
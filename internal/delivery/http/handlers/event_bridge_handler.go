@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EventBridgeHandler handles monitoring the domain event stream to the message broker
+type EventBridgeHandler struct {
+	eventBridgeUseCase usecases.EventBridgeUseCase
+}
+
+// NewEventBridgeHandler creates a new event bridge handler
+func NewEventBridgeHandler(eventBridgeUseCase usecases.EventBridgeUseCase) *EventBridgeHandler {
+	return &EventBridgeHandler{
+		eventBridgeUseCase: eventBridgeUseCase,
+	}
+}
+
+// GetStatus handles getting the delivery state of the event outbox
+// @Summary Get event bridge status
+// @Description Get the number of domain events waiting in the outbox, how many of them failed to publish and the oldest failure
+// @Tags event-bridge
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.EventBridgeStatusResponse
+// @Router /admin/event-bridge/status [get]
+func (h *EventBridgeHandler) GetStatus(c *gin.Context) {
+	status, err := h.eventBridgeUseCase.GetEventBridgeStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: status,
+	})
+}
+
+// PublishPendingEvents handles manually triggering the event publishing job
+// @Summary Publish pending events now
+// @Tags event-bridge
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/event-bridge/publish [post]
+func (h *EventBridgeHandler) PublishPendingEvents(c *gin.Context) {
+	published, err := h.eventBridgeUseCase.PublishPendingEvents(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to publish pending events",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Pending events published successfully",
+		Data: gin.H{
+			"published": published,
+		},
+	})
+}
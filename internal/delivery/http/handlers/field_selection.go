@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// productFields are the fields product listings can be narrowed to. Relations are only sent
+// when included once a client selects fields.
+var productFields = newFieldSet(usecases.ProductResponse{},
+	"category", "brand", "images", "tags", "attributes", "variants", "alternatives")
+
+// categoryFields are the fields category listings can be narrowed to
+var categoryFields = newFieldSet(usecases.CategoryResponse{}, "parent", "children", "seo")
+
+// fieldSet is the JSON fields of a response type, in declaration order, and which of them are
+// relations
+type fieldSet struct {
+	names     []string
+	index     map[string][]int
+	omitEmpty map[string]bool
+	relations map[string]bool
+}
+
+// newFieldSet reads the JSON fields of a response struct, including those of embedded structs.
+// Relations must be fields of the struct.
+func newFieldSet(response interface{}, relations ...string) *fieldSet {
+	set := &fieldSet{
+		index:     make(map[string][]int),
+		omitEmpty: make(map[string]bool),
+		relations: make(map[string]bool, len(relations)),
+	}
+	set.addFields(reflect.TypeOf(response), nil)
+	for _, relation := range relations {
+		if _, ok := set.index[relation]; !ok {
+			panic(fmt.Sprintf("%s has no %s field", reflect.TypeOf(response), relation))
+		}
+		set.relations[relation] = true
+	}
+	return set
+}
+
+func (s *fieldSet) addFields(t reflect.Type, parent []int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		index := append(append([]int{}, parent...), i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				s.addFields(embedded, index)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := s.index[name]; !ok {
+			s.names = append(s.names, name)
+		}
+		s.index[name] = index
+		s.omitEmpty[name] = strings.Contains(","+options+",", ",omitempty,")
+	}
+}
+
+// fieldSelection is the fields a client asked for with the fields and include query parameters
+type fieldSelection struct {
+	set    *fieldSet
+	fields []string // In declaration order
+}
+
+// bindFieldSelection reads the fields and include query parameters, answering 400 for fields
+// the response doesn't have. It returns a nil selection, which keeps every field, when neither
+// is given. With fields, the listed fields and included relations are sent, and the id always;
+// with only include, every field but the relations that aren't included.
+func bindFieldSelection(c *gin.Context, set *fieldSet) (*fieldSelection, bool) {
+	fields := splitQueryList(c.Query("fields"))
+	include := splitQueryList(c.Query("include"))
+	if len(fields) == 0 && len(include) == 0 {
+		return nil, true
+	}
+
+	selected := make(map[string]bool)
+	for _, name := range fields {
+		if _, ok := set.index[name]; !ok {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   fmt.Sprintf("Unknown field %q", name),
+				Details: "Fields are " + strings.Join(set.names, ", "),
+			})
+			return nil, false
+		}
+		selected[name] = true
+	}
+	for _, name := range include {
+		if !set.relations[name] {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   fmt.Sprintf("Unknown relation %q", name),
+				Details: "Relations are " + strings.Join(set.relationNames(), ", "),
+			})
+			return nil, false
+		}
+		selected[name] = true
+	}
+	if len(fields) == 0 {
+		for _, name := range set.names {
+			if !set.relations[name] {
+				selected[name] = true
+			}
+		}
+	}
+	if _, ok := set.index["id"]; ok {
+		selected["id"] = true
+	}
+
+	selection := &fieldSelection{set: set}
+	for _, name := range set.names {
+		if selected[name] {
+			selection.fields = append(selection.fields, name)
+		}
+	}
+	return selection, true
+}
+
+func (s *fieldSet) relationNames() []string {
+	var names []string
+	for _, name := range s.names {
+		if s.relations[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// apply encodes each item of a list of response structs with only the selected fields, so the
+// rest are never serialized. A nil selection returns the items as they are, as does a list
+// that can't be encoded, so the response is never worse than without a selection.
+func (s *fieldSelection) apply(items interface{}) interface{} {
+	if s == nil {
+		return items
+	}
+	list := reflect.ValueOf(items)
+	if list.Kind() != reflect.Slice {
+		return items
+	}
+
+	projected := make([]json.RawMessage, list.Len())
+	for i := range projected {
+		item, err := s.encode(list.Index(i))
+		if err != nil {
+			return items
+		}
+		projected[i] = item
+	}
+	return projected
+}
+
+func (s *fieldSelection) encode(item reflect.Value) (json.RawMessage, error) {
+	for item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface {
+		if item.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		item = item.Elem()
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, name := range s.fields {
+		value, ok := fieldByIndex(item, s.set.index[name])
+		if !ok || s.set.omitEmpty[name] && isEmptyValue(value) {
+			continue
+		}
+		encoded, err := json.Marshal(value.Interface())
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(encoded)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// isEmptyValue reports whether encoding/json leaves out an omitempty field with the value
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex that reports a nil embedded pointer, which
+// encoding/json leaves the fields of out, instead of panicking
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v, true
+}
+
+// splitQueryList splits a comma separated query parameter, dropping blanks
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
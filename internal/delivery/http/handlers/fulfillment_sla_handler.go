@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FulfillmentSLAHandler handles the fulfillment SLA dashboard widgets and late order lists
+type FulfillmentSLAHandler struct {
+	fulfillmentSLAUseCase usecases.FulfillmentSLAUseCase
+}
+
+// NewFulfillmentSLAHandler creates a new fulfillment SLA handler
+func NewFulfillmentSLAHandler(fulfillmentSLAUseCase usecases.FulfillmentSLAUseCase) *FulfillmentSLAHandler {
+	return &FulfillmentSLAHandler{
+		fulfillmentSLAUseCase: fulfillmentSLAUseCase,
+	}
+}
+
+// GetSummary handles getting the fulfillment SLA dashboard widgets
+// @Summary Get fulfillment SLA summary
+// @Description Get the on-time shipping rate over the last days, overall and per day, with the counts of open orders on track, at risk of shipping late, late (breached) and stuck in a status. Orders must ship within the configured number of business days of the day they were placed.
+// @Tags fulfillment-sla
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Days to cover, today included" default(30)
+// @Success 200 {object} usecases.FulfillmentSLASummaryResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/fulfillment-sla [get]
+func (h *FulfillmentSLAHandler) GetSummary(c *gin.Context) {
+	var req usecases.GetFulfillmentSLASummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	summary, err := h.fulfillmentSLAUseCase.GetSummary(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: summary,
+	})
+}
+
+// GetOrders handles listing orders tracked against the fulfillment SLA
+// @Summary Get fulfillment SLA orders
+// @Description List tracked orders, soonest ship-by time first. Only orders still on their way are listed unless all is set.
+// @Tags fulfillment-sla
+// @Produce json
+// @Security BearerAuth
+// @Param state query string false "SLA state (on_track, at_risk, breached, met, missed, cancelled)"
+// @Param stuck query bool false "Only orders stuck in their status"
+// @Param all query bool false "Include delivered and closed orders"
+// @Param limit query int false "Number of orders to return" default(20)
+// @Param offset query int false "Number of orders to skip" default(0)
+// @Success 200 {object} usecases.FulfillmentSLAOrdersResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/fulfillment-sla/orders [get]
+func (h *FulfillmentSLAHandler) GetOrders(c *gin.Context) {
+	var req usecases.GetFulfillmentSLAOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	orders, err := h.fulfillmentSLAUseCase.GetOrders(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: orders,
+	})
+}
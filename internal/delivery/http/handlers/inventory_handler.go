@@ -3,7 +3,9 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
+	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/usecases"
 
 	"github.com/gin-gonic/gin"
@@ -23,6 +25,16 @@ func NewInventoryHandler(inventoryUseCase usecases.InventoryUseCase) *InventoryH
 }
 
 // GetInventory gets inventory by product and warehouse ID
+// @Summary Get inventory
+// @Description Gets inventory by product and warehouse ID
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.InventoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/inventory/{id} [get]
 func (h *InventoryHandler) GetInventory(c *gin.Context) {
 	productIDStr := c.Param("productId")
 	productID, err := uuid.Parse(productIDStr)
@@ -60,6 +72,19 @@ func (h *InventoryHandler) GetInventory(c *gin.Context) {
 }
 
 // GetInventories gets inventories with pagination
+// @Summary Get inventories
+// @Description Gets inventories with pagination
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param warehouse_id query string false "Warehouse id"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param search query string false "Search"
+// @Success 200 {object} usecases.InventoriesListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory [get]
 func (h *InventoryHandler) GetInventories(c *gin.Context) {
 	warehouseIDStr := c.Query("warehouse_id")
 	if warehouseIDStr == "" {
@@ -116,6 +141,18 @@ func (h *InventoryHandler) GetInventories(c *gin.Context) {
 }
 
 // UpdateInventory updates inventory
+// @Summary Update inventory
+// @Description Updates inventory
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.UpdateInventoryRequest true "Update inventory"
+// @Success 200 {object} usecases.InventoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/{id} [put]
 func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
 	idStr := c.Param("id")
 	_, err := uuid.Parse(idStr)
@@ -152,6 +189,17 @@ func (h *InventoryHandler) UpdateInventory(c *gin.Context) {
 }
 
 // AdjustStock adjusts inventory stock
+// @Summary Adjust stock
+// @Description Adjusts inventory stock
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.AdjustStockRequest true "Adjust stock"
+// @Success 200 {object} usecases.InventoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/adjust [post]
 func (h *InventoryHandler) AdjustStock(c *gin.Context) {
 	var req usecases.AdjustStockRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -178,6 +226,16 @@ func (h *InventoryHandler) AdjustStock(c *gin.Context) {
 }
 
 // GetLowStockItems gets low stock items
+// @Summary Get low stock items
+// @Description Gets low stock items
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} usecases.LowStockItemsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/low-stock [get]
 func (h *InventoryHandler) GetLowStockItems(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -203,6 +261,17 @@ func (h *InventoryHandler) GetLowStockItems(c *gin.Context) {
 }
 
 // TransferStock transfers stock between warehouses
+// @Summary Transfer stock
+// @Description Transfers stock between warehouses
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.TransferStockRequest true "Transfer stock"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/transfer [post]
 func (h *InventoryHandler) TransferStock(c *gin.Context) {
 	var req usecases.TransferStockRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -229,6 +298,16 @@ func (h *InventoryHandler) TransferStock(c *gin.Context) {
 }
 
 // GetMovements gets inventory movements
+// @Summary Get movements
+// @Description Gets inventory movements
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} usecases.MovementsListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/movements [get]
 func (h *InventoryHandler) GetMovements(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -254,6 +333,17 @@ func (h *InventoryHandler) GetMovements(c *gin.Context) {
 }
 
 // RecordMovement records an inventory movement
+// @Summary Record movement
+// @Description Records an inventory movement
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.RecordMovementRequest true "Record movement"
+// @Success 201 {object} usecases.MovementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/movements [post]
 func (h *InventoryHandler) RecordMovement(c *gin.Context) {
 	var req usecases.RecordMovementRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -280,6 +370,18 @@ func (h *InventoryHandler) RecordMovement(c *gin.Context) {
 }
 
 // GetStockAlerts gets stock alerts
+// @Summary Get stock alerts
+// @Description Gets stock alerts
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param type query string false "Type"
+// @Param status query string false "Status"
+// @Success 200 {object} usecases.AlertsListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/alerts [get]
 func (h *InventoryHandler) GetStockAlerts(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -309,6 +411,16 @@ func (h *InventoryHandler) GetStockAlerts(c *gin.Context) {
 }
 
 // ResolveAlert resolves a stock alert
+// @Summary Resolve alert
+// @Description Resolves a stock alert
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/alerts/{id}/resolve [put]
 func (h *InventoryHandler) ResolveAlert(c *gin.Context) {
 	alertIDStr := c.Param("id")
 	alertID, err := uuid.Parse(alertIDStr)
@@ -331,10 +443,9 @@ func (h *InventoryHandler) ResolveAlert(c *gin.Context) {
 		return
 	}
 
-	// For now, use a system user ID - in real implementation, get from auth context
-	systemUserID := uuid.New()
+	resolvedBy := entities.ActorFromContext(c.Request.Context()).ID()
 
-	err = h.inventoryUseCase.ResolveAlert(c.Request.Context(), alertID, req.Resolution, systemUserID)
+	err = h.inventoryUseCase.ResolveAlert(c.Request.Context(), alertID, req.Resolution, resolvedBy)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "Failed to resolve alert",
@@ -349,6 +460,16 @@ func (h *InventoryHandler) ResolveAlert(c *gin.Context) {
 }
 
 // GetOutOfStockItems gets out of stock items
+// @Summary Get out of stock items
+// @Description Gets out of stock items
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param warehouse_id query string false "Warehouse id"
+// @Success 200 {array} usecases.InventoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/out-of-stock [get]
 func (h *InventoryHandler) GetOutOfStockItems(c *gin.Context) {
 	warehouseIDStr := c.Query("warehouse_id")
 	var warehouseID *uuid.UUID
@@ -379,3 +500,167 @@ func (h *InventoryHandler) GetOutOfStockItems(c *gin.Context) {
 		Data:    items,
 	})
 }
+
+// CaptureInventorySnapshot captures today's inventory snapshot
+// @Summary Capture inventory snapshot
+// @Description Snapshots current stock levels for today, replacing any snapshot already taken today. Snapshots are also taken automatically every hour.
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.InventorySnapshotResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/inventory/snapshots [post]
+func (h *InventoryHandler) CaptureInventorySnapshot(c *gin.Context) {
+	snapshot, err := h.inventoryUseCase.CaptureInventorySnapshot(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Inventory snapshot captured successfully",
+		Data:    snapshot,
+	})
+}
+
+// GetStockAsOf gets stock levels as of a past date
+// @Summary Get stock as of a date
+// @Description Gets stock levels and value from the latest inventory snapshot on or before the given date
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param date query string true "Date (YYYY-MM-DD)"
+// @Param warehouse_id query string false "Warehouse ID"
+// @Param product_id query string false "Product ID"
+// @Param page query int false "Page" default(1)
+// @Param limit query int false "Limit" default(20)
+// @Success 200 {object} usecases.StockAsOfResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/inventory/snapshots/stock [get]
+func (h *InventoryHandler) GetStockAsOf(c *gin.Context) {
+	date, ok := parseSnapshotDate(c, "date")
+	if !ok {
+		return
+	}
+	warehouseID, productID, ok := parseSnapshotFilters(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.inventoryUseCase.GetStockAsOf(c.Request.Context(), usecases.GetStockAsOfRequest{
+		Date:        date,
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Stock levels retrieved successfully",
+		Data:    response,
+	})
+}
+
+// GetStockDeltas gets stock changes between two dates
+// @Summary Get stock deltas between dates
+// @Description Compares the inventory snapshots as of two dates per product and warehouse, largest quantity changes first
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Param warehouse_id query string false "Warehouse ID"
+// @Param product_id query string false "Product ID"
+// @Param changed_only query bool false "Only include stock that changed" default(false)
+// @Param page query int false "Page" default(1)
+// @Param limit query int false "Limit" default(20)
+// @Success 200 {object} usecases.StockDeltasResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/inventory/snapshots/deltas [get]
+func (h *InventoryHandler) GetStockDeltas(c *gin.Context) {
+	from, ok := parseSnapshotDate(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseSnapshotDate(c, "to")
+	if !ok {
+		return
+	}
+	warehouseID, productID, ok := parseSnapshotFilters(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	changedOnly, _ := strconv.ParseBool(c.DefaultQuery("changed_only", "false"))
+
+	response, err := h.inventoryUseCase.GetStockDeltas(c.Request.Context(), usecases.GetStockDeltasRequest{
+		From:        from,
+		To:          to,
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+		ChangedOnly: changedOnly,
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Stock deltas retrieved successfully",
+		Data:    response,
+	})
+}
+
+func parseSnapshotDate(c *gin.Context, name string) (time.Time, bool) {
+	date, err := time.Parse("2006-01-02", c.Query(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid " + name + " date, expected YYYY-MM-DD",
+		})
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+func parseSnapshotFilters(c *gin.Context) (warehouseID, productID *uuid.UUID, ok bool) {
+	if value := c.Query("warehouse_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid warehouse ID",
+			})
+			return nil, nil, false
+		}
+		warehouseID = &id
+	}
+	if value := c.Query("product_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID",
+			})
+			return nil, nil, false
+		}
+		productID = &id
+	}
+	return warehouseID, productID, true
+}
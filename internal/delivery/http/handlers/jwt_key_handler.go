@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jwksCacheControl lets services cache the JWKS for less than the key activation delay, so
+// they fetch a new key before it signs tokens
+const jwksCacheControl = "public, max-age=300"
+
+// JWTKeyHandler handles the keys access tokens are signed with
+type JWTKeyHandler struct {
+	jwtKeyUseCase usecases.JWTKeyUseCase
+}
+
+// NewJWTKeyHandler creates a new JWT key handler
+func NewJWTKeyHandler(jwtKeyUseCase usecases.JWTKeyUseCase) *JWTKeyHandler {
+	return &JWTKeyHandler{
+		jwtKeyUseCase: jwtKeyUseCase,
+	}
+}
+
+// GetJWKS handles publishing the public signing keys
+// @Summary Get the JSON Web Key Set
+// @Description Get the public keys access tokens are signed with, for services that verify tokens themselves. Match a token's kid header to a key. Keys that activate soon are listed before they sign tokens, and replaced keys stay listed for the grace period. HS256 keys are never published.
+// @Tags jwt-keys
+// @Produce json
+// @Success 200 {object} jwtkeys.JSONWebKeySet
+// @Router /.well-known/jwks.json [get]
+func (h *JWTKeyHandler) GetJWKS(c *gin.Context) {
+	writeCacheableJSON(c, jwksCacheControl, h.jwtKeyUseCase.JWKS(c.Request.Context()))
+}
+
+// ListKeys handles listing the signing keys
+// @Summary List JWT signing keys
+// @Description List the keys that still verify tokens, oldest first, with when each activates and expires and which one signs new tokens. The legacy key is the static JWT secret.
+// @Tags jwt-keys
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]jwtkeys.KeyInfo}
+// @Router /admin/jwt-keys [get]
+func (h *JWTKeyHandler) ListKeys(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "JWT signing keys retrieved successfully",
+		Data:    h.jwtKeyUseCase.ListKeys(c.Request.Context()),
+	})
+}
+
+// RotateKeys handles rotating the signing keys ahead of schedule
+// @Summary Rotate JWT signing keys
+// @Description Create a new signing key right away, e.g. when a key may have leaked. The new key signs tokens after the activation delay; tokens signed by the previous key stay valid for the grace period.
+// @Tags jwt-keys
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]jwtkeys.KeyInfo}
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/jwt-keys/rotate [post]
+func (h *JWTKeyHandler) RotateKeys(c *gin.Context) {
+	keys, err := h.jwtKeyUseCase.RotateKeys(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "JWT signing keys rotated successfully",
+		Data:    keys,
+	})
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MessageTemplateHandler handles checking emails and notifications before they are sent
+type MessageTemplateHandler struct {
+	messageTemplateUseCase usecases.MessageTemplateUseCase
+}
+
+// NewMessageTemplateHandler creates a new message template handler
+func NewMessageTemplateHandler(messageTemplateUseCase usecases.MessageTemplateUseCase) *MessageTemplateHandler {
+	return &MessageTemplateHandler{
+		messageTemplateUseCase: messageTemplateUseCase,
+	}
+}
+
+// PreviewMessage handles previewing a message
+// @Summary Preview message
+// @Description Render an email or notification, or a stored email template, for a sample user and order as it would be sent. Placeholders are written {{first_name}} or {{.FirstName}}; names match regardless of case and underscores. Variables with no value are left as written and listed in missing, with a warning for each and for placeholders that are not a variable, such as {{if .paid}}. sendable is false when anything would go out unfilled.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.PreviewMessageRequest true "Message to preview"
+// @Success 200 {object} usecases.PreviewMessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/messages/preview [post]
+func (h *MessageTemplateHandler) PreviewMessage(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.PreviewMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	preview, err := h.messageTemplateUseCase.PreviewMessage(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: preview,
+	})
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsHandler exposes operational metrics for external provider circuit breakers
+type MetricsHandler struct {
+	breakers *resilience.Registry
+}
+
+// NewMetricsHandler creates a new metrics handler
+func NewMetricsHandler(breakers *resilience.Registry) *MetricsHandler {
+	return &MetricsHandler{
+		breakers: breakers,
+	}
+}
+
+// GetMetrics handles Prometheus scraping
+// @Summary Prometheus metrics
+// @Description Circuit breaker state and call counters per external provider, in the Prometheus text format
+// @Tags metrics
+// @Produce plain
+// @Success 200 {string} string "Prometheus text exposition"
+// @Router /metrics [get]
+func (h *MetricsHandler) GetMetrics(c *gin.Context) {
+	var buf bytes.Buffer
+	if err := h.breakers.WritePrometheus(&buf); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to render metrics",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", buf.Bytes())
+}
+
+// GetCircuitBreakers handles listing circuit breaker states
+// @Summary Get circuit breakers
+// @Description State, limits and counters of the circuit breaker guarding each external provider
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} resilience.Snapshot
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/system/circuit-breakers [get]
+func (h *MetricsHandler) GetCircuitBreakers(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Circuit breakers retrieved successfully",
+		Data:    h.breakers.Snapshots(),
+	})
+}
@@ -101,6 +101,18 @@ func (h *NotificationHandler) GetNotification(c *gin.Context) {
 }
 
 // GetUserNotifications gets notifications for current user
+// @Summary Get user notifications
+// @Description Gets notifications for current user
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications [get]
 func (h *NotificationHandler) GetUserNotifications(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -144,6 +156,17 @@ func (h *NotificationHandler) GetUserNotifications(c *gin.Context) {
 }
 
 // MarkAsRead marks a notification as read
+// @Summary Mark as read
+// @Description Marks a notification as read
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/{id}/read [put]
 func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -180,6 +203,15 @@ func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
 }
 
 // MarkAllAsRead marks all notifications as read for user
+// @Summary Mark all as read
+// @Description Marks all notifications as read for user
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/read-all [put]
 func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -206,6 +238,15 @@ func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
 }
 
 // GetUnreadCount gets unread notification count
+// @Summary Get unread count
+// @Description Gets unread notification count
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/count [get]
 func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -283,6 +324,15 @@ func (h *NotificationHandler) GetTemplates(c *gin.Context) {
 }
 
 // GetUserPreferences gets user notification preferences
+// @Summary Get user preferences
+// @Description Gets user notification preferences
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.PreferencesResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/preferences [get]
 func (h *NotificationHandler) GetUserPreferences(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -309,6 +359,16 @@ func (h *NotificationHandler) GetUserPreferences(c *gin.Context) {
 }
 
 // UpdateUserPreferences updates user notification preferences
+// @Summary Update user preferences
+// @Description Updates user notification preferences
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.PreferencesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/preferences [put]
 func (h *NotificationHandler) UpdateUserPreferences(c *gin.Context) {
 	userID, err := h.getUserID(c)
 	if err != nil {
@@ -12,17 +12,26 @@ import (
 
 // OAuthHandler handles OAuth-related HTTP requests
 type OAuthHandler struct {
-	oauthUseCase usecases.OAuthUseCase
+	oauthUseCase     usecases.OAuthUseCase
+	analyticsUseCase usecases.AnalyticsUseCase
 }
 
 // NewOAuthHandler creates a new OAuth handler
-func NewOAuthHandler(oauthUseCase usecases.OAuthUseCase) *OAuthHandler {
+func NewOAuthHandler(oauthUseCase usecases.OAuthUseCase, analyticsUseCase usecases.AnalyticsUseCase) *OAuthHandler {
 	return &OAuthHandler{
-		oauthUseCase: oauthUseCase,
+		oauthUseCase:     oauthUseCase,
+		analyticsUseCase: analyticsUseCase,
 	}
 }
 
 // GetGoogleAuthURL generates Google OAuth URL
+// @Summary Get google auth URL
+// @Description Generates Google OAuth URL
+// @Tags auth
+// @Produce json
+// @Success 200 {object} usecases.OAuthURLResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/google/url [get]
 func (h *OAuthHandler) GetGoogleAuthURL(c *gin.Context) {
 	response, err := h.oauthUseCase.GetGoogleAuthURL(c.Request.Context())
 	if err != nil {
@@ -40,6 +49,13 @@ func (h *OAuthHandler) GetGoogleAuthURL(c *gin.Context) {
 }
 
 // GetFacebookAuthURL generates Facebook OAuth URL
+// @Summary Get facebook auth URL
+// @Description Generates Facebook OAuth URL
+// @Tags auth
+// @Produce json
+// @Success 200 {object} usecases.OAuthURLResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/facebook/url [get]
 func (h *OAuthHandler) GetFacebookAuthURL(c *gin.Context) {
 	response, err := h.oauthUseCase.GetFacebookAuthURL(c.Request.Context())
 	if err != nil {
@@ -57,6 +73,15 @@ func (h *OAuthHandler) GetFacebookAuthURL(c *gin.Context) {
 }
 
 // GoogleCallback handles Google OAuth callback
+// @Summary Google callback
+// @Tags auth
+// @Produce json
+// @Param code query string false "Code"
+// @Param state query string false "State"
+// @Param error query string false "Error"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/google/callback [get]
 func (h *OAuthHandler) GoogleCallback(c *gin.Context) {
 	code := c.Query("code")
 	state := c.Query("state")
@@ -106,6 +131,9 @@ func (h *OAuthHandler) GoogleCallback(c *gin.Context) {
 	}
 
 	fmt.Printf("✅ Google OAuth callback successful, redirecting with token\n")
+	if response.User != nil {
+		stitchVisitorSession(c, h.analyticsUseCase, response.User.ID)
+	}
 	// Always redirect to frontend callback page with success token
 	// Use URL fragment to pass token (more secure than query params)
 	frontendURL := "http://localhost:3000/auth/google/callback"
@@ -114,6 +142,14 @@ func (h *OAuthHandler) GoogleCallback(c *gin.Context) {
 }
 
 // FacebookCallback handles Facebook OAuth callback
+// @Summary Facebook callback
+// @Tags auth
+// @Produce json
+// @Param code query string false "Code"
+// @Param state query string false "State"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/facebook/callback [get]
 func (h *OAuthHandler) FacebookCallback(c *gin.Context) {
 	code := c.Query("code")
 	state := c.Query("state")
@@ -144,6 +180,9 @@ func (h *OAuthHandler) FacebookCallback(c *gin.Context) {
 		c.Redirect(http.StatusTemporaryRedirect, frontendURL)
 		return
 	}
+	if response.User != nil {
+		stitchVisitorSession(c, h.analyticsUseCase, response.User.ID)
+	}
 
 	// Always redirect to frontend callback page with success token
 	// Use URL fragment to pass token (more secure than query params)
@@ -153,6 +192,13 @@ func (h *OAuthHandler) FacebookCallback(c *gin.Context) {
 }
 
 // GoogleLogin initiates Google OAuth flow (alternative endpoint)
+// @Summary Google login
+// @Description Initiates Google OAuth flow (alternative endpoint)
+// @Tags auth
+// @Produce json
+// @Success 200 {object} SuccessResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/google/login [get]
 func (h *OAuthHandler) GoogleLogin(c *gin.Context) {
 	response, err := h.oauthUseCase.GetGoogleAuthURL(c.Request.Context())
 	if err != nil {
@@ -168,6 +214,13 @@ func (h *OAuthHandler) GoogleLogin(c *gin.Context) {
 }
 
 // FacebookLogin initiates Facebook OAuth flow (alternative endpoint)
+// @Summary Facebook login
+// @Description Initiates Facebook OAuth flow (alternative endpoint)
+// @Tags auth
+// @Produce json
+// @Success 200 {object} SuccessResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/facebook/login [get]
 func (h *OAuthHandler) FacebookLogin(c *gin.Context) {
 	response, err := h.oauthUseCase.GetFacebookAuthURL(c.Request.Context())
 	if err != nil {
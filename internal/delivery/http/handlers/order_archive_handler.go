@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// OrderArchiveHandler handles archiving old orders and restoring them for customer service
+type OrderArchiveHandler struct {
+	orderArchiveUseCase usecases.OrderArchiveUseCase
+}
+
+// NewOrderArchiveHandler creates a new order archive handler
+func NewOrderArchiveHandler(orderArchiveUseCase usecases.OrderArchiveUseCase) *OrderArchiveHandler {
+	return &OrderArchiveHandler{
+		orderArchiveUseCase: orderArchiveUseCase,
+	}
+}
+
+// GetStatus handles getting the archive age and what the archive holds
+// @Summary Get order archive status
+// @Description Get the age at which orders are archived and the number of archived orders, items and events
+// @Tags order-archive
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.OrderArchiveStatusResponse
+// @Router /admin/order-archive/status [get]
+func (h *OrderArchiveHandler) GetStatus(c *gin.Context) {
+	status, err := h.orderArchiveUseCase.GetArchiveStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: status,
+	})
+}
+
+// ArchiveOldOrders handles manually triggering the order archive job
+// @Summary Archive old orders now
+// @Description Move the items and events of delivered, cancelled, refunded, returned and exchanged orders past the archive age to the archive. The orders stay listed, flagged archived.
+// @Tags order-archive
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/order-archive/run [post]
+func (h *OrderArchiveHandler) ArchiveOldOrders(c *gin.Context) {
+	archived, err := h.orderArchiveUseCase.ArchiveOldOrders(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to archive orders",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Old orders archived successfully",
+		Data: gin.H{
+			"archived": archived,
+		},
+	})
+}
+
+// GetArchivedOrder handles getting an order's archived items and events
+// @Summary Get archived order rows
+// @Description Get the items and events of an archived order without restoring it
+// @Tags order-archive
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} entities.OrderArchive
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/order-archive/orders/{id} [get]
+func (h *OrderArchiveHandler) GetArchivedOrder(c *gin.Context) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid order ID",
+		})
+		return
+	}
+
+	archive, err := h.orderArchiveUseCase.GetArchivedOrder(c.Request.Context(), orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: archive,
+	})
+}
+
+// RestoreOrder handles moving an archived order back to the live tables
+// @Summary Restore archived order
+// @Description Move an archived order's items and events back, so it can be viewed and changed like any other order
+// @Tags order-archive
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/order-archive/orders/{id}/restore [post]
+func (h *OrderArchiveHandler) RestoreOrder(c *gin.Context) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid order ID",
+		})
+		return
+	}
+
+	if err := h.orderArchiveUseCase.RestoreOrder(c.Request.Context(), orderID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Order restored successfully",
+	})
+}
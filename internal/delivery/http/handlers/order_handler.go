@@ -473,6 +473,17 @@ func (h *OrderHandler) GetOrderBySessionID(c *gin.Context) {
 }
 
 // UpdateShippingInfo handles updating shipping information for an order
+// @Summary Update shipping info
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.UpdateShippingInfoRequest true "Update shipping info"
+// @Success 200 {object} usecases.OrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/orders/{id}/shipping [put]
 func (h *OrderHandler) UpdateShippingInfo(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -509,6 +520,15 @@ func (h *OrderHandler) UpdateShippingInfo(c *gin.Context) {
 }
 
 // UpdateDeliveryStatus handles updating delivery status for an order
+// @Summary Update delivery status
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.OrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/orders/{id}/delivery [put]
 func (h *OrderHandler) UpdateDeliveryStatus(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -547,6 +567,18 @@ func (h *OrderHandler) UpdateDeliveryStatus(c *gin.Context) {
 }
 
 // AddOrderNote handles adding a note to an order
+// @Summary Add order note
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.AddOrderNoteRequest true "Add order note"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /orders/{id}/notes [post]
+// @Router /admin/orders/{id}/notes [post]
 func (h *OrderHandler) AddOrderNote(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -581,6 +613,17 @@ func (h *OrderHandler) AddOrderNote(c *gin.Context) {
 }
 
 // GetOrderEvents handles getting order events/timeline
+// @Summary Get order events
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param public query bool false "Public"
+// @Success 200 {array} entities.OrderEvent
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /orders/{id}/events [get]
+// @Router /admin/orders/{id}/events [get]
 func (h *OrderHandler) GetOrderEvents(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -629,6 +672,11 @@ func validateCreateOrderRequest(req *usecases.CreateOrderRequest) error {
 		return fmt.Errorf("discount amount cannot be negative, got: %.2f", req.DiscountAmount)
 	}
 
+	// Saved or default addresses are resolved and validated by the use case
+	if req.ShippingAddressID != nil || req.ShippingAddress == (usecases.AddressRequest{}) {
+		return nil
+	}
+
 	// Validate shipping address (required)
 	if req.ShippingAddress.FirstName == "" {
 		return fmt.Errorf("shipping address first name is required")
@@ -648,3 +696,146 @@ func validateCreateOrderRequest(req *usecases.CreateOrderRequest) error {
 
 	return nil
 }
+
+// DownloadMyInvoice handles downloading the invoice for one of the current user's orders
+// @Summary Download order invoice
+// @Description Download an order's invoice as a PDF. Gift orders are invoiced to the buyer; the recipient gets a gift receipt instead.
+// @Tags orders
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /orders/{id}/invoice [get]
+func (h *OrderHandler) DownloadMyInvoice(c *gin.Context) {
+	userID, orderID, ok := parseUserAndOrderID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.orderUseCase.GetMyOrderInvoicePDF(c.Request.Context(), userID, orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// DownloadMyGiftReceipt handles downloading the gift receipt for one of the current user's gift orders
+// @Summary Download gift receipt
+// @Description Download a gift order's gift receipt as a PDF. It lists the items and the gift message without prices.
+// @Tags orders
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /orders/{id}/gift-receipt [get]
+func (h *OrderHandler) DownloadMyGiftReceipt(c *gin.Context) {
+	userID, orderID, ok := parseUserAndOrderID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.orderUseCase.GetMyGiftReceiptPDF(c.Request.Context(), userID, orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// DownloadPackingSlip handles downloading an order's packing slip for admins
+// @Summary Download packing slip
+// @Description Download the slip packed with an order as a PDF. Slips for gift orders leave out prices and carry the gift message.
+// @Tags admin
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/orders/{id}/packing-slip [get]
+func (h *OrderHandler) DownloadPackingSlip(c *gin.Context) {
+	orderID, ok := parseOrderID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.orderUseCase.GetPackingSlipPDF(c.Request.Context(), orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// DownloadGiftReceipt handles downloading a gift order's gift receipt for admins
+// @Summary Download gift receipt (admin)
+// @Description Download a gift order's gift receipt as a PDF, to pack with the order
+// @Tags admin
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/orders/{id}/gift-receipt [get]
+func (h *OrderHandler) DownloadGiftReceipt(c *gin.Context) {
+	orderID, ok := parseOrderID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.orderUseCase.GetGiftReceiptPDF(c.Request.Context(), orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+func parseUserAndOrderID(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	orderID, ok := parseOrderID(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return *userID, orderID, true
+}
+
+func parseOrderID(c *gin.Context) (uuid.UUID, bool) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid order ID",
+		})
+		return uuid.Nil, false
+	}
+	return orderID, true
+}
@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// OrderMessageHandler handles the message thread between customers and the store on an order
+type OrderMessageHandler struct {
+	orderMessageUseCase usecases.OrderMessageUseCase
+}
+
+// NewOrderMessageHandler creates a new order message handler
+func NewOrderMessageHandler(orderMessageUseCase usecases.OrderMessageUseCase) *OrderMessageHandler {
+	return &OrderMessageHandler{
+		orderMessageUseCase: orderMessageUseCase,
+	}
+}
+
+// GetMessages handles listing the messages on one of the customer's orders
+// @Summary Get order messages
+// @Description Gets the message thread with the store on one of the current user's orders. unread_count counts store messages the customer has not read.
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} usecases.OrderMessagesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /orders/{id}/messages [get]
+func (h *OrderMessageHandler) GetMessages(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	messages, err := h.orderMessageUseCase.GetCustomerMessages(c.Request.Context(), *userID, orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Messages retrieved successfully",
+		Data:    messages,
+	})
+}
+
+// SendMessage handles a customer sending a message to the store about their order
+// @Summary Send order message
+// @Description Sends a message to the store about one of the current user's orders. Attachments are files uploaded beforehand through the upload endpoints. The store is notified by email.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body usecases.SendOrderMessageRequest true "Message"
+// @Success 201 {object} usecases.OrderMessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /orders/{id}/messages [post]
+func (h *OrderMessageHandler) SendMessage(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SendOrderMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	message, err := h.orderMessageUseCase.SendCustomerMessage(c.Request.Context(), *userID, orderID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Message sent successfully",
+		Data:    message,
+	})
+}
+
+// MarkMessagesRead handles a customer marking the store's messages as read
+// @Summary Mark order messages as read
+// @Description Marks the store's messages on one of the current user's orders as read
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /orders/{id}/messages/read [post]
+func (h *OrderMessageHandler) MarkMessagesRead(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.orderMessageUseCase.MarkReadByCustomer(c.Request.Context(), *userID, orderID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Messages marked as read",
+	})
+}
+
+// AdminGetMessages handles listing the messages on an order for the store
+// @Summary Get order messages (admin)
+// @Description Gets the message thread with the customer on an order. unread_count counts customer messages the store has not read.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} usecases.OrderMessagesResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/orders/{id}/messages [get]
+func (h *OrderMessageHandler) AdminGetMessages(c *gin.Context) {
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	messages, err := h.orderMessageUseCase.GetOrderMessages(c.Request.Context(), orderID, entities.OrderMessageSenderStore)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Messages retrieved successfully",
+		Data:    messages,
+	})
+}
+
+// AdminSendMessage handles the store replying to the customer on an order
+// @Summary Send order message (admin)
+// @Description Sends a message from the store to the customer on an order. The customer is notified by email.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body usecases.SendOrderMessageRequest true "Message"
+// @Success 201 {object} usecases.OrderMessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/orders/{id}/messages [post]
+func (h *OrderMessageHandler) AdminSendMessage(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SendOrderMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	message, err := h.orderMessageUseCase.SendStoreMessage(c.Request.Context(), *adminID, orderID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Message sent successfully",
+		Data:    message,
+	})
+}
+
+// AdminMarkMessagesRead handles the store marking the customer's messages as read
+// @Summary Mark order messages as read (admin)
+// @Description Marks the customer's messages on an order as read by the store
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/orders/{id}/messages/read [post]
+func (h *OrderMessageHandler) AdminMarkMessagesRead(c *gin.Context) {
+	orderID, ok := parseOrderMessageOrderID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.orderMessageUseCase.MarkReadByStore(c.Request.Context(), orderID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Messages marked as read",
+	})
+}
+
+func parseOrderMessageOrderID(c *gin.Context) (uuid.UUID, bool) {
+	orderID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid order ID",
+		})
+		return uuid.Nil, false
+	}
+	return orderID, true
+}
@@ -0,0 +1,458 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/delivery/http/middleware"
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PartnerFeedHandler handles the price and availability feed of price-comparison and affiliate
+// partners, and their management by admins
+type PartnerFeedHandler struct {
+	partnerFeedUseCase usecases.PartnerFeedUseCase
+}
+
+// NewPartnerFeedHandler creates a new partner feed handler
+func NewPartnerFeedHandler(partnerFeedUseCase usecases.PartnerFeedUseCase) *PartnerFeedHandler {
+	return &PartnerFeedHandler{
+		partnerFeedUseCase: partnerFeedUseCase,
+	}
+}
+
+// AuthenticateAPIKey authenticates partner API keys for middleware.APIKeyMiddleware
+func (h *PartnerFeedHandler) AuthenticateAPIKey(ctx context.Context, key string, scope entities.APIKeyScope) (*entities.APIKey, *entities.Partner, error) {
+	return h.partnerFeedUseCase.AuthenticateAPIKey(ctx, key, scope)
+}
+
+// RatePlan returns the limits of an API key plan for middleware.APIKeyMiddleware
+func (h *PartnerFeedHandler) RatePlan(plan entities.APIKeyPlan) entities.APIRatePlan {
+	return h.partnerFeedUseCase.RatePlan(plan)
+}
+
+// RecordAPIKeyRequest meters partner API key requests for middleware.APIKeyMiddleware
+func (h *PartnerFeedHandler) RecordAPIKeyRequest(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKeyQuota, error) {
+	return h.partnerFeedUseCase.RecordAPIKeyRequest(ctx, apiKey)
+}
+
+// GetFeedChanges handles a partner reading the feed
+// @Summary Get price and availability changes
+// @Description Get the price and availability changes after a cursor, oldest first, each product's changes merged into its latest values. Only what changed is sent, not full products. Start with the cursor 0 or the last one kept, and pass the returned cursor as after to read on while has_more is set. Authenticated with a partner API key in X-API-Key and limited to the requests per minute and daily quota of the key's plan; 429 responses carry when the limit resets in reset_at and Retry-After.
+// @Tags partner-feed
+// @Produce json
+// @Param X-API-Key header string true "Partner API key"
+// @Param after query int false "Cursor to read after" default(0)
+// @Param limit query int false "Most changes to read, up to 1000; the partner's batch size by default"
+// @Success 200 {object} usecases.PartnerFeedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /partner/feed/changes [get]
+func (h *PartnerFeedHandler) GetFeedChanges(c *gin.Context) {
+	partner := c.MustGet(middleware.PartnerContextKey).(*entities.Partner)
+
+	after, err := strconv.ParseInt(c.DefaultQuery("after", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid after cursor",
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid limit",
+		})
+		return
+	}
+
+	feed, err := h.partnerFeedUseCase.GetFeedChanges(c.Request.Context(), partner, after, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: feed,
+	})
+}
+
+// GetAPIKeyUsage handles a partner checking its API key's usage
+// @Summary Get API key usage
+// @Description Get the plan, limits and what is left of the daily quota of the API key in X-API-Key, with its requests on each of the last days. Quotas are per UTC day and reset at midnight UTC. Checking usage counts as a request; once the quota is used up it answers 429 with the same limit and reset_at.
+// @Tags partner-feed
+// @Produce json
+// @Param X-API-Key header string true "Partner API key"
+// @Param days query int false "Days of usage, today included, up to 366" default(30)
+// @Success 200 {object} usecases.APIKeyUsageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /partner/usage [get]
+func (h *PartnerFeedHandler) GetAPIKeyUsage(c *gin.Context) {
+	apiKey := c.MustGet(middleware.APIKeyContextKey).(*entities.APIKey)
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid days",
+		})
+		return
+	}
+
+	usage, err := h.partnerFeedUseCase.GetAPIKeyUsage(c.Request.Context(), apiKey, days)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: usage,
+	})
+}
+
+// ListPartners handles listing partners
+// @Summary List partners
+// @Description List price-comparison and affiliate partners by name, with their API keys and webhook delivery state
+// @Tags partner-feed
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} usecases.PartnerResponse
+// @Router /admin/partners [get]
+func (h *PartnerFeedHandler) ListPartners(c *gin.Context) {
+	partners, err := h.partnerFeedUseCase.ListPartners(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: partners,
+	})
+}
+
+// CreatePartner handles adding a partner
+// @Summary Create partner
+// @Description Add a partner. With a webhook URL, the price and availability changes made from now on are posted to it in batches of at most batch_size, no more often than every min_interval_seconds, signed with the webhook secret returned here once.
+// @Tags partner-feed
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreatePartnerRequest true "Partner"
+// @Success 201 {object} usecases.PartnerResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/partners [post]
+func (h *PartnerFeedHandler) CreatePartner(c *gin.Context) {
+	var req usecases.CreatePartnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	partner, err := h.partnerFeedUseCase.CreatePartner(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Partner created successfully",
+		Data:    partner,
+	})
+}
+
+// GetPartner handles getting a partner
+// @Summary Get partner
+// @Description Get a partner with its API keys and webhook delivery state
+// @Tags partner-feed
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Success 200 {object} usecases.PartnerResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id} [get]
+func (h *PartnerFeedHandler) GetPartner(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+
+	partner, err := h.partnerFeedUseCase.GetPartner(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: partner,
+	})
+}
+
+// UpdatePartner handles changing a partner
+// @Summary Update partner
+// @Description Change a partner's details, webhook and rate controls, pause or resume it, or rotate its webhook secret. A new secret is returned once. Resuming a partner or changing its webhook clears its failures and delivers right away.
+// @Tags partner-feed
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Param request body usecases.UpdatePartnerRequest true "Changes"
+// @Success 200 {object} usecases.PartnerResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id} [put]
+func (h *PartnerFeedHandler) UpdatePartner(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdatePartnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	partner, err := h.partnerFeedUseCase.UpdatePartner(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Partner updated successfully",
+		Data:    partner,
+	})
+}
+
+// DeletePartner handles deleting a partner
+// @Summary Delete partner
+// @Description Delete a partner; its API keys are revoked and its webhook stops
+// @Tags partner-feed
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id} [delete]
+func (h *PartnerFeedHandler) DeletePartner(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.partnerFeedUseCase.DeletePartner(c.Request.Context(), id); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Partner deleted successfully",
+	})
+}
+
+// CreatePartnerAPIKey handles issuing a partner an API key
+// @Summary Create partner API key
+// @Description Issue a partner an API key scoped to the feed, on the free, partner or internal plan (partner by default). The key is returned once; only its prefix is kept to tell keys apart.
+// @Tags partner-feed
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Param request body usecases.CreatePartnerAPIKeyRequest true "API key"
+// @Success 201 {object} usecases.CreatedAPIKeyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id}/api-keys [post]
+func (h *PartnerFeedHandler) CreatePartnerAPIKey(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.CreatePartnerAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	key, err := h.partnerFeedUseCase.CreatePartnerAPIKey(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "API key created successfully",
+		Data:    key,
+	})
+}
+
+// UpdatePartnerAPIKey handles renaming a partner's API key or changing its plan
+// @Summary Update partner API key
+// @Description Rename one of a partner's API keys or move it to the free, partner or internal plan. The new plan's limits apply from the key's next request, counting what it already used that day.
+// @Tags partner-feed
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Param keyId path string true "API key ID"
+// @Param request body usecases.UpdatePartnerAPIKeyRequest true "Changes"
+// @Success 200 {object} entities.APIKey
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id}/api-keys/{keyId} [put]
+func (h *PartnerFeedHandler) UpdatePartnerAPIKey(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+	keyID, err := uuid.Parse(c.Param("keyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid API key ID",
+		})
+		return
+	}
+
+	var req usecases.UpdatePartnerAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	key, err := h.partnerFeedUseCase.UpdatePartnerAPIKey(c.Request.Context(), id, keyID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "API key updated successfully",
+		Data:    key,
+	})
+}
+
+// RevokePartnerAPIKey handles revoking a partner's API key
+// @Summary Revoke partner API key
+// @Description Revoke one of a partner's API keys; requests with it are refused at once
+// @Tags partner-feed
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Param keyId path string true "API key ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id}/api-keys/{keyId} [delete]
+func (h *PartnerFeedHandler) RevokePartnerAPIKey(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+	keyID, err := uuid.Parse(c.Param("keyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid API key ID",
+		})
+		return
+	}
+
+	if err := h.partnerFeedUseCase.RevokePartnerAPIKey(c.Request.Context(), id, keyID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "API key revoked successfully",
+	})
+}
+
+// GetPartnerAPIUsage handles getting the usage of a partner's API keys
+// @Summary Get partner API usage
+// @Description Get the requests each of a partner's API keys, revoked ones included, was served and refused over its daily quota on each UTC day of a range, with totals, for billing and reporting. Requests refused by the per-minute limit aren't counted.
+// @Tags partner-feed
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Partner ID"
+// @Param from query string false "First day (YYYY-MM-DD); 30 days before to by default"
+// @Param to query string false "Last day (YYYY-MM-DD); today by default"
+// @Success 200 {object} usecases.PartnerAPIUsageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/partners/{id}/usage [get]
+func (h *PartnerFeedHandler) GetPartnerAPIUsage(c *gin.Context) {
+	id, ok := parsePartnerID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.GetAPIUsageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	usage, err := h.partnerFeedUseCase.GetPartnerAPIUsage(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: usage,
+	})
+}
+
+func parsePartnerID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid partner ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
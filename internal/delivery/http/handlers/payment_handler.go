@@ -9,6 +9,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/usecases"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -27,6 +28,18 @@ func NewPaymentHandler(paymentUseCase usecases.PaymentUseCase) *PaymentHandler {
 }
 
 // ProcessPayment processes a payment
+// @Summary Process payment
+// @Description Processes a payment
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.ProcessPaymentRequest true "Process payment"
+// @Success 200 {object} usecases.PaymentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /payments [post]
 func (h *PaymentHandler) ProcessPayment(c *gin.Context) {
 	// Check authentication
 	userIDInterface, exists := c.Get("user_id")
@@ -80,6 +93,16 @@ func (h *PaymentHandler) ProcessPayment(c *gin.Context) {
 }
 
 // UpdatePaymentStatus updates payment status
+// @Summary Update payment status
+// @Description Updates payment status
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.PaymentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/{id}/status [put]
 func (h *PaymentHandler) UpdatePaymentStatus(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -136,6 +159,16 @@ func (h *PaymentHandler) UpdatePaymentStatus(c *gin.Context) {
 }
 
 // GetPayment retrieves a payment by ID
+// @Summary Get payment
+// @Description Retrieves a payment by ID
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.PaymentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /payments/{id} [get]
 func (h *PaymentHandler) GetPayment(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -163,6 +196,16 @@ func (h *PaymentHandler) GetPayment(c *gin.Context) {
 }
 
 // GetOrderPayments retrieves all payments for an order
+// @Summary Get order payments
+// @Description Retrieves all payments for an order
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {array} usecases.PaymentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /orders/{id}/payments [get]
 func (h *PaymentHandler) GetOrderPayments(c *gin.Context) {
 	orderIDStr := c.Param("id")
 	orderID, err := uuid.Parse(orderIDStr)
@@ -190,6 +233,19 @@ func (h *PaymentHandler) GetOrderPayments(c *gin.Context) {
 }
 
 // ProcessRefund processes a refund
+// @Summary Process refund
+// @Description Processes a refund
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.ProcessRefundRequest true "Process refund"
+// @Success 200 {object} usecases.RefundResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/{id}/refund [post]
+// @Router /payments/refunds [post]
 func (h *PaymentHandler) ProcessRefund(c *gin.Context) {
 	var req usecases.ProcessRefundRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -216,6 +272,16 @@ func (h *PaymentHandler) ProcessRefund(c *gin.Context) {
 }
 
 // GetRefunds retrieves refunds for a payment
+// @Summary Get refunds
+// @Description Retrieves refunds for a payment
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param payment_id path string true "Payment ID"
+// @Success 200 {array} usecases.RefundResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/refunds/{payment_id} [get]
 func (h *PaymentHandler) GetRefunds(c *gin.Context) {
 	paymentIDStr := c.Param("payment_id")
 	paymentID, err := uuid.Parse(paymentIDStr)
@@ -243,6 +309,17 @@ func (h *PaymentHandler) GetRefunds(c *gin.Context) {
 }
 
 // ApproveRefund approves a pending refund
+// @Summary Approve refund
+// @Description Approves a pending refund
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param refund_id path string true "Refund ID"
+// @Success 200 {object} usecases.RefundResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/refunds/{refund_id}/approve [put]
 func (h *PaymentHandler) ApproveRefund(c *gin.Context) {
 	refundIDStr := c.Param("refund_id")
 	refundID, err := uuid.Parse(refundIDStr)
@@ -287,6 +364,16 @@ func (h *PaymentHandler) ApproveRefund(c *gin.Context) {
 }
 
 // RejectRefund rejects a pending refund
+// @Summary Reject refund
+// @Description Rejects a pending refund
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param refund_id path string true "Refund ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/refunds/{refund_id}/reject [put]
 func (h *PaymentHandler) RejectRefund(c *gin.Context) {
 	refundIDStr := c.Param("refund_id")
 	refundID, err := uuid.Parse(refundIDStr)
@@ -324,6 +411,16 @@ func (h *PaymentHandler) RejectRefund(c *gin.Context) {
 }
 
 // GetPendingRefunds retrieves refunds awaiting approval
+// @Summary Get pending refunds
+// @Description Retrieves refunds awaiting approval
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {array} usecases.RefundResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/refunds/pending [get]
 func (h *PaymentHandler) GetPendingRefunds(c *gin.Context) {
 	limit := 20
 	offset := 0
@@ -356,6 +453,18 @@ func (h *PaymentHandler) GetPendingRefunds(c *gin.Context) {
 }
 
 // SavePaymentMethod saves a user's payment method
+// @Summary Save payment method
+// @Description Saves a user's payment method
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.SavePaymentMethodRequest true "Save payment method"
+// @Success 200 {object} usecases.PaymentMethodResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/methods [post]
 func (h *PaymentHandler) SavePaymentMethod(c *gin.Context) {
 	// Get user ID from JWT token context
 	userID, exists := c.Get("user_id")
@@ -404,6 +513,15 @@ func (h *PaymentHandler) SavePaymentMethod(c *gin.Context) {
 }
 
 // GetUserPaymentMethods retrieves user's payment methods
+// @Summary Get user payment methods
+// @Description Retrieves user's payment methods
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} usecases.PaymentMethodResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/methods [get]
 func (h *PaymentHandler) GetUserPaymentMethods(c *gin.Context) {
 	// Get user ID from JWT token context
 	userID, exists := c.Get("user_id")
@@ -440,6 +558,16 @@ func (h *PaymentHandler) GetUserPaymentMethods(c *gin.Context) {
 }
 
 // DeletePaymentMethod deletes a payment method
+// @Summary Delete payment method
+// @Description Deletes a payment method
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/methods/{id} [delete]
 func (h *PaymentHandler) DeletePaymentMethod(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -465,6 +593,17 @@ func (h *PaymentHandler) DeletePaymentMethod(c *gin.Context) {
 }
 
 // SetDefaultPaymentMethod sets a payment method as default
+// @Summary Set default payment method
+// @Description Sets a payment method as default
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param method_id path string true "Method ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/methods/{method_id}/default [put]
 func (h *PaymentHandler) SetDefaultPaymentMethod(c *gin.Context) {
 	// Get user ID from JWT token context
 	userID, exists := c.Get("user_id")
@@ -509,6 +648,15 @@ func (h *PaymentHandler) SetDefaultPaymentMethod(c *gin.Context) {
 }
 
 // HandleWebhook handles payment webhooks
+// @Summary Handle webhook
+// @Tags payments
+// @Produce json
+// @Param provider path string true "ProvIDer"
+// @Param Stripe-Signature header string false "Stripe-Signature"
+// @Param PAYPAL-TRANSMISSION-SIG header string false "PAYPAL-TRANSMISSION-SIG"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /webhooks/payment/{provider} [post]
 func (h *PaymentHandler) HandleWebhook(c *gin.Context) {
 	provider := c.Param("provider")
 
@@ -652,6 +800,18 @@ func (h *PaymentHandler) GetPaymentReports(c *gin.Context) {
 }
 
 // CreateCheckoutSession creates a Stripe checkout session for hosted payment page
+// @Summary Create checkout session
+// @Description Creates a Stripe checkout session for hosted payment page
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateCheckoutSessionRequest true "Create checkout session"
+// @Success 200 {object} usecases.CreateCheckoutSessionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /payments/checkout-session [post]
 func (h *PaymentHandler) CreateCheckoutSession(c *gin.Context) {
 	var req usecases.CreateCheckoutSessionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -665,7 +825,11 @@ func (h *PaymentHandler) CreateCheckoutSession(c *gin.Context) {
 	// Create checkout session
 	response, err := h.paymentUseCase.CreateCheckoutSession(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		statusCode := http.StatusInternalServerError
+		if appErr := pkgErrors.GetAppError(err); appErr != nil {
+			statusCode = appErr.StatusCode
+		}
+		c.JSON(statusCode, ErrorResponse{
 			Error:   "Failed to create checkout session",
 			Details: err.Error(),
 		})
@@ -686,6 +850,14 @@ func (h *PaymentHandler) CreateCheckoutSession(c *gin.Context) {
 }
 
 // ConfirmPaymentSuccess confirms payment success for an order (fallback method)
+// @Summary Confirm payment success
+// @Description Confirms payment success for an order (fallback method)
+// @Tags payments
+// @Produce json
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/confirm-success [post]
 func (h *PaymentHandler) ConfirmPaymentSuccess(c *gin.Context) {
 	var req struct {
 		SessionID string `json:"session_id" binding:"required"`
@@ -739,6 +911,15 @@ func (h *PaymentHandler) ConfirmPaymentSuccess(c *gin.Context) {
 }
 
 // TestWebhook manually triggers webhook processing for development
+// @Summary Test webhook
+// @Description Manually triggers webhook processing for development
+// @Tags payments
+// @Produce json
+// @Param session_id path string true "Session ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /payments/test-webhook/{session_id} [post]
 func (h *PaymentHandler) TestWebhook(c *gin.Context) {
 	sessionID := c.Param("session_id")
 
@@ -853,6 +1034,11 @@ func getPaymentErrorStatusCode(err error) int {
 		return http.StatusOK
 	}
 
+	// Structured errors carry their own status, e.g. 503 when the provider's circuit is open
+	if appErr := pkgErrors.GetAppError(err); appErr != nil {
+		return appErr.StatusCode
+	}
+
 	errorMsg := strings.ToLower(err.Error())
 
 	// Authentication/Authorization errors
@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PaymentLinkHandler handles creating payment links and paying them
+type PaymentLinkHandler struct {
+	paymentLinkUseCase usecases.PaymentLinkUseCase
+}
+
+// NewPaymentLinkHandler creates a new payment link handler
+func NewPaymentLinkHandler(paymentLinkUseCase usecases.PaymentLinkUseCase) *PaymentLinkHandler {
+	return &PaymentLinkHandler{
+		paymentLinkUseCase: paymentLinkUseCase,
+	}
+}
+
+// CreatePaymentLink handles creating a payment link
+// @Summary Create payment link
+// @Description Create a single-use payment link for a pending order, a quote that was converted to an order, or an arbitrary amount. Set send to email or text the link to the customer right away.
+// @Tags payment-links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreatePaymentLinkRequest true "What the link pays and who it is sent to"
+// @Success 201 {object} usecases.PaymentLinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/payment-links [post]
+func (h *PaymentLinkHandler) CreatePaymentLink(c *gin.Context) {
+	var req usecases.CreatePaymentLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	link, err := h.paymentLinkUseCase.CreatePaymentLink(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to create payment link",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Payment link created successfully",
+		Data:    link,
+	})
+}
+
+// GetPaymentLinks handles listing payment links
+// @Summary Get payment links
+// @Description List payment links, newest first
+// @Tags payment-links
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Link status (active, paid, expired, cancelled)"
+// @Param order_id query string false "Order ID"
+// @Param quote_id query string false "Quote ID"
+// @Param limit query int false "Number of links to return" default(20)
+// @Param offset query int false "Number of links to skip" default(0)
+// @Success 200 {object} usecases.PaymentLinksListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/payment-links [get]
+func (h *PaymentLinkHandler) GetPaymentLinks(c *gin.Context) {
+	var req usecases.GetPaymentLinksRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	links, err := h.paymentLinkUseCase.GetPaymentLinks(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: links,
+	})
+}
+
+// GetPaymentLink handles getting a payment link
+// @Summary Get payment link
+// @Description Get a payment link with its URL
+// @Tags payment-links
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Payment link ID"
+// @Success 200 {object} usecases.PaymentLinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/payment-links/{id} [get]
+func (h *PaymentLinkHandler) GetPaymentLink(c *gin.Context) {
+	linkID, ok := parsePaymentLinkID(c)
+	if !ok {
+		return
+	}
+
+	link, err := h.paymentLinkUseCase.GetPaymentLink(c.Request.Context(), linkID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: link,
+	})
+}
+
+// SendPaymentLink handles sending a payment link to the customer
+// @Summary Send payment link
+// @Description Email and/or text a payment link that can still be paid to the customer. An email address or phone number in the body replaces the one stored on the link.
+// @Tags payment-links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Payment link ID"
+// @Param request body usecases.SendPaymentLinkRequest false "Recipients"
+// @Success 200 {object} usecases.PaymentLinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Router /admin/payment-links/{id}/send [post]
+func (h *PaymentLinkHandler) SendPaymentLink(c *gin.Context) {
+	linkID, ok := parsePaymentLinkID(c)
+	if !ok {
+		return
+	}
+
+	// Recipients are optional, so an empty body is allowed
+	var req usecases.SendPaymentLinkRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request body",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	link, err := h.paymentLinkUseCase.SendPaymentLink(c.Request.Context(), linkID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Payment link sent successfully",
+		Data:    link,
+	})
+}
+
+// CancelPaymentLink handles cancelling a payment link
+// @Summary Cancel payment link
+// @Description Cancel a payment link that has not been paid, so it can no longer be opened
+// @Tags payment-links
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Payment link ID"
+// @Success 200 {object} usecases.PaymentLinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/payment-links/{id}/cancel [post]
+func (h *PaymentLinkHandler) CancelPaymentLink(c *gin.Context) {
+	linkID, ok := parsePaymentLinkID(c)
+	if !ok {
+		return
+	}
+
+	link, err := h.paymentLinkUseCase.CancelPaymentLink(c.Request.Context(), linkID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Payment link cancelled successfully",
+		Data:    link,
+	})
+}
+
+// GetPublicPaymentLink handles showing a payment link to the customer
+// @Summary Get payment link by token
+// @Description Get the amount, description and status of a payment link. No authentication is required; the token is the link's secret.
+// @Tags payment-links
+// @Produce json
+// @Param token path string true "Payment link token"
+// @Success 200 {object} usecases.PublicPaymentLinkResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /pay/{token} [get]
+func (h *PaymentLinkHandler) GetPublicPaymentLink(c *gin.Context) {
+	link, err := h.paymentLinkUseCase.GetPublicPaymentLink(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: link,
+	})
+}
+
+// StartPaymentLinkCheckout handles opening the hosted checkout page for a payment link
+// @Summary Pay payment link
+// @Description Open a Stripe checkout session for a payment link and return its URL. While a session opened from the link is still open, the same URL is returned.
+// @Tags payment-links
+// @Produce json
+// @Param token path string true "Payment link token"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /pay/{token}/checkout [post]
+func (h *PaymentLinkHandler) StartPaymentLinkCheckout(c *gin.Context) {
+	checkoutURL, err := h.paymentLinkUseCase.StartCheckout(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: gin.H{"checkout_url": checkoutURL},
+	})
+}
+
+func parsePaymentLinkID(c *gin.Context) (uuid.UUID, bool) {
+	linkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid payment link ID",
+		})
+		return uuid.Nil, false
+	}
+	return linkID, true
+}
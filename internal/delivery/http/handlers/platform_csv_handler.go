@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxPlatformCSVImportSize caps the size of an uploaded product CSV
+const maxPlatformCSVImportSize = 20 << 20
+
+// PlatformCSVHandler handles CSV files in Shopify and WooCommerce layouts
+type PlatformCSVHandler struct {
+	platformCSVUseCase usecases.PlatformCSVUseCase
+}
+
+// NewPlatformCSVHandler creates a new platform CSV handler
+func NewPlatformCSVHandler(platformCSVUseCase usecases.PlatformCSVUseCase) *PlatformCSVHandler {
+	return &PlatformCSVHandler{
+		platformCSVUseCase: platformCSVUseCase,
+	}
+}
+
+// ExportProducts handles downloading the catalog as a platform CSV
+// @Summary Export products for another platform
+// @Description Downloads the catalog as a Shopify or WooCommerce product CSV, ready for that platform's product importer. Each product is exported as a single variant, with its primary category as the Shopify Type. Weights are in kg and dimensions in cm.
+// @Tags admin-platform-csv
+// @Produce text/csv
+// @Security BearerAuth
+// @Param platform query string true "Platform layout (shopify, woocommerce)"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/platform-csv/products/export [get]
+func (h *PlatformCSVHandler) ExportProducts(c *gin.Context) {
+	file, err := h.platformCSVUseCase.ExportProducts(c.Request.Context(), c.Query("platform"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(http.StatusOK, "text/csv", file.Data)
+}
+
+// ExportOrders handles downloading orders as a platform CSV
+// @Summary Export orders for another platform
+// @Description Downloads orders as a CSV in Shopify's order export layout, one line per item, or in the layout of WooCommerce's order export and import tools, one line per order with a line_item_N column per item. Amounts use the order currency's decimals.
+// @Tags admin-platform-csv
+// @Produce text/csv
+// @Security BearerAuth
+// @Param platform query string true "Platform layout (shopify, woocommerce)"
+// @Param status query string false "Order status"
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/platform-csv/orders/export [get]
+func (h *PlatformCSVHandler) ExportOrders(c *gin.Context) {
+	req := usecases.ExportPlatformOrdersRequest{
+		Platform: c.Query("platform"),
+	}
+	if status := c.Query("status"); status != "" {
+		orderStatus := entities.OrderStatus(status)
+		req.Status = &orderStatus
+	}
+	if value := c.Query("date_from"); value != "" {
+		dateFrom, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_from, expected YYYY-MM-DD",
+			})
+			return
+		}
+		req.DateFrom = &dateFrom
+	}
+	if value := c.Query("date_to"); value != "" {
+		dateTo, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_to, expected YYYY-MM-DD",
+			})
+			return
+		}
+		// Include the whole day
+		dateTo = dateTo.Add(24*time.Hour - time.Nanosecond)
+		req.DateTo = &dateTo
+	}
+
+	file, err := h.platformCSVUseCase.ExportOrders(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(http.StatusOK, "text/csv", file.Data)
+}
+
+// ImportProducts handles uploading a platform product CSV
+// @Summary Import products from another platform
+// @Description Imports a Shopify or WooCommerce product CSV. Products are matched by SKU; categories and brands by name. Products are imported with their first variant, and further variants and WooCommerce variations are reported rather than imported. Use dry_run to get the validation report of what would be created, updated, skipped or rejected without saving anything.
+// @Tags admin-platform-csv
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Product CSV"
+// @Param platform formData string true "Platform layout (shopify, woocommerce)"
+// @Param dry_run formData bool false "Validate and report without importing" default(false)
+// @Param update_existing formData bool false "Update products whose SKU already exists instead of skipping them" default(false)
+// @Param default_category_id formData string false "Category for products whose category is missing or not found"
+// @Success 200 {object} usecases.ImportPlatformProductsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/platform-csv/products/import [post]
+func (h *PlatformCSVHandler) ImportProducts(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "No file provided",
+		})
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxPlatformCSVImportSize {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Product file is too large",
+		})
+		return
+	}
+	if strings.ToLower(filepath.Ext(header.Filename)) != ".csv" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Product file must be .csv",
+		})
+		return
+	}
+
+	req := usecases.ImportPlatformProductsRequest{
+		Platform: c.PostForm("platform"),
+	}
+	req.DryRun, _ = strconv.ParseBool(c.PostForm("dry_run"))
+	req.UpdateExisting, _ = strconv.ParseBool(c.PostForm("update_existing"))
+	if value := c.PostForm("default_category_id"); value != "" {
+		categoryID, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid default category ID",
+			})
+			return
+		}
+		req.DefaultCategoryID = &categoryID
+	}
+
+	req.Data, err = io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to read product file",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.platformCSVUseCase.ImportProducts(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	message := "Products imported"
+	if req.DryRun {
+		message = "Product file validated; nothing was imported"
+	}
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: message,
+		Data:    result,
+	})
+}
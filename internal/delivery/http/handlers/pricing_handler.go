@@ -0,0 +1,471 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PricingHandler handles price scheduling and price history HTTP requests
+type PricingHandler struct {
+	pricingUseCase usecases.PricingUseCase
+}
+
+// NewPricingHandler creates a new pricing handler
+func NewPricingHandler(pricingUseCase usecases.PricingUseCase) *PricingHandler {
+	return &PricingHandler{
+		pricingUseCase: pricingUseCase,
+	}
+}
+
+// SchedulePriceChange handles scheduling a future price change
+// @Summary Schedule a price change
+// @Description Schedule a future price and/or sale window change for a product
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param request body usecases.SchedulePriceChangeRequest true "Schedule price change request"
+// @Success 201 {object} usecases.ScheduledPriceChangeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/products/{id}/price-schedules [post]
+func (h *PricingHandler) SchedulePriceChange(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID format",
+		})
+		return
+	}
+
+	var req usecases.SchedulePriceChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	req.CreatedBy = getUserIDFromContext(c)
+
+	change, err := h.pricingUseCase.SchedulePriceChange(c.Request.Context(), productID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Price change scheduled successfully",
+		Data:    change,
+	})
+}
+
+// GetProductScheduledPriceChanges handles listing scheduled price changes for a product
+// @Summary Get scheduled price changes for a product
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/products/{id}/price-schedules [get]
+func (h *PricingHandler) GetProductScheduledPriceChanges(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID format",
+		})
+		return
+	}
+
+	changes, err := h.pricingUseCase.GetProductScheduledPriceChanges(c.Request.Context(), productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Scheduled price changes retrieved successfully",
+		Data:    changes,
+	})
+}
+
+// ListScheduledPriceChanges handles listing scheduled price changes across products
+// @Summary List scheduled price changes
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status filter (pending, applied, cancelled, failed)"
+// @Param product_id query string false "Product ID filter"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/price-schedules [get]
+func (h *PricingHandler) ListScheduledPriceChanges(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListScheduledPriceChangesRequest{
+		Page:  page,
+		Limit: limit,
+	}
+
+	if status := c.Query("status"); status != "" {
+		s := entities.ScheduledPriceChangeStatus(status)
+		req.Status = &s
+	}
+
+	if productIDStr := c.Query("product_id"); productIDStr != "" {
+		productID, err := uuid.Parse(productIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID format",
+			})
+			return
+		}
+		req.ProductID = &productID
+	}
+
+	response, err := h.pricingUseCase.ListScheduledPriceChanges(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Changes,
+		Pagination: response.Pagination,
+	})
+}
+
+// CancelScheduledPriceChange handles cancelling a pending price change
+// @Summary Cancel a scheduled price change
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Scheduled price change ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/price-schedules/{id} [delete]
+func (h *PricingHandler) CancelScheduledPriceChange(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid scheduled price change ID format",
+		})
+		return
+	}
+
+	if err := h.pricingUseCase.CancelScheduledPriceChange(c.Request.Context(), id, getUserIDFromContext(c)); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Scheduled price change cancelled successfully",
+	})
+}
+
+// ApplyDuePriceChanges handles manually triggering the scheduled price job
+// @Summary Apply due price changes now
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/price-schedules/apply [post]
+func (h *PricingHandler) ApplyDuePriceChanges(c *gin.Context) {
+	applied, err := h.pricingUseCase.ApplyDuePriceChanges(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to apply scheduled price changes",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Scheduled price changes applied successfully",
+		Data: gin.H{
+			"applied": applied,
+		},
+	})
+}
+
+// RevertExpiredSales handles manually reverting products whose sale window has ended
+// @Summary Revert expired sales now
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/price-schedules/revert-expired-sales [post]
+func (h *PricingHandler) RevertExpiredSales(c *gin.Context) {
+	reverted, err := h.pricingUseCase.RevertExpiredSales(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to revert expired sales",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Expired sales reverted successfully",
+		Data: gin.H{
+			"reverted": reverted,
+		},
+	})
+}
+
+// GetPriceHistory handles getting the full price change log for a product
+// @Summary Get product price change log
+// @Description Get all price changes for a product including actor and reason
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/products/{id}/price-history [get]
+func (h *PricingHandler) GetPriceHistory(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID format",
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.pricingUseCase.GetPriceHistory(c.Request.Context(), productID, page, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.History,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetPriceClaim handles getting public "was/now" price data for a product
+// @Summary Get product price history for price claims
+// @Description Get the current price and the lowest price of the last 30 days (EU Omnibus "prior price")
+// @Tags pricing
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} usecases.PriceClaimResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/{id}/price-history [get]
+func (h *PricingHandler) GetPriceClaim(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID format",
+		})
+		return
+	}
+
+	claim, err := h.pricingUseCase.GetPriceClaim(c.Request.Context(), productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Price history retrieved successfully",
+		Data:    claim,
+	})
+}
+
+// PreviewBulkPriceUpdate handles a dry run of a rule-based price change
+// @Summary Preview a bulk price update
+// @Description List the products a price rule matches with their old and new prices, without saving anything
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkPriceUpdateRequest true "Bulk price update rule"
+// @Success 200 {object} usecases.BulkPriceUpdatePreviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/bulk-price-updates/preview [post]
+func (h *PricingHandler) PreviewBulkPriceUpdate(c *gin.Context) {
+	var req usecases.BulkPriceUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	preview, err := h.pricingUseCase.PreviewBulkPriceUpdate(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Bulk price update preview generated successfully",
+		Data:    preview,
+	})
+}
+
+// ApplyBulkPriceUpdate handles applying a rule-based price change
+// @Summary Apply a bulk price update
+// @Description Apply a price rule to all matching products in one transaction and log each change in price history
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.BulkPriceUpdateRequest true "Bulk price update rule"
+// @Success 201 {object} usecases.BulkPriceUpdateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/bulk-price-updates [post]
+func (h *PricingHandler) ApplyBulkPriceUpdate(c *gin.Context) {
+	var req usecases.BulkPriceUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	req.AppliedBy = getUserIDFromContext(c)
+
+	update, err := h.pricingUseCase.ApplyBulkPriceUpdate(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Bulk price update applied successfully",
+		Data:    update,
+	})
+}
+
+// ListBulkPriceUpdates handles listing applied bulk price updates
+// @Summary List bulk price updates
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/bulk-price-updates [get]
+func (h *PricingHandler) ListBulkPriceUpdates(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.pricingUseCase.ListBulkPriceUpdates(c.Request.Context(), page, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Updates,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetBulkPriceUpdate handles getting a bulk price update with its price changes
+// @Summary Get a bulk price update
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bulk price update ID"
+// @Success 200 {object} usecases.BulkPriceUpdateResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/bulk-price-updates/{id} [get]
+func (h *PricingHandler) GetBulkPriceUpdate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid bulk price update ID format",
+		})
+		return
+	}
+
+	update, err := h.pricingUseCase.GetBulkPriceUpdate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Bulk price update retrieved successfully",
+		Data:    update,
+	})
+}
+
+// RollbackBulkPriceUpdate handles restoring the prices replaced by a bulk price update
+// @Summary Roll back a bulk price update
+// @Description Restore the previous prices from the price history log. Products repriced since are skipped.
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bulk price update ID"
+// @Success 200 {object} usecases.BulkPriceUpdateResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/bulk-price-updates/{id}/rollback [post]
+func (h *PricingHandler) RollbackBulkPriceUpdate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid bulk price update ID format",
+		})
+		return
+	}
+
+	update, err := h.pricingUseCase.RollbackBulkPriceUpdate(c.Request.Context(), id, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Bulk price update rolled back successfully",
+		Data:    update,
+	})
+}
@@ -24,6 +24,42 @@ func NewProductFilterHandler(filterUseCase usecases.ProductFilterUseCase) *Produ
 }
 
 // FilterProducts handles advanced product filtering
+// @Summary Filter products
+// @Tags product-filters
+// @Produce json
+// @Param query query string false "Query"
+// @Param category_ids query string false "Category ids"
+// @Param brand_ids query string false "Brand ids"
+// @Param brand_id query string false "Brand id"
+// @Param tags query string false "Tags"
+// @Param product_types query string false "Product types"
+// @Param stock_status query string false "Stock status"
+// @Param visibility query string false "Visibility"
+// @Param min_price query number false "Min price"
+// @Param max_price query number false "Max price"
+// @Param min_rating query number false "Min rating"
+// @Param max_rating query number false "Max rating"
+// @Param in_stock query bool false "In stock"
+// @Param low_stock query bool false "Low stock"
+// @Param on_sale query bool false "On sale"
+// @Param featured query bool false "Featured"
+// @Param has_images query bool false "Has images"
+// @Param has_variants query bool false "Has variants"
+// @Param has_reviews query bool false "Has reviews"
+// @Param created_after query string false "Created after"
+// @Param created_before query string false "Created before"
+// @Param updated_after query string false "Updated after"
+// @Param updated_before query string false "Updated before"
+// @Param sort_by query string false "Sort by"
+// @Param sort_order query string false "Sort order"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param include_facets query bool false "Include facets"
+// @Param facet_limit query int false "Facet limit"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter [get]
 func (h *ProductFilterHandler) FilterProducts(c *gin.Context) {
 	var req usecases.AdvancedFilterRequest
 
@@ -160,6 +196,8 @@ func (h *ProductFilterHandler) FilterProducts(c *gin.Context) {
 		req.FacetLimit = 10
 	}
 
+	req.ViewerID = getUserIDFromContext(c)
+
 	// Execute filtering
 	result, err := h.filterUseCase.FilterProducts(c.Request.Context(), req)
 	if err != nil {
@@ -200,6 +238,17 @@ func (h *ProductFilterHandler) FilterProducts(c *gin.Context) {
 }
 
 // GetFilterFacets gets available filter facets
+// @Summary Get filter facets
+// @Description Gets available filter facets
+// @Tags product-filters
+// @Produce json
+// @Param category_ids query string false "Category ids"
+// @Param brand_ids query string false "Brand ids"
+// @Param tags query string false "Tags"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/facets [get]
 func (h *ProductFilterHandler) GetFilterFacets(c *gin.Context) {
 	var req usecases.AdvancedFilterRequest
 
@@ -230,6 +279,16 @@ func (h *ProductFilterHandler) GetFilterFacets(c *gin.Context) {
 }
 
 // GetDynamicFilters gets dynamic filters based on current state
+// @Summary Get dynamic filters
+// @Description Gets dynamic filters based on current state
+// @Tags product-filters
+// @Accept json
+// @Produce json
+// @Param request body usecases.AdvancedFilterRequest true "Advanced filter"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filters/dynamic [post]
 func (h *ProductFilterHandler) GetDynamicFilters(c *gin.Context) {
 	var req usecases.AdvancedFilterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -254,6 +313,17 @@ func (h *ProductFilterHandler) GetDynamicFilters(c *gin.Context) {
 }
 
 // SaveFilterSet saves a filter set
+// @Summary Save filter set
+// @Description Saves a filter set
+// @Tags product-filters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.FilterSetRequest true "Filter set"
+// @Success 201 {object} usecases.FilterSetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter-sets [post]
 func (h *ProductFilterHandler) SaveFilterSet(c *gin.Context) {
 	var req usecases.FilterSetRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -281,6 +351,15 @@ func (h *ProductFilterHandler) SaveFilterSet(c *gin.Context) {
 }
 
 // GetFilterSet gets a filter set by ID
+// @Summary Get filter set
+// @Description Gets a filter set by ID
+// @Tags product-filters
+// @Produce json
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.FilterSetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/filter-sets/{id} [get]
 func (h *ProductFilterHandler) GetFilterSet(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -306,6 +385,15 @@ func (h *ProductFilterHandler) GetFilterSet(c *gin.Context) {
 }
 
 // GetUserFilterSets gets filter sets for current user
+// @Summary Get user filter sets
+// @Description Gets filter sets for current user
+// @Tags product-filters
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} usecases.FilterSetResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter-sets/user [get]
 func (h *ProductFilterHandler) GetUserFilterSets(c *gin.Context) {
 	userID := getUserIDFromContext(c)
 	if userID == nil {
@@ -330,6 +418,13 @@ func (h *ProductFilterHandler) GetUserFilterSets(c *gin.Context) {
 }
 
 // GetSessionFilterSets gets filter sets for current session
+// @Summary Get session filter sets
+// @Description Gets filter sets for current session
+// @Tags product-filters
+// @Produce json
+// @Success 200 {array} usecases.FilterSetResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter-sets/session [get]
 func (h *ProductFilterHandler) GetSessionFilterSets(c *gin.Context) {
 	sessionID := getSessionIDFromContext(c)
 
@@ -348,6 +443,18 @@ func (h *ProductFilterHandler) GetSessionFilterSets(c *gin.Context) {
 }
 
 // UpdateFilterSet updates a filter set
+// @Summary Update filter set
+// @Description Updates a filter set
+// @Tags product-filters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.FilterSetRequest true "Filter set"
+// @Success 200 {object} usecases.FilterSetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter-sets/{id} [put]
 func (h *ProductFilterHandler) UpdateFilterSet(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -381,6 +488,16 @@ func (h *ProductFilterHandler) UpdateFilterSet(c *gin.Context) {
 }
 
 // DeleteFilterSet deletes a filter set
+// @Summary Delete filter set
+// @Description Deletes a filter set
+// @Tags product-filters
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filter-sets/{id} [delete]
 func (h *ProductFilterHandler) DeleteFilterSet(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -404,6 +521,15 @@ func (h *ProductFilterHandler) DeleteFilterSet(c *gin.Context) {
 }
 
 // GetFilterAnalytics gets filter analytics
+// @Summary Get filter analytics
+// @Description Gets filter analytics
+// @Tags product-filters
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Days"
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/filters [get]
 func (h *ProductFilterHandler) GetFilterAnalytics(c *gin.Context) {
 	days := 30
 	if daysStr := c.Query("days"); daysStr != "" {
@@ -427,6 +553,15 @@ func (h *ProductFilterHandler) GetFilterAnalytics(c *gin.Context) {
 }
 
 // GetPopularFilters gets popular filters
+// @Summary Get popular filters
+// @Description Gets popular filters
+// @Tags product-filters
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Limit"
+// @Success 200 {array} entities.FilterUsage
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/analytics/filters/popular [get]
 func (h *ProductFilterHandler) GetPopularFilters(c *gin.Context) {
 	limit := 10
 	if limitStr := c.Query("limit"); limitStr != "" {
@@ -450,6 +585,16 @@ func (h *ProductFilterHandler) GetPopularFilters(c *gin.Context) {
 }
 
 // GetFilterSuggestions gets filter suggestions
+// @Summary Get filter suggestions
+// @Description Gets filter suggestions
+// @Tags product-filters
+// @Produce json
+// @Param q query string false "Q"
+// @Param limit query int false "Limit"
+// @Success 200 {array} string
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filters/suggestions [get]
 func (h *ProductFilterHandler) GetFilterSuggestions(c *gin.Context) {
 	query := c.Query("q")
 	if query == "" {
@@ -481,6 +626,16 @@ func (h *ProductFilterHandler) GetFilterSuggestions(c *gin.Context) {
 }
 
 // GetRelatedFilters gets related filters
+// @Summary Get related filters
+// @Description Gets related filters
+// @Tags product-filters
+// @Accept json
+// @Produce json
+// @Param request body usecases.AdvancedFilterRequest true "Advanced filter"
+// @Success 200 {array} string
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/filters/related [post]
 func (h *ProductFilterHandler) GetRelatedFilters(c *gin.Context) {
 	var req usecases.AdvancedFilterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -505,6 +660,14 @@ func (h *ProductFilterHandler) GetRelatedFilters(c *gin.Context) {
 }
 
 // GetAttributeFilters gets attribute filters
+// @Summary Get attribute filters
+// @Description Gets attribute filters
+// @Tags product-filters
+// @Produce json
+// @Param category_id query string false "Category id"
+// @Success 200 {array} entities.ProductAttribute
+// @Failure 500 {object} ErrorResponse
+// @Router /products/attributes [get]
 func (h *ProductFilterHandler) GetAttributeFilters(c *gin.Context) {
 	categoryID := c.Query("category_id")
 	var categoryPtr *string
@@ -527,6 +690,16 @@ func (h *ProductFilterHandler) GetAttributeFilters(c *gin.Context) {
 }
 
 // GetAttributeTerms gets attribute terms
+// @Summary Get attribute terms
+// @Description Gets attribute terms
+// @Tags product-filters
+// @Produce json
+// @Param attribute_id path string true "Attribute ID"
+// @Param category_id query string false "Category id"
+// @Success 200 {array} entities.ProductAttributeTerm
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/attributes/{attribute_id}/terms [get]
 func (h *ProductFilterHandler) GetAttributeTerms(c *gin.Context) {
 	attributeIDStr := c.Param("attribute_id")
 	attributeID, err := uuid.Parse(attributeIDStr)
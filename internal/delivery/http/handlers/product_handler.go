@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"ecom-golang-clean-architecture/internal/usecases"
 
@@ -12,6 +13,13 @@ import (
 	"github.com/google/uuid"
 )
 
+// Cache lifetimes of price and availability responses
+const (
+	priceAvailabilityMaxAge               = 30 * time.Second
+	priceAvailabilityStaleWhileRevalidate = 5 * time.Minute
+	priceAvailabilityStaleIfError         = 24 * time.Hour
+)
+
 // ProductHandler handles product-related HTTP requests
 type ProductHandler struct {
 	productUseCase usecases.ProductUseCase
@@ -63,11 +71,13 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 
 // GetProduct handles getting a product by ID
 // @Summary Get product by ID
-// @Description Get a single product by its ID
+// @Description Get a single product by its ID. The name, descriptions and SEO fields are served in the requested locale where translated, falling back to the source content.
 // @Tags products
 // @Accept json
 // @Produce json
 // @Param id path string true "Product ID"
+// @Param locale query string false "Content locale, e.g. vi; defaults to the Accept-Language header"
+// @Param preview_token query string false "Catalog changeset preview token; shows the product with the changeset's unpublished edits applied"
 // @Success 200 {object} usecases.ProductResponse
 // @Failure 404 {object} ErrorResponse
 // @Router /products/{id} [get]
@@ -80,7 +90,7 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := h.productUseCase.GetProduct(c.Request.Context(), productID)
+	product, err := h.productUseCase.GetProduct(c.Request.Context(), productID, getUserIDFromContext(c), getRequestLocale(c), c.Query("preview_token"))
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error: err.Error(),
@@ -101,9 +111,19 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(12)
-// @Success 200 {object} PaginatedResponse
+// @Param personalize query bool false "Rank results by the signed-in customer's preferences" default(true)
+// @Param locale query string false "Content locale, e.g. vi; defaults to the Accept-Language header"
+// @Param preview_token query string false "Catalog changeset preview token; shows the products with the changeset's unpublished edits applied"
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
+// @Success 200 {object} PersonalizedPaginatedResponse
 // @Router /products [get]
 func (h *ProductHandler) GetProducts(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0")) // 0 means use default
@@ -121,8 +141,12 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 	offset := (page - 1) * limit
 
 	req := usecases.GetProductsRequest{
-		Limit:  limit,
-		Offset: offset,
+		Limit:        limit,
+		Offset:       offset,
+		ViewerID:     getUserIDFromContext(c),
+		Personalize:  c.DefaultQuery("personalize", "true") != "false",
+		Locale:       getRequestLocale(c),
+		PreviewToken: c.Query("preview_token"),
 	}
 
 	response, err := h.productUseCase.GetProducts(c.Request.Context(), req)
@@ -133,9 +157,10 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
-		Pagination: response.Pagination,
+	c.JSON(http.StatusOK, PersonalizedPaginatedResponse{
+		Data:         fields.apply(response.Products),
+		Pagination:   response.Pagination,
+		Personalized: response.Personalized,
 	})
 }
 
@@ -154,9 +179,17 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 // @Param sort_order query string false "Sort order" default(desc)
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} PaginatedResponse
+// @Param personalize query bool false "Rank results by the signed-in customer's preferences when no sort_by is given" default(true)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
+// @Success 200 {object} PersonalizedPaginatedResponse
 // @Router /products/search [get]
 func (h *ProductHandler) SearchProducts(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0")) // 0 means use default
@@ -199,6 +232,11 @@ func (h *ProductHandler) SearchProducts(c *gin.Context) {
 		}
 	}
 
+	req.ViewerID = getUserIDFromContext(c)
+
+	// An explicit sort always wins over personalized ranking
+	req.Personalize = c.Query("sort_by") == "" && c.DefaultQuery("personalize", "true") != "false"
+
 	// Use the new paginated search method
 	response, err := h.productUseCase.SearchProductsPaginated(c.Request.Context(), req)
 	if err != nil {
@@ -208,9 +246,10 @@ func (h *ProductHandler) SearchProducts(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
-		Pagination: response.Pagination,
+	c.JSON(http.StatusOK, PersonalizedPaginatedResponse{
+		Data:         fields.apply(response.Products),
+		Pagination:   response.Pagination,
+		Personalized: response.Personalized,
 	})
 }
 
@@ -256,6 +295,11 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 		return
 	}
 
+	// Record who made the change for the price history log
+	if uid, ok := userID.(uuid.UUID); ok {
+		req.UpdatedBy = &uid
+	}
+
 	// Validate the request
 	if err := h.validateUpdateProductRequest(&req); err != nil {
 		fmt.Printf("UpdateProduct: Validation error: %v\n", err)
@@ -327,6 +371,11 @@ func (h *ProductHandler) PatchProduct(c *gin.Context) {
 		return
 	}
 
+	// Record who made the change for the price history log
+	if uid, ok := userID.(uuid.UUID); ok {
+		req.UpdatedBy = &uid
+	}
+
 	// Validate the request
 	if err := h.validatePatchProductRequest(&req); err != nil {
 		fmt.Printf("PatchProduct: Validation error: %v\n", err)
@@ -392,6 +441,160 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 	})
 }
 
+// ChangeProductLifecycle handles moving a product through its lifecycle
+// @Summary Change product lifecycle status
+// @Description Move a product between draft, active, discontinued and archived (admin only). Products with orders awaiting fulfillment cannot be archived.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param request body usecases.ChangeProductLifecycleRequest true "Lifecycle change"
+// @Success 200 {object} usecases.ProductResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/products/{id}/lifecycle [put]
+func (h *ProductHandler) ChangeProductLifecycle(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	var req usecases.ChangeProductLifecycleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	product, err := h.productUseCase.ChangeProductLifecycle(c.Request.Context(), productID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product lifecycle updated successfully",
+		Data:    product,
+	})
+}
+
+// GetProductAlternatives handles getting alternatives for a product
+// @Summary Get product alternatives
+// @Description Get active products to suggest instead of a product, typically one that is no longer available
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param limit query int false "Number of alternatives" default(4)
+// @Success 200 {array} usecases.ProductResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/{id}/alternatives [get]
+func (h *ProductHandler) GetProductAlternatives(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "4"))
+
+	alternatives, err := h.productUseCase.GetProductAlternatives(c.Request.Context(), productID, limit, getUserIDFromContext(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: alternatives,
+	})
+}
+
+// GetPriceAvailability handles getting the volatile data of a batch of products
+// @Summary Get price and availability for products
+// @Description Get only the price, sale price and availability of up to 100 products, for headless frontends that cache the full product content for long and refresh this data cheaply. Responses carry Cache-Control and ETag headers for edge caches; the cache lifetime never runs past the next sale start or end. Send the IDs in a stable order to share cache entries.
+// @Tags products
+// @Produce json
+// @Param ids query string true "Comma-separated product IDs, at most 100"
+// @Success 200 {object} usecases.PriceAvailabilityResponse
+// @Success 304 {string} string "Not modified since the ETag in If-None-Match"
+// @Failure 400 {object} ErrorResponse
+// @Router /products/price-availability [get]
+func (h *ProductHandler) GetPriceAvailability(c *gin.Context) {
+	value := c.Query("ids")
+	if value == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "ids parameter is required",
+		})
+		return
+	}
+
+	idStrings := strings.Split(value, ",")
+	if len(idStrings) > usecases.MaxPriceAvailabilityBatch {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: fmt.Sprintf("At most %d product IDs are allowed", usecases.MaxPriceAvailabilityBatch),
+		})
+		return
+	}
+
+	ids := make([]uuid.UUID, len(idStrings))
+	for i, idStr := range idStrings {
+		id, err := uuid.Parse(strings.TrimSpace(idStr))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID: " + idStr,
+			})
+			return
+		}
+		ids[i] = id
+	}
+
+	viewerID := getUserIDFromContext(c)
+	response, err := h.productUseCase.GetPriceAvailability(c.Request.Context(), ids, viewerID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	// Signed-in shoppers may see products hidden from guests, so only guest responses go to shared caches
+	maxAge := priceAvailabilityMaxAge
+	if response.NextChangeAt != nil {
+		if untilChange := time.Until(*response.NextChangeAt); untilChange < maxAge {
+			maxAge = untilChange
+		}
+		if maxAge < 0 {
+			maxAge = 0
+		}
+	}
+	seconds := int(maxAge.Seconds())
+	cacheControl := fmt.Sprintf("private, max-age=%d", seconds)
+	if viewerID == nil {
+		cacheControl = fmt.Sprintf("public, max-age=%d, s-maxage=%d, stale-while-revalidate=%d, stale-if-error=%d",
+			seconds, seconds, int(priceAvailabilityStaleWhileRevalidate.Seconds()), int(priceAvailabilityStaleIfError.Seconds()))
+	}
+
+	writeCacheableJSON(c, cacheControl, SuccessResponse{
+		Data: response,
+	})
+}
+
 // GetProductsByCategory handles getting products by category
 // @Summary Get products by category
 // @Description Get products belonging to a specific category
@@ -401,10 +604,17 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 // @Param categoryId path string true "Category ID"
 // @Param limit query int false "Limit" default(10)
 // @Param offset query int false "Offset" default(0)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
 // @Success 200 {array} usecases.ProductResponse
 // @Failure 400 {object} ErrorResponse
 // @Router /products/category/{categoryId} [get]
 func (h *ProductHandler) GetProductsByCategory(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	categoryID, err := uuid.Parse(c.Param("categoryId"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -429,7 +639,7 @@ func (h *ProductHandler) GetProductsByCategory(c *gin.Context) {
 	// Convert to offset for repository
 	offset := (page - 1) * limit
 
-	response, err := h.productUseCase.GetProductsByCategory(c.Request.Context(), categoryID, limit, offset)
+	response, err := h.productUseCase.GetProductsByCategory(c.Request.Context(), categoryID, limit, offset, getUserIDFromContext(c))
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error: err.Error(),
@@ -438,7 +648,7 @@ func (h *ProductHandler) GetProductsByCategory(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
+		Data:       fields.apply(response.Products),
 		Pagination: response.Pagination,
 	})
 }
@@ -686,9 +896,16 @@ func (h *ProductHandler) validatePatchProductRequest(req *usecases.PatchProductR
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of products per page" default(12)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
 // @Success 200 {object} PaginatedResponse
 // @Router /products/featured [get]
 func (h *ProductHandler) GetFeaturedProducts(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "0")) // 0 means use default
@@ -703,7 +920,7 @@ func (h *ProductHandler) GetFeaturedProducts(c *gin.Context) {
 	}
 
 	// Get featured products with pagination
-	response, err := h.productUseCase.GetFeaturedProductsPaginated(c.Request.Context(), page, limit)
+	response, err := h.productUseCase.GetFeaturedProductsPaginated(c.Request.Context(), page, limit, getUserIDFromContext(c))
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error: err.Error(),
@@ -712,7 +929,7 @@ func (h *ProductHandler) GetFeaturedProducts(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
+		Data:       fields.apply(response.Products),
 		Pagination: response.Pagination,
 	})
 }
@@ -725,9 +942,16 @@ func (h *ProductHandler) GetFeaturedProducts(c *gin.Context) {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of products per page" default(12)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
 // @Success 200 {object} PaginatedResponse
 // @Router /products/trending [get]
 func (h *ProductHandler) GetTrendingProducts(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	// Parse and validate pagination parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
@@ -742,7 +966,7 @@ func (h *ProductHandler) GetTrendingProducts(c *gin.Context) {
 	}
 
 	// Get trending products with pagination
-	response, err := h.productUseCase.GetTrendingProductsPaginated(c.Request.Context(), page, limit)
+	response, err := h.productUseCase.GetTrendingProductsPaginated(c.Request.Context(), page, limit, getUserIDFromContext(c))
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error: err.Error(),
@@ -751,7 +975,7 @@ func (h *ProductHandler) GetTrendingProducts(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
+		Data:       fields.apply(response.Products),
 		Pagination: response.Pagination,
 	})
 }
@@ -765,9 +989,16 @@ func (h *ProductHandler) GetTrendingProducts(c *gin.Context) {
 // @Param id path string true "Product ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Number of products per page" default(12)
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"
+// @Param include query string false "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"
 // @Success 200 {object} PaginatedResponse
 // @Router /products/{id}/related [get]
 func (h *ProductHandler) GetRelatedProducts(c *gin.Context) {
+	fields, ok := bindFieldSelection(c, productFields)
+	if !ok {
+		return
+	}
+
 	productID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{
@@ -790,7 +1021,7 @@ func (h *ProductHandler) GetRelatedProducts(c *gin.Context) {
 	}
 
 	// Get related products with pagination
-	response, err := h.productUseCase.GetRelatedProductsPaginated(c.Request.Context(), productID, page, limit)
+	response, err := h.productUseCase.GetRelatedProductsPaginated(c.Request.Context(), productID, page, limit, getUserIDFromContext(c))
 	if err != nil {
 		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error: err.Error(),
@@ -799,7 +1030,7 @@ func (h *ProductHandler) GetRelatedProducts(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, PaginatedResponse{
-		Data:       response.Products,
+		Data:       fields.apply(response.Products),
 		Pagination: response.Pagination,
 	})
 }
@@ -866,8 +1097,9 @@ func (h *ProductHandler) GetSearchSuggestions(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
 	req := usecases.SearchSuggestionsRequest{
-		Query: query,
-		Limit: limit,
+		Query:    query,
+		Limit:    limit,
+		ViewerID: getUserIDFromContext(c),
 	}
 
 	suggestions, err := h.productUseCase.GetSearchSuggestions(c.Request.Context(), req)
@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxTranslationImportSize caps the size of an uploaded translation file
+const maxTranslationImportSize = 10 << 20
+
+// ProductTranslationHandler handles per-locale product content and translation files
+type ProductTranslationHandler struct {
+	productTranslationUseCase usecases.ProductTranslationUseCase
+}
+
+// NewProductTranslationHandler creates a new product translation handler
+func NewProductTranslationHandler(productTranslationUseCase usecases.ProductTranslationUseCase) *ProductTranslationHandler {
+	return &ProductTranslationHandler{
+		productTranslationUseCase: productTranslationUseCase,
+	}
+}
+
+// GetProductTranslations handles getting a product's translations
+// @Summary Get product translations
+// @Description Gets a product's source content and its translation in every supported locale. Each locale's status is missing, outdated (the source changed after it was translated) or complete.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} usecases.ProductTranslationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/products/{id}/translations [get]
+func (h *ProductTranslationHandler) GetProductTranslations(c *gin.Context) {
+	productID, ok := parseTranslationProductID(c)
+	if !ok {
+		return
+	}
+
+	translations, err := h.productTranslationUseCase.GetProductTranslations(c.Request.Context(), productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product translations retrieved successfully",
+		Data:    translations,
+	})
+}
+
+// SaveProductTranslation handles saving a product's translation in a locale
+// @Summary Save product translation
+// @Description Creates or replaces a product's translation in a locale and marks it complete. Empty fields fall back to the source content.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param locale path string true "Locale, e.g. vi"
+// @Param request body usecases.SaveProductTranslationRequest true "Translated content"
+// @Success 200 {object} entities.ProductTranslation
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/products/{id}/translations/{locale} [put]
+func (h *ProductTranslationHandler) SaveProductTranslation(c *gin.Context) {
+	productID, ok := parseTranslationProductID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SaveProductTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	translation, err := h.productTranslationUseCase.SaveProductTranslation(c.Request.Context(), productID, c.Param("locale"), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product translation saved successfully",
+		Data:    translation,
+	})
+}
+
+// DeleteProductTranslation handles deleting a product's translation in a locale
+// @Summary Delete product translation
+// @Description Deletes a product's translation in a locale; the product is then served in the source content there
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param locale path string true "Locale, e.g. vi"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/products/{id}/translations/{locale} [delete]
+func (h *ProductTranslationHandler) DeleteProductTranslation(c *gin.Context) {
+	productID, ok := parseTranslationProductID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.productTranslationUseCase.DeleteProductTranslation(c.Request.Context(), productID, c.Param("locale")); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product translation deleted successfully",
+	})
+}
+
+// GetTranslationSummary handles getting translation progress across the catalog
+// @Summary Get product translation summary
+// @Description Counts complete, outdated and missing product translations in each supported locale
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.ProductTranslationSummaryResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/product-translations/summary [get]
+func (h *ProductTranslationHandler) GetTranslationSummary(c *gin.Context) {
+	summary, err := h.productTranslationUseCase.GetTranslationSummary(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product translation summary retrieved successfully",
+		Data:    summary,
+	})
+}
+
+// ExportTranslations handles downloading a translation file
+// @Summary Export product translations
+// @Description Downloads the catalog's source content and translations in a locale as CSV or XLIFF 1.2, for translators to fill in and import
+// @Tags admin
+// @Produce text/csv,application/x-xliff+xml
+// @Security BearerAuth
+// @Param locale query string true "Locale, e.g. vi"
+// @Param format query string false "File format (csv, xliff)" default(csv)
+// @Param status query string false "Comma-separated statuses to include (missing, outdated, complete); all by default"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/product-translations/export [get]
+func (h *ProductTranslationHandler) ExportTranslations(c *gin.Context) {
+	req := usecases.ExportProductTranslationsRequest{
+		Locale: c.Query("locale"),
+		Format: c.DefaultQuery("format", usecases.TranslationFileFormatCSV),
+	}
+	if value := c.Query("status"); value != "" {
+		for _, status := range strings.Split(value, ",") {
+			req.Statuses = append(req.Statuses, entities.ProductTranslationStatus(strings.TrimSpace(status)))
+		}
+	}
+
+	file, err := h.productTranslationUseCase.ExportTranslations(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
+// ImportTranslations handles uploading a translation file
+// @Summary Import product translations
+// @Description Imports a CSV or XLIFF file in the export layout. Non-empty translated fields replace the stored ones and each imported product's translation is marked complete. Rows that cannot be imported are reported in errors.
+// @Tags admin
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Translation file (.csv, .xlf or .xliff)"
+// @Param locale formData string false "Locale, e.g. vi; required for CSV, XLIFF files default to their target-language"
+// @Success 200 {object} usecases.ImportProductTranslationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/product-translations/import [post]
+func (h *ProductTranslationHandler) ImportTranslations(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "No file provided",
+		})
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxTranslationImportSize {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Translation file is too large",
+		})
+		return
+	}
+
+	var format string
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".csv":
+		format = usecases.TranslationFileFormatCSV
+	case ".xlf", ".xliff":
+		format = usecases.TranslationFileFormatXLIFF
+	default:
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Translation file must be .csv, .xlf or .xliff",
+		})
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Failed to read translation file",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.productTranslationUseCase.ImportTranslations(c.Request.Context(), usecases.ImportProductTranslationsRequest{
+		Locale: c.PostForm("locale"),
+		Format: format,
+		Data:   data,
+	})
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product translations imported",
+		Data:    result,
+	})
+}
+
+// getRequestLocale returns the locale the reader asked for: the locale query parameter, then the
+// storefront locale, otherwise the first language in the Accept-Language header
+func getRequestLocale(c *gin.Context) string {
+	if locale := c.Query("locale"); locale != "" {
+		return locale
+	}
+	if storefront := entities.StorefrontFromContext(c.Request.Context()); storefront.IsSet() {
+		return storefront.Locale
+	}
+	language, _, _ := strings.Cut(c.GetHeader("Accept-Language"), ",")
+	language, _, _ = strings.Cut(language, ";")
+	return strings.TrimSpace(language)
+}
+
+func parseTranslationProductID(c *gin.Context) (uuid.UUID, bool) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return uuid.Nil, false
+	}
+	return productID, true
+}
@@ -0,0 +1,403 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PurchasingHandler handles suppliers and the purchase orders stock is ordered on
+type PurchasingHandler struct {
+	purchasingUseCase usecases.PurchasingUseCase
+}
+
+// NewPurchasingHandler creates a new purchasing handler
+func NewPurchasingHandler(purchasingUseCase usecases.PurchasingUseCase) *PurchasingHandler {
+	return &PurchasingHandler{
+		purchasingUseCase: purchasingUseCase,
+	}
+}
+
+// CreateSupplier handles creating a supplier
+// @Summary Create a supplier
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateSupplierRequest true "Supplier"
+// @Success 201 {object} SuccessResponse{data=entities.Supplier}
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/suppliers [post]
+func (h *PurchasingHandler) CreateSupplier(c *gin.Context) {
+	var req usecases.CreateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	supplier, err := h.purchasingUseCase.CreateSupplier(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Supplier created successfully",
+		Data:    supplier,
+	})
+}
+
+// UpdateSupplier handles updating a supplier
+// @Summary Update a supplier
+// @Description Update a supplier's contact details, lead time or status. Changing the lead time moves the restock estimates of its open purchase orders that have no confirmed delivery date.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Supplier ID"
+// @Param request body usecases.UpdateSupplierRequest true "Supplier changes"
+// @Success 200 {object} SuccessResponse{data=entities.Supplier}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/suppliers/{id} [put]
+func (h *PurchasingHandler) UpdateSupplier(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid supplier ID",
+		})
+		return
+	}
+
+	var req usecases.UpdateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	supplier, err := h.purchasingUseCase.UpdateSupplier(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Supplier updated successfully",
+		Data:    supplier,
+	})
+}
+
+// ListSuppliers handles listing suppliers
+// @Summary List suppliers
+// @Tags purchasing
+// @Produce json
+// @Security BearerAuth
+// @Param active_only query bool false "Only list active suppliers"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/suppliers [get]
+func (h *PurchasingHandler) ListSuppliers(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	activeOnly, _ := strconv.ParseBool(c.DefaultQuery("active_only", "false"))
+
+	response, err := h.purchasingUseCase.ListSuppliers(c.Request.Context(), usecases.ListSuppliersRequest{
+		ActiveOnly: activeOnly,
+		Page:       page,
+		Limit:      limit,
+	})
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Suppliers,
+		Pagination: response.Pagination,
+	})
+}
+
+// CreatePurchaseOrder handles ordering stock from a supplier
+// @Summary Create a purchase order
+// @Description Order stock from a supplier. Products that are out of stock show the earliest expected delivery of their open purchase orders as a restock estimate; the supplier's lead time applies when no delivery date is given.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreatePurchaseOrderRequest true "Purchase order"
+// @Success 201 {object} SuccessResponse{data=entities.PurchaseOrder}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/purchase-orders [post]
+func (h *PurchasingHandler) CreatePurchaseOrder(c *gin.Context) {
+	var req usecases.CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	if userID := getUserIDFromContext(c); userID != nil {
+		req.CreatedBy = *userID
+	}
+
+	order, err := h.purchasingUseCase.CreatePurchaseOrder(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Purchase order created successfully",
+		Data:    order,
+	})
+}
+
+// ListPurchaseOrders handles listing purchase orders
+// @Summary List purchase orders
+// @Tags purchasing
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status filter (open, received, cancelled)"
+// @Param supplier_id query string false "Supplier ID"
+// @Param product_id query string false "Only orders with this product"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/purchase-orders [get]
+func (h *PurchasingHandler) ListPurchaseOrders(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListPurchaseOrdersRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if status := c.Query("status"); status != "" {
+		s := entities.PurchaseOrderStatus(status)
+		req.Status = &s
+	}
+	if supplierIDStr := c.Query("supplier_id"); supplierIDStr != "" {
+		supplierID, err := uuid.Parse(supplierIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid supplier ID",
+			})
+			return
+		}
+		req.SupplierID = &supplierID
+	}
+	if productIDStr := c.Query("product_id"); productIDStr != "" {
+		productID, err := uuid.Parse(productIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID",
+			})
+			return
+		}
+		req.ProductID = &productID
+	}
+
+	response, err := h.purchasingUseCase.ListPurchaseOrders(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.PurchaseOrders,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetPurchaseOrder handles getting a purchase order
+// @Summary Get a purchase order
+// @Tags purchasing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Purchase order ID"
+// @Success 200 {object} SuccessResponse{data=entities.PurchaseOrder}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/purchase-orders/{id} [get]
+func (h *PurchasingHandler) GetPurchaseOrder(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid purchase order ID",
+		})
+		return
+	}
+
+	order, err := h.purchasingUseCase.GetPurchaseOrder(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Purchase order retrieved successfully",
+		Data:    order,
+	})
+}
+
+// UpdateExpectedDate handles changing when a purchase order is expected
+// @Summary Update a purchase order's expected delivery date
+// @Description Set the delivery date the supplier confirmed, or clear it to fall back to the supplier's lead time. Restock estimates follow the new date.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Purchase order ID"
+// @Param request body usecases.UpdatePurchaseOrderExpectedDateRequest true "Expected date"
+// @Success 200 {object} SuccessResponse{data=entities.PurchaseOrder}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/purchase-orders/{id}/expected-date [put]
+func (h *PurchasingHandler) UpdateExpectedDate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid purchase order ID",
+		})
+		return
+	}
+
+	var req usecases.UpdatePurchaseOrderExpectedDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.purchasingUseCase.UpdateExpectedDate(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Expected delivery date updated successfully",
+		Data:    order,
+	})
+}
+
+// ReceivePurchaseOrder handles receiving a delivery on a purchase order
+// @Summary Receive a purchase order
+// @Description Add the delivered units to stock. Send the units received of each item, or no items to receive everything still outstanding. The order is closed once every item has arrived in full; subscribers of restocked products are then notified in batches.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Purchase order ID"
+// @Param request body usecases.ReceivePurchaseOrderRequest false "Items received"
+// @Success 200 {object} SuccessResponse{data=entities.PurchaseOrder}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/purchase-orders/{id}/receive [post]
+func (h *PurchasingHandler) ReceivePurchaseOrder(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid purchase order ID",
+		})
+		return
+	}
+
+	var req usecases.ReceivePurchaseOrderRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	if userID := getUserIDFromContext(c); userID != nil {
+		req.ReceivedBy = *userID
+	}
+
+	order, err := h.purchasingUseCase.ReceivePurchaseOrder(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Purchase order received successfully",
+		Data:    order,
+	})
+}
+
+// CancelPurchaseOrder handles cancelling a purchase order
+// @Summary Cancel a purchase order
+// @Description Cancel an open purchase order. Its items no longer count towards restock estimates.
+// @Tags purchasing
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Purchase order ID"
+// @Success 200 {object} SuccessResponse{data=entities.PurchaseOrder}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/purchase-orders/{id}/cancel [post]
+func (h *PurchasingHandler) CancelPurchaseOrder(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid purchase order ID",
+		})
+		return
+	}
+
+	order, err := h.purchasingUseCase.CancelPurchaseOrder(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Purchase order cancelled successfully",
+		Data:    order,
+	})
+}
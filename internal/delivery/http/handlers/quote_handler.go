@@ -0,0 +1,535 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// QuoteHandler handles quote request (RFQ) HTTP requests
+type QuoteHandler struct {
+	quoteUseCase usecases.QuoteUseCase
+}
+
+// NewQuoteHandler creates a new quote handler
+func NewQuoteHandler(quoteUseCase usecases.QuoteUseCase) *QuoteHandler {
+	return &QuoteHandler{
+		quoteUseCase: quoteUseCase,
+	}
+}
+
+// RequestQuote handles converting the cart into a quote request
+// @Summary Request a quote
+// @Description Convert the current cart into a quote request for custom pricing; the cart is emptied
+// @Tags quotes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.RequestQuoteRequest false "Quote request notes"
+// @Success 201 {object} usecases.QuoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /quotes [post]
+func (h *QuoteHandler) RequestQuote(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	// Notes are optional, so an empty body is allowed
+	var req usecases.RequestQuoteRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	quote, err := h.quoteUseCase.RequestQuote(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Quote requested successfully",
+		Data:    quote,
+	})
+}
+
+// GetMyQuotes handles listing the current user's quotes
+// @Summary List my quotes
+// @Tags quotes
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /quotes [get]
+func (h *QuoteHandler) GetMyQuotes(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	response, err := h.quoteUseCase.GetMyQuotes(c.Request.Context(), *userID, parseListQuotesRequest(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Quotes,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetMyQuote handles getting one of the current user's quotes
+// @Summary Get my quote
+// @Tags quotes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /quotes/{id} [get]
+func (h *QuoteHandler) GetMyQuote(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteUseCase.GetMyQuote(c.Request.Context(), userID, quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote retrieved successfully",
+		Data:    quote,
+	})
+}
+
+// DownloadMyQuotePDF handles downloading one of the current user's quotes as a PDF
+// @Summary Download my quote as PDF
+// @Tags quotes
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {file} file
+// @Failure 404 {object} ErrorResponse
+// @Router /quotes/{id}/pdf [get]
+func (h *QuoteHandler) DownloadMyQuotePDF(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.quoteUseCase.GetMyQuotePDF(c.Request.Context(), userID, quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// AcceptQuote handles accepting a quote's prices
+// @Summary Accept quote
+// @Tags quotes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Router /quotes/{id}/accept [post]
+func (h *QuoteHandler) AcceptQuote(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteUseCase.AcceptQuote(c.Request.Context(), userID, quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote accepted successfully",
+		Data:    quote,
+	})
+}
+
+// RejectQuote handles declining a quote's prices
+// @Summary Reject quote
+// @Tags quotes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /quotes/{id}/reject [post]
+func (h *QuoteHandler) RejectQuote(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteUseCase.RejectQuote(c.Request.Context(), userID, quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote rejected successfully",
+		Data:    quote,
+	})
+}
+
+// CancelQuote handles withdrawing a quote request
+// @Summary Cancel quote request
+// @Tags quotes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /quotes/{id}/cancel [post]
+func (h *QuoteHandler) CancelQuote(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteUseCase.CancelQuote(c.Request.Context(), userID, quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote cancelled successfully",
+		Data:    quote,
+	})
+}
+
+// ConvertQuoteToOrder handles placing an order for an accepted quote
+// @Summary Convert quote to order
+// @Description Place an order for an accepted quote with item prices locked at the quoted prices
+// @Tags quotes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Param request body usecases.CreateOrderRequest true "Order details"
+// @Success 201 {object} usecases.OrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Router /quotes/{id}/order [post]
+func (h *QuoteHandler) ConvertQuoteToOrder(c *gin.Context) {
+	userID, quoteID, ok := h.parseUserAndQuoteID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.CreateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.quoteUseCase.ConvertQuoteToOrder(c.Request.Context(), userID, quoteID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Order created from quote successfully",
+		Data:    order,
+	})
+}
+
+// ListQuotes handles listing all quotes for admins
+// @Summary List quotes
+// @Tags admin-quotes
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Router /admin/quotes [get]
+func (h *QuoteHandler) ListQuotes(c *gin.Context) {
+	response, err := h.quoteUseCase.ListQuotes(c.Request.Context(), parseListQuotesRequest(c))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Quotes,
+		Pagination: response.Pagination,
+	})
+}
+
+// GetQuote handles getting a quote for admins
+// @Summary Get quote
+// @Tags admin-quotes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/quotes/{id} [get]
+func (h *QuoteHandler) GetQuote(c *gin.Context) {
+	quoteID, ok := parseQuoteID(c)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteUseCase.GetQuote(c.Request.Context(), quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote retrieved successfully",
+		Data:    quote,
+	})
+}
+
+// DownloadQuotePDF handles downloading a quote as a PDF for admins
+// @Summary Download quote as PDF
+// @Tags admin-quotes
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {file} file
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/quotes/{id}/pdf [get]
+func (h *QuoteHandler) DownloadQuotePDF(c *gin.Context) {
+	quoteID, ok := parseQuoteID(c)
+	if !ok {
+		return
+	}
+
+	pdf, filename, err := h.quoteUseCase.GetQuotePDF(c.Request.Context(), quoteID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// RespondToQuote handles pricing a quote request
+// @Summary Respond to quote
+// @Description Set custom per-item prices and an expiry on a quote request
+// @Tags admin-quotes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Param request body usecases.RespondToQuoteRequest true "Quoted prices"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/quotes/{id}/respond [post]
+func (h *QuoteHandler) RespondToQuote(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	quoteID, ok := parseQuoteID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.RespondToQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	quote, err := h.quoteUseCase.RespondToQuote(c.Request.Context(), *adminID, quoteID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote priced successfully",
+		Data:    quote,
+	})
+}
+
+// DeclineQuote handles declining a quote request
+// @Summary Decline quote
+// @Tags admin-quotes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Quote ID"
+// @Param request body usecases.DeclineQuoteRequest false "Decline reason"
+// @Success 200 {object} usecases.QuoteResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/quotes/{id}/decline [post]
+func (h *QuoteHandler) DeclineQuote(c *gin.Context) {
+	quoteID, ok := parseQuoteID(c)
+	if !ok {
+		return
+	}
+
+	// The reason is optional, so an empty body is allowed
+	var req usecases.DeclineQuoteRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	quote, err := h.quoteUseCase.DeclineQuote(c.Request.Context(), quoteID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Quote declined successfully",
+		Data:    quote,
+	})
+}
+
+// ExpireQuotes handles manually expiring quotes whose validity has lapsed
+// @Summary Expire lapsed quotes now
+// @Tags admin-quotes
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /admin/quotes/expire [post]
+func (h *QuoteHandler) ExpireQuotes(c *gin.Context) {
+	expired, err := h.quoteUseCase.ExpireQuotes(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to expire quotes",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Lapsed quotes expired successfully",
+		Data: gin.H{
+			"expired": expired,
+		},
+	})
+}
+
+func (h *QuoteHandler) parseUserAndQuoteID(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	quoteID, ok := parseQuoteID(c)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return *userID, quoteID, true
+}
+
+func parseQuoteID(c *gin.Context) (uuid.UUID, bool) {
+	quoteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid quote ID format",
+		})
+		return uuid.Nil, false
+	}
+	return quoteID, true
+}
+
+func parseListQuotesRequest(c *gin.Context) usecases.ListQuotesRequest {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.ListQuotesRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if status := c.Query("status"); status != "" {
+		quoteStatus := entities.QuoteStatus(status)
+		req.Status = &quoteStatus
+	}
+	return req
+}
+
+func writePDF(c *gin.Context, pdf []byte, filename string) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
@@ -35,9 +35,9 @@ func NewRecommendationHandler(recommendationUseCase *usecases.RecommendationUseC
 // @Param brand_id query string false "Brand ID for brand-based recommendations"
 // @Param limit query int false "Number of recommendations to return" default(10)
 // @Param period query string false "Period for trending recommendations" Enums(daily,weekly,monthly) default(weekly)
-// @Success 200 {object} APIResponse{data=entities.RecommendationResponse}
-// @Failure 400 {object} APIResponse
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse{data=entities.RecommendationResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /api/v1/recommendations [get]
 func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
 	// Parse query parameters
@@ -59,9 +59,10 @@ func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
 
 	// Build recommendation request
 	req := &entities.RecommendationRequest{
-		Type:    entities.RecommendationType(recType),
-		Limit:   limit,
-		Context: make(map[string]interface{}),
+		Type:     entities.RecommendationType(recType),
+		Limit:    limit,
+		Context:  make(map[string]interface{}),
+		ViewerID: getUserIDFromContext(c),
 	}
 
 	// Parse optional parameters
@@ -86,6 +87,13 @@ func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
 		}
 	}
 
+	// Anonymous visitors are recommended products from their session's browsing
+	if req.UserID == nil {
+		if sessionID := getSessionIDFromContext(c); sessionID != "anonymous" {
+			req.SessionID = &sessionID
+		}
+	}
+
 	// Parse context parameters
 	if categoryIDStr := c.Query("category_id"); categoryIDStr != "" {
 		req.Context["category_id"] = categoryIDStr
@@ -104,7 +112,7 @@ func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
 	// Get recommendations
 	response, err := h.recommendationUseCase.GetRecommendations(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to get recommendations",
 			Details: err.Error(),
 		})
@@ -125,10 +133,10 @@ func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
 // @Produce json
 // @Param product_id path string true "Product ID"
 // @Param limit query int false "Number of products to return" default(10)
-// @Success 200 {object} APIResponse{data=entities.RecommendationResponse}
-// @Failure 400 {object} APIResponse
-// @Failure 404 {object} APIResponse
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse{data=entities.RecommendationResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /api/v1/products/{product_id}/related [get]
 func (h *RecommendationHandler) GetRelatedProducts(c *gin.Context) {
 	productIDStr := c.Param("id")
@@ -152,11 +160,12 @@ func (h *RecommendationHandler) GetRelatedProducts(c *gin.Context) {
 		ProductID: &productID,
 		Type:      entities.RecommendationTypeRelated,
 		Limit:     limit,
+		ViewerID:  getUserIDFromContext(c),
 	}
 
 	response, err := h.recommendationUseCase.GetRecommendations(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to get related products",
 			Details: err.Error(),
 		})
@@ -177,10 +186,10 @@ func (h *RecommendationHandler) GetRelatedProducts(c *gin.Context) {
 // @Produce json
 // @Param product_id path string true "Product ID"
 // @Param limit query int false "Number of products to return" default(5)
-// @Success 200 {object} APIResponse{data=entities.RecommendationResponse}
-// @Failure 400 {object} APIResponse
-// @Failure 404 {object} APIResponse
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse{data=entities.RecommendationResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /api/v1/products/{product_id}/frequently-bought-together [get]
 func (h *RecommendationHandler) GetFrequentlyBoughtTogether(c *gin.Context) {
 	productIDStr := c.Param("id")
@@ -204,11 +213,12 @@ func (h *RecommendationHandler) GetFrequentlyBoughtTogether(c *gin.Context) {
 		ProductID: &productID,
 		Type:      entities.RecommendationTypeFrequentlyBought,
 		Limit:     limit,
+		ViewerID:  getUserIDFromContext(c),
 	}
 
 	response, err := h.recommendationUseCase.GetRecommendations(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
 			Error:   "Failed to get frequently bought together products",
 			Details: err.Error(),
 		})
@@ -228,9 +238,9 @@ func (h *RecommendationHandler) GetFrequentlyBoughtTogether(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param limit query int false "Number of recommendations to return" default(20)
-// @Success 200 {object} APIResponse{data=entities.RecommendationResponse}
-// @Failure 401 {object} APIResponse
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse{data=entities.RecommendationResponse}
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/recommendations/personalized [get]
 func (h *RecommendationHandler) GetPersonalizedRecommendations(c *gin.Context) {
@@ -260,9 +270,10 @@ func (h *RecommendationHandler) GetPersonalizedRecommendations(c *gin.Context) {
 	}
 
 	req := &entities.RecommendationRequest{
-		UserID: &uid,
-		Type:   entities.RecommendationTypePersonalized,
-		Limit:  limit,
+		UserID:   &uid,
+		Type:     entities.RecommendationTypePersonalized,
+		Limit:    limit,
+		ViewerID: &uid,
 	}
 
 	response, err := h.recommendationUseCase.GetRecommendations(c.Request.Context(), req)
@@ -288,8 +299,8 @@ func (h *RecommendationHandler) GetPersonalizedRecommendations(c *gin.Context) {
 // @Produce json
 // @Param period query string false "Period for trending" Enums(daily,weekly,monthly) default(weekly)
 // @Param limit query int false "Number of products to return" default(20)
-// @Success 200 {object} APIResponse{data=entities.RecommendationResponse}
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse{data=entities.RecommendationResponse}
+// @Failure 500 {object} ErrorResponse
 // @Router /api/v1/recommendations/trending [get]
 func (h *RecommendationHandler) GetTrendingProducts(c *gin.Context) {
 	// Parse period
@@ -304,9 +315,10 @@ func (h *RecommendationHandler) GetTrendingProducts(c *gin.Context) {
 	}
 
 	req := &entities.RecommendationRequest{
-		Type:    entities.RecommendationTypeTrending,
-		Limit:   limit,
-		Context: map[string]interface{}{"period": period},
+		Type:     entities.RecommendationTypeTrending,
+		Limit:    limit,
+		Context:  map[string]interface{}{"period": period},
+		ViewerID: getUserIDFromContext(c),
 	}
 
 	response, err := h.recommendationUseCase.GetRecommendations(c.Request.Context(), req)
@@ -331,9 +343,9 @@ func (h *RecommendationHandler) GetTrendingProducts(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param interaction body TrackInteractionRequest true "Interaction data"
-// @Success 200 {object} APIResponse
-// @Failure 400 {object} APIResponse
-// @Failure 500 {object} APIResponse
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /api/v1/recommendations/track [post]
 func (h *RecommendationHandler) TrackInteraction(c *gin.Context) {
 	var req TrackInteractionRequest
@@ -356,7 +368,7 @@ func (h *RecommendationHandler) TrackInteraction(c *gin.Context) {
 	// Get session ID for guest users
 	var sessionID *string
 	if userID == nil {
-		if sid := c.GetHeader("X-Session-ID"); sid != "" {
+		if sid := getSessionIDFromContext(c); sid != "anonymous" {
 			sessionID = &sid
 		}
 	}
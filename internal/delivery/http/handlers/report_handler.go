@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReportHandler handles generating reports and downloading them through signed URLs
+type ReportHandler struct {
+	reportUseCase usecases.ReportUseCase
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(reportUseCase usecases.ReportUseCase) *ReportHandler {
+	return &ReportHandler{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// GenerateReport handles generating a report
+// @Summary Generate report
+// @Description Export sales, product sales, new users, inventory or payments for a period as CSV. Columns, header locale (en, vi or keys), delimiter and encoding (utf-8-bom for Excel) default to the admin's export profile for the report type, then to every column headed in the admin's language, comma separated, in UTF-8. With anonymize, customer IDs are replaced with stable pseudonyms and names and emails are left out, for analytics. The response carries a download URL that is valid for a limited time.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.GenerateReportRequest true "Report type, period and layout"
+// @Success 201 {object} usecases.ReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reports/generate [post]
+func (h *ReportHandler) GenerateReport(c *gin.Context) {
+	var req usecases.GenerateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.reportUseCase.GenerateReport(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to generate report",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Report generated successfully",
+		Data:    report,
+	})
+}
+
+// GetReports handles listing reports
+// @Summary Get reports
+// @Description List generated reports, newest first, each with a fresh download URL
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Report type"
+// @Param status query string false "Report status"
+// @Param limit query int false "Number of reports to return" default(20)
+// @Param offset query int false "Number of reports to skip" default(0)
+// @Success 200 {object} usecases.ReportsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/reports [get]
+func (h *ReportHandler) GetReports(c *gin.Context) {
+	var req usecases.GetReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	reports, err := h.reportUseCase.GetReports(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: reports,
+	})
+}
+
+// GetReport handles getting a report
+// @Summary Get report
+// @Description Get a report with a fresh download URL
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Success 200 {object} usecases.ReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/reports/{id} [get]
+func (h *ReportHandler) GetReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportUseCase.GetReport(c.Request.Context(), reportID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: report,
+	})
+}
+
+// DeleteReport handles deleting a report
+// @Summary Delete report
+// @Description Delete a report and its file. Download URLs issued for it stop working.
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/reports/{id} [delete]
+func (h *ReportHandler) DeleteReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reportUseCase.DeleteReport(c.Request.Context(), reportID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Report deleted successfully",
+	})
+}
+
+// DownloadReport handles downloading a report file through a signed URL
+// @Summary Download report
+// @Description Download a report file. Takes the download URL returned with the report; it only works for admins, until it expires or the report is deleted. Downloads are recorded in the audit log.
+// @Tags reports
+// @Produce text/csv
+// @Security BearerAuth
+// @Param id path string true "Report ID"
+// @Param expires query int true "URL expiry as a Unix time"
+// @Param signature query string true "URL signature"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/reports/{id}/download [get]
+func (h *ReportHandler) DownloadReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportUseCase.DownloadReport(c.Request.Context(), usecases.DownloadReportRequest{
+		ReportID:  reportID,
+		Expires:   c.Query("expires"),
+		Signature: c.Query("signature"),
+	})
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", report.FileName))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, report.ContentType, report.Content)
+}
+
+// GetExportProfiles handles listing the admin's export profiles
+// @Summary Get export profiles
+// @Description List the calling admin's saved report layouts, one per report type
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entities.ExportProfile
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/reports/export-profiles [get]
+func (h *ReportHandler) GetExportProfiles(c *gin.Context) {
+	profiles, err := h.reportUseCase.GetExportProfiles(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: profiles,
+	})
+}
+
+// SaveExportProfile handles saving the admin's export profile for a report type
+// @Summary Save export profile
+// @Description Save the calling admin's layout for a report type, replacing any saved before. Reports the admin generates of that type use it for the options their request leaves out.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Report type"
+// @Param request body usecases.SaveExportProfileRequest true "Columns, locale, delimiter and encoding"
+// @Success 200 {object} entities.ExportProfile
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/reports/export-profiles/{type} [put]
+func (h *ReportHandler) SaveExportProfile(c *gin.Context) {
+	var req usecases.SaveExportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+	req.Type = entities.ReportType(c.Param("type"))
+
+	profile, err := h.reportUseCase.SaveExportProfile(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to save export profile",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Export profile saved successfully",
+		Data:    profile,
+	})
+}
+
+// DeleteExportProfile handles deleting the admin's export profile for a report type
+// @Summary Delete export profile
+// @Description Delete the calling admin's layout for a report type
+// @Tags reports
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Report type"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/reports/export-profiles/{type} [delete]
+func (h *ReportHandler) DeleteExportProfile(c *gin.Context) {
+	reportType := entities.ReportType(c.Param("type"))
+	if err := h.reportUseCase.DeleteExportProfile(c.Request.Context(), reportType); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Export profile deleted successfully",
+	})
+}
+
+func parseReportID(c *gin.Context) (uuid.UUID, bool) {
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid report ID",
+		})
+		return uuid.Nil, false
+	}
+	return reportID, true
+}
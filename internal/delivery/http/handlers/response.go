@@ -26,6 +26,13 @@ type PaginatedResponse struct {
 	Pagination *usecases.PaginationInfo `json:"pagination"`
 }
 
+// PersonalizedPaginatedResponse represents a paginated listing that may be ranked for the viewer
+type PersonalizedPaginatedResponse struct {
+	Data         interface{}              `json:"data"`
+	Pagination   *usecases.PaginationInfo `json:"pagination"`
+	Personalized bool                     `json:"personalized"`
+}
+
 // Pagination represents pagination metadata (alias for backward compatibility)
 type Pagination = usecases.PaginationInfo
 
@@ -45,20 +52,70 @@ func getErrorStatusCode(err error) int {
 		 entities.ErrCartItemNotFound,
 		 entities.ErrOrderNotFound,
 		 entities.ErrPaymentNotFound,
+		 entities.ErrAddressNotFound,
+		 entities.ErrCompanyNotFound,
+		 entities.ErrCompanyMemberNotFound,
+		 entities.ErrOrderApprovalNotFound,
+		 entities.ErrCompanyInvoiceNotFound,
+		 entities.ErrQuoteNotFound,
+		 entities.ErrVisibilityRuleNotFound,
+		 entities.ErrBulkPriceUpdateNotFound,
+		 entities.ErrAdminNoteNotFound,
+		 entities.ErrOrderMessageNotFound,
+		 entities.ErrProductTranslationNotFound,
+		 entities.ErrCatalogChangesetNotFound,
+		 entities.ErrCategoryAssignmentNotFound,
+		 entities.ErrAggregateRepairRunNotFound,
+		 entities.ErrOrderArchiveNotFound,
+		 entities.ErrReportNotFound,
+		 entities.ErrExportProfileNotFound,
+		 entities.ErrPaymentLinkNotFound,
+		 entities.ErrDisputeNotFound,
+		 entities.ErrSettlementNotFound,
+		 entities.ErrAdminTaskNotFound,
+		 entities.ErrVendorNotFound,
+		 entities.ErrVendorCommissionNotFound,
+		 entities.ErrVendorApplicationNotFound,
+		 entities.ErrVendorDocumentNotFound,
+		 entities.ErrSupplierNotFound,
+		 entities.ErrPurchaseOrderNotFound,
+		 entities.ErrReturnNotFound,
+		 entities.ErrPartnerNotFound,
+		 entities.ErrAPIKeyNotFound,
+		 entities.ErrTaxExemptionNotFound,
+		 entities.ErrWaitlistNotFound,
 		 entities.ErrNotFound:
 		return http.StatusNotFound
 
 	case entities.ErrUserAlreadyExists,
 		 entities.ErrCategoryExists,
+		 entities.ErrPaymentLinkUsed,
+		 entities.ErrAdminTaskClosed,
+		 entities.ErrVendorExists,
+		 entities.ErrVendorApplicationExists,
+		 entities.ErrVendorApplicationLocked,
+		 entities.ErrSupplierExists,
+		 entities.ErrPurchaseOrderNotOpen,
 		 entities.ErrConflict:
 		return http.StatusConflict
 
+	case entities.ErrPaymentLinkExpired,
+		 entities.ErrPaymentLinkCancelled,
+		 entities.ErrReturnLinkExpired:
+		return http.StatusGone
+
 	case entities.ErrInvalidCredentials,
 		 entities.ErrUserNotActive,
+		 entities.ErrAPIKeyInvalid,
 		 entities.ErrUnauthorized:
 		return http.StatusUnauthorized
 
-	case entities.ErrForbidden:
+	case entities.ErrForbidden,
+		 entities.ErrNotVendor,
+		 entities.ErrReportDownloadInvalid,
+		 entities.ErrReportDownloadExpired,
+		 entities.ErrReturnLinkInvalid,
+		 entities.ErrDataRegionDenied:
 		return http.StatusForbidden
 
 	case entities.ErrInvalidInput,
@@ -74,7 +131,10 @@ func getErrorStatusCode(err error) int {
 		 entities.ErrInsufficientStock,
 		 entities.ErrOrderCannotBeCancelled,
 		 entities.ErrOrderCannotBeRefunded,
+		 entities.ErrOrderCannotBeReturned,
 		 entities.ErrOrderAlreadyPaid,
+		 entities.ErrOrderAwaitingApproval,
+		 entities.ErrQuoteExpired,
 		 entities.ErrRefundAmountExceedsPayment,
 		 entities.ErrPaymentAlreadyProcessed:
 		return http.StatusUnprocessableEntity
@@ -82,6 +142,9 @@ func getErrorStatusCode(err error) int {
 	case entities.ErrPaymentFailed:
 		return http.StatusPaymentRequired
 
+	case entities.ErrReviewLimitReached:
+		return http.StatusTooManyRequests
+
 	default:
 		return http.StatusInternalServerError
 	}
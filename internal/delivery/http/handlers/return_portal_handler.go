@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReturnPortalHandler handles the self-service returns portal customers open from delivery emails
+type ReturnPortalHandler struct {
+	returnPortalUseCase usecases.ReturnPortalUseCase
+	fileUseCase         usecases.FileUseCase
+}
+
+// NewReturnPortalHandler creates a new returns portal handler
+func NewReturnPortalHandler(returnPortalUseCase usecases.ReturnPortalUseCase, fileUseCase usecases.FileUseCase) *ReturnPortalHandler {
+	return &ReturnPortalHandler{
+		returnPortalUseCase: returnPortalUseCase,
+		fileUseCase:         fileUseCase,
+	}
+}
+
+// GetReturnPortal handles showing the order a return link is for
+// @Summary Get returns portal
+// @Description Get the order a return link is for: its items and how many of each can still be returned, the reasons to pick from and which are approved right away, and the returns already opened. No authentication is required; the token is the link's secret.
+// @Tags returns-portal
+// @Produce json
+// @Param token path string true "Return link token"
+// @Success 200 {object} usecases.ReturnPortalResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Router /returns/portal/{token} [get]
+func (h *ReturnPortalHandler) GetReturnPortal(c *gin.Context) {
+	portal, err := h.returnPortalUseCase.GetPortal(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: portal,
+	})
+}
+
+// CreatePortalReturn handles opening a return from a return link
+// @Summary Open return
+// @Description Open a return for items of the link's order. Send JSON, or multipart form data with the items as a JSON array in items, reason, description and up to 5 photos. Returns for a reason that qualifies for auto-approval are approved with a label right away; the others wait for review.
+// @Tags returns-portal
+// @Accept json
+// @Accept multipart/form-data
+// @Produce json
+// @Param token path string true "Return link token"
+// @Param request body usecases.CreatePortalReturnRequest true "Return request"
+// @Success 201 {object} usecases.PortalReturnResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Router /returns/portal/{token}/returns [post]
+func (h *ReturnPortalHandler) CreatePortalReturn(c *gin.Context) {
+	token := c.Param("token")
+
+	var req usecases.CreatePortalReturnRequest
+	var photoIDs []string
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		// The link is checked before anything is stored
+		portal, err := h.returnPortalUseCase.GetPortal(c.Request.Context(), token)
+		if err != nil {
+			c.JSON(getErrorStatusCode(err), ErrorResponse{
+				Error: err.Error(),
+			})
+			return
+		}
+		if err := h.parseMultipartReturnRequest(c, &req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request format",
+				Details: err.Error(),
+			})
+			return
+		}
+		photoIDs, err = h.uploadPhotos(c, portal.CustomerID, portal.MaxPhotos, &req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid photo",
+				Details: err.Error(),
+			})
+			return
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	ret, err := h.returnPortalUseCase.CreateReturn(c.Request.Context(), token, req)
+	if err != nil {
+		// Photos of a return that wasn't opened aren't kept
+		for _, id := range photoIDs {
+			_ = h.fileUseCase.DeleteFile(c.Request.Context(), id)
+		}
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Return opened successfully",
+		Data:    ret,
+	})
+}
+
+// DownloadReturnLabel handles downloading the label of a return opened from a return link
+// @Summary Download return label
+// @Description Download the shipping label of an approved return as a PDF
+// @Tags returns-portal
+// @Produce application/pdf
+// @Param token path string true "Return link token"
+// @Param id path string true "Return ID"
+// @Success 200 {file} file
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /returns/portal/{token}/returns/{id}/label [get]
+func (h *ReturnPortalHandler) DownloadReturnLabel(c *gin.Context) {
+	returnID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid return ID",
+		})
+		return
+	}
+
+	pdf, filename, err := h.returnPortalUseCase.GetReturnLabel(c.Request.Context(), c.Param("token"), returnID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	writePDF(c, pdf, filename)
+}
+
+// parseMultipartReturnRequest parses the fields of a return sent as multipart form data
+func (h *ReturnPortalHandler) parseMultipartReturnRequest(c *gin.Context, req *usecases.CreatePortalReturnRequest) error {
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil { // 32MB max
+		return err
+	}
+	if err := json.Unmarshal([]byte(c.PostForm("items")), &req.Items); err != nil {
+		return fmt.Errorf("items must be a JSON array: %w", err)
+	}
+	req.Reason = entities.ReturnReason(c.PostForm("reason"))
+	req.Description = c.PostForm("description")
+	return nil
+}
+
+// uploadPhotos stores the photos sent with a return for the order's customer and adds their URLs
+// to the request. It returns the IDs of the stored files.
+func (h *ReturnPortalHandler) uploadPhotos(c *gin.Context, customerID uuid.UUID, maxPhotos int, req *usecases.CreatePortalReturnRequest) ([]string, error) {
+	files := c.Request.MultipartForm.File["photos"]
+	if len(files) == 0 {
+		return nil, nil
+	}
+	if len(files) > maxPhotos {
+		return nil, fmt.Errorf("at most %d photos can be sent", maxPhotos)
+	}
+
+	uploadedBy := customerID.String()
+	var ids []string
+	for _, header := range files {
+		upload, err := h.uploadPhoto(c, header, &uploadedBy)
+		if err != nil {
+			for _, id := range ids {
+				_ = h.fileUseCase.DeleteFile(c.Request.Context(), id)
+			}
+			return nil, fmt.Errorf("%s: %w", header.Filename, err)
+		}
+		ids = append(ids, upload.ID)
+		req.Photos = append(req.Photos, upload.URL)
+	}
+	return ids, nil
+}
+
+func (h *ReturnPortalHandler) uploadPhoto(c *gin.Context, header *multipart.FileHeader, uploadedBy *string) (*entities.FileUploadResponse, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return h.fileUseCase.UploadImage(c.Request.Context(), file, header, entities.FileUploadTypeUser, uploadedBy)
+}
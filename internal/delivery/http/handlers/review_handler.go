@@ -26,6 +26,18 @@ func NewReviewHandler(reviewUseCase usecases.ReviewUseCase, fileUseCase usecases
 }
 
 // CreateReview creates a new review (supports both JSON and multipart form with images)
+// @Summary Create review
+// @Description Creates a new review (supports both JSON and multipart form with images)
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateReviewRequest true "Create review"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews [post]
 func (h *ReviewHandler) CreateReview(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userIDInterface, exists := c.Get("user_id")
@@ -60,7 +72,7 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 
 	review, err := h.reviewUseCase.CreateReview(c.Request.Context(), userID, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create review", "details": err.Error()})
+		c.JSON(getErrorStatusCode(err), gin.H{"error": "Failed to create review", "details": err.Error()})
 		return
 	}
 
@@ -68,6 +80,17 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 }
 
 // GetReview gets a review by ID
+// @Summary Get review
+// @Description Gets a review by ID
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /public/reviews/{id} [get]
+// @Router /reviews/{id} [get]
 func (h *ReviewHandler) GetReview(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -86,6 +109,21 @@ func (h *ReviewHandler) GetReview(c *gin.Context) {
 }
 
 // UpdateReview updates a review
+// @Summary Update review
+// @Description Updates a review
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Param request body usecases.UpdateReviewRequest true "Update review"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{id} [put]
 func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -127,7 +165,7 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 
 	review, err := h.reviewUseCase.UpdateReview(c.Request.Context(), userID, id, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update review"})
+		c.JSON(getErrorStatusCode(err), gin.H{"error": "Failed to update review", "details": err.Error()})
 		return
 	}
 
@@ -135,6 +173,19 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 }
 
 // DeleteReview deletes a review
+// @Summary Delete review
+// @Description Deletes a review
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{id} [delete]
 func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -178,8 +229,30 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 }
 
 // GetProductReviews gets reviews for a product
+// @Summary Get product reviews
+// @Description Gets approved reviews for a product with their helpful and not helpful vote counts and the store's reply. Reviews can be filtered by one or more star ratings and to verified purchases, and sorted by most_recent, most_helpful, highest_rating or lowest_rating. Pages can be fetched by page, or by passing pagination.next_cursor back as cursor, which stays stable while new reviews come in. Signed-in shoppers also get their own vote on each review as user_vote.
+// @Tags reviews
+// @Produce json
+// @Param id path string true "ID"
+// @Param product_id path string true "Product ID"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param rating query string false "Star ratings, comma separated (e.g. 4,5)"
+// @Param verified query bool false "Verified purchases only"
+// @Param sort query string false "Sort (most_recent, most_helpful, highest_rating, lowest_rating)"
+// @Param sort_by query string false "Sort by"
+// @Param sort_order query string false "Sort order"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/{id}/reviews [get]
+// @Router /public/reviews/product/{product_id} [get]
 func (h *ReviewHandler) GetProductReviews(c *gin.Context) {
 	productIDStr := c.Param("product_id")
+	if productIDStr == "" {
+		productIDStr = c.Param("id")
+	}
 	productID, err := uuid.Parse(productIDStr)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
@@ -202,13 +275,20 @@ func (h *ReviewHandler) GetProductReviews(c *gin.Context) {
 
 	// Parse query parameters
 	req := usecases.GetReviewsRequest{
-		Limit:  limit,
-		Offset: offset,
+		Limit:    limit,
+		Offset:   offset,
+		Cursor:   c.Query("cursor"),
+		ViewerID: getUserIDFromContext(c),
 	}
 
 	if ratingStr := c.Query("rating"); ratingStr != "" {
-		if rating, err := strconv.Atoi(ratingStr); err == nil && rating >= 1 && rating <= 5 {
-			req.Rating = &rating
+		for _, value := range strings.Split(ratingStr, ",") {
+			rating, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || rating < 1 || rating > 5 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Rating must be between 1 and 5"})
+				return
+			}
+			req.Ratings = append(req.Ratings, rating)
 		}
 	}
 
@@ -219,12 +299,13 @@ func (h *ReviewHandler) GetProductReviews(c *gin.Context) {
 		}
 	}
 
+	req.Sort = c.Query("sort")
 	req.SortBy = c.Query("sort_by")
 	req.SortOrder = c.Query("sort_order")
 
 	response, err := h.reviewUseCase.GetProductReviews(c.Request.Context(), productID, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get product reviews"})
+		c.JSON(getErrorStatusCode(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -235,6 +316,21 @@ func (h *ReviewHandler) GetProductReviews(c *gin.Context) {
 }
 
 // GetUserReviews gets reviews by a user
+// @Summary Get user reviews
+// @Description Gets reviews by a user
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "User ID"
+// @Param page query int false "Page"
+// @Param limit query int false "Limit"
+// @Param rating query int false "Rating"
+// @Param sort_by query string false "Sort by"
+// @Param sort_order query string false "Sort order"
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/reviews [get]
 func (h *ReviewHandler) GetUserReviews(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := uuid.Parse(userIDStr)
@@ -285,6 +381,17 @@ func (h *ReviewHandler) GetUserReviews(c *gin.Context) {
 }
 
 // VoteReview votes on a review
+// @Summary Vote review
+// @Description Votes on a review
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reviews/{id}/vote [post]
 func (h *ReviewHandler) VoteReview(c *gin.Context) {
 	idStr := c.Param("id")
 	reviewID, err := uuid.Parse(idStr)
@@ -328,6 +435,17 @@ func (h *ReviewHandler) VoteReview(c *gin.Context) {
 }
 
 // GetProductRating gets aggregated rating for a product
+// @Summary Get product rating
+// @Description Gets aggregated rating for a product
+// @Tags reviews
+// @Produce json
+// @Param id path string true "ID"
+// @Param product_id path string true "Product ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/{id}/rating [get]
+// @Router /public/reviews/product/{product_id}/summary [get]
 func (h *ReviewHandler) GetProductRating(c *gin.Context) {
 	productIDStr := c.Param("product_id")
 	productID, err := uuid.Parse(productIDStr)
@@ -345,6 +463,33 @@ func (h *ReviewHandler) GetProductRating(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": rating})
 }
 
+// GetProductReviewSummary gets a summary of a product's reviews
+// @Summary Get product review summary
+// @Description Summarizes a product's approved reviews: the 1-5 star histogram, keywords that stand out in positive (4-5 star) and negative (1-2 star) reviews, the most helpful review of each sentiment, and average quality, value and shipping ratings.
+// @Tags reviews
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} usecases.ProductReviewSummaryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/{id}/reviews/summary [get]
+func (h *ReviewHandler) GetProductReviewSummary(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	summary, err := h.reviewUseCase.GetProductReviewSummary(c.Request.Context(), productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": summary})
+}
+
 // parseMultipartReviewRequest parses multipart form data for review creation with images
 func (h *ReviewHandler) parseMultipartReviewRequest(c *gin.Context, req *usecases.CreateReviewRequest) error {
 	// Parse form data
@@ -383,6 +528,21 @@ func (h *ReviewHandler) parseMultipartReviewRequest(c *gin.Context, req *usecase
 	req.Title = c.PostForm("title")
 	req.Comment = c.PostForm("comment")
 
+	// Parse optional dimension ratings
+	for field, target := range map[string]**int{
+		"quality_rating":  &req.QualityRating,
+		"value_rating":    &req.ValueRating,
+		"shipping_rating": &req.ShippingRating,
+	} {
+		if value := c.PostForm(field); value != "" {
+			rating, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			*target = &rating
+		}
+	}
+
 	// Handle image files
 	form := c.Request.MultipartForm
 	if files := form.File["images"]; len(files) > 0 {
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReviewIncentiveHandler handles the incentivized review program
+type ReviewIncentiveHandler struct {
+	reviewIncentiveUseCase usecases.ReviewIncentiveUseCase
+}
+
+// NewReviewIncentiveHandler creates a new review incentive handler
+func NewReviewIncentiveHandler(reviewIncentiveUseCase usecases.ReviewIncentiveUseCase) *ReviewIncentiveHandler {
+	return &ReviewIncentiveHandler{
+		reviewIncentiveUseCase: reviewIncentiveUseCase,
+	}
+}
+
+// GetProgram handles getting the review incentive program
+// @Summary Get review incentive program
+// @Description Gets the reward configuration and fraud guards for approved reviews. Returns the inactive defaults if the program was never configured.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entities.ReviewIncentiveProgram
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/review-incentives/program [get]
+func (h *ReviewIncentiveHandler) GetProgram(c *gin.Context) {
+	program, err := h.reviewIncentiveUseCase.GetProgram(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review incentive program retrieved successfully",
+		Data:    program,
+	})
+}
+
+// UpdateProgram handles updating the review incentive program
+// @Summary Update review incentive program
+// @Description Updates the reward granted for approved reviews: loyalty points or a single-use coupon restricted to the reviewer. Customers are rewarded at most once per product. Switching the program on only rewards reviews written from then on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.UpdateReviewIncentiveProgramRequest true "Program changes"
+// @Success 200 {object} entities.ReviewIncentiveProgram
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /admin/review-incentives/program [put]
+func (h *ReviewIncentiveHandler) UpdateProgram(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.UpdateReviewIncentiveProgramRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	program, err := h.reviewIncentiveUseCase.UpdateProgram(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review incentive program updated successfully",
+		Data:    program,
+	})
+}
+
+// ListRewards handles listing granted review rewards
+// @Summary List review rewards
+// @Description Lists the rewards granted for approved reviews, newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page" default(1)
+// @Param limit query int false "Limit" default(20)
+// @Success 200 {object} usecases.ReviewIncentiveRewardsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/review-incentives/rewards [get]
+func (h *ReviewIncentiveHandler) ListRewards(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	response, err := h.reviewIncentiveUseCase.ListRewards(c.Request.Context(), page, limit)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review rewards retrieved successfully",
+		Data:    response,
+	})
+}
+
+// GetReport handles the review incentive program report
+// @Summary Get review incentive report
+// @Description Compares review volume during a period with the equally long period before it and summarizes the rewards granted. The period defaults to since the program was activated, or the last 30 days.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Success 200 {object} usecases.ReviewIncentiveReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/review-incentives/report [get]
+func (h *ReviewIncentiveHandler) GetReport(c *gin.Context) {
+	var req usecases.ReviewIncentiveReportRequest
+	if value := c.Query("date_from"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_from, expected YYYY-MM-DD",
+			})
+			return
+		}
+		req.DateFrom = &date
+	}
+	if value := c.Query("date_to"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid date_to, expected YYYY-MM-DD",
+			})
+			return
+		}
+		// Include the whole last day
+		date = date.AddDate(0, 0, 1)
+		req.DateTo = &date
+	}
+
+	report, err := h.reviewIncentiveUseCase.GetReport(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review incentive report retrieved successfully",
+		Data:    report,
+	})
+}
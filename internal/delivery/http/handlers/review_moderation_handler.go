@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReviewModerationHandler handles tuning review auto-approval and spam heuristics
+type ReviewModerationHandler struct {
+	reviewModerationUseCase usecases.ReviewModerationUseCase
+}
+
+// NewReviewModerationHandler creates a new review moderation handler
+func NewReviewModerationHandler(reviewModerationUseCase usecases.ReviewModerationUseCase) *ReviewModerationHandler {
+	return &ReviewModerationHandler{
+		reviewModerationUseCase: reviewModerationUseCase,
+	}
+}
+
+// GetSettings handles getting the review moderation settings
+// @Summary Get review moderation settings
+// @Description Gets which reviews are published right away, the spam heuristics that hold reviews for an admin, and the daily review limit. Returns the defaults if the settings were never saved.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entities.ReviewModerationSettings
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/review-moderation/settings [get]
+func (h *ReviewModerationHandler) GetSettings(c *gin.Context) {
+	settings, err := h.reviewModerationUseCase.GetSettings(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review moderation settings retrieved successfully",
+		Data:    settings,
+	})
+}
+
+// UpdateSettings handles updating the review moderation settings
+// @Summary Update review moderation settings
+// @Description Updates the review moderation settings. Verified purchases are published when the reviewer's trust score reaches min_trust_score; other reviews when they have enough content for their rating. Reviews with links, phone numbers or banned words are held for an admin. Customers over max_reviews_per_day are refused with 429. Applies to reviews written from now on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.UpdateReviewModerationSettingsRequest true "Settings changes"
+// @Success 200 {object} entities.ReviewModerationSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /admin/review-moderation/settings [put]
+func (h *ReviewModerationHandler) UpdateSettings(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.UpdateReviewModerationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	settings, err := h.reviewModerationUseCase.UpdateSettings(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review moderation settings updated successfully",
+		Data:    settings,
+	})
+}
+
+// TestSettings handles trying settings changes on recent reviews
+// @Summary Test review moderation settings
+// @Description Applies settings changes, without saving them, to the reviews of the last days as if each were written again, and lists the reviews they would flag, refuse over the daily limit, or treat differently than the saved settings. Trust scores are as of now.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.TestReviewModerationSettingsRequest true "Settings changes to test"
+// @Success 200 {object} usecases.ReviewModerationTestResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/review-moderation/test [post]
+func (h *ReviewModerationHandler) TestSettings(c *gin.Context) {
+	var req usecases.TestReviewModerationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.reviewModerationUseCase.TestSettings(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Review moderation settings tested successfully",
+		Data:    response,
+	})
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SandboxHandler handles sandbox mode HTTP requests
+type SandboxHandler struct {
+	sandboxUseCase usecases.SandboxUseCase
+}
+
+// NewSandboxHandler creates a new sandbox handler
+func NewSandboxHandler(sandboxUseCase usecases.SandboxUseCase) *SandboxHandler {
+	return &SandboxHandler{
+		sandboxUseCase: sandboxUseCase,
+	}
+}
+
+// GetInfo handles getting sandbox information
+// @Summary Get sandbox information
+// @Description List magic card numbers, supported webhook events, runnable jobs and the sandbox clock
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SandboxInfoResponse
+// @Router /sandbox [get]
+func (h *SandboxHandler) GetInfo(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Sandbox mode is enabled",
+		Data:    h.sandboxUseCase.GetInfo(c.Request.Context()),
+	})
+}
+
+// ListEmails handles listing captured emails
+// @Summary List captured emails
+// @Description List emails captured by the sandbox mailbox, newest first
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Param to query string false "Filter by recipient email"
+// @Success 200 {object} usecases.SandboxMailboxResponse
+// @Router /sandbox/mailbox [get]
+func (h *SandboxHandler) ListEmails(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Captured emails retrieved successfully",
+		Data:    h.sandboxUseCase.ListEmails(c.Request.Context(), c.Query("to")),
+	})
+}
+
+// GetEmail handles getting a captured email
+// @Summary Get captured email
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Captured email ID"
+// @Success 200 {object} usecases.CapturedEmail
+// @Failure 404 {object} ErrorResponse
+// @Router /sandbox/mailbox/{id} [get]
+func (h *SandboxHandler) GetEmail(c *gin.Context) {
+	email, err := h.sandboxUseCase.GetEmail(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Captured email retrieved successfully",
+		Data:    email,
+	})
+}
+
+// ClearMailbox handles clearing captured emails
+// @Summary Clear captured emails
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /sandbox/mailbox [delete]
+func (h *SandboxHandler) ClearMailbox(c *gin.Context) {
+	h.sandboxUseCase.ClearMailbox(c.Request.Context())
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Mailbox cleared successfully",
+	})
+}
+
+// TriggerWebhook handles simulating a payment gateway webhook
+// @Summary Trigger payment webhook
+// @Description Feed a simulated gateway event through the regular webhook pipeline without a signature
+// @Tags sandbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "Payment provider (stripe, paypal)"
+// @Param request body usecases.TriggerWebhookRequest true "Webhook event"
+// @Success 200 {object} usecases.TriggerWebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /sandbox/webhooks/{provider} [post]
+func (h *SandboxHandler) TriggerWebhook(c *gin.Context) {
+	var req usecases.TriggerWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.sandboxUseCase.TriggerWebhook(c.Request.Context(), c.Param("provider"), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Webhook processed successfully",
+		Data:    result,
+	})
+}
+
+// GetClock handles getting the sandbox clock
+// @Summary Get sandbox clock
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SandboxClockResponse
+// @Router /sandbox/clock [get]
+func (h *SandboxHandler) GetClock(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Sandbox clock retrieved successfully",
+		Data:    h.sandboxUseCase.GetClock(c.Request.Context()),
+	})
+}
+
+// AdvanceClock handles moving the sandbox clock forward
+// @Summary Advance sandbox clock
+// @Description Move the clock used by time-based jobs forward, e.g. to trigger abandoned cart reminders
+// @Tags sandbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.AdvanceClockRequest true "Duration to advance"
+// @Success 200 {object} usecases.SandboxClockResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /sandbox/clock/advance [post]
+func (h *SandboxHandler) AdvanceClock(c *gin.Context) {
+	var req usecases.AdvanceClockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	clock, err := h.sandboxUseCase.AdvanceClock(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Sandbox clock advanced successfully",
+		Data:    clock,
+	})
+}
+
+// ResetClock handles returning the sandbox clock to real time
+// @Summary Reset sandbox clock
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SandboxClockResponse
+// @Router /sandbox/clock/reset [post]
+func (h *SandboxHandler) ResetClock(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Sandbox clock reset successfully",
+		Data:    h.sandboxUseCase.ResetClock(c.Request.Context()),
+	})
+}
+
+// RunJob handles running a background job immediately
+// @Summary Run background job
+// @Description Run a scheduled job now, using the sandbox clock
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Job name"
+// @Success 200 {object} usecases.RunJobResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /sandbox/jobs/{name}/run [post]
+func (h *SandboxHandler) RunJob(c *gin.Context) {
+	result, err := h.sandboxUseCase.RunJob(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Job run completed",
+		Data:    result,
+	})
+}
+
+// Reset handles resetting the sandbox
+// @Summary Reset sandbox
+// @Description Clear the mailbox, reset the clock and restart simulated gateway ID sequences
+// @Tags sandbox
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SandboxInfoResponse
+// @Router /sandbox/reset [post]
+func (h *SandboxHandler) Reset(c *gin.Context) {
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Sandbox reset successfully",
+		Data:    h.sandboxUseCase.Reset(c.Request.Context()),
+	})
+}
@@ -79,11 +79,7 @@ func (h *SearchHandler) FullTextSearch(c *gin.Context) {
 	}
 
 	// Parse user ID if authenticated
-	if userIDStr, exists := c.Get("user_id"); exists {
-		if userID, err := uuid.Parse(userIDStr.(string)); err == nil {
-			req.UserID = &userID
-		}
-	}
+	req.UserID = getUserIDFromContext(c)
 
 	// Parse category IDs (support both category_id and category_ids)
 	categoryIDsStr := c.Query("category_ids")
@@ -387,11 +383,7 @@ func (h *SearchHandler) RecordSearchEvent(c *gin.Context) {
 	req.UserAgent = c.GetHeader("User-Agent")
 
 	// Parse user ID if authenticated
-	if userIDStr, exists := c.Get("user_id"); exists {
-		if userID, err := uuid.Parse(userIDStr.(string)); err == nil {
-			req.UserID = &userID
-		}
-	}
+	req.UserID = getUserIDFromContext(c)
 
 	err := h.searchUseCase.RecordSearchEvent(c.Request.Context(), req)
 	if err != nil {
@@ -1106,8 +1098,9 @@ func (h *SearchHandler) EnhancedSearch(c *gin.Context) {
 	}
 
 	// Parse user ID if authenticated
-	if userID := c.GetString("user_id"); userID != "" {
-		req.UserID = &userID
+	if userID := getUserIDFromContext(c); userID != nil {
+		userIDStr := userID.String()
+		req.UserID = &userIDStr
 	}
 
 	// Parse multi-select filters
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SettlementHandler handles reconciling and reviewing payment gateway settlements
+type SettlementHandler struct {
+	settlementUseCase usecases.SettlementUseCase
+}
+
+// NewSettlementHandler creates a new settlement handler
+func NewSettlementHandler(settlementUseCase usecases.SettlementUseCase) *SettlementHandler {
+	return &SettlementHandler{
+		settlementUseCase: settlementUseCase,
+	}
+}
+
+// ReconcileSettlement handles reconciling a day of gateway transactions
+// @Summary Reconcile settlements
+// @Description Match a day's (UTC) gateway balance transactions against the recorded payments, refunds and disputes, check its payouts, and replace that day's settlements, one per currency. Reconciling a day again is safe. Only Stripe is supported.
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.ReconcileSettlementRequest true "Day to reconcile"
+// @Success 200 {object} usecases.ReconcileSettlementResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/settlements/reconcile [post]
+func (h *SettlementHandler) ReconcileSettlement(c *gin.Context) {
+	var req usecases.ReconcileSettlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid date, expected YYYY-MM-DD",
+		})
+		return
+	}
+	if !date.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Only past days can be reconciled",
+		})
+		return
+	}
+
+	result, err := h.settlementUseCase.ReconcileDay(c.Request.Context(), req.Gateway, date)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to reconcile settlements",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Settlements reconciled successfully",
+		Data:    result,
+	})
+}
+
+// GetSettlements handles listing settlements
+// @Summary Get settlements
+// @Description List daily settlements, most recent day first. Amounts are in minor units of the settlement currency; divide by 10^exponent for the decimal amount.
+// @Tags settlements
+// @Produce json
+// @Security BearerAuth
+// @Param gateway query string false "Payment provider (stripe)"
+// @Param currency query string false "Settlement currency, e.g. USD"
+// @Param status query string false "Settlement status (reconciled, discrepancies)"
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Param limit query int false "Number of settlements to return" default(20)
+// @Param offset query int false "Number of settlements to skip" default(0)
+// @Success 200 {object} usecases.SettlementsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/settlements [get]
+func (h *SettlementHandler) GetSettlements(c *gin.Context) {
+	var req usecases.GetSettlementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	settlements, err := h.settlementUseCase.GetSettlements(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: settlements,
+	})
+}
+
+// GetSettlement handles getting a settlement
+// @Summary Get settlement
+// @Description Get a day's settlement of one currency with its totals and reconciliation counts
+// @Tags settlements
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Settlement ID"
+// @Success 200 {object} entities.Settlement
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/settlements/{id} [get]
+func (h *SettlementHandler) GetSettlement(c *gin.Context) {
+	settlementID, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	settlement, err := h.settlementUseCase.GetSettlement(c.Request.Context(), settlementID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: settlement,
+	})
+}
+
+// GetSettlementItems handles listing the items of a settlement
+// @Summary Get settlement items
+// @Description List the gateway transactions of a settlement with how each matched our records, and the records the gateway has no transaction for
+// @Tags settlements
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Settlement ID"
+// @Param status query string false "Item status (matched, amount_mismatch, currency_mismatch, missing_record, missing_at_gateway, unmatched)"
+// @Param discrepancies query bool false "Only mismatches and missing records"
+// @Param limit query int false "Number of items to return" default(100)
+// @Param offset query int false "Number of items to skip" default(0)
+// @Success 200 {object} usecases.SettlementItemsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/settlements/{id}/items [get]
+func (h *SettlementHandler) GetSettlementItems(c *gin.Context) {
+	settlementID, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.GetSettlementItemsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	items, err := h.settlementUseCase.GetSettlementItems(c.Request.Context(), settlementID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: items,
+	})
+}
+
+func parseSettlementID(c *gin.Context) (uuid.UUID, bool) {
+	settlementID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid settlement ID",
+		})
+		return uuid.Nil, false
+	}
+	return settlementID, true
+}
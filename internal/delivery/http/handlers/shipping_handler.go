@@ -25,6 +25,14 @@ func NewShippingHandler(shippingUseCase usecases.ShippingUseCase) *ShippingHandl
 }
 
 // GetShippingMethods retrieves available shipping methods
+// @Summary Get shipping methods
+// @Description Retrieves available shipping methods
+// @Tags shipping
+// @Produce json
+// @Success 200 {array} usecases.ShippingMethodResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /shipping/methods [get]
 func (h *ShippingHandler) GetShippingMethods(c *gin.Context) {
 	var req usecases.GetShippingMethodsRequest
 	if err := c.ShouldBindQuery(&req); err != nil {
@@ -51,6 +59,16 @@ func (h *ShippingHandler) GetShippingMethods(c *gin.Context) {
 }
 
 // CalculateShippingCost calculates shipping cost
+// @Summary Calculate shipping cost
+// @Description Calculates shipping cost
+// @Tags shipping
+// @Accept json
+// @Produce json
+// @Param request body usecases.CalculateShippingRequest true "Calculate shipping"
+// @Success 200 {object} usecases.ShippingCostResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /shipping/rates [post]
 func (h *ShippingHandler) CalculateShippingCost(c *gin.Context) {
 	var req usecases.CalculateShippingRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -76,7 +94,70 @@ func (h *ShippingHandler) CalculateShippingCost(c *gin.Context) {
 	})
 }
 
+// EstimateShipping estimates shipping for the cart or a product
+// @Summary Estimate shipping
+// @Description Returns the shipping methods and prices of the shopper's cart, or of a single product with product_id, to a destination without starting checkout. The country defaults to the storefront ship-to country. Estimates for the same cart and destination are cached for a few minutes.
+// @Tags shipping
+// @Produce json
+// @Param product_id query string false "Estimate this product instead of the cart"
+// @Param quantity query int false "Quantity of the product" default(1)
+// @Param country query string false "Destination country code"
+// @Param state query string false "Destination state"
+// @Param city query string false "Destination city"
+// @Param zip_code query string false "Destination postal code"
+// @Param X-Session-ID header string false "Session ID for guest cart"
+// @Success 200 {object} SuccessResponse{data=usecases.ShippingEstimateResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /shipping/estimate [get]
+func (h *ShippingHandler) EstimateShipping(c *gin.Context) {
+	var req usecases.ShippingEstimateRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+	if productID := c.Query("product_id"); productID != "" {
+		id, err := uuid.Parse(productID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid product ID",
+			})
+			return
+		}
+		req.ProductID = &id
+	}
+	req.UserID = getUserIDFromContext(c)
+	req.SessionID = getSessionIDFromContext(c)
+
+	estimate, err := h.shippingUseCase.EstimateShipping(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Shipping estimated successfully",
+		Data:    estimate,
+	})
+}
+
 // CreateShipment creates a new shipment
+// @Summary Create shipment
+// @Description Creates a new shipment
+// @Tags shipping
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateShipmentRequest true "Create shipment"
+// @Success 201 {object} usecases.ShipmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/shipments [post]
 func (h *ShippingHandler) CreateShipment(c *gin.Context) {
 	var req usecases.CreateShipmentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -103,6 +184,16 @@ func (h *ShippingHandler) CreateShipment(c *gin.Context) {
 }
 
 // GetShipment retrieves a shipment by ID
+// @Summary Get shipment
+// @Description Retrieves a shipment by ID
+// @Tags shipping
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.ShipmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/shipments/{id} [get]
 func (h *ShippingHandler) GetShipment(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -130,6 +221,16 @@ func (h *ShippingHandler) GetShipment(c *gin.Context) {
 }
 
 // UpdateShipmentStatus updates shipment status
+// @Summary Update shipment status
+// @Description Updates shipment status
+// @Tags shipping
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID"
+// @Success 200 {object} usecases.ShipmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/shipments/{id}/status [put]
 func (h *ShippingHandler) UpdateShipmentStatus(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -168,6 +269,15 @@ func (h *ShippingHandler) UpdateShipmentStatus(c *gin.Context) {
 }
 
 // TrackShipment tracks a shipment by tracking number
+// @Summary Track shipment
+// @Description Tracks a shipment by tracking number
+// @Tags shipping
+// @Produce json
+// @Param tracking_number path string true "Tracking number"
+// @Success 200 {object} usecases.ShipmentTrackingResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /shipping/track/{tracking_number} [get]
 func (h *ShippingHandler) TrackShipment(c *gin.Context) {
 	trackingNumber := c.Param("tracking_number")
 	if trackingNumber == "" {
@@ -284,6 +394,16 @@ func (h *ShippingHandler) ProcessReturn(c *gin.Context) {
 }
 
 // CalculateDistanceBasedShipping calculates shipping options based on distance
+// @Summary Calculate distance based shipping
+// @Description Calculates shipping options based on distance
+// @Tags shipping
+// @Accept json
+// @Produce json
+// @Param request body usecases.DistanceBasedShippingRequest true "Distance based shipping"
+// @Success 200 {object} usecases.DistanceBasedShippingResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /shipping/calculate-distance [post]
 func (h *ShippingHandler) CalculateDistanceBasedShipping(c *gin.Context) {
 	var req usecases.DistanceBasedShippingRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -310,6 +430,13 @@ func (h *ShippingHandler) CalculateDistanceBasedShipping(c *gin.Context) {
 }
 
 // GetShippingZones returns available shipping zones
+// @Summary Get shipping zones
+// @Description Returns available shipping zones
+// @Tags shipping
+// @Produce json
+// @Success 200 {object} SuccessResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /shipping/zones [get]
 func (h *ShippingHandler) GetShippingZones(c *gin.Context) {
 	zones, err := h.shippingUseCase.GetShippingZones(c.Request.Context())
 	if err != nil {
@@ -327,6 +454,15 @@ func (h *ShippingHandler) GetShippingZones(c *gin.Context) {
 }
 
 // ValidateShippingAddress handles shipping address validation requests
+// @Summary Validate shipping address
+// @Tags shipping
+// @Accept json
+// @Produce json
+// @Param request body usecases.ValidateShippingAddressRequest true "Validate shipping address"
+// @Success 200 {object} usecases.ValidateShippingAddressResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /shipping/validate-address [post]
 func (h *ShippingHandler) ValidateShippingAddress(c *gin.Context) {
 	var req usecases.ValidateShippingAddressRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/delivery/http/middleware"
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StorefrontHandler handles the currency, locale and ship-to country shoppers browse in
+type StorefrontHandler struct {
+	storefrontUseCase usecases.StorefrontUseCase
+	cookieMaxAge      time.Duration
+	secureCookies     bool
+}
+
+// NewStorefrontHandler creates a new storefront handler
+func NewStorefrontHandler(storefrontUseCase usecases.StorefrontUseCase, cookieMaxAge time.Duration, secureCookies bool) *StorefrontHandler {
+	return &StorefrontHandler{
+		storefrontUseCase: storefrontUseCase,
+		cookieMaxAge:      cookieMaxAge,
+		secureCookies:     secureCookies,
+	}
+}
+
+// Resolve resolves a shopper's storefront for middleware.StorefrontMiddleware
+func (h *StorefrontHandler) Resolve(ctx context.Context, requested entities.Storefront, acceptLanguage string, userID *uuid.UUID) entities.Storefront {
+	return h.storefrontUseCase.ResolveStorefront(ctx, requested, acceptLanguage, userID)
+}
+
+// GetStorefront handles getting the shopper's storefront
+// @Summary Get storefront context
+// @Description Get the currency, locale and ship-to country the request resolved to, the choices available and the shopper's cart re-priced in them. The storefront comes from the X-Currency and X-Country headers, the locale query parameter, the storefront cookies, the signed-in user's profile and Accept-Language, in that order.
+// @Tags storefront
+// @Produce json
+// @Param X-Currency header string false "Currency to price in"
+// @Param X-Country header string false "Ship-to country (ISO 3166-1 alpha-2)"
+// @Param X-Session-ID header string false "Session ID for guest cart"
+// @Success 200 {object} SuccessResponse{data=usecases.StorefrontResponse}
+// @Router /storefront [get]
+func (h *StorefrontHandler) GetStorefront(c *gin.Context) {
+	storefront := h.storefrontUseCase.GetStorefront(c.Request.Context(), getUserIDFromContext(c), getSessionIDFromContext(c))
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Storefront retrieved successfully",
+		Data:    storefront,
+	})
+}
+
+// SwitchStorefront handles switching the shopper's storefront
+// @Summary Switch storefront context
+// @Description Switch the shopper's currency, locale or ship-to country. The choice is remembered in cookies for the next requests, and optionally on the signed-in user's profile. Returns the new storefront with the cart re-priced.
+// @Tags storefront
+// @Accept json
+// @Produce json
+// @Param X-Session-ID header string false "Session ID for guest cart"
+// @Param request body usecases.SwitchStorefrontRequest true "Storefront to switch to"
+// @Success 200 {object} SuccessResponse{data=usecases.StorefrontResponse}
+// @Failure 400 {object} ErrorResponse
+// @Router /storefront [put]
+func (h *StorefrontHandler) SwitchStorefront(c *gin.Context) {
+	var req usecases.SwitchStorefrontRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	storefront, err := h.storefrontUseCase.SwitchStorefront(c.Request.Context(), getUserIDFromContext(c), getSessionIDFromContext(c), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	maxAge := int(h.cookieMaxAge.Seconds())
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(middleware.StorefrontCurrencyCookie, storefront.Currency, maxAge, "/", "", h.secureCookies, true)
+	c.SetCookie(middleware.StorefrontLocaleCookie, storefront.Locale, maxAge, "/", "", h.secureCookies, true)
+	c.SetCookie(middleware.StorefrontCountryCookie, storefront.Country, maxAge, "/", "", h.secureCookies, true)
+	c.Header("Content-Language", storefront.Locale)
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Storefront switched successfully",
+		Data:    storefront,
+	})
+}
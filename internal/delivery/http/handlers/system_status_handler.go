@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SystemStatusHandler reports the operational status of the system for the runbook
+type SystemStatusHandler struct {
+	systemStatusUseCase usecases.SystemStatusUseCase
+}
+
+// NewSystemStatusHandler creates a new system status handler
+func NewSystemStatusHandler(systemStatusUseCase usecases.SystemStatusUseCase) *SystemStatusHandler {
+	return &SystemStatusHandler{
+		systemStatusUseCase: systemStatusUseCase,
+	}
+}
+
+// GetSystemStatus handles getting the operational status of the system
+// @Summary Get system status
+// @Description Notification and email retry queue depths, outbox backlog, background job states with their last successful run, partner webhook delivery failures, and email and storage provider health, checked live with a 5 second limit each. Status is degraded when any of them needs attention, with each problem listed in issues.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.SystemStatusResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/system/status [get]
+func (h *SystemStatusHandler) GetSystemStatus(c *gin.Context) {
+	status, err := h.systemStatusUseCase.GetSystemStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "System status retrieved successfully",
+		Data:    status,
+	})
+}
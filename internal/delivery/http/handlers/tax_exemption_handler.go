@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TaxExemptionHandler handles customers' tax exemption certificates and their review by admins
+type TaxExemptionHandler struct {
+	taxExemptionUseCase usecases.TaxExemptionUseCase
+}
+
+// NewTaxExemptionHandler creates a new tax exemption handler
+func NewTaxExemptionHandler(taxExemptionUseCase usecases.TaxExemptionUseCase) *TaxExemptionHandler {
+	return &TaxExemptionHandler{
+		taxExemptionUseCase: taxExemptionUseCase,
+	}
+}
+
+// UploadCertificate handles a customer uploading a tax exemption certificate
+// @Summary Upload tax exemption certificate
+// @Description Upload a PDF, JPEG or PNG tax exemption certificate of up to 10MB, such as a reseller's resale certificate, for an admin to verify. Once verified it applies at checkout to orders shipped to its country, or state when given, and exempts products of its tax classes, or all products when none are given. Certificates stop applying at the start of their expiry date.
+// @Tags tax-exemptions
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Certificate document"
+// @Param type formData string true "Exemption type (resale, nonprofit, government, other)"
+// @Param certificate_number formData string true "Certificate number"
+// @Param country formData string true "Country code (ISO 3166-1 alpha-2)"
+// @Param state formData string false "State; empty for the whole country"
+// @Param tax_classes formData string false "Comma separated product tax classes; empty for all"
+// @Param expires_at formData string false "Expiry date (YYYY-MM-DD); empty if it does not expire"
+// @Success 201 {object} entities.TaxExemptionCertificate
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /tax-exemptions [post]
+func (h *TaxExemptionHandler) UploadCertificate(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.UploadTaxExemptionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request format",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "No file provided",
+		})
+		return
+	}
+	defer file.Close()
+
+	certificate, err := h.taxExemptionUseCase.UploadCertificate(c.Request.Context(), *userID, req, file, header)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Tax exemption certificate uploaded successfully",
+		Data:    certificate,
+	})
+}
+
+// GetMyCertificates handles listing the current user's tax exemption certificates
+// @Summary Get my tax exemption certificates
+// @Description List the current user's tax exemption certificates, newest first, with their review status and expiry
+// @Tags tax-exemptions
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entities.TaxExemptionCertificate
+// @Failure 401 {object} ErrorResponse
+// @Router /tax-exemptions [get]
+func (h *TaxExemptionHandler) GetMyCertificates(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	certificates, err := h.taxExemptionUseCase.GetMyCertificates(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: certificates,
+	})
+}
+
+// DeleteMyCertificate handles a customer withdrawing a tax exemption certificate
+// @Summary Delete tax exemption certificate
+// @Description Withdraw one of the current user's certificates and delete its document. It no longer applies at checkout; orders placed with it keep their record of the exemption.
+// @Tags tax-exemptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Certificate ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /tax-exemptions/{id} [delete]
+func (h *TaxExemptionHandler) DeleteMyCertificate(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+	id, ok := parseTaxExemptionID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taxExemptionUseCase.DeleteMyCertificate(c.Request.Context(), *userID, id); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Tax exemption certificate deleted successfully",
+	})
+}
+
+// GetCertificates handles listing tax exemption certificates for review
+// @Summary List tax exemption certificates
+// @Description List tax exemption certificates, oldest first, by status or customer
+// @Tags tax-exemptions
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status (pending, verified, rejected, expired)"
+// @Param user_id query string false "Customer ID"
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} usecases.TaxExemptionsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/tax-exemptions [get]
+func (h *TaxExemptionHandler) GetCertificates(c *gin.Context) {
+	var req usecases.GetTaxExemptionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	certificates, err := h.taxExemptionUseCase.GetCertificates(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: certificates,
+	})
+}
+
+// GetCertificate handles getting a tax exemption certificate
+// @Summary Get tax exemption certificate
+// @Description Get a tax exemption certificate with the link to its document
+// @Tags tax-exemptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Certificate ID"
+// @Success 200 {object} entities.TaxExemptionCertificate
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/tax-exemptions/{id} [get]
+func (h *TaxExemptionHandler) GetCertificate(c *gin.Context) {
+	id, ok := parseTaxExemptionID(c)
+	if !ok {
+		return
+	}
+
+	certificate, err := h.taxExemptionUseCase.GetCertificate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: certificate,
+	})
+}
+
+// VerifyCertificate handles verifying a tax exemption certificate
+// @Summary Verify tax exemption certificate
+// @Description Verify a pending certificate, correcting its expiry, state or tax classes from the document where needed. It applies at checkout from now on, and the customer is notified.
+// @Tags tax-exemptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Certificate ID"
+// @Param request body usecases.VerifyTaxExemptionRequest false "Corrections and notes"
+// @Success 200 {object} entities.TaxExemptionCertificate
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/tax-exemptions/{id}/verify [post]
+func (h *TaxExemptionHandler) VerifyCertificate(c *gin.Context) {
+	id, ok := parseTaxExemptionID(c)
+	if !ok {
+		return
+	}
+	reviewerID := getUserIDFromContext(c)
+	if reviewerID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.VerifyTaxExemptionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request body",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	certificate, err := h.taxExemptionUseCase.VerifyCertificate(c.Request.Context(), id, *reviewerID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Tax exemption certificate verified",
+		Data:    certificate,
+	})
+}
+
+// RejectCertificate handles rejecting or revoking a tax exemption certificate
+// @Summary Reject tax exemption certificate
+// @Description Reject a pending certificate, or revoke a verified one, with the reason in notes. It no longer applies at checkout, and the customer is notified.
+// @Tags tax-exemptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Certificate ID"
+// @Param request body usecases.RejectTaxExemptionRequest true "Reason"
+// @Success 200 {object} entities.TaxExemptionCertificate
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/tax-exemptions/{id}/reject [post]
+func (h *TaxExemptionHandler) RejectCertificate(c *gin.Context) {
+	id, ok := parseTaxExemptionID(c)
+	if !ok {
+		return
+	}
+	reviewerID := getUserIDFromContext(c)
+	if reviewerID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.RejectTaxExemptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	certificate, err := h.taxExemptionUseCase.RejectCertificate(c.Request.Context(), id, *reviewerID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Tax exemption certificate rejected",
+		Data:    certificate,
+	})
+}
+
+func parseTaxExemptionID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid certificate ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
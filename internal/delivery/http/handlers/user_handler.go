@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/usecases"
 
 	"github.com/gin-gonic/gin"
@@ -13,7 +15,8 @@ import (
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	userUseCase usecases.UserUseCase
+	userUseCase      usecases.UserUseCase
+	analyticsUseCase usecases.AnalyticsUseCase
 }
 
 // getUserIDFromContext extracts user ID from gin context
@@ -32,9 +35,10 @@ func (h *UserHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, error) {
 }
 
 // NewUserHandler creates a new user handler
-func NewUserHandler(userUseCase usecases.UserUseCase) *UserHandler {
+func NewUserHandler(userUseCase usecases.UserUseCase, analyticsUseCase usecases.AnalyticsUseCase) *UserHandler {
 	return &UserHandler{
-		userUseCase: userUseCase,
+		userUseCase:      userUseCase,
+		analyticsUseCase: analyticsUseCase,
 	}
 }
 
@@ -66,6 +70,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 		})
 		return
 	}
+	stitchVisitorSession(c, h.analyticsUseCase, user.ID)
 
 	c.JSON(http.StatusCreated, SuccessResponse{
 		Message: "User registered successfully",
@@ -101,6 +106,9 @@ func (h *UserHandler) Login(c *gin.Context) {
 		})
 		return
 	}
+	if response.User != nil {
+		stitchVisitorSession(c, h.analyticsUseCase, response.User.ID)
+	}
 
 	c.JSON(http.StatusOK, SuccessResponse{
 		Message: "Login successful",
@@ -1082,6 +1090,19 @@ func (h *UserHandler) ResendVerification(c *gin.Context) {
 }
 
 // TrackSearch tracks user search activity
+// @Summary Track search
+// @Description Tracks user search activity
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param User-Agent header string false "User-Agent"
+// @Param request body usecases.TrackSearchRequest true "Track search"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/search-history/track [post]
 func (h *UserHandler) TrackSearch(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1126,6 +1147,16 @@ func (h *UserHandler) TrackSearch(c *gin.Context) {
 }
 
 // GetSearchHistory retrieves user's search history
+// @Summary Get search history
+// @Description Retrieves user's search history
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.UserSearchHistoryListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/search-history [get]
 func (h *UserHandler) GetSearchHistory(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1176,6 +1207,16 @@ func (h *UserHandler) GetSearchHistory(c *gin.Context) {
 }
 
 // ClearSearchHistory clears user's search history
+// @Summary Clear search history
+// @Description Clears user's search history
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/search-history [delete]
 func (h *UserHandler) ClearSearchHistory(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1207,6 +1248,18 @@ func (h *UserHandler) ClearSearchHistory(c *gin.Context) {
 }
 
 // CreateSavedSearch creates a new saved search
+// @Summary Create saved search
+// @Description Creates a new saved search
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateSavedSearchRequest true "Create saved search"
+// @Success 201 {object} usecases.SavedSearchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/saved-searches [post]
 func (h *UserHandler) CreateSavedSearch(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1251,6 +1304,16 @@ func (h *UserHandler) CreateSavedSearch(c *gin.Context) {
 }
 
 // GetSavedSearches retrieves user's saved searches
+// @Summary Get saved searches
+// @Description Retrieves user's saved searches
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.GetSavedSearchesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/saved-searches [get]
 func (h *UserHandler) GetSavedSearches(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1301,6 +1364,19 @@ func (h *UserHandler) GetSavedSearches(c *gin.Context) {
 }
 
 // TrackProductView tracks user product viewing activity
+// @Summary Track product view
+// @Description Tracks user product viewing activity
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param User-Agent header string false "User-Agent"
+// @Param request body usecases.TrackProductViewRequest true "Track product view"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/browsing-history/track [post]
 func (h *UserHandler) TrackProductView(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1345,6 +1421,16 @@ func (h *UserHandler) TrackProductView(c *gin.Context) {
 }
 
 // GetBrowsingHistory retrieves user's browsing history
+// @Summary Get browsing history
+// @Description Retrieves user's browsing history
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.BrowsingHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/browsing-history [get]
 func (h *UserHandler) GetBrowsingHistory(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1395,6 +1481,16 @@ func (h *UserHandler) GetBrowsingHistory(c *gin.Context) {
 }
 
 // GetPersonalization retrieves user personalization data
+// @Summary Get personalization
+// @Description Retrieves user personalization data
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.PersonalizationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/personalization [get]
 func (h *UserHandler) GetPersonalization(c *gin.Context) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -1426,3 +1522,54 @@ func (h *UserHandler) GetPersonalization(c *gin.Context) {
 		Data:    response,
 	})
 }
+
+// GetMyActivity handles getting the current user's activity stream
+// @Summary Get my activity stream
+// @Description Get a paginated stream of the current user's notable events: orders, reviews, loyalty points, wishlist changes and support replies
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Comma-separated activity types to include"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} PaginatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /me/activity [get]
+func (h *UserHandler) GetMyActivity(c *gin.Context) {
+	userID, err := h.getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	req := usecases.GetActivityStreamRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if types := c.Query("type"); types != "" {
+		for _, activityType := range strings.Split(types, ",") {
+			if activityType = strings.TrimSpace(activityType); activityType != "" {
+				req.Types = append(req.Types, entities.ActivityType(activityType))
+			}
+		}
+	}
+
+	response, err := h.userUseCase.GetActivityStream(c.Request.Context(), userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, PaginatedResponse{
+		Data:       response.Activities,
+		Pagination: response.Pagination,
+	})
+}
@@ -0,0 +1,435 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// VendorApplicationHandler handles vendor onboarding and the review of applications
+type VendorApplicationHandler struct {
+	applicationUseCase usecases.VendorApplicationUseCase
+}
+
+// NewVendorApplicationHandler creates a new vendor application handler
+func NewVendorApplicationHandler(applicationUseCase usecases.VendorApplicationUseCase) *VendorApplicationHandler {
+	return &VendorApplicationHandler{
+		applicationUseCase: applicationUseCase,
+	}
+}
+
+// Apply handles starting a vendor application
+// @Summary Apply to become a vendor
+// @Description Start a draft vendor application with the store and business details. The vendor is created pending and cannot publish products until the application is approved.
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.VendorApplicationRequest true "Application"
+// @Success 201 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vendor/application [post]
+func (h *VendorApplicationHandler) Apply(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.VendorApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	application, err := h.applicationUseCase.Apply(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Vendor application created successfully",
+		Data:    application,
+	})
+}
+
+// GetMyApplication handles getting the current user's vendor application
+// @Summary Get my vendor application
+// @Description Get the current user's vendor application with its documents and the reviewer's notes
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entities.VendorApplication
+// @Failure 404 {object} ErrorResponse
+// @Router /vendor/application [get]
+func (h *VendorApplicationHandler) GetMyApplication(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	application, err := h.applicationUseCase.GetMyApplication(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: application,
+	})
+}
+
+// UpdateMyApplication handles replacing the details of the current user's vendor application
+// @Summary Update my vendor application
+// @Description Replace the store and business details of a draft application, or one sent back for changes
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.VendorApplicationRequest true "Application"
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vendor/application [put]
+func (h *VendorApplicationHandler) UpdateMyApplication(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.VendorApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	application, err := h.applicationUseCase.UpdateMyApplication(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor application updated successfully",
+		Data:    application,
+	})
+}
+
+// UploadDocument handles uploading a KYC document to the current user's vendor application
+// @Summary Upload vendor document
+// @Description Upload a PDF, JPEG or PNG document of up to 10MB to a draft application, or one sent back for changes. Files are checked for content that does not match their type and for suspicious content.
+// @Tags vendor
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Document file"
+// @Param type formData string true "Document type (business_registration, identity, tax_certificate, bank_statement, other)"
+// @Success 201 {object} entities.VendorDocument
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vendor/application/documents [post]
+func (h *VendorApplicationHandler) UploadDocument(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "No file provided",
+		})
+		return
+	}
+	defer file.Close()
+
+	documentType := entities.VendorDocumentType(c.PostForm("type"))
+	document, err := h.applicationUseCase.UploadDocument(c.Request.Context(), *userID, documentType, file, header)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Document uploaded successfully",
+		Data:    document,
+	})
+}
+
+// DeleteDocument handles removing a document from the current user's vendor application
+// @Summary Delete vendor document
+// @Description Remove a document from a draft application, or one sent back for changes, and delete its file
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Document ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vendor/application/documents/{id} [delete]
+func (h *VendorApplicationHandler) DeleteDocument(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	documentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid document ID",
+		})
+		return
+	}
+
+	if err := h.applicationUseCase.DeleteDocument(c.Request.Context(), *userID, documentID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Document deleted successfully",
+	})
+}
+
+// SubmitMyApplication handles sending the current user's vendor application for review
+// @Summary Submit my vendor application
+// @Description Send the application for review. It needs a business_registration and an identity document, and companies need a registration number.
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vendor/application/submit [post]
+func (h *VendorApplicationHandler) SubmitMyApplication(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	application, err := h.applicationUseCase.SubmitMyApplication(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor application submitted successfully",
+		Data:    application,
+	})
+}
+
+// GetApplications handles listing vendor applications
+// @Summary Get vendor applications
+// @Description List vendor applications, oldest submission first. Filter by status=submitted for the review queue.
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Application status (draft, submitted, changes_requested, approved, rejected)"
+// @Param limit query int false "Number of applications to return" default(20)
+// @Param offset query int false "Number of applications to skip" default(0)
+// @Success 200 {object} usecases.VendorApplicationsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/vendor-applications [get]
+func (h *VendorApplicationHandler) GetApplications(c *gin.Context) {
+	var req usecases.GetVendorApplicationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	applications, err := h.applicationUseCase.GetApplications(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: applications,
+	})
+}
+
+// GetApplication handles getting a vendor application
+// @Summary Get vendor application
+// @Description Get a vendor application with its vendor and documents
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendor-applications/{id} [get]
+func (h *VendorApplicationHandler) GetApplication(c *gin.Context) {
+	applicationID, ok := parseVendorApplicationID(c)
+	if !ok {
+		return
+	}
+
+	application, err := h.applicationUseCase.GetApplication(c.Request.Context(), applicationID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: application,
+	})
+}
+
+// ApproveApplication handles approving a vendor application
+// @Summary Approve vendor application
+// @Description Approve a submitted application. The vendor becomes active and can publish products, and the applicant is notified.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param request body usecases.ReviewVendorApplicationRequest false "Review notes"
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/vendor-applications/{id}/approve [post]
+func (h *VendorApplicationHandler) ApproveApplication(c *gin.Context) {
+	h.review(c, "approved", h.applicationUseCase.ApproveApplication)
+}
+
+// RejectApplication handles rejecting a vendor application
+// @Summary Reject vendor application
+// @Description Reject a submitted application with the reason in notes. The vendor is rejected and the applicant is notified.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param request body usecases.ReviewVendorApplicationRequest true "Reason"
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/vendor-applications/{id}/reject [post]
+func (h *VendorApplicationHandler) RejectApplication(c *gin.Context) {
+	h.review(c, "rejected", h.applicationUseCase.RejectApplication)
+}
+
+// RequestApplicationChanges handles sending a vendor application back to the applicant
+// @Summary Request vendor application changes
+// @Description Send a submitted application back with the changes needed in notes. The applicant is notified and can edit and submit it again.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Application ID"
+// @Param request body usecases.ReviewVendorApplicationRequest true "Changes needed"
+// @Success 200 {object} entities.VendorApplication
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/vendor-applications/{id}/request-changes [post]
+func (h *VendorApplicationHandler) RequestApplicationChanges(c *gin.Context) {
+	h.review(c, "sent back for changes", h.applicationUseCase.RequestApplicationChanges)
+}
+
+// review handles a review decision on an application
+func (h *VendorApplicationHandler) review(c *gin.Context, outcome string, decide func(ctx context.Context, id, reviewerID uuid.UUID, req usecases.ReviewVendorApplicationRequest) (*entities.VendorApplication, error)) {
+	applicationID, ok := parseVendorApplicationID(c)
+	if !ok {
+		return
+	}
+	reviewerID := getUserIDFromContext(c)
+	if reviewerID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.ReviewVendorApplicationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid request body",
+				Details: err.Error(),
+			})
+			return
+		}
+	}
+
+	application, err := decide(c.Request.Context(), applicationID, *reviewerID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor application " + outcome,
+		Data:    application,
+	})
+}
+
+func parseVendorApplicationID(c *gin.Context) (uuid.UUID, bool) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid application ID",
+		})
+		return uuid.Nil, false
+	}
+	return applicationID, true
+}
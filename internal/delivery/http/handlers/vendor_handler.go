@@ -0,0 +1,711 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// VendorHandler handles marketplace vendors, their commissions and statements
+type VendorHandler struct {
+	vendorUseCase usecases.VendorUseCase
+}
+
+// NewVendorHandler creates a new vendor handler
+func NewVendorHandler(vendorUseCase usecases.VendorUseCase) *VendorHandler {
+	return &VendorHandler{
+		vendorUseCase: vendorUseCase,
+	}
+}
+
+// CreateVendor handles creating a vendor
+// @Summary Create vendor
+// @Description Create a marketplace vendor. Link an account with user_id so the vendor can sign in and see its statement.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateVendorRequest true "Vendor"
+// @Success 201 {object} entities.Vendor
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/vendors [post]
+func (h *VendorHandler) CreateVendor(c *gin.Context) {
+	var req usecases.CreateVendorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	vendor, err := h.vendorUseCase.CreateVendor(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Vendor created successfully",
+		Data:    vendor,
+	})
+}
+
+// GetVendors handles listing vendors
+// @Summary Get vendors
+// @Description List marketplace vendors by name
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Vendor status (active, suspended)"
+// @Param search query string false "Search name, slug or email"
+// @Param limit query int false "Number of vendors to return" default(20)
+// @Param offset query int false "Number of vendors to skip" default(0)
+// @Success 200 {object} usecases.VendorsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/vendors [get]
+func (h *VendorHandler) GetVendors(c *gin.Context) {
+	var req usecases.GetVendorsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	vendors, err := h.vendorUseCase.GetVendors(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: vendors,
+	})
+}
+
+// GetVendor handles getting a vendor
+// @Summary Get vendor
+// @Description Get a marketplace vendor
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Success 200 {object} entities.Vendor
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id} [get]
+func (h *VendorHandler) GetVendor(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	vendor, err := h.vendorUseCase.GetVendor(c.Request.Context(), vendorID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: vendor,
+	})
+}
+
+// UpdateVendor handles updating a vendor
+// @Summary Update vendor
+// @Description Update a marketplace vendor. Suspending a vendor does not change how its products' orders are split.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param request body usecases.UpdateVendorRequest true "Vendor fields to update"
+// @Success 200 {object} entities.Vendor
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/vendors/{id} [put]
+func (h *VendorHandler) UpdateVendor(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdateVendorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	vendor, err := h.vendorUseCase.UpdateVendor(c.Request.Context(), vendorID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor updated successfully",
+		Data:    vendor,
+	})
+}
+
+// AssignVendorProducts handles making a vendor the seller of products
+// @Summary Assign products to vendor
+// @Description Make a vendor the seller of products. Orders already placed keep the vendors they were split between.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param request body usecases.AssignVendorProductsRequest true "Products"
+// @Success 200 {object} usecases.AssignVendorProductsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/products [post]
+func (h *VendorHandler) AssignVendorProducts(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.AssignVendorProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	result, err := h.vendorUseCase.AssignProducts(c.Request.Context(), vendorID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Products assigned successfully",
+		Data:    result,
+	})
+}
+
+// GetVendorCommissions handles listing a vendor's commission overrides
+// @Summary Get vendor commissions
+// @Description List a vendor's commission overrides. The one without a category applies to all categories.
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Success 200 {array} entities.VendorCommission
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/commissions [get]
+func (h *VendorHandler) GetVendorCommissions(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	commissions, err := h.vendorUseCase.GetVendorCommissions(c.Request.Context(), vendorID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: commissions,
+	})
+}
+
+// SetVendorCommission handles setting a vendor's commission override
+// @Summary Set vendor commission
+// @Description Set a vendor's commission for a category and its subcategories, or for all categories when category_id is left out. Replaces the vendor's existing override for the same scope.
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param request body usecases.SetVendorCommissionRequest true "Commission"
+// @Success 200 {object} entities.VendorCommission
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/commissions [put]
+func (h *VendorHandler) SetVendorCommission(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SetVendorCommissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	commission, err := h.vendorUseCase.SetVendorCommission(c.Request.Context(), vendorID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor commission saved successfully",
+		Data:    commission,
+	})
+}
+
+// DeleteVendorCommission handles removing a vendor's commission override
+// @Summary Delete vendor commission
+// @Description Remove one of a vendor's commission overrides
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param commission_id path string true "Vendor commission ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/commissions/{commission_id} [delete]
+func (h *VendorHandler) DeleteVendorCommission(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+	commissionID, err := uuid.Parse(c.Param("commission_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid vendor commission ID",
+		})
+		return
+	}
+
+	if err := h.vendorUseCase.DeleteVendorCommission(c.Request.Context(), vendorID, commissionID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Vendor commission deleted successfully",
+	})
+}
+
+// ResolveVendorCommission handles previewing the commission a vendor is charged
+// @Summary Resolve vendor commission
+// @Description Show the commission a vendor's items are charged in a category and where it comes from: the vendor's override for the category or an ancestor, the vendor's override for all categories, the category's or an ancestor's commission, or the default
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param category_id query string false "Category ID"
+// @Success 200 {object} services.ResolvedCommission
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/commissions/resolve [get]
+func (h *VendorHandler) ResolveVendorCommission(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	var categoryID *uuid.UUID
+	if value := c.Query("category_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid category ID",
+			})
+			return
+		}
+		categoryID = &id
+	}
+
+	commission, err := h.vendorUseCase.ResolveVendorCommission(c.Request.Context(), vendorID, categoryID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: commission,
+	})
+}
+
+// GetVendorStatement handles getting a vendor's statement
+// @Summary Get vendor statement
+// @Description Sum a vendor's gross sales, commissions, fixed fees, refund clawbacks and net payout per period and currency. Amounts are in minor units of the currency; divide by 10^exponent for the decimal amount.
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vendor ID"
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Param period query string false "Period (day, week, month)" default(month)
+// @Success 200 {object} usecases.VendorStatementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/vendors/{id}/statement [get]
+func (h *VendorHandler) GetVendorStatement(c *gin.Context) {
+	vendorID, ok := parseVendorID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.GetVendorStatementRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	statement, err := h.vendorUseCase.GetVendorStatement(c.Request.Context(), vendorID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: statement,
+	})
+}
+
+// GetCategoryCommissions handles listing category commissions
+// @Summary Get category commissions
+// @Description List the categories with their own commission. Subcategories without one inherit their nearest ancestor's.
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entities.CategoryCommission
+// @Router /admin/marketplace/category-commissions [get]
+func (h *VendorHandler) GetCategoryCommissions(c *gin.Context) {
+	commissions, err := h.vendorUseCase.GetCategoryCommissions(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: commissions,
+	})
+}
+
+// SetCategoryCommission handles setting a category's commission
+// @Summary Set category commission
+// @Description Set the commission rate and per-unit fixed fee charged on items of a category and its subcategories without their own
+// @Tags marketplace
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param category_id path string true "Category ID"
+// @Param request body usecases.SetCommissionRequest true "Commission"
+// @Success 200 {object} entities.CategoryCommission
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/marketplace/category-commissions/{category_id} [put]
+func (h *VendorHandler) SetCategoryCommission(c *gin.Context) {
+	categoryID, ok := parseCommissionCategoryID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.SetCommissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	commission, err := h.vendorUseCase.SetCategoryCommission(c.Request.Context(), categoryID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Category commission saved successfully",
+		Data:    commission,
+	})
+}
+
+// DeleteCategoryCommission handles removing a category's commission
+// @Summary Delete category commission
+// @Description Remove a category's commission so it inherits its parent's, or the default
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param category_id path string true "Category ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/marketplace/category-commissions/{category_id} [delete]
+func (h *VendorHandler) DeleteCategoryCommission(c *gin.Context) {
+	categoryID, ok := parseCommissionCategoryID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.vendorUseCase.DeleteCategoryCommission(c.Request.Context(), categoryID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Category commission deleted successfully",
+	})
+}
+
+// GetOrderVendorOrders handles getting the vendor orders of an order
+// @Summary Get order vendor orders
+// @Description Get the vendor orders an order was split into, with the fee lines computed when it was placed and the refunds taken back from each vendor
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param order_id path string true "Order ID"
+// @Success 200 {array} entities.VendorOrder
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/marketplace/orders/{order_id}/vendor-orders [get]
+func (h *VendorHandler) GetOrderVendorOrders(c *gin.Context) {
+	orderID, ok := parseVendorOrderOrderID(c)
+	if !ok {
+		return
+	}
+
+	vendorOrders, err := h.vendorUseCase.GetOrderVendorOrders(c.Request.Context(), orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: vendorOrders,
+	})
+}
+
+// SplitOrder handles splitting an order between its vendors
+// @Summary Split order between vendors
+// @Description Split an order between the vendors of its products if that failed when it was placed. An order that was already split is returned as it is.
+// @Tags marketplace
+// @Produce json
+// @Security BearerAuth
+// @Param order_id path string true "Order ID"
+// @Success 200 {array} entities.VendorOrder
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/marketplace/orders/{order_id}/vendor-orders [post]
+func (h *VendorHandler) SplitOrder(c *gin.Context) {
+	orderID, ok := parseVendorOrderOrderID(c)
+	if !ok {
+		return
+	}
+
+	vendorOrders, err := h.vendorUseCase.SplitOrder(c.Request.Context(), orderID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to split order",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Order split successfully",
+		Data:    vendorOrders,
+	})
+}
+
+// GetMyVendor handles getting the vendor the current user signs in for
+// @Summary Get my vendor
+// @Description Get the marketplace vendor the current user signs in for
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entities.Vendor
+// @Failure 403 {object} ErrorResponse
+// @Router /vendor [get]
+func (h *VendorHandler) GetMyVendor(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	vendor, err := h.vendorUseCase.GetMyVendor(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: vendor,
+	})
+}
+
+// GetMyVendorOrders handles listing the current vendor's orders
+// @Summary Get my vendor orders
+// @Description List the current vendor's share of orders with their fee lines, most recent first
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Param limit query int false "Number of vendor orders to return" default(20)
+// @Param offset query int false "Number of vendor orders to skip" default(0)
+// @Success 200 {object} usecases.VendorOrdersListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /vendor/orders [get]
+func (h *VendorHandler) GetMyVendorOrders(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.GetVendorOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	vendorOrders, err := h.vendorUseCase.GetMyVendorOrders(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: vendorOrders,
+	})
+}
+
+// GetMyStatement handles getting the current vendor's statement
+// @Summary Get my vendor statement
+// @Description Sum the current vendor's gross sales, commissions, fixed fees, refund clawbacks and net payout per period and currency. Amounts are in minor units of the currency; divide by 10^exponent for the decimal amount.
+// @Tags vendor
+// @Produce json
+// @Security BearerAuth
+// @Param date_from query string false "From date (YYYY-MM-DD)"
+// @Param date_to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Param period query string false "Period (day, week, month)" default(month)
+// @Success 200 {object} usecases.VendorStatementResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /vendor/statement [get]
+func (h *VendorHandler) GetMyStatement(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.GetVendorStatementRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	statement, err := h.vendorUseCase.GetMyStatement(c.Request.Context(), *userID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: statement,
+	})
+}
+
+func parseVendorID(c *gin.Context) (uuid.UUID, bool) {
+	vendorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid vendor ID",
+		})
+		return uuid.Nil, false
+	}
+	return vendorID, true
+}
+
+func parseCommissionCategoryID(c *gin.Context) (uuid.UUID, bool) {
+	categoryID, err := uuid.Parse(c.Param("category_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid category ID",
+		})
+		return uuid.Nil, false
+	}
+	return categoryID, true
+}
+
+func parseVendorOrderOrderID(c *gin.Context) (uuid.UUID, bool) {
+	orderID, err := uuid.Parse(c.Param("order_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid order ID",
+		})
+		return uuid.Nil, false
+	}
+	return orderID, true
+}
@@ -0,0 +1,361 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// WaitlistHandler handles waitlists for upcoming products and their launch by admins
+type WaitlistHandler struct {
+	waitlistUseCase usecases.WaitlistUseCase
+}
+
+// NewWaitlistHandler creates a new waitlist handler
+func NewWaitlistHandler(waitlistUseCase usecases.WaitlistUseCase) *WaitlistHandler {
+	return &WaitlistHandler{
+		waitlistUseCase: waitlistUseCase,
+	}
+}
+
+// JoinWaitlist handles a customer joining an upcoming product's waitlist
+// @Summary Join a product's waitlist
+// @Description Join the waitlist of a product that has not launched. Customers are notified when they can buy it: at launch, or earlier when their membership tier or customer segment, as of joining, has early access. Joining again keeps the customer's place.
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 201 {object} SuccessResponse{data=usecases.WaitlistEntryResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/{id}/waitlist [post]
+func (h *WaitlistHandler) JoinWaitlist(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	entry, err := h.waitlistUseCase.JoinWaitlist(c.Request.Context(), *userID, productID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "You will be notified when the product launches",
+		Data:    entry,
+	})
+}
+
+// LeaveWaitlist handles a customer leaving a product's waitlist
+// @Summary Leave a product's waitlist
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /products/{id}/waitlist [delete]
+func (h *WaitlistHandler) LeaveWaitlist(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid product ID",
+		})
+		return
+	}
+
+	if err := h.waitlistUseCase.LeaveWaitlist(c.Request.Context(), *userID, productID); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "You have left the waitlist",
+	})
+}
+
+// GetMyWaitlists handles listing the waitlists the current user is on
+// @Summary Get my waitlists
+// @Description List the product waitlists the current user is on, newest first, with when they can buy each product
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} usecases.WaitlistEntryResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /waitlists [get]
+func (h *WaitlistHandler) GetMyWaitlists(c *gin.Context) {
+	userID := getUserIDFromContext(c)
+	if userID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User ID not found in token",
+		})
+		return
+	}
+
+	entries, err := h.waitlistUseCase.GetMyWaitlists(c.Request.Context(), *userID)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: entries,
+	})
+}
+
+// CreateWaitlist handles opening a waitlist for an upcoming product
+// @Summary Create product waitlist
+// @Description Open a waitlist for a product that launches at launch_at. Until then only customers on the waitlist whose early access window has opened can add it to their cart. Wave size and interval default to the server's configuration.
+// @Tags waitlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body usecases.CreateWaitlistRequest true "Waitlist"
+// @Success 201 {object} usecases.WaitlistResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/waitlists [post]
+func (h *WaitlistHandler) CreateWaitlist(c *gin.Context) {
+	adminID := getUserIDFromContext(c)
+	if adminID == nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "User not authenticated",
+		})
+		return
+	}
+
+	var req usecases.CreateWaitlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	waitlist, err := h.waitlistUseCase.CreateWaitlist(c.Request.Context(), *adminID, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, SuccessResponse{
+		Message: "Waitlist created successfully",
+		Data:    waitlist,
+	})
+}
+
+// GetWaitlists handles listing product waitlists
+// @Summary List product waitlists
+// @Description List waitlists, soonest launch first, with how many customers joined and were notified and the shortfall against the product's stock
+// @Tags waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status (open, launching, launched, cancelled)"
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} usecases.WaitlistsListResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /admin/waitlists [get]
+func (h *WaitlistHandler) GetWaitlists(c *gin.Context) {
+	var req usecases.GetWaitlistsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	waitlists, err := h.waitlistUseCase.GetWaitlists(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: waitlists,
+	})
+}
+
+// GetWaitlist handles getting a product waitlist with its demand
+// @Summary Get product waitlist
+// @Description Get a waitlist with its demand by membership tier and customer segment, and when each group's access opens
+// @Tags waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Waitlist ID"
+// @Success 200 {object} usecases.WaitlistResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/waitlists/{id} [get]
+func (h *WaitlistHandler) GetWaitlist(c *gin.Context) {
+	id, ok := parseWaitlistID(c)
+	if !ok {
+		return
+	}
+
+	waitlist, err := h.waitlistUseCase.GetWaitlist(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: waitlist,
+	})
+}
+
+// UpdateWaitlist handles changing a product waitlist
+// @Summary Update product waitlist
+// @Description Change a waitlist's wave size or interval, or, before anyone's access has opened, its launch time and early access windows
+// @Tags waitlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Waitlist ID"
+// @Param request body usecases.UpdateWaitlistRequest true "Changes"
+// @Success 200 {object} usecases.WaitlistResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/waitlists/{id} [put]
+func (h *WaitlistHandler) UpdateWaitlist(c *gin.Context) {
+	id, ok := parseWaitlistID(c)
+	if !ok {
+		return
+	}
+
+	var req usecases.UpdateWaitlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid request body",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	waitlist, err := h.waitlistUseCase.UpdateWaitlist(c.Request.Context(), id, req)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Waitlist updated successfully",
+		Data:    waitlist,
+	})
+}
+
+// LaunchWaitlist handles launching a waitlist's product now
+// @Summary Launch product now
+// @Description Move a waitlist's launch to now. Everyone can buy the product, and the next run starts notifying the customers on the list in waves.
+// @Tags waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Waitlist ID"
+// @Success 200 {object} usecases.WaitlistResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/waitlists/{id}/launch [post]
+func (h *WaitlistHandler) LaunchWaitlist(c *gin.Context) {
+	id, ok := parseWaitlistID(c)
+	if !ok {
+		return
+	}
+
+	waitlist, err := h.waitlistUseCase.LaunchNow(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Product launched",
+		Data:    waitlist,
+	})
+}
+
+// CancelWaitlist handles calling off a product's launch
+// @Summary Cancel product waitlist
+// @Description Close a waitlist without notifying its remaining customers. The product is no longer held back until a launch.
+// @Tags waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Waitlist ID"
+// @Success 200 {object} usecases.WaitlistResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/waitlists/{id}/cancel [post]
+func (h *WaitlistHandler) CancelWaitlist(c *gin.Context) {
+	id, ok := parseWaitlistID(c)
+	if !ok {
+		return
+	}
+
+	waitlist, err := h.waitlistUseCase.CancelWaitlist(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Waitlist cancelled",
+		Data:    waitlist,
+	})
+}
+
+func parseWaitlistID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid waitlist ID",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
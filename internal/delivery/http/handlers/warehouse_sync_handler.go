@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WarehouseSyncHandler handles monitoring and running the data warehouse export
+type WarehouseSyncHandler struct {
+	warehouseSyncUseCase usecases.WarehouseSyncUseCase
+}
+
+// NewWarehouseSyncHandler creates a new warehouse sync handler
+func NewWarehouseSyncHandler(warehouseSyncUseCase usecases.WarehouseSyncUseCase) *WarehouseSyncHandler {
+	return &WarehouseSyncHandler{
+		warehouseSyncUseCase: warehouseSyncUseCase,
+	}
+}
+
+// GetStatus handles getting the export state of every table
+// @Summary Get warehouse sync status
+// @Description Get the watermark, last run, last error and exported row counts of every table exported to the data warehouse
+// @Tags warehouse-sync
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} usecases.WarehouseSyncStatusResponse
+// @Router /admin/warehouse-sync/status [get]
+func (h *WarehouseSyncHandler) GetStatus(c *gin.Context) {
+	status, err := h.warehouseSyncUseCase.GetWarehouseSyncStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Data: status,
+	})
+}
+
+// SyncTables handles manually triggering the warehouse sync job
+// @Summary Sync warehouse tables now
+// @Tags warehouse-sync
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/warehouse-sync/run [post]
+func (h *WarehouseSyncHandler) SyncTables(c *gin.Context) {
+	exported, err := h.warehouseSyncUseCase.SyncTables(c.Request.Context())
+	if err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error:   "Failed to sync warehouse tables",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Warehouse tables synced successfully",
+		Data: gin.H{
+			"exported": exported,
+		},
+	})
+}
+
+// ResetTable handles clearing a table's watermark so the next sync exports all its rows again
+// @Summary Re-export a warehouse table
+// @Description Clear the watermark of a table so the next sync exports all of its rows again, e.g. to backfill a newly exported column. Rows already in the warehouse are not deleted.
+// @Tags warehouse-sync
+// @Produce json
+// @Security BearerAuth
+// @Param table path string true "Table" Enums(orders, order_items, customers, products)
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/warehouse-sync/tables/{table}/reset [post]
+func (h *WarehouseSyncHandler) ResetTable(c *gin.Context) {
+	table := entities.WarehouseTable(c.Param("table"))
+	if err := h.warehouseSyncUseCase.ResetTable(c.Request.Context(), table); err != nil {
+		c.JSON(getErrorStatusCode(err), ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SuccessResponse{
+		Message: "Table will be re-exported on the next sync",
+	})
+}
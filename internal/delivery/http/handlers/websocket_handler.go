@@ -23,11 +23,23 @@ func NewWebSocketHandler(hub *websocket.Hub) *WebSocketHandler {
 }
 
 // HandleNotificationWebSocket handles WebSocket connections for real-time notifications
+// @Summary Handle notification web socket
+// @Tags websocket
+// @Param token query string false "JWT token for browsers that cannot set headers"
+// @Success 101 {string} string "Switching protocols"
+// @Router /ws/notifications [get]
 func (h *WebSocketHandler) HandleNotificationWebSocket(c *gin.Context) {
 	h.hub.HandleWebSocket(c)
 }
 
 // GetWebSocketStats returns WebSocket connection statistics
+// @Summary Get web socket stats
+// @Description Returns WebSocket connection statistics
+// @Tags websocket
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /ws/stats [get]
 func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
 	stats := h.hub.GetStats()
 	
@@ -38,6 +50,13 @@ func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
 }
 
 // GetConnectedUsers returns list of connected users
+// @Summary Get connected users
+// @Description Returns list of connected users
+// @Tags websocket
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Router /ws/users [get]
 func (h *WebSocketHandler) GetConnectedUsers(c *gin.Context) {
 	users := h.hub.GetConnectedUsers()
 	
@@ -51,6 +70,15 @@ func (h *WebSocketHandler) GetConnectedUsers(c *gin.Context) {
 }
 
 // SendTestNotification sends a test notification to a specific user
+// @Summary Send test notification
+// @Description Sends a test notification to a specific user
+// @Tags websocket
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "User ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /ws/test/{user_id} [post]
 func (h *WebSocketHandler) SendTestNotification(c *gin.Context) {
 	userIDStr := c.Param("user_id")
 	userID, err := uuid.Parse(userIDStr)
@@ -100,6 +128,14 @@ func (h *WebSocketHandler) SendTestNotification(c *gin.Context) {
 }
 
 // BroadcastTestNotification broadcasts a test notification to all connected users
+// @Summary Broadcast test notification
+// @Description Broadcasts a test notification to all connected users
+// @Tags websocket
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /ws/broadcast [post]
 func (h *WebSocketHandler) BroadcastTestNotification(c *gin.Context) {
 	var req struct {
 		Title    string                 `json:"title" binding:"required"`
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ActorMiddleware puts the caller's address, user agent and request ID in the request context
+// as an entities.Actor. The auth middlewares add the signed-in user to it.
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setActor(c)
+		c.Next()
+	}
+}
+
+// setActor rebuilds the request's actor from what the middlewares so far have identified
+func setActor(c *gin.Context) {
+	actor := entities.Actor{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: c.GetString("request_id"),
+		SessionID: c.GetString("session_id"),
+		Email:     c.GetString("email"),
+	}
+	if userID, ok := c.Get("user_id"); ok {
+		if id, ok := userID.(uuid.UUID); ok {
+			actor.UserID = &id
+		}
+	}
+	if role, ok := c.Get("role"); ok {
+		if roleStr, ok := role.(string); ok {
+			actor.Role = entities.UserRole(roleStr)
+		}
+	}
+
+	c.Request = c.Request.WithContext(entities.ContextWithActor(c.Request.Context(), actor))
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyAuthenticator returns the key and its partner when the key may be used for scope
+type APIKeyAuthenticator func(ctx context.Context, key string, scope entities.APIKeyScope) (*entities.APIKey, *entities.Partner, error)
+
+// APIKeyLimits looks up the limits of API keys' rate plans and meters their requests
+type APIKeyLimits interface {
+	// RatePlan returns the limits of a plan
+	RatePlan(plan entities.APIKeyPlan) entities.APIRatePlan
+	// RecordAPIKeyRequest counts a request against its key's daily quota; the request is
+	// refused when the returned quota isn't Allowed
+	RecordAPIKeyRequest(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKeyQuota, error)
+}
+
+// Context keys set by APIKeyMiddleware
+const (
+	APIKeyContextKey  = "api_key"
+	PartnerContextKey = "partner"
+)
+
+// APIKeyMiddleware lets requests through with an X-API-Key scoped to scope, and limits each key
+// to its plan's requests per minute and daily quota. Requests within the per-minute limit are
+// metered against the quota, and the quota's limit, what is left of it and when it resets are
+// sent in X-RateLimit headers. The key and partner are set in the gin context.
+func APIKeyMiddleware(scope entities.APIKeyScope, authenticate APIKeyAuthenticator, limits APIKeyLimits) gin.HandlerFunc {
+	limiter := NewRateLimiter(1, time.Minute)
+
+	return func(c *gin.Context) {
+		key := c.GetHeader("X-API-Key")
+		if key == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "X-API-Key header is required",
+			})
+			c.Abort()
+			return
+		}
+
+		apiKey, partner, err := authenticate(c.Request.Context(), key, scope)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if err == entities.ErrAPIKeyInvalid {
+				status = http.StatusUnauthorized
+			}
+			c.JSON(status, gin.H{
+				"error": err.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		plan := limits.RatePlan(apiKey.Plan)
+		if !limiter.AllowN(apiKey.ID.String(), plan.RequestsPerMinute) {
+			resetAt := time.Now().Add(limiter.RetryAfter(apiKey.ID.String(), plan.RequestsPerMinute))
+			abortOverLimit(c, "Rate limit exceeded. Please try again later.", int64(plan.RequestsPerMinute), resetAt)
+			return
+		}
+
+		quota, err := limits.RecordAPIKeyRequest(c.Request.Context(), apiKey)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			c.Abort()
+			return
+		}
+		if quota.Remaining != nil {
+			setRateLimitHeaders(c, quota.DailyQuota, *quota.Remaining, quota.ResetAt)
+		}
+		if !quota.Allowed {
+			abortOverLimit(c, "Daily quota exceeded. Please try again after it resets.", quota.DailyQuota, quota.ResetAt)
+			return
+		}
+
+		c.Set(APIKeyContextKey, apiKey)
+		c.Set(PartnerContextKey, partner)
+		c.Next()
+	}
+}
+
+// abortOverLimit refuses a request over a limit with 429 and when the limit resets
+func abortOverLimit(c *gin.Context, message string, limit int64, resetAt time.Time) {
+	setRateLimitHeaders(c, limit, 0, resetAt)
+	retryAfter := math.Ceil(time.Until(resetAt).Seconds())
+	c.Header("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":    message,
+		"limit":    limit,
+		"reset_at": resetAt.UTC(),
+	})
+	c.Abort()
+}
+
+// setRateLimitHeaders sends a limit, what is left of it and when it resets, in Unix seconds
+func setRateLimitHeaders(c *gin.Context, limit, remaining int64, resetAt time.Time) {
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	c.Header("X-RateLimit-Reset", strconv.FormatInt(int64(math.Ceil(float64(resetAt.UnixMilli())/1000)), 10))
+}
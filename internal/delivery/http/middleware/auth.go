@@ -7,7 +7,7 @@ import (
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
-	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -15,23 +15,24 @@ import (
 
 // AuthMiddlewareStruct holds the auth middleware configuration
 type AuthMiddlewareStruct struct {
-	jwtSecret string
+	jwtKeys *jwtkeys.KeySet
 }
 
 // NewAuthMiddleware creates a new auth middleware instance
-func NewAuthMiddleware(cfg *config.Config) *AuthMiddlewareStruct {
+func NewAuthMiddleware(jwtKeys *jwtkeys.KeySet) *AuthMiddlewareStruct {
 	return &AuthMiddlewareStruct{
-		jwtSecret: cfg.JWT.Secret,
+		jwtKeys: jwtKeys,
 	}
 }
 
 // RequireAuth returns a middleware that requires authentication
 func (a *AuthMiddlewareStruct) RequireAuth() gin.HandlerFunc {
-	return AuthMiddleware(a.jwtSecret)
+	return AuthMiddleware(a.jwtKeys)
 }
 
-// AuthMiddleware creates JWT authentication middleware
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+// AuthMiddleware creates JWT authentication middleware. Tokens signed by any valid key in
+// jwtKeys are accepted, each only with its key's algorithm.
+func AuthMiddleware(jwtKeys *jwtkeys.KeySet) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -53,15 +54,7 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 		// Parse and validate the token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing method is HMAC and specifically HS256
-			if method, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			} else if method != jwt.SigningMethodHS256 {
-				return nil, fmt.Errorf("unexpected signing method: %v", method.Alg())
-			}
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwtKeys.Parse(tokenString, jwt.MapClaims{})
 
 		if err != nil || !token.Valid {
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -175,6 +168,7 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			c.Set("user_id", userID)
 			c.Set("email", email)
 			c.Set("role", role)
+			setActor(c)
 		} else {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"error": "Invalid token claims",
@@ -187,6 +181,52 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 	}
 }
 
+// OptionalAuthMiddleware identifies the user from a valid bearer token without requiring one.
+// Requests with a missing or invalid token continue as guests, so public routes can personalize
+// their results (e.g. catalog visibility) for signed-in customers.
+func OptionalAuthMiddleware(jwtKeys *jwtkeys.KeySet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID, email, role, ok := bearerUser(c, jwtKeys); ok {
+			c.Set("user_id", userID)
+			c.Set("email", email)
+			c.Set("role", role)
+			setActor(c)
+		}
+		c.Next()
+	}
+}
+
+// bearerUser identifies the user of a valid bearer token, reporting false when the request has no
+// token or an invalid one
+func bearerUser(c *gin.Context, jwtKeys *jwtkeys.KeySet) (userID uuid.UUID, email, role string, ok bool) {
+	tokenString := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if tokenString == "" || tokenString == c.GetHeader("Authorization") {
+		return uuid.Nil, "", "", false
+	}
+
+	token, err := jwtKeys.Parse(tokenString, jwt.MapClaims{})
+	if err != nil || !token.Valid {
+		return uuid.Nil, "", "", false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return uuid.Nil, "", "", false
+	}
+	if exp, ok := claims["exp"].(float64); !ok || time.Now().Unix() > int64(exp) {
+		return uuid.Nil, "", "", false
+	}
+
+	userIDStr, _ := claims["user_id"].(string)
+	email, _ = claims["email"].(string)
+	role, _ = claims["role"].(string)
+	userID, err = uuid.Parse(userIDStr)
+	if err != nil || email == "" || role == "" {
+		return uuid.Nil, "", "", false
+	}
+	return userID, email, role, true
+}
+
 // AdminMiddleware checks if user has admin role
 func AdminMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
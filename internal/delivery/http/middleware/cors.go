@@ -26,6 +26,7 @@ func CORSMiddleware(cfg *config.CORSConfig) gin.HandlerFunc {
 
 		c.Header("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
 		c.Header("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+		c.Header("Access-Control-Expose-Headers", "X-Session-ID")
 		c.Header("Access-Control-Max-Age", "86400")
 
 		if c.Request.Method == "OPTIONS" {
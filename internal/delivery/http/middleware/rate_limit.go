@@ -32,6 +32,12 @@ func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
 
 // Allow checks if a request is allowed
 func (rl *RateLimiter) Allow(key string) bool {
+	return rl.AllowN(key, rl.limit)
+}
+
+// AllowN checks if a request is allowed under a limit of its own, for keys with different limits
+// in the same window
+func (rl *RateLimiter) AllowN(key string, limit int) bool {
 	rl.mutex.Lock()
 	defer rl.mutex.Unlock()
 	
@@ -50,7 +56,7 @@ func (rl *RateLimiter) Allow(key string) bool {
 	}
 	
 	// Check if we're under the limit
-	if len(validRequests) >= rl.limit {
+	if len(validRequests) >= limit {
 		rl.requests[key] = validRequests
 		return false
 	}
@@ -62,6 +68,32 @@ func (rl *RateLimiter) Allow(key string) bool {
 	return true
 }
 
+// RetryAfter returns how long until a key has room for another request under limit, 0 when it
+// has room now
+func (rl *RateLimiter) RetryAfter(key string, limit int) time.Duration {
+	rl.mutex.RLock()
+	defer rl.mutex.RUnlock()
+	
+	now := time.Now()
+	cutoff := now.Add(-rl.window)
+	
+	var validRequests []time.Time
+	for _, req := range rl.requests[key] {
+		if req.After(cutoff) {
+			validRequests = append(validRequests, req)
+		}
+	}
+	if limit < 1 {
+		return rl.window
+	}
+	if len(validRequests) < limit {
+		return 0
+	}
+	
+	// Requests are kept oldest first, so room opens when the one limit requests back leaves the window
+	return validRequests[len(validRequests)-limit].Add(rl.window).Sub(now)
+}
+
 // cleanup removes old entries periodically
 func (rl *RateLimiter) cleanup() {
 	ticker := time.NewTicker(time.Minute)
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // SessionValidationMiddleware validates session ID format for guest operations
@@ -41,6 +43,33 @@ func SessionValidationMiddleware() gin.HandlerFunc {
 	}
 }
 
+// AnonymousSessionMiddleware gives every visitor a stable session ID so tracking, guest carts and
+// recommendations work before login. An explicit X-Session-ID header wins over the cookie; visitors
+// with neither get a new ID. The ID is stored in the context as "session_id" and echoed back.
+func AnonymousSessionMiddleware(cookieName string, maxAge time.Duration, secure bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.GetHeader("X-Session-ID")
+		if sessionID == "" || validateSessionIDRelaxed(sessionID) != nil {
+			sessionID = ""
+			if cookie, err := c.Cookie(cookieName); err == nil && validateSessionIDRelaxed(cookie) == nil {
+				sessionID = cookie
+			}
+		}
+		if sessionID == "" {
+			sessionID = uuid.New().String()
+		}
+
+		// Refresh the cookie on every request so active visitors keep their session
+		c.SetSameSite(http.SameSiteLaxMode)
+		c.SetCookie(cookieName, sessionID, int(maxAge.Seconds()), "/", "", secure, true)
+		c.Header("X-Session-ID", sessionID)
+		c.Set("session_id", sessionID)
+		setActor(c)
+
+		c.Next()
+	}
+}
+
 // validateSessionID validates the session ID format (strict)
 func validateSessionID(sessionID string) error {
 	// Session ID should be 16-128 characters, alphanumeric with hyphens and underscores
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Cookies remembering the storefront a shopper picked with PUT /storefront
+const (
+	StorefrontCurrencyCookie = "storefront_currency"
+	StorefrontLocaleCookie   = "storefront_locale"
+	StorefrontCountryCookie  = "storefront_country"
+)
+
+// StorefrontResolver works out a shopper's storefront from what the request asked for
+type StorefrontResolver func(ctx context.Context, requested entities.Storefront, acceptLanguage string, userID *uuid.UUID) entities.Storefront
+
+// StorefrontMiddleware resolves the currency, locale and ship-to country a shopper browses in once
+// per request and puts it in the request context as an entities.Storefront. The X-Currency and
+// X-Country headers and the locale query parameter win over the storefront cookies, which win over
+// the signed-in user's profile; Accept-Language and the store defaults fill in the rest.
+func StorefrontMiddleware(jwtKeys *jwtkeys.KeySet, resolve StorefrontResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requested := entities.Storefront{
+			Currency: firstNonEmpty(c.GetHeader("X-Currency"), cookieValue(c, StorefrontCurrencyCookie)),
+			Locale:   firstNonEmpty(c.Query("locale"), cookieValue(c, StorefrontLocaleCookie)),
+			Country:  firstNonEmpty(c.GetHeader("X-Country"), cookieValue(c, StorefrontCountryCookie)),
+		}
+
+		var userID *uuid.UUID
+		if id, _, _, ok := bearerUser(c, jwtKeys); ok {
+			userID = &id
+		}
+
+		storefront := resolve(c.Request.Context(), requested, c.GetHeader("Accept-Language"), userID)
+		c.Request = c.Request.WithContext(entities.ContextWithStorefront(c.Request.Context(), storefront))
+		c.Header("Content-Language", storefront.Locale)
+		c.Header("Vary", "Accept-Language, X-Currency, X-Country, Cookie")
+
+		c.Next()
+	}
+}
+
+func cookieValue(c *gin.Context, name string) string {
+	value, _ := c.Cookie(name)
+	return value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -4,11 +4,13 @@ import (
 	"net/http"
 	"strings"
 
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
+
 	"github.com/gin-gonic/gin"
 )
 
 // PublicUploadAuthMiddleware requires either JWT token or API key for public uploads
-func PublicUploadAuthMiddleware(jwtSecret string) gin.HandlerFunc {
+func PublicUploadAuthMiddleware(jwtKeys *jwtkeys.KeySet) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check for JWT token first
 		authHeader := c.GetHeader("Authorization")
@@ -0,0 +1,402 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OperationDoc documents a handler. Request and response bodies are given as sample
+// values (e.g. usecases.ProductResponse{}) whose types are reflected into schemas.
+type OperationDoc struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Secured     bool
+	Params      []ParamDoc
+	Body        interface{} // Request body sample; nil when the operation takes no body
+	Responses   map[int]ResponseDoc
+}
+
+// ParamDoc documents a path, query, header or form parameter
+type ParamDoc struct {
+	Name        string
+	In          string // path, query, header or formData
+	Type        string // string, integer, number, boolean or file
+	Required    bool
+	Description string
+}
+
+// ResponseDoc documents a response
+type ResponseDoc struct {
+	Description string
+	Body        interface{} // Body sample; nil when the response has no documented content
+	Data        interface{} // Replaces the body's "data" property, e.g. SuccessResponse{data=T}
+	File        bool        // Binary file download
+}
+
+// Spec generates the OpenAPI document for the registered routes
+type Spec struct {
+	info Info
+
+	mu         sync.RWMutex
+	document   *Document
+	raw        []byte
+	registry   *schemaRegistry
+	operations map[string]*Operation // Keyed by "METHOD /gin/:path"
+}
+
+// NewSpec creates an empty spec; call Build once all routes are registered
+func NewSpec(info Info) *Spec {
+	return &Spec{info: info}
+}
+
+// handlerNamePattern extracts "ProductHandler.GetProducts" from a gin handler name
+// such as ".../handlers.(*ProductHandler).GetProducts-fm"
+var handlerNamePattern = regexp.MustCompile(`\(\*(\w+)\)\.(\w+)-fm$`)
+
+// HandlerKey returns the documentation key of a gin handler name
+func HandlerKey(handlerName string) string {
+	match := handlerNamePattern.FindStringSubmatch(handlerName)
+	if match == nil {
+		return ""
+	}
+	return match[1] + "." + match[2]
+}
+
+// Build generates the document from the router's routes and the handler documentation.
+// Every route is included; routes without documentation get a generic operation.
+func (s *Spec) Build(routes gin.RoutesInfo, docs map[string]OperationDoc) error {
+	registry := newSchemaRegistry()
+	document := &Document{
+		OpenAPI: "3.0.3",
+		Info:    s.info,
+		Servers: []Server{{URL: "/"}},
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			Schemas: registry.schemas,
+			SecuritySchemes: map[string]*SecurityScheme{
+				"BearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	operations := make(map[string]*Operation, len(routes))
+	operationIDs := make(map[string]int)
+
+	sorted := make(gin.RoutesInfo, len(routes))
+	copy(sorted, routes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	for _, route := range sorted {
+		key := HandlerKey(route.Handler)
+		doc, documented := docs[key]
+		if key == "" {
+			key = route.Method + " " + route.Path
+		}
+
+		operation := buildOperation(registry, route, key, doc, documented)
+		operation.OperationID = uniqueOperationID(operationIDs, operationID(key))
+
+		path := openAPIPath(route.Path)
+		item, ok := document.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			document.Paths[path] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = operation
+		operations[route.Method+" "+route.Path] = operation
+	}
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to encode OpenAPI document: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.document = document
+	s.raw = raw
+	s.registry = registry
+	s.operations = operations
+	return nil
+}
+
+// Document returns the generated document, or nil before Build
+func (s *Spec) Document() *Document {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.document
+}
+
+// Handler serves the generated document as JSON
+func (s *Spec) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s.mu.RLock()
+		raw := s.raw
+		s.mu.RUnlock()
+
+		if raw == nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "OpenAPI document not generated"})
+			return
+		}
+		c.Data(http.StatusOK, ContentTypeJSON, raw)
+	}
+}
+
+// responseSchemas returns the documented JSON response schemas of a route by status code
+func (s *Spec) responseSchemas(method, fullPath string) map[string]*Schema {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	operation, ok := s.operations[method+" "+fullPath]
+	if !ok {
+		return nil
+	}
+	schemas := make(map[string]*Schema)
+	for status, response := range operation.Responses {
+		if media, ok := response.Content[ContentTypeJSON]; ok && media.Schema != nil {
+			schemas[status] = media.Schema
+		}
+	}
+	return schemas
+}
+
+func buildOperation(registry *schemaRegistry, route gin.RouteInfo, key string, doc OperationDoc, documented bool) *Operation {
+	operation := &Operation{
+		Summary:     doc.Summary,
+		Description: doc.Description,
+		Tags:        doc.Tags,
+		Responses:   make(map[string]*Response),
+	}
+	if operation.Summary == "" {
+		operation.Summary = humanize(key)
+	}
+	if len(operation.Tags) == 0 {
+		operation.Tags = []string{defaultTag(route.Path)}
+	}
+	if doc.Secured {
+		operation.Security = []map[string][]string{{"BearerAuth": {}}}
+	}
+
+	// Path parameters come from the route itself so they are never missing
+	documentedParams := make(map[string]ParamDoc)
+	for _, param := range doc.Params {
+		documentedParams[param.In+":"+param.Name] = param
+	}
+	for _, segment := range strings.Split(route.Path, "/") {
+		if segment == "" || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		name := segment[1:]
+		param := ParamDoc{Name: name, In: "path", Type: "string"}
+		if documented, ok := documentedParams["path:"+name]; ok {
+			param.Description = documented.Description
+			param.Type = documented.Type
+		}
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:        name,
+			In:          "path",
+			Description: param.Description,
+			Required:    true,
+			Schema:      paramSchema(param.Type),
+		})
+	}
+
+	var formFields []ParamDoc
+	for _, param := range doc.Params {
+		switch param.In {
+		case "query", "header":
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:        param.Name,
+				In:          param.In,
+				Description: param.Description,
+				Required:    param.Required,
+				Schema:      paramSchema(param.Type),
+			})
+		case "formData":
+			formFields = append(formFields, param)
+		}
+	}
+
+	if doc.Body != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				ContentTypeJSON: {Schema: registry.schemaOf(doc.Body)},
+			},
+		}
+	} else if len(formFields) > 0 {
+		form := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		for _, field := range formFields {
+			form.Properties[field.Name] = paramSchema(field.Type)
+			if field.Required {
+				form.Required = append(form.Required, field.Name)
+			}
+		}
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{ContentTypeMultipart: {Schema: form}},
+		}
+	}
+
+	for status, responseDoc := range doc.Responses {
+		operation.Responses[strconv.Itoa(status)] = buildResponse(registry, status, responseDoc)
+	}
+	if !documented || len(operation.Responses) == 0 {
+		operation.Responses["default"] = &Response{Description: "Undocumented response"}
+	}
+
+	return operation
+}
+
+func buildResponse(registry *schemaRegistry, status int, doc ResponseDoc) *Response {
+	response := &Response{Description: doc.Description}
+	if response.Description == "" {
+		response.Description = http.StatusText(status)
+	}
+
+	switch {
+	case doc.File:
+		response.Content = map[string]*MediaType{
+			ContentTypeBinary: {Schema: &Schema{Type: "string", Format: "binary"}},
+		}
+	case doc.Body != nil:
+		schema := registry.schemaOf(doc.Body)
+		if doc.Data != nil {
+			schema = withData(registry, schema, registry.schemaOf(doc.Data))
+		}
+		response.Content = map[string]*MediaType{ContentTypeJSON: {Schema: schema}}
+	}
+	return response
+}
+
+// withData returns an inline copy of an envelope schema whose "data" property is replaced
+func withData(registry *schemaRegistry, envelope, data *Schema) *Schema {
+	resolved := registry.resolve(envelope)
+	if resolved == nil || resolved.Type != "object" {
+		return envelope
+	}
+	copied := *resolved
+	copied.Properties = make(map[string]*Schema, len(resolved.Properties))
+	for name, property := range resolved.Properties {
+		copied.Properties[name] = property
+	}
+	copied.Properties["data"] = data
+	return &copied
+}
+
+func paramSchema(paramType string) *Schema {
+	switch paramType {
+	case "int", "integer":
+		return &Schema{Type: "integer"}
+	case "number", "float", "float64":
+		return &Schema{Type: "number"}
+	case "bool", "boolean":
+		return &Schema{Type: "boolean"}
+	case "file":
+		return &Schema{Type: "string", Format: "binary"}
+	case "array", "[]string":
+		return &Schema{Type: "array", Items: &Schema{Type: "string"}}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// openAPIPath converts gin path parameters (:id, *path) into OpenAPI templates ({id})
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && (segment[0] == ':' || segment[0] == '*') {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// operationID derives an operation ID from a handler key, e.g. productGetProducts
+func operationID(key string) string {
+	handler, method, ok := strings.Cut(key, ".")
+	if !ok {
+		var b strings.Builder
+		upperNext := false
+		for _, r := range strings.ToLower(key) {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				upperNext = b.Len() > 0
+				continue
+			}
+			if upperNext {
+				r = unicode.ToUpper(r)
+				upperNext = false
+			}
+			b.WriteRune(r)
+		}
+		return b.String()
+	}
+	handler = strings.TrimSuffix(handler, "Handler")
+	if handler == "" {
+		return method
+	}
+	return strings.ToLower(handler[:1]) + handler[1:] + method
+}
+
+func uniqueOperationID(seen map[string]int, id string) string {
+	seen[id]++
+	if seen[id] == 1 {
+		return id
+	}
+	return id + strconv.Itoa(seen[id])
+}
+
+// humanize turns "ProductHandler.GetProducts" into "Get products"
+func humanize(key string) string {
+	_, method, ok := strings.Cut(key, ".")
+	if !ok {
+		return key
+	}
+	var words []string
+	start := 0
+	for i := 1; i < len(method); i++ {
+		if unicode.IsUpper(rune(method[i])) && !unicode.IsUpper(rune(method[i-1])) {
+			words = append(words, method[start:i])
+			start = i
+		}
+	}
+	words = append(words, method[start:])
+	for i := 1; i < len(words); i++ {
+		if strings.ToUpper(words[i]) != words[i] {
+			words[i] = strings.ToLower(words[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// defaultTag tags an undocumented route by its first path segment after the API version
+func defaultTag(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "api" || strings.HasPrefix(segment, "v") && len(segment) > 1 && unicode.IsDigit(rune(segment[1])) {
+			continue
+		}
+		if segment == "" || segment[0] == ':' || segment[0] == '*' {
+			break
+		}
+		if segment == "admin" && i+1 < len(segments) && segments[i+1] != "" && segments[i+1][0] != ':' {
+			return "admin-" + segments[i+1]
+		}
+		return segment
+	}
+	return "default"
+}
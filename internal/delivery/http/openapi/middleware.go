@@ -0,0 +1,108 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResponseValidationMiddleware rejects JSON responses that diverge from the documented schema.
+// It buffers whole responses and is meant for development only: a diverging response is
+// replaced by a 500 listing the divergences so contract drift surfaces immediately.
+func ResponseValidationMiddleware(spec *Spec) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		schemas := spec.responseSchemas(c.Request.Method, c.FullPath())
+		if len(schemas) == 0 {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		schema, ok := schemas[strconv.Itoa(writer.status)]
+		if !ok || writer.body.Len() == 0 || !strings.HasPrefix(writer.Header().Get("Content-Type"), ContentTypeJSON) {
+			writer.flush()
+			return
+		}
+
+		var value interface{}
+		var divergences []string
+		if err := json.Unmarshal(writer.body.Bytes(), &value); err != nil {
+			divergences = []string{"$: invalid JSON: " + err.Error()}
+		} else {
+			divergences = spec.Validate(value, schema)
+		}
+		if len(divergences) == 0 {
+			writer.flush()
+			return
+		}
+
+		log.Printf("OpenAPI response validation failed for %s %s (%d): %s",
+			c.Request.Method, c.FullPath(), writer.status, strings.Join(divergences, "; "))
+
+		body, _ := json.Marshal(gin.H{
+			"error":   "Response does not match OpenAPI schema",
+			"details": divergences,
+		})
+		writer.ResponseWriter.Header().Set("Content-Type", ContentTypeJSON+"; charset=utf-8")
+		writer.ResponseWriter.Header().Del("Content-Length")
+		writer.ResponseWriter.WriteHeader(http.StatusInternalServerError)
+		_, _ = writer.ResponseWriter.Write(body)
+	}
+}
+
+// bufferedResponseWriter holds the status and body until the handler chain completes
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	if w.body.Len() == 0 {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// flush writes the buffered response through unchanged
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() == 0 {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
@@ -0,0 +1,174 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaRegistry converts Go types into schemas, collecting named structs as components
+type schemaRegistry struct {
+	schemas map[string]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{schemas: make(map[string]*Schema)}
+}
+
+// schemaOf returns the schema of a sample value, or nil when the value is nil
+func (r *schemaRegistry) schemaOf(sample interface{}) *Schema {
+	if sample == nil {
+		return nil
+	}
+	return r.schemaFor(reflect.TypeOf(sample))
+}
+
+// schemaFor returns the schema describing how encoding/json marshals values of type t
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Ptr {
+		return nullable(r.schemaFor(t.Elem()))
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		return &Schema{}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string", Format: textFormat(t)}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem()), Nullable: true}
+	case reflect.Array:
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return r.componentRef(t)
+	default:
+		// Interfaces, and anything else without a fixed shape, accept any value
+		return &Schema{}
+	}
+}
+
+// componentRef registers a named struct as a component and returns a reference to it
+func (r *schemaRegistry) componentRef(t reflect.Type) *Schema {
+	name := componentName(t)
+	if _, ok := r.schemas[name]; !ok {
+		// Reserve the name first so self-referencing types terminate
+		r.schemas[name] = &Schema{Type: "object"}
+		r.schemas[name] = r.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// structSchema builds an object schema from exported fields, following encoding/json rules
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.addFields(schema, t, false)
+	return schema
+}
+
+func (r *schemaRegistry) addFields(schema *Schema, t reflect.Type, optional bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			embedded := fieldType
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.addFields(schema, embedded, optional || fieldType.Kind() == reflect.Ptr)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if fieldType.Kind() == reflect.Chan || fieldType.Kind() == reflect.Func {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		var propSchema *Schema
+		if strings.Contains(opts, "string") {
+			propSchema = &Schema{Type: "string"}
+		} else {
+			propSchema = r.schemaFor(fieldType)
+		}
+		schema.Properties[name] = propSchema
+		if !optional && !strings.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// resolve follows a component reference
+func (r *schemaRegistry) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = r.schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+	return schema
+}
+
+// nullable marks a schema as accepting null, wrapping references whose siblings would be ignored
+func nullable(schema *Schema) *Schema {
+	if schema.Ref != "" {
+		return &Schema{AllOf: []*Schema{schema}, Nullable: true}
+	}
+	if schema.Type == "" && len(schema.AllOf) == 0 {
+		return schema
+	}
+	copied := *schema
+	copied.Nullable = true
+	return &copied
+}
+
+// componentName names a type after its package and type name, e.g. usecases.ProductResponse
+func componentName(t reflect.Type) string {
+	name := t.String()
+	replacer := strings.NewReplacer("*", "", "[", "_", "]", "", "/", "_", " ", "")
+	return replacer.Replace(name)
+}
+
+// textFormat returns the string format of well-known text-marshaled types
+func textFormat(t reflect.Type) string {
+	if t.PkgPath() == "github.com/google/uuid" && t.Name() == "UUID" {
+		return "uuid"
+	}
+	return ""
+}
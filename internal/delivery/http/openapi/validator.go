@@ -0,0 +1,166 @@
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxDivergences caps how many divergences are reported for one document
+const maxDivergences = 20
+
+// validator checks decoded JSON values against schemas
+type validator struct {
+	registry    *schemaRegistry
+	divergences []string
+}
+
+// Validate checks a decoded JSON value against a schema and returns the divergences found
+func (s *Spec) Validate(value interface{}, schema *Schema) []string {
+	s.mu.RLock()
+	registry := s.registry
+	s.mu.RUnlock()
+
+	v := &validator{registry: registry}
+	v.validate("$", value, schema)
+	return v.divergences
+}
+
+func (v *validator) report(path, format string, args ...interface{}) {
+	if len(v.divergences) < maxDivergences {
+		v.divergences = append(v.divergences, path+": "+fmt.Sprintf(format, args...))
+	}
+}
+
+func (v *validator) validate(path string, value interface{}, schema *Schema) {
+	if schema == nil || len(v.divergences) >= maxDivergences {
+		return
+	}
+	if schema.Ref != "" {
+		resolved := v.registry.resolve(schema)
+		if resolved == nil {
+			v.report(path, "unresolved schema %s", schema.Ref)
+			return
+		}
+		schema = resolved
+	}
+
+	if value == nil {
+		if !schema.Nullable && (schema.Type != "" || len(schema.AllOf) > 0) {
+			v.report(path, "expected %s, got null", describe(schema))
+		}
+		return
+	}
+
+	for _, part := range schema.AllOf {
+		v.validate(path, value, part)
+	}
+
+	switch schema.Type {
+	case "":
+		return
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			v.report(path, "expected object, got %s", jsonType(value))
+			return
+		}
+		v.validateObject(path, object, schema)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			v.report(path, "expected array, got %s", jsonType(value))
+			return
+		}
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, schema.Items)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			v.report(path, "expected string, got %s", jsonType(value))
+			return
+		}
+		v.validateFormat(path, str, schema.Format)
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			v.report(path, "expected integer, got %s", jsonType(value))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			v.report(path, "expected number, got %s", jsonType(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.report(path, "expected boolean, got %s", jsonType(value))
+		}
+	}
+}
+
+func (v *validator) validateObject(path string, object map[string]interface{}, schema *Schema) {
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			v.report(path, "missing required property %q", name)
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if property, ok := schema.Properties[name]; ok {
+			v.validate(path+"."+name, object[name], property)
+		} else if schema.AdditionalProperties != nil {
+			v.validate(path+"."+name, object[name], schema.AdditionalProperties)
+		}
+	}
+}
+
+func (v *validator) validateFormat(path, value, format string) {
+	switch format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+			v.report(path, "expected date-time, got %q", value)
+		}
+	case "uuid":
+		if _, err := uuid.Parse(value); err != nil {
+			v.report(path, "expected uuid, got %q", value)
+		}
+	}
+}
+
+func describe(schema *Schema) string {
+	if schema.Type == "" {
+		return "value"
+	}
+	return schema.Type
+}
+
+func jsonType(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,7789 @@
+// Code generated by cmd/openapi-gen; DO NOT EDIT.
+
+package routes
+
+import (
+	"ecom-golang-clean-architecture/internal/delivery/http/handlers"
+	"ecom-golang-clean-architecture/internal/delivery/http/openapi"
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	"ecom-golang-clean-architecture/internal/usecases"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
+)
+
+// openAPIOperations documents handlers by "Handler.Method", generated from their swagger annotations
+var openAPIOperations = map[string]openapi.OperationDoc{
+	"AbandonedCartHandler.GetAbandonedCartStats": {
+		Summary:     "Get abandoned cart stats",
+		Description: "Gets abandoned cart statistics",
+		Tags:        []string{"abandoned-carts"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "days", In: "query", Type: "int", Description: "Days"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AbandonedCartStats{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AbandonedCartHandler.GetAbandonedCarts": {
+		Summary:     "Get abandoned carts",
+		Description: "Gets list of abandoned carts",
+		Tags:        []string{"abandoned-carts"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.AbandonedCartResponse(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AbandonedCartHandler.ProcessAbandonedCarts": {
+		Summary:     "Process abandoned carts",
+		Description: "Processes abandoned carts and sends reminder emails",
+		Tags:        []string{"abandoned-carts"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AbandonedCartHandler.SendReminderEmail": {
+		Summary:     "Send reminder email",
+		Description: "Sends reminder email for specific abandoned cart",
+		Tags:        []string{"abandoned-carts"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.CreateAddress": {
+		Summary:     "Create a new address",
+		Description: "Create a new address for the authenticated user",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Body:        usecases.CreateAddressRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.AddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.DeleteAddress": {
+		Summary:     "Delete address",
+		Description: "Delete an existing address",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Address ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.GetAddress": {
+		Summary:     "Get address by ID",
+		Description: "Get a specific address by its ID",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Address ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.GetAddresses": {
+		Summary:     "Get user's addresses",
+		Description: "Get all addresses for the authenticated user",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of addresses per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.GetCheckoutAddresses": {
+		Summary:     "Get checkout addresses",
+		Description: "Get default shipping and billing addresses plus all usable saved addresses for checkout",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CheckoutAddressesResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.GetDefaultAddress": {
+		Summary:     "Get default address",
+		Description: "Get default address for shipping or billing",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "query", Type: "string", Required: true, Description: "Address type (shipping/billing)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.SetDefaultAddress": {
+		Summary:     "Set default address",
+		Description: "Set an address as default for shipping or billing",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Address ID"},
+		},
+		Body: map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AddressHandler.UpdateAddress": {
+		Summary:     "Update address",
+		Description: "Update an existing address",
+		Tags:        []string{"addresses"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Address ID"},
+		},
+		Body: usecases.UpdateAddressRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BackupDatabase": {
+		Summary:     "Backup database",
+		Description: "Creates a database backup",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BackupResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BulkActivateUsers": {
+		Summary: "Bulk activate users",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.BulkUserActivateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkUserActivateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BulkDeactivateUsers": {
+		Summary: "Bulk deactivate users",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.BulkUserDeactivateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkUserDeactivateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BulkDeleteUsers": {
+		Summary: "Bulk delete users",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.BulkUserDeleteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkUserDeleteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BulkUpdateUserRoles": {
+		Summary: "Bulk update user roles",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.BulkUserRoleUpdateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkUserRoleUpdateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.BulkUpdateUsers": {
+		Summary: "Bulk update users",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.BulkUserUpdateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkUserUpdateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.CreateAnnouncement": {
+		Summary: "Create announcement",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Body:    usecases.AnnouncementRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.AnnouncementResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetAllUsersLoginHistory": {
+		Summary:     "Get all users login history (Admin)",
+		Description: "Get login history overview for all users with filtering and search",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from (RFC3339 format)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to (RFC3339 format)"},
+			{Name: "success", In: "query", Type: "bool", Description: "Filter by success status"},
+			{Name: "user_id", In: "query", Type: "string", Description: "Filter by user ID"},
+			{Name: "ip_address", In: "query", Type: "string", Description: "Filter by IP address"},
+			{Name: "search", In: "query", Type: "string", Description: "Search by email, name, or IP"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminAllLoginHistoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetAuditLogs": {
+		Summary:     "Get audit logs",
+		Description: "Returns audit logs",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AuditLogsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetCleanupStats": {
+		Summary:     "Get cleanup stats",
+		Description: "Returns cleanup statistics - DEPRECATED",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"AdminHandler.GetCustomerAnalytics": {
+		Summary:     "Get customer analytics",
+		Description: "Get comprehensive customer analytics and insights",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from filter (RFC3339)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to filter (RFC3339)"},
+			{Name: "segment", In: "query", Type: "string", Description: "Customer segment filter"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CustomerAnalyticsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetCustomerLifetimeValue": {
+		Summary:     "Get customer lifetime value",
+		Description: "Calculate and return customer lifetime value with analytics",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "customer_id", In: "path", Type: "string", Required: true, Description: "Customer ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CustomerLifetimeValueResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetCustomerSegments": {
+		Summary:     "Get customer segments",
+		Description: "Get customer segmentation analysis with statistics",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CustomerSegmentsResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetCustomersBySegment": {
+		Summary:     "Get customers by segment",
+		Description: "Get customers filtered by specific segment with pagination",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "segment", In: "path", Type: "string", Required: true, Description: "Customer segment"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CustomersBySegmentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetDashboard": {
+		Summary:     "Get dashboard",
+		Description: "Returns admin dashboard data",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminDashboardResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetHighValueCustomers": {
+		Summary:     "Get high value customers",
+		Description: "Get list of high value customers based on spending and order criteria",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.HighValueCustomersResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetLoginSecurityReport": {
+		Summary:     "Get login security report (Admin)",
+		Description: "Generate comprehensive security report with login analytics and risk assessment",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from (RFC3339 format)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to (RFC3339 format)"},
+			{Name: "report_type", In: "query", Type: "string", Description: "Report type"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SecurityReportResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetOrderDetails": {
+		Summary:     "Get order details",
+		Description: "Returns detailed order information",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminOrderDetailsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetOrders": {
+		Summary:     "Get orders",
+		Description: "Returns paginated list of orders",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status"},
+			{Name: "payment_status", In: "query", Type: "string", Description: "Payment status"},
+			{Name: "user_id", In: "query", Type: "string", Description: "User ID"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to"},
+			{Name: "search", In: "query", Type: "string", Description: "Search"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminOrdersResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetRecentActivity": {
+		Summary:     "Get recent activity",
+		Description: "Returns recent admin activity",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"AdminHandler.GetSuspiciousLoginActivity": {
+		Summary:     "Get suspicious login activities (Admin)",
+		Description: "Identify and retrieve suspicious login activities with risk assessment",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from (RFC3339 format)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to (RFC3339 format)"},
+			{Name: "min_risk_score", In: "query", Type: "number", Description: "Minimum risk score"},
+			{Name: "activity_type", In: "query", Type: "string", Description: "Activity type filter"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SuspiciousActivityResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetSystemLogs": {
+		Summary:     "Get system logs",
+		Description: "Returns system logs",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SystemLogsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetSystemStats": {
+		Summary:     "Get system stats",
+		Description: "Size, table and connection counts of the database, and uptime and memory use of the serving instance. See /admin/system/status for queues, jobs and provider health.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SystemStatsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetUserActivity": {
+		Summary:     "Get user activity",
+		Description: "Returns user activity",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ActivityResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetUserAuditLogs": {
+		Summary: "Get user audit logs",
+		Tags:    []string{"admin"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserAuditLogsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetUserLoginHistory": {
+		Summary:     "Get user login history (Admin)",
+		Description: "Get login history for a specific user with admin privileges",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "user_id", In: "path", Type: "string", Required: true, Description: "User ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from (RFC3339 format)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to (RFC3339 format)"},
+			{Name: "success", In: "query", Type: "bool", Description: "Filter by success status"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminLoginHistoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.GetUsers": {
+		Summary:     "Get users",
+		Description: "Returns paginated list of users",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.ManageReviews": {
+		Summary:     "Manage reviews",
+		Description: "Returns paginated list of reviews for admin management",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ManageReviewsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.ProcessRefund": {
+		Summary:     "Process refund",
+		Description: "Processes a refund for an order",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.ReplyToReview": {
+		Summary:     "Reply to review",
+		Description: "Allows admin to reply to a review",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.SearchCustomers": {
+		Summary:     "Search customers with advanced filters",
+		Description: "Search customers with advanced filtering, segmentation, and analytics",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "query", In: "query", Type: "string", Description: "Search query"},
+			{Name: "role", In: "query", Type: "string", Description: "User role filter"},
+			{Name: "status", In: "query", Type: "string", Description: "User status filter"},
+			{Name: "is_active", In: "query", Type: "bool", Description: "Active status filter"},
+			{Name: "email_verified", In: "query", Type: "bool", Description: "Email verified filter"},
+			{Name: "phone_verified", In: "query", Type: "bool", Description: "Phone verified filter"},
+			{Name: "two_factor_enabled", In: "query", Type: "bool", Description: "Two factor enabled filter"},
+			{Name: "membership_tier", In: "query", Type: "string", Description: "Membership tier filter"},
+			{Name: "customer_segment", In: "query", Type: "string", Description: "Customer segment filter"},
+			{Name: "min_total_spent", In: "query", Type: "number", Description: "Minimum total spent filter"},
+			{Name: "max_total_spent", In: "query", Type: "number", Description: "Maximum total spent filter"},
+			{Name: "min_total_orders", In: "query", Type: "int", Description: "Minimum total orders filter"},
+			{Name: "max_total_orders", In: "query", Type: "int", Description: "Maximum total orders filter"},
+			{Name: "min_loyalty_points", In: "query", Type: "int", Description: "Minimum loyalty points filter"},
+			{Name: "max_loyalty_points", In: "query", Type: "int", Description: "Maximum loyalty points filter"},
+			{Name: "created_from", In: "query", Type: "string", Description: "Created from date filter (RFC3339)"},
+			{Name: "created_to", In: "query", Type: "string", Description: "Created to date filter (RFC3339)"},
+			{Name: "last_login_from", In: "query", Type: "string", Description: "Last login from date filter (RFC3339)"},
+			{Name: "last_login_to", In: "query", Type: "string", Description: "Last login to date filter (RFC3339)"},
+			{Name: "last_activity_from", In: "query", Type: "string", Description: "Last activity from date filter (RFC3339)"},
+			{Name: "last_activity_to", In: "query", Type: "string", Description: "Last activity to date filter (RFC3339)"},
+			{Name: "include_inactive", In: "query", Type: "bool", Description: "Include inactive customers"},
+			{Name: "include_unverified", In: "query", Type: "bool", Description: "Include unverified customers"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by field"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.CustomerSearchResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.SendBulkEmail": {
+		Summary:     "Send bulk email",
+		Description: "Subject and body may use {{variable}} placeholders, filled in from each user and data. Users for whom any would go out unfilled fail; preview the email first with POST /admin/messages/preview.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.BulkEmailRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkEmailResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.SendBulkNotification": {
+		Summary:     "Send bulk notification",
+		Description: "Title and message may use {{variable}} placeholders, filled in from each user and data. Users for whom any would go out unfilled fail; preview the message first with POST /admin/messages/preview.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.BulkNotificationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkNotificationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.SendUserEmail": {
+		Summary:     "Send user email",
+		Description: "Subject and body may use {{variable}} placeholders, filled in from the user and data. The email is refused if any would go out unfilled; check it first with POST /admin/messages/preview.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.UserEmailRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserEmailResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.SendUserNotification": {
+		Summary:     "Send user notification",
+		Description: "Title and message may use {{variable}} placeholders, filled in from the user and data. The notification is refused if any would go out unfilled; check it first with POST /admin/messages/preview.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.UserNotificationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserNotificationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.TriggerCleanup": {
+		Summary:     "Trigger cleanup",
+		Description: "Manually triggers cleanup process - DEPRECATED",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"AdminHandler.UpdateOrderStatus": {
+		Summary:     "Update order status",
+		Description: "Updates an order's status",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.UpdateReviewStatus": {
+		Summary:     "Update review status",
+		Description: "Updates review status (approve/reject/hide)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.UpdateUserRole": {
+		Summary:     "Update user role",
+		Description: "Updates a user's role",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminHandler.UpdateUserStatus": {
+		Summary:     "Update user status",
+		Description: "Updates a user's status",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.AddCustomerNote": {
+		Summary:     "Add customer internal note",
+		Description: "Adds an internal note or reply to a customer. Admins mentioned with @email or @username are notified.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "customer_id", In: "path", Type: "string", Required: true, Description: "Customer ID"},
+		},
+		Body: usecases.CreateAdminNoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: usecases.AdminNoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.AddOrderNote": {
+		Summary:     "Add order internal note",
+		Description: "Adds an internal note or reply to an order. Admins mentioned with @email or @username are notified.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Body: usecases.CreateAdminNoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: usecases.AdminNoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.DeleteNote": {
+		Summary:     "Delete internal note",
+		Description: "Deletes an internal note together with its replies",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Note ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.ListCustomerNotes": {
+		Summary:     "List customer internal notes",
+		Description: "Lists internal notes on a customer as threads, pinned notes first. Notes are never shown to the customer.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "customer_id", In: "path", Type: "string", Required: true, Description: "Customer ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.AdminNoteResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.ListOrderNotes": {
+		Summary:     "List order internal notes",
+		Description: "Lists internal notes on an order as threads, pinned notes first. Notes are never shown to the customer.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.AdminNoteResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.PinNote": {
+		Summary:     "Pin internal note",
+		Description: "Pins a note to the top of its order's or customer's notes. Replies cannot be pinned.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Note ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.AdminNoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.UnpinNote": {
+		Summary: "Unpin internal note",
+		Tags:    []string{"admin-notes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Note ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.AdminNoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminNoteHandler.UpdateNote": {
+		Summary:     "Edit internal note",
+		Description: "Edits an internal note. Only the author can edit a note; newly mentioned admins are notified.",
+		Tags:        []string{"admin-notes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Note ID"},
+		},
+		Body: usecases.UpdateAdminNoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminNoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminTaskHandler.AssignTask": {
+		Summary:     "Assign admin task",
+		Description: "Assign an open task to an active admin or moderator, who is notified unless they assigned it to themselves. Leave out assignee_id to unassign the task.",
+		Tags:        []string{"admin-inbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Task ID"},
+		},
+		Body: usecases.AssignAdminTaskRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminTaskResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminTaskHandler.CompleteTask": {
+		Summary:     "Complete admin task",
+		Description: "Mark an open task done with an optional note. Tasks also complete on their own once their source no longer needs attention; a task completed while its source still does is raised again.",
+		Tags:        []string{"admin-inbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Task ID"},
+		},
+		Body: usecases.CompleteAdminTaskRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminTaskResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminTaskHandler.GetInbox": {
+		Summary:     "Get admin inbox",
+		Description: "Get the open task counts per type (dispute_evidence, order_verification, stock_reorder, review_moderation) with a page of tasks, most urgent first. Tasks are raised and resolved automatically every minute from disputes needing evidence, COD and bank transfer orders awaiting payment confirmation, stock at its reorder level and reviews awaiting moderation.",
+		Tags:        []string{"admin-inbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "query", Type: "string", Description: "Task type"},
+			{Name: "status", In: "query", Type: "string", Description: "Task status (open, completed)"},
+			{Name: "assignee", In: "query", Type: "string", Description: "me, unassigned or an admin's user ID"},
+			{Name: "overdue", In: "query", Type: "bool", Description: "Only tasks past their due date"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of tasks to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of tasks to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminInboxResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminTaskHandler.GetTask": {
+		Summary:     "Get admin task",
+		Description: "Get an inbox task with its source_type and source_id, the review, order, inventory or dispute it is about",
+		Tags:        []string{"admin-inbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Task ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminTaskResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AdminTaskHandler.SetTaskDueDate": {
+		Summary:     "Set admin task due date",
+		Description: "Set or clear the due date of an open task. Dispute tasks are moved back to the provider's evidence deadline whenever the provider changes it.",
+		Tags:        []string{"admin-inbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Task ID"},
+		},
+		Body: usecases.SetAdminTaskDueDateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AdminTaskResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AggregateRepairHandler.GetRepairRun": {
+		Summary:     "Get aggregate repair run",
+		Description: "Get an aggregate repair run with the discrepancies it found",
+		Tags:        []string{"aggregates"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Repair run ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.AggregateRepairRun{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AggregateRepairHandler.ListRepairRuns": {
+		Summary:     "List aggregate repair runs",
+		Description: "List aggregate repair runs with their per-aggregate counts, newest first",
+		Tags:        []string{"aggregates"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Number of runs to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of runs to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AggregateRepairRunsResponse{}},
+		},
+	},
+	"AggregateRepairHandler.RepairAggregates": {
+		Summary:     "Repair denormalized aggregates now",
+		Description: "Compare customer order totals, product rating summaries and review vote counts with their source tables and repair the records that drifted. With dry_run the discrepancies are only reported.",
+		Tags:        []string{"aggregates"},
+		Secured:     true,
+		Body:        usecases.RepairAggregatesRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.AggregateRepairRun{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetProductMetrics": {
+		Summary:     "Get product metrics",
+		Description: "Returns product metrics",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductMetricsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetRealTimeMetrics": {
+		Summary:     "Get real time metrics",
+		Description: "Returns real-time metrics",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.RealTimeMetricsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetSalesMetrics": {
+		Summary:     "Get sales metrics",
+		Description: "Returns sales metrics",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SalesMetricsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetTopCategories": {
+		Summary:     "Get top categories",
+		Description: "Returns top categories",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetTopProducts": {
+		Summary:     "Get top products",
+		Description: "Returns top products",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetTrafficMetrics": {
+		Summary:     "Get traffic metrics",
+		Description: "Returns traffic metrics",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.TrafficMetricsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetUserMetrics": {
+		Summary:     "Get user metrics",
+		Description: "Returns user metrics",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserMetricsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.GetVisitorFunnel": {
+		Summary:     "Get visitor funnel",
+		Description: "Returns how many visitor sessions, signed in or anonymous, reached each funnel step in order. Steps default to product_view, add_to_cart, checkout and purchase; dates default to the last 30 days.",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "steps", In: "query", Type: "string", Description: "Comma-separated event types"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Date from (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "Date to (YYYY-MM-DD)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VisitorFunnelResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.TrackEvent": {
+		Summary:     "Track event",
+		Description: "Tracks a custom event",
+		Tags:        []string{"analytics"},
+		Secured:     true,
+		Body:        usecases.TrackEventRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.TrackPageView": {
+		Summary:     "Track page view",
+		Description: "Tracks a page view for the current visitor. The visitor session comes from the anonymous session cookie or X-Session-ID header and is attributed to the user when signed in.",
+		Tags:        []string{"analytics"},
+		Body:        usecases.TrackPageViewRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"AnalyticsHandler.TrackProductView": {
+		Summary:     "Track product view",
+		Description: "Tracks a product view for the current visitor. The visitor session comes from the anonymous session cookie or X-Session-ID header and is attributed to the user when signed in.",
+		Tags:        []string{"analytics"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BackInStockHandler.Subscribe": {
+		Summary:     "Get notified when a product is back in stock",
+		Description: "Subscribe to an out-of-stock product. The response includes the restock estimate when a purchase order for the product is on its way. Subscribers are notified oldest first, in batches sized to the restocked units.",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.BackInStockSubscriptionResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BackInStockHandler.Unsubscribe": {
+		Summary: "Stop back in stock notifications for a product",
+		Tags:    []string{"products"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BrandHandler.CreateBrand": {
+		Summary:     "Create brand",
+		Description: "Create a new brand",
+		Tags:        []string{"brands"},
+		Body:        usecases.CreateBrandRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.BrandResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BrandHandler.DeleteBrand": {
+		Summary:     "Delete brand",
+		Description: "Delete a brand",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Brand ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BrandHandler.GetActiveBrands": {
+		Summary:     "Get active brands",
+		Description: "Get list of active brands",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrandsListResponse{}},
+		},
+	},
+	"BrandHandler.GetBrand": {
+		Summary:     "Get brand",
+		Description: "Get brand by ID",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Brand ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrandResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BrandHandler.GetBrandBySlug": {
+		Summary:     "Get brand by slug",
+		Description: "Get brand by slug",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "slug", In: "path", Type: "string", Required: true, Description: "Brand slug"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrandResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"BrandHandler.GetBrands": {
+		Summary:     "Get brands list",
+		Description: "Get list of brands with pagination",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+			{Name: "is_active", In: "query", Type: "bool", Description: "Filter by active status"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"BrandHandler.GetPopularBrands": {
+		Summary:     "Get popular brands",
+		Description: "Get list of popular brands by product count",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.BrandResponse(nil)},
+		},
+	},
+	"BrandHandler.SearchBrands": {
+		Summary:     "Search brands",
+		Description: "Search brands by name or description",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrandsListResponse{}},
+		},
+	},
+	"BrandHandler.UpdateBrand": {
+		Summary:     "Update brand",
+		Description: "Update an existing brand",
+		Tags:        []string{"brands"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Brand ID"},
+		},
+		Body: usecases.UpdateBrandRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrandResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.AddToCart": {
+		Summary:     "Add item to cart",
+		Description: "Add a product to the shopping cart (user or guest)",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "X-Session-ID", In: "header", Type: "string", Description: "Session ID for guest cart"},
+		},
+		Body: usecases.AddToCartRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CartResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.CheckCartConflict": {
+		Summary:     "Check cart merge conflicts",
+		Description: "Check if guest cart merge will cause conflicts with existing user cart",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Body:        handlers.CheckConflictRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: handlers.CartConflictResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.ClearCart": {
+		Summary:     "Clear cart",
+		Description: "Remove all items from the shopping cart",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.GetCart": {
+		Summary:     "Get user's cart or guest cart",
+		Description: "Get current user's shopping cart or guest cart by session ID",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "X-Session-ID", In: "header", Type: "string", Description: "Session ID for guest cart"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CartResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.MergeGuestCart": {
+		Summary:     "Merge guest cart with user cart",
+		Description: "Merge guest cart items into user cart when user logs in",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Body:        handlers.MergeCartRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CartResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.RemoveFromCart": {
+		Summary:     "Remove item from cart",
+		Description: "Remove a product from the shopping cart",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "productId", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CartResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CartHandler.UpdateCartItem": {
+		Summary:     "Update cart item",
+		Description: "Update quantity of an item in the cart",
+		Tags:        []string{"cart"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "productId", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: usecases.UpdateCartItemRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CartResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.CreateChangeset": {
+		Summary:     "Create a catalog changeset",
+		Description: "Start an empty draft changeset to batch product edits in. Its preview token shows the storefront with the edits applied through the preview_token query parameter of the product endpoints.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Body:        usecases.CreateCatalogChangesetRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.DeleteChangeset": {
+		Summary:     "Delete a catalog changeset",
+		Description: "Discard an unpublished changeset and its staged edits. Published changesets are kept for rollback.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.GetChangeset": {
+		Summary:     "Get a catalog changeset",
+		Description: "Get a changeset with each product's staged edits. Until the changeset is published, before and after show the product now and with the edits applied, and error flags edits that no longer apply.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.ListChangesets": {
+		Summary: "List catalog changesets",
+		Tags:    []string{"catalog-changesets"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status filter (draft, scheduled, published, rolled_back, failed)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.PublishChangeset": {
+		Summary:     "Publish a catalog changeset",
+		Description: "Apply all of the changeset's edits in one transaction. Nothing is published if any product's edits no longer apply.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.PublishDueChangesets": {
+		Summary: "Publish due catalog changesets now",
+		Tags:    []string{"catalog-changesets"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.RemoveChangesetItem": {
+		Summary: "Remove a product from a catalog changeset",
+		Tags:    []string{"catalog-changesets"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.RollbackChangeset": {
+		Summary:     "Roll back a catalog changeset",
+		Description: "Restore the products a published changeset edited to how they were before it. Products edited again since are skipped.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.SaveChangesetItem": {
+		Summary:     "Stage product edits in a catalog changeset",
+		Description: "Stage edits to a product's content, pricing, featured flag, visibility or status, replacing the product's earlier edits in the changeset. Omitted fields are left unchanged.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: entities.CatalogProductChanges{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetItemResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.ScheduleChangeset": {
+		Summary:     "Schedule a catalog changeset",
+		Description: "Publish the changeset automatically at the given time. If its edits no longer apply then, the changeset is marked failed and nothing is published.",
+		Tags:        []string{"catalog-changesets"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Body: usecases.ScheduleCatalogChangesetRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.UnscheduleChangeset": {
+		Summary: "Unschedule a catalog changeset",
+		Tags:    []string{"catalog-changesets"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogChangesetHandler.UpdateChangeset": {
+		Summary: "Update a catalog changeset",
+		Tags:    []string{"catalog-changesets"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Changeset ID"},
+		},
+		Body: usecases.UpdateCatalogChangesetRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CatalogChangesetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.CreateRule": {
+		Summary:     "Create catalog visibility rule",
+		Description: "Restrict a product, or a category and its subcategories, to an audience",
+		Tags:        []string{"admin-catalog-visibility"},
+		Secured:     true,
+		Body:        usecases.CreateVisibilityRuleRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.VisibilityRuleResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.DeleteRule": {
+		Summary: "Delete catalog visibility rule",
+		Tags:    []string{"admin-catalog-visibility"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Rule ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.GetRule": {
+		Summary: "Get catalog visibility rule",
+		Tags:    []string{"admin-catalog-visibility"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Rule ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VisibilityRuleResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.ListRules": {
+		Summary: "List catalog visibility rules",
+		Tags:    []string{"admin-catalog-visibility"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "product_id", In: "query", Type: "string", Description: "Filter by product ID"},
+			{Name: "category_id", In: "query", Type: "string", Description: "Filter by category ID"},
+			{Name: "audience", In: "query", Type: "string", Description: "Filter by audience"},
+			{Name: "is_active", In: "query", Type: "bool", Description: "Filter by active state"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.PreviewHiddenProducts": {
+		Summary:     "Preview hidden products",
+		Description: "List the products hidden from a customer, or from guests when user_id is omitted",
+		Tags:        []string{"admin-catalog-visibility"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "user_id", In: "query", Type: "string", Description: "Customer ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.HiddenProductsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CatalogVisibilityHandler.UpdateRule": {
+		Summary: "Update catalog visibility rule",
+		Tags:    []string{"admin-catalog-visibility"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Rule ID"},
+		},
+		Body: usecases.UpdateVisibilityRuleRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VisibilityRuleResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryAssignmentHandler.CreateAssignment": {
+		Summary:     "Run a bulk category assignment",
+		Description: "Add products to a category, remove them from it, or move them from a source category to it. Products are selected by IDs or by filter. Assignments of up to 500 products are applied right away (201); larger ones are accepted (202) and run in the background, so poll the assignment for its status.",
+		Tags:        []string{"category-assignments"},
+		Secured:     true,
+		Body:        usecases.CategoryAssignmentRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.CategoryAssignment{}},
+			202: {Body: handlers.SuccessResponse{}, Data: entities.CategoryAssignment{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryAssignmentHandler.GetAssignment": {
+		Summary:     "Get a bulk category assignment",
+		Description: "Get an assignment with its status and the number of products it matched and changed.",
+		Tags:        []string{"category-assignments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Assignment ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.CategoryAssignment{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryAssignmentHandler.ListAssignments": {
+		Summary: "List bulk category assignments",
+		Tags:    []string{"category-assignments"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status filter (pending, running, completed, failed)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CategoryAssignmentHandler.PreviewAssignment": {
+		Summary:     "Preview a bulk category assignment",
+		Description: "Count the products an assignment would match and change, with a sample of the changed products, without changing anything.",
+		Tags:        []string{"category-assignments"},
+		Secured:     true,
+		Body:        usecases.CategoryAssignmentRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryAssignmentPreviewResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.BulkCreateCategories": {
+		Summary:     "Bulk create categories",
+		Description: "Create multiple categories at once",
+		Tags:        []string{"categories"},
+		Body:        []usecases.CreateCategoryRequest(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+		},
+	},
+	"CategoryHandler.BulkDeleteCategories": {
+		Summary:     "Bulk delete categories",
+		Description: "Delete multiple categories at once",
+		Tags:        []string{"categories"},
+		Body:        map[string][]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"CategoryHandler.BulkGenerateSEO": {
+		Summary:     "Bulk generate SEO",
+		Description: "Generate SEO metadata for multiple categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.BulkSEOGenerateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkSEOGenerateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.BulkUpdateCategories": {
+		Summary:     "Bulk update categories",
+		Description: "Update multiple categories at once",
+		Tags:        []string{"categories"},
+		Body:        []usecases.BulkUpdateCategoryRequest(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+		},
+	},
+	"CategoryHandler.BulkUpdateSEO": {
+		Summary:     "Bulk update SEO",
+		Description: "Update SEO metadata for multiple categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.BulkSEOUpdateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkSEOUpdateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.BulkValidateSEO": {
+		Summary:     "Bulk validate SEO",
+		Description: "Validate SEO metadata for multiple categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.BulkSEOValidateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkSEOValidateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.CreateCategory": {
+		Summary:     "Create a new category",
+		Description: "Create a new category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.CreateCategoryRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.DeleteCategory": {
+		Summary:     "Delete category",
+		Description: "Delete a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GenerateCategorySEO": {
+		Summary:     "Generate category SEO",
+		Description: "Auto-generate SEO metadata for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategorySEOResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GenerateSlugSuggestions": {
+		Summary:     "Generate slug suggestions",
+		Description: "Generate SEO-friendly slug suggestions for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SlugSuggestionsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategories": {
+		Summary:     "Get categories list",
+		Description: "Get list of categories with pagination",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (parent, children, seo)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoriesWithFilters": {
+		Summary:     "Get categories with filters",
+		Description: "Get categories with advanced filtering options",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "search", In: "query", Type: "string", Description: "Search query"},
+			{Name: "parent_id", In: "query", Type: "string", Description: "Parent category ID"},
+			{Name: "is_active", In: "query", Type: "bool", Description: "Active status"},
+			{Name: "has_parent", In: "query", Type: "bool", Description: "Has parent filter"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by field"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoriesListResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategory": {
+		Summary:     "Get category by ID",
+		Description: "Get a single category by its ID",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryAnalytics": {
+		Summary:     "Get category analytics",
+		Description: "Get comprehensive analytics for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+			{Name: "time_range", In: "query", Type: "string", Description: "Time range"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryAnalyticsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryChildren": {
+		Summary:     "Get category children",
+		Description: "Get child categories of a specific category",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Parent Category ID"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (parent, children, seo)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryLandingPage": {
+		Summary:     "Get category landing page",
+		Description: "Get category with products, subcategories, featured products, and navigation data",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Products per page"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by field"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "include_subcategory_products", In: "query", Type: "bool", Description: "Include products from subcategories"},
+			{Name: "include_featured", In: "query", Type: "bool", Description: "Include featured products in category"},
+			{Name: "featured_limit", In: "query", Type: "int", Description: "Featured products limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryLandingPageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryPath": {
+		Summary:     "Get category path",
+		Description: "Get full path from root to specified category (breadcrumbs)",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryPerformanceMetrics": {
+		Summary:     "Get category performance metrics",
+		Description: "Get detailed performance metrics for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryPerformanceResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryProductCount": {
+		Summary:     "Get category product count",
+		Description: "Get total product count for category including all subcategories",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]int64(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategorySEO": {
+		Summary:     "Get category SEO",
+		Description: "Get SEO metadata for a category",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategorySEOResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategorySalesStats": {
+		Summary:     "Get category sales statistics",
+		Description: "Get sales statistics for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+			{Name: "time_range", In: "query", Type: "string", Description: "Time range"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategorySalesStatsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetCategoryTree": {
+		Summary:     "Get category tree",
+		Description: "Get hierarchical category tree",
+		Tags:        []string{"categories"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+		},
+	},
+	"CategoryHandler.GetCategoryTreeStats": {
+		Summary:     "Get category tree statistics",
+		Description: "Get statistics about the category tree (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryTreeStatsResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetRootCategories": {
+		Summary:     "Get root categories",
+		Description: "Get categories that have no parent",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,slug,image; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (parent, children, seo)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.CategoryResponse(nil)},
+		},
+	},
+	"CategoryHandler.GetSEOAnalytics": {
+		Summary:     "Get SEO analytics",
+		Description: "Get comprehensive SEO analytics across categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "category_ids", In: "query", Type: "[]string", Description: "Category IDs to analyze"},
+			{Name: "date_from", In: "query", Type: "string", Description: "Start date for analysis (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "End date for analysis (YYYY-MM-DD)"},
+			{Name: "metrics", In: "query", Type: "[]string", Description: "Specific metrics to include"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SEOAnalyticsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetSEOCompetitorAnalysis": {
+		Summary:     "Get SEO competitor analysis",
+		Description: "Get competitor analysis and benchmarks for category SEO (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SEOCompetitorAnalysisResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetSEOInsights": {
+		Summary:     "Get SEO insights",
+		Description: "Get detailed SEO insights and recommendations for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SEOInsightsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetSlugHistory": {
+		Summary:     "Get slug history",
+		Description: "Get slug change history for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SlugHistoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.GetTopCategories": {
+		Summary:     "Get top categories",
+		Description: "Get top performing categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.TopCategoriesResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.MoveCategory": {
+		Summary:     "Move category to new parent",
+		Description: "Move a category to a new parent (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.MoveCategoryRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.OptimizeSlug": {
+		Summary:     "Optimize category slug",
+		Description: "Optimize category slug for better SEO (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Body: usecases.SlugOptimizationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SlugOptimizationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.ReorderCategories": {
+		Summary:     "Reorder categories",
+		Description: "Reorder multiple categories (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Body:        usecases.ReorderCategoriesRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.SearchCategories": {
+		Summary:     "Search categories",
+		Description: "Search categories by name and description",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoriesListResponse{}},
+		},
+	},
+	"CategoryHandler.UpdateCategory": {
+		Summary:     "Update category",
+		Description: "Update an existing category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Body: usecases.UpdateCategoryRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.UpdateCategorySEO": {
+		Summary:     "Update category SEO",
+		Description: "Update SEO metadata for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Body: usecases.CategorySEORequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.ValidateAndRepairTree": {
+		Summary:     "Validate and repair category tree",
+		Description: "Validate the category tree integrity and perform repairs if needed (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.TreeValidationResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.ValidateCategorySEO": {
+		Summary:     "Validate category SEO",
+		Description: "Validate SEO metadata for a category (admin only)",
+		Tags:        []string{"categories"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CategorySEOValidationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CategoryHandler.ValidateSlugAvailability": {
+		Summary:     "Validate slug availability",
+		Description: "Check if a slug is available and SEO-friendly",
+		Tags:        []string{"categories"},
+		Params: []openapi.ParamDoc{
+			{Name: "slug", In: "query", Type: "string", Required: true, Description: "Slug to validate"},
+			{Name: "exclude_id", In: "query", Type: "string", Description: "Category ID to exclude from check"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SlugValidationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CheckoutHandler.CancelCheckoutSession": {
+		Summary:     "Cancel checkout session",
+		Description: "Cancel an active checkout session",
+		Tags:        []string{"checkout"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "path", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CheckoutHandler.CompleteCheckoutSession": {
+		Summary:     "Complete checkout session",
+		Description: "Complete checkout session after successful payment",
+		Tags:        []string{"checkout"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "path", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CheckoutHandler.CreateCODOrder": {
+		Summary:     "Create COD order",
+		Description: "Create order directly for Cash on Delivery payments",
+		Tags:        []string{"checkout"},
+		Secured:     true,
+		Body:        usecases.CreateOrderRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CheckoutHandler.CreateCheckoutSession": {
+		Summary:     "Create checkout session",
+		Description: "Create a checkout session for online payments (credit card, PayPal, etc.)",
+		Tags:        []string{"checkout"},
+		Secured:     true,
+		Body:        usecases.CreateNewCheckoutSessionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.NewCheckoutSessionResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CheckoutHandler.GetCheckoutSession": {
+		Summary:     "Get checkout session",
+		Description: "Get checkout session by session ID",
+		Tags:        []string{"checkout"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "path", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.NewCheckoutSessionResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.AddMember": {
+		Summary: "Add company member",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+		},
+		Body: usecases.AddCompanyMemberRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyMemberResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.ApproveOrder": {
+		Summary: "Approve company order",
+		Tags:    []string{"company"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Approval ID"},
+		},
+		Body: usecases.DecideOrderApprovalRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.OrderApprovalResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.CreateCompany": {
+		Summary: "Create company account",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Body:    usecases.CreateCompanyRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.CreateCompanyAddress": {
+		Summary: "Add company shared address",
+		Tags:    []string{"company"},
+		Secured: true,
+		Body:    usecases.CreateAddressRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.AddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.DeleteCompanyAddress": {
+		Summary: "Delete company shared address",
+		Tags:    []string{"company"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Address ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GenerateInvoice": {
+		Summary:     "Generate consolidated company invoice",
+		Description: "Consolidate all uninvoiced company orders in the period into one invoice",
+		Tags:        []string{"companies"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+		},
+		Body: usecases.GenerateCompanyInvoiceRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyInvoiceResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetCompany": {
+		Summary: "Get company account",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetCompanyAddresses": {
+		Summary: "Get company shared addresses",
+		Tags:    []string{"company"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.AddressResponse(nil)},
+		},
+	},
+	"CompanyHandler.GetCompanyInvoices": {
+		Summary: "List company invoices",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CompanyHandler.GetInvoice": {
+		Summary: "Get company invoice",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "invoice_id", In: "path", Type: "string", Required: true, Description: "Invoice ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyInvoiceResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetMyCompany": {
+		Summary: "Get my company",
+		Tags:    []string{"company"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetMyCompanyInvoice": {
+		Summary: "Get my company invoice",
+		Tags:    []string{"company"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Invoice ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyInvoiceResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetMyCompanyInvoices": {
+		Summary: "List my company invoices",
+		Tags:    []string{"company"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.GetOrderApprovals": {
+		Summary: "List company order approvals",
+		Tags:    []string{"company"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status filter (pending, approved, rejected)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CompanyHandler.ListCompanies": {
+		Summary: "List company accounts",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "search", In: "query", Type: "string", Description: "Search by name or tax ID"},
+			{Name: "is_active", In: "query", Type: "bool", Description: "Filter by active status"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"CompanyHandler.MarkInvoicePaid": {
+		Summary: "Mark company invoice as paid",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "invoice_id", In: "path", Type: "string", Required: true, Description: "Invoice ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyInvoiceResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.RecordInvoicePayment": {
+		Summary: "Record company invoice payment",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "invoice_id", In: "path", Type: "string", Required: true, Description: "Invoice ID"},
+		},
+		Body: usecases.RecordInvoicePaymentRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyInvoiceResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.RejectOrder": {
+		Summary:     "Reject company order",
+		Description: "Reject a company order over the buyer's spend limit; the order is cancelled",
+		Tags:        []string{"company"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Approval ID"},
+		},
+		Body: usecases.DecideOrderApprovalRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.OrderApprovalResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.RemoveMember": {
+		Summary: "Remove company member",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+			{Name: "member_id", In: "path", Type: "string", Required: true, Description: "Member ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.SendInvoiceReminders": {
+		Summary: "Send overdue invoice reminders",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.UpdateCompany": {
+		Summary: "Update company account",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+		},
+		Body: usecases.UpdateCompanyRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CompanyHandler.UpdateMember": {
+		Summary: "Update company member",
+		Tags:    []string{"companies"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Company ID"},
+			{Name: "member_id", In: "path", Type: "string", Required: true, Description: "Member ID"},
+		},
+		Body: usecases.UpdateCompanyMemberRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CompanyMemberResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.CreateCoupon": {
+		Summary:     "Create coupon",
+		Description: "Creates a new coupon",
+		Tags:        []string{"coupons"},
+		Secured:     true,
+		Body:        usecases.CreateCouponRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CouponResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.DeleteCoupon": {
+		Summary:     "Delete coupon",
+		Description: "Deletes a coupon",
+		Tags:        []string{"coupons"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.GetCoupon": {
+		Summary:     "Get coupon",
+		Description: "Retrieves a coupon by ID",
+		Tags:        []string{"coupons"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CouponResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.ListCoupons": {
+		Summary:     "List coupons",
+		Description: "Returns paginated list of coupons",
+		Tags:        []string{"coupons"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "search", In: "query", Type: "string", Description: "Search"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CouponsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.UpdateCoupon": {
+		Summary:     "Update coupon",
+		Description: "Updates a coupon",
+		Tags:        []string{"coupons"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.UpdateCouponRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CouponResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"CouponHandler.ValidateCoupon": {
+		Summary:     "Validate coupon",
+		Description: "Validates a coupon",
+		Tags:        []string{"coupons"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CouponValidationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DataResidencyHandler.GetRegions": {
+		Summary:     "List data regions",
+		Description: "List the data regions customers' personal data and files are kept in, and the default region.",
+		Tags:        []string{"data-residency"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.DataRegionsResponse{}},
+		},
+	},
+	"DataResidencyHandler.SetExportAccess": {
+		Summary:     "Set an admin's data region access",
+		Description: "Set the data regions whose customer data an admin or moderator may export through order exports and reports. An empty list lets them export every region. Admins only grant regions they may export themselves.",
+		Tags:        []string{"data-residency"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "User ID"},
+		},
+		Body: usecases.SetDataRegionAccessRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.User{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DataResidencyHandler.SetUserRegion": {
+		Summary:     "Set a user's data region",
+		Description: "Set the data region a user's personal data is kept in. New uploads go to the new region's storage; files uploaded before stay where they are.",
+		Tags:        []string{"data-residency"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "User ID"},
+		},
+		Body: usecases.SetUserDataRegionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.User{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DisputeHandler.GetDispute": {
+		Summary:     "Get dispute",
+		Description: "Get a dispute with its evidence deadline and the payment and order it was matched to",
+		Tags:        []string{"disputes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Dispute ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Dispute{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DisputeHandler.GetDisputeMetrics": {
+		Summary:     "Get dispute metrics",
+		Description: "Count the disputes opened during a period with their dispute rate (disputes per paid payment) and win rate (won out of decided), as percentages. The period defaults to the last 30 days.",
+		Tags:        []string{"disputes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.DisputeMetricsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DisputeHandler.GetDisputes": {
+		Summary:     "Get disputes",
+		Description: "List disputes and chargebacks reported by the payment providers, most recently opened first",
+		Tags:        []string{"disputes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Dispute status (needs_response, under_review, won, lost, closed)"},
+			{Name: "gateway", In: "query", Type: "string", Description: "Payment provider (stripe, paypal)"},
+			{Name: "order_id", In: "query", Type: "string", Description: "Order ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of disputes to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of disputes to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.DisputesListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"DisputeHandler.RecordDisputeEvidence": {
+		Summary:     "Record dispute evidence",
+		Description: "Record that evidence was submitted to the payment provider for an open dispute, which stops evidence deadline reminders. The dispute stays open until the provider reports its outcome.",
+		Tags:        []string{"disputes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Dispute ID"},
+		},
+		Body: usecases.RecordDisputeEvidenceRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Dispute{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"EventBridgeHandler.GetStatus": {
+		Summary:     "Get event bridge status",
+		Description: "Get the number of domain events waiting in the outbox, how many of them failed to publish and the oldest failure",
+		Tags:        []string{"event-bridge"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.EventBridgeStatusResponse{}},
+		},
+	},
+	"EventBridgeHandler.PublishPendingEvents": {
+		Summary: "Publish pending events now",
+		Tags:    []string{"event-bridge"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"FileHandler.DeleteFile": {
+		Summary:     "Delete a file",
+		Description: "Delete a file by ID (authentication required)",
+		Tags:        []string{"files"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "File ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.GetFileUpload": {
+		Summary:     "Get file upload information",
+		Description: "Get file upload information by ID",
+		Tags:        []string{"files"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "File ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUpload{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.GetFileUploads": {
+		Summary:     "Get list of file uploads",
+		Description: "Get list of file uploads with filtering and pagination",
+		Tags:        []string{"files"},
+		Params: []openapi.ParamDoc{
+			{Name: "upload_type", In: "query", Type: "string", Description: "Upload type (admin, user, public)"},
+			{Name: "category", In: "query", Type: "string", Description: "File category (images, documents)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit number of results"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset for pagination"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadDocument": {
+		Summary:     "Upload a document file",
+		Description: "Upload a document file and return the URL (authentication required)",
+		Tags:        []string{"files"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Document file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadDocumentAdmin": {
+		Summary:     "Upload a document file (admin)",
+		Description: "Upload a document file and return the URL (admin only)",
+		Tags:        []string{"files"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Document file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadDocumentPublic": {
+		Summary:     "Upload a document file (public)",
+		Description: "Upload a document file and return the URL (no authentication required)",
+		Tags:        []string{"files"},
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Document file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadImage": {
+		Summary:     "Upload an image file",
+		Description: "Upload an image file and return the URL (authentication required)",
+		Tags:        []string{"files"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Image file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadImageAdmin": {
+		Summary:     "Upload an image file (admin)",
+		Description: "Upload an image file and return the URL (admin only)",
+		Tags:        []string{"files"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Image file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FileHandler.UploadImagePublic": {
+		Summary:     "Upload an image file (public)",
+		Description: "Upload an image file and return the URL (no authentication required)",
+		Tags:        []string{"files"},
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Image file to upload"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.FileUploadResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			413: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FulfillmentSLAHandler.GetOrders": {
+		Summary:     "Get fulfillment SLA orders",
+		Description: "List tracked orders, soonest ship-by time first. Only orders still on their way are listed unless all is set.",
+		Tags:        []string{"fulfillment-sla"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "state", In: "query", Type: "string", Description: "SLA state (on_track, at_risk, breached, met, missed, cancelled)"},
+			{Name: "stuck", In: "query", Type: "bool", Description: "Only orders stuck in their status"},
+			{Name: "all", In: "query", Type: "bool", Description: "Include delivered and closed orders"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of orders to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of orders to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.FulfillmentSLAOrdersResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"FulfillmentSLAHandler.GetSummary": {
+		Summary:     "Get fulfillment SLA summary",
+		Description: "Get the on-time shipping rate over the last days, overall and per day, with the counts of open orders on track, at risk of shipping late, late (breached) and stuck in a status. Orders must ship within the configured number of business days of the day they were placed.",
+		Tags:        []string{"fulfillment-sla"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "days", In: "query", Type: "int", Description: "Days to cover, today included"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.FulfillmentSLASummaryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.AdjustStock": {
+		Summary:     "Adjust stock",
+		Description: "Adjusts inventory stock",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Body:        usecases.AdjustStockRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.InventoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.CaptureInventorySnapshot": {
+		Summary:     "Capture inventory snapshot",
+		Description: "Snapshots current stock levels for today, replacing any snapshot already taken today. Snapshots are also taken automatically every hour.",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.InventorySnapshotResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetInventories": {
+		Summary:     "Get inventories",
+		Description: "Gets inventories with pagination",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "warehouse_id", In: "query", Type: "string", Description: "Warehouse id"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "search", In: "query", Type: "string", Description: "Search"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.InventoriesListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetInventory": {
+		Summary:     "Get inventory",
+		Description: "Gets inventory by product and warehouse ID",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.InventoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetLowStockItems": {
+		Summary:     "Get low stock items",
+		Description: "Gets low stock items",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.LowStockItemsResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetMovements": {
+		Summary:     "Get movements",
+		Description: "Gets inventory movements",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.MovementsListResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetOutOfStockItems": {
+		Summary:     "Get out of stock items",
+		Description: "Gets out of stock items",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "warehouse_id", In: "query", Type: "string", Description: "Warehouse id"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.InventoryResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetStockAlerts": {
+		Summary:     "Get stock alerts",
+		Description: "Gets stock alerts",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "type", In: "query", Type: "string", Description: "Type"},
+			{Name: "status", In: "query", Type: "string", Description: "Status"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AlertsListResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetStockAsOf": {
+		Summary:     "Get stock as of a date",
+		Description: "Gets stock levels and value from the latest inventory snapshot on or before the given date",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date", In: "query", Type: "string", Required: true, Description: "Date (YYYY-MM-DD)"},
+			{Name: "warehouse_id", In: "query", Type: "string", Description: "Warehouse ID"},
+			{Name: "product_id", In: "query", Type: "string", Description: "Product ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.StockAsOfResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.GetStockDeltas": {
+		Summary:     "Get stock deltas between dates",
+		Description: "Compares the inventory snapshots as of two dates per product and warehouse, largest quantity changes first",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "from", In: "query", Type: "string", Required: true, Description: "From date (YYYY-MM-DD)"},
+			{Name: "to", In: "query", Type: "string", Required: true, Description: "To date (YYYY-MM-DD)"},
+			{Name: "warehouse_id", In: "query", Type: "string", Description: "Warehouse ID"},
+			{Name: "product_id", In: "query", Type: "string", Description: "Product ID"},
+			{Name: "changed_only", In: "query", Type: "bool", Description: "Only include stock that changed"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.StockDeltasResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.RecordMovement": {
+		Summary:     "Record movement",
+		Description: "Records an inventory movement",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Body:        usecases.RecordMovementRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.MovementResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.ResolveAlert": {
+		Summary:     "Resolve alert",
+		Description: "Resolves a stock alert",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.TransferStock": {
+		Summary:     "Transfer stock",
+		Description: "Transfers stock between warehouses",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Body:        usecases.TransferStockRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"InventoryHandler.UpdateInventory": {
+		Summary:     "Update inventory",
+		Description: "Updates inventory",
+		Tags:        []string{"inventory"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.UpdateInventoryRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.InventoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"JWTKeyHandler.GetJWKS": {
+		Summary:     "Get the JSON Web Key Set",
+		Description: "Get the public keys access tokens are signed with, for services that verify tokens themselves. Match a token's kid header to a key. Keys that activate soon are listed before they sign tokens, and replaced keys stay listed for the grace period. HS256 keys are never published.",
+		Tags:        []string{"jwt-keys"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: jwtkeys.JSONWebKeySet{}},
+		},
+	},
+	"JWTKeyHandler.ListKeys": {
+		Summary:     "List JWT signing keys",
+		Description: "List the keys that still verify tokens, oldest first, with when each activates and expires and which one signs new tokens. The legacy key is the static JWT secret.",
+		Tags:        []string{"jwt-keys"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []jwtkeys.KeyInfo(nil)},
+		},
+	},
+	"JWTKeyHandler.RotateKeys": {
+		Summary:     "Rotate JWT signing keys",
+		Description: "Create a new signing key right away, e.g. when a key may have leaked. The new key signs tokens after the activation delay; tokens signed by the previous key stay valid for the grace period.",
+		Tags:        []string{"jwt-keys"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []jwtkeys.KeyInfo(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"MessageTemplateHandler.PreviewMessage": {
+		Summary:     "Preview message",
+		Description: "Render an email or notification, or a stored email template, for a sample user and order as it would be sent. Placeholders are written {{first_name}} or {{.FirstName}}; names match regardless of case and underscores. Variables with no value are left as written and listed in missing, with a warning for each and for placeholders that are not a variable, such as {{if .paid}}. sendable is false when anything would go out unfilled.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.PreviewMessageRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PreviewMessageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"MetricsHandler.GetCircuitBreakers": {
+		Summary:     "Get circuit breakers",
+		Description: "State, limits and counters of the circuit breaker guarding each external provider",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []resilience.Snapshot(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"MetricsHandler.GetMetrics": {
+		Summary:     "Prometheus metrics",
+		Description: "Circuit breaker state and call counters per external provider, in the Prometheus text format",
+		Tags:        []string{"metrics"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Description: "Prometheus text exposition"},
+		},
+	},
+	"MigrationHandler.GetMigrationStatus": {
+		Summary:     "Get migration status",
+		Description: "Get the status of all database migrations",
+		Tags:        []string{"migrations"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			500: {Body: map[string]interface{}(nil)},
+		},
+	},
+	"MigrationHandler.RollbackMigration": {
+		Summary:     "Rollback migration",
+		Description: "Rollback the last applied migration",
+		Tags:        []string{"migrations"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			500: {Body: map[string]interface{}(nil)},
+		},
+	},
+	"MigrationHandler.RunMigrations": {
+		Summary:     "Run migrations",
+		Description: "Run all pending database migrations",
+		Tags:        []string{"migrations"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			500: {Body: map[string]interface{}(nil)},
+		},
+	},
+	"NotificationHandler.GetUnreadCount": {
+		Summary:     "Get unread count",
+		Description: "Gets unread notification count",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"NotificationHandler.GetUserNotifications": {
+		Summary:     "Get user notifications",
+		Description: "Gets notifications for current user",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"NotificationHandler.GetUserPreferences": {
+		Summary:     "Get user preferences",
+		Description: "Gets user notification preferences",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PreferencesResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"NotificationHandler.MarkAllAsRead": {
+		Summary:     "Mark all as read",
+		Description: "Marks all notifications as read for user",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"NotificationHandler.MarkAsRead": {
+		Summary:     "Mark as read",
+		Description: "Marks a notification as read",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"NotificationHandler.UpdateUserPreferences": {
+		Summary:     "Update user preferences",
+		Description: "Updates user notification preferences",
+		Tags:        []string{"notifications"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PreferencesResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.FacebookCallback": {
+		Summary: "Facebook callback",
+		Tags:    []string{"auth"},
+		Params: []openapi.ParamDoc{
+			{Name: "code", In: "query", Type: "string", Description: "Code"},
+			{Name: "state", In: "query", Type: "string", Description: "State"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.FacebookLogin": {
+		Summary:     "Facebook login",
+		Description: "Initiates Facebook OAuth flow (alternative endpoint)",
+		Tags:        []string{"auth"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.GetFacebookAuthURL": {
+		Summary:     "Get facebook auth URL",
+		Description: "Generates Facebook OAuth URL",
+		Tags:        []string{"auth"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OAuthURLResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.GetGoogleAuthURL": {
+		Summary:     "Get google auth URL",
+		Description: "Generates Google OAuth URL",
+		Tags:        []string{"auth"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OAuthURLResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.GoogleCallback": {
+		Summary: "Google callback",
+		Tags:    []string{"auth"},
+		Params: []openapi.ParamDoc{
+			{Name: "code", In: "query", Type: "string", Description: "Code"},
+			{Name: "state", In: "query", Type: "string", Description: "State"},
+			{Name: "error", In: "query", Type: "string", Description: "Error"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OAuthHandler.GoogleLogin": {
+		Summary:     "Google login",
+		Description: "Initiates Google OAuth flow (alternative endpoint)",
+		Tags:        []string{"auth"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderArchiveHandler.ArchiveOldOrders": {
+		Summary:     "Archive old orders now",
+		Description: "Move the items and events of delivered, cancelled, refunded, returned and exchanged orders past the archive age to the archive. The orders stay listed, flagged archived.",
+		Tags:        []string{"order-archive"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderArchiveHandler.GetArchivedOrder": {
+		Summary:     "Get archived order rows",
+		Description: "Get the items and events of an archived order without restoring it",
+		Tags:        []string{"order-archive"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.OrderArchive{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderArchiveHandler.GetStatus": {
+		Summary:     "Get order archive status",
+		Description: "Get the age at which orders are archived and the number of archived orders, items and events",
+		Tags:        []string{"order-archive"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderArchiveStatusResponse{}},
+		},
+	},
+	"OrderArchiveHandler.RestoreOrder": {
+		Summary:     "Restore archived order",
+		Description: "Move an archived order's items and events back, so it can be viewed and changed like any other order",
+		Tags:        []string{"order-archive"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.AddOrderNote": {
+		Summary: "Add order note",
+		Tags:    []string{"orders"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.AddOrderNoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.CancelOrder": {
+		Summary:     "Cancel order",
+		Description: "Cancel an existing order",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.CreateOrder": {
+		Summary:     "Create a new order for bank transfer",
+		Description: "Create a new order from user's cart for bank transfer payments only",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Body:        usecases.CreateOrderRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.DownloadGiftReceipt": {
+		Summary:     "Download gift receipt (admin)",
+		Description: "Download a gift order's gift receipt as a PDF, to pack with the order",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.DownloadMyGiftReceipt": {
+		Summary:     "Download gift receipt",
+		Description: "Download a gift order's gift receipt as a PDF. It lists the items and the gift message without prices.",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.DownloadMyInvoice": {
+		Summary:     "Download order invoice",
+		Description: "Download an order's invoice as a PDF. Gift orders are invoiced to the buyer; the recipient gets a gift receipt instead.",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.DownloadPackingSlip": {
+		Summary:     "Download packing slip",
+		Description: "Download the slip packed with an order as a PDF. Slips for gift orders leave out prices and carry the gift message.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetOrder": {
+		Summary:     "Get order by ID",
+		Description: "Get a single order by its ID",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetOrderBySessionID": {
+		Summary:     "Get order by session ID",
+		Description: "Get a single order by its checkout session ID",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "query", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetOrderEvents": {
+		Summary: "Get order events",
+		Tags:    []string{"orders"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+			{Name: "public", In: "query", Type: "bool", Description: "Public"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.OrderEvent(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetOrderPublic": {
+		Summary:     "Get order details (public access for success page)",
+		Description: "Get order details without authentication for success page",
+		Tags:        []string{"orders"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetOrders": {
+		Summary:     "Get orders list",
+		Description: "Get list of all orders with filters (admin only)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Order status"},
+			{Name: "payment_status", In: "query", Type: "string", Description: "Payment status"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.OrderResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.GetUserOrders": {
+		Summary:     "Get user's orders",
+		Description: "Get current user's order history with optional filters",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Order status"},
+			{Name: "payment_status", In: "query", Type: "string", Description: "Payment status"},
+			{Name: "search", In: "query", Type: "string", Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by field"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.OrderResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.UpdateDeliveryStatus": {
+		Summary: "Update delivery status",
+		Tags:    []string{"orders"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.UpdateOrderStatus": {
+		Summary:     "Update order status",
+		Description: "Update the status of an order (admin only)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Body: map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderHandler.UpdateShippingInfo": {
+		Summary: "Update shipping info",
+		Tags:    []string{"orders"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.UpdateShippingInfoRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.AdminGetMessages": {
+		Summary:     "Get order messages (admin)",
+		Description: "Gets the message thread with the customer on an order. unread_count counts customer messages the store has not read.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderMessagesResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.AdminMarkMessagesRead": {
+		Summary:     "Mark order messages as read (admin)",
+		Description: "Marks the customer's messages on an order as read by the store",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.AdminSendMessage": {
+		Summary:     "Send order message (admin)",
+		Description: "Sends a message from the store to the customer on an order. The customer is notified by email.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Body: usecases.SendOrderMessageRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.OrderMessageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.GetMessages": {
+		Summary:     "Get order messages",
+		Description: "Gets the message thread with the store on one of the current user's orders. unread_count counts store messages the customer has not read.",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.OrderMessagesResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.MarkMessagesRead": {
+		Summary:     "Mark order messages as read",
+		Description: "Marks the store's messages on one of the current user's orders as read",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"OrderMessageHandler.SendMessage": {
+		Summary:     "Send order message",
+		Description: "Sends a message to the store about one of the current user's orders. Attachments are files uploaded beforehand through the upload endpoints. The store is notified by email.",
+		Tags:        []string{"orders"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Body: usecases.SendOrderMessageRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.OrderMessageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.CreatePartner": {
+		Summary:     "Create partner",
+		Description: "Add a partner. With a webhook URL, the price and availability changes made from now on are posted to it in batches of at most batch_size, no more often than every min_interval_seconds, signed with the webhook secret returned here once.",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Body:        usecases.CreatePartnerRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.PartnerResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.CreatePartnerAPIKey": {
+		Summary:     "Create partner API key",
+		Description: "Issue a partner an API key scoped to the feed, on the free, partner or internal plan (partner by default). The key is returned once; only its prefix is kept to tell keys apart.",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+		},
+		Body: usecases.CreatePartnerAPIKeyRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.CreatedAPIKeyResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.DeletePartner": {
+		Summary:     "Delete partner",
+		Description: "Delete a partner; its API keys are revoked and its webhook stops",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.GetAPIKeyUsage": {
+		Summary:     "Get API key usage",
+		Description: "Get the plan, limits and what is left of the daily quota of the API key in X-API-Key, with its requests on each of the last days. Quotas are per UTC day and reset at midnight UTC. Checking usage counts as a request; once the quota is used up it answers 429 with the same limit and reset_at.",
+		Tags:        []string{"partner-feed"},
+		Params: []openapi.ParamDoc{
+			{Name: "X-API-Key", In: "header", Type: "string", Required: true, Description: "Partner API key"},
+			{Name: "days", In: "query", Type: "int", Description: "Days of usage, today included, up to 366"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.APIKeyUsageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			429: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.GetFeedChanges": {
+		Summary:     "Get price and availability changes",
+		Description: "Get the price and availability changes after a cursor, oldest first, each product's changes merged into its latest values. Only what changed is sent, not full products. Start with the cursor 0 or the last one kept, and pass the returned cursor as after to read on while has_more is set. Authenticated with a partner API key in X-API-Key and limited to the requests per minute and daily quota of the key's plan; 429 responses carry when the limit resets in reset_at and Retry-After.",
+		Tags:        []string{"partner-feed"},
+		Params: []openapi.ParamDoc{
+			{Name: "X-API-Key", In: "header", Type: "string", Required: true, Description: "Partner API key"},
+			{Name: "after", In: "query", Type: "int", Description: "Cursor to read after"},
+			{Name: "limit", In: "query", Type: "int", Description: "Most changes to read, up to 1000; the partner's batch size by default"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PartnerFeedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			429: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.GetPartner": {
+		Summary:     "Get partner",
+		Description: "Get a partner with its API keys and webhook delivery state",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PartnerResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.GetPartnerAPIUsage": {
+		Summary:     "Get partner API usage",
+		Description: "Get the requests each of a partner's API keys, revoked ones included, was served and refused over its daily quota on each UTC day of a range, with totals, for billing and reporting. Requests refused by the per-minute limit aren't counted.",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+			{Name: "from", In: "query", Type: "string", Description: "First day (YYYY-MM-DD); 30 days before to by default"},
+			{Name: "to", In: "query", Type: "string", Description: "Last day (YYYY-MM-DD); today by default"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PartnerAPIUsageResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.ListPartners": {
+		Summary:     "List partners",
+		Description: "List price-comparison and affiliate partners by name, with their API keys and webhook delivery state",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.PartnerResponse(nil)},
+		},
+	},
+	"PartnerFeedHandler.RevokePartnerAPIKey": {
+		Summary:     "Revoke partner API key",
+		Description: "Revoke one of a partner's API keys; requests with it are refused at once",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+			{Name: "keyId", In: "path", Type: "string", Required: true, Description: "API key ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.UpdatePartner": {
+		Summary:     "Update partner",
+		Description: "Change a partner's details, webhook and rate controls, pause or resume it, or rotate its webhook secret. A new secret is returned once. Resuming a partner or changing its webhook clears its failures and delivers right away.",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+		},
+		Body: usecases.UpdatePartnerRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PartnerResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PartnerFeedHandler.UpdatePartnerAPIKey": {
+		Summary:     "Update partner API key",
+		Description: "Rename one of a partner's API keys or move it to the free, partner or internal plan. The new plan's limits apply from the key's next request, counting what it already used that day.",
+		Tags:        []string{"partner-feed"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Partner ID"},
+			{Name: "keyId", In: "path", Type: "string", Required: true, Description: "API key ID"},
+		},
+		Body: usecases.UpdatePartnerAPIKeyRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.APIKey{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.ApproveRefund": {
+		Summary:     "Approve refund",
+		Description: "Approves a pending refund",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "refund_id", In: "path", Type: "string", Required: true, Description: "Refund ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.RefundResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.ConfirmPaymentSuccess": {
+		Summary:     "Confirm payment success",
+		Description: "Confirms payment success for an order (fallback method)",
+		Tags:        []string{"payments"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.CreateCheckoutSession": {
+		Summary:     "Create checkout session",
+		Description: "Creates a Stripe checkout session for hosted payment page",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Body:        usecases.CreateCheckoutSessionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.CreateCheckoutSessionResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+			503: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.DeletePaymentMethod": {
+		Summary:     "Delete payment method",
+		Description: "Deletes a payment method",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.GetOrderPayments": {
+		Summary:     "Get order payments",
+		Description: "Retrieves all payments for an order",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.PaymentResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.GetPayment": {
+		Summary:     "Get payment",
+		Description: "Retrieves a payment by ID",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.GetPendingRefunds": {
+		Summary:     "Get pending refunds",
+		Description: "Retrieves refunds awaiting approval",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.RefundResponse(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.GetRefunds": {
+		Summary:     "Get refunds",
+		Description: "Retrieves refunds for a payment",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "payment_id", In: "path", Type: "string", Required: true, Description: "Payment ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.RefundResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.GetUserPaymentMethods": {
+		Summary:     "Get user payment methods",
+		Description: "Retrieves user's payment methods",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.PaymentMethodResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.HandleWebhook": {
+		Summary: "Handle webhook",
+		Tags:    []string{"payments"},
+		Params: []openapi.ParamDoc{
+			{Name: "provider", In: "path", Type: "string", Required: true, Description: "ProvIDer"},
+			{Name: "Stripe-Signature", In: "header", Type: "string", Description: "Stripe-Signature"},
+			{Name: "PAYPAL-TRANSMISSION-SIG", In: "header", Type: "string", Description: "PAYPAL-TRANSMISSION-SIG"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.ProcessPayment": {
+		Summary:     "Process payment",
+		Description: "Processes a payment",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Body:        usecases.ProcessPaymentRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			503: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.ProcessRefund": {
+		Summary:     "Process refund",
+		Description: "Processes a refund",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.ProcessRefundRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.RefundResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.RejectRefund": {
+		Summary:     "Reject refund",
+		Description: "Rejects a pending refund",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "refund_id", In: "path", Type: "string", Required: true, Description: "Refund ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.SavePaymentMethod": {
+		Summary:     "Save payment method",
+		Description: "Saves a user's payment method",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Body:        usecases.SavePaymentMethodRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentMethodResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.SetDefaultPaymentMethod": {
+		Summary:     "Set default payment method",
+		Description: "Sets a payment method as default",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "method_id", In: "path", Type: "string", Required: true, Description: "Method ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.TestWebhook": {
+		Summary:     "Test webhook",
+		Description: "Manually triggers webhook processing for development",
+		Tags:        []string{"payments"},
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "path", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentHandler.UpdatePaymentStatus": {
+		Summary:     "Update payment status",
+		Description: "Updates payment status",
+		Tags:        []string{"payments"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.CancelPaymentLink": {
+		Summary:     "Cancel payment link",
+		Description: "Cancel a payment link that has not been paid, so it can no longer be opened",
+		Tags:        []string{"payment-links"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Payment link ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentLinkResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.CreatePaymentLink": {
+		Summary:     "Create payment link",
+		Description: "Create a single-use payment link for a pending order, a quote that was converted to an order, or an arbitrary amount. Set send to email or text the link to the customer right away.",
+		Tags:        []string{"payment-links"},
+		Secured:     true,
+		Body:        usecases.CreatePaymentLinkRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentLinkResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.GetPaymentLink": {
+		Summary:     "Get payment link",
+		Description: "Get a payment link with its URL",
+		Tags:        []string{"payment-links"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Payment link ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentLinkResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.GetPaymentLinks": {
+		Summary:     "Get payment links",
+		Description: "List payment links, newest first",
+		Tags:        []string{"payment-links"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Link status (active, paid, expired, cancelled)"},
+			{Name: "order_id", In: "query", Type: "string", Description: "Order ID"},
+			{Name: "quote_id", In: "query", Type: "string", Description: "Quote ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of links to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of links to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentLinksListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.GetPublicPaymentLink": {
+		Summary:     "Get payment link by token",
+		Description: "Get the amount, description and status of a payment link. No authentication is required; the token is the link's secret.",
+		Tags:        []string{"payment-links"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "path", Type: "string", Required: true, Description: "Payment link token"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PublicPaymentLinkResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.SendPaymentLink": {
+		Summary:     "Send payment link",
+		Description: "Email and/or text a payment link that can still be paid to the customer. An email address or phone number in the body replaces the one stored on the link.",
+		Tags:        []string{"payment-links"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Payment link ID"},
+		},
+		Body: usecases.SendPaymentLinkRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PaymentLinkResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+			410: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PaymentLinkHandler.StartPaymentLinkCheckout": {
+		Summary:     "Pay payment link",
+		Description: "Open a Stripe checkout session for a payment link and return its URL. While a session opened from the link is still open, the same URL is returned.",
+		Tags:        []string{"payment-links"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "path", Type: "string", Required: true, Description: "Payment link token"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+			410: {Body: handlers.ErrorResponse{}},
+			503: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PlatformCSVHandler.ExportOrders": {
+		Summary:     "Export orders for another platform",
+		Description: "Downloads orders as a CSV in Shopify's order export layout, one line per item, or in the layout of WooCommerce's order export and import tools, one line per order with a line_item_N column per item. Amounts use the order currency's decimals.",
+		Tags:        []string{"admin-platform-csv"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "platform", In: "query", Type: "string", Required: true, Description: "Platform layout (shopify, woocommerce)"},
+			{Name: "status", In: "query", Type: "string", Description: "Order status"},
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PlatformCSVHandler.ExportProducts": {
+		Summary:     "Export products for another platform",
+		Description: "Downloads the catalog as a Shopify or WooCommerce product CSV, ready for that platform's product importer. Each product is exported as a single variant, with its primary category as the Shopify Type. Weights are in kg and dimensions in cm.",
+		Tags:        []string{"admin-platform-csv"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "platform", In: "query", Type: "string", Required: true, Description: "Platform layout (shopify, woocommerce)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PlatformCSVHandler.ImportProducts": {
+		Summary:     "Import products from another platform",
+		Description: "Imports a Shopify or WooCommerce product CSV. Products are matched by SKU; categories and brands by name. Products are imported with their first variant, and further variants and WooCommerce variations are reported rather than imported. Use dry_run to get the validation report of what would be created, updated, skipped or rejected without saving anything.",
+		Tags:        []string{"admin-platform-csv"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Product CSV"},
+			{Name: "platform", In: "formData", Type: "string", Required: true, Description: "Platform layout (shopify, woocommerce)"},
+			{Name: "dry_run", In: "formData", Type: "bool", Description: "Validate and report without importing"},
+			{Name: "update_existing", In: "formData", Type: "bool", Description: "Update products whose SKU already exists instead of skipping them"},
+			{Name: "default_category_id", In: "formData", Type: "string", Description: "Category for products whose category is missing or not found"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ImportPlatformProductsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.ApplyBulkPriceUpdate": {
+		Summary:     "Apply a bulk price update",
+		Description: "Apply a price rule to all matching products in one transaction and log each change in price history",
+		Tags:        []string{"pricing"},
+		Secured:     true,
+		Body:        usecases.BulkPriceUpdateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.BulkPriceUpdateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.ApplyDuePriceChanges": {
+		Summary: "Apply due price changes now",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"PricingHandler.CancelScheduledPriceChange": {
+		Summary: "Cancel a scheduled price change",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Scheduled price change ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.GetBulkPriceUpdate": {
+		Summary: "Get a bulk price update",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Bulk price update ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkPriceUpdateResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.GetPriceClaim": {
+		Summary:     "Get product price history for price claims",
+		Description: "Get the current price and the lowest price of the last 30 days (EU Omnibus \"prior price\")",
+		Tags:        []string{"pricing"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PriceClaimResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.GetPriceHistory": {
+		Summary:     "Get product price change log",
+		Description: "Get all price changes for a product including actor and reason",
+		Tags:        []string{"pricing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"PricingHandler.GetProductScheduledPriceChanges": {
+		Summary: "Get scheduled price changes for a product",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.ListBulkPriceUpdates": {
+		Summary: "List bulk price updates",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"PricingHandler.ListScheduledPriceChanges": {
+		Summary: "List scheduled price changes",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status filter (pending, applied, cancelled, failed)"},
+			{Name: "product_id", In: "query", Type: "string", Description: "Product ID filter"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"PricingHandler.PreviewBulkPriceUpdate": {
+		Summary:     "Preview a bulk price update",
+		Description: "List the products a price rule matches with their old and new prices, without saving anything",
+		Tags:        []string{"pricing"},
+		Secured:     true,
+		Body:        usecases.BulkPriceUpdateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkPriceUpdatePreviewResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.RevertExpiredSales": {
+		Summary: "Revert expired sales now",
+		Tags:    []string{"pricing"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"PricingHandler.RollbackBulkPriceUpdate": {
+		Summary:     "Roll back a bulk price update",
+		Description: "Restore the previous prices from the price history log. Products repriced since are skipped.",
+		Tags:        []string{"pricing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Bulk price update ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BulkPriceUpdateResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PricingHandler.SchedulePriceChange": {
+		Summary:     "Schedule a price change",
+		Description: "Schedule a future price and/or sale window change for a product",
+		Tags:        []string{"pricing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: usecases.SchedulePriceChangeRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.ScheduledPriceChangeResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.AddProductToComparison": {
+		Summary:     "Add product to comparison",
+		Description: "Add a product to an existing comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.ClearComparison": {
+		Summary:     "Clear comparison",
+		Description: "Remove all products from a comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.CompareProducts": {
+		Summary:     "Compare products",
+		Description: "Compare multiple products and get comparison matrix",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "product_ids", In: "query", Type: "string", Required: true, Description: "Comma-separated product IDs"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ComparisonMatrixResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.CreateComparison": {
+		Summary:     "Create product comparison",
+		Description: "Create a new product comparison for user or session",
+		Tags:        []string{"product-comparison"},
+		Body:        usecases.ProductComparisonRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.DeleteComparison": {
+		Summary:     "Delete product comparison",
+		Description: "Delete a product comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.GetComparison": {
+		Summary:     "Get product comparison",
+		Description: "Get a product comparison by ID",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.GetComparisonMatrix": {
+		Summary:     "Get comparison matrix",
+		Description: "Get comparison matrix for an existing comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ComparisonMatrixResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.GetPopularComparedProducts": {
+		Summary:     "Get popular compared products",
+		Description: "Get the most frequently compared products",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products to return"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.ProductResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.GetSessionComparison": {
+		Summary:     "Get session's product comparison",
+		Description: "Get the current session's product comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "X-Session-ID", In: "header", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.GetUserComparison": {
+		Summary:     "Get user's product comparison",
+		Description: "Get the current user's product comparison",
+		Tags:        []string{"product-comparison"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.RemoveProductFromComparison": {
+		Summary:     "Remove product from comparison",
+		Description: "Remove a product from an existing comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductComparisonHandler.UpdateComparison": {
+		Summary:     "Update product comparison",
+		Description: "Update a product comparison",
+		Tags:        []string{"product-comparison"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Comparison ID"},
+		},
+		Body: usecases.ProductComparisonRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductComparisonResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.DeleteFilterSet": {
+		Summary:     "Delete filter set",
+		Description: "Deletes a filter set",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.FilterProducts": {
+		Summary: "Filter products",
+		Tags:    []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "query", In: "query", Type: "string", Description: "Query"},
+			{Name: "category_ids", In: "query", Type: "string", Description: "Category ids"},
+			{Name: "brand_ids", In: "query", Type: "string", Description: "Brand ids"},
+			{Name: "brand_id", In: "query", Type: "string", Description: "Brand id"},
+			{Name: "tags", In: "query", Type: "string", Description: "Tags"},
+			{Name: "product_types", In: "query", Type: "string", Description: "Product types"},
+			{Name: "stock_status", In: "query", Type: "string", Description: "Stock status"},
+			{Name: "visibility", In: "query", Type: "string", Description: "Visibility"},
+			{Name: "min_price", In: "query", Type: "number", Description: "Min price"},
+			{Name: "max_price", In: "query", Type: "number", Description: "Max price"},
+			{Name: "min_rating", In: "query", Type: "number", Description: "Min rating"},
+			{Name: "max_rating", In: "query", Type: "number", Description: "Max rating"},
+			{Name: "in_stock", In: "query", Type: "bool", Description: "In stock"},
+			{Name: "low_stock", In: "query", Type: "bool", Description: "Low stock"},
+			{Name: "on_sale", In: "query", Type: "bool", Description: "On sale"},
+			{Name: "featured", In: "query", Type: "bool", Description: "Featured"},
+			{Name: "has_images", In: "query", Type: "bool", Description: "Has images"},
+			{Name: "has_variants", In: "query", Type: "bool", Description: "Has variants"},
+			{Name: "has_reviews", In: "query", Type: "bool", Description: "Has reviews"},
+			{Name: "created_after", In: "query", Type: "string", Description: "Created after"},
+			{Name: "created_before", In: "query", Type: "string", Description: "Created before"},
+			{Name: "updated_after", In: "query", Type: "string", Description: "Updated after"},
+			{Name: "updated_before", In: "query", Type: "string", Description: "Updated before"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "include_facets", In: "query", Type: "bool", Description: "Include facets"},
+			{Name: "facet_limit", In: "query", Type: "int", Description: "Facet limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetAttributeFilters": {
+		Summary:     "Get attribute filters",
+		Description: "Gets attribute filters",
+		Tags:        []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "category_id", In: "query", Type: "string", Description: "Category id"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.ProductAttribute(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetAttributeTerms": {
+		Summary:     "Get attribute terms",
+		Description: "Gets attribute terms",
+		Tags:        []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "attribute_id", In: "path", Type: "string", Required: true, Description: "Attribute ID"},
+			{Name: "category_id", In: "query", Type: "string", Description: "Category id"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.ProductAttributeTerm(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetDynamicFilters": {
+		Summary:     "Get dynamic filters",
+		Description: "Gets dynamic filters based on current state",
+		Tags:        []string{"product-filters"},
+		Body:        usecases.AdvancedFilterRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetFilterAnalytics": {
+		Summary:     "Get filter analytics",
+		Description: "Gets filter analytics",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "days", In: "query", Type: "int", Description: "Days"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetFilterFacets": {
+		Summary:     "Get filter facets",
+		Description: "Gets available filter facets",
+		Tags:        []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "category_ids", In: "query", Type: "string", Description: "Category ids"},
+			{Name: "brand_ids", In: "query", Type: "string", Description: "Brand ids"},
+			{Name: "tags", In: "query", Type: "string", Description: "Tags"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetFilterSet": {
+		Summary:     "Get filter set",
+		Description: "Gets a filter set by ID",
+		Tags:        []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.FilterSetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetFilterSuggestions": {
+		Summary:     "Get filter suggestions",
+		Description: "Gets filter suggestions",
+		Tags:        []string{"product-filters"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Description: "Q"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []string(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetPopularFilters": {
+		Summary:     "Get popular filters",
+		Description: "Gets popular filters",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.FilterUsage(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetRelatedFilters": {
+		Summary:     "Get related filters",
+		Description: "Gets related filters",
+		Tags:        []string{"product-filters"},
+		Body:        usecases.AdvancedFilterRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []string(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetSessionFilterSets": {
+		Summary:     "Get session filter sets",
+		Description: "Gets filter sets for current session",
+		Tags:        []string{"product-filters"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.FilterSetResponse(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.GetUserFilterSets": {
+		Summary:     "Get user filter sets",
+		Description: "Gets filter sets for current user",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.FilterSetResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.SaveFilterSet": {
+		Summary:     "Save filter set",
+		Description: "Saves a filter set",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Body:        usecases.FilterSetRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.FilterSetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductFilterHandler.UpdateFilterSet": {
+		Summary:     "Update filter set",
+		Description: "Updates a filter set",
+		Tags:        []string{"product-filters"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.FilterSetRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.FilterSetResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.ChangeProductLifecycle": {
+		Summary:     "Change product lifecycle status",
+		Description: "Move a product between draft, active, discontinued and archived (admin only). Products with orders awaiting fulfillment cannot be archived.",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: usecases.ChangeProductLifecycleRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.CreateProduct": {
+		Summary:     "Create a new product",
+		Description: "Create a new product (admin/moderator only)",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Body:        usecases.CreateProductRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.ProductResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.DeleteProduct": {
+		Summary:     "Delete product",
+		Description: "Delete a product (admin only)",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetFeaturedProducts": {
+		Summary:     "Get featured products",
+		Description: "Get list of featured products",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products per page"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"ProductHandler.GetPopularSearches": {
+		Summary:     "Get popular searches",
+		Description: "Get popular search queries",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PopularSearchesResponse{}},
+		},
+	},
+	"ProductHandler.GetPriceAvailability": {
+		Summary:     "Get price and availability for products",
+		Description: "Get only the price, sale price and availability of up to 100 products, for headless frontends that cache the full product content for long and refresh this data cheaply. Responses carry Cache-Control and ETag headers for edge caches; the cache lifetime never runs past the next sale start or end. Send the IDs in a stable order to share cache entries.",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "ids", In: "query", Type: "string", Required: true, Description: "Comma-separated product IDs, at most 100"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.PriceAvailabilityResponse{}},
+			304: {Description: "Not modified since the ETag in If-None-Match"},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetProduct": {
+		Summary:     "Get product by ID",
+		Description: "Get a single product by its ID. The name, descriptions and SEO fields are served in the requested locale where translated, falling back to the source content.",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "locale", In: "query", Type: "string", Description: "Content locale, e.g. vi; defaults to the Accept-Language header"},
+			{Name: "preview_token", In: "query", Type: "string", Description: "Catalog changeset preview token; shows the product with the changeset's unpublished edits applied"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetProductAlternatives": {
+		Summary:     "Get product alternatives",
+		Description: "Get active products to suggest instead of a product, typically one that is no longer available",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of alternatives"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.ProductResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetProductFilters": {
+		Summary:     "Get product filters",
+		Description: "Get available filters for products including brands, price range, and attributes",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "category_id", In: "query", Type: "string", Description: "Category ID to filter brands"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+		},
+	},
+	"ProductHandler.GetProducts": {
+		Summary:     "Get products list",
+		Description: "Get list of products with pagination",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+			{Name: "personalize", In: "query", Type: "bool", Description: "Rank results by the signed-in customer's preferences"},
+			{Name: "locale", In: "query", Type: "string", Description: "Content locale, e.g. vi; defaults to the Accept-Language header"},
+			{Name: "preview_token", In: "query", Type: "string", Description: "Catalog changeset preview token; shows the products with the changeset's unpublished edits applied"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PersonalizedPaginatedResponse{}},
+		},
+	},
+	"ProductHandler.GetProductsByCategory": {
+		Summary:     "Get products by category",
+		Description: "Get products belonging to a specific category",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "categoryId", In: "path", Type: "string", Required: true, Description: "Category ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.ProductResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetRelatedProducts": {
+		Summary:     "Get related products",
+		Description: "Get products related to a specific product",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products per page"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"ProductHandler.GetSearchHistory": {
+		Summary:     "Get search history",
+		Description: "Get search history for authenticated user",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SearchHistoryResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetSearchSuggestions": {
+		Summary:     "Get search suggestions",
+		Description: "Get search suggestions based on query",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SearchSuggestionsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.GetTrendingProducts": {
+		Summary:     "Get trending products",
+		Description: "Get list of trending products",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products per page"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"ProductHandler.PatchProduct": {
+		Summary:     "Partially update product",
+		Description: "Partially update an existing product - only updates provided fields (admin/moderator only)",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: usecases.PatchProductRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.SearchProducts": {
+		Summary:     "Search products",
+		Description: "Search products with various filters",
+		Tags:        []string{"products"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Description: "Search query"},
+			{Name: "category_id", In: "query", Type: "string", Description: "Category ID"},
+			{Name: "min_price", In: "query", Type: "number", Description: "Minimum price"},
+			{Name: "max_price", In: "query", Type: "number", Description: "Maximum price"},
+			{Name: "status", In: "query", Type: "string", Description: "Product status"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by field"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+			{Name: "personalize", In: "query", Type: "bool", Description: "Rank results by the signed-in customer's preferences when no sort_by is given"},
+			{Name: "fields", In: "query", Type: "string", Description: "Comma separated fields to return, e.g. id,name,price,images; the id is always returned"},
+			{Name: "include", In: "query", Type: "string", Description: "Comma separated relations to return (category, brand, images, tags, attributes, variants, alternatives)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PersonalizedPaginatedResponse{}},
+		},
+	},
+	"ProductHandler.UpdateProduct": {
+		Summary:     "Update product",
+		Description: "Update an existing product (admin/moderator only)",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: usecases.UpdateProductRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductHandler.UpdateStock": {
+		Summary:     "Update product stock",
+		Description: "Update product stock quantity (admin/moderator only)",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Body: map[string]int(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.DeleteProductTranslation": {
+		Summary:     "Delete product translation",
+		Description: "Deletes a product's translation in a locale; the product is then served in the source content there",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "locale", In: "path", Type: "string", Required: true, Description: "Locale, e.g. vi"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.ExportTranslations": {
+		Summary:     "Export product translations",
+		Description: "Downloads the catalog's source content and translations in a locale as CSV or XLIFF 1.2, for translators to fill in and import",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "locale", In: "query", Type: "string", Required: true, Description: "Locale, e.g. vi"},
+			{Name: "format", In: "query", Type: "string", Description: "File format (csv, xliff)"},
+			{Name: "status", In: "query", Type: "string", Description: "Comma-separated statuses to include (missing, outdated, complete); all by default"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.GetProductTranslations": {
+		Summary:     "Get product translations",
+		Description: "Gets a product's source content and its translation in every supported locale. Each locale's status is missing, outdated (the source changed after it was translated) or complete.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductTranslationsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.GetTranslationSummary": {
+		Summary:     "Get product translation summary",
+		Description: "Counts complete, outdated and missing product translations in each supported locale",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ProductTranslationSummaryResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.ImportTranslations": {
+		Summary:     "Import product translations",
+		Description: "Imports a CSV or XLIFF file in the export layout. Non-empty translated fields replace the stored ones and each imported product's translation is marked complete. Rows that cannot be imported are reported in errors.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Translation file (.csv, .xlf or .xliff)"},
+			{Name: "locale", In: "formData", Type: "string", Description: "Locale, e.g. vi; required for CSV, XLIFF files default to their target-language"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ImportProductTranslationsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ProductTranslationHandler.SaveProductTranslation": {
+		Summary:     "Save product translation",
+		Description: "Creates or replaces a product's translation in a locale and marks it complete. Empty fields fall back to the source content.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "locale", In: "path", Type: "string", Required: true, Description: "Locale, e.g. vi"},
+		},
+		Body: usecases.SaveProductTranslationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ProductTranslation{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.CancelPurchaseOrder": {
+		Summary:     "Cancel a purchase order",
+		Description: "Cancel an open purchase order. Its items no longer count towards restock estimates.",
+		Tags:        []string{"purchasing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Purchase order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.PurchaseOrder{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.CreatePurchaseOrder": {
+		Summary:     "Create a purchase order",
+		Description: "Order stock from a supplier. Products that are out of stock show the earliest expected delivery of their open purchase orders as a restock estimate; the supplier's lead time applies when no delivery date is given.",
+		Tags:        []string{"purchasing"},
+		Secured:     true,
+		Body:        usecases.CreatePurchaseOrderRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.PurchaseOrder{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.CreateSupplier": {
+		Summary: "Create a supplier",
+		Tags:    []string{"purchasing"},
+		Secured: true,
+		Body:    usecases.CreateSupplierRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.Supplier{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.GetPurchaseOrder": {
+		Summary: "Get a purchase order",
+		Tags:    []string{"purchasing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Purchase order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.PurchaseOrder{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.ListPurchaseOrders": {
+		Summary: "List purchase orders",
+		Tags:    []string{"purchasing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status filter (open, received, cancelled)"},
+			{Name: "supplier_id", In: "query", Type: "string", Description: "Supplier ID"},
+			{Name: "product_id", In: "query", Type: "string", Description: "Only orders with this product"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.ListSuppliers": {
+		Summary: "List suppliers",
+		Tags:    []string{"purchasing"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "active_only", In: "query", Type: "bool", Description: "Only list active suppliers"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"PurchasingHandler.ReceivePurchaseOrder": {
+		Summary:     "Receive a purchase order",
+		Description: "Add the delivered units to stock. Send the units received of each item, or no items to receive everything still outstanding. The order is closed once every item has arrived in full; subscribers of restocked products are then notified in batches.",
+		Tags:        []string{"purchasing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Purchase order ID"},
+		},
+		Body: usecases.ReceivePurchaseOrderRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.PurchaseOrder{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.UpdateExpectedDate": {
+		Summary:     "Update a purchase order's expected delivery date",
+		Description: "Set the delivery date the supplier confirmed, or clear it to fall back to the supplier's lead time. Restock estimates follow the new date.",
+		Tags:        []string{"purchasing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Purchase order ID"},
+		},
+		Body: usecases.UpdatePurchaseOrderExpectedDateRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.PurchaseOrder{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"PurchasingHandler.UpdateSupplier": {
+		Summary:     "Update a supplier",
+		Description: "Update a supplier's contact details, lead time or status. Changing the lead time moves the restock estimates of its open purchase orders that have no confirmed delivery date.",
+		Tags:        []string{"purchasing"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Supplier ID"},
+		},
+		Body: usecases.UpdateSupplierRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Supplier{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.AcceptQuote": {
+		Summary: "Accept quote",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+			422: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.CancelQuote": {
+		Summary: "Cancel quote request",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.ConvertQuoteToOrder": {
+		Summary:     "Convert quote to order",
+		Description: "Place an order for an accepted quote with item prices locked at the quoted prices",
+		Tags:        []string{"quotes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Body: usecases.CreateOrderRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.OrderResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+			422: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.DeclineQuote": {
+		Summary: "Decline quote",
+		Tags:    []string{"admin-quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Body: usecases.DeclineQuoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.DownloadMyQuotePDF": {
+		Summary: "Download my quote as PDF",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.DownloadQuotePDF": {
+		Summary: "Download quote as PDF",
+		Tags:    []string{"admin-quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.ExpireQuotes": {
+		Summary: "Expire lapsed quotes now",
+		Tags:    []string{"admin-quotes"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"QuoteHandler.GetMyQuote": {
+		Summary: "Get my quote",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.GetMyQuotes": {
+		Summary: "List my quotes",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Filter by status"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"QuoteHandler.GetQuote": {
+		Summary: "Get quote",
+		Tags:    []string{"admin-quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.ListQuotes": {
+		Summary: "List quotes",
+		Tags:    []string{"admin-quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Filter by status"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"QuoteHandler.RejectQuote": {
+		Summary: "Reject quote",
+		Tags:    []string{"quotes"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.RequestQuote": {
+		Summary:     "Request a quote",
+		Description: "Convert the current cart into a quote request for custom pricing; the cart is emptied",
+		Tags:        []string{"quotes"},
+		Secured:     true,
+		Body:        usecases.RequestQuoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"QuoteHandler.RespondToQuote": {
+		Summary:     "Respond to quote",
+		Description: "Set custom per-item prices and an expiry on a quote request",
+		Tags:        []string{"admin-quotes"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Quote ID"},
+		},
+		Body: usecases.RespondToQuoteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.QuoteResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.GetFrequentlyBoughtTogether": {
+		Summary:     "Get frequently bought together products",
+		Description: "Get products that are frequently bought together with a specific product",
+		Tags:        []string{"recommendations"},
+		Params: []openapi.ParamDoc{
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products to return"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.RecommendationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.GetPersonalizedRecommendations": {
+		Summary:     "Get personalized recommendations",
+		Description: "Get personalized product recommendations for the authenticated user",
+		Tags:        []string{"recommendations"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Number of recommendations to return"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.RecommendationResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.GetRecommendations": {
+		Summary:     "Get product recommendations",
+		Description: "Get product recommendations based on type and context",
+		Tags:        []string{"recommendations"},
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "query", Type: "string", Required: true, Description: "Recommendation type"},
+			{Name: "product_id", In: "query", Type: "string", Description: "Product ID for product-based recommendations"},
+			{Name: "user_id", In: "query", Type: "string", Description: "User ID for personalized recommendations"},
+			{Name: "category_id", In: "query", Type: "string", Description: "Category ID for category-based recommendations"},
+			{Name: "brand_id", In: "query", Type: "string", Description: "Brand ID for brand-based recommendations"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of recommendations to return"},
+			{Name: "period", In: "query", Type: "string", Description: "Period for trending recommendations"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.RecommendationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.GetRelatedProducts": {
+		Summary:     "Get related products",
+		Description: "Get products related to a specific product",
+		Tags:        []string{"recommendations"},
+		Params: []openapi.ParamDoc{
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products to return"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.RecommendationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.GetTrendingProducts": {
+		Summary:     "Get trending products",
+		Description: "Get trending products for a specific period",
+		Tags:        []string{"recommendations"},
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period for trending"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of products to return"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.RecommendationResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"RecommendationHandler.TrackInteraction": {
+		Summary:     "Track user interaction",
+		Description: "Track user interaction with a product for recommendation purposes",
+		Tags:        []string{"recommendations"},
+		Body:        handlers.TrackInteractionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.DeleteExportProfile": {
+		Summary:     "Delete export profile",
+		Description: "Delete the calling admin's layout for a report type",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "path", Type: "string", Required: true, Description: "Report type"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.DeleteReport": {
+		Summary:     "Delete report",
+		Description: "Delete a report and its file. Download URLs issued for it stop working.",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Report ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.DownloadReport": {
+		Summary:     "Download report",
+		Description: "Download a report file. Takes the download URL returned with the report; it only works for admins, until it expires or the report is deleted. Downloads are recorded in the audit log.",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Report ID"},
+			{Name: "expires", In: "query", Type: "int", Required: true, Description: "URL expiry as a Unix time"},
+			{Name: "signature", In: "query", Type: "string", Required: true, Description: "URL signature"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.GenerateReport": {
+		Summary:     "Generate report",
+		Description: "Export sales, product sales, new users, inventory or payments for a period as CSV. Columns, header locale (en, vi or keys), delimiter and encoding (utf-8-bom for Excel) default to the admin's export profile for the report type, then to every column headed in the admin's language, comma separated, in UTF-8. With anonymize, customer IDs are replaced with stable pseudonyms and names and emails are left out, for analytics. The response carries a download URL that is valid for a limited time.",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Body:        usecases.GenerateReportRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.ReportResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.GetExportProfiles": {
+		Summary:     "Get export profiles",
+		Description: "List the calling admin's saved report layouts, one per report type",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.ExportProfile(nil)},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.GetReport": {
+		Summary:     "Get report",
+		Description: "Get a report with a fresh download URL",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Report ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReportResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.GetReports": {
+		Summary:     "Get reports",
+		Description: "List generated reports, newest first, each with a fresh download URL",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "query", Type: "string", Description: "Report type"},
+			{Name: "status", In: "query", Type: "string", Description: "Report status"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of reports to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of reports to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReportsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReportHandler.SaveExportProfile": {
+		Summary:     "Save export profile",
+		Description: "Save the calling admin's layout for a report type, replacing any saved before. Reports the admin generates of that type use it for the options their request leaves out.",
+		Tags:        []string{"reports"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "path", Type: "string", Required: true, Description: "Report type"},
+		},
+		Body: usecases.SaveExportProfileRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ExportProfile{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReturnPortalHandler.CreatePortalReturn": {
+		Summary:     "Open return",
+		Description: "Open a return for items of the link's order. Send JSON, or multipart form data with the items as a JSON array in items, reason, description and up to 5 photos. Returns for a reason that qualifies for auto-approval are approved with a label right away; the others wait for review.",
+		Tags:        []string{"returns-portal"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "path", Type: "string", Required: true, Description: "Return link token"},
+		},
+		Body: usecases.CreatePortalReturnRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.PortalReturnResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			410: {Body: handlers.ErrorResponse{}},
+			422: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReturnPortalHandler.DownloadReturnLabel": {
+		Summary:     "Download return label",
+		Description: "Download the shipping label of an approved return as a PDF",
+		Tags:        []string{"returns-portal"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "path", Type: "string", Required: true, Description: "Return link token"},
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Return ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {File: true},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReturnPortalHandler.GetReturnPortal": {
+		Summary:     "Get returns portal",
+		Description: "Get the order a return link is for: its items and how many of each can still be returned, the reasons to pick from and which are approved right away, and the returns already opened. No authentication is required; the token is the link's secret.",
+		Tags:        []string{"returns-portal"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "path", Type: "string", Required: true, Description: "Return link token"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReturnPortalResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			410: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.CreateReview": {
+		Summary:     "Create review",
+		Description: "Creates a new review (supports both JSON and multipart form with images)",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Body:        usecases.CreateReviewRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.DeleteReview": {
+		Summary:     "Delete review",
+		Description: "Deletes a review",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.GetProductRating": {
+		Summary:     "Get product rating",
+		Description: "Gets aggregated rating for a product",
+		Tags:        []string{"reviews"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.GetProductReviewSummary": {
+		Summary:     "Get product review summary",
+		Description: "Summarizes a product's approved reviews: the 1-5 star histogram, keywords that stand out in positive (4-5 star) and negative (1-2 star) reviews, the most helpful review of each sentiment, and average quality, value and shipping ratings.",
+		Tags:        []string{"reviews"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.GetProductReviews": {
+		Summary:     "Get product reviews",
+		Description: "Gets approved reviews for a product with their helpful and not helpful vote counts and the store's reply. Reviews can be filtered by one or more star ratings and to verified purchases, and sorted by most_recent, most_helpful, highest_rating or lowest_rating. Pages can be fetched by page, or by passing pagination.next_cursor back as cursor, which stays stable while new reviews come in. Signed-in shoppers also get their own vote on each review as user_vote.",
+		Tags:        []string{"reviews"},
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+			{Name: "product_id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "rating", In: "query", Type: "string", Description: "Star ratings, comma separated (e.g. 4,5)"},
+			{Name: "verified", In: "query", Type: "bool", Description: "Verified purchases only"},
+			{Name: "sort", In: "query", Type: "string", Description: "Sort (most_recent, most_helpful, highest_rating, lowest_rating)"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+			{Name: "cursor", In: "query", Type: "string", Description: "Cursor from the previous page's next_cursor"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.GetReview": {
+		Summary:     "Get review",
+		Description: "Gets a review by ID",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.GetUserReviews": {
+		Summary:     "Get user reviews",
+		Description: "Gets reviews by a user",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "user_id", In: "path", Type: "string", Required: true, Description: "User ID"},
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "rating", In: "query", Type: "int", Description: "Rating"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.UpdateReview": {
+		Summary:     "Update review",
+		Description: "Updates a review",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Body: usecases.UpdateReviewRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewHandler.VoteReview": {
+		Summary:     "Vote review",
+		Description: "Votes on a review",
+		Tags:        []string{"reviews"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]interface{}(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewIncentiveHandler.GetProgram": {
+		Summary:     "Get review incentive program",
+		Description: "Gets the reward configuration and fraud guards for approved reviews. Returns the inactive defaults if the program was never configured.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ReviewIncentiveProgram{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewIncentiveHandler.GetReport": {
+		Summary:     "Get review incentive report",
+		Description: "Compares review volume during a period with the equally long period before it and summarizes the rewards granted. The period defaults to since the program was activated, or the last 30 days.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReviewIncentiveReportResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewIncentiveHandler.ListRewards": {
+		Summary:     "List review rewards",
+		Description: "Lists the rewards granted for approved reviews, newest first",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReviewIncentiveRewardsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewIncentiveHandler.UpdateProgram": {
+		Summary:     "Update review incentive program",
+		Description: "Updates the reward granted for approved reviews: loyalty points or a single-use coupon restricted to the reviewer. Customers are rewarded at most once per product. Switching the program on only rewards reviews written from then on.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.UpdateReviewIncentiveProgramRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ReviewIncentiveProgram{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewModerationHandler.GetSettings": {
+		Summary:     "Get review moderation settings",
+		Description: "Gets which reviews are published right away, the spam heuristics that hold reviews for an admin, and the daily review limit. Returns the defaults if the settings were never saved.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ReviewModerationSettings{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewModerationHandler.TestSettings": {
+		Summary:     "Test review moderation settings",
+		Description: "Applies settings changes, without saving them, to the reviews of the last days as if each were written again, and lists the reviews they would flag, refuse over the daily limit, or treat differently than the saved settings. Trust scores are as of now.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.TestReviewModerationSettingsRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReviewModerationTestResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ReviewModerationHandler.UpdateSettings": {
+		Summary:     "Update review moderation settings",
+		Description: "Updates the review moderation settings. Verified purchases are published when the reviewer's trust score reaches min_trust_score; other reviews when they have enough content for their rating. Reviews with links, phone numbers or banned words are held for an admin. Customers over max_reviews_per_day are refused with 429. Applies to reviews written from now on.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Body:        usecases.UpdateReviewModerationSettingsRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.ReviewModerationSettings{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SandboxHandler.AdvanceClock": {
+		Summary:     "Advance sandbox clock",
+		Description: "Move the clock used by time-based jobs forward, e.g. to trigger abandoned cart reminders",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Body:        usecases.AdvanceClockRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxClockResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SandboxHandler.ClearMailbox": {
+		Summary: "Clear captured emails",
+		Tags:    []string{"sandbox"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SandboxHandler.GetClock": {
+		Summary: "Get sandbox clock",
+		Tags:    []string{"sandbox"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxClockResponse{}},
+		},
+	},
+	"SandboxHandler.GetEmail": {
+		Summary: "Get captured email",
+		Tags:    []string{"sandbox"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Captured email ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: *new(usecases.CapturedEmail)},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SandboxHandler.GetInfo": {
+		Summary:     "Get sandbox information",
+		Description: "List magic card numbers, supported webhook events, runnable jobs and the sandbox clock",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxInfoResponse{}},
+		},
+	},
+	"SandboxHandler.ListEmails": {
+		Summary:     "List captured emails",
+		Description: "List emails captured by the sandbox mailbox, newest first",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "to", In: "query", Type: "string", Description: "Filter by recipient email"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxMailboxResponse{}},
+		},
+	},
+	"SandboxHandler.Reset": {
+		Summary:     "Reset sandbox",
+		Description: "Clear the mailbox, reset the clock and restart simulated gateway ID sequences",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxInfoResponse{}},
+		},
+	},
+	"SandboxHandler.ResetClock": {
+		Summary: "Reset sandbox clock",
+		Tags:    []string{"sandbox"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SandboxClockResponse{}},
+		},
+	},
+	"SandboxHandler.RunJob": {
+		Summary:     "Run background job",
+		Description: "Run a scheduled job now, using the sandbox clock",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "name", In: "path", Type: "string", Required: true, Description: "Job name"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.RunJobResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SandboxHandler.TriggerWebhook": {
+		Summary:     "Trigger payment webhook",
+		Description: "Feed a simulated gateway event through the regular webhook pipeline without a signature",
+		Tags:        []string{"sandbox"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "provider", In: "path", Type: "string", Required: true, Description: "Payment provider (stripe, paypal)"},
+		},
+		Body: usecases.TriggerWebhookRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.TriggerWebhookResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.CleanupSearchData": {
+		Summary:     "Cleanup old search data",
+		Description: "Cleanup old search data",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "days", In: "query", Type: "int", Description: "Days to keep"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SearchHandler.ClearUserSearchHistory": {
+		Summary:     "Clear user search history",
+		Description: "Clear all user's search history",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.DeleteSearchFilter": {
+		Summary:     "Delete search filter",
+		Description: "Delete a saved search filter",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Filter ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.EnhancedSearch": {
+		Summary:     "Enhanced search with dynamic faceting",
+		Description: "Perform enhanced product search with multi-select filters and dynamic facets",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Description: "Search query"},
+			{Name: "category_ids", In: "query", Type: "string", Description: "Category IDs (comma-separated)"},
+			{Name: "brand_ids", In: "query", Type: "string", Description: "Brand IDs (comma-separated)"},
+			{Name: "tag_ids", In: "query", Type: "string", Description: "Tag IDs (comma-separated)"},
+			{Name: "min_price", In: "query", Type: "number", Description: "Minimum price"},
+			{Name: "max_price", In: "query", Type: "number", Description: "Maximum price"},
+			{Name: "featured", In: "query", Type: "boolean", Description: "Featured products only"},
+			{Name: "in_stock", In: "query", Type: "boolean", Description: "In stock products only"},
+			{Name: "on_sale", In: "query", Type: "boolean", Description: "On sale products only"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by (relevance, price, name, created_at, rating)"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order (asc, desc)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+			{Name: "include_facets", In: "query", Type: "boolean", Description: "Include facets in response"},
+			{Name: "dynamic_facets", In: "query", Type: "boolean", Description: "Use dynamic facets"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.EnhancedSearchResponse{}},
+		},
+	},
+	"SearchHandler.FullTextSearch": {
+		Summary:     "Perform full-text search",
+		Description: "Search products using advanced full-text search with filters and facets",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Description: "Search query"},
+			{Name: "category_ids", In: "query", Type: "string", Description: "Category IDs (comma-separated)"},
+			{Name: "brand_ids", In: "query", Type: "string", Description: "Brand IDs (comma-separated)"},
+			{Name: "min_price", In: "query", Type: "number", Description: "Minimum price"},
+			{Name: "max_price", In: "query", Type: "number", Description: "Maximum price"},
+			{Name: "in_stock", In: "query", Type: "boolean", Description: "In stock only"},
+			{Name: "featured", In: "query", Type: "boolean", Description: "Featured products only"},
+			{Name: "on_sale", In: "query", Type: "boolean", Description: "On sale products only"},
+			{Name: "tags", In: "query", Type: "string", Description: "Tags (comma-separated)"},
+			{Name: "min_rating", In: "query", Type: "number", Description: "Minimum rating"},
+			{Name: "max_rating", In: "query", Type: "number", Description: "Maximum rating"},
+			{Name: "visibility", In: "query", Type: "string", Description: "Product visibility (public, private, hidden)"},
+			{Name: "product_type", In: "query", Type: "string", Description: "Product type (simple, variable, grouped)"},
+			{Name: "status", In: "query", Type: "string", Description: "Product status (active, inactive, draft)"},
+			{Name: "availability_status", In: "query", Type: "string", Description: "Availability status (in_stock, out_of_stock, low_stock)"},
+			{Name: "created_after", In: "query", Type: "string", Description: "Created after date (RFC3339)"},
+			{Name: "created_before", In: "query", Type: "string", Description: "Created before date (RFC3339)"},
+			{Name: "updated_after", In: "query", Type: "string", Description: "Updated after date (RFC3339)"},
+			{Name: "updated_before", In: "query", Type: "string", Description: "Updated before date (RFC3339)"},
+			{Name: "min_weight", In: "query", Type: "number", Description: "Minimum weight"},
+			{Name: "max_weight", In: "query", Type: "number", Description: "Maximum weight"},
+			{Name: "shipping_class", In: "query", Type: "string", Description: "Shipping class"},
+			{Name: "tax_class", In: "query", Type: "string", Description: "Tax class"},
+			{Name: "min_discount_percent", In: "query", Type: "number", Description: "Minimum discount percentage"},
+			{Name: "max_discount_percent", In: "query", Type: "number", Description: "Maximum discount percentage"},
+			{Name: "is_digital", In: "query", Type: "boolean", Description: "Digital products only"},
+			{Name: "requires_shipping", In: "query", Type: "boolean", Description: "Requires shipping"},
+			{Name: "allow_backorder", In: "query", Type: "boolean", Description: "Allow backorder"},
+			{Name: "track_quantity", In: "query", Type: "boolean", Description: "Track quantity"},
+			{Name: "sort_by", In: "query", Type: "string", Description: "Sort by (relevance, price, name, created_at, rating)"},
+			{Name: "sort_order", In: "query", Type: "string", Description: "Sort order (asc, desc)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SearchResponse{}},
+		},
+	},
+	"SearchHandler.GetAutocomplete": {
+		Summary:     "Get autocomplete suggestions",
+		Description: "Get autocomplete suggestions including products, categories, brands",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of suggestions"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AutocompleteResponse{}},
+		},
+	},
+	"SearchHandler.GetEnhancedAutocomplete": {
+		Summary:     "Get enhanced autocomplete suggestions",
+		Description: "Get enhanced autocomplete suggestions with multiple sources",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "types", In: "query", Type: "string", Description: "Comma-separated types (product,category,brand,query)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "include_trending", In: "query", Type: "bool", Description: "Include trending suggestions"},
+			{Name: "include_personalized", In: "query", Type: "bool", Description: "Include personalized suggestions"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.EnhancedAutocompleteResponse{}},
+		},
+	},
+	"SearchHandler.GetPersonalizedAutocomplete": {
+		Summary:     "Get personalized autocomplete suggestions",
+		Description: "Get personalized autocomplete suggestions for authenticated user",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.EnhancedAutocompleteResponse{}},
+		},
+	},
+	"SearchHandler.GetPopularSearchTerms": {
+		Summary:     "Get popular search terms",
+		Description: "Get popular search terms for a given period",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period (daily, weekly, monthly)"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of terms per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"SearchHandler.GetSearchAnalytics": {
+		Summary:     "Get search analytics",
+		Description: "Get search analytics for admin dashboard",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "start_date", In: "query", Type: "string", Description: "Start date (YYYY-MM-DD)"},
+			{Name: "end_date", In: "query", Type: "string", Description: "End date (YYYY-MM-DD)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.SearchAnalyticsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.GetSearchFacets": {
+		Summary:     "Get search facets",
+		Description: "Get search facets for filtering",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Description: "Search query"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SearchFacetsResponse{}},
+		},
+	},
+	"SearchHandler.GetSearchSuggestions": {
+		Summary:     "Get search suggestions",
+		Description: "Get search suggestions based on query",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of suggestions per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"SearchHandler.GetSearchTrends": {
+		Summary:     "Get search trends",
+		Description: "Get search trends for analytics",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "period", In: "query", Type: "string", Description: "Period (daily, weekly, monthly)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.SearchTrendResponse(nil)},
+		},
+	},
+	"SearchHandler.GetSmartAutocomplete": {
+		Summary:     "Get smart autocomplete suggestions",
+		Description: "Get intelligent autocomplete suggestions with fuzzy matching, personalization, and trending",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "q", In: "query", Type: "string", Required: true, Description: "Search query"},
+			{Name: "types", In: "query", Type: "string", Description: "Comma-separated types (product,category,brand,query)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "include_trending", In: "query", Type: "bool", Description: "Include trending suggestions"},
+			{Name: "include_personalized", In: "query", Type: "bool", Description: "Include personalized suggestions"},
+			{Name: "include_history", In: "query", Type: "bool", Description: "Include search history"},
+			{Name: "include_popular", In: "query", Type: "bool", Description: "Include popular suggestions"},
+			{Name: "language", In: "query", Type: "string", Description: "Language code"},
+			{Name: "region", In: "query", Type: "string", Description: "Region code"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.SmartAutocompleteResponse{}},
+		},
+	},
+	"SearchHandler.GetTrendingSearches": {
+		Summary:     "Get trending search terms",
+		Description: "Get trending search terms",
+		Tags:        []string{"search"},
+		Params: []openapi.ParamDoc{
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of terms per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+		},
+	},
+	"SearchHandler.GetUserSearchFilters": {
+		Summary:     "Get user search filters",
+		Description: "Get user's saved search filters",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.SearchFilterResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.GetUserSearchHistory": {
+		Summary:     "Get user search history",
+		Description: "Get user's search history",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Number of history items"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.SearchHistoryResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.GetUserSearchPreferences": {
+		Summary:     "Get user search preferences",
+		Description: "Get user search preferences",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserSearchPreferencesResponse{}},
+		},
+	},
+	"SearchHandler.RebuildAutocompleteIndex": {
+		Summary:     "Rebuild autocomplete index",
+		Description: "Rebuild autocomplete index from existing data",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SearchHandler.RecordAutocompleteClick": {
+		Summary:     "Record autocomplete click",
+		Description: "Record autocomplete click for analytics",
+		Tags:        []string{"search"},
+		Body:        usecases.AutocompleteClickRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SearchHandler.RecordSearchEvent": {
+		Summary:     "Record search event",
+		Description: "Record a search event for analytics",
+		Tags:        []string{"search"},
+		Body:        usecases.RecordSearchEventRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SearchHandler.SaveSearchFilter": {
+		Summary:     "Save search filter",
+		Description: "Save a search filter for reuse",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Body:        usecases.SaveSearchFilterRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.SearchFilterResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.SaveSearchHistory": {
+		Summary:     "Save search history",
+		Description: "Save user search history",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Body:        usecases.SaveSearchHistoryRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.TrackAutocompleteInteraction": {
+		Summary:     "Track autocomplete interaction",
+		Description: "Track when users click or interact with autocomplete suggestions",
+		Tags:        []string{"search"},
+		Body:        handlers.TrackAutocompleteRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SearchHandler.UpdateSearchFilter": {
+		Summary:     "Update search filter",
+		Description: "Update a saved search filter",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Filter ID"},
+		},
+		Body: usecases.UpdateSearchFilterRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SearchHandler.UpdateUserSearchPreferences": {
+		Summary:     "Update user search preferences",
+		Description: "Update user search preferences",
+		Tags:        []string{"search"},
+		Secured:     true,
+		Body:        usecases.UpdateSearchPreferencesRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"SettlementHandler.GetSettlement": {
+		Summary:     "Get settlement",
+		Description: "Get a day's settlement of one currency with its totals and reconciliation counts",
+		Tags:        []string{"settlements"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Settlement ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Settlement{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SettlementHandler.GetSettlementItems": {
+		Summary:     "Get settlement items",
+		Description: "List the gateway transactions of a settlement with how each matched our records, and the records the gateway has no transaction for",
+		Tags:        []string{"settlements"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Settlement ID"},
+			{Name: "status", In: "query", Type: "string", Description: "Item status (matched, amount_mismatch, currency_mismatch, missing_record, missing_at_gateway, unmatched)"},
+			{Name: "discrepancies", In: "query", Type: "bool", Description: "Only mismatches and missing records"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of items to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of items to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SettlementItemsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SettlementHandler.GetSettlements": {
+		Summary:     "Get settlements",
+		Description: "List daily settlements, most recent day first. Amounts are in minor units of the settlement currency; divide by 10^exponent for the decimal amount.",
+		Tags:        []string{"settlements"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "gateway", In: "query", Type: "string", Description: "Payment provider (stripe)"},
+			{Name: "currency", In: "query", Type: "string", Description: "Settlement currency, e.g. USD"},
+			{Name: "status", In: "query", Type: "string", Description: "Settlement status (reconciled, discrepancies)"},
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of settlements to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of settlements to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SettlementsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SettlementHandler.ReconcileSettlement": {
+		Summary:     "Reconcile settlements",
+		Description: "Match a day's (UTC) gateway balance transactions against the recorded payments, refunds and disputes, check its payouts, and replace that day's settlements, one per currency. Reconciling a day again is safe. Only Stripe is supported.",
+		Tags:        []string{"settlements"},
+		Secured:     true,
+		Body:        usecases.ReconcileSettlementRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ReconcileSettlementResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.CalculateDistanceBasedShipping": {
+		Summary:     "Calculate distance based shipping",
+		Description: "Calculates shipping options based on distance",
+		Tags:        []string{"shipping"},
+		Body:        usecases.DistanceBasedShippingRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.DistanceBasedShippingResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.CalculateShippingCost": {
+		Summary:     "Calculate shipping cost",
+		Description: "Calculates shipping cost",
+		Tags:        []string{"shipping"},
+		Body:        usecases.CalculateShippingRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ShippingCostResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.CreateShipment": {
+		Summary:     "Create shipment",
+		Description: "Creates a new shipment",
+		Tags:        []string{"shipping"},
+		Secured:     true,
+		Body:        usecases.CreateShipmentRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.ShipmentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.EstimateShipping": {
+		Summary:     "Estimate shipping",
+		Description: "Returns the shipping methods and prices of the shopper's cart, or of a single product with product_id, to a destination without starting checkout. The country defaults to the storefront ship-to country. Estimates for the same cart and destination are cached for a few minutes.",
+		Tags:        []string{"shipping"},
+		Params: []openapi.ParamDoc{
+			{Name: "product_id", In: "query", Type: "string", Description: "Estimate this product instead of the cart"},
+			{Name: "quantity", In: "query", Type: "int", Description: "Quantity of the product"},
+			{Name: "country", In: "query", Type: "string", Description: "Destination country code"},
+			{Name: "state", In: "query", Type: "string", Description: "Destination state"},
+			{Name: "city", In: "query", Type: "string", Description: "Destination city"},
+			{Name: "zip_code", In: "query", Type: "string", Description: "Destination postal code"},
+			{Name: "X-Session-ID", In: "header", Type: "string", Description: "Session ID for guest cart"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ShippingEstimateResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.GetShipment": {
+		Summary:     "Get shipment",
+		Description: "Retrieves a shipment by ID",
+		Tags:        []string{"shipping"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ShipmentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.GetShippingMethods": {
+		Summary:     "Get shipping methods",
+		Description: "Retrieves available shipping methods",
+		Tags:        []string{"shipping"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.ShippingMethodResponse(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.GetShippingZones": {
+		Summary:     "Get shipping zones",
+		Description: "Returns available shipping zones",
+		Tags:        []string{"shipping"},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.TrackShipment": {
+		Summary:     "Track shipment",
+		Description: "Tracks a shipment by tracking number",
+		Tags:        []string{"shipping"},
+		Params: []openapi.ParamDoc{
+			{Name: "tracking_number", In: "path", Type: "string", Required: true, Description: "Tracking number"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ShipmentTrackingResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.UpdateShipmentStatus": {
+		Summary:     "Update shipment status",
+		Description: "Updates shipment status",
+		Tags:        []string{"shipping"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ShipmentResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"ShippingHandler.ValidateShippingAddress": {
+		Summary: "Validate shipping address",
+		Tags:    []string{"shipping"},
+		Body:    usecases.ValidateShippingAddressRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.ValidateShippingAddressResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"StorefrontHandler.GetStorefront": {
+		Summary:     "Get storefront context",
+		Description: "Get the currency, locale and ship-to country the request resolved to, the choices available and the shopper's cart re-priced in them. The storefront comes from the X-Currency and X-Country headers, the locale query parameter, the storefront cookies, the signed-in user's profile and Accept-Language, in that order.",
+		Tags:        []string{"storefront"},
+		Params: []openapi.ParamDoc{
+			{Name: "X-Currency", In: "header", Type: "string", Description: "Currency to price in"},
+			{Name: "X-Country", In: "header", Type: "string", Description: "Ship-to country (ISO 3166-1 alpha-2)"},
+			{Name: "X-Session-ID", In: "header", Type: "string", Description: "Session ID for guest cart"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.StorefrontResponse{}},
+		},
+	},
+	"StorefrontHandler.SwitchStorefront": {
+		Summary:     "Switch storefront context",
+		Description: "Switch the shopper's currency, locale or ship-to country. The choice is remembered in cookies for the next requests, and optionally on the signed-in user's profile. Returns the new storefront with the cart re-priced.",
+		Tags:        []string{"storefront"},
+		Params: []openapi.ParamDoc{
+			{Name: "X-Session-ID", In: "header", Type: "string", Description: "Session ID for guest cart"},
+		},
+		Body: usecases.SwitchStorefrontRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.StorefrontResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"SystemStatusHandler.GetSystemStatus": {
+		Summary:     "Get system status",
+		Description: "Notification and email retry queue depths, outbox backlog, background job states with their last successful run, partner webhook delivery failures, and email and storage provider health, checked live with a 5 second limit each. Status is degraded when any of them needs attention, with each problem listed in issues.",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.SystemStatusResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.DeleteMyCertificate": {
+		Summary:     "Delete tax exemption certificate",
+		Description: "Withdraw one of the current user's certificates and delete its document. It no longer applies at checkout; orders placed with it keep their record of the exemption.",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Certificate ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.GetCertificate": {
+		Summary:     "Get tax exemption certificate",
+		Description: "Get a tax exemption certificate with the link to its document",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Certificate ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.TaxExemptionCertificate{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.GetCertificates": {
+		Summary:     "List tax exemption certificates",
+		Description: "List tax exemption certificates, oldest first, by status or customer",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status (pending, verified, rejected, expired)"},
+			{Name: "user_id", In: "query", Type: "string", Description: "Customer ID"},
+			{Name: "limit", In: "query", Type: "int", Description: "Page size"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.TaxExemptionsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.GetMyCertificates": {
+		Summary:     "Get my tax exemption certificates",
+		Description: "List the current user's tax exemption certificates, newest first, with their review status and expiry",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.TaxExemptionCertificate(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.RejectCertificate": {
+		Summary:     "Reject tax exemption certificate",
+		Description: "Reject a pending certificate, or revoke a verified one, with the reason in notes. It no longer applies at checkout, and the customer is notified.",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Certificate ID"},
+		},
+		Body: usecases.RejectTaxExemptionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.TaxExemptionCertificate{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.UploadCertificate": {
+		Summary:     "Upload tax exemption certificate",
+		Description: "Upload a PDF, JPEG or PNG tax exemption certificate of up to 10MB, such as a reseller's resale certificate, for an admin to verify. Once verified it applies at checkout to orders shipped to its country, or state when given, and exempts products of its tax classes, or all products when none are given. Certificates stop applying at the start of their expiry date.",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Certificate document"},
+			{Name: "type", In: "formData", Type: "string", Required: true, Description: "Exemption type (resale, nonprofit, government, other)"},
+			{Name: "certificate_number", In: "formData", Type: "string", Required: true, Description: "Certificate number"},
+			{Name: "country", In: "formData", Type: "string", Required: true, Description: "Country code (ISO 3166-1 alpha-2)"},
+			{Name: "state", In: "formData", Type: "string", Description: "State; empty for the whole country"},
+			{Name: "tax_classes", In: "formData", Type: "string", Description: "Comma separated product tax classes; empty for all"},
+			{Name: "expires_at", In: "formData", Type: "string", Description: "Expiry date (YYYY-MM-DD); empty if it does not expire"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.TaxExemptionCertificate{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"TaxExemptionHandler.VerifyCertificate": {
+		Summary:     "Verify tax exemption certificate",
+		Description: "Verify a pending certificate, correcting its expiry, state or tax classes from the document where needed. It applies at checkout from now on, and the customer is notified.",
+		Tags:        []string{"tax-exemptions"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Certificate ID"},
+		},
+		Body: usecases.VerifyTaxExemptionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.TaxExemptionCertificate{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ActivateUser": {
+		Summary:     "Activate user",
+		Description: "Activate a user account (admin only)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "User ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ChangePassword": {
+		Summary:     "Change user password",
+		Description: "Change current user's password",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        usecases.ChangePasswordRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ClearSearchHistory": {
+		Summary:     "Clear search history",
+		Description: "Clears user's search history",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.CreateSavedSearch": {
+		Summary:     "Create saved search",
+		Description: "Creates a new saved search",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        usecases.CreateSavedSearchRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.SavedSearchResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.DeactivateUser": {
+		Summary:     "Deactivate user",
+		Description: "Deactivate a user account (admin only)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "User ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ForgotPassword": {
+		Summary:     "Forgot password",
+		Description: "Send password reset email",
+		Tags:        []string{"auth"},
+		Body:        usecases.ForgotPasswordRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetBrowsingHistory": {
+		Summary:     "Get browsing history",
+		Description: "Retrieves user's browsing history",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.BrowsingHistoryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetMyActivity": {
+		Summary:     "Get my activity stream",
+		Description: "Get a paginated stream of the current user's notable events: orders, reviews, loyalty points, wishlist changes and support replies",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "type", In: "query", Type: "string", Description: "Comma-separated activity types to include"},
+			{Name: "page", In: "query", Type: "int", Description: "Page number"},
+			{Name: "limit", In: "query", Type: "int", Description: "Items per page"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.PaginatedResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetPersonalization": {
+		Summary:     "Get personalization",
+		Description: "Retrieves user personalization data",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.PersonalizationResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetProfile": {
+		Summary:     "Get user profile",
+		Description: "Get current user's profile",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetSavedSearches": {
+		Summary:     "Get saved searches",
+		Description: "Retrieves user's saved searches",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.GetSavedSearchesResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetSearchHistory": {
+		Summary:     "Get search history",
+		Description: "Retrieves user's search history",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserSearchHistoryListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetUserPreferences": {
+		Summary:     "Get user preferences",
+		Description: "Get current user's preferences",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserPreferencesResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetUserSessions": {
+		Summary:     "Get user sessions",
+		Description: "Get current user's active sessions",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserSessionsResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetUsers": {
+		Summary:     "Get users list",
+		Description: "Get list of users with pagination (admin only)",
+		Tags:        []string{"admin"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: []usecases.UserResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.GetVerificationStatus": {
+		Summary:     "Get verification status",
+		Description: "Get current user's verification status",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VerificationStatusResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.InvalidateAllSessions": {
+		Summary:     "Invalidate all sessions",
+		Description: "Invalidate all user sessions except current",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.InvalidateSession": {
+		Summary:     "Invalidate session",
+		Description: "Invalidate a specific user session",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "session_id", In: "path", Type: "string", Required: true, Description: "Session ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.Login": {
+		Summary:     "Login user",
+		Description: "Authenticate user and return JWT token",
+		Tags:        []string{"auth"},
+		Body:        usecases.LoginRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.LoginResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.Logout": {
+		Summary:     "Logout user",
+		Description: "Logout user and invalidate token",
+		Tags:        []string{"auth"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.RefreshToken": {
+		Summary:     "Refresh access token",
+		Description: "Refresh access token using refresh token",
+		Tags:        []string{"auth"},
+		Body:        map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.RefreshTokenResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.Register": {
+		Summary:     "Register a new user",
+		Description: "Register a new user account",
+		Tags:        []string{"auth"},
+		Body:        usecases.RegisterRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.UserResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ResendVerification": {
+		Summary:     "Resend verification email",
+		Description: "Resend email verification",
+		Tags:        []string{"auth"},
+		Body:        usecases.ResendVerificationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.ResetPassword": {
+		Summary:     "Reset password",
+		Description: "Reset password using reset token",
+		Tags:        []string{"auth"},
+		Body:        usecases.ResetPasswordRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.SendEmailVerification": {
+		Summary:     "Send email verification",
+		Description: "Send email verification to current user",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.TrackProductView": {
+		Summary:     "Track product view",
+		Description: "Tracks user product viewing activity",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "User-Agent", In: "header", Type: "string", Description: "User-Agent"},
+		},
+		Body: usecases.TrackProductViewRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.TrackSearch": {
+		Summary:     "Track search",
+		Description: "Tracks user search activity",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "User-Agent", In: "header", Type: "string", Description: "User-Agent"},
+		},
+		Body: usecases.TrackSearchRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			500: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.UpdateLanguage": {
+		Summary:     "Update user language",
+		Description: "Update current user's language preference",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.UpdateProfile": {
+		Summary:     "Update user profile",
+		Description: "Update current user's profile",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        usecases.UpdateProfileRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.UpdateTheme": {
+		Summary:     "Update user theme",
+		Description: "Update current user's theme preference",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.UpdateUserPreferences": {
+		Summary:     "Update user preferences",
+		Description: "Update current user's preferences",
+		Tags:        []string{"users"},
+		Secured:     true,
+		Body:        usecases.UpdateUserPreferencesRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.UserPreferencesResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.VerifyEmail": {
+		Summary:     "Verify email",
+		Description: "Verify email with token",
+		Tags:        []string{"users"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "query", Type: "string", Required: true, Description: "Verification token"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"UserHandler.VerifyEmailByToken": {
+		Summary:     "Verify email by token",
+		Description: "Verify user email using verification token from email link",
+		Tags:        []string{"users"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "query", Type: "string", Required: true, Description: "Verification token"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			302: {Description: "Redirect to frontend"},
+		},
+	},
+	"UserHandler.VerifyEmailWithToken": {
+		Summary:     "Verify email with token",
+		Description: "Verify email using verification token in request body",
+		Tags:        []string{"auth"},
+		Body:        usecases.VerifyEmailRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.Apply": {
+		Summary:     "Apply to become a vendor",
+		Description: "Start a draft vendor application with the store and business details. The vendor is created pending and cannot publish products until the application is approved.",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Body:        usecases.VendorApplicationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.ApproveApplication": {
+		Summary:     "Approve vendor application",
+		Description: "Approve a submitted application. The vendor becomes active and can publish products, and the applicant is notified.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Application ID"},
+		},
+		Body: usecases.ReviewVendorApplicationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.DeleteDocument": {
+		Summary:     "Delete vendor document",
+		Description: "Remove a document from a draft application, or one sent back for changes, and delete its file",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Document ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.GetApplication": {
+		Summary:     "Get vendor application",
+		Description: "Get a vendor application with its vendor and documents",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Application ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.GetApplications": {
+		Summary:     "Get vendor applications",
+		Description: "List vendor applications, oldest submission first. Filter by status=submitted for the review queue.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Application status (draft, submitted, changes_requested, approved, rejected)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of applications to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of applications to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VendorApplicationsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.GetMyApplication": {
+		Summary:     "Get my vendor application",
+		Description: "Get the current user's vendor application with its documents and the reviewer's notes",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.VendorApplication{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.RejectApplication": {
+		Summary:     "Reject vendor application",
+		Description: "Reject a submitted application with the reason in notes. The vendor is rejected and the applicant is notified.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Application ID"},
+		},
+		Body: usecases.ReviewVendorApplicationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.RequestApplicationChanges": {
+		Summary:     "Request vendor application changes",
+		Description: "Send a submitted application back with the changes needed in notes. The applicant is notified and can edit and submit it again.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Application ID"},
+		},
+		Body: usecases.ReviewVendorApplicationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.SubmitMyApplication": {
+		Summary:     "Submit my vendor application",
+		Description: "Send the application for review. It needs a business_registration and an identity document, and companies need a registration number.",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.UpdateMyApplication": {
+		Summary:     "Update my vendor application",
+		Description: "Replace the store and business details of a draft application, or one sent back for changes",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Body:        usecases.VendorApplicationRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.VendorApplication{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorApplicationHandler.UploadDocument": {
+		Summary:     "Upload vendor document",
+		Description: "Upload a PDF, JPEG or PNG document of up to 10MB to a draft application, or one sent back for changes. Files are checked for content that does not match their type and for suspicious content.",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "file", In: "formData", Type: "file", Required: true, Description: "Document file"},
+			{Name: "type", In: "formData", Type: "string", Required: true, Description: "Document type (business_registration, identity, tax_certificate, bank_statement, other)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.VendorDocument{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.AssignVendorProducts": {
+		Summary:     "Assign products to vendor",
+		Description: "Make a vendor the seller of products. Orders already placed keep the vendors they were split between.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+		},
+		Body: usecases.AssignVendorProductsRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.AssignVendorProductsResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.CreateVendor": {
+		Summary:     "Create vendor",
+		Description: "Create a marketplace vendor. Link an account with user_id so the vendor can sign in and see its statement.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Body:        usecases.CreateVendorRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: entities.Vendor{}},
+			400: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.DeleteCategoryCommission": {
+		Summary:     "Delete category commission",
+		Description: "Remove a category's commission so it inherits its parent's, or the default",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "category_id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.DeleteVendorCommission": {
+		Summary:     "Delete vendor commission",
+		Description: "Remove one of a vendor's commission overrides",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+			{Name: "commission_id", In: "path", Type: "string", Required: true, Description: "Vendor commission ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetCategoryCommissions": {
+		Summary:     "Get category commissions",
+		Description: "List the categories with their own commission. Subcategories without one inherit their nearest ancestor's.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.CategoryCommission(nil)},
+		},
+	},
+	"VendorHandler.GetMyStatement": {
+		Summary:     "Get my vendor statement",
+		Description: "Sum the current vendor's gross sales, commissions, fixed fees, refund clawbacks and net payout per period and currency. Amounts are in minor units of the currency; divide by 10^exponent for the decimal amount.",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+			{Name: "period", In: "query", Type: "string", Description: "Period (day, week, month)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VendorStatementResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetMyVendor": {
+		Summary:     "Get my vendor",
+		Description: "Get the marketplace vendor the current user signs in for",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Vendor{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetMyVendorOrders": {
+		Summary:     "Get my vendor orders",
+		Description: "List the current vendor's share of orders with their fee lines, most recent first",
+		Tags:        []string{"vendor"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of vendor orders to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of vendor orders to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VendorOrdersListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			403: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetOrderVendorOrders": {
+		Summary:     "Get order vendor orders",
+		Description: "Get the vendor orders an order was split into, with the fee lines computed when it was placed and the refunds taken back from each vendor",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "order_id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.VendorOrder(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetVendor": {
+		Summary:     "Get vendor",
+		Description: "Get a marketplace vendor",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Vendor{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetVendorCommissions": {
+		Summary:     "Get vendor commissions",
+		Description: "List a vendor's commission overrides. The one without a category applies to all categories.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.VendorCommission(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetVendorStatement": {
+		Summary:     "Get vendor statement",
+		Description: "Sum a vendor's gross sales, commissions, fixed fees, refund clawbacks and net payout per period and currency. Amounts are in minor units of the currency; divide by 10^exponent for the decimal amount.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+			{Name: "date_from", In: "query", Type: "string", Description: "From date (YYYY-MM-DD)"},
+			{Name: "date_to", In: "query", Type: "string", Description: "To date, inclusive (YYYY-MM-DD)"},
+			{Name: "period", In: "query", Type: "string", Description: "Period (day, week, month)"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VendorStatementResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.GetVendors": {
+		Summary:     "Get vendors",
+		Description: "List marketplace vendors by name",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Vendor status (active, suspended)"},
+			{Name: "search", In: "query", Type: "string", Description: "Search name, slug or email"},
+			{Name: "limit", In: "query", Type: "int", Description: "Number of vendors to return"},
+			{Name: "offset", In: "query", Type: "int", Description: "Number of vendors to skip"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.VendorsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.ResolveVendorCommission": {
+		Summary:     "Resolve vendor commission",
+		Description: "Show the commission a vendor's items are charged in a category and where it comes from: the vendor's override for the category or an ancestor, the vendor's override for all categories, the category's or an ancestor's commission, or the default",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+			{Name: "category_id", In: "query", Type: "string", Description: "Category ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: services.ResolvedCommission{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.SetCategoryCommission": {
+		Summary:     "Set category commission",
+		Description: "Set the commission rate and per-unit fixed fee charged on items of a category and its subcategories without their own",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "category_id", In: "path", Type: "string", Required: true, Description: "Category ID"},
+		},
+		Body: usecases.SetCommissionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.CategoryCommission{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.SetVendorCommission": {
+		Summary:     "Set vendor commission",
+		Description: "Set a vendor's commission for a category and its subcategories, or for all categories when category_id is left out. Replaces the vendor's existing override for the same scope.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+		},
+		Body: usecases.SetVendorCommissionRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.VendorCommission{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.SplitOrder": {
+		Summary:     "Split order between vendors",
+		Description: "Split an order between the vendors of its products if that failed when it was placed. An order that was already split is returned as it is.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "order_id", In: "path", Type: "string", Required: true, Description: "Order ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []entities.VendorOrder(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"VendorHandler.UpdateVendor": {
+		Summary:     "Update vendor",
+		Description: "Update a marketplace vendor. Suspending a vendor does not change how its products' orders are split.",
+		Tags:        []string{"marketplace"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Vendor ID"},
+		},
+		Body: usecases.UpdateVendorRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: entities.Vendor{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.CancelWaitlist": {
+		Summary:     "Cancel product waitlist",
+		Description: "Close a waitlist without notifying its remaining customers. The product is no longer held back until a launch.",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Waitlist ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.CreateWaitlist": {
+		Summary:     "Create product waitlist",
+		Description: "Open a waitlist for a product that launches at launch_at. Until then only customers on the waitlist whose early access window has opened can add it to their cart. Wave size and interval default to the server's configuration.",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Body:        usecases.CreateWaitlistRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.GetMyWaitlists": {
+		Summary:     "Get my waitlists",
+		Description: "List the product waitlists the current user is on, newest first, with when they can buy each product",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: []usecases.WaitlistEntryResponse(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.GetWaitlist": {
+		Summary:     "Get product waitlist",
+		Description: "Get a waitlist with its demand by membership tier and customer segment, and when each group's access opens",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Waitlist ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.GetWaitlists": {
+		Summary:     "List product waitlists",
+		Description: "List waitlists, soonest launch first, with how many customers joined and were notified and the shortfall against the product's stock",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "status", In: "query", Type: "string", Description: "Status (open, launching, launched, cancelled)"},
+			{Name: "limit", In: "query", Type: "int", Description: "Page size"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistsListResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.JoinWaitlist": {
+		Summary:     "Join a product's waitlist",
+		Description: "Join the waitlist of a product that has not launched. Customers are notified when they can buy it: at launch, or earlier when their membership tier or customer segment, as of joining, has early access. Joining again keeps the customer's place.",
+		Tags:        []string{"products"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			201: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistEntryResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.LaunchWaitlist": {
+		Summary:     "Launch product now",
+		Description: "Move a waitlist's launch to now. Everyone can buy the product, and the next run starts notifying the customers on the list in waves.",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Waitlist ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.LeaveWaitlist": {
+		Summary: "Leave a product's waitlist",
+		Tags:    []string{"products"},
+		Secured: true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WaitlistHandler.UpdateWaitlist": {
+		Summary:     "Update product waitlist",
+		Description: "Change a waitlist's wave size or interval, or, before anyone's access has opened, its launch time and early access windows",
+		Tags:        []string{"waitlists"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "id", In: "path", Type: "string", Required: true, Description: "Waitlist ID"},
+		},
+		Body: usecases.UpdateWaitlistRequest{},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WaitlistResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WarehouseSyncHandler.GetStatus": {
+		Summary:     "Get warehouse sync status",
+		Description: "Get the watermark, last run, last error and exported row counts of every table exported to the data warehouse",
+		Tags:        []string{"warehouse-sync"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}, Data: usecases.WarehouseSyncStatusResponse{}},
+		},
+	},
+	"WarehouseSyncHandler.ResetTable": {
+		Summary:     "Re-export a warehouse table",
+		Description: "Clear the watermark of a table so the next sync exports all of its rows again, e.g. to backfill a newly exported column. Rows already in the warehouse are not deleted.",
+		Tags:        []string{"warehouse-sync"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "table", In: "path", Type: "string", Required: true, Description: "Table"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WarehouseSyncHandler.SyncTables": {
+		Summary: "Sync warehouse tables now",
+		Tags:    []string{"warehouse-sync"},
+		Secured: true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WebSocketHandler.BroadcastTestNotification": {
+		Summary:     "Broadcast test notification",
+		Description: "Broadcasts a test notification to all connected users",
+		Tags:        []string{"websocket"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WebSocketHandler.GetConnectedUsers": {
+		Summary:     "Get connected users",
+		Description: "Returns list of connected users",
+		Tags:        []string{"websocket"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"WebSocketHandler.GetWebSocketStats": {
+		Summary:     "Get web socket stats",
+		Description: "Returns WebSocket connection statistics",
+		Tags:        []string{"websocket"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+		},
+	},
+	"WebSocketHandler.HandleNotificationWebSocket": {
+		Summary: "Handle notification web socket",
+		Tags:    []string{"websocket"},
+		Params: []openapi.ParamDoc{
+			{Name: "token", In: "query", Type: "string", Description: "JWT token for browsers that cannot set headers"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			101: {Description: "Switching protocols"},
+		},
+	},
+	"WebSocketHandler.SendTestNotification": {
+		Summary:     "Send test notification",
+		Description: "Sends a test notification to a specific user",
+		Tags:        []string{"websocket"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "user_id", In: "path", Type: "string", Required: true, Description: "User ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.AddToWishlist": {
+		Summary:     "Add product to wishlist",
+		Description: "Add a product to the user's wishlist",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Body:        map[string]string(nil),
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+			409: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.CheckWishlistStatus": {
+		Summary:     "Check if product is in wishlist",
+		Description: "Check if a specific product is in the user's wishlist",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "productId", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]bool(nil)},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.ClearWishlist": {
+		Summary:     "Clear wishlist",
+		Description: "Remove all items from the user's wishlist",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.GetWishlist": {
+		Summary:     "Get user's wishlist",
+		Description: "Get current user's wishlist with pagination",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "limit", In: "query", Type: "int", Description: "Limit"},
+			{Name: "offset", In: "query", Type: "int", Description: "Offset"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: usecases.WishlistResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.GetWishlistCount": {
+		Summary:     "Get wishlist count",
+		Description: "Get the total number of items in the user's wishlist",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: map[string]int64(nil)},
+			401: {Body: handlers.ErrorResponse{}},
+		},
+	},
+	"WishlistHandler.RemoveFromWishlist": {
+		Summary:     "Remove product from wishlist",
+		Description: "Remove a product from the user's wishlist",
+		Tags:        []string{"wishlist"},
+		Secured:     true,
+		Params: []openapi.ParamDoc{
+			{Name: "productId", In: "path", Type: "string", Required: true, Description: "Product ID"},
+		},
+		Responses: map[int]openapi.ResponseDoc{
+			200: {Body: handlers.SuccessResponse{}},
+			400: {Body: handlers.ErrorResponse{}},
+			401: {Body: handlers.ErrorResponse{}},
+			404: {Body: handlers.ErrorResponse{}},
+		},
+	},
+}
@@ -1,9 +1,14 @@
 package routes
 
 import (
+	"log"
+
 	"ecom-golang-clean-architecture/internal/delivery/http/handlers"
 	"ecom-golang-clean-architecture/internal/delivery/http/middleware"
+	"ecom-golang-clean-architecture/internal/delivery/http/openapi"
+	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,6 +17,7 @@ import (
 func SetupRoutes(
 	router *gin.Engine,
 	cfg *config.Config,
+	jwtKeys *jwtkeys.KeySet,
 	userHandler *handlers.UserHandler,
 	productHandler *handlers.ProductHandler,
 	categoryHandler *handlers.CategoryHandler,
@@ -38,6 +44,43 @@ func SetupRoutes(
 	comparisonHandler *handlers.ProductComparisonHandler,
 	productFilterHandler *handlers.ProductFilterHandler,
 	abandonedCartHandler *handlers.AbandonedCartHandler,
+	pricingHandler *handlers.PricingHandler,
+	companyHandler *handlers.CompanyHandler,
+	quoteHandler *handlers.QuoteHandler,
+	catalogVisibilityHandler *handlers.CatalogVisibilityHandler,
+	sandboxHandler *handlers.SandboxHandler,
+	metricsHandler *handlers.MetricsHandler,
+	adminNoteHandler *handlers.AdminNoteHandler,
+	orderMessageHandler *handlers.OrderMessageHandler,
+	reviewIncentiveHandler *handlers.ReviewIncentiveHandler,
+	productTranslationHandler *handlers.ProductTranslationHandler,
+	catalogChangesetHandler *handlers.CatalogChangesetHandler,
+	eventBridgeHandler *handlers.EventBridgeHandler,
+	warehouseSyncHandler *handlers.WarehouseSyncHandler,
+	aggregateRepairHandler *handlers.AggregateRepairHandler,
+	orderArchiveHandler *handlers.OrderArchiveHandler,
+	reportHandler *handlers.ReportHandler,
+	paymentLinkHandler *handlers.PaymentLinkHandler,
+	disputeHandler *handlers.DisputeHandler,
+	settlementHandler *handlers.SettlementHandler,
+	adminTaskHandler *handlers.AdminTaskHandler,
+	messageTemplateHandler *handlers.MessageTemplateHandler,
+	platformCSVHandler *handlers.PlatformCSVHandler,
+	vendorHandler *handlers.VendorHandler,
+	vendorApplicationHandler *handlers.VendorApplicationHandler,
+	storefrontHandler *handlers.StorefrontHandler,
+	categoryAssignmentHandler *handlers.CategoryAssignmentHandler,
+	jwtKeyHandler *handlers.JWTKeyHandler,
+	dataResidencyHandler *handlers.DataResidencyHandler,
+	purchasingHandler *handlers.PurchasingHandler,
+	backInStockHandler *handlers.BackInStockHandler,
+	fulfillmentSLAHandler *handlers.FulfillmentSLAHandler,
+	returnPortalHandler *handlers.ReturnPortalHandler,
+	partnerFeedHandler *handlers.PartnerFeedHandler,
+	reviewModerationHandler *handlers.ReviewModerationHandler,
+	taxExemptionHandler *handlers.TaxExemptionHandler,
+	systemStatusHandler *handlers.SystemStatusHandler,
+	waitlistHandler *handlers.WaitlistHandler,
 ) {
 	// Apply global middleware
 	router.Use(gin.Recovery())                       // Add panic recovery middleware
@@ -46,12 +89,24 @@ func SetupRoutes(
 	router.Use(middleware.RequestSizeLimitMiddleware(10 << 20)) // 10MB limit
 	router.Use(middleware.LoggingMiddleware())
 	router.Use(middleware.RequestIDMiddleware())
+	router.Use(middleware.ActorMiddleware())
 	router.Use(middleware.ErrorHandlerMiddleware())
 	router.Use(middleware.ValidationMiddleware())
 	router.Use(middleware.SessionValidationMiddleware())
 
+	// OpenAPI document, generated from the registered routes once they are all in place
+	spec := openapi.NewSpec(openapi.Info{
+		Title:       "E-commerce API",
+		Description: "A modern e-commerce API built with Go and Clean Architecture",
+		Version:     "1.0",
+	})
+	if cfg.App.Env == "development" && cfg.App.ValidateResponses {
+		router.Use(openapi.ResponseValidationMiddleware(spec))
+	}
+	router.GET("/openapi.json", spec.Handler())
+
 	// Create auth middleware instance
-	authMiddleware := middleware.NewAuthMiddleware(cfg)
+	authMiddleware := middleware.NewAuthMiddleware(jwtKeys)
 
 	// Serve static files for uploads
 	router.Static("/uploads", "./uploads")
@@ -64,8 +119,24 @@ func SetupRoutes(
 		})
 	})
 
+	// Prometheus metrics (external provider circuit breakers)
+	router.GET("/metrics", metricsHandler.GetMetrics)
+
+	// Public keys for services that verify access tokens themselves
+	if jwtKeyHandler != nil {
+		router.GET("/.well-known/jwks.json", jwtKeyHandler.GetJWKS)
+	}
+
 	// API v1 routes
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.AnonymousSessionMiddleware(
+		cfg.Analytics.SessionCookieName,
+		cfg.Analytics.GetSessionCookieMaxAge(),
+		cfg.App.IsProduction(),
+	))
+	if storefrontHandler != nil {
+		v1.Use(middleware.StorefrontMiddleware(jwtKeys, storefrontHandler.Resolve))
+	}
 	{
 		// Public routes (no authentication required)
 		auth := v1.Group("/auth")
@@ -97,19 +168,28 @@ func SetupRoutes(
 
 		// Public product routes
 		products := v1.Group("/products")
+		products.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 		{
 			products.GET("", productHandler.GetProducts)
 			products.GET("/:id", productHandler.GetProduct)
 			products.GET("/search", productHandler.SearchProducts)
+			products.GET("/price-availability", productHandler.GetPriceAvailability)
 			products.GET("/filters", productHandler.GetProductFilters)
 			products.GET("/category/:categoryId", productHandler.GetProductsByCategory)
 			products.GET("/featured", productHandler.GetFeaturedProducts)
 			products.GET("/trending", productHandler.GetTrendingProducts)
 			if reviewHandler != nil {
 				products.GET("/:id/reviews", reviewHandler.GetProductReviews)
+				products.GET("/:id/reviews/summary", reviewHandler.GetProductReviewSummary)
 				products.GET("/:id/rating", reviewHandler.GetProductRating)
 			}
 			products.GET("/:id/related", productHandler.GetRelatedProducts)
+			products.GET("/:id/alternatives", productHandler.GetProductAlternatives)
+
+			// Price history for "was/now" price claims
+			if pricingHandler != nil {
+				products.GET("/:id/price-history", pricingHandler.GetPriceClaim)
+			}
 
 			// Product recommendation routes
 			if recommendationHandler != nil {
@@ -154,7 +234,7 @@ func SetupRoutes(
 
 			// Authenticated routes
 			authProducts := products.Group("")
-			authProducts.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
+			authProducts.Use(middleware.AuthMiddleware(jwtKeys))
 			{
 				authProducts.GET("/search-history", productHandler.GetSearchHistory)
 
@@ -170,6 +250,7 @@ func SetupRoutes(
 
 		// Public category routes
 		categories := v1.Group("/categories")
+		categories.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 		{
 			categories.GET("", categoryHandler.GetCategories)
 			categories.GET("/:id", categoryHandler.GetCategory)
@@ -192,6 +273,7 @@ func SetupRoutes(
 		// Public search routes
 		if searchHandler != nil {
 			search := v1.Group("/search")
+			search.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 			{
 				search.GET("", searchHandler.FullTextSearch)
 				search.GET("/enhanced", searchHandler.EnhancedSearch)
@@ -221,6 +303,7 @@ func SetupRoutes(
 		// Public recommendation routes
 		if recommendationHandler != nil {
 			recommendations := v1.Group("/recommendations")
+			recommendations.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 			{
 				recommendations.GET("", recommendationHandler.GetRecommendations)
 				recommendations.GET("/trending", recommendationHandler.GetTrendingProducts)
@@ -228,6 +311,14 @@ func SetupRoutes(
 			}
 		}
 
+		// Public visitor tracking routes (anonymous or signed in)
+		publicAnalytics := v1.Group("/analytics")
+		publicAnalytics.Use(middleware.OptionalAuthMiddleware(jwtKeys))
+		{
+			publicAnalytics.POST("/page-views", analyticsHandler.TrackPageView)
+			publicAnalytics.POST("/products/:id/views", analyticsHandler.TrackProductView)
+		}
+
 		// Public brand routes
 		brands := v1.Group("/brands")
 		{
@@ -253,7 +344,7 @@ func SetupRoutes(
 		// Public file upload routes (requires authentication, with strict rate limiting)
 		publicUpload := v1.Group("/public/upload")
 		publicUpload.Use(middleware.PublicUploadRateLimitMiddleware())
-		publicUpload.Use(middleware.PublicUploadAuthMiddleware(cfg.JWT.Secret))
+		publicUpload.Use(middleware.PublicUploadAuthMiddleware(jwtKeys))
 		publicUpload.Use(middleware.FileUploadSecurityMiddleware())
 		{
 			publicUpload.POST("/image", fileHandler.UploadImagePublic)
@@ -269,8 +360,10 @@ func SetupRoutes(
 		// Shipping routes (public)
 		if shippingHandler != nil {
 			shipping := v1.Group("/shipping")
+			shipping.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 			{
 				shipping.GET("/methods", shippingHandler.GetShippingMethods)
+				shipping.GET("/estimate", shippingHandler.EstimateShipping)
 				shipping.POST("/calculate-distance", shippingHandler.CalculateDistanceBasedShipping)
 				shipping.GET("/zones", shippingHandler.GetShippingZones)
 				shipping.POST("/rates", shippingHandler.CalculateShippingCost)
@@ -279,6 +372,16 @@ func SetupRoutes(
 			}
 		}
 
+		// Storefront context routes (currency, locale and ship-to country)
+		if storefrontHandler != nil {
+			storefront := v1.Group("/storefront")
+			storefront.Use(middleware.OptionalAuthMiddleware(jwtKeys))
+			{
+				storefront.GET("", storefrontHandler.GetStorefront)
+				storefront.PUT("", storefrontHandler.SwitchStorefront)
+			}
+		}
+
 		// Coupon routes (public validation)
 		coupons := v1.Group("/coupons")
 		{
@@ -302,9 +405,40 @@ func SetupRoutes(
 			}
 		}
 
+		// Public payment link routes; the token is the link's secret
+		if paymentLinkHandler != nil {
+			pay := v1.Group("/pay")
+			{
+				pay.GET("/:token", paymentLinkHandler.GetPublicPaymentLink)
+				pay.POST("/:token/checkout", paymentLinkHandler.StartPaymentLinkCheckout)
+			}
+		}
+
+		// Public returns portal routes; the token is the return link's secret
+		if returnPortalHandler != nil {
+			returnsPortal := v1.Group("/returns/portal/:token")
+			{
+				returnsPortal.GET("", returnPortalHandler.GetReturnPortal)
+				returnsPortal.POST("/returns", returnPortalHandler.CreatePortalReturn)
+				returnsPortal.GET("/returns/:id/label", returnPortalHandler.DownloadReturnLabel)
+			}
+		}
+
+		// Partner feed routes; partners authenticate with their API keys, which share one set of
+		// limits across these routes
+		if partnerFeedHandler != nil {
+			partner := v1.Group("/partner")
+			partner.Use(middleware.APIKeyMiddleware(entities.APIKeyScopePartnerFeed, partnerFeedHandler.AuthenticateAPIKey, partnerFeedHandler))
+			{
+				partner.GET("/feed/changes", partnerFeedHandler.GetFeedChanges)
+				partner.GET("/usage", partnerFeedHandler.GetAPIKeyUsage)
+			}
+		}
+
 		// Public review routes (no authentication required)
 		if reviewHandler != nil {
 			publicReviews := v1.Group("/public/reviews")
+			publicReviews.Use(middleware.OptionalAuthMiddleware(jwtKeys))
 			{
 				publicReviews.GET("/product/:product_id", reviewHandler.GetProductReviews)
 				publicReviews.GET("/product/:product_id/summary", reviewHandler.GetProductRating)
@@ -320,7 +454,7 @@ func SetupRoutes(
 
 		// Protected routes (authentication required)
 		protected := v1.Group("")
-		protected.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
+		protected.Use(middleware.AuthMiddleware(jwtKeys))
 		{
 			// User routes
 			users := protected.Group("/users")
@@ -393,6 +527,12 @@ func SetupRoutes(
 				}
 			}
 
+			// Current user's self-service views
+			me := protected.Group("/me")
+			{
+				me.GET("/activity", userHandler.GetMyActivity)
+			}
+
 			// Protected search routes (authentication required)
 			if searchHandler != nil {
 				searchProtected := protected.Group("/search")
@@ -467,7 +607,13 @@ func SetupRoutes(
 				orders.GET("/:id/events", orderHandler.GetOrderEvents)
 				orders.POST("/:id/notes", orderHandler.AddOrderNote)
 				orders.GET("/:id/payments", paymentHandler.GetOrderPayments)
-				// orders.GET("/:id/invoice", orderHandler.GetOrderInvoice) // TODO: Implement GetOrderInvoice method
+				if orderMessageHandler != nil {
+					orders.GET("/:id/messages", orderMessageHandler.GetMessages)
+					orders.POST("/:id/messages", orderMessageHandler.SendMessage)
+					orders.POST("/:id/messages/read", orderMessageHandler.MarkMessagesRead)
+				}
+				orders.GET("/:id/invoice", orderHandler.DownloadMyInvoice)
+				orders.GET("/:id/gift-receipt", orderHandler.DownloadMyGiftReceipt)
 				// orders.POST("/:id/reorder", orderHandler.ReorderItems) // TODO: Implement ReorderItems method
 			}
 
@@ -492,11 +638,26 @@ func SetupRoutes(
 				wishlist.GET("/count", wishlistHandler.GetWishlistCount)
 			}
 
+			// Back in stock notification routes
+			if backInStockHandler != nil {
+				protected.POST("/products/:id/back-in-stock", backInStockHandler.Subscribe)
+				protected.DELETE("/products/:id/back-in-stock", backInStockHandler.Unsubscribe)
+			}
+
+			// Upcoming product waitlist routes
+			if waitlistHandler != nil {
+				protected.POST("/products/:id/waitlist", waitlistHandler.JoinWaitlist)
+				protected.DELETE("/products/:id/waitlist", waitlistHandler.LeaveWaitlist)
+				protected.GET("/waitlists", waitlistHandler.GetMyWaitlists)
+			}
+
 			// Address routes
 			addresses := protected.Group("/addresses")
 			{
 				addresses.GET("", addressHandler.GetAddresses)
 				addresses.POST("", addressHandler.CreateAddress)
+				addresses.GET("/default", addressHandler.GetDefaultAddress)
+				addresses.GET("/checkout", addressHandler.GetCheckoutAddresses)
 				addresses.GET("/:id", addressHandler.GetAddress)
 				addresses.PUT("/:id", addressHandler.UpdateAddress)
 				addresses.DELETE("/:id", addressHandler.DeleteAddress)
@@ -504,6 +665,70 @@ func SetupRoutes(
 				// addresses.POST("/validate", addressHandler.ValidateAddress) // TODO: Implement ValidateAddress method
 			}
 
+			// Company (B2B) account routes for members
+			if companyHandler != nil {
+				company := protected.Group("/company")
+				{
+					company.GET("", companyHandler.GetMyCompany)
+					company.GET("/addresses", companyHandler.GetCompanyAddresses)
+					company.POST("/addresses", companyHandler.CreateCompanyAddress)
+					company.DELETE("/addresses/:id", companyHandler.DeleteCompanyAddress)
+					company.GET("/approvals", companyHandler.GetOrderApprovals)
+					company.POST("/approvals/:id/approve", companyHandler.ApproveOrder)
+					company.POST("/approvals/:id/reject", companyHandler.RejectOrder)
+					company.GET("/invoices", companyHandler.GetMyCompanyInvoices)
+					company.GET("/invoices/:id", companyHandler.GetMyCompanyInvoice)
+				}
+			}
+
+			// Marketplace vendor routes for the accounts vendors sign in with
+			if vendorHandler != nil {
+				vendor := protected.Group("/vendor")
+				{
+					vendor.GET("", vendorHandler.GetMyVendor)
+					vendor.GET("/orders", vendorHandler.GetMyVendorOrders)
+					vendor.GET("/statement", vendorHandler.GetMyStatement)
+				}
+			}
+
+			// Vendor onboarding routes
+			if vendorApplicationHandler != nil {
+				application := protected.Group("/vendor/application")
+				{
+					application.GET("", vendorApplicationHandler.GetMyApplication)
+					application.POST("", vendorApplicationHandler.Apply)
+					application.PUT("", vendorApplicationHandler.UpdateMyApplication)
+					application.POST("/documents", vendorApplicationHandler.UploadDocument)
+					application.DELETE("/documents/:id", vendorApplicationHandler.DeleteDocument)
+					application.POST("/submit", vendorApplicationHandler.SubmitMyApplication)
+				}
+			}
+
+			// Tax exemption certificate routes
+			if taxExemptionHandler != nil {
+				taxExemptions := protected.Group("/tax-exemptions")
+				{
+					taxExemptions.GET("", taxExemptionHandler.GetMyCertificates)
+					taxExemptions.POST("", taxExemptionHandler.UploadCertificate)
+					taxExemptions.DELETE("/:id", taxExemptionHandler.DeleteMyCertificate)
+				}
+			}
+
+			// Quote request (RFQ) routes
+			if quoteHandler != nil {
+				quotes := protected.Group("/quotes")
+				{
+					quotes.GET("", quoteHandler.GetMyQuotes)
+					quotes.POST("", quoteHandler.RequestQuote)
+					quotes.GET("/:id", quoteHandler.GetMyQuote)
+					quotes.GET("/:id/pdf", quoteHandler.DownloadMyQuotePDF)
+					quotes.POST("/:id/accept", quoteHandler.AcceptQuote)
+					quotes.POST("/:id/reject", quoteHandler.RejectQuote)
+					quotes.POST("/:id/cancel", quoteHandler.CancelQuote)
+					quotes.POST("/:id/order", quoteHandler.ConvertQuoteToOrder)
+				}
+			}
+
 			// Payment routes
 			payments := protected.Group("/payments")
 			{
@@ -563,7 +788,7 @@ func SetupRoutes(
 
 		// Admin routes (admin authentication required)
 		admin := v1.Group("/admin")
-		admin.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
+		admin.Use(middleware.AuthMiddleware(jwtKeys))
 		admin.Use(middleware.AdminMiddleware())
 		{
 			// Dashboard routes
@@ -616,6 +841,10 @@ func SetupRoutes(
 				adminCustomers.GET("/analytics", adminHandler.GetCustomerAnalytics)
 				adminCustomers.GET("/high-value", adminHandler.GetHighValueCustomers)
 				adminCustomers.GET("/:customer_id/lifetime-value", adminHandler.GetCustomerLifetimeValue)
+				if adminNoteHandler != nil {
+					adminCustomers.GET("/:customer_id/internal-notes", adminNoteHandler.ListCustomerNotes)
+					adminCustomers.POST("/:customer_id/internal-notes", adminNoteHandler.AddCustomerNote)
+				}
 			}
 
 			// Admin product management
@@ -626,7 +855,200 @@ func SetupRoutes(
 				adminProducts.PUT("/:id", productHandler.UpdateProduct)  // Complete replacement
 				adminProducts.PATCH("/:id", productHandler.PatchProduct) // Partial update
 				adminProducts.DELETE("/:id", productHandler.DeleteProduct)
+				adminProducts.PUT("/:id/lifecycle", productHandler.ChangeProductLifecycle)
 				adminProducts.PUT("/:id/stock", productHandler.UpdateStock)
+
+				// Price scheduling and price change log
+				if pricingHandler != nil {
+					adminProducts.POST("/:id/price-schedules", pricingHandler.SchedulePriceChange)
+					adminProducts.GET("/:id/price-schedules", pricingHandler.GetProductScheduledPriceChanges)
+					adminProducts.GET("/:id/price-history", pricingHandler.GetPriceHistory)
+				}
+
+				// Per-locale product content
+				if productTranslationHandler != nil {
+					adminProducts.GET("/:id/translations", productTranslationHandler.GetProductTranslations)
+					adminProducts.PUT("/:id/translations/:locale", productTranslationHandler.SaveProductTranslation)
+					adminProducts.DELETE("/:id/translations/:locale", productTranslationHandler.DeleteProductTranslation)
+				}
+			}
+
+			// Translation progress and translation files
+			if productTranslationHandler != nil {
+				productTranslations := admin.Group("/product-translations")
+				{
+					productTranslations.GET("/summary", productTranslationHandler.GetTranslationSummary)
+					productTranslations.GET("/export", productTranslationHandler.ExportTranslations)
+					productTranslations.POST("/import", productTranslationHandler.ImportTranslations)
+				}
+			}
+
+			// Shopify and WooCommerce CSV files
+			if platformCSVHandler != nil {
+				platformCSV := admin.Group("/platform-csv")
+				{
+					platformCSV.GET("/products/export", platformCSVHandler.ExportProducts)
+					platformCSV.POST("/products/import", platformCSVHandler.ImportProducts)
+					platformCSV.GET("/orders/export", platformCSVHandler.ExportOrders)
+				}
+			}
+
+			// Scheduled price change management
+			if pricingHandler != nil {
+				priceSchedules := admin.Group("/price-schedules")
+				{
+					priceSchedules.GET("", pricingHandler.ListScheduledPriceChanges)
+					priceSchedules.POST("/apply", pricingHandler.ApplyDuePriceChanges)
+					priceSchedules.POST("/revert-expired-sales", pricingHandler.RevertExpiredSales)
+					priceSchedules.DELETE("/:id", pricingHandler.CancelScheduledPriceChange)
+				}
+
+				// Rule-based bulk price changes with dry-run preview and rollback
+				bulkPriceUpdates := admin.Group("/bulk-price-updates")
+				{
+					bulkPriceUpdates.GET("", pricingHandler.ListBulkPriceUpdates)
+					bulkPriceUpdates.POST("", pricingHandler.ApplyBulkPriceUpdate)
+					bulkPriceUpdates.POST("/preview", pricingHandler.PreviewBulkPriceUpdate)
+					bulkPriceUpdates.GET("/:id", pricingHandler.GetBulkPriceUpdate)
+					bulkPriceUpdates.POST("/:id/rollback", pricingHandler.RollbackBulkPriceUpdate)
+				}
+			}
+
+			// Staged catalog changes with storefront preview, scheduled publishing and rollback
+			if catalogChangesetHandler != nil {
+				catalogChangesets := admin.Group("/catalog-changesets")
+				{
+					catalogChangesets.GET("", catalogChangesetHandler.ListChangesets)
+					catalogChangesets.POST("", catalogChangesetHandler.CreateChangeset)
+					catalogChangesets.POST("/publish-due", catalogChangesetHandler.PublishDueChangesets)
+					catalogChangesets.GET("/:id", catalogChangesetHandler.GetChangeset)
+					catalogChangesets.PUT("/:id", catalogChangesetHandler.UpdateChangeset)
+					catalogChangesets.DELETE("/:id", catalogChangesetHandler.DeleteChangeset)
+					catalogChangesets.PUT("/:id/products/:product_id", catalogChangesetHandler.SaveChangesetItem)
+					catalogChangesets.DELETE("/:id/products/:product_id", catalogChangesetHandler.RemoveChangesetItem)
+					catalogChangesets.PUT("/:id/schedule", catalogChangesetHandler.ScheduleChangeset)
+					catalogChangesets.DELETE("/:id/schedule", catalogChangesetHandler.UnscheduleChangeset)
+					catalogChangesets.POST("/:id/publish", catalogChangesetHandler.PublishChangeset)
+					catalogChangesets.POST("/:id/rollback", catalogChangesetHandler.RollbackChangeset)
+				}
+			}
+
+			// Domain event stream to Kafka or NATS, only when an event broker is configured
+			if eventBridgeHandler != nil {
+				eventBridge := admin.Group("/event-bridge")
+				{
+					eventBridge.GET("/status", eventBridgeHandler.GetStatus)
+					eventBridge.POST("/publish", eventBridgeHandler.PublishPendingEvents)
+				}
+			}
+
+			// Data warehouse export, only when a warehouse target is configured
+			if warehouseSyncHandler != nil {
+				warehouseSync := admin.Group("/warehouse-sync")
+				{
+					warehouseSync.GET("/status", warehouseSyncHandler.GetStatus)
+					warehouseSync.POST("/run", warehouseSyncHandler.SyncTables)
+					warehouseSync.POST("/tables/:table/reset", warehouseSyncHandler.ResetTable)
+				}
+			}
+
+			// Denormalized aggregate checks and repairs
+			if aggregateRepairHandler != nil {
+				aggregates := admin.Group("/aggregates")
+				{
+					aggregates.POST("/repair", aggregateRepairHandler.RepairAggregates)
+					aggregates.GET("/repair-runs", aggregateRepairHandler.ListRepairRuns)
+					aggregates.GET("/repair-runs/:id", aggregateRepairHandler.GetRepairRun)
+				}
+			}
+
+			// Archived orders and restoring them on demand
+			if orderArchiveHandler != nil {
+				orderArchive := admin.Group("/order-archive")
+				{
+					orderArchive.GET("/status", orderArchiveHandler.GetStatus)
+					orderArchive.POST("/run", orderArchiveHandler.ArchiveOldOrders)
+					orderArchive.GET("/orders/:id", orderArchiveHandler.GetArchivedOrder)
+					orderArchive.POST("/orders/:id/restore", orderArchiveHandler.RestoreOrder)
+				}
+			}
+
+			// Company (B2B) account management
+			if companyHandler != nil {
+				companies := admin.Group("/companies")
+				{
+					companies.GET("", companyHandler.ListCompanies)
+					companies.POST("", companyHandler.CreateCompany)
+					companies.POST("/invoices/send-reminders", companyHandler.SendInvoiceReminders)
+					companies.GET("/invoices/:invoice_id", companyHandler.GetInvoice)
+					companies.PUT("/invoices/:invoice_id/paid", companyHandler.MarkInvoicePaid)
+					companies.POST("/invoices/:invoice_id/payments", companyHandler.RecordInvoicePayment)
+					companies.GET("/:id", companyHandler.GetCompany)
+					companies.PUT("/:id", companyHandler.UpdateCompany)
+					companies.POST("/:id/members", companyHandler.AddMember)
+					companies.PUT("/:id/members/:member_id", companyHandler.UpdateMember)
+					companies.DELETE("/:id/members/:member_id", companyHandler.RemoveMember)
+					companies.GET("/:id/invoices", companyHandler.GetCompanyInvoices)
+					companies.POST("/:id/invoices", companyHandler.GenerateInvoice)
+				}
+			}
+
+			// Quote request (RFQ) management
+			if quoteHandler != nil {
+				adminQuotes := admin.Group("/quotes")
+				{
+					adminQuotes.GET("", quoteHandler.ListQuotes)
+					adminQuotes.POST("/expire", quoteHandler.ExpireQuotes)
+					adminQuotes.GET("/:id", quoteHandler.GetQuote)
+					adminQuotes.GET("/:id/pdf", quoteHandler.DownloadQuotePDF)
+					adminQuotes.POST("/:id/respond", quoteHandler.RespondToQuote)
+					adminQuotes.POST("/:id/decline", quoteHandler.DeclineQuote)
+				}
+			}
+
+			// Internal notes on orders and customers
+			if adminNoteHandler != nil {
+				internalNotes := admin.Group("/internal-notes")
+				{
+					internalNotes.PUT("/:id", adminNoteHandler.UpdateNote)
+					internalNotes.DELETE("/:id", adminNoteHandler.DeleteNote)
+					internalNotes.POST("/:id/pin", adminNoteHandler.PinNote)
+					internalNotes.DELETE("/:id/pin", adminNoteHandler.UnpinNote)
+				}
+			}
+
+			// Incentivized review program
+			if reviewIncentiveHandler != nil {
+				reviewIncentives := admin.Group("/review-incentives")
+				{
+					reviewIncentives.GET("/program", reviewIncentiveHandler.GetProgram)
+					reviewIncentives.PUT("/program", reviewIncentiveHandler.UpdateProgram)
+					reviewIncentives.GET("/rewards", reviewIncentiveHandler.ListRewards)
+					reviewIncentives.GET("/report", reviewIncentiveHandler.GetReport)
+				}
+			}
+
+			// Review auto-approval and spam heuristics
+			if reviewModerationHandler != nil {
+				reviewModeration := admin.Group("/review-moderation")
+				{
+					reviewModeration.GET("/settings", reviewModerationHandler.GetSettings)
+					reviewModeration.PUT("/settings", reviewModerationHandler.UpdateSettings)
+					reviewModeration.POST("/test", reviewModerationHandler.TestSettings)
+				}
+			}
+
+			// Catalog visibility rules (per-customer catalogs)
+			if catalogVisibilityHandler != nil {
+				catalogVisibility := admin.Group("/catalog-visibility")
+				{
+					catalogVisibility.GET("", catalogVisibilityHandler.ListRules)
+					catalogVisibility.POST("", catalogVisibilityHandler.CreateRule)
+					catalogVisibility.GET("/preview", catalogVisibilityHandler.PreviewHiddenProducts)
+					catalogVisibility.GET("/:id", catalogVisibilityHandler.GetRule)
+					catalogVisibility.PUT("/:id", catalogVisibilityHandler.UpdateRule)
+					catalogVisibility.DELETE("/:id", catalogVisibilityHandler.DeleteRule)
+				}
 			}
 
 			// Admin category management
@@ -670,6 +1092,14 @@ func SetupRoutes(
 				adminCategories.POST("/seo/bulk-generate", categoryHandler.BulkGenerateSEO)
 				adminCategories.POST("/seo/bulk-validate", categoryHandler.BulkValidateSEO)
 				adminCategories.GET("/seo/analytics", categoryHandler.GetSEOAnalytics)
+
+				// Bulk product assignment
+				if categoryAssignmentHandler != nil {
+					adminCategories.POST("/product-assignments/preview", categoryAssignmentHandler.PreviewAssignment)
+					adminCategories.POST("/product-assignments", categoryAssignmentHandler.CreateAssignment)
+					adminCategories.GET("/product-assignments", categoryAssignmentHandler.ListAssignments)
+					adminCategories.GET("/product-assignments/:id", categoryAssignmentHandler.GetAssignment)
+				}
 			}
 
 			// Admin brand management
@@ -708,6 +1138,17 @@ func SetupRoutes(
 				adminOrders.PUT("/:id/delivery", orderHandler.UpdateDeliveryStatus)
 				adminOrders.POST("/:id/notes", orderHandler.AddOrderNote)
 				adminOrders.GET("/:id/events", orderHandler.GetOrderEvents)
+				adminOrders.GET("/:id/packing-slip", orderHandler.DownloadPackingSlip)
+				adminOrders.GET("/:id/gift-receipt", orderHandler.DownloadGiftReceipt)
+				if adminNoteHandler != nil {
+					adminOrders.GET("/:id/internal-notes", adminNoteHandler.ListOrderNotes)
+					adminOrders.POST("/:id/internal-notes", adminNoteHandler.AddOrderNote)
+				}
+				if orderMessageHandler != nil {
+					adminOrders.GET("/:id/messages", orderMessageHandler.AdminGetMessages)
+					adminOrders.POST("/:id/messages", orderMessageHandler.AdminSendMessage)
+					adminOrders.POST("/:id/messages/read", orderMessageHandler.AdminMarkMessagesRead)
+				}
 				adminOrders.POST("/:id/refund", adminHandler.ProcessRefund)
 			}
 
@@ -754,6 +1195,54 @@ func SetupRoutes(
 				inventory.PUT("/alerts/:id/resolve", inventoryHandler.ResolveAlert)
 				inventory.GET("/low-stock", inventoryHandler.GetLowStockItems)
 				inventory.GET("/out-of-stock", inventoryHandler.GetOutOfStockItems)
+				inventory.POST("/snapshots", inventoryHandler.CaptureInventorySnapshot)
+				inventory.GET("/snapshots/stock", inventoryHandler.GetStockAsOf)
+				inventory.GET("/snapshots/deltas", inventoryHandler.GetStockDeltas)
+			}
+
+			// Supplier and purchase order routes
+			if purchasingHandler != nil {
+				suppliers := admin.Group("/suppliers")
+				{
+					suppliers.GET("", purchasingHandler.ListSuppliers)
+					suppliers.POST("", purchasingHandler.CreateSupplier)
+					suppliers.PUT("/:id", purchasingHandler.UpdateSupplier)
+				}
+
+				purchaseOrders := admin.Group("/purchase-orders")
+				{
+					purchaseOrders.GET("", purchasingHandler.ListPurchaseOrders)
+					purchaseOrders.POST("", purchasingHandler.CreatePurchaseOrder)
+					purchaseOrders.GET("/:id", purchasingHandler.GetPurchaseOrder)
+					purchaseOrders.PUT("/:id/expected-date", purchasingHandler.UpdateExpectedDate)
+					purchaseOrders.POST("/:id/receive", purchasingHandler.ReceivePurchaseOrder)
+					purchaseOrders.POST("/:id/cancel", purchasingHandler.CancelPurchaseOrder)
+				}
+			}
+
+			// Fulfillment SLA routes
+			if fulfillmentSLAHandler != nil {
+				fulfillmentSLA := admin.Group("/fulfillment-sla")
+				{
+					fulfillmentSLA.GET("", fulfillmentSLAHandler.GetSummary)
+					fulfillmentSLA.GET("/orders", fulfillmentSLAHandler.GetOrders)
+				}
+			}
+
+			// Partner management routes
+			if partnerFeedHandler != nil {
+				partners := admin.Group("/partners")
+				{
+					partners.GET("", partnerFeedHandler.ListPartners)
+					partners.POST("", partnerFeedHandler.CreatePartner)
+					partners.GET("/:id", partnerFeedHandler.GetPartner)
+					partners.PUT("/:id", partnerFeedHandler.UpdatePartner)
+					partners.DELETE("/:id", partnerFeedHandler.DeletePartner)
+					partners.POST("/:id/api-keys", partnerFeedHandler.CreatePartnerAPIKey)
+					partners.PUT("/:id/api-keys/:keyId", partnerFeedHandler.UpdatePartnerAPIKey)
+					partners.DELETE("/:id/api-keys/:keyId", partnerFeedHandler.RevokePartnerAPIKey)
+					partners.GET("/:id/usage", partnerFeedHandler.GetPartnerAPIUsage)
+				}
 			}
 
 			// Abandoned cart management routes
@@ -785,6 +1274,7 @@ func SetupRoutes(
 				analytics.POST("/events", analyticsHandler.TrackEvent)
 				analytics.GET("/top-products", analyticsHandler.GetTopProducts)
 				analytics.GET("/top-categories", analyticsHandler.GetTopCategories)
+				analytics.GET("/funnel", analyticsHandler.GetVisitorFunnel)
 
 				// Filter analytics
 				if productFilterHandler != nil {
@@ -794,11 +1284,150 @@ func SetupRoutes(
 			}
 
 			// Reports routes
-			reports := admin.Group("/reports")
-			{
-				reports.POST("/generate", adminHandler.GenerateReport)
-				reports.GET("", adminHandler.GetReports)
-				reports.GET("/:id/download", adminHandler.DownloadReport)
+			if reportHandler != nil {
+				reports := admin.Group("/reports")
+				{
+					reports.POST("/generate", reportHandler.GenerateReport)
+					reports.GET("", reportHandler.GetReports)
+					reports.GET("/export-profiles", reportHandler.GetExportProfiles)
+					reports.PUT("/export-profiles/:type", reportHandler.SaveExportProfile)
+					reports.DELETE("/export-profiles/:type", reportHandler.DeleteExportProfile)
+					reports.GET("/:id", reportHandler.GetReport)
+					reports.DELETE("/:id", reportHandler.DeleteReport)
+					reports.GET("/:id/download", reportHandler.DownloadReport)
+				}
+			}
+
+			// Payment link routes
+			if paymentLinkHandler != nil {
+				paymentLinks := admin.Group("/payment-links")
+				{
+					paymentLinks.POST("", paymentLinkHandler.CreatePaymentLink)
+					paymentLinks.GET("", paymentLinkHandler.GetPaymentLinks)
+					paymentLinks.GET("/:id", paymentLinkHandler.GetPaymentLink)
+					paymentLinks.POST("/:id/send", paymentLinkHandler.SendPaymentLink)
+					paymentLinks.POST("/:id/cancel", paymentLinkHandler.CancelPaymentLink)
+				}
+			}
+
+			// Dispute routes
+			if disputeHandler != nil {
+				disputes := admin.Group("/disputes")
+				{
+					disputes.GET("", disputeHandler.GetDisputes)
+					disputes.GET("/metrics", disputeHandler.GetDisputeMetrics)
+					disputes.GET("/:id", disputeHandler.GetDispute)
+					disputes.POST("/:id/evidence", disputeHandler.RecordDisputeEvidence)
+				}
+			}
+
+			// Settlement routes
+			if settlementHandler != nil {
+				settlements := admin.Group("/settlements")
+				{
+					settlements.GET("", settlementHandler.GetSettlements)
+					settlements.POST("/reconcile", settlementHandler.ReconcileSettlement)
+					settlements.GET("/:id", settlementHandler.GetSettlement)
+					settlements.GET("/:id/items", settlementHandler.GetSettlementItems)
+				}
+			}
+
+			// Admin inbox routes
+			if adminTaskHandler != nil {
+				inbox := admin.Group("/inbox")
+				{
+					inbox.GET("", adminTaskHandler.GetInbox)
+					inbox.GET("/tasks/:id", adminTaskHandler.GetTask)
+					inbox.POST("/tasks/:id/assign", adminTaskHandler.AssignTask)
+					inbox.PUT("/tasks/:id/due-date", adminTaskHandler.SetTaskDueDate)
+					inbox.POST("/tasks/:id/complete", adminTaskHandler.CompleteTask)
+				}
+			}
+
+			// Marketplace routes
+			if vendorHandler != nil {
+				vendors := admin.Group("/vendors")
+				{
+					vendors.GET("", vendorHandler.GetVendors)
+					vendors.POST("", vendorHandler.CreateVendor)
+					vendors.GET("/:id", vendorHandler.GetVendor)
+					vendors.PUT("/:id", vendorHandler.UpdateVendor)
+					vendors.POST("/:id/products", vendorHandler.AssignVendorProducts)
+					vendors.GET("/:id/commissions", vendorHandler.GetVendorCommissions)
+					vendors.PUT("/:id/commissions", vendorHandler.SetVendorCommission)
+					vendors.GET("/:id/commissions/resolve", vendorHandler.ResolveVendorCommission)
+					vendors.DELETE("/:id/commissions/:commission_id", vendorHandler.DeleteVendorCommission)
+					vendors.GET("/:id/statement", vendorHandler.GetVendorStatement)
+				}
+
+				marketplace := admin.Group("/marketplace")
+				{
+					marketplace.GET("/category-commissions", vendorHandler.GetCategoryCommissions)
+					marketplace.PUT("/category-commissions/:category_id", vendorHandler.SetCategoryCommission)
+					marketplace.DELETE("/category-commissions/:category_id", vendorHandler.DeleteCategoryCommission)
+					marketplace.GET("/orders/:order_id/vendor-orders", vendorHandler.GetOrderVendorOrders)
+					marketplace.POST("/orders/:order_id/vendor-orders", vendorHandler.SplitOrder)
+				}
+			}
+
+			// Vendor application review routes
+			if vendorApplicationHandler != nil {
+				applications := admin.Group("/vendor-applications")
+				{
+					applications.GET("", vendorApplicationHandler.GetApplications)
+					applications.GET("/:id", vendorApplicationHandler.GetApplication)
+					applications.POST("/:id/approve", vendorApplicationHandler.ApproveApplication)
+					applications.POST("/:id/reject", vendorApplicationHandler.RejectApplication)
+					applications.POST("/:id/request-changes", vendorApplicationHandler.RequestApplicationChanges)
+				}
+			}
+
+			// Tax exemption certificate review routes
+			if taxExemptionHandler != nil {
+				taxExemptions := admin.Group("/tax-exemptions")
+				{
+					taxExemptions.GET("", taxExemptionHandler.GetCertificates)
+					taxExemptions.GET("/:id", taxExemptionHandler.GetCertificate)
+					taxExemptions.POST("/:id/verify", taxExemptionHandler.VerifyCertificate)
+					taxExemptions.POST("/:id/reject", taxExemptionHandler.RejectCertificate)
+				}
+			}
+
+			// Product waitlist and launch routes
+			if waitlistHandler != nil {
+				waitlists := admin.Group("/waitlists")
+				{
+					waitlists.GET("", waitlistHandler.GetWaitlists)
+					waitlists.POST("", waitlistHandler.CreateWaitlist)
+					waitlists.GET("/:id", waitlistHandler.GetWaitlist)
+					waitlists.PUT("/:id", waitlistHandler.UpdateWaitlist)
+					waitlists.POST("/:id/launch", waitlistHandler.LaunchWaitlist)
+					waitlists.POST("/:id/cancel", waitlistHandler.CancelWaitlist)
+				}
+			}
+
+			// JWT signing key routes
+			if jwtKeyHandler != nil {
+				jwtKeys := admin.Group("/jwt-keys")
+				{
+					jwtKeys.GET("", jwtKeyHandler.ListKeys)
+					jwtKeys.POST("/rotate", jwtKeyHandler.RotateKeys)
+				}
+			}
+
+			// Data residency routes
+			if dataResidencyHandler != nil {
+				admin.GET("/data-regions", dataResidencyHandler.GetRegions)
+				admin.PUT("/users/:id/data-region", dataResidencyHandler.SetUserRegion)
+				admin.PUT("/users/:id/data-region-access", dataResidencyHandler.SetExportAccess)
+			}
+
+			// Message preview routes
+			if messageTemplateHandler != nil {
+				messages := admin.Group("/messages")
+				{
+					messages.POST("/preview", messageTemplateHandler.PreviewMessage)
+				}
 			}
 
 			// System management routes
@@ -809,6 +1438,8 @@ func SetupRoutes(
 				system.POST("/backup", adminHandler.BackupDatabase)
 				system.GET("/cleanup/stats", adminHandler.GetCleanupStats)
 				system.POST("/cleanup/trigger", adminHandler.TriggerCleanup)
+				system.GET("/circuit-breakers", metricsHandler.GetCircuitBreakers)
+				system.GET("/status", systemStatusHandler.GetSystemStatus)
 			}
 
 			// Security management routes
@@ -829,7 +1460,7 @@ func SetupRoutes(
 
 		// Moderator routes (moderator/admin authentication required)
 		moderator := v1.Group("/moderator")
-		moderator.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
+		moderator.Use(middleware.AuthMiddleware(jwtKeys))
 		moderator.Use(middleware.ModeratorMiddleware())
 		{
 			// Moderator product management
@@ -848,5 +1479,29 @@ func SetupRoutes(
 				modUpload.POST("/document", fileHandler.UploadDocument)
 			}
 		}
+
+		// Sandbox routes (only registered in sandbox mode, never in production). They expose captured
+		// emails, the clock and background jobs, so they are limited to admins like the admin routes.
+		if sandboxHandler != nil {
+			sandbox := v1.Group("/sandbox")
+			sandbox.Use(middleware.AuthMiddleware(jwtKeys))
+			sandbox.Use(middleware.AdminMiddleware())
+			{
+				sandbox.GET("", sandboxHandler.GetInfo)
+				sandbox.POST("/reset", sandboxHandler.Reset)
+				sandbox.GET("/mailbox", sandboxHandler.ListEmails)
+				sandbox.DELETE("/mailbox", sandboxHandler.ClearMailbox)
+				sandbox.GET("/mailbox/:id", sandboxHandler.GetEmail)
+				sandbox.POST("/webhooks/:provider", sandboxHandler.TriggerWebhook)
+				sandbox.GET("/clock", sandboxHandler.GetClock)
+				sandbox.POST("/clock/advance", sandboxHandler.AdvanceClock)
+				sandbox.POST("/clock/reset", sandboxHandler.ResetClock)
+				sandbox.POST("/jobs/:name/run", sandboxHandler.RunJob)
+			}
+		}
+	}
+
+	if err := spec.Build(router.Routes(), openAPIOperations); err != nil {
+		log.Printf("⚠️ Failed to generate OpenAPI document: %v", err)
 	}
 }
@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Actor is who a request acts for and where it came from. The HTTP layer puts it in the request
+// context, so use cases can attribute audit and event records without every request type
+// carrying the caller.
+type Actor struct {
+	UserID    *uuid.UUID // Nil for guests and background jobs
+	Email     string
+	Role      UserRole
+	IPAddress string
+	UserAgent string
+	RequestID string
+	SessionID string
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the actor
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or an empty actor for contexts that didn't
+// come from a request, such as scheduled jobs
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(Actor)
+	return actor
+}
+
+// IsAuthenticated checks if the actor is a signed-in user
+func (a Actor) IsAuthenticated() bool {
+	return a.UserID != nil
+}
+
+// IsStaff checks if the actor is an admin or moderator
+func (a Actor) IsStaff() bool {
+	return a.Role == UserRoleAdmin || a.Role == UserRoleModerator
+}
+
+// ID returns the actor's user ID, or uuid.Nil when no user is acting, e.g. in scheduled jobs
+func (a Actor) ID() uuid.UUID {
+	if a.UserID == nil {
+		return uuid.Nil
+	}
+	return *a.UserID
+}
+
+// optional returns a pointer to s, or nil when it is empty
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// Attribute fills in the request details of an audit log that its writer left empty
+func (a Actor) Attribute(log *AuditLog) {
+	if log.UserID == nil {
+		log.UserID = a.UserID
+	}
+	if log.IPAddress == "" {
+		log.IPAddress = a.IPAddress
+	}
+	if log.UserAgent == "" {
+		log.UserAgent = a.UserAgent
+	}
+	if log.RequestID == nil {
+		log.RequestID = optional(a.RequestID)
+	}
+	if log.SessionID == nil {
+		log.SessionID = optional(a.SessionID)
+	}
+}
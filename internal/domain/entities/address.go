@@ -17,26 +17,48 @@ const (
 	AddressTypeBoth     AddressType = "both"
 )
 
+// AddressLabel represents a user-facing label for an address
+type AddressLabel string
+
+const (
+	AddressLabelHome  AddressLabel = "home"
+	AddressLabelWork  AddressLabel = "work"
+	AddressLabelOther AddressLabel = "other"
+)
+
+// MaxDeliveryInstructionsLength is the maximum length of delivery instructions passed to carriers
+const MaxDeliveryInstructionsLength = 500
+
 // Address represents a user address
 type Address struct {
-	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	UserID      uuid.UUID   `json:"user_id" gorm:"type:uuid;not null;index"`
-	User        User        `json:"user,omitempty" gorm:"foreignKey:UserID"`
-	Type        AddressType `json:"type" gorm:"not null;default:'shipping'"`
-	FirstName   string      `json:"first_name" gorm:"not null" validate:"required"`
-	LastName    string      `json:"last_name" gorm:"not null" validate:"required"`
-	Company     string      `json:"company"`
-	Address1    string      `json:"address1" gorm:"not null" validate:"required"`
-	Address2    string      `json:"address2"`
-	City        string      `json:"city" gorm:"not null" validate:"required"`
-	State       string      `json:"state" gorm:"not null" validate:"required"`
-	ZipCode     string      `json:"zip_code" gorm:"not null" validate:"required"`
-	Country     string      `json:"country" gorm:"not null;default:'USA'" validate:"required"`
-	Phone       string      `json:"phone"`
-	IsDefault   bool        `json:"is_default" gorm:"default:false"`
-	IsActive    bool        `json:"is_active" gorm:"default:true"`
-	CreatedAt   time.Time   `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt   time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
+	ID        uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    uuid.UUID    `json:"user_id" gorm:"type:uuid;not null;index"`
+	User      User         `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	CompanyID *uuid.UUID   `json:"company_id,omitempty" gorm:"type:uuid;index"` // Set for addresses shared across a company
+	Type      AddressType  `json:"type" gorm:"not null;default:'shipping'"`
+	FirstName string       `json:"first_name" gorm:"not null" validate:"required"`
+	LastName  string       `json:"last_name" gorm:"not null" validate:"required"`
+	Company   string       `json:"company"`
+	Address1  string       `json:"address1" gorm:"not null" validate:"required"`
+	Address2  string       `json:"address2"`
+	City      string       `json:"city" gorm:"not null" validate:"required"`
+	State     string       `json:"state" gorm:"not null" validate:"required"`
+	ZipCode   string       `json:"zip_code" gorm:"not null" validate:"required"`
+	Country   string       `json:"country" gorm:"not null;default:'USA'" validate:"required"`
+	Phone     string       `json:"phone"`
+	Label     AddressLabel `json:"label" gorm:"default:'home'"`
+
+	// Delivery instructions surfaced to carriers (gate code, drop-off spot, etc.)
+	DeliveryInstructions string `json:"delivery_instructions" gorm:"type:text"`
+
+	// IsDefault is kept for backward compatibility and is true when the address
+	// is the default for either shipping or billing
+	IsDefault         bool      `json:"is_default" gorm:"default:false"`
+	IsDefaultShipping bool      `json:"is_default_shipping" gorm:"default:false"`
+	IsDefaultBilling  bool      `json:"is_default_billing" gorm:"default:false"`
+	IsActive          bool      `json:"is_active" gorm:"default:true"`
+	CreatedAt         time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 // TableName returns the table name for Address entity
@@ -44,6 +66,11 @@ func (Address) TableName() string {
 	return "addresses"
 }
 
+// IsCompanyAddress checks if the address belongs to a company's shared address book
+func (a *Address) IsCompanyAddress() bool {
+	return a.CompanyID != nil
+}
+
 // GetFullName returns the full name for the address
 func (a *Address) GetFullName() string {
 	return a.FirstName + " " + a.LastName
@@ -133,9 +160,68 @@ func (a *Address) Validate() error {
 		}
 	}
 
+	// Validate type and label
+	if a.Type != "" && !IsValidAddressType(a.Type) {
+		return fmt.Errorf("invalid address type: %s", a.Type)
+	}
+	if a.Label != "" && !IsValidAddressLabel(a.Label) {
+		return fmt.Errorf("invalid address label: %s", a.Label)
+	}
+
+	if len(a.DeliveryInstructions) > MaxDeliveryInstructionsLength {
+		return fmt.Errorf("delivery instructions must be %d characters or less", MaxDeliveryInstructionsLength)
+	}
+
+	// Default flags must match what the address can be used for
+	if a.IsDefaultShipping && !a.IsShippingAddress() {
+		return fmt.Errorf("only shipping addresses can be the default shipping address")
+	}
+	if a.IsDefaultBilling && !a.IsBillingAddress() {
+		return fmt.Errorf("only billing addresses can be the default billing address")
+	}
+
 	return nil
 }
 
+// SyncDefaultFlag keeps the legacy IsDefault flag in sync with the per-type flags
+func (a *Address) SyncDefaultFlag() {
+	a.IsDefault = a.IsDefaultShipping || a.IsDefaultBilling
+}
+
+// IsValidAddressType checks if the address type is supported
+func IsValidAddressType(t AddressType) bool {
+	switch t {
+	case AddressTypeShipping, AddressTypeBilling, AddressTypeBoth:
+		return true
+	}
+	return false
+}
+
+// IsValidAddressLabel checks if the address label is supported
+func IsValidAddressLabel(l AddressLabel) bool {
+	switch l {
+	case AddressLabelHome, AddressLabelWork, AddressLabelOther:
+		return true
+	}
+	return false
+}
+
+// ToOrderAddress converts a saved address into an order address snapshot
+func (a *Address) ToOrderAddress() *OrderAddress {
+	return &OrderAddress{
+		FirstName: a.FirstName,
+		LastName:  a.LastName,
+		Company:   a.Company,
+		Address1:  a.Address1,
+		Address2:  a.Address2,
+		City:      a.City,
+		State:     a.State,
+		ZipCode:   a.ZipCode,
+		Country:   a.Country,
+		Phone:     a.Phone,
+	}
+}
+
 // IsInternational checks if the address is international (non-domestic)
 // This method considers both US and Vietnam as domestic countries
 // since the system supports both US and Vietnamese carriers
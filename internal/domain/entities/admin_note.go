@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminNoteSubject represents what an internal note is attached to
+type AdminNoteSubject string
+
+const (
+	AdminNoteSubjectOrder    AdminNoteSubject = "order"
+	AdminNoteSubjectCustomer AdminNoteSubject = "customer"
+)
+
+// MaxAdminNoteLength caps the length of a note body
+const MaxAdminNoteLength = 5000
+
+// AdminNote is an internal note left by an admin on an order or a customer. Notes are never
+// shown to customers. Replies point at the note that started their thread.
+type AdminNote struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SubjectType AdminNoteSubject `json:"subject_type" gorm:"not null;index:idx_admin_notes_subject,priority:1"`
+	SubjectID   uuid.UUID        `json:"subject_id" gorm:"type:uuid;not null;index:idx_admin_notes_subject,priority:2"`
+	ParentID    *uuid.UUID       `json:"parent_id" gorm:"type:uuid;index"`
+	AuthorID    uuid.UUID        `json:"author_id" gorm:"type:uuid;not null;index"`
+	Author      *User            `json:"author,omitempty" gorm:"foreignKey:AuthorID"`
+	Body        string           `json:"body" gorm:"type:text;not null"`
+
+	// MentionedUserIDs are the admins mentioned with @email or @username in the body
+	MentionedUserIDs []uuid.UUID `json:"mentioned_user_ids" gorm:"serializer:json"`
+
+	IsPinned  bool       `json:"is_pinned" gorm:"default:false"`
+	PinnedBy  *uuid.UUID `json:"pinned_by" gorm:"type:uuid"`
+	PinnedAt  *time.Time `json:"pinned_at"`
+	EditedAt  *time.Time `json:"edited_at"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for AdminNote entity
+func (AdminNote) TableName() string {
+	return "admin_notes"
+}
+
+// Validate validates the note
+func (n *AdminNote) Validate() error {
+	switch n.SubjectType {
+	case AdminNoteSubjectOrder, AdminNoteSubjectCustomer:
+	default:
+		return fmt.Errorf("invalid note subject: %s", n.SubjectType)
+	}
+	if n.SubjectID == uuid.Nil {
+		return fmt.Errorf("note subject is required")
+	}
+	if strings.TrimSpace(n.Body) == "" {
+		return fmt.Errorf("note body is required")
+	}
+	if len(n.Body) > MaxAdminNoteLength {
+		return fmt.Errorf("note body must be at most %d characters", MaxAdminNoteLength)
+	}
+	return nil
+}
+
+// IsReply checks if the note is a reply in another note's thread
+func (n *AdminNote) IsReply() bool {
+	return n.ParentID != nil
+}
+
+// IsMentioned checks if the user is mentioned in the note
+func (n *AdminNote) IsMentioned(userID uuid.UUID) bool {
+	for _, id := range n.MentionedUserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Pin pins the note to the top of its subject's notes
+func (n *AdminNote) Pin(by uuid.UUID, at time.Time) {
+	n.IsPinned = true
+	n.PinnedBy = &by
+	n.PinnedAt = &at
+}
+
+// Unpin removes the note's pin
+func (n *AdminNote) Unpin() {
+	n.IsPinned = false
+	n.PinnedBy = nil
+	n.PinnedAt = nil
+}
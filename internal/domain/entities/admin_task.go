@@ -0,0 +1,133 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminTaskType is the kind of work an admin task asks for
+type AdminTaskType string
+
+const (
+	AdminTaskTypeReviewModeration  AdminTaskType = "review_moderation"  // A review is waiting for moderation
+	AdminTaskTypeOrderVerification AdminTaskType = "order_verification" // A COD or bank transfer order needs its payment confirmed
+	AdminTaskTypeStockReorder      AdminTaskType = "stock_reorder"      // Stock fell to its reorder level and needs a purchase order
+	AdminTaskTypeDisputeEvidence   AdminTaskType = "dispute_evidence"   // A dispute needs evidence before its deadline
+)
+
+// AdminTaskTypes lists the task types in the order the inbox summarizes them
+var AdminTaskTypes = []AdminTaskType{
+	AdminTaskTypeDisputeEvidence,
+	AdminTaskTypeOrderVerification,
+	AdminTaskTypeStockReorder,
+	AdminTaskTypeReviewModeration,
+}
+
+// AdminTaskStatus represents the status of an admin task
+type AdminTaskStatus string
+
+const (
+	AdminTaskStatusOpen      AdminTaskStatus = "open"
+	AdminTaskStatusCompleted AdminTaskStatus = "completed"
+)
+
+// AdminTaskPriority orders tasks in the inbox, most urgent first
+type AdminTaskPriority int
+
+const (
+	AdminTaskPriorityLow    AdminTaskPriority = 1
+	AdminTaskPriorityNormal AdminTaskPriority = 2
+	AdminTaskPriorityHigh   AdminTaskPriority = 3
+	AdminTaskPriorityUrgent AdminTaskPriority = 4
+)
+
+// AdminTask is an item in the admin inbox. Tasks are raised from what happens in the store,
+// such as a review waiting for moderation, and are completed by an admin or, once their source
+// no longer needs attention, automatically. A source has at most one open task of each type.
+type AdminTask struct {
+	ID          uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Type        AdminTaskType     `json:"type" gorm:"not null;index;uniqueIndex:idx_admin_tasks_open_source,where:status = 'open'"`
+	SourceID    uuid.UUID         `json:"source_id" gorm:"type:uuid;not null;uniqueIndex:idx_admin_tasks_open_source,where:status = 'open'"`
+	Status      AdminTaskStatus   `json:"status" gorm:"not null;default:'open';index"`
+	Priority    AdminTaskPriority `json:"priority" gorm:"not null;default:2"`
+	Title       string            `json:"title" gorm:"not null"`
+	Description string            `json:"description" gorm:"type:text"`
+	DueAt       *time.Time        `json:"due_at" gorm:"index"`
+
+	// Assignment
+	AssigneeID *uuid.UUID `json:"assignee_id" gorm:"type:uuid;index"`
+	Assignee   *User      `json:"assignee,omitempty" gorm:"foreignKey:AssigneeID"`
+	AssignedAt *time.Time `json:"assigned_at"`
+	AssignedBy *uuid.UUID `json:"assigned_by" gorm:"type:uuid"`
+
+	// Completion
+	CompletedAt    *time.Time `json:"completed_at"`
+	CompletedBy    *uuid.UUID `json:"completed_by" gorm:"type:uuid"` // Nil when resolved automatically
+	CompletionNote string     `json:"completion_note" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for AdminTask entity
+func (AdminTask) TableName() string {
+	return "admin_tasks"
+}
+
+// SourceType returns the kind of entity the task is about
+func (t AdminTaskType) SourceType() string {
+	switch t {
+	case AdminTaskTypeReviewModeration:
+		return "review"
+	case AdminTaskTypeOrderVerification:
+		return "order"
+	case AdminTaskTypeStockReorder:
+		return "inventory"
+	case AdminTaskTypeDisputeEvidence:
+		return "dispute"
+	default:
+		return ""
+	}
+}
+
+// IsOpen checks if the task still needs doing
+func (t *AdminTask) IsOpen() bool {
+	return t.Status == AdminTaskStatusOpen
+}
+
+// IsOverdue checks if an open task is past its due date
+func (t *AdminTask) IsOverdue(now time.Time) bool {
+	return t.IsOpen() && t.DueAt != nil && t.DueAt.Before(now)
+}
+
+// Complete marks the task done. completedBy is nil when the task resolved on its own.
+func (t *AdminTask) Complete(completedBy *uuid.UUID, note string) {
+	now := time.Now()
+	t.Status = AdminTaskStatusCompleted
+	t.CompletedAt = &now
+	t.CompletedBy = completedBy
+	t.CompletionNote = note
+}
+
+// AdminTaskSummary counts the open tasks of one type
+type AdminTaskSummary struct {
+	Type         AdminTaskType `json:"type"`
+	Open         int64         `json:"open"`
+	Overdue      int64         `json:"overdue"`
+	Unassigned   int64         `json:"unassigned"`
+	AssignedToMe int64         `json:"assigned_to_me"`
+}
+
+// AdminTaskSource is something in the store that needs an admin's attention, read from the
+// table behind a task type, e.g. a pending review
+type AdminTaskSource struct {
+	ID         uuid.UUID  `json:"id"`
+	Reference  string     `json:"reference"` // Order number, product SKU or provider dispute ID
+	Name       string     `json:"name"`      // Product name or customer email
+	Quantity   int        `json:"quantity"`  // Available stock, or the review rating
+	Amount     float64    `json:"amount"`
+	Currency   string     `json:"currency"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	DueAt      *time.Time `json:"due_at"` // Set when the source has its own deadline
+}
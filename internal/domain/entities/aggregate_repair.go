@@ -0,0 +1,204 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// AggregateKind is a group of denormalized fields that are kept in step with their source tables
+type AggregateKind string
+
+const (
+	// AggregateCustomerMetrics is users.total_orders and total_spent, counted from paid orders
+	// that were not cancelled, refunded or returned
+	AggregateCustomerMetrics AggregateKind = "customer_metrics"
+	// AggregateProductRatings is the product_ratings summary of a product's approved reviews
+	AggregateProductRatings AggregateKind = "product_ratings"
+	// AggregateReviewVotes is reviews.helpful_count and not_helpful_count, counted from review_votes
+	AggregateReviewVotes AggregateKind = "review_votes"
+)
+
+// AggregateKinds lists every repairable aggregate in the order they are checked
+var AggregateKinds = []AggregateKind{
+	AggregateCustomerMetrics,
+	AggregateProductRatings,
+	AggregateReviewVotes,
+}
+
+// aggregateFields are the stored fields of each aggregate
+var aggregateFields = map[AggregateKind][]string{
+	AggregateCustomerMetrics: {"total_orders", "total_spent"},
+	AggregateProductRatings: {"total_reviews", "average_rating",
+		"rating_1_count", "rating_2_count", "rating_3_count", "rating_4_count", "rating_5_count"},
+	AggregateReviewVotes: {"helpful_count", "not_helpful_count"},
+}
+
+// CustomerMetricsExcludedOrderStatuses are the statuses of paid orders that no longer count
+// towards a customer's order count and spend
+var CustomerMetricsExcludedOrderStatuses = []OrderStatus{
+	OrderStatusCancelled,
+	OrderStatusRefunded,
+	OrderStatusReturned,
+}
+
+// aggregateTolerance is how far apart two values can be and still match, so averages and
+// money only differ when they differ in the second decimal
+const aggregateTolerance = 0.005
+
+// IsValid checks if the aggregate kind is known
+func (k AggregateKind) IsValid() bool {
+	_, ok := aggregateFields[k]
+	return ok
+}
+
+// Fields returns the stored fields of the aggregate
+func (k AggregateKind) Fields() []string {
+	return aggregateFields[k]
+}
+
+// AggregateDiscrepancy is a denormalized field whose stored value differs from its source
+type AggregateDiscrepancy struct {
+	Kind     AggregateKind `json:"kind"`
+	RecordID uuid.UUID     `json:"record_id"`
+	Field    string        `json:"field"`
+	Stored   float64       `json:"stored"`
+	Actual   float64       `json:"actual"`
+}
+
+// AggregateComparison holds the stored and recomputed values of one record's aggregate fields
+type AggregateComparison struct {
+	RecordID uuid.UUID
+	Stored   map[string]float64
+	Actual   map[string]float64
+}
+
+// Discrepancies returns the fields of the record whose stored value is off
+func (c *AggregateComparison) Discrepancies(kind AggregateKind) []AggregateDiscrepancy {
+	var discrepancies []AggregateDiscrepancy
+	for _, field := range kind.Fields() {
+		stored, actual := c.Stored[field], c.Actual[field]
+		if math.Abs(stored-actual) >= aggregateTolerance {
+			discrepancies = append(discrepancies, AggregateDiscrepancy{
+				Kind:     kind,
+				RecordID: c.RecordID,
+				Field:    field,
+				Stored:   stored,
+				Actual:   actual,
+			})
+		}
+	}
+	return discrepancies
+}
+
+// AggregateRepairTrigger is what started a repair run
+type AggregateRepairTrigger string
+
+const (
+	AggregateRepairTriggerScheduled AggregateRepairTrigger = "scheduled"
+	AggregateRepairTriggerManual    AggregateRepairTrigger = "manual"
+	AggregateRepairTriggerCLI       AggregateRepairTrigger = "cli"
+)
+
+// AggregateRepairStatus represents the state of a repair run
+type AggregateRepairStatus string
+
+const (
+	AggregateRepairStatusRunning   AggregateRepairStatus = "running"
+	AggregateRepairStatusCompleted AggregateRepairStatus = "completed"
+	AggregateRepairStatusFailed    AggregateRepairStatus = "failed"
+)
+
+// MaxReportedDiscrepancies caps the discrepancies kept with a run; the counts include all of them
+const MaxReportedDiscrepancies = 1000
+
+// AggregateRepairSummary counts what a run found for one aggregate
+type AggregateRepairSummary struct {
+	Kind            AggregateKind `json:"kind"`
+	RecordsChecked  int           `json:"records_checked"`
+	RecordsOff      int           `json:"records_off"` // Records with at least one discrepancy
+	Discrepancies   int           `json:"discrepancies"`
+	RecordsRepaired int           `json:"records_repaired"`
+}
+
+// AggregateRepairRun records a check of denormalized aggregates against their source tables
+type AggregateRepairRun struct {
+	ID            uuid.UUID                `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Trigger       AggregateRepairTrigger   `json:"trigger" gorm:"not null"`
+	DryRun        bool                     `json:"dry_run" gorm:"default:false"` // Discrepancies were only reported
+	Status        AggregateRepairStatus    `json:"status" gorm:"not null;index"`
+	Summaries     []AggregateRepairSummary `json:"summaries" gorm:"type:jsonb;serializer:json"`
+	Discrepancies []AggregateDiscrepancy   `json:"discrepancies" gorm:"type:jsonb;serializer:json"` // The first MaxReportedDiscrepancies found
+	Error         string                   `json:"error,omitempty"`
+	StartedAt     time.Time                `json:"started_at" gorm:"not null;index"`
+	FinishedAt    *time.Time               `json:"finished_at,omitempty"`
+	CreatedAt     time.Time                `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time                `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for AggregateRepairRun entity
+func (AggregateRepairRun) TableName() string {
+	return "aggregate_repair_runs"
+}
+
+// NewAggregateRepairRun starts a run over the given aggregates
+func NewAggregateRepairRun(trigger AggregateRepairTrigger, kinds []AggregateKind, dryRun bool) *AggregateRepairRun {
+	summaries := make([]AggregateRepairSummary, len(kinds))
+	for i, kind := range kinds {
+		summaries[i] = AggregateRepairSummary{Kind: kind}
+	}
+	return &AggregateRepairRun{
+		ID:        ids.New(),
+		Trigger:   trigger,
+		DryRun:    dryRun,
+		Status:    AggregateRepairStatusRunning,
+		Summaries: summaries,
+		StartedAt: time.Now(),
+	}
+}
+
+// Summary returns the summary of an aggregate checked by the run
+func (r *AggregateRepairRun) Summary(kind AggregateKind) *AggregateRepairSummary {
+	for i := range r.Summaries {
+		if r.Summaries[i].Kind == kind {
+			return &r.Summaries[i]
+		}
+	}
+	return nil
+}
+
+// RecordDiscrepancies keeps discrepancies until the report is full
+func (r *AggregateRepairRun) RecordDiscrepancies(discrepancies []AggregateDiscrepancy) {
+	room := MaxReportedDiscrepancies - len(r.Discrepancies)
+	if room <= 0 {
+		return
+	}
+	if len(discrepancies) > room {
+		discrepancies = discrepancies[:room]
+	}
+	r.Discrepancies = append(r.Discrepancies, discrepancies...)
+}
+
+// TotalDiscrepancies returns the number of discrepancies found across all aggregates
+func (r *AggregateRepairRun) TotalDiscrepancies() int {
+	total := 0
+	for _, summary := range r.Summaries {
+		total += summary.Discrepancies
+	}
+	return total
+}
+
+// Finish marks the run completed, or failed with err
+func (r *AggregateRepairRun) Finish(err error) {
+	now := time.Now()
+	r.FinishedAt = &now
+	if err != nil {
+		r.Status = AggregateRepairStatusFailed
+		r.Error = err.Error()
+		return
+	}
+	r.Status = AggregateRepairStatusCompleted
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// AnonymizationRule is how a column with personal data is anonymized in analytics exports
+type AnonymizationRule string
+
+const (
+	// AnonymizePseudonymize replaces an identifier with a stable hash, so rows of the same
+	// customer can still be grouped and joined
+	AnonymizePseudonymize AnonymizationRule = "pseudonymize"
+	// AnonymizeStrip leaves the column out: names, contact details, free text that may hold
+	// them, and address details below region level
+	AnonymizeStrip AnonymizationRule = "strip"
+)
+
+// pseudonymPrefix marks values that were replaced with a pseudonym
+const pseudonymPrefix = "anon_"
+
+// Pseudonymizer replaces identifiers with keyed hashes. The same identifier always gets the same
+// pseudonym under the same secret, across exports, and without the secret pseudonyms can't be
+// traced back by hashing known identifiers.
+type Pseudonymizer struct {
+	secret []byte
+}
+
+// NewPseudonymizer creates a pseudonymizer; changing the secret changes every pseudonym
+func NewPseudonymizer(secret string) *Pseudonymizer {
+	return &Pseudonymizer{secret: []byte(secret)}
+}
+
+// Pseudonym returns the pseudonym of an identifier, or an empty string for an empty one.
+// Identifiers are compared ignoring case and surrounding space.
+func (p *Pseudonymizer) Pseudonym(identifier string) string {
+	identifier = strings.ToLower(strings.TrimSpace(identifier))
+	if identifier == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(identifier))
+	return pseudonymPrefix + hex.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyScope is what an API key may be used for
+type APIKeyScope string
+
+const (
+	// APIKeyScopePartnerFeed lets a partner read the price and availability feed
+	APIKeyScopePartnerFeed APIKeyScope = "partner_feed"
+)
+
+// APIKeyPlan is the rate plan of an API key, which sets how much it may be used
+type APIKeyPlan string
+
+const (
+	APIKeyPlanFree     APIKeyPlan = "free"
+	APIKeyPlanPartner  APIKeyPlan = "partner"
+	APIKeyPlanInternal APIKeyPlan = "internal"
+)
+
+// IsValidAPIKeyPlan checks if a rate plan is known
+func IsValidAPIKeyPlan(plan APIKeyPlan) bool {
+	switch plan {
+	case APIKeyPlanFree, APIKeyPlanPartner, APIKeyPlanInternal:
+		return true
+	}
+	return false
+}
+
+// APIRatePlan is the limits of an API key plan
+type APIRatePlan struct {
+	Plan              APIKeyPlan `json:"plan"`
+	RequestsPerMinute int        `json:"requests_per_minute"` // Burst limit over any minute
+	DailyQuota        int64      `json:"daily_quota"`         // Requests per UTC day; 0 is unlimited
+}
+
+// APIKeyQuota is an API key's limits and what is left of its quota for the UTC day
+type APIKeyQuota struct {
+	APIRatePlan
+	Used      int64     `json:"used"`      // Requests served today
+	Remaining *int64    `json:"remaining"` // Requests left today; null when the quota is unlimited
+	ResetAt   time.Time `json:"reset_at"`  // When the quota starts over, at the next UTC midnight
+	Allowed   bool      `json:"-"`         // Whether the request just counted was served
+}
+
+// NewAPIKeyQuota returns the quota of a key on plan limits that served used requests on day
+func NewAPIKeyQuota(limits APIRatePlan, day time.Time, used int64) *APIKeyQuota {
+	quota := &APIKeyQuota{
+		APIRatePlan: limits,
+		Used:        used,
+		ResetAt:     day.AddDate(0, 0, 1),
+		Allowed:     true,
+	}
+	if limits.DailyQuota > 0 {
+		remaining := max(limits.DailyQuota-used, 0)
+		quota.Remaining = &remaining
+	}
+	return quota
+}
+
+// apiKeyPrefix starts every key so leaked keys are easy to recognize in logs and scanners
+const apiKeyPrefix = "ek_"
+
+// APIKey is a key programs send in the X-API-Key header instead of signing in. Only a hash of
+// the key is stored; the key itself is shown once, when it is created.
+type APIKey struct {
+	ID         uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PartnerID  *uuid.UUID    `json:"partner_id,omitempty" gorm:"type:uuid;index"`
+	Name       string        `json:"name" gorm:"not null"`
+	Prefix     string        `json:"prefix" gorm:"not null"` // Start of the key, to tell keys apart
+	KeyHash    string        `json:"-" gorm:"uniqueIndex;not null"`
+	Scopes     []APIKeyScope `json:"scopes" gorm:"serializer:json"`
+	Plan       APIKeyPlan    `json:"plan" gorm:"not null;default:'partner'"`
+	LastUsedAt *time.Time    `json:"last_used_at"`
+	RevokedAt  *time.Time    `json:"revoked_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+// TableName returns the table name for APIKey entity
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// GenerateAPIKey returns a new random key and the start of it that is kept to tell keys apart
+func GenerateAPIKey() (key, prefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:len(apiKeyPrefix)+8], nil
+}
+
+// HashAPIKey returns the hash keys are stored and looked up by
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(key)))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsActive checks if the key hasn't been revoked
+func (k *APIKey) IsActive() bool {
+	return k.RevokedAt == nil
+}
+
+// HasScope checks if the key may be used for scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyUsage counts an API key's requests over a UTC day, for its quota and for billing and
+// reporting
+type APIKeyUsage struct {
+	ID        uuid.UUID `json:"-" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	APIKeyID  uuid.UUID `json:"api_key_id" gorm:"type:uuid;not null;uniqueIndex:idx_api_key_usage_day"`
+	Day       time.Time `json:"day" gorm:"type:date;not null;uniqueIndex:idx_api_key_usage_day"`
+	Requests  int64     `json:"requests" gorm:"not null;default:0"` // Requests served
+	Rejected  int64     `json:"rejected" gorm:"not null;default:0"` // Requests refused over the daily quota
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for APIKeyUsage entity
+func (APIKeyUsage) TableName() string {
+	return "api_key_usages"
+}
+
+// APIUsageDay returns the start of the UTC day usage at t is counted in
+func APIUsageDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -64,8 +64,8 @@ type AuditLog struct {
 	Level       LogLevel               `json:"level" gorm:"not null;index"`
 	Category    LogCategory            `json:"category" gorm:"not null;index"`
 	Message     string                 `json:"message" gorm:"not null"`
-	Details     map[string]interface{} `json:"details,omitempty" gorm:"type:jsonb"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" gorm:"type:jsonb"`
+	Details     map[string]interface{} `json:"details,omitempty" gorm:"type:jsonb;serializer:json"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty" gorm:"type:jsonb;serializer:json"`
 	IPAddress   string                 `json:"ip_address,omitempty" gorm:"index"`
 	UserAgent   string                 `json:"user_agent,omitempty"`
 	SessionID   *string                `json:"session_id,omitempty" gorm:"index"`
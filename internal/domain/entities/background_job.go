@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+// JobState describes the current state of a scheduled background job
+type JobState struct {
+	Name           string        `json:"name"`
+	Interval       time.Duration `json:"interval"`
+	Running        bool          `json:"running"`
+	LastRunAt      *time.Time    `json:"last_run_at,omitempty"`
+	LastSuccessAt  *time.Time    `json:"last_success_at,omitempty"`
+	LastError      string        `json:"last_error,omitempty"` // Error of the last run, cleared when a run succeeds
+	LastDurationMs int64         `json:"last_duration_ms"`
+	RunCount       int64         `json:"run_count"`
+	FailureCount   int64         `json:"failure_count"`
+}
+
+// IsOverdue checks if a job has gone more than missedRuns intervals without succeeding, counting
+// from startedAt when it has never succeeded
+func (s JobState) IsOverdue(now, startedAt time.Time, missedRuns int) bool {
+	since := startedAt
+	if s.LastSuccessAt != nil {
+		since = *s.LastSuccessAt
+	}
+	return now.Sub(since) > time.Duration(missedRuns)*s.Interval
+}
@@ -0,0 +1,156 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BulkPriceAdjustment represents how a bulk price update changes prices
+type BulkPriceAdjustment string
+
+const (
+	BulkPriceAdjustmentPercent BulkPriceAdjustment = "percent" // Value is a percentage, e.g. 5 for +5% or -10 for -10%
+	BulkPriceAdjustmentFixed   BulkPriceAdjustment = "fixed"   // Value is an amount added to the price, negative to lower it
+)
+
+// BulkPriceUpdateStatus represents the status of a bulk price update
+type BulkPriceUpdateStatus string
+
+const (
+	BulkPriceUpdateStatusApplied    BulkPriceUpdateStatus = "applied"
+	BulkPriceUpdateStatusRolledBack BulkPriceUpdateStatus = "rolled_back"
+)
+
+// MaxBulkPriceUpdateProducts caps the number of products a single bulk update may change
+const MaxBulkPriceUpdateProducts = 5000
+
+// BulkPriceRule selects products and describes how their prices change.
+// Selectors are combined with AND; at least one of CategoryID, BrandID or ProductIDs is required.
+type BulkPriceRule struct {
+	CategoryID *uuid.UUID  `json:"category_id" gorm:"type:uuid"` // Includes subcategories
+	BrandID    *uuid.UUID  `json:"brand_id" gorm:"type:uuid"`
+	ProductIDs []uuid.UUID `json:"product_ids" gorm:"serializer:json"`
+	MinPrice   *float64    `json:"min_price"` // Only products priced at or above
+	MaxPrice   *float64    `json:"max_price"` // Only products priced at or below
+
+	Adjustment BulkPriceAdjustment `json:"adjustment" gorm:"not null"`
+	Value      float64             `json:"value"`
+	// RoundTo is the price ending to round to, e.g. 0.99 turns 21.37 into 20.99 and 21.62 into 21.99
+	RoundTo *float64 `json:"round_to"`
+	// AdjustSalePrice applies the same rule to products' sale prices
+	AdjustSalePrice bool `json:"adjust_sale_price"`
+}
+
+// Validate validates the bulk price rule
+func (r *BulkPriceRule) Validate() error {
+	if r.CategoryID == nil && r.BrandID == nil && len(r.ProductIDs) == 0 {
+		return fmt.Errorf("at least one of category_id, brand_id or product_ids is required")
+	}
+	if len(r.ProductIDs) > MaxBulkPriceUpdateProducts {
+		return fmt.Errorf("at most %d product IDs are allowed", MaxBulkPriceUpdateProducts)
+	}
+	if r.MinPrice != nil && r.MaxPrice != nil && *r.MinPrice > *r.MaxPrice {
+		return fmt.Errorf("min price must not be greater than max price")
+	}
+
+	switch r.Adjustment {
+	case BulkPriceAdjustmentPercent:
+		if r.Value <= -100 {
+			return fmt.Errorf("percentage decrease must be less than 100")
+		}
+	case BulkPriceAdjustmentFixed:
+	default:
+		return fmt.Errorf("invalid adjustment: %s", r.Adjustment)
+	}
+
+	if r.RoundTo != nil && (*r.RoundTo < 0 || *r.RoundTo >= 1) {
+		return fmt.Errorf("round_to must be a price ending between 0 and 0.99")
+	}
+	if r.Value == 0 && r.RoundTo == nil {
+		return fmt.Errorf("rule does not change any price")
+	}
+	return nil
+}
+
+// Calculate returns the price after the adjustment and rounding
+func (r *BulkPriceRule) Calculate(price float64) float64 {
+	switch r.Adjustment {
+	case BulkPriceAdjustmentPercent:
+		price = price * (1 + r.Value/100)
+	case BulkPriceAdjustmentFixed:
+		price = price + r.Value
+	}
+	price = roundCents(price)
+
+	if r.RoundTo != nil {
+		price = roundToEnding(price, *r.RoundTo)
+	}
+	return price
+}
+
+// ApplyTo applies the rule to a product's pricing and validates the result
+func (r *BulkPriceRule) ApplyTo(p *Product) error {
+	p.Price = r.Calculate(p.Price)
+	if p.Price <= 0 {
+		return fmt.Errorf("new price must be greater than 0")
+	}
+	if r.AdjustSalePrice && p.SalePrice != nil {
+		salePrice := r.Calculate(*p.SalePrice)
+		p.SalePrice = &salePrice
+	}
+	if err := p.ValidateSalePricing(); err != nil {
+		return err
+	}
+	return p.ValidateMinAdvertisedPrice()
+}
+
+// BulkPriceUpdate records a rule-based price change applied to many products at once.
+// The affected products are linked through their price history entries, which also drive rollback.
+type BulkPriceUpdate struct {
+	ID           uuid.UUID             `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name         string                `json:"name" gorm:"not null"`
+	Reason       string                `json:"reason" gorm:"type:text"`
+	Rule         BulkPriceRule         `json:"rule" gorm:"embedded;embeddedPrefix:rule_"`
+	Status       BulkPriceUpdateStatus `json:"status" gorm:"default:'applied';index"`
+	ProductCount int                   `json:"product_count"`
+	AppliedBy    *uuid.UUID            `json:"applied_by" gorm:"type:uuid;index"`
+	RolledBackBy *uuid.UUID            `json:"rolled_back_by" gorm:"type:uuid"`
+	RolledBackAt *time.Time            `json:"rolled_back_at"`
+	CreatedAt    time.Time             `json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt    time.Time             `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for BulkPriceUpdate entity
+func (BulkPriceUpdate) TableName() string {
+	return "bulk_price_updates"
+}
+
+// CanBeRolledBack checks if the bulk update is still in effect
+func (b *BulkPriceUpdate) CanBeRolledBack() bool {
+	return b.Status == BulkPriceUpdateStatusApplied
+}
+
+// roundCents rounds a price to two decimals
+func roundCents(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// roundToEnding moves a price to the nearest amount with the given ending, preferring the
+// higher one on a tie. Prices are never rounded down to zero or below.
+func roundToEnding(price, ending float64) float64 {
+	base := math.Floor(price)
+	best := roundCents(base + ending)
+	for _, candidate := range []float64{roundCents(base - 1 + ending), roundCents(base + 1 + ending)} {
+		if candidate <= 0 {
+			continue
+		}
+		distance, bestDistance := math.Abs(candidate-price), math.Abs(best-price)
+		if best <= 0 || distance < bestDistance || (distance == bestDistance && candidate > best) {
+			best = candidate
+		}
+	}
+	return best
+}
@@ -5,6 +5,8 @@ import (
 	"math"
 	"time"
 
+	"ecom-golang-clean-architecture/pkg/ids"
+
 	"github.com/google/uuid"
 )
 
@@ -336,7 +338,7 @@ func (c *Cart) AddItem(productID uuid.UUID, quantity int, price float64) {
 		existingItem.UpdatedAt = time.Now()
 	} else {
 		newItem := CartItem{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			CartID:    c.ID,
 			ProductID: productID,
 			Quantity:  quantity,
@@ -0,0 +1,323 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogChangesetStatus represents the status of a catalog changeset
+type CatalogChangesetStatus string
+
+const (
+	CatalogChangesetStatusDraft      CatalogChangesetStatus = "draft"
+	CatalogChangesetStatusScheduled  CatalogChangesetStatus = "scheduled"
+	CatalogChangesetStatusPublished  CatalogChangesetStatus = "published"
+	CatalogChangesetStatusRolledBack CatalogChangesetStatus = "rolled_back"
+	CatalogChangesetStatusFailed     CatalogChangesetStatus = "failed" // Scheduled publish failed; fix the items and schedule it again
+)
+
+// MaxCatalogChangesetItems caps the number of products a single changeset may change
+const MaxCatalogChangesetItems = 2000
+
+// CatalogChangeset batches staged product edits that go live together, either on demand
+// or at a scheduled time. Until then the edits are only visible through the preview token.
+type CatalogChangeset struct {
+	ID          uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name        string                 `json:"name" gorm:"not null"`
+	Description string                 `json:"description" gorm:"type:text"`
+	Status      CatalogChangesetStatus `json:"status" gorm:"not null;index"`
+	ScheduledAt *time.Time             `json:"scheduled_at" gorm:"index"`
+	// PreviewToken lets the storefront render the catalog with the changeset applied
+	PreviewToken string     `json:"preview_token" gorm:"not null;uniqueIndex"`
+	ErrorMessage string     `json:"error_message" gorm:"type:text"`
+	CreatedBy    *uuid.UUID `json:"created_by" gorm:"type:uuid;index"`
+	PublishedBy  *uuid.UUID `json:"published_by" gorm:"type:uuid"`
+	RolledBackBy *uuid.UUID `json:"rolled_back_by" gorm:"type:uuid"`
+	PublishedAt  *time.Time `json:"published_at"`
+	RolledBackAt *time.Time `json:"rolled_back_at"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	Items []CatalogChangesetItem `json:"items,omitempty" gorm:"foreignKey:ChangesetID"`
+}
+
+// TableName returns the table name for CatalogChangeset entity
+func (CatalogChangeset) TableName() string {
+	return "catalog_changesets"
+}
+
+// IsEditable checks if the changeset has not been published yet
+func (c *CatalogChangeset) IsEditable() bool {
+	return c.Status == CatalogChangesetStatusDraft ||
+		c.Status == CatalogChangesetStatusScheduled ||
+		c.Status == CatalogChangesetStatusFailed
+}
+
+// CanBeRolledBack checks if the changeset's edits are live
+func (c *CatalogChangeset) CanBeRolledBack() bool {
+	return c.Status == CatalogChangesetStatusPublished
+}
+
+// CatalogChangesetItem holds the staged edits to one product. Before and After capture the
+// product when the changeset is published; rollback restores Before while the product still
+// matches After.
+type CatalogChangesetItem struct {
+	ID          uuid.UUID             `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ChangesetID uuid.UUID             `json:"changeset_id" gorm:"type:uuid;not null;uniqueIndex:idx_catalog_changeset_items_changeset_product"`
+	ProductID   uuid.UUID             `json:"product_id" gorm:"type:uuid;not null;uniqueIndex:idx_catalog_changeset_items_changeset_product;index"`
+	Changes     CatalogProductChanges `json:"changes" gorm:"serializer:json"`
+	Before      *CatalogProductState  `json:"before,omitempty" gorm:"serializer:json"`
+	After       *CatalogProductState  `json:"after,omitempty" gorm:"serializer:json"`
+	CreatedAt   time.Time             `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time             `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for CatalogChangesetItem entity
+func (CatalogChangesetItem) TableName() string {
+	return "catalog_changeset_items"
+}
+
+// CatalogProductChanges are the staged edits to a product - nil fields are left unchanged
+type CatalogProductChanges struct {
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"short_description,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	MetaTitle        *string `json:"meta_title,omitempty"`
+	MetaDescription  *string `json:"meta_description,omitempty"`
+	Keywords         *string `json:"keywords,omitempty"`
+
+	Price         *float64   `json:"price,omitempty"`
+	ComparePrice  *float64   `json:"compare_price,omitempty"`
+	SalePrice     *float64   `json:"sale_price,omitempty"`
+	SaleStartDate *time.Time `json:"sale_start_date,omitempty"`
+	SaleEndDate   *time.Time `json:"sale_end_date,omitempty"`
+	ClearSale     bool       `json:"clear_sale,omitempty"`
+
+	Featured   *bool              `json:"featured,omitempty"`
+	Visibility *ProductVisibility `json:"visibility,omitempty"`
+	Status     *ProductStatus     `json:"status,omitempty"`
+}
+
+// IsEmpty checks if the changes leave the product unchanged
+func (c CatalogProductChanges) IsEmpty() bool {
+	return c.Name == nil && c.ShortDescription == nil && c.Description == nil &&
+		c.MetaTitle == nil && c.MetaDescription == nil && c.Keywords == nil &&
+		c.Price == nil && c.ComparePrice == nil && c.SalePrice == nil &&
+		c.SaleStartDate == nil && c.SaleEndDate == nil && !c.ClearSale &&
+		c.Featured == nil && c.Visibility == nil && c.Status == nil
+}
+
+// Validate validates the changes on their own; ApplyTo checks them against the product
+func (c CatalogProductChanges) Validate() error {
+	if c.IsEmpty() {
+		return fmt.Errorf("at least one field must be changed")
+	}
+	if c.Name != nil && *c.Name == "" {
+		return fmt.Errorf("product name cannot be empty")
+	}
+	if c.ClearSale && c.SalePrice != nil {
+		return fmt.Errorf("cannot set a sale price and clear the sale in the same change")
+	}
+	if c.Visibility != nil {
+		switch *c.Visibility {
+		case ProductVisibilityVisible, ProductVisibilityHidden, ProductVisibilityPrivate:
+		default:
+			return fmt.Errorf("invalid visibility: %s", *c.Visibility)
+		}
+	}
+	if c.Status != nil && !IsValidProductStatus(*c.Status) {
+		return fmt.Errorf("invalid product status: %s", *c.Status)
+	}
+	return nil
+}
+
+// ApplyTo applies the changes to a product and validates the result
+func (c CatalogProductChanges) ApplyTo(p *Product, at time.Time) error {
+	if c.Name != nil {
+		p.Name = *c.Name
+	}
+	if c.ShortDescription != nil {
+		p.ShortDescription = *c.ShortDescription
+	}
+	if c.Description != nil {
+		p.Description = *c.Description
+	}
+	if c.MetaTitle != nil {
+		p.MetaTitle = *c.MetaTitle
+	}
+	if c.MetaDescription != nil {
+		p.MetaDescription = *c.MetaDescription
+	}
+	if c.Keywords != nil {
+		p.Keywords = *c.Keywords
+	}
+
+	if c.Price != nil {
+		p.Price = *c.Price
+	}
+	if c.ComparePrice != nil {
+		p.ComparePrice = copyFloatPtr(c.ComparePrice)
+	}
+	if c.ClearSale {
+		p.ClearSale()
+	}
+	if c.SalePrice != nil {
+		p.SalePrice = copyFloatPtr(c.SalePrice)
+	}
+	if c.SaleStartDate != nil {
+		p.SaleStartDate = copyTimePtr(c.SaleStartDate)
+	}
+	if c.SaleEndDate != nil {
+		p.SaleEndDate = copyTimePtr(c.SaleEndDate)
+	}
+
+	if c.Featured != nil {
+		p.Featured = *c.Featured
+	}
+	if c.Visibility != nil {
+		p.Visibility = *c.Visibility
+	}
+	if c.Status != nil {
+		if err := p.TransitionTo(*c.Status, at); err != nil {
+			return err
+		}
+	}
+
+	if p.Price <= 0 {
+		return fmt.Errorf("product price must be greater than 0")
+	}
+	if p.ComparePrice != nil && *p.ComparePrice <= p.Price {
+		return fmt.Errorf("compare price must be greater than regular price")
+	}
+	if err := p.ValidateSalePricing(); err != nil {
+		return err
+	}
+	return p.ValidateMinAdvertisedPrice()
+}
+
+// CatalogProductState captures the product fields a changeset can edit
+type CatalogProductState struct {
+	Name             string `json:"name"`
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	MetaTitle        string `json:"meta_title"`
+	MetaDescription  string `json:"meta_description"`
+	Keywords         string `json:"keywords"`
+
+	Price         float64    `json:"price"`
+	ComparePrice  *float64   `json:"compare_price"`
+	SalePrice     *float64   `json:"sale_price"`
+	SaleStartDate *time.Time `json:"sale_start_date"`
+	SaleEndDate   *time.Time `json:"sale_end_date"`
+
+	Featured             bool              `json:"featured"`
+	Visibility           ProductVisibility `json:"visibility"`
+	Status               ProductStatus     `json:"status"`
+	ReplacementProductID *uuid.UUID        `json:"replacement_product_id"`
+	DiscontinuedAt       *time.Time        `json:"discontinued_at"`
+	ArchivedAt           *time.Time        `json:"archived_at"`
+}
+
+// NewCatalogProductState captures a product's editable fields. Times are rounded to the
+// microsecond, as the database stores them, so states compare equal after a reload.
+func NewCatalogProductState(p *Product) CatalogProductState {
+	return CatalogProductState{
+		Name:                 p.Name,
+		ShortDescription:     p.ShortDescription,
+		Description:          p.Description,
+		MetaTitle:            p.MetaTitle,
+		MetaDescription:      p.MetaDescription,
+		Keywords:             p.Keywords,
+		Price:                p.Price,
+		ComparePrice:         copyFloatPtr(p.ComparePrice),
+		SalePrice:            copyFloatPtr(p.SalePrice),
+		SaleStartDate:        roundedTimePtr(p.SaleStartDate),
+		SaleEndDate:          roundedTimePtr(p.SaleEndDate),
+		Featured:             p.Featured,
+		Visibility:           p.Visibility,
+		Status:               p.Status,
+		ReplacementProductID: copyUUIDPtr(p.ReplacementProductID),
+		DiscontinuedAt:       roundedTimePtr(p.DiscontinuedAt),
+		ArchivedAt:           roundedTimePtr(p.ArchivedAt),
+	}
+}
+
+// Equal checks if two states have the same field values
+func (s CatalogProductState) Equal(other CatalogProductState) bool {
+	return s.Name == other.Name &&
+		s.ShortDescription == other.ShortDescription &&
+		s.Description == other.Description &&
+		s.MetaTitle == other.MetaTitle &&
+		s.MetaDescription == other.MetaDescription &&
+		s.Keywords == other.Keywords &&
+		s.Price == other.Price &&
+		floatPtrEqual(s.ComparePrice, other.ComparePrice) &&
+		floatPtrEqual(s.SalePrice, other.SalePrice) &&
+		timePtrEqual(s.SaleStartDate, other.SaleStartDate) &&
+		timePtrEqual(s.SaleEndDate, other.SaleEndDate) &&
+		s.Featured == other.Featured &&
+		s.Visibility == other.Visibility &&
+		s.Status == other.Status &&
+		uuidPtrEqual(s.ReplacementProductID, other.ReplacementProductID) &&
+		timePtrEqual(s.DiscontinuedAt, other.DiscontinuedAt) &&
+		timePtrEqual(s.ArchivedAt, other.ArchivedAt)
+}
+
+// Content returns the translatable content in the state
+func (s CatalogProductState) Content() ProductContent {
+	return ProductContent{
+		Name:             s.Name,
+		ShortDescription: s.ShortDescription,
+		Description:      s.Description,
+		MetaTitle:        s.MetaTitle,
+		MetaDescription:  s.MetaDescription,
+		Keywords:         s.Keywords,
+	}
+}
+
+// RestoreTo writes the captured fields back onto a product
+func (s CatalogProductState) RestoreTo(p *Product) {
+	p.Name = s.Name
+	p.ShortDescription = s.ShortDescription
+	p.Description = s.Description
+	p.MetaTitle = s.MetaTitle
+	p.MetaDescription = s.MetaDescription
+	p.Keywords = s.Keywords
+	p.Price = s.Price
+	p.ComparePrice = copyFloatPtr(s.ComparePrice)
+	p.SalePrice = copyFloatPtr(s.SalePrice)
+	p.SaleStartDate = copyTimePtr(s.SaleStartDate)
+	p.SaleEndDate = copyTimePtr(s.SaleEndDate)
+	p.Featured = s.Featured
+	p.Visibility = s.Visibility
+	p.Status = s.Status
+	p.ReplacementProductID = copyUUIDPtr(s.ReplacementProductID)
+	p.DiscontinuedAt = copyTimePtr(s.DiscontinuedAt)
+	p.ArchivedAt = copyTimePtr(s.ArchivedAt)
+}
+
+func roundedTimePtr(v *time.Time) *time.Time {
+	if v == nil {
+		return nil
+	}
+	c := v.Round(time.Microsecond)
+	return &c
+}
+
+func copyUUIDPtr(v *uuid.UUID) *uuid.UUID {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+func uuidPtrEqual(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
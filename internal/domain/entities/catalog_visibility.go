@@ -0,0 +1,130 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VisibilityAudience represents the group of customers a catalog visibility rule admits
+type VisibilityAudience string
+
+const (
+	VisibilityAudienceLoggedIn        VisibilityAudience = "logged_in"        // Any signed-in customer
+	VisibilityAudienceCompany         VisibilityAudience = "company"          // Company (B2B) members; AudienceValue optionally limits to one company ID
+	VisibilityAudienceMembershipTier  VisibilityAudience = "membership_tier"  // Customers in the membership tier named by AudienceValue
+	VisibilityAudienceCustomerSegment VisibilityAudience = "customer_segment" // Customers in the segment named by AudienceValue
+)
+
+// CatalogVisibilityRule restricts a product, or every product in a category tree, to an audience.
+// A product targeted by one or more active rules is visible only to viewers matching at least one of them.
+type CatalogVisibilityRule struct {
+	ID            uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name          string             `json:"name" gorm:"not null"`
+	ProductID     *uuid.UUID         `json:"product_id" gorm:"type:uuid;index"`
+	CategoryID    *uuid.UUID         `json:"category_id" gorm:"type:uuid;index"` // Applies to the category and its subcategories
+	Audience      VisibilityAudience `json:"audience" gorm:"not null"`
+	AudienceValue string             `json:"audience_value"`
+	IsActive      bool               `json:"is_active" gorm:"default:true;index"`
+	CreatedBy     *uuid.UUID         `json:"created_by" gorm:"type:uuid"`
+	CreatedAt     time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for CatalogVisibilityRule entity
+func (CatalogVisibilityRule) TableName() string {
+	return "catalog_visibility_rules"
+}
+
+// Validate validates catalog visibility rule data
+func (r *CatalogVisibilityRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("rule name is required")
+	}
+	if (r.ProductID == nil) == (r.CategoryID == nil) {
+		return fmt.Errorf("rule must target exactly one of product_id or category_id")
+	}
+
+	switch r.Audience {
+	case VisibilityAudienceLoggedIn:
+		if r.AudienceValue != "" {
+			return fmt.Errorf("audience_value is not used for the %s audience", r.Audience)
+		}
+	case VisibilityAudienceCompany:
+		if r.AudienceValue != "" {
+			if _, err := uuid.Parse(r.AudienceValue); err != nil {
+				return fmt.Errorf("audience_value must be a company ID for the company audience")
+			}
+		}
+	case VisibilityAudienceMembershipTier:
+		if !isValidMembershipTier(r.AudienceValue) {
+			return fmt.Errorf("invalid membership tier: %s", r.AudienceValue)
+		}
+	case VisibilityAudienceCustomerSegment:
+		if !isValidCustomerSegment(r.AudienceValue) {
+			return fmt.Errorf("invalid customer segment: %s", r.AudienceValue)
+		}
+	default:
+		return fmt.Errorf("invalid audience: %s", r.Audience)
+	}
+	return nil
+}
+
+// CatalogViewer describes who is browsing the catalog, for evaluating visibility rules
+type CatalogViewer struct {
+	UserID          *uuid.UUID // Nil for guests
+	IsStaff         bool       // Admins and moderators see the full catalog
+	CompanyID       *uuid.UUID // Active company membership, if any
+	MembershipTier  string
+	CustomerSegment string
+}
+
+// IsGuest checks if the viewer is not signed in
+func (v *CatalogViewer) IsGuest() bool {
+	return v.UserID == nil
+}
+
+// Matches checks if the viewer belongs to the audience admitted by the rule
+func (v *CatalogViewer) Matches(rule *CatalogVisibilityRule) bool {
+	if v.IsStaff {
+		return true
+	}
+	if v.IsGuest() {
+		return false
+	}
+
+	switch rule.Audience {
+	case VisibilityAudienceLoggedIn:
+		return true
+	case VisibilityAudienceCompany:
+		if v.CompanyID == nil {
+			return false
+		}
+		return rule.AudienceValue == "" || rule.AudienceValue == v.CompanyID.String()
+	case VisibilityAudienceMembershipTier:
+		return rule.AudienceValue == v.MembershipTier
+	case VisibilityAudienceCustomerSegment:
+		return rule.AudienceValue == v.CustomerSegment
+	}
+	return false
+}
+
+func isValidMembershipTier(tier string) bool {
+	for _, valid := range []string{"bronze", "silver", "gold", "platinum", "diamond"} {
+		if tier == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidCustomerSegment(segment string) bool {
+	for _, valid := range []string{"new", "occasional", "regular", "loyal"} {
+		if segment == valid {
+			return true
+		}
+	}
+	return false
+}
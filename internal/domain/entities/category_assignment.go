@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CategoryAssignmentAction represents what a bulk category assignment does with its products
+type CategoryAssignmentAction string
+
+const (
+	CategoryAssignmentActionAdd    CategoryAssignmentAction = "add"    // Adds the products to the category
+	CategoryAssignmentActionRemove CategoryAssignmentAction = "remove" // Removes the products from the category
+	CategoryAssignmentActionMove   CategoryAssignmentAction = "move"   // Moves the products from the source category to the category
+)
+
+// CategoryAssignmentStatus represents the status of a bulk category assignment
+type CategoryAssignmentStatus string
+
+const (
+	CategoryAssignmentStatusPending   CategoryAssignmentStatus = "pending" // Waiting for the background job
+	CategoryAssignmentStatusRunning   CategoryAssignmentStatus = "running"
+	CategoryAssignmentStatusCompleted CategoryAssignmentStatus = "completed"
+	CategoryAssignmentStatusFailed    CategoryAssignmentStatus = "failed"
+)
+
+const (
+	// MaxCategoryAssignmentProducts caps the number of products a single assignment may change
+	MaxCategoryAssignmentProducts = 10000
+	// MaxInlineCategoryAssignmentProducts is the most products an assignment changes within the
+	// request; larger assignments run in the background
+	MaxInlineCategoryAssignmentProducts = 500
+)
+
+// CategoryAssignmentSelector selects the products of a bulk category assignment, by explicit IDs
+// or by filter. Selectors are combined with AND; at least one is required.
+type CategoryAssignmentSelector struct {
+	ProductIDs []uuid.UUID    `json:"product_ids" gorm:"serializer:json"`
+	CategoryID *uuid.UUID     `json:"category_id" gorm:"type:uuid"` // Includes subcategories
+	BrandID    *uuid.UUID     `json:"brand_id" gorm:"type:uuid"`
+	VendorID   *uuid.UUID     `json:"vendor_id" gorm:"type:uuid"`
+	Status     *ProductStatus `json:"status"`
+	Search     string         `json:"search"` // Name or SKU contains
+	MinPrice   *float64       `json:"min_price"`
+	MaxPrice   *float64       `json:"max_price"`
+}
+
+// IsEmpty checks if the selector selects nothing, rather than every product
+func (s *CategoryAssignmentSelector) IsEmpty() bool {
+	return len(s.ProductIDs) == 0 && s.CategoryID == nil && s.BrandID == nil && s.VendorID == nil &&
+		s.Status == nil && s.Search == "" && s.MinPrice == nil && s.MaxPrice == nil
+}
+
+// CategoryAssignment records a bulk add, remove or move of products across categories. Small
+// assignments run within the request; larger ones are left pending for the background job.
+type CategoryAssignment struct {
+	ID               uuid.UUID                  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Action           CategoryAssignmentAction   `json:"action" gorm:"not null"`
+	CategoryID       uuid.UUID                  `json:"category_id" gorm:"type:uuid;not null;index"`
+	SourceCategoryID *uuid.UUID                 `json:"source_category_id" gorm:"type:uuid"` // Category products move out of
+	MakePrimary      bool                       `json:"make_primary"`                        // Make the category the products' primary category
+	Selector         CategoryAssignmentSelector `json:"selector" gorm:"embedded;embeddedPrefix:selector_"`
+	Status           CategoryAssignmentStatus   `json:"status" gorm:"default:'pending';index"`
+	MatchedCount     int                        `json:"matched_count"`
+	ChangedCount     int                        `json:"changed_count"` // Products whose categories or primary category changed
+	ErrorMessage     string                     `json:"error_message,omitempty" gorm:"type:text"`
+	RequestedBy      *uuid.UUID                 `json:"requested_by" gorm:"type:uuid"`
+	StartedAt        *time.Time                 `json:"started_at"`
+	CompletedAt      *time.Time                 `json:"completed_at"`
+	CreatedAt        time.Time                  `json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt        time.Time                  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for CategoryAssignment entity
+func (CategoryAssignment) TableName() string {
+	return "category_assignments"
+}
+
+// Validate validates the category assignment
+func (a *CategoryAssignment) Validate() error {
+	switch a.Action {
+	case CategoryAssignmentActionAdd, CategoryAssignmentActionRemove:
+		if a.SourceCategoryID != nil {
+			return fmt.Errorf("source_category_id is only used to move products")
+		}
+	case CategoryAssignmentActionMove:
+		if a.SourceCategoryID == nil {
+			return fmt.Errorf("source_category_id is required to move products")
+		}
+		if *a.SourceCategoryID == a.CategoryID {
+			return fmt.Errorf("source and target category must differ")
+		}
+	default:
+		return fmt.Errorf("invalid action: %s", a.Action)
+	}
+	if a.Action == CategoryAssignmentActionRemove && a.MakePrimary {
+		return fmt.Errorf("make_primary cannot be used to remove products")
+	}
+
+	if a.Selector.IsEmpty() {
+		return fmt.Errorf("at least one of product_ids or a filter is required")
+	}
+	if len(a.Selector.ProductIDs) > MaxCategoryAssignmentProducts {
+		return fmt.Errorf("at most %d product IDs are allowed", MaxCategoryAssignmentProducts)
+	}
+	if a.Selector.MinPrice != nil && a.Selector.MaxPrice != nil && *a.Selector.MinPrice > *a.Selector.MaxPrice {
+		return fmt.Errorf("min price must not be greater than max price")
+	}
+	return nil
+}
+
+// IsFinished checks if the assignment has stopped running
+func (a *CategoryAssignment) IsFinished() bool {
+	return a.Status == CategoryAssignmentStatusCompleted || a.Status == CategoryAssignmentStatusFailed
+}
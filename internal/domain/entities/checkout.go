@@ -33,6 +33,15 @@ type CheckoutSession struct {
 	ShippingAddress *OrderAddress `json:"shipping_address" gorm:"embedded;embeddedPrefix:shipping_"`
 	BillingAddress  *OrderAddress `json:"billing_address" gorm:"embedded;embeddedPrefix:billing_"`
 
+	// Delivery instructions for the carrier, copied to the order on completion
+	DeliveryInstructions string `json:"delivery_instructions" gorm:"type:text"`
+
+	// Gift options, copied to the order on completion
+	IsGift             bool   `json:"is_gift" gorm:"default:false"`
+	GiftMessage        string `json:"gift_message" gorm:"type:text"`
+	GiftWrap           bool   `json:"gift_wrap" gorm:"default:false"`
+	GiftRecipientEmail string `json:"gift_recipient_email"`
+
 	// Payment Information
 	PaymentMethod   PaymentMethod `json:"payment_method" gorm:"not null"`
 	PaymentIntentID string        `json:"payment_intent_id"` // For Stripe/PayPal
@@ -49,6 +58,9 @@ type CheckoutSession struct {
 	TaxRate      float64 `json:"tax_rate" gorm:"default:0"`
 	ShippingCost float64 `json:"shipping_cost" gorm:"default:0"`
 
+	// Tax exemption applied to the totals, copied to the order on completion
+	TaxExemption *OrderTaxExemption `json:"tax_exemption,omitempty" gorm:"serializer:json"`
+
 	// Customer notes
 	Notes string `json:"notes"`
 
@@ -0,0 +1,402 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// PaymentTerms represents the payment terms agreed with a company
+type PaymentTerms string
+
+const (
+	PaymentTermsPrepaid PaymentTerms = "prepaid"
+	PaymentTermsNet15   PaymentTerms = "net_15"
+	PaymentTermsNet30   PaymentTerms = "net_30"
+	PaymentTermsNet60   PaymentTerms = "net_60"
+)
+
+// DueDays returns the number of days an invoice has to be paid under these terms
+func (t PaymentTerms) DueDays() int {
+	switch t {
+	case PaymentTermsNet15:
+		return 15
+	case PaymentTermsNet30:
+		return 30
+	case PaymentTermsNet60:
+		return 60
+	default:
+		return 0
+	}
+}
+
+// IsValid checks if the payment terms are supported
+func (t PaymentTerms) IsValid() bool {
+	switch t {
+	case PaymentTermsPrepaid, PaymentTermsNet15, PaymentTermsNet30, PaymentTermsNet60:
+		return true
+	}
+	return false
+}
+
+// CompanyMemberRole represents the role of a user within a company
+type CompanyMemberRole string
+
+const (
+	CompanyMemberRoleAdmin    CompanyMemberRole = "admin"    // Manages members, addresses and approves orders
+	CompanyMemberRoleApprover CompanyMemberRole = "approver" // Places and approves orders
+	CompanyMemberRoleBuyer    CompanyMemberRole = "buyer"    // Places orders within their spend limit
+)
+
+// IsValid checks if the member role is supported
+func (r CompanyMemberRole) IsValid() bool {
+	switch r {
+	case CompanyMemberRoleAdmin, CompanyMemberRoleApprover, CompanyMemberRoleBuyer:
+		return true
+	}
+	return false
+}
+
+// Company represents an organization account with multiple buyers
+type Company struct {
+	ID           uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name         string       `json:"name" gorm:"not null" validate:"required"`
+	LegalName    string       `json:"legal_name"`
+	TaxID        string       `json:"tax_id" gorm:"index"`
+	Email        string       `json:"email"`
+	Phone        string       `json:"phone"`
+	PaymentTerms PaymentTerms `json:"payment_terms" gorm:"default:'prepaid'"`
+
+	// DefaultSpendLimit applies to buyers without their own spend limit (0 means no limit)
+	DefaultSpendLimit float64 `json:"default_spend_limit" gorm:"default:0"`
+
+	IsActive  bool            `json:"is_active" gorm:"default:true"`
+	Members   []CompanyMember `json:"members,omitempty" gorm:"foreignKey:CompanyID"`
+	CreatedAt time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for Company entity
+func (Company) TableName() string {
+	return "companies"
+}
+
+// AllowsNetTerms checks if the company may check out on invoice and pay later
+func (c *Company) AllowsNetTerms() bool {
+	return c.IsActive && c.PaymentTerms != "" && c.PaymentTerms != PaymentTermsPrepaid
+}
+
+// Validate validates company data
+func (c *Company) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("company name is required")
+	}
+	if len(c.Name) > 200 {
+		return fmt.Errorf("company name must be 200 characters or less")
+	}
+	if c.PaymentTerms != "" && !c.PaymentTerms.IsValid() {
+		return fmt.Errorf("invalid payment terms: %s", c.PaymentTerms)
+	}
+	if c.DefaultSpendLimit < 0 {
+		return fmt.Errorf("default spend limit cannot be negative")
+	}
+	return nil
+}
+
+// CompanyMember represents a user's membership in a company
+type CompanyMember struct {
+	ID        uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CompanyID uuid.UUID         `json:"company_id" gorm:"type:uuid;not null;index"`
+	Company   *Company          `json:"company,omitempty" gorm:"foreignKey:CompanyID"`
+	UserID    uuid.UUID         `json:"user_id" gorm:"type:uuid;not null;uniqueIndex"` // A user belongs to at most one company
+	User      *User             `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	Role      CompanyMemberRole `json:"role" gorm:"not null;default:'buyer'"`
+
+	// SpendLimit is the maximum order total the member can place without approval.
+	// nil falls back to the company default spend limit.
+	SpendLimit *float64 `json:"spend_limit"`
+
+	IsActive  bool      `json:"is_active" gorm:"default:true"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for CompanyMember entity
+func (CompanyMember) TableName() string {
+	return "company_members"
+}
+
+// CanApprove checks if the member can approve orders
+func (m *CompanyMember) CanApprove() bool {
+	return m.IsActive && (m.Role == CompanyMemberRoleAdmin || m.Role == CompanyMemberRoleApprover)
+}
+
+// CanManage checks if the member can manage the company account
+func (m *CompanyMember) CanManage() bool {
+	return m.IsActive && m.Role == CompanyMemberRoleAdmin
+}
+
+// EffectiveSpendLimit returns the member's spend limit, falling back to the company default.
+// A return value of 0 means no limit.
+func (m *CompanyMember) EffectiveSpendLimit(company *Company) float64 {
+	if m.SpendLimit != nil {
+		return *m.SpendLimit
+	}
+	if company != nil {
+		return company.DefaultSpendLimit
+	}
+	return 0
+}
+
+// RequiresApproval checks if an order total needs approval for this member
+func (m *CompanyMember) RequiresApproval(company *Company, total float64) bool {
+	if m.CanApprove() {
+		return false
+	}
+	limit := m.EffectiveSpendLimit(company)
+	return limit > 0 && total > limit
+}
+
+// OrderApprovalStatus represents the approval state of a company order
+type OrderApprovalStatus string
+
+const (
+	OrderApprovalStatusPending  OrderApprovalStatus = "pending"
+	OrderApprovalStatusApproved OrderApprovalStatus = "approved"
+	OrderApprovalStatusRejected OrderApprovalStatus = "rejected"
+)
+
+// OrderApproval represents an approval request for a company order over the buyer's spend limit
+type OrderApproval struct {
+	ID          uuid.UUID           `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrderID     uuid.UUID           `json:"order_id" gorm:"type:uuid;not null;uniqueIndex"`
+	Order       *Order              `json:"order,omitempty" gorm:"foreignKey:OrderID"`
+	CompanyID   uuid.UUID           `json:"company_id" gorm:"type:uuid;not null;index"`
+	RequestedBy uuid.UUID           `json:"requested_by" gorm:"type:uuid;not null;index"`
+	Requester   *User               `json:"requester,omitempty" gorm:"foreignKey:RequestedBy"`
+	Status      OrderApprovalStatus `json:"status" gorm:"not null;default:'pending';index"`
+	OrderTotal  float64             `json:"order_total" gorm:"not null"`
+	SpendLimit  float64             `json:"spend_limit"`
+	DecidedBy   *uuid.UUID          `json:"decided_by" gorm:"type:uuid"`
+	DecidedAt   *time.Time          `json:"decided_at"`
+	Comment     string              `json:"comment" gorm:"type:text"`
+	CreatedAt   time.Time           `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time           `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for OrderApproval entity
+func (OrderApproval) TableName() string {
+	return "order_approvals"
+}
+
+// IsPending checks if the approval is still awaiting a decision
+func (a *OrderApproval) IsPending() bool {
+	return a.Status == OrderApprovalStatusPending
+}
+
+// Decide records an approval decision
+func (a *OrderApproval) Decide(status OrderApprovalStatus, decidedBy uuid.UUID, comment string) error {
+	if !a.IsPending() {
+		return fmt.Errorf("order approval has already been %s", a.Status)
+	}
+	if status != OrderApprovalStatusApproved && status != OrderApprovalStatusRejected {
+		return fmt.Errorf("invalid approval decision: %s", status)
+	}
+	if decidedBy == a.RequestedBy {
+		return fmt.Errorf("buyers cannot approve their own orders")
+	}
+
+	now := time.Now()
+	a.Status = status
+	a.DecidedBy = &decidedBy
+	a.DecidedAt = &now
+	a.Comment = comment
+	return nil
+}
+
+// CompanyInvoiceStatus represents the status of a consolidated company invoice
+type CompanyInvoiceStatus string
+
+const (
+	CompanyInvoiceStatusIssued CompanyInvoiceStatus = "issued"
+	CompanyInvoiceStatusPaid   CompanyInvoiceStatus = "paid"
+	CompanyInvoiceStatusVoid   CompanyInvoiceStatus = "void"
+)
+
+// CompanyInvoice represents a consolidated invoice covering several company orders
+type CompanyInvoice struct {
+	ID            uuid.UUID            `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	InvoiceNumber string               `json:"invoice_number" gorm:"uniqueIndex;not null"`
+	CompanyID     uuid.UUID            `json:"company_id" gorm:"type:uuid;not null;index"`
+	Company       *Company             `json:"company,omitempty" gorm:"foreignKey:CompanyID"`
+	PeriodStart   time.Time            `json:"period_start" gorm:"not null"`
+	PeriodEnd     time.Time            `json:"period_end" gorm:"not null"`
+	Subtotal      float64              `json:"subtotal" gorm:"not null"`
+	TaxAmount     float64              `json:"tax_amount" gorm:"default:0"`
+	ShippingTotal float64              `json:"shipping_total" gorm:"default:0"`
+	DiscountTotal float64              `json:"discount_total" gorm:"default:0"`
+	Total         float64              `json:"total" gorm:"not null"`
+	Currency      string               `json:"currency" gorm:"default:'USD'"`
+	PaymentTerms  PaymentTerms         `json:"payment_terms"`
+	Status        CompanyInvoiceStatus `json:"status" gorm:"not null;default:'issued';index"`
+	IssuedAt      time.Time            `json:"issued_at" gorm:"not null"`
+	DueDate       time.Time            `json:"due_date" gorm:"not null"`
+	PaidAt        *time.Time           `json:"paid_at"`
+	Items         []CompanyInvoiceItem `json:"items" gorm:"foreignKey:InvoiceID"`
+	CreatedAt     time.Time            `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time            `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Offline payments recorded against the invoice
+	AmountPaid float64                 `json:"amount_paid" gorm:"not null;default:0"`
+	Payments   []CompanyInvoicePayment `json:"payments,omitempty" gorm:"foreignKey:InvoiceID"`
+
+	// Overdue reminder tracking
+	LastReminderAt *time.Time `json:"last_reminder_at"`
+	ReminderCount  int        `json:"reminder_count" gorm:"default:0"`
+}
+
+// InvoiceReminderInterval is the minimum time between overdue reminders for an invoice
+const InvoiceReminderInterval = 7 * 24 * time.Hour
+
+// TableName returns the table name for CompanyInvoice entity
+func (CompanyInvoice) TableName() string {
+	return "company_invoices"
+}
+
+// IsOverdue checks if the invoice is past its due date and unpaid
+func (i *CompanyInvoice) IsOverdue() bool {
+	return i.Status == CompanyInvoiceStatusIssued && time.Now().After(i.DueDate)
+}
+
+// Balance returns the amount still owed on the invoice
+func (i *CompanyInvoice) Balance() float64 {
+	balance := math.Round((i.Total-i.AmountPaid)*100) / 100
+	if balance < 0 {
+		return 0
+	}
+	return balance
+}
+
+// RecordPayment applies an offline payment to the invoice and marks it paid once settled
+func (i *CompanyInvoice) RecordPayment(payment *CompanyInvoicePayment) error {
+	if i.Status != CompanyInvoiceStatusIssued {
+		return fmt.Errorf("invoice is %s and cannot accept payments", i.Status)
+	}
+	if payment.Amount <= 0 {
+		return fmt.Errorf("payment amount must be greater than 0")
+	}
+	if payment.Amount > i.Balance()+0.005 {
+		return fmt.Errorf("payment amount %.2f exceeds the outstanding balance of %.2f", payment.Amount, i.Balance())
+	}
+
+	payment.InvoiceID = i.ID
+	i.AmountPaid = math.Round((i.AmountPaid+payment.Amount)*100) / 100
+	i.Payments = append(i.Payments, *payment)
+
+	if i.Balance() == 0 {
+		paidAt := payment.ReceivedAt
+		i.Status = CompanyInvoiceStatusPaid
+		i.PaidAt = &paidAt
+	}
+	return nil
+}
+
+// NeedsReminder checks if an overdue reminder should be sent for the invoice
+func (i *CompanyInvoice) NeedsReminder(now time.Time) bool {
+	if !i.IsOverdue() {
+		return false
+	}
+	return i.LastReminderAt == nil || now.Sub(*i.LastReminderAt) >= InvoiceReminderInterval
+}
+
+// MarkReminded records that an overdue reminder was sent
+func (i *CompanyInvoice) MarkReminded(now time.Time) {
+	i.LastReminderAt = &now
+	i.ReminderCount++
+}
+
+// CompanyInvoicePayment represents an offline payment (bank transfer, check, cash) recorded against an invoice
+type CompanyInvoicePayment struct {
+	ID         uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	InvoiceID  uuid.UUID     `json:"invoice_id" gorm:"type:uuid;not null;index"`
+	Amount     float64       `json:"amount" gorm:"not null"`
+	Method     PaymentMethod `json:"method" gorm:"not null"`
+	Reference  string        `json:"reference"` // Bank transfer reference, check number, etc.
+	Notes      string        `json:"notes" gorm:"type:text"`
+	ReceivedAt time.Time     `json:"received_at" gorm:"not null"`
+	RecordedBy uuid.UUID     `json:"recorded_by" gorm:"type:uuid;not null"`
+	CreatedAt  time.Time     `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for CompanyInvoicePayment entity
+func (CompanyInvoicePayment) TableName() string {
+	return "company_invoice_payments"
+}
+
+// CompanyInvoiceItem represents one order on a consolidated invoice
+type CompanyInvoiceItem struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	InvoiceID   uuid.UUID `json:"invoice_id" gorm:"type:uuid;not null;index"`
+	OrderID     uuid.UUID `json:"order_id" gorm:"type:uuid;not null;uniqueIndex"`
+	OrderNumber string    `json:"order_number"`
+	OrderedBy   uuid.UUID `json:"ordered_by" gorm:"type:uuid"`
+	OrderDate   time.Time `json:"order_date"`
+	Subtotal    float64   `json:"subtotal"`
+	TaxAmount   float64   `json:"tax_amount"`
+	Shipping    float64   `json:"shipping"`
+	Discount    float64   `json:"discount"`
+	Total       float64   `json:"total"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for CompanyInvoiceItem entity
+func (CompanyInvoiceItem) TableName() string {
+	return "company_invoice_items"
+}
+
+// NewCompanyInvoice builds a consolidated invoice from a set of company orders
+func NewCompanyInvoice(company *Company, invoiceNumber string, periodStart, periodEnd time.Time, orders []*Order) *CompanyInvoice {
+	now := time.Now()
+	invoice := &CompanyInvoice{
+		ID:            ids.New(),
+		InvoiceNumber: invoiceNumber,
+		CompanyID:     company.ID,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+		Currency:      "USD",
+		PaymentTerms:  company.PaymentTerms,
+		Status:        CompanyInvoiceStatusIssued,
+		IssuedAt:      now,
+		DueDate:       now.AddDate(0, 0, company.PaymentTerms.DueDays()),
+	}
+
+	for _, order := range orders {
+		invoice.Items = append(invoice.Items, CompanyInvoiceItem{
+			ID:          ids.New(),
+			InvoiceID:   invoice.ID,
+			OrderID:     order.ID,
+			OrderNumber: order.OrderNumber,
+			OrderedBy:   order.UserID,
+			OrderDate:   order.CreatedAt,
+			Subtotal:    order.Subtotal,
+			TaxAmount:   order.TaxAmount,
+			Shipping:    order.ShippingAmount,
+			Discount:    order.DiscountAmount,
+			Total:       order.Total,
+		})
+		invoice.Subtotal += order.Subtotal
+		invoice.TaxAmount += order.TaxAmount
+		invoice.ShippingTotal += order.ShippingAmount
+		invoice.DiscountTotal += order.DiscountAmount
+		invoice.Total += order.Total
+		if order.Currency != "" {
+			invoice.Currency = order.Currency
+		}
+	}
+
+	return invoice
+}
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DisputeStatus represents where a dispute or chargeback stands with the payment provider
+type DisputeStatus string
+
+const (
+	DisputeStatusNeedsResponse DisputeStatus = "needs_response" // Evidence is due from us
+	DisputeStatusUnderReview   DisputeStatus = "under_review"   // Waiting for the provider or bank to decide
+	DisputeStatusWon           DisputeStatus = "won"            // Resolved in our favor
+	DisputeStatusLost          DisputeStatus = "lost"           // Resolved in the customer's favor; the funds were charged back
+	DisputeStatusClosed        DisputeStatus = "closed"         // Closed without a decision, e.g. withdrawn by the customer
+)
+
+// Dispute is a dispute or chargeback a customer raised with their bank or payment provider,
+// recorded from the provider's webhooks
+type Dispute struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Gateway    string    `json:"gateway" gorm:"not null;uniqueIndex:idx_disputes_gateway_external_id"`
+	ExternalID string    `json:"external_id" gorm:"not null;uniqueIndex:idx_disputes_gateway_external_id"`
+
+	// The disputed payment, when it could be matched
+	PaymentID *uuid.UUID `json:"payment_id" gorm:"type:uuid;index"`
+	OrderID   *uuid.UUID `json:"order_id" gorm:"type:uuid;index"`
+
+	Amount        float64       `json:"amount" gorm:"not null"`
+	Currency      string        `json:"currency" gorm:"default:'USD'"`
+	Reason        string        `json:"reason"`
+	Status        DisputeStatus `json:"status" gorm:"not null;index"`
+	GatewayStatus string        `json:"gateway_status"`
+
+	// Evidence
+	EvidenceDueBy          *time.Time `json:"evidence_due_by" gorm:"index"`
+	EvidenceSubmittedAt    *time.Time `json:"evidence_submitted_at"`
+	EvidenceNotes          string     `json:"evidence_notes" gorm:"type:text"`
+	EvidenceReminderSentAt *time.Time `json:"evidence_reminder_sent_at"`
+
+	OpenedAt   time.Time  `json:"opened_at" gorm:"not null;index"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for Dispute entity
+func (Dispute) TableName() string {
+	return "disputes"
+}
+
+// IsResolved checks if the dispute has been decided or closed
+func (d *Dispute) IsResolved() bool {
+	return d.Status == DisputeStatusWon || d.Status == DisputeStatusLost || d.Status == DisputeStatusClosed
+}
+
+// DisputeStats counts disputes opened in a period and the payments they are measured against
+type DisputeStats struct {
+	Opened         int64   `json:"opened"`
+	Won            int64   `json:"won"`
+	Lost           int64   `json:"lost"`
+	Open           int64   `json:"open"`
+	DisputedAmount float64 `json:"disputed_amount"`
+	LostAmount     float64 `json:"lost_amount"`
+	PaidPayments   int64   `json:"paid_payments"`
+}
@@ -70,6 +70,109 @@ var (
 	// Address errors
 	ErrAddressNotFound = errors.New("address not found")
 
+	// Company account errors
+	ErrCompanyNotFound        = errors.New("company not found")
+	ErrCompanyMemberNotFound  = errors.New("company member not found")
+	ErrOrderApprovalNotFound  = errors.New("order approval not found")
+	ErrCompanyInvoiceNotFound = errors.New("company invoice not found")
+	ErrOrderAwaitingApproval  = errors.New("order is awaiting company approval")
+
+	// Quote errors
+	ErrQuoteNotFound = errors.New("quote not found")
+	ErrQuoteExpired  = errors.New("quote has expired")
+
+	// Catalog visibility errors
+	ErrVisibilityRuleNotFound = errors.New("catalog visibility rule not found")
+
+	// Bulk price update errors
+	ErrBulkPriceUpdateNotFound = errors.New("bulk price update not found")
+
+	// Admin note errors
+	ErrAdminNoteNotFound = errors.New("note not found")
+
+	// Order message errors
+	ErrOrderMessageNotFound = errors.New("order message not found")
+
+	// Review incentive errors
+	ErrReviewIncentiveProgramNotFound = errors.New("review incentive program not found")
+
+	// Review moderation errors
+	ErrReviewModerationSettingsNotFound = errors.New("review moderation settings not found")
+	ErrReviewLimitReached               = errors.New("daily review limit reached, try again later")
+
+	// Tax exemption errors
+	ErrTaxExemptionNotFound = errors.New("tax exemption certificate not found")
+
+	// Product waitlist errors
+	ErrWaitlistNotFound = errors.New("product waitlist not found")
+
+	// Product translation errors
+	ErrProductTranslationNotFound = errors.New("product translation not found")
+
+	// Catalog changeset errors
+	ErrCatalogChangesetNotFound = errors.New("catalog changeset not found")
+
+	// Category assignment errors
+	ErrCategoryAssignmentNotFound = errors.New("category assignment not found")
+
+	// Aggregate repair errors
+	ErrAggregateRepairRunNotFound = errors.New("aggregate repair run not found")
+
+	// Order archive errors
+	ErrOrderArchiveNotFound = errors.New("order is not archived")
+
+	// Report errors
+	ErrReportNotFound        = errors.New("report not found")
+	ErrReportDownloadInvalid = errors.New("report download link is invalid")
+	ErrReportDownloadExpired = errors.New("report download link has expired")
+	ErrExportProfileNotFound = errors.New("export profile not found")
+
+	// Data residency errors
+	ErrDataRegionDenied = errors.New("not allowed to export data of this data region")
+
+	// Purchasing errors
+	ErrSupplierNotFound      = errors.New("supplier not found")
+	ErrSupplierExists        = errors.New("supplier with this code already exists")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrPurchaseOrderNotOpen  = errors.New("purchase order is no longer open")
+
+	// Returns portal errors
+	ErrReturnLinkInvalid = errors.New("return link is invalid")
+	ErrReturnLinkExpired = errors.New("return link has expired")
+
+	// Partner feed errors
+	ErrPartnerNotFound = errors.New("partner not found")
+	ErrAPIKeyNotFound  = errors.New("API key not found")
+	ErrAPIKeyInvalid   = errors.New("API key is invalid or revoked")
+
+	// Payment link errors
+	ErrPaymentLinkNotFound  = errors.New("payment link not found")
+	ErrPaymentLinkExpired   = errors.New("payment link has expired")
+	ErrPaymentLinkCancelled = errors.New("payment link has been cancelled")
+	ErrPaymentLinkUsed      = errors.New("payment link has already been paid")
+
+	// Dispute errors
+	ErrDisputeNotFound = errors.New("dispute not found")
+
+	// Settlement errors
+	ErrSettlementNotFound = errors.New("settlement not found")
+
+	// Vendor errors
+	ErrVendorNotFound           = errors.New("vendor not found")
+	ErrVendorExists             = errors.New("vendor with this slug already exists")
+	ErrNotVendor                = errors.New("account is not linked to a vendor")
+	ErrVendorCommissionNotFound = errors.New("vendor commission not found")
+
+	// Vendor application errors
+	ErrVendorApplicationNotFound = errors.New("vendor application not found")
+	ErrVendorApplicationExists   = errors.New("account already has a vendor")
+	ErrVendorApplicationLocked   = errors.New("vendor application cannot be changed while it is reviewed or after it is decided")
+	ErrVendorDocumentNotFound    = errors.New("vendor document not found")
+
+	// Admin task errors
+	ErrAdminTaskNotFound = errors.New("admin task not found")
+	ErrAdminTaskClosed   = errors.New("admin task is already completed")
+
 	// Wishlist errors
 	ErrWishlistItemNotFound = errors.New("wishlist item not found")
 
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ExportDelimiter separates the fields of an exported CSV file
+type ExportDelimiter string
+
+const (
+	ExportDelimiterComma     ExportDelimiter = "comma"
+	ExportDelimiterSemicolon ExportDelimiter = "semicolon" // Excel's default in locales with a decimal comma
+	ExportDelimiterTab       ExportDelimiter = "tab"
+)
+
+// Rune returns the character the delimiter stands for
+func (d ExportDelimiter) Rune() rune {
+	switch d {
+	case ExportDelimiterSemicolon:
+		return ';'
+	case ExportDelimiterTab:
+		return '\t'
+	default:
+		return ','
+	}
+}
+
+// IsValid checks if the delimiter is supported
+func (d ExportDelimiter) IsValid() bool {
+	return d == ExportDelimiterComma || d == ExportDelimiterSemicolon || d == ExportDelimiterTab
+}
+
+// ExportEncoding is the text encoding of an exported file
+type ExportEncoding string
+
+const (
+	ExportEncodingUTF8    ExportEncoding = "utf-8"
+	ExportEncodingUTF8BOM ExportEncoding = "utf-8-bom" // Starts with a byte order mark, so Excel reads the file as UTF-8
+)
+
+// IsValid checks if the encoding is supported
+func (e ExportEncoding) IsValid() bool {
+	return e == ExportEncodingUTF8 || e == ExportEncodingUTF8BOM
+}
+
+// ExportHeaderKeys is the locale that labels columns with their keys rather than translated
+// names, for scripts that read the files
+const ExportHeaderKeys = "keys"
+
+// ReportColumns are the column keys of each report type, in file order. They must match the
+// columns the report's query selects.
+var ReportColumns = map[ReportType][]string{
+	ReportTypeSales: {
+		"order_number", "created_at", "status", "payment_status", "customer_email",
+		"subtotal", "tax", "shipping", "discount", "total", "currency", "customer_id",
+	},
+	ReportTypeProducts: {"sku", "name", "units_sold", "revenue", "orders"},
+	ReportTypeUsers:    {"email", "first_name", "last_name", "role", "status", "registered_at", "user_id"},
+	ReportTypeInventory: {
+		"sku", "name", "warehouse", "quantity_on_hand", "quantity_reserved", "quantity_available", "reorder_level",
+	},
+	ReportTypePayments: {"payment_id", "order_number", "created_at", "method", "gateway", "status", "amount", "currency"},
+	ReportTypeSettlements: {
+		"date", "gateway", "currency", "charge_count", "refund_count", "payout_count",
+		"charges", "refunds", "disputes", "adjustments", "fees", "net", "payouts",
+		"matched_count", "mismatch_count", "missing_count", "status",
+	},
+}
+
+// ReportAnonymization is how the columns with personal data are anonymized in anonymized
+// reports; other columns are kept. Customer IDs get the same pseudonyms as in an anonymized
+// warehouse export.
+var ReportAnonymization = map[ReportType]map[string]AnonymizationRule{
+	ReportTypeSales: {
+		"customer_email": AnonymizeStrip,
+		"customer_id":    AnonymizePseudonymize,
+	},
+	ReportTypeUsers: {
+		"email":      AnonymizeStrip,
+		"first_name": AnonymizeStrip,
+		"last_name":  AnonymizeStrip,
+		"user_id":    AnonymizePseudonymize,
+	},
+}
+
+// ValidateReportColumns checks that every column belongs to the report type, once
+func ValidateReportColumns(reportType ReportType, columns []string) error {
+	known := make(map[string]bool, len(ReportColumns[reportType]))
+	for _, column := range ReportColumns[reportType] {
+		known[column] = true
+	}
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if !known[column] {
+			return fmt.Errorf("unknown %s report column %q; columns are %s", reportType, column, strings.Join(ReportColumns[reportType], ", "))
+		}
+		if seen[column] {
+			return fmt.Errorf("column %q is listed more than once", column)
+		}
+		seen[column] = true
+	}
+	return nil
+}
+
+// ExportProfile is an admin's preferred layout for a report type, applied to the reports they
+// generate unless the request says otherwise
+type ExportProfile struct {
+	ID         uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID       `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_export_profile_user_type,priority:1"`
+	ReportType ReportType      `json:"report_type" gorm:"not null;uniqueIndex:idx_export_profile_user_type,priority:2"`
+	Columns    pq.StringArray  `json:"columns" gorm:"type:text[]"` // Empty for every column
+	Locale     string          `json:"locale"`                     // Empty for the admin's language
+	Delimiter  ExportDelimiter `json:"delimiter"`                  // Empty for comma
+	Encoding   ExportEncoding  `json:"encoding"`                   // Empty for UTF-8
+	CreatedAt  time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for ExportProfile entity
+func (ExportProfile) TableName() string {
+	return "export_profiles"
+}
+
+// Validate validates export profile data
+func (p *ExportProfile) Validate() error {
+	if p.UserID == uuid.Nil {
+		return fmt.Errorf("user ID is required")
+	}
+	if _, ok := ReportColumns[p.ReportType]; !ok {
+		return fmt.Errorf("invalid report type %q", p.ReportType)
+	}
+	if err := ValidateReportColumns(p.ReportType, p.Columns); err != nil {
+		return err
+	}
+	if p.Delimiter != "" && !p.Delimiter.IsValid() {
+		return fmt.Errorf("invalid delimiter %q", p.Delimiter)
+	}
+	if p.Encoding != "" && !p.Encoding.IsValid() {
+		return fmt.Errorf("invalid encoding %q", p.Encoding)
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ type FileUpload struct {
 	UploadedBy   *string       `json:"uploadedBy,omitempty" gorm:"index"`  // User ID if authenticated
 	UploadType   FileUploadType `json:"uploadType" gorm:"not null;index"`  // admin, user, public
 	Category     string        `json:"category" gorm:"not null;index"`     // images, documents, etc.
+	DataRegion   string        `json:"dataRegion" gorm:"index"`            // Region whose storage holds the file
 	
 	// Metadata
 	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
@@ -99,3 +100,23 @@ func DefaultDocumentConfig() *FileConfig {
 		},
 	}
 }
+
+// DefaultKYCDocumentConfig returns configuration for identity and business documents, which
+// are often scans or photos
+func DefaultKYCDocumentConfig() *FileConfig {
+	return &FileConfig{
+		MaxFileSize: 10 * 1024 * 1024, // 10MB
+		AllowedTypes: []string{
+			"application/pdf",
+			"image/jpeg",
+			"image/jpg",
+			"image/png",
+		},
+		AllowedExtensions: []string{
+			".pdf",
+			".jpg",
+			".jpeg",
+			".png",
+		},
+	}
+}
@@ -559,6 +559,33 @@ func (sa *StockAlert) Validate() error {
 	return nil
 }
 
+// InventorySnapshot records the stock of a product in a warehouse on a given day.
+// Snapshots are taken by a background job and back point-in-time stock reporting.
+type InventorySnapshot struct {
+	ID                uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SnapshotDate      time.Time `json:"snapshot_date" gorm:"type:date;not null;uniqueIndex:idx_inventory_snapshots_day_product_warehouse,priority:1"`
+	ProductID         uuid.UUID `json:"product_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_inventory_snapshots_day_product_warehouse,priority:2"`
+	WarehouseID       uuid.UUID `json:"warehouse_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_inventory_snapshots_day_product_warehouse,priority:3"`
+	InventoryID       uuid.UUID `json:"inventory_id" gorm:"type:uuid;not null"`
+	QuantityOnHand    int       `json:"quantity_on_hand"`
+	QuantityReserved  int       `json:"quantity_reserved"`
+	QuantityAvailable int       `json:"quantity_available"`
+	UnitCost          float64   `json:"unit_cost"`   // Average cost, falling back to the product's cost price
+	TotalValue        float64   `json:"total_value"` // Quantity on hand valued at unit cost
+	CreatedAt         time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for InventorySnapshot entity
+func (InventorySnapshot) TableName() string {
+	return "inventory_snapshots"
+}
+
+// SnapshotDay returns the calendar day, in UTC, that a snapshot taken at t belongs to
+func SnapshotDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 // Supplier represents a product supplier
 type Supplier struct {
 	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JWTSigningKey is a stored key access tokens are signed with. Every API server loads the keys,
+// so a key created by one server's rotation signs on all of them once it activates.
+type JWTSigningKey struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	KeyID       string    `json:"kid" gorm:"uniqueIndex;not null"` // Written to the kid header of the tokens it signs
+	Algorithm   string    `json:"alg" gorm:"not null"`             // HS256, RS256 or EdDSA
+	PrivateKey  string    `json:"-" gorm:"type:text;not null"`     // Encrypted with JWT_SECRET
+	ActivatesAt time.Time `json:"activates_at" gorm:"not null;index"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for JWTSigningKey entity
+func (JWTSigningKey) TableName() string {
+	return "jwt_signing_keys"
+}
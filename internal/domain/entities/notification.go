@@ -291,6 +291,22 @@ func (n *Notification) MarkAsFailed(errorMessage, errorCode string) {
 	n.UpdatedAt = now
 }
 
+// CanRequeue checks if a notification can be put back in the delivery queue: it ran out of
+// retries, or it was left processing by a worker that stopped
+func (n *Notification) CanRequeue() bool {
+	return n.Status == NotificationStatusFailed || n.Status == NotificationStatusProcessing
+}
+
+// Requeue puts the notification back in the delivery queue with a fresh set of retries
+func (n *Notification) Requeue() {
+	n.Status = NotificationStatusPending
+	n.RetryCount = 0
+	n.NextRetryAt = nil
+	n.ErrorMessage = ""
+	n.ErrorCode = ""
+	n.UpdatedAt = time.Now()
+}
+
 // IsNotificationEnabled checks if a specific notification type is enabled
 func (np *NotificationPreferences) IsNotificationEnabled(notificationType NotificationType, category NotificationCategory) bool {
 	switch notificationType {
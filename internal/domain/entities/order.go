@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"regexp"
 	"strconv"
 	"time"
 
+	"ecom-golang-clean-architecture/pkg/ids"
+
 	"github.com/google/uuid"
 )
 
@@ -21,6 +24,26 @@ func getOrderTimeoutMinutes() int {
 	return 30 // default 30 phút
 }
 
+// MaxGiftMessageLength is the maximum length of a gift message printed on the gift receipt
+const MaxGiftMessageLength = 500
+
+var emailAddressRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// ValidateGiftOptions validates gift options. The recipient email is optional, but only gifts
+// can have one.
+func ValidateGiftOptions(isGift bool, recipientEmail, message string) error {
+	if recipientEmail != "" && !isGift {
+		return fmt.Errorf("only gift orders can have a gift recipient")
+	}
+	if recipientEmail != "" && !emailAddressRegex.MatchString(recipientEmail) {
+		return fmt.Errorf("invalid gift recipient email format")
+	}
+	if len(message) > MaxGiftMessageLength {
+		return fmt.Errorf("gift message cannot exceed %d characters", MaxGiftMessageLength)
+	}
+	return nil
+}
+
 // OrderStatus represents the status of an order
 type OrderStatus string
 
@@ -39,6 +62,14 @@ const (
 	OrderStatusExchanged      OrderStatus = "exchanged"      // Order exchanged
 )
 
+// PendingFulfillmentOrderStatuses are the statuses of orders that have not shipped yet
+var PendingFulfillmentOrderStatuses = []OrderStatus{
+	OrderStatusPending,
+	OrderStatusConfirmed,
+	OrderStatusProcessing,
+	OrderStatusReadyToShip,
+}
+
 // FulfillmentStatus represents the fulfillment status of an order
 type FulfillmentStatus string
 
@@ -115,6 +146,9 @@ type Order struct {
 	Total          float64 `json:"total" gorm:"not null"`
 	Currency       string  `json:"currency" gorm:"default:'USD'"`
 
+	// TaxExemption records the tax exemption certificate the order was placed with, if any
+	TaxExemption *OrderTaxExemption `json:"tax_exemption,omitempty" gorm:"serializer:json"`
+
 	// Address Information
 	ShippingAddress *OrderAddress `json:"shipping_address" gorm:"embedded;embeddedPrefix:shipping_"`
 	BillingAddress  *OrderAddress `json:"billing_address" gorm:"embedded;embeddedPrefix:billing_"`
@@ -135,10 +169,11 @@ type Order struct {
 	AdminNotes    string `json:"admin_notes" gorm:"type:text"`
 	InternalNotes string `json:"internal_notes" gorm:"type:text"`
 
-	// Gift Options
-	IsGift      bool   `json:"is_gift" gorm:"default:false"`
-	GiftMessage string `json:"gift_message" gorm:"type:text"`
-	GiftWrap    bool   `json:"gift_wrap" gorm:"default:false"`
+	// Gift Options; shipping updates for gifts go to the recipient, without prices
+	IsGift             bool   `json:"is_gift" gorm:"default:false"`
+	GiftMessage        string `json:"gift_message" gorm:"type:text"`
+	GiftWrap           bool   `json:"gift_wrap" gorm:"default:false"`
+	GiftRecipientEmail string `json:"gift_recipient_email"`
 
 	// Business Information
 	SalesChannel   string `json:"sales_channel"`
@@ -146,6 +181,11 @@ type Order struct {
 	CouponCodes    string `json:"coupon_codes" gorm:"type:text"` // JSON array as string
 	Tags           string `json:"tags" gorm:"type:text"`         // JSON array as string
 
+	// Company (B2B) Information
+	CompanyID        *uuid.UUID          `json:"company_id" gorm:"type:uuid;index"`
+	ApprovalStatus   OrderApprovalStatus `json:"approval_status,omitempty"` // Empty when no approval is required
+	CompanyInvoiceID *uuid.UUID          `json:"company_invoice_id" gorm:"type:uuid;index"`
+
 	// Fulfillment Information
 	WarehouseID *uuid.UUID `json:"warehouse_id" gorm:"type:uuid"`
 	PackedAt    *time.Time `json:"packed_at"`
@@ -159,6 +199,10 @@ type Order struct {
 	Version        int        `json:"version" gorm:"default:1"` // For optimistic locking
 	LastModifiedBy *uuid.UUID `json:"last_modified_by" gorm:"type:uuid"`
 
+	// Archived orders keep only this row; their items and events are in order_archives
+	IsArchived bool       `json:"is_archived" gorm:"default:false;index"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
 	// Relationships
 	Payments    []Payment    `json:"payments" gorm:"foreignKey:OrderID"`
 	OrderEvents []OrderEvent `json:"order_events" gorm:"foreignKey:OrderID"`
@@ -184,6 +228,7 @@ type OrderItem struct {
 	Price       float64   `json:"price" gorm:"not null"`
 	Total       float64   `json:"total" gorm:"not null"`
 	Weight      float64   `json:"weight" gorm:"default:0"` // Individual item weight for shipping calculation
+	TaxExempt   bool      `json:"tax_exempt" gorm:"default:false"` // Exempted from tax by the order's certificate
 	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"` // Added missing UpdatedAt field
 }
@@ -291,6 +336,8 @@ const (
 	OrderEventTypeReturned          OrderEventType = "returned"
 	OrderEventTypeNoteAdded         OrderEventType = "note_added"
 	OrderEventTypeTrackingUpdated   OrderEventType = "tracking_updated"
+	OrderEventTypeDisputeOpened     OrderEventType = "dispute_opened"
+	OrderEventTypeDisputeResolved   OrderEventType = "dispute_resolved"
 
 	OrderEventTypeCustom            OrderEventType = "custom"
 )
@@ -350,6 +397,16 @@ func (o *Order) IsCompleted() bool {
 	return o.Status == OrderStatusDelivered
 }
 
+// IsAwaitingApproval checks if the order is waiting for a company approver
+func (o *Order) IsAwaitingApproval() bool {
+	return o.ApprovalStatus == OrderApprovalStatusPending
+}
+
+// IsCompanyOrder checks if the order was placed on behalf of a company
+func (o *Order) IsCompanyOrder() bool {
+	return o.CompanyID != nil
+}
+
 // IsPaid checks if the order is paid (uses proper multiple payments logic)
 func (o *Order) IsPaid() bool {
 	// For multiple payments support, check if order is fully paid
@@ -400,6 +457,12 @@ func (o *Order) Validate() error {
 	if o.OrderNumber == "" {
 		return fmt.Errorf("order number is required")
 	}
+	return o.ValidateDetails()
+}
+
+// ValidateDetails validates order data other than the order number, for orders that only get
+// their number when they are saved
+func (o *Order) ValidateDetails() error {
 	if o.UserID == uuid.Nil {
 		return fmt.Errorf("user ID is required")
 	}
@@ -459,6 +522,10 @@ func (o *Order) Validate() error {
 		}
 	}
 
+	if err := ValidateGiftOptions(o.IsGift, o.GiftRecipientEmail, o.GiftMessage); err != nil {
+		return err
+	}
+
 	// Validate addresses if present
 	if o.ShippingAddress != nil {
 		if err := o.ShippingAddress.Validate(); err != nil {
@@ -476,6 +543,16 @@ func (o *Order) Validate() error {
 
 // CanTransitionTo checks if order can transition to the given status
 func (o *Order) CanTransitionTo(newStatus OrderStatus) bool {
+	// Archived orders have to be restored before they change
+	if o.IsArchived {
+		return false
+	}
+
+	// Orders awaiting company approval can only be cancelled
+	if o.IsAwaitingApproval() && newStatus != OrderStatusCancelled {
+		return false
+	}
+
 	switch o.Status {
 	case OrderStatusDraft:
 		return newStatus == OrderStatusPending || newStatus == OrderStatusConfirmed || newStatus == OrderStatusCancelled
@@ -581,7 +658,7 @@ func (o *Order) TryAutoTransitionOnPayment() error {
 // Call ValidateTimeouts when creating new order (example constructor)
 func NewOrder(userID uuid.UUID, items []OrderItem) *Order {
 	order := &Order{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        userID,
 		Items:         items,
 		Status:        OrderStatusPending,
@@ -626,6 +703,14 @@ func (o *Order) UpdateTotalWeight() {
 	o.UpdatedAt = time.Now()
 }
 
+// ApplyTaxExemption records the tax exemption an order was placed with and flags its exempt items
+func (o *Order) ApplyTaxExemption(exemption *OrderTaxExemption) {
+	o.TaxExemption = exemption
+	for i := range o.Items {
+		o.Items[i].TaxExempt = exemption != nil && exemption.Exempts(o.Items[i].ProductID)
+	}
+}
+
 // CalculateTotal calculates the total amount of the order
 func (o *Order) CalculateTotal() {
 	o.Total = o.Subtotal + o.TaxAmount + o.ShippingAmount + o.TipAmount - o.DiscountAmount
@@ -692,7 +777,7 @@ func (o *Order) GetRemainingAmount() float64 {
 func (o *Order) AutoSyncPaymentStatus() {
 	// If no payments exist, determine status based on payment method
 	if len(o.Payments) == 0 {
-		if o.PaymentMethod == PaymentMethodCash {
+		if o.PaymentMethod == PaymentMethodCash || o.PaymentMethod == PaymentMethodInvoice {
 			o.PaymentStatus = PaymentStatusAwaitingPayment
 		} else {
 			o.PaymentStatus = PaymentStatusPending
@@ -790,6 +875,11 @@ func (o *Order) IsGiftOrder() bool {
 	return o.IsGift
 }
 
+// HasGiftRecipient checks if shipping updates go to a gift recipient instead of the buyer
+func (o *Order) HasGiftRecipient() bool {
+	return o.IsGift && o.GiftRecipientEmail != ""
+}
+
 // GetStatusDisplayName returns a human-readable status name
 func (o *Order) GetStatusDisplayName() string {
 	switch o.Status {
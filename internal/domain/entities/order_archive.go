@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderArchivableStatuses are the final statuses an order must have before it is archived
+var OrderArchivableStatuses = []OrderStatus{
+	OrderStatusDelivered,
+	OrderStatusCancelled,
+	OrderStatusRefunded,
+	OrderStatusReturned,
+	OrderStatusExchanged,
+}
+
+// OrderArchive holds the rows moved out of the live tables when an order was archived. The
+// order itself stays in orders as a stub flagged archived, so it can still be looked up by ID
+// or number with its totals and addresses.
+type OrderArchive struct {
+	OrderID    uuid.UUID       `json:"order_id" gorm:"type:uuid;primary_key"`
+	Items      json.RawMessage `json:"items" gorm:"type:jsonb;not null"`  // The order_items rows, as JSON objects
+	Events     json.RawMessage `json:"events" gorm:"type:jsonb;not null"` // The order_events rows, as JSON objects
+	ItemCount  int             `json:"item_count" gorm:"not null"`
+	EventCount int             `json:"event_count" gorm:"not null"`
+	ArchivedAt time.Time       `json:"archived_at" gorm:"not null;index"`
+}
+
+// TableName returns the table name for OrderArchive entity
+func (OrderArchive) TableName() string {
+	return "order_archives"
+}
+
+// OrderArchiveTotals counts what the archive holds
+type OrderArchiveTotals struct {
+	Orders         int64      `json:"orders"`
+	Items          int64      `json:"items"`
+	Events         int64      `json:"events"`
+	LastArchivedAt *time.Time `json:"last_archived_at,omitempty"`
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderMessageSender represents which side of an order conversation sent a message
+type OrderMessageSender string
+
+const (
+	OrderMessageSenderCustomer OrderMessageSender = "customer"
+	OrderMessageSenderStore    OrderMessageSender = "store"
+)
+
+const (
+	// MaxOrderMessageLength caps the length of a message body
+	MaxOrderMessageLength = 2000
+	// MaxOrderMessageAttachments caps how many files a single message can carry
+	MaxOrderMessageAttachments = 5
+)
+
+// OrderMessageAttachment is a snapshot of an uploaded file attached to a message
+type OrderMessageAttachment struct {
+	FileID      string `json:"file_id"`
+	FileName    string `json:"file_name"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	FileSize    int64  `json:"file_size"`
+}
+
+// OrderMessage is a message in the conversation between a customer and the store about an order.
+// Unlike admin notes, messages are visible to both sides.
+type OrderMessage struct {
+	ID          uuid.UUID                `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrderID     uuid.UUID                `json:"order_id" gorm:"type:uuid;not null;index"`
+	SenderType  OrderMessageSender       `json:"sender_type" gorm:"not null"`
+	SenderID    uuid.UUID                `json:"sender_id" gorm:"type:uuid;not null"`
+	Sender      *User                    `json:"sender,omitempty" gorm:"foreignKey:SenderID"`
+	Body        string                   `json:"body" gorm:"type:text"`
+	Attachments []OrderMessageAttachment `json:"attachments" gorm:"serializer:json"`
+
+	// ReadAt is set when the other side of the conversation has read the message
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for OrderMessage entity
+func (OrderMessage) TableName() string {
+	return "order_messages"
+}
+
+// Validate validates the message
+func (m *OrderMessage) Validate() error {
+	switch m.SenderType {
+	case OrderMessageSenderCustomer, OrderMessageSenderStore:
+	default:
+		return fmt.Errorf("invalid message sender: %s", m.SenderType)
+	}
+	if m.OrderID == uuid.Nil {
+		return fmt.Errorf("order is required")
+	}
+	if strings.TrimSpace(m.Body) == "" && len(m.Attachments) == 0 {
+		return fmt.Errorf("message must have a body or an attachment")
+	}
+	if len(m.Body) > MaxOrderMessageLength {
+		return fmt.Errorf("message must be at most %d characters", MaxOrderMessageLength)
+	}
+	if len(m.Attachments) > MaxOrderMessageAttachments {
+		return fmt.Errorf("message can have at most %d attachments", MaxOrderMessageAttachments)
+	}
+	return nil
+}
+
+// IsFromCustomer checks if the message was sent by the customer
+func (m *OrderMessage) IsFromCustomer() bool {
+	return m.SenderType == OrderMessageSenderCustomer
+}
+
+// IsRead checks if the other side has read the message
+func (m *OrderMessage) IsRead() bool {
+	return m.ReadAt != nil
+}
+
+// Recipient returns the side of the conversation the message is addressed to
+func (m *OrderMessage) Recipient() OrderMessageSender {
+	if m.IsFromCustomer() {
+		return OrderMessageSenderStore
+	}
+	return OrderMessageSenderCustomer
+}
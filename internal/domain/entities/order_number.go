@@ -0,0 +1,204 @@
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderNumberStrategy represents how order numbers are generated
+type OrderNumberStrategy string
+
+const (
+	// OrderNumberStrategyRandom generates ORD-YYYYMMDD-HHMMSS-XXXX numbers with a random suffix
+	OrderNumberStrategyRandom OrderNumberStrategy = "random"
+	// OrderNumberStrategySequential generates prefix, store code, date and sequence numbers
+	OrderNumberStrategySequential OrderNumberStrategy = "sequential"
+)
+
+// OrderNumberReset represents how often sequential order numbers start over
+type OrderNumberReset string
+
+const (
+	OrderNumberResetNever   OrderNumberReset = "never"
+	OrderNumberResetDaily   OrderNumberReset = "daily"
+	OrderNumberResetMonthly OrderNumberReset = "monthly"
+	OrderNumberResetYearly  OrderNumberReset = "yearly"
+)
+
+// Order number sequence scope used when all stores share one sequence
+const OrderNumberGlobalScope = "global"
+
+// OrderNumberFormat describes how order numbers are built
+type OrderNumberFormat struct {
+	Strategy OrderNumberStrategy
+
+	// Sequential strategy settings
+	Prefix         string
+	DateLayout     string // Go time layout of the date part, empty for none
+	Reset          OrderNumberReset
+	SequenceDigits int   // Sequence values are zero-padded to this width
+	SequenceStart  int64 // First value of every new sequence
+	PerStore       bool  // Each order source keeps its own sequence and adds its store code
+	StoreCodes     map[OrderSource]string
+	Checksum       bool // Append a Luhn check digit computed over the number's digits
+	GapFree        bool // Draw the sequence value in the transaction that creates the order
+}
+
+// Validate validates the order number format
+func (f OrderNumberFormat) Validate() error {
+	switch f.Strategy {
+	case OrderNumberStrategyRandom:
+		return nil
+	case OrderNumberStrategySequential:
+	default:
+		return fmt.Errorf("unknown order number strategy %q", f.Strategy)
+	}
+
+	switch f.Reset {
+	case OrderNumberResetNever, OrderNumberResetDaily, OrderNumberResetMonthly, OrderNumberResetYearly:
+	default:
+		return fmt.Errorf("unknown order number reset %q", f.Reset)
+	}
+	if f.Reset != OrderNumberResetNever && f.DateLayout == "" {
+		// Without the date, numbers from different periods would repeat
+		return fmt.Errorf("order numbers that reset %s need a date part", f.Reset)
+	}
+	if f.SequenceDigits < 1 || f.SequenceDigits > 18 {
+		return fmt.Errorf("order number sequence digits must be between 1 and 18")
+	}
+	if f.SequenceStart < 1 {
+		return fmt.Errorf("order number sequence start must be at least 1")
+	}
+	if f.Prefix == "" && f.DateLayout == "" && !f.PerStore {
+		// Bare sequence numbers could collide with numbers of other systems
+		return fmt.Errorf("sequential order numbers need a prefix, a date or store codes")
+	}
+	for source, code := range f.StoreCodes {
+		if code == "" || strings.ContainsAny(code, "- ") {
+			return fmt.Errorf("invalid store code %q for order source %s", code, source)
+		}
+	}
+	return nil
+}
+
+// IsSequential checks if order numbers are drawn from a sequence
+func (f OrderNumberFormat) IsSequential() bool {
+	return f.Strategy == OrderNumberStrategySequential
+}
+
+// StoreCode returns the code the given order source's numbers carry
+func (f OrderNumberFormat) StoreCode(source OrderSource) string {
+	if code, ok := f.StoreCodes[source]; ok {
+		return code
+	}
+	if source == "" {
+		source = OrderSourceWeb
+	}
+	return strings.ToUpper(string(source))
+}
+
+// SequenceKey returns the scope and period of the sequence an order placed at the given
+// time draws its number from
+func (f OrderNumberFormat) SequenceKey(source OrderSource, at time.Time) (scope, period string) {
+	scope = OrderNumberGlobalScope
+	if f.PerStore {
+		scope = f.StoreCode(source)
+	}
+
+	switch f.Reset {
+	case OrderNumberResetDaily:
+		period = at.Format("2006-01-02")
+	case OrderNumberResetMonthly:
+		period = at.Format("2006-01")
+	case OrderNumberResetYearly:
+		period = at.Format("2006")
+	}
+	return scope, period
+}
+
+// Build builds the order number for the given sequence value
+func (f OrderNumberFormat) Build(source OrderSource, at time.Time, value int64) string {
+	var parts []string
+	if f.Prefix != "" {
+		parts = append(parts, f.Prefix)
+	}
+	if f.PerStore {
+		parts = append(parts, f.StoreCode(source))
+	}
+	if f.DateLayout != "" {
+		parts = append(parts, at.Format(f.DateLayout))
+	}
+
+	sequence := fmt.Sprintf("%0*d", f.SequenceDigits, value)
+	parts = append(parts, sequence)
+
+	number := strings.Join(parts, "-")
+	if f.Checksum {
+		number += strconv.Itoa(OrderNumberCheckDigit(number))
+	}
+	return number
+}
+
+// Example returns a sample number of the format, for settings screens and startup logs
+func (f OrderNumberFormat) Example(at time.Time) string {
+	if !f.IsSequential() {
+		return "ORD-" + at.Format("20060102-150405") + "-1234"
+	}
+	return f.Build(OrderSourceWeb, at, f.SequenceStart)
+}
+
+// OrderNumberCheckDigit computes the Luhn check digit over the digits of the given number;
+// other characters are ignored
+func OrderNumberCheckDigit(number string) int {
+	sum := 0
+	double := true
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
+
+// HasValidOrderNumberCheckDigit checks if the last character of the number is the check digit
+// of the rest. Only numbers built with a checksum format carry one; older numbers stay valid
+// order numbers without it.
+func HasValidOrderNumberCheckDigit(number string) bool {
+	if len(number) < 2 {
+		return false
+	}
+	last := number[len(number)-1]
+	if last < '0' || last > '9' {
+		return false
+	}
+	return OrderNumberCheckDigit(number[:len(number)-1]) == int(last-'0')
+}
+
+// OrderNumberSequence holds the last order number value drawn in a scope and period
+type OrderNumberSequence struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Scope     string    `json:"scope" gorm:"not null;uniqueIndex:idx_order_number_sequences_scope_period"`
+	Period    string    `json:"period" gorm:"not null;default:'';uniqueIndex:idx_order_number_sequences_scope_period"`
+	LastValue int64     `json:"last_value" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for OrderNumberSequence entity
+func (OrderNumberSequence) TableName() string {
+	return "order_number_sequences"
+}
@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FulfillmentSLAState is where an order stands against its ship-by time
+type FulfillmentSLAState string
+
+const (
+	FulfillmentSLAStateOnTrack   FulfillmentSLAState = "on_track"
+	FulfillmentSLAStateAtRisk    FulfillmentSLAState = "at_risk"   // Not shipped and due within the warning window
+	FulfillmentSLAStateBreached  FulfillmentSLAState = "breached"  // Not shipped and past its ship-by time
+	FulfillmentSLAStateMet       FulfillmentSLAState = "met"       // Shipped by its ship-by time
+	FulfillmentSLAStateMissed    FulfillmentSLAState = "missed"    // Shipped after its ship-by time
+	FulfillmentSLAStateCancelled FulfillmentSLAState = "cancelled" // Cancelled, refunded or returned before it shipped
+)
+
+// IsValid checks if the state is known
+func (s FulfillmentSLAState) IsValid() bool {
+	switch s {
+	case FulfillmentSLAStateOnTrack, FulfillmentSLAStateAtRisk, FulfillmentSLAStateBreached,
+		FulfillmentSLAStateMet, FulfillmentSLAStateMissed, FulfillmentSLAStateCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsShipped checks if the order shipped, in time or not
+func (s FulfillmentSLAState) IsShipped() bool {
+	return s == FulfillmentSLAStateMet || s == FulfillmentSLAStateMissed
+}
+
+// FulfillmentSLAAlert is the kind of alert admins get about orders
+type FulfillmentSLAAlert string
+
+const (
+	FulfillmentSLAAlertAtRisk   FulfillmentSLAAlert = "at_risk"
+	FulfillmentSLAAlertBreached FulfillmentSLAAlert = "breached"
+	FulfillmentSLAAlertStuck    FulfillmentSLAAlert = "stuck" // In the same status longer than allowed
+)
+
+// OrderFulfillmentSLA tracks an order from confirmation until it is delivered or closed: whether
+// it ships by its ship-by time, and how long it stays in each status. Statuses are observed by
+// the monitoring job, so StatusSince is as precise as the job interval for orders it watched
+// change, and the order's own timestamps for orders it started tracking later.
+type OrderFulfillmentSLA struct {
+	OrderID     uuid.UUID           `json:"order_id" gorm:"type:uuid;primary_key"`
+	Order       *Order              `json:"-" gorm:"foreignKey:OrderID"`
+	State       FulfillmentSLAState `json:"state" gorm:"not null;index"`
+	ShipBy      time.Time           `json:"ship_by" gorm:"not null;index"`
+	ShippedAt   *time.Time          `json:"shipped_at,omitempty" gorm:"index"`
+	OrderStatus OrderStatus         `json:"order_status"` // Status last seen
+	StatusSince time.Time           `json:"status_since"`
+	BreachedAt  *time.Time          `json:"breached_at,omitempty"`
+	EscalatedAt *time.Time          `json:"escalated_at,omitempty" gorm:"index"` // Admins were told the order is stuck in its status; cleared when the status changes
+	ClosedAt    *time.Time          `json:"closed_at,omitempty" gorm:"index"`    // Delivered, or closed before
+	CreatedAt   time.Time           `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time           `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for OrderFulfillmentSLA entity
+func (OrderFulfillmentSLA) TableName() string {
+	return "order_fulfillment_slas"
+}
+
+// IsOpen checks if the order is still being tracked
+func (s *OrderFulfillmentSLA) IsOpen() bool {
+	return s.ClosedAt == nil
+}
+
+// BusinessCalendar counts business days: weekdays that are not holidays, in the store's time zone
+type BusinessCalendar struct {
+	Location *time.Location
+	Holidays map[string]bool // Dates as YYYY-MM-DD
+}
+
+// IsBusinessDay checks if the day t falls on in the calendar's time zone is a business day
+func (c BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	t = t.In(c.location())
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	return !c.Holidays[t.Format("2006-01-02")]
+}
+
+// ShipBy returns when an order placed at placedAt must have shipped: the end of the
+// businessDays-th business day after the day it was placed. With no days, it is the end of the
+// day it was placed, or of the next business day when it was placed on a day off.
+func (c BusinessCalendar) ShipBy(placedAt time.Time, businessDays int) time.Time {
+	placedAt = placedAt.In(c.location())
+	day := time.Date(placedAt.Year(), placedAt.Month(), placedAt.Day(), 0, 0, 0, 0, placedAt.Location())
+	for remaining := businessDays; remaining > 0; {
+		day = day.AddDate(0, 0, 1)
+		if c.IsBusinessDay(day) {
+			remaining--
+		}
+	}
+	for !c.IsBusinessDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day.AddDate(0, 0, 1)
+}
+
+func (c BusinessCalendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
@@ -0,0 +1,272 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// DomainEventType represents the type of an event published to downstream consumers
+type DomainEventType string
+
+const (
+	DomainEventOrderCreated            DomainEventType = "order.created"
+	DomainEventPaymentCaptured         DomainEventType = "payment.captured"
+	DomainEventStockChanged            DomainEventType = "stock.changed"
+	DomainEventUserRegistered          DomainEventType = "user.registered"
+	DomainEventCategoryProductsChanged DomainEventType = "category.products_changed"
+)
+
+const (
+	// DomainEventSchemaVersion is bumped when the data of an event changes incompatibly
+	DomainEventSchemaVersion = 1
+	// DomainEventSource identifies this service in published events
+	DomainEventSource = "ecom-api"
+)
+
+// AggregateType returns the kind of entity the event is about
+func (t DomainEventType) AggregateType() string {
+	switch t {
+	case DomainEventOrderCreated:
+		return "order"
+	case DomainEventPaymentCaptured:
+		return "payment"
+	case DomainEventStockChanged:
+		return "product"
+	case DomainEventUserRegistered:
+		return "user"
+	case DomainEventCategoryProductsChanged:
+		return "category"
+	default:
+		return ""
+	}
+}
+
+// OutboxEvent is a domain event waiting in the outbox to be published to the event broker
+type OutboxEvent struct {
+	ID            uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Type          DomainEventType `json:"type" gorm:"not null;index"`
+	Version       int             `json:"version" gorm:"not null;default:1"`
+	AggregateType string          `json:"aggregate_type" gorm:"not null"`
+	AggregateID   uuid.UUID       `json:"aggregate_id" gorm:"type:uuid;not null;index"`
+	Data          json.RawMessage `json:"data" gorm:"type:jsonb;serializer:json"`
+	OccurredAt    time.Time       `json:"occurred_at" gorm:"not null;index"`
+
+	// Delivery
+	PublishedAt *time.Time `json:"published_at" gorm:"index"`
+	Attempts    int        `json:"attempts" gorm:"default:0"`
+	LastError   string     `json:"last_error"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for OutboxEvent entity
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// NewOutboxEvent creates an outbox event holding the given event data
+func NewOutboxEvent(eventType DomainEventType, aggregateID uuid.UUID, data interface{}) (*OutboxEvent, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &OutboxEvent{
+		ID:            ids.New(),
+		Type:          eventType,
+		Version:       DomainEventSchemaVersion,
+		AggregateType: eventType.AggregateType(),
+		AggregateID:   aggregateID,
+		Data:          encoded,
+		OccurredAt:    now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// MarkFailed records a failed publish attempt
+func (e *OutboxEvent) MarkFailed(err error) {
+	e.Attempts++
+	e.LastError = err.Error()
+}
+
+// Envelope returns the message published for the event
+func (e *OutboxEvent) Envelope() DomainEventEnvelope {
+	return DomainEventEnvelope{
+		ID:            e.ID,
+		Type:          e.Type,
+		Version:       e.Version,
+		Source:        DomainEventSource,
+		OccurredAt:    e.OccurredAt,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		Data:          e.Data,
+	}
+}
+
+// DomainEventEnvelope is the message every published event is wrapped in. Consumers should
+// deduplicate on ID, since an event may be delivered more than once.
+type DomainEventEnvelope struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          DomainEventType `json:"type"`
+	Version       int             `json:"version"`
+	Source        string          `json:"source"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// OrderCreatedEventData is the data of an order.created event
+type OrderCreatedEventData struct {
+	OrderID        uuid.UUID                   `json:"order_id"`
+	OrderNumber    string                      `json:"order_number"`
+	UserID         uuid.UUID                   `json:"user_id"`
+	Status         OrderStatus                 `json:"status"`
+	PaymentStatus  PaymentStatus               `json:"payment_status"`
+	PaymentMethod  PaymentMethod               `json:"payment_method"`
+	Source         OrderSource                 `json:"source"`
+	Currency       string                      `json:"currency"`
+	Subtotal       float64                     `json:"subtotal"`
+	TaxAmount      float64                     `json:"tax_amount"`
+	ShippingAmount float64                     `json:"shipping_amount"`
+	DiscountAmount float64                     `json:"discount_amount"`
+	Total          float64                     `json:"total"`
+	Items          []OrderCreatedEventDataItem `json:"items"`
+	CreatedAt      time.Time                   `json:"created_at"`
+}
+
+// OrderCreatedEventDataItem is an item of an order.created event
+type OrderCreatedEventDataItem struct {
+	ProductID   uuid.UUID `json:"product_id"`
+	ProductSKU  string    `json:"product_sku"`
+	ProductName string    `json:"product_name"`
+	Quantity    int       `json:"quantity"`
+	Price       float64   `json:"price"`
+	Total       float64   `json:"total"`
+}
+
+// NewOrderCreatedEventData creates the data of an order.created event
+func NewOrderCreatedEventData(order *Order) OrderCreatedEventData {
+	data := OrderCreatedEventData{
+		OrderID:        order.ID,
+		OrderNumber:    order.OrderNumber,
+		UserID:         order.UserID,
+		Status:         order.Status,
+		PaymentStatus:  order.PaymentStatus,
+		PaymentMethod:  order.PaymentMethod,
+		Source:         order.Source,
+		Currency:       order.Currency,
+		Subtotal:       order.Subtotal,
+		TaxAmount:      order.TaxAmount,
+		ShippingAmount: order.ShippingAmount,
+		DiscountAmount: order.DiscountAmount,
+		Total:          order.Total,
+		Items:          make([]OrderCreatedEventDataItem, len(order.Items)),
+		CreatedAt:      order.CreatedAt,
+	}
+	for i, item := range order.Items {
+		data.Items[i] = OrderCreatedEventDataItem{
+			ProductID:   item.ProductID,
+			ProductSKU:  item.ProductSKU,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Price:       item.Price,
+			Total:       item.Total,
+		}
+	}
+	return data
+}
+
+// PaymentCapturedEventData is the data of a payment.captured event
+type PaymentCapturedEventData struct {
+	PaymentID     uuid.UUID     `json:"payment_id"`
+	OrderID       uuid.UUID     `json:"order_id"`
+	UserID        uuid.UUID     `json:"user_id"`
+	Amount        float64       `json:"amount"`
+	Currency      string        `json:"currency"`
+	Method        PaymentMethod `json:"method"`
+	TransactionID string        `json:"transaction_id"`
+	CapturedAt    time.Time     `json:"captured_at"`
+}
+
+// NewPaymentCapturedEventData creates the data of a payment.captured event
+func NewPaymentCapturedEventData(payment *Payment) PaymentCapturedEventData {
+	capturedAt := payment.UpdatedAt
+	if payment.ProcessedAt != nil {
+		capturedAt = *payment.ProcessedAt
+	}
+	return PaymentCapturedEventData{
+		PaymentID:     payment.ID,
+		OrderID:       payment.OrderID,
+		UserID:        payment.UserID,
+		Amount:        payment.Amount,
+		Currency:      payment.Currency,
+		Method:        payment.Method,
+		TransactionID: payment.TransactionID,
+		CapturedAt:    capturedAt,
+	}
+}
+
+// StockChangedEventData is the data of a stock.changed event
+type StockChangedEventData struct {
+	ProductID     uuid.UUID   `json:"product_id"`
+	PreviousStock int         `json:"previous_stock"`
+	Stock         int         `json:"stock"`
+	StockStatus   StockStatus `json:"stock_status"`
+	ChangedAt     time.Time   `json:"changed_at"`
+}
+
+// UserRegisteredEventData is the data of a user.registered event
+type UserRegisteredEventData struct {
+	UserID       uuid.UUID `json:"user_id"`
+	Email        string    `json:"email"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	Role         UserRole  `json:"role"`
+	Provider     string    `json:"provider"` // email, google or facebook
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+// NewUserRegisteredEventData creates the data of a user.registered event
+func NewUserRegisteredEventData(user *User, provider string) UserRegisteredEventData {
+	return UserRegisteredEventData{
+		UserID:       user.ID,
+		Email:        user.Email,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		Role:         user.Role,
+		Provider:     provider,
+		RegisteredAt: user.CreatedAt,
+	}
+}
+
+// CategoryProductsChangedEventData is the data of a category.products_changed event
+type CategoryProductsChangedEventData struct {
+	AssignmentID     uuid.UUID                `json:"assignment_id"`
+	Action           CategoryAssignmentAction `json:"action"`
+	CategoryID       uuid.UUID                `json:"category_id"`
+	SourceCategoryID *uuid.UUID               `json:"source_category_id,omitempty"`
+	ProductIDs       []uuid.UUID              `json:"product_ids"`
+	ChangedAt        time.Time                `json:"changed_at"`
+}
+
+// NewCategoryProductsChangedEventData creates the data of a category.products_changed event
+func NewCategoryProductsChangedEventData(assignment *CategoryAssignment, productIDs []uuid.UUID) CategoryProductsChangedEventData {
+	changedAt := assignment.UpdatedAt
+	if assignment.CompletedAt != nil {
+		changedAt = *assignment.CompletedAt
+	}
+	return CategoryProductsChangedEventData{
+		AssignmentID:     assignment.ID,
+		Action:           assignment.Action,
+		CategoryID:       assignment.CategoryID,
+		SourceCategoryID: assignment.SourceCategoryID,
+		ProductIDs:       productIDs,
+		ChangedAt:        changedAt,
+	}
+}
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Partner is an affiliate or price-comparison engine kept in sync with the catalog's prices and
+// availability, by webhook and by reading the feed with its API keys
+type Partner struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name         string    `json:"name" gorm:"not null"`
+	ContactEmail string    `json:"contact_email"`
+	IsActive     bool      `json:"is_active" gorm:"default:true"`
+
+	// Webhook delivery; changes are only pushed when a URL is set
+	WebhookURL         string `json:"webhook_url"`
+	WebhookSecret      string `json:"-"`
+	BatchSize          int    `json:"batch_size" gorm:"default:100"`          // Most changes sent per delivery
+	MinIntervalSeconds int    `json:"min_interval_seconds" gorm:"default:60"` // Least time between two deliveries
+
+	// Delivery state
+	Cursor           int64      `json:"cursor" gorm:"not null;default:0"` // Sequence of the last change delivered
+	NextDeliveryAt   *time.Time `json:"next_delivery_at" gorm:"index"`
+	LastDeliveredAt  *time.Time `json:"last_delivered_at"`
+	FailedDeliveries int        `json:"failed_deliveries" gorm:"default:0"` // Consecutive failed deliveries
+	LastError        string     `json:"last_error"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for Partner entity
+func (Partner) TableName() string {
+	return "partners"
+}
+
+// HasWebhook checks if changes are pushed to the partner
+func (p *Partner) HasWebhook() bool {
+	return p.WebhookURL != ""
+}
+
+// PartnerFeedChangeType is what changed about a product
+type PartnerFeedChangeType string
+
+const (
+	PartnerFeedChangePrice        PartnerFeedChangeType = "price"
+	PartnerFeedChangeAvailability PartnerFeedChangeType = "availability"
+)
+
+// PartnerAvailability is whether partners can send shoppers to buy a product
+type PartnerAvailability string
+
+const (
+	PartnerAvailabilityInStock     PartnerAvailability = "in_stock"
+	PartnerAvailabilityOutOfStock  PartnerAvailability = "out_of_stock"
+	PartnerAvailabilityBackorder   PartnerAvailability = "backorder"
+	PartnerAvailabilityUnavailable PartnerAvailability = "unavailable" // Not sold or not listed
+)
+
+// PartnerAvailabilityOf returns a product's availability as partners see it. Low stock is still
+// in stock, so stock moving within it is no change to them.
+func PartnerAvailabilityOf(product *Product) PartnerAvailability {
+	if product.Status != ProductStatusActive || product.Visibility != ProductVisibilityVisible {
+		return PartnerAvailabilityUnavailable
+	}
+	switch product.StockStatus {
+	case StockStatusOutOfStock:
+		return PartnerAvailabilityOutOfStock
+	case StockStatusOnBackorder:
+		return PartnerAvailabilityBackorder
+	default:
+		return PartnerAvailabilityInStock
+	}
+}
+
+// PartnerFeedChange is a change of a product's price or availability, in the order changes were
+// recorded. Only the values of its type are set.
+type PartnerFeedChange struct {
+	Sequence             int64                 `json:"sequence" gorm:"primaryKey;autoIncrement"`
+	ProductID            uuid.UUID             `json:"product_id" gorm:"type:uuid;not null;index"`
+	SKU                  string                `json:"sku"`
+	Type                 PartnerFeedChangeType `json:"type" gorm:"not null"`
+	Price                *float64              `json:"price,omitempty"`         // Regular price
+	CurrentPrice         *float64              `json:"current_price,omitempty"` // Price shoppers pay, the sale price while on sale
+	PreviousCurrentPrice *float64              `json:"previous_current_price,omitempty"`
+	Availability         PartnerAvailability   `json:"availability,omitempty"`
+	PreviousAvailability PartnerAvailability   `json:"previous_availability,omitempty"`
+	ChangedAt            time.Time             `json:"changed_at" gorm:"not null;index"`
+}
+
+// TableName returns the table name for PartnerFeedChange entity
+func (PartnerFeedChange) TableName() string {
+	return "partner_feed_changes"
+}
+
+// NewPartnerPriceChange records that a product's price changed from previous
+func NewPartnerPriceChange(product *Product, previous float64, at time.Time) *PartnerFeedChange {
+	price, current := product.Price, product.GetCurrentPrice()
+	return &PartnerFeedChange{
+		ProductID:            product.ID,
+		SKU:                  product.SKU,
+		Type:                 PartnerFeedChangePrice,
+		Price:                &price,
+		CurrentPrice:         &current,
+		PreviousCurrentPrice: &previous,
+		ChangedAt:            at,
+	}
+}
+
+// NewPartnerAvailabilityChange records that a product's availability changed from previous
+func NewPartnerAvailabilityChange(product *Product, previous PartnerAvailability, at time.Time) *PartnerFeedChange {
+	return &PartnerFeedChange{
+		ProductID:            product.ID,
+		SKU:                  product.SKU,
+		Type:                 PartnerFeedChangeAvailability,
+		Availability:         PartnerAvailabilityOf(product),
+		PreviousAvailability: previous,
+		ChangedAt:            at,
+	}
+}
+
+// GeneratePartnerWebhookSecret returns a random secret partner webhooks are signed with
+func GeneratePartnerWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SignPartnerWebhook returns the X-Feed-Signature header of a webhook body sent at a time:
+// "t=<unix>,v1=<hex HMAC-SHA256 of "<unix>.<body>">". Partners recompute it with their webhook
+// secret and reject old timestamps to stop replays.
+func SignPartnerWebhook(secret string, body []byte, at time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", at.Unix())
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", at.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
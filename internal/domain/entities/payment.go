@@ -20,6 +20,7 @@ const (
 	PaymentMethodGooglePay    PaymentMethod = "google_pay"
 	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
 	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodInvoice      PaymentMethod = "invoice" // Net payment terms, settled against a company invoice
 )
 
 // PaymentStatus represents the payment status
@@ -128,6 +129,7 @@ func (p *Payment) Validate() error {
 		PaymentMethodGooglePay,
 		PaymentMethodBankTransfer,
 		PaymentMethodCash,
+		PaymentMethodInvoice,
 	}
 
 	isValidMethod := false
@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentLinkStatus represents the lifecycle state of a payment link
+type PaymentLinkStatus string
+
+const (
+	PaymentLinkStatusActive    PaymentLinkStatus = "active"    // Can be opened and paid
+	PaymentLinkStatusPaid      PaymentLinkStatus = "paid"      // Paid once; the link can't be used again
+	PaymentLinkStatusExpired   PaymentLinkStatus = "expired"   // Lapsed before it was paid
+	PaymentLinkStatusCancelled PaymentLinkStatus = "cancelled" // Withdrawn by an admin
+)
+
+// DefaultPaymentLinkValidityHours is used when a link is created without an explicit expiry
+const DefaultPaymentLinkValidityHours = 72
+
+var paymentLinkPhoneRegex = regexp.MustCompile(`^\+?[0-9]{8,15}$`)
+
+// PaymentLink is a single-use link to a hosted payment page, either for an existing order or for
+// an arbitrary amount. The customer opens it without signing in, so it is only addressed by its
+// random token.
+type PaymentLink struct {
+	ID            uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Token         string            `json:"-" gorm:"uniqueIndex;not null"`
+	OrderID       *uuid.UUID        `json:"order_id" gorm:"type:uuid;index"`
+	QuoteID       *uuid.UUID        `json:"quote_id" gorm:"type:uuid;index"`
+	Amount        float64           `json:"amount" gorm:"not null"`
+	Currency      string            `json:"currency" gorm:"default:'USD'"`
+	Description   string            `json:"description" gorm:"type:text"`
+	CustomerEmail string            `json:"customer_email"`
+	CustomerPhone string            `json:"customer_phone"`
+	Status        PaymentLinkStatus `json:"status" gorm:"not null;default:'active';index"`
+	ExpiresAt     time.Time         `json:"expires_at" gorm:"not null;index"`
+
+	// Stripe checkout session opened from the link. A new session is only opened once the
+	// previous one has lapsed.
+	StripeSessionID   string     `json:"stripe_session_id" gorm:"index"`
+	CheckoutURL       string     `json:"-"`
+	CheckoutStartedAt *time.Time `json:"checkout_started_at"`
+
+	PaidAt      *time.Time `json:"paid_at"`
+	CancelledAt *time.Time `json:"cancelled_at"`
+	LastSentAt  *time.Time `json:"last_sent_at"`
+	CreatedBy   uuid.UUID  `json:"created_by" gorm:"type:uuid;index"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for PaymentLink entity
+func (PaymentLink) TableName() string {
+	return "payment_links"
+}
+
+// IsExpired checks if the link has passed its expiry
+func (l *PaymentLink) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
+
+// CheckPayable returns why the link can't be paid, or nil if it can
+func (l *PaymentLink) CheckPayable(now time.Time) error {
+	switch l.Status {
+	case PaymentLinkStatusPaid:
+		return ErrPaymentLinkUsed
+	case PaymentLinkStatusCancelled:
+		return ErrPaymentLinkCancelled
+	case PaymentLinkStatusExpired:
+		return ErrPaymentLinkExpired
+	}
+	if l.IsExpired(now) {
+		return ErrPaymentLinkExpired
+	}
+	return nil
+}
+
+// ValidatePaymentLinkRecipients validates the email address and phone number a link is sent to.
+// Both are optional.
+func ValidatePaymentLinkRecipients(email, phone string) error {
+	if email != "" && !emailAddressRegex.MatchString(email) {
+		return fmt.Errorf("invalid customer email format")
+	}
+	if phone != "" && !paymentLinkPhoneRegex.MatchString(phone) {
+		return fmt.Errorf("invalid customer phone format")
+	}
+	return nil
+}
+
+// GeneratePaymentLinkToken returns a random URL-safe token for a payment link
+func GeneratePaymentLinkToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,287 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriceChangeSource represents what triggered a price change
+type PriceChangeSource string
+
+const (
+	PriceChangeSourceManual    PriceChangeSource = "manual"
+	PriceChangeSourceScheduled PriceChangeSource = "scheduled"
+	PriceChangeSourceSystem    PriceChangeSource = "system"
+	PriceChangeSourceBulk      PriceChangeSource = "bulk"
+	PriceChangeSourceChangeset PriceChangeSource = "changeset"
+)
+
+// ScheduledPriceChangeStatus represents the status of a scheduled price change
+type ScheduledPriceChangeStatus string
+
+const (
+	ScheduledPriceChangeStatusPending   ScheduledPriceChangeStatus = "pending"
+	ScheduledPriceChangeStatusApplied   ScheduledPriceChangeStatus = "applied"
+	ScheduledPriceChangeStatusCancelled ScheduledPriceChangeStatus = "cancelled"
+	ScheduledPriceChangeStatusFailed    ScheduledPriceChangeStatus = "failed"
+)
+
+// PriceReferenceWindow is the look-back window used for "was" price claims.
+// EU Omnibus Directive requires the prior price to be the lowest price applied
+// during at least the 30 days before a price reduction.
+const PriceReferenceWindow = 30 * 24 * time.Hour
+
+// PriceSnapshot captures the pricing fields of a product at a point in time
+type PriceSnapshot struct {
+	Price         float64    `json:"price"`
+	SalePrice     *float64   `json:"sale_price"`
+	SaleStartDate *time.Time `json:"sale_start_date"`
+	SaleEndDate   *time.Time `json:"sale_end_date"`
+}
+
+// NewPriceSnapshot creates a price snapshot from a product
+func NewPriceSnapshot(p *Product) PriceSnapshot {
+	return PriceSnapshot{
+		Price:         p.Price,
+		SalePrice:     copyFloatPtr(p.SalePrice),
+		SaleStartDate: copyTimePtr(p.SaleStartDate),
+		SaleEndDate:   copyTimePtr(p.SaleEndDate),
+	}
+}
+
+// Equal checks if two snapshots have the same pricing
+func (s PriceSnapshot) Equal(other PriceSnapshot) bool {
+	return s.Price == other.Price &&
+		floatPtrEqual(s.SalePrice, other.SalePrice) &&
+		timePtrEqual(s.SaleStartDate, other.SaleStartDate) &&
+		timePtrEqual(s.SaleEndDate, other.SaleEndDate)
+}
+
+// EffectivePriceAt returns the price a customer pays at the given time
+func (s PriceSnapshot) EffectivePriceAt(at time.Time) float64 {
+	if s.SalePrice == nil || *s.SalePrice <= 0 || *s.SalePrice >= s.Price {
+		return s.Price
+	}
+	if s.SaleStartDate != nil && at.Before(*s.SaleStartDate) {
+		return s.Price
+	}
+	if s.SaleEndDate != nil && at.After(*s.SaleEndDate) {
+		return s.Price
+	}
+	return *s.SalePrice
+}
+
+// PriceHistory is an immutable log entry for a product price change
+type PriceHistory struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProductID uuid.UUID  `json:"product_id" gorm:"type:uuid;not null;index"`
+	ChangedBy *uuid.UUID `json:"changed_by" gorm:"type:uuid;index"`
+
+	// Previous values
+	OldPrice         float64    `json:"old_price"`
+	OldSalePrice     *float64   `json:"old_sale_price"`
+	OldSaleStartDate *time.Time `json:"old_sale_start_date"`
+	OldSaleEndDate   *time.Time `json:"old_sale_end_date"`
+
+	// New values
+	NewPrice         float64    `json:"new_price"`
+	NewSalePrice     *float64   `json:"new_sale_price"`
+	NewSaleStartDate *time.Time `json:"new_sale_start_date"`
+	NewSaleEndDate   *time.Time `json:"new_sale_end_date"`
+
+	// Effective (customer-facing) prices at the moment of the change
+	OldEffectivePrice float64 `json:"old_effective_price"`
+	NewEffectivePrice float64 `json:"new_effective_price"`
+
+	Source            PriceChangeSource `json:"source" gorm:"not null;index"`
+	Reason            string            `json:"reason" gorm:"type:text"`
+	ScheduledChangeID *uuid.UUID        `json:"scheduled_change_id" gorm:"type:uuid;index"`
+	BulkUpdateID      *uuid.UUID        `json:"bulk_update_id" gorm:"type:uuid;index"`
+	CreatedAt         time.Time         `json:"created_at" gorm:"autoCreateTime;index"`
+
+	// Relationships
+	Product *Product `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+	User    *User    `json:"user,omitempty" gorm:"foreignKey:ChangedBy"`
+}
+
+// TableName returns the table name for PriceHistory entity
+func (PriceHistory) TableName() string {
+	return "price_histories"
+}
+
+// NewPriceHistory builds a history entry for a change between two snapshots
+func NewPriceHistory(productID uuid.UUID, before, after PriceSnapshot, changedBy *uuid.UUID, source PriceChangeSource, reason string) *PriceHistory {
+	now := time.Now()
+	return &PriceHistory{
+		ProductID:         productID,
+		ChangedBy:         changedBy,
+		OldPrice:          before.Price,
+		OldSalePrice:      before.SalePrice,
+		OldSaleStartDate:  before.SaleStartDate,
+		OldSaleEndDate:    before.SaleEndDate,
+		NewPrice:          after.Price,
+		NewSalePrice:      after.SalePrice,
+		NewSaleStartDate:  after.SaleStartDate,
+		NewSaleEndDate:    after.SaleEndDate,
+		OldEffectivePrice: before.EffectivePriceAt(now),
+		NewEffectivePrice: after.EffectivePriceAt(now),
+		Source:            source,
+		Reason:            reason,
+	}
+}
+
+// OldSnapshot returns the pricing before the change
+func (h *PriceHistory) OldSnapshot() PriceSnapshot {
+	return PriceSnapshot{
+		Price:         h.OldPrice,
+		SalePrice:     h.OldSalePrice,
+		SaleStartDate: h.OldSaleStartDate,
+		SaleEndDate:   h.OldSaleEndDate,
+	}
+}
+
+// NewSnapshot returns the pricing after the change
+func (h *PriceHistory) NewSnapshot() PriceSnapshot {
+	return PriceSnapshot{
+		Price:         h.NewPrice,
+		SalePrice:     h.NewSalePrice,
+		SaleStartDate: h.NewSaleStartDate,
+		SaleEndDate:   h.NewSaleEndDate,
+	}
+}
+
+// ScheduledPriceChange represents a future price change for a product
+type ScheduledPriceChange struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProductID uuid.UUID `json:"product_id" gorm:"type:uuid;not null;index"`
+
+	// Target pricing - nil fields are left unchanged
+	NewPrice      *float64   `json:"new_price"`
+	NewSalePrice  *float64   `json:"new_sale_price"`
+	SaleStartDate *time.Time `json:"sale_start_date"`
+	SaleEndDate   *time.Time `json:"sale_end_date"`
+	ClearSale     bool       `json:"clear_sale" gorm:"default:false"`
+
+	EffectiveAt  time.Time                  `json:"effective_at" gorm:"not null;index"`
+	Status       ScheduledPriceChangeStatus `json:"status" gorm:"default:'pending';index"`
+	Reason       string                     `json:"reason" gorm:"type:text"`
+	CreatedBy    *uuid.UUID                 `json:"created_by" gorm:"type:uuid;index"`
+	CancelledBy  *uuid.UUID                 `json:"cancelled_by" gorm:"type:uuid"`
+	AppliedAt    *time.Time                 `json:"applied_at"`
+	CancelledAt  *time.Time                 `json:"cancelled_at"`
+	ErrorMessage string                     `json:"error_message" gorm:"type:text"`
+	CreatedAt    time.Time                  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time                  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	Product *Product `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+}
+
+// TableName returns the table name for ScheduledPriceChange entity
+func (ScheduledPriceChange) TableName() string {
+	return "scheduled_price_changes"
+}
+
+// IsPending checks if the scheduled change has not been applied or cancelled
+func (s *ScheduledPriceChange) IsPending() bool {
+	return s.Status == ScheduledPriceChangeStatusPending
+}
+
+// IsDue checks if the scheduled change should be applied at the given time
+func (s *ScheduledPriceChange) IsDue(at time.Time) bool {
+	return s.IsPending() && !s.EffectiveAt.After(at)
+}
+
+// Validate validates the scheduled price change
+func (s *ScheduledPriceChange) Validate() error {
+	if s.ProductID == uuid.Nil {
+		return fmt.Errorf("product ID is required")
+	}
+	if s.NewPrice == nil && s.NewSalePrice == nil && s.SaleStartDate == nil && s.SaleEndDate == nil && !s.ClearSale {
+		return fmt.Errorf("at least one price field must be set")
+	}
+	if s.NewPrice != nil && *s.NewPrice <= 0 {
+		return fmt.Errorf("new price must be greater than 0")
+	}
+	if s.NewSalePrice != nil && *s.NewSalePrice <= 0 {
+		return fmt.Errorf("new sale price must be greater than 0")
+	}
+	if s.ClearSale && s.NewSalePrice != nil {
+		return fmt.Errorf("cannot set a sale price and clear the sale in the same change")
+	}
+	if s.SaleStartDate != nil && s.SaleEndDate != nil && s.SaleStartDate.After(*s.SaleEndDate) {
+		return fmt.Errorf("sale start date must be before sale end date")
+	}
+	if s.EffectiveAt.IsZero() {
+		return fmt.Errorf("effective date is required")
+	}
+	return nil
+}
+
+// ApplyTo applies the scheduled pricing to a product
+func (s *ScheduledPriceChange) ApplyTo(p *Product) error {
+	if s.NewPrice != nil {
+		p.Price = *s.NewPrice
+	}
+	if s.ClearSale {
+		p.ClearSale()
+	}
+	if s.NewSalePrice != nil {
+		p.SalePrice = copyFloatPtr(s.NewSalePrice)
+	}
+	if s.SaleStartDate != nil {
+		p.SaleStartDate = copyTimePtr(s.SaleStartDate)
+	}
+	if s.SaleEndDate != nil {
+		p.SaleEndDate = copyTimePtr(s.SaleEndDate)
+	}
+	if err := p.ValidateSalePricing(); err != nil {
+		return err
+	}
+	return p.ValidateMinAdvertisedPrice()
+}
+
+// LowestPriceInWindow returns the lowest effective price applied between since and now.
+// history must be ordered by CreatedAt ascending; priceAtStart is the effective price
+// in force at the beginning of the window.
+func LowestPriceInWindow(history []*PriceHistory, priceAtStart float64) float64 {
+	lowest := priceAtStart
+	for _, h := range history {
+		if h.NewEffectivePrice > 0 && h.NewEffectivePrice < lowest {
+			lowest = h.NewEffectivePrice
+		}
+	}
+	return lowest
+}
+
+func copyFloatPtr(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+func copyTimePtr(v *time.Time) *time.Time {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+func floatPtrEqual(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -11,11 +11,32 @@ import (
 type ProductStatus string
 
 const (
-	ProductStatusActive   ProductStatus = "active"
-	ProductStatusInactive ProductStatus = "inactive"
-	ProductStatusDraft    ProductStatus = "draft"
+	ProductStatusActive       ProductStatus = "active"
+	ProductStatusInactive     ProductStatus = "inactive"
+	ProductStatusDraft        ProductStatus = "draft"
+	ProductStatusDiscontinued ProductStatus = "discontinued" // No longer sold; hidden from listings and search but its page stays reachable
+	ProductStatusArchived     ProductStatus = "archived"     // Retired from the catalog; kept for order history
 )
 
+// DelistedProductStatuses are the lifecycle states excluded from listings and search by default
+var DelistedProductStatuses = []ProductStatus{ProductStatusDiscontinued, ProductStatusArchived}
+
+// productStatusTransitions lists the allowed lifecycle moves:
+// draft → active → discontinued → archived, with inactive as a temporary pause
+var productStatusTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusDraft:        {ProductStatusActive, ProductStatusInactive, ProductStatusArchived},
+	ProductStatusActive:       {ProductStatusInactive, ProductStatusDiscontinued},
+	ProductStatusInactive:     {ProductStatusActive, ProductStatusDraft, ProductStatusDiscontinued, ProductStatusArchived},
+	ProductStatusDiscontinued: {ProductStatusActive, ProductStatusArchived},
+	ProductStatusArchived:     {ProductStatusDraft},
+}
+
+// IsValidProductStatus checks if a product status is known
+func IsValidProductStatus(status ProductStatus) bool {
+	_, exists := productStatusTransitions[status]
+	return exists
+}
+
 // ProductVisibility represents the visibility of a product
 type ProductVisibility string
 
@@ -45,6 +66,24 @@ const (
 	StockStatusLowStock    StockStatus = "low_stock"
 )
 
+// StockVisibility represents how much of a product's stock level customers are shown
+type StockVisibility string
+
+const (
+	StockVisibilityExact    StockVisibility = "exact"     // The quantity is always shown
+	StockVisibilityLowStock StockVisibility = "low_stock" // The quantity is shown only as "only X left" at or below the display threshold
+	StockVisibilityHidden   StockVisibility = "hidden"    // Only whether the product can be bought is shown
+)
+
+// IsValidStockVisibility checks if a stock visibility is known
+func IsValidStockVisibility(visibility StockVisibility) bool {
+	switch visibility {
+	case StockVisibilityExact, StockVisibilityLowStock, StockVisibilityHidden:
+		return true
+	}
+	return false
+}
+
 // Product represents a product in the system
 type Product struct {
 	ID               uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -66,6 +105,9 @@ type Product struct {
 	ComparePrice *float64 `json:"compare_price" validate:"omitempty,gt=0"`
 	CostPrice    *float64 `json:"cost_price" validate:"omitempty,gt=0"`
 
+	// Minimum Advertised Price (MAP) floor - no advertised price may go below it
+	MinAdvertisedPrice *float64 `json:"min_advertised_price" validate:"omitempty,gt=0"`
+
 	// Sale Pricing
 	SalePrice     *float64   `json:"sale_price" validate:"omitempty,gt=0"`
 	SaleStartDate *time.Time `json:"sale_start_date"`
@@ -78,6 +120,10 @@ type Product struct {
 	AllowBackorder    bool        `json:"allow_backorder" gorm:"default:false"`
 	StockStatus       StockStatus `json:"stock_status" gorm:"default:'in_stock'"`
 
+	// Stock shown to customers - staff always see the exact stock
+	StockVisibility       StockVisibility `json:"stock_visibility" gorm:"default:'exact'"`
+	StockDisplayThreshold *int            `json:"stock_display_threshold"` // "Only X left" is shown at or below it; LowStockThreshold when unset
+
 	// Physical Properties
 	Weight     *float64    `json:"weight" validate:"omitempty,gt=0"`
 	Dimensions *Dimensions `json:"dimensions" gorm:"embedded"`
@@ -91,11 +137,19 @@ type Product struct {
 	// Categorization - CategoryID removed, use ProductCategory many-to-many as single source of truth
 	BrandID    *uuid.UUID `json:"brand_id" gorm:"type:uuid;index"`
 
+	// Marketplace - products without a vendor are sold by the store itself
+	VendorID *uuid.UUID `json:"vendor_id,omitempty" gorm:"type:uuid;index"`
+
 	// Status and Type
 	Status      ProductStatus `json:"status" gorm:"default:'draft'" validate:"required"`
 	ProductType ProductType   `json:"product_type" gorm:"default:'simple'" validate:"required"`
 	IsDigital   bool          `json:"is_digital" gorm:"default:false"`
 
+	// Lifecycle
+	ReplacementProductID *uuid.UUID `json:"replacement_product_id" gorm:"type:uuid"` // Suggested instead of a discontinued product
+	DiscontinuedAt       *time.Time `json:"discontinued_at"`
+	ArchivedAt           *time.Time `json:"archived_at"`
+
 	// Timestamps
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
@@ -495,6 +549,45 @@ func (p *Product) IsLowStock() bool {
 	return p.Stock <= p.LowStockThreshold && p.Stock > 0
 }
 
+// StockDisclosure is what customers are shown of a stock level
+type StockDisclosure struct {
+	Quantity   *int   // Nil when the quantity is withheld
+	IsLowStock bool   // Whether customers are told the stock is running low
+	Message    string // Urgency message such as "Only 3 left in stock"
+}
+
+// DiscloseStock works out what customers are shown of a stock level under a stock visibility.
+// threshold is the level at or below which the stock is announced as running low.
+func DiscloseStock(visibility StockVisibility, stock, threshold int, trackQuantity bool) StockDisclosure {
+	if visibility == StockVisibilityHidden {
+		return StockDisclosure{}
+	}
+
+	var disclosure StockDisclosure
+	if trackQuantity && stock > 0 && stock <= threshold {
+		disclosure.IsLowStock = true
+		disclosure.Message = fmt.Sprintf("Only %d left in stock", stock)
+	}
+	if visibility != StockVisibilityLowStock || disclosure.IsLowStock {
+		quantity := stock
+		disclosure.Quantity = &quantity
+	}
+	return disclosure
+}
+
+// GetStockDisplayThreshold returns the stock level at or below which customers are told "only X left"
+func (p *Product) GetStockDisplayThreshold() int {
+	if p.StockDisplayThreshold != nil {
+		return *p.StockDisplayThreshold
+	}
+	return p.LowStockThreshold
+}
+
+// DiscloseStock works out what customers are shown of the product's stock
+func (p *Product) DiscloseStock() StockDisclosure {
+	return DiscloseStock(p.StockVisibility, p.Stock, p.GetStockDisplayThreshold(), p.TrackQuantity)
+}
+
 // UpdateStockStatus updates the stock status based on current stock level
 func (p *Product) UpdateStockStatus() {
 	if !p.TrackQuantity {
@@ -520,6 +613,51 @@ func (p *Product) IsVisible() bool {
 	return p.Status == ProductStatusActive && p.Visibility == ProductVisibilityVisible
 }
 
+// IsDelisted checks if the product has been discontinued or archived
+func (p *Product) IsDelisted() bool {
+	return p.Status == ProductStatusDiscontinued || p.Status == ProductStatusArchived
+}
+
+// CanTransitionTo checks if the product can move to the given lifecycle status
+func (p *Product) CanTransitionTo(status ProductStatus) bool {
+	if p.Status == status {
+		return true
+	}
+	for _, allowed := range productStatusTransitions[p.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the product to a lifecycle status and records when it was discontinued or archived
+func (p *Product) TransitionTo(status ProductStatus, at time.Time) error {
+	if !IsValidProductStatus(status) {
+		return fmt.Errorf("invalid product status: %s", status)
+	}
+	if !p.CanTransitionTo(status) {
+		return fmt.Errorf("cannot change product status from %s to %s", p.Status, status)
+	}
+	if p.Status == status {
+		return nil
+	}
+
+	switch status {
+	case ProductStatusDiscontinued:
+		p.DiscontinuedAt = &at
+	case ProductStatusArchived:
+		p.ArchivedAt = &at
+	default:
+		// Returning to the catalog clears the retirement
+		p.DiscontinuedAt = nil
+		p.ArchivedAt = nil
+		p.ReplacementProductID = nil
+	}
+	p.Status = status
+	return nil
+}
+
 // HasVariants checks if the product has variants
 func (p *Product) HasVariants() bool {
 	return p.ProductType == ProductTypeVariable && len(p.Variants) > 0
@@ -595,6 +733,12 @@ func (p *Product) Validate() error {
 	if p.LowStockThreshold < 0 {
 		return fmt.Errorf("low stock threshold cannot be negative")
 	}
+	if p.StockVisibility != "" && !IsValidStockVisibility(p.StockVisibility) {
+		return fmt.Errorf("invalid stock visibility: %s", p.StockVisibility)
+	}
+	if p.StockDisplayThreshold != nil && *p.StockDisplayThreshold < 0 {
+		return fmt.Errorf("stock display threshold cannot be negative")
+	}
 
 	// Validate weight
 	if p.Weight != nil && *p.Weight <= 0 {
@@ -613,6 +757,11 @@ func (p *Product) Validate() error {
 		return err
 	}
 
+	// Validate MAP floor
+	if err := p.ValidateMinAdvertisedPrice(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -640,3 +789,36 @@ func (p *Product) ValidateSalePricing() error {
 
 	return nil
 }
+
+// ValidateMinAdvertisedPrice validates that advertised prices respect the MAP floor
+func (p *Product) ValidateMinAdvertisedPrice() error {
+	if p.MinAdvertisedPrice == nil {
+		return nil
+	}
+
+	if *p.MinAdvertisedPrice <= 0 {
+		return fmt.Errorf("minimum advertised price must be greater than 0")
+	}
+
+	if p.Price < *p.MinAdvertisedPrice {
+		return fmt.Errorf("price %.2f is below minimum advertised price %.2f", p.Price, *p.MinAdvertisedPrice)
+	}
+
+	if p.SalePrice != nil && *p.SalePrice < *p.MinAdvertisedPrice {
+		return fmt.Errorf("sale price %.2f is below minimum advertised price %.2f", *p.SalePrice, *p.MinAdvertisedPrice)
+	}
+
+	return nil
+}
+
+// HasSaleEnded checks if the product still carries a sale whose window has closed
+func (p *Product) HasSaleEnded(at time.Time) bool {
+	return p.SalePrice != nil && p.SaleEndDate != nil && at.After(*p.SaleEndDate)
+}
+
+// ClearSale removes sale pricing and reverts the product to its regular price
+func (p *Product) ClearSale() {
+	p.SalePrice = nil
+	p.SaleStartDate = nil
+	p.SaleEndDate = nil
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProductTranslationStatus represents how a locale's translation compares to the product's source content
+type ProductTranslationStatus string
+
+const (
+	// ProductTranslationStatusMissing means the product has no translation in the locale; it is never stored
+	ProductTranslationStatusMissing  ProductTranslationStatus = "missing"
+	ProductTranslationStatusOutdated ProductTranslationStatus = "outdated"
+	ProductTranslationStatusComplete ProductTranslationStatus = "complete"
+)
+
+// ProductTranslation holds a product's name, descriptions and SEO fields in one locale.
+// Empty fields fall back to the source content when the product is read in that locale.
+type ProductTranslation struct {
+	ID               uuid.UUID                `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProductID        uuid.UUID                `json:"product_id" gorm:"type:uuid;not null;uniqueIndex:idx_product_translations_product_locale"`
+	Locale           string                   `json:"locale" gorm:"not null;size:16;uniqueIndex:idx_product_translations_product_locale;index"`
+	Name             string                   `json:"name"`
+	ShortDescription string                   `json:"short_description" gorm:"type:text"`
+	Description      string                   `json:"description" gorm:"type:text"`
+	MetaTitle        string                   `json:"meta_title"`
+	MetaDescription  string                   `json:"meta_description" gorm:"type:text"`
+	Keywords         string                   `json:"keywords"`
+	Status           ProductTranslationStatus `json:"status" gorm:"not null;index"`
+	// TranslatedAt is when the translation was last written; the status turns outdated when the source changes after it
+	TranslatedAt time.Time `json:"translated_at"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for ProductTranslation entity
+func (ProductTranslation) TableName() string {
+	return "product_translations"
+}
+
+// Validate validates the translation
+func (t *ProductTranslation) Validate() error {
+	if t.ProductID == uuid.Nil {
+		return fmt.Errorf("product ID is required")
+	}
+	if t.Locale == "" {
+		return fmt.Errorf("locale is required")
+	}
+	if t.IsEmpty() {
+		return fmt.Errorf("translation must have at least one translated field")
+	}
+	return nil
+}
+
+// IsEmpty checks if none of the fields are translated
+func (t *ProductTranslation) IsEmpty() bool {
+	return t.Name == "" && t.ShortDescription == "" && t.Description == "" &&
+		t.MetaTitle == "" && t.MetaDescription == "" && t.Keywords == ""
+}
+
+// ProductContent is the translatable source content of a product
+type ProductContent struct {
+	Name             string `json:"name"`
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	MetaTitle        string `json:"meta_title"`
+	MetaDescription  string `json:"meta_description"`
+	Keywords         string `json:"keywords"`
+}
+
+// TranslatableContent returns the product's source content that translations are written against
+func (p *Product) TranslatableContent() ProductContent {
+	return ProductContent{
+		Name:             p.Name,
+		ShortDescription: p.ShortDescription,
+		Description:      p.Description,
+		MetaTitle:        p.MetaTitle,
+		MetaDescription:  p.MetaDescription,
+		Keywords:         p.Keywords,
+	}
+}
+
+// NormalizeLocale lowercases a locale tag and uses "-" as the region separator, e.g. "pt_BR" becomes "pt-br"
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
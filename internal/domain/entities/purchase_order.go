@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PurchaseOrderStatus represents the status of a purchase order
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderStatusOpen      PurchaseOrderStatus = "open"      // Ordered from the supplier, not fully received yet
+	PurchaseOrderStatusReceived  PurchaseOrderStatus = "received"  // Every item was received
+	PurchaseOrderStatusCancelled PurchaseOrderStatus = "cancelled" // Won't be delivered
+)
+
+// PurchaseOrder represents stock ordered from a supplier
+type PurchaseOrder struct {
+	ID         uuid.UUID           `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Number     string              `json:"number" gorm:"uniqueIndex;not null"`
+	SupplierID uuid.UUID           `json:"supplier_id" gorm:"type:uuid;not null;index"`
+	Supplier   *Supplier           `json:"supplier,omitempty" gorm:"foreignKey:SupplierID"`
+	Status     PurchaseOrderStatus `json:"status" gorm:"not null;default:'open';index"`
+
+	OrderedAt  time.Time  `json:"ordered_at" gorm:"not null"`
+	ExpectedAt *time.Time `json:"expected_at"` // Delivery date the supplier confirmed; the supplier's lead time applies when unset
+	ReceivedAt *time.Time `json:"received_at"`
+	Notes      string     `json:"notes"`
+
+	Items []PurchaseOrderItem `json:"items,omitempty" gorm:"foreignKey:PurchaseOrderID"`
+
+	CreatedBy uuid.UUID `json:"created_by" gorm:"type:uuid"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for PurchaseOrder entity
+func (PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+// IsOpen checks if the purchase order is still awaiting delivery
+func (po *PurchaseOrder) IsOpen() bool {
+	return po.Status == PurchaseOrderStatusOpen
+}
+
+// ExpectedArrival returns the confirmed delivery date, or the order date plus the supplier's lead
+// time. The supplier must be loaded when no delivery date was confirmed.
+func (po *PurchaseOrder) ExpectedArrival() time.Time {
+	if po.ExpectedAt != nil {
+		return *po.ExpectedAt
+	}
+	leadTimeDays := 0
+	if po.Supplier != nil {
+		leadTimeDays = po.Supplier.LeadTimeDays
+	}
+	return po.OrderedAt.AddDate(0, 0, leadTimeDays)
+}
+
+// IsFullyReceived checks if every item was received in full
+func (po *PurchaseOrder) IsFullyReceived() bool {
+	for _, item := range po.Items {
+		if item.RemainingQuantity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates purchase order data
+func (po *PurchaseOrder) Validate() error {
+	if po.SupplierID == uuid.Nil {
+		return fmt.Errorf("supplier ID is required")
+	}
+	if len(po.Items) == 0 {
+		return fmt.Errorf("at least one item is required")
+	}
+	seen := make(map[uuid.UUID]bool, len(po.Items))
+	for _, item := range po.Items {
+		if item.ProductID == uuid.Nil {
+			return fmt.Errorf("product ID is required")
+		}
+		if seen[item.ProductID] {
+			return fmt.Errorf("product %s is listed more than once", item.ProductID)
+		}
+		seen[item.ProductID] = true
+		if item.Quantity <= 0 {
+			return fmt.Errorf("quantity must be positive")
+		}
+	}
+	if po.ExpectedAt != nil && po.ExpectedAt.Before(po.OrderedAt) {
+		return fmt.Errorf("expected date cannot be before the order date")
+	}
+	return nil
+}
+
+// PurchaseOrderItem represents a product ordered on a purchase order
+type PurchaseOrderItem struct {
+	ID               uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PurchaseOrderID  uuid.UUID `json:"purchase_order_id" gorm:"type:uuid;not null;index"`
+	ProductID        uuid.UUID `json:"product_id" gorm:"type:uuid;not null;index"`
+	Product          *Product  `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+	Quantity         int       `json:"quantity" gorm:"not null"`
+	ReceivedQuantity int       `json:"received_quantity" gorm:"default:0"`
+	UnitCost         float64   `json:"unit_cost" gorm:"default:0"`
+	CreatedAt        time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+
+	PurchaseOrder *PurchaseOrder `json:"-" gorm:"foreignKey:PurchaseOrderID"`
+}
+
+// TableName returns the table name for PurchaseOrderItem entity
+func (PurchaseOrderItem) TableName() string {
+	return "purchase_order_items"
+}
+
+// RemainingQuantity returns the quantity still to be received
+func (item *PurchaseOrderItem) RemainingQuantity() int {
+	if remaining := item.Quantity - item.ReceivedQuantity; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// RestockEstimate is when a product is expected back in stock, from its earliest open purchase order
+type RestockEstimate struct {
+	ProductID       uuid.UUID `json:"product_id"`
+	ExpectedAt      time.Time `json:"expected_at"`
+	Quantity        int       `json:"quantity"` // Units the purchase order brings
+	PurchaseOrderID uuid.UUID `json:"purchase_order_id"`
+}
+
+// Message returns the message shoppers see for the estimate
+func (e *RestockEstimate) Message() string {
+	return fmt.Sprintf("Back in stock around %s", e.ExpectedAt.Format("Jan 2"))
+}
+
+// BackInStockSubscription represents a customer asking to be told when a product is back in stock
+type BackInStockSubscription struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProductID  uuid.UUID  `json:"product_id" gorm:"type:uuid;not null;uniqueIndex:idx_back_in_stock_product_user,priority:1"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_back_in_stock_product_user,priority:2"`
+	NotifiedAt *time.Time `json:"notified_at" gorm:"index"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for BackInStockSubscription entity
+func (BackInStockSubscription) TableName() string {
+	return "back_in_stock_subscriptions"
+}
@@ -0,0 +1,219 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// QuoteStatus represents the lifecycle state of a quote request
+type QuoteStatus string
+
+const (
+	QuoteStatusRequested QuoteStatus = "requested" // Submitted by the customer, awaiting pricing
+	QuoteStatusQuoted    QuoteStatus = "quoted"    // Priced by an admin, awaiting customer decision
+	QuoteStatusAccepted  QuoteStatus = "accepted"  // Accepted by the customer, ready to be ordered
+	QuoteStatusRejected  QuoteStatus = "rejected"  // Declined by the customer
+	QuoteStatusExpired   QuoteStatus = "expired"   // Quoted prices lapsed before conversion
+	QuoteStatusConverted QuoteStatus = "converted" // Turned into an order
+	QuoteStatusCancelled QuoteStatus = "cancelled" // Withdrawn by the customer or declined by an admin
+)
+
+// DefaultQuoteValidityDays is used when an admin does not set an explicit expiry
+const DefaultQuoteValidityDays = 14
+
+// Quote represents a request for custom pricing on a large order
+type Quote struct {
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	QuoteNumber string      `json:"quote_number" gorm:"uniqueIndex;not null"`
+	UserID      uuid.UUID   `json:"user_id" gorm:"type:uuid;not null;index"`
+	User        *User       `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	CompanyID   *uuid.UUID  `json:"company_id" gorm:"type:uuid;index"`
+	Status      QuoteStatus `json:"status" gorm:"not null;default:'requested';index"`
+	Items       []QuoteItem `json:"items" gorm:"foreignKey:QuoteID"`
+
+	// ListSubtotal is the catalog value of the items when the quote was requested
+	ListSubtotal   float64 `json:"list_subtotal" gorm:"not null;default:0"`
+	QuotedSubtotal float64 `json:"quoted_subtotal" gorm:"not null;default:0"`
+	Currency       string  `json:"currency" gorm:"default:'USD'"`
+
+	CustomerNotes string     `json:"customer_notes" gorm:"type:text"`
+	AdminNotes    string     `json:"admin_notes" gorm:"type:text"`
+	RespondedBy   *uuid.UUID `json:"responded_by" gorm:"type:uuid"`
+	RespondedAt   *time.Time `json:"responded_at"`
+	ExpiresAt     *time.Time `json:"expires_at" gorm:"index"`
+	DecidedAt     *time.Time `json:"decided_at"`
+	OrderID       *uuid.UUID `json:"order_id" gorm:"type:uuid;index"`
+	ConvertedAt   *time.Time `json:"converted_at"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for Quote entity
+func (Quote) TableName() string {
+	return "quotes"
+}
+
+// QuoteItem represents a product line on a quote
+type QuoteItem struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	QuoteID     uuid.UUID `json:"quote_id" gorm:"type:uuid;not null;index"`
+	ProductID   uuid.UUID `json:"product_id" gorm:"type:uuid;not null;index"`
+	ProductName string    `json:"product_name" gorm:"not null"`
+	ProductSKU  string    `json:"product_sku"`
+	Quantity    int       `json:"quantity" gorm:"not null"`
+	ListPrice   float64   `json:"list_price" gorm:"not null"`
+	QuotedPrice float64   `json:"quoted_price" gorm:"not null;default:0"` // Unit price offered by the admin
+	Total       float64   `json:"total" gorm:"not null;default:0"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for QuoteItem entity
+func (QuoteItem) TableName() string {
+	return "quote_items"
+}
+
+// IsExpired checks if the quoted prices have lapsed
+func (q *Quote) IsExpired() bool {
+	if q.Status == QuoteStatusExpired {
+		return true
+	}
+	return q.ExpiresAt != nil && time.Now().After(*q.ExpiresAt)
+}
+
+// CanRespond checks if an admin can (re)price the quote
+func (q *Quote) CanRespond() bool {
+	return q.Status == QuoteStatusRequested || q.Status == QuoteStatusQuoted
+}
+
+// CanCancel checks if the quote can still be withdrawn
+func (q *Quote) CanCancel() bool {
+	return q.Status == QuoteStatusRequested || q.Status == QuoteStatusQuoted
+}
+
+// CanConvert checks if the quote can be turned into an order
+func (q *Quote) CanConvert() bool {
+	return q.Status == QuoteStatusAccepted && !q.IsExpired()
+}
+
+// Respond prices the quote. prices maps quote item IDs to quoted unit prices; every item must be priced.
+func (q *Quote) Respond(prices map[uuid.UUID]float64, expiresAt time.Time, adminNotes string, respondedBy uuid.UUID) error {
+	if !q.CanRespond() {
+		return fmt.Errorf("quote cannot be priced in %s status", q.Status)
+	}
+	if !expiresAt.After(time.Now()) {
+		return fmt.Errorf("quote expiry must be in the future")
+	}
+
+	for i := range q.Items {
+		price, ok := prices[q.Items[i].ID]
+		if !ok {
+			return fmt.Errorf("missing quoted price for %s", q.Items[i].ProductName)
+		}
+		if price <= 0 {
+			return fmt.Errorf("quoted price for %s must be greater than 0", q.Items[i].ProductName)
+		}
+		q.Items[i].QuotedPrice = price
+	}
+	q.RecalculateTotals()
+
+	now := time.Now()
+	q.Status = QuoteStatusQuoted
+	q.AdminNotes = adminNotes
+	q.RespondedBy = &respondedBy
+	q.RespondedAt = &now
+	q.ExpiresAt = &expiresAt
+	return nil
+}
+
+// Accept records the customer's acceptance of the quoted prices
+func (q *Quote) Accept() error {
+	if q.Status != QuoteStatusQuoted {
+		return fmt.Errorf("only quoted quotes can be accepted")
+	}
+	if q.IsExpired() {
+		return ErrQuoteExpired
+	}
+	now := time.Now()
+	q.Status = QuoteStatusAccepted
+	q.DecidedAt = &now
+	return nil
+}
+
+// Reject records the customer declining the quoted prices
+func (q *Quote) Reject() error {
+	if q.Status != QuoteStatusQuoted {
+		return fmt.Errorf("only quoted quotes can be rejected")
+	}
+	now := time.Now()
+	q.Status = QuoteStatusRejected
+	q.DecidedAt = &now
+	return nil
+}
+
+// RecalculateTotals recomputes line totals and the quote subtotals
+func (q *Quote) RecalculateTotals() {
+	q.ListSubtotal = 0
+	q.QuotedSubtotal = 0
+	for i := range q.Items {
+		item := &q.Items[i]
+		q.ListSubtotal += item.ListPrice * float64(item.Quantity)
+		if item.QuotedPrice > 0 {
+			item.Total = roundQuoteAmount(item.QuotedPrice * float64(item.Quantity))
+			q.QuotedSubtotal += item.Total
+		}
+	}
+	q.ListSubtotal = roundQuoteAmount(q.ListSubtotal)
+	q.QuotedSubtotal = roundQuoteAmount(q.QuotedSubtotal)
+}
+
+// ToCartItems returns the quote lines as cart items priced at the quoted unit prices
+func (q *Quote) ToCartItems() []CartItem {
+	items := make([]CartItem, 0, len(q.Items))
+	for _, item := range q.Items {
+		items = append(items, CartItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.QuotedPrice,
+			Total:     item.Total,
+		})
+	}
+	return items
+}
+
+// NewQuoteFromCart builds a quote request from the items in a cart
+func NewQuoteFromCart(cart *Cart, userID uuid.UUID, quoteNumber string, companyID *uuid.UUID, notes string) *Quote {
+	quote := &Quote{
+		ID:            ids.New(),
+		QuoteNumber:   quoteNumber,
+		UserID:        userID,
+		CompanyID:     companyID,
+		Status:        QuoteStatusRequested,
+		Currency:      "USD",
+		CustomerNotes: notes,
+	}
+
+	for _, cartItem := range cart.Items {
+		quote.Items = append(quote.Items, QuoteItem{
+			ID:          ids.New(),
+			QuoteID:     quote.ID,
+			ProductID:   cartItem.ProductID,
+			ProductName: cartItem.Product.Name,
+			ProductSKU:  cartItem.Product.SKU,
+			Quantity:    cartItem.Quantity,
+			ListPrice:   cartItem.Product.GetCurrentPrice(),
+		})
+	}
+	quote.RecalculateTotals()
+
+	return quote
+}
+
+func roundQuoteAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
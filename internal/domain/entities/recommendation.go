@@ -154,6 +154,9 @@ type RecommendationRequest struct {
 	Limit       int                  `json:"limit"`
 	Filters     *RecommendationFilters `json:"filters"`
 	Context     map[string]interface{} `json:"context"`
+
+	// ViewerID is the authenticated caller, used for catalog visibility; unlike UserID it is never taken from the request
+	ViewerID *uuid.UUID `json:"-"`
 }
 
 // RecommendationFilters represents filters for recommendations
@@ -186,7 +189,7 @@ type ProductListItem struct {
 	IsOnSale         bool      `json:"is_on_sale"`
 	SaleDiscountPercentage float64 `json:"sale_discount_percentage"`
 	MainImage        string    `json:"main_image"`
-	Stock            int       `json:"stock"`
+	Stock            *int      `json:"stock,omitempty"` // Nil when the product's stock visibility withholds it
 	StockStatus      string    `json:"stock_status"`
 	IsAvailable      bool      `json:"is_available"`
 	RatingAverage    float64   `json:"rating_average"`
@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ReportType is the data a report exports
+type ReportType string
+
+const (
+	ReportTypeSales       ReportType = "sales"       // Orders placed in the period
+	ReportTypeProducts    ReportType = "products"    // Units sold and revenue per product in the period
+	ReportTypeUsers       ReportType = "users"       // Users registered in the period
+	ReportTypeInventory   ReportType = "inventory"   // Current stock levels; the period is ignored
+	ReportTypePayments    ReportType = "payments"    // Payments made in the period
+	ReportTypeSettlements ReportType = "settlements" // Daily gateway settlements per currency in the period
+)
+
+// ReportFormat is the file format of a report
+type ReportFormat string
+
+const (
+	ReportFormatCSV ReportFormat = "csv"
+)
+
+// ReportStatus represents the generation status of a report
+type ReportStatus string
+
+const (
+	ReportStatusCompleted ReportStatus = "completed"
+	ReportStatusFailed    ReportStatus = "failed"
+)
+
+// Report is a generated export file. The file is kept in the row until the report is deleted,
+// which also revokes its download URLs.
+type Report struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Type        ReportType     `json:"type" gorm:"not null;index"`
+	Format      ReportFormat   `json:"format" gorm:"not null"`
+	Status      ReportStatus   `json:"status" gorm:"not null;index"`
+	DateFrom    time.Time      `json:"date_from"`
+	DateTo      time.Time      `json:"date_to"`
+	FileName    string         `json:"file_name"`
+	ContentType string         `json:"content_type"`
+	Content     []byte         `json:"-" gorm:"type:bytea"`
+	Size        int64          `json:"size"`
+	RowCount    int            `json:"row_count"`
+	Error       string         `json:"error,omitempty" gorm:"type:text"`
+	DataRegions pq.StringArray `json:"data_regions,omitempty" gorm:"type:text[]"` // Customer data regions the rows are limited to; empty for every region
+	Anonymized  bool           `json:"anonymized" gorm:"default:false"`           // Customer IDs are pseudonyms and other personal data is left out
+
+	// File layout
+	Columns   pq.StringArray  `json:"columns,omitempty" gorm:"type:text[]"` // Columns in the file; empty for every column
+	Locale    string          `json:"locale,omitempty"`                     // Language of the column headers
+	Delimiter ExportDelimiter `json:"delimiter,omitempty"`
+	Encoding  ExportEncoding  `json:"encoding,omitempty"`
+
+	CreatedBy   uuid.UUID  `json:"created_by" gorm:"type:uuid;index"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime;index"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName returns the table name for Report entity
+func (Report) TableName() string {
+	return "reports"
+}
+
+// IsDownloadable checks if the report has a file to download
+func (r *Report) IsDownloadable() bool {
+	return r.Status == ReportStatusCompleted
+}
+
+// ReportTable is the header and rows of a report before they are written to a file
+type ReportTable struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// SignReportDownload returns the HMAC signature that lets a download URL fetch the report until
+// expiresAt. The report ID is signed, so deleting the report makes every URL for it useless.
+func SignReportDownload(secret string, reportID uuid.UUID, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "report:%s:%d", reportID, expiresAt.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyReportDownload checks that signature was made by SignReportDownload for the report and
+// expiry, and that the expiry hasn't passed. expires is the Unix time from the URL.
+func VerifyReportDownload(secret string, reportID uuid.UUID, expires, signature string, now time.Time) error {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrReportDownloadInvalid
+	}
+	expiresAt := time.Unix(unix, 0)
+	expected := SignReportDownload(secret, reportID, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrReportDownloadInvalid
+	}
+	if now.After(expiresAt) {
+		return ErrReportDownloadExpired
+	}
+	return nil
+}
@@ -18,26 +18,30 @@ const (
 
 // Review represents a product review
 type Review struct {
-	ID              uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	UserID          uuid.UUID     `json:"user_id" gorm:"type:uuid;not null;index"`
-	User            User          `json:"user,omitempty" gorm:"foreignKey:UserID"`
-	ProductID       uuid.UUID     `json:"product_id" gorm:"type:uuid;not null;index"`
-	Product         Product       `json:"product,omitempty" gorm:"foreignKey:ProductID"`
-	OrderID         *uuid.UUID    `json:"order_id" gorm:"type:uuid;index"` // Optional: link to order for verified purchases
-	Order           *Order        `json:"order,omitempty" gorm:"foreignKey:OrderID"`
-	Rating          int           `json:"rating" gorm:"not null;check:rating >= 1 AND rating <= 5" validate:"required,min=1,max=5"`
-	Title           string        `json:"title" gorm:"not null" validate:"required,max=200"`
-	Comment         string        `json:"comment" gorm:"type:text" validate:"max=2000"`
-	Status          ReviewStatus  `json:"status" gorm:"default:'pending'"`
-	IsVerified      bool          `json:"is_verified" gorm:"default:false"` // Verified purchase
-	AdminReply      string        `json:"admin_reply" gorm:"type:text"`     // Admin response to review
-	AdminReplyAt    *time.Time    `json:"admin_reply_at"`                   // When admin replied
-	HelpfulCount    int           `json:"helpful_count" gorm:"default:0"`
-	NotHelpfulCount int           `json:"not_helpful_count" gorm:"default:0"`
-	Images          []ReviewImage `json:"images,omitempty" gorm:"foreignKey:ReviewID"`
-	Votes           []ReviewVote  `json:"votes,omitempty" gorm:"foreignKey:ReviewID"`
-	CreatedAt       time.Time     `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt       time.Time     `json:"updated_at" gorm:"autoUpdateTime"`
+	ID              uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID          uuid.UUID              `json:"user_id" gorm:"type:uuid;not null;index"`
+	User            User                   `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	ProductID       uuid.UUID              `json:"product_id" gorm:"type:uuid;not null;index"`
+	Product         Product                `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+	OrderID         *uuid.UUID             `json:"order_id" gorm:"type:uuid;index"` // Optional: link to order for verified purchases
+	Order           *Order                 `json:"order,omitempty" gorm:"foreignKey:OrderID"`
+	Rating          int                    `json:"rating" gorm:"not null;check:rating >= 1 AND rating <= 5" validate:"required,min=1,max=5"`
+	Title           string                 `json:"title" gorm:"not null" validate:"required,max=200"`
+	Comment         string                 `json:"comment" gorm:"type:text" validate:"max=2000"`
+	Status          ReviewStatus           `json:"status" gorm:"default:'pending'"`
+	QualityRating   *int                   `json:"quality_rating,omitempty"`                          // Optional 1-5 rating of product quality
+	ValueRating     *int                   `json:"value_rating,omitempty"`                            // Optional 1-5 rating of value for money
+	ShippingRating  *int                   `json:"shipping_rating,omitempty"`                         // Optional 1-5 rating of shipping
+	IsVerified      bool                   `json:"is_verified" gorm:"default:false"`                  // Verified purchase
+	ModerationFlags []ReviewModerationFlag `json:"moderation_flags,omitempty" gorm:"serializer:json"` // Why the review was held for an admin
+	AdminReply      string                 `json:"admin_reply" gorm:"type:text"`                      // Admin response to review
+	AdminReplyAt    *time.Time             `json:"admin_reply_at"`                                    // When admin replied
+	HelpfulCount    int                    `json:"helpful_count" gorm:"default:0"`
+	NotHelpfulCount int                    `json:"not_helpful_count" gorm:"default:0"`
+	Images          []ReviewImage          `json:"images,omitempty" gorm:"foreignKey:ReviewID"`
+	Votes           []ReviewVote           `json:"votes,omitempty" gorm:"foreignKey:ReviewID"`
+	CreatedAt       time.Time              `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time              `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 // TableName returns the table name for Review entity
@@ -50,6 +54,16 @@ func (r *Review) IsApproved() bool {
 	return r.Status == ReviewStatusApproved
 }
 
+// IsPositive checks if the review is positive (4 or 5 stars)
+func (r *Review) IsPositive() bool {
+	return r.Rating >= 4
+}
+
+// IsNegative checks if the review is negative (1 or 2 stars)
+func (r *Review) IsNegative() bool {
+	return r.Rating <= 2
+}
+
 // GetHelpfulPercentage calculates the helpful percentage
 func (r *Review) GetHelpfulPercentage() float64 {
 	totalVotes := r.HelpfulCount + r.NotHelpfulCount
@@ -163,11 +177,32 @@ type ReviewSummary struct {
 	RecentReviews      []Review        `json:"recent_reviews,omitempty"`
 }
 
+// Review rating dimensions
+const (
+	ReviewDimensionQuality  = "quality"
+	ReviewDimensionValue    = "value"
+	ReviewDimensionShipping = "shipping"
+)
+
+// ReviewDimensionRating represents the average rating of one review dimension for a product
+type ReviewDimensionRating struct {
+	Dimension     string  `json:"dimension"`
+	AverageRating float64 `json:"average_rating"`
+	// RatingCount is the number of reviews that rated this dimension
+	RatingCount int `json:"rating_count"`
+}
+
+// IsValidDimensionRating checks if an optional dimension rating is unset or between 1 and 5
+func IsValidDimensionRating(rating *int) bool {
+	return rating == nil || (*rating >= 1 && *rating <= 5)
+}
+
 // ReviewFilter represents filters for review queries
 type ReviewFilter struct {
 	ProductID  *uuid.UUID    `json:"product_id"`
 	UserID     *uuid.UUID    `json:"user_id"`
 	Rating     *int          `json:"rating"`
+	Ratings    []int         `json:"ratings"` // Any of these star ratings
 	Status     *ReviewStatus `json:"status"`
 	IsVerified *bool         `json:"is_verified"`
 	MinRating  *int          `json:"min_rating"`
@@ -176,4 +211,14 @@ type ReviewFilter struct {
 	SortOrder  string        `json:"sort_order"` // asc, desc
 	Limit      int           `json:"limit"`
 	Offset     int           `json:"offset"`
+	// After continues a listing after the review a cursor points at, instead of using Offset
+	After *ReviewCursor `json:"after"`
+}
+
+// ReviewCursor is the position of a review in a sorted listing. SortValue holds the review's
+// rating or helpful count when the listing is sorted by one of them.
+type ReviewCursor struct {
+	SortValue int       `json:"sort_value"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
 }
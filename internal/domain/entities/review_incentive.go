@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewIncentiveRewardType represents what a customer receives for an approved review
+type ReviewIncentiveRewardType string
+
+const (
+	ReviewIncentiveRewardCoupon        ReviewIncentiveRewardType = "coupon"
+	ReviewIncentiveRewardLoyaltyPoints ReviewIncentiveRewardType = "loyalty_points"
+)
+
+// ReviewIncentiveProgram configures the reward granted once per product when a customer's review is approved.
+// There is a single program; it starts out inactive, and its defaults come from the use case.
+type ReviewIncentiveProgram struct {
+	ID         uuid.UUID                 `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IsActive   bool                      `json:"is_active" gorm:"default:false"`
+	RewardType ReviewIncentiveRewardType `json:"reward_type" gorm:"not null;default:'loyalty_points'"`
+
+	// Loyalty points reward
+	Points int `json:"points" gorm:"default:0"`
+
+	// Coupon reward; each coupon is single-use and restricted to the reviewer
+	CouponType           CouponType `json:"coupon_type" gorm:"default:'percentage'"`
+	CouponValue          float64    `json:"coupon_value" gorm:"default:0"`
+	CouponMaxDiscount    *float64   `json:"coupon_max_discount"`
+	CouponMinOrderAmount *float64   `json:"coupon_min_order_amount"`
+	CouponValidDays      int        `json:"coupon_valid_days"`
+
+	// Fraud guards
+	RequireVerifiedPurchase bool `json:"require_verified_purchase"`
+	MinCommentLength        int  `json:"min_comment_length"`
+	MinAccountAgeDays       int  `json:"min_account_age_days" gorm:"default:0"`
+	// MaxRewardsPerUserMonthly caps rewards per customer over a rolling 30 days; 0 means no cap
+	MaxRewardsPerUserMonthly int `json:"max_rewards_per_user_monthly"`
+
+	// ActivatedAt is when the program was last switched on. Only reviews written after it are rewarded,
+	// and reporting compares review volume since then with the period before.
+	ActivatedAt *time.Time `json:"activated_at"`
+	UpdatedBy   *uuid.UUID `json:"updated_by" gorm:"type:uuid"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for ReviewIncentiveProgram entity
+func (ReviewIncentiveProgram) TableName() string {
+	return "review_incentive_programs"
+}
+
+// Validate validates the program configuration
+func (p *ReviewIncentiveProgram) Validate() error {
+	switch p.RewardType {
+	case ReviewIncentiveRewardLoyaltyPoints:
+		if p.Points <= 0 {
+			return fmt.Errorf("points must be positive for a loyalty points reward")
+		}
+	case ReviewIncentiveRewardCoupon:
+		switch p.CouponType {
+		case CouponTypePercentage:
+			if p.CouponValue <= 0 || p.CouponValue > 100 {
+				return fmt.Errorf("percentage coupon value must be between 0 and 100")
+			}
+		case CouponTypeFixed:
+			if p.CouponValue <= 0 {
+				return fmt.Errorf("fixed coupon value must be positive")
+			}
+		default:
+			return fmt.Errorf("coupon type must be percentage or fixed")
+		}
+		if p.CouponValidDays <= 0 {
+			return fmt.Errorf("coupon valid days must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid reward type: %s", p.RewardType)
+	}
+	if p.MinCommentLength < 0 || p.MinAccountAgeDays < 0 || p.MaxRewardsPerUserMonthly < 0 {
+		return fmt.Errorf("fraud guard limits cannot be negative")
+	}
+	return nil
+}
+
+// ReviewIncentiveReward records a reward granted for an approved review. A customer is rewarded at most once per product.
+type ReviewIncentiveReward struct {
+	ID         uuid.UUID                 `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ReviewID   uuid.UUID                 `json:"review_id" gorm:"type:uuid;not null;uniqueIndex"`
+	UserID     uuid.UUID                 `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_review_incentive_rewards_user_product"`
+	User       *User                     `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	ProductID  uuid.UUID                 `json:"product_id" gorm:"type:uuid;not null;uniqueIndex:idx_review_incentive_rewards_user_product"`
+	RewardType ReviewIncentiveRewardType `json:"reward_type" gorm:"not null"`
+	Points     int                       `json:"points" gorm:"default:0"`
+	CouponID   *uuid.UUID                `json:"coupon_id" gorm:"type:uuid"`
+	CouponCode string                    `json:"coupon_code,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at" gorm:"autoCreateTime;index"`
+}
+
+// TableName returns the table name for ReviewIncentiveReward entity
+func (ReviewIncentiveReward) TableName() string {
+	return "review_incentive_rewards"
+}
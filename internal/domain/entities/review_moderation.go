@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxReviewBannedWords caps the banned words and phrases in the moderation settings
+	MaxReviewBannedWords = 500
+	// MaxReviewTrustScore is the highest reviewer trust score
+	MaxReviewTrustScore = 100
+)
+
+// ReviewModerationSettings configures which reviews are published right away and which are held
+// for an admin. There is a single row; until it is saved the use case's defaults apply.
+type ReviewModerationSettings struct {
+	ID uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+
+	// Auto-approval
+	AutoApproveVerified bool `json:"auto_approve_verified" gorm:"default:true"` // Publish verified purchases of trusted reviewers
+	MinTrustScore       int  `json:"min_trust_score" gorm:"default:0"`          // Reviewer trust score verified purchases need, 0-100
+	// AutoApproveByContent publishes other reviews that have enough content for their rating
+	AutoApproveByContent bool `json:"auto_approve_by_content" gorm:"default:true"`
+
+	// Spam heuristics; flagged reviews are held for an admin
+	FlagLinks        bool     `json:"flag_links" gorm:"default:true"`         // URLs, domains and email addresses
+	FlagPhoneNumbers bool     `json:"flag_phone_numbers" gorm:"default:true"` // Runs of 9 or more digits
+	BannedWords      []string `json:"banned_words" gorm:"serializer:json"`    // Whole words and phrases, lower case
+
+	// MaxReviewsPerDay caps the reviews a customer writes in 24 hours; 0 means no cap
+	MaxReviewsPerDay int `json:"max_reviews_per_day" gorm:"default:0"`
+
+	UpdatedBy *uuid.UUID `json:"updated_by" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for ReviewModerationSettings entity
+func (ReviewModerationSettings) TableName() string {
+	return "review_moderation_settings"
+}
+
+// Validate validates the moderation settings
+func (s *ReviewModerationSettings) Validate() error {
+	if s.MinTrustScore < 0 || s.MinTrustScore > MaxReviewTrustScore {
+		return fmt.Errorf("min trust score must be between 0 and %d", MaxReviewTrustScore)
+	}
+	if s.MaxReviewsPerDay < 0 {
+		return fmt.Errorf("max reviews per day cannot be negative")
+	}
+	if len(s.BannedWords) > MaxReviewBannedWords {
+		return fmt.Errorf("at most %d banned words are allowed", MaxReviewBannedWords)
+	}
+	for _, word := range s.BannedWords {
+		if utf8.RuneCountInString(word) > 100 {
+			return fmt.Errorf("banned words must be at most 100 characters")
+		}
+	}
+	return nil
+}
+
+// ReviewModerationRule is why a review was flagged
+type ReviewModerationRule string
+
+const (
+	ReviewModerationRuleLink        ReviewModerationRule = "link"
+	ReviewModerationRulePhoneNumber ReviewModerationRule = "phone_number"
+	ReviewModerationRuleBannedWord  ReviewModerationRule = "banned_word"
+	ReviewModerationRuleSpamPattern ReviewModerationRule = "spam_pattern" // Repeated words, shouting, too short
+)
+
+// ReviewModerationFlag records a rule a review broke and what in it broke the rule
+type ReviewModerationFlag struct {
+	Rule  ReviewModerationRule `json:"rule"`
+	Match string               `json:"match"`
+}
+
+var (
+	reviewLinkPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+|[\w.+-]+@[a-z0-9-]+(?:\.[a-z0-9-]+)+|\b[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|info|biz|io|co|me|ly|link|shop|store|online|site|xyz|vn)\b`)
+	// reviewPhonePattern finds digit runs that may be separated by spaces, dots, dashes and brackets
+	reviewPhonePattern = regexp.MustCompile(`\+?\d[\d\s().-]{6,}\d`)
+)
+
+// reviewPhoneMinDigits is how many digits a run needs to count as a phone number; dates have fewer
+const reviewPhoneMinDigits = 9
+
+// NormalizeReviewWords lower-cases text and keeps only its words, each separated by one space
+func NormalizeReviewWords(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	}), " ")
+}
+
+// Check applies the link, phone number and banned word rules to a review's title and comment
+func (s *ReviewModerationSettings) Check(title, comment string) []ReviewModerationFlag {
+	content := title + "\n" + comment
+	var flags []ReviewModerationFlag
+
+	if s.FlagLinks {
+		if match := reviewLinkPattern.FindString(content); match != "" {
+			flags = append(flags, ReviewModerationFlag{Rule: ReviewModerationRuleLink, Match: match})
+		}
+	}
+	if s.FlagPhoneNumbers {
+		for _, match := range reviewPhonePattern.FindAllString(content, -1) {
+			digits := 0
+			for _, r := range match {
+				if r >= '0' && r <= '9' {
+					digits++
+				}
+			}
+			if digits >= reviewPhoneMinDigits {
+				flags = append(flags, ReviewModerationFlag{Rule: ReviewModerationRulePhoneNumber, Match: strings.TrimSpace(match)})
+				break
+			}
+		}
+	}
+	if len(s.BannedWords) > 0 {
+		words := " " + NormalizeReviewWords(content) + " "
+		for _, banned := range s.BannedWords {
+			if banned != "" && strings.Contains(words, " "+banned+" ") {
+				flags = append(flags, ReviewModerationFlag{Rule: ReviewModerationRuleBannedWord, Match: banned})
+			}
+		}
+	}
+
+	return flags
+}
+
+// ReviewerStats is a customer's history used to judge how far their reviews can be trusted
+type ReviewerStats struct {
+	AccountCreatedAt time.Time `json:"account_created_at"`
+	DeliveredOrders  int64     `json:"delivered_orders"`
+	ApprovedReviews  int64     `json:"approved_reviews"`
+	RemovedReviews   int64     `json:"removed_reviews"` // Reviews admins hid or rejected
+}
+
+// TrustScore scores a reviewer from 0 to 100: up to 30 for account age (1 per 3 days), up to 40
+// for delivered orders (10 each) and up to 30 for approved reviews (5 each), less 20 for each
+// review admins removed.
+func (s *ReviewerStats) TrustScore(now time.Time) int {
+	score := min(int(now.Sub(s.AccountCreatedAt).Hours()/24)/3, 30)
+	score += min(int(s.DeliveredOrders)*10, 40)
+	score += min(int(s.ApprovedReviews)*5, 30)
+	score -= int(s.RemovedReviews) * 20
+
+	if score < 0 {
+		return 0
+	}
+	if score > MaxReviewTrustScore {
+		return MaxReviewTrustScore
+	}
+	return score
+}
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SettlementStatus tells whether everything the gateway settled on a day matched our records
+type SettlementStatus string
+
+const (
+	SettlementStatusReconciled    SettlementStatus = "reconciled"    // Every transaction matched a record
+	SettlementStatusDiscrepancies SettlementStatus = "discrepancies" // Some transactions or records need a look
+)
+
+// SettlementItemType is the kind of balance transaction a settlement item is for
+type SettlementItemType string
+
+const (
+	SettlementItemTypeCharge     SettlementItemType = "charge"
+	SettlementItemTypeRefund     SettlementItemType = "refund"
+	SettlementItemTypeDispute    SettlementItemType = "dispute" // Chargebacks and their reversals
+	SettlementItemTypePayout     SettlementItemType = "payout"
+	SettlementItemTypeAdjustment SettlementItemType = "adjustment" // Fees and anything else
+)
+
+// SettlementItemStatus is the outcome of matching a balance transaction with our records
+type SettlementItemStatus string
+
+const (
+	SettlementItemStatusMatched          SettlementItemStatus = "matched"
+	SettlementItemStatusAmountMismatch   SettlementItemStatus = "amount_mismatch"
+	SettlementItemStatusCurrencyMismatch SettlementItemStatus = "currency_mismatch"
+	SettlementItemStatusMissingRecord    SettlementItemStatus = "missing_record"     // The gateway has it, we don't
+	SettlementItemStatusMissingAtGateway SettlementItemStatus = "missing_at_gateway" // We recorded it, the gateway has nothing
+	SettlementItemStatusUnmatched        SettlementItemStatus = "unmatched"          // Nothing to match it with, e.g. fees
+)
+
+// Settlement is what a payment gateway settled in one currency on one day (UTC), reconciled with
+// the payments, refunds and disputes we recorded. Amounts are integer minor units of Currency,
+// i.e. the amount divided by 10^Exponent, so they add up exactly.
+type Settlement struct {
+	ID       uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Gateway  string    `json:"gateway" gorm:"not null;uniqueIndex:idx_settlements_day"`
+	Date     time.Time `json:"date" gorm:"type:date;not null;uniqueIndex:idx_settlements_day"`
+	Currency string    `json:"currency" gorm:"not null;uniqueIndex:idx_settlements_day"`
+	Exponent int       `json:"exponent" gorm:"not null"`
+
+	ChargeCount      int   `json:"charge_count"`
+	ChargeAmount     int64 `json:"charge_amount"`
+	RefundCount      int   `json:"refund_count"`
+	RefundAmount     int64 `json:"refund_amount"`  // Negative
+	DisputeAmount    int64 `json:"dispute_amount"` // Chargebacks, net of reversals
+	AdjustmentAmount int64 `json:"adjustment_amount"`
+	FeeAmount        int64 `json:"fee_amount"`
+	NetAmount        int64 `json:"net_amount"` // What the day added to the balance, before payouts
+	PayoutCount      int   `json:"payout_count"`
+	PayoutAmount     int64 `json:"payout_amount"` // Negative: paid out of the balance
+
+	MatchedCount  int              `json:"matched_count"`
+	MismatchCount int              `json:"mismatch_count"`
+	MissingCount  int              `json:"missing_count"`
+	Status        SettlementStatus `json:"status" gorm:"not null;index"`
+	GeneratedAt   time.Time        `json:"generated_at"`
+
+	Items []SettlementItem `json:"items,omitempty" gorm:"foreignKey:SettlementID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the table name for Settlement entity
+func (Settlement) TableName() string {
+	return "settlements"
+}
+
+// SettlementItem is one balance transaction of a settlement, or one record the gateway has no
+// transaction for. Amounts are minor units: Amount, Fee and Net of the settlement currency,
+// GatewayAmount and RecordedAmount of their own currencies.
+type SettlementItem struct {
+	ID           uuid.UUID            `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SettlementID uuid.UUID            `json:"settlement_id" gorm:"type:uuid;not null;index"`
+	Type         SettlementItemType   `json:"type" gorm:"not null"`
+	Status       SettlementItemStatus `json:"status" gorm:"not null;index"`
+
+	// The gateway's balance transaction and the charge, refund, dispute or payout behind it
+	TransactionID string `json:"transaction_id" gorm:"index"`
+	SourceID      string `json:"source_id" gorm:"index"`
+
+	// Our record, when one was found
+	PaymentID *uuid.UUID `json:"payment_id,omitempty" gorm:"type:uuid"`
+	RefundID  *uuid.UUID `json:"refund_id,omitempty" gorm:"type:uuid"`
+	DisputeID *uuid.UUID `json:"dispute_id,omitempty" gorm:"type:uuid"`
+
+	Amount int64 `json:"amount"`
+	Fee    int64 `json:"fee"`
+	Net    int64 `json:"net"`
+
+	GatewayCurrency  string `json:"gateway_currency"`
+	GatewayAmount    int64  `json:"gateway_amount"`
+	RecordedCurrency string `json:"recorded_currency,omitempty"`
+	RecordedAmount   int64  `json:"recorded_amount,omitempty"`
+
+	Note       string    `json:"note,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// TableName returns the table name for SettlementItem entity
+func (SettlementItem) TableName() string {
+	return "settlement_items"
+}
+
+// IsDiscrepancy checks if the item needs a look from the finance team
+func (i *SettlementItem) IsDiscrepancy() bool {
+	return i.Status != SettlementItemStatusMatched && i.Status != SettlementItemStatusUnmatched
+}
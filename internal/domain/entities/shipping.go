@@ -625,6 +625,10 @@ type Return struct {
 	// Tracking
 	ReturnShipmentID *uuid.UUID  `json:"return_shipment_id" gorm:"type:uuid"`
 	TrackingNumber   string      `json:"tracking_number"`
+	LabelCreatedAt   *time.Time  `json:"label_created_at"` // When the customer's return label was generated
+	
+	// Photos the customer sent of the returned items
+	Photos          []string     `json:"photos,omitempty" gorm:"serializer:json"`
 	
 	// Dates
 	RequestedAt     time.Time    `json:"requested_at" gorm:"autoCreateTime"`
@@ -657,6 +661,11 @@ func (r *Return) IsCompleted() bool {
 	return r.Status == ReturnStatusCompleted
 }
 
+// IsWithdrawn checks if the return was rejected or cancelled, so its items can be returned again
+func (r *Return) IsWithdrawn() bool {
+	return r.Status == ReturnStatusRejected || r.Status == ReturnStatusCancelled
+}
+
 // validateDimensionsFormat validates dimensions format (LxWxH)
 func validateDimensionsFormat(dimensions string) error {
 	if dimensions == "" {
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"context"
+	"strings"
+
+	"ecom-golang-clean-architecture/pkg/money"
+)
+
+// Storefront is the currency, locale and ship-to country a shopper browses in, with the exchange
+// and tax rates that go with them. The HTTP layer resolves it once per request and puts it in the
+// request context, so pricing, shipping and tax display read it without every request carrying it.
+type Storefront struct {
+	Currency     string
+	Locale       string
+	Country      string  // ISO 3166-1 alpha-2 ship-to country
+	BaseCurrency string  // Currency catalog prices are kept in
+	ExchangeRate float64 // Units of Currency per unit of BaseCurrency
+	TaxRate      float64 // Estimated tax rate of Country as a fraction, e.g. 0.1 for 10%
+	TaxInclusive bool    // Whether prices are shown with the estimated tax included
+}
+
+type storefrontContextKey struct{}
+
+// ContextWithStorefront returns a copy of ctx carrying the storefront
+func ContextWithStorefront(ctx context.Context, storefront Storefront) context.Context {
+	return context.WithValue(ctx, storefrontContextKey{}, storefront)
+}
+
+// StorefrontFromContext returns the storefront carried by ctx, or an empty storefront for
+// contexts that didn't come from a storefront request, such as admin tools and scheduled jobs
+func StorefrontFromContext(ctx context.Context) Storefront {
+	storefront, _ := ctx.Value(storefrontContextKey{}).(Storefront)
+	return storefront
+}
+
+// IsSet checks if the storefront was resolved for the request
+func (s Storefront) IsSet() bool {
+	return s.Currency != ""
+}
+
+// Convert converts an amount in the base currency to the storefront currency, rounded to the
+// currency's minor unit
+func (s Storefront) Convert(amount float64) float64 {
+	rate := s.ExchangeRate
+	if rate <= 0 {
+		rate = 1
+	}
+	return money.FromMinor(money.ToMinor(amount*rate, s.Currency), s.Currency)
+}
+
+// CurrencyFormat returns how amounts are written for the storefront's currency and locale
+func (s Storefront) CurrencyFormat() money.CurrencyFormat {
+	return money.FormatFor(s.Currency, s.Locale)
+}
+
+// DisplayAmount converts a base currency price to what the shopper is shown, adding the estimated
+// tax when prices are shown tax inclusive
+func (s Storefront) DisplayAmount(amount float64) float64 {
+	if s.TaxInclusive {
+		amount += amount * s.TaxRate
+	}
+	return s.Convert(amount)
+}
+
+// Tax display modes of a TaxDisplayRule
+const (
+	TaxDisplayInclusive = "inclusive"
+	TaxDisplayExclusive = "exclusive"
+)
+
+// TaxDisplayRule is a store's rule for whether prices are shown with tax included, by the
+// ship-to country: EU shoppers expect gross prices while US shoppers expect net prices with tax
+// added at checkout. Countries listed either way override the default.
+type TaxDisplayRule struct {
+	Default            string // TaxDisplayInclusive or TaxDisplayExclusive
+	InclusiveCountries []string
+	ExclusiveCountries []string
+}
+
+// IsInclusive checks if prices shipped to the country are shown with tax included
+func (r TaxDisplayRule) IsInclusive(country string) bool {
+	for _, exclusive := range r.ExclusiveCountries {
+		if strings.EqualFold(exclusive, country) {
+			return false
+		}
+	}
+	for _, inclusive := range r.InclusiveCountries {
+		if strings.EqualFold(inclusive, country) {
+			return true
+		}
+	}
+	return r.Default == TaxDisplayInclusive
+}
@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaxExemptionType is the grounds a customer is exempt from tax on
+type TaxExemptionType string
+
+const (
+	TaxExemptionTypeResale     TaxExemptionType = "resale" // Resellers buying goods to sell on
+	TaxExemptionTypeNonprofit  TaxExemptionType = "nonprofit"
+	TaxExemptionTypeGovernment TaxExemptionType = "government"
+	TaxExemptionTypeOther      TaxExemptionType = "other"
+)
+
+// IsValidTaxExemptionType checks if a tax exemption type is supported
+func IsValidTaxExemptionType(exemptionType TaxExemptionType) bool {
+	switch exemptionType {
+	case TaxExemptionTypeResale, TaxExemptionTypeNonprofit, TaxExemptionTypeGovernment, TaxExemptionTypeOther:
+		return true
+	}
+	return false
+}
+
+// TaxExemptionStatus represents where a certificate is in its review
+type TaxExemptionStatus string
+
+const (
+	TaxExemptionStatusPending  TaxExemptionStatus = "pending"  // Uploaded, waiting for an admin
+	TaxExemptionStatusVerified TaxExemptionStatus = "verified" // Applied at checkout until it expires
+	TaxExemptionStatusRejected TaxExemptionStatus = "rejected" // Refused or revoked by an admin
+	TaxExemptionStatusExpired  TaxExemptionStatus = "expired"  // Lapsed after it was verified
+)
+
+// TaxExemptionCertificate is a customer's certificate exempting their purchases from tax, such as a
+// reseller's resale certificate. Once an admin verified it, it applies at checkout to orders
+// shipped to its jurisdiction until it expires.
+type TaxExemptionCertificate struct {
+	ID     uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+
+	Type              TaxExemptionType `json:"type" gorm:"not null"`
+	CertificateNumber string           `json:"certificate_number" gorm:"not null"`
+
+	// Jurisdiction; an empty state covers the whole country
+	Country string `json:"country" gorm:"size:2;not null"` // ISO 3166-1 alpha-2
+	State   string `json:"state"`
+
+	// TaxClasses are the product tax classes the certificate exempts; empty exempts all of them
+	TaxClasses []string `json:"tax_classes" gorm:"serializer:json"`
+
+	// Certificate document
+	FileID      string `json:"file_id" gorm:"not null"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	FileSize    int64  `json:"file_size"`
+	URL         string `json:"url"`
+
+	Status    TaxExemptionStatus `json:"status" gorm:"default:'pending';index"`
+	ExpiresAt *time.Time         `json:"expires_at" gorm:"index"` // Nil for certificates that do not expire
+
+	// Review
+	ReviewedBy  *uuid.UUID `json:"reviewed_by" gorm:"type:uuid"`
+	ReviewedAt  *time.Time `json:"reviewed_at"`
+	ReviewNotes string     `json:"review_notes" gorm:"type:text"`
+
+	// ReminderSentAt is when the customer was reminded the certificate expires soon
+	ReminderSentAt *time.Time `json:"reminder_sent_at"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for TaxExemptionCertificate entity
+func (TaxExemptionCertificate) TableName() string {
+	return "tax_exemption_certificates"
+}
+
+// Validate validates the certificate details
+func (c *TaxExemptionCertificate) Validate() error {
+	if !IsValidTaxExemptionType(c.Type) {
+		return fmt.Errorf("invalid tax exemption type: %s", c.Type)
+	}
+	if c.CertificateNumber == "" {
+		return fmt.Errorf("certificate number is required")
+	}
+	if len(c.CertificateNumber) > 100 {
+		return fmt.Errorf("certificate number must be at most 100 characters")
+	}
+	if len(c.Country) != 2 {
+		return fmt.Errorf("country must be a 2-letter ISO code")
+	}
+	if len(c.State) > 100 {
+		return fmt.Errorf("state must be at most 100 characters")
+	}
+	for _, taxClass := range c.TaxClasses {
+		if taxClass == "" || len(taxClass) > 50 {
+			return fmt.Errorf("tax classes must be 1 to 50 characters")
+		}
+	}
+	return nil
+}
+
+// IsActive checks if the certificate applies at checkout
+func (c *TaxExemptionCertificate) IsActive(now time.Time) bool {
+	return c.Status == TaxExemptionStatusVerified && !c.IsExpired(now)
+}
+
+// IsExpired checks if the certificate's expiry date has passed
+func (c *TaxExemptionCertificate) IsExpired(now time.Time) bool {
+	return c.ExpiresAt != nil && !now.Before(*c.ExpiresAt)
+}
+
+// Covers checks if the certificate applies to orders shipped to a country and state
+func (c *TaxExemptionCertificate) Covers(country, state string) bool {
+	if !strings.EqualFold(c.Country, strings.TrimSpace(country)) {
+		return false
+	}
+	return c.State == "" || strings.EqualFold(c.State, strings.TrimSpace(state))
+}
+
+// ExemptsTaxClass checks if the certificate exempts products of a tax class
+func (c *TaxExemptionCertificate) ExemptsTaxClass(taxClass string) bool {
+	if len(c.TaxClasses) == 0 {
+		return true
+	}
+	if taxClass == "" {
+		taxClass = "standard"
+	}
+	for _, exempt := range c.TaxClasses {
+		if strings.EqualFold(exempt, taxClass) {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderTaxExemption records on an order the certificate it was exempted from tax with, as it was
+// when the order was placed, for tax audits
+type OrderTaxExemption struct {
+	CertificateID     uuid.UUID        `json:"certificate_id"`
+	CertificateNumber string           `json:"certificate_number"`
+	Type              TaxExemptionType `json:"type"`
+	Country           string           `json:"country"`
+	State             string           `json:"state,omitempty"`
+	ExpiresAt         *time.Time       `json:"expires_at,omitempty"`
+	VerifiedAt        *time.Time       `json:"verified_at,omitempty"`
+
+	ExemptProductIDs []uuid.UUID `json:"exempt_product_ids"`
+	ExemptSubtotal   float64     `json:"exempt_subtotal"` // Item totals the exemption applied to
+	ExemptTax        float64     `json:"exempt_tax"`      // Tax the order was not charged
+}
+
+// Exempts checks if a product of the order was exempted from tax
+func (e *OrderTaxExemption) Exempts(productID uuid.UUID) bool {
+	for _, id := range e.ExemptProductIDs {
+		if id == productID {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // UserStatus represents user account status
@@ -65,6 +66,11 @@ type User struct {
 	TwoFactorEnabled bool `json:"two_factor_enabled" gorm:"default:false"`
 	SecurityScore    int  `json:"security_score" gorm:"default:0"`
 
+	// Data residency: the region the user's personal data and files are kept in, and for staff,
+	// the regions whose customer data they may export (empty for every region)
+	DataRegion       string         `json:"data_region" gorm:"index"`
+	DataRegionAccess pq.StringArray `json:"data_region_access,omitempty" gorm:"type:text[]"`
+
 	// Customer metrics
 	TotalOrders    int     `json:"total_orders" gorm:"default:0"`
 	TotalSpent     float64 `json:"total_spent" gorm:"default:0"`
@@ -340,8 +346,31 @@ const (
 	ActivityTypeOrderCancel    ActivityType = "order_cancel"
 	ActivityTypeReviewCreate   ActivityType = "review_create"
 	ActivityTypeAddressAdd     ActivityType = "address_add"
+	ActivityTypePointsEarned   ActivityType = "points_earned"
+	ActivityTypeSupportReply   ActivityType = "support_reply"
 )
 
+// NotableActivityTypes are the activity types shown in a user's own activity stream
+var NotableActivityTypes = []ActivityType{
+	ActivityTypeOrderPlace,
+	ActivityTypeOrderCancel,
+	ActivityTypeReviewCreate,
+	ActivityTypePointsEarned,
+	ActivityTypeWishlistAdd,
+	ActivityTypeWishlistRemove,
+	ActivityTypeSupportReply,
+}
+
+// IsNotableActivityType checks if an activity type belongs in the user's activity stream
+func IsNotableActivityType(activityType ActivityType) bool {
+	for _, notable := range NotableActivityTypes {
+		if activityType == notable {
+			return true
+		}
+	}
+	return false
+}
+
 // UserActivity represents user activity log
 type UserActivity struct {
 	ID          uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
@@ -0,0 +1,184 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VendorStatus represents whether a vendor can sell on the marketplace
+type VendorStatus string
+
+const (
+	VendorStatusPending   VendorStatus = "pending" // Applied and awaiting review
+	VendorStatusActive    VendorStatus = "active"
+	VendorStatusSuspended VendorStatus = "suspended"
+	VendorStatusRejected  VendorStatus = "rejected"
+)
+
+// Vendor is a seller whose products are sold on the marketplace. Products with a vendor are
+// split into a vendor order when ordered, and the marketplace keeps a commission on them.
+type Vendor struct {
+	ID     uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name   string       `json:"name" gorm:"not null" validate:"required"`
+	Slug   string       `json:"slug" gorm:"uniqueIndex;not null" validate:"required"`
+	Email  string       `json:"email" gorm:"not null" validate:"required,email"`
+	Phone  string       `json:"phone"`
+	Status VendorStatus `json:"status" gorm:"not null;default:'active';index"`
+
+	// UserID is the account that signs in to the vendor's statements and orders
+	UserID *uuid.UUID `json:"user_id,omitempty" gorm:"type:uuid;uniqueIndex"`
+	User   *User      `json:"user,omitempty" gorm:"foreignKey:UserID"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for Vendor entity
+func (Vendor) TableName() string {
+	return "vendors"
+}
+
+// IsActive checks if the vendor can sell
+func (v *Vendor) IsActive() bool {
+	return v.Status == VendorStatusActive
+}
+
+// IsUnderReview checks if the vendor's application decides its status
+func (v *Vendor) IsUnderReview() bool {
+	return v.Status == VendorStatusPending || v.Status == VendorStatusRejected
+}
+
+// CommissionSource tells which setting a commission rate came from
+type CommissionSource string
+
+const (
+	CommissionSourceVendorCategory CommissionSource = "vendor_category" // Vendor override for the item's category
+	CommissionSourceVendor         CommissionSource = "vendor"          // Vendor override for all categories
+	CommissionSourceCategory       CommissionSource = "category"
+	CommissionSourceDefault        CommissionSource = "default"
+)
+
+// CategoryCommission is the marketplace commission on items of a category and, unless they
+// have their own, its subcategories. Rate is a percent of the item total; FixedFee is charged
+// per unit sold, in the order currency.
+type CategoryCommission struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CategoryID uuid.UUID `json:"category_id" gorm:"type:uuid;uniqueIndex;not null"`
+	Category   *Category `json:"category,omitempty" gorm:"foreignKey:CategoryID"`
+	Rate       float64   `json:"rate" gorm:"not null"`
+	FixedFee   float64   `json:"fixed_fee" gorm:"not null;default:0"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for CategoryCommission entity
+func (CategoryCommission) TableName() string {
+	return "category_commissions"
+}
+
+// VendorCommission overrides the commission for one vendor, on a category and its
+// subcategories or, without a category, on everything the vendor sells
+type VendorCommission struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	VendorID   uuid.UUID  `json:"vendor_id" gorm:"type:uuid;not null;uniqueIndex:idx_vendor_commissions_scope"`
+	CategoryID *uuid.UUID `json:"category_id,omitempty" gorm:"type:uuid;uniqueIndex:idx_vendor_commissions_scope"`
+	Category   *Category  `json:"category,omitempty" gorm:"foreignKey:CategoryID"`
+	Rate       float64    `json:"rate" gorm:"not null"`
+	FixedFee   float64    `json:"fixed_fee" gorm:"not null;default:0"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for VendorCommission entity
+func (VendorCommission) TableName() string {
+	return "vendor_commissions"
+}
+
+// VendorOrderFeeType is the kind of fee the marketplace keeps from a vendor order
+type VendorOrderFeeType string
+
+const (
+	VendorOrderFeeTypeCommission VendorOrderFeeType = "commission" // Percent of the item total
+	VendorOrderFeeTypeFixed      VendorOrderFeeType = "fixed_fee"  // Per unit sold
+)
+
+// VendorOrder is the part of an order sold by one vendor, with the fees the marketplace keeps
+// from it. It is created with the order and keeps the rates that applied then. Amounts are
+// integer minor units of Currency, i.e. the amount divided by 10^Exponent, so they add up
+// exactly.
+type VendorOrder struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrderID     uuid.UUID `json:"order_id" gorm:"type:uuid;not null;uniqueIndex:idx_vendor_orders_order_vendor"`
+	VendorID    uuid.UUID `json:"vendor_id" gorm:"type:uuid;not null;uniqueIndex:idx_vendor_orders_order_vendor;index"`
+	OrderNumber string    `json:"order_number" gorm:"not null"`
+	Currency    string    `json:"currency" gorm:"not null"`
+	Exponent    int       `json:"exponent" gorm:"not null"`
+	ItemCount   int       `json:"item_count"`
+
+	GrossAmount      int64 `json:"gross_amount"`      // Item totals
+	CommissionAmount int64 `json:"commission_amount"` // Commission fee lines
+	FixedFeeAmount   int64 `json:"fixed_fee_amount"`  // Fixed fee lines
+	RefundedAmount   int64 `json:"refunded_amount"`   // Share of the order's refunds
+	// CommissionRefunded is the commission given back on refunds; the vendor bears the rest
+	CommissionRefunded int64 `json:"commission_refunded"`
+
+	Fees    []VendorOrderFee    `json:"fees,omitempty" gorm:"foreignKey:VendorOrderID;constraint:OnDelete:CASCADE"`
+	Refunds []VendorOrderRefund `json:"refunds,omitempty" gorm:"foreignKey:VendorOrderID;constraint:OnDelete:CASCADE"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for VendorOrder entity
+func (VendorOrder) TableName() string {
+	return "vendor_orders"
+}
+
+// RefundClawback is what refunds took back from the vendor
+func (vo *VendorOrder) RefundClawback() int64 {
+	return vo.RefundedAmount - vo.CommissionRefunded
+}
+
+// NetAmount is what the vendor is paid for the order
+func (vo *VendorOrder) NetAmount() int64 {
+	return vo.GrossAmount - vo.CommissionAmount - vo.FixedFeeAmount - vo.RefundClawback()
+}
+
+// VendorOrderFee is one fee the marketplace keeps on one item of a vendor order
+type VendorOrderFee struct {
+	ID            uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	VendorOrderID uuid.UUID          `json:"vendor_order_id" gorm:"type:uuid;not null;index"`
+	OrderItemID   uuid.UUID          `json:"order_item_id" gorm:"type:uuid;not null"`
+	ProductID     uuid.UUID          `json:"product_id" gorm:"type:uuid;not null"`
+	CategoryID    *uuid.UUID         `json:"category_id,omitempty" gorm:"type:uuid"`
+	Type          VendorOrderFeeType `json:"type" gorm:"not null"`
+	Source        CommissionSource   `json:"source" gorm:"not null"`
+	Rate          float64            `json:"rate"`     // Percent, for commission lines
+	Quantity      int                `json:"quantity"` // Units, for fixed fee lines
+	BaseAmount    int64              `json:"base_amount"`
+	Amount        int64              `json:"amount"`
+}
+
+// TableName returns the table name for VendorOrderFee entity
+func (VendorOrderFee) TableName() string {
+	return "vendor_order_fees"
+}
+
+// VendorOrderRefund is a vendor order's share of a refund of the order
+type VendorOrderRefund struct {
+	ID                 uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	VendorOrderID      uuid.UUID `json:"vendor_order_id" gorm:"type:uuid;not null;uniqueIndex:idx_vendor_order_refunds_refund"`
+	VendorID           uuid.UUID `json:"vendor_id" gorm:"type:uuid;not null;index"`
+	RefundID           uuid.UUID `json:"refund_id" gorm:"type:uuid;not null;uniqueIndex:idx_vendor_order_refunds_refund"`
+	Currency           string    `json:"currency" gorm:"not null"`
+	Exponent           int       `json:"exponent" gorm:"not null"`
+	Amount             int64     `json:"amount"`
+	CommissionRefunded int64     `json:"commission_refunded"`
+	CreatedAt          time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName returns the table name for VendorOrderRefund entity
+func (VendorOrderRefund) TableName() string {
+	return "vendor_order_refunds"
+}
@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VendorApplicationStatus represents where a vendor application is in review
+type VendorApplicationStatus string
+
+const (
+	VendorApplicationStatusDraft            VendorApplicationStatus = "draft"
+	VendorApplicationStatusSubmitted        VendorApplicationStatus = "submitted"
+	VendorApplicationStatusChangesRequested VendorApplicationStatus = "changes_requested"
+	VendorApplicationStatusApproved         VendorApplicationStatus = "approved"
+	VendorApplicationStatusRejected         VendorApplicationStatus = "rejected"
+)
+
+// VendorBusinessType represents the legal form of an applying business
+type VendorBusinessType string
+
+const (
+	VendorBusinessTypeIndividual VendorBusinessType = "individual"
+	VendorBusinessTypeCompany    VendorBusinessType = "company"
+)
+
+// VendorDocumentType represents what a KYC document proves
+type VendorDocumentType string
+
+const (
+	VendorDocumentTypeBusinessRegistration VendorDocumentType = "business_registration"
+	VendorDocumentTypeIdentity             VendorDocumentType = "identity"
+	VendorDocumentTypeTaxCertificate       VendorDocumentType = "tax_certificate"
+	VendorDocumentTypeBankStatement        VendorDocumentType = "bank_statement"
+	VendorDocumentTypeOther                VendorDocumentType = "other"
+)
+
+// RequiredVendorDocumentTypes are the documents an application needs before it can be submitted
+var RequiredVendorDocumentTypes = []VendorDocumentType{
+	VendorDocumentTypeBusinessRegistration,
+	VendorDocumentTypeIdentity,
+}
+
+// IsValidVendorDocumentType checks if a document type is known
+func IsValidVendorDocumentType(documentType VendorDocumentType) bool {
+	switch documentType {
+	case VendorDocumentTypeBusinessRegistration, VendorDocumentTypeIdentity, VendorDocumentTypeTaxCertificate,
+		VendorDocumentTypeBankStatement, VendorDocumentTypeOther:
+		return true
+	}
+	return false
+}
+
+// VendorApplication is an account's request to sell on the marketplace. Its vendor is created
+// pending when the account applies and becomes active when the application is approved.
+type VendorApplication struct {
+	ID       uuid.UUID               `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	VendorID uuid.UUID               `json:"vendor_id" gorm:"type:uuid;uniqueIndex;not null"`
+	Vendor   *Vendor                 `json:"vendor,omitempty" gorm:"foreignKey:VendorID"`
+	UserID   uuid.UUID               `json:"user_id" gorm:"type:uuid;not null;index"`
+	Status   VendorApplicationStatus `json:"status" gorm:"not null;default:'draft';index"`
+
+	// Business details
+	LegalName          string             `json:"legal_name" gorm:"not null"`
+	BusinessType       VendorBusinessType `json:"business_type" gorm:"not null"`
+	RegistrationNumber string             `json:"registration_number"`
+	TaxID              string             `json:"tax_id"`
+	Country            string             `json:"country" gorm:"size:2"`
+	Address            string             `json:"address"`
+	City               string             `json:"city"`
+	PostalCode         string             `json:"postal_code"`
+	Website            string             `json:"website"`
+	Description        string             `json:"description" gorm:"type:text"`
+
+	Documents []VendorDocument `json:"documents,omitempty" gorm:"foreignKey:ApplicationID"`
+
+	// ReviewNotes are the reviewer's requested changes or reason for rejection
+	ReviewNotes string     `json:"review_notes,omitempty" gorm:"type:text"`
+	ReviewedBy  *uuid.UUID `json:"reviewed_by,omitempty" gorm:"type:uuid"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty" gorm:"index"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for VendorApplication entity
+func (VendorApplication) TableName() string {
+	return "vendor_applications"
+}
+
+// IsEditable checks if the applicant can change the application and its documents
+func (a *VendorApplication) IsEditable() bool {
+	return a.Status == VendorApplicationStatusDraft || a.Status == VendorApplicationStatusChangesRequested
+}
+
+// MissingDocumentTypes lists the required documents the application does not have yet
+func (a *VendorApplication) MissingDocumentTypes() []VendorDocumentType {
+	has := make(map[VendorDocumentType]bool, len(a.Documents))
+	for _, document := range a.Documents {
+		has[document.Type] = true
+	}
+
+	var missing []VendorDocumentType
+	for _, documentType := range RequiredVendorDocumentTypes {
+		if !has[documentType] {
+			missing = append(missing, documentType)
+		}
+	}
+	return missing
+}
+
+// VendorDocument is a KYC document uploaded with a vendor application. The file itself is kept
+// by the file service.
+type VendorDocument struct {
+	ID            uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ApplicationID uuid.UUID          `json:"application_id" gorm:"type:uuid;not null;index"`
+	Type          VendorDocumentType `json:"type" gorm:"not null"`
+	FileID        string             `json:"file_id" gorm:"not null"`
+	FileName      string             `json:"file_name"`
+	ContentType   string             `json:"content_type"`
+	FileSize      int64              `json:"file_size"`
+	URL           string             `json:"url"`
+	CreatedAt     time.Time          `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for VendorDocument entity
+func (VendorDocument) TableName() string {
+	return "vendor_documents"
+}
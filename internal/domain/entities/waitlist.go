@@ -0,0 +1,198 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistStatus represents where a product's pre-launch waitlist is in the launch
+type WaitlistStatus string
+
+const (
+	WaitlistStatusOpen      WaitlistStatus = "open"      // Taking sign-ups; nobody has access yet
+	WaitlistStatusLaunching WaitlistStatus = "launching" // Access has opened for some or all; customers are notified in waves
+	WaitlistStatusLaunched  WaitlistStatus = "launched"  // Open to everyone and every customer on the list was notified
+	WaitlistStatusCancelled WaitlistStatus = "cancelled" // Launch called off; nobody is notified
+)
+
+// WaitlistEarlyAccess opens a launch to customers in a membership tier or segment some hours before
+// everyone else
+type WaitlistEarlyAccess struct {
+	Audience      VisibilityAudience `json:"audience"` // membership_tier or customer_segment
+	AudienceValue string             `json:"audience_value"`
+	HoursBefore   int                `json:"hours_before"`
+}
+
+// Validate validates an early access window
+func (a WaitlistEarlyAccess) Validate() error {
+	switch a.Audience {
+	case VisibilityAudienceMembershipTier:
+		if !isValidMembershipTier(a.AudienceValue) {
+			return fmt.Errorf("invalid membership tier: %s", a.AudienceValue)
+		}
+	case VisibilityAudienceCustomerSegment:
+		if !isValidCustomerSegment(a.AudienceValue) {
+			return fmt.Errorf("invalid customer segment: %s", a.AudienceValue)
+		}
+	default:
+		return fmt.Errorf("early access audience must be membership_tier or customer_segment")
+	}
+	if a.HoursBefore <= 0 {
+		return fmt.Errorf("early access hours_before must be positive")
+	}
+	return nil
+}
+
+// ProductWaitlist collects customers waiting for an upcoming product. From launch the product can
+// be bought by everyone; customers matching an early access window can buy it that many hours
+// earlier. Customers on the list are told when their access opens, in waves of WaveSize every
+// WaveIntervalMinutes so a launch doesn't send everyone to the store at once.
+type ProductWaitlist struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProductID uuid.UUID      `json:"product_id" gorm:"type:uuid;not null;uniqueIndex"`
+	Status    WaitlistStatus `json:"status" gorm:"default:'open';index"`
+
+	LaunchAt    time.Time             `json:"launch_at" gorm:"not null"`
+	EarlyAccess []WaitlistEarlyAccess `json:"early_access" gorm:"serializer:json"`
+	// OpensAt is when the first early access window opens, or LaunchAt without any
+	OpensAt time.Time `json:"opens_at" gorm:"not null;index"`
+
+	// Notification waves
+	WaveSize            int        `json:"wave_size" gorm:"not null"`             // Customers notified per wave
+	WaveIntervalMinutes int        `json:"wave_interval_minutes" gorm:"not null"` // Least time between two waves
+	WavesSent           int        `json:"waves_sent" gorm:"default:0"`
+	NextWaveAt          *time.Time `json:"next_wave_at"`
+	LaunchedAt          *time.Time `json:"launched_at"` // When every customer on the list was notified
+
+	CreatedBy *uuid.UUID `json:"created_by" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for ProductWaitlist entity
+func (ProductWaitlist) TableName() string {
+	return "product_waitlists"
+}
+
+// Validate validates waitlist data and sets when it opens
+func (w *ProductWaitlist) Validate() error {
+	if w.LaunchAt.IsZero() {
+		return fmt.Errorf("launch_at is required")
+	}
+	if w.WaveSize <= 0 {
+		return fmt.Errorf("wave_size must be positive")
+	}
+	if w.WaveIntervalMinutes <= 0 {
+		return fmt.Errorf("wave_interval_minutes must be positive")
+	}
+	seen := make(map[string]bool, len(w.EarlyAccess))
+	for _, window := range w.EarlyAccess {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+		key := string(window.Audience) + ":" + window.AudienceValue
+		if seen[key] {
+			return fmt.Errorf("duplicate early access for %s %s", window.Audience, window.AudienceValue)
+		}
+		seen[key] = true
+	}
+
+	w.OpensAt = w.LaunchAt
+	for _, window := range w.EarlyAccess {
+		if opensAt := w.LaunchAt.Add(-time.Duration(window.HoursBefore) * time.Hour); opensAt.Before(w.OpensAt) {
+			w.OpensAt = opensAt
+		}
+	}
+	return nil
+}
+
+// IsActive checks if the launch is still to come or under way
+func (w *ProductWaitlist) IsActive() bool {
+	return w.Status == WaitlistStatusOpen || w.Status == WaitlistStatusLaunching
+}
+
+// AccessAt returns when a customer in the membership tier and segment may buy the product: at
+// launch, or at the earliest early access window they match
+func (w *ProductWaitlist) AccessAt(membershipTier, customerSegment string) time.Time {
+	accessAt := w.LaunchAt
+	for _, window := range w.EarlyAccess {
+		if !window.matches(membershipTier, customerSegment) {
+			continue
+		}
+		if opensAt := w.LaunchAt.Add(-time.Duration(window.HoursBefore) * time.Hour); opensAt.Before(accessAt) {
+			accessAt = opensAt
+		}
+	}
+	return accessAt
+}
+
+// OpenAudiences returns who may buy the product at now
+func (w *ProductWaitlist) OpenAudiences(now time.Time) WaitlistAudiences {
+	if !now.Before(w.LaunchAt) {
+		return WaitlistAudiences{Everyone: true}
+	}
+	var audiences WaitlistAudiences
+	for _, window := range w.EarlyAccess {
+		if now.Before(w.LaunchAt.Add(-time.Duration(window.HoursBefore) * time.Hour)) {
+			continue
+		}
+		switch window.Audience {
+		case VisibilityAudienceMembershipTier:
+			audiences.MembershipTiers = append(audiences.MembershipTiers, window.AudienceValue)
+		case VisibilityAudienceCustomerSegment:
+			audiences.CustomerSegments = append(audiences.CustomerSegments, window.AudienceValue)
+		}
+	}
+	return audiences
+}
+
+func (a WaitlistEarlyAccess) matches(membershipTier, customerSegment string) bool {
+	switch a.Audience {
+	case VisibilityAudienceMembershipTier:
+		return a.AudienceValue == membershipTier
+	case VisibilityAudienceCustomerSegment:
+		return a.AudienceValue == customerSegment
+	}
+	return false
+}
+
+// WaitlistAudiences are the customers whose access to a launch has opened
+type WaitlistAudiences struct {
+	Everyone         bool
+	MembershipTiers  []string
+	CustomerSegments []string
+}
+
+// IsEmpty checks if access has not opened for anyone
+func (a WaitlistAudiences) IsEmpty() bool {
+	return !a.Everyone && len(a.MembershipTiers) == 0 && len(a.CustomerSegments) == 0
+}
+
+// WaitlistEntry is a customer waiting for a product's launch. Their membership tier and segment
+// are taken when they join and decide their early access.
+type WaitlistEntry struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	WaitlistID      uuid.UUID  `json:"waitlist_id" gorm:"type:uuid;not null;uniqueIndex:idx_waitlist_entry_user,priority:1"`
+	ProductID       uuid.UUID  `json:"product_id" gorm:"type:uuid;not null"`
+	UserID          uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_waitlist_entry_user,priority:2"`
+	MembershipTier  string     `json:"membership_tier"`
+	CustomerSegment string     `json:"customer_segment"`
+	Wave            int        `json:"wave"` // Wave the customer was notified in, 0 until then
+	NotifiedAt      *time.Time `json:"notified_at" gorm:"index"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for WaitlistEntry entity
+func (WaitlistEntry) TableName() string {
+	return "waitlist_entries"
+}
+
+// WaitlistDemand counts a waitlist's customers in a membership tier and segment
+type WaitlistDemand struct {
+	MembershipTier  string `json:"membership_tier"`
+	CustomerSegment string `json:"customer_segment"`
+	Joined          int64  `json:"joined"`
+	Notified        int64  `json:"notified"`
+}
@@ -0,0 +1,316 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// WarehouseTable represents a table exported to the data warehouse
+type WarehouseTable string
+
+const (
+	WarehouseTableOrders     WarehouseTable = "orders"
+	WarehouseTableOrderItems WarehouseTable = "order_items"
+	WarehouseTableCustomers  WarehouseTable = "customers"
+	WarehouseTableProducts   WarehouseTable = "products"
+)
+
+// WarehouseColumnType represents the warehouse type of an exported column
+type WarehouseColumnType string
+
+const (
+	WarehouseColumnString    WarehouseColumnType = "string"
+	WarehouseColumnInteger   WarehouseColumnType = "integer"
+	WarehouseColumnFloat     WarehouseColumnType = "float"
+	WarehouseColumnBoolean   WarehouseColumnType = "boolean"
+	WarehouseColumnTimestamp WarehouseColumnType = "timestamp"
+)
+
+// WarehouseSyncedAtColumn is added to every exported row. Rows are appended, so a row that
+// changes again is exported again; the latest version of a row has the greatest updated_at.
+const WarehouseSyncedAtColumn = "_synced_at"
+
+// WarehouseColumn is a column of an exported table
+type WarehouseColumn struct {
+	Name string              `json:"name"`
+	Type WarehouseColumnType `json:"type"`
+}
+
+// WarehouseTableSchema describes the columns exported for a table. Every table has id and
+// updated_at columns, which the export watermark follows.
+type WarehouseTableSchema struct {
+	Table   WarehouseTable    `json:"table"`
+	Columns []WarehouseColumn `json:"columns"`
+}
+
+// Fingerprint identifies the column list, so schema changes are applied to the warehouse
+// only when the exported columns change
+func (s WarehouseTableSchema) Fingerprint() string {
+	var b strings.Builder
+	for _, column := range s.Columns {
+		b.WriteString(column.Name)
+		b.WriteByte(':')
+		b.WriteString(string(column.Type))
+		b.WriteByte(';')
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+// WarehouseColumns returns the columns of the table in the warehouse, including the columns
+// the export adds
+func (s WarehouseTableSchema) WarehouseColumns() []WarehouseColumn {
+	columns := make([]WarehouseColumn, 0, len(s.Columns)+1)
+	columns = append(columns, s.Columns...)
+	return append(columns, WarehouseColumn{Name: WarehouseSyncedAtColumn, Type: WarehouseColumnTimestamp})
+}
+
+// WarehouseSchemas lists the exported tables in export order. Columns may be added over time -
+// the sync adds them to the warehouse table - but an existing column must keep its type.
+var WarehouseSchemas = []WarehouseTableSchema{
+	{
+		Table: WarehouseTableCustomers,
+		Columns: []WarehouseColumn{
+			{"id", WarehouseColumnString},
+			{"email", WarehouseColumnString},
+			{"first_name", WarehouseColumnString},
+			{"last_name", WarehouseColumnString},
+			{"phone", WarehouseColumnString},
+			{"status", WarehouseColumnString},
+			{"is_active", WarehouseColumnBoolean},
+			{"language", WarehouseColumnString},
+			{"currency", WarehouseColumnString},
+			{"email_verified", WarehouseColumnBoolean},
+			{"is_oauth_user", WarehouseColumnBoolean},
+			{"marketing_opt_in", WarehouseColumnBoolean},
+			{"newsletter_opt_in", WarehouseColumnBoolean},
+			{"total_orders", WarehouseColumnInteger},
+			{"total_spent", WarehouseColumnFloat},
+			{"loyalty_points", WarehouseColumnInteger},
+			{"membership_tier", WarehouseColumnString},
+			{"last_login_at", WarehouseColumnTimestamp},
+			{"created_at", WarehouseColumnTimestamp},
+			{"updated_at", WarehouseColumnTimestamp},
+		},
+	},
+	{
+		Table: WarehouseTableProducts,
+		Columns: []WarehouseColumn{
+			{"id", WarehouseColumnString},
+			{"sku", WarehouseColumnString},
+			{"name", WarehouseColumnString},
+			{"slug", WarehouseColumnString},
+			{"brand_id", WarehouseColumnString},
+			{"product_type", WarehouseColumnString},
+			{"status", WarehouseColumnString},
+			{"visibility", WarehouseColumnString},
+			{"featured", WarehouseColumnBoolean},
+			{"is_digital", WarehouseColumnBoolean},
+			{"price", WarehouseColumnFloat},
+			{"compare_price", WarehouseColumnFloat},
+			{"cost_price", WarehouseColumnFloat},
+			{"sale_price", WarehouseColumnFloat},
+			{"sale_start_date", WarehouseColumnTimestamp},
+			{"sale_end_date", WarehouseColumnTimestamp},
+			{"stock", WarehouseColumnInteger},
+			{"stock_status", WarehouseColumnString},
+			{"tax_class", WarehouseColumnString},
+			{"country_of_origin", WarehouseColumnString},
+			{"discontinued_at", WarehouseColumnTimestamp},
+			{"archived_at", WarehouseColumnTimestamp},
+			{"created_at", WarehouseColumnTimestamp},
+			{"updated_at", WarehouseColumnTimestamp},
+		},
+	},
+	{
+		Table: WarehouseTableOrders,
+		Columns: []WarehouseColumn{
+			{"id", WarehouseColumnString},
+			{"order_number", WarehouseColumnString},
+			{"user_id", WarehouseColumnString},
+			{"status", WarehouseColumnString},
+			{"fulfillment_status", WarehouseColumnString},
+			{"payment_status", WarehouseColumnString},
+			{"payment_method", WarehouseColumnString},
+			{"source", WarehouseColumnString},
+			{"customer_type", WarehouseColumnString},
+			{"sales_channel", WarehouseColumnString},
+			{"referral_source", WarehouseColumnString},
+			{"company_id", WarehouseColumnString},
+			{"currency", WarehouseColumnString},
+			{"subtotal", WarehouseColumnFloat},
+			{"tax_amount", WarehouseColumnFloat},
+			{"shipping_amount", WarehouseColumnFloat},
+			{"discount_amount", WarehouseColumnFloat},
+			{"tip_amount", WarehouseColumnFloat},
+			{"total", WarehouseColumnFloat},
+			{"shipping_method", WarehouseColumnString},
+			{"shipping_country", WarehouseColumnString},
+			{"shipping_city", WarehouseColumnString},
+			{"shipping_state", WarehouseColumnString},
+			{"is_gift", WarehouseColumnBoolean},
+			{"shipped_at", WarehouseColumnTimestamp},
+			{"actual_delivery", WarehouseColumnTimestamp},
+			{"created_at", WarehouseColumnTimestamp},
+			{"updated_at", WarehouseColumnTimestamp},
+		},
+	},
+	{
+		Table: WarehouseTableOrderItems,
+		Columns: []WarehouseColumn{
+			{"id", WarehouseColumnString},
+			{"order_id", WarehouseColumnString},
+			{"product_id", WarehouseColumnString},
+			{"product_sku", WarehouseColumnString},
+			{"product_name", WarehouseColumnString},
+			{"quantity", WarehouseColumnInteger},
+			{"price", WarehouseColumnFloat},
+			{"total", WarehouseColumnFloat},
+			{"weight", WarehouseColumnFloat},
+			{"created_at", WarehouseColumnTimestamp},
+			{"updated_at", WarehouseColumnTimestamp},
+		},
+	},
+}
+
+// WarehouseAnonymization is how the columns with personal data are anonymized when the export
+// is anonymized; other columns are exported as they are. Customer IDs get the same pseudonym in
+// every table, so orders still join to customers, and addresses are kept to country and state.
+var WarehouseAnonymization = map[WarehouseTable]map[string]AnonymizationRule{
+	WarehouseTableCustomers: {
+		"id":         AnonymizePseudonymize,
+		"email":      AnonymizeStrip,
+		"first_name": AnonymizeStrip,
+		"last_name":  AnonymizeStrip,
+		"phone":      AnonymizeStrip,
+	},
+	WarehouseTableOrders: {
+		"user_id":         AnonymizePseudonymize,
+		"referral_source": AnonymizeStrip, // Free text
+		"shipping_city":   AnonymizeStrip,
+	},
+}
+
+// Anonymized returns the schema with the columns anonymization strips left out, so they are
+// never read
+func (s WarehouseTableSchema) Anonymized() WarehouseTableSchema {
+	rules := WarehouseAnonymization[s.Table]
+	anonymized := WarehouseTableSchema{Table: s.Table, Columns: make([]WarehouseColumn, 0, len(s.Columns))}
+	for _, column := range s.Columns {
+		if rules[column.Name] != AnonymizeStrip {
+			anonymized.Columns = append(anonymized.Columns, column)
+		}
+	}
+	return anonymized
+}
+
+// AnonymizeWarehouseRow replaces the identifiers in a row of a table with their pseudonyms
+func AnonymizeWarehouseRow(table WarehouseTable, row WarehouseRow, pseudonymizer *Pseudonymizer) {
+	for name, rule := range WarehouseAnonymization[table] {
+		value, ok := row[name]
+		if !ok {
+			continue
+		}
+		switch rule {
+		case AnonymizePseudonymize:
+			if value != nil {
+				row[name] = pseudonymizer.Pseudonym(fmt.Sprint(value))
+			}
+		case AnonymizeStrip:
+			delete(row, name)
+		}
+	}
+}
+
+// GetWarehouseSchema returns the schema of an exported table
+func GetWarehouseSchema(table WarehouseTable) (WarehouseTableSchema, bool) {
+	for _, schema := range WarehouseSchemas {
+		if schema.Table == table {
+			return schema, true
+		}
+	}
+	return WarehouseTableSchema{}, false
+}
+
+// WarehouseRow is an exported row by column name; NULL values are nil
+type WarehouseRow map[string]interface{}
+
+// WarehouseSyncStatus represents the outcome of a table's last export
+type WarehouseSyncStatus string
+
+const (
+	WarehouseSyncStatusNeverSynced WarehouseSyncStatus = "never_synced"
+	WarehouseSyncStatusSuccess     WarehouseSyncStatus = "success"
+	WarehouseSyncStatusFailed      WarehouseSyncStatus = "failed"
+)
+
+// WarehouseSyncState tracks the export of one table. The watermark is the (updated_at, id) of
+// the last exported row; each run exports the rows that sort after it.
+type WarehouseSyncState struct {
+	ID          uuid.UUID           `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Table       WarehouseTable      `json:"table" gorm:"column:warehouse_table;not null;uniqueIndex"`
+	Status      WarehouseSyncStatus `json:"status" gorm:"not null;default:'never_synced'"`
+	WatermarkAt *time.Time          `json:"watermark_at"`
+	WatermarkID *uuid.UUID          `json:"watermark_id" gorm:"type:uuid"`
+
+	// Schema last applied to the warehouse table
+	SchemaFingerprint string     `json:"schema_fingerprint"`
+	SchemaUpdatedAt   *time.Time `json:"schema_updated_at"`
+
+	LastRunAt     *time.Time `json:"last_run_at"`
+	LastSuccessAt *time.Time `json:"last_success_at"`
+	LastError     string     `json:"last_error" gorm:"type:text"`
+	RowsLastRun   int        `json:"rows_last_run" gorm:"default:0"`
+	RowsTotal     int64      `json:"rows_total" gorm:"default:0"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for WarehouseSyncState entity
+func (WarehouseSyncState) TableName() string {
+	return "warehouse_sync_states"
+}
+
+// NewWarehouseSyncState creates the state of a table that has never been exported
+func NewWarehouseSyncState(table WarehouseTable) *WarehouseSyncState {
+	return &WarehouseSyncState{
+		ID:     ids.New(),
+		Table:  table,
+		Status: WarehouseSyncStatusNeverSynced,
+	}
+}
+
+// Advance moves the watermark past an exported batch
+func (s *WarehouseSyncState) Advance(at time.Time, id uuid.UUID, rows int) {
+	s.WatermarkAt = &at
+	s.WatermarkID = &id
+	s.RowsLastRun += rows
+	s.RowsTotal += int64(rows)
+}
+
+// MarkSucceeded records a completed export run
+func (s *WarehouseSyncState) MarkSucceeded(at time.Time) {
+	s.Status = WarehouseSyncStatusSuccess
+	s.LastSuccessAt = &at
+	s.LastError = ""
+}
+
+// MarkFailed records a failed export run; the watermark keeps the progress made before it
+func (s *WarehouseSyncState) MarkFailed(err error) {
+	s.Status = WarehouseSyncStatusFailed
+	s.LastError = err.Error()
+}
+
+// Reset clears the watermark so the next run exports every row again
+func (s *WarehouseSyncState) Reset() {
+	s.WatermarkAt = nil
+	s.WatermarkID = nil
+}
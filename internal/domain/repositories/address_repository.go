@@ -36,6 +36,9 @@ type AddressRepository interface {
 	SetAsDefault(ctx context.Context, userID, addressID uuid.UUID, addressType entities.AddressType) error
 	GetByUserIDAndType(ctx context.Context, userID uuid.UUID, addressType entities.AddressType) ([]*entities.Address, error)
 
+	// Company shared address book
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Address, error)
+
 	// Validation
 	ExistsByUserIDAndID(ctx context.Context, userID, addressID uuid.UUID) (bool, error)
 
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AdminNoteRepository defines the interface for internal admin note persistence
+type AdminNoteRepository interface {
+	Create(ctx context.Context, note *entities.AdminNote) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminNote, error)
+	Update(ctx context.Context, note *entities.AdminNote) error
+
+	// Delete deletes the note together with its replies
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListBySubject returns all notes and replies on a subject, oldest first, with their authors
+	ListBySubject(ctx context.Context, subjectType entities.AdminNoteSubject, subjectID uuid.UUID) ([]*entities.AdminNote, error)
+}
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AdminTaskFilters represents filters for listing admin tasks
+type AdminTaskFilters struct {
+	Type       entities.AdminTaskType
+	Status     entities.AdminTaskStatus
+	AssigneeID *uuid.UUID
+	Unassigned bool
+	// OverdueAt keeps open tasks due before it
+	OverdueAt *time.Time
+	Limit     int
+	Offset    int
+}
+
+// AdminTaskRepository defines the interface for admin inbox persistence
+type AdminTaskRepository interface {
+	// Create creates a task unless its source already has an open task of the same type, and
+	// reports whether it was created
+	Create(ctx context.Context, task *entities.AdminTask) (bool, error)
+	// GetByID gets a task with its assignee
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminTask, error)
+	Update(ctx context.Context, task *entities.AdminTask) error
+	// List lists tasks, most urgent first, then by due date
+	List(ctx context.Context, filters AdminTaskFilters) ([]*entities.AdminTask, int64, error)
+	// GetOpenByType gets every open task of a type
+	GetOpenByType(ctx context.Context, taskType entities.AdminTaskType) ([]*entities.AdminTask, error)
+	// GetSummary counts open tasks per type, with those overdue at now, unassigned and assigned
+	// to assigneeID
+	GetSummary(ctx context.Context, assigneeID uuid.UUID, now time.Time) ([]*entities.AdminTaskSummary, error)
+
+	// GetTaskSources reads what currently needs attention for a task type, oldest first
+	GetTaskSources(ctx context.Context, taskType entities.AdminTaskType, limit int) ([]*entities.AdminTaskSource, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AggregateRepairRepository defines the interface for checking and repairing denormalized aggregates
+type AggregateRepairRepository interface {
+	// CompareAggregates recomputes the aggregate of up to limit records whose ID sorts after
+	// afterID, in ID order, alongside the stored values
+	CompareAggregates(ctx context.Context, kind entities.AggregateKind, afterID uuid.UUID, limit int) ([]*entities.AggregateComparison, error)
+	// RepairAggregates recomputes the aggregate of the records and stores the result
+	RepairAggregates(ctx context.Context, kind entities.AggregateKind, recordIDs []uuid.UUID) error
+
+	CreateRun(ctx context.Context, run *entities.AggregateRepairRun) error
+	UpdateRun(ctx context.Context, run *entities.AggregateRepairRun) error
+	GetRun(ctx context.Context, id uuid.UUID) (*entities.AggregateRepairRun, error)
+	// ListRuns lists runs, newest first, without their discrepancies
+	ListRuns(ctx context.Context, limit, offset int) ([]*entities.AggregateRepairRun, int64, error)
+}
@@ -43,6 +43,14 @@ type AnalyticsRepository interface {
 
 	// Custom reports
 	ExecuteCustomQuery(ctx context.Context, query string, params map[string]interface{}) ([]map[string]interface{}, error)
+
+	// Anonymous visitor sessions
+	// StitchSession attributes a session's anonymous tracking data to the user who signed in on it
+	StitchSession(ctx context.Context, sessionID string, userID uuid.UUID) (int64, error)
+	// AnonymizeVisitorData strips IPs and user agents from anonymous tracking data and replaces session IDs with hashes
+	AnonymizeVisitorData(ctx context.Context, before time.Time) (int64, error)
+	// PurgeVisitorData deletes anonymous tracking data
+	PurgeVisitorData(ctx context.Context, before time.Time) (int64, error)
 }
 
 
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CatalogChangesetRepository defines the interface for staged catalog changes
+type CatalogChangesetRepository interface {
+	Create(ctx context.Context, changeset *entities.CatalogChangeset) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.CatalogChangeset, error)
+	// GetByPreviewToken returns the changeset a storefront preview link belongs to
+	GetByPreviewToken(ctx context.Context, token string) (*entities.CatalogChangeset, error)
+	Update(ctx context.Context, changeset *entities.CatalogChangeset) error
+	// Delete deletes a changeset and its items
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	List(ctx context.Context, filters CatalogChangesetFilters) ([]*entities.CatalogChangeset, error)
+	Count(ctx context.Context, filters CatalogChangesetFilters) (int64, error)
+
+	// GetDue returns scheduled changesets whose publish time has passed, oldest first
+	GetDue(ctx context.Context, at time.Time, limit int) ([]*entities.CatalogChangeset, error)
+
+	// ListItems returns a changeset's items, oldest first
+	ListItems(ctx context.Context, changesetID uuid.UUID) ([]*entities.CatalogChangesetItem, error)
+	// CountItems counts the items of each of the given changesets
+	CountItems(ctx context.Context, changesetIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	// SaveItem creates the item or replaces the changeset's existing item for the same product
+	SaveItem(ctx context.Context, item *entities.CatalogChangesetItem) error
+	DeleteItem(ctx context.Context, changesetID, productID uuid.UUID) error
+
+	// Publish marks the changeset published, saves the edited products, records the items'
+	// before and after states and writes the price history entries in one transaction. It fails
+	// with entities.ErrConflict if the changeset was published meanwhile or a product was
+	// updated since it was loaded.
+	Publish(ctx context.Context, changeset *entities.CatalogChangeset, items []*entities.CatalogChangesetItem, products []*entities.Product, entries []*entities.PriceHistory) error
+
+	// Rollback marks the changeset rolled back, saves the restored products and writes the
+	// reversal price history entries in one transaction. It fails with entities.ErrConflict
+	// under the same conditions as Publish.
+	Rollback(ctx context.Context, changeset *entities.CatalogChangeset, products []*entities.Product, entries []*entities.PriceHistory) error
+}
+
+// CatalogChangesetFilters represents filters for catalog changeset queries
+type CatalogChangesetFilters struct {
+	Status *entities.CatalogChangesetStatus
+	Limit  int
+	Offset int
+}
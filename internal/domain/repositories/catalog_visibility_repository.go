@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CatalogVisibilityRepository defines the interface for catalog visibility rule persistence
+type CatalogVisibilityRepository interface {
+	Create(ctx context.Context, rule *entities.CatalogVisibilityRule) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.CatalogVisibilityRule, error)
+	Update(ctx context.Context, rule *entities.CatalogVisibilityRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters VisibilityRuleFilters) ([]*entities.CatalogVisibilityRule, error)
+	Count(ctx context.Context, filters VisibilityRuleFilters) (int64, error)
+
+	// GetHiddenProductIDs returns the products targeted by active rules that admit none of the viewer's audiences
+	GetHiddenProductIDs(ctx context.Context, viewer *entities.CatalogViewer) ([]uuid.UUID, error)
+}
+
+// VisibilityRuleFilters represents filters for catalog visibility rule queries
+type VisibilityRuleFilters struct {
+	ProductID  *uuid.UUID
+	CategoryID *uuid.UUID
+	Audience   *entities.VisibilityAudience
+	IsActive   *bool
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CategoryAssignmentRepository defines the interface for bulk category assignments
+type CategoryAssignmentRepository interface {
+	Create(ctx context.Context, assignment *entities.CategoryAssignment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.CategoryAssignment, error)
+	Update(ctx context.Context, assignment *entities.CategoryAssignment) error
+
+	List(ctx context.Context, filters CategoryAssignmentFilters) ([]*entities.CategoryAssignment, error)
+	Count(ctx context.Context, filters CategoryAssignmentFilters) (int64, error)
+
+	// ClaimNext marks the oldest pending assignment running and returns it, or nil when none is
+	// pending. Assignments left running since before staleBefore, e.g. by a crashed server, are
+	// claimed again.
+	ClaimNext(ctx context.Context, staleBefore time.Time) (*entities.CategoryAssignment, error)
+
+	// GetMatchingProducts returns the ID, name and SKU of the products a selector matches
+	GetMatchingProducts(ctx context.Context, filter CategoryAssignmentProductFilter) ([]*entities.Product, error)
+
+	// Apply adds, removes or moves the products in one transaction and touches their updated_at,
+	// so caches and search indexes keyed on it pick up the change
+	Apply(ctx context.Context, assignment *entities.CategoryAssignment, productIDs []uuid.UUID) error
+}
+
+// CategoryAssignmentFilters represents filters for category assignment queries
+type CategoryAssignmentFilters struct {
+	Status *entities.CategoryAssignmentStatus
+	Limit  int
+	Offset int
+}
+
+// CategoryAssignmentProductFilter represents the product selection of a category assignment
+type CategoryAssignmentProductFilter struct {
+	ProductIDs  []uuid.UUID
+	CategoryIDs []uuid.UUID // A category and its subcategories
+	BrandID     *uuid.UUID
+	VendorID    *uuid.UUID
+	Status      *entities.ProductStatus
+	Search      string
+	MinPrice    *float64
+	MaxPrice    *float64
+	Limit       int
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CompanyRepository defines the interface for company account persistence
+type CompanyRepository interface {
+	Create(ctx context.Context, company *entities.Company) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Company, error)
+	Update(ctx context.Context, company *entities.Company) error
+	List(ctx context.Context, filters CompanyFilters) ([]*entities.Company, error)
+	Count(ctx context.Context, filters CompanyFilters) (int64, error)
+
+	// Members
+	AddMember(ctx context.Context, member *entities.CompanyMember) error
+	GetMemberByID(ctx context.Context, id uuid.UUID) (*entities.CompanyMember, error)
+	GetMemberByUserID(ctx context.Context, userID uuid.UUID) (*entities.CompanyMember, error)
+	GetMembers(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error)
+	UpdateMember(ctx context.Context, member *entities.CompanyMember) error
+	RemoveMember(ctx context.Context, id uuid.UUID) error
+}
+
+// CompanyFilters represents filters for company queries
+type CompanyFilters struct {
+	Search   string
+	IsActive *bool
+	Limit    int
+	Offset   int
+}
+
+// OrderApprovalRepository defines the interface for company order approval persistence
+type OrderApprovalRepository interface {
+	Create(ctx context.Context, approval *entities.OrderApproval) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.OrderApproval, error)
+	GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entities.OrderApproval, error)
+	Update(ctx context.Context, approval *entities.OrderApproval) error
+
+	// GetByCompanyID returns approvals for a company, newest first. A non-nil requestedBy keeps
+	// only the approvals that member requested.
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID, status *entities.OrderApprovalStatus, requestedBy *uuid.UUID, limit, offset int) ([]*entities.OrderApproval, error)
+	CountByCompanyID(ctx context.Context, companyID uuid.UUID, status *entities.OrderApprovalStatus, requestedBy *uuid.UUID) (int64, error)
+}
+
+// CompanyInvoiceRepository defines the interface for consolidated company invoice persistence
+type CompanyInvoiceRepository interface {
+	// Create stores the invoice with its items and links the invoiced orders to it
+	Create(ctx context.Context, invoice *entities.CompanyInvoice) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.CompanyInvoice, error)
+	Update(ctx context.Context, invoice *entities.CompanyInvoice) error
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID, limit, offset int) ([]*entities.CompanyInvoice, error)
+	CountByCompanyID(ctx context.Context, companyID uuid.UUID) (int64, error)
+
+	// GetUninvoicedOrders returns approved, non-cancelled company orders placed in the period
+	// that are not yet on an invoice, oldest first
+	GetUninvoicedOrders(ctx context.Context, companyID uuid.UUID, from, to time.Time) ([]*entities.Order, error)
+
+	// RecordPayment stores an offline payment, saves the invoice balance and updates
+	// the payment status of the invoiced orders
+	RecordPayment(ctx context.Context, invoice *entities.CompanyInvoice, payment *entities.CompanyInvoicePayment) error
+
+	// GetOverdue returns issued invoices past their due date, oldest due first
+	GetOverdue(ctx context.Context, now time.Time, limit int) ([]*entities.CompanyInvoice, error)
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// DisputeFilters represents filters for listing disputes
+type DisputeFilters struct {
+	Status  entities.DisputeStatus
+	Gateway string
+	OrderID *uuid.UUID
+	Limit   int
+	Offset  int
+}
+
+// DisputeRepository defines the interface for dispute persistence
+type DisputeRepository interface {
+	Create(ctx context.Context, dispute *entities.Dispute) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Dispute, error)
+	// GetByExternalID gets a dispute by the provider's own ID
+	GetByExternalID(ctx context.Context, gateway, externalID string) (*entities.Dispute, error)
+	Update(ctx context.Context, dispute *entities.Dispute) error
+	// Resolve saves a dispute that was just resolved together with the chargeback it applies to
+	// the disputed payment and, when given, the order's payment status, in one transaction. It
+	// returns entities.ErrConflict when the dispute's resolution was already applied.
+	Resolve(ctx context.Context, dispute *entities.Dispute, chargedBack *entities.Payment, order *entities.Order) error
+	// List lists disputes, most recently opened first
+	List(ctx context.Context, filters DisputeFilters) ([]*entities.Dispute, int64, error)
+
+	// GetEvidenceDue gets disputes still awaiting our response whose evidence is due by before
+	// and that have not been reminded about yet
+	GetEvidenceDue(ctx context.Context, before time.Time, limit int) ([]*entities.Dispute, error)
+	// GetStats counts the disputes opened and the payments made between from and to
+	GetStats(ctx context.Context, from, to time.Time) (*entities.DisputeStats, error)
+}
@@ -36,4 +36,7 @@ type FileRepository interface {
 	
 	// Get total count of files by type
 	GetFileCountByType(ctx context.Context, uploadType entities.FileUploadType) (int64, error)
+	
+	// Tag files that have no data region with region, returning how many
+	TagUntaggedDataRegion(ctx context.Context, region string) (int64, error)
 }
@@ -149,6 +149,9 @@ type FunnelAnalysis struct {
 	Steps          []string `json:"steps"`
 	TotalUsers     int64    `json:"total_users"`
 	ConversionRate float64  `json:"conversion_rate"`
+
+	// StepSessions counts the visitor sessions, signed in or anonymous, that reached each step in order
+	StepSessions []int64 `json:"step_sessions"`
 }
 
 // DeliveryStats represents notification delivery statistics
@@ -122,3 +122,74 @@ type MovementReportItem struct {
 	TotalCost    float64                          `json:"total_cost"`
 	Reference    string                           `json:"reference"`
 }
+
+// InventorySnapshotRepository defines inventory snapshot repository interface
+type InventorySnapshotRepository interface {
+	// Capture snapshots every inventory record for the given day, replacing any snapshot
+	// already taken that day, and returns the number of records captured
+	Capture(ctx context.Context, day time.Time) (int64, error)
+
+	// GetLatestDay returns the latest day with snapshots on or before the given day, or nil if there is none
+	GetLatestDay(ctx context.Context, onOrBefore time.Time) (*time.Time, error)
+
+	// Point-in-time reporting
+	GetStockOnDay(ctx context.Context, day time.Time, filters InventorySnapshotFilters) (*StockSnapshotReport, error)
+	GetStockDeltas(ctx context.Context, fromDay, toDay time.Time, filters InventorySnapshotFilters) (*StockDeltaReport, error)
+}
+
+// InventorySnapshotFilters represents filters for point-in-time stock queries
+type InventorySnapshotFilters struct {
+	WarehouseID *uuid.UUID
+	ProductID   *uuid.UUID
+	ChangedOnly bool // Deltas only: skip stock that did not change between the two days
+	Limit       int
+	Offset      int
+}
+
+// StockSnapshotReport represents stock levels on a snapshot day
+type StockSnapshotReport struct {
+	Items         []StockSnapshotItem `json:"items"`
+	TotalItems    int64               `json:"total_items"`
+	TotalQuantity int64               `json:"total_quantity"`
+	TotalValue    float64             `json:"total_value"`
+}
+
+// StockSnapshotItem represents the stock of a product in a warehouse on a snapshot day
+type StockSnapshotItem struct {
+	ProductID         uuid.UUID `json:"product_id"`
+	ProductName       string    `json:"product_name"`
+	SKU               string    `json:"sku"`
+	WarehouseID       uuid.UUID `json:"warehouse_id"`
+	WarehouseCode     string    `json:"warehouse_code"`
+	WarehouseName     string    `json:"warehouse_name"`
+	QuantityOnHand    int       `json:"quantity_on_hand"`
+	QuantityReserved  int       `json:"quantity_reserved"`
+	QuantityAvailable int       `json:"quantity_available"`
+	UnitCost          float64   `json:"unit_cost"`
+	TotalValue        float64   `json:"total_value"`
+}
+
+// StockDeltaReport represents stock changes between two snapshot days
+type StockDeltaReport struct {
+	Items          []StockDeltaItem `json:"items"`
+	TotalItems     int64            `json:"total_items"`
+	QuantityChange int64            `json:"quantity_change"`
+	ValueChange    float64          `json:"value_change"`
+}
+
+// StockDeltaItem represents the change in stock of a product in a warehouse between two snapshot days.
+// Stock missing from one of the days counts as zero.
+type StockDeltaItem struct {
+	ProductID      uuid.UUID `json:"product_id"`
+	ProductName    string    `json:"product_name"`
+	SKU            string    `json:"sku"`
+	WarehouseID    uuid.UUID `json:"warehouse_id"`
+	WarehouseCode  string    `json:"warehouse_code"`
+	WarehouseName  string    `json:"warehouse_name"`
+	FromQuantity   int       `json:"from_quantity"`
+	ToQuantity     int       `json:"to_quantity"`
+	QuantityChange int       `json:"quantity_change"`
+	FromValue      float64   `json:"from_value"`
+	ToValue        float64   `json:"to_value"`
+	ValueChange    float64   `json:"value_change"`
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// JWTSigningKeyRepository defines the interface for stored JWT signing keys
+type JWTSigningKeyRepository interface {
+	// List returns every stored key, oldest activation first
+	List(ctx context.Context) ([]*entities.JWTSigningKey, error)
+
+	// CreateUnlessRotatedSince saves the key unless another key activates at or after since,
+	// e.g. because another server rotated first. It reports whether the key was saved.
+	CreateUnlessRotatedSince(ctx context.Context, key *entities.JWTSigningKey, since time.Time) (bool, error)
+
+	// Delete deletes keys by ID
+	Delete(ctx context.Context, ids []uuid.UUID) error
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// OrderArchiveRepository defines the interface for moving old orders' rows to the archive and back
+type OrderArchiveRepository interface {
+	// FindArchivableOrderIDs returns up to limit unarchived orders in one of the statuses that
+	// were created and last updated before the cutoff, oldest first
+	FindArchivableOrderIDs(ctx context.Context, before time.Time, statuses []entities.OrderStatus, limit int) ([]uuid.UUID, error)
+	// ArchiveOrders moves the items and events of the orders to the archive and flags the
+	// orders archived, in one transaction. It returns how many orders were archived.
+	ArchiveOrders(ctx context.Context, orderIDs []uuid.UUID) (int, error)
+	// RestoreOrder moves an archived order's items and events back to the live tables
+	RestoreOrder(ctx context.Context, orderID uuid.UUID) error
+
+	GetArchive(ctx context.Context, orderID uuid.UUID) (*entities.OrderArchive, error)
+	GetTotals(ctx context.Context) (*entities.OrderArchiveTotals, error)
+}
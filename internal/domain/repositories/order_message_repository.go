@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// OrderMessageRepository defines the interface for customer/store order message persistence
+type OrderMessageRepository interface {
+	Create(ctx context.Context, message *entities.OrderMessage) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.OrderMessage, error)
+
+	// ListByOrder returns all messages on an order, oldest first, with their senders
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.OrderMessage, error)
+
+	// MarkRead marks unread messages from the given sender as read and returns how many were marked
+	MarkRead(ctx context.Context, orderID uuid.UUID, sender entities.OrderMessageSender, readAt time.Time) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// OrderNumberSequenceRepository defines the interface for order number sequences
+type OrderNumberSequenceRepository interface {
+	// NextValue draws the next value of the scope and period's sequence, starting it at start.
+	// The value is committed right away, so it is lost if the order is never saved.
+	NextValue(ctx context.Context, scope, period string, start int64) (int64, error)
+
+	// CreateOrderWithNextValue draws the next sequence value, sets the order number built from
+	// it and creates the order in one transaction. A failed create rolls the sequence back, so
+	// no value is skipped; orders drawing from the same sequence are created one at a time.
+	CreateOrderWithNextValue(ctx context.Context, order *entities.Order, scope, period string, start int64, build func(value int64) string) error
+}
@@ -18,8 +18,9 @@ type OrderSearchParams struct {
 	EndDate       *time.Time
 	MinTotal      *float64
 	MaxTotal      *float64
-	SortBy        string // created_at, total, status
-	SortOrder     string // asc, desc
+	DataRegions   []string // Data regions of the customers; nil for every region
+	SortBy        string   // created_at, total, status
+	SortOrder     string   // asc, desc
 	Limit         int
 	Offset        int
 }
@@ -86,6 +87,9 @@ type OrderRepository interface {
 	GetDiscountsGiven(ctx context.Context) (float64, error)  // Total discounts
 	CountOrders(ctx context.Context) (int64, error)
 	CountOrdersByStatus(ctx context.Context, status entities.OrderStatus) (int64, error)
+
+	// CountPendingFulfillmentByProduct counts orders containing the product that have not shipped yet
+	CountPendingFulfillmentByProduct(ctx context.Context, productID uuid.UUID) (int64, error)
 }
 
 // PaymentRepository defines the interface for payment data access
@@ -111,6 +115,9 @@ type PaymentRepository interface {
 	// GetByExternalID retrieves a payment by external ID (e.g., Stripe session ID)
 	GetByExternalID(ctx context.Context, externalID string) (*entities.Payment, error)
 
+	// GetByPaymentIntentID retrieves a payment by its Stripe payment intent ID
+	GetByPaymentIntentID(ctx context.Context, paymentIntentID string) (*entities.Payment, error)
+
 	// GetByCustomSessionID retrieves a payment by custom session ID through checkout session mapping
 	GetByCustomSessionID(ctx context.Context, customSessionID string) (*entities.Payment, error)
 
@@ -135,6 +142,10 @@ type PaymentRepository interface {
 	// GetRefundablePayments retrieves payments that can be refunded
 	GetRefundablePayments(ctx context.Context, limit, offset int) ([]*entities.Payment, error)
 
+	// GetProcessedByGateway retrieves a gateway's payments processed between from and to that
+	// were paid, including those refunded since
+	GetProcessedByGateway(ctx context.Context, gateway string, from, to time.Time) ([]*entities.Payment, error)
+
 	// Refund-related methods
 	CreateRefund(ctx context.Context, refund *entities.Refund) error
 	GetRefund(ctx context.Context, refundID uuid.UUID) (*entities.Refund, error)
@@ -142,6 +153,10 @@ type PaymentRepository interface {
 	UpdateRefund(ctx context.Context, refund *entities.Refund) error
 	ListRefunds(ctx context.Context, limit, offset int) ([]*entities.Refund, error)
 	GetPendingRefunds(ctx context.Context, limit, offset int) ([]*entities.Refund, error)
+	// GetRefundByTransactionID retrieves a refund by the gateway's refund ID
+	GetRefundByTransactionID(ctx context.Context, transactionID string) (*entities.Refund, error)
+	// GetCompletedRefundsByGateway retrieves refunds of a gateway's payments completed between from and to
+	GetCompletedRefundsByGateway(ctx context.Context, gateway string, from, to time.Time) ([]*entities.Refund, error)
 }
 
 // PaymentMethodRepository defines the interface for payment method data access
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// FulfillmentSLAFilters represents filters for listing tracked orders
+type FulfillmentSLAFilters struct {
+	State entities.FulfillmentSLAState
+	// Stuck keeps orders admins were told are stuck in their status
+	Stuck bool
+	// Open keeps orders that are still being tracked
+	Open   bool
+	Limit  int
+	Offset int
+}
+
+// FulfillmentSLACounts counts the orders being tracked
+type FulfillmentSLACounts struct {
+	OnTrack  int64 `json:"on_track"`
+	AtRisk   int64 `json:"at_risk"`
+	Breached int64 `json:"breached"`
+	Stuck    int64 `json:"stuck"`
+}
+
+// FulfillmentSLADay counts the orders shipped on one day
+type FulfillmentSLADay struct {
+	Date    string `json:"date"` // YYYY-MM-DD in the store's time zone
+	Shipped int64  `json:"shipped"`
+	OnTime  int64  `json:"on_time"`
+}
+
+// OrderSLARepository defines the interface for fulfillment SLA tracking persistence
+type OrderSLARepository interface {
+	// GetUntrackedOrders gets orders in one of the statuses that aren't tracked yet, oldest first
+	GetUntrackedOrders(ctx context.Context, statuses []entities.OrderStatus, limit int) ([]*entities.Order, error)
+	// Create starts tracking an order unless it already is
+	Create(ctx context.Context, sla *entities.OrderFulfillmentSLA) error
+	Update(ctx context.Context, sla *entities.OrderFulfillmentSLA) error
+	// GetOpen gets the orders still being tracked, with their orders, oldest ship-by time first
+	GetOpen(ctx context.Context, limit int) ([]*entities.OrderFulfillmentSLA, error)
+	// List lists tracked orders with their orders, oldest ship-by time first
+	List(ctx context.Context, filters FulfillmentSLAFilters) ([]*entities.OrderFulfillmentSLA, int64, error)
+
+	// CountOpen counts the orders still being tracked by state, and those stuck in their status
+	CountOpen(ctx context.Context) (*FulfillmentSLACounts, error)
+	// GetDailyShipped counts the orders shipped in time and in all per day from from up to to,
+	// with days in the time zone
+	GetDailyShipped(ctx context.Context, from, to time.Time, timezone string) ([]*FulfillmentSLADay, error)
+}
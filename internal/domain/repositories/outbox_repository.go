@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEventRepository defines the interface for the domain event outbox
+type OutboxEventRepository interface {
+	Create(ctx context.Context, event *entities.OutboxEvent) error
+	Update(ctx context.Context, event *entities.OutboxEvent) error
+
+	// GetPending returns unpublished events in the order they occurred
+	GetPending(ctx context.Context, limit int) ([]*entities.OutboxEvent, error)
+	MarkPublished(ctx context.Context, ids []uuid.UUID, at time.Time) error
+
+	// DeletePublishedBefore deletes events published before the given time
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+
+	GetStats(ctx context.Context) (*OutboxStats, error)
+}
+
+// OutboxStats summarizes the unpublished events of the outbox
+type OutboxStats struct {
+	Pending         int64
+	Failing         int64 // Pending events whose last publish attempt failed
+	OldestPendingAt *time.Time
+	LastError       string // Error of the oldest failing event
+}
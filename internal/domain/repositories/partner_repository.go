@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PartnerRepository defines the interface for price-comparison and affiliate partner persistence
+type PartnerRepository interface {
+	Create(ctx context.Context, partner *entities.Partner) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Partner, error)
+	Update(ctx context.Context, partner *entities.Partner) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List lists partners by name
+	List(ctx context.Context) ([]*entities.Partner, error)
+	// GetDueForDelivery returns active partners with a webhook whose next delivery is due
+	GetDueForDelivery(ctx context.Context, now time.Time, limit int) ([]*entities.Partner, error)
+}
+
+// APIKeyRepository defines the interface for API key persistence
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.APIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.APIKey, error)
+	GetByHash(ctx context.Context, hash string) (*entities.APIKey, error)
+	// ListByPartner lists a partner's keys, newest first
+	ListByPartner(ctx context.Context, partnerID uuid.UUID) ([]*entities.APIKey, error)
+	Update(ctx context.Context, key *entities.APIKey) error
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// APIKeyUsageRepository defines the interface for the daily request counts of API keys
+type APIKeyUsageRepository interface {
+	// RecordRequest counts a request on a key's day, as served while the day's requests are
+	// below quota (0 is unlimited) and as rejected once they reach it, and returns the day's
+	// counts and whether it was served
+	RecordRequest(ctx context.Context, keyID uuid.UUID, day time.Time, quota int64) (*entities.APIKeyUsage, bool, error)
+	// ListByKeys lists the counts of keys on the days from from to to, oldest first
+	ListByKeys(ctx context.Context, keyIDs []uuid.UUID, from, to time.Time) ([]*entities.APIKeyUsage, error)
+}
+
+// PartnerFeedRepository defines the interface for the log of price and availability changes
+// partners are sent
+type PartnerFeedRepository interface {
+	RecordChange(ctx context.Context, change *entities.PartnerFeedChange) error
+	// GetChangesAfter returns changes with a sequence above after that were recorded before
+	// recordedBefore, oldest first
+	GetChangesAfter(ctx context.Context, after int64, recordedBefore time.Time, limit int) ([]*entities.PartnerFeedChange, error)
+	// LatestSequence returns the sequence of the last change, or 0 when there is none
+	LatestSequence(ctx context.Context) (int64, error)
+	// DeleteBefore deletes changes recorded before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
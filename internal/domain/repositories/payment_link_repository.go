@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PaymentLinkFilters represents filters for listing payment links
+type PaymentLinkFilters struct {
+	Status  entities.PaymentLinkStatus
+	OrderID *uuid.UUID
+	QuoteID *uuid.UUID
+	Limit   int
+	Offset  int
+}
+
+// PaymentLinkRepository defines the interface for payment link persistence
+type PaymentLinkRepository interface {
+	Create(ctx context.Context, link *entities.PaymentLink) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.PaymentLink, error)
+	GetByToken(ctx context.Context, token string) (*entities.PaymentLink, error)
+	GetByStripeSessionID(ctx context.Context, sessionID string) (*entities.PaymentLink, error)
+	// List lists payment links, newest first
+	List(ctx context.Context, filters PaymentLinkFilters) ([]*entities.PaymentLink, int64, error)
+
+	// ClaimCheckout marks an active link as opening a checkout session, returning ErrConflict
+	// unless the link is active and has no session started after staleBefore
+	ClaimCheckout(ctx context.Context, id uuid.UUID, staleBefore, now time.Time) error
+	// SetCheckoutSession records the checkout session opened for a claimed link
+	SetCheckoutSession(ctx context.Context, id uuid.UUID, sessionID, checkoutURL string) error
+	// ReleaseCheckout clears a claim whose checkout session could not be opened
+	ReleaseCheckout(ctx context.Context, id uuid.UUID) error
+	// MarkSent records the email address and phone number the link was last sent to, and when
+	MarkSent(ctx context.Context, id uuid.UUID, email, phone string, at time.Time) error
+
+	// Cancel cancels an active link, returning ErrConflict if it is no longer active
+	Cancel(ctx context.Context, id uuid.UUID, at time.Time) error
+	// MarkPaid marks a link as paid, returning ErrConflict if it already is. A checkout session
+	// opened before the link expired or was cancelled can still be paid.
+	MarkPaid(ctx context.Context, id uuid.UUID, at time.Time) error
+	// ExpireActive expires active links whose expiry has passed and returns how many were expired
+	ExpireActive(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PriceHistoryRepository defines the interface for price history persistence
+type PriceHistoryRepository interface {
+	Create(ctx context.Context, entry *entities.PriceHistory) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.PriceHistory, error)
+
+	// GetByProductID returns price history for a product, newest first
+	GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int) ([]*entities.PriceHistory, error)
+	CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error)
+
+	// GetByProductIDSince returns price history entries created after since, oldest first
+	GetByProductIDSince(ctx context.Context, productID uuid.UUID, since time.Time) ([]*entities.PriceHistory, error)
+
+	// GetLatestBefore returns the most recent entry created before the given time
+	GetLatestBefore(ctx context.Context, productID uuid.UUID, before time.Time) (*entities.PriceHistory, error)
+
+	// GetByBulkUpdateID returns the entries written by a bulk price update, oldest first
+	GetByBulkUpdateID(ctx context.Context, bulkUpdateID uuid.UUID) ([]*entities.PriceHistory, error)
+}
+
+// ScheduledPriceChangeRepository defines the interface for scheduled price change persistence
+type ScheduledPriceChangeRepository interface {
+	Create(ctx context.Context, change *entities.ScheduledPriceChange) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.ScheduledPriceChange, error)
+	Update(ctx context.Context, change *entities.ScheduledPriceChange) error
+
+	// GetByProductID returns scheduled changes for a product ordered by effective date
+	GetByProductID(ctx context.Context, productID uuid.UUID, status *entities.ScheduledPriceChangeStatus) ([]*entities.ScheduledPriceChange, error)
+
+	// GetDue returns pending changes whose effective date has passed, oldest first
+	GetDue(ctx context.Context, at time.Time, limit int) ([]*entities.ScheduledPriceChange, error)
+
+	List(ctx context.Context, filters ScheduledPriceChangeFilters) ([]*entities.ScheduledPriceChange, error)
+	Count(ctx context.Context, filters ScheduledPriceChangeFilters) (int64, error)
+
+	// Apply saves the product's new pricing, saves the change and writes its history entry in one
+	// transaction. A nil entry means the pricing did not change, so only the change is saved. It
+	// fails with entities.ErrConflict if the product's price changed meanwhile.
+	Apply(ctx context.Context, change *entities.ScheduledPriceChange, product *entities.Product, entry *entities.PriceHistory) error
+}
+
+// ScheduledPriceChangeFilters represents filters for scheduled price change queries
+type ScheduledPriceChangeFilters struct {
+	ProductID *uuid.UUID
+	Status    *entities.ScheduledPriceChangeStatus
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
+
+// BulkPriceUpdateRepository defines the interface for bulk price update persistence
+type BulkPriceUpdateRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.BulkPriceUpdate, error)
+	List(ctx context.Context, limit, offset int) ([]*entities.BulkPriceUpdate, error)
+	Count(ctx context.Context) (int64, error)
+
+	// GetMatchingProducts returns the products selected by a bulk price rule
+	GetMatchingProducts(ctx context.Context, filter BulkPriceProductFilter) ([]*entities.Product, error)
+
+	// Apply creates the bulk update, saves the repriced products and writes their history entries
+	// in one transaction. It fails with entities.ErrConflict if a product's price changed meanwhile.
+	Apply(ctx context.Context, update *entities.BulkPriceUpdate, products []*entities.Product, entries []*entities.PriceHistory) error
+
+	// Rollback restores the products, writes the reversal history entries and marks the bulk update
+	// rolled back in one transaction. It fails with entities.ErrConflict under the same condition as Apply.
+	Rollback(ctx context.Context, update *entities.BulkPriceUpdate, products []*entities.Product, entries []*entities.PriceHistory) error
+}
+
+// BulkPriceProductFilter represents the product selection of a bulk price rule
+type BulkPriceProductFilter struct {
+	CategoryIDs []uuid.UUID // A category and its subcategories
+	BrandID     *uuid.UUID
+	ProductIDs  []uuid.UUID
+	MinPrice    *float64
+	MaxPrice    *float64
+	Limit       int
+}
@@ -18,6 +18,7 @@ type ProductCategoryRepository interface {
 
 	// Product-Category relationship operations
 	GetCategoriesByProductID(ctx context.Context, productID uuid.UUID) ([]*entities.Category, error)
+	GetCategoryIDsByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
 	GetProductsByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*entities.Product, error)
 	GetProductWithCategories(ctx context.Context, productID uuid.UUID) (*entities.ProductWithCategories, error)
 	GetCategoryWithProducts(ctx context.Context, categoryID uuid.UUID) (*entities.CategoryWithProducts, error)
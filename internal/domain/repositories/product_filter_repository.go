@@ -52,6 +52,9 @@ type AdvancedFilterParams struct {
 	// Filter options
 	IncludeFacets bool `json:"include_facets"`
 	FacetLimit    int  `json:"facet_limit"`
+
+	// ExcludeIDs removes products hidden from the viewer by catalog visibility rules
+	ExcludeIDs []uuid.UUID `json:"-"`
 }
 
 // FilterFacets represents available filter facets (reusing existing types)
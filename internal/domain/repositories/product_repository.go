@@ -15,12 +15,15 @@ type ProductSearchParams struct {
 	CategoryID *uuid.UUID
 	MinPrice   *float64
 	MaxPrice   *float64
-	Status     *entities.ProductStatus
+	Status     *entities.ProductStatus // Nil excludes discontinued and archived products
 	Tags       []string
 	SortBy     string // name, price, created_at
 	SortOrder  string // asc, desc
 	Limit      int
 	Offset     int
+
+	// ExcludeIDs removes products hidden from the viewer by catalog visibility rules
+	ExcludeIDs []uuid.UUID
 }
 
 // ProductRepository defines the interface for product data access
@@ -37,6 +40,9 @@ type ProductRepository interface {
 	// GetByIDs retrieves multiple products by IDs (bulk operation)
 	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entities.Product, error)
 
+	// GetPriceAndStockByIDs retrieves only the pricing, stock and status columns of products, without relations
+	GetPriceAndStockByIDs(ctx context.Context, ids []uuid.UUID) ([]*entities.Product, error)
+
 	// GetByIDsWithFullDetails retrieves multiple products by IDs with all relations (optimized for bulk operations)
 	GetByIDsWithFullDetails(ctx context.Context, ids []uuid.UUID) ([]*entities.Product, error)
 
@@ -45,6 +51,9 @@ type ProductRepository interface {
 
 	// Update updates an existing product
 	Update(ctx context.Context, product *entities.Product) error
+	// UpdateWithPriceHistory updates a product and writes the history entry of its pricing
+	// change in one transaction
+	UpdateWithPriceHistory(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error
 
 	// Delete deletes a product by ID
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -67,6 +76,13 @@ type ProductRepository interface {
 	// GetByCategory retrieves products by category
 	GetByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int) ([]*entities.Product, error)
 
+	// GetWithExpiredSales retrieves products still carrying a sale price whose sale window ended before at
+	GetWithExpiredSales(ctx context.Context, at time.Time, limit int) ([]*entities.Product, error)
+
+	// RevertExpiredSale clears a product's ended sale and writes its history entry in one
+	// transaction. It fails with entities.ErrConflict if the product's sale changed meanwhile.
+	RevertExpiredSale(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error
+
 	// UpdateStock updates product stock
 	UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error
 
@@ -82,6 +98,9 @@ type ProductRepository interface {
 	// GetRelated retrieves related products
 	GetRelated(ctx context.Context, productID uuid.UUID, limit int) ([]*entities.Product, error)
 
+	// GetAlternatives retrieves visible products in the same primary category, same brand first and then by closest price
+	GetAlternatives(ctx context.Context, product *entities.Product, limit int) ([]*entities.Product, error)
+
 	// ClearTags removes all tag associations for a product
 	ClearTags(ctx context.Context, productID uuid.UUID) error
 
@@ -124,7 +143,7 @@ type AdvancedSearchParams struct {
 	Featured    *bool
 	Visibility  *entities.ProductVisibility
 	ProductType *entities.ProductType
-	Status      *entities.ProductStatus
+	Status      *entities.ProductStatus // Nil excludes discontinued and archived products
 	Tags        []string
 	Attributes  map[uuid.UUID][]uuid.UUID // AttributeID -> TermIDs
 	SortBy      string                    // price, name, created_at, etc.
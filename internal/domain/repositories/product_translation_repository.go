@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ProductTranslationRepository defines the interface for per-locale product content
+type ProductTranslationRepository interface {
+	// Get returns a product's translation, or ErrProductTranslationNotFound
+	Get(ctx context.Context, productID uuid.UUID, locale string) (*entities.ProductTranslation, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]*entities.ProductTranslation, error)
+	// ListByProducts returns the translations of several products in the given locales
+	ListByProducts(ctx context.Context, productIDs []uuid.UUID, locales []string) ([]*entities.ProductTranslation, error)
+
+	// Save creates the translation or replaces the product's existing translation in the same locale
+	Save(ctx context.Context, translation *entities.ProductTranslation) error
+	Delete(ctx context.Context, productID uuid.UUID, locale string) error
+
+	// MarkOutdated flags a product's complete translations as outdated
+	MarkOutdated(ctx context.Context, productID uuid.UUID) error
+	// CountByStatus counts stored translations per locale and status
+	CountByStatus(ctx context.Context) ([]*ProductTranslationStatusCount, error)
+}
+
+// ProductTranslationStatusCount is the number of translations in a locale with a status
+type ProductTranslationStatusCount struct {
+	Locale string                            `json:"locale"`
+	Status entities.ProductTranslationStatus `json:"status"`
+	Count  int64                             `json:"count"`
+}
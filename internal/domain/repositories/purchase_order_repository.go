@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SupplierRepository defines the interface for suppliers
+type SupplierRepository interface {
+	Create(ctx context.Context, supplier *entities.Supplier) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Supplier, error)
+	Update(ctx context.Context, supplier *entities.Supplier) error
+	ExistsByCode(ctx context.Context, code string) (bool, error)
+	List(ctx context.Context, activeOnly bool, limit, offset int) ([]*entities.Supplier, error)
+	Count(ctx context.Context, activeOnly bool) (int64, error)
+}
+
+// PurchaseOrderRepository defines the interface for purchase orders
+type PurchaseOrderRepository interface {
+	// Create creates a purchase order with its items
+	Create(ctx context.Context, order *entities.PurchaseOrder) error
+	// GetByID gets a purchase order with its supplier and items
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error)
+	List(ctx context.Context, filters PurchaseOrderFilters) ([]*entities.PurchaseOrder, error)
+	Count(ctx context.Context, filters PurchaseOrderFilters) (int64, error)
+
+	// UpdateExpectedAt sets the delivery date the supplier confirmed, nil for none
+	UpdateExpectedAt(ctx context.Context, id uuid.UUID, expectedAt *time.Time) error
+	// UpdateStatus changes the status of an open purchase order and reports whether it was still open
+	UpdateStatus(ctx context.Context, id uuid.UUID, status entities.PurchaseOrderStatus) (bool, error)
+	// Receive records received quantities by item ID in one transaction: it adds them to the stock of
+	// the products and their inventory, and marks the purchase order received once every item was
+	// received in full
+	Receive(ctx context.Context, order *entities.PurchaseOrder, received map[uuid.UUID]int, receivedBy uuid.UUID) error
+
+	// GetOpenItemsByProducts returns the items of open purchase orders for the products that still
+	// have quantities to receive, with their purchase order and its supplier loaded
+	GetOpenItemsByProducts(ctx context.Context, productIDs []uuid.UUID) ([]*entities.PurchaseOrderItem, error)
+}
+
+// PurchaseOrderFilters represents filters for purchase order queries
+type PurchaseOrderFilters struct {
+	Status     *entities.PurchaseOrderStatus
+	SupplierID *uuid.UUID
+	ProductID  *uuid.UUID
+	Limit      int
+	Offset     int
+}
+
+// BackInStockRepository defines the interface for back in stock subscriptions
+type BackInStockRepository interface {
+	// Subscribe subscribes a user to a product, renewing a subscription they were already notified of
+	Subscribe(ctx context.Context, subscription *entities.BackInStockSubscription) error
+	Unsubscribe(ctx context.Context, productID, userID uuid.UUID) error
+	// GetPending gets the user's subscription to the product that wasn't notified yet
+	GetPending(ctx context.Context, productID, userID uuid.UUID) (*entities.BackInStockSubscription, error)
+
+	// GetPendingProductIDs returns the products with subscribers waiting to be notified
+	GetPendingProductIDs(ctx context.Context) ([]uuid.UUID, error)
+	// GetPendingByProduct returns a product's subscribers waiting to be notified, oldest first
+	GetPendingByProduct(ctx context.Context, productID uuid.UUID, limit int) ([]*entities.BackInStockSubscription, error)
+	CountPendingByProduct(ctx context.Context, productID uuid.UUID) (int64, error)
+	MarkNotified(ctx context.Context, ids []uuid.UUID) error
+}
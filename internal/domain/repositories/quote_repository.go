@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// QuoteRepository defines the interface for quote request persistence
+type QuoteRepository interface {
+	// Create stores the quote with its items
+	Create(ctx context.Context, quote *entities.Quote) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Quote, error)
+
+	// Update saves the quote and its item prices
+	Update(ctx context.Context, quote *entities.Quote) error
+
+	// TransitionStatus moves a quote from one status to another, returning ErrConflict
+	// if the quote is no longer in the expected status
+	TransitionStatus(ctx context.Context, id uuid.UUID, from, to entities.QuoteStatus) error
+
+	List(ctx context.Context, filters QuoteFilters) ([]*entities.Quote, error)
+	Count(ctx context.Context, filters QuoteFilters) (int64, error)
+
+	// GetExpired returns quoted or accepted quotes whose expiry has passed
+	GetExpired(ctx context.Context, now time.Time, limit int) ([]*entities.Quote, error)
+}
+
+// QuoteFilters represents filters for quote queries
+type QuoteFilters struct {
+	UserID    *uuid.UUID
+	CompanyID *uuid.UUID
+	Status    *entities.QuoteStatus
+	Limit     int
+	Offset    int
+}
@@ -54,6 +54,7 @@ type RecommendationRepository interface {
 	GenerateSimilarProducts(ctx context.Context, productID uuid.UUID, limit int) ([]entities.ProductListItem, error)
 	GenerateFrequentlyBoughtTogether(ctx context.Context, productID uuid.UUID, limit int) ([]entities.ProductListItem, error)
 	GeneratePersonalizedRecommendations(ctx context.Context, userID uuid.UUID, limit int) ([]entities.ProductListItem, error)
+	GenerateSessionRecommendations(ctx context.Context, sessionID string, limit int) ([]entities.ProductListItem, error)
 	GenerateTrendingRecommendations(ctx context.Context, period string, limit int) ([]entities.ProductListItem, error)
 	GenerateCategoryBasedRecommendations(ctx context.Context, categoryID uuid.UUID, excludeProductID *uuid.UUID, limit int) ([]entities.ProductListItem, error)
 	GenerateBrandBasedRecommendations(ctx context.Context, brandID uuid.UUID, excludeProductID *uuid.UUID, limit int) ([]entities.ProductListItem, error)
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// GeneratedReportFilters represents filters for listing generated reports
+type GeneratedReportFilters struct {
+	Type      entities.ReportType
+	Status    entities.ReportStatus
+	CreatedBy *uuid.UUID
+	DateFrom  *time.Time // Reports created at or after
+	DateTo    *time.Time // Reports created at or before
+	Limit     int
+	Offset    int
+}
+
+// ReportRepository defines the interface for generated reports and the data they export
+type ReportRepository interface {
+	Create(ctx context.Context, report *entities.Report) error
+	// GetByID gets a report with its file
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Report, error)
+	// List lists reports without their files, newest first
+	List(ctx context.Context, filters GeneratedReportFilters) ([]*entities.Report, int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetTable queries the rows of a report of the type for the period, at most limit rows. Rows
+	// with customer data are limited to customers in regions, unless it is nil.
+	GetTable(ctx context.Context, reportType entities.ReportType, from, to time.Time, regions []string, limit int) (*entities.ReportTable, error)
+
+	// Export profiles
+	GetExportProfile(ctx context.Context, userID uuid.UUID, reportType entities.ReportType) (*entities.ExportProfile, error)
+	ListExportProfiles(ctx context.Context, userID uuid.UUID) ([]*entities.ExportProfile, error)
+	// SaveExportProfile creates the admin's profile for the report type, or replaces it
+	SaveExportProfile(ctx context.Context, profile *entities.ExportProfile) error
+	DeleteExportProfile(ctx context.Context, userID uuid.UUID, reportType entities.ReportType) error
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ReviewIncentiveRepository defines the interface for review incentive program persistence
+type ReviewIncentiveRepository interface {
+	// GetProgram returns the program, or ErrReviewIncentiveProgramNotFound if it was never configured
+	GetProgram(ctx context.Context) (*entities.ReviewIncentiveProgram, error)
+	SaveProgram(ctx context.Context, program *entities.ReviewIncentiveProgram) error
+
+	HasReward(ctx context.Context, userID, productID uuid.UUID) (bool, error)
+	CountUserRewardsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+
+	// Grant records the reward and, in the same transaction, issues the coupon or adds the loyalty points
+	Grant(ctx context.Context, reward *entities.ReviewIncentiveReward, coupon *entities.Coupon) error
+	ListRewards(ctx context.Context, limit, offset int) ([]*entities.ReviewIncentiveReward, int64, error)
+
+	// Reporting
+	CountReviewsCreated(ctx context.Context, from, to time.Time) (int64, error)
+	GetRewardStats(ctx context.Context, from, to time.Time) (*ReviewIncentiveRewardStats, error)
+}
+
+// ReviewIncentiveRewardStats summarizes the rewards granted in a period
+type ReviewIncentiveRewardStats struct {
+	Rewards         int64 `json:"rewards"`
+	PointsGranted   int64 `json:"points_granted"`
+	CouponsIssued   int64 `json:"coupons_issued"`
+	CouponsRedeemed int64 `json:"coupons_redeemed"`
+}
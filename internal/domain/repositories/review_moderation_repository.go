@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ReviewModerationRepository defines the interface for review moderation settings and the
+// reviewer history they are applied with
+type ReviewModerationRepository interface {
+	// GetSettings returns the settings, or ErrReviewModerationSettingsNotFound if they were never saved
+	GetSettings(ctx context.Context) (*entities.ReviewModerationSettings, error)
+	SaveSettings(ctx context.Context, settings *entities.ReviewModerationSettings) error
+
+	CountUserReviewsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+	GetReviewerStats(ctx context.Context, userID uuid.UUID) (*entities.ReviewerStats, error)
+
+	// ListReviewsSince lists the most recent reviews written since a time, newest first
+	ListReviewsSince(ctx context.Context, since time.Time, limit int) ([]*entities.Review, error)
+}
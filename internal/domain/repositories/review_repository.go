@@ -47,6 +47,7 @@ type ReviewRepository interface {
 	GetAverageRating(ctx context.Context, productID uuid.UUID) (float64, error)
 	GetRatingDistribution(ctx context.Context, productID uuid.UUID) (map[int]int, error)
 	GetReviewStats(ctx context.Context, productID uuid.UUID) (*entities.ReviewSummary, error)
+	GetDimensionRatings(ctx context.Context, productID uuid.UUID) ([]entities.ReviewDimensionRating, error)
 	CountReviewsByStatus(ctx context.Context, status entities.ReviewStatus) (int64, error)
 
 	// Optimized bulk operations
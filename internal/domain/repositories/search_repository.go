@@ -124,7 +124,7 @@ type FullTextSearchParams struct {
 	MaxRating           *float64                    `json:"max_rating"`
 	Visibility          *entities.ProductVisibility `json:"visibility"`
 	ProductType         *entities.ProductType       `json:"product_type"`
-	Status              *entities.ProductStatus     `json:"status"`
+	Status              *entities.ProductStatus     `json:"status"` // Nil excludes discontinued and archived products
 	AvailabilityStatus  *string                     `json:"availability_status"` // in_stock, out_of_stock, low_stock
 	CreatedAfter        *time.Time                  `json:"created_after"`
 	CreatedBefore       *time.Time                  `json:"created_before"`
@@ -145,6 +145,9 @@ type FullTextSearchParams struct {
 	SortOrder   string                  `json:"sort_order"` // asc, desc
 	Limit       int                     `json:"limit"`
 	Offset      int                     `json:"offset"`
+
+	// ExcludeIDs removes products hidden from the viewer by catalog visibility rules
+	ExcludeIDs []uuid.UUID `json:"-"`
 }
 
 // EnhancedSearchParams represents enhanced search parameters with dynamic faceting
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SettlementFilters represents filters for listing settlements
+type SettlementFilters struct {
+	Gateway  string
+	Currency string
+	Status   entities.SettlementStatus
+	DateFrom *time.Time
+	DateTo   *time.Time // Inclusive
+	Limit    int
+	Offset   int
+}
+
+// SettlementItemFilters represents filters for listing the items of a settlement
+type SettlementItemFilters struct {
+	Status entities.SettlementItemStatus
+	// DiscrepanciesOnly keeps mismatches and missing records, dropping matched and unmatched items
+	DiscrepanciesOnly bool
+	Limit             int
+	Offset            int
+}
+
+// SettlementRepository defines the interface for settlement persistence
+type SettlementRepository interface {
+	// ReplaceDay replaces a gateway's settlements for a day, with their items, so reconciling a
+	// day again leaves only the latest result
+	ReplaceDay(ctx context.Context, gateway string, date time.Time, settlements []*entities.Settlement) error
+	// GetByID gets a settlement without its items
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Settlement, error)
+	// List lists settlements, most recent day first
+	List(ctx context.Context, filters SettlementFilters) ([]*entities.Settlement, int64, error)
+	// GetItems lists the items of a settlement in the order they occurred
+	GetItems(ctx context.Context, settlementID uuid.UUID, filters SettlementItemFilters) ([]*entities.SettlementItem, int64, error)
+}
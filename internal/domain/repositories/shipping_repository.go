@@ -24,4 +24,6 @@ type ShippingRepository interface {
 	CreateReturn(ctx context.Context, returnEntity *entities.Return) error
 	GetReturnByID(ctx context.Context, id uuid.UUID) (*entities.Return, error)
 	UpdateReturn(ctx context.Context, returnEntity *entities.Return) error
+	// GetReturnsByOrder gets an order's returns with their items, newest first
+	GetReturnsByOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.Return, error)
 }
@@ -0,0 +1,18 @@
+package repositories
+
+import "context"
+
+// SystemRepository defines the interface for statistics of the database itself
+type SystemRepository interface {
+	GetDatabaseStats(ctx context.Context) (*DatabaseStats, error)
+}
+
+// DatabaseStats describes the size and load of the database
+type DatabaseStats struct {
+	SizeBytes        int64
+	TableCount       int
+	ConnectionCount  int   // Connections to the database from all clients
+	TransactionCount int64 // Committed and rolled back transactions since statistics were last reset
+	PoolOpen         int   // Connections this instance's pool holds open
+	PoolInUse        int   // Of those, connections running a query
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// TaxExemptionFilters represents filters for listing tax exemption certificates
+type TaxExemptionFilters struct {
+	Status entities.TaxExemptionStatus
+	UserID *uuid.UUID
+	Limit  int
+	Offset int
+}
+
+// TaxExemptionRepository defines the interface for tax exemption certificate persistence
+type TaxExemptionRepository interface {
+	Create(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.TaxExemptionCertificate, error)
+	Update(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListByUser lists a customer's certificates, newest first
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entities.TaxExemptionCertificate, error)
+	// List lists certificates for review, oldest first
+	List(ctx context.Context, filters TaxExemptionFilters) ([]*entities.TaxExemptionCertificate, int64, error)
+	// ListActiveByUser lists a customer's verified certificates that have not expired
+	ListActiveByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]*entities.TaxExemptionCertificate, error)
+	// ListDueForExpiryNotice lists verified certificates that have expired, and those expiring
+	// before remindBefore whose customer was not reminded yet, soonest expiry first
+	ListDueForExpiryNotice(ctx context.Context, now, remindBefore time.Time, limit int) ([]*entities.TaxExemptionCertificate, error)
+}
@@ -47,9 +47,23 @@ type UserRepository interface {
 	// UpdatePassword updates user password
 	UpdatePassword(ctx context.Context, userID uuid.UUID, hashedPassword string) error
 
+	// ListPasswordHashes returns the ID and password hash of up to limit users with a password,
+	// ordered by ID and starting after afterID
+	ListPasswordHashes(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.User, error)
+
 	// SetActive sets user active status
 	SetActive(ctx context.Context, userID uuid.UUID, isActive bool) error
 
+	// SetDataRegion sets the data region a user's personal data is kept in
+	SetDataRegion(ctx context.Context, userID uuid.UUID, region string) error
+
+	// SetDataRegionAccess sets the regions whose customer data a staff user may export; empty
+	// for every region
+	SetDataRegionAccess(ctx context.Context, userID uuid.UUID, regions []string) error
+
+	// TagUntaggedDataRegion sets the data region of users that have none, returning how many
+	TagUntaggedDataRegion(ctx context.Context, region string) (int64, error)
+
 	// Additional methods for admin dashboard
 	CountUsers(ctx context.Context) (int64, error)
 	CountActiveUsers(ctx context.Context) (int64, error)
@@ -152,6 +166,8 @@ type UserActivityRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.UserActivity, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.UserActivity, error)
 	GetByUserIDAndType(ctx context.Context, userID uuid.UUID, activityType entities.ActivityType, limit, offset int) ([]*entities.UserActivity, error)
+	GetByUserIDAndTypes(ctx context.Context, userID uuid.UUID, activityTypes []entities.ActivityType, limit, offset int) ([]*entities.UserActivity, error)
+	CountByUserIDAndTypes(ctx context.Context, userID uuid.UUID, activityTypes []entities.ActivityType) (int64, error)
 	GetRecentActivity(ctx context.Context, userID uuid.UUID, since time.Time) ([]*entities.UserActivity, error)
 
 	// Analytics
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// VendorFilters represents filters for listing vendors
+type VendorFilters struct {
+	Status entities.VendorStatus
+	Search string // Name, slug or email
+	Limit  int
+	Offset int
+}
+
+// VendorRepository defines the interface for vendor and commission settings persistence
+type VendorRepository interface {
+	Create(ctx context.Context, vendor *entities.Vendor) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Vendor, error)
+	GetBySlug(ctx context.Context, slug string) (*entities.Vendor, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.Vendor, error)
+	Update(ctx context.Context, vendor *entities.Vendor) error
+	List(ctx context.Context, filters VendorFilters) ([]*entities.Vendor, int64, error)
+
+	// AssignProducts makes the vendor the seller of the products, or the store itself when
+	// vendorID is nil, and returns how many products were found
+	AssignProducts(ctx context.Context, vendorID *uuid.UUID, productIDs []uuid.UUID) (int64, error)
+	// GetVendorIDsByProductIDs maps the products that have a vendor to their vendor
+	GetVendorIDsByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	// CountPublishedProducts counts the active products among productIDs
+	CountPublishedProducts(ctx context.Context, productIDs []uuid.UUID) (int64, error)
+
+	ListCategoryCommissions(ctx context.Context) ([]*entities.CategoryCommission, error)
+	// SaveCategoryCommission creates or replaces the commission of a category
+	SaveCategoryCommission(ctx context.Context, commission *entities.CategoryCommission) error
+	DeleteCategoryCommission(ctx context.Context, categoryID uuid.UUID) error
+
+	ListVendorCommissions(ctx context.Context, vendorID uuid.UUID) ([]*entities.VendorCommission, error)
+	// SaveVendorCommission creates or replaces a vendor's override for its category, or for all
+	// categories when it has none
+	SaveVendorCommission(ctx context.Context, commission *entities.VendorCommission) error
+	DeleteVendorCommission(ctx context.Context, vendorID, id uuid.UUID) error
+}
+
+// VendorOrderFilters represents filters for listing vendor orders
+type VendorOrderFilters struct {
+	VendorID *uuid.UUID
+	DateFrom *time.Time
+	DateTo   *time.Time // Exclusive
+	Limit    int
+	Offset   int
+}
+
+// VendorOrderRepository defines the interface for vendor order persistence
+type VendorOrderRepository interface {
+	// CreateForOrder saves the vendor orders of an order with their fee lines
+	CreateForOrder(ctx context.Context, vendorOrders []*entities.VendorOrder) error
+	// GetByOrderID gets the vendor orders of an order with their fees and refunds
+	GetByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error)
+	// List lists vendor orders with their fees, most recent first
+	List(ctx context.Context, filters VendorOrderFilters) ([]*entities.VendorOrder, int64, error)
+	// AddRefunds records vendor orders' shares of a refund and adds them to the vendor orders'
+	// totals. Shares of a refund that was already recorded are skipped.
+	AddRefunds(ctx context.Context, refunds []*entities.VendorOrderRefund) error
+
+	// ListPaid lists a vendor's vendor orders created from from up to to whose order was paid
+	ListPaid(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]*entities.VendorOrder, error)
+	// ListRefunds lists a vendor's refund shares recorded from from up to to
+	ListRefunds(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]*entities.VendorOrderRefund, error)
+}
+
+// VendorApplicationFilters represents filters for listing vendor applications
+type VendorApplicationFilters struct {
+	Status entities.VendorApplicationStatus
+	Limit  int
+	Offset int
+}
+
+// VendorApplicationRepository defines the interface for vendor application persistence
+type VendorApplicationRepository interface {
+	// Create saves a new application together with its pending vendor
+	Create(ctx context.Context, application *entities.VendorApplication, vendor *entities.Vendor) error
+	// GetByID gets an application with its vendor and documents
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.VendorApplication, error)
+	// GetByUserID gets the application of an account with its vendor and documents
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error)
+	Update(ctx context.Context, application *entities.VendorApplication) error
+	// SaveReview saves a review decision and sets the vendor's status with it
+	SaveReview(ctx context.Context, application *entities.VendorApplication, vendorStatus entities.VendorStatus) error
+	// List lists applications with their vendors, oldest submission first
+	List(ctx context.Context, filters VendorApplicationFilters) ([]*entities.VendorApplication, int64, error)
+
+	AddDocument(ctx context.Context, document *entities.VendorDocument) error
+	GetDocument(ctx context.Context, applicationID, id uuid.UUID) (*entities.VendorDocument, error)
+	DeleteDocument(ctx context.Context, id uuid.UUID) error
+}
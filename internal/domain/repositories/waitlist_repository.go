@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistFilters represents filters for listing product waitlists
+type WaitlistFilters struct {
+	Status entities.WaitlistStatus
+	Limit  int
+	Offset int
+}
+
+// WaitlistCounts counts a waitlist's customers
+type WaitlistCounts struct {
+	Joined   int64
+	Notified int64
+}
+
+// WaitlistRepository defines the interface for product waitlists and the customers on them
+type WaitlistRepository interface {
+	Create(ctx context.Context, waitlist *entities.ProductWaitlist) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.ProductWaitlist, error)
+	// GetByProduct gets a product's waitlist
+	GetByProduct(ctx context.Context, productID uuid.UUID) (*entities.ProductWaitlist, error)
+	Update(ctx context.Context, waitlist *entities.ProductWaitlist) error
+	// List lists waitlists, soonest launch first
+	List(ctx context.Context, filters WaitlistFilters) ([]*entities.ProductWaitlist, int64, error)
+	// GetDueForWave returns active waitlists that have opened and whose next wave is due, soonest
+	// opening first
+	GetDueForWave(ctx context.Context, now time.Time, limit int) ([]*entities.ProductWaitlist, error)
+
+	// Join adds a customer to a waitlist, keeping their place when they already joined
+	Join(ctx context.Context, entry *entities.WaitlistEntry) error
+	Leave(ctx context.Context, waitlistID, userID uuid.UUID) error
+	GetEntry(ctx context.Context, waitlistID, userID uuid.UUID) (*entities.WaitlistEntry, error)
+	// ListEntriesByUser lists the waitlists a customer is on, newest first
+	ListEntriesByUser(ctx context.Context, userID uuid.UUID) ([]*entities.WaitlistEntry, error)
+	// GetEntriesForWave returns customers not yet notified whose access has opened, in the order
+	// they joined
+	GetEntriesForWave(ctx context.Context, waitlistID uuid.UUID, audiences entities.WaitlistAudiences, limit int) ([]*entities.WaitlistEntry, error)
+	MarkNotified(ctx context.Context, ids []uuid.UUID, wave int, at time.Time) error
+
+	// CountEntries counts the customers of each waitlist
+	CountEntries(ctx context.Context, waitlistIDs []uuid.UUID) (map[uuid.UUID]WaitlistCounts, error)
+	// GetDemand counts a waitlist's customers by membership tier and segment
+	GetDemand(ctx context.Context, waitlistID uuid.UUID) ([]*entities.WaitlistDemand, error)
+}
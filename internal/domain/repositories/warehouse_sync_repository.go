@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// WarehouseSyncRepository defines the interface for exporting tables to the data warehouse
+type WarehouseSyncRepository interface {
+	GetStates(ctx context.Context) ([]*entities.WarehouseSyncState, error)
+	SaveState(ctx context.Context, state *entities.WarehouseSyncState) error
+
+	// GetChangedRows returns the rows of a table whose (updated_at, id) sorts after the
+	// watermark, up to updated_at until, in that order. A nil watermark starts from the first row.
+	GetChangedRows(ctx context.Context, schema entities.WarehouseTableSchema, afterAt *time.Time, afterID *uuid.UUID, until time.Time, limit int) ([]entities.WarehouseRow, error)
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// DataResidencyService decides which data region customers and their files belong to, and which
+// regions' customer data an admin may export
+type DataResidencyService interface {
+	DefaultRegion() string
+	// Regions returns every region, starting with the default region
+	Regions() []string
+	IsRegion(region string) bool
+	// RegionForCountry returns the region of an ISO 3166-1 alpha-2 country, or the default region
+	RegionForCountry(country string) string
+	// UserRegion returns the region of a user, or the default region for untagged users
+	UserRegion(ctx context.Context, userID uuid.UUID) (string, error)
+	// ExportRegions returns the regions whose customer data the acting admin may export, or nil
+	// when they may export every region
+	ExportRegions(ctx context.Context) ([]string, error)
+	// CanExport checks if the acting admin may export data limited to the regions, where nil
+	// stands for every region
+	CanExport(ctx context.Context, regions []string) error
+}
+
+// DataResidencySettings configures the data regions
+type DataResidencySettings struct {
+	DefaultRegion  string
+	Regions        []string          // Every region, the default included
+	CountryRegions map[string]string // Region by upper case country code
+}
+
+type dataResidencyService struct {
+	userRepo repositories.UserRepository
+	settings DataResidencySettings
+}
+
+// NewDataResidencyService creates a new data residency service
+func NewDataResidencyService(userRepo repositories.UserRepository, settings DataResidencySettings) DataResidencyService {
+	return &dataResidencyService{
+		userRepo: userRepo,
+		settings: settings,
+	}
+}
+
+// DefaultRegion returns the region of customers whose country maps to no region
+func (s *dataResidencyService) DefaultRegion() string {
+	return s.settings.DefaultRegion
+}
+
+// Regions returns every region
+func (s *dataResidencyService) Regions() []string {
+	return append([]string(nil), s.settings.Regions...)
+}
+
+// IsRegion checks if a region is configured
+func (s *dataResidencyService) IsRegion(region string) bool {
+	return containsRegion(s.settings.Regions, region)
+}
+
+// RegionForCountry maps a country to its region
+func (s *dataResidencyService) RegionForCountry(country string) string {
+	if region, ok := s.settings.CountryRegions[strings.ToUpper(country)]; ok && s.IsRegion(region) {
+		return region
+	}
+	return s.settings.DefaultRegion
+}
+
+// UserRegion looks up the region a user is tagged with
+func (s *dataResidencyService) UserRegion(ctx context.Context, userID uuid.UUID) (string, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.DataRegion == "" {
+		return s.settings.DefaultRegion, nil
+	}
+	return user.DataRegion, nil
+}
+
+// ExportRegions reads the acting admin's region access. Background jobs and callers without a
+// user may export every region.
+func (s *dataResidencyService) ExportRegions(ctx context.Context) ([]string, error) {
+	actor := entities.ActorFromContext(ctx)
+	if !actor.IsAuthenticated() {
+		return nil, nil
+	}
+	user, err := s.userRepo.GetByID(ctx, actor.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data region access: %w", err)
+	}
+	if len(user.DataRegionAccess) == 0 {
+		return nil, nil
+	}
+	return append([]string(nil), user.DataRegionAccess...), nil
+}
+
+// CanExport checks the acting admin's region access covers the regions
+func (s *dataResidencyService) CanExport(ctx context.Context, regions []string) error {
+	allowed, err := s.ExportRegions(ctx)
+	if err != nil {
+		return err
+	}
+	if allowed == nil {
+		return nil
+	}
+	if regions == nil {
+		return entities.ErrDataRegionDenied
+	}
+	for _, region := range regions {
+		if !containsRegion(allowed, region) {
+			return entities.ErrDataRegionDenied
+		}
+	}
+	return nil
+}
+
+func containsRegion(regions []string, region string) bool {
+	for _, candidate := range regions {
+		if candidate == region {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,8 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/msgtemplate"
 
 	"github.com/google/uuid"
 )
@@ -138,7 +140,7 @@ func (s *emailService) SendTemplateEmail(ctx context.Context, templateName strin
 
 	// Create email
 	email := &entities.Email{
-		ID:           uuid.New(),
+		ID:           ids.New(),
 		Type:         template.Type,
 		Priority:     entities.EmailPriorityNormal,
 		Status:       entities.EmailStatusPending,
@@ -248,13 +250,9 @@ func (s *emailService) RenderTemplate(ctx context.Context, templateName string,
 	return subject, bodyText, bodyHTML, nil
 }
 
-// renderString performs simple variable substitution
+// renderString performs simple variable substitution of {{name}} and {{.name}} placeholders
 func (s *emailService) renderString(template string, data map[string]interface{}) string {
-	result := template
-	for key, value := range data {
-		placeholder := fmt.Sprintf("{{%s}}", key)
-		result = strings.ReplaceAll(result, placeholder, fmt.Sprintf("%v", value))
-	}
+	result, _ := msgtemplate.Render(template, data)
 	return result
 }
 
@@ -383,7 +381,7 @@ func (s *emailService) SendNotificationEmail(ctx context.Context, notification *
 
 	// Create email entity
 	email := &entities.Email{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		ToEmail:   notification.Recipient,
 		ToName:    "", // We don't have recipient name in notification
 		FromEmail: s.defaultFromEmail,
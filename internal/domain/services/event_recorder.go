@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// EventRecorder records domain events in the outbox, from where the event bridge publishes them
+type EventRecorder interface {
+	// Record stores an event about a change that was just saved. A failure is logged rather
+	// than returned, since the change itself already succeeded.
+	Record(ctx context.Context, eventType entities.DomainEventType, aggregateID uuid.UUID, data interface{})
+}
+
+type eventRecorder struct {
+	outboxRepo repositories.OutboxEventRepository
+}
+
+// NewEventRecorder creates a new event recorder
+func NewEventRecorder(outboxRepo repositories.OutboxEventRepository) EventRecorder {
+	return &eventRecorder{
+		outboxRepo: outboxRepo,
+	}
+}
+
+// Record stores a domain event in the outbox
+func (r *eventRecorder) Record(ctx context.Context, eventType entities.DomainEventType, aggregateID uuid.UUID, data interface{}) {
+	event, err := entities.NewOutboxEvent(eventType, aggregateID, data)
+	if err == nil {
+		err = r.outboxRepo.Create(context.WithoutCancel(ctx), event)
+	}
+	if err != nil {
+		log.Printf("❌ Failed to record %s event for %s: %v", eventType, aggregateID, err)
+	}
+}
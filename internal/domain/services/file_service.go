@@ -12,6 +12,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/storage"
+	"ecom-golang-clean-architecture/pkg/ids"
 )
 
 // FileService quản lý các operations với file
@@ -36,17 +37,20 @@ type FileService interface {
 }
 
 type fileService struct {
-	storageProvider storage.StorageProvider
+	storage         storage.RegionalStorage
 	fileRepo        repositories.FileRepository
 	securityService FileSecurityService
+	residency       DataResidencyService
 }
 
-// NewFileService tạo file service mới
-func NewFileService(storageProvider storage.StorageProvider, fileRepo repositories.FileRepository, securityService FileSecurityService) FileService {
+// NewFileService tạo file service mới. Files are stored in the storage of their data region:
+// user uploads in the uploader's region, admin and public uploads in the default region.
+func NewFileService(regionalStorage storage.RegionalStorage, fileRepo repositories.FileRepository, securityService FileSecurityService, residency DataResidencyService) FileService {
 	return &fileService{
-		storageProvider: storageProvider,
+		storage:         regionalStorage,
 		fileRepo:        fileRepo,
 		securityService: securityService,
+		residency:       residency,
 	}
 }
 
@@ -83,15 +87,21 @@ func (fs *fileService) UploadFile(ctx context.Context, req *entities.FileUploadR
 		return nil, fmt.Errorf("invalid upload type: %s", req.UploadType)
 	}
 
+	region, err := fs.uploadRegion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data region of upload: %w", err)
+	}
+	storageProvider := fs.storage.ForRegion(region)
+
 	// Upload file to storage
-	fileURL, err := fs.storageProvider.UploadFile(file, objectKey, header.Header.Get("Content-Type"))
+	fileURL, err := storageProvider.UploadFile(file, objectKey, header.Header.Get("Content-Type"))
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload file to storage: %w", err)
 	}
 
 	// Create file upload record
 	fileUpload := &entities.FileUpload{
-		ID:           uuid.New().String(),
+		ID:           ids.New().String(),
 		FileName:     fileName,
 		OriginalName: header.Filename,
 		ObjectKey:    objectKey,
@@ -101,6 +111,7 @@ func (fs *fileService) UploadFile(ctx context.Context, req *entities.FileUploadR
 		UploadedBy:   req.UploadedBy,
 		UploadType:   req.UploadType,
 		Category:     req.Category,
+		DataRegion:   region,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
@@ -108,7 +119,7 @@ func (fs *fileService) UploadFile(ctx context.Context, req *entities.FileUploadR
 	// Save to database
 	if err := fs.fileRepo.CreateFileUpload(ctx, fileUpload); err != nil {
 		// Try to cleanup uploaded file if database save fails
-		if deleteErr := fs.storageProvider.DeleteFile(objectKey); deleteErr != nil {
+		if deleteErr := storageProvider.DeleteFile(objectKey); deleteErr != nil {
 			// Log the cleanup error but don't override the original error
 			fmt.Printf("Warning: failed to cleanup uploaded file after database error: %v\n", deleteErr)
 		}
@@ -134,7 +145,7 @@ func (fs *fileService) DeleteFile(ctx context.Context, id string) error {
 	}
 
 	// Delete from storage
-	if err := fs.storageProvider.DeleteFile(fileUpload.ObjectKey); err != nil {
+	if err := fs.storage.ForRegion(fileUpload.DataRegion).DeleteFile(fileUpload.ObjectKey); err != nil {
 		return fmt.Errorf("failed to delete file from storage: %w", err)
 	}
 
@@ -147,7 +158,19 @@ func (fs *fileService) DeleteFile(ctx context.Context, id string) error {
 }
 
 func (fs *fileService) GetFileURL(objectKey string) string {
-	return fs.storageProvider.GetFileURL(objectKey)
+	return fs.storage.ForRegion(fs.residency.DefaultRegion()).GetFileURL(objectKey)
+}
+
+// uploadRegion picks the data region whose storage an upload goes to
+func (fs *fileService) uploadRegion(ctx context.Context, req *entities.FileUploadRequest) (string, error) {
+	if req.UploadType != entities.FileUploadTypeUser || req.UploadedBy == nil {
+		return fs.residency.DefaultRegion(), nil
+	}
+	userID, err := uuid.Parse(*req.UploadedBy)
+	if err != nil {
+		return fs.residency.DefaultRegion(), nil
+	}
+	return fs.residency.UserRegion(ctx, userID)
 }
 
 func (fs *fileService) GetFileUpload(ctx context.Context, id string) (*entities.FileUpload, error) {
@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// MarketplaceFeeService splits orders between the vendors whose products were ordered and
+// works out the fees the marketplace keeps
+type MarketplaceFeeService interface {
+	// SplitOrder creates a vendor order, with a fee line per commission and fixed fee, for each
+	// vendor with items in the order. An order that was already split keeps its vendor orders.
+	SplitOrder(ctx context.Context, order *entities.Order) ([]*entities.VendorOrder, error)
+	// RecordRefund shares a completed refund of an order between its vendor orders
+	RecordRefund(ctx context.Context, order *entities.Order, refund *entities.Refund) error
+	// ResolveCommission finds the commission rate and fixed fee for a vendor's item in a category
+	ResolveCommission(ctx context.Context, vendorID uuid.UUID, categoryID *uuid.UUID) (*ResolvedCommission, error)
+}
+
+// ResolvedCommission is the commission that applies to an item and the setting it came from
+type ResolvedCommission struct {
+	Rate       float64                   `json:"rate"`
+	FixedFee   float64                   `json:"fixed_fee"`
+	Source     entities.CommissionSource `json:"source"`
+	CategoryID *uuid.UUID                `json:"category_id,omitempty"` // Category the setting is for, an ancestor of the item's category for inherited settings
+}
+
+type marketplaceFeeService struct {
+	vendorRepo          repositories.VendorRepository
+	vendorOrderRepo     repositories.VendorOrderRepository
+	productCategoryRepo repositories.ProductCategoryRepository
+	categoryRepo        repositories.CategoryRepository
+	defaultRate         float64
+}
+
+// NewMarketplaceFeeService creates a new marketplace fee service. defaultRate is the commission
+// percent kept when neither the category nor the vendor has a rate.
+func NewMarketplaceFeeService(
+	vendorRepo repositories.VendorRepository,
+	vendorOrderRepo repositories.VendorOrderRepository,
+	productCategoryRepo repositories.ProductCategoryRepository,
+	categoryRepo repositories.CategoryRepository,
+	defaultRate float64,
+) MarketplaceFeeService {
+	return &marketplaceFeeService{
+		vendorRepo:          vendorRepo,
+		vendorOrderRepo:     vendorOrderRepo,
+		productCategoryRepo: productCategoryRepo,
+		categoryRepo:        categoryRepo,
+		defaultRate:         defaultRate,
+	}
+}
+
+// commissionRules are the commission settings that apply to one vendor
+type commissionRules struct {
+	categories       map[uuid.UUID]*entities.CategoryCommission
+	vendorAll        *entities.VendorCommission
+	vendorByCategory map[uuid.UUID]*entities.VendorCommission
+}
+
+// SplitOrder creates the vendor orders of an order
+func (s *marketplaceFeeService) SplitOrder(ctx context.Context, order *entities.Order) ([]*entities.VendorOrder, error) {
+	existing, err := s.vendorOrderRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor orders: %w", err)
+	}
+	if len(existing) > 0 {
+		return existing, nil
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(order.Items))
+	for _, item := range order.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+	vendorIDs, err := s.vendorRepo.GetVendorIDsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product vendors: %w", err)
+	}
+	if len(vendorIDs) == 0 {
+		return nil, nil
+	}
+
+	categoryCommissions, err := s.categoryCommissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := order.Currency
+	exponent := money.Exponent(currency)
+	createdAt := order.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	var vendorOrders []*entities.VendorOrder
+	byVendor := make(map[uuid.UUID]*entities.VendorOrder)
+	rulesByVendor := make(map[uuid.UUID]*commissionRules)
+	for _, item := range order.Items {
+		vendorID, ok := vendorIDs[item.ProductID]
+		if !ok {
+			continue
+		}
+
+		vendorOrder := byVendor[vendorID]
+		if vendorOrder == nil {
+			vendorOrder = &entities.VendorOrder{
+				OrderID:     order.ID,
+				VendorID:    vendorID,
+				OrderNumber: order.OrderNumber,
+				Currency:    currency,
+				Exponent:    exponent,
+				CreatedAt:   createdAt,
+			}
+			byVendor[vendorID] = vendorOrder
+			vendorOrders = append(vendorOrders, vendorOrder)
+		}
+
+		rules := rulesByVendor[vendorID]
+		if rules == nil {
+			rules, err = s.vendorRules(ctx, vendorID, categoryCommissions)
+			if err != nil {
+				return nil, err
+			}
+			rulesByVendor[vendorID] = rules
+		}
+
+		var categoryID *uuid.UUID
+		if category, err := s.productCategoryRepo.GetPrimaryCategory(ctx, item.ProductID); err == nil && category != nil {
+			categoryID = &category.ID
+		}
+		commission, err := s.resolve(ctx, rules, categoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		gross := money.ToMinor(item.Total, currency)
+		vendorOrder.ItemCount += item.Quantity
+		vendorOrder.GrossAmount += gross
+
+		commissionAmount := int64(math.Round(float64(gross) * commission.Rate / 100))
+		vendorOrder.CommissionAmount += commissionAmount
+		vendorOrder.Fees = append(vendorOrder.Fees, entities.VendorOrderFee{
+			OrderItemID: item.ID,
+			ProductID:   item.ProductID,
+			CategoryID:  categoryID,
+			Type:        entities.VendorOrderFeeTypeCommission,
+			Source:      commission.Source,
+			Rate:        commission.Rate,
+			Quantity:    item.Quantity,
+			BaseAmount:  gross,
+			Amount:      commissionAmount,
+		})
+
+		if commission.FixedFee > 0 {
+			unitFee := money.ToMinor(commission.FixedFee, currency)
+			fixedAmount := unitFee * int64(item.Quantity)
+			vendorOrder.FixedFeeAmount += fixedAmount
+			vendorOrder.Fees = append(vendorOrder.Fees, entities.VendorOrderFee{
+				OrderItemID: item.ID,
+				ProductID:   item.ProductID,
+				CategoryID:  categoryID,
+				Type:        entities.VendorOrderFeeTypeFixed,
+				Source:      commission.Source,
+				Quantity:    item.Quantity,
+				BaseAmount:  unitFee,
+				Amount:      fixedAmount,
+			})
+		}
+	}
+
+	if err := s.vendorOrderRepo.CreateForOrder(ctx, vendorOrders); err != nil {
+		return nil, fmt.Errorf("failed to save vendor orders: %w", err)
+	}
+	return vendorOrders, nil
+}
+
+// RecordRefund shares a refund between the vendor orders of an order in proportion to their
+// share of the order total. The commission on the refunded share is given back to the vendor;
+// fixed fees are not.
+func (s *marketplaceFeeService) RecordRefund(ctx context.Context, order *entities.Order, refund *entities.Refund) error {
+	vendorOrders, err := s.vendorOrderRepo.GetByOrderID(ctx, order.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get vendor orders: %w", err)
+	}
+	if len(vendorOrders) == 0 {
+		return nil
+	}
+
+	orderTotal := money.ToMinor(order.Total, order.Currency)
+	refundAmount := money.ToMinor(refund.Amount, order.Currency)
+	if orderTotal <= 0 || refundAmount <= 0 {
+		return nil
+	}
+
+	refundedAt := time.Now()
+	if refund.ProcessedAt != nil {
+		refundedAt = *refund.ProcessedAt
+	}
+
+	var shares []*entities.VendorOrderRefund
+	for _, vendorOrder := range vendorOrders {
+		share := int64(math.Round(float64(refundAmount) * float64(vendorOrder.GrossAmount) / float64(orderTotal)))
+		if remaining := vendorOrder.GrossAmount - vendorOrder.RefundedAmount; share > remaining {
+			share = remaining
+		}
+		if share <= 0 {
+			continue
+		}
+
+		var commissionRefunded int64
+		if vendorOrder.GrossAmount > 0 {
+			commissionRefunded = int64(math.Round(float64(share) * float64(vendorOrder.CommissionAmount) / float64(vendorOrder.GrossAmount)))
+		}
+		if remaining := vendorOrder.CommissionAmount - vendorOrder.CommissionRefunded; commissionRefunded > remaining {
+			commissionRefunded = remaining
+		}
+
+		shares = append(shares, &entities.VendorOrderRefund{
+			VendorOrderID:      vendorOrder.ID,
+			VendorID:           vendorOrder.VendorID,
+			RefundID:           refund.ID,
+			Currency:           vendorOrder.Currency,
+			Exponent:           vendorOrder.Exponent,
+			Amount:             share,
+			CommissionRefunded: commissionRefunded,
+			CreatedAt:          refundedAt,
+		})
+	}
+	if len(shares) == 0 {
+		return nil
+	}
+
+	if err := s.vendorOrderRepo.AddRefunds(ctx, shares); err != nil {
+		return fmt.Errorf("failed to record vendor refunds: %w", err)
+	}
+	return nil
+}
+
+// ResolveCommission finds the commission for a vendor's item in a category
+func (s *marketplaceFeeService) ResolveCommission(ctx context.Context, vendorID uuid.UUID, categoryID *uuid.UUID) (*ResolvedCommission, error) {
+	categoryCommissions, err := s.categoryCommissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.vendorRules(ctx, vendorID, categoryCommissions)
+	if err != nil {
+		return nil, err
+	}
+	return s.resolve(ctx, rules, categoryID)
+}
+
+// resolve picks, in order: the vendor's override for the category or its nearest ancestor
+// with one, the vendor's override for all categories, the rate of the category or its nearest
+// ancestor with one, and the default rate
+func (s *marketplaceFeeService) resolve(ctx context.Context, rules *commissionRules, categoryID *uuid.UUID) (*ResolvedCommission, error) {
+	var lineage []uuid.UUID
+	if categoryID != nil {
+		path, err := s.categoryRepo.GetCategoryPath(ctx, *categoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category path: %w", err)
+		}
+		// The path runs from the root down to the category
+		for i := len(path) - 1; i >= 0; i-- {
+			lineage = append(lineage, path[i].ID)
+		}
+		if len(lineage) == 0 {
+			lineage = []uuid.UUID{*categoryID}
+		}
+	}
+
+	for _, id := range lineage {
+		if override, ok := rules.vendorByCategory[id]; ok {
+			id := id
+			return &ResolvedCommission{Rate: override.Rate, FixedFee: override.FixedFee, Source: entities.CommissionSourceVendorCategory, CategoryID: &id}, nil
+		}
+	}
+	if rules.vendorAll != nil {
+		return &ResolvedCommission{Rate: rules.vendorAll.Rate, FixedFee: rules.vendorAll.FixedFee, Source: entities.CommissionSourceVendor}, nil
+	}
+	for _, id := range lineage {
+		if commission, ok := rules.categories[id]; ok {
+			id := id
+			return &ResolvedCommission{Rate: commission.Rate, FixedFee: commission.FixedFee, Source: entities.CommissionSourceCategory, CategoryID: &id}, nil
+		}
+	}
+	return &ResolvedCommission{Rate: s.defaultRate, Source: entities.CommissionSourceDefault}, nil
+}
+
+func (s *marketplaceFeeService) categoryCommissions(ctx context.Context) (map[uuid.UUID]*entities.CategoryCommission, error) {
+	commissions, err := s.vendorRepo.ListCategoryCommissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category commissions: %w", err)
+	}
+	byCategory := make(map[uuid.UUID]*entities.CategoryCommission, len(commissions))
+	for _, commission := range commissions {
+		byCategory[commission.CategoryID] = commission
+	}
+	return byCategory, nil
+}
+
+func (s *marketplaceFeeService) vendorRules(ctx context.Context, vendorID uuid.UUID, categories map[uuid.UUID]*entities.CategoryCommission) (*commissionRules, error) {
+	overrides, err := s.vendorRepo.ListVendorCommissions(ctx, vendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor commissions: %w", err)
+	}
+
+	rules := &commissionRules{
+		categories:       categories,
+		vendorByCategory: make(map[uuid.UUID]*entities.VendorCommission),
+	}
+	for _, override := range overrides {
+		if override.CategoryID == nil {
+			rules.vendorAll = override
+		} else {
+			rules.vendorByCategory[*override.CategoryID] = override
+		}
+	}
+	return rules, nil
+}
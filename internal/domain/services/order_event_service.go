@@ -8,6 +8,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 )
 
@@ -47,8 +48,13 @@ func NewOrderEventService(eventRepo repositories.OrderEventRepository) OrderEven
 
 // CreateEvent creates a generic order event
 func (s *orderEventService) CreateEvent(ctx context.Context, orderID uuid.UUID, eventType entities.OrderEventType, title, description string, data interface{}, userID *uuid.UUID, isPublic bool) error {
+	// Callers that don't know who acted get the request's user, if any
+	if userID == nil {
+		userID = entities.ActorFromContext(ctx).UserID
+	}
+
 	event := &entities.OrderEvent{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		OrderID:     orderID,
 		EventType:   eventType,
 		Title:       title,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"log"
 	"math"
 	"math/big"
 	"time"
@@ -14,41 +15,79 @@ import (
 
 // OrderService handles order-related business logic
 type OrderService interface {
-	GenerateUniqueOrderNumber(ctx context.Context) (string, error)
+	// CreateOrder gives the order its order number and saves it
+	CreateOrder(ctx context.Context, order *entities.Order) error
 	CalculateOrderTotal(items []entities.CartItem, taxRate, shippingCost, discountAmount float64) (subtotal, taxAmount, total float64)
 	ValidateOrderItems(items []entities.CartItem) error
 }
 
 type orderService struct {
-	orderRepo repositories.OrderRepository
+	orderRepo    repositories.OrderRepository
+	sequenceRepo repositories.OrderNumberSequenceRepository
+	numbering    entities.OrderNumberFormat
+	events       EventRecorder
+	fees         MarketplaceFeeService
 }
 
 // NewOrderService creates a new order service
-func NewOrderService(orderRepo repositories.OrderRepository) OrderService {
+func NewOrderService(orderRepo repositories.OrderRepository, sequenceRepo repositories.OrderNumberSequenceRepository, numbering entities.OrderNumberFormat, events EventRecorder, fees MarketplaceFeeService) OrderService {
 	return &orderService{
-		orderRepo: orderRepo,
+		orderRepo:    orderRepo,
+		sequenceRepo: sequenceRepo,
+		numbering:    numbering,
+		events:       events,
+		fees:         fees,
 	}
 }
 
-// GenerateUniqueOrderNumber generates a unique order number
-func (s *orderService) GenerateUniqueOrderNumber(ctx context.Context) (string, error) {
+// CreateOrder numbers and saves an order. Gap-free sequential numbers are drawn in the
+// transaction that saves the order; all other numbers are generated up front.
+func (s *orderService) CreateOrder(ctx context.Context, order *entities.Order) error {
+	if err := s.saveNumberedOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if s.events != nil {
+		s.events.Record(ctx, entities.DomainEventOrderCreated, order.ID, entities.NewOrderCreatedEventData(order))
+	}
+
+	// The order is placed either way; an order that failed to split can be split again later
+	if s.fees != nil {
+		if _, err := s.fees.SplitOrder(ctx, order); err != nil {
+			log.Printf("❌ Failed to split order %s between vendors: %v", order.OrderNumber, err)
+		}
+	}
+	return nil
+}
+
+// saveNumberedOrder gives the order its number and saves it
+func (s *orderService) saveNumberedOrder(ctx context.Context, order *entities.Order) error {
+	now := time.Now()
+
+	if s.numbering.IsSequential() && s.numbering.GapFree {
+		scope, period := s.numbering.SequenceKey(order.Source, now)
+		return s.sequenceRepo.CreateOrderWithNextValue(ctx, order, scope, period, s.numbering.SequenceStart, func(value int64) string {
+			return s.numbering.Build(order.Source, now, value)
+		})
+	}
+
+	orderNumber, err := s.generateUniqueOrderNumber(ctx, order.Source, now)
+	if err != nil {
+		return err
+	}
+	order.OrderNumber = orderNumber
+	return s.orderRepo.Create(ctx, order)
+}
+
+// generateUniqueOrderNumber generates an order number no order has yet
+func (s *orderService) generateUniqueOrderNumber(ctx context.Context, source entities.OrderSource, now time.Time) (string, error) {
 	const maxAttempts = 10
 
 	for attempt := 0; attempt < maxAttempts; attempt++ {
-		// Generate order number with format: ORD-YYYYMMDD-HHMMSS-XXXX
-		now := time.Now()
-		dateStr := now.Format("20060102")
-		timeStr := now.Format("150405") // HHMMSS format
-
-		// Generate cryptographically secure random 4-digit number
-		// Using smaller random part since we have time component for uniqueness
-		randomBig, err := rand.Int(rand.Reader, big.NewInt(9000))
+		orderNumber, err := s.generateOrderNumber(ctx, source, now)
 		if err != nil {
-			return "", fmt.Errorf("failed to generate random number: %w", err)
+			return "", err
 		}
-		randomNum := randomBig.Int64() + 1000
-
-		orderNumber := fmt.Sprintf("ORD-%s-%s-%d", dateStr, timeStr, randomNum)
 
 		// Check if order number already exists
 		exists, err := s.orderRepo.ExistsByOrderNumber(ctx, orderNumber)
@@ -61,14 +100,41 @@ func (s *orderService) GenerateUniqueOrderNumber(ctx context.Context) (string, e
 		}
 
 		// Add small delay between attempts to reduce collision probability
-		if attempt < maxAttempts-1 {
+		if attempt < maxAttempts-1 && !s.numbering.IsSequential() {
 			time.Sleep(time.Millisecond * 10)
+			now = time.Now()
 		}
 	}
 
 	return "", fmt.Errorf("failed to generate unique order number after %d attempts", maxAttempts)
 }
 
+// generateOrderNumber generates an order number of the configured format
+func (s *orderService) generateOrderNumber(ctx context.Context, source entities.OrderSource, now time.Time) (string, error) {
+	if s.numbering.IsSequential() {
+		scope, period := s.numbering.SequenceKey(source, now)
+		value, err := s.sequenceRepo.NextValue(ctx, scope, period, s.numbering.SequenceStart)
+		if err != nil {
+			return "", fmt.Errorf("failed to draw order number sequence value: %w", err)
+		}
+		return s.numbering.Build(source, now, value), nil
+	}
+
+	// Generate order number with format: ORD-YYYYMMDD-HHMMSS-XXXX
+	dateStr := now.Format("20060102")
+	timeStr := now.Format("150405") // HHMMSS format
+
+	// Generate cryptographically secure random 4-digit number
+	// Using smaller random part since we have time component for uniqueness
+	randomBig, err := rand.Int(rand.Reader, big.NewInt(9000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random number: %w", err)
+	}
+	randomNum := randomBig.Int64() + 1000
+
+	return fmt.Sprintf("ORD-%s-%s-%d", dateStr, timeStr, randomNum), nil
+}
+
 // CalculateOrderTotal calculates the order totals
 func (s *orderService) CalculateOrderTotal(items []entities.CartItem, taxRate, shippingCost, discountAmount float64) (subtotal, taxAmount, total float64) {
 	// Validate inputs
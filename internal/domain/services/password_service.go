@@ -1,36 +1,217 @@
 package services
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Password hashing algorithms. Stored hashes carry their algorithm and parameters in the
+// standard modular crypt format ($2a$..., $argon2id$...), so hashes of every algorithm can be
+// checked side by side while users are moved to the configured one.
+const (
+	PasswordAlgorithmBcrypt   = "bcrypt"
+	PasswordAlgorithmArgon2id = "argon2id"
+)
+
+const (
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+)
+
+var (
+	// ErrPasswordMismatch is returned when a password does not match its hash
+	ErrPasswordMismatch = errors.New("password does not match")
+	// ErrUnknownPasswordHash is returned for hashes of an unsupported algorithm
+	ErrUnknownPasswordHash = errors.New("unknown password hash format")
+)
+
 // PasswordService handles password operations
 type PasswordService interface {
 	HashPassword(password string) (string, error)
 	CheckPassword(password, hashedPassword string) error
+	// NeedsRehash reports whether a hash was made with another algorithm or with other
+	// parameters than new hashes, so it should be replaced the next time the password is known
+	NeedsRehash(hashedPassword string) bool
+	// HashAlgorithm returns the algorithm of a stored hash, or "" when it is not recognized
+	HashAlgorithm(hashedPassword string) string
+}
+
+// PasswordHashSettings configures how new passwords are hashed
+type PasswordHashSettings struct {
+	Algorithm         string // PasswordAlgorithmArgon2id or PasswordAlgorithmBcrypt
+	BcryptCost        int
+	Argon2Memory      uint32 // KiB
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+// DefaultPasswordHashSettings returns argon2id with the parameters recommended by RFC 9106 for
+// memory-constrained servers
+func DefaultPasswordHashSettings() PasswordHashSettings {
+	return PasswordHashSettings{
+		Algorithm:         PasswordAlgorithmArgon2id,
+		BcryptCost:        bcrypt.DefaultCost,
+		Argon2Memory:      64 * 1024,
+		Argon2Iterations:  3,
+		Argon2Parallelism: 2,
+	}
 }
 
 type passwordService struct {
-	cost int
+	settings PasswordHashSettings
 }
 
-// NewPasswordService creates a new password service
-func NewPasswordService() PasswordService {
+// NewPasswordService creates a new password service. Unset settings fall back to
+// DefaultPasswordHashSettings.
+func NewPasswordService(settings PasswordHashSettings) PasswordService {
+	defaults := DefaultPasswordHashSettings()
+	if settings.Algorithm != PasswordAlgorithmBcrypt {
+		settings.Algorithm = defaults.Algorithm
+	}
+	if settings.BcryptCost < bcrypt.MinCost || settings.BcryptCost > bcrypt.MaxCost {
+		settings.BcryptCost = defaults.BcryptCost
+	}
+	if settings.Argon2Memory == 0 {
+		settings.Argon2Memory = defaults.Argon2Memory
+	}
+	if settings.Argon2Iterations == 0 {
+		settings.Argon2Iterations = defaults.Argon2Iterations
+	}
+	if settings.Argon2Parallelism == 0 {
+		settings.Argon2Parallelism = defaults.Argon2Parallelism
+	}
+
 	return &passwordService{
-		cost: bcrypt.DefaultCost,
+		settings: settings,
 	}
 }
 
-// HashPassword hashes a password using bcrypt
+// HashPassword hashes a password with the configured algorithm
 func (s *passwordService) HashPassword(password string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), s.cost)
-	if err != nil {
-		return "", err
+	if s.settings.Algorithm == PasswordAlgorithmBcrypt {
+		hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), s.settings.BcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hashedBytes), nil
+	}
+
+	salt := make([]byte, argon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
 	}
-	return string(hashedBytes), nil
+	params := argon2idParams{
+		memory:      s.settings.Argon2Memory,
+		iterations:  s.settings.Argon2Iterations,
+		parallelism: s.settings.Argon2Parallelism,
+	}
+	key := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, argon2idKeyLength)
+	return params.encode(salt, key), nil
 }
 
-// CheckPassword checks if a password matches the hashed password
+// CheckPassword checks if a password matches the hashed password, whatever algorithm hashed it
 func (s *passwordService) CheckPassword(password, hashedPassword string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	switch s.HashAlgorithm(hashedPassword) {
+	case PasswordAlgorithmBcrypt:
+		if err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)); err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return ErrPasswordMismatch
+			}
+			return err
+		}
+		return nil
+
+	case PasswordAlgorithmArgon2id:
+		params, salt, key, err := decodeArgon2id(hashedPassword)
+		if err != nil {
+			return err
+		}
+		other := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return ErrPasswordMismatch
+		}
+		return nil
+	}
+	return ErrUnknownPasswordHash
+}
+
+// NeedsRehash reports whether a hash differs from what HashPassword makes now
+func (s *passwordService) NeedsRehash(hashedPassword string) bool {
+	algorithm := s.HashAlgorithm(hashedPassword)
+	if algorithm != s.settings.Algorithm {
+		return true
+	}
+
+	if algorithm == PasswordAlgorithmBcrypt {
+		cost, err := bcrypt.Cost([]byte(hashedPassword))
+		return err != nil || cost != s.settings.BcryptCost
+	}
+
+	params, salt, key, err := decodeArgon2id(hashedPassword)
+	return err != nil ||
+		params.memory != s.settings.Argon2Memory ||
+		params.iterations != s.settings.Argon2Iterations ||
+		params.parallelism != s.settings.Argon2Parallelism ||
+		len(salt) != argon2idSaltLength ||
+		len(key) != argon2idKeyLength
+}
+
+// HashAlgorithm returns the algorithm a hash was made with
+func (s *passwordService) HashAlgorithm(hashedPassword string) string {
+	switch {
+	case strings.HasPrefix(hashedPassword, "$argon2id$"):
+		return PasswordAlgorithmArgon2id
+	case strings.HasPrefix(hashedPassword, "$2a$"), strings.HasPrefix(hashedPassword, "$2b$"), strings.HasPrefix(hashedPassword, "$2y$"):
+		return PasswordAlgorithmBcrypt
+	}
+	return ""
+}
+
+// argon2idParams holds the cost parameters stored in an argon2id hash
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// encode formats a hash as $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+func (p argon2idParams) encode(salt, key []byte) string {
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.memory, p.iterations, p.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// decodeArgon2id parses a hash made by argon2idParams.encode
+func decodeArgon2id(hashedPassword string) (argon2idParams, []byte, []byte, error) {
+	var params argon2idParams
+
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+	return params, salt, key, nil
 }
@@ -1,6 +1,9 @@
 package storage
 
-import "mime/multipart"
+import (
+	"context"
+	"mime/multipart"
+)
 
 // StorageProvider defines the interface for file storage operations
 type StorageProvider interface {
@@ -16,3 +19,15 @@ type StorageProvider interface {
 	// FileExists checks if a file exists in storage
 	FileExists(objectKey string) (bool, error)
 }
+
+// RegionalStorage routes files to the storage of the data region they belong to, so files of
+// customers in one region never land in another region's bucket
+type RegionalStorage interface {
+	// ForRegion returns the storage of a region, or the default region's for unknown regions
+	ForRegion(region string) StorageProvider
+}
+
+// HealthChecker is implemented by storage that can check it is able to store files
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
@@ -234,9 +234,9 @@ func NewCachedProductUseCase(useCase usecases.ProductUseCase, cache Cache) useca
 }
 
 // GetProduct gets product with caching
-func (c *CachedProductUseCase) GetProduct(ctx context.Context, productID uuid.UUID) (*usecases.ProductResponse, error) {
+func (c *CachedProductUseCase) GetProduct(ctx context.Context, productID uuid.UUID, viewerID *uuid.UUID, locale, previewToken string) (*usecases.ProductResponse, error) {
 	// For now, just pass-through to avoid compilation errors
-	return c.useCase.GetProduct(ctx, productID)
+	return c.useCase.GetProduct(ctx, productID, viewerID, locale, previewToken)
 }
 
 // PatchProduct patches a product with cache invalidation
@@ -270,21 +270,25 @@ func (c *CachedProductUseCase) SearchProductsPaginated(ctx context.Context, req
 	return c.useCase.SearchProductsPaginated(ctx, req)
 }
 
-func (c *CachedProductUseCase) GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int) (*usecases.GetProductsResponse, error) {
-	return c.useCase.GetProductsByCategory(ctx, categoryID, limit, offset)
+func (c *CachedProductUseCase) GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int, viewerID *uuid.UUID) (*usecases.GetProductsResponse, error) {
+	return c.useCase.GetProductsByCategory(ctx, categoryID, limit, offset, viewerID)
 }
 
 // Paginated product methods
-func (c *CachedProductUseCase) GetFeaturedProductsPaginated(ctx context.Context, page, limit int) (*usecases.FeaturedProductsPaginatedResponse, error) {
-	return c.useCase.GetFeaturedProductsPaginated(ctx, page, limit)
+func (c *CachedProductUseCase) GetFeaturedProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*usecases.FeaturedProductsPaginatedResponse, error) {
+	return c.useCase.GetFeaturedProductsPaginated(ctx, page, limit, viewerID)
 }
 
-func (c *CachedProductUseCase) GetTrendingProductsPaginated(ctx context.Context, page, limit int) (*usecases.TrendingProductsPaginatedResponse, error) {
-	return c.useCase.GetTrendingProductsPaginated(ctx, page, limit)
+func (c *CachedProductUseCase) GetTrendingProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*usecases.TrendingProductsPaginatedResponse, error) {
+	return c.useCase.GetTrendingProductsPaginated(ctx, page, limit, viewerID)
 }
 
-func (c *CachedProductUseCase) GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int) (*usecases.RelatedProductsPaginatedResponse, error) {
-	return c.useCase.GetRelatedProductsPaginated(ctx, productID, page, limit)
+func (c *CachedProductUseCase) GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int, viewerID *uuid.UUID) (*usecases.RelatedProductsPaginatedResponse, error) {
+	return c.useCase.GetRelatedProductsPaginated(ctx, productID, page, limit, viewerID)
+}
+
+func (c *CachedProductUseCase) GetPriceAvailability(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) (*usecases.PriceAvailabilityResponse, error) {
+	return c.useCase.GetPriceAvailability(ctx, ids, viewerID)
 }
 
 func (c *CachedProductUseCase) UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error {
@@ -20,14 +20,41 @@ type Config struct {
 	Upload   UploadConfig
 	Log      LogConfig
 	CORS     CORSConfig
+
+	Resilience   ResilienceConfig
+	Analytics    AnalyticsConfig
+	Localization LocalizationConfig
+	OrderNumbers OrderNumberConfig
+	EventBridge  EventBridgeConfig
+	Warehouse    WarehouseConfig
+
+	AggregateRepair AggregateRepairConfig
+	OrderArchive    OrderArchiveConfig
+	Report          ReportConfig
+	PaymentLink     PaymentLinkConfig
+	Marketplace     MarketplaceConfig
+	Storefront      StorefrontConfig
+	Shipping        ShippingConfig
+	Password        PasswordConfig
+	DataResidency   DataResidencyConfig
+	BackInStock     BackInStockConfig
+	FulfillmentSLA  FulfillmentSLAConfig
+	ReturnPortal    ReturnPortalConfig
+	PartnerFeed     PartnerFeedConfig
+	Anonymization   AnonymizationConfig
+	APIPlans        APIPlansConfig
+	TaxExemption    TaxExemptionConfig
+	Waitlist        WaitlistConfig
 }
 
 // AppConfig holds application configuration
 type AppConfig struct {
-	Name string
-	Env  string
-	Host string
-	Port string
+	Name              string
+	Env               string
+	Host              string
+	Port              string
+	ValidateResponses bool // Validate JSON responses against the OpenAPI document (development only)
+	SandboxMode       bool // Simulate payment gateways, capture emails and allow clock control (never in production)
 }
 
 // DatabaseConfig holds database configuration
@@ -51,8 +78,11 @@ type RedisConfig struct {
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	Secret      string
-	ExpireHours int
+	Secret           string
+	ExpireHours      int
+	SigningAlgorithm string // HS256, RS256 or EdDSA
+	KeyRotationDays  int    // How often a new signing key is created; 0 keeps the current one
+	KeyGraceHours    int    // How long tokens signed by a replaced key are still accepted
 }
 
 // EmailConfig holds Gmail SMTP configuration
@@ -78,6 +108,209 @@ type PaymentConfig struct {
 	PayPalSandbox        bool
 }
 
+// ResilienceConfig holds timeouts and circuit breaker limits for external providers
+type ResilienceConfig struct {
+	PaymentTimeoutSeconds   int // Stripe and PayPal calls
+	EmailTimeoutSeconds     int // SMTP delivery
+	OAuthTimeoutSeconds     int // Google and Facebook code exchange
+	BreakerFailureThreshold int // Consecutive failures that open a circuit
+	BreakerOpenSeconds      int // How long an open circuit rejects calls before a trial call
+	MaxConcurrentCalls      int // In-flight calls per provider before new calls are shed
+}
+
+// AnalyticsConfig holds anonymous visitor session tracking and retention configuration
+type AnalyticsConfig struct {
+	SessionCookieName  string // Cookie holding the anonymous session ID
+	SessionCookieDays  int    // How long a visitor keeps the same anonymous session
+	AnonymizeAfterDays int    // Anonymous tracking data is stripped of IPs, user agents and session IDs after this many days
+	RetentionDays      int    // Anonymous tracking data is deleted after this many days
+}
+
+// LocalizationConfig holds product content locale configuration
+type LocalizationConfig struct {
+	SourceLocale     string   // Language the product content is written in
+	SupportedLocales []string // Locales products can be translated to and read in
+}
+
+// OrderNumberConfig holds order number generation configuration
+type OrderNumberConfig struct {
+	Strategy       string   // random keeps the ORD-YYYYMMDD-HHMMSS-XXXX numbers, sequential draws from a sequence
+	Prefix         string   // Leading part of sequential numbers
+	DateFormat     string   // Date part of sequential numbers using YYYY, YY, MM and DD, empty for none
+	Reset          string   // How often sequences start over: never, daily, monthly or yearly
+	SequenceDigits int      // Sequence values are zero-padded to this width
+	SequenceStart  int64    // First value of new sequences, e.g. to continue after numbers issued elsewhere
+	PerStore       bool     // Each order source (web, mobile, admin, ...) keeps its own sequence
+	StoreCodes     []string // Store codes of order sources as source=CODE pairs
+	Checksum       bool     // Append a check digit to sequential numbers
+	GapFree        bool     // Never skip a sequence value, at the cost of creating orders one at a time
+}
+
+// EventBridgeConfig holds domain event streaming configuration
+type EventBridgeConfig struct {
+	Broker         string // kafka or nats; empty disables the event bridge
+	URL            string // Kafka REST Proxy URL or NATS server URL
+	Username       string // Basic auth user for Kafka, user or token for NATS
+	Password       string
+	TopicPrefix    string // Events go to <prefix>.<event type>, e.g. ecom.order.created
+	BatchSize      int    // Events read from the outbox per batch
+	RetentionDays  int    // Published events are deleted from the outbox after this many days
+	TimeoutSeconds int    // Per-event publish deadline
+}
+
+// WarehouseConfig holds data warehouse export configuration
+type WarehouseConfig struct {
+	Target          string // bigquery or snowflake; empty disables the warehouse sync
+	TablePrefix     string // Prefix of the warehouse table names, e.g. ecom_ for ecom_orders
+	Anonymize       bool   // Export customer IDs as pseudonyms and leave other personal data out
+	IntervalMinutes int    // How often changed rows are exported
+	BatchSize       int    // Rows read and loaded per batch
+	LagSeconds      int    // Rows changed more recently wait for the next run, so commits still in flight aren't skipped
+	TimeoutSeconds  int    // Per-request deadline of warehouse API calls
+
+	BigQueryProject         string
+	BigQueryDataset         string
+	BigQueryCredentialsFile string // Service account key; Application Default Credentials when empty
+
+	SnowflakeAccount        string // Account identifier, e.g. myorg-myaccount
+	SnowflakeUser           string
+	SnowflakePrivateKeyFile string // PKCS#8 PEM key registered for key pair authentication
+	SnowflakeDatabase       string
+	SnowflakeSchema         string
+	SnowflakeWarehouse      string
+	SnowflakeRole           string
+}
+
+// AggregateRepairConfig holds the schedule of the denormalized aggregate repair job
+type AggregateRepairConfig struct {
+	IntervalHours int  // How often aggregates are checked; 0 disables the scheduled check
+	BatchSize     int  // Records compared per query
+	ReportOnly    bool // Scheduled checks only report discrepancies instead of repairing them
+}
+
+// OrderArchiveConfig holds the archiving of old orders
+type OrderArchiveConfig struct {
+	AfterYears    int // Orders are archived this many years after they were created; 0 disables archiving
+	BatchSize     int // Orders archived per transaction
+	IntervalHours int // How often old orders are archived
+}
+
+// ReportConfig holds generated report limits and download links
+type ReportConfig struct {
+	DownloadSecret     string // Signs download URLs; falls back to the JWT secret
+	DownloadURLMinutes int    // How long a download URL stays valid
+	MaxRows            int    // Rows exported per report at most
+}
+
+// PaymentLinkConfig holds pay-by-link settings
+type PaymentLinkConfig struct {
+	BaseURL     string // Storefront page that payment links point to, followed by the link token
+	ExpiryHours int    // How long a link stays valid unless created with an explicit expiry
+}
+
+// MarketplaceConfig holds multi-vendor marketplace settings
+type MarketplaceConfig struct {
+	DefaultCommissionRate float64 // Percent of a vendor's item sales kept when no category or vendor rate applies
+}
+
+// StorefrontConfig holds the currencies, ship-to countries and tax display shoppers browse with
+type StorefrontConfig struct {
+	BaseCurrency          string             // Currency catalog prices are kept in
+	DefaultCountry        string             // Ship-to country of shoppers who haven't picked one
+	CurrencyRates         map[string]float64 // Units of each other currency shoppers can pick per unit of BaseCurrency
+	TaxRates              map[string]float64 // Estimated tax percent by ship-to country
+	TaxDisplay            string             // Whether prices are shown "inclusive" or "exclusive" of tax in countries not listed below
+	TaxInclusiveCountries []string           // Ship-to countries whose shoppers see prices with tax included
+	TaxExclusiveCountries []string           // Ship-to countries whose shoppers see prices before tax
+	CookieDays            int                // How long a shopper's picked currency, locale and country are remembered
+}
+
+// ShippingConfig holds shipping estimate settings
+type ShippingConfig struct {
+	OriginAddress        string // Address parcels ship from, used to work out the distance to a destination
+	EstimateCacheSeconds int    // How long an estimate for the same cart and destination is reused
+}
+
+// PasswordConfig holds how new passwords are hashed. Hashes made with other settings are
+// replaced when their users next sign in.
+type PasswordConfig struct {
+	HashAlgorithm     string // argon2id or bcrypt
+	BcryptCost        int
+	Argon2MemoryKB    int
+	Argon2Iterations  int
+	Argon2Parallelism int
+}
+
+// DataResidencyConfig holds the data regions customers and their files are kept in
+type DataResidencyConfig struct {
+	DefaultRegion  string   // Region of customers whose country maps to no region, and of catalog files
+	Regions        []string // Every region, the default included
+	CountryRegions []string // COUNTRY=region pairs, e.g. DE=eu
+}
+
+// BackInStockConfig holds how customers waiting for a product are told it is back in stock
+type BackInStockConfig struct {
+	IntervalMinutes    int // How often restocked products' subscribers are notified
+	SubscribersPerUnit int // Subscribers notified per unit in stock in one run
+	HoldDays           int // Low stock waits this many days for a purchase order due to arrive
+}
+
+// FulfillmentSLAConfig holds how quickly orders must ship and how long they may stay in a status
+type FulfillmentSLAConfig struct {
+	IntervalMinutes int                // How often orders are checked against the SLA
+	BusinessDays    int                // Orders ship within this many business days of the day they were placed
+	WarningHours    int                // Unshipped orders due within this many hours are at risk
+	Timezone        string             // Time zone business days are counted in
+	Holidays        []string           // Days off besides weekends, as YYYY-MM-DD
+	StuckHours      map[string]float64 // Hours an order may stay in a status, by upper case status
+}
+
+// ReturnPortalConfig holds the self-service returns portal settings
+type ReturnPortalConfig struct {
+	Secret             string   // Signs return links; falls back to the JWT secret
+	BaseURL            string   // Storefront page return links point to, followed by the link token
+	WindowDays         int      // Days after delivery items can be returned
+	AutoApproveReasons []string // Return reasons approved right away, with a label
+	Carrier            string   // Carrier named on return labels
+	Address            string   // Where returned parcels are sent; the shipping origin when unset
+}
+
+// PartnerFeedConfig holds the price and availability feed of affiliate and price-comparison partners
+type PartnerFeedConfig struct {
+	DeliveryIntervalSeconds int // How often due webhook deliveries are sent
+	RetentionDays           int // Days changes are kept for partners to catch up
+	SettleSeconds           int // Changes are held back until this old so none is skipped
+	WebhookTimeoutSeconds   int // How long partners have to answer a webhook
+	MaxBackoffMinutes       int // Longest wait between retries of failed deliveries
+}
+
+// AnonymizationConfig holds the pseudonyms of anonymized analytics exports
+type AnonymizationConfig struct {
+	Secret string // Keys customer ID pseudonyms; falls back to the JWT secret. Changing it changes every pseudonym
+}
+
+// APIPlansConfig holds the limits of each API key plan; a daily quota of 0 is unlimited
+type APIPlansConfig struct {
+	FreeRequestsPerMinute     int
+	FreeDailyQuota            int64
+	PartnerRequestsPerMinute  int
+	PartnerDailyQuota         int64
+	InternalRequestsPerMinute int
+	InternalDailyQuota        int64
+}
+
+// TaxExemptionConfig holds tax exemption certificate reminders
+type TaxExemptionConfig struct {
+	ReminderDays int // Days before a certificate expires its customer is reminded
+}
+
+// WaitlistConfig holds how customers on a product's waitlist are notified when it launches
+type WaitlistConfig struct {
+	IntervalMinutes     int // How often due launch waves are sent
+	WaveSize            int // Default customers notified per wave
+	WaveIntervalMinutes int // Default least time between two waves of a launch
+}
+
 // UploadConfig holds file upload configuration
 type UploadConfig struct {
 	Path        string
@@ -110,6 +343,9 @@ func Load() (*Config, error) {
 			Env:  getEnv("APP_ENV", "development"),
 			Host: getEnv("APP_HOST", "localhost"),
 			Port: getEnv("APP_PORT", "8080"),
+
+			ValidateResponses: getEnvAsBool("OPENAPI_VALIDATE_RESPONSES", false),
+			SandboxMode:       getEnvAsBool("SANDBOX_MODE", false),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -127,8 +363,11 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		JWT: JWTConfig{
-			Secret:      getEnvOrPanic("JWT_SECRET", "JWT_SECRET is required for security"),
-			ExpireHours: getEnvAsInt("JWT_EXPIRE_HOURS", 24),
+			Secret:           getEnvOrPanic("JWT_SECRET", "JWT_SECRET is required for security"),
+			ExpireHours:      getEnvAsInt("JWT_EXPIRE_HOURS", 24),
+			SigningAlgorithm: getEnv("JWT_SIGNING_ALGORITHM", "HS256"),
+			KeyRotationDays:  getEnvAsInt("JWT_KEY_ROTATION_DAYS", 0),
+			KeyGraceHours:    getEnvAsInt("JWT_KEY_GRACE_HOURS", 168),
 		},
 		Email: EmailConfig{
 			SMTPHost:     getEnv("SMTP_HOST", "smtp.gmail.com"),
@@ -158,8 +397,177 @@ func Load() (*Config, error) {
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:8080"}),
 			AllowedMethods: getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
-			AllowedHeaders: getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "X-Session-ID"}),
+			AllowedHeaders: getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "X-Session-ID", "X-Currency", "X-Country"}),
+		},
+		Resilience: ResilienceConfig{
+			PaymentTimeoutSeconds:   getEnvAsInt("PAYMENT_TIMEOUT_SECONDS", 15),
+			EmailTimeoutSeconds:     getEnvAsInt("EMAIL_TIMEOUT_SECONDS", 10),
+			OAuthTimeoutSeconds:     getEnvAsInt("OAUTH_TIMEOUT_SECONDS", 10),
+			BreakerFailureThreshold: getEnvAsInt("CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5),
+			BreakerOpenSeconds:      getEnvAsInt("CIRCUIT_BREAKER_OPEN_SECONDS", 30),
+			MaxConcurrentCalls:      getEnvAsInt("EXTERNAL_MAX_CONCURRENT_CALLS", 20),
+		},
+		Analytics: AnalyticsConfig{
+			SessionCookieName:  getEnv("ANON_SESSION_COOKIE_NAME", "anon_session_id"),
+			SessionCookieDays:  getEnvAsInt("ANON_SESSION_COOKIE_DAYS", 30),
+			AnonymizeAfterDays: getEnvAsInt("ANALYTICS_ANONYMIZE_AFTER_DAYS", 30),
+			RetentionDays:      getEnvAsInt("ANALYTICS_RETENTION_DAYS", 395),
+		},
+		Localization: LocalizationConfig{
+			SourceLocale:     getEnv("CONTENT_SOURCE_LOCALE", "en"),
+			SupportedLocales: getEnvAsSlice("CONTENT_LOCALES", []string{"en", "vi"}),
+		},
+		OrderNumbers: OrderNumberConfig{
+			Strategy:       getEnv("ORDER_NUMBER_STRATEGY", "random"),
+			Prefix:         getEnv("ORDER_NUMBER_PREFIX", "ORD"),
+			DateFormat:     getEnv("ORDER_NUMBER_DATE_FORMAT", "YYYYMMDD"),
+			Reset:          getEnv("ORDER_NUMBER_RESET", "never"),
+			SequenceDigits: getEnvAsInt("ORDER_NUMBER_SEQUENCE_DIGITS", 6),
+			SequenceStart:  getEnvAsInt64("ORDER_NUMBER_SEQUENCE_START", 1),
+			PerStore:       getEnvAsBool("ORDER_NUMBER_PER_STORE", false),
+			StoreCodes:     getEnvAsSlice("ORDER_NUMBER_STORE_CODES", nil),
+			Checksum:       getEnvAsBool("ORDER_NUMBER_CHECKSUM", false),
+			GapFree:        getEnvAsBool("ORDER_NUMBER_GAP_FREE", false),
+		},
+		EventBridge: EventBridgeConfig{
+			Broker:         getEnv("EVENT_BRIDGE_BROKER", ""),
+			URL:            getEnv("EVENT_BRIDGE_URL", ""),
+			Username:       getEnv("EVENT_BRIDGE_USERNAME", ""),
+			Password:       getEnv("EVENT_BRIDGE_PASSWORD", ""),
+			TopicPrefix:    getEnv("EVENT_BRIDGE_TOPIC_PREFIX", "ecom"),
+			BatchSize:      getEnvAsInt("EVENT_BRIDGE_BATCH_SIZE", 100),
+			RetentionDays:  getEnvAsInt("EVENT_BRIDGE_RETENTION_DAYS", 7),
+			TimeoutSeconds: getEnvAsInt("EVENT_BRIDGE_TIMEOUT_SECONDS", 10),
+		},
+		Warehouse: WarehouseConfig{
+			Target:          getEnv("WAREHOUSE_TARGET", ""),
+			TablePrefix:     getEnv("WAREHOUSE_TABLE_PREFIX", ""),
+			Anonymize:       getEnvAsBool("WAREHOUSE_ANONYMIZE", false),
+			IntervalMinutes: getEnvAsInt("WAREHOUSE_SYNC_INTERVAL_MINUTES", 60),
+			BatchSize:       getEnvAsInt("WAREHOUSE_BATCH_SIZE", 500),
+			LagSeconds:      getEnvAsInt("WAREHOUSE_SYNC_LAG_SECONDS", 60),
+			TimeoutSeconds:  getEnvAsInt("WAREHOUSE_TIMEOUT_SECONDS", 60),
+
+			BigQueryProject:         getEnv("WAREHOUSE_BIGQUERY_PROJECT", ""),
+			BigQueryDataset:         getEnv("WAREHOUSE_BIGQUERY_DATASET", ""),
+			BigQueryCredentialsFile: getEnv("WAREHOUSE_BIGQUERY_CREDENTIALS_FILE", ""),
+
+			SnowflakeAccount:        getEnv("WAREHOUSE_SNOWFLAKE_ACCOUNT", ""),
+			SnowflakeUser:           getEnv("WAREHOUSE_SNOWFLAKE_USER", ""),
+			SnowflakePrivateKeyFile: getEnv("WAREHOUSE_SNOWFLAKE_PRIVATE_KEY_FILE", ""),
+			SnowflakeDatabase:       getEnv("WAREHOUSE_SNOWFLAKE_DATABASE", ""),
+			SnowflakeSchema:         getEnv("WAREHOUSE_SNOWFLAKE_SCHEMA", "PUBLIC"),
+			SnowflakeWarehouse:      getEnv("WAREHOUSE_SNOWFLAKE_WAREHOUSE", ""),
+			SnowflakeRole:           getEnv("WAREHOUSE_SNOWFLAKE_ROLE", ""),
+		},
+		AggregateRepair: AggregateRepairConfig{
+			IntervalHours: getEnvAsInt("AGGREGATE_REPAIR_INTERVAL_HOURS", 24),
+			BatchSize:     getEnvAsInt("AGGREGATE_REPAIR_BATCH_SIZE", 500),
+			ReportOnly:    getEnvAsBool("AGGREGATE_REPAIR_REPORT_ONLY", false),
+		},
+		OrderArchive: OrderArchiveConfig{
+			AfterYears:    getEnvAsInt("ORDER_ARCHIVE_AFTER_YEARS", 0),
+			BatchSize:     getEnvAsInt("ORDER_ARCHIVE_BATCH_SIZE", 200),
+			IntervalHours: getEnvAsInt("ORDER_ARCHIVE_INTERVAL_HOURS", 24),
+		},
+		Report: ReportConfig{
+			DownloadSecret:     getEnv("REPORT_DOWNLOAD_SECRET", ""),
+			DownloadURLMinutes: getEnvAsInt("REPORT_DOWNLOAD_URL_MINUTES", 15),
+			MaxRows:            getEnvAsInt("REPORT_MAX_ROWS", 100000),
+		},
+		PaymentLink: PaymentLinkConfig{
+			BaseURL:     getEnv("PAYMENT_LINK_BASE_URL", "http://localhost:3000/pay"),
+			ExpiryHours: getEnvAsInt("PAYMENT_LINK_EXPIRY_HOURS", 72),
+		},
+		Marketplace: MarketplaceConfig{
+			DefaultCommissionRate: getEnvAsFloat("MARKETPLACE_DEFAULT_COMMISSION_RATE", 10),
+		},
+		Storefront: StorefrontConfig{
+			BaseCurrency:          strings.ToUpper(getEnv("STORE_CURRENCY", "USD")),
+			DefaultCountry:        strings.ToUpper(getEnv("STOREFRONT_DEFAULT_COUNTRY", "US")),
+			CurrencyRates:         getEnvAsFloatMap("STOREFRONT_CURRENCY_RATES"),
+			TaxRates:              getEnvAsFloatMap("STOREFRONT_TAX_RATES"),
+			TaxDisplay:            strings.ToLower(getEnv("STOREFRONT_TAX_DISPLAY", "exclusive")),
+			TaxInclusiveCountries: getEnvAsSlice("STOREFRONT_TAX_INCLUSIVE_COUNTRIES", nil),
+			TaxExclusiveCountries: getEnvAsSlice("STOREFRONT_TAX_EXCLUSIVE_COUNTRIES", nil),
+			CookieDays:            getEnvAsInt("STOREFRONT_COOKIE_DAYS", 365),
+		},
+		Shipping: ShippingConfig{
+			OriginAddress:        getEnv("SHIPPING_ORIGIN_ADDRESS", "New York, NY, USA"),
+			EstimateCacheSeconds: getEnvAsInt("SHIPPING_ESTIMATE_CACHE_SECONDS", 300),
 		},
+		Password: PasswordConfig{
+			HashAlgorithm:     strings.ToLower(getEnv("PASSWORD_HASH_ALGORITHM", "argon2id")),
+			BcryptCost:        getEnvAsInt("PASSWORD_BCRYPT_COST", 10),
+			Argon2MemoryKB:    getEnvAsInt("PASSWORD_ARGON2_MEMORY_KB", 65536),
+			Argon2Iterations:  getEnvAsInt("PASSWORD_ARGON2_ITERATIONS", 3),
+			Argon2Parallelism: getEnvAsInt("PASSWORD_ARGON2_PARALLELISM", 2),
+		},
+		DataResidency: DataResidencyConfig{
+			DefaultRegion:  strings.ToLower(getEnv("DATA_DEFAULT_REGION", "global")),
+			Regions:        getEnvAsSlice("DATA_REGIONS", nil),
+			CountryRegions: getEnvAsSlice("DATA_REGION_COUNTRIES", nil),
+		},
+		BackInStock: BackInStockConfig{
+			IntervalMinutes:    getEnvAsInt("BACK_IN_STOCK_INTERVAL_MINUTES", 15),
+			SubscribersPerUnit: getEnvAsInt("BACK_IN_STOCK_SUBSCRIBERS_PER_UNIT", 3),
+			HoldDays:           getEnvAsInt("BACK_IN_STOCK_HOLD_DAYS", 3),
+		},
+		FulfillmentSLA: FulfillmentSLAConfig{
+			IntervalMinutes: getEnvAsInt("FULFILLMENT_SLA_INTERVAL_MINUTES", 10),
+			BusinessDays:    getEnvAsInt("FULFILLMENT_SLA_BUSINESS_DAYS", 2),
+			WarningHours:    getEnvAsInt("FULFILLMENT_SLA_WARNING_HOURS", 12),
+			Timezone:        getEnv("FULFILLMENT_SLA_TIMEZONE", "UTC"),
+			Holidays:        getEnvAsSlice("FULFILLMENT_SLA_HOLIDAYS", nil),
+			StuckHours:      getEnvAsFloatMap("ORDER_STUCK_HOURS"),
+		},
+		ReturnPortal: ReturnPortalConfig{
+			Secret:             getEnv("RETURN_PORTAL_SECRET", ""),
+			BaseURL:            getEnv("RETURN_PORTAL_BASE_URL", "http://localhost:3000/returns"),
+			WindowDays:         getEnvAsInt("RETURN_WINDOW_DAYS", 30),
+			AutoApproveReasons: getEnvAsSlice("RETURN_AUTO_APPROVE_REASONS", []string{"defective", "damaged", "wrong_item"}),
+			Carrier:            getEnv("RETURN_CARRIER", ""),
+			Address:            getEnv("RETURN_ADDRESS", ""),
+		},
+		PartnerFeed: PartnerFeedConfig{
+			DeliveryIntervalSeconds: getEnvAsInt("PARTNER_FEED_DELIVERY_INTERVAL_SECONDS", 30),
+			RetentionDays:           getEnvAsInt("PARTNER_FEED_RETENTION_DAYS", 30),
+			SettleSeconds:           getEnvAsInt("PARTNER_FEED_SETTLE_SECONDS", 5),
+			WebhookTimeoutSeconds:   getEnvAsInt("PARTNER_FEED_WEBHOOK_TIMEOUT_SECONDS", 10),
+			MaxBackoffMinutes:       getEnvAsInt("PARTNER_FEED_MAX_BACKOFF_MINUTES", 60),
+		},
+		Anonymization: AnonymizationConfig{
+			Secret: getEnv("ANONYMIZATION_SECRET", ""),
+		},
+		APIPlans: APIPlansConfig{
+			FreeRequestsPerMinute:     getEnvAsInt("API_PLAN_FREE_REQUESTS_PER_MINUTE", 10),
+			FreeDailyQuota:            getEnvAsInt64("API_PLAN_FREE_DAILY_QUOTA", 1000),
+			PartnerRequestsPerMinute:  getEnvAsInt("API_PLAN_PARTNER_REQUESTS_PER_MINUTE", 60),
+			PartnerDailyQuota:         getEnvAsInt64("API_PLAN_PARTNER_DAILY_QUOTA", 50000),
+			InternalRequestsPerMinute: getEnvAsInt("API_PLAN_INTERNAL_REQUESTS_PER_MINUTE", 600),
+			InternalDailyQuota:        getEnvAsInt64("API_PLAN_INTERNAL_DAILY_QUOTA", 0),
+		},
+		TaxExemption: TaxExemptionConfig{
+			ReminderDays: getEnvAsInt("TAX_EXEMPTION_REMINDER_DAYS", 30),
+		},
+		Waitlist: WaitlistConfig{
+			IntervalMinutes:     getEnvAsInt("WAITLIST_INTERVAL_MINUTES", 1),
+			WaveSize:            getEnvAsInt("WAITLIST_WAVE_SIZE", 500),
+			WaveIntervalMinutes: getEnvAsInt("WAITLIST_WAVE_INTERVAL_MINUTES", 15),
+		},
+	}
+
+	if config.Anonymization.Secret == "" {
+		config.Anonymization.Secret = config.JWT.Secret
+	}
+	if config.Report.DownloadSecret == "" {
+		config.Report.DownloadSecret = config.JWT.Secret
+	}
+	if config.ReturnPortal.Secret == "" {
+		config.ReturnPortal.Secret = config.JWT.Secret
+	}
+	if config.ReturnPortal.Address == "" {
+		config.ReturnPortal.Address = config.Shipping.OriginAddress
 	}
 
 	return config, nil
@@ -186,6 +594,109 @@ func (c *JWTConfig) GetJWTExpireDuration() time.Duration {
 	return time.Duration(c.ExpireHours) * time.Hour
 }
 
+// GetKeyRotationInterval returns how often signing keys rotate, zero when they don't
+func (c *JWTConfig) GetKeyRotationInterval() time.Duration {
+	return time.Duration(c.KeyRotationDays) * 24 * time.Hour
+}
+
+// GetKeyGracePeriod returns how long a replaced signing key still verifies tokens
+func (c *JWTConfig) GetKeyGracePeriod() time.Duration {
+	return time.Duration(c.KeyGraceHours) * time.Hour
+}
+
+// GetSessionCookieMaxAge returns how long the anonymous session cookie lives
+func (c *AnalyticsConfig) GetSessionCookieMaxAge() time.Duration {
+	return time.Duration(c.SessionCookieDays) * 24 * time.Hour
+}
+
+// GetCookieMaxAge returns how long the storefront cookies live
+func (c *StorefrontConfig) GetCookieMaxAge() time.Duration {
+	return time.Duration(c.CookieDays) * 24 * time.Hour
+}
+
+// GetRegions returns the data regions in lower case, starting with the default region
+func (c *DataResidencyConfig) GetRegions() []string {
+	regions := []string{c.DefaultRegion}
+	for _, region := range c.Regions {
+		region = strings.ToLower(region)
+		if region != c.DefaultRegion {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// GetCountryRegions returns the data region by upper case country code
+func (c *DataResidencyConfig) GetCountryRegions() map[string]string {
+	regions := make(map[string]string, len(c.CountryRegions))
+	for _, pair := range c.CountryRegions {
+		if country, region, ok := strings.Cut(pair, "="); ok {
+			regions[strings.ToUpper(strings.TrimSpace(country))] = strings.ToLower(strings.TrimSpace(region))
+		}
+	}
+	return regions
+}
+
+// GetEstimateCacheTTL returns how long shipping estimates are cached
+func (c *ShippingConfig) GetEstimateCacheTTL() time.Duration {
+	return time.Duration(c.EstimateCacheSeconds) * time.Second
+}
+
+// GetLocation returns the time zone business days are counted in, UTC when it is unknown
+func (c *FulfillmentSLAConfig) GetLocation() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// GetHolidays returns the days off as a set of YYYY-MM-DD dates
+func (c *FulfillmentSLAConfig) GetHolidays() map[string]bool {
+	holidays := make(map[string]bool, len(c.Holidays))
+	for _, day := range c.Holidays {
+		holidays[day] = true
+	}
+	return holidays
+}
+
+// GetStuckAfter returns how long an order may stay in each status, by lower case status
+func (c *FulfillmentSLAConfig) GetStuckAfter() map[string]time.Duration {
+	stuckAfter := make(map[string]time.Duration, len(c.StuckHours))
+	for status, hours := range c.StuckHours {
+		if hours > 0 {
+			stuckAfter[strings.ToLower(status)] = time.Duration(hours * float64(time.Hour))
+		}
+	}
+	return stuckAfter
+}
+
+// GetDateLayout returns the date format as a Go time layout
+func (c *OrderNumberConfig) GetDateLayout() string {
+	return strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(c.DateFormat)
+}
+
+// GetStoreCodes returns the store codes by order source
+func (c *OrderNumberConfig) GetStoreCodes() map[string]string {
+	codes := make(map[string]string, len(c.StoreCodes))
+	for _, pair := range c.StoreCodes {
+		if source, code, ok := strings.Cut(pair, "="); ok {
+			codes[strings.TrimSpace(source)] = strings.TrimSpace(code)
+		}
+	}
+	return codes
+}
+
+// IsEnabled checks if domain events are streamed to a broker
+func (c *EventBridgeConfig) IsEnabled() bool {
+	return c.Broker != ""
+}
+
+// IsEnabled checks if tables are exported to a data warehouse
+func (c *WarehouseConfig) IsEnabled() bool {
+	return c.Target != ""
+}
+
 // IsProduction checks if the environment is production
 func (c *AppConfig) IsProduction() bool {
 	return c.Env == "production"
@@ -196,6 +707,11 @@ func (c *AppConfig) IsDevelopment() bool {
 	return c.Env == "development"
 }
 
+// IsSandbox checks if sandbox mode is enabled; it is always off in production
+func (c *AppConfig) IsSandbox() bool {
+	return c.SandboxMode && !c.IsProduction()
+}
+
 // GetAddress returns the full address
 func (c *AppConfig) GetAddress() string {
 	return c.Host + ":" + c.Port
@@ -234,6 +750,15 @@ func getEnvAsInt64(key string, defaultValue int64) int64 {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
 		// Split by comma and trim spaces
@@ -248,6 +773,22 @@ func getEnvAsSlice(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+// getEnvAsFloatMap parses comma separated KEY:value pairs, e.g. "EUR:0.92,VND:25400", with upper
+// case keys. Malformed pairs are skipped.
+func getEnvAsFloatMap(key string) map[string]float64 {
+	result := make(map[string]float64)
+	for _, pair := range getEnvAsSlice(key, nil) {
+		name, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		if floatValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			result[strings.ToUpper(strings.TrimSpace(name))] = floatValue
+		}
+	}
+	return result
+}
+
 func getEnvAsBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolValue, err := strconv.ParseBool(value); err == nil {
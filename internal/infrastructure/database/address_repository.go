@@ -34,11 +34,11 @@ func (r *addressRepository) GetByID(ctx context.Context, id uuid.UUID) (*entitie
 	return &address, nil
 }
 
-// GetByUser gets all addresses for a user
+// GetByUser gets all personal addresses for a user (company shared addresses are excluded)
 func (r *addressRepository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*entities.Address, error) {
 	var addresses []*entities.Address
 	err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Where("user_id = ? AND company_id IS NULL", userID).
 		Order("is_default DESC, created_at DESC").
 		Find(&addresses).Error
 	return addresses, err
@@ -222,12 +222,12 @@ func (r *addressRepository) DeleteByUser(ctx context.Context, userID uuid.UUID)
 	return r.db.WithContext(ctx).Delete(&entities.Address{}, "user_id = ?", userID).Error
 }
 
-// ExistsByUserIDAndID checks if an address exists for a user
+// ExistsByUserIDAndID checks if a personal address exists for a user
 func (r *addressRepository) ExistsByUserIDAndID(ctx context.Context, userID, addressID uuid.UUID) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).
 		Model(&entities.Address{}).
-		Where("id = ? AND user_id = ?", addressID, userID).
+		Where("id = ? AND user_id = ? AND company_id IS NULL", addressID, userID).
 		Count(&count).Error
 	return count > 0, err
 }
@@ -237,33 +237,81 @@ func (r *addressRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (
 	return r.GetByUser(ctx, userID)
 }
 
+// GetByCompanyID gets the active shared addresses of a company
+func (r *addressRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Address, error) {
+	var addresses []*entities.Address
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND is_active = ?", companyID, true).
+		Order("created_at DESC").
+		Find(&addresses).Error
+	return addresses, err
+}
+
 // GetDefaultByUserID gets the default address for a user by type
 func (r *addressRepository) GetDefaultByUserID(ctx context.Context, userID uuid.UUID, addressType entities.AddressType) (*entities.Address, error) {
 	var address entities.Address
-	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND type = ? AND is_default = ?", userID, addressType, true).
-		First(&address).Error
+	query := r.db.WithContext(ctx).Where("user_id = ? AND is_active = ? AND company_id IS NULL", userID, true)
+
+	switch addressType {
+	case entities.AddressTypeShipping:
+		query = query.Where("is_default_shipping = ?", true)
+	case entities.AddressTypeBilling:
+		query = query.Where("is_default_billing = ?", true)
+	default:
+		query = query.Where("is_default_shipping = ? AND is_default_billing = ?", true, true)
+	}
+
+	err := query.First(&address).Error
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrAddressNotFound
+		}
 		return nil, err
 	}
 	return &address, nil
 }
 
-// SetAsDefault sets an address as default for a specific type
+// SetAsDefault sets an address as the default shipping and/or billing address.
+// AddressTypeBoth sets the address as default for both.
 func (r *addressRepository) SetAsDefault(ctx context.Context, userID, addressID uuid.UUID, addressType entities.AddressType) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Unset all other addresses of this type as default
-		err := tx.Model(&entities.Address{}).
-			Where("user_id = ? AND type = ?", userID, addressType).
-			Update("is_default", false).Error
-		if err != nil {
-			return err
+		columns := []string{}
+		switch addressType {
+		case entities.AddressTypeShipping:
+			columns = append(columns, "is_default_shipping")
+		case entities.AddressTypeBilling:
+			columns = append(columns, "is_default_billing")
+		case entities.AddressTypeBoth:
+			columns = append(columns, "is_default_shipping", "is_default_billing")
+		default:
+			return entities.ErrInvalidInput
+		}
+
+		for _, column := range columns {
+			// Unset the current default for this purpose
+			err := tx.Model(&entities.Address{}).
+				Where("user_id = ? AND id <> ?", userID, addressID).
+				Update(column, false).Error
+			if err != nil {
+				return err
+			}
+
+			// Set the specified address as default
+			result := tx.Model(&entities.Address{}).
+				Where("id = ? AND user_id = ?", addressID, userID).
+				Update(column, true)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entities.ErrAddressNotFound
+			}
 		}
 
-		// Set the specified address as default
+		// Keep the legacy is_default flag in sync
 		return tx.Model(&entities.Address{}).
-			Where("id = ? AND user_id = ? AND type = ?", addressID, userID, addressType).
-			Update("is_default", true).Error
+			Where("user_id = ?", userID).
+			Update("is_default", gorm.Expr("is_default_shipping OR is_default_billing")).Error
 	})
 }
 
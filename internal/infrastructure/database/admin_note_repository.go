@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type adminNoteRepository struct {
+	db *gorm.DB
+}
+
+// NewAdminNoteRepository creates a new admin note repository
+func NewAdminNoteRepository(db *gorm.DB) repositories.AdminNoteRepository {
+	return &adminNoteRepository{db: db}
+}
+
+// Create creates a new note
+func (r *adminNoteRepository) Create(ctx context.Context, note *entities.AdminNote) error {
+	return r.db.WithContext(ctx).Omit("Author").Create(note).Error
+}
+
+// GetByID gets a note by ID with its author
+func (r *adminNoteRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminNote, error) {
+	var note entities.AdminNote
+	err := r.db.WithContext(ctx).
+		Preload("Author").
+		Where("id = ?", id).
+		First(&note).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrAdminNoteNotFound
+		}
+		return nil, err
+	}
+	return &note, nil
+}
+
+// Update updates a note
+func (r *adminNoteRepository) Update(ctx context.Context, note *entities.AdminNote) error {
+	return r.db.WithContext(ctx).Omit("Author").Save(note).Error
+}
+
+// Delete deletes a note together with its replies
+func (r *adminNoteRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? OR parent_id = ?", id, id).
+		Delete(&entities.AdminNote{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrAdminNoteNotFound
+	}
+	return nil
+}
+
+// ListBySubject gets all notes and replies on a subject, oldest first
+func (r *adminNoteRepository) ListBySubject(ctx context.Context, subjectType entities.AdminNoteSubject, subjectID uuid.UUID) ([]*entities.AdminNote, error) {
+	var notes []*entities.AdminNote
+	err := r.db.WithContext(ctx).
+		Preload("Author").
+		Where("subject_type = ? AND subject_id = ?", subjectType, subjectID).
+		Order("created_at ASC").
+		Find(&notes).Error
+	return notes, err
+}
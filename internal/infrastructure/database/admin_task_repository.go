@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// adminTaskSourceQueries select what needs attention for each task type as admin task sources.
+// They take the row limit.
+var adminTaskSourceQueries = map[entities.AdminTaskType]string{
+	entities.AdminTaskTypeReviewModeration: `SELECT r.id, p.sku AS reference, p.name, r.rating AS quantity,
+			0 AS amount, '' AS currency, r.created_at AS occurred_at, NULL::timestamptz AS due_at
+		FROM reviews r
+		JOIN products p ON p.id = r.product_id
+		WHERE r.status = 'pending'
+		ORDER BY r.created_at
+		LIMIT ?`,
+	entities.AdminTaskTypeOrderVerification: `SELECT o.id, o.order_number AS reference, COALESCE(u.email, '') AS name, 0 AS quantity,
+			o.total AS amount, o.currency, o.created_at AS occurred_at, NULL::timestamptz AS due_at
+		FROM orders o
+		LEFT JOIN users u ON u.id = o.user_id
+		WHERE o.status = 'pending'
+			AND o.payment_method IN ('cash', 'bank_transfer')
+			AND o.payment_status IN ('pending', 'awaiting_payment')
+		ORDER BY o.created_at
+		LIMIT ?`,
+	entities.AdminTaskTypeStockReorder: `SELECT i.id, p.sku AS reference, p.name, i.quantity_available AS quantity,
+			0 AS amount, '' AS currency, COALESCE(i.last_movement_at, i.updated_at) AS occurred_at, NULL::timestamptz AS due_at
+		FROM inventories i
+		JOIN products p ON p.id = i.product_id
+		WHERE i.is_active AND i.quantity_available <= i.reorder_level
+		ORDER BY i.quantity_available, p.sku
+		LIMIT ?`,
+	entities.AdminTaskTypeDisputeEvidence: `SELECT d.id, d.external_id AS reference, COALESCE(o.order_number, '') AS name, 0 AS quantity,
+			d.amount, d.currency, d.opened_at AS occurred_at, d.evidence_due_by AS due_at
+		FROM disputes d
+		LEFT JOIN orders o ON o.id = d.order_id
+		WHERE d.status = 'needs_response' AND d.evidence_submitted_at IS NULL
+		ORDER BY d.evidence_due_by NULLS LAST
+		LIMIT ?`,
+}
+
+type adminTaskRepository struct {
+	db *gorm.DB
+}
+
+// NewAdminTaskRepository creates a new admin task repository
+func NewAdminTaskRepository(db *gorm.DB) repositories.AdminTaskRepository {
+	return &adminTaskRepository{db: db}
+}
+
+// Create creates a task unless its source already has one open
+func (r *adminTaskRepository) Create(ctx context.Context, task *entities.AdminTask) (bool, error) {
+	// The partial unique index on open tasks makes a concurrent sync skip the duplicate
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(task)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetByID gets a task by ID
+func (r *adminTaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminTask, error) {
+	var task entities.AdminTask
+	if err := r.db.WithContext(ctx).Preload("Assignee").First(&task, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrAdminTaskNotFound
+		}
+		return nil, err
+	}
+	return &task, nil
+}
+
+// Update updates a task
+func (r *adminTaskRepository) Update(ctx context.Context, task *entities.AdminTask) error {
+	return r.db.WithContext(ctx).Omit("Assignee").Save(task).Error
+}
+
+// List lists tasks with filters
+func (r *adminTaskRepository) List(ctx context.Context, filters repositories.AdminTaskFilters) ([]*entities.AdminTask, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.AdminTask{})
+	if filters.Type != "" {
+		query = query.Where("type = ?", filters.Type)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.AssigneeID != nil {
+		query = query.Where("assignee_id = ?", *filters.AssigneeID)
+	}
+	if filters.Unassigned {
+		query = query.Where("assignee_id IS NULL")
+	}
+	if filters.OverdueAt != nil {
+		query = query.Where("status = ? AND due_at < ?", entities.AdminTaskStatusOpen, *filters.OverdueAt)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var tasks []*entities.AdminTask
+	err := query.
+		Preload("Assignee").
+		Order("priority DESC, due_at ASC NULLS LAST, created_at ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&tasks).Error
+	return tasks, total, err
+}
+
+// GetOpenByType gets the open tasks of a type
+func (r *adminTaskRepository) GetOpenByType(ctx context.Context, taskType entities.AdminTaskType) ([]*entities.AdminTask, error) {
+	var tasks []*entities.AdminTask
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND status = ?", taskType, entities.AdminTaskStatusOpen).
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// GetSummary counts open tasks per type
+func (r *adminTaskRepository) GetSummary(ctx context.Context, assigneeID uuid.UUID, now time.Time) ([]*entities.AdminTaskSummary, error) {
+	var summaries []*entities.AdminTaskSummary
+	err := r.db.WithContext(ctx).
+		Model(&entities.AdminTask{}).
+		Select(`type,
+			COUNT(*) AS open,
+			COUNT(*) FILTER (WHERE due_at < ?) AS overdue,
+			COUNT(*) FILTER (WHERE assignee_id IS NULL) AS unassigned,
+			COUNT(*) FILTER (WHERE assignee_id = ?) AS assigned_to_me`, now, assigneeID).
+		Where("status = ?", entities.AdminTaskStatusOpen).
+		Group("type").
+		Scan(&summaries).Error
+	return summaries, err
+}
+
+// GetTaskSources runs the task type's source query
+func (r *adminTaskRepository) GetTaskSources(ctx context.Context, taskType entities.AdminTaskType, limit int) ([]*entities.AdminTaskSource, error) {
+	query, ok := adminTaskSourceQueries[taskType]
+	if !ok {
+		return nil, fmt.Errorf("unknown admin task type %q", taskType)
+	}
+
+	var sources []*entities.AdminTaskSource
+	err := r.db.WithContext(ctx).Raw(query, limit).Scan(&sources).Error
+	return sources, err
+}
@@ -0,0 +1,210 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// aggregateSource describes where an aggregate is stored and how it is recomputed. Queries
+// refer to the record as rec.
+type aggregateSource struct {
+	table  string // Table of the records the aggregate belongs to
+	join   string // Join of the table holding the stored values, when they aren't on the record
+	stored string // Alias of the table holding the stored values
+	// actual selects the recomputed fields of rec, in the order of the kind's fields
+	actual string
+	// upsert stores recomputed values in a table of their own keyed by this column, instead of
+	// updating the record
+	upsertTable string
+	upsertKey   string
+}
+
+var aggregateSources = map[entities.AggregateKind]aggregateSource{
+	entities.AggregateCustomerMetrics: {
+		table:  "users",
+		stored: "rec",
+		actual: `SELECT COUNT(*) AS total_orders, COALESCE(SUM(o.total), 0) AS total_spent
+			FROM orders o
+			WHERE o.user_id = rec.id AND o.payment_status = @paid AND o.status NOT IN @excluded`,
+	},
+	entities.AggregateProductRatings: {
+		table:  "products",
+		join:   "LEFT JOIN product_ratings pr ON pr.product_id = rec.id",
+		stored: "pr",
+		actual: `SELECT COUNT(*) AS total_reviews, COALESCE(AVG(r.rating), 0) AS average_rating,
+				COUNT(*) FILTER (WHERE r.rating = 1) AS rating_1_count,
+				COUNT(*) FILTER (WHERE r.rating = 2) AS rating_2_count,
+				COUNT(*) FILTER (WHERE r.rating = 3) AS rating_3_count,
+				COUNT(*) FILTER (WHERE r.rating = 4) AS rating_4_count,
+				COUNT(*) FILTER (WHERE r.rating = 5) AS rating_5_count
+			FROM reviews r
+			WHERE r.product_id = rec.id AND r.status = @approved`,
+		upsertTable: "product_ratings",
+		upsertKey:   "product_id",
+	},
+	entities.AggregateReviewVotes: {
+		table:  "reviews",
+		stored: "rec",
+		actual: `SELECT COUNT(*) FILTER (WHERE v.vote_type = @helpful) AS helpful_count,
+				COUNT(*) FILTER (WHERE v.vote_type = @not_helpful) AS not_helpful_count
+			FROM review_votes v
+			WHERE v.review_id = rec.id`,
+	},
+}
+
+// aggregateParams are the named parameters the actual queries use
+func aggregateParams() map[string]interface{} {
+	return map[string]interface{}{
+		"paid":        entities.PaymentStatusPaid,
+		"excluded":    entities.CustomerMetricsExcludedOrderStatuses,
+		"approved":    entities.ReviewStatusApproved,
+		"helpful":     entities.ReviewVoteHelpful,
+		"not_helpful": entities.ReviewVoteNotHelpful,
+	}
+}
+
+type aggregateRepairRepository struct {
+	db *gorm.DB
+}
+
+// NewAggregateRepairRepository creates a new aggregate repair repository
+func NewAggregateRepairRepository(db *gorm.DB) repositories.AggregateRepairRepository {
+	return &aggregateRepairRepository{db: db}
+}
+
+// CompareAggregates reads a batch of records with their stored and recomputed values
+func (r *aggregateRepairRepository) CompareAggregates(ctx context.Context, kind entities.AggregateKind, afterID uuid.UUID, limit int) ([]*entities.AggregateComparison, error) {
+	source, ok := aggregateSources[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown aggregate %s", kind)
+	}
+	fields := kind.Fields()
+
+	columns := []string{"rec.id AS record_id"}
+	for _, field := range fields {
+		columns = append(columns, fmt.Sprintf("COALESCE(%s.%s, 0) AS stored_%s", source.stored, field, field))
+	}
+	for _, field := range fields {
+		columns = append(columns, fmt.Sprintf("a.%s AS actual_%s", field, field))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s rec %s CROSS JOIN LATERAL (%s) a WHERE rec.id > @after ORDER BY rec.id LIMIT @limit",
+		strings.Join(columns, ", "), source.table, source.join, source.actual)
+
+	params := aggregateParams()
+	params["after"] = afterID
+	params["limit"] = limit
+	rows, err := r.db.WithContext(ctx).Raw(query, params).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comparisons []*entities.AggregateComparison
+	for rows.Next() {
+		comparison := &entities.AggregateComparison{
+			Stored: make(map[string]float64, len(fields)),
+			Actual: make(map[string]float64, len(fields)),
+		}
+		stored := make([]float64, len(fields))
+		actual := make([]float64, len(fields))
+		dest := []interface{}{&comparison.RecordID}
+		for i := range fields {
+			dest = append(dest, &stored[i])
+		}
+		for i := range fields {
+			dest = append(dest, &actual[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		for i, field := range fields {
+			comparison.Stored[field] = stored[i]
+			comparison.Actual[field] = actual[i]
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, rows.Err()
+}
+
+// RepairAggregates recomputes and stores the aggregate of the records in one statement, so
+// changes made since they were compared are not overwritten with stale values
+func (r *aggregateRepairRepository) RepairAggregates(ctx context.Context, kind entities.AggregateKind, recordIDs []uuid.UUID) error {
+	source, ok := aggregateSources[kind]
+	if !ok {
+		return fmt.Errorf("unknown aggregate %s", kind)
+	}
+	if len(recordIDs) == 0 {
+		return nil
+	}
+	fields := kind.Fields()
+
+	var statement string
+	if source.upsertTable != "" {
+		values := make([]string, len(fields))
+		updates := make([]string, len(fields))
+		for i, field := range fields {
+			values[i] = "a." + field
+			updates[i] = fmt.Sprintf("%s = EXCLUDED.%s", field, field)
+		}
+		statement = fmt.Sprintf(`INSERT INTO %s (%s, %s, created_at, updated_at)
+			SELECT rec.id, %s, NOW(), NOW() FROM %s rec CROSS JOIN LATERAL (%s) a WHERE rec.id IN @ids
+			ON CONFLICT (%s) DO UPDATE SET %s, updated_at = EXCLUDED.updated_at`,
+			source.upsertTable, source.upsertKey, strings.Join(fields, ", "),
+			strings.Join(values, ", "), source.table, source.actual,
+			source.upsertKey, strings.Join(updates, ", "))
+	} else {
+		statement = fmt.Sprintf("UPDATE %s rec SET (%s) = (%s), updated_at = NOW() WHERE rec.id IN @ids",
+			source.table, strings.Join(fields, ", "), source.actual)
+	}
+
+	params := aggregateParams()
+	params["ids"] = recordIDs
+	return r.db.WithContext(ctx).Exec(statement, params).Error
+}
+
+// CreateRun creates a repair run
+func (r *aggregateRepairRepository) CreateRun(ctx context.Context, run *entities.AggregateRepairRun) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+// UpdateRun updates a repair run
+func (r *aggregateRepairRepository) UpdateRun(ctx context.Context, run *entities.AggregateRepairRun) error {
+	return r.db.WithContext(ctx).Save(run).Error
+}
+
+// GetRun gets a repair run by ID
+func (r *aggregateRepairRepository) GetRun(ctx context.Context, id uuid.UUID) (*entities.AggregateRepairRun, error) {
+	var run entities.AggregateRepairRun
+	if err := r.db.WithContext(ctx).First(&run, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrAggregateRepairRunNotFound
+		}
+		return nil, err
+	}
+	return &run, nil
+}
+
+// ListRuns lists repair runs, newest first
+func (r *aggregateRepairRepository) ListRuns(ctx context.Context, limit, offset int) ([]*entities.AggregateRepairRun, int64, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&entities.AggregateRepairRun{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var runs []*entities.AggregateRepairRun
+	err := r.db.WithContext(ctx).
+		Omit("discrepancies").
+		Order("started_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&runs).Error
+	return runs, total, err
+}
@@ -646,25 +646,49 @@ func (r *analyticsRepository) GetDashboardMetrics(ctx context.Context, dateFrom,
 	}, nil
 }
 
-// GetFunnelAnalysis gets funnel analysis data (placeholder)
+// GetFunnelAnalysis gets how many sessions reached each funnel step in order
 func (r *analyticsRepository) GetFunnelAnalysis(ctx context.Context, steps []string, from, to time.Time) (*repositories.FunnelAnalysis, error) {
-	// Placeholder implementation
-	return &repositories.FunnelAnalysis{
-		Steps:          steps,
-		TotalUsers:     0,
-		ConversionRate: 0,
-	}, nil
+	funnel := &repositories.FunnelAnalysis{
+		Steps:        steps,
+		StepSessions: make([]int64, len(steps)),
+	}
+
+	// Sessions rather than users are counted so anonymous visitors show up in the funnel.
+	// A session reaches a step when it has events for that step and every step before it.
+	for i := range steps {
+		var count int64
+		err := r.db.WithContext(ctx).Raw(`
+			SELECT COUNT(*) FROM (
+				SELECT session_id
+				FROM analytics_events
+				WHERE created_at >= ? AND created_at < ? AND event_type IN ?
+				GROUP BY session_id
+				HAVING COUNT(DISTINCT event_type) = ?
+			) reached`, from, to, steps[:i+1], i+1).Scan(&count).Error
+		if err != nil {
+			return nil, err
+		}
+		funnel.StepSessions[i] = count
+	}
+
+	if len(steps) > 0 {
+		funnel.TotalUsers = funnel.StepSessions[0]
+		if funnel.TotalUsers > 0 {
+			funnel.ConversionRate = float64(funnel.StepSessions[len(steps)-1]) / float64(funnel.TotalUsers) * 100
+		}
+	}
+	return funnel, nil
 }
 
 // GetOnlineVisitors gets current online visitors count
 func (r *analyticsRepository) GetOnlineVisitors(ctx context.Context) (int64, error) {
-	// Consider users active in the last 5 minutes as online
+	// Consider sessions active in the last 5 minutes as online, so anonymous visitors are counted too
 	fiveMinutesAgo := time.Now().Add(-5 * time.Minute)
 	var count int64
 	err := r.db.WithContext(ctx).
 		Model(&entities.AnalyticsEvent{}).
 		Where("created_at >= ?", fiveMinutesAgo).
-		Select("COUNT(DISTINCT user_id)").
+		Select("COUNT(DISTINCT session_id)").
 		Scan(&count).Error
 	return count, err
 }
@@ -745,3 +769,76 @@ func (r *analyticsRepository) GetUserCohorts(ctx context.Context, period string)
 		RetentionRate: 0,
 	}, nil
 }
+
+// visitorTrackingTables are the tables holding per-session tracking data for visitors who may not be signed in
+var visitorTrackingTables = []string{"analytics_events", "user_product_interactions", "search_events", "filter_usage"}
+
+// anonymizedSessionPrefix marks session IDs that were replaced with a hash and can no longer be stitched
+const anonymizedSessionPrefix = "anon_"
+
+// StitchSession attributes a session's anonymous tracking data to a user
+func (r *analyticsRepository) StitchSession(ctx context.Context, sessionID string, userID uuid.UUID) (int64, error) {
+	var stitched int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, table := range visitorTrackingTables {
+			result := tx.Table(table).
+				Where("session_id = ? AND user_id IS NULL", sessionID).
+				Update("user_id", userID)
+			if result.Error != nil {
+				return result.Error
+			}
+			stitched += result.RowsAffected
+		}
+		return nil
+	})
+	return stitched, err
+}
+
+// AnonymizeVisitorData strips identifying data from anonymous tracking rows created before the cutoff.
+// Session IDs are hashed rather than cleared so funnels over old data still group events by visit.
+func (r *analyticsRepository) AnonymizeVisitorData(ctx context.Context, before time.Time) (int64, error) {
+	var anonymized int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, table := range visitorTrackingTables {
+			updates := map[string]interface{}{
+				"session_id": gorm.Expr("? || md5(session_id)", anonymizedSessionPrefix),
+			}
+			switch table {
+			case "analytics_events":
+				updates["ip_address"] = ""
+				updates["user_agent"] = ""
+				updates["city"] = ""
+			case "search_events":
+				updates["ip_address"] = ""
+				updates["user_agent"] = ""
+			}
+
+			result := tx.Table(table).
+				Where("user_id IS NULL AND created_at < ?", before).
+				Where("session_id IS NOT NULL AND session_id NOT LIKE ?", anonymizedSessionPrefix+"%").
+				Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			anonymized += result.RowsAffected
+		}
+		return nil
+	})
+	return anonymized, err
+}
+
+// PurgeVisitorData deletes anonymous tracking rows created before the cutoff
+func (r *analyticsRepository) PurgeVisitorData(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, table := range visitorTrackingTables {
+			result := tx.Exec("DELETE FROM "+table+" WHERE user_id IS NULL AND created_at < ?", before)
+			if result.Error != nil {
+				return result.Error
+			}
+			purged += result.RowsAffected
+		}
+		return nil
+	})
+	return purged, err
+}
@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -21,6 +22,12 @@ func NewAuditRepository(db *gorm.DB) repositories.AuditRepository {
 
 // Create creates a new audit log entry
 func (r *auditRepository) Create(ctx context.Context, log *entities.AuditLog) error {
+	return r.create(ctx, log)
+}
+
+// create stores an audit log, attributed to the actor of the request it was written in
+func (r *auditRepository) create(ctx context.Context, log *entities.AuditLog) error {
+	entities.ActorFromContext(ctx).Attribute(log)
 	return r.db.WithContext(ctx).Create(log).Error
 }
 
@@ -254,6 +261,10 @@ func (r *auditRepository) DeleteOld(ctx context.Context, olderThan time.Time) er
 
 // CreateBulk creates multiple audit logs in batch
 func (r *auditRepository) CreateBulk(ctx context.Context, logs []*entities.AuditLog) error {
+	actor := entities.ActorFromContext(ctx)
+	for _, log := range logs {
+		actor.Attribute(log)
+	}
 	return r.db.WithContext(ctx).CreateInBatches(logs, 100).Error
 }
 
@@ -648,7 +659,7 @@ func (r *auditRepository) LogDataChange(ctx context.Context, userID uuid.UUID, t
 		"new_data":  newData,
 	}
 	log := &entities.AuditLog{
-		ID:       uuid.New(),
+		ID:       ids.New(),
 		UserID:   &userID,
 		Action:   string(action),
 		Resource: table,
@@ -657,7 +668,7 @@ func (r *auditRepository) LogDataChange(ctx context.Context, userID uuid.UUID, t
 		Category: entities.LogCategoryData,
 		CreatedAt: time.Now(),
 	}
-	return r.db.WithContext(ctx).Create(log).Error
+	return r.create(ctx, log)
 }
 
 // LogSecurityEvent logs security events
@@ -668,7 +679,7 @@ func (r *auditRepository) LogSecurityEvent(ctx context.Context, userID *uuid.UUI
 		"metadata":    metadata,
 	}
 	log := &entities.AuditLog{
-		ID:       uuid.New(),
+		ID:       ids.New(),
 		UserID:   userID,
 		Action:   event,
 		Resource: "security",
@@ -677,7 +688,7 @@ func (r *auditRepository) LogSecurityEvent(ctx context.Context, userID *uuid.UUI
 		Category: entities.LogCategorySecurity,
 		CreatedAt: time.Now(),
 	}
-	return r.db.WithContext(ctx).Create(log).Error
+	return r.create(ctx, log)
 }
 
 // LogSystemEvent logs system events
@@ -687,7 +698,7 @@ func (r *auditRepository) LogSystemEvent(ctx context.Context, event, description
 		"metadata":    metadata,
 	}
 	log := &entities.AuditLog{
-		ID:       uuid.New(),
+		ID:       ids.New(),
 		Action:   event,
 		Resource: "system",
 		Details:  details,
@@ -695,13 +706,13 @@ func (r *auditRepository) LogSystemEvent(ctx context.Context, event, description
 		Category: entities.LogCategorySystem,
 		CreatedAt: time.Now(),
 	}
-	return r.db.WithContext(ctx).Create(log).Error
+	return r.create(ctx, log)
 }
 
 // LogUserAction logs user actions
 func (r *auditRepository) LogUserAction(ctx context.Context, userID uuid.UUID, action, resource string, details map[string]interface{}) error {
 	log := &entities.AuditLog{
-		ID:       uuid.New(),
+		ID:       ids.New(),
 		UserID:   &userID,
 		Action:   action,
 		Resource: resource,
@@ -710,7 +721,7 @@ func (r *auditRepository) LogUserAction(ctx context.Context, userID uuid.UUID, a
 		Category: entities.LogCategoryUser,
 		CreatedAt: time.Now(),
 	}
-	return r.db.WithContext(ctx).Create(log).Error
+	return r.create(ctx, log)
 }
 
 // SearchLogs searches audit logs
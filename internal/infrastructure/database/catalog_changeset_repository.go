@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type catalogChangesetRepository struct {
+	db *gorm.DB
+}
+
+// NewCatalogChangesetRepository creates a new catalog changeset repository
+func NewCatalogChangesetRepository(db *gorm.DB) repositories.CatalogChangesetRepository {
+	return &catalogChangesetRepository{db: db}
+}
+
+// Create creates a new catalog changeset
+func (r *catalogChangesetRepository) Create(ctx context.Context, changeset *entities.CatalogChangeset) error {
+	return r.db.WithContext(ctx).Create(changeset).Error
+}
+
+// GetByID gets a catalog changeset by ID
+func (r *catalogChangesetRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CatalogChangeset, error) {
+	var changeset entities.CatalogChangeset
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&changeset).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCatalogChangesetNotFound
+		}
+		return nil, err
+	}
+	return &changeset, nil
+}
+
+// GetByPreviewToken gets a catalog changeset by its preview token
+func (r *catalogChangesetRepository) GetByPreviewToken(ctx context.Context, token string) (*entities.CatalogChangeset, error) {
+	var changeset entities.CatalogChangeset
+	err := r.db.WithContext(ctx).Where("preview_token = ?", token).First(&changeset).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCatalogChangesetNotFound
+		}
+		return nil, err
+	}
+	return &changeset, nil
+}
+
+// Update updates a catalog changeset
+func (r *catalogChangesetRepository) Update(ctx context.Context, changeset *entities.CatalogChangeset) error {
+	return r.db.WithContext(ctx).Omit("Items").Save(changeset).Error
+}
+
+// Delete deletes a catalog changeset and its items
+func (r *catalogChangesetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("changeset_id = ?", id).Delete(&entities.CatalogChangesetItem{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("id = ?", id).Delete(&entities.CatalogChangeset{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrCatalogChangesetNotFound
+		}
+		return nil
+	})
+}
+
+// List lists catalog changesets with filters, newest first
+func (r *catalogChangesetRepository) List(ctx context.Context, filters repositories.CatalogChangesetFilters) ([]*entities.CatalogChangeset, error) {
+	var changesets []*entities.CatalogChangeset
+	query := r.applyFilters(r.db.WithContext(ctx), filters).
+		Order("created_at DESC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&changesets).Error
+	return changesets, err
+}
+
+// Count counts catalog changesets with filters
+func (r *catalogChangesetRepository) Count(ctx context.Context, filters repositories.CatalogChangesetFilters) (int64, error) {
+	var count int64
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.CatalogChangeset{}), filters)
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *catalogChangesetRepository) applyFilters(query *gorm.DB, filters repositories.CatalogChangesetFilters) *gorm.DB {
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	return query
+}
+
+// GetDue gets scheduled changesets whose publish time has passed, oldest first
+func (r *catalogChangesetRepository) GetDue(ctx context.Context, at time.Time, limit int) ([]*entities.CatalogChangeset, error) {
+	var changesets []*entities.CatalogChangeset
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND scheduled_at <= ?", entities.CatalogChangesetStatusScheduled, at).
+		Order("scheduled_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&changesets).Error
+	return changesets, err
+}
+
+// ListItems lists a changeset's items, oldest first
+func (r *catalogChangesetRepository) ListItems(ctx context.Context, changesetID uuid.UUID) ([]*entities.CatalogChangesetItem, error) {
+	var items []*entities.CatalogChangesetItem
+	err := r.db.WithContext(ctx).
+		Where("changeset_id = ?", changesetID).
+		Order("created_at ASC").
+		Find(&items).Error
+	return items, err
+}
+
+// CountItems counts the items of each of the given changesets
+func (r *catalogChangesetRepository) CountItems(ctx context.Context, changesetIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64, len(changesetIDs))
+	if len(changesetIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ChangesetID uuid.UUID
+		Count       int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.CatalogChangesetItem{}).
+		Select("changeset_id, COUNT(*) as count").
+		Where("changeset_id IN ?", changesetIDs).
+		Group("changeset_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ChangesetID] = row.Count
+	}
+	return counts, nil
+}
+
+// SaveItem creates an item or replaces the changeset's existing item for the product
+func (r *catalogChangesetRepository) SaveItem(ctx context.Context, item *entities.CatalogChangesetItem) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "changeset_id"}, {Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"changes", "updated_at"}),
+		}).
+		Create(item).Error
+}
+
+// DeleteItem removes a product from a changeset
+func (r *catalogChangesetRepository) DeleteItem(ctx context.Context, changesetID, productID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("changeset_id = ? AND product_id = ?", changesetID, productID).
+		Delete(&entities.CatalogChangesetItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// Publish applies a changeset's products and marks it published in one transaction
+func (r *catalogChangesetRepository) Publish(ctx context.Context, changeset *entities.CatalogChangeset, items []*entities.CatalogChangesetItem, products []*entities.Product, entries []*entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.CatalogChangeset{}).
+			Where("id = ? AND status IN ?", changeset.ID, []entities.CatalogChangesetStatus{
+				entities.CatalogChangesetStatusDraft,
+				entities.CatalogChangesetStatusScheduled,
+				entities.CatalogChangesetStatusFailed,
+			}).
+			Updates(map[string]interface{}{
+				"status":        changeset.Status,
+				"published_by":  changeset.PublishedBy,
+				"published_at":  changeset.PublishedAt,
+				"error_message": "",
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+
+		for _, item := range items {
+			if err := tx.Model(item).Select("before", "after").Updates(item).Error; err != nil {
+				return err
+			}
+		}
+		return saveCatalogProducts(tx, products, entries)
+	})
+}
+
+// Rollback restores a changeset's products and marks it rolled back in one transaction
+func (r *catalogChangesetRepository) Rollback(ctx context.Context, changeset *entities.CatalogChangeset, products []*entities.Product, entries []*entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.CatalogChangeset{}).
+			Where("id = ? AND status = ?", changeset.ID, entities.CatalogChangesetStatusPublished).
+			Updates(map[string]interface{}{
+				"status":         changeset.Status,
+				"rolled_back_by": changeset.RolledBackBy,
+				"rolled_back_at": changeset.RolledBackAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+		return saveCatalogProducts(tx, products, entries)
+	})
+}
+
+// saveCatalogProducts writes the changeset-editable fields of each product and the price history
+// entries. A product is only updated while its updated_at still matches the loaded one, so edits
+// made since are not overwritten.
+func saveCatalogProducts(tx *gorm.DB, products []*entities.Product, entries []*entities.PriceHistory) error {
+	now := time.Now()
+	for _, product := range products {
+		result := tx.Model(&entities.Product{}).
+			Where("id = ? AND updated_at = ?", product.ID, product.UpdatedAt).
+			Updates(map[string]interface{}{
+				"name":                   product.Name,
+				"short_description":      product.ShortDescription,
+				"description":            product.Description,
+				"meta_title":             product.MetaTitle,
+				"meta_description":       product.MetaDescription,
+				"keywords":               product.Keywords,
+				"price":                  product.Price,
+				"compare_price":          product.ComparePrice,
+				"sale_price":             product.SalePrice,
+				"sale_start_date":        product.SaleStartDate,
+				"sale_end_date":          product.SaleEndDate,
+				"featured":               product.Featured,
+				"visibility":             product.Visibility,
+				"status":                 product.Status,
+				"replacement_product_id": product.ReplacementProductID,
+				"discontinued_at":        product.DiscontinuedAt,
+				"archived_at":            product.ArchivedAt,
+				"updated_at":             now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(entries, 500).Error
+}
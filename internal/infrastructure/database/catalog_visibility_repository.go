@@ -0,0 +1,165 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type catalogVisibilityRepository struct {
+	db *gorm.DB
+}
+
+// NewCatalogVisibilityRepository creates a new catalog visibility rule repository
+func NewCatalogVisibilityRepository(db *gorm.DB) repositories.CatalogVisibilityRepository {
+	return &catalogVisibilityRepository{db: db}
+}
+
+// Create creates a new visibility rule
+func (r *catalogVisibilityRepository) Create(ctx context.Context, rule *entities.CatalogVisibilityRule) error {
+	return r.db.WithContext(ctx).Create(rule).Error
+}
+
+// GetByID gets a visibility rule by ID
+func (r *catalogVisibilityRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CatalogVisibilityRule, error) {
+	var rule entities.CatalogVisibilityRule
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&rule).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrVisibilityRuleNotFound
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// Update updates a visibility rule
+func (r *catalogVisibilityRepository) Update(ctx context.Context, rule *entities.CatalogVisibilityRule) error {
+	rule.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Save(rule).Error
+}
+
+// Delete deletes a visibility rule
+func (r *catalogVisibilityRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entities.CatalogVisibilityRule{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrVisibilityRuleNotFound
+	}
+	return nil
+}
+
+// List lists visibility rules with filters, newest first
+func (r *catalogVisibilityRepository) List(ctx context.Context, filters repositories.VisibilityRuleFilters) ([]*entities.CatalogVisibilityRule, error) {
+	var rules []*entities.CatalogVisibilityRule
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.CatalogVisibilityRule{}), filters).
+		Order("created_at DESC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&rules).Error
+	return rules, err
+}
+
+// Count counts visibility rules with filters
+func (r *catalogVisibilityRepository) Count(ctx context.Context, filters repositories.VisibilityRuleFilters) (int64, error) {
+	var count int64
+	err := r.applyFilters(r.db.WithContext(ctx).Model(&entities.CatalogVisibilityRule{}), filters).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *catalogVisibilityRepository) applyFilters(query *gorm.DB, filters repositories.VisibilityRuleFilters) *gorm.DB {
+	if filters.ProductID != nil {
+		query = query.Where("product_id = ?", *filters.ProductID)
+	}
+	if filters.CategoryID != nil {
+		query = query.Where("category_id = ?", *filters.CategoryID)
+	}
+	if filters.Audience != nil {
+		query = query.Where("audience = ?", *filters.Audience)
+	}
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+	return query
+}
+
+// GetHiddenProductIDs gets the products restricted by active rules that admit none of the viewer's audiences.
+// Category rules cover products assigned to the category or any of its subcategories.
+func (r *catalogVisibilityRepository) GetHiddenProductIDs(ctx context.Context, viewer *entities.CatalogViewer) ([]uuid.UUID, error) {
+	if viewer.IsStaff {
+		return nil, nil
+	}
+
+	admitted, args := r.viewerAudienceCondition(viewer)
+
+	query := `
+		WITH RECURSIVE rule_categories AS (
+			SELECT id AS rule_id, category_id FROM catalog_visibility_rules
+			WHERE is_active = true AND category_id IS NOT NULL
+
+			UNION
+
+			SELECT rc.rule_id, c.id FROM categories c
+			INNER JOIN rule_categories rc ON c.parent_id = rc.category_id
+		),
+		rule_products AS (
+			SELECT id AS rule_id, product_id FROM catalog_visibility_rules
+			WHERE is_active = true AND product_id IS NOT NULL
+
+			UNION
+
+			SELECT rc.rule_id, pc.product_id FROM rule_categories rc
+			INNER JOIN product_categories pc ON pc.category_id = rc.category_id
+		)
+		SELECT DISTINCT rp.product_id FROM rule_products rp
+		WHERE NOT EXISTS (
+			SELECT 1 FROM rule_products admitted
+			INNER JOIN catalog_visibility_rules r ON r.id = admitted.rule_id
+			WHERE admitted.product_id = rp.product_id AND (` + admitted + `)
+		)
+	`
+
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Raw(query, args...).Scan(&ids).Error
+	return ids, err
+}
+
+// viewerAudienceCondition builds the SQL condition matching rules that admit the viewer
+func (r *catalogVisibilityRepository) viewerAudienceCondition(viewer *entities.CatalogViewer) (string, []interface{}) {
+	if viewer.IsGuest() {
+		return "FALSE", nil
+	}
+
+	conditions := []string{"r.audience = 'logged_in'"}
+	var args []interface{}
+
+	if viewer.CompanyID != nil {
+		conditions = append(conditions, "(r.audience = 'company' AND (r.audience_value = '' OR r.audience_value IS NULL OR r.audience_value = ?))")
+		args = append(args, viewer.CompanyID.String())
+	}
+	if viewer.MembershipTier != "" {
+		conditions = append(conditions, "(r.audience = 'membership_tier' AND r.audience_value = ?)")
+		args = append(args, viewer.MembershipTier)
+	}
+	if viewer.CustomerSegment != "" {
+		conditions = append(conditions, "(r.audience = 'customer_segment' AND r.audience_value = ?)")
+		args = append(args, viewer.CustomerSegment)
+	}
+
+	return strings.Join(conditions, " OR "), args
+}
@@ -0,0 +1,228 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// categoryAssignmentBatchSize is the number of products written per statement
+const categoryAssignmentBatchSize = 500
+
+type categoryAssignmentRepository struct {
+	db *gorm.DB
+}
+
+// NewCategoryAssignmentRepository creates a new category assignment repository
+func NewCategoryAssignmentRepository(db *gorm.DB) repositories.CategoryAssignmentRepository {
+	return &categoryAssignmentRepository{db: db}
+}
+
+// Create creates a new category assignment
+func (r *categoryAssignmentRepository) Create(ctx context.Context, assignment *entities.CategoryAssignment) error {
+	return r.db.WithContext(ctx).Create(assignment).Error
+}
+
+// GetByID gets a category assignment by ID
+func (r *categoryAssignmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CategoryAssignment, error) {
+	var assignment entities.CategoryAssignment
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&assignment).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCategoryAssignmentNotFound
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// Update updates a category assignment
+func (r *categoryAssignmentRepository) Update(ctx context.Context, assignment *entities.CategoryAssignment) error {
+	return r.db.WithContext(ctx).Save(assignment).Error
+}
+
+// List lists category assignments with filters, newest first
+func (r *categoryAssignmentRepository) List(ctx context.Context, filters repositories.CategoryAssignmentFilters) ([]*entities.CategoryAssignment, error) {
+	var assignments []*entities.CategoryAssignment
+	query := r.applyFilters(r.db.WithContext(ctx), filters).
+		Order("created_at DESC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&assignments).Error
+	return assignments, err
+}
+
+// Count counts category assignments with filters
+func (r *categoryAssignmentRepository) Count(ctx context.Context, filters repositories.CategoryAssignmentFilters) (int64, error) {
+	var count int64
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.CategoryAssignment{}), filters)
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *categoryAssignmentRepository) applyFilters(query *gorm.DB, filters repositories.CategoryAssignmentFilters) *gorm.DB {
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	return query
+}
+
+// ClaimNext marks the oldest pending, or stale running, assignment running. Rows locked by
+// another server are skipped, so each assignment is run by one server.
+func (r *categoryAssignmentRepository) ClaimNext(ctx context.Context, staleBefore time.Time) (*entities.CategoryAssignment, error) {
+	var assignment entities.CategoryAssignment
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND started_at < ?)",
+				entities.CategoryAssignmentStatusPending, entities.CategoryAssignmentStatusRunning, staleBefore).
+			Order("created_at ASC").
+			First(&assignment).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		assignment.Status = entities.CategoryAssignmentStatusRunning
+		assignment.StartedAt = &now
+		return tx.Model(&entities.CategoryAssignment{}).
+			Where("id = ?", assignment.ID).
+			Updates(map[string]interface{}{
+				"status":     assignment.Status,
+				"started_at": assignment.StartedAt,
+			}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// GetMatchingProducts gets the ID, name and SKU of the products a selector matches
+func (r *categoryAssignmentRepository) GetMatchingProducts(ctx context.Context, filter repositories.CategoryAssignmentProductFilter) ([]*entities.Product, error) {
+	var products []*entities.Product
+	query := r.db.WithContext(ctx).Model(&entities.Product{}).
+		Select("products.id", "products.name", "products.sku")
+
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("products.id IN ?", filter.ProductIDs)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where("products.id IN (?)", r.db.Table("product_categories").
+			Select("product_id").
+			Where("category_id IN ?", filter.CategoryIDs))
+	}
+	if filter.BrandID != nil {
+		query = query.Where("products.brand_id = ?", *filter.BrandID)
+	}
+	if filter.VendorID != nil {
+		query = query.Where("products.vendor_id = ?", *filter.VendorID)
+	}
+	if filter.Status != nil {
+		query = query.Where("products.status = ?", *filter.Status)
+	}
+	if filter.Search != "" {
+		query = query.Where("products.name ILIKE ? OR products.sku ILIKE ?", "%"+filter.Search+"%", "%"+filter.Search+"%")
+	}
+	if filter.MinPrice != nil {
+		query = query.Where("products.price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where("products.price <= ?", *filter.MaxPrice)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	err := query.Order("products.name ASC").Find(&products).Error
+	return products, err
+}
+
+// Apply adds, removes or moves the products in one transaction, in batches
+func (r *categoryAssignmentRepository) Apply(ctx context.Context, assignment *entities.CategoryAssignment, productIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(productIDs); start += categoryAssignmentBatchSize {
+			end := start + categoryAssignmentBatchSize
+			if end > len(productIDs) {
+				end = len(productIDs)
+			}
+			if err := applyCategoryAssignment(tx, assignment, productIDs[start:end]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// applyCategoryAssignment applies an assignment to one batch of products
+func applyCategoryAssignment(tx *gorm.DB, assignment *entities.CategoryAssignment, productIDs []uuid.UUID) error {
+	switch assignment.Action {
+	case entities.CategoryAssignmentActionRemove:
+		if err := tx.Where("product_id IN ? AND category_id = ?", productIDs, assignment.CategoryID).
+			Delete(&entities.ProductCategory{}).Error; err != nil {
+			return err
+		}
+
+	case entities.CategoryAssignmentActionMove:
+		source := *assignment.SourceCategoryID
+		// Products already in the target keep that assignment, taking over the primary flag
+		if err := tx.Exec(`UPDATE product_categories SET is_primary = true, updated_at = NOW()
+			WHERE category_id = ? AND product_id IN (
+				SELECT product_id FROM product_categories
+				WHERE category_id = ? AND is_primary = true AND product_id IN ?
+			)`, assignment.CategoryID, source, productIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`DELETE FROM product_categories
+			WHERE category_id = ? AND product_id IN ? AND product_id IN (
+				SELECT product_id FROM product_categories WHERE category_id = ?
+			)`, source, productIDs, assignment.CategoryID).Error; err != nil {
+			return err
+		}
+		// The others move their source assignment, keeping its primary flag
+		if err := tx.Exec(`UPDATE product_categories SET category_id = ?, updated_at = NOW()
+			WHERE category_id = ? AND product_id IN ?`,
+			assignment.CategoryID, source, productIDs).Error; err != nil {
+			return err
+		}
+		// Products that were not in the source are added to the target
+		fallthrough
+
+	case entities.CategoryAssignmentActionAdd:
+		if err := tx.Exec(`INSERT INTO product_categories (id, product_id, category_id, is_primary, created_at, updated_at)
+			SELECT gen_random_uuid(), products.id, ?, false, NOW(), NOW() FROM products
+			WHERE products.id IN ? AND NOT EXISTS (
+				SELECT 1 FROM product_categories
+				WHERE product_categories.product_id = products.id AND product_categories.category_id = ?
+			)`, assignment.CategoryID, productIDs, assignment.CategoryID).Error; err != nil {
+			return err
+		}
+	}
+
+	if assignment.MakePrimary {
+		if err := tx.Model(&entities.ProductCategory{}).
+			Where("product_id IN ?", productIDs).
+			Update("is_primary", gorm.Expr("category_id = ?", assignment.CategoryID)).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(&entities.Product{}).
+		Where("id IN ?", productIDs).
+		UpdateColumn("updated_at", time.Now()).Error
+}
@@ -0,0 +1,384 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type companyRepository struct {
+	db *gorm.DB
+}
+
+// NewCompanyRepository creates a new company repository
+func NewCompanyRepository(db *gorm.DB) repositories.CompanyRepository {
+	return &companyRepository{db: db}
+}
+
+// Create creates a new company
+func (r *companyRepository) Create(ctx context.Context, company *entities.Company) error {
+	return r.db.WithContext(ctx).Create(company).Error
+}
+
+// GetByID gets a company by ID
+func (r *companyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Company, error) {
+	var company entities.Company
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&company).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCompanyNotFound
+		}
+		return nil, err
+	}
+	return &company, nil
+}
+
+// Update updates a company
+func (r *companyRepository) Update(ctx context.Context, company *entities.Company) error {
+	company.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Omit("Members").Save(company).Error
+}
+
+// List lists companies with filters
+func (r *companyRepository) List(ctx context.Context, filters repositories.CompanyFilters) ([]*entities.Company, error) {
+	var companies []*entities.Company
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.Company{}), filters).
+		Order("name ASC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&companies).Error
+	return companies, err
+}
+
+// Count counts companies with filters
+func (r *companyRepository) Count(ctx context.Context, filters repositories.CompanyFilters) (int64, error) {
+	var count int64
+	err := r.applyFilters(r.db.WithContext(ctx).Model(&entities.Company{}), filters).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *companyRepository) applyFilters(query *gorm.DB, filters repositories.CompanyFilters) *gorm.DB {
+	if filters.Search != "" {
+		search := "%" + filters.Search + "%"
+		query = query.Where("name ILIKE ? OR legal_name ILIKE ? OR tax_id ILIKE ?", search, search, search)
+	}
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+	return query
+}
+
+// AddMember adds a user to a company
+func (r *companyRepository) AddMember(ctx context.Context, member *entities.CompanyMember) error {
+	return r.db.WithContext(ctx).Create(member).Error
+}
+
+// GetMemberByID gets a company member by ID
+func (r *companyRepository) GetMemberByID(ctx context.Context, id uuid.UUID) (*entities.CompanyMember, error) {
+	var member entities.CompanyMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id = ?", id).
+		First(&member).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCompanyMemberNotFound
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+// GetMemberByUserID gets the company membership of a user
+func (r *companyRepository) GetMemberByUserID(ctx context.Context, userID uuid.UUID) (*entities.CompanyMember, error) {
+	var member entities.CompanyMember
+	err := r.db.WithContext(ctx).
+		Preload("Company").
+		Where("user_id = ?", userID).
+		First(&member).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCompanyMemberNotFound
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+// GetMembers gets all members of a company
+func (r *companyRepository) GetMembers(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error) {
+	var members []*entities.CompanyMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("company_id = ?", companyID).
+		Order("created_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+// UpdateMember updates a company member
+func (r *companyRepository) UpdateMember(ctx context.Context, member *entities.CompanyMember) error {
+	member.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Omit("Company", "User").Save(member).Error
+}
+
+// RemoveMember removes a member from a company
+func (r *companyRepository) RemoveMember(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&entities.CompanyMember{}, "id = ?", id).Error
+}
+
+type orderApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderApprovalRepository creates a new order approval repository
+func NewOrderApprovalRepository(db *gorm.DB) repositories.OrderApprovalRepository {
+	return &orderApprovalRepository{db: db}
+}
+
+// Create creates a new order approval request
+func (r *orderApprovalRepository) Create(ctx context.Context, approval *entities.OrderApproval) error {
+	return r.db.WithContext(ctx).Create(approval).Error
+}
+
+// GetByID gets an order approval by ID
+func (r *orderApprovalRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.OrderApproval, error) {
+	var approval entities.OrderApproval
+	err := r.db.WithContext(ctx).
+		Preload("Order").
+		Preload("Requester").
+		Where("id = ?", id).
+		First(&approval).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrOrderApprovalNotFound
+		}
+		return nil, err
+	}
+	return &approval, nil
+}
+
+// GetByOrderID gets the approval request for an order
+func (r *orderApprovalRepository) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entities.OrderApproval, error) {
+	var approval entities.OrderApproval
+	err := r.db.WithContext(ctx).Where("order_id = ?", orderID).First(&approval).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrOrderApprovalNotFound
+		}
+		return nil, err
+	}
+	return &approval, nil
+}
+
+// Update updates an order approval
+func (r *orderApprovalRepository) Update(ctx context.Context, approval *entities.OrderApproval) error {
+	approval.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Omit("Order", "Requester").Save(approval).Error
+}
+
+// GetByCompanyID gets approvals for a company, newest first
+func (r *orderApprovalRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID, status *entities.OrderApprovalStatus, requestedBy *uuid.UUID, limit, offset int) ([]*entities.OrderApproval, error) {
+	var approvals []*entities.OrderApproval
+	query := r.db.WithContext(ctx).
+		Preload("Order").
+		Preload("Requester").
+		Where("company_id = ?", companyID)
+
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	if requestedBy != nil {
+		query = query.Where("requested_by = ?", *requestedBy)
+	}
+
+	query = query.Order("created_at DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err := query.Find(&approvals).Error
+	return approvals, err
+}
+
+// CountByCompanyID counts approvals for a company
+func (r *orderApprovalRepository) CountByCompanyID(ctx context.Context, companyID uuid.UUID, status *entities.OrderApprovalStatus, requestedBy *uuid.UUID) (int64, error) {
+	var count int64
+	query := r.db.WithContext(ctx).
+		Model(&entities.OrderApproval{}).
+		Where("company_id = ?", companyID)
+
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	if requestedBy != nil {
+		query = query.Where("requested_by = ?", *requestedBy)
+	}
+
+	err := query.Count(&count).Error
+	return count, err
+}
+
+type companyInvoiceRepository struct {
+	db *gorm.DB
+}
+
+// NewCompanyInvoiceRepository creates a new company invoice repository
+func NewCompanyInvoiceRepository(db *gorm.DB) repositories.CompanyInvoiceRepository {
+	return &companyInvoiceRepository{db: db}
+}
+
+// Create creates an invoice with its items and links the invoiced orders to it
+func (r *companyInvoiceRepository) Create(ctx context.Context, invoice *entities.CompanyInvoice) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(invoice).Error; err != nil {
+			return err
+		}
+
+		orderIDs := make([]uuid.UUID, 0, len(invoice.Items))
+		for _, item := range invoice.Items {
+			orderIDs = append(orderIDs, item.OrderID)
+		}
+		if len(orderIDs) == 0 {
+			return nil
+		}
+
+		// Only link orders that are still uninvoiced so concurrent runs cannot bill an order twice
+		result := tx.Model(&entities.Order{}).
+			Where("id IN ? AND company_invoice_id IS NULL", orderIDs).
+			Update("company_invoice_id", invoice.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(orderIDs)) {
+			return entities.ErrConflict
+		}
+		return nil
+	})
+}
+
+// GetByID gets a company invoice by ID
+func (r *companyInvoiceRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.CompanyInvoice, error) {
+	var invoice entities.CompanyInvoice
+	err := r.db.WithContext(ctx).
+		Preload("Company").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_date ASC")
+		}).
+		Preload("Payments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("received_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrCompanyInvoiceNotFound
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// Update updates a company invoice
+func (r *companyInvoiceRepository) Update(ctx context.Context, invoice *entities.CompanyInvoice) error {
+	invoice.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Omit("Company", "Items", "Payments").Save(invoice).Error
+}
+
+// GetByCompanyID gets invoices for a company, newest first
+func (r *companyInvoiceRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID, limit, offset int) ([]*entities.CompanyInvoice, error) {
+	var invoices []*entities.CompanyInvoice
+	query := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("issued_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err := query.Find(&invoices).Error
+	return invoices, err
+}
+
+// CountByCompanyID counts invoices for a company
+func (r *companyInvoiceRepository) CountByCompanyID(ctx context.Context, companyID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.CompanyInvoice{}).
+		Where("company_id = ?", companyID).
+		Count(&count).Error
+	return count, err
+}
+
+// GetUninvoicedOrders gets approved, non-cancelled company orders in the period that are not yet invoiced
+func (r *companyInvoiceRepository) GetUninvoicedOrders(ctx context.Context, companyID uuid.UUID, from, to time.Time) ([]*entities.Order, error) {
+	var orders []*entities.Order
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND company_invoice_id IS NULL", companyID).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Where("status NOT IN ?", []entities.OrderStatus{entities.OrderStatusCancelled, entities.OrderStatusRefunded}).
+		Where("approval_status IS NULL OR approval_status IN ?", []entities.OrderApprovalStatus{"", entities.OrderApprovalStatusApproved}).
+		Order("created_at ASC").
+		Find(&orders).Error
+	return orders, err
+}
+
+// RecordPayment stores an offline payment and settles the invoiced orders when the invoice is paid
+func (r *companyInvoiceRepository) RecordPayment(ctx context.Context, invoice *entities.CompanyInvoice, payment *entities.CompanyInvoicePayment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(payment).Error; err != nil {
+			return err
+		}
+
+		invoice.UpdatedAt = time.Now()
+		if err := tx.Omit("Company", "Items", "Payments").Save(invoice).Error; err != nil {
+			return err
+		}
+
+		paymentStatus := entities.PaymentStatusPartiallyPaid
+		if invoice.Status == entities.CompanyInvoiceStatusPaid {
+			paymentStatus = entities.PaymentStatusPaid
+		}
+
+		return tx.Model(&entities.Order{}).
+			Where("company_invoice_id = ?", invoice.ID).
+			Updates(map[string]interface{}{
+				"payment_status": paymentStatus,
+				"updated_at":     time.Now(),
+			}).Error
+	})
+}
+
+// GetOverdue gets issued invoices past their due date, oldest due first
+func (r *companyInvoiceRepository) GetOverdue(ctx context.Context, now time.Time, limit int) ([]*entities.CompanyInvoice, error) {
+	var invoices []*entities.CompanyInvoice
+	query := r.db.WithContext(ctx).
+		Preload("Company").
+		Where("status = ? AND due_date < ?", entities.CompanyInvoiceStatusIssued, now).
+		Order("due_date ASC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Find(&invoices).Error
+	return invoices, err
+}
@@ -36,6 +36,9 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
+	if err := registerIDCallback(db); err != nil {
+		return nil, fmt.Errorf("failed to register ID callback: %w", err)
+	}
 
 	// Get underlying sql.DB
 	sqlDB, err := db.DB()
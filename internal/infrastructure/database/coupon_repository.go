@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -310,7 +311,7 @@ func (r *loyaltyRepository) GetUserPoints(ctx context.Context, userID uuid.UUID)
 		if err == gorm.ErrRecordNotFound {
 			// Create new points record
 			points = entities.UserLoyaltyPoints{
-				ID:              uuid.New(),
+				ID:              ids.New(),
 				UserID:          userID,
 				TotalPoints:     0,
 				AvailablePoints: 0,
@@ -348,7 +349,7 @@ func (r *loyaltyRepository) AddPoints(ctx context.Context, userID uuid.UUID, poi
 		if result.RowsAffected == 0 {
 			// Create new record if doesn't exist
 			userPoints := &entities.UserLoyaltyPoints{
-				ID:              uuid.New(),
+				ID:              ids.New(),
 				UserID:          userID,
 				TotalPoints:     points,
 				AvailablePoints: points,
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type disputeRepository struct {
+	db *gorm.DB
+}
+
+// NewDisputeRepository creates a new dispute repository
+func NewDisputeRepository(db *gorm.DB) repositories.DisputeRepository {
+	return &disputeRepository{db: db}
+}
+
+// Create creates a new dispute
+func (r *disputeRepository) Create(ctx context.Context, dispute *entities.Dispute) error {
+	return r.db.WithContext(ctx).Create(dispute).Error
+}
+
+// GetByID gets a dispute by ID
+func (r *disputeRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Dispute, error) {
+	var dispute entities.Dispute
+	if err := r.db.WithContext(ctx).First(&dispute, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrDisputeNotFound
+		}
+		return nil, err
+	}
+	return &dispute, nil
+}
+
+// GetByExternalID gets a dispute by the provider's ID
+func (r *disputeRepository) GetByExternalID(ctx context.Context, gateway, externalID string) (*entities.Dispute, error) {
+	var dispute entities.Dispute
+	err := r.db.WithContext(ctx).
+		First(&dispute, "gateway = ? AND external_id = ?", gateway, externalID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrDisputeNotFound
+		}
+		return nil, err
+	}
+	return &dispute, nil
+}
+
+// Update updates a dispute
+func (r *disputeRepository) Update(ctx context.Context, dispute *entities.Dispute) error {
+	return r.db.WithContext(ctx).Save(dispute).Error
+}
+
+// Resolve saves a resolved dispute and its chargeback in one transaction. A dispute's resolution
+// is applied once: resolved_at is set only here, so a concurrent or retried delivery finds no
+// unresolved row to update and charges nothing back.
+func (r *disputeRepository) Resolve(ctx context.Context, dispute *entities.Dispute, chargedBack *entities.Payment, order *entities.Order) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(dispute).
+			Where("resolved_at IS NULL").
+			Select("*").
+			Omit("id", "created_at").
+			Updates(dispute)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+
+		if chargedBack != nil {
+			err := tx.Model(&entities.Payment{}).
+				Where("id = ?", chargedBack.ID).
+				Updates(map[string]interface{}{
+					"status":        chargedBack.Status,
+					"refund_amount": chargedBack.RefundAmount,
+					"refund_reason": chargedBack.RefundReason,
+					"refunded_at":   chargedBack.RefundedAt,
+					"updated_at":    chargedBack.UpdatedAt,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if order != nil {
+			return tx.Model(&entities.Order{}).
+				Where("id = ?", order.ID).
+				Updates(map[string]interface{}{
+					"payment_status": order.PaymentStatus,
+					"updated_at":     order.UpdatedAt,
+				}).Error
+		}
+		return nil
+	})
+}
+
+// List lists disputes with filters
+func (r *disputeRepository) List(ctx context.Context, filters repositories.DisputeFilters) ([]*entities.Dispute, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Dispute{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.Gateway != "" {
+		query = query.Where("gateway = ?", filters.Gateway)
+	}
+	if filters.OrderID != nil {
+		query = query.Where("order_id = ?", *filters.OrderID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var disputes []*entities.Dispute
+	err := query.
+		Order("opened_at DESC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&disputes).Error
+	return disputes, total, err
+}
+
+// GetEvidenceDue gets disputes needing a response that are due soon and not yet reminded about
+func (r *disputeRepository) GetEvidenceDue(ctx context.Context, before time.Time, limit int) ([]*entities.Dispute, error) {
+	var disputes []*entities.Dispute
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND evidence_submitted_at IS NULL", entities.DisputeStatusNeedsResponse).
+		Where("evidence_due_by IS NOT NULL AND evidence_due_by <= ?", before).
+		Where("evidence_reminder_sent_at IS NULL").
+		Order("evidence_due_by ASC").
+		Limit(limit).
+		Find(&disputes).Error
+	return disputes, err
+}
+
+// GetStats counts disputes opened and payments made in a period
+func (r *disputeRepository) GetStats(ctx context.Context, from, to time.Time) (*entities.DisputeStats, error) {
+	var stats entities.DisputeStats
+	err := r.db.WithContext(ctx).
+		Model(&entities.Dispute{}).
+		Select(`COUNT(*) AS opened,
+			COUNT(*) FILTER (WHERE status = ?) AS won,
+			COUNT(*) FILTER (WHERE status = ?) AS lost,
+			COUNT(*) FILTER (WHERE status IN ?) AS open,
+			COALESCE(SUM(amount), 0) AS disputed_amount,
+			COALESCE(SUM(amount) FILTER (WHERE status = ?), 0) AS lost_amount`,
+			entities.DisputeStatusWon,
+			entities.DisputeStatusLost,
+			[]entities.DisputeStatus{entities.DisputeStatusNeedsResponse, entities.DisputeStatusUnderReview},
+			entities.DisputeStatusLost).
+		Where("opened_at >= ? AND opened_at < ?", from, to).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Refunded payments were paid first, so they count towards the dispute rate too
+	err = r.db.WithContext(ctx).
+		Model(&entities.Payment{}).
+		Where("status IN ?", []entities.PaymentStatus{entities.PaymentStatusPaid, entities.PaymentStatusRefunded}).
+		Where("processed_at >= ? AND processed_at < ?", from, to).
+		Count(&stats.PaidPayments).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -139,7 +140,7 @@ func (r *emailSubscriptionRepository) UpdateSubscriptions(ctx context.Context, u
 		if err == gorm.ErrRecordNotFound {
 			// Create new subscription with default values
 			subscription = entities.EmailSubscription{
-				ID:             uuid.New(),
+				ID:             ids.New(),
 				UserID:         userID,
 				Newsletter:     true,
 				Promotions:     true,
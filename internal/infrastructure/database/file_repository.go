@@ -89,3 +89,12 @@ func (r *fileRepository) GetFileCountByType(ctx context.Context, uploadType enti
 	err := r.db.WithContext(ctx).Model(&entities.FileUpload{}).Where("upload_type = ?", uploadType).Count(&count).Error
 	return count, err
 }
+
+// TagUntaggedDataRegion tags files uploaded before data regions with region
+func (r *fileRepository) TagUntaggedDataRegion(ctx context.Context, region string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.FileUpload{}).
+		Where("data_region IS NULL OR data_region = ''").
+		Update("data_region", region)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"reflect"
+
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+// registerIDCallback gives records created without an ID one from ids.New, instead of leaving
+// it to the column's gen_random_uuid() default, so every new row gets a time-ordered ID
+func registerIDCallback(db *gorm.DB) error {
+	return db.Callback().Create().Before("gorm:create").Register("ids:assign_id", assignIDs)
+}
+
+// assignIDs sets the UUID primary key of the records being created where it is still zero
+func assignIDs(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	if field == nil || field.FieldType != uuidType {
+		return
+	}
+
+	ctx := db.Statement.Context
+	assign := func(record reflect.Value) {
+		if _, isZero := field.ValueOf(ctx, record); isZero {
+			if err := field.Set(ctx, record, ids.New()); err != nil {
+				db.AddError(err)
+			}
+		}
+	}
+
+	records := db.Statement.ReflectValue
+	switch records.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < records.Len(); i++ {
+			record := reflect.Indirect(records.Index(i))
+			if record.Kind() == reflect.Struct {
+				assign(record)
+			}
+		}
+	case reflect.Struct:
+		assign(records)
+	}
+}
@@ -2,10 +2,12 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -105,7 +107,7 @@ func (r *inventoryRepository) SyncWithProductStock(ctx context.Context, inventor
 		// Create movement record for tracking if there's a difference
 		if quantityDifference != 0 {
 			movement := &entities.InventoryMovement{
-				ID:             uuid.New(),
+				ID:             ids.New(),
 				InventoryID:    inventoryID,
 				Type:           entities.InventoryMovementTypeAdjust,
 				Reason:         entities.InventoryMovementReason(reason),
@@ -489,7 +491,7 @@ func (r *inventoryRepository) TransferStock(ctx context.Context, fromInventoryID
 
 		// Create movement records
 		outMovement := &entities.InventoryMovement{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			InventoryID: fromInventoryID,
 			Type:        entities.InventoryMovementTypeOut,
 			Quantity:    quantity,
@@ -503,7 +505,7 @@ func (r *inventoryRepository) TransferStock(ctx context.Context, fromInventoryID
 		}
 
 		inMovement := &entities.InventoryMovement{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			InventoryID: toInventoryID,
 			Type:        entities.InventoryMovementTypeIn,
 			Quantity:    quantity,
@@ -514,3 +516,190 @@ func (r *inventoryRepository) TransferStock(ctx context.Context, fromInventoryID
 		return tx.Create(inMovement).Error
 	})
 }
+
+type inventorySnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewInventorySnapshotRepository creates a new inventory snapshot repository
+func NewInventorySnapshotRepository(db *gorm.DB) repositories.InventorySnapshotRepository {
+	return &inventorySnapshotRepository{db: db}
+}
+
+// snapshotDateLayout formats days for comparison with the snapshot_date column
+const snapshotDateLayout = "2006-01-02"
+
+// stockDeltaSource pairs the snapshots of two days by product and warehouse
+const stockDeltaSource = `(SELECT * FROM inventory_snapshots WHERE snapshot_date = ?) f
+	FULL OUTER JOIN (SELECT * FROM inventory_snapshots WHERE snapshot_date = ?) t
+	ON t.product_id = f.product_id AND t.warehouse_id = f.warehouse_id`
+
+// Capture snapshots every inventory record for the given day, replacing any snapshot already taken that day
+func (r *inventorySnapshotRepository) Capture(ctx context.Context, day time.Time) (int64, error) {
+	date := day.Format(snapshotDateLayout)
+	var captured int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("snapshot_date = ?", date).Delete(&entities.InventorySnapshot{}).Error; err != nil {
+			return err
+		}
+
+		// Stock is valued at average cost, falling back to the product's cost price
+		result := tx.Exec(`
+			INSERT INTO inventory_snapshots (snapshot_date, product_id, warehouse_id, inventory_id,
+				quantity_on_hand, quantity_reserved, quantity_available, unit_cost, total_value, created_at)
+			SELECT ?::date, i.product_id, i.warehouse_id, i.id,
+				i.quantity_on_hand, i.quantity_reserved, i.quantity_available, c.unit_cost,
+				i.quantity_on_hand * c.unit_cost, NOW()
+			FROM inventories i
+			LEFT JOIN products p ON p.id = i.product_id
+			CROSS JOIN LATERAL (SELECT COALESCE(NULLIF(i.average_cost, 0), p.cost_price, 0) AS unit_cost) c`, date)
+		if result.Error != nil {
+			return result.Error
+		}
+		captured = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return captured, nil
+}
+
+// GetLatestDay returns the latest day with snapshots on or before the given day
+func (r *inventorySnapshotRepository) GetLatestDay(ctx context.Context, onOrBefore time.Time) (*time.Time, error) {
+	var day sql.NullTime
+	err := r.db.WithContext(ctx).
+		Model(&entities.InventorySnapshot{}).
+		Select("MAX(snapshot_date)").
+		Where("snapshot_date <= ?", onOrBefore.Format(snapshotDateLayout)).
+		Row().Scan(&day)
+	if err != nil {
+		return nil, err
+	}
+	if !day.Valid {
+		return nil, nil
+	}
+
+	latest := entities.SnapshotDay(day.Time)
+	return &latest, nil
+}
+
+// GetStockOnDay gets stock levels recorded on a snapshot day
+func (r *inventorySnapshotRepository) GetStockOnDay(ctx context.Context, day time.Time, filters repositories.InventorySnapshotFilters) (*repositories.StockSnapshotReport, error) {
+	newQuery := func() *gorm.DB {
+		query := r.db.WithContext(ctx).
+			Table("inventory_snapshots s").
+			Joins("LEFT JOIN products ON products.id = s.product_id").
+			Joins("LEFT JOIN warehouses ON warehouses.id = s.warehouse_id").
+			Where("s.snapshot_date = ?", day.Format(snapshotDateLayout))
+
+		if filters.WarehouseID != nil {
+			query = query.Where("s.warehouse_id = ?", *filters.WarehouseID)
+		}
+		if filters.ProductID != nil {
+			query = query.Where("s.product_id = ?", *filters.ProductID)
+		}
+		return query
+	}
+
+	var totals struct {
+		TotalItems    int64
+		TotalQuantity int64
+		TotalValue    float64
+	}
+	err := newQuery().
+		Select("COUNT(*) AS total_items, COALESCE(SUM(s.quantity_on_hand), 0) AS total_quantity, COALESCE(SUM(s.total_value), 0) AS total_value").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	report := repositories.StockSnapshotReport{
+		TotalItems:    totals.TotalItems,
+		TotalQuantity: totals.TotalQuantity,
+		TotalValue:    totals.TotalValue,
+	}
+
+	query := newQuery().
+		Select("s.product_id, COALESCE(products.name, '') AS product_name, COALESCE(products.sku, '') AS sku, " +
+			"s.warehouse_id, COALESCE(warehouses.code, '') AS warehouse_code, COALESCE(warehouses.name, '') AS warehouse_name, " +
+			"s.quantity_on_hand, s.quantity_reserved, s.quantity_available, s.unit_cost, s.total_value").
+		Order("product_name, warehouse_code")
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit).Offset(filters.Offset)
+	}
+	if err := query.Scan(&report.Items).Error; err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetStockDeltas gets stock changes between two snapshot days, largest quantity changes first
+func (r *inventorySnapshotRepository) GetStockDeltas(ctx context.Context, fromDay, toDay time.Time, filters repositories.InventorySnapshotFilters) (*repositories.StockDeltaReport, error) {
+	const (
+		fromQuantity = "COALESCE(f.quantity_on_hand, 0)"
+		toQuantity   = "COALESCE(t.quantity_on_hand, 0)"
+		fromValue    = "COALESCE(f.total_value, 0)"
+		toValue      = "COALESCE(t.total_value, 0)"
+	)
+
+	newQuery := func() *gorm.DB {
+		query := r.db.WithContext(ctx).
+			Table(stockDeltaSource, fromDay.Format(snapshotDateLayout), toDay.Format(snapshotDateLayout)).
+			Joins("LEFT JOIN products ON products.id = COALESCE(t.product_id, f.product_id)").
+			Joins("LEFT JOIN warehouses ON warehouses.id = COALESCE(t.warehouse_id, f.warehouse_id)")
+
+		if filters.WarehouseID != nil {
+			query = query.Where("COALESCE(t.warehouse_id, f.warehouse_id) = ?", *filters.WarehouseID)
+		}
+		if filters.ProductID != nil {
+			query = query.Where("COALESCE(t.product_id, f.product_id) = ?", *filters.ProductID)
+		}
+		if filters.ChangedOnly {
+			query = query.Where(toQuantity + " <> " + fromQuantity + " OR " + toValue + " <> " + fromValue)
+		}
+		return query
+	}
+
+	var totals struct {
+		TotalItems     int64
+		QuantityChange int64
+		ValueChange    float64
+	}
+	err := newQuery().
+		Select("COUNT(*) AS total_items, " +
+			"COALESCE(SUM(" + toQuantity + " - " + fromQuantity + "), 0) AS quantity_change, " +
+			"COALESCE(SUM(" + toValue + " - " + fromValue + "), 0) AS value_change").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	report := repositories.StockDeltaReport{
+		TotalItems:     totals.TotalItems,
+		QuantityChange: totals.QuantityChange,
+		ValueChange:    totals.ValueChange,
+	}
+
+	query := newQuery().
+		Select("COALESCE(t.product_id, f.product_id) AS product_id, " +
+			"COALESCE(products.name, '') AS product_name, COALESCE(products.sku, '') AS sku, " +
+			"COALESCE(t.warehouse_id, f.warehouse_id) AS warehouse_id, " +
+			"COALESCE(warehouses.code, '') AS warehouse_code, COALESCE(warehouses.name, '') AS warehouse_name, " +
+			fromQuantity + " AS from_quantity, " + toQuantity + " AS to_quantity, " +
+			toQuantity + " - " + fromQuantity + " AS quantity_change, " +
+			fromValue + " AS from_value, " + toValue + " AS to_value, " +
+			toValue + " - " + fromValue + " AS value_change").
+		Order("ABS(" + toQuantity + " - " + fromQuantity + ") DESC, product_name, warehouse_code")
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit).Offset(filters.Offset)
+	}
+	if err := query.Scan(&report.Items).Error; err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
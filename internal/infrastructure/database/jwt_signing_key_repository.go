@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type jwtSigningKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewJWTSigningKeyRepository creates a new JWT signing key repository
+func NewJWTSigningKeyRepository(db *gorm.DB) repositories.JWTSigningKeyRepository {
+	return &jwtSigningKeyRepository{db: db}
+}
+
+// List returns every stored key, oldest activation first
+func (r *jwtSigningKeyRepository) List(ctx context.Context) ([]*entities.JWTSigningKey, error) {
+	var keys []*entities.JWTSigningKey
+	err := r.db.WithContext(ctx).Order("activates_at ASC").Find(&keys).Error
+	return keys, err
+}
+
+// CreateUnlessRotatedSince saves the key while holding a transaction-scoped advisory lock, so
+// servers rotating at the same time create one key between them
+func (r *jwtSigningKeyRepository) CreateUnlessRotatedSince(ctx context.Context, key *entities.JWTSigningKey, since time.Time) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('jwt_signing_keys'))").Error; err != nil {
+			return err
+		}
+
+		var newer int64
+		if err := tx.Model(&entities.JWTSigningKey{}).
+			Where("activates_at >= ?", since).
+			Count(&newer).Error; err != nil {
+			return err
+		}
+		if newer > 0 {
+			return nil
+		}
+
+		if err := tx.Create(key).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+// Delete deletes keys by ID
+func (r *jwtSigningKeyRepository) Delete(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&entities.JWTSigningKey{}).Error
+}
@@ -275,6 +275,264 @@ func getMigrations() []Migration {
 			Up:      migration013Up,
 			Down:    migration013Down,
 		},
+		{
+			Version: "014_add_price_history",
+			Name:    "Add price history and scheduled price changes",
+			Up:      migration014Up,
+			Down:    migration014Down,
+		},
+		{
+			Version: "015_add_map_pricing",
+			Name:    "Add minimum advertised price and sale window indexes",
+			Up:      migration015Up,
+			Down:    migration015Down,
+		},
+		{
+			Version: "016_address_book_defaults",
+			Name:    "Add separate default billing/shipping flags, labels and delivery instructions to addresses",
+			Up:      migration016Up,
+			Down:    migration016Down,
+		},
+		{
+			Version: "017_company_accounts",
+			Name:    "Add company accounts, order approvals and consolidated invoices",
+			Up:      migration017Up,
+			Down:    migration017Down,
+		},
+		{
+			Version: "018_quote_requests",
+			Name:    "Add quote requests (RFQ) and quote items",
+			Up:      migration018Up,
+			Down:    migration018Down,
+		},
+		{
+			Version: "019_net_payment_terms",
+			Name:    "Add net terms invoice payments and overdue reminders",
+			Up:      migration019Up,
+			Down:    migration019Down,
+		},
+		{
+			Version: "020_catalog_visibility",
+			Name:    "Add catalog visibility rules for per-customer catalogs",
+			Up:      migration020Up,
+			Down:    migration020Down,
+		},
+		{
+			Version: "021_bulk_price_updates",
+			Name:    "Add bulk price updates linked to price history",
+			Up:      migration021Up,
+			Down:    migration021Down,
+		},
+		{
+			Version: "022_product_lifecycle",
+			Name:    "Add product lifecycle tracking for discontinued and archived products",
+			Up:      migration022Up,
+			Down:    migration022Down,
+		},
+		{
+			Version: "023_inventory_snapshots",
+			Name:    "Add daily inventory snapshots for point-in-time stock reporting",
+			Up:      migration023Up,
+			Down:    migration023Down,
+		},
+		{
+			Version: "024_admin_notes",
+			Name:    "Add internal admin notes on orders and customers",
+			Up:      migration024Up,
+			Down:    migration024Down,
+		},
+		{
+			Version: "025_order_messages",
+			Name:    "Add customer and store messages on orders",
+			Up:      migration025Up,
+			Down:    migration025Down,
+		},
+		{
+			Version: "026_review_dimension_ratings",
+			Name:    "Add quality, value and shipping ratings to reviews",
+			Up:      migration026Up,
+			Down:    migration026Down,
+		},
+		{
+			Version: "027_review_incentives",
+			Name:    "Add incentivized review program and rewards",
+			Up:      migration027Up,
+			Down:    migration027Down,
+		},
+		{
+			Version: "028_product_translations",
+			Name:    "Add per-locale product content",
+			Up:      migration028Up,
+			Down:    migration028Down,
+		},
+		{
+			Version: "029_catalog_changesets",
+			Name:    "Add staged catalog changesets",
+			Up:      migration029Up,
+			Down:    migration029Down,
+		},
+		{
+			Version: "030_order_number_sequences",
+			Name:    "Add configurable order number sequences",
+			Up:      migration030Up,
+			Down:    migration030Down,
+		},
+		{
+			Version: "031_outbox_events",
+			Name:    "Add domain event outbox",
+			Up:      migration031Up,
+			Down:    migration031Down,
+		},
+		{
+			Version: "032_warehouse_sync",
+			Name:    "Add data warehouse sync state",
+			Up:      migration032Up,
+			Down:    migration032Down,
+		},
+		{
+			Version: "033_aggregate_repair_runs",
+			Name:    "Add aggregate repair run reports",
+			Up:      migration033Up,
+			Down:    migration033Down,
+		},
+		{
+			Version: "034_order_archives",
+			Name:    "Add order archive",
+			Up:      migration034Up,
+			Down:    migration034Down,
+		},
+		{
+			Version: "035_reports",
+			Name:    "Add generated reports",
+			Up:      migration035Up,
+			Down:    migration035Down,
+		},
+		{
+			Version: "036_gift_recipients",
+			Name:    "Add gift recipients to orders and checkout sessions",
+			Up:      migration036Up,
+			Down:    migration036Down,
+		},
+		{
+			Version: "037_payment_links",
+			Name:    "Add payment links table",
+			Up:      migration037Up,
+			Down:    migration037Down,
+		},
+		{
+			Version: "038_disputes",
+			Name:    "Add payment disputes table",
+			Up:      migration038Up,
+			Down:    migration038Down,
+		},
+		{
+			Version: "039_settlements",
+			Name:    "Add gateway settlement tables",
+			Up:      migration039Up,
+			Down:    migration039Down,
+		},
+		{
+			Version: "040_admin_tasks",
+			Name:    "Add admin inbox tasks table",
+			Up:      migration040Up,
+			Down:    migration040Down,
+		},
+		{
+			Version: "041_marketplace",
+			Name:    "Add vendors, commissions and vendor orders",
+			Up:      migration041Up,
+			Down:    migration041Down,
+		},
+		{
+			Version: "042_vendor_applications",
+			Name:    "Add vendor applications and KYC documents",
+			Up:      migration042Up,
+			Down:    migration042Down,
+		},
+		{
+			Version: "043_stock_visibility",
+			Name:    "Add per-product stock visibility",
+			Up:      migration043Up,
+			Down:    migration043Down,
+		},
+		{
+			Version: "044_category_assignments",
+			Name:    "Add bulk category assignments",
+			Up:      migration044Up,
+			Down:    migration044Down,
+		},
+		{
+			Version: "045_jwt_signing_keys",
+			Name:    "Add rotating JWT signing keys",
+			Up:      migration045Up,
+			Down:    migration045Down,
+		},
+		{
+			Version: "046_data_regions",
+			Name:    "Add customer data regions",
+			Up:      migration046Up,
+			Down:    migration046Down,
+		},
+		{
+			Version: "047_purchase_orders",
+			Name:    "Add purchase orders and back in stock subscriptions",
+			Up:      migration047Up,
+			Down:    migration047Down,
+		},
+		{
+			Version: "048_export_profiles",
+			Name:    "Add report layouts and export profiles",
+			Up:      migration048Up,
+			Down:    migration048Down,
+		},
+		{
+			Version: "049_fulfillment_sla",
+			Name:    "Add fulfillment SLA tracking",
+			Up:      migration049Up,
+			Down:    migration049Down,
+		},
+		{
+			Version: "050_return_portal",
+			Name:    "Add return photos and labels",
+			Up:      migration050Up,
+			Down:    migration050Down,
+		},
+		{
+			Version: "051_partner_feed",
+			Name:    "Add partners, API keys and the partner feed",
+			Up:      migration051Up,
+			Down:    migration051Down,
+		},
+		{
+			Version: "052_review_moderation",
+			Name:    "Add review moderation settings and flags",
+			Up:      migration052Up,
+			Down:    migration052Down,
+		},
+		{
+			Version: "053_tax_exemptions",
+			Name:    "Add tax exemption certificates and order exemption records",
+			Up:      migration053Up,
+			Down:    migration053Down,
+		},
+		{
+			Version: "054_api_key_plans",
+			Name:    "Add API key rate plans and daily usage",
+			Up:      migration054Up,
+			Down:    migration054Down,
+		},
+		{
+			Version: "055_anonymized_reports",
+			Name:    "Record which reports are anonymized",
+			Up:      migration055Up,
+			Down:    migration055Down,
+		},
+		{
+			Version: "056_product_waitlists",
+			Name:    "Add waitlists for upcoming products",
+			Up:      migration056Up,
+			Down:    migration056Down,
+		},
 		// Temporarily disabled due to product_tags issue
 		// {
 		// 	Version: "006_enhance_search",
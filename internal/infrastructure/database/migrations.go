@@ -1044,3 +1044,1403 @@ func migration010Down(db *gorm.DB) error {
 	log.Println("✅ Removed weight field from order_items table")
 	return nil
 }
+
+// migration014Up adds price history and scheduled price change tables
+func migration014Up(db *gorm.DB) error {
+	log.Println("🔧 Creating price history tables...")
+
+	if err := db.AutoMigrate(
+		&entities.PriceHistory{},
+		&entities.ScheduledPriceChange{},
+	); err != nil {
+		return fmt.Errorf("failed to create price history tables: %w", err)
+	}
+
+	sqls := []string{
+		"CREATE INDEX IF NOT EXISTS idx_price_histories_product_created ON price_histories(product_id, created_at)",
+		"CREATE INDEX IF NOT EXISTS idx_scheduled_price_changes_status_effective ON scheduled_price_changes(status, effective_at)",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Price history tables created")
+	return nil
+}
+
+// migration014Down drops price history and scheduled price change tables
+func migration014Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping price history tables...")
+	return db.Migrator().DropTable(&entities.ScheduledPriceChange{}, &entities.PriceHistory{})
+}
+
+// migration015Up adds the MAP floor column and an index for expiring sales
+func migration015Up(db *gorm.DB) error {
+	log.Println("🔧 Adding MAP pricing columns...")
+
+	sqls := []string{
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS min_advertised_price NUMERIC",
+		"CREATE INDEX IF NOT EXISTS idx_products_sale_end_date ON products(sale_end_date) WHERE sale_price IS NOT NULL",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ MAP pricing columns added")
+	return nil
+}
+
+// migration015Down removes the MAP floor column and expiring sales index
+func migration015Down(db *gorm.DB) error {
+	log.Println("🔧 Removing MAP pricing columns...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_products_sale_end_date",
+		"ALTER TABLE products DROP COLUMN IF EXISTS min_advertised_price",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration016Up adds address book enhancements
+func migration016Up(db *gorm.DB) error {
+	log.Println("🔧 Adding address book enhancements...")
+
+	sqls := []string{
+		"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS label TEXT DEFAULT 'home'",
+		"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS delivery_instructions TEXT",
+		"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS is_default_shipping BOOLEAN DEFAULT false",
+		"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS is_default_billing BOOLEAN DEFAULT false",
+		"ALTER TABLE checkout_sessions ADD COLUMN IF NOT EXISTS delivery_instructions TEXT",
+
+		// Backfill the per-purpose default flags from the legacy is_default flag
+		"UPDATE addresses SET is_default_shipping = true WHERE is_default = true AND type IN ('shipping', 'both')",
+		"UPDATE addresses SET is_default_billing = true WHERE is_default = true AND type IN ('billing', 'both')",
+
+		"CREATE INDEX IF NOT EXISTS idx_addresses_user_default_shipping ON addresses(user_id) WHERE is_default_shipping = true",
+		"CREATE INDEX IF NOT EXISTS idx_addresses_user_default_billing ON addresses(user_id) WHERE is_default_billing = true",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Address book enhancements added")
+	return nil
+}
+
+// migration016Down removes address book enhancements
+func migration016Down(db *gorm.DB) error {
+	log.Println("🔧 Removing address book enhancements...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_addresses_user_default_billing",
+		"DROP INDEX IF EXISTS idx_addresses_user_default_shipping",
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS delivery_instructions",
+		"ALTER TABLE addresses DROP COLUMN IF EXISTS is_default_billing",
+		"ALTER TABLE addresses DROP COLUMN IF EXISTS is_default_shipping",
+		"ALTER TABLE addresses DROP COLUMN IF EXISTS delivery_instructions",
+		"ALTER TABLE addresses DROP COLUMN IF EXISTS label",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration017Up adds company accounts, order approvals and consolidated invoices
+func migration017Up(db *gorm.DB) error {
+	log.Println("🔧 Creating company account tables...")
+
+	if err := db.AutoMigrate(
+		&entities.Company{},
+		&entities.CompanyMember{},
+		&entities.OrderApproval{},
+		&entities.CompanyInvoice{},
+		&entities.CompanyInvoiceItem{},
+	); err != nil {
+		return fmt.Errorf("failed to create company account tables: %w", err)
+	}
+
+	sqls := []string{
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS company_id UUID",
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS approval_status TEXT",
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS company_invoice_id UUID",
+		"ALTER TABLE addresses ADD COLUMN IF NOT EXISTS company_id UUID",
+
+		"CREATE INDEX IF NOT EXISTS idx_orders_company_id ON orders(company_id) WHERE company_id IS NOT NULL",
+		"CREATE INDEX IF NOT EXISTS idx_orders_company_uninvoiced ON orders(company_id, created_at) WHERE company_id IS NOT NULL AND company_invoice_id IS NULL",
+		"CREATE INDEX IF NOT EXISTS idx_addresses_company_id ON addresses(company_id) WHERE company_id IS NOT NULL",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Company account tables created")
+	return nil
+}
+
+// migration017Down drops company accounts, order approvals and consolidated invoices
+func migration017Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping company account tables...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_addresses_company_id",
+		"DROP INDEX IF EXISTS idx_orders_company_uninvoiced",
+		"DROP INDEX IF EXISTS idx_orders_company_id",
+		"ALTER TABLE addresses DROP COLUMN IF EXISTS company_id",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS company_invoice_id",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS approval_status",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS company_id",
+		"DROP TABLE IF EXISTS company_invoice_items",
+		"DROP TABLE IF EXISTS company_invoices",
+		"DROP TABLE IF EXISTS order_approvals",
+		"DROP TABLE IF EXISTS company_members",
+		"DROP TABLE IF EXISTS companies",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration018Up adds quote requests and quote items
+func migration018Up(db *gorm.DB) error {
+	log.Println("🔧 Creating quote tables...")
+
+	if err := db.AutoMigrate(
+		&entities.Quote{},
+		&entities.QuoteItem{},
+	); err != nil {
+		return fmt.Errorf("failed to create quote tables: %w", err)
+	}
+
+	sqls := []string{
+		"CREATE INDEX IF NOT EXISTS idx_quotes_expiring ON quotes(expires_at) WHERE status IN ('quoted', 'accepted')",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Quote tables created")
+	return nil
+}
+
+// migration018Down drops quote requests and quote items
+func migration018Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping quote tables...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_quotes_expiring",
+		"DROP TABLE IF EXISTS quote_items",
+		"DROP TABLE IF EXISTS quotes",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration019Up adds offline invoice payments and overdue reminder tracking
+func migration019Up(db *gorm.DB) error {
+	log.Println("🔧 Adding net payment terms tables...")
+
+	if err := db.AutoMigrate(
+		&entities.CompanyInvoice{},
+		&entities.CompanyInvoicePayment{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate net payment terms tables: %w", err)
+	}
+
+	sqls := []string{
+		"CREATE INDEX IF NOT EXISTS idx_company_invoices_overdue ON company_invoices(due_date) WHERE status = 'issued'",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Net payment terms tables added")
+	return nil
+}
+
+// migration019Down drops offline invoice payments and overdue reminder tracking
+func migration019Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping net payment terms tables...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_company_invoices_overdue",
+		"DROP TABLE IF EXISTS company_invoice_payments",
+		"ALTER TABLE company_invoices DROP COLUMN IF EXISTS reminder_count",
+		"ALTER TABLE company_invoices DROP COLUMN IF EXISTS last_reminder_at",
+		"ALTER TABLE company_invoices DROP COLUMN IF EXISTS amount_paid",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration020Up adds catalog visibility rules
+func migration020Up(db *gorm.DB) error {
+	log.Println("🔧 Adding catalog visibility rules table...")
+
+	if err := db.AutoMigrate(&entities.CatalogVisibilityRule{}); err != nil {
+		return fmt.Errorf("failed to migrate catalog visibility rules table: %w", err)
+	}
+
+	sqls := []string{
+		"CREATE INDEX IF NOT EXISTS idx_catalog_visibility_rules_audience ON catalog_visibility_rules(audience, audience_value) WHERE is_active = true",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Catalog visibility rules table added")
+	return nil
+}
+
+// migration020Down drops catalog visibility rules
+func migration020Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping catalog visibility rules table...")
+
+	sqls := []string{
+		"DROP INDEX IF EXISTS idx_catalog_visibility_rules_audience",
+		"DROP TABLE IF EXISTS catalog_visibility_rules",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration021Up adds bulk price updates and links price history entries to them
+func migration021Up(db *gorm.DB) error {
+	log.Println("🔧 Adding bulk price updates table...")
+
+	if err := db.AutoMigrate(&entities.BulkPriceUpdate{}, &entities.PriceHistory{}); err != nil {
+		return fmt.Errorf("failed to migrate bulk price updates: %w", err)
+	}
+
+	log.Println("✅ Bulk price updates table added")
+	return nil
+}
+
+// migration021Down drops bulk price updates
+func migration021Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping bulk price updates table...")
+
+	sqls := []string{
+		"ALTER TABLE price_histories DROP COLUMN IF EXISTS bulk_update_id",
+		"DROP TABLE IF EXISTS bulk_price_updates",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration022Up adds product lifecycle tracking for discontinued and archived products
+func migration022Up(db *gorm.DB) error {
+	log.Println("🔧 Adding product lifecycle columns...")
+
+	sqls := []string{
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS replacement_product_id UUID",
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS discontinued_at TIMESTAMP WITH TIME ZONE",
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Product lifecycle columns added")
+	return nil
+}
+
+// migration022Down drops product lifecycle tracking, moving delisted products back to inactive
+func migration022Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping product lifecycle columns...")
+
+	sqls := []string{
+		"UPDATE products SET status = 'inactive' WHERE status IN ('discontinued', 'archived')",
+		"ALTER TABLE products DROP COLUMN IF EXISTS archived_at",
+		"ALTER TABLE products DROP COLUMN IF EXISTS discontinued_at",
+		"ALTER TABLE products DROP COLUMN IF EXISTS replacement_product_id",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration023Up adds daily inventory snapshots for point-in-time stock reporting
+func migration023Up(db *gorm.DB) error {
+	log.Println("🔧 Adding inventory snapshots table...")
+
+	if err := db.AutoMigrate(&entities.InventorySnapshot{}); err != nil {
+		return fmt.Errorf("failed to migrate inventory snapshots table: %w", err)
+	}
+
+	log.Println("✅ Inventory snapshots table added")
+	return nil
+}
+
+// migration023Down drops inventory snapshots
+func migration023Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping inventory snapshots table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS inventory_snapshots").Error; err != nil {
+		return fmt.Errorf("failed to drop inventory snapshots table: %w", err)
+	}
+
+	return nil
+}
+
+// migration024Up adds internal admin notes on orders and customers
+func migration024Up(db *gorm.DB) error {
+	log.Println("🔧 Adding admin notes table...")
+
+	if err := db.AutoMigrate(&entities.AdminNote{}); err != nil {
+		return fmt.Errorf("failed to migrate admin notes table: %w", err)
+	}
+
+	log.Println("✅ Admin notes table added")
+	return nil
+}
+
+// migration024Down drops admin notes
+func migration024Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping admin notes table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS admin_notes").Error; err != nil {
+		return fmt.Errorf("failed to drop admin notes table: %w", err)
+	}
+
+	return nil
+}
+
+// migration025Up adds the customer/store message thread on orders
+func migration025Up(db *gorm.DB) error {
+	log.Println("🔧 Adding order messages table...")
+
+	if err := db.AutoMigrate(&entities.OrderMessage{}); err != nil {
+		return fmt.Errorf("failed to migrate order messages table: %w", err)
+	}
+
+	log.Println("✅ Order messages table added")
+	return nil
+}
+
+// migration025Down drops order messages
+func migration025Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping order messages table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS order_messages").Error; err != nil {
+		return fmt.Errorf("failed to drop order messages table: %w", err)
+	}
+
+	return nil
+}
+
+// migration026Up adds optional per-dimension review ratings
+func migration026Up(db *gorm.DB) error {
+	log.Println("🔧 Adding review dimension ratings...")
+
+	sqls := []string{
+		"ALTER TABLE reviews ADD COLUMN IF NOT EXISTS quality_rating INTEGER CHECK (quality_rating BETWEEN 1 AND 5)",
+		"ALTER TABLE reviews ADD COLUMN IF NOT EXISTS value_rating INTEGER CHECK (value_rating BETWEEN 1 AND 5)",
+		"ALTER TABLE reviews ADD COLUMN IF NOT EXISTS shipping_rating INTEGER CHECK (shipping_rating BETWEEN 1 AND 5)",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Review dimension ratings added")
+	return nil
+}
+
+// migration026Down removes per-dimension review ratings
+func migration026Down(db *gorm.DB) error {
+	log.Println("🔧 Removing review dimension ratings...")
+
+	sqls := []string{
+		"ALTER TABLE reviews DROP COLUMN IF EXISTS quality_rating",
+		"ALTER TABLE reviews DROP COLUMN IF EXISTS value_rating",
+		"ALTER TABLE reviews DROP COLUMN IF EXISTS shipping_rating",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration027Up adds the incentivized review program and its rewards
+func migration027Up(db *gorm.DB) error {
+	log.Println("🔧 Adding review incentive tables...")
+
+	if err := db.AutoMigrate(&entities.ReviewIncentiveProgram{}, &entities.ReviewIncentiveReward{}); err != nil {
+		return fmt.Errorf("failed to migrate review incentive tables: %w", err)
+	}
+
+	log.Println("✅ Review incentive tables added")
+	return nil
+}
+
+// migration027Down drops the review incentive tables
+func migration027Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping review incentive tables...")
+
+	sqls := []string{
+		"DROP TABLE IF EXISTS review_incentive_rewards",
+		"DROP TABLE IF EXISTS review_incentive_programs",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration028Up adds per-locale product content
+func migration028Up(db *gorm.DB) error {
+	log.Println("🔧 Adding product translations table...")
+
+	if err := db.AutoMigrate(&entities.ProductTranslation{}); err != nil {
+		return fmt.Errorf("failed to migrate product translations table: %w", err)
+	}
+
+	// Translations go away with their product
+	sql := "ALTER TABLE product_translations ADD CONSTRAINT fk_product_translations_product_id FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE"
+	if err := db.Exec(sql).Error; err != nil {
+		return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+	}
+
+	log.Println("✅ Product translations table added")
+	return nil
+}
+
+// migration028Down drops per-locale product content
+func migration028Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping product translations table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS product_translations").Error; err != nil {
+		return fmt.Errorf("failed to drop product translations table: %w", err)
+	}
+
+	return nil
+}
+
+// migration029Up adds staged catalog changesets
+func migration029Up(db *gorm.DB) error {
+	log.Println("🔧 Adding catalog changeset tables...")
+
+	if err := db.AutoMigrate(&entities.CatalogChangeset{}, &entities.CatalogChangesetItem{}); err != nil {
+		return fmt.Errorf("failed to migrate catalog changeset tables: %w", err)
+	}
+
+	log.Println("✅ Catalog changeset tables added")
+	return nil
+}
+
+// migration029Down drops the catalog changeset tables
+func migration029Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping catalog changeset tables...")
+
+	sqls := []string{
+		"DROP TABLE IF EXISTS catalog_changeset_items",
+		"DROP TABLE IF EXISTS catalog_changesets",
+	}
+
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration030Up adds order number sequences
+func migration030Up(db *gorm.DB) error {
+	log.Println("🔧 Adding order number sequences table...")
+
+	// Existing order numbers are kept as they are; sequences only number new orders
+	if err := db.AutoMigrate(&entities.OrderNumberSequence{}); err != nil {
+		return fmt.Errorf("failed to migrate order number sequences table: %w", err)
+	}
+
+	log.Println("✅ Order number sequences table added")
+	return nil
+}
+
+// migration030Down drops the order number sequences table
+func migration030Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping order number sequences table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS order_number_sequences").Error; err != nil {
+		return fmt.Errorf("failed to drop order number sequences table: %w", err)
+	}
+
+	return nil
+}
+
+// migration031Up adds the domain event outbox
+func migration031Up(db *gorm.DB) error {
+	log.Println("🔧 Adding outbox events table...")
+
+	if err := db.AutoMigrate(&entities.OutboxEvent{}); err != nil {
+		return fmt.Errorf("failed to migrate outbox events table: %w", err)
+	}
+
+	// The publisher only ever scans unpublished events
+	sql := "CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(occurred_at, id) WHERE published_at IS NULL"
+	if err := db.Exec(sql).Error; err != nil {
+		return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+	}
+
+	log.Println("✅ Outbox events table added")
+	return nil
+}
+
+// migration031Down drops the domain event outbox
+func migration031Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping outbox events table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS outbox_events").Error; err != nil {
+		return fmt.Errorf("failed to drop outbox events table: %w", err)
+	}
+
+	return nil
+}
+
+// migration032Up adds the data warehouse sync state and the indexes its exports read through
+func migration032Up(db *gorm.DB) error {
+	log.Println("🔧 Adding warehouse sync states table...")
+
+	if err := db.AutoMigrate(&entities.WarehouseSyncState{}); err != nil {
+		return fmt.Errorf("failed to migrate warehouse sync states table: %w", err)
+	}
+
+	// Exports read each table in (updated_at, id) order from the watermark
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS idx_orders_updated_at_id ON orders(updated_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_order_items_updated_at_id ON order_items(updated_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_users_updated_at_id ON users(updated_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_products_updated_at_id ON products(updated_at, id)",
+	}
+	for _, sql := range indexes {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Warehouse sync states table added")
+	return nil
+}
+
+// migration032Down drops the data warehouse sync state
+func migration032Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping warehouse sync states table...")
+
+	indexes := []string{
+		"DROP INDEX IF EXISTS idx_orders_updated_at_id",
+		"DROP INDEX IF EXISTS idx_order_items_updated_at_id",
+		"DROP INDEX IF EXISTS idx_users_updated_at_id",
+		"DROP INDEX IF EXISTS idx_products_updated_at_id",
+	}
+	for _, sql := range indexes {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	if err := db.Exec("DROP TABLE IF EXISTS warehouse_sync_states").Error; err != nil {
+		return fmt.Errorf("failed to drop warehouse sync states table: %w", err)
+	}
+
+	return nil
+}
+
+// migration033Up adds the aggregate repair run reports
+func migration033Up(db *gorm.DB) error {
+	log.Println("🔧 Adding aggregate repair runs table...")
+
+	if err := db.AutoMigrate(&entities.AggregateRepairRun{}); err != nil {
+		return fmt.Errorf("failed to migrate aggregate repair runs table: %w", err)
+	}
+
+	log.Println("✅ Aggregate repair runs table added")
+	return nil
+}
+
+// migration033Down drops the aggregate repair run reports
+func migration033Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping aggregate repair runs table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS aggregate_repair_runs").Error; err != nil {
+		return fmt.Errorf("failed to drop aggregate repair runs table: %w", err)
+	}
+
+	return nil
+}
+
+// migration034Up adds the order archive and the archived flag of orders
+func migration034Up(db *gorm.DB) error {
+	log.Println("🔧 Adding order archive table...")
+
+	sqls := []string{
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS is_archived BOOLEAN DEFAULT false",
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE",
+		"CREATE INDEX IF NOT EXISTS idx_orders_is_archived ON orders(is_archived)",
+	}
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	if err := db.AutoMigrate(&entities.OrderArchive{}); err != nil {
+		return fmt.Errorf("failed to migrate order archives table: %w", err)
+	}
+
+	log.Println("✅ Order archive table added")
+	return nil
+}
+
+// migration034Down drops the order archive. Archived orders must be restored first, or their
+// items and events are lost.
+func migration034Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping order archive table...")
+
+	sqls := []string{
+		"DROP TABLE IF EXISTS order_archives",
+		"DROP INDEX IF EXISTS idx_orders_is_archived",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS archived_at",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS is_archived",
+	}
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration035Up adds the generated reports table
+func migration035Up(db *gorm.DB) error {
+	log.Println("🔧 Adding reports table...")
+
+	if err := db.AutoMigrate(&entities.Report{}); err != nil {
+		return fmt.Errorf("failed to migrate reports table: %w", err)
+	}
+
+	log.Println("✅ Reports table added")
+	return nil
+}
+
+// migration035Down drops the generated reports
+func migration035Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping reports table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS reports").Error; err != nil {
+		return fmt.Errorf("failed to drop reports table: %w", err)
+	}
+
+	return nil
+}
+
+// migration036Up adds the gift recipient of orders and the gift options of checkout sessions
+func migration036Up(db *gorm.DB) error {
+	log.Println("🔧 Adding gift recipients...")
+
+	sqls := []string{
+		"ALTER TABLE orders ADD COLUMN IF NOT EXISTS gift_recipient_email TEXT",
+		"ALTER TABLE checkout_sessions ADD COLUMN IF NOT EXISTS is_gift BOOLEAN DEFAULT false",
+		"ALTER TABLE checkout_sessions ADD COLUMN IF NOT EXISTS gift_message TEXT",
+		"ALTER TABLE checkout_sessions ADD COLUMN IF NOT EXISTS gift_wrap BOOLEAN DEFAULT false",
+		"ALTER TABLE checkout_sessions ADD COLUMN IF NOT EXISTS gift_recipient_email TEXT",
+	}
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	log.Println("✅ Gift recipients added")
+	return nil
+}
+
+// migration036Down drops the gift recipients and checkout session gift options
+func migration036Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping gift recipients...")
+
+	sqls := []string{
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS gift_recipient_email",
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS gift_wrap",
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS gift_message",
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS is_gift",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS gift_recipient_email",
+	}
+	for _, sql := range sqls {
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to execute SQL: %s, error: %w", sql, err)
+		}
+	}
+
+	return nil
+}
+
+// migration037Up adds the payment links table
+func migration037Up(db *gorm.DB) error {
+	log.Println("🔧 Adding payment links table...")
+
+	if err := db.AutoMigrate(&entities.PaymentLink{}); err != nil {
+		return fmt.Errorf("failed to migrate payment links table: %w", err)
+	}
+
+	log.Println("✅ Payment links table added")
+	return nil
+}
+
+// migration037Down drops the payment links
+func migration037Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping payment links table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS payment_links").Error; err != nil {
+		return fmt.Errorf("failed to drop payment links table: %w", err)
+	}
+
+	return nil
+}
+
+// migration038Up adds the payment disputes table
+func migration038Up(db *gorm.DB) error {
+	log.Println("🔧 Adding payment disputes table...")
+
+	if err := db.AutoMigrate(&entities.Dispute{}); err != nil {
+		return fmt.Errorf("failed to migrate disputes table: %w", err)
+	}
+
+	log.Println("✅ Payment disputes table added")
+	return nil
+}
+
+// migration038Down drops the payment disputes
+func migration038Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping payment disputes table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS disputes").Error; err != nil {
+		return fmt.Errorf("failed to drop disputes table: %w", err)
+	}
+
+	return nil
+}
+
+// migration039Up adds the gateway settlement tables
+func migration039Up(db *gorm.DB) error {
+	log.Println("🔧 Adding gateway settlement tables...")
+
+	if err := db.AutoMigrate(&entities.Settlement{}, &entities.SettlementItem{}); err != nil {
+		return fmt.Errorf("failed to migrate settlement tables: %w", err)
+	}
+
+	log.Println("✅ Gateway settlement tables added")
+	return nil
+}
+
+// migration039Down drops the gateway settlements
+func migration039Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping gateway settlement tables...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS settlement_items, settlements").Error; err != nil {
+		return fmt.Errorf("failed to drop settlement tables: %w", err)
+	}
+
+	return nil
+}
+
+// migration040Up adds the admin inbox tasks table
+func migration040Up(db *gorm.DB) error {
+	log.Println("🔧 Adding admin inbox tasks table...")
+
+	if err := db.AutoMigrate(&entities.AdminTask{}); err != nil {
+		return fmt.Errorf("failed to migrate admin tasks table: %w", err)
+	}
+
+	log.Println("✅ Admin inbox tasks table added")
+	return nil
+}
+
+// migration040Down drops the admin inbox tasks
+func migration040Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping admin inbox tasks table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS admin_tasks").Error; err != nil {
+		return fmt.Errorf("failed to drop admin tasks table: %w", err)
+	}
+
+	return nil
+}
+
+// migration041Up adds marketplace vendors, their commissions and the vendor orders orders are split into
+func migration041Up(db *gorm.DB) error {
+	log.Println("🔧 Adding marketplace vendor tables...")
+
+	err := db.AutoMigrate(
+		&entities.Vendor{},
+		&entities.CategoryCommission{},
+		&entities.VendorCommission{},
+		&entities.VendorOrder{},
+		&entities.VendorOrderFee{},
+		&entities.VendorOrderRefund{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to migrate marketplace tables: %w", err)
+	}
+
+	// Products without a vendor are sold by the store itself
+	statements := []string{
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS vendor_id UUID",
+		"CREATE INDEX IF NOT EXISTS idx_products_vendor_id ON products(vendor_id)",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to add product vendors: %w", err)
+		}
+	}
+
+	log.Println("✅ Marketplace vendor tables added")
+	return nil
+}
+
+// migration041Down drops the marketplace vendor tables
+func migration041Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping marketplace vendor tables...")
+
+	statements := []string{
+		"DROP INDEX IF EXISTS idx_products_vendor_id",
+		"ALTER TABLE products DROP COLUMN IF EXISTS vendor_id",
+		"DROP TABLE IF EXISTS vendor_order_refunds",
+		"DROP TABLE IF EXISTS vendor_order_fees",
+		"DROP TABLE IF EXISTS vendor_orders",
+		"DROP TABLE IF EXISTS vendor_commissions",
+		"DROP TABLE IF EXISTS category_commissions",
+		"DROP TABLE IF EXISTS vendors",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop marketplace tables: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration042Up adds vendor applications and their KYC documents
+func migration042Up(db *gorm.DB) error {
+	log.Println("🔧 Adding vendor application tables...")
+
+	if err := db.AutoMigrate(&entities.VendorApplication{}, &entities.VendorDocument{}); err != nil {
+		return fmt.Errorf("failed to migrate vendor application tables: %w", err)
+	}
+
+	log.Println("✅ Vendor application tables added")
+	return nil
+}
+
+// migration042Down drops the vendor application tables
+func migration042Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping vendor application tables...")
+
+	for _, table := range []string{"vendor_documents", "vendor_applications"} {
+		if err := db.Exec("DROP TABLE IF EXISTS " + table).Error; err != nil {
+			return fmt.Errorf("failed to drop %s table: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// migration043Up adds how much of each product's stock customers are shown
+func migration043Up(db *gorm.DB) error {
+	log.Println("🔧 Adding product stock visibility...")
+
+	statements := []string{
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS stock_visibility TEXT DEFAULT 'exact'",
+		"ALTER TABLE products ADD COLUMN IF NOT EXISTS stock_display_threshold INTEGER",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to add product stock visibility: %w", err)
+		}
+	}
+
+	log.Println("✅ Product stock visibility added")
+	return nil
+}
+
+// migration043Down removes product stock visibility
+func migration043Down(db *gorm.DB) error {
+	statements := []string{
+		"ALTER TABLE products DROP COLUMN IF EXISTS stock_display_threshold",
+		"ALTER TABLE products DROP COLUMN IF EXISTS stock_visibility",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop product stock visibility: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration044Up adds bulk category assignments
+func migration044Up(db *gorm.DB) error {
+	log.Println("🔧 Adding category assignments table...")
+
+	if err := db.AutoMigrate(&entities.CategoryAssignment{}); err != nil {
+		return fmt.Errorf("failed to migrate category assignments table: %w", err)
+	}
+
+	log.Println("✅ Category assignments table added")
+	return nil
+}
+
+// migration044Down drops the category assignments table
+func migration044Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping category assignments table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS category_assignments").Error; err != nil {
+		return fmt.Errorf("failed to drop category_assignments table: %w", err)
+	}
+
+	return nil
+}
+
+// migration045Up adds rotating JWT signing keys
+func migration045Up(db *gorm.DB) error {
+	log.Println("🔧 Adding JWT signing keys table...")
+
+	if err := db.AutoMigrate(&entities.JWTSigningKey{}); err != nil {
+		return fmt.Errorf("failed to migrate JWT signing keys table: %w", err)
+	}
+
+	log.Println("✅ JWT signing keys table added")
+	return nil
+}
+
+// migration045Down drops the JWT signing keys table
+func migration045Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping JWT signing keys table...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS jwt_signing_keys").Error; err != nil {
+		return fmt.Errorf("failed to drop jwt_signing_keys table: %w", err)
+	}
+
+	return nil
+}
+
+// migration046Up adds the data regions of users and files, admins' export access by region, and
+// the regions reports are limited to
+func migration046Up(db *gorm.DB) error {
+	log.Println("🔧 Adding data regions...")
+
+	statements := []string{
+		"ALTER TABLE users ADD COLUMN IF NOT EXISTS data_region TEXT",
+		"ALTER TABLE users ADD COLUMN IF NOT EXISTS data_region_access TEXT[]",
+		"CREATE INDEX IF NOT EXISTS idx_users_data_region ON users (data_region)",
+		"ALTER TABLE file_uploads ADD COLUMN IF NOT EXISTS data_region TEXT",
+		"CREATE INDEX IF NOT EXISTS idx_file_uploads_data_region ON file_uploads (data_region)",
+		"ALTER TABLE reports ADD COLUMN IF NOT EXISTS data_regions TEXT[]",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to add data regions: %w", err)
+		}
+	}
+
+	log.Println("✅ Data regions added")
+	return nil
+}
+
+// migration046Down removes data regions
+func migration046Down(db *gorm.DB) error {
+	statements := []string{
+		"ALTER TABLE reports DROP COLUMN IF EXISTS data_regions",
+		"DROP INDEX IF EXISTS idx_file_uploads_data_region",
+		"ALTER TABLE file_uploads DROP COLUMN IF EXISTS data_region",
+		"DROP INDEX IF EXISTS idx_users_data_region",
+		"ALTER TABLE users DROP COLUMN IF EXISTS data_region_access",
+		"ALTER TABLE users DROP COLUMN IF EXISTS data_region",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop data regions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration047Up adds purchase orders and back in stock subscriptions
+func migration047Up(db *gorm.DB) error {
+	log.Println("🔧 Adding purchase orders and back in stock subscriptions...")
+
+	if err := db.AutoMigrate(
+		&entities.PurchaseOrder{},
+		&entities.PurchaseOrderItem{},
+		&entities.BackInStockSubscription{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate purchase order tables: %w", err)
+	}
+
+	log.Println("✅ Purchase orders and back in stock subscriptions added")
+	return nil
+}
+
+// migration047Down drops purchase orders and back in stock subscriptions
+func migration047Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping purchase orders and back in stock subscriptions...")
+
+	for _, table := range []string{"back_in_stock_subscriptions", "purchase_order_items", "purchase_orders"} {
+		if err := db.Exec("DROP TABLE IF EXISTS " + table).Error; err != nil {
+			return fmt.Errorf("failed to drop %s table: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// migration048Up adds report file layouts and admins' export profiles
+func migration048Up(db *gorm.DB) error {
+	log.Println("🔧 Adding report layouts and export profiles...")
+
+	if err := db.AutoMigrate(&entities.Report{}, &entities.ExportProfile{}); err != nil {
+		return fmt.Errorf("failed to migrate export profiles: %w", err)
+	}
+
+	log.Println("✅ Report layouts and export profiles added")
+	return nil
+}
+
+// migration048Down drops export profiles and report file layouts
+func migration048Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping report layouts and export profiles...")
+
+	statements := []string{
+		"DROP TABLE IF EXISTS export_profiles",
+		"ALTER TABLE reports DROP COLUMN IF EXISTS columns",
+		"ALTER TABLE reports DROP COLUMN IF EXISTS locale",
+		"ALTER TABLE reports DROP COLUMN IF EXISTS delimiter",
+		"ALTER TABLE reports DROP COLUMN IF EXISTS encoding",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop export profiles: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration049Up adds fulfillment SLA tracking
+func migration049Up(db *gorm.DB) error {
+	log.Println("🔧 Adding fulfillment SLA tracking...")
+
+	if err := db.AutoMigrate(&entities.OrderFulfillmentSLA{}); err != nil {
+		return fmt.Errorf("failed to migrate fulfillment SLA table: %w", err)
+	}
+
+	log.Println("✅ Fulfillment SLA tracking added")
+	return nil
+}
+
+// migration049Down drops fulfillment SLA tracking
+func migration049Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping fulfillment SLA tracking...")
+
+	if err := db.Exec("DROP TABLE IF EXISTS order_fulfillment_slas").Error; err != nil {
+		return fmt.Errorf("failed to drop order_fulfillment_slas table: %w", err)
+	}
+
+	return nil
+}
+
+// migration050Up adds return photos and labels for the returns portal
+func migration050Up(db *gorm.DB) error {
+	log.Println("🔧 Adding returns portal fields...")
+
+	if err := db.AutoMigrate(&entities.Return{}); err != nil {
+		return fmt.Errorf("failed to migrate returns table: %w", err)
+	}
+
+	log.Println("✅ Returns portal fields added")
+	return nil
+}
+
+// migration050Down drops return photos and labels
+func migration050Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping returns portal fields...")
+
+	statements := []string{
+		"ALTER TABLE returns DROP COLUMN IF EXISTS label_created_at",
+		"ALTER TABLE returns DROP COLUMN IF EXISTS photos",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop returns portal fields: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration051Up adds partners, their API keys and the price and availability feed
+func migration051Up(db *gorm.DB) error {
+	log.Println("🔧 Adding partner feed tables...")
+
+	if err := db.AutoMigrate(
+		&entities.Partner{},
+		&entities.APIKey{},
+		&entities.PartnerFeedChange{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate partner feed tables: %w", err)
+	}
+
+	log.Println("✅ Partner feed tables added")
+	return nil
+}
+
+// migration051Down drops the partner feed tables
+func migration051Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping partner feed tables...")
+
+	for _, table := range []string{"partner_feed_changes", "api_keys", "partners"} {
+		if err := db.Exec("DROP TABLE IF EXISTS " + table).Error; err != nil {
+			return fmt.Errorf("failed to drop %s table: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// migration052Up adds review moderation settings and the flags reviews are held for
+func migration052Up(db *gorm.DB) error {
+	log.Println("🔧 Adding review moderation settings...")
+
+	if err := db.AutoMigrate(&entities.ReviewModerationSettings{}, &entities.Review{}); err != nil {
+		return fmt.Errorf("failed to migrate review moderation tables: %w", err)
+	}
+
+	log.Println("✅ Review moderation settings added")
+	return nil
+}
+
+// migration052Down drops review moderation settings and flags
+func migration052Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping review moderation settings...")
+
+	statements := []string{
+		"ALTER TABLE reviews DROP COLUMN IF EXISTS moderation_flags",
+		"DROP TABLE IF EXISTS review_moderation_settings",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop review moderation settings: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration053Up adds tax exemption certificates and the exemptions recorded on orders
+func migration053Up(db *gorm.DB) error {
+	log.Println("🔧 Adding tax exemption certificates...")
+
+	if err := db.AutoMigrate(
+		&entities.TaxExemptionCertificate{},
+		&entities.Order{},
+		&entities.OrderItem{},
+		&entities.CheckoutSession{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate tax exemption tables: %w", err)
+	}
+
+	log.Println("✅ Tax exemption certificates added")
+	return nil
+}
+
+// migration053Down drops tax exemption certificates and order exemption records
+func migration053Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping tax exemption certificates...")
+
+	statements := []string{
+		"ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS tax_exemption",
+		"ALTER TABLE order_items DROP COLUMN IF EXISTS tax_exempt",
+		"ALTER TABLE orders DROP COLUMN IF EXISTS tax_exemption",
+		"DROP TABLE IF EXISTS tax_exemption_certificates",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop tax exemption certificates: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration054Up adds API key rate plans and their daily usage, which replace partners' own
+// requests per minute
+func migration054Up(db *gorm.DB) error {
+	log.Println("🔧 Adding API key rate plans...")
+
+	if err := db.AutoMigrate(&entities.APIKey{}, &entities.APIKeyUsage{}); err != nil {
+		return fmt.Errorf("failed to migrate API key usage tables: %w", err)
+	}
+	if err := db.Exec("ALTER TABLE partners DROP COLUMN IF EXISTS requests_per_minute").Error; err != nil {
+		return fmt.Errorf("failed to drop partner requests per minute: %w", err)
+	}
+
+	log.Println("✅ API key rate plans added")
+	return nil
+}
+
+// migration054Down drops API key rate plans and usage, and restores partners' requests per minute
+func migration054Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping API key rate plans...")
+
+	statements := []string{
+		"ALTER TABLE partners ADD COLUMN IF NOT EXISTS requests_per_minute BIGINT DEFAULT 60",
+		"DROP TABLE IF EXISTS api_key_usages",
+		"ALTER TABLE api_keys DROP COLUMN IF EXISTS plan",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop API key rate plans: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migration055Up records which reports are anonymized
+func migration055Up(db *gorm.DB) error {
+	log.Println("🔧 Adding anonymized reports...")
+
+	if err := db.AutoMigrate(&entities.Report{}); err != nil {
+		return fmt.Errorf("failed to migrate reports table: %w", err)
+	}
+
+	log.Println("✅ Anonymized reports added")
+	return nil
+}
+
+// migration055Down drops the anonymized flag of reports
+func migration055Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping anonymized reports...")
+
+	if err := db.Exec("ALTER TABLE reports DROP COLUMN IF EXISTS anonymized").Error; err != nil {
+		return fmt.Errorf("failed to drop anonymized reports: %w", err)
+	}
+
+	return nil
+}
+
+// migration056Up adds waitlists for upcoming products and the customers on them
+func migration056Up(db *gorm.DB) error {
+	log.Println("🔧 Adding product waitlists...")
+
+	if err := db.AutoMigrate(
+		&entities.ProductWaitlist{},
+		&entities.WaitlistEntry{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate product waitlist tables: %w", err)
+	}
+
+	log.Println("✅ Product waitlists added")
+	return nil
+}
+
+// migration056Down drops product waitlists
+func migration056Down(db *gorm.DB) error {
+	log.Println("🔧 Dropping product waitlists...")
+
+	statements := []string{
+		"DROP TABLE IF EXISTS waitlist_entries",
+		"DROP TABLE IF EXISTS product_waitlists",
+	}
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to drop product waitlists: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -313,7 +314,7 @@ func (r *notificationRepository) CountUserNotifications(ctx context.Context, use
 // CreateDefaultPreferences creates default notification preferences for a user
 func (r *notificationRepository) CreateDefaultPreferences(ctx context.Context, userID uuid.UUID) error {
 	prefs := &entities.NotificationPreferences{
-		ID:                uuid.New(),
+		ID:                ids.New(),
 		UserID:            userID,
 		EmailEnabled:      true,
 		PushEnabled:       true,
@@ -331,7 +332,7 @@ func (r *notificationRepository) CreateDefaultPreferences(ctx context.Context, u
 
 // CreateTemplate creates a notification template
 func (r *notificationRepository) CreateTemplate(ctx context.Context, template *entities.NotificationTemplate) error {
-	template.ID = uuid.New()
+	template.ID = ids.New()
 	template.CreatedAt = time.Now()
 	template.UpdatedAt = time.Now()
 	return r.db.WithContext(ctx).Create(template).Error
@@ -473,7 +474,7 @@ func (r *notificationRepository) GetEngagementStats(ctx context.Context, from, t
 func (r *notificationRepository) GetFailedNotifications(ctx context.Context, retryCount int, limit int) ([]*entities.Notification, error) {
 	var notifications []*entities.Notification
 	err := r.db.WithContext(ctx).
-		Where("delivery_status = ? AND retry_count <= ?", "failed", retryCount).
+		Where("status = ? AND retry_count <= ?", entities.NotificationStatusFailed, retryCount).
 		Order("created_at ASC").
 		Limit(limit).
 		Find(&notifications).Error
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type orderArchiveRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderArchiveRepository creates a new order archive repository
+func NewOrderArchiveRepository(db *gorm.DB) repositories.OrderArchiveRepository {
+	return &orderArchiveRepository{db: db}
+}
+
+// FindArchivableOrderIDs finds old orders in a final status that are not archived yet
+func (r *orderArchiveRepository) FindArchivableOrderIDs(ctx context.Context, before time.Time, statuses []entities.OrderStatus, limit int) ([]uuid.UUID, error) {
+	var orderIDs []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&entities.Order{}).
+		Where("is_archived = ? AND status IN ? AND created_at < ? AND updated_at < ?", false, statuses, before, before).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &orderIDs).Error
+	return orderIDs, err
+}
+
+// ArchiveOrders copies the item and event rows into order_archives as JSON and deletes them.
+// Flagging the orders first locks them, so an order changed in the meantime is archived with
+// its latest rows, and an order archived by another run is skipped.
+func (r *orderArchiveRepository) ArchiveOrders(ctx context.Context, orderIDs []uuid.UUID) (int, error) {
+	if len(orderIDs) == 0 {
+		return 0, nil
+	}
+
+	var archived []uuid.UUID
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`UPDATE orders SET is_archived = true, archived_at = NOW(), updated_at = NOW()
+			WHERE id IN ? AND is_archived = false
+			RETURNING id`, orderIDs).
+			Scan(&archived).Error
+		if err != nil || len(archived) == 0 {
+			return err
+		}
+
+		err = tx.Exec(`INSERT INTO order_archives (order_id, items, events, item_count, event_count, archived_at)
+			SELECT o.id, items.rows, events.rows, items.count, events.count, NOW()
+			FROM orders o
+			CROSS JOIN LATERAL (
+				SELECT COALESCE(jsonb_agg(to_jsonb(oi)), '[]'::jsonb) AS rows, COUNT(*) AS count
+				FROM order_items oi WHERE oi.order_id = o.id
+			) items
+			CROSS JOIN LATERAL (
+				SELECT COALESCE(jsonb_agg(to_jsonb(oe)), '[]'::jsonb) AS rows, COUNT(*) AS count
+				FROM order_events oe WHERE oe.order_id = o.id
+			) events
+			WHERE o.id IN ?`, archived).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM order_items WHERE order_id IN ?", archived).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM order_events WHERE order_id IN ?", archived).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(archived), nil
+}
+
+// RestoreOrder reinserts the archived rows as they were. Columns added to the tables since the
+// order was archived are left NULL.
+func (r *orderArchiveRepository) RestoreOrder(ctx context.Context, orderID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var archive entities.OrderArchive
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&archive, "order_id = ?", orderID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return entities.ErrOrderArchiveNotFound
+			}
+			return err
+		}
+
+		err := tx.Exec(`INSERT INTO order_items
+			SELECT rows.* FROM jsonb_populate_recordset(NULL::order_items, ?::jsonb) rows`, string(archive.Items)).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(`INSERT INTO order_events
+			SELECT rows.* FROM jsonb_populate_recordset(NULL::order_events, ?::jsonb) rows`, string(archive.Events)).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&entities.OrderArchive{}, "order_id = ?", orderID).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.Order{}).
+			Where("id = ?", orderID).
+			Updates(map[string]interface{}{
+				"is_archived": false,
+				"archived_at": nil,
+			}).Error
+	})
+}
+
+// GetArchive gets the archived rows of an order
+func (r *orderArchiveRepository) GetArchive(ctx context.Context, orderID uuid.UUID) (*entities.OrderArchive, error) {
+	var archive entities.OrderArchive
+	if err := r.db.WithContext(ctx).First(&archive, "order_id = ?", orderID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrOrderArchiveNotFound
+		}
+		return nil, err
+	}
+	return &archive, nil
+}
+
+// GetTotals counts the archived orders and rows
+func (r *orderArchiveRepository) GetTotals(ctx context.Context) (*entities.OrderArchiveTotals, error) {
+	var totals entities.OrderArchiveTotals
+	err := r.db.WithContext(ctx).
+		Model(&entities.OrderArchive{}).
+		Select("COUNT(*) AS orders, COALESCE(SUM(item_count), 0) AS items, COALESCE(SUM(event_count), 0) AS events, MAX(archived_at) AS last_archived_at").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return &totals, nil
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type orderMessageRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderMessageRepository creates a new order message repository
+func NewOrderMessageRepository(db *gorm.DB) repositories.OrderMessageRepository {
+	return &orderMessageRepository{db: db}
+}
+
+// Create creates a new message
+func (r *orderMessageRepository) Create(ctx context.Context, message *entities.OrderMessage) error {
+	return r.db.WithContext(ctx).Omit("Sender").Create(message).Error
+}
+
+// GetByID gets a message by ID with its sender
+func (r *orderMessageRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.OrderMessage, error) {
+	var message entities.OrderMessage
+	err := r.db.WithContext(ctx).
+		Preload("Sender").
+		Where("id = ?", id).
+		First(&message).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrOrderMessageNotFound
+		}
+		return nil, err
+	}
+	return &message, nil
+}
+
+// ListByOrder gets all messages on an order, oldest first
+func (r *orderMessageRepository) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.OrderMessage, error) {
+	var messages []*entities.OrderMessage
+	err := r.db.WithContext(ctx).
+		Preload("Sender").
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&messages).Error
+	return messages, err
+}
+
+// MarkRead marks unread messages from a sender as read
+func (r *orderMessageRepository) MarkRead(ctx context.Context, orderID uuid.UUID, sender entities.OrderMessageSender, readAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.OrderMessage{}).
+		Where("order_id = ? AND sender_type = ? AND read_at IS NULL", orderID, sender).
+		Update("read_at", readAt)
+	return result.RowsAffected, result.Error
+}
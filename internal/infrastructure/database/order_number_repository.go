@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"gorm.io/gorm"
+)
+
+type orderNumberSequenceRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderNumberSequenceRepository creates a new order number sequence repository
+func NewOrderNumberSequenceRepository(db *gorm.DB) repositories.OrderNumberSequenceRepository {
+	return &orderNumberSequenceRepository{db: db}
+}
+
+// NextValue draws the next value of a sequence, creating the sequence at its start value
+func (r *orderNumberSequenceRepository) NextValue(ctx context.Context, scope, period string, start int64) (int64, error) {
+	return nextOrderNumberValue(r.db.WithContext(ctx), scope, period, start)
+}
+
+// CreateOrderWithNextValue numbers and creates an order in one transaction
+func (r *orderNumberSequenceRepository) CreateOrderWithNextValue(ctx context.Context, order *entities.Order, scope, period string, start int64, build func(value int64) string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The upsert locks the sequence row until the transaction ends
+		value, err := nextOrderNumberValue(tx, scope, period, start)
+		if err != nil {
+			return err
+		}
+		order.OrderNumber = build(value)
+		return tx.Create(order).Error
+	})
+}
+
+// nextOrderNumberValue increments a sequence row, inserting it at the start value if missing
+func nextOrderNumberValue(db *gorm.DB, scope, period string, start int64) (int64, error) {
+	var value int64
+	err := db.Raw(`
+		INSERT INTO order_number_sequences (id, scope, period, last_value, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+		ON CONFLICT (scope, period)
+		DO UPDATE SET last_value = order_number_sequences.last_value + 1, updated_at = NOW()
+		RETURNING last_value`, ids.New(), scope, period, start).
+		Scan(&value).Error
+	return value, err
+}
@@ -151,6 +151,10 @@ func (r *orderRepository) Search(ctx context.Context, params repositories.OrderS
 		query = query.Where("total <= ?", *params.MaxTotal)
 	}
 
+	if params.DataRegions != nil {
+		query = query.Where("user_id IN (SELECT id FROM users WHERE data_region IN ?)", params.DataRegions)
+	}
+
 	// Apply sorting
 	orderBy := "created_at DESC"
 	if params.SortBy != "" {
@@ -349,6 +353,17 @@ func (r *orderRepository) CountOrdersByStatus(ctx context.Context, status entiti
 	return count, err
 }
 
+// CountPendingFulfillmentByProduct counts orders containing the product that have not shipped yet
+func (r *orderRepository) CountPendingFulfillmentByProduct(ctx context.Context, productID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Order{}).
+		Where("status IN ?", entities.PendingFulfillmentOrderStatuses).
+		Where("id IN (?)", r.db.Table("order_items").Select("order_id").Where("product_id = ?", productID)).
+		Count(&count).Error
+	return count, err
+}
+
 // GetGrossRevenue gets gross revenue (before discounts)
 func (r *orderRepository) GetGrossRevenue(ctx context.Context) (float64, error) {
 	var total float64
@@ -503,6 +518,19 @@ func (r *paymentRepository) GetByExternalID(ctx context.Context, externalID stri
 	return &payment, nil
 }
 
+// GetByPaymentIntentID retrieves a payment by its Stripe payment intent ID
+func (r *paymentRepository) GetByPaymentIntentID(ctx context.Context, paymentIntentID string) (*entities.Payment, error) {
+	var payment entities.Payment
+	err := r.db.WithContext(ctx).Where("payment_intent_id = ?", paymentIntentID).First(&payment).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
 // GetByCustomSessionID retrieves a payment by custom session ID through checkout session mapping
 func (r *paymentRepository) GetByCustomSessionID(ctx context.Context, customSessionID string) (*entities.Payment, error) {
 	var payment entities.Payment
@@ -605,6 +633,18 @@ func (r *paymentRepository) GetRefundablePayments(ctx context.Context, limit, of
 }
 
 // Refund-related methods
+// GetProcessedByGateway retrieves a gateway's paid payments processed in a period
+func (r *paymentRepository) GetProcessedByGateway(ctx context.Context, gateway string, from, to time.Time) ([]*entities.Payment, error) {
+	var payments []*entities.Payment
+	err := r.db.WithContext(ctx).
+		Where("gateway = ?", gateway).
+		Where("status IN ?", []entities.PaymentStatus{entities.PaymentStatusPaid, entities.PaymentStatusRefunded}).
+		Where("processed_at >= ? AND processed_at < ?", from, to).
+		Order("processed_at ASC").
+		Find(&payments).Error
+	return payments, err
+}
+
 func (r *paymentRepository) CreateRefund(ctx context.Context, refund *entities.Refund) error {
 	return r.db.WithContext(ctx).Create(refund).Error
 }
@@ -654,3 +694,28 @@ func (r *paymentRepository) GetPendingRefunds(ctx context.Context, limit, offset
 		Find(&refunds).Error
 	return refunds, err
 }
+
+func (r *paymentRepository) GetRefundByTransactionID(ctx context.Context, transactionID string) (*entities.Refund, error) {
+	var refund entities.Refund
+	err := r.db.WithContext(ctx).Where("transaction_id = ?", transactionID).First(&refund).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrRefundNotFound
+		}
+		return nil, err
+	}
+	return &refund, nil
+}
+
+func (r *paymentRepository) GetCompletedRefundsByGateway(ctx context.Context, gateway string, from, to time.Time) ([]*entities.Refund, error) {
+	var refunds []*entities.Refund
+	err := r.db.WithContext(ctx).
+		Joins("JOIN payments ON payments.id = refunds.payment_id").
+		Where("payments.gateway = ?", gateway).
+		Where("refunds.status = ?", entities.RefundStatusCompleted).
+		Where("refunds.processed_at >= ? AND refunds.processed_at < ?", from, to).
+		Order("refunds.processed_at ASC").
+		Preload("Payment").
+		Find(&refunds).Error
+	return refunds, err
+}
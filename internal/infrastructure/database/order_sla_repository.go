@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type orderSLARepository struct {
+	db *gorm.DB
+}
+
+// NewOrderSLARepository creates a new fulfillment SLA repository
+func NewOrderSLARepository(db *gorm.DB) repositories.OrderSLARepository {
+	return &orderSLARepository{db: db}
+}
+
+// GetUntrackedOrders gets orders in the statuses that have no SLA row
+func (r *orderSLARepository) GetUntrackedOrders(ctx context.Context, statuses []entities.OrderStatus, limit int) ([]*entities.Order, error) {
+	var orders []*entities.Order
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND NOT is_archived", statuses).
+		Where("NOT EXISTS (SELECT 1 FROM order_fulfillment_slas s WHERE s.order_id = orders.id)").
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+// Create starts tracking an order; a concurrent run tracking it first wins
+func (r *orderSLARepository) Create(ctx context.Context, sla *entities.OrderFulfillmentSLA) error {
+	return r.db.WithContext(ctx).Omit("Order").Clauses(clause.OnConflict{DoNothing: true}).Create(sla).Error
+}
+
+// Update updates a tracked order
+func (r *orderSLARepository) Update(ctx context.Context, sla *entities.OrderFulfillmentSLA) error {
+	return r.db.WithContext(ctx).Omit("Order").Save(sla).Error
+}
+
+// GetOpen gets the orders still being tracked
+func (r *orderSLARepository) GetOpen(ctx context.Context, limit int) ([]*entities.OrderFulfillmentSLA, error) {
+	var slas []*entities.OrderFulfillmentSLA
+	err := r.db.WithContext(ctx).
+		Preload("Order").
+		Where("closed_at IS NULL").
+		Order("ship_by ASC").
+		Limit(limit).
+		Find(&slas).Error
+	return slas, err
+}
+
+// List lists tracked orders with filters
+func (r *orderSLARepository) List(ctx context.Context, filters repositories.FulfillmentSLAFilters) ([]*entities.OrderFulfillmentSLA, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.OrderFulfillmentSLA{})
+	if filters.State != "" {
+		query = query.Where("state = ?", filters.State)
+	}
+	if filters.Stuck {
+		query = query.Where("escalated_at IS NOT NULL")
+	}
+	if filters.Open {
+		query = query.Where("closed_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var slas []*entities.OrderFulfillmentSLA
+	err := query.
+		Preload("Order").
+		Order("ship_by ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&slas).Error
+	return slas, total, err
+}
+
+// CountOpen counts the open orders by state
+func (r *orderSLARepository) CountOpen(ctx context.Context) (*repositories.FulfillmentSLACounts, error) {
+	var counts repositories.FulfillmentSLACounts
+	err := r.db.WithContext(ctx).
+		Model(&entities.OrderFulfillmentSLA{}).
+		Select(`COUNT(*) FILTER (WHERE state = ?) AS on_track,
+			COUNT(*) FILTER (WHERE state = ?) AS at_risk,
+			COUNT(*) FILTER (WHERE state = ?) AS breached,
+			COUNT(*) FILTER (WHERE escalated_at IS NOT NULL) AS stuck`,
+			entities.FulfillmentSLAStateOnTrack, entities.FulfillmentSLAStateAtRisk, entities.FulfillmentSLAStateBreached).
+		Where("closed_at IS NULL").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return &counts, nil
+}
+
+// GetDailyShipped counts shipped orders per day in the time zone
+func (r *orderSLARepository) GetDailyShipped(ctx context.Context, from, to time.Time, timezone string) ([]*repositories.FulfillmentSLADay, error) {
+	var days []*repositories.FulfillmentSLADay
+	err := r.db.WithContext(ctx).
+		Model(&entities.OrderFulfillmentSLA{}).
+		Select(`to_char(shipped_at AT TIME ZONE ?, 'YYYY-MM-DD') AS date,
+			COUNT(*) AS shipped,
+			COUNT(*) FILTER (WHERE state = ?) AS on_time`, timezone, entities.FulfillmentSLAStateMet).
+		Where("state IN ? AND shipped_at >= ? AND shipped_at < ?",
+			[]entities.FulfillmentSLAState{entities.FulfillmentSLAStateMet, entities.FulfillmentSLAStateMissed}, from, to).
+		Group("1").
+		Order("1").
+		Scan(&days).Error
+	return days, err
+}
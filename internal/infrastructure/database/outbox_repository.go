@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type outboxEventRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxEventRepository creates a new outbox event repository
+func NewOutboxEventRepository(db *gorm.DB) repositories.OutboxEventRepository {
+	return &outboxEventRepository{db: db}
+}
+
+// Create creates a new outbox event
+func (r *outboxEventRepository) Create(ctx context.Context, event *entities.OutboxEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// Update updates an outbox event
+func (r *outboxEventRepository) Update(ctx context.Context, event *entities.OutboxEvent) error {
+	return r.db.WithContext(ctx).Save(event).Error
+}
+
+// GetPending gets unpublished events, oldest first
+func (r *outboxEventRepository) GetPending(ctx context.Context, limit int) ([]*entities.OutboxEvent, error) {
+	var events []*entities.OutboxEvent
+	query := r.db.WithContext(ctx).
+		Where("published_at IS NULL").
+		Order("occurred_at ASC, id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&events).Error
+	return events, err
+}
+
+// MarkPublished marks events as published
+func (r *outboxEventRepository) MarkPublished(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"published_at": at,
+			"last_error":   "",
+		}).Error
+}
+
+// DeletePublishedBefore deletes events published before the given time
+func (r *outboxEventRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("published_at IS NOT NULL AND published_at < ?", before).
+		Delete(&entities.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// GetStats summarizes the unpublished events
+func (r *outboxEventRepository) GetStats(ctx context.Context) (*repositories.OutboxStats, error) {
+	var row struct {
+		Pending         int64
+		Failing         int64
+		OldestPendingAt *time.Time
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.OutboxEvent{}).
+		Select("COUNT(*) AS pending, COUNT(*) FILTER (WHERE last_error <> '') AS failing, MIN(occurred_at) AS oldest_pending_at").
+		Where("published_at IS NULL").
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &repositories.OutboxStats{
+		Pending:         row.Pending,
+		Failing:         row.Failing,
+		OldestPendingAt: row.OldestPendingAt,
+	}
+	if row.Failing > 0 {
+		var lastFailed entities.OutboxEvent
+		err := r.db.WithContext(ctx).
+			Select("last_error").
+			Where("published_at IS NULL AND last_error <> ''").
+			Order("occurred_at ASC").
+			First(&lastFailed).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		stats.LastError = lastFailed.LastError
+	}
+	return stats, nil
+}
@@ -0,0 +1,245 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type partnerRepository struct {
+	db *gorm.DB
+}
+
+// NewPartnerRepository creates a new partner repository
+func NewPartnerRepository(db *gorm.DB) repositories.PartnerRepository {
+	return &partnerRepository{db: db}
+}
+
+// Create creates a new partner
+func (r *partnerRepository) Create(ctx context.Context, partner *entities.Partner) error {
+	return r.db.WithContext(ctx).Create(partner).Error
+}
+
+// GetByID gets a partner by ID
+func (r *partnerRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Partner, error) {
+	var partner entities.Partner
+	if err := r.db.WithContext(ctx).First(&partner, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrPartnerNotFound
+		}
+		return nil, err
+	}
+	return &partner, nil
+}
+
+// Update updates a partner
+func (r *partnerRepository) Update(ctx context.Context, partner *entities.Partner) error {
+	return r.db.WithContext(ctx).Save(partner).Error
+}
+
+// Delete deletes a partner and revokes its API keys
+func (r *partnerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&entities.APIKey{}).
+			Where("partner_id = ? AND revoked_at IS NULL", id).
+			Updates(map[string]interface{}{"revoked_at": now, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&entities.Partner{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrPartnerNotFound
+		}
+		return nil
+	})
+}
+
+// List lists partners by name
+func (r *partnerRepository) List(ctx context.Context) ([]*entities.Partner, error) {
+	var partners []*entities.Partner
+	err := r.db.WithContext(ctx).Order("name ASC").Find(&partners).Error
+	return partners, err
+}
+
+// GetDueForDelivery returns active partners with a webhook whose next delivery is due
+func (r *partnerRepository) GetDueForDelivery(ctx context.Context, now time.Time, limit int) ([]*entities.Partner, error) {
+	var partners []*entities.Partner
+	err := r.db.WithContext(ctx).
+		Where("is_active = ? AND webhook_url <> ?", true, "").
+		Where("next_delivery_at IS NULL OR next_delivery_at <= ?", now).
+		Order("next_delivery_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&partners).Error
+	return partners, err
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(db *gorm.DB) repositories.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+// Create creates a new API key
+func (r *apiKeyRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+// GetByID gets an API key by ID
+func (r *apiKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.APIKey, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetByHash gets an API key by the hash of the key
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	return r.getBy(ctx, "key_hash = ?", hash)
+}
+
+func (r *apiKeyRepository) getBy(ctx context.Context, query string, arg interface{}) (*entities.APIKey, error) {
+	var key entities.APIKey
+	if err := r.db.WithContext(ctx).First(&key, query, arg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// ListByPartner lists a partner's keys, newest first
+func (r *apiKeyRepository) ListByPartner(ctx context.Context, partnerID uuid.UUID) ([]*entities.APIKey, error) {
+	var keys []*entities.APIKey
+	err := r.db.WithContext(ctx).
+		Where("partner_id = ?", partnerID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// Update updates an API key
+func (r *apiKeyRepository) Update(ctx context.Context, key *entities.APIKey) error {
+	return r.db.WithContext(ctx).Save(key).Error
+}
+
+// MarkUsed records when a key was last used
+func (r *apiKeyRepository) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", at).Error
+}
+
+type apiKeyUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyUsageRepository creates a new API key usage repository
+func NewAPIKeyUsageRepository(db *gorm.DB) repositories.APIKeyUsageRepository {
+	return &apiKeyUsageRepository{db: db}
+}
+
+// RecordRequest counts a request on a key's day against its quota
+func (r *apiKeyUsageRepository) RecordRequest(ctx context.Context, keyID uuid.UUID, day time.Time, quota int64) (*entities.APIKeyUsage, bool, error) {
+	var usage entities.APIKeyUsage
+	var allowed bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Make sure the day's row exists, then lock it so concurrent requests across servers
+		// can't both take the last of the quota
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "api_key_id"}, {Name: "day"}},
+			DoNothing: true,
+		}).Create(&entities.APIKeyUsage{ID: ids.New(), APIKeyID: keyID, Day: day}).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("api_key_id = ? AND day = ?", keyID, day).
+			First(&usage).Error; err != nil {
+			return err
+		}
+
+		allowed = quota <= 0 || usage.Requests < quota
+		if allowed {
+			usage.Requests++
+		} else {
+			usage.Rejected++
+		}
+		usage.UpdatedAt = time.Now()
+		return tx.Model(&entities.APIKeyUsage{}).
+			Where("id = ?", usage.ID).
+			Updates(map[string]interface{}{
+				"requests":   usage.Requests,
+				"rejected":   usage.Rejected,
+				"updated_at": usage.UpdatedAt,
+			}).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &usage, allowed, nil
+}
+
+// ListByKeys lists the counts of keys on the days from from to to, oldest first
+func (r *apiKeyUsageRepository) ListByKeys(ctx context.Context, keyIDs []uuid.UUID, from, to time.Time) ([]*entities.APIKeyUsage, error) {
+	var usage []*entities.APIKeyUsage
+	if len(keyIDs) == 0 {
+		return usage, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("api_key_id IN ? AND day >= ? AND day <= ?", keyIDs, from, to).
+		Order("day ASC, api_key_id ASC").
+		Find(&usage).Error
+	return usage, err
+}
+
+type partnerFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewPartnerFeedRepository creates a new partner feed repository
+func NewPartnerFeedRepository(db *gorm.DB) repositories.PartnerFeedRepository {
+	return &partnerFeedRepository{db: db}
+}
+
+// RecordChange records a price or availability change
+func (r *partnerFeedRepository) RecordChange(ctx context.Context, change *entities.PartnerFeedChange) error {
+	return r.db.WithContext(ctx).Create(change).Error
+}
+
+// GetChangesAfter returns changes after a sequence, oldest first
+func (r *partnerFeedRepository) GetChangesAfter(ctx context.Context, after int64, recordedBefore time.Time, limit int) ([]*entities.PartnerFeedChange, error) {
+	var changes []*entities.PartnerFeedChange
+	err := r.db.WithContext(ctx).
+		Where("sequence > ? AND changed_at < ?", after, recordedBefore).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&changes).Error
+	return changes, err
+}
+
+// LatestSequence returns the sequence of the last change
+func (r *partnerFeedRepository) LatestSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.PartnerFeedChange{}).
+		Select("COALESCE(MAX(sequence), 0)").
+		Scan(&sequence).Error
+	return sequence, err
+}
+
+// DeleteBefore deletes changes recorded before the given time
+func (r *partnerFeedRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("changed_at < ?", before).Delete(&entities.PartnerFeedChange{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type paymentLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentLinkRepository creates a new payment link repository
+func NewPaymentLinkRepository(db *gorm.DB) repositories.PaymentLinkRepository {
+	return &paymentLinkRepository{db: db}
+}
+
+// Create creates a new payment link
+func (r *paymentLinkRepository) Create(ctx context.Context, link *entities.PaymentLink) error {
+	return r.db.WithContext(ctx).Create(link).Error
+}
+
+// GetByID gets a payment link by ID
+func (r *paymentLinkRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.PaymentLink, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetByToken gets a payment link by its token
+func (r *paymentLinkRepository) GetByToken(ctx context.Context, token string) (*entities.PaymentLink, error) {
+	return r.getBy(ctx, "token = ?", token)
+}
+
+// GetByStripeSessionID gets the payment link a checkout session was opened from
+func (r *paymentLinkRepository) GetByStripeSessionID(ctx context.Context, sessionID string) (*entities.PaymentLink, error) {
+	return r.getBy(ctx, "stripe_session_id = ?", sessionID)
+}
+
+func (r *paymentLinkRepository) getBy(ctx context.Context, query string, arg interface{}) (*entities.PaymentLink, error) {
+	var link entities.PaymentLink
+	if err := r.db.WithContext(ctx).First(&link, query, arg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrPaymentLinkNotFound
+		}
+		return nil, err
+	}
+	return &link, nil
+}
+
+// List lists payment links with filters
+func (r *paymentLinkRepository) List(ctx context.Context, filters repositories.PaymentLinkFilters) ([]*entities.PaymentLink, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.PaymentLink{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.OrderID != nil {
+		query = query.Where("order_id = ?", *filters.OrderID)
+	}
+	if filters.QuoteID != nil {
+		query = query.Where("quote_id = ?", *filters.QuoteID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var links []*entities.PaymentLink
+	err := query.
+		Order("created_at DESC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&links).Error
+	return links, total, err
+}
+
+// ClaimCheckout claims an active link for opening a checkout session
+func (r *paymentLinkRepository) ClaimCheckout(ctx context.Context, id uuid.UUID, staleBefore, now time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ? AND status = ? AND expires_at > ?", id, entities.PaymentLinkStatusActive, now).
+		Where("checkout_started_at IS NULL OR checkout_started_at < ?", staleBefore).
+		Updates(map[string]interface{}{
+			"checkout_started_at": now,
+			"stripe_session_id":   "",
+			"checkout_url":        "",
+			"updated_at":          now,
+		})
+	return conflictUnlessUpdated(result)
+}
+
+// SetCheckoutSession records the checkout session opened for a link
+func (r *paymentLinkRepository) SetCheckoutSession(ctx context.Context, id uuid.UUID, sessionID, checkoutURL string) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"stripe_session_id": sessionID,
+			"checkout_url":      checkoutURL,
+			"updated_at":        time.Now(),
+		}).Error
+}
+
+// ReleaseCheckout clears a checkout claim so the link can be opened again
+func (r *paymentLinkRepository) ReleaseCheckout(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ? AND stripe_session_id = ?", id, "").
+		Updates(map[string]interface{}{
+			"checkout_started_at": nil,
+			"updated_at":          time.Now(),
+		}).Error
+}
+
+// MarkSent records where and when the link was last sent to the customer
+func (r *paymentLinkRepository) MarkSent(ctx context.Context, id uuid.UUID, email, phone string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"customer_email": email,
+			"customer_phone": phone,
+			"last_sent_at":   at,
+			"updated_at":     at,
+		}).Error
+}
+
+// Cancel cancels an active link
+func (r *paymentLinkRepository) Cancel(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ? AND status = ?", id, entities.PaymentLinkStatusActive).
+		Updates(map[string]interface{}{
+			"status":       entities.PaymentLinkStatusCancelled,
+			"cancelled_at": at,
+			"updated_at":   at,
+		})
+	return conflictUnlessUpdated(result)
+}
+
+// MarkPaid marks a link as paid
+func (r *paymentLinkRepository) MarkPaid(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("id = ? AND status <> ?", id, entities.PaymentLinkStatusPaid).
+		Updates(map[string]interface{}{
+			"status":     entities.PaymentLinkStatusPaid,
+			"paid_at":    at,
+			"updated_at": at,
+		})
+	return conflictUnlessUpdated(result)
+}
+
+// ExpireActive expires active links past their expiry
+func (r *paymentLinkRepository) ExpireActive(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PaymentLink{}).
+		Where("status = ? AND expires_at <= ?", entities.PaymentLinkStatusActive, now).
+		Updates(map[string]interface{}{
+			"status":     entities.PaymentLinkStatusExpired,
+			"updated_at": now,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// conflictUnlessUpdated returns ErrConflict if a conditional update matched no rows
+func conflictUnlessUpdated(result *gorm.DB) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrConflict
+	}
+	return nil
+}
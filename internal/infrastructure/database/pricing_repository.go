@@ -0,0 +1,376 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type priceHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewPriceHistoryRepository creates a new price history repository
+func NewPriceHistoryRepository(db *gorm.DB) repositories.PriceHistoryRepository {
+	return &priceHistoryRepository{db: db}
+}
+
+// Create creates a new price history entry
+func (r *priceHistoryRepository) Create(ctx context.Context, entry *entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+// GetByID gets a price history entry by ID
+func (r *priceHistoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.PriceHistory, error) {
+	var entry entities.PriceHistory
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&entry).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetByProductID gets price history for a product, newest first
+func (r *priceHistoryRepository) GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int) ([]*entities.PriceHistory, error) {
+	var entries []*entities.PriceHistory
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Where("product_id = ?", productID).
+		Order("created_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err := query.Find(&entries).Error
+	return entries, err
+}
+
+// CountByProductID counts price history entries for a product
+func (r *priceHistoryRepository) CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.PriceHistory{}).
+		Where("product_id = ?", productID).
+		Count(&count).Error
+	return count, err
+}
+
+// GetByProductIDSince gets price history entries created after since, oldest first
+func (r *priceHistoryRepository) GetByProductIDSince(ctx context.Context, productID uuid.UUID, since time.Time) ([]*entities.PriceHistory, error) {
+	var entries []*entities.PriceHistory
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND created_at >= ?", productID, since).
+		Order("created_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+// GetLatestBefore gets the most recent entry created before the given time
+func (r *priceHistoryRepository) GetLatestBefore(ctx context.Context, productID uuid.UUID, before time.Time) (*entities.PriceHistory, error) {
+	var entry entities.PriceHistory
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND created_at < ?", productID, before).
+		Order("created_at DESC").
+		First(&entry).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetByBulkUpdateID gets the entries written by a bulk price update, oldest first
+func (r *priceHistoryRepository) GetByBulkUpdateID(ctx context.Context, bulkUpdateID uuid.UUID) ([]*entities.PriceHistory, error) {
+	var entries []*entities.PriceHistory
+	err := r.db.WithContext(ctx).
+		Where("bulk_update_id = ?", bulkUpdateID).
+		Order("created_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+type scheduledPriceChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewScheduledPriceChangeRepository creates a new scheduled price change repository
+func NewScheduledPriceChangeRepository(db *gorm.DB) repositories.ScheduledPriceChangeRepository {
+	return &scheduledPriceChangeRepository{db: db}
+}
+
+// Create creates a new scheduled price change
+func (r *scheduledPriceChangeRepository) Create(ctx context.Context, change *entities.ScheduledPriceChange) error {
+	return r.db.WithContext(ctx).Create(change).Error
+}
+
+// GetByID gets a scheduled price change by ID
+func (r *scheduledPriceChangeRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ScheduledPriceChange, error) {
+	var change entities.ScheduledPriceChange
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&change).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrNotFound
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+// Update updates a scheduled price change
+func (r *scheduledPriceChangeRepository) Update(ctx context.Context, change *entities.ScheduledPriceChange) error {
+	return r.db.WithContext(ctx).Save(change).Error
+}
+
+// Apply saves the product's new pricing, the change and its history entry in one transaction
+func (r *scheduledPriceChangeRepository) Apply(ctx context.Context, change *entities.ScheduledPriceChange, product *entities.Product, entry *entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if entry != nil {
+			result := tx.Model(&entities.Product{}).
+				Where("id = ? AND price = ?", product.ID, entry.OldPrice).
+				Updates(map[string]interface{}{
+					"price":           product.Price,
+					"sale_price":      product.SalePrice,
+					"sale_start_date": product.SaleStartDate,
+					"sale_end_date":   product.SaleEndDate,
+					"updated_at":      product.UpdatedAt,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return entities.ErrConflict
+			}
+		}
+
+		if err := tx.Save(change).Error; err != nil {
+			return err
+		}
+		if entry == nil {
+			return nil
+		}
+		return tx.Create(entry).Error
+	})
+}
+
+// GetByProductID gets scheduled changes for a product ordered by effective date
+func (r *scheduledPriceChangeRepository) GetByProductID(ctx context.Context, productID uuid.UUID, status *entities.ScheduledPriceChangeStatus) ([]*entities.ScheduledPriceChange, error) {
+	var changes []*entities.ScheduledPriceChange
+	query := r.db.WithContext(ctx).Where("product_id = ?", productID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	err := query.Order("effective_at ASC").Find(&changes).Error
+	return changes, err
+}
+
+// GetDue gets pending changes whose effective date has passed, oldest first
+func (r *scheduledPriceChangeRepository) GetDue(ctx context.Context, at time.Time, limit int) ([]*entities.ScheduledPriceChange, error) {
+	var changes []*entities.ScheduledPriceChange
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND effective_at <= ?", entities.ScheduledPriceChangeStatusPending, at).
+		Order("effective_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&changes).Error
+	return changes, err
+}
+
+// List lists scheduled price changes with filters
+func (r *scheduledPriceChangeRepository) List(ctx context.Context, filters repositories.ScheduledPriceChangeFilters) ([]*entities.ScheduledPriceChange, error) {
+	var changes []*entities.ScheduledPriceChange
+	query := r.applyFilters(r.db.WithContext(ctx).Preload("Product"), filters).
+		Order("effective_at ASC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&changes).Error
+	return changes, err
+}
+
+// Count counts scheduled price changes with filters
+func (r *scheduledPriceChangeRepository) Count(ctx context.Context, filters repositories.ScheduledPriceChangeFilters) (int64, error) {
+	var count int64
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.ScheduledPriceChange{}), filters)
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *scheduledPriceChangeRepository) applyFilters(query *gorm.DB, filters repositories.ScheduledPriceChangeFilters) *gorm.DB {
+	if filters.ProductID != nil {
+		query = query.Where("product_id = ?", *filters.ProductID)
+	}
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	if filters.From != nil {
+		query = query.Where("effective_at >= ?", *filters.From)
+	}
+	if filters.To != nil {
+		query = query.Where("effective_at <= ?", *filters.To)
+	}
+	return query
+}
+
+type bulkPriceUpdateRepository struct {
+	db *gorm.DB
+}
+
+// NewBulkPriceUpdateRepository creates a new bulk price update repository
+func NewBulkPriceUpdateRepository(db *gorm.DB) repositories.BulkPriceUpdateRepository {
+	return &bulkPriceUpdateRepository{db: db}
+}
+
+// GetByID gets a bulk price update by ID
+func (r *bulkPriceUpdateRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.BulkPriceUpdate, error) {
+	var update entities.BulkPriceUpdate
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&update).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrBulkPriceUpdateNotFound
+		}
+		return nil, err
+	}
+	return &update, nil
+}
+
+// List lists bulk price updates, newest first
+func (r *bulkPriceUpdateRepository) List(ctx context.Context, limit, offset int) ([]*entities.BulkPriceUpdate, error) {
+	var updates []*entities.BulkPriceUpdate
+	query := r.db.WithContext(ctx).Order("created_at DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err := query.Find(&updates).Error
+	return updates, err
+}
+
+// Count counts bulk price updates
+func (r *bulkPriceUpdateRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.BulkPriceUpdate{}).Count(&count).Error
+	return count, err
+}
+
+// GetMatchingProducts gets the products selected by a bulk price rule
+func (r *bulkPriceUpdateRepository) GetMatchingProducts(ctx context.Context, filter repositories.BulkPriceProductFilter) ([]*entities.Product, error) {
+	var products []*entities.Product
+	query := r.db.WithContext(ctx).Model(&entities.Product{})
+
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where("products.id IN (?)", r.db.Table("product_categories").
+			Select("product_id").
+			Where("category_id IN ?", filter.CategoryIDs))
+	}
+	if filter.BrandID != nil {
+		query = query.Where("products.brand_id = ?", *filter.BrandID)
+	}
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("products.id IN ?", filter.ProductIDs)
+	}
+	if filter.MinPrice != nil {
+		query = query.Where("products.price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where("products.price <= ?", *filter.MaxPrice)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	err := query.Order("products.name ASC").Find(&products).Error
+	return products, err
+}
+
+// Apply creates the bulk update and reprices its products in one transaction
+func (r *bulkPriceUpdateRepository) Apply(ctx context.Context, update *entities.BulkPriceUpdate, products []*entities.Product, entries []*entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(update).Error; err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			entry.BulkUpdateID = &update.ID
+		}
+		return savePriceChanges(tx, products, entries)
+	})
+}
+
+// Rollback restores the products of a bulk update and marks it rolled back in one transaction
+func (r *bulkPriceUpdateRepository) Rollback(ctx context.Context, update *entities.BulkPriceUpdate, products []*entities.Product, entries []*entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.BulkPriceUpdate{}).
+			Where("id = ? AND status = ?", update.ID, entities.BulkPriceUpdateStatusApplied).
+			Updates(map[string]interface{}{
+				"status":         update.Status,
+				"rolled_back_by": update.RolledBackBy,
+				"rolled_back_at": update.RolledBackAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+		for _, entry := range entries {
+			entry.BulkUpdateID = &update.ID
+		}
+		return savePriceChanges(tx, products, entries)
+	})
+}
+
+// savePriceChanges writes the new pricing of each product and its history entry. A product is
+// only updated while its price still matches the entry's old price, so concurrent edits are not
+// overwritten.
+func savePriceChanges(tx *gorm.DB, products []*entities.Product, entries []*entities.PriceHistory) error {
+	oldPrices := make(map[uuid.UUID]float64, len(entries))
+	for _, entry := range entries {
+		oldPrices[entry.ProductID] = entry.OldPrice
+	}
+
+	for _, product := range products {
+		oldPrice, ok := oldPrices[product.ID]
+		if !ok {
+			continue
+		}
+		result := tx.Model(&entities.Product{}).
+			Where("id = ? AND price = ?", product.ID, oldPrice).
+			Updates(map[string]interface{}{
+				"price":      product.Price,
+				"sale_price": product.SalePrice,
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(entries, 500).Error
+}
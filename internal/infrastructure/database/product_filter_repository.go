@@ -53,10 +53,10 @@ func (r *productFilterRepository) FilterProducts(ctx context.Context, params rep
 
 	// Apply stock filters
 	if params.InStock != nil && *params.InStock {
-		query = query.Where("stock > 0 AND stock_status = ?", entities.StockStatusInStock)
+		query = query.Where("stock > 0 AND "+publicStockStatus+" = ?", entities.StockStatusInStock)
 	}
 	if params.LowStock != nil && *params.LowStock {
-		query = query.Where("stock <= low_stock_threshold AND stock > 0")
+		query = query.Where(publicLowStockCondition)
 	}
 
 	// Apply sale filter
@@ -76,7 +76,7 @@ func (r *productFilterRepository) FilterProducts(ctx context.Context, params rep
 
 	// Apply stock status filters
 	if len(params.StockStatus) > 0 {
-		query = query.Where("stock_status IN ?", params.StockStatus)
+		query = query.Where(publicStockStatus+" IN ?", params.StockStatus)
 	}
 
 	// Apply visibility filters
@@ -84,6 +84,11 @@ func (r *productFilterRepository) FilterProducts(ctx context.Context, params rep
 		query = query.Where("visibility IN ?", params.Visibility)
 	}
 
+	// Apply catalog visibility rules
+	if len(params.ExcludeIDs) > 0 {
+		query = query.Where("products.id NOT IN ?", params.ExcludeIDs)
+	}
+
 	// Apply tag filters (temporarily disabled)
 	/*
 	if len(params.Tags) > 0 {
@@ -595,7 +600,7 @@ func (r *productFilterRepository) getStockFacets(ctx context.Context, categoryID
 	r.db.WithContext(ctx).Raw(baseQuery+" AND stock > 0"+categoryFilter, stockArgs...).Scan(&facet.InStock)
 
 	// Low stock
-	r.db.WithContext(ctx).Raw(baseQuery+" AND stock <= low_stock_threshold AND stock > 0"+categoryFilter, stockArgs...).Scan(&facet.LowStock)
+	r.db.WithContext(ctx).Raw(baseQuery+" AND "+publicLowStockCondition+categoryFilter, stockArgs...).Scan(&facet.LowStock)
 
 	// Out of stock
 	r.db.WithContext(ctx).Raw(baseQuery+" AND stock = 0"+categoryFilter, stockArgs...).Scan(&facet.OutStock)
@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -121,7 +122,7 @@ func (r *productRatingRepository) UpdateRating(ctx context.Context, productID uu
 
 		// If no record was updated, create a new one
 		if result.RowsAffected == 0 {
-			productRating.ID = uuid.New()
+			productRating.ID = ids.New()
 			productRating.CreatedAt = time.Now()
 			return tx.Create(productRating).Error
 		}
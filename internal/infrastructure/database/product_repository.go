@@ -9,9 +9,11 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type productRepository struct {
@@ -94,6 +96,25 @@ func (r *productRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*e
 	return products, nil
 }
 
+// GetPriceAndStockByIDs retrieves the pricing, stock and status columns of products, without relations
+func (r *productRepository) GetPriceAndStockByIDs(ctx context.Context, ids []uuid.UUID) ([]*entities.Product, error) {
+	if len(ids) == 0 {
+		return []*entities.Product{}, nil
+	}
+
+	var products []*entities.Product
+	err := r.db.WithContext(ctx).
+		Select("id", "sku", "price", "compare_price", "sale_price", "sale_start_date", "sale_end_date",
+			"stock", "low_stock_threshold", "track_quantity", "allow_backorder", "stock_status",
+			"stock_visibility", "stock_display_threshold", "status", "visibility", "updated_at").
+		Where("id IN ?", ids).
+		Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
 // GetBySKU retrieves a product by SKU
 func (r *productRepository) GetBySKU(ctx context.Context, sku string) (*entities.Product, error) {
 	var product entities.Product
@@ -116,9 +137,24 @@ func (r *productRepository) GetBySKU(ctx context.Context, sku string) (*entities
 
 // Update updates an existing product
 func (r *productRepository) Update(ctx context.Context, product *entities.Product) error {
+	return updateProduct(r.db.WithContext(ctx), product)
+}
+
+// UpdateWithPriceHistory updates a product and writes its price history entry in one transaction
+func (r *productRepository) UpdateWithPriceHistory(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateProduct(tx, product); err != nil {
+			return err
+		}
+		return tx.Create(entry).Error
+	})
+}
+
+// updateProduct saves a product's own columns
+func updateProduct(db *gorm.DB, product *entities.Product) error {
 	// Use Updates instead of Save to ensure all fields are updated properly
 	// Select specific fields to avoid issues with relationships
-	result := r.db.WithContext(ctx).Model(product).Select(
+	result := db.Model(product).Select(
 		// Basic fields
 		"name", "description", "short_description", "sku", "updated_at",
 
@@ -126,13 +162,14 @@ func (r *productRepository) Update(ctx context.Context, product *entities.Produc
 		"slug", "meta_title", "meta_description", "keywords", "featured", "visibility",
 
 		// Pricing
-		"price", "compare_price", "cost_price",
+		"price", "compare_price", "cost_price", "min_advertised_price",
 
 		// Sale Pricing
 		"sale_price", "sale_start_date", "sale_end_date",
 
 		// Inventory
 		"stock", "low_stock_threshold", "track_quantity", "allow_backorder", "stock_status",
+		"stock_visibility", "stock_display_threshold",
 
 		// Physical Properties
 		"weight", "length", "width", "height", // dimensions fields
@@ -145,6 +182,9 @@ func (r *productRepository) Update(ctx context.Context, product *entities.Produc
 
 		// Status and Type
 		"status", "product_type", "is_digital",
+
+		// Lifecycle
+		"replacement_product_id", "discontinued_at", "archived_at",
 	).Updates(product)
 
 	return result.Error
@@ -264,8 +304,10 @@ func (r *productRepository) Search(ctx context.Context, params repositories.Prod
 		query = query.Where("price <= ?", *params.MaxPrice)
 	}
 
-	if params.Status != nil {
-		query = query.Where("status = ?", *params.Status)
+	query = applyProductStatusFilter(query, params.Status)
+
+	if len(params.ExcludeIDs) > 0 {
+		query = query.Where("products.id NOT IN ?", params.ExcludeIDs)
 	}
 
 	// Apply sorting with relevance ranking
@@ -340,8 +382,10 @@ func (r *productRepository) SearchCount(ctx context.Context, params repositories
 		query = query.Where("price <= ?", *params.MaxPrice)
 	}
 
-	if params.Status != nil {
-		query = query.Where("status = ?", *params.Status)
+	query = applyProductStatusFilter(query, params.Status)
+
+	if len(params.ExcludeIDs) > 0 {
+		query = query.Where("products.id NOT IN ?", params.ExcludeIDs)
 	}
 
 	var count int64
@@ -448,6 +492,41 @@ func (r *productRepository) GetByCategory(ctx context.Context, categoryID uuid.U
 	return products, err
 }
 
+// GetWithExpiredSales retrieves products still carrying a sale price whose sale window ended before at
+func (r *productRepository) GetWithExpiredSales(ctx context.Context, at time.Time, limit int) ([]*entities.Product, error) {
+	var products []*entities.Product
+	query := r.db.WithContext(ctx).
+		Where("sale_price IS NOT NULL AND sale_end_date IS NOT NULL AND sale_end_date < ?", at).
+		Order("sale_end_date ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&products).Error
+	return products, err
+}
+
+// RevertExpiredSale clears only the sale columns, and only while the product still carries the
+// sale that ended, so prices or stock saved meanwhile are kept
+func (r *productRepository) RevertExpiredSale(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Product{}).
+			Where("id = ? AND sale_price = ? AND sale_end_date = ?", product.ID, entry.OldSalePrice, entry.OldSaleEndDate).
+			Updates(map[string]interface{}{
+				"sale_price":      nil,
+				"sale_start_date": nil,
+				"sale_end_date":   nil,
+				"updated_at":      product.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entities.ErrConflict
+		}
+		return tx.Create(entry).Error
+	})
+}
+
 // UpdateStock updates product stock and stock status
 func (r *productRepository) UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error {
 	// Get the product first to calculate stock status
@@ -620,6 +699,43 @@ func (r *productRepository) GetRelated(ctx context.Context, productID uuid.UUID,
 	return products, err
 }
 
+// GetAlternatives retrieves visible products in the same primary category, same brand first and then by closest price
+func (r *productRepository) GetAlternatives(ctx context.Context, product *entities.Product, limit int) ([]*entities.Product, error) {
+	var categoryIDs []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Table("product_categories").
+		Where("product_id = ? AND is_primary = true", product.ID).
+		Pluck("category_id", &categoryIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(categoryIDs) == 0 {
+		return []*entities.Product{}, nil
+	}
+
+	brandID := uuid.Nil
+	if product.BrandID != nil {
+		brandID = *product.BrandID
+	}
+
+	var products []*entities.Product
+	err = r.db.WithContext(ctx).
+		Preload("Brand").
+		Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Where("position >= 0").Order("position ASC")
+		}).
+		Where("products.id IN (?)", r.db.Table("product_categories").Select("product_id").Where("category_id IN ?", categoryIDs)).
+		Where("products.id != ? AND products.status = ? AND products.visibility = ?", product.ID, entities.ProductStatusActive, entities.ProductVisibilityVisible).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "CASE WHEN products.brand_id = ? THEN 0 ELSE 1 END, ABS(products.price - ?)",
+			Vars:               []interface{}{brandID, product.Price},
+			WithoutParentheses: true,
+		}}).
+		Limit(limit).
+		Find(&products).Error
+	return products, err
+}
+
 // ClearTags removes all tag associations for a product using GORM Association
 func (r *productRepository) ClearTags(ctx context.Context, productID uuid.UUID) error {
 	// Get the product first
@@ -815,9 +931,7 @@ func (r *productRepository) SearchAdvanced(ctx context.Context, params repositor
 		query = query.Where("product_type = ?", *params.ProductType)
 	}
 
-	if params.Status != nil {
-		query = query.Where("status = ?", *params.Status)
-	}
+	query = applyProductStatusFilter(query, params.Status)
 
 	// Apply sorting
 	if params.SortBy != "" {
@@ -1013,7 +1127,7 @@ func (r *productRepository) GetPopularSearches(ctx context.Context, limit int) (
 // RecordSearchQuery records a search query for analytics
 func (r *productRepository) RecordSearchQuery(ctx context.Context, query string, userID *uuid.UUID, resultCount int) error {
 	searchQuery := repositories.SearchQuery{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		Query:       query,
 		UserID:      userID,
 		ResultCount: resultCount,
@@ -1060,3 +1174,21 @@ func calculateRelevance(productName, query string) float64 {
 	// Default relevance
 	return 0.5
 }
+
+// publicLowStockCondition matches the products shoppers are told are running low: their stock
+// visibility doesn't hide it and they are at or below their "only X left" threshold
+const publicLowStockCondition = "stock_visibility <> 'hidden' AND track_quantity AND stock > 0 AND " +
+	"stock <= COALESCE(stock_display_threshold, low_stock_threshold)"
+
+// publicStockStatus is a product's stock status as shoppers see it: low stock they aren't told
+// about reads as in stock
+const publicStockStatus = "CASE WHEN stock_status = 'low_stock' AND NOT (" + publicLowStockCondition + ") " +
+	"THEN 'in_stock' ELSE stock_status END"
+
+// applyProductStatusFilter filters by status, or hides delisted products when no status is requested
+func applyProductStatusFilter(query *gorm.DB, status *entities.ProductStatus) *gorm.DB {
+	if status != nil {
+		return query.Where("products.status = ?", *status)
+	}
+	return query.Where("products.status NOT IN ?", entities.DelistedProductStatuses)
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type productTranslationRepository struct {
+	db *gorm.DB
+}
+
+// NewProductTranslationRepository creates a new product translation repository
+func NewProductTranslationRepository(db *gorm.DB) repositories.ProductTranslationRepository {
+	return &productTranslationRepository{db: db}
+}
+
+// Get gets a product's translation in a locale
+func (r *productTranslationRepository) Get(ctx context.Context, productID uuid.UUID, locale string) (*entities.ProductTranslation, error) {
+	var translation entities.ProductTranslation
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND locale = ?", productID, locale).
+		First(&translation).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrProductTranslationNotFound
+		}
+		return nil, err
+	}
+	return &translation, nil
+}
+
+// ListByProduct lists a product's translations
+func (r *productTranslationRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*entities.ProductTranslation, error) {
+	var translations []*entities.ProductTranslation
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("locale ASC").
+		Find(&translations).Error
+	return translations, err
+}
+
+// ListByProducts lists the translations of several products in the given locales
+func (r *productTranslationRepository) ListByProducts(ctx context.Context, productIDs []uuid.UUID, locales []string) ([]*entities.ProductTranslation, error) {
+	if len(productIDs) == 0 || len(locales) == 0 {
+		return []*entities.ProductTranslation{}, nil
+	}
+
+	var translations []*entities.ProductTranslation
+	err := r.db.WithContext(ctx).
+		Where("product_id IN ? AND locale IN ?", productIDs, locales).
+		Find(&translations).Error
+	return translations, err
+}
+
+// Save creates a translation or replaces the existing one for the product and locale
+func (r *productTranslationRepository) Save(ctx context.Context, translation *entities.ProductTranslation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "product_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "short_description", "description", "meta_title", "meta_description", "keywords",
+				"status", "translated_at", "updated_at",
+			}),
+		}).
+		Create(translation).Error
+}
+
+// Delete deletes a product's translation in a locale
+func (r *productTranslationRepository) Delete(ctx context.Context, productID uuid.UUID, locale string) error {
+	result := r.db.WithContext(ctx).
+		Where("product_id = ? AND locale = ?", productID, locale).
+		Delete(&entities.ProductTranslation{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrProductTranslationNotFound
+	}
+	return nil
+}
+
+// MarkOutdated flags a product's complete translations as outdated
+func (r *productTranslationRepository) MarkOutdated(ctx context.Context, productID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.ProductTranslation{}).
+		Where("product_id = ? AND status = ?", productID, entities.ProductTranslationStatusComplete).
+		Update("status", entities.ProductTranslationStatusOutdated).Error
+}
+
+// CountByStatus counts translations per locale and status
+func (r *productTranslationRepository) CountByStatus(ctx context.Context) ([]*repositories.ProductTranslationStatusCount, error) {
+	var counts []*repositories.ProductTranslationStatusCount
+	err := r.db.WithContext(ctx).
+		Model(&entities.ProductTranslation{}).
+		Select("locale, status, COUNT(*) as count").
+		Group("locale, status").
+		Order("locale ASC").
+		Scan(&counts).Error
+	return counts, err
+}
@@ -0,0 +1,398 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type supplierRepository struct {
+	db *gorm.DB
+}
+
+// NewSupplierRepository creates a new supplier repository
+func NewSupplierRepository(db *gorm.DB) repositories.SupplierRepository {
+	return &supplierRepository{db: db}
+}
+
+// Create creates a new supplier
+func (r *supplierRepository) Create(ctx context.Context, supplier *entities.Supplier) error {
+	return r.db.WithContext(ctx).Create(supplier).Error
+}
+
+// GetByID gets a supplier by ID
+func (r *supplierRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Supplier, error) {
+	var supplier entities.Supplier
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&supplier).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrSupplierNotFound
+		}
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+// Update updates a supplier
+func (r *supplierRepository) Update(ctx context.Context, supplier *entities.Supplier) error {
+	return r.db.WithContext(ctx).Save(supplier).Error
+}
+
+// ExistsByCode checks if a supplier with the code exists
+func (r *supplierRepository) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.Supplier{}).Where("code = ?", code).Count(&count).Error
+	return count > 0, err
+}
+
+// List lists suppliers by name
+func (r *supplierRepository) List(ctx context.Context, activeOnly bool, limit, offset int) ([]*entities.Supplier, error) {
+	var suppliers []*entities.Supplier
+	query := r.db.WithContext(ctx).Order("name ASC")
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err := query.Find(&suppliers).Error
+	return suppliers, err
+}
+
+// Count counts suppliers
+func (r *supplierRepository) Count(ctx context.Context, activeOnly bool) (int64, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&entities.Supplier{})
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Count(&count).Error
+	return count, err
+}
+
+type purchaseOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewPurchaseOrderRepository creates a new purchase order repository
+func NewPurchaseOrderRepository(db *gorm.DB) repositories.PurchaseOrderRepository {
+	return &purchaseOrderRepository{db: db}
+}
+
+// Create creates a new purchase order with its items
+func (r *purchaseOrderRepository) Create(ctx context.Context, order *entities.PurchaseOrder) error {
+	return r.db.WithContext(ctx).Create(order).Error
+}
+
+// GetByID gets a purchase order by ID
+func (r *purchaseOrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	var order entities.PurchaseOrder
+	err := r.db.WithContext(ctx).
+		Preload("Supplier").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Items.Product", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "name", "sku")
+		}).
+		Where("id = ?", id).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrPurchaseOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// List lists purchase orders with filters, newest first
+func (r *purchaseOrderRepository) List(ctx context.Context, filters repositories.PurchaseOrderFilters) ([]*entities.PurchaseOrder, error) {
+	var orders []*entities.PurchaseOrder
+	query := r.applyFilters(r.db.WithContext(ctx), filters).
+		Preload("Supplier").
+		Preload("Items").
+		Order("ordered_at DESC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&orders).Error
+	return orders, err
+}
+
+// Count counts purchase orders with filters
+func (r *purchaseOrderRepository) Count(ctx context.Context, filters repositories.PurchaseOrderFilters) (int64, error) {
+	var count int64
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.PurchaseOrder{}), filters)
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *purchaseOrderRepository) applyFilters(query *gorm.DB, filters repositories.PurchaseOrderFilters) *gorm.DB {
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	if filters.SupplierID != nil {
+		query = query.Where("supplier_id = ?", *filters.SupplierID)
+	}
+	if filters.ProductID != nil {
+		query = query.Where("id IN (SELECT purchase_order_id FROM purchase_order_items WHERE product_id = ?)", *filters.ProductID)
+	}
+	return query
+}
+
+// UpdateExpectedAt sets the expected delivery date of a purchase order
+func (r *purchaseOrderRepository) UpdateExpectedAt(ctx context.Context, id uuid.UUID, expectedAt *time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.PurchaseOrder{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"expected_at": expectedAt,
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+// UpdateStatus changes the status of an open purchase order
+func (r *purchaseOrderRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entities.PurchaseOrderStatus) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PurchaseOrder{}).
+		Where("id = ? AND status = ?", id, entities.PurchaseOrderStatusOpen).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Receive records received quantities and adds them to stock
+func (r *purchaseOrderRepository) Receive(ctx context.Context, order *entities.PurchaseOrder, received map[uuid.UUID]int, receivedBy uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the purchase order so concurrent receipts don't add the same stock twice
+		var locked entities.PurchaseOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", order.ID).
+			First(&locked).Error; err != nil {
+			return err
+		}
+		if !locked.IsOpen() {
+			return entities.ErrPurchaseOrderNotOpen
+		}
+
+		now := time.Now()
+		for i := range order.Items {
+			item := &order.Items[i]
+			quantity := received[item.ID]
+			if quantity <= 0 {
+				continue
+			}
+
+			if err := tx.Model(&entities.PurchaseOrderItem{}).
+				Where("id = ?", item.ID).
+				Updates(map[string]interface{}{
+					"received_quantity": gorm.Expr("received_quantity + ?", quantity),
+					"updated_at":        now,
+				}).Error; err != nil {
+				return err
+			}
+			item.ReceivedQuantity += quantity
+
+			if err := r.addStock(tx, order, item, quantity, receivedBy, now); err != nil {
+				return err
+			}
+		}
+
+		updates := map[string]interface{}{"updated_at": now}
+		if order.IsFullyReceived() {
+			updates["status"] = entities.PurchaseOrderStatusReceived
+			updates["received_at"] = now
+			order.Status = entities.PurchaseOrderStatusReceived
+			order.ReceivedAt = &now
+		}
+		return tx.Model(&entities.PurchaseOrder{}).Where("id = ?", order.ID).Updates(updates).Error
+	})
+}
+
+// addStock adds received units to a product's stock, and to its inventory with a purchase movement
+func (r *purchaseOrderRepository) addStock(tx *gorm.DB, order *entities.PurchaseOrder, item *entities.PurchaseOrderItem, quantity int, receivedBy uuid.UUID, now time.Time) error {
+	var product entities.Product
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "stock", "low_stock_threshold", "track_quantity", "allow_backorder", "stock_status").
+		Where("id = ?", item.ProductID).
+		First(&product).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.ErrProductNotFound
+		}
+		return err
+	}
+	product.Stock += quantity
+	product.UpdateStockStatus()
+	if err := tx.Model(&entities.Product{}).
+		Where("id = ?", product.ID).
+		Updates(map[string]interface{}{
+			"stock":        product.Stock,
+			"stock_status": product.StockStatus,
+			"updated_at":   now,
+		}).Error; err != nil {
+		return err
+	}
+
+	var inventory entities.Inventory
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id = ?", item.ProductID).
+		First(&inventory).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Model(&entities.Inventory{}).
+		Where("id = ?", inventory.ID).
+		Updates(map[string]interface{}{
+			"quantity_on_hand":   gorm.Expr("quantity_on_hand + ?", quantity),
+			"quantity_available": gorm.Expr("quantity_on_hand + ? - quantity_reserved", quantity),
+			"last_cost":          item.UnitCost,
+			"last_movement_at":   now,
+			"updated_at":         now,
+		}).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&entities.InventoryMovement{
+		ID:             ids.New(),
+		InventoryID:    inventory.ID,
+		Type:           entities.InventoryMovementTypeIn,
+		Reason:         entities.InventoryReasonPurchase,
+		Quantity:       quantity,
+		UnitCost:       item.UnitCost,
+		TotalCost:      item.UnitCost * float64(quantity),
+		QuantityBefore: inventory.QuantityOnHand,
+		QuantityAfter:  inventory.QuantityOnHand + quantity,
+		ReferenceType:  "purchase_order",
+		ReferenceID:    &order.ID,
+		Notes:          "Received on purchase order " + order.Number,
+		CreatedBy:      receivedBy,
+	}).Error
+}
+
+// GetOpenItemsByProducts returns the outstanding items of open purchase orders for the products
+func (r *purchaseOrderRepository) GetOpenItemsByProducts(ctx context.Context, productIDs []uuid.UUID) ([]*entities.PurchaseOrderItem, error) {
+	var items []*entities.PurchaseOrderItem
+	if len(productIDs) == 0 {
+		return items, nil
+	}
+	err := r.db.WithContext(ctx).
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_items.purchase_order_id").
+		Where("purchase_order_items.product_id IN ?", productIDs).
+		Where("purchase_orders.status = ?", entities.PurchaseOrderStatusOpen).
+		Where("purchase_order_items.received_quantity < purchase_order_items.quantity").
+		Preload("PurchaseOrder.Supplier").
+		Find(&items).Error
+	return items, err
+}
+
+type backInStockRepository struct {
+	db *gorm.DB
+}
+
+// NewBackInStockRepository creates a new back in stock subscription repository
+func NewBackInStockRepository(db *gorm.DB) repositories.BackInStockRepository {
+	return &backInStockRepository{db: db}
+}
+
+// Subscribe subscribes a user to a product
+func (r *backInStockRepository) Subscribe(ctx context.Context, subscription *entities.BackInStockSubscription) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "product_id"}, {Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"notified_at": nil,
+				"created_at":  gorm.Expr("CASE WHEN back_in_stock_subscriptions.notified_at IS NULL THEN back_in_stock_subscriptions.created_at ELSE EXCLUDED.created_at END"),
+			}),
+		}).
+		Create(subscription).Error
+}
+
+// Unsubscribe removes a user's subscription to a product
+func (r *backInStockRepository) Unsubscribe(ctx context.Context, productID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("product_id = ? AND user_id = ?", productID, userID).
+		Delete(&entities.BackInStockSubscription{}).Error
+}
+
+// GetPending gets a user's pending subscription to a product
+func (r *backInStockRepository) GetPending(ctx context.Context, productID, userID uuid.UUID) (*entities.BackInStockSubscription, error) {
+	var subscription entities.BackInStockSubscription
+	err := r.db.WithContext(ctx).
+		Where("product_id = ? AND user_id = ? AND notified_at IS NULL", productID, userID).
+		First(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// GetPendingProductIDs returns the products with pending subscribers
+func (r *backInStockRepository) GetPendingProductIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var productIDs []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&entities.BackInStockSubscription{}).
+		Where("notified_at IS NULL").
+		Distinct("product_id").
+		Pluck("product_id", &productIDs).Error
+	return productIDs, err
+}
+
+// GetPendingByProduct returns a product's pending subscribers, oldest first
+func (r *backInStockRepository) GetPendingByProduct(ctx context.Context, productID uuid.UUID, limit int) ([]*entities.BackInStockSubscription, error) {
+	var subscriptions []*entities.BackInStockSubscription
+	query := r.db.WithContext(ctx).
+		Where("product_id = ? AND notified_at IS NULL", productID).
+		Order("created_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// CountPendingByProduct counts a product's pending subscribers
+func (r *backInStockRepository) CountPendingByProduct(ctx context.Context, productID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.BackInStockSubscription{}).
+		Where("product_id = ? AND notified_at IS NULL", productID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkNotified marks subscriptions as notified
+func (r *backInStockRepository) MarkNotified(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&entities.BackInStockSubscription{}).
+		Where("id IN ?", ids).
+		Update("notified_at", time.Now()).Error
+}
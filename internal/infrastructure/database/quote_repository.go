@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type quoteRepository struct {
+	db *gorm.DB
+}
+
+// NewQuoteRepository creates a new quote repository
+func NewQuoteRepository(db *gorm.DB) repositories.QuoteRepository {
+	return &quoteRepository{db: db}
+}
+
+// Create creates a new quote with its items
+func (r *quoteRepository) Create(ctx context.Context, quote *entities.Quote) error {
+	return r.db.WithContext(ctx).Create(quote).Error
+}
+
+// GetByID gets a quote by ID
+func (r *quoteRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Quote, error) {
+	var quote entities.Quote
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&quote).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrQuoteNotFound
+		}
+		return nil, err
+	}
+	return &quote, nil
+}
+
+// Update updates a quote and its item prices
+func (r *quoteRepository) Update(ctx context.Context, quote *entities.Quote) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		quote.UpdatedAt = time.Now()
+		if err := tx.Omit("User", "Items").Save(quote).Error; err != nil {
+			return err
+		}
+		for i := range quote.Items {
+			if err := tx.Save(&quote.Items[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TransitionStatus atomically moves a quote between statuses
+func (r *quoteRepository) TransitionStatus(ctx context.Context, id uuid.UUID, from, to entities.QuoteStatus) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Quote{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrConflict
+	}
+	return nil
+}
+
+// List lists quotes with filters, newest first
+func (r *quoteRepository) List(ctx context.Context, filters repositories.QuoteFilters) ([]*entities.Quote, error) {
+	var quotes []*entities.Quote
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&entities.Quote{}), filters).
+		Preload("User").
+		Preload("Items").
+		Order("created_at DESC")
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := query.Find(&quotes).Error
+	return quotes, err
+}
+
+// Count counts quotes with filters
+func (r *quoteRepository) Count(ctx context.Context, filters repositories.QuoteFilters) (int64, error) {
+	var count int64
+	err := r.applyFilters(r.db.WithContext(ctx).Model(&entities.Quote{}), filters).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *quoteRepository) applyFilters(query *gorm.DB, filters repositories.QuoteFilters) *gorm.DB {
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+	if filters.CompanyID != nil {
+		query = query.Where("company_id = ?", *filters.CompanyID)
+	}
+	if filters.Status != nil {
+		query = query.Where("status = ?", *filters.Status)
+	}
+	return query
+}
+
+// GetExpired gets quoted or accepted quotes whose expiry has passed
+func (r *quoteRepository) GetExpired(ctx context.Context, now time.Time, limit int) ([]*entities.Quote, error) {
+	var quotes []*entities.Quote
+	query := r.db.WithContext(ctx).
+		Where("status IN ?", []entities.QuoteStatus{entities.QuoteStatusQuoted, entities.QuoteStatusAccepted}).
+		Where("expires_at IS NOT NULL AND expires_at < ?", now).
+		Order("expires_at ASC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Find(&quotes).Error
+	return quotes, err
+}
@@ -463,7 +463,7 @@ func (p ProductQueryResult) ToProductListItem() entities.ProductListItem {
 		IsOnSale:               p.IsOnSale,
 		SaleDiscountPercentage: p.SaleDiscountPercentage,
 		MainImage:              p.MainImage,
-		Stock:                  p.Stock,
+		Stock:                  &p.Stock,
 		StockStatus:            p.StockStatus,
 		IsAvailable:            p.IsAvailable,
 		RatingAverage:          p.RatingAverage,
@@ -529,7 +529,7 @@ func (r *recommendationRepository) GenerateSimilarProducts(ctx context.Context,
 				CurrentPrice:           sim.Similar.Price, // TODO: Calculate current price
 				IsOnSale:               false,             // TODO: Calculate sale status
 				SaleDiscountPercentage: 0,                 // TODO: Calculate discount
-				Stock:                  sim.Similar.Stock,
+				Stock:                  &sim.Similar.Stock,
 				StockStatus:            string(sim.Similar.StockStatus),
 				IsAvailable:            sim.Similar.Stock > 0 || sim.Similar.AllowBackorder,
 			}
@@ -556,7 +556,7 @@ func (r *recommendationRepository) GenerateFrequentlyBoughtTogether(ctx context.
 				CurrentPrice:           fbt.With.Price, // TODO: Calculate current price
 				IsOnSale:               false,          // TODO: Calculate sale status
 				SaleDiscountPercentage: 0,              // TODO: Calculate discount
-				Stock:                  fbt.With.Stock,
+				Stock:                  &fbt.With.Stock,
 				StockStatus:            string(fbt.With.StockStatus),
 				IsAvailable:            fbt.With.Stock > 0 || fbt.With.AllowBackorder,
 			}
@@ -607,6 +607,17 @@ func (r *recommendationRepository) GenerateFrequentlyBoughtTogether(ctx context.
 
 // GeneratePersonalizedRecommendations generates personalized recommendations for a user
 func (r *recommendationRepository) GeneratePersonalizedRecommendations(ctx context.Context, userID uuid.UUID, limit int) ([]entities.ProductListItem, error) {
+	return r.generateAffinityRecommendations(ctx, "user_id", userID, limit)
+}
+
+// GenerateSessionRecommendations generates personalized recommendations for an anonymous visitor session
+func (r *recommendationRepository) GenerateSessionRecommendations(ctx context.Context, sessionID string, limit int) ([]entities.ProductListItem, error) {
+	return r.generateAffinityRecommendations(ctx, "session_id", sessionID, limit)
+}
+
+// generateAffinityRecommendations recommends products from the categories and brands the owner interacted with.
+// ownerColumn is user_id or session_id.
+func (r *recommendationRepository) generateAffinityRecommendations(ctx context.Context, ownerColumn string, owner interface{}, limit int) ([]entities.ProductListItem, error) {
 	var queryResults []ProductQueryResult
 
 	query := `
@@ -617,7 +628,7 @@ func (r *recommendationRepository) GeneratePersonalizedRecommendations(ctx conte
 				SUM(upi.value) as affinity_score
 			FROM user_product_interactions upi
 			JOIN products p ON upi.product_id = p.id
-			WHERE upi.user_id = $1
+			WHERE upi.` + ownerColumn + ` = $1
 			GROUP BY p.category_id, p.brand_id
 		),
 		recommended_products AS (
@@ -639,7 +650,7 @@ func (r *recommendationRepository) GeneratePersonalizedRecommendations(ctx conte
 			WHERE p.status = 'active'
 				AND p.id NOT IN (
 					SELECT product_id FROM user_product_interactions
-					WHERE user_id = $1 AND interaction_type IN ('purchase', 'view')
+					WHERE ` + ownerColumn + ` = $1 AND interaction_type IN ('purchase', 'view')
 				)
 			GROUP BY p.id, p.name, p.slug, p.price, p.sale_price, p.stock, p.stock_status, p.allow_backorder, up.affinity_score
 		)
@@ -649,7 +660,7 @@ func (r *recommendationRepository) GeneratePersonalizedRecommendations(ctx conte
 		LIMIT $2
 	`
 
-	err := r.db.WithContext(ctx).Raw(query, userID, limit).Scan(&queryResults).Error
+	err := r.db.WithContext(ctx).Raw(query, owner, limit).Scan(&queryResults).Error
 	if err != nil {
 		return nil, err
 	}
@@ -678,7 +689,7 @@ func (r *recommendationRepository) GenerateTrendingRecommendations(ctx context.C
 				CurrentPrice:           t.Product.Price, // TODO: Calculate current price
 				IsOnSale:               false,           // TODO: Calculate sale status
 				SaleDiscountPercentage: 0,               // TODO: Calculate discount
-				Stock:                  t.Product.Stock,
+				Stock:                  &t.Product.Stock,
 				StockStatus:            string(t.Product.StockStatus),
 				IsAvailable:            t.Product.Stock > 0 || t.Product.AllowBackorder,
 			}
@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reportTimestamp formats a timestamp column as UTC ISO 8601 in report queries
+const reportTimestamp = `to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`
+
+// reportMinorAmount formats an amount column in minor units as a decimal of its currency
+const reportMinorAmount = `round(%s::numeric / power(10, s.exponent)::numeric, s.exponent)`
+
+// reportRegionFilter limits rows to customers whose data region is in a list, unless the list is
+// NULL. It takes the list twice.
+const reportRegionFilter = `(?::text[] IS NULL OR u.data_region = ANY(?::text[]))`
+
+// reportQueries select the columns of each report type. They take the period start and end,
+// then the row limit, except the inventory query, which only takes the limit. The queries of
+// reportRegionQueries take the region list for reportRegionFilter before the limit.
+var reportQueries = map[entities.ReportType]string{
+	entities.ReportTypeSales: `SELECT o.order_number, ` + fmt.Sprintf(reportTimestamp, "o.created_at") + ` AS created_at,
+			o.status, o.payment_status, u.email AS customer_email,
+			o.subtotal::numeric(14,2) AS subtotal, o.tax_amount::numeric(14,2) AS tax,
+			o.shipping_amount::numeric(14,2) AS shipping, o.discount_amount::numeric(14,2) AS discount,
+			o.total::numeric(14,2) AS total, o.currency, o.user_id AS customer_id
+		FROM orders o
+		LEFT JOIN users u ON u.id = o.user_id
+		WHERE o.created_at >= ? AND o.created_at < ? AND ` + reportRegionFilter + `
+		ORDER BY o.created_at
+		LIMIT ?`,
+	entities.ReportTypeProducts: `SELECT oi.product_sku AS sku, MAX(oi.product_name) AS name,
+			SUM(oi.quantity) AS units_sold, SUM(oi.total)::numeric(14,2) AS revenue,
+			COUNT(DISTINCT oi.order_id) AS orders
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.created_at >= ? AND o.created_at < ?
+		GROUP BY oi.product_sku
+		ORDER BY revenue DESC
+		LIMIT ?`,
+	entities.ReportTypeUsers: `SELECT u.email, u.first_name, u.last_name, u.role, u.status,
+			` + fmt.Sprintf(reportTimestamp, "u.created_at") + ` AS registered_at, u.id AS user_id
+		FROM users u
+		WHERE u.created_at >= ? AND u.created_at < ? AND ` + reportRegionFilter + `
+		ORDER BY u.created_at
+		LIMIT ?`,
+	entities.ReportTypeInventory: `SELECT p.sku, p.name, w.code AS warehouse,
+			i.quantity_on_hand, i.quantity_reserved, i.quantity_available, i.reorder_level
+		FROM inventories i
+		JOIN products p ON p.id = i.product_id
+		LEFT JOIN warehouses w ON w.id = i.warehouse_id
+		ORDER BY p.sku
+		LIMIT ?`,
+	entities.ReportTypePayments: `SELECT pm.id::text AS payment_id, o.order_number, ` + fmt.Sprintf(reportTimestamp, "pm.created_at") + ` AS created_at,
+			pm.method, pm.gateway, pm.status, pm.amount::numeric(14,2) AS amount, pm.currency
+		FROM payments pm
+		LEFT JOIN orders o ON o.id = pm.order_id
+		LEFT JOIN users u ON u.id = o.user_id
+		WHERE pm.created_at >= ? AND pm.created_at < ? AND ` + reportRegionFilter + `
+		ORDER BY pm.created_at
+		LIMIT ?`,
+	entities.ReportTypeSettlements: `SELECT to_char(s.date, 'YYYY-MM-DD') AS date, s.gateway, s.currency,
+			s.charge_count, s.refund_count, s.payout_count,
+			` + fmt.Sprintf(reportMinorAmount, "s.charge_amount") + ` AS charges,
+			` + fmt.Sprintf(reportMinorAmount, "s.refund_amount") + ` AS refunds,
+			` + fmt.Sprintf(reportMinorAmount, "s.dispute_amount") + ` AS disputes,
+			` + fmt.Sprintf(reportMinorAmount, "s.adjustment_amount") + ` AS adjustments,
+			` + fmt.Sprintf(reportMinorAmount, "s.fee_amount") + ` AS fees,
+			` + fmt.Sprintf(reportMinorAmount, "s.net_amount") + ` AS net,
+			` + fmt.Sprintf(reportMinorAmount, "s.payout_amount") + ` AS payouts,
+			s.matched_count, s.mismatch_count, s.missing_count, s.status
+		FROM settlements s
+		WHERE s.date >= (? AT TIME ZONE 'UTC')::date AND s.date < (? AT TIME ZONE 'UTC')::date
+		ORDER BY s.date, s.gateway, s.currency
+		LIMIT ?`,
+}
+
+// reportRegionQueries are the report types whose rows are limited by data region
+var reportRegionQueries = map[entities.ReportType]bool{
+	entities.ReportTypeSales:    true,
+	entities.ReportTypeUsers:    true,
+	entities.ReportTypePayments: true,
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(db *gorm.DB) repositories.ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// Create creates a new report
+func (r *reportRepository) Create(ctx context.Context, report *entities.Report) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+// GetByID gets a report by ID
+func (r *reportRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Report, error) {
+	var report entities.Report
+	if err := r.db.WithContext(ctx).First(&report, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrReportNotFound
+		}
+		return nil, err
+	}
+	return &report, nil
+}
+
+// List lists reports with filters
+func (r *reportRepository) List(ctx context.Context, filters repositories.GeneratedReportFilters) ([]*entities.Report, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Report{})
+	if filters.Type != "" {
+		query = query.Where("type = ?", filters.Type)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.CreatedBy != nil {
+		query = query.Where("created_by = ?", *filters.CreatedBy)
+	}
+	if filters.DateFrom != nil {
+		query = query.Where("created_at >= ?", *filters.DateFrom)
+	}
+	if filters.DateTo != nil {
+		query = query.Where("created_at <= ?", *filters.DateTo)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var reports []*entities.Report
+	err := query.
+		Omit("content").
+		Order("created_at DESC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&reports).Error
+	return reports, total, err
+}
+
+// Delete deletes a report and its file
+func (r *reportRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Report{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrReportNotFound
+	}
+	return nil
+}
+
+// GetTable runs the report type's query. Every value is read as text, NULLs as empty strings.
+func (r *reportRepository) GetTable(ctx context.Context, reportType entities.ReportType, from, to time.Time, regions []string, limit int) (*entities.ReportTable, error) {
+	query, ok := reportQueries[reportType]
+	if !ok {
+		return nil, fmt.Errorf("unknown report type %q", reportType)
+	}
+
+	args := []interface{}{from, to, limit}
+	switch {
+	case reportType == entities.ReportTypeInventory:
+		args = []interface{}{limit}
+	case reportRegionQueries[reportType]:
+		// A nil pq.StringArray is sent as NULL, which lifts the filter
+		args = []interface{}{from, to, pq.StringArray(regions), pq.StringArray(regions), limit}
+	}
+
+	rows, err := r.db.WithContext(ctx).Raw(query, args...).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	table := &entities.ReportTable{Columns: columns}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(values))
+		for i, value := range values {
+			row[i] = value.String
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table, rows.Err()
+}
+
+// GetExportProfile gets an admin's export profile for a report type
+func (r *reportRepository) GetExportProfile(ctx context.Context, userID uuid.UUID, reportType entities.ReportType) (*entities.ExportProfile, error) {
+	var profile entities.ExportProfile
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND report_type = ?", userID, reportType).
+		First(&profile).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrExportProfileNotFound
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// ListExportProfiles lists an admin's export profiles by report type
+func (r *reportRepository) ListExportProfiles(ctx context.Context, userID uuid.UUID) ([]*entities.ExportProfile, error) {
+	var profiles []*entities.ExportProfile
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("report_type").
+		Find(&profiles).Error
+	return profiles, err
+}
+
+// SaveExportProfile upserts an export profile on the admin and report type
+func (r *reportRepository) SaveExportProfile(ctx context.Context, profile *entities.ExportProfile) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "report_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"columns", "locale", "delimiter", "encoding", "updated_at"}),
+		}).
+		Create(profile).Error
+}
+
+// DeleteExportProfile deletes an admin's export profile for a report type
+func (r *reportRepository) DeleteExportProfile(ctx context.Context, userID uuid.UUID, reportType entities.ReportType) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND report_type = ?", userID, reportType).
+		Delete(&entities.ExportProfile{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrExportProfileNotFound
+	}
+	return nil
+}
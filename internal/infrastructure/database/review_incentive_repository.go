@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reviewIncentiveRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewIncentiveRepository creates a new review incentive repository
+func NewReviewIncentiveRepository(db *gorm.DB) repositories.ReviewIncentiveRepository {
+	return &reviewIncentiveRepository{db: db}
+}
+
+// GetProgram gets the review incentive program
+func (r *reviewIncentiveRepository) GetProgram(ctx context.Context) (*entities.ReviewIncentiveProgram, error) {
+	var program entities.ReviewIncentiveProgram
+	err := r.db.WithContext(ctx).Order("created_at ASC").First(&program).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrReviewIncentiveProgramNotFound
+		}
+		return nil, err
+	}
+	return &program, nil
+}
+
+// SaveProgram creates or updates the review incentive program
+func (r *reviewIncentiveRepository) SaveProgram(ctx context.Context, program *entities.ReviewIncentiveProgram) error {
+	if program.ID == uuid.Nil {
+		return r.db.WithContext(ctx).Create(program).Error
+	}
+	return r.db.WithContext(ctx).Save(program).Error
+}
+
+// HasReward checks if a customer was already rewarded for reviewing a product
+func (r *reviewIncentiveRepository) HasReward(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.ReviewIncentiveReward{}).
+		Where("user_id = ? AND product_id = ?", userID, productID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CountUserRewardsSince counts the rewards a customer received since the given time
+func (r *reviewIncentiveRepository) CountUserRewardsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.ReviewIncentiveReward{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// Grant records a reward together with the coupon or loyalty points it grants
+func (r *reviewIncentiveRepository) Grant(ctx context.Context, reward *entities.ReviewIncentiveReward, coupon *entities.Coupon) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if coupon != nil {
+			if err := tx.Omit(clause.Associations).Create(coupon).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("INSERT INTO coupon_users (coupon_id, user_id) VALUES (?, ?)", coupon.ID, reward.UserID).Error; err != nil {
+				return err
+			}
+			reward.CouponID = &coupon.ID
+			reward.CouponCode = coupon.Code
+		}
+
+		// The unique indexes on review and user/product make a concurrent second grant fail here
+		if err := tx.Omit("User").Create(reward).Error; err != nil {
+			return err
+		}
+
+		if reward.Points > 0 {
+			if err := tx.Model(&entities.User{}).
+				Where("id = ?", reward.UserID).
+				Update("loyalty_points", gorm.Expr("loyalty_points + ?", reward.Points)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListRewards lists granted rewards, newest first
+func (r *reviewIncentiveRepository) ListRewards(ctx context.Context, limit, offset int) ([]*entities.ReviewIncentiveReward, int64, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&entities.ReviewIncentiveReward{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rewards []*entities.ReviewIncentiveReward
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&rewards).Error
+	return rewards, total, err
+}
+
+// CountReviewsCreated counts reviews written in a period, whatever their status
+func (r *reviewIncentiveRepository) CountReviewsCreated(ctx context.Context, from, to time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Review{}).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Count(&count).Error
+	return count, err
+}
+
+// GetRewardStats summarizes the rewards granted in a period
+func (r *reviewIncentiveRepository) GetRewardStats(ctx context.Context, from, to time.Time) (*repositories.ReviewIncentiveRewardStats, error) {
+	var stats repositories.ReviewIncentiveRewardStats
+	err := r.db.WithContext(ctx).
+		Table("review_incentive_rewards AS r").
+		Select(`
+			COUNT(*) as rewards,
+			COALESCE(SUM(r.points), 0) as points_granted,
+			COUNT(r.coupon_id) as coupons_issued,
+			COUNT(c.id) FILTER (WHERE c.used_count > 0) as coupons_redeemed
+		`).
+		Joins("LEFT JOIN coupons c ON c.id = r.coupon_id").
+		Where("r.created_at >= ? AND r.created_at < ?", from, to).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
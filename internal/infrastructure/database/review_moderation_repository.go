@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type reviewModerationRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewModerationRepository creates a new review moderation repository
+func NewReviewModerationRepository(db *gorm.DB) repositories.ReviewModerationRepository {
+	return &reviewModerationRepository{db: db}
+}
+
+// GetSettings gets the review moderation settings
+func (r *reviewModerationRepository) GetSettings(ctx context.Context) (*entities.ReviewModerationSettings, error) {
+	var settings entities.ReviewModerationSettings
+	err := r.db.WithContext(ctx).Order("created_at ASC").First(&settings).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrReviewModerationSettingsNotFound
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveSettings creates or updates the review moderation settings
+func (r *reviewModerationRepository) SaveSettings(ctx context.Context, settings *entities.ReviewModerationSettings) error {
+	if settings.ID == uuid.Nil {
+		return r.db.WithContext(ctx).Create(settings).Error
+	}
+	return r.db.WithContext(ctx).Save(settings).Error
+}
+
+// CountUserReviewsSince counts the reviews a customer wrote since the given time
+func (r *reviewModerationRepository) CountUserReviewsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Review{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// GetReviewerStats gets a customer's account age, delivered orders and review history
+func (r *reviewModerationRepository) GetReviewerStats(ctx context.Context, userID uuid.UUID) (*entities.ReviewerStats, error) {
+	var user entities.User
+	if err := r.db.WithContext(ctx).Select("id", "created_at").First(&user, "id = ?", userID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrUserNotFound
+		}
+		return nil, err
+	}
+	stats := &entities.ReviewerStats{AccountCreatedAt: user.CreatedAt}
+
+	if err := r.db.WithContext(ctx).
+		Model(&entities.Order{}).
+		Where("user_id = ? AND status = ?", userID, entities.OrderStatusDelivered).
+		Count(&stats.DeliveredOrders).Error; err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		Status entities.ReviewStatus
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&entities.Review{}).
+		Select("status, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Group("status").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		switch c.Status {
+		case entities.ReviewStatusApproved:
+			stats.ApprovedReviews = c.Count
+		case entities.ReviewStatusHidden, entities.ReviewStatusRejected:
+			stats.RemovedReviews += c.Count
+		}
+	}
+
+	return stats, nil
+}
+
+// ListReviewsSince lists the most recent reviews written since the given time, newest first
+func (r *reviewModerationRepository) ListReviewsSince(ctx context.Context, since time.Time, limit int) ([]*entities.Review, error) {
+	var reviews []*entities.Review
+	err := r.db.WithContext(ctx).
+		Where("created_at >= ?", since).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&reviews).Error
+	return reviews, err
+}
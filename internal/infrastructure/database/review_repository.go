@@ -109,6 +109,10 @@ func (r *reviewRepository) Search(ctx context.Context, filter entities.ReviewFil
 		Preload("Product").
 		Preload("Images")
 
+	if filter.After != nil {
+		query = r.applyCursor(query, filter)
+		filter.Offset = 0
+	}
 	query = r.applyFilters(query, filter)
 
 	err := query.Find(&reviews).Error
@@ -119,6 +123,10 @@ func (r *reviewRepository) Search(ctx context.Context, filter entities.ReviewFil
 func (r *reviewRepository) Count(ctx context.Context, filter entities.ReviewFilter) (int64, error) {
 	var count int64
 
+	// Count every matching review, not just the requested page
+	filter.Limit = 0
+	filter.Offset = 0
+
 	query := r.db.WithContext(ctx).Model(&entities.Review{})
 	query = r.applyFilters(query, filter)
 
@@ -484,6 +492,40 @@ func (r *reviewRepository) GetReviewStats(ctx context.Context, productID uuid.UU
 	}, nil
 }
 
+// GetDimensionRatings gets the average per-dimension ratings of a product's approved reviews
+func (r *reviewRepository) GetDimensionRatings(ctx context.Context, productID uuid.UUID) ([]entities.ReviewDimensionRating, error) {
+	var stats struct {
+		QualityAverage  float64
+		QualityCount    int
+		ValueAverage    float64
+		ValueCount      int
+		ShippingAverage float64
+		ShippingCount   int
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&entities.Review{}).
+		Select(`
+			COALESCE(AVG(quality_rating), 0) as quality_average,
+			COUNT(quality_rating) as quality_count,
+			COALESCE(AVG(value_rating), 0) as value_average,
+			COUNT(value_rating) as value_count,
+			COALESCE(AVG(shipping_rating), 0) as shipping_average,
+			COUNT(shipping_rating) as shipping_count
+		`).
+		Where("product_id = ? AND status = ?", productID, entities.ReviewStatusApproved).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return []entities.ReviewDimensionRating{
+		{Dimension: entities.ReviewDimensionQuality, AverageRating: stats.QualityAverage, RatingCount: stats.QualityCount},
+		{Dimension: entities.ReviewDimensionValue, AverageRating: stats.ValueAverage, RatingCount: stats.ValueCount},
+		{Dimension: entities.ReviewDimensionShipping, AverageRating: stats.ShippingAverage, RatingCount: stats.ShippingCount},
+	}, nil
+}
+
 // GetByProductIDsWithUser retrieves reviews for multiple products with user data (bulk operation)
 func (r *reviewRepository) GetByProductIDsWithUser(ctx context.Context, productIDs []uuid.UUID, limit int) ([]*entities.Review, error) {
 	if len(productIDs) == 0 {
@@ -556,6 +598,10 @@ func (r *reviewRepository) applyFilters(query *gorm.DB, filter entities.ReviewFi
 		query = query.Where("rating = ?", *filter.Rating)
 	}
 
+	if len(filter.Ratings) > 0 {
+		query = query.Where("rating IN ?", filter.Ratings)
+	}
+
 	if filter.Status != nil {
 		query = query.Where("status = ?", *filter.Status)
 	}
@@ -579,18 +625,19 @@ func (r *reviewRepository) applyFilters(query *gorm.DB, filter entities.ReviewFi
 			order = "ASC"
 		}
 
+		// Ties are broken by newest first, then ID, so that pages and cursors are stable
 		switch filter.SortBy {
 		case "rating":
-			query = query.Order(fmt.Sprintf("rating %s", order))
+			query = query.Order(fmt.Sprintf("rating %s, created_at DESC, id DESC", order))
 		case "helpful_count":
-			query = query.Order(fmt.Sprintf("helpful_count %s", order))
+			query = query.Order(fmt.Sprintf("helpful_count %s, created_at DESC, id DESC", order))
 		case "created_at":
-			query = query.Order(fmt.Sprintf("created_at %s", order))
+			query = query.Order(fmt.Sprintf("created_at %s, id %s", order, order))
 		default:
-			query = query.Order("created_at DESC")
+			query = query.Order("created_at DESC, id DESC")
 		}
 	} else {
-		query = query.Order("created_at DESC")
+		query = query.Order("created_at DESC, id DESC")
 	}
 
 	// Apply pagination
@@ -604,3 +651,31 @@ func (r *reviewRepository) applyFilters(query *gorm.DB, filter entities.ReviewFi
 
 	return query
 }
+
+// applyCursor keeps the reviews that come after filter.After in the order applyFilters sorts by
+func (r *reviewRepository) applyCursor(query *gorm.DB, filter entities.ReviewFilter) *gorm.DB {
+	after := filter.After
+
+	op := "<"
+	if filter.SortOrder == "asc" {
+		op = ">"
+	}
+
+	switch filter.SortBy {
+	case "rating", "helpful_count":
+		return query.Where(
+			fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND (created_at < ? OR (created_at = ? AND id < ?))))", filter.SortBy, op),
+			after.SortValue, after.SortValue, after.CreatedAt, after.CreatedAt, after.ID,
+		)
+	case "created_at":
+		return query.Where(
+			fmt.Sprintf("(created_at %[1]s ? OR (created_at = ? AND id %[1]s ?))", op),
+			after.CreatedAt, after.CreatedAt, after.ID,
+		)
+	default:
+		return query.Where(
+			"(created_at < ? OR (created_at = ? AND id < ?))",
+			after.CreatedAt, after.CreatedAt, after.ID,
+		)
+	}
+}
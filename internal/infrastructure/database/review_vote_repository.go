@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -343,7 +344,7 @@ func (r *reviewVoteRepository) VoteReview(ctx context.Context, reviewID, userID
 	if err == gorm.ErrRecordNotFound {
 		// Create new vote
 		vote := &entities.ReviewVote{
-			ID:       uuid.New(),
+			ID:       ids.New(),
 			UserID:   userID,
 			ReviewID: reviewID,
 			VoteType: voteType,
@@ -307,9 +307,7 @@ func (r *searchRepository) FullTextSearch(ctx context.Context, params repositori
 	if params.ProductType != nil {
 		query = query.Where("product_type = ?", *params.ProductType)
 	}
-	if params.Status != nil {
-		query = query.Where("status = ?", *params.Status)
-	}
+	query = applyProductStatusFilter(query, params.Status)
 	if params.AvailabilityStatus != nil {
 		switch *params.AvailabilityStatus {
 		case "in_stock":
@@ -317,7 +315,7 @@ func (r *searchRepository) FullTextSearch(ctx context.Context, params repositori
 		case "out_of_stock":
 			query = query.Where("stock = 0")
 		case "low_stock":
-			query = query.Where("stock > 0 AND stock <= low_stock_threshold")
+			query = query.Where(publicLowStockCondition)
 		}
 	}
 	if params.CreatedAfter != nil {
@@ -363,6 +361,11 @@ func (r *searchRepository) FullTextSearch(ctx context.Context, params repositori
 		query = query.Where("track_quantity = ?", *params.TrackQuantity)
 	}
 
+	// Catalog visibility filter
+	if len(params.ExcludeIDs) > 0 {
+		query = query.Where("products.id NOT IN ?", params.ExcludeIDs)
+	}
+
 	// Tags filter
 	if len(params.Tags) > 0 {
 		query = query.Joins("JOIN product_tag_associations pta ON products.id = pta.product_id").
@@ -429,6 +432,9 @@ func (r *searchRepository) FullTextSearch(ctx context.Context, params repositori
 			countQuery = countQuery.Where("sale_price IS NULL OR sale_price = 0")
 		}
 	}
+	if len(params.ExcludeIDs) > 0 {
+		countQuery = countQuery.Where("products.id NOT IN ?", params.ExcludeIDs)
+	}
 	if len(params.Tags) > 0 {
 		countQuery = countQuery.Joins("JOIN product_tag_associations pta ON products.id = pta.product_id").
 			Joins("JOIN tags t ON pta.product_tag_id = t.id").
@@ -701,6 +707,8 @@ func (r *searchRepository) GetSearchFacets(ctx context.Context, query string) (*
 				WHEN status = 'active' THEN 'Active'
 				WHEN status = 'inactive' THEN 'Inactive'
 				WHEN status = 'draft' THEN 'Draft'
+				WHEN status = 'discontinued' THEN 'Discontinued'
+				WHEN status = 'archived' THEN 'Archived'
 				ELSE status
 			END as label,
 			COUNT(*) as product_count
@@ -1591,6 +1599,8 @@ func (r *searchRepository) getDynamicStatusFacets(ctx context.Context, params re
 				WHEN status = 'active' THEN 'Active'
 				WHEN status = 'inactive' THEN 'Inactive'
 				WHEN status = 'draft' THEN 'Draft'
+				WHEN status = 'discontinued' THEN 'Discontinued'
+				WHEN status = 'archived' THEN 'Archived'
 				ELSE status
 			END as label,
 			COUNT(*) as product_count
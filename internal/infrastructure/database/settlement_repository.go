@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type settlementRepository struct {
+	db *gorm.DB
+}
+
+// NewSettlementRepository creates a new settlement repository
+func NewSettlementRepository(db *gorm.DB) repositories.SettlementRepository {
+	return &settlementRepository{db: db}
+}
+
+// ReplaceDay replaces a gateway's settlements for a day with their items
+func (r *settlementRepository) ReplaceDay(ctx context.Context, gateway string, date time.Time, settlements []*entities.Settlement) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Items go with their settlement through the foreign key's ON DELETE CASCADE
+		if err := tx.Where("gateway = ? AND date = ?", gateway, date.Format("2006-01-02")).
+			Delete(&entities.Settlement{}).Error; err != nil {
+			return err
+		}
+
+		for _, settlement := range settlements {
+			items := settlement.Items
+			settlement.Items = nil
+			if err := tx.Create(settlement).Error; err != nil {
+				return err
+			}
+
+			for i := range items {
+				items[i].SettlementID = settlement.ID
+			}
+			if len(items) > 0 {
+				if err := tx.CreateInBatches(items, 500).Error; err != nil {
+					return err
+				}
+			}
+			settlement.Items = items
+		}
+		return nil
+	})
+}
+
+// GetByID gets a settlement by ID
+func (r *settlementRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Settlement, error) {
+	var settlement entities.Settlement
+	if err := r.db.WithContext(ctx).First(&settlement, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrSettlementNotFound
+		}
+		return nil, err
+	}
+	return &settlement, nil
+}
+
+// List lists settlements with filters
+func (r *settlementRepository) List(ctx context.Context, filters repositories.SettlementFilters) ([]*entities.Settlement, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Settlement{})
+	if filters.Gateway != "" {
+		query = query.Where("gateway = ?", filters.Gateway)
+	}
+	if filters.Currency != "" {
+		query = query.Where("currency = ?", filters.Currency)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.DateFrom != nil {
+		query = query.Where("date >= ?", filters.DateFrom.Format("2006-01-02"))
+	}
+	if filters.DateTo != nil {
+		query = query.Where("date <= ?", filters.DateTo.Format("2006-01-02"))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var settlements []*entities.Settlement
+	err := query.
+		Order("date DESC, gateway, currency").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&settlements).Error
+	return settlements, total, err
+}
+
+// GetItems lists the items of a settlement with filters
+func (r *settlementRepository) GetItems(ctx context.Context, settlementID uuid.UUID, filters repositories.SettlementItemFilters) ([]*entities.SettlementItem, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.SettlementItem{}).
+		Where("settlement_id = ?", settlementID)
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.DiscrepanciesOnly {
+		query = query.Where("status NOT IN ?", []entities.SettlementItemStatus{
+			entities.SettlementItemStatusMatched,
+			entities.SettlementItemStatusUnmatched,
+		})
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*entities.SettlementItem
+	err := query.
+		Order("occurred_at ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&items).Error
+	return items, total, err
+}
@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -297,8 +298,12 @@ func (r *shippingRepository) DeleteShipment(ctx context.Context, id uuid.UUID) e
 // CreateReturn creates a return request
 func (r *shippingRepository) CreateReturn(ctx context.Context, returnRequest *entities.Return) error {
 	// Set return-specific properties
-	returnRequest.ID = uuid.New()
-	returnRequest.Status = entities.ReturnStatusRequested
+	if returnRequest.ID == uuid.Nil {
+		returnRequest.ID = ids.New()
+	}
+	if returnRequest.Status == "" {
+		returnRequest.Status = entities.ReturnStatusRequested
+	}
 	returnRequest.CreatedAt = time.Now()
 	returnRequest.UpdatedAt = time.Now()
 
@@ -339,3 +344,14 @@ func (r *shippingRepository) UpdateReturn(ctx context.Context, returnRequest *en
 	returnRequest.UpdatedAt = time.Now()
 	return r.db.WithContext(ctx).Save(returnRequest).Error
 }
+
+// GetReturnsByOrder gets an order's returns with their items
+func (r *shippingRepository) GetReturnsByOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.Return, error) {
+	var returns []*entities.Return
+	err := r.db.WithContext(ctx).
+		Preload("Items").
+		Where("order_id = ?", orderID).
+		Order("created_at DESC").
+		Find(&returns).Error
+	return returns, err
+}
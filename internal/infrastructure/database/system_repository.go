@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type systemRepository struct {
+	db *gorm.DB
+}
+
+// NewSystemRepository creates a new system repository
+func NewSystemRepository(db *gorm.DB) repositories.SystemRepository {
+	return &systemRepository{db: db}
+}
+
+// GetDatabaseStats reads the size and activity of the current database from the Postgres
+// statistics views
+func (r *systemRepository) GetDatabaseStats(ctx context.Context) (*repositories.DatabaseStats, error) {
+	var row struct {
+		SizeBytes        int64
+		TableCount       int
+		ConnectionCount  int
+		TransactionCount int64
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			pg_database_size(current_database()) AS size_bytes,
+			(SELECT COUNT(*) FROM information_schema.tables
+				WHERE table_schema = current_schema() AND table_type = 'BASE TABLE') AS table_count,
+			(SELECT COUNT(*) FROM pg_stat_activity WHERE datname = current_database()) AS connection_count,
+			(SELECT COALESCE(xact_commit + xact_rollback, 0) FROM pg_stat_database
+				WHERE datname = current_database()) AS transaction_count
+	`).Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &repositories.DatabaseStats{
+		SizeBytes:        row.SizeBytes,
+		TableCount:       row.TableCount,
+		ConnectionCount:  row.ConnectionCount,
+		TransactionCount: row.TransactionCount,
+	}
+	if sqlDB, err := r.db.DB(); err == nil {
+		pool := sqlDB.Stats()
+		stats.PoolOpen = pool.OpenConnections
+		stats.PoolInUse = pool.InUse
+	}
+	return stats, nil
+}
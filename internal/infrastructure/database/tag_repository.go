@@ -7,6 +7,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -102,7 +103,7 @@ func (r *tagRepository) FindOrCreate(ctx context.Context, name string) (*entitie
 		slug := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "-"))
 		
 		newTag := &entities.ProductTag{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			Name:      strings.TrimSpace(name),
 			Slug:      slug,
 			CreatedAt: time.Now(),
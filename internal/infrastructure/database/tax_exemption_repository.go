@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type taxExemptionRepository struct {
+	db *gorm.DB
+}
+
+// NewTaxExemptionRepository creates a new tax exemption repository
+func NewTaxExemptionRepository(db *gorm.DB) repositories.TaxExemptionRepository {
+	return &taxExemptionRepository{db: db}
+}
+
+// Create saves a new certificate
+func (r *taxExemptionRepository) Create(ctx context.Context, certificate *entities.TaxExemptionCertificate) error {
+	return r.db.WithContext(ctx).Create(certificate).Error
+}
+
+// GetByID gets a certificate
+func (r *taxExemptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.TaxExemptionCertificate, error) {
+	var certificate entities.TaxExemptionCertificate
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&certificate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrTaxExemptionNotFound
+		}
+		return nil, err
+	}
+	return &certificate, nil
+}
+
+// Update updates a certificate
+func (r *taxExemptionRepository) Update(ctx context.Context, certificate *entities.TaxExemptionCertificate) error {
+	return r.db.WithContext(ctx).Save(certificate).Error
+}
+
+// Delete deletes a certificate
+func (r *taxExemptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.TaxExemptionCertificate{}).Error
+}
+
+// ListByUser lists a customer's certificates, newest first
+func (r *taxExemptionRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entities.TaxExemptionCertificate, error) {
+	var certificates []*entities.TaxExemptionCertificate
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&certificates).Error
+	return certificates, err
+}
+
+// List lists certificates for review, oldest first
+func (r *taxExemptionRepository) List(ctx context.Context, filters repositories.TaxExemptionFilters) ([]*entities.TaxExemptionCertificate, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.TaxExemptionCertificate{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var certificates []*entities.TaxExemptionCertificate
+	err := query.
+		Order("created_at ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&certificates).Error
+	return certificates, total, err
+}
+
+// ListActiveByUser lists a customer's verified certificates that have not expired
+func (r *taxExemptionRepository) ListActiveByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]*entities.TaxExemptionCertificate, error) {
+	var certificates []*entities.TaxExemptionCertificate
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, entities.TaxExemptionStatusVerified).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("reviewed_at ASC").
+		Find(&certificates).Error
+	return certificates, err
+}
+
+// ListDueForExpiryNotice lists verified certificates that have expired, and those expiring before
+// remindBefore whose customer was not reminded yet, soonest expiry first
+func (r *taxExemptionRepository) ListDueForExpiryNotice(ctx context.Context, now, remindBefore time.Time, limit int) ([]*entities.TaxExemptionCertificate, error) {
+	var certificates []*entities.TaxExemptionCertificate
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at IS NOT NULL", entities.TaxExemptionStatusVerified).
+		Where("expires_at <= ? OR (expires_at <= ? AND reminder_sent_at IS NULL)", now, remindBefore).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&certificates).Error
+	return certificates, err
+}
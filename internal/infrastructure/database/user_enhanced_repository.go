@@ -245,6 +245,28 @@ func (r *userActivityRepository) GetByUserIDAndType(ctx context.Context, userID
 	return activities, err
 }
 
+// GetByUserIDAndTypes retrieves activities for a user matching any of the given types
+func (r *userActivityRepository) GetByUserIDAndTypes(ctx context.Context, userID uuid.UUID, activityTypes []entities.ActivityType, limit, offset int) ([]*entities.UserActivity, error) {
+	var activities []*entities.UserActivity
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND type IN ?", userID, activityTypes).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&activities).Error
+	return activities, err
+}
+
+// CountByUserIDAndTypes counts activities for a user matching any of the given types
+func (r *userActivityRepository) CountByUserIDAndTypes(ctx context.Context, userID uuid.UUID, activityTypes []entities.ActivityType) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.UserActivity{}).
+		Where("user_id = ? AND type IN ?", userID, activityTypes).
+		Count(&count).Error
+	return count, err
+}
+
 // GetRecentActivity retrieves recent activities for a user
 func (r *userActivityRepository) GetRecentActivity(ctx context.Context, userID uuid.UUID, since time.Time) ([]*entities.UserActivity, error) {
 	var activities []*entities.UserActivity
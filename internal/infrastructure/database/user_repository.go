@@ -9,6 +9,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -152,6 +153,59 @@ func (r *userRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, h
 	return nil
 }
 
+// ListPasswordHashes returns the ID and password hash of users with a password
+func (r *userRepository) ListPasswordHashes(ctx context.Context, afterID uuid.UUID, limit int) ([]*entities.User, error) {
+	var users []*entities.User
+	err := r.db.WithContext(ctx).
+		Select("id", "password").
+		Where("id > ? AND password IS NOT NULL AND password <> ''", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// SetDataRegion sets the data region of a user
+func (r *userRepository) SetDataRegion(ctx context.Context, userID uuid.UUID, region string) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.User{}).
+		Where("id = ?", userID).
+		Update("data_region", region)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrUserNotFound
+	}
+	return nil
+}
+
+// SetDataRegionAccess sets the data regions a staff user may export
+func (r *userRepository) SetDataRegionAccess(ctx context.Context, userID uuid.UUID, regions []string) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.User{}).
+		Where("id = ?", userID).
+		Update("data_region_access", pq.StringArray(regions))
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrUserNotFound
+	}
+	return nil
+}
+
+// TagUntaggedDataRegion tags users created before data regions with region
+func (r *userRepository) TagUntaggedDataRegion(ctx context.Context, region string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.User{}).
+		Where("data_region IS NULL OR data_region = ''").
+		Update("data_region", region)
+	return result.RowsAffected, result.Error
+}
+
 // SetActive sets user active status
 func (r *userRepository) SetActive(ctx context.Context, userID uuid.UUID, isActive bool) error {
 	result := r.db.WithContext(ctx).
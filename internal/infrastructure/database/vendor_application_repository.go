@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type vendorApplicationRepository struct {
+	db *gorm.DB
+}
+
+// NewVendorApplicationRepository creates a new vendor application repository
+func NewVendorApplicationRepository(db *gorm.DB) repositories.VendorApplicationRepository {
+	return &vendorApplicationRepository{db: db}
+}
+
+// Create saves a new application together with its pending vendor
+func (r *vendorApplicationRepository) Create(ctx context.Context, application *entities.VendorApplication, vendor *entities.Vendor) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Create(vendor).Error; err != nil {
+			return err
+		}
+		return tx.Omit("Vendor", "Documents").Create(application).Error
+	})
+}
+
+// GetByID gets an application with its vendor and documents
+func (r *vendorApplicationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.VendorApplication, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetByUserID gets the application of an account with its vendor and documents
+func (r *vendorApplicationRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error) {
+	return r.getBy(ctx, "user_id = ?", userID)
+}
+
+func (r *vendorApplicationRepository) getBy(ctx context.Context, query string, value interface{}) (*entities.VendorApplication, error) {
+	var application entities.VendorApplication
+	err := r.db.WithContext(ctx).
+		Preload("Vendor").
+		Preload("Documents", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where(query, value).
+		First(&application).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrVendorApplicationNotFound
+		}
+		return nil, err
+	}
+	return &application, nil
+}
+
+// Update updates an application's details and status
+func (r *vendorApplicationRepository) Update(ctx context.Context, application *entities.VendorApplication) error {
+	return r.db.WithContext(ctx).Omit("Vendor", "Documents").Save(application).Error
+}
+
+// SaveReview saves a review decision and sets the vendor's status with it
+func (r *vendorApplicationRepository) SaveReview(ctx context.Context, application *entities.VendorApplication, vendorStatus entities.VendorStatus) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Vendor", "Documents").Save(application).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.Vendor{}).
+			Where("id = ?", application.VendorID).
+			Updates(map[string]interface{}{
+				"status":     vendorStatus,
+				"updated_at": time.Now(),
+			}).Error
+	})
+}
+
+// List lists applications with their vendors, oldest submission first
+func (r *vendorApplicationRepository) List(ctx context.Context, filters repositories.VendorApplicationFilters) ([]*entities.VendorApplication, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.VendorApplication{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var applications []*entities.VendorApplication
+	err := query.
+		Preload("Vendor").
+		Order("submitted_at ASC NULLS LAST, created_at ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&applications).Error
+	return applications, total, err
+}
+
+// AddDocument adds a document to an application
+func (r *vendorApplicationRepository) AddDocument(ctx context.Context, document *entities.VendorDocument) error {
+	return r.db.WithContext(ctx).Create(document).Error
+}
+
+// GetDocument gets a document of an application
+func (r *vendorApplicationRepository) GetDocument(ctx context.Context, applicationID, id uuid.UUID) (*entities.VendorDocument, error) {
+	var document entities.VendorDocument
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND application_id = ?", id, applicationID).
+		First(&document).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrVendorDocumentNotFound
+		}
+		return nil, err
+	}
+	return &document, nil
+}
+
+// DeleteDocument removes a document from its application
+func (r *vendorApplicationRepository) DeleteDocument(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.VendorDocument{}).Error
+}
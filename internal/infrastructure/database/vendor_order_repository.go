@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type vendorOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewVendorOrderRepository creates a new vendor order repository
+func NewVendorOrderRepository(db *gorm.DB) repositories.VendorOrderRepository {
+	return &vendorOrderRepository{db: db}
+}
+
+// CreateForOrder saves vendor orders with their fee lines
+func (r *vendorOrderRepository) CreateForOrder(ctx context.Context, vendorOrders []*entities.VendorOrder) error {
+	if len(vendorOrders) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, vendorOrder := range vendorOrders {
+			if err := tx.Omit("Refunds").Create(vendorOrder).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetByOrderID gets the vendor orders of an order
+func (r *vendorOrderRepository) GetByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error) {
+	var vendorOrders []*entities.VendorOrder
+	err := r.db.WithContext(ctx).
+		Preload("Fees").
+		Preload("Refunds").
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&vendorOrders).Error
+	return vendorOrders, err
+}
+
+// List lists vendor orders with filters
+func (r *vendorOrderRepository) List(ctx context.Context, filters repositories.VendorOrderFilters) ([]*entities.VendorOrder, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.VendorOrder{})
+	if filters.VendorID != nil {
+		query = query.Where("vendor_id = ?", *filters.VendorID)
+	}
+	if filters.DateFrom != nil {
+		query = query.Where("created_at >= ?", *filters.DateFrom)
+	}
+	if filters.DateTo != nil {
+		query = query.Where("created_at < ?", *filters.DateTo)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var vendorOrders []*entities.VendorOrder
+	err := query.
+		Preload("Fees").
+		Order("created_at DESC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&vendorOrders).Error
+	return vendorOrders, total, err
+}
+
+// AddRefunds records refund shares and adds them to their vendor orders
+func (r *vendorOrderRepository) AddRefunds(ctx context.Context, refunds []*entities.VendorOrderRefund) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, refund := range refunds {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(refund)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+
+			err := tx.Model(&entities.VendorOrder{}).
+				Where("id = ?", refund.VendorOrderID).
+				Updates(map[string]interface{}{
+					"refunded_amount":     gorm.Expr("refunded_amount + ?", refund.Amount),
+					"commission_refunded": gorm.Expr("commission_refunded + ?", refund.CommissionRefunded),
+					"updated_at":          time.Now(),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPaid lists a vendor's vendor orders of paid orders
+func (r *vendorOrderRepository) ListPaid(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]*entities.VendorOrder, error) {
+	var vendorOrders []*entities.VendorOrder
+	err := r.db.WithContext(ctx).
+		Joins("JOIN orders ON orders.id = vendor_orders.order_id").
+		Where("vendor_orders.vendor_id = ?", vendorID).
+		Where("vendor_orders.created_at >= ? AND vendor_orders.created_at < ?", from, to).
+		// Refunded orders were paid; their refunds are taken back separately
+		Where("orders.payment_status IN ?", []entities.PaymentStatus{
+			entities.PaymentStatusPaid,
+			entities.PaymentStatusRefunded,
+		}).
+		Order("vendor_orders.created_at ASC").
+		Find(&vendorOrders).Error
+	return vendorOrders, err
+}
+
+// ListRefunds lists a vendor's refund shares
+func (r *vendorOrderRepository) ListRefunds(ctx context.Context, vendorID uuid.UUID, from, to time.Time) ([]*entities.VendorOrderRefund, error) {
+	var refunds []*entities.VendorOrderRefund
+	err := r.db.WithContext(ctx).
+		Where("vendor_id = ?", vendorID).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Order("created_at ASC").
+		Find(&refunds).Error
+	return refunds, err
+}
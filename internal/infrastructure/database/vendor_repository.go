@@ -0,0 +1,210 @@
+package database
+
+import (
+	"context"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type vendorRepository struct {
+	db *gorm.DB
+}
+
+// NewVendorRepository creates a new vendor repository
+func NewVendorRepository(db *gorm.DB) repositories.VendorRepository {
+	return &vendorRepository{db: db}
+}
+
+// Create creates a vendor
+func (r *vendorRepository) Create(ctx context.Context, vendor *entities.Vendor) error {
+	return r.db.WithContext(ctx).Create(vendor).Error
+}
+
+// GetByID gets a vendor by ID
+func (r *vendorRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Vendor, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+// GetBySlug gets a vendor by slug
+func (r *vendorRepository) GetBySlug(ctx context.Context, slug string) (*entities.Vendor, error) {
+	return r.getBy(ctx, "slug = ?", slug)
+}
+
+// GetByUserID gets the vendor an account signs in for
+func (r *vendorRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entities.Vendor, error) {
+	return r.getBy(ctx, "user_id = ?", userID)
+}
+
+func (r *vendorRepository) getBy(ctx context.Context, query string, value interface{}) (*entities.Vendor, error) {
+	var vendor entities.Vendor
+	if err := r.db.WithContext(ctx).Where(query, value).First(&vendor).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrVendorNotFound
+		}
+		return nil, err
+	}
+	return &vendor, nil
+}
+
+// Update updates a vendor
+func (r *vendorRepository) Update(ctx context.Context, vendor *entities.Vendor) error {
+	return r.db.WithContext(ctx).Omit("User").Save(vendor).Error
+}
+
+// List lists vendors with filters
+func (r *vendorRepository) List(ctx context.Context, filters repositories.VendorFilters) ([]*entities.Vendor, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.Vendor{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.Search != "" {
+		search := "%" + filters.Search + "%"
+		query = query.Where("name ILIKE ? OR slug ILIKE ? OR email ILIKE ?", search, search, search)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var vendors []*entities.Vendor
+	err := query.
+		Order("name ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&vendors).Error
+	return vendors, total, err
+}
+
+// AssignProducts sets the vendor of products
+func (r *vendorRepository) AssignProducts(ctx context.Context, vendorID *uuid.UUID, productIDs []uuid.UUID) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Product{}).
+		Where("id IN ?", productIDs).
+		Update("vendor_id", vendorID)
+	return result.RowsAffected, result.Error
+}
+
+// GetVendorIDsByProductIDs maps products to their vendor
+func (r *vendorRepository) GetVendorIDsByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	var rows []struct {
+		ID       uuid.UUID
+		VendorID uuid.UUID
+	}
+	err := r.db.WithContext(ctx).Model(&entities.Product{}).
+		Select("id, vendor_id").
+		Where("id IN ? AND vendor_id IS NOT NULL", productIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	vendorIDs := make(map[uuid.UUID]uuid.UUID, len(rows))
+	for _, row := range rows {
+		vendorIDs[row.ID] = row.VendorID
+	}
+	return vendorIDs, nil
+}
+
+// CountPublishedProducts counts the active products among productIDs
+func (r *vendorRepository) CountPublishedProducts(ctx context.Context, productIDs []uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.Product{}).
+		Where("id IN ? AND status = ?", productIDs, entities.ProductStatusActive).
+		Count(&count).Error
+	return count, err
+}
+
+// ListCategoryCommissions lists the commission of every category that has one
+func (r *vendorRepository) ListCategoryCommissions(ctx context.Context) ([]*entities.CategoryCommission, error) {
+	var commissions []*entities.CategoryCommission
+	err := r.db.WithContext(ctx).
+		Preload("Category").
+		Order("created_at ASC").
+		Find(&commissions).Error
+	return commissions, err
+}
+
+// SaveCategoryCommission creates or replaces the commission of a category
+func (r *vendorRepository) SaveCategoryCommission(ctx context.Context, commission *entities.CategoryCommission) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing entities.CategoryCommission
+		err := tx.Where("category_id = ?", commission.CategoryID).First(&existing).Error
+		if err == gorm.ErrRecordNotFound {
+			return tx.Omit("Category").Create(commission).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		commission.ID = existing.ID
+		commission.CreatedAt = existing.CreatedAt
+		return tx.Omit("Category").Save(commission).Error
+	})
+}
+
+// DeleteCategoryCommission removes the commission of a category
+func (r *vendorRepository) DeleteCategoryCommission(ctx context.Context, categoryID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("category_id = ?", categoryID).Delete(&entities.CategoryCommission{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// ListVendorCommissions lists a vendor's commission overrides, the one for all categories first
+func (r *vendorRepository) ListVendorCommissions(ctx context.Context, vendorID uuid.UUID) ([]*entities.VendorCommission, error) {
+	var commissions []*entities.VendorCommission
+	err := r.db.WithContext(ctx).
+		Preload("Category").
+		Where("vendor_id = ?", vendorID).
+		Order("category_id NULLS FIRST, created_at ASC").
+		Find(&commissions).Error
+	return commissions, err
+}
+
+// SaveVendorCommission creates or replaces a vendor's commission override
+func (r *vendorRepository) SaveVendorCommission(ctx context.Context, commission *entities.VendorCommission) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique index does not cover the override for all categories, whose category is NULL
+		query := tx.Where("vendor_id = ?", commission.VendorID)
+		if commission.CategoryID != nil {
+			query = query.Where("category_id = ?", *commission.CategoryID)
+		} else {
+			query = query.Where("category_id IS NULL")
+		}
+
+		var existing entities.VendorCommission
+		err := query.First(&existing).Error
+		if err == gorm.ErrRecordNotFound {
+			return tx.Omit("Category").Create(commission).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		commission.ID = existing.ID
+		commission.CreatedAt = existing.CreatedAt
+		return tx.Omit("Category").Save(commission).Error
+	})
+}
+
+// DeleteVendorCommission removes one of a vendor's commission overrides
+func (r *vendorRepository) DeleteVendorCommission(ctx context.Context, vendorID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND vendor_id = ?", id, vendorID).
+		Delete(&entities.VendorCommission{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entities.ErrVendorCommissionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type waitlistRepository struct {
+	db *gorm.DB
+}
+
+// NewWaitlistRepository creates a new product waitlist repository
+func NewWaitlistRepository(db *gorm.DB) repositories.WaitlistRepository {
+	return &waitlistRepository{db: db}
+}
+
+// Create saves a new waitlist
+func (r *waitlistRepository) Create(ctx context.Context, waitlist *entities.ProductWaitlist) error {
+	return r.db.WithContext(ctx).Create(waitlist).Error
+}
+
+// GetByID gets a waitlist
+func (r *waitlistRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.ProductWaitlist, error) {
+	var waitlist entities.ProductWaitlist
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&waitlist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrWaitlistNotFound
+		}
+		return nil, err
+	}
+	return &waitlist, nil
+}
+
+// GetByProduct gets a product's waitlist
+func (r *waitlistRepository) GetByProduct(ctx context.Context, productID uuid.UUID) (*entities.ProductWaitlist, error) {
+	var waitlist entities.ProductWaitlist
+	if err := r.db.WithContext(ctx).Where("product_id = ?", productID).First(&waitlist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entities.ErrWaitlistNotFound
+		}
+		return nil, err
+	}
+	return &waitlist, nil
+}
+
+// Update updates a waitlist
+func (r *waitlistRepository) Update(ctx context.Context, waitlist *entities.ProductWaitlist) error {
+	return r.db.WithContext(ctx).Save(waitlist).Error
+}
+
+// List lists waitlists, soonest launch first
+func (r *waitlistRepository) List(ctx context.Context, filters repositories.WaitlistFilters) ([]*entities.ProductWaitlist, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.ProductWaitlist{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var waitlists []*entities.ProductWaitlist
+	err := query.
+		Order("launch_at ASC").
+		Limit(filters.Limit).
+		Offset(filters.Offset).
+		Find(&waitlists).Error
+	return waitlists, total, err
+}
+
+// GetDueForWave returns active waitlists that have opened and whose next wave is due
+func (r *waitlistRepository) GetDueForWave(ctx context.Context, now time.Time, limit int) ([]*entities.ProductWaitlist, error) {
+	var waitlists []*entities.ProductWaitlist
+	err := r.db.WithContext(ctx).
+		Where("status IN ?", []entities.WaitlistStatus{entities.WaitlistStatusOpen, entities.WaitlistStatusLaunching}).
+		Where("opens_at <= ?", now).
+		Where("next_wave_at IS NULL OR next_wave_at <= ?", now).
+		Order("opens_at ASC").
+		Limit(limit).
+		Find(&waitlists).Error
+	return waitlists, err
+}
+
+// Join adds a customer to a waitlist
+func (r *waitlistRepository) Join(ctx context.Context, entry *entities.WaitlistEntry) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "waitlist_id"}, {Name: "user_id"}},
+			DoNothing: true,
+		}).
+		Create(entry).Error
+}
+
+// Leave removes a customer from a waitlist
+func (r *waitlistRepository) Leave(ctx context.Context, waitlistID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("waitlist_id = ? AND user_id = ?", waitlistID, userID).
+		Delete(&entities.WaitlistEntry{}).Error
+}
+
+// GetEntry gets a customer's place on a waitlist, or nil when they are not on it
+func (r *waitlistRepository) GetEntry(ctx context.Context, waitlistID, userID uuid.UUID) (*entities.WaitlistEntry, error) {
+	var entry entities.WaitlistEntry
+	err := r.db.WithContext(ctx).
+		Where("waitlist_id = ? AND user_id = ?", waitlistID, userID).
+		First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ListEntriesByUser lists the waitlists a customer is on, newest first
+func (r *waitlistRepository) ListEntriesByUser(ctx context.Context, userID uuid.UUID) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&entries).Error
+	return entries, err
+}
+
+// GetEntriesForWave returns customers not yet notified whose access has opened, in the order they joined
+func (r *waitlistRepository) GetEntriesForWave(ctx context.Context, waitlistID uuid.UUID, audiences entities.WaitlistAudiences, limit int) ([]*entities.WaitlistEntry, error) {
+	var entries []*entities.WaitlistEntry
+	if audiences.IsEmpty() {
+		return entries, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Where("waitlist_id = ? AND notified_at IS NULL", waitlistID)
+	if !audiences.Everyone {
+		var conditions []string
+		var args []interface{}
+		if len(audiences.MembershipTiers) > 0 {
+			conditions = append(conditions, "membership_tier IN ?")
+			args = append(args, audiences.MembershipTiers)
+		}
+		if len(audiences.CustomerSegments) > 0 {
+			conditions = append(conditions, "customer_segment IN ?")
+			args = append(args, audiences.CustomerSegments)
+		}
+		query = query.Where(strings.Join(conditions, " OR "), args...)
+	}
+	err := query.
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&entries).Error
+	return entries, err
+}
+
+// MarkNotified records the wave customers were notified in
+func (r *waitlistRepository) MarkNotified(ctx context.Context, ids []uuid.UUID, wave int, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&entities.WaitlistEntry{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"wave":        wave,
+			"notified_at": at,
+		}).Error
+}
+
+// CountEntries counts the customers of each waitlist
+func (r *waitlistRepository) CountEntries(ctx context.Context, waitlistIDs []uuid.UUID) (map[uuid.UUID]repositories.WaitlistCounts, error) {
+	counts := make(map[uuid.UUID]repositories.WaitlistCounts, len(waitlistIDs))
+	if len(waitlistIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		WaitlistID uuid.UUID
+		Joined     int64
+		Notified   int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.WaitlistEntry{}).
+		Select("waitlist_id, COUNT(*) AS joined, COUNT(notified_at) AS notified").
+		Where("waitlist_id IN ?", waitlistIDs).
+		Group("waitlist_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.WaitlistID] = repositories.WaitlistCounts{Joined: row.Joined, Notified: row.Notified}
+	}
+	return counts, nil
+}
+
+// GetDemand counts a waitlist's customers by membership tier and segment
+func (r *waitlistRepository) GetDemand(ctx context.Context, waitlistID uuid.UUID) ([]*entities.WaitlistDemand, error) {
+	var demand []*entities.WaitlistDemand
+	err := r.db.WithContext(ctx).
+		Model(&entities.WaitlistEntry{}).
+		Select("membership_tier, customer_segment, COUNT(*) AS joined, COUNT(notified_at) AS notified").
+		Where("waitlist_id = ?", waitlistID).
+		Group("membership_tier, customer_segment").
+		Order("membership_tier, customer_segment").
+		Scan(&demand).Error
+	return demand, err
+}
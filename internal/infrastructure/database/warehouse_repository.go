@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -336,7 +337,7 @@ func (r *warehouseRepository) AssignStaff(ctx context.Context, warehouseID, user
 
 	// Create a warehouse staff assignment record
 	assignment := map[string]interface{}{
-		"id":           uuid.New(),
+		"id":           ids.New(),
 		"warehouse_id": warehouseID,
 		"user_id":      userID,
 		"role":         role,
@@ -350,7 +351,7 @@ func (r *warehouseRepository) AssignStaff(ctx context.Context, warehouseID, user
 
 // CreateZone creates a warehouse zone
 func (r *warehouseRepository) CreateZone(ctx context.Context, zone *entities.WarehouseZone) error {
-	zone.ID = uuid.New()
+	zone.ID = ids.New()
 	zone.CreatedAt = time.Now()
 	zone.UpdatedAt = time.Now()
 	return r.db.WithContext(ctx).Create(zone).Error
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// warehouseSource is where the rows of an exported table are read from
+type warehouseSource struct {
+	table string
+	where string
+}
+
+var warehouseSources = map[entities.WarehouseTable]warehouseSource{
+	entities.WarehouseTableCustomers:  {table: "users", where: "role = 'customer'"},
+	entities.WarehouseTableProducts:   {table: "products"},
+	entities.WarehouseTableOrders:     {table: "orders"},
+	entities.WarehouseTableOrderItems: {table: "order_items"},
+}
+
+type warehouseSyncRepository struct {
+	db *gorm.DB
+}
+
+// NewWarehouseSyncRepository creates a new warehouse sync repository
+func NewWarehouseSyncRepository(db *gorm.DB) repositories.WarehouseSyncRepository {
+	return &warehouseSyncRepository{db: db}
+}
+
+// GetStates gets the export state of every table exported so far
+func (r *warehouseSyncRepository) GetStates(ctx context.Context) ([]*entities.WarehouseSyncState, error) {
+	var states []*entities.WarehouseSyncState
+	err := r.db.WithContext(ctx).Order("warehouse_table ASC").Find(&states).Error
+	return states, err
+}
+
+// SaveState creates or updates the export state of a table
+func (r *warehouseSyncRepository) SaveState(ctx context.Context, state *entities.WarehouseSyncState) error {
+	return r.db.WithContext(ctx).Save(state).Error
+}
+
+// GetChangedRows reads the rows changed after the watermark
+func (r *warehouseSyncRepository) GetChangedRows(ctx context.Context, schema entities.WarehouseTableSchema, afterAt *time.Time, afterID *uuid.UUID, until time.Time, limit int) ([]entities.WarehouseRow, error) {
+	source, ok := warehouseSources[schema.Table]
+	if !ok {
+		return nil, fmt.Errorf("no source for warehouse table %s", schema.Table)
+	}
+
+	names := make([]string, len(schema.Columns))
+	for i, column := range schema.Columns {
+		names[i] = column.Name
+	}
+
+	query := r.db.WithContext(ctx).
+		Table(source.table).
+		Select(names).
+		Where("updated_at <= ?", until)
+	if source.where != "" {
+		query = query.Where(source.where)
+	}
+	if afterAt != nil && afterID != nil {
+		query = query.Where("(updated_at, id) > (?, ?)", *afterAt, *afterID)
+	}
+
+	rows, err := query.Order("updated_at ASC, id ASC").Limit(limit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entities.WarehouseRow
+	for rows.Next() {
+		values := make([]interface{}, len(schema.Columns))
+		for i, column := range schema.Columns {
+			values[i] = newWarehouseScanValue(column.Type)
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+
+		row := make(entities.WarehouseRow, len(schema.Columns))
+		for i, column := range schema.Columns {
+			row[column.Name] = warehouseScanResult(values[i])
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// newWarehouseScanValue returns a scan destination for a column of the given type
+func newWarehouseScanValue(columnType entities.WarehouseColumnType) interface{} {
+	switch columnType {
+	case entities.WarehouseColumnInteger:
+		return &sql.NullInt64{}
+	case entities.WarehouseColumnFloat:
+		return &sql.NullFloat64{}
+	case entities.WarehouseColumnBoolean:
+		return &sql.NullBool{}
+	case entities.WarehouseColumnTimestamp:
+		return &sql.NullTime{}
+	default:
+		return &sql.NullString{}
+	}
+}
+
+// warehouseScanResult unwraps a scanned value, returning nil for NULL
+func warehouseScanResult(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *sql.NullInt64:
+		if v.Valid {
+			return v.Int64
+		}
+	case *sql.NullFloat64:
+		if v.Valid {
+			return v.Float64
+		}
+	case *sql.NullBool:
+		if v.Valid {
+			return v.Bool
+		}
+	case *sql.NullTime:
+		if v.Valid {
+			return v.Time.UTC()
+		}
+	case *sql.NullString:
+		if v.Valid {
+			return v.String
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -213,7 +214,7 @@ func (r *wishlistRepository) Count(ctx context.Context, filters repositories.Wis
 // AddToWishlist adds a product to user's wishlist
 func (r *wishlistRepository) AddToWishlist(ctx context.Context, userID, productID uuid.UUID) error {
 	wishlist := &entities.Wishlist{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		UserID:    userID,
 		ProductID: productID,
 		CreatedAt: time.Now(),
@@ -0,0 +1,110 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// KafkaPublisher publishes events to Kafka through a Confluent REST Proxy (v2 API). Each event
+// type goes to its own topic, keyed by aggregate ID so the events of one order, payment,
+// product or user stay in order on a single partition.
+type KafkaPublisher struct {
+	baseURL     string
+	topicPrefix string
+	username    string
+	password    string
+	client      *http.Client
+}
+
+// NewKafkaPublisher creates a publisher for the REST Proxy at baseURL
+func NewKafkaPublisher(baseURL, topicPrefix, username, password string, timeout time.Duration) *KafkaPublisher {
+	return &KafkaPublisher{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		topicPrefix: topicPrefix,
+		username:    username,
+		password:    password,
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+type kafkaRecord struct {
+	Key   string                       `json:"key"`
+	Value entities.DomainEventEnvelope `json:"value"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int     `json:"partition"`
+		Offset    int64   `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish produces the event and returns once Kafka has acknowledged it
+func (p *KafkaPublisher) Publish(ctx context.Context, event entities.DomainEventEnvelope) error {
+	body, err := json.Marshal(map[string][]kafkaRecord{
+		"records": {{Key: event.AggregateID.String(), Value: event}},
+	})
+	if err != nil {
+		return err
+	}
+
+	topic := TopicName(p.topicPrefix, event.Type)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/topics/"+url.PathEscape(topic), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if p.username != "" {
+		req.SetBasicAuth(p.username, p.password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("kafka rest proxy request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return fmt.Errorf("failed to read kafka rest proxy response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kafka rest proxy returned %d for topic %s: %s", resp.StatusCode, topic, strings.TrimSpace(string(respBody)))
+	}
+
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(respBody, &produced); err != nil {
+		return fmt.Errorf("invalid kafka rest proxy response: %w", err)
+	}
+	if len(produced.Offsets) != 1 {
+		return fmt.Errorf("kafka rest proxy acknowledged %d records instead of 1", len(produced.Offsets))
+	}
+	if offset := produced.Offsets[0]; offset.ErrorCode != nil || offset.Error != nil {
+		message := ""
+		if offset.Error != nil {
+			message = *offset.Error
+		}
+		return fmt.Errorf("kafka rejected event %s on topic %s: %s", event.ID, topic, message)
+	}
+	return nil
+}
+
+// TopicName returns the Kafka topic or NATS subject an event type is published to
+func TopicName(prefix string, eventType entities.DomainEventType) string {
+	if prefix == "" {
+		return string(eventType)
+	}
+	return prefix + "." + string(eventType)
+}
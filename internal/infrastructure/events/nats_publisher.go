@@ -0,0 +1,281 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// NATSPublisher publishes events to NATS JetStream over the NATS client protocol. Every event is
+// published with a reply subject and only counts as delivered once a JetStream stream has
+// stored it and acknowledged it. The event ID is sent as Nats-Msg-Id, so JetStream drops
+// redelivered events within its duplicate window.
+type NATSPublisher struct {
+	serverURL   string
+	topicPrefix string
+	username    string
+	password    string
+	timeout     time.Duration
+
+	mu      sync.Mutex
+	conn    net.Conn
+	reader  *bufio.Reader
+	inbox   string
+	replyID int64
+}
+
+// NewNATSPublisher creates a publisher for the NATS server at serverURL (nats:// or tls://)
+func NewNATSPublisher(serverURL, topicPrefix, username, password string, timeout time.Duration) *NATSPublisher {
+	return &NATSPublisher{
+		serverURL:   serverURL,
+		topicPrefix: topicPrefix,
+		username:    username,
+		password:    password,
+		timeout:     timeout,
+	}
+}
+
+type natsPubAck struct {
+	Stream    string `json:"stream"`
+	Sequence  uint64 `json:"seq"`
+	Duplicate bool   `json:"duplicate"`
+	Error     *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// Publish publishes the event and returns once JetStream has acknowledged it
+func (p *NATSPublisher) Publish(ctx context.Context, event entities.DomainEventEnvelope) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	err = p.publish(ctx, TopicName(p.topicPrefix, event.Type), event.ID.String(), payload)
+	if err != nil {
+		// The connection may be out of sync with the server; start over on the next event
+		p.close()
+	}
+	return err
+}
+
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	u, err := url.Parse(p.serverURL)
+	if err != nil {
+		return fmt.Errorf("invalid NATS URL: %w", err)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	dialer := &net.Dialer{Timeout: p.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	if u.Scheme == "tls" {
+		conn = tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+	p.setDeadline(ctx)
+
+	line, err := p.readLine()
+	if err != nil {
+		p.close()
+		return fmt.Errorf("failed to read NATS server info: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		p.close()
+		return fmt.Errorf("unexpected NATS greeting: %s", line)
+	}
+
+	options := map[string]interface{}{
+		"verbose":       false,
+		"pedantic":      false,
+		"headers":       true,
+		"no_responders": true,
+		"name":          entities.DomainEventSource,
+		"lang":          "go",
+		"protocol":      1,
+	}
+	username, password := p.username, p.password
+	if u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+	}
+	if username != "" && password == "" {
+		options["auth_token"] = username
+	} else if username != "" {
+		options["user"] = username
+		options["pass"] = password
+	}
+	connectOptions, err := json.Marshal(options)
+	if err != nil {
+		p.close()
+		return err
+	}
+
+	p.inbox = "_INBOX." + randomToken()
+	p.replyID = 0
+	command := fmt.Sprintf("CONNECT %s\r\nSUB %s.* 1\r\nPING\r\n", connectOptions, p.inbox)
+	if _, err := io.WriteString(p.conn, command); err != nil {
+		p.close()
+		return fmt.Errorf("failed to send NATS connect: %w", err)
+	}
+
+	// The server answers the PING once it has accepted the connection and subscription
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			p.close()
+			return fmt.Errorf("failed to connect to NATS: %w", err)
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			p.close()
+			return fmt.Errorf("NATS rejected the connection: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (p *NATSPublisher) publish(ctx context.Context, subject, msgID string, payload []byte) error {
+	p.setDeadline(ctx)
+	p.replyID++
+	reply := p.inbox + "." + strconv.FormatInt(p.replyID, 10)
+
+	headers := "NATS/1.0\r\nNats-Msg-Id: " + msgID + "\r\n\r\n"
+	command := fmt.Sprintf("HPUB %s %s %d %d\r\n%s%s\r\n", subject, reply, len(headers), len(headers)+len(payload), headers, payload)
+	if _, err := io.WriteString(p.conn, command); err != nil {
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return fmt.Errorf("failed to read NATS acknowledgement: %w", err)
+		}
+
+		switch {
+		case line == "PING":
+			if _, err := io.WriteString(p.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		case strings.HasPrefix(line, "MSG ") || strings.HasPrefix(line, "HMSG "):
+			msgSubject, msgHeaders, msgPayload, err := p.readMessage(line)
+			if err != nil {
+				return err
+			}
+			if msgSubject != reply {
+				// A late reply to an earlier attempt
+				continue
+			}
+			return parseNATSAck(subject, msgHeaders, msgPayload)
+		}
+	}
+}
+
+// readMessage reads the body of a MSG or HMSG whose control line was already read
+func (p *NATSPublisher) readMessage(line string) (subject, headers string, payload []byte, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 {
+		return "", "", nil, fmt.Errorf("invalid NATS message: %s", line)
+	}
+
+	headerSize := 0
+	total, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return "", "", nil, fmt.Errorf("invalid NATS message size: %s", line)
+	}
+	if fields[0] == "HMSG" {
+		if headerSize, err = strconv.Atoi(fields[len(fields)-2]); err != nil || headerSize > total {
+			return "", "", nil, fmt.Errorf("invalid NATS header size: %s", line)
+		}
+	}
+
+	body := make([]byte, total+2)
+	if _, err := io.ReadFull(p.reader, body); err != nil {
+		return "", "", nil, fmt.Errorf("failed to read NATS message: %w", err)
+	}
+	return fields[1], string(body[:headerSize]), body[headerSize:total], nil
+}
+
+func parseNATSAck(subject, headers string, payload []byte) error {
+	// With no_responders, NATS answers 503 right away when no stream captures the subject
+	if strings.HasPrefix(headers, "NATS/1.0 503") {
+		return fmt.Errorf("no JetStream stream captures subject %s", subject)
+	}
+
+	var ack natsPubAck
+	if err := json.Unmarshal(payload, &ack); err != nil {
+		return fmt.Errorf("invalid JetStream acknowledgement for %s: %w", subject, err)
+	}
+	if ack.Error != nil {
+		return fmt.Errorf("JetStream rejected event on %s: %s (%d)", subject, ack.Error.Description, ack.Error.Code)
+	}
+	if ack.Stream == "" {
+		return errors.New("JetStream acknowledgement has no stream")
+	}
+	return nil
+}
+
+func (p *NATSPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *NATSPublisher) setDeadline(ctx context.Context) {
+	deadline := time.Now().Add(p.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	p.conn.SetDeadline(deadline)
+}
+
+func (p *NATSPublisher) close() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn = nil
+	p.reader = nil
+}
+
+func randomToken() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,154 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// partnerFeedProductRepository records the price and availability changes partners are sent
+// whenever a product is saved. Only the price shoppers pay and the availability partners see
+// are compared, so edits of other fields and stock moving while in stock record nothing.
+type partnerFeedProductRepository struct {
+	repositories.ProductRepository
+	feed repositories.PartnerFeedRepository
+}
+
+// NewPartnerFeedProductRepository wraps a product repository so price and availability changes
+// are recorded for partners
+func NewPartnerFeedProductRepository(repo repositories.ProductRepository, feed repositories.PartnerFeedRepository) repositories.ProductRepository {
+	return &partnerFeedProductRepository{
+		ProductRepository: repo,
+		feed:              feed,
+	}
+}
+
+// Update updates a product and records its price and availability changes
+func (r *partnerFeedProductRepository) Update(ctx context.Context, product *entities.Product) error {
+	previous := r.loadPrevious(ctx, product.ID)
+	if err := r.ProductRepository.Update(ctx, product); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		r.record(ctx, previous, product)
+	}
+	return nil
+}
+
+// UpdateWithPriceHistory updates a product with its price history and records its price and
+// availability changes
+func (r *partnerFeedProductRepository) UpdateWithPriceHistory(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error {
+	previous := r.loadPrevious(ctx, product.ID)
+	if err := r.ProductRepository.UpdateWithPriceHistory(ctx, product, entry); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		r.record(ctx, previous, product)
+	}
+	return nil
+}
+
+// RevertExpiredSale clears a product's ended sale and records its price change
+func (r *partnerFeedProductRepository) RevertExpiredSale(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error {
+	previous := r.loadPrevious(ctx, product.ID)
+	if err := r.ProductRepository.RevertExpiredSale(ctx, product, entry); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		updated := *previous
+		updated.ClearSale()
+		r.record(ctx, previous, &updated)
+	}
+	return nil
+}
+
+// partnerFeedScheduledPriceChangeRepository records the price changes partners are sent when a
+// scheduled price change is applied, which saves the product without the product repository
+type partnerFeedScheduledPriceChangeRepository struct {
+	repositories.ScheduledPriceChangeRepository
+	products *partnerFeedProductRepository
+}
+
+// NewPartnerFeedScheduledPriceChangeRepository wraps a scheduled price change repository so the
+// price changes it applies are recorded for partners
+func NewPartnerFeedScheduledPriceChangeRepository(repo repositories.ScheduledPriceChangeRepository, productRepo repositories.ProductRepository, feed repositories.PartnerFeedRepository) repositories.ScheduledPriceChangeRepository {
+	return &partnerFeedScheduledPriceChangeRepository{
+		ScheduledPriceChangeRepository: repo,
+		products: &partnerFeedProductRepository{
+			ProductRepository: productRepo,
+			feed:              feed,
+		},
+	}
+}
+
+// Apply applies a scheduled price change and records the product's price change
+func (r *partnerFeedScheduledPriceChangeRepository) Apply(ctx context.Context, change *entities.ScheduledPriceChange, product *entities.Product, entry *entities.PriceHistory) error {
+	var previous *entities.Product
+	if entry != nil {
+		previous = r.products.loadPrevious(ctx, product.ID)
+	}
+	if err := r.ScheduledPriceChangeRepository.Apply(ctx, change, product, entry); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		r.products.record(ctx, previous, product)
+	}
+	return nil
+}
+
+// UpdateStock updates a product's stock and records its availability change
+func (r *partnerFeedProductRepository) UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error {
+	previous := r.loadPrevious(ctx, productID)
+	if err := r.ProductRepository.UpdateStock(ctx, productID, stock); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		updated := *previous
+		updated.Stock = stock
+		updated.UpdateStockStatus()
+		r.record(ctx, previous, &updated)
+	}
+	return nil
+}
+
+// loadPrevious reads the price and stock columns of a product before it is saved, or nil if
+// they can't be read
+func (r *partnerFeedProductRepository) loadPrevious(ctx context.Context, productID uuid.UUID) *entities.Product {
+	products, err := r.ProductRepository.GetPriceAndStockByIDs(ctx, []uuid.UUID{productID})
+	if err != nil || len(products) == 0 {
+		return nil
+	}
+	return products[0]
+}
+
+// record records the changes between the previous and saved product. A change that can't be
+// recorded doesn't fail the save; partners catch up with the next change of the product.
+func (r *partnerFeedProductRepository) record(ctx context.Context, previous, product *entities.Product) {
+	now := time.Now()
+	var changes []*entities.PartnerFeedChange
+	if previousPrice := previous.GetCurrentPrice(); previousPrice != product.GetCurrentPrice() || previous.Price != product.Price {
+		changes = append(changes, entities.NewPartnerPriceChange(product, previousPrice, now))
+	}
+	if previousAvailability := entities.PartnerAvailabilityOf(previous); previousAvailability != entities.PartnerAvailabilityOf(product) {
+		changes = append(changes, entities.NewPartnerAvailabilityChange(product, previousAvailability, now))
+	}
+
+	for _, change := range changes {
+		if change.SKU == "" {
+			change.SKU = previous.SKU
+		}
+		if err := r.feed.RecordChange(ctx, change); err != nil {
+			fmt.Printf("❌ Failed to record %s change of product %s for partners: %v\n", change.Type, product.ID, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// PartnerWebhookClient posts batches of the partner feed to partners' webhook URLs
+type PartnerWebhookClient struct {
+	client *http.Client
+}
+
+// NewPartnerWebhookClient creates a client that gives partners timeout to answer
+func NewPartnerWebhookClient(timeout time.Duration) *PartnerWebhookClient {
+	return &PartnerWebhookClient{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts a signed batch and returns once the partner answered with a 2xx status. When the
+// partner answers 429 with Retry-After, it returns how long the partner asked to be left alone.
+func (c *PartnerWebhookClient) Send(ctx context.Context, url, secret string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", entities.DomainEventSource+"-partner-feed")
+	req.Header.Set("X-Feed-Signature", entities.SignPartnerWebhook(secret, body, time.Now()))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return 0, nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("webhook returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if seconds, parseErr := strconv.Atoi(resp.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second, err
+		}
+	}
+	return 0, err
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+)
+
+// Supported event brokers
+const (
+	BrokerKafka = "kafka"
+	BrokerNATS  = "nats"
+)
+
+// Publisher publishes a domain event and returns once the broker has acknowledged it
+type Publisher interface {
+	Publish(ctx context.Context, event entities.DomainEventEnvelope) error
+}
+
+// NewPublisher creates the publisher for the given broker
+func NewPublisher(broker, serverURL, topicPrefix, username, password string, timeout time.Duration) (Publisher, error) {
+	if serverURL == "" {
+		return nil, fmt.Errorf("an event broker URL is required")
+	}
+
+	switch broker {
+	case BrokerKafka:
+		return NewKafkaPublisher(serverURL, topicPrefix, username, password, timeout), nil
+	case BrokerNATS:
+		return NewNATSPublisher(serverURL, topicPrefix, username, password, timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown event broker %q, use %s or %s", broker, BrokerKafka, BrokerNATS)
+	}
+}
+
+// BreakerPublisher guards a publisher with a circuit breaker and per-call timeout
+type BreakerPublisher struct {
+	publisher Publisher
+	breaker   *resilience.Breaker
+}
+
+// NewBreakerPublisher wraps publisher so its calls go through breaker
+func NewBreakerPublisher(publisher Publisher, breaker *resilience.Breaker) *BreakerPublisher {
+	return &BreakerPublisher{
+		publisher: publisher,
+		breaker:   breaker,
+	}
+}
+
+// Publish publishes the event unless the broker's circuit is open
+func (p *BreakerPublisher) Publish(ctx context.Context, event entities.DomainEventEnvelope) error {
+	return p.breaker.Execute(ctx, func(ctx context.Context) error {
+		return p.publisher.Publish(ctx, event)
+	})
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+
+	"github.com/google/uuid"
+)
+
+// stockEventProductRepository records a stock.changed event whenever a product's stock is saved
+// with a different value. Stock is changed by orders, cancellations, inventory adjustments and
+// product edits, which all go through the product repository.
+type stockEventProductRepository struct {
+	repositories.ProductRepository
+	events services.EventRecorder
+}
+
+// NewStockEventProductRepository wraps a product repository so stock changes are recorded as events
+func NewStockEventProductRepository(repo repositories.ProductRepository, events services.EventRecorder) repositories.ProductRepository {
+	return &stockEventProductRepository{
+		ProductRepository: repo,
+		events:            events,
+	}
+}
+
+// Update updates a product and records a stock.changed event if its stock changed
+func (r *stockEventProductRepository) Update(ctx context.Context, product *entities.Product) error {
+	previous := r.loadStock(ctx, product.ID)
+	if err := r.ProductRepository.Update(ctx, product); err != nil {
+		return err
+	}
+
+	if previous != nil && previous.Stock != product.Stock {
+		r.record(ctx, previous.Stock, product)
+	}
+	return nil
+}
+
+// UpdateWithPriceHistory updates a product with its price history and records a stock.changed
+// event if its stock changed
+func (r *stockEventProductRepository) UpdateWithPriceHistory(ctx context.Context, product *entities.Product, entry *entities.PriceHistory) error {
+	previous := r.loadStock(ctx, product.ID)
+	if err := r.ProductRepository.UpdateWithPriceHistory(ctx, product, entry); err != nil {
+		return err
+	}
+
+	if previous != nil && previous.Stock != product.Stock {
+		r.record(ctx, previous.Stock, product)
+	}
+	return nil
+}
+
+// UpdateStock updates a product's stock and records a stock.changed event if it changed
+func (r *stockEventProductRepository) UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error {
+	previous := r.loadStock(ctx, productID)
+	if err := r.ProductRepository.UpdateStock(ctx, productID, stock); err != nil {
+		return err
+	}
+
+	if previous != nil && previous.Stock != stock {
+		previousStock := previous.Stock
+		previous.Stock = stock
+		previous.UpdateStockStatus()
+		r.record(ctx, previousStock, previous)
+	}
+	return nil
+}
+
+// loadStock reads the stock columns of a product before it is saved, or nil if it can't be read
+func (r *stockEventProductRepository) loadStock(ctx context.Context, productID uuid.UUID) *entities.Product {
+	products, err := r.ProductRepository.GetPriceAndStockByIDs(ctx, []uuid.UUID{productID})
+	if err != nil || len(products) == 0 {
+		return nil
+	}
+	return products[0]
+}
+
+func (r *stockEventProductRepository) record(ctx context.Context, previousStock int, product *entities.Product) {
+	r.events.Record(ctx, entities.DomainEventStockChanged, product.ID, entities.StockChangedEventData{
+		ProductID:     product.ID,
+		PreviousStock: previousStock,
+		Stock:         product.Stock,
+		StockStatus:   product.StockStatus,
+		ChangedAt:     time.Now(),
+	})
+}
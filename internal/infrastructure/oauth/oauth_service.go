@@ -5,15 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 
 	"golang.org/x/oauth2"
 
 	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
 )
 
 // Service handles OAuth operations
 type Service struct {
-	config *config.OAuthConfig
+	config   *config.OAuthConfig
+	google   *resilience.Breaker
+	facebook *resilience.Breaker
 }
 
 // NewService creates a new OAuth service
@@ -23,6 +27,12 @@ func NewService(cfg *config.OAuthConfig) *Service {
 	}
 }
 
+// UseBreakers guards the calls to each provider with a circuit breaker and timeout
+func (s *Service) UseBreakers(google, facebook *resilience.Breaker) {
+	s.google = google
+	s.facebook = facebook
+}
+
 // GetGoogleAuthURL returns the Google OAuth authorization URL
 func (s *Service) GetGoogleAuthURL(state string) string {
 	return s.config.Google.AuthCodeURL(state, oauth2.AccessTypeOffline)
@@ -35,13 +45,23 @@ func (s *Service) GetFacebookAuthURL(state string) string {
 
 // ExchangeGoogleCode exchanges authorization code for user info
 func (s *Service) ExchangeGoogleCode(ctx context.Context, code string) (*config.OAuthUserInfo, error) {
+	return s.guard(ctx, s.google, "Google", func(ctx context.Context) (*config.OAuthUserInfo, error) {
+		return s.exchangeGoogleCode(ctx, code)
+	})
+}
+
+func (s *Service) exchangeGoogleCode(ctx context.Context, code string) (*config.OAuthUserInfo, error) {
 	token, err := s.config.Google.Exchange(ctx, code)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange Google code: %w", err)
 	}
 
 	client := s.config.Google.Client(ctx, token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.googleapis.com/oauth2/v2/userinfo", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Google user info request: %w", err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Google user info: %w", err)
 	}
@@ -62,13 +82,23 @@ func (s *Service) ExchangeGoogleCode(ctx context.Context, code string) (*config.
 
 // ExchangeFacebookCode exchanges authorization code for user info
 func (s *Service) ExchangeFacebookCode(ctx context.Context, code string) (*config.OAuthUserInfo, error) {
+	return s.guard(ctx, s.facebook, "Facebook", func(ctx context.Context) (*config.OAuthUserInfo, error) {
+		return s.exchangeFacebookCode(ctx, code)
+	})
+}
+
+func (s *Service) exchangeFacebookCode(ctx context.Context, code string) (*config.OAuthUserInfo, error) {
 	token, err := s.config.Facebook.Exchange(ctx, code)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange Facebook code: %w", err)
 	}
 
 	client := s.config.Facebook.Client(ctx, token)
-	resp, err := client.Get("https://graph.facebook.com/me?fields=id,name,email,picture")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://graph.facebook.com/me?fields=id,name,email,picture", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Facebook user info request: %w", err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Facebook user info: %w", err)
 	}
@@ -87,6 +117,20 @@ func (s *Service) ExchangeFacebookCode(ctx context.Context, code string) (*confi
 	return facebookUser.ToStandardUserInfo(), nil
 }
 
+// guard runs a provider call through its breaker, if any, and explains unavailability so users
+// can fall back to password login
+func (s *Service) guard(ctx context.Context, breaker *resilience.Breaker, provider string, fn func(ctx context.Context) (*config.OAuthUserInfo, error)) (*config.OAuthUserInfo, error) {
+	if breaker == nil {
+		return fn(ctx)
+	}
+
+	userInfo, err := resilience.Call(ctx, breaker, fn)
+	if resilience.IsUnavailable(err) {
+		return nil, fmt.Errorf("%s sign-in is temporarily unavailable, please sign in with email and password: %w", provider, err)
+	}
+	return userInfo, err
+}
+
 // ValidateState validates OAuth state parameter
 func (s *Service) ValidateState(receivedState, expectedState string) bool {
 	return receivedState == expectedState
@@ -0,0 +1,123 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+
+	"github.com/stripe/stripe-go/v76"
+)
+
+// Gateway is the set of calls a payment provider makes over the network
+type Gateway interface {
+	ProcessPayment(ctx context.Context, req PaymentGatewayRequest) (*PaymentGatewayResponse, error)
+	ProcessRefund(ctx context.Context, req RefundGatewayRequest) (*RefundGatewayResponse, error)
+	CreateCheckoutSession(ctx context.Context, req CheckoutSessionRequest) (*CheckoutSessionResponse, error)
+}
+
+// BreakerGateway guards a payment gateway with a circuit breaker and per-call timeout
+type BreakerGateway struct {
+	gateway Gateway
+	breaker *resilience.Breaker
+}
+
+// NewBreakerGateway wraps gateway so its calls go through breaker
+func NewBreakerGateway(gateway Gateway, breaker *resilience.Breaker) *BreakerGateway {
+	return &BreakerGateway{
+		gateway: gateway,
+		breaker: breaker,
+	}
+}
+
+// ProcessPayment processes a payment through the guarded gateway
+func (g *BreakerGateway) ProcessPayment(ctx context.Context, req PaymentGatewayRequest) (*PaymentGatewayResponse, error) {
+	resp, err := resilience.Call(ctx, g.breaker, func(ctx context.Context) (*PaymentGatewayResponse, error) {
+		return g.gateway.ProcessPayment(ctx, req)
+	})
+	if resilience.IsUnavailable(err) {
+		return &PaymentGatewayResponse{
+			Success: false,
+			Status:  "pending",
+			Message: fmt.Sprintf("%s is temporarily unavailable", g.breaker.Name()),
+		}, err
+	}
+	return resp, err
+}
+
+// ProcessRefund processes a refund through the guarded gateway
+func (g *BreakerGateway) ProcessRefund(ctx context.Context, req RefundGatewayRequest) (*RefundGatewayResponse, error) {
+	return resilience.Call(ctx, g.breaker, func(ctx context.Context) (*RefundGatewayResponse, error) {
+		return g.gateway.ProcessRefund(ctx, req)
+	})
+}
+
+// CreateCheckoutSession creates a checkout session through the guarded gateway
+func (g *BreakerGateway) CreateCheckoutSession(ctx context.Context, req CheckoutSessionRequest) (*CheckoutSessionResponse, error) {
+	return resilience.Call(ctx, g.breaker, func(ctx context.Context) (*CheckoutSessionResponse, error) {
+		return g.gateway.CreateCheckoutSession(ctx, req)
+	})
+}
+
+// HandleWebhook decodes a webhook with the wrapped gateway. Webhooks are parsed locally, so the
+// breaker is not involved.
+func (g *BreakerGateway) HandleWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error) {
+	parser, ok := g.gateway.(interface {
+		HandleWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%s does not support webhooks", g.breaker.Name())
+	}
+	return parser.HandleWebhook(ctx, payload, signature)
+}
+
+// FetchWebhookEvent retrieves a webhook event from the wrapped gateway's API
+func (g *BreakerGateway) FetchWebhookEvent(ctx context.Context, eventID string) (*WebhookEvent, error) {
+	fetcher, ok := g.gateway.(interface {
+		FetchWebhookEvent(ctx context.Context, eventID string) (*WebhookEvent, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%s does not support fetching webhook events", g.breaker.Name())
+	}
+	return resilience.Call(ctx, g.breaker, func(ctx context.Context) (*WebhookEvent, error) {
+		return fetcher.FetchWebhookEvent(ctx, eventID)
+	})
+}
+
+// ListBalanceTransactions lists balance transactions with the wrapped gateway. Listing pages
+// through a whole day of transactions, so it is not bounded by the breaker's call timeout.
+func (g *BreakerGateway) ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*BalanceTransaction, error) {
+	lister, ok := g.gateway.(interface {
+		ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*BalanceTransaction, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%s does not support balance transactions", g.breaker.Name())
+	}
+	return lister.ListBalanceTransactions(ctx, from, to)
+}
+
+// ListPayoutTransactions lists the balance transactions of a payout with the wrapped gateway
+func (g *BreakerGateway) ListPayoutTransactions(ctx context.Context, payoutID string) ([]*BalanceTransaction, error) {
+	lister, ok := g.gateway.(interface {
+		ListPayoutTransactions(ctx context.Context, payoutID string) ([]*BalanceTransaction, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%s does not support balance transactions", g.breaker.Name())
+	}
+	return lister.ListPayoutTransactions(ctx, payoutID)
+}
+
+// IsStripeProviderFailure reports whether a Stripe error reflects a provider problem rather than
+// a rejected request such as a declined card
+func IsStripeProviderFailure(err error) bool {
+	var stripeErr *stripe.Error
+	if errors.As(err, &stripeErr) {
+		return stripeErr.HTTPStatusCode >= http.StatusInternalServerError ||
+			stripeErr.HTTPStatusCode == http.StatusTooManyRequests ||
+			stripeErr.HTTPStatusCode == 0
+	}
+	return true
+}
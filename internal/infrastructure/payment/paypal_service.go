@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
+
+	"ecom-golang-clean-architecture/pkg/money"
 )
 
 // PayPalService implements payment processing with PayPal
@@ -220,11 +223,15 @@ func (p *PayPalService) ProcessRefund(ctx context.Context, req RefundGatewayRequ
 		}, err
 	}
 
-	// Create refund request
+	// Create refund request in the payment's currency, with the decimals PayPal accepts for it
+	currency := req.Currency
+	if currency == "" {
+		currency = "USD"
+	}
 	refundReq := map[string]interface{}{
 		"amount": map[string]string{
-			"total":    fmt.Sprintf("%.2f", req.Amount),
-			"currency": "USD", // This should be configurable
+			"total":    money.Format(money.ToMinor(req.Amount, currency), currency),
+			"currency": currency,
 		},
 	}
 
@@ -305,3 +312,146 @@ func (p *PayPalService) CreateCheckoutSession(ctx context.Context, req CheckoutS
 		Message:    "PayPal checkout sessions not implemented yet",
 	}, fmt.Errorf("PayPal checkout sessions not implemented")
 }
+
+// PayPalWebhookEvent represents a PayPal webhook event
+type PayPalWebhookEvent struct {
+	ID           string          `json:"id"`
+	EventType    string          `json:"event_type"`
+	ResourceType string          `json:"resource_type"`
+	CreateTime   time.Time       `json:"create_time"`
+	Resource     json.RawMessage `json:"resource"`
+}
+
+// PayPalDispute represents the dispute resource of PayPal dispute events
+type PayPalDispute struct {
+	DisputeID            string `json:"dispute_id"`
+	Reason               string `json:"reason"`
+	Status               string `json:"status"`
+	DisputedTransactions []struct {
+		SellerTransactionID string `json:"seller_transaction_id"`
+	} `json:"disputed_transactions"`
+	DisputeAmount struct {
+		CurrencyCode string `json:"currency_code"`
+		Value        string `json:"value"`
+	} `json:"dispute_amount"`
+	DisputeOutcome *struct {
+		OutcomeCode string `json:"outcome_code"`
+	} `json:"dispute_outcome"`
+	SellerResponseDueDate *time.Time `json:"seller_response_due_date"`
+}
+
+// HandleWebhook decodes a PayPal webhook. Transmission signatures are not checked locally;
+// instead the event is fetched back from the PayPal API by its ID, so only events PayPal
+// actually sent to this account are processed.
+func (p *PayPalService) HandleWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error) {
+	var event PayPalWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %v", err)
+	}
+	if event.ID == "" {
+		return nil, fmt.Errorf("webhook event ID is required")
+	}
+	return p.FetchWebhookEvent(ctx, event.ID)
+}
+
+// FetchWebhookEvent retrieves a webhook event from the PayPal API
+func (p *PayPalService) FetchWebhookEvent(ctx context.Context, eventID string) (*WebhookEvent, error) {
+	token, err := p.getAccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PayPal auth failed: %v", err)
+	}
+
+	url := fmt.Sprintf("%s/v1/notifications/webhooks-events/%s", p.baseURL, eventID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("PayPal webhook event %s not found (status %d)", eventID, resp.StatusCode)
+	}
+
+	var event PayPalWebhookEvent
+	if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+		return nil, fmt.Errorf("failed to parse PayPal webhook event: %v", err)
+	}
+	return decodePayPalEvent(&event)
+}
+
+// decodePayPalEvent extracts the fields the payment use case needs from a PayPal event
+func decodePayPalEvent(event *PayPalWebhookEvent) (*WebhookEvent, error) {
+	webhookEvent := &WebhookEvent{
+		ID:        event.ID,
+		Type:      event.EventType,
+		CreatedAt: event.CreateTime,
+		Data:      map[string]interface{}{},
+	}
+
+	if strings.HasPrefix(event.EventType, "CUSTOMER.DISPUTE.") {
+		var dispute PayPalDispute
+		if err := json.Unmarshal(event.Resource, &dispute); err != nil {
+			return nil, fmt.Errorf("failed to parse dispute: %v", err)
+		}
+		webhookEvent.Dispute = paypalGatewayDispute(&dispute)
+		webhookEvent.Data["dispute_id"] = dispute.DisputeID
+	}
+
+	return webhookEvent, nil
+}
+
+// paypalDisputeStatuses maps open PayPal dispute statuses to normalized ones
+var paypalDisputeStatuses = map[string]string{
+	"OPEN":                        DisputeStatusNeedsResponse,
+	"WAITING_FOR_SELLER_RESPONSE": DisputeStatusNeedsResponse,
+	"WAITING_FOR_BUYER_RESPONSE":  DisputeStatusUnderReview,
+	"UNDER_REVIEW":                DisputeStatusUnderReview,
+}
+
+// paypalDisputeOutcomes maps the outcomes of resolved PayPal disputes to normalized statuses
+var paypalDisputeOutcomes = map[string]string{
+	"RESOLVED_SELLER_FAVOUR": DisputeStatusWon,
+	"DENIED":                 DisputeStatusWon,
+	"CANCELED_BY_BUYER":      DisputeStatusClosed,
+	"RESOLVED_BUYER_FAVOUR":  DisputeStatusLost,
+	"RESOLVED_WITH_PAYOUT":   DisputeStatusLost,
+	"ACCEPTED":               DisputeStatusLost,
+}
+
+// paypalGatewayDispute converts a PayPal dispute
+func paypalGatewayDispute(dispute *PayPalDispute) *GatewayDispute {
+	amount, _ := strconv.ParseFloat(dispute.DisputeAmount.Value, 64)
+	gatewayDispute := &GatewayDispute{
+		ID:            dispute.DisputeID,
+		Amount:        amount,
+		Currency:      dispute.DisputeAmount.CurrencyCode,
+		Reason:        strings.ToLower(dispute.Reason),
+		Status:        paypalDisputeStatuses[dispute.Status],
+		GatewayStatus: dispute.Status,
+		EvidenceDueBy: dispute.SellerResponseDueDate,
+	}
+	if dispute.Status == "RESOLVED" {
+		gatewayDispute.Status = DisputeStatusClosed
+		if dispute.DisputeOutcome != nil {
+			if status, ok := paypalDisputeOutcomes[dispute.DisputeOutcome.OutcomeCode]; ok {
+				gatewayDispute.Status = status
+			}
+		}
+	}
+	if gatewayDispute.Status == "" {
+		gatewayDispute.Status = DisputeStatusUnderReview
+	}
+	for _, transaction := range dispute.DisputedTransactions {
+		if transaction.SellerTransactionID != "" {
+			gatewayDispute.TransactionIDs = append(gatewayDispute.TransactionIDs, transaction.SellerTransactionID)
+		}
+	}
+	return gatewayDispute
+}
@@ -0,0 +1,233 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stripe/stripe-go/v76"
+)
+
+// MagicCard describes a card number with a fixed simulated outcome
+type MagicCard struct {
+	Number      string `json:"number"`
+	Token       string `json:"token"`
+	Outcome     string `json:"outcome"`
+	Description string `json:"description"`
+}
+
+// Simulated outcomes
+const (
+	SandboxOutcomeSucceeded         = "succeeded"
+	SandboxOutcomeDeclined          = "declined"
+	SandboxOutcomeInsufficientFunds = "insufficient_funds"
+	SandboxOutcomeRequiresAction    = "requires_action"
+	SandboxOutcomeProcessingError   = "processing_error"
+)
+
+// MagicCards lists the card numbers (and Stripe-style test tokens) the sandbox gateway recognizes.
+// Any other card succeeds.
+var MagicCards = []MagicCard{
+	{Number: "4242424242424242", Token: "pm_card_visa", Outcome: SandboxOutcomeSucceeded, Description: "Payment succeeds"},
+	{Number: "4000000000000002", Token: "pm_card_chargeDeclined", Outcome: SandboxOutcomeDeclined, Description: "Card is declined"},
+	{Number: "4000000000009995", Token: "pm_card_chargeDeclinedInsufficientFunds", Outcome: SandboxOutcomeInsufficientFunds, Description: "Declined for insufficient funds"},
+	{Number: "4000002500003155", Token: "pm_card_authenticationRequired", Outcome: SandboxOutcomeRequiresAction, Description: "Payment stays pending until confirmed by a webhook"},
+	{Number: "4000000000000119", Token: "pm_card_chargeDeclinedProcessingError", Outcome: SandboxOutcomeProcessingError, Description: "Gateway returns an error"},
+}
+
+// SandboxService simulates a payment gateway with deterministic IDs and magic card outcomes
+type SandboxService struct {
+	provider PaymentProvider
+
+	mu  sync.Mutex
+	seq map[string]int
+}
+
+// NewSandboxService creates a gateway simulator standing in for the given provider
+func NewSandboxService(provider PaymentProvider) *SandboxService {
+	return &SandboxService{
+		provider: provider,
+		seq:      make(map[string]int),
+	}
+}
+
+// Reset restarts the ID sequences so repeated test runs produce the same IDs
+func (s *SandboxService) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq = make(map[string]int)
+}
+
+// ProcessPayment simulates a charge, choosing the outcome from the card number or token
+func (s *SandboxService) ProcessPayment(ctx context.Context, req PaymentGatewayRequest) (*PaymentGatewayResponse, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("invalid payment amount: %.2f", req.Amount)
+	}
+
+	outcome := sandboxOutcome(req.PaymentMethodID, req.PaymentToken)
+	if outcome == SandboxOutcomeProcessingError {
+		return nil, fmt.Errorf("sandbox %s gateway processing error", s.provider)
+	}
+
+	transactionID := s.nextID("txn")
+	response := &PaymentGatewayResponse{
+		TransactionID: transactionID,
+		ExternalID:    transactionID,
+	}
+
+	switch outcome {
+	case SandboxOutcomeDeclined:
+		response.Status = "failed"
+		response.Message = "Your card was declined"
+	case SandboxOutcomeInsufficientFunds:
+		response.Status = "failed"
+		response.Message = "Your card has insufficient funds"
+	case SandboxOutcomeRequiresAction:
+		response.Success = true
+		response.Status = "pending"
+		response.Message = "Payment requires authentication"
+	default:
+		response.Success = true
+		response.Status = "succeeded"
+		response.Message = "Payment processed successfully"
+	}
+
+	return response, nil
+}
+
+// ProcessRefund simulates a refund; refunds always succeed
+func (s *SandboxService) ProcessRefund(ctx context.Context, req RefundGatewayRequest) (*RefundGatewayResponse, error) {
+	if req.TransactionID == "" {
+		return nil, fmt.Errorf("transaction ID is required")
+	}
+
+	return &RefundGatewayResponse{
+		Success:  true,
+		RefundID: s.nextID("re"),
+		Message:  "Refund processed successfully",
+		Status:   "succeeded",
+	}, nil
+}
+
+// CreateCheckoutSession simulates a hosted checkout. The session URL points straight at the
+// success URL; complete the payment by triggering a checkout.session.completed webhook.
+func (s *SandboxService) CreateCheckoutSession(ctx context.Context, req CheckoutSessionRequest) (*CheckoutSessionResponse, error) {
+	sessionID := s.nextID("cs")
+
+	sessionURL := req.SuccessURL
+	if strings.Contains(sessionURL, "{CHECKOUT_SESSION_ID}") {
+		sessionURL = strings.ReplaceAll(sessionURL, "{CHECKOUT_SESSION_ID}", sessionID)
+	}
+
+	return &CheckoutSessionResponse{
+		Success:    true,
+		SessionID:  sessionID,
+		SessionURL: sessionURL,
+		Message:    "Checkout session created successfully",
+	}, nil
+}
+
+// HandleWebhook decodes a webhook payload without signature verification. It accepts the flat
+// {"id","type","data"} shape as well as Stripe-shaped events carrying data.object. Dispute
+// objects are read in the Stripe or PayPal format matching the event type.
+func (s *SandboxService) HandleWebhook(ctx context.Context, payload []byte, signature string) (*WebhookEvent, error) {
+	var raw struct {
+		ID   string                 `json:"id"`
+		Type string                 `json:"type"`
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %v", err)
+	}
+	if raw.Type == "" {
+		return nil, fmt.Errorf("webhook event type is required")
+	}
+
+	data := raw.Data
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	if object, ok := data["object"].(map[string]interface{}); ok {
+		if id, ok := object["id"].(string); ok {
+			switch {
+			case strings.HasPrefix(raw.Type, "checkout.session."):
+				data["session_id"] = id
+			case strings.HasPrefix(raw.Type, "payment_intent."):
+				data["payment_intent_id"] = id
+			}
+		}
+	}
+
+	dispute, err := sandboxDispute(raw.Type, data["object"])
+	if err != nil {
+		return nil, err
+	}
+
+	id := raw.ID
+	if id == "" {
+		id = s.nextID("evt")
+	}
+
+	return &WebhookEvent{
+		ID:        id,
+		Type:      raw.Type,
+		Data:      data,
+		Dispute:   dispute,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// sandboxDispute decodes the dispute object of a Stripe or PayPal dispute event
+func sandboxDispute(eventType string, object interface{}) (*GatewayDispute, error) {
+	if object == nil {
+		return nil, nil
+	}
+	isStripe := strings.HasPrefix(eventType, "charge.dispute.")
+	if !isStripe && !strings.HasPrefix(eventType, "CUSTOMER.DISPUTE.") {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	if isStripe {
+		var dispute stripe.Dispute
+		if err := json.Unmarshal(raw, &dispute); err != nil {
+			return nil, fmt.Errorf("failed to parse dispute: %v", err)
+		}
+		return stripeGatewayDispute(&dispute), nil
+	}
+	var dispute PayPalDispute
+	if err := json.Unmarshal(raw, &dispute); err != nil {
+		return nil, fmt.Errorf("failed to parse dispute: %v", err)
+	}
+	return paypalGatewayDispute(&dispute), nil
+}
+
+// nextID returns a deterministic, provider-scoped ID such as cs_stripe_sbx_000001
+func (s *SandboxService) nextID(prefix string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq[prefix]++
+	return fmt.Sprintf("%s_%s_sbx_%06d", prefix, s.provider, s.seq[prefix])
+}
+
+// sandboxOutcome finds the magic card outcome for a payment method ID or token
+func sandboxOutcome(values ...string) string {
+	for _, value := range values {
+		value = strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+		if value == "" {
+			continue
+		}
+		for _, card := range MagicCards {
+			if value == card.Number || value == card.Token {
+				return card.Outcome
+			}
+		}
+	}
+	return SandboxOutcomeSucceeded
+}
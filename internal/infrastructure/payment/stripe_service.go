@@ -4,9 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/pkg/money"
 
 	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/balancetransaction"
 	"github.com/stripe/stripe-go/v76/checkout/session"
+	"github.com/stripe/stripe-go/v76/event"
 	"github.com/stripe/stripe-go/v76/paymentintent"
 	"github.com/stripe/stripe-go/v76/refund"
 	"github.com/stripe/stripe-go/v76/webhook"
@@ -37,8 +43,8 @@ func NewStripeServiceWithWebhook(apiKey, webhookSecret string) *StripeService {
 
 // ProcessPayment processes a payment through Stripe
 func (s *StripeService) ProcessPayment(ctx context.Context, req PaymentGatewayRequest) (*PaymentGatewayResponse, error) {
-	// Convert amount to cents (Stripe uses smallest currency unit)
-	amountCents := int64(req.Amount * 100)
+	// Convert amount to the currency's smallest unit, which Stripe uses
+	amountCents := money.ToMinor(req.Amount, req.Currency)
 
 	params := &stripe.PaymentIntentParams{
 		Amount:   stripe.Int64(amountCents),
@@ -63,6 +69,9 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req PaymentGatewayRe
 		params.Metadata = req.Metadata
 	}
 
+	// Bound the API call by the caller's deadline
+	params.Context = ctx
+
 	// Create payment intent
 	pi, err := paymentintent.New(params)
 	if err != nil {
@@ -86,8 +95,8 @@ func (s *StripeService) ProcessPayment(ctx context.Context, req PaymentGatewayRe
 
 // ProcessRefund processes a refund through Stripe
 func (s *StripeService) ProcessRefund(ctx context.Context, req RefundGatewayRequest) (*RefundGatewayResponse, error) {
-	// Convert amount to cents
-	amountCents := int64(req.Amount * 100)
+	// Convert amount to the smallest unit of the payment's currency
+	amountCents := money.ToMinor(req.Amount, req.Currency)
 
 	params := &stripe.RefundParams{
 		PaymentIntent: stripe.String(req.TransactionID),
@@ -98,6 +107,9 @@ func (s *StripeService) ProcessRefund(ctx context.Context, req RefundGatewayRequ
 		params.Reason = stripe.String(req.Reason)
 	}
 
+	// Bound the API call by the caller's deadline
+	params.Context = ctx
+
 	// Create refund
 	r, err := refund.New(params)
 	if err != nil {
@@ -138,8 +150,8 @@ func (s *StripeService) GetPaymentStatus(ctx context.Context, transactionID stri
 
 // CreateCheckoutSession creates a Stripe Checkout Session for hosted payment page
 func (s *StripeService) CreateCheckoutSession(ctx context.Context, req CheckoutSessionRequest) (*CheckoutSessionResponse, error) {
-	// Convert amount to cents (Stripe uses smallest currency unit)
-	amountCents := int64(req.Amount * 100)
+	// Convert amount to the currency's smallest unit, which Stripe uses
+	amountCents := money.ToMinor(req.Amount, req.Currency)
 
 	// Ensure description is not empty
 	description := req.Description
@@ -179,6 +191,9 @@ func (s *StripeService) CreateCheckoutSession(ctx context.Context, req CheckoutS
 		params.Metadata = req.Metadata
 	}
 
+	// Bound the API call by the caller's deadline
+	params.Context = ctx
+
 	// Create checkout session
 	sess, err := session.New(params)
 	if err != nil {
@@ -215,6 +230,23 @@ func (s *StripeService) HandleWebhook(ctx context.Context, payload []byte, signa
 		}
 	}
 
+	return decodeStripeEvent(event)
+}
+
+// FetchWebhookEvent retrieves an event from the Stripe API, e.g. to replay a webhook that was
+// missed or failed. Stripe keeps events for 30 days.
+func (s *StripeService) FetchWebhookEvent(ctx context.Context, eventID string) (*WebhookEvent, error) {
+	params := &stripe.EventParams{}
+	params.Context = ctx
+	stripeEvent, err := event.Get(eventID, params)
+	if err != nil {
+		return nil, err
+	}
+	return decodeStripeEvent(*stripeEvent)
+}
+
+// decodeStripeEvent extracts the fields the payment use case needs from a Stripe event
+func decodeStripeEvent(event stripe.Event) (*WebhookEvent, error) {
 	// Create webhook event response
 	webhookEvent := &WebhookEvent{
 		ID:   event.ID,
@@ -238,6 +270,9 @@ func (s *StripeService) HandleWebhook(ctx context.Context, payload []byte, signa
 			"currency":       session.Currency,
 			"metadata":       session.Metadata,
 		}
+		if session.PaymentIntent != nil {
+			webhookEvent.Data["payment_intent_id"] = session.PaymentIntent.ID
+		}
 
 	case "payment_intent.succeeded":
 		// Handle successful payment intent
@@ -272,6 +307,18 @@ func (s *StripeService) HandleWebhook(ctx context.Context, payload []byte, signa
 			"metadata":           paymentIntent.Metadata,
 		}
 
+	case "charge.dispute.created", "charge.dispute.updated", "charge.dispute.closed",
+		"charge.dispute.funds_withdrawn", "charge.dispute.funds_reinstated":
+		var dispute stripe.Dispute
+		if err := json.Unmarshal(event.Data.Raw, &dispute); err != nil {
+			return nil, fmt.Errorf("failed to parse dispute: %v", err)
+		}
+
+		webhookEvent.Dispute = stripeGatewayDispute(&dispute)
+		webhookEvent.Data = map[string]interface{}{
+			"dispute_id": dispute.ID,
+		}
+
 	default:
 		// For other event types, just store the raw data
 		webhookEvent.Data = map[string]interface{}{
@@ -281,3 +328,130 @@ func (s *StripeService) HandleWebhook(ctx context.Context, payload []byte, signa
 
 	return webhookEvent, nil
 }
+
+// stripeDisputeStatuses maps Stripe dispute statuses to normalized ones. Warnings are inquiries
+// that have not been escalated to a chargeback.
+var stripeDisputeStatuses = map[stripe.DisputeStatus]string{
+	stripe.DisputeStatusNeedsResponse:        DisputeStatusNeedsResponse,
+	stripe.DisputeStatusWarningNeedsResponse: DisputeStatusNeedsResponse,
+	stripe.DisputeStatusUnderReview:          DisputeStatusUnderReview,
+	stripe.DisputeStatusWarningUnderReview:   DisputeStatusUnderReview,
+	stripe.DisputeStatusWon:                  DisputeStatusWon,
+	stripe.DisputeStatusLost:                 DisputeStatusLost,
+	stripe.DisputeStatusWarningClosed:        DisputeStatusClosed,
+}
+
+// stripeGatewayDispute converts a Stripe dispute
+func stripeGatewayDispute(dispute *stripe.Dispute) *GatewayDispute {
+	gatewayDispute := &GatewayDispute{
+		ID:            dispute.ID,
+		Amount:        money.FromMinor(dispute.Amount, string(dispute.Currency)),
+		Currency:      strings.ToUpper(string(dispute.Currency)),
+		Reason:        string(dispute.Reason),
+		Status:        stripeDisputeStatuses[dispute.Status],
+		GatewayStatus: string(dispute.Status),
+	}
+	if gatewayDispute.Status == "" {
+		gatewayDispute.Status = DisputeStatusUnderReview
+	}
+	if dispute.PaymentIntent != nil && dispute.PaymentIntent.ID != "" {
+		gatewayDispute.TransactionIDs = append(gatewayDispute.TransactionIDs, dispute.PaymentIntent.ID)
+	}
+	if dispute.Charge != nil && dispute.Charge.ID != "" {
+		gatewayDispute.TransactionIDs = append(gatewayDispute.TransactionIDs, dispute.Charge.ID)
+	}
+	if details := dispute.EvidenceDetails; details != nil {
+		if details.DueBy > 0 {
+			dueBy := time.Unix(details.DueBy, 0)
+			gatewayDispute.EvidenceDueBy = &dueBy
+		}
+		gatewayDispute.EvidenceSubmitted = details.SubmissionCount > 0
+	}
+	return gatewayDispute
+}
+
+// ListBalanceTransactions lists the balance transactions created from from up to, but not
+// including, to. Their sources are expanded, so charges and refunds carry their payment intent
+// and original amount.
+func (s *StripeService) ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*BalanceTransaction, error) {
+	params := &stripe.BalanceTransactionListParams{
+		CreatedRange: &stripe.RangeQueryParams{
+			GreaterThanOrEqual: from.Unix(),
+			LesserThan:         to.Unix(),
+		},
+	}
+	params.Context = ctx
+	return listBalanceTransactions(params)
+}
+
+// ListPayoutTransactions lists the balance transactions paid out by a payout, including the
+// payout itself
+func (s *StripeService) ListPayoutTransactions(ctx context.Context, payoutID string) ([]*BalanceTransaction, error) {
+	params := &stripe.BalanceTransactionListParams{
+		Payout: stripe.String(payoutID),
+	}
+	params.Context = ctx
+	return listBalanceTransactions(params)
+}
+
+func listBalanceTransactions(params *stripe.BalanceTransactionListParams) ([]*BalanceTransaction, error) {
+	params.AddExpand("data.source")
+	params.Limit = stripe.Int64(100)
+
+	var transactions []*BalanceTransaction
+	iter := balancetransaction.List(params)
+	for iter.Next() {
+		transactions = append(transactions, stripeBalanceTransaction(iter.BalanceTransaction()))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list Stripe balance transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+// stripeBalanceTransaction converts a Stripe balance transaction with an expanded source
+func stripeBalanceTransaction(txn *stripe.BalanceTransaction) *BalanceTransaction {
+	transaction := &BalanceTransaction{
+		ID:          txn.ID,
+		Type:        string(txn.Type),
+		Currency:    strings.ToUpper(string(txn.Currency)),
+		Amount:      txn.Amount,
+		Fee:         txn.Fee,
+		Net:         txn.Net,
+		Description: txn.Description,
+		CreatedAt:   time.Unix(txn.Created, 0),
+		AvailableOn: time.Unix(txn.AvailableOn, 0),
+	}
+	if txn.Source == nil {
+		return transaction
+	}
+
+	transaction.SourceID = txn.Source.ID
+	switch {
+	case txn.Source.Charge != nil:
+		charge := txn.Source.Charge
+		transaction.SourceAmount = charge.Amount
+		transaction.SourceCurrency = strings.ToUpper(string(charge.Currency))
+		if charge.PaymentIntent != nil {
+			transaction.PaymentIntentID = charge.PaymentIntent.ID
+		}
+	case txn.Source.Refund != nil:
+		refund := txn.Source.Refund
+		transaction.SourceAmount = refund.Amount
+		transaction.SourceCurrency = strings.ToUpper(string(refund.Currency))
+		if refund.PaymentIntent != nil {
+			transaction.PaymentIntentID = refund.PaymentIntent.ID
+		}
+	case txn.Source.Dispute != nil:
+		dispute := txn.Source.Dispute
+		transaction.SourceAmount = dispute.Amount
+		transaction.SourceCurrency = strings.ToUpper(string(dispute.Currency))
+		if dispute.PaymentIntent != nil {
+			transaction.PaymentIntentID = dispute.PaymentIntent.ID
+		}
+	case txn.Source.Payout != nil:
+		transaction.SourceAmount = txn.Source.Payout.Amount
+		transaction.SourceCurrency = strings.ToUpper(string(txn.Source.Payout.Currency))
+	}
+	return transaction
+}
@@ -27,6 +27,7 @@ type PaymentGatewayResponse struct {
 type RefundGatewayRequest struct {
 	TransactionID string  `json:"transaction_id"`
 	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
 	Reason        string  `json:"reason,omitempty"`
 }
 
@@ -64,6 +65,51 @@ type WebhookEvent struct {
 	Type      string                 `json:"type"`
 	Data      map[string]interface{} `json:"data"`
 	CreatedAt time.Time              `json:"created_at"`
+
+	// Dispute is set for dispute and chargeback events
+	Dispute *GatewayDispute `json:"dispute,omitempty"`
+}
+
+// Dispute statuses, normalized across providers
+const (
+	DisputeStatusNeedsResponse = "needs_response" // Evidence or a response is due from the merchant
+	DisputeStatusUnderReview   = "under_review"   // Responded to; the provider or bank is deciding
+	DisputeStatusWon           = "won"            // Resolved in the merchant's favor
+	DisputeStatusLost          = "lost"           // Resolved in the customer's favor; the funds are gone
+	DisputeStatusClosed        = "closed"         // Closed without a chargeback, e.g. an inquiry that was not escalated
+)
+
+// GatewayDispute is a dispute or chargeback as reported by a payment provider
+type GatewayDispute struct {
+	ID string `json:"id"`
+	// TransactionIDs identify the disputed payment, e.g. a Stripe payment intent and charge
+	TransactionIDs    []string   `json:"transaction_ids"`
+	Amount            float64    `json:"amount"`
+	Currency          string     `json:"currency"`
+	Reason            string     `json:"reason"`
+	Status            string     `json:"status"`
+	GatewayStatus     string     `json:"gateway_status"` // The provider's own status
+	EvidenceDueBy     *time.Time `json:"evidence_due_by,omitempty"`
+	EvidenceSubmitted bool       `json:"evidence_submitted"`
+}
+
+// BalanceTransaction is a movement of funds in a provider balance, such as a charge, a refund,
+// a dispute or a payout. Amounts are in minor units: Amount, Fee and Net of the balance
+// currency, SourceAmount of the charge, refund or dispute as the customer saw it.
+type BalanceTransaction struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"` // charge, refund, adjustment, payout, ...
+	SourceID        string    `json:"source_id"`
+	PaymentIntentID string    `json:"payment_intent_id,omitempty"`
+	Currency        string    `json:"currency"`
+	Amount          int64     `json:"amount"`
+	Fee             int64     `json:"fee"`
+	Net             int64     `json:"net"`
+	SourceCurrency  string    `json:"source_currency,omitempty"`
+	SourceAmount    int64     `json:"source_amount,omitempty"`
+	Description     string    `json:"description,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	AvailableOn     time.Time `json:"available_on"`
 }
 
 // WebhookEventType represents different types of webhook events
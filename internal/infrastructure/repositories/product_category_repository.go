@@ -6,6 +6,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -187,7 +188,7 @@ func (r *productCategoryRepository) AssignProductToCategory(ctx context.Context,
 
 	// Create new relationship
 	productCategory := &entities.ProductCategory{
-		ID:         uuid.New(),
+		ID:         ids.New(),
 		ProductID:  productID,
 		CategoryID: categoryID,
 		IsPrimary:  isPrimary,
@@ -342,6 +343,28 @@ func (r *productCategoryRepository) SearchProductsByCategories(ctx context.Conte
 	return products, err
 }
 
+// GetCategoryIDsByProductIDs gets the category IDs assigned to each of the given products
+func (r *productCategoryRepository) GetCategoryIDsByProductIDs(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	result := make(map[uuid.UUID][]uuid.UUID)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var assignments []entities.ProductCategory
+	err := r.db.WithContext(ctx).
+		Select("product_id", "category_id").
+		Where("product_id IN ?", productIDs).
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range assignments {
+		result[assignment.ProductID] = append(result[assignment.ProductID], assignment.CategoryID)
+	}
+	return result, nil
+}
+
 // GetProductsInCategoryHierarchy gets all products in a category and its subcategories
 func (r *productCategoryRepository) GetProductsInCategoryHierarchy(ctx context.Context, categoryID uuid.UUID) ([]*entities.Product, error) {
 	return r.SearchProductsByCategories(ctx, []uuid.UUID{categoryID}, true)
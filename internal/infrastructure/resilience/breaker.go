@@ -0,0 +1,311 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+// String returns the state name
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Errors returned instead of calling the provider. Their messages contain "service unavailable"
+// so callers that classify errors by message treat them as retryable.
+var (
+	ErrCircuitOpen     = errors.New("circuit breaker is open: service unavailable")
+	ErrTooManyRequests = errors.New("too many concurrent calls: service unavailable")
+	ErrTimeout         = errors.New("call timeout: service unavailable")
+)
+
+// IsUnavailable reports whether err means the provider was skipped or did not answer in time
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrTooManyRequests) || errors.Is(err, ErrTimeout)
+}
+
+// Settings configures a circuit breaker
+type Settings struct {
+	Timeout          time.Duration // Per-call deadline
+	MaxConcurrent    int           // In-flight calls allowed before new calls are shed
+	FailureThreshold int           // Consecutive failures that open the circuit
+	OpenDuration     time.Duration // How long the circuit stays open before a trial call
+
+	// IsFailure decides whether an error counts against the provider. Defaults to every error;
+	// use it to ignore client errors such as declined cards.
+	IsFailure func(err error) bool
+}
+
+// DefaultSettings returns settings suitable for most HTTP providers
+func DefaultSettings() Settings {
+	return Settings{
+		Timeout:          10 * time.Second,
+		MaxConcurrent:    20,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+	}
+}
+
+// Breaker guards calls to one external provider with a timeout, a concurrency limit and a
+// circuit that opens after repeated failures so slow providers can't tie up request workers
+type Breaker struct {
+	name     string
+	settings Settings
+	slots    chan struct{}
+
+	mu                  sync.Mutex
+	state               State
+	consecutiveFailures int
+	openedAt            time.Time
+	trialInFlight       bool
+	stateChangedAt      time.Time
+	lastError           string
+	counts              Counts
+}
+
+// Counts are cumulative call counters
+type Counts struct {
+	Requests   int64 `json:"requests"`
+	Successes  int64 `json:"successes"`
+	Failures   int64 `json:"failures"`
+	Timeouts   int64 `json:"timeouts"`
+	Rejections int64 `json:"rejections"`
+}
+
+// Snapshot describes the current state of a breaker
+type Snapshot struct {
+	Name                string    `json:"name"`
+	State               string    `json:"state"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	InFlight            int       `json:"in_flight"`
+	MaxConcurrent       int       `json:"max_concurrent"`
+	TimeoutMs           int64     `json:"timeout_ms"`
+	StateChangedAt      time.Time `json:"state_changed_at"`
+	LastError           string    `json:"last_error,omitempty"`
+	Counts              Counts    `json:"counts"`
+}
+
+// NewBreaker creates a closed circuit breaker
+func NewBreaker(name string, settings Settings) *Breaker {
+	defaults := DefaultSettings()
+	if settings.Timeout <= 0 {
+		settings.Timeout = defaults.Timeout
+	}
+	if settings.MaxConcurrent <= 0 {
+		settings.MaxConcurrent = defaults.MaxConcurrent
+	}
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = defaults.FailureThreshold
+	}
+	if settings.OpenDuration <= 0 {
+		settings.OpenDuration = defaults.OpenDuration
+	}
+
+	return &Breaker{
+		name:           name,
+		settings:       settings,
+		slots:          make(chan struct{}, settings.MaxConcurrent),
+		state:          StateClosed,
+		stateChangedAt: time.Now(),
+	}
+}
+
+// Name returns the breaker name
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state, moving an expired open circuit to half-open
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refreshState(time.Now())
+	return b.state
+}
+
+// Execute runs fn under the breaker. fn receives a context carrying the per-call deadline; if it
+// does not return in time Execute returns ErrTimeout without waiting for it. The abandoned call
+// keeps its concurrency slot until it finishes, so a hung provider sheds load instead of piling up.
+func (b *Breaker) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	trial, err := b.admit()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+	default:
+		b.reject(trial)
+		return ErrTooManyRequests
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, b.settings.Timeout)
+	done := make(chan error, 1)
+	go func() {
+		defer func() { <-b.slots }()
+		defer cancel()
+		done <- fn(callCtx)
+	}()
+
+	select {
+	case err = <-done:
+	case <-callCtx.Done():
+		if ctx.Err() != nil {
+			// The caller gave up; that says nothing about the provider
+			b.release(trial)
+			return ctx.Err()
+		}
+		err = ErrTimeout
+	}
+
+	b.record(trial, err)
+	return err
+}
+
+// Call runs fn under the breaker and returns its result
+func Call[T any](ctx context.Context, b *Breaker, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	var mu sync.Mutex
+	err := b.Execute(ctx, func(ctx context.Context) error {
+		value, err := fn(ctx)
+		mu.Lock()
+		result = value
+		mu.Unlock()
+		return err
+	})
+	if errors.Is(err, ErrTimeout) {
+		var zero T
+		return zero, err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return result, err
+}
+
+// Snapshot returns the current state and counters
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refreshState(time.Now())
+
+	return Snapshot{
+		Name:                b.name,
+		State:               b.state.String(),
+		ConsecutiveFailures: b.consecutiveFailures,
+		InFlight:            len(b.slots),
+		MaxConcurrent:       b.settings.MaxConcurrent,
+		TimeoutMs:           b.settings.Timeout.Milliseconds(),
+		StateChangedAt:      b.stateChangedAt,
+		LastError:           b.lastError,
+		Counts:              b.counts,
+	}
+}
+
+// admit decides whether a call may proceed; in half-open state only one trial call runs at a time
+func (b *Breaker) admit() (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.counts.Requests++
+	b.refreshState(time.Now())
+
+	switch b.state {
+	case StateOpen:
+		b.counts.Rejections++
+		return false, ErrCircuitOpen
+	case StateHalfOpen:
+		if b.trialInFlight {
+			b.counts.Rejections++
+			return false, ErrCircuitOpen
+		}
+		b.trialInFlight = true
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// reject records a call shed by the concurrency limit
+func (b *Breaker) reject(trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.counts.Rejections++
+	if trial {
+		b.trialInFlight = false
+	}
+}
+
+// release frees a trial slot without recording an outcome
+func (b *Breaker) release(trial bool) {
+	if !trial {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trialInFlight = false
+}
+
+// record updates counters and state with the outcome of a call
+func (b *Breaker) record(trial bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if trial {
+		b.trialInFlight = false
+	}
+
+	failed := err != nil && (errors.Is(err, ErrTimeout) || b.settings.IsFailure == nil || b.settings.IsFailure(err))
+	if errors.Is(err, ErrTimeout) {
+		b.counts.Timeouts++
+	}
+
+	if !failed {
+		b.counts.Successes++
+		b.consecutiveFailures = 0
+		if b.state != StateClosed {
+			b.setState(StateClosed, time.Now())
+		}
+		return
+	}
+
+	b.counts.Failures++
+	b.consecutiveFailures++
+	b.lastError = err.Error()
+	if b.state == StateHalfOpen || b.consecutiveFailures >= b.settings.FailureThreshold {
+		b.setState(StateOpen, time.Now())
+	}
+}
+
+// refreshState moves an open circuit to half-open once the open duration has passed
+func (b *Breaker) refreshState(now time.Time) {
+	if b.state == StateOpen && now.Sub(b.openedAt) >= b.settings.OpenDuration {
+		b.setState(StateHalfOpen, now)
+	}
+}
+
+func (b *Breaker) setState(state State, now time.Time) {
+	if state == StateOpen {
+		b.openedAt = now
+	}
+	b.state = state
+	b.stateChangedAt = now
+}
@@ -0,0 +1,110 @@
+package resilience
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Registry keeps the breakers of all external providers so their state can be reported
+type Registry struct {
+	mu       sync.RWMutex
+	breakers map[string]*Breaker
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		breakers: make(map[string]*Breaker),
+	}
+}
+
+// Breaker returns the named breaker, creating it with settings on first use
+func (r *Registry) Breaker(name string, settings Settings) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if breaker, exists := r.breakers[name]; exists {
+		return breaker
+	}
+	breaker := NewBreaker(name, settings)
+	r.breakers[name] = breaker
+	return breaker
+}
+
+// Snapshots returns the state of every breaker sorted by name
+func (r *Registry) Snapshots() []Snapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshots := make([]Snapshot, 0, len(r.breakers))
+	for _, breaker := range r.breakers {
+		snapshots = append(snapshots, breaker.Snapshot())
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots
+}
+
+// WritePrometheus writes breaker metrics in the Prometheus text exposition format
+func (r *Registry) WritePrometheus(w io.Writer) error {
+	snapshots := r.Snapshots()
+
+	gauges := []struct {
+		name  string
+		help  string
+		value func(s Snapshot) int64
+	}{
+		{"circuit_breaker_state", "Circuit state (0 closed, 1 half-open, 2 open)", func(s Snapshot) int64 { return int64(stateValue(s.State)) }},
+		{"circuit_breaker_consecutive_failures", "Consecutive failed calls", func(s Snapshot) int64 { return int64(s.ConsecutiveFailures) }},
+		{"circuit_breaker_in_flight", "Calls currently in flight", func(s Snapshot) int64 { return int64(s.InFlight) }},
+	}
+	counters := []struct {
+		name  string
+		help  string
+		value func(s Snapshot) int64
+	}{
+		{"circuit_breaker_requests_total", "Calls attempted", func(s Snapshot) int64 { return s.Counts.Requests }},
+		{"circuit_breaker_successes_total", "Calls that succeeded", func(s Snapshot) int64 { return s.Counts.Successes }},
+		{"circuit_breaker_failures_total", "Calls that failed, including timeouts", func(s Snapshot) int64 { return s.Counts.Failures }},
+		{"circuit_breaker_timeouts_total", "Calls that exceeded their deadline", func(s Snapshot) int64 { return s.Counts.Timeouts }},
+		{"circuit_breaker_rejections_total", "Calls rejected by an open circuit or the concurrency limit", func(s Snapshot) int64 { return s.Counts.Rejections }},
+	}
+
+	for _, metric := range gauges {
+		if err := writeMetric(w, metric.name, "gauge", metric.help, snapshots, metric.value); err != nil {
+			return err
+		}
+	}
+	for _, metric := range counters {
+		if err := writeMetric(w, metric.name, "counter", metric.help, snapshots, metric.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMetric(w io.Writer, name, kind, help string, snapshots []Snapshot, value func(s Snapshot) int64) error {
+	if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind); err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if _, err := fmt.Fprintf(w, "%s{breaker=%q} %d\n", name, snapshot.Name, value(snapshot)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stateValue(state string) int {
+	switch state {
+	case StateHalfOpen.String():
+		return int(StateHalfOpen)
+	case StateOpen.String():
+		return int(StateOpen)
+	default:
+		return int(StateClosed)
+	}
+}
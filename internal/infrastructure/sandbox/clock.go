@@ -0,0 +1,46 @@
+package sandbox
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a wall clock that can be moved forward to exercise time-based behavior
+// such as abandoned cart reminders without waiting
+type Clock struct {
+	mu     sync.RWMutex
+	offset time.Duration
+}
+
+// NewClock creates a clock that starts at the real current time
+func NewClock() *Clock {
+	return &Clock{}
+}
+
+// Now returns the real time shifted by the accumulated offset
+func (c *Clock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Now().Add(c.offset)
+}
+
+// Offset returns how far the clock has been advanced
+func (c *Clock) Offset() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset
+}
+
+// Advance moves the clock forward by d
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
+
+// Reset returns the clock to the real current time
+func (c *Clock) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset = 0
+}
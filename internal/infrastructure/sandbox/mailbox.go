@@ -0,0 +1,122 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// mailboxCapacity caps how many emails are kept; the oldest are dropped first
+const mailboxCapacity = 500
+
+// CapturedEmail represents an email captured instead of being delivered
+type CapturedEmail struct {
+	ID         string    `json:"id"`
+	To         string    `json:"to"`
+	ToName     string    `json:"to_name,omitempty"`
+	From       string    `json:"from,omitempty"`
+	Subject    string    `json:"subject"`
+	BodyText   string    `json:"body_text,omitempty"`
+	BodyHTML   string    `json:"body_html,omitempty"`
+	Template   string    `json:"template,omitempty"`
+	CapturedAt time.Time `json:"captured_at"`
+}
+
+// Mailbox captures outgoing emails in memory so they can be read back through the API.
+// It doubles as an email provider for the domain email service.
+type Mailbox struct {
+	mu     sync.RWMutex
+	clock  *Clock
+	emails []*CapturedEmail
+	seq    int
+}
+
+// NewMailbox creates an empty mailbox stamping emails with the sandbox clock
+func NewMailbox(clock *Clock) *Mailbox {
+	return &Mailbox{clock: clock}
+}
+
+// Capture stores an email and returns its deterministic ID
+func (m *Mailbox) Capture(email CapturedEmail) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.seq++
+	email.ID = fmt.Sprintf("msg_sbx_%06d", m.seq)
+	email.CapturedAt = m.clock.Now()
+
+	m.emails = append(m.emails, &email)
+	if len(m.emails) > mailboxCapacity {
+		m.emails = m.emails[len(m.emails)-mailboxCapacity:]
+	}
+	return email.ID
+}
+
+// List returns captured emails, newest first, optionally filtered by recipient
+func (m *Mailbox) List(to string) []*CapturedEmail {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	to = strings.ToLower(strings.TrimSpace(to))
+	emails := make([]*CapturedEmail, 0, len(m.emails))
+	for i := len(m.emails) - 1; i >= 0; i-- {
+		if to == "" || strings.ToLower(m.emails[i].To) == to {
+			emails = append(emails, m.emails[i])
+		}
+	}
+	return emails
+}
+
+// Get returns a captured email by ID
+func (m *Mailbox) Get(id string) (*CapturedEmail, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, email := range m.emails {
+		if email.ID == id {
+			return email, true
+		}
+	}
+	return nil, false
+}
+
+// Clear removes all captured emails and restarts the ID sequence
+func (m *Mailbox) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emails = nil
+	m.seq = 0
+}
+
+// SendEmail captures a domain email (services.EmailProvider)
+func (m *Mailbox) SendEmail(ctx context.Context, email *entities.Email) (string, error) {
+	return m.Capture(CapturedEmail{
+		To:       email.ToEmail,
+		ToName:   email.ToName,
+		From:     email.FromEmail,
+		Subject:  email.Subject,
+		BodyText: email.BodyText,
+		BodyHTML: email.BodyHTML,
+		Template: email.TemplateID,
+	}), nil
+}
+
+// SendBulkEmails captures several domain emails (services.EmailProvider)
+func (m *Mailbox) SendBulkEmails(ctx context.Context, emails []*entities.Email) (map[uuid.UUID]string, error) {
+	results := make(map[uuid.UUID]string, len(emails))
+	for _, email := range emails {
+		results[email.ID], _ = m.SendEmail(ctx, email)
+	}
+	return results, nil
+}
+
+// ValidateConfiguration always succeeds; the mailbox needs no configuration
+func (m *Mailbox) ValidateConfiguration() error {
+	return nil
+}
@@ -7,8 +7,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
-
-	"github.com/google/uuid"
+	"ecom-golang-clean-architecture/pkg/ids"
 )
 
 // EmailTemplateService handles email template operations
@@ -47,7 +46,7 @@ func (s *EmailTemplateService) InitializeDefaultTemplates(ctx context.Context) e
 func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 	return []*entities.EmailTemplate{
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "email_verification",
 			Type:        entities.EmailTypeWelcome,
 			Subject:     "Verify Your Email Address",
@@ -60,7 +59,7 @@ func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 			UpdatedAt:   time.Now(),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "password_reset",
 			Type:        entities.EmailTypePasswordReset,
 			Subject:     "Reset Your Password",
@@ -73,7 +72,7 @@ func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 			UpdatedAt:   time.Now(),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "welcome",
 			Type:        entities.EmailTypeWelcome,
 			Subject:     "Welcome to Our Store!",
@@ -86,7 +85,7 @@ func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 			UpdatedAt:   time.Now(),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "order_confirmation",
 			Type:        entities.EmailTypeOrderConfirmation,
 			Subject:     "Order Confirmation - Order #{{.order_number}}",
@@ -99,7 +98,7 @@ func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 			UpdatedAt:   time.Now(),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "order_shipped",
 			Type:        entities.EmailTypeOrderShipped,
 			Subject:     "Your Order Has Been Shipped - Order #{{.order_number}}",
@@ -111,6 +110,19 @@ func (s *EmailTemplateService) getDefaultTemplates() []*entities.EmailTemplate {
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
 		},
+		{
+			ID:          ids.New(),
+			Name:        "abandoned_cart",
+			Type:        entities.EmailTypeAbandonedCart,
+			Subject:     "You Left Something in Your Cart",
+			BodyText:    s.getAbandonedCartTextTemplate(),
+			BodyHTML:    s.getAbandonedCartHTMLTemplate(),
+			IsActive:    true,
+			Version:     1,
+			Description: "Abandoned cart reminder email",
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
 	}
 }
 
@@ -380,3 +392,49 @@ func (s *EmailTemplateService) getOrderShippedHTMLTemplate() string {
 </body>
 </html>`
 }
+
+func (s *EmailTemplateService) getAbandonedCartTextTemplate() string {
+	return `Hi {{.first_name}},
+
+You left some items in your cart. They are still waiting for you:
+
+{{.cart_url}}
+
+Best regards,
+The E-commerce Team`
+}
+
+func (s *EmailTemplateService) getAbandonedCartHTMLTemplate() string {
+	return `<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Your Cart Is Waiting</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background: #fd7e14; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background: #f9f9f9; }
+        .button { display: inline-block; padding: 12px 24px; background: #fd7e14; color: white; text-decoration: none; border-radius: 4px; }
+        .footer { padding: 20px; text-align: center; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Your Cart Is Waiting</h1>
+        </div>
+        <div class="content">
+            <p>Hi {{.first_name}},</p>
+            <p>You left some items in your cart. They are still waiting for you.</p>
+            <p style="text-align: center;">
+                <a href="{{.cart_url}}" class="button">Return to Cart</a>
+            </p>
+        </div>
+        <div class="footer">
+            <p>Best regards,<br>The E-commerce Team</p>
+        </div>
+    </div>
+</body>
+</html>`
+}
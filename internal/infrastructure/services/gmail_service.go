@@ -5,17 +5,41 @@ import (
 	"crypto/tls"
 	"fmt"
 	"log"
+	"net"
 	"net/smtp"
 	"strings"
+	"sync"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	"ecom-golang-clean-architecture/internal/infrastructure/sandbox"
 )
 
+const (
+	// emailQueueCapacity caps how many undelivered emails are held for retry
+	emailQueueCapacity = 1000
+	// emailMaxAttempts is how many deliveries are tried before a queued email is dropped
+	emailMaxAttempts = 5
+)
+
+// queuedEmail is an email held back while the SMTP provider is unavailable
+type queuedEmail struct {
+	to       string
+	message  []byte
+	attempts int
+	queuedAt time.Time
+}
+
 // GmailService handles email sending via Gmail SMTP
 type GmailService struct {
-	config *config.EmailConfig
-	auth   smtp.Auth
+	config  *config.EmailConfig
+	auth    smtp.Auth
+	mailbox *sandbox.Mailbox
+	breaker *resilience.Breaker
+
+	queueMu sync.Mutex
+	queue   []*queuedEmail
 }
 
 // NewGmailService creates a new Gmail service
@@ -31,6 +55,17 @@ func NewGmailService(config *config.EmailConfig) *GmailService {
 	}
 }
 
+// CaptureTo routes all outgoing email into the sandbox mailbox instead of SMTP
+func (g *GmailService) CaptureTo(mailbox *sandbox.Mailbox) {
+	g.mailbox = mailbox
+}
+
+// UseBreaker guards SMTP delivery with a circuit breaker. While SMTP is unavailable, emails are
+// queued and delivered later by FlushQueue instead of failing the request.
+func (g *GmailService) UseBreaker(breaker *resilience.Breaker) {
+	g.breaker = breaker
+}
+
 // SendEmail sends an email via Gmail SMTP
 func (g *GmailService) SendEmail(ctx context.Context, to, subject, body string) error {
 	return g.SendEmailWithTemplate(ctx, to, subject, body, "")
@@ -38,6 +73,17 @@ func (g *GmailService) SendEmail(ctx context.Context, to, subject, body string)
 
 // SendEmailWithTemplate sends an email with HTML template
 func (g *GmailService) SendEmailWithTemplate(ctx context.Context, to, subject, bodyText, bodyHTML string) error {
+	if g.mailbox != nil {
+		g.mailbox.Capture(sandbox.CapturedEmail{
+			To:       to,
+			From:     g.config.FromEmail,
+			Subject:  subject,
+			BodyText: bodyText,
+			BodyHTML: bodyHTML,
+		})
+		return nil
+	}
+
 	// Build email message
 	message, err := g.buildEmailMessage(to, subject, bodyText, bodyHTML)
 	if err != nil {
@@ -45,13 +91,81 @@ func (g *GmailService) SendEmailWithTemplate(ctx context.Context, to, subject, b
 	}
 
 	// Send email
-	if err := g.sendSMTP(to, message); err != nil {
+	if err := g.deliver(ctx, to, message); err != nil {
+		if resilience.IsUnavailable(err) {
+			g.enqueue(&queuedEmail{to: to, message: message, attempts: 1, queuedAt: time.Now()})
+			log.Printf("📥 SMTP unavailable, queued email to %s for retry: %v", to, err)
+			return nil
+		}
 		return fmt.Errorf("failed to send email via Gmail SMTP: %w", err)
 	}
 
 	return nil
 }
 
+// FlushQueue retries queued emails and returns how many were delivered
+func (g *GmailService) FlushQueue(ctx context.Context) (int, error) {
+	g.queueMu.Lock()
+	pending := g.queue
+	g.queue = nil
+	g.queueMu.Unlock()
+
+	sent := 0
+	for i, email := range pending {
+		err := g.deliver(ctx, email.to, email.message)
+		if err == nil {
+			sent++
+			continue
+		}
+
+		email.attempts++
+		if resilience.IsUnavailable(err) {
+			// Still unavailable: keep this and the remaining emails for the next run
+			for _, remaining := range pending[i:] {
+				g.enqueue(remaining)
+			}
+			return sent, err
+		}
+		if email.attempts >= emailMaxAttempts {
+			log.Printf("❌ Dropping email to %s after %d attempts: %v", email.to, email.attempts, err)
+			continue
+		}
+		g.enqueue(email)
+	}
+
+	return sent, nil
+}
+
+// QueueLength returns the number of emails waiting for retry
+func (g *GmailService) QueueLength() int {
+	g.queueMu.Lock()
+	defer g.queueMu.Unlock()
+	return len(g.queue)
+}
+
+// deliver sends a built message, through the breaker when one is configured
+func (g *GmailService) deliver(ctx context.Context, to string, message []byte) error {
+	if g.breaker == nil {
+		return g.sendSMTP(ctx, to, message)
+	}
+	return g.breaker.Execute(ctx, func(ctx context.Context) error {
+		return g.sendSMTP(ctx, to, message)
+	})
+}
+
+// enqueue holds an email for retry, dropping the oldest when the queue is full
+func (g *GmailService) enqueue(email *queuedEmail) {
+	g.queueMu.Lock()
+	defer g.queueMu.Unlock()
+
+	g.queue = append(g.queue, email)
+	if len(g.queue) > emailQueueCapacity {
+		dropped := g.queue[0]
+		g.queue = g.queue[1:]
+		log.Printf("❌ Email retry queue full, dropping email to %s", dropped.to)
+	}
+}
+
 // SendVerificationEmail sends email verification
 func (g *GmailService) SendVerificationEmail(ctx context.Context, to, firstName, verificationLink string) error {
 	subject := "Verify Your Email Address"
@@ -448,6 +562,9 @@ Best regards,
 
 // ValidateConfiguration validates Gmail SMTP configuration
 func (g *GmailService) ValidateConfiguration() error {
+	if g.mailbox != nil {
+		return nil
+	}
 	if g.config.SMTPHost == "" {
 		return fmt.Errorf("SMTP host is required")
 	}
@@ -465,7 +582,21 @@ func (g *GmailService) ValidateConfiguration() error {
 	}
 
 	// Test connection
-	return g.testConnection()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return g.testConnection(ctx)
+}
+
+// CheckHealth checks that the SMTP server accepts a connection and the configured credentials.
+// Emails captured by the sandbox mailbox are always deliverable.
+func (g *GmailService) CheckHealth(ctx context.Context) error {
+	if g.mailbox != nil {
+		return nil
+	}
+	if g.config.SMTPHost == "" || g.config.SMTPPort == "" {
+		return fmt.Errorf("SMTP is not configured")
+	}
+	return g.testConnection(ctx)
 }
 
 // buildEmailMessage builds the email message in RFC 5322 format
@@ -514,12 +645,12 @@ func (g *GmailService) buildEmailMessage(to, subject, bodyText, bodyHTML string)
 }
 
 // sendSMTP sends the email via SMTP with TLS
-func (g *GmailService) sendSMTP(to string, message []byte) error {
+func (g *GmailService) sendSMTP(ctx context.Context, to string, message []byte) error {
 	addr := fmt.Sprintf("%s:%s", g.config.SMTPHost, g.config.SMTPPort)
 	log.Printf("🔄 Connecting to SMTP server: %s", addr)
 	log.Printf("🔄 From: %s, To: %s", g.config.FromEmail, to)
 
-	client, err := smtp.Dial(addr)
+	client, err := g.dialSMTP(ctx, addr)
 	if err != nil {
 		log.Printf("❌ Failed to connect to SMTP server: %v", err)
 		return fmt.Errorf("failed to connect to SMTP server: %w", err)
@@ -574,10 +705,10 @@ func (g *GmailService) sendSMTP(to string, message []byte) error {
 }
 
 // testConnection tests the Gmail SMTP connection
-func (g *GmailService) testConnection() error {
+func (g *GmailService) testConnection(ctx context.Context) error {
 	addr := fmt.Sprintf("%s:%s", g.config.SMTPHost, g.config.SMTPPort)
-	
-	client, err := smtp.Dial(addr)
+
+	client, err := g.dialSMTP(ctx, addr)
 	if err != nil {
 		return fmt.Errorf("failed to connect to SMTP server: %w", err)
 	}
@@ -598,3 +729,25 @@ func (g *GmailService) testConnection() error {
 
 	return nil
 }
+
+// dialSMTP connects to the SMTP server, bounding the whole conversation by the context deadline
+func (g *GmailService) dialSMTP(ctx context.Context, addr string) (*smtp.Client, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, g.config.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
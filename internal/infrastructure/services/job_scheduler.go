@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+// JobFunc is a unit of periodic background work
+type JobFunc func(ctx context.Context) error
+
+// JobState describes the current state of a scheduled job
+type JobState = entities.JobState
+
+type scheduledJob struct {
+	fn    JobFunc
+	state JobState
+}
+
+// JobScheduler runs registered jobs on fixed intervals in the background
+type JobScheduler struct {
+	jobs     map[string]*scheduledJob
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	running  bool
+	mu       sync.RWMutex
+}
+
+// NewJobScheduler creates a new job scheduler
+func NewJobScheduler() *JobScheduler {
+	return &JobScheduler{
+		jobs:     make(map[string]*scheduledJob),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Register registers a job to run every interval. Jobs must be registered before Start.
+func (s *JobScheduler) Register(name string, interval time.Duration, fn JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	s.jobs[name] = &scheduledJob{
+		fn: fn,
+		state: JobState{
+			Name:     name,
+			Interval: interval,
+		},
+	}
+}
+
+// Start starts all registered jobs
+func (s *JobScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return fmt.Errorf("job scheduler is already running")
+	}
+
+	s.running = true
+	log.Printf("Starting job scheduler with %d jobs", len(s.jobs))
+
+	for name := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, name)
+	}
+
+	return nil
+}
+
+// Stop stops all running jobs
+func (s *JobScheduler) Stop() error {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return fmt.Errorf("job scheduler is not running")
+	}
+	close(s.stopChan)
+	s.mu.Unlock()
+
+	s.wg.Wait()
+
+	s.mu.Lock()
+	s.running = false
+	s.mu.Unlock()
+	log.Println("Job scheduler stopped")
+
+	return nil
+}
+
+// RunNow runs a registered job immediately in the caller's goroutine
+func (s *JobScheduler) RunNow(ctx context.Context, name string) error {
+	s.mu.RLock()
+	_, exists := s.jobs[name]
+	s.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("job %s is not registered", name)
+	}
+
+	return s.run(ctx, name)
+}
+
+// JobNames returns the registered job names sorted alphabetically
+func (s *JobScheduler) JobNames() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// States returns a snapshot of all job states sorted by name
+func (s *JobScheduler) States() []JobState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := make([]JobState, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		states = append(states, job.state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Name < states[j].Name
+	})
+	return states
+}
+
+// loop runs a job on its interval until stopped
+func (s *JobScheduler) loop(ctx context.Context, name string) {
+	defer s.wg.Done()
+
+	s.mu.RLock()
+	interval := s.jobs[name].state.Interval
+	s.mu.RUnlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopChan:
+			return
+		case <-ticker.C:
+			if err := s.run(ctx, name); err != nil {
+				log.Printf("Job %s failed: %v", name, err)
+			}
+		}
+	}
+}
+
+// run executes a job once and records its state
+func (s *JobScheduler) run(ctx context.Context, name string) error {
+	s.mu.Lock()
+	job := s.jobs[name]
+	if job.state.Running {
+		s.mu.Unlock()
+		return fmt.Errorf("job %s is already running", name)
+	}
+	job.state.Running = true
+	startedAt := time.Now()
+	job.state.LastRunAt = &startedAt
+	s.mu.Unlock()
+
+	err := job.fn(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job.state.Running = false
+	job.state.RunCount++
+	job.state.LastDurationMs = time.Since(startedAt).Milliseconds()
+	if err != nil {
+		job.state.FailureCount++
+		job.state.LastError = err.Error()
+		return err
+	}
+	finishedAt := time.Now()
+	job.state.LastSuccessAt = &finishedAt
+	job.state.LastError = ""
+	return nil
+}
@@ -3,18 +3,20 @@ package services
 import (
 	"time"
 
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
 // JWTService implements JWT token generation
 type JWTService struct {
-	secret string
+	keys *jwtkeys.KeySet
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService(secret string) *JWTService {
+// NewJWTService creates a new JWT service that signs with the current key of keys
+func NewJWTService(keys *jwtkeys.KeySet) *JWTService {
 	return &JWTService{
-		secret: secret,
+		keys: keys,
 	}
 }
 
@@ -27,8 +29,7 @@ func (s *JWTService) GenerateToken(userID, role string) (string, error) {
 		"iat":     time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.secret))
+	return s.keys.Sign(claims)
 }
 
 // GenerateTokenWithEmail generates a JWT token with email claim for OAuth
@@ -41,6 +42,5 @@ func (s *JWTService) GenerateTokenWithEmail(userID, email, role string) (string,
 		"iat":     time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.secret))
+	return s.keys.Sign(claims)
 }
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -95,3 +96,13 @@ func (s *LocalFileStorage) FileExists(objectKey string) (bool, error) {
 	}
 	return true, nil
 }
+
+// CheckHealth checks that files can be written to the base directory
+func (s *LocalFileStorage) CheckHealth(ctx context.Context) error {
+	probe, err := os.CreateTemp(s.config.BaseDir, ".health-*")
+	if err != nil {
+		return fmt.Errorf("upload directory is not writable: %w", err)
+	}
+	probe.Close()
+	return os.Remove(probe.Name())
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"ecom-golang-clean-architecture/internal/domain/storage"
+	"ecom-golang-clean-architecture/internal/infrastructure/config"
+)
+
+// RegionalStorage keeps a storage provider per data region
+type RegionalStorage struct {
+	defaultRegion string
+	providers     map[string]storage.StorageProvider
+}
+
+// Ensure RegionalStorage implements storage.RegionalStorage
+var _ storage.RegionalStorage = (*RegionalStorage)(nil)
+
+// NewRegionalStorage routes files to the provider of their region. providers must include the
+// default region's.
+func NewRegionalStorage(defaultRegion string, providers map[string]storage.StorageProvider) (*RegionalStorage, error) {
+	if _, ok := providers[defaultRegion]; !ok {
+		return nil, fmt.Errorf("no storage configured for the default data region %q", defaultRegion)
+	}
+	return &RegionalStorage{
+		defaultRegion: defaultRegion,
+		providers:     providers,
+	}, nil
+}
+
+// NewLocalRegionalStorage stores the default region's files in the upload directory as before,
+// and every other region's in a directory of its own below it, served under the same public path
+func NewLocalRegionalStorage(cfg *config.LocalStorageConfig, defaultRegion string, regions []string) (*RegionalStorage, error) {
+	providers := make(map[string]storage.StorageProvider, len(regions))
+	for _, region := range regions {
+		regionConfig := *cfg
+		if region != defaultRegion {
+			regionConfig.BaseDir = filepath.Join(cfg.BaseDir, "regions", region)
+			regionConfig.PublicPath = path.Join(cfg.PublicPath, "regions", region)
+		}
+		provider, err := NewLocalStorage(&regionConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize storage of data region %s: %w", region, err)
+		}
+		providers[region] = provider
+	}
+	return NewRegionalStorage(defaultRegion, providers)
+}
+
+// ForRegion returns the region's provider, falling back to the default region's
+func (s *RegionalStorage) ForRegion(region string) storage.StorageProvider {
+	if provider, ok := s.providers[region]; ok {
+		return provider
+	}
+	return s.providers[s.defaultRegion]
+}
+
+// CheckHealth checks the storage of every region that supports checks, and fails with the first
+// region, by name, whose storage is unusable
+func (s *RegionalStorage) CheckHealth(ctx context.Context) error {
+	regions := make([]string, 0, len(s.providers))
+	for region := range s.providers {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		checker, ok := s.providers[region].(storage.HealthChecker)
+		if !ok {
+			continue
+		}
+		if err := checker.CheckHealth(ctx); err != nil {
+			return fmt.Errorf("storage of data region %s: %w", region, err)
+		}
+	}
+	return nil
+}
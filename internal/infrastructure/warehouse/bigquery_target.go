@@ -0,0 +1,270 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const bigQueryScope = "https://www.googleapis.com/auth/bigquery"
+
+// bigQueryTypes maps export column types to BigQuery field types
+var bigQueryTypes = map[entities.WarehouseColumnType]string{
+	entities.WarehouseColumnString:    "STRING",
+	entities.WarehouseColumnInteger:   "INTEGER",
+	entities.WarehouseColumnFloat:     "FLOAT",
+	entities.WarehouseColumnBoolean:   "BOOLEAN",
+	entities.WarehouseColumnTimestamp: "TIMESTAMP",
+}
+
+// BigQueryTarget loads rows into BigQuery tables through the REST API, using streaming inserts.
+// Tables are partitioned by day of _synced_at.
+type BigQueryTarget struct {
+	baseURL     string
+	project     string
+	dataset     string
+	tablePrefix string
+	client      *http.Client
+}
+
+// NewBigQueryTarget creates a target for the given dataset. Without a credentials file the
+// Application Default Credentials are used.
+func NewBigQueryTarget(ctx context.Context, project, dataset, credentialsFile, tablePrefix string, timeout time.Duration) (*BigQueryTarget, error) {
+	var credentials *google.Credentials
+	if credentialsFile != "" {
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read BigQuery credentials: %w", err)
+		}
+		credentials, err = google.CredentialsFromJSON(ctx, data, bigQueryScope)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BigQuery credentials: %w", err)
+		}
+	} else {
+		var err error
+		credentials, err = google.FindDefaultCredentials(ctx, bigQueryScope)
+		if err != nil {
+			return nil, fmt.Errorf("no BigQuery credentials found: %w", err)
+		}
+	}
+
+	return &BigQueryTarget{
+		baseURL:     "https://bigquery.googleapis.com/bigquery/v2/projects/" + url.PathEscape(project) + "/datasets/" + url.PathEscape(dataset),
+		project:     project,
+		dataset:     dataset,
+		tablePrefix: tablePrefix,
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: &oauth2.Transport{Source: credentials.TokenSource, Base: http.DefaultTransport},
+		},
+	}, nil
+}
+
+type bigQueryTable struct {
+	Schema struct {
+		// Fields are kept as returned so a patch doesn't drop their modes and descriptions
+		Fields []map[string]interface{} `json:"fields"`
+	} `json:"schema"`
+}
+
+// EnsureTable creates the table or adds the columns it is missing
+func (t *BigQueryTarget) EnsureTable(ctx context.Context, schema entities.WarehouseTableSchema) error {
+	name := tableName(t.tablePrefix, schema.Table)
+	columns := schema.WarehouseColumns()
+
+	var table bigQueryTable
+	status, err := t.do(ctx, http.MethodGet, "/tables/"+url.PathEscape(name), nil, &table)
+	if status == http.StatusNotFound {
+		fields := make([]map[string]interface{}, len(columns))
+		for i, column := range columns {
+			fields[i] = bigQueryField(column)
+		}
+		_, err := t.do(ctx, http.MethodPost, "/tables", map[string]interface{}{
+			"tableReference": map[string]string{
+				"projectId": t.project,
+				"datasetId": t.dataset,
+				"tableId":   name,
+			},
+			"schema":           map[string]interface{}{"fields": fields},
+			"timePartitioning": map[string]string{"type": "DAY", "field": entities.WarehouseSyncedAtColumn},
+		}, nil)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]string, len(table.Schema.Fields))
+	for _, field := range table.Schema.Fields {
+		name, _ := field["name"].(string)
+		fieldType, _ := field["type"].(string)
+		existing[strings.ToLower(name)] = normalizeBigQueryType(fieldType)
+	}
+
+	fields := table.Schema.Fields
+	added := 0
+	for _, column := range columns {
+		fieldType, ok := existing[strings.ToLower(column.Name)]
+		if !ok {
+			fields = append(fields, bigQueryField(column))
+			added++
+			continue
+		}
+		if fieldType != bigQueryTypes[column.Type] {
+			return fmt.Errorf("%w: column %s of %s is %s in BigQuery but is exported as %s",
+				ErrSchemaConflict, column.Name, name, fieldType, bigQueryTypes[column.Type])
+		}
+	}
+	if added == 0 {
+		return nil
+	}
+
+	_, err = t.do(ctx, http.MethodPatch, "/tables/"+url.PathEscape(name), map[string]interface{}{
+		"schema": map[string]interface{}{"fields": fields},
+	}, nil)
+	return err
+}
+
+type bigQueryInsertRow struct {
+	InsertID string                 `json:"insertId"`
+	JSON     map[string]interface{} `json:"json"`
+}
+
+type bigQueryInsertResponse struct {
+	InsertErrors []struct {
+		Index  int `json:"index"`
+		Errors []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"insertErrors"`
+}
+
+// Load streams the rows into the table. Each row's insert ID is its id and updated_at, so
+// BigQuery drops duplicates when a batch is retried shortly after a partial failure.
+func (t *BigQueryTarget) Load(ctx context.Context, schema entities.WarehouseTableSchema, rows []entities.WarehouseRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	insertRows := make([]bigQueryInsertRow, len(rows))
+	for i, row := range rows {
+		values := make(map[string]interface{}, len(row))
+		for column, value := range row {
+			if at, ok := value.(time.Time); ok {
+				value = formatTimestamp(at)
+			}
+			values[column] = value
+		}
+		insertRows[i] = bigQueryInsertRow{
+			InsertID: fmt.Sprintf("%v@%v", values["id"], values["updated_at"]),
+			JSON:     values,
+		}
+	}
+
+	name := tableName(t.tablePrefix, schema.Table)
+	var resp bigQueryInsertResponse
+	if _, err := t.do(ctx, http.MethodPost, "/tables/"+url.PathEscape(name)+"/insertAll", map[string]interface{}{
+		"rows": insertRows,
+	}, &resp); err != nil {
+		return err
+	}
+	for _, insertError := range resp.InsertErrors {
+		for _, rowError := range insertError.Errors {
+			// Valid rows of a failed request are reported as "stopped"; show the actual cause
+			if rowError.Reason != "stopped" {
+				return fmt.Errorf("BigQuery rejected %d of %d rows of %s: row %d: %s",
+					len(resp.InsertErrors), len(rows), name, insertError.Index, rowError.Message)
+			}
+		}
+	}
+	if len(resp.InsertErrors) > 0 {
+		return fmt.Errorf("BigQuery rejected %d of %d rows of %s", len(resp.InsertErrors), len(rows), name)
+	}
+	return nil
+}
+
+// do calls the BigQuery API and decodes the response into out. The status is returned with
+// the error so callers can tell a missing table apart.
+func (t *BigQueryTarget) do(ctx context.Context, method, path string, body interface{}, out interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, t.baseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("BigQuery request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to read BigQuery response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiError struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		message := strings.TrimSpace(string(respBody))
+		if json.Unmarshal(respBody, &apiError) == nil && apiError.Error.Message != "" {
+			message = apiError.Error.Message
+		}
+		return resp.StatusCode, fmt.Errorf("BigQuery returned %d for %s %s: %s", resp.StatusCode, method, path, message)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("invalid BigQuery response: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+func bigQueryField(column entities.WarehouseColumn) map[string]interface{} {
+	return map[string]interface{}{
+		"name": column.Name,
+		"type": bigQueryTypes[column.Type],
+		"mode": "NULLABLE",
+	}
+}
+
+// normalizeBigQueryType maps standard SQL type names to the legacy names the API reports
+func normalizeBigQueryType(fieldType string) string {
+	switch strings.ToUpper(fieldType) {
+	case "INT64":
+		return "INTEGER"
+	case "FLOAT64":
+		return "FLOAT"
+	case "BOOL":
+		return "BOOLEAN"
+	default:
+		return strings.ToUpper(fieldType)
+	}
+}
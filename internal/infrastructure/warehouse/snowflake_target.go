@@ -0,0 +1,307 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// snowflakeType is how an export column type is declared in Snowflake and how
+// information_schema reports it
+type snowflakeType struct {
+	declared string
+	reported string
+}
+
+var snowflakeTypes = map[entities.WarehouseColumnType]snowflakeType{
+	entities.WarehouseColumnString:    {declared: "VARCHAR", reported: "TEXT"},
+	entities.WarehouseColumnInteger:   {declared: "NUMBER(38,0)", reported: "NUMBER"},
+	entities.WarehouseColumnFloat:     {declared: "FLOAT", reported: "FLOAT"},
+	entities.WarehouseColumnBoolean:   {declared: "BOOLEAN", reported: "BOOLEAN"},
+	entities.WarehouseColumnTimestamp: {declared: "TIMESTAMP_TZ", reported: "TIMESTAMP_TZ"},
+}
+
+// SnowflakeSettings holds the connection settings of a Snowflake target
+type SnowflakeSettings struct {
+	Account        string
+	User           string
+	PrivateKeyFile string
+	Database       string
+	Schema         string
+	Warehouse      string
+	Role           string
+}
+
+// SnowflakeTarget loads rows into Snowflake tables through the SQL API, authenticating with a
+// key pair. Each batch is a single INSERT of a JSON array bound as one parameter.
+type SnowflakeTarget struct {
+	settings    SnowflakeSettings
+	baseURL     string
+	tablePrefix string
+	privateKey  *rsa.PrivateKey
+	issuer      string // <ACCOUNT>.<USER>.SHA256:<public key fingerprint>
+	subject     string // <ACCOUNT>.<USER>
+	client      *http.Client
+}
+
+// NewSnowflakeTarget creates a target for the given account and database
+func NewSnowflakeTarget(settings SnowflakeSettings, tablePrefix string, timeout time.Duration) (*SnowflakeTarget, error) {
+	data, err := os.ReadFile(settings.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Snowflake private key: %w", err)
+	}
+	privateKey, err := parseRSAPrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Snowflake private key: %w", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := sha256.Sum256(publicKey)
+
+	// JWTs name the account without its region or cloud, e.g. XY12345 for xy12345.us-east-1
+	account, _, _ := strings.Cut(strings.ToUpper(settings.Account), ".")
+	subject := account + "." + strings.ToUpper(settings.User)
+
+	return &SnowflakeTarget{
+		settings:    settings,
+		baseURL:     "https://" + strings.ToLower(settings.Account) + ".snowflakecomputing.com/api/v2/statements",
+		tablePrefix: tablePrefix,
+		privateKey:  privateKey,
+		issuer:      subject + ".SHA256:" + base64.StdEncoding.EncodeToString(fingerprint[:]),
+		subject:     subject,
+		client:      &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// EnsureTable creates the table or adds the columns it is missing
+func (t *SnowflakeTarget) EnsureTable(ctx context.Context, schema entities.WarehouseTableSchema) error {
+	name := tableName(t.tablePrefix, schema.Table)
+	columns := schema.WarehouseColumns()
+
+	result, err := t.execute(ctx,
+		"SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = ? AND table_name = ?",
+		strings.ToUpper(t.settings.Schema), strings.ToUpper(name))
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]string, len(result.Data))
+	for _, row := range result.Data {
+		if len(row) == 2 && row[0] != nil && row[1] != nil {
+			existing[strings.ToUpper(*row[0])] = strings.ToUpper(*row[1])
+		}
+	}
+
+	if len(existing) == 0 {
+		definitions := make([]string, len(columns))
+		for i, column := range columns {
+			definitions[i] = column.Name + " " + snowflakeTypes[column.Type].declared
+		}
+		_, err := t.execute(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", name, strings.Join(definitions, ", ")))
+		return err
+	}
+
+	var missing []string
+	for _, column := range columns {
+		dataType, ok := existing[strings.ToUpper(column.Name)]
+		if !ok {
+			missing = append(missing, column.Name+" "+snowflakeTypes[column.Type].declared)
+			continue
+		}
+		if dataType != snowflakeTypes[column.Type].reported {
+			return fmt.Errorf("%w: column %s of %s is %s in Snowflake but is exported as %s",
+				ErrSchemaConflict, column.Name, name, dataType, snowflakeTypes[column.Type].reported)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	_, err = t.execute(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", name, strings.Join(missing, ", ")))
+	return err
+}
+
+// Load inserts the rows, casting the fields of the bound JSON array to the column types
+func (t *SnowflakeTarget) Load(ctx context.Context, schema entities.WarehouseTableSchema, rows []entities.WarehouseRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns := schema.WarehouseColumns()
+	names := make([]string, len(columns))
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+		values[i] = fmt.Sprintf(`value:"%s"::%s`, column.Name, snowflakeTypes[column.Type].declared)
+	}
+
+	encodedRows := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		encoded := make(map[string]interface{}, len(row))
+		for column, value := range row {
+			if at, ok := value.(time.Time); ok {
+				value = formatTimestamp(at)
+			}
+			encoded[column] = value
+		}
+		encodedRows[i] = encoded
+	}
+	data, err := json.Marshal(encodedRows)
+	if err != nil {
+		return err
+	}
+
+	name := tableName(t.tablePrefix, schema.Table)
+	_, err = t.execute(ctx, fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM TABLE(FLATTEN(INPUT => PARSE_JSON(?)))",
+		name, strings.Join(names, ", "), strings.Join(values, ", ")), string(data))
+	return err
+}
+
+type snowflakeBinding struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type snowflakeResult struct {
+	StatementHandle string      `json:"statementHandle"`
+	Message         string      `json:"message"`
+	Code            string      `json:"code"`
+	Data            [][]*string `json:"data"`
+}
+
+// execute runs a statement with text bindings and waits for its result
+func (t *SnowflakeTarget) execute(ctx context.Context, statement string, bindings ...string) (*snowflakeResult, error) {
+	body := map[string]interface{}{
+		"statement": statement,
+		"database":  t.settings.Database,
+		"schema":    t.settings.Schema,
+	}
+	if t.settings.Warehouse != "" {
+		body["warehouse"] = t.settings.Warehouse
+	}
+	if t.settings.Role != "" {
+		body["role"] = t.settings.Role
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		body["timeout"] = int(time.Until(deadline).Seconds()) + 1
+	}
+	if len(bindings) > 0 {
+		values := make(map[string]snowflakeBinding, len(bindings))
+		for i, binding := range bindings {
+			values[fmt.Sprint(i+1)] = snowflakeBinding{Type: "TEXT", Value: binding}
+		}
+		body["bindings"] = values
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	result, status, err := t.do(ctx, http.MethodPost, t.baseURL, encoded)
+
+	// Long statements continue asynchronously; poll until they finish
+	for err == nil && status == http.StatusAccepted {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+		result, status, err = t.do(ctx, http.MethodGet, t.baseURL+"/"+url.PathEscape(result.StatementHandle), nil)
+	}
+	return result, err
+}
+
+func (t *SnowflakeTarget) do(ctx context.Context, method, endpoint string, body []byte) (*snowflakeResult, int, error) {
+	token, err := t.token()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Snowflake-Authorization-Token-Type", "KEYPAIR_JWT")
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("snowflake request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 8*1024*1024))
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to read snowflake response: %w", err)
+	}
+
+	var result snowflakeResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, resp.StatusCode, fmt.Errorf("snowflake returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		}
+		return nil, resp.StatusCode, fmt.Errorf("invalid snowflake response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, resp.StatusCode, fmt.Errorf("snowflake returned %d: %s (%s)", resp.StatusCode, result.Message, result.Code)
+	}
+	return &result, resp.StatusCode, nil
+}
+
+// token signs a short-lived key pair JWT
+func (t *SnowflakeTarget) token() (string, error) {
+	now := time.Now()
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": t.issuer,
+		"sub": t.subject,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}).SignedString(t.privateKey)
+}
+
+// parseRSAPrivateKey parses a PKCS#8 or PKCS#1 PEM private key
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("only RSA keys are supported")
+	}
+	return rsaKey, nil
+}
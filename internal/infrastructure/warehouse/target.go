@@ -0,0 +1,102 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+)
+
+// Supported warehouse targets
+const (
+	TargetBigQuery  = "bigquery"
+	TargetSnowflake = "snowflake"
+)
+
+// ErrSchemaConflict means a warehouse table has a column whose type differs from the export's.
+// The sync can't fix that on its own: rename or drop the column in the warehouse.
+var ErrSchemaConflict = errors.New("warehouse schema conflict")
+
+// Target is a data warehouse that exported rows are loaded into
+type Target interface {
+	// EnsureTable creates the table, or adds the columns of the schema it is missing
+	EnsureTable(ctx context.Context, schema entities.WarehouseTableSchema) error
+	// Load appends rows to the table
+	Load(ctx context.Context, schema entities.WarehouseTableSchema, rows []entities.WarehouseRow) error
+}
+
+// NewTarget creates the target configured in cfg
+func NewTarget(ctx context.Context, cfg *config.WarehouseConfig) (Target, error) {
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+
+	switch cfg.Target {
+	case TargetBigQuery:
+		if cfg.BigQueryProject == "" || cfg.BigQueryDataset == "" {
+			return nil, fmt.Errorf("a BigQuery project and dataset are required")
+		}
+		return NewBigQueryTarget(ctx, cfg.BigQueryProject, cfg.BigQueryDataset, cfg.BigQueryCredentialsFile, cfg.TablePrefix, timeout)
+	case TargetSnowflake:
+		if cfg.SnowflakeAccount == "" || cfg.SnowflakeUser == "" || cfg.SnowflakePrivateKeyFile == "" || cfg.SnowflakeDatabase == "" {
+			return nil, fmt.Errorf("a Snowflake account, user, private key file and database are required")
+		}
+		return NewSnowflakeTarget(SnowflakeSettings{
+			Account:        cfg.SnowflakeAccount,
+			User:           cfg.SnowflakeUser,
+			PrivateKeyFile: cfg.SnowflakePrivateKeyFile,
+			Database:       cfg.SnowflakeDatabase,
+			Schema:         cfg.SnowflakeSchema,
+			Warehouse:      cfg.SnowflakeWarehouse,
+			Role:           cfg.SnowflakeRole,
+		}, cfg.TablePrefix, timeout)
+	default:
+		return nil, fmt.Errorf("unknown warehouse target %q, use %s or %s", cfg.Target, TargetBigQuery, TargetSnowflake)
+	}
+}
+
+// IsProviderFailure reports whether err counts against the warehouse's circuit breaker; schema
+// conflicts are configuration problems, not outages
+func IsProviderFailure(err error) bool {
+	return !errors.Is(err, ErrSchemaConflict)
+}
+
+// BreakerTarget guards a target with a circuit breaker and per-call timeout
+type BreakerTarget struct {
+	target  Target
+	breaker *resilience.Breaker
+}
+
+// NewBreakerTarget wraps target so its calls go through breaker
+func NewBreakerTarget(target Target, breaker *resilience.Breaker) *BreakerTarget {
+	return &BreakerTarget{
+		target:  target,
+		breaker: breaker,
+	}
+}
+
+// EnsureTable ensures the table unless the warehouse's circuit is open
+func (t *BreakerTarget) EnsureTable(ctx context.Context, schema entities.WarehouseTableSchema) error {
+	return t.breaker.Execute(ctx, func(ctx context.Context) error {
+		return t.target.EnsureTable(ctx, schema)
+	})
+}
+
+// Load loads the rows unless the warehouse's circuit is open
+func (t *BreakerTarget) Load(ctx context.Context, schema entities.WarehouseTableSchema, rows []entities.WarehouseRow) error {
+	return t.breaker.Execute(ctx, func(ctx context.Context) error {
+		return t.target.Load(ctx, schema, rows)
+	})
+}
+
+// tableName returns the warehouse name of an exported table
+func tableName(prefix string, table entities.WarehouseTable) string {
+	return prefix + string(table)
+}
+
+// formatTimestamp formats a timestamp the way both warehouses parse it
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000Z")
+}
@@ -0,0 +1,197 @@
+// Package testfixtures provides entity factories and a database sandbox for usecase and
+// repository tests:
+//
+//	func TestOrderRepository_GetByID(t *testing.T) {
+//		db := testfixtures.NewSandbox(t)
+//		user := testfixtures.NewTestUser()
+//		product := testfixtures.NewTestProductWithVariants(2)
+//		order := testfixtures.NewTestPaidOrder(user, product)
+//		testfixtures.MustCreate(t, db, user, product, order)
+//		...
+//	}
+//
+// Factories only build entities in memory, with unique emails, SKUs and slugs so they can be
+// saved side by side. Options passed to a factory run last and may override any field.
+package testfixtures
+
+import (
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TestPassword is the password of users built by NewTestUser
+const TestPassword = "password123"
+
+// testPasswordHash is TestPassword hashed once, at the lowest cost, to keep tests fast
+var testPasswordHash = func() string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(TestPassword), bcrypt.MinCost)
+	if err != nil {
+		panic(fmt.Sprintf("failed to hash test password: %v", err))
+	}
+	return string(hash)
+}()
+
+// uniqueSuffix returns a short random suffix for fields that must be unique
+func uniqueSuffix() string {
+	return uuid.NewString()[:8]
+}
+
+// NewTestUser builds an active, verified customer who signs in with TestPassword
+func NewTestUser(opts ...func(*entities.User)) *entities.User {
+	suffix := uniqueSuffix()
+	user := &entities.User{
+		ID:            ids.New(),
+		Email:         fmt.Sprintf("user-%s@example.com", suffix),
+		Password:      testPasswordHash,
+		FirstName:     "Test",
+		LastName:      "User " + suffix,
+		Role:          entities.UserRoleCustomer,
+		Status:        entities.UserStatusActive,
+		IsActive:      true,
+		Language:      "en",
+		Timezone:      "UTC",
+		Currency:      "USD",
+		EmailVerified: true,
+	}
+	for _, opt := range opts {
+		opt(user)
+	}
+	return user
+}
+
+// NewTestAdmin builds an active admin who signs in with TestPassword
+func NewTestAdmin(opts ...func(*entities.User)) *entities.User {
+	return NewTestUser(append([]func(*entities.User){func(u *entities.User) {
+		u.Role = entities.UserRoleAdmin
+	}}, opts...)...)
+}
+
+// NewTestProduct builds an active, visible simple product priced at 19.99 with 100 in stock
+func NewTestProduct(opts ...func(*entities.Product)) *entities.Product {
+	suffix := uniqueSuffix()
+	product := &entities.Product{
+		ID:                ids.New(),
+		Name:              "Test Product " + suffix,
+		Description:       "A product created for tests",
+		SKU:               "TEST-" + suffix,
+		Slug:              "test-product-" + suffix,
+		Visibility:        entities.ProductVisibilityVisible,
+		Price:             19.99,
+		Stock:             100,
+		LowStockThreshold: 5,
+		TrackQuantity:     true,
+		StockStatus:       entities.StockStatusInStock,
+		StockVisibility:   entities.StockVisibilityExact,
+		RequiresShipping:  true,
+		TaxClass:          "standard",
+		Status:            entities.ProductStatusActive,
+		ProductType:       entities.ProductTypeSimple,
+	}
+	for _, opt := range opts {
+		opt(product)
+	}
+	return product
+}
+
+// NewTestProductWithVariants builds a variable product with the given number of active variants.
+// Variant n has SKU <product SKU>-<n>, costs 1.00 more than the one before and has 10 in stock;
+// the product's stock is their total.
+func NewTestProductWithVariants(variants int, opts ...func(*entities.Product)) *entities.Product {
+	product := NewTestProduct(func(p *entities.Product) {
+		p.ProductType = entities.ProductTypeVariable
+		p.Stock = 0
+	})
+	for i := 1; i <= variants; i++ {
+		product.Variants = append(product.Variants, entities.ProductVariant{
+			ID:        ids.New(),
+			ProductID: product.ID,
+			SKU:       fmt.Sprintf("%s-%d", product.SKU, i),
+			Price:     product.Price + float64(i-1),
+			Stock:     10,
+			Position:  i,
+			IsActive:  true,
+		})
+		product.Stock += 10
+	}
+	for _, opt := range opts {
+		opt(product)
+	}
+	return product
+}
+
+// NewTestPaidOrder builds a confirmed order of one of each product, paid in full by credit card.
+// A simple product is added when none is given. Items only reference their product, so save the
+// user and products before the order.
+func NewTestPaidOrder(user *entities.User, products ...*entities.Product) *entities.Order {
+	if len(products) == 0 {
+		products = []*entities.Product{NewTestProduct()}
+	}
+
+	now := time.Now()
+	order := &entities.Order{
+		ID:                ids.New(),
+		OrderNumber:       "TEST-" + uniqueSuffix(),
+		UserID:            user.ID,
+		Status:            entities.OrderStatusConfirmed,
+		FulfillmentStatus: entities.FulfillmentStatusPending,
+		PaymentStatus:     entities.PaymentStatusPaid,
+		PaymentMethod:     entities.PaymentMethodCreditCard,
+		Priority:          entities.OrderPriorityNormal,
+		Source:            entities.OrderSourceWeb,
+		CustomerType:      entities.CustomerTypeRegistered,
+		Currency:          "USD",
+		ShippingAddress:   newTestAddress(user),
+		BillingAddress:    newTestAddress(user),
+		ShippingMethod:    "standard",
+		Version:           1,
+	}
+
+	for _, product := range products {
+		order.Items = append(order.Items, entities.OrderItem{
+			ID:          ids.New(),
+			OrderID:     order.ID,
+			ProductID:   product.ID,
+			ProductName: product.Name,
+			ProductSKU:  product.SKU,
+			Quantity:    1,
+			Price:       product.Price,
+			Total:       product.Price,
+		})
+		order.Subtotal += product.Price
+	}
+	order.Total = order.Subtotal
+
+	order.Payments = []entities.Payment{{
+		ID:            ids.New(),
+		OrderID:       order.ID,
+		UserID:        user.ID,
+		Amount:        order.Total,
+		Currency:      order.Currency,
+		Method:        entities.PaymentMethodCreditCard,
+		Status:        entities.PaymentStatusPaid,
+		TransactionID: "txn_" + uniqueSuffix(),
+		Gateway:       "stripe",
+		NetAmount:     order.Total,
+		ProcessedAt:   &now,
+	}}
+	return order
+}
+
+// newTestAddress builds a US address in the user's name
+func newTestAddress(user *entities.User) *entities.OrderAddress {
+	return &entities.OrderAddress{
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Address1:  "1 Test Street",
+		City:      "New York",
+		State:     "NY",
+		ZipCode:   "10001",
+		Country:   "US",
+	}
+}
@@ -0,0 +1,95 @@
+package testfixtures
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"ecom-golang-clean-architecture/internal/infrastructure/config"
+	"ecom-golang-clean-architecture/internal/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// TestDatabaseEnv names the database tests run against. Sandboxed tests are skipped when it is
+// unset, so they never touch the development database by accident. The other DB_* variables
+// are shared with the API.
+const TestDatabaseEnv = "TEST_DB_NAME"
+
+var (
+	testDBOnce sync.Once
+	testDB     *gorm.DB
+	testDBErr  error
+)
+
+// NewSandbox returns a transaction on the test database that is rolled back when the test
+// ends, so each test starts from the migrated schema and sees none of the rows other tests
+// save. Repositories may open their own transactions on it; GORM turns them into savepoints.
+//
+// The database is connected to and migrated once per test binary.
+func NewSandbox(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	name := os.Getenv(TestDatabaseEnv)
+	if name == "" {
+		t.Skipf("%s is not set, skipping database test", TestDatabaseEnv)
+	}
+
+	testDBOnce.Do(func() {
+		testDB, testDBErr = openTestDB(name)
+	})
+	if testDBErr != nil {
+		t.Fatalf("failed to open test database: %v", testDBErr)
+	}
+
+	tx := testDB.Begin()
+	if tx.Error != nil {
+		t.Fatalf("failed to begin sandbox transaction: %v", tx.Error)
+	}
+	t.Cleanup(func() {
+		tx.Rollback()
+	})
+	return tx
+}
+
+// MustCreate saves each value, typically entities built by the factories, failing the test on
+// the first error
+func MustCreate(t testing.TB, db *gorm.DB, values ...interface{}) {
+	t.Helper()
+
+	for _, value := range values {
+		if err := db.Create(value).Error; err != nil {
+			t.Fatalf("failed to create %T: %v", value, err)
+		}
+	}
+}
+
+// openTestDB connects to the named database and runs the migrations
+func openTestDB(name string) (*gorm.DB, error) {
+	cfg := config.DatabaseConfig{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnv("DB_PORT", "5432"),
+		User:     getEnv("DB_USER", "postgres"),
+		Password: getEnv("DB_PASSWORD", "password"),
+		Name:     name,
+		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+		Timezone: getEnv("DB_TIMEZONE", "UTC"),
+	}
+
+	db, err := database.NewConnection(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.NewMigrationManager(db).RunMigrations(context.Background()); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,64 @@
+package testfixtures
+
+import (
+	"os"
+	"testing"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+)
+
+func TestNewSandbox_RollsBackSavedEntities(t *testing.T) {
+	if os.Getenv(TestDatabaseEnv) == "" {
+		t.Skipf("%s is not set, skipping database test", TestDatabaseEnv)
+	}
+
+	user := NewTestUser()
+	product := NewTestProductWithVariants(2)
+	order := NewTestPaidOrder(user, product)
+
+	t.Run("save", func(t *testing.T) {
+		db := NewSandbox(t)
+		MustCreate(t, db, user, product, order)
+
+		var saved entities.Order
+		if err := db.Preload("Items").Preload("Payments").First(&saved, "id = ?", order.ID).Error; err != nil {
+			t.Fatalf("failed to load saved order: %v", err)
+		}
+		if saved.PaymentStatus != entities.PaymentStatusPaid {
+			t.Errorf("payment status = %s, want %s", saved.PaymentStatus, entities.PaymentStatusPaid)
+		}
+		if len(saved.Items) != 1 || len(saved.Payments) != 1 {
+			t.Errorf("order has %d items and %d payments, want 1 and 1", len(saved.Items), len(saved.Payments))
+		}
+
+		var variants int64
+		if err := db.Model(&entities.ProductVariant{}).Where("product_id = ?", product.ID).Count(&variants).Error; err != nil {
+			t.Fatalf("failed to count variants: %v", err)
+		}
+		if variants != 2 {
+			t.Errorf("product has %d variants, want 2", variants)
+		}
+	})
+
+	// The first sandbox was rolled back when its subtest ended
+	db := NewSandbox(t)
+	checks := []struct {
+		model interface{}
+		id    interface{}
+	}{
+		{&entities.User{}, user.ID},
+		{&entities.Product{}, product.ID},
+		{&entities.ProductVariant{}, product.Variants[0].ID},
+		{&entities.Order{}, order.ID},
+		{&entities.Payment{}, order.Payments[0].ID},
+	}
+	for _, check := range checks {
+		var count int64
+		if err := db.Model(check.model).Where("id = ?", check.id).Count(&count).Error; err != nil {
+			t.Fatalf("failed to count %T: %v", check.model, err)
+		}
+		if count != 0 {
+			t.Errorf("%T %v is visible in a later sandbox", check.model, check.id)
+		}
+	}
+}
@@ -32,6 +32,7 @@ type abandonedCartUseCase struct {
 	emailUseCase EmailUseCase
 	productRepo  repositories.ProductRepository
 	orderRepo    repositories.OrderRepository
+	clock        Clock
 }
 
 // NewAbandonedCartUseCase creates a new abandoned cart use case
@@ -41,20 +42,25 @@ func NewAbandonedCartUseCase(
 	emailUseCase EmailUseCase,
 	productRepo repositories.ProductRepository,
 	orderRepo repositories.OrderRepository,
+	clock Clock,
 ) AbandonedCartUseCase {
+	if clock == nil {
+		clock = systemClock{}
+	}
 	return &abandonedCartUseCase{
 		cartRepo:     cartRepo,
 		userRepo:     userRepo,
 		emailUseCase: emailUseCase,
 		productRepo:  productRepo,
 		orderRepo:    orderRepo,
+		clock:        clock,
 	}
 }
 
 // DetectAbandonedCarts detects carts that have been abandoned
 func (uc *abandonedCartUseCase) DetectAbandonedCarts(ctx context.Context) error {
 	// Define abandonment criteria
-	abandonmentThreshold := time.Now().Add(-24 * time.Hour) // 24 hours ago
+	abandonmentThreshold := uc.clock.Now().Add(-24 * time.Hour) // 24 hours ago
 
 	// Get carts that haven't been updated recently
 	carts, err := uc.cartRepo.GetAbandonedCarts(ctx, abandonmentThreshold)
@@ -96,14 +102,14 @@ func (uc *abandonedCartUseCase) DetectAbandonedCarts(ctx context.Context) error
 
 		// Check if we should send reminder emails
 		if cart.AbandonedAt != nil {
-			timeSinceAbandoned := time.Since(*cart.AbandonedAt)
+			timeSinceAbandoned := uc.clock.Now().Sub(*cart.AbandonedAt)
 
 			// Send first reminder after 1 hour
 			if timeSinceAbandoned >= time.Hour && cart.FirstReminderSent == nil {
 				if err := uc.sendFirstReminder(ctx, cart); err != nil {
 					fmt.Printf("❌ Failed to send first reminder for cart %s: %v\n", cart.ID, err)
 				} else {
-					now := time.Now()
+					now := uc.clock.Now()
 					cart.FirstReminderSent = &now
 					_ = uc.cartRepo.Update(ctx, cart)
 				}
@@ -114,7 +120,7 @@ func (uc *abandonedCartUseCase) DetectAbandonedCarts(ctx context.Context) error
 				if err := uc.sendSecondReminder(ctx, cart); err != nil {
 					fmt.Printf("❌ Failed to send second reminder for cart %s: %v\n", cart.ID, err)
 				} else {
-					now := time.Now()
+					now := uc.clock.Now()
 					cart.SecondReminderSent = &now
 					_ = uc.cartRepo.Update(ctx, cart)
 				}
@@ -125,7 +131,7 @@ func (uc *abandonedCartUseCase) DetectAbandonedCarts(ctx context.Context) error
 				if err := uc.sendFinalReminder(ctx, cart); err != nil {
 					fmt.Printf("❌ Failed to send final reminder for cart %s: %v\n", cart.ID, err)
 				} else {
-					now := time.Now()
+					now := uc.clock.Now()
 					cart.FinalReminderSent = &now
 					_ = uc.cartRepo.Update(ctx, cart)
 				}
@@ -194,7 +200,7 @@ func (uc *abandonedCartUseCase) TrackCartRecovery(ctx context.Context, cartID uu
 
 	if cart.IsAbandoned {
 		cart.IsAbandoned = false
-		now := time.Now()
+		now := uc.clock.Now()
 		cart.RecoveredAt = &now
 
 		return uc.cartRepo.Update(ctx, cart)
@@ -221,7 +227,7 @@ func (uc *abandonedCartUseCase) GetAbandonedCartStats(ctx context.Context, since
 		SecondReminderSent: stats.SecondReminderSent,
 		FinalReminderSent:  stats.FinalReminderSent,
 		Since:              since,
-		Until:              time.Now(),
+		Until:              uc.clock.Now(),
 	}, nil
 }
 
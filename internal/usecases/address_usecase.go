@@ -6,6 +6,8 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 )
 
@@ -19,6 +21,7 @@ type AddressUseCase interface {
 	DeleteAddress(ctx context.Context, userID, addressID uuid.UUID) error
 	SetDefaultAddress(ctx context.Context, userID, addressID uuid.UUID, addressType entities.AddressType) error
 	GetDefaultAddress(ctx context.Context, userID uuid.UUID, addressType entities.AddressType) (*AddressResponse, error)
+	GetCheckoutAddresses(ctx context.Context, userID uuid.UUID) (*CheckoutAddressesResponse, error)
 }
 
 type addressUseCase struct {
@@ -46,6 +49,11 @@ type CreateAddressRequest struct {
 	Country   string               `json:"country" validate:"required"`
 	Phone     string               `json:"phone"`
 	IsDefault bool                 `json:"is_default"`
+
+	Label                entities.AddressLabel `json:"label" validate:"omitempty,oneof=home work other"`
+	DeliveryInstructions string                `json:"delivery_instructions" validate:"max=500"`
+	IsDefaultShipping    bool                  `json:"is_default_shipping"`
+	IsDefaultBilling     bool                  `json:"is_default_billing"`
 }
 
 // UpdateAddressRequest represents update address request
@@ -62,6 +70,11 @@ type UpdateAddressRequest struct {
 	Country   *string               `json:"country"`
 	Phone     *string               `json:"phone"`
 	IsDefault *bool                 `json:"is_default"`
+
+	Label                *entities.AddressLabel `json:"label"`
+	DeliveryInstructions *string                `json:"delivery_instructions"`
+	IsDefaultShipping    *bool                  `json:"is_default_shipping"`
+	IsDefaultBilling     *bool                  `json:"is_default_billing"`
 }
 
 // AddressResponse represents address response
@@ -83,6 +96,20 @@ type AddressResponse struct {
 	FullAddress string               `json:"full_address"`
 	CreatedAt   time.Time            `json:"created_at"`
 	UpdatedAt   time.Time            `json:"updated_at"`
+
+	Label                entities.AddressLabel `json:"label"`
+	DeliveryInstructions string                `json:"delivery_instructions"`
+	IsDefaultShipping    bool                  `json:"is_default_shipping"`
+	IsDefaultBilling     bool                  `json:"is_default_billing"`
+	CompanyID            *uuid.UUID            `json:"company_id,omitempty"`
+}
+
+// CheckoutAddressesResponse represents the saved addresses used to pre-fill checkout
+type CheckoutAddressesResponse struct {
+	DefaultShipping   *AddressResponse   `json:"default_shipping"`
+	DefaultBilling    *AddressResponse   `json:"default_billing"`
+	ShippingAddresses []*AddressResponse `json:"shipping_addresses"`
+	BillingAddresses  []*AddressResponse `json:"billing_addresses"`
 }
 
 // UserAddressesPaginatedResponse represents paginated user addresses
@@ -95,23 +122,54 @@ type UserAddressesPaginatedResponse struct {
 // CreateAddress creates a new address for user
 func (uc *addressUseCase) CreateAddress(ctx context.Context, userID uuid.UUID, req CreateAddressRequest) (*AddressResponse, error) {
 	address := &entities.Address{
-		ID:        uuid.New(),
-		UserID:    userID,
-		Type:      req.Type,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Company:   req.Company,
-		Address1:  req.Address1,
-		Address2:  req.Address2,
-		City:      req.City,
-		State:     req.State,
-		ZipCode:   req.ZipCode,
-		Country:   req.Country,
-		Phone:     req.Phone,
-		IsDefault: req.IsDefault,
-		IsActive:  true,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:                   ids.New(),
+		UserID:               userID,
+		Type:                 req.Type,
+		FirstName:            req.FirstName,
+		LastName:             req.LastName,
+		Company:              req.Company,
+		Address1:             req.Address1,
+		Address2:             req.Address2,
+		City:                 req.City,
+		State:                req.State,
+		ZipCode:              req.ZipCode,
+		Country:              req.Country,
+		Phone:                req.Phone,
+		Label:                req.Label,
+		DeliveryInstructions: req.DeliveryInstructions,
+		IsActive:             true,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	if address.Label == "" {
+		address.Label = entities.AddressLabelHome
+	}
+
+	// Legacy is_default applies to whatever the address can be used for
+	if req.IsDefault {
+		req.IsDefaultShipping = req.IsDefaultShipping || address.IsShippingAddress()
+		req.IsDefaultBilling = req.IsDefaultBilling || address.IsBillingAddress()
+	}
+
+	// The first address for a purpose becomes its default automatically
+	if address.IsShippingAddress() && !req.IsDefaultShipping {
+		if _, err := uc.addressRepo.GetDefaultByUserID(ctx, userID, entities.AddressTypeShipping); err == entities.ErrAddressNotFound {
+			req.IsDefaultShipping = true
+		}
+	}
+	if address.IsBillingAddress() && !req.IsDefaultBilling {
+		if _, err := uc.addressRepo.GetDefaultByUserID(ctx, userID, entities.AddressTypeBilling); err == entities.ErrAddressNotFound {
+			req.IsDefaultBilling = true
+		}
+	}
+
+	address.IsDefaultShipping = req.IsDefaultShipping
+	address.IsDefaultBilling = req.IsDefaultBilling
+	address.SyncDefaultFlag()
+
+	if err := address.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
 	}
 
 	if err := uc.addressRepo.Create(ctx, address); err != nil {
@@ -119,13 +177,11 @@ func (uc *addressUseCase) CreateAddress(ctx context.Context, userID uuid.UUID, r
 	}
 
 	// If this is set as default, update other addresses
-	if req.IsDefault {
-		if err := uc.addressRepo.SetAsDefault(ctx, userID, address.ID, req.Type); err != nil {
-			return nil, err
-		}
+	if err := uc.applyDefaultFlags(ctx, address); err != nil {
+		return nil, err
 	}
 
-	return uc.toAddressResponse(address), nil
+	return toAddressResponse(address), nil
 }
 
 // GetUserAddresses gets all addresses for a user
@@ -137,7 +193,7 @@ func (uc *addressUseCase) GetUserAddresses(ctx context.Context, userID uuid.UUID
 
 	responses := make([]*AddressResponse, len(addresses))
 	for i, address := range addresses {
-		responses[i] = uc.toAddressResponse(address)
+		responses[i] = toAddressResponse(address)
 	}
 
 	return responses, nil
@@ -197,7 +253,7 @@ func (uc *addressUseCase) GetAddress(ctx context.Context, userID, addressID uuid
 		return nil, err
 	}
 
-	return uc.toAddressResponse(address), nil
+	return toAddressResponse(address), nil
 }
 
 // UpdateAddress updates an existing address
@@ -250,8 +306,36 @@ func (uc *addressUseCase) UpdateAddress(ctx context.Context, userID, addressID u
 	if req.Phone != nil {
 		address.Phone = *req.Phone
 	}
-	if req.IsDefault != nil {
-		address.IsDefault = *req.IsDefault
+	if req.Label != nil {
+		address.Label = *req.Label
+	}
+	if req.DeliveryInstructions != nil {
+		address.DeliveryInstructions = *req.DeliveryInstructions
+	}
+	if req.IsDefault != nil && *req.IsDefault {
+		address.IsDefaultShipping = address.IsShippingAddress()
+		address.IsDefaultBilling = address.IsBillingAddress()
+	}
+	if req.IsDefaultShipping != nil {
+		address.IsDefaultShipping = *req.IsDefaultShipping
+	}
+	if req.IsDefaultBilling != nil {
+		address.IsDefaultBilling = *req.IsDefaultBilling
+	}
+
+	// A type change can make existing default flags invalid
+	if req.Type != nil {
+		if !address.IsShippingAddress() {
+			address.IsDefaultShipping = false
+		}
+		if !address.IsBillingAddress() {
+			address.IsDefaultBilling = false
+		}
+	}
+	address.SyncDefaultFlag()
+
+	if err := address.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
 	}
 
 	address.UpdatedAt = time.Now()
@@ -261,13 +345,11 @@ func (uc *addressUseCase) UpdateAddress(ctx context.Context, userID, addressID u
 	}
 
 	// If this is set as default, update other addresses
-	if req.IsDefault != nil && *req.IsDefault {
-		if err := uc.addressRepo.SetAsDefault(ctx, userID, addressID, address.Type); err != nil {
-			return nil, err
-		}
+	if err := uc.applyDefaultFlags(ctx, address); err != nil {
+		return nil, err
 	}
 
-	return uc.toAddressResponse(address), nil
+	return toAddressResponse(address), nil
 }
 
 // DeleteAddress deletes an address
@@ -295,6 +377,28 @@ func (uc *addressUseCase) SetDefaultAddress(ctx context.Context, userID, address
 		return entities.ErrAddressNotFound
 	}
 
+	address, err := uc.addressRepo.GetByID(ctx, addressID)
+	if err != nil {
+		return err
+	}
+
+	switch addressType {
+	case entities.AddressTypeShipping:
+		if !address.IsShippingAddress() {
+			return pkgErrors.InvalidInput("Only shipping addresses can be the default shipping address")
+		}
+	case entities.AddressTypeBilling:
+		if !address.IsBillingAddress() {
+			return pkgErrors.InvalidInput("Only billing addresses can be the default billing address")
+		}
+	case entities.AddressTypeBoth:
+		if address.Type != entities.AddressTypeBoth {
+			return pkgErrors.InvalidInput("Only addresses of type 'both' can be the default for shipping and billing")
+		}
+	default:
+		return pkgErrors.InvalidInput("Invalid address type")
+	}
+
 	return uc.addressRepo.SetAsDefault(ctx, userID, addressID, addressType)
 }
 
@@ -305,11 +409,152 @@ func (uc *addressUseCase) GetDefaultAddress(ctx context.Context, userID uuid.UUI
 		return nil, err
 	}
 
-	return uc.toAddressResponse(address), nil
+	return toAddressResponse(address), nil
+}
+
+// GetCheckoutAddresses gets the user's saved addresses grouped for checkout pre-fill
+func (uc *addressUseCase) GetCheckoutAddresses(ctx context.Context, userID uuid.UUID) (*CheckoutAddressesResponse, error) {
+	addresses, err := uc.addressRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckoutAddressesResponse{
+		ShippingAddresses: []*AddressResponse{},
+		BillingAddresses:  []*AddressResponse{},
+	}
+
+	for _, address := range addresses {
+		if !address.IsActive {
+			continue
+		}
+
+		addressResponse := toAddressResponse(address)
+		if address.IsShippingAddress() {
+			response.ShippingAddresses = append(response.ShippingAddresses, addressResponse)
+			if address.IsDefaultShipping {
+				response.DefaultShipping = addressResponse
+			}
+		}
+		if address.IsBillingAddress() {
+			response.BillingAddresses = append(response.BillingAddresses, addressResponse)
+			if address.IsDefaultBilling {
+				response.DefaultBilling = addressResponse
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// applyDefaultFlags clears other defaults for the purposes this address is now default for
+func (uc *addressUseCase) applyDefaultFlags(ctx context.Context, address *entities.Address) error {
+	switch {
+	case address.IsDefaultShipping && address.IsDefaultBilling:
+		return uc.addressRepo.SetAsDefault(ctx, address.UserID, address.ID, entities.AddressTypeBoth)
+	case address.IsDefaultShipping:
+		return uc.addressRepo.SetAsDefault(ctx, address.UserID, address.ID, entities.AddressTypeShipping)
+	case address.IsDefaultBilling:
+		return uc.addressRepo.SetAsDefault(ctx, address.UserID, address.ID, entities.AddressTypeBilling)
+	}
+	return nil
+}
+
+// resolveCheckoutAddresses pre-fills checkout addresses from the user's address book and
+// re-validates saved addresses before they are used for an order. A selected address ID
+// takes precedence; otherwise an empty shipping address and a missing billing address are
+// pre-filled from the user's defaults.
+func resolveCheckoutAddresses(
+	ctx context.Context,
+	addressRepo repositories.AddressRepository,
+	userID uuid.UUID,
+	companyID *uuid.UUID,
+	shippingAddressID, billingAddressID *uuid.UUID,
+	shipping *AddressRequest,
+	billing **AddressRequest,
+) error {
+	if addressRepo == nil {
+		return nil
+	}
+
+	if shippingAddressID != nil {
+		address, err := getCheckoutAddress(ctx, addressRepo, userID, companyID, *shippingAddressID, entities.AddressTypeShipping)
+		if err != nil {
+			return err
+		}
+		*shipping = toAddressRequest(address)
+	} else if *shipping == (AddressRequest{}) {
+		if address, err := addressRepo.GetDefaultByUserID(ctx, userID, entities.AddressTypeShipping); err == nil && address.Validate() == nil {
+			*shipping = toAddressRequest(address)
+		}
+	}
+
+	if billingAddressID != nil {
+		address, err := getCheckoutAddress(ctx, addressRepo, userID, companyID, *billingAddressID, entities.AddressTypeBilling)
+		if err != nil {
+			return err
+		}
+		billingReq := toAddressRequest(address)
+		*billing = &billingReq
+	} else if *billing == nil {
+		if address, err := addressRepo.GetDefaultByUserID(ctx, userID, entities.AddressTypeBilling); err == nil && address.Validate() == nil {
+			billingReq := toAddressRequest(address)
+			*billing = &billingReq
+		}
+	}
+
+	return nil
+}
+
+// getCheckoutAddress loads a saved address and checks it can still be used for the given purpose.
+// Addresses from the shared address book of the user's company are also accepted.
+func getCheckoutAddress(ctx context.Context, addressRepo repositories.AddressRepository, userID uuid.UUID, companyID *uuid.UUID, addressID uuid.UUID, purpose entities.AddressType) (*entities.Address, error) {
+	address, err := addressRepo.GetByID(ctx, addressID)
+	if err != nil || !address.IsActive {
+		return nil, entities.ErrAddressNotFound
+	}
+
+	if address.IsCompanyAddress() {
+		if companyID == nil || *address.CompanyID != *companyID {
+			return nil, entities.ErrAddressNotFound
+		}
+	} else if address.UserID != userID {
+		return nil, entities.ErrAddressNotFound
+	}
+
+	if purpose == entities.AddressTypeShipping && !address.IsShippingAddress() {
+		return nil, pkgErrors.InvalidInput("Selected address cannot be used for shipping")
+	}
+	if purpose == entities.AddressTypeBilling && !address.IsBillingAddress() {
+		return nil, pkgErrors.InvalidInput("Selected address cannot be used for billing")
+	}
+
+	if err := address.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput("Selected " + string(purpose) + " address is invalid, please update it: " + err.Error())
+	}
+
+	return address, nil
+}
+
+// toAddressRequest converts a saved address into an order address request
+func toAddressRequest(address *entities.Address) AddressRequest {
+	return AddressRequest{
+		FirstName:            address.FirstName,
+		LastName:             address.LastName,
+		Company:              address.Company,
+		Address1:             address.Address1,
+		Address2:             address.Address2,
+		City:                 address.City,
+		State:                address.State,
+		ZipCode:              address.ZipCode,
+		Country:              address.Country,
+		Phone:                address.Phone,
+		DeliveryInstructions: address.DeliveryInstructions,
+	}
 }
 
 // toAddressResponse converts address entity to response
-func (uc *addressUseCase) toAddressResponse(address *entities.Address) *AddressResponse {
+func toAddressResponse(address *entities.Address) *AddressResponse {
 	return &AddressResponse{
 		ID:          address.ID,
 		Type:        address.Type,
@@ -328,5 +573,11 @@ func (uc *addressUseCase) toAddressResponse(address *entities.Address) *AddressR
 		FullAddress: address.GetFullAddress(),
 		CreatedAt:   address.CreatedAt,
 		UpdatedAt:   address.UpdatedAt,
+
+		Label:                address.Label,
+		DeliveryInstructions: address.DeliveryInstructions,
+		IsDefaultShipping:    address.IsDefaultShipping,
+		IsDefaultBilling:     address.IsDefaultBilling,
+		CompanyID:            address.CompanyID,
 	}
 }
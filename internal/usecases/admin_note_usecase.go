@@ -0,0 +1,340 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// AdminNoteUseCase defines use cases for internal admin notes on orders and customers
+type AdminNoteUseCase interface {
+	AddNote(ctx context.Context, authorID uuid.UUID, subjectType entities.AdminNoteSubject, subjectID uuid.UUID, req CreateAdminNoteRequest) (*AdminNoteResponse, error)
+	ListNotes(ctx context.Context, subjectType entities.AdminNoteSubject, subjectID uuid.UUID) ([]*AdminNoteResponse, error)
+	UpdateNote(ctx context.Context, authorID, noteID uuid.UUID, req UpdateAdminNoteRequest) (*AdminNoteResponse, error)
+	DeleteNote(ctx context.Context, noteID uuid.UUID) error
+	SetNotePinned(ctx context.Context, adminID, noteID uuid.UUID, pinned bool) (*AdminNoteResponse, error)
+}
+
+// AdminNoteNotificationService interface for note mention notifications
+type AdminNoteNotificationService interface {
+	NotifyNoteMention(ctx context.Context, note *entities.AdminNote, mentionedUserID uuid.UUID, subjectRef string) error
+}
+
+type adminNoteUseCase struct {
+	noteRepo            repositories.AdminNoteRepository
+	orderRepo           repositories.OrderRepository
+	userRepo            repositories.UserRepository
+	notificationService AdminNoteNotificationService
+}
+
+// NewAdminNoteUseCase creates a new admin note use case
+func NewAdminNoteUseCase(
+	noteRepo repositories.AdminNoteRepository,
+	orderRepo repositories.OrderRepository,
+	userRepo repositories.UserRepository,
+	notificationService AdminNoteNotificationService,
+) AdminNoteUseCase {
+	return &adminNoteUseCase{
+		noteRepo:            noteRepo,
+		orderRepo:           orderRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// maxNoteMentions caps how many admins a single note can notify
+const maxNoteMentions = 20
+
+// noteMentionPattern matches @username and @email mentions that are not part of a longer word
+var noteMentionPattern = regexp.MustCompile(`(?:^|[^\w.@])@([\w.+-]+(?:@[\w-]+(?:\.[\w-]+)+)?)`)
+
+// CreateAdminNoteRequest represents a request to add an internal note
+type CreateAdminNoteRequest struct {
+	Body     string     `json:"body" validate:"required,max=5000"`
+	ParentID *uuid.UUID `json:"parent_id"` // Reply to a note on the same order or customer
+}
+
+// UpdateAdminNoteRequest represents a request to edit an internal note
+type UpdateAdminNoteRequest struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+// AdminNoteAuthorResponse represents the admin who wrote a note
+type AdminNoteAuthorResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
+
+// AdminNoteResponse represents an internal note with its replies
+type AdminNoteResponse struct {
+	ID               uuid.UUID                 `json:"id"`
+	SubjectType      entities.AdminNoteSubject `json:"subject_type"`
+	SubjectID        uuid.UUID                 `json:"subject_id"`
+	ParentID         *uuid.UUID                `json:"parent_id,omitempty"`
+	Author           *AdminNoteAuthorResponse  `json:"author"`
+	Body             string                    `json:"body"`
+	MentionedUserIDs []uuid.UUID               `json:"mentioned_user_ids"`
+	IsPinned         bool                      `json:"is_pinned"`
+	PinnedBy         *uuid.UUID                `json:"pinned_by,omitempty"`
+	PinnedAt         *time.Time                `json:"pinned_at,omitempty"`
+	EditedAt         *time.Time                `json:"edited_at,omitempty"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	Replies          []*AdminNoteResponse      `json:"replies,omitempty"`
+}
+
+// AddNote adds an internal note, or a reply to one, and notifies the admins it mentions
+func (uc *adminNoteUseCase) AddNote(ctx context.Context, authorID uuid.UUID, subjectType entities.AdminNoteSubject, subjectID uuid.UUID, req CreateAdminNoteRequest) (*AdminNoteResponse, error) {
+	subjectRef, err := uc.getSubjectRef(ctx, subjectType, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &entities.AdminNote{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		AuthorID:    authorID,
+		Body:        strings.TrimSpace(req.Body),
+	}
+	if err := note.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if req.ParentID != nil {
+		parent, err := uc.noteRepo.GetByID(ctx, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.SubjectType != subjectType || parent.SubjectID != subjectID {
+			return nil, pkgErrors.InvalidInput("parent note belongs to a different " + string(parent.SubjectType))
+		}
+		// Replies to replies join the thread of the note that started it
+		if parent.IsReply() {
+			note.ParentID = parent.ParentID
+		} else {
+			note.ParentID = &parent.ID
+		}
+	}
+
+	note.MentionedUserIDs = uc.resolveMentions(ctx, note.Body, authorID)
+
+	if err := uc.noteRepo.Create(ctx, note); err != nil {
+		return nil, fmt.Errorf("failed to create note: %w", err)
+	}
+
+	created, err := uc.noteRepo.GetByID(ctx, note.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notifyMentions(created, created.MentionedUserIDs, subjectRef)
+	return toAdminNoteResponse(created), nil
+}
+
+// ListNotes lists the notes on an order or customer as threads, pinned notes first and then newest first
+func (uc *adminNoteUseCase) ListNotes(ctx context.Context, subjectType entities.AdminNoteSubject, subjectID uuid.UUID) ([]*AdminNoteResponse, error) {
+	notes, err := uc.noteRepo.ListBySubject(ctx, subjectType, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notes: %w", err)
+	}
+
+	threads := make([]*AdminNoteResponse, 0, len(notes))
+	byID := make(map[uuid.UUID]*AdminNoteResponse, len(notes))
+	for _, note := range notes {
+		if !note.IsReply() {
+			response := toAdminNoteResponse(note)
+			threads = append(threads, response)
+			byID[note.ID] = response
+		}
+	}
+	// Notes are listed oldest first, so replies end up in chronological order
+	for _, note := range notes {
+		if note.IsReply() {
+			if thread, ok := byID[*note.ParentID]; ok {
+				thread.Replies = append(thread.Replies, toAdminNoteResponse(note))
+			}
+		}
+	}
+
+	sort.SliceStable(threads, func(i, j int) bool {
+		if threads[i].IsPinned != threads[j].IsPinned {
+			return threads[i].IsPinned
+		}
+		if threads[i].IsPinned {
+			return threads[i].PinnedAt.After(*threads[j].PinnedAt)
+		}
+		return threads[i].CreatedAt.After(threads[j].CreatedAt)
+	})
+
+	return threads, nil
+}
+
+// UpdateNote edits a note's body. Only the author can edit a note; newly mentioned admins are notified.
+func (uc *adminNoteUseCase) UpdateNote(ctx context.Context, authorID, noteID uuid.UUID, req UpdateAdminNoteRequest) (*AdminNoteResponse, error) {
+	note, err := uc.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if note.AuthorID != authorID {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only the author can edit a note")
+	}
+
+	note.Body = strings.TrimSpace(req.Body)
+	if err := note.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	mentioned := uc.resolveMentions(ctx, note.Body, authorID)
+	var newlyMentioned []uuid.UUID
+	for _, id := range mentioned {
+		if !note.IsMentioned(id) {
+			newlyMentioned = append(newlyMentioned, id)
+		}
+	}
+
+	now := time.Now()
+	note.MentionedUserIDs = mentioned
+	note.EditedAt = &now
+	if err := uc.noteRepo.Update(ctx, note); err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	if len(newlyMentioned) > 0 {
+		subjectRef, err := uc.getSubjectRef(ctx, note.SubjectType, note.SubjectID)
+		if err == nil {
+			uc.notifyMentions(note, newlyMentioned, subjectRef)
+		}
+	}
+
+	return toAdminNoteResponse(note), nil
+}
+
+// DeleteNote deletes a note together with its replies
+func (uc *adminNoteUseCase) DeleteNote(ctx context.Context, noteID uuid.UUID) error {
+	return uc.noteRepo.Delete(ctx, noteID)
+}
+
+// SetNotePinned pins or unpins a note. Only notes that start a thread can be pinned.
+func (uc *adminNoteUseCase) SetNotePinned(ctx context.Context, adminID, noteID uuid.UUID, pinned bool) (*AdminNoteResponse, error) {
+	note, err := uc.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if note.IsReply() {
+		return nil, pkgErrors.InvalidInput("replies cannot be pinned")
+	}
+	if note.IsPinned == pinned {
+		return toAdminNoteResponse(note), nil
+	}
+
+	if pinned {
+		note.Pin(adminID, time.Now())
+	} else {
+		note.Unpin()
+	}
+	if err := uc.noteRepo.Update(ctx, note); err != nil {
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	return toAdminNoteResponse(note), nil
+}
+
+// getSubjectRef checks that the order or customer exists and returns how notifications refer to it
+func (uc *adminNoteUseCase) getSubjectRef(ctx context.Context, subjectType entities.AdminNoteSubject, subjectID uuid.UUID) (string, error) {
+	switch subjectType {
+	case entities.AdminNoteSubjectOrder:
+		order, err := uc.orderRepo.GetByID(ctx, subjectID)
+		if err != nil {
+			return "", entities.ErrOrderNotFound
+		}
+		return order.OrderNumber, nil
+	case entities.AdminNoteSubjectCustomer:
+		user, err := uc.userRepo.GetByID(ctx, subjectID)
+		if err != nil {
+			return "", entities.ErrUserNotFound
+		}
+		return user.GetFullName(), nil
+	default:
+		return "", pkgErrors.InvalidInput(fmt.Sprintf("invalid note subject: %s", subjectType))
+	}
+}
+
+// resolveMentions finds the admins mentioned in a note body by @email or @username.
+// Mentions of unknown users, non-admins and the author are ignored.
+func (uc *adminNoteUseCase) resolveMentions(ctx context.Context, body string, authorID uuid.UUID) []uuid.UUID {
+	mentioned := []uuid.UUID{}
+	seen := map[uuid.UUID]bool{authorID: true}
+
+	for _, match := range noteMentionPattern.FindAllStringSubmatch(body, -1) {
+		if len(mentioned) >= maxNoteMentions {
+			break
+		}
+
+		handle := strings.TrimRight(match[1], ".-")
+		var user *entities.User
+		var err error
+		if strings.Contains(handle, "@") {
+			user, err = uc.userRepo.GetByEmail(ctx, strings.ToLower(handle))
+		} else {
+			user, err = uc.userRepo.GetByUsername(ctx, handle)
+		}
+		if err != nil || user == nil || !user.IsAdmin() || seen[user.ID] {
+			continue
+		}
+
+		seen[user.ID] = true
+		mentioned = append(mentioned, user.ID)
+	}
+
+	return mentioned
+}
+
+// notifyMentions notifies mentioned admins in the background
+func (uc *adminNoteUseCase) notifyMentions(note *entities.AdminNote, userIDs []uuid.UUID, subjectRef string) {
+	if uc.notificationService == nil || len(userIDs) == 0 {
+		return
+	}
+	go func(note entities.AdminNote) {
+		for _, userID := range userIDs {
+			if err := uc.notificationService.NotifyNoteMention(context.Background(), &note, userID, subjectRef); err != nil {
+				fmt.Printf("Failed to send note mention notification: %v\n", err)
+			}
+		}
+	}(*note)
+}
+
+func toAdminNoteResponse(note *entities.AdminNote) *AdminNoteResponse {
+	response := &AdminNoteResponse{
+		ID:               note.ID,
+		SubjectType:      note.SubjectType,
+		SubjectID:        note.SubjectID,
+		ParentID:         note.ParentID,
+		Body:             note.Body,
+		MentionedUserIDs: note.MentionedUserIDs,
+		IsPinned:         note.IsPinned,
+		PinnedBy:         note.PinnedBy,
+		PinnedAt:         note.PinnedAt,
+		EditedAt:         note.EditedAt,
+		CreatedAt:        note.CreatedAt,
+	}
+	if response.MentionedUserIDs == nil {
+		response.MentionedUserIDs = []uuid.UUID{}
+	}
+	if note.Author != nil {
+		response.Author = &AdminNoteAuthorResponse{
+			ID:    note.Author.ID,
+			Name:  note.Author.GetFullName(),
+			Email: note.Author.Email,
+		}
+	}
+	return response
+}
@@ -0,0 +1,430 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// adminTaskSourceLimit caps how many sources of one type a sync reads. Open tasks are only
+// resolved automatically when every source fit, so a backlog larger than this never closes
+// tasks that were merely left out.
+const adminTaskSourceLimit = 5000
+
+// How long admins have to act on a task once it is raised, unless its source has a deadline
+const (
+	reviewModerationDue  = 48 * time.Hour
+	orderVerificationDue = 24 * time.Hour
+	stockReorderDue      = 48 * time.Hour
+	stockOutReorderDue   = 24 * time.Hour
+)
+
+// AdminTaskUseCase defines use cases for the admin inbox: tasks raised from what needs an
+// admin's attention, assigned, scheduled and completed by admins
+type AdminTaskUseCase interface {
+	// GetInbox gets the open task counts per type and a page of tasks for the admin home screen
+	GetInbox(ctx context.Context, adminID uuid.UUID, req GetAdminInboxRequest) (*AdminInboxResponse, error)
+	GetTask(ctx context.Context, id uuid.UUID) (*AdminTaskResponse, error)
+	AssignTask(ctx context.Context, adminID, id uuid.UUID, req AssignAdminTaskRequest) (*AdminTaskResponse, error)
+	SetTaskDueDate(ctx context.Context, id uuid.UUID, req SetAdminTaskDueDateRequest) (*AdminTaskResponse, error)
+	CompleteTask(ctx context.Context, adminID, id uuid.UUID, req CompleteAdminTaskRequest) (*AdminTaskResponse, error)
+
+	// SyncTasks raises tasks for whatever newly needs attention and resolves open tasks whose
+	// source no longer does, returning how many were raised and resolved
+	SyncTasks(ctx context.Context) (raised int, resolved int, err error)
+}
+
+// AdminTaskNotificationService interface for telling admins about tasks given to them
+type AdminTaskNotificationService interface {
+	NotifyAdminTaskAssigned(ctx context.Context, task *entities.AdminTask) error
+}
+
+type adminTaskUseCase struct {
+	taskRepo            repositories.AdminTaskRepository
+	userRepo            repositories.UserRepository
+	notificationService AdminTaskNotificationService
+}
+
+// NewAdminTaskUseCase creates a new admin task use case
+func NewAdminTaskUseCase(
+	taskRepo repositories.AdminTaskRepository,
+	userRepo repositories.UserRepository,
+	notificationService AdminTaskNotificationService,
+) AdminTaskUseCase {
+	return &adminTaskUseCase{
+		taskRepo:            taskRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// GetAdminInboxRequest represents filters for the admin inbox
+type GetAdminInboxRequest struct {
+	Type entities.AdminTaskType `form:"type" json:"type,omitempty"`
+	// Status defaults to open tasks
+	Status entities.AdminTaskStatus `form:"status" json:"status,omitempty"`
+	// Assignee is "me", "unassigned" or an admin's user ID
+	Assignee string `form:"assignee" json:"assignee,omitempty"`
+	Overdue  bool   `form:"overdue" json:"overdue,omitempty"`
+	Limit    int    `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset   int    `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// AdminInboxResponse represents the admin inbox: open task counts and a page of tasks
+type AdminInboxResponse struct {
+	Open         int64                        `json:"open"`
+	Overdue      int64                        `json:"overdue"`
+	AssignedToMe int64                        `json:"assigned_to_me"`
+	Summary      []*entities.AdminTaskSummary `json:"summary"`
+	Tasks        []*AdminTaskResponse         `json:"tasks"`
+	Total        int64                        `json:"total"`
+	Pagination   *PaginationInfo              `json:"pagination"`
+}
+
+// AdminTaskResponse represents an inbox task with what it is about
+type AdminTaskResponse struct {
+	*entities.AdminTask
+	SourceType string `json:"source_type"`
+	Overdue    bool   `json:"overdue"`
+}
+
+// AssignAdminTaskRequest represents assigning a task; a missing assignee unassigns it
+type AssignAdminTaskRequest struct {
+	AssigneeID *uuid.UUID `json:"assignee_id"`
+}
+
+// SetAdminTaskDueDateRequest represents rescheduling a task; a missing date clears it
+type SetAdminTaskDueDateRequest struct {
+	DueAt *time.Time `json:"due_at"`
+}
+
+// CompleteAdminTaskRequest represents completing a task
+type CompleteAdminTaskRequest struct {
+	Note string `json:"note" validate:"max=2000"`
+}
+
+// GetInbox gets the admin inbox
+func (uc *adminTaskUseCase) GetInbox(ctx context.Context, adminID uuid.UUID, req GetAdminInboxRequest) (*AdminInboxResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+	if req.Status == "" {
+		req.Status = entities.AdminTaskStatusOpen
+	}
+
+	now := time.Now()
+	filters := repositories.AdminTaskFilters{
+		Type:   req.Type,
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	switch req.Assignee {
+	case "":
+	case "me":
+		filters.AssigneeID = &adminID
+	case "unassigned":
+		filters.Unassigned = true
+	default:
+		assigneeID, err := uuid.Parse(req.Assignee)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("assignee must be me, unassigned or a user ID")
+		}
+		filters.AssigneeID = &assigneeID
+	}
+	if req.Overdue {
+		filters.OverdueAt = &now
+	}
+
+	tasks, total, err := uc.taskRepo.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := uc.taskRepo.GetSummary(ctx, adminID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminInboxResponse{
+		Tasks:      make([]*AdminTaskResponse, len(tasks)),
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}
+	for i, task := range tasks {
+		response.Tasks[i] = newAdminTaskResponse(task, now)
+	}
+
+	// Every type is listed, with zeros when it has no open tasks
+	byType := make(map[entities.AdminTaskType]*entities.AdminTaskSummary, len(counts))
+	for _, count := range counts {
+		byType[count.Type] = count
+	}
+	for _, taskType := range entities.AdminTaskTypes {
+		summary, ok := byType[taskType]
+		if !ok {
+			summary = &entities.AdminTaskSummary{Type: taskType}
+		}
+		response.Summary = append(response.Summary, summary)
+		response.Open += summary.Open
+		response.Overdue += summary.Overdue
+		response.AssignedToMe += summary.AssignedToMe
+	}
+	return response, nil
+}
+
+// GetTask gets a task
+func (uc *adminTaskUseCase) GetTask(ctx context.Context, id uuid.UUID) (*AdminTaskResponse, error) {
+	task, err := uc.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newAdminTaskResponse(task, time.Now()), nil
+}
+
+// AssignTask assigns an open task to an admin or moderator, who is notified, or unassigns it
+func (uc *adminTaskUseCase) AssignTask(ctx context.Context, adminID, id uuid.UUID, req AssignAdminTaskRequest) (*AdminTaskResponse, error) {
+	task, err := uc.getOpenTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Assignee = nil
+	if req.AssigneeID == nil {
+		task.AssigneeID = nil
+		task.AssignedAt = nil
+		task.AssignedBy = nil
+	} else {
+		assignee, err := uc.userRepo.GetByID(ctx, *req.AssigneeID)
+		if err != nil {
+			if err == entities.ErrUserNotFound {
+				return nil, pkgErrors.InvalidInput("assignee not found")
+			}
+			return nil, err
+		}
+		if !assignee.CanManageProducts() || assignee.Status != entities.UserStatusActive {
+			return nil, pkgErrors.InvalidInput("tasks can only be assigned to active admins and moderators")
+		}
+
+		now := time.Now()
+		task.AssigneeID = &assignee.ID
+		task.AssignedAt = &now
+		task.AssignedBy = &adminID
+	}
+
+	if err := uc.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to assign task: %w", err)
+	}
+
+	// Admins taking a task themselves don't need telling
+	if task.AssigneeID != nil && *task.AssigneeID != adminID && uc.notificationService != nil {
+		if err := uc.notificationService.NotifyAdminTaskAssigned(ctx, task); err != nil {
+			fmt.Printf("❌ Failed to notify assignee of task %s: %v\n", task.ID, err)
+		}
+	}
+
+	return uc.GetTask(ctx, task.ID)
+}
+
+// SetTaskDueDate reschedules an open task. Dispute tasks follow the provider's evidence
+// deadline, so their due date is reset whenever the provider changes it.
+func (uc *adminTaskUseCase) SetTaskDueDate(ctx context.Context, id uuid.UUID, req SetAdminTaskDueDateRequest) (*AdminTaskResponse, error) {
+	task, err := uc.getOpenTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Assignee = nil
+	task.DueAt = req.DueAt
+	if err := uc.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return uc.GetTask(ctx, task.ID)
+}
+
+// CompleteTask marks an open task done. A task whose source still needs attention is raised
+// again by the next sync, e.g. a review that is still pending.
+func (uc *adminTaskUseCase) CompleteTask(ctx context.Context, adminID, id uuid.UUID, req CompleteAdminTaskRequest) (*AdminTaskResponse, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if len(req.Note) > 2000 {
+		return nil, pkgErrors.InvalidInput("note must be at most 2000 characters")
+	}
+
+	task, err := uc.getOpenTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Assignee = nil
+	task.Complete(&adminID, req.Note)
+	if err := uc.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to complete task: %w", err)
+	}
+	return uc.GetTask(ctx, task.ID)
+}
+
+func (uc *adminTaskUseCase) getOpenTask(ctx context.Context, id uuid.UUID) (*entities.AdminTask, error) {
+	task, err := uc.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !task.IsOpen() {
+		return nil, entities.ErrAdminTaskClosed
+	}
+	return task, nil
+}
+
+// SyncTasks brings the open tasks of every type in line with what needs attention
+func (uc *adminTaskUseCase) SyncTasks(ctx context.Context) (int, int, error) {
+	raised, resolved := 0, 0
+	for _, taskType := range entities.AdminTaskTypes {
+		typeRaised, typeResolved, err := uc.syncTaskType(ctx, taskType)
+		raised += typeRaised
+		resolved += typeResolved
+		if err != nil {
+			return raised, resolved, fmt.Errorf("failed to sync %s tasks: %w", taskType, err)
+		}
+	}
+	return raised, resolved, nil
+}
+
+func (uc *adminTaskUseCase) syncTaskType(ctx context.Context, taskType entities.AdminTaskType) (int, int, error) {
+	sources, err := uc.taskRepo.GetTaskSources(ctx, taskType, adminTaskSourceLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	openTasks, err := uc.taskRepo.GetOpenByType(ctx, taskType)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	open := make(map[uuid.UUID]*entities.AdminTask, len(openTasks))
+	for _, task := range openTasks {
+		open[task.SourceID] = task
+	}
+
+	raised, resolved := 0, 0
+	current := make(map[uuid.UUID]bool, len(sources))
+	for _, source := range sources {
+		current[source.ID] = true
+		task := newAdminTask(taskType, source)
+
+		existing, ok := open[source.ID]
+		if !ok {
+			created, err := uc.taskRepo.Create(ctx, task)
+			if err != nil {
+				return raised, resolved, err
+			}
+			if created {
+				raised++
+			}
+			continue
+		}
+
+		// Keep the task describing its source, e.g. stock that ran out since it was raised
+		changed := existing.Priority != task.Priority || existing.Title != task.Title ||
+			existing.Description != task.Description
+		if source.DueAt != nil && (existing.DueAt == nil || !existing.DueAt.Equal(*source.DueAt)) {
+			existing.DueAt = source.DueAt
+			changed = true
+		}
+		if changed {
+			existing.Priority = task.Priority
+			existing.Title = task.Title
+			existing.Description = task.Description
+			if err := uc.taskRepo.Update(ctx, existing); err != nil {
+				return raised, resolved, err
+			}
+		}
+	}
+
+	if len(sources) >= adminTaskSourceLimit {
+		return raised, resolved, nil
+	}
+	for sourceID, task := range open {
+		if current[sourceID] {
+			continue
+		}
+		task.Complete(nil, "Resolved automatically: no longer needs attention")
+		if err := uc.taskRepo.Update(ctx, task); err != nil {
+			return raised, resolved, err
+		}
+		resolved++
+	}
+	return raised, resolved, nil
+}
+
+// newAdminTask describes the task a source raises
+func newAdminTask(taskType entities.AdminTaskType, source *entities.AdminTaskSource) *entities.AdminTask {
+	task := &entities.AdminTask{
+		Type:     taskType,
+		SourceID: source.ID,
+		Status:   entities.AdminTaskStatusOpen,
+		Priority: entities.AdminTaskPriorityNormal,
+	}
+
+	var due time.Time
+	switch taskType {
+	case entities.AdminTaskTypeReviewModeration:
+		task.Title = fmt.Sprintf("Moderate review of %s", source.Name)
+		task.Description = fmt.Sprintf("A %d-star review of %s (%s) is waiting for moderation.",
+			source.Quantity, source.Name, source.Reference)
+		// Unhappy customers are answered first
+		if source.Quantity <= 2 {
+			task.Priority = entities.AdminTaskPriorityHigh
+		}
+		due = source.OccurredAt.Add(reviewModerationDue)
+	case entities.AdminTaskTypeOrderVerification:
+		task.Title = fmt.Sprintf("Verify order %s", source.Reference)
+		task.Description = fmt.Sprintf("Confirm the %.2f %s payment of order %s", source.Amount, source.Currency, source.Reference)
+		if source.Name != "" {
+			task.Description += fmt.Sprintf(" by %s", source.Name)
+		}
+		task.Description += " so it can be processed."
+		task.Priority = entities.AdminTaskPriorityHigh
+		due = source.OccurredAt.Add(orderVerificationDue)
+	case entities.AdminTaskTypeStockReorder:
+		task.Title = fmt.Sprintf("Reorder %s", source.Name)
+		task.Description = fmt.Sprintf("%s has %d units available, at or below its reorder level. Raise a purchase order.",
+			source.Reference, source.Quantity)
+		task.Priority = entities.AdminTaskPriorityHigh
+		due = source.OccurredAt.Add(stockReorderDue)
+		if source.Quantity <= 0 {
+			task.Title = fmt.Sprintf("Reorder %s (out of stock)", source.Name)
+			task.Priority = entities.AdminTaskPriorityUrgent
+			due = source.OccurredAt.Add(stockOutReorderDue)
+		}
+	case entities.AdminTaskTypeDisputeEvidence:
+		task.Title = fmt.Sprintf("Submit evidence for dispute %s", source.Reference)
+		task.Description = fmt.Sprintf("%.2f %s is disputed", source.Amount, source.Currency)
+		if source.Name != "" {
+			task.Description += fmt.Sprintf(" on order %s", source.Name)
+		}
+		task.Description += ". Submit evidence to the provider, then record it on the dispute."
+		task.Priority = entities.AdminTaskPriorityUrgent
+	}
+
+	if source.DueAt != nil {
+		task.DueAt = source.DueAt
+	} else if !due.IsZero() {
+		task.DueAt = &due
+	}
+	return task
+}
+
+func newAdminTaskResponse(task *entities.AdminTask, now time.Time) *AdminTaskResponse {
+	return &AdminTaskResponse{
+		AdminTask:  task,
+		SourceType: task.Type.SourceType(),
+		Overdue:    task.IsOverdue(now),
+	}
+}
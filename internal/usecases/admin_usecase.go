@@ -3,11 +3,13 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"runtime"
 	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -88,24 +90,23 @@ type AdminUseCase interface {
 	GetSystemLogs(ctx context.Context, req SystemLogsRequest) (*SystemLogsResponse, error)
 	GetAuditLogs(ctx context.Context, req AuditLogsRequest) (*AuditLogsResponse, error)
 	BackupDatabase(ctx context.Context) (*BackupResponse, error)
-
-	// Reports
-	GenerateReport(ctx context.Context, req GenerateReportRequest) (*ReportResponse, error)
-	GetReports(ctx context.Context, req GetReportsRequest) (*ReportsListResponse, error)
-	DownloadReport(ctx context.Context, reportID uuid.UUID) (*DownloadResponse, error)
 }
 
 type adminUseCase struct {
-	userRepo             repositories.UserRepository
-	orderRepo            repositories.OrderRepository
-	productRepo          repositories.ProductRepository
-	reviewRepo           repositories.ReviewRepository
-	analyticsRepo        repositories.AnalyticsRepository
-	inventoryRepo        repositories.InventoryRepository
-	paymentRepo          repositories.PaymentRepository
-	auditRepo            repositories.AuditRepository
-	userLoginHistoryRepo repositories.UserLoginHistoryRepository
-	orderUseCase         OrderUseCase
+	userRepo               repositories.UserRepository
+	orderRepo              repositories.OrderRepository
+	productRepo            repositories.ProductRepository
+	reviewRepo             repositories.ReviewRepository
+	analyticsRepo          repositories.AnalyticsRepository
+	inventoryRepo          repositories.InventoryRepository
+	paymentRepo            repositories.PaymentRepository
+	auditRepo              repositories.AuditRepository
+	userLoginHistoryRepo   repositories.UserLoginHistoryRepository
+	orderUseCase           OrderUseCase
+	adminNoteUseCase       AdminNoteUseCase
+	orderMessageUseCase    OrderMessageUseCase
+	reviewIncentiveService ReviewIncentiveService
+	systemRepo             repositories.SystemRepository
 }
 
 // NewAdminUseCase creates a new admin use case
@@ -120,18 +121,26 @@ func NewAdminUseCase(
 	auditRepo repositories.AuditRepository,
 	userLoginHistoryRepo repositories.UserLoginHistoryRepository,
 	orderUseCase OrderUseCase,
+	adminNoteUseCase AdminNoteUseCase,
+	orderMessageUseCase OrderMessageUseCase,
+	reviewIncentiveService ReviewIncentiveService,
+	systemRepo repositories.SystemRepository,
 ) AdminUseCase {
 	return &adminUseCase{
-		userRepo:             userRepo,
-		orderRepo:            orderRepo,
-		productRepo:          productRepo,
-		reviewRepo:           reviewRepo,
-		analyticsRepo:        analyticsRepo,
-		inventoryRepo:        inventoryRepo,
-		paymentRepo:          paymentRepo,
-		auditRepo:            auditRepo,
-		userLoginHistoryRepo: userLoginHistoryRepo,
-		orderUseCase:         orderUseCase,
+		userRepo:               userRepo,
+		orderRepo:              orderRepo,
+		productRepo:            productRepo,
+		reviewRepo:             reviewRepo,
+		analyticsRepo:          analyticsRepo,
+		inventoryRepo:          inventoryRepo,
+		paymentRepo:            paymentRepo,
+		auditRepo:              auditRepo,
+		userLoginHistoryRepo:   userLoginHistoryRepo,
+		orderUseCase:           orderUseCase,
+		adminNoteUseCase:       adminNoteUseCase,
+		orderMessageUseCase:    orderMessageUseCase,
+		reviewIncentiveService: reviewIncentiveService,
+		systemRepo:             systemRepo,
 	}
 }
 
@@ -267,26 +276,6 @@ type AuditLogsRequest struct {
 	Offset   int        `json:"offset" validate:"min=0"`
 }
 
-type GenerateReportRequest struct {
-	Type      string                 `json:"type" validate:"required,oneof=sales products users inventory payments"`
-	Format    string                 `json:"format" validate:"required,oneof=csv excel pdf"`
-	DateFrom  time.Time              `json:"date_from" validate:"required"`
-	DateTo    time.Time              `json:"date_to" validate:"required"`
-	Filters   map[string]interface{} `json:"filters,omitempty"`
-	GroupBy   string                 `json:"group_by,omitempty"`
-	CreatedBy uuid.UUID              `json:"created_by" validate:"required"`
-}
-
-type GetReportsRequest struct {
-	Type      string     `json:"type,omitempty"`
-	Status    string     `json:"status,omitempty"`
-	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
-	DateFrom  *time.Time `json:"date_from,omitempty"`
-	DateTo    *time.Time `json:"date_to,omitempty"`
-	Limit     int        `json:"limit" validate:"min=1,max=100"`
-	Offset    int        `json:"offset" validate:"min=0"`
-}
-
 // Response types
 type AdminDashboardResponse struct {
 	Overview struct {
@@ -356,29 +345,27 @@ type AdminDashboardResponse struct {
 	} `json:"recent_orders"`
 }
 
+// SystemStatsResponse represents the size and load of the database and this server instance
 type SystemStatsResponse struct {
 	Database struct {
-		TotalSize       string `json:"total_size"`
-		TableCount      int    `json:"table_count"`
-		ConnectionCount int    `json:"connection_count"`
-		QueryCount      int64  `json:"query_count"`
+		TotalSize        string `json:"total_size"`
+		SizeBytes        int64  `json:"size_bytes"`
+		TableCount       int    `json:"table_count"`
+		ConnectionCount  int    `json:"connection_count"`
+		TransactionCount int64  `json:"transaction_count"`
+		PoolOpen         int    `json:"pool_open"`
+		PoolInUse        int    `json:"pool_in_use"`
 	} `json:"database"`
 
 	Server struct {
-		Uptime       string  `json:"uptime"`
-		CPUUsage     float64 `json:"cpu_usage"`
-		MemoryUsage  float64 `json:"memory_usage"`
-		DiskUsage    float64 `json:"disk_usage"`
-		RequestCount int64   `json:"request_count"`
-		ErrorRate    float64 `json:"error_rate"`
+		Uptime      string    `json:"uptime"`
+		StartedAt   time.Time `json:"started_at"`
+		GoVersion   string    `json:"go_version"`
+		CPUCount    int       `json:"cpu_count"`
+		Goroutines  int       `json:"goroutines"`
+		MemoryUsage string    `json:"memory_usage"` // Heap in use
+		MemoryBytes uint64    `json:"memory_bytes"`
 	} `json:"server"`
-
-	Cache struct {
-		HitRate     float64 `json:"hit_rate"`
-		MissRate    float64 `json:"miss_rate"`
-		KeyCount    int64   `json:"key_count"`
-		MemoryUsage string  `json:"memory_usage"`
-	} `json:"cache"`
 }
 
 // AdminUserResponse represents a single user in admin responses
@@ -503,6 +490,12 @@ type AdminOrderDetailsResponse struct {
 		UserID      *uuid.UUID `json:"user_id,omitempty"`
 		UserName    string     `json:"user_name,omitempty"`
 	} `json:"timeline"`
+
+	// Notes are internal notes and comments, never shown to the customer
+	Notes []*AdminNoteResponse `json:"notes"`
+
+	// Messages is the conversation with the customer, with unread counting the customer's messages
+	Messages *OrderMessagesResponse `json:"messages,omitempty"`
 }
 
 type AdminProductsResponse struct {
@@ -631,31 +624,6 @@ type BackupResponse struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
-type ReportResponse struct {
-	ReportID    uuid.UUID  `json:"report_id"`
-	Type        string     `json:"type"`
-	Format      string     `json:"format"`
-	Status      string     `json:"status"`
-	Progress    int        `json:"progress"`
-	DownloadURL string     `json:"download_url,omitempty"`
-	CreatedBy   uuid.UUID  `json:"created_by"`
-	CreatedAt   time.Time  `json:"created_at"`
-	CompletedAt *time.Time `json:"completed_at"`
-}
-
-type ReportsListResponse struct {
-	Reports    []*ReportResponse `json:"reports"`
-	Total      int64             `json:"total"`
-	Pagination *PaginationInfo   `json:"pagination"`
-}
-
-type DownloadResponse struct {
-	URL       string    `json:"url"`
-	Filename  string    `json:"filename"`
-	Size      int64     `json:"size"`
-	ExpiresAt time.Time `json:"expires_at"`
-}
-
 type AuditLogFilters struct {
 	UserID   *uuid.UUID `json:"user_id,omitempty"`
 	Action   string     `json:"action,omitempty"`
@@ -1013,34 +981,6 @@ func (uc *adminUseCase) BulkUpdateProducts(ctx context.Context, req BulkUpdatePr
 	return nil
 }
 
-// GenerateReport generates a report
-func (uc *adminUseCase) GenerateReport(ctx context.Context, req GenerateReportRequest) (*ReportResponse, error) {
-	// Mock implementation for generate report
-	response := &ReportResponse{
-		ReportID:    uuid.New(),
-		Type:        req.Type,
-		Format:      req.Format,
-		Status:      "completed",
-		Progress:    100,
-		DownloadURL: "/api/v1/admin/reports/" + uuid.New().String() + "/download",
-		CreatedBy:   uuid.New(), // Should be current user ID
-		CreatedAt:   time.Now(),
-		CompletedAt: &time.Time{},
-	}
-	*response.CompletedAt = time.Now()
-	return response, nil
-}
-
-// DownloadReport downloads a report
-func (uc *adminUseCase) DownloadReport(ctx context.Context, reportID uuid.UUID) (*DownloadResponse, error) {
-	// Mock implementation for download report
-	response := &DownloadResponse{
-		Filename: "report_" + reportID.String() + ".pdf",
-		Size:     1024000, // 1MB
-	}
-	return response, nil
-}
-
 // GetAuditLogs gets audit logs
 func (uc *adminUseCase) GetAuditLogs(ctx context.Context, req AuditLogsRequest) (*AuditLogsResponse, error) {
 	// Mock implementation for audit logs
@@ -1059,7 +999,7 @@ func (uc *adminUseCase) GetAuditLogs(ctx context.Context, req AuditLogsRequest)
 			CreatedAt  time.Time `json:"created_at"`
 		}{
 			{
-				ID:         uuid.New(),
+				ID:         ids.New(),
 				UserID:     uuid.New(),
 				UserName:   "John Doe",
 				Action:     "login",
@@ -1070,7 +1010,7 @@ func (uc *adminUseCase) GetAuditLogs(ctx context.Context, req AuditLogsRequest)
 				CreatedAt:  time.Now().Add(-1 * time.Hour),
 			},
 			{
-				ID:         uuid.New(),
+				ID:         ids.New(),
 				UserID:     uuid.New(),
 				UserName:   "Jane Smith",
 				Action:     "create_product",
@@ -1278,6 +1218,29 @@ func (uc *adminUseCase) GetOrderDetails(ctx context.Context, orderID uuid.UUID)
 	}
 	response.Payments = payments
 
+	// Add internal notes
+	response.Notes = []*AdminNoteResponse{}
+	if uc.adminNoteUseCase != nil {
+		notes, err := uc.adminNoteUseCase.ListNotes(ctx, entities.AdminNoteSubjectOrder, order.ID)
+		if err != nil {
+			// Log error but don't fail the request
+			fmt.Printf("❌ Failed to get order notes: %v\n", err)
+		} else {
+			response.Notes = notes
+		}
+	}
+
+	// Add the customer conversation
+	if uc.orderMessageUseCase != nil {
+		messages, err := uc.orderMessageUseCase.GetOrderMessages(ctx, order.ID, entities.OrderMessageSenderStore)
+		if err != nil {
+			// Log error but don't fail the request
+			fmt.Printf("❌ Failed to get order messages: %v\n", err)
+		} else {
+			response.Messages = messages
+		}
+	}
+
 	return response, nil
 }
 
@@ -1415,52 +1378,49 @@ func (uc *adminUseCase) GetOrders(ctx context.Context, req AdminOrdersRequest) (
 	return response, nil
 }
 
-// GetSystemStats gets system statistics
+// GetSystemStats gets the size and load of the database and of this server instance
 func (uc *adminUseCase) GetSystemStats(ctx context.Context) (*SystemStatsResponse, error) {
-	// Mock implementation for system stats
-	response := &SystemStatsResponse{
-		Database: struct {
-			TotalSize       string `json:"total_size"`
-			TableCount      int    `json:"table_count"`
-			ConnectionCount int    `json:"connection_count"`
-			QueryCount      int64  `json:"query_count"`
-		}{
-			TotalSize:       "2.5 GB",
-			TableCount:      25,
-			ConnectionCount: 10,
-			QueryCount:      1250000,
-		},
-		Server: struct {
-			Uptime       string  `json:"uptime"`
-			CPUUsage     float64 `json:"cpu_usage"`
-			MemoryUsage  float64 `json:"memory_usage"`
-			DiskUsage    float64 `json:"disk_usage"`
-			RequestCount int64   `json:"request_count"`
-			ErrorRate    float64 `json:"error_rate"`
-		}{
-			Uptime:       "15 days, 8 hours",
-			CPUUsage:     45.2,
-			MemoryUsage:  65.5,
-			DiskUsage:    78.9,
-			RequestCount: 1250000,
-			ErrorRate:    0.02,
-		},
-		Cache: struct {
-			HitRate     float64 `json:"hit_rate"`
-			MissRate    float64 `json:"miss_rate"`
-			KeyCount    int64   `json:"key_count"`
-			MemoryUsage string  `json:"memory_usage"`
-		}{
-			HitRate:     92.5,
-			MissRate:    7.5,
-			KeyCount:    50000,
-			MemoryUsage: "256 MB",
-		},
-	}
+	dbStats, err := uc.systemRepo.GetDatabaseStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database stats: %w", err)
+	}
+
+	response := &SystemStatsResponse{}
+	response.Database.TotalSize = formatByteSize(uint64(dbStats.SizeBytes))
+	response.Database.SizeBytes = dbStats.SizeBytes
+	response.Database.TableCount = dbStats.TableCount
+	response.Database.ConnectionCount = dbStats.ConnectionCount
+	response.Database.TransactionCount = dbStats.TransactionCount
+	response.Database.PoolOpen = dbStats.PoolOpen
+	response.Database.PoolInUse = dbStats.PoolInUse
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	response.Server.Uptime = time.Since(processStartedAt).Round(time.Second).String()
+	response.Server.StartedAt = processStartedAt
+	response.Server.GoVersion = runtime.Version()
+	response.Server.CPUCount = runtime.NumCPU()
+	response.Server.Goroutines = runtime.NumGoroutine()
+	response.Server.MemoryUsage = formatByteSize(memStats.HeapInuse)
+	response.Server.MemoryBytes = memStats.HeapInuse
 
 	return response, nil
 }
 
+// formatByteSize formats a number of bytes with a binary unit, such as "2.5 GB"
+func formatByteSize(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTP"[exp])
+}
+
 // ManageReviews manages reviews
 func (uc *adminUseCase) ManageReviews(ctx context.Context, req ManageReviewsRequest) (*ManageReviewsResponse, error) {
 	// Mock implementation for manage reviews
@@ -1481,7 +1441,7 @@ func (uc *adminUseCase) ManageReviews(ctx context.Context, req ManageReviewsRequ
 			CreatedAt    time.Time             `json:"created_at"`
 		}{
 			{
-				ID:           uuid.New(),
+				ID:           ids.New(),
 				ProductID:    uuid.New(),
 				ProductName:  "iPhone 15",
 				UserID:       uuid.New(),
@@ -1532,6 +1492,11 @@ func (uc *adminUseCase) UpdateReviewStatus(ctx context.Context, reviewID uuid.UU
 		return err
 	}
 
+	// Grant the review incentive if the program is running
+	if review.Status == entities.ReviewStatusApproved {
+		rewardApprovedReview(uc.reviewIncentiveService, review.ID)
+	}
+
 	// Recalculate product rating (only approved reviews count)
 	// This will be handled by the repository layer
 	return nil
@@ -1562,40 +1527,6 @@ func (uc *adminUseCase) ProcessRefund(ctx context.Context, orderID uuid.UUID, am
 	return nil
 }
 
-// GetReports gets reports
-func (uc *adminUseCase) GetReports(ctx context.Context, req GetReportsRequest) (*ReportsListResponse, error) {
-	// Mock implementation for get reports
-	now := time.Now()
-	response := &ReportsListResponse{
-		Reports: []*ReportResponse{
-			{
-				ReportID:    uuid.New(),
-				Type:        "sales",
-				Format:      "pdf",
-				Status:      "completed",
-				Progress:    100,
-				DownloadURL: "/api/v1/admin/reports/" + uuid.New().String() + "/download",
-				CreatedBy:   uuid.New(),
-				CreatedAt:   now.Add(-1 * time.Hour),
-				CompletedAt: &now,
-			},
-			{
-				ReportID:    uuid.New(),
-				Type:        "inventory",
-				Format:      "excel",
-				Status:      "pending",
-				Progress:    45,
-				CreatedBy:   uuid.New(),
-				CreatedAt:   now.Add(-30 * time.Minute),
-				CompletedAt: nil,
-			},
-		},
-		Total: 10,
-	}
-
-	return response, nil
-}
-
 // GetSystemLogs gets system logs
 func (uc *adminUseCase) GetSystemLogs(ctx context.Context, req SystemLogsRequest) (*SystemLogsResponse, error) {
 	// Mock implementation for system logs
@@ -1609,7 +1540,7 @@ func (uc *adminUseCase) GetSystemLogs(ctx context.Context, req SystemLogsRequest
 			Timestamp time.Time `json:"timestamp"`
 		}{
 			{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				Level:     "info",
 				Service:   "api",
 				Message:   "User login successful",
@@ -1617,7 +1548,7 @@ func (uc *adminUseCase) GetSystemLogs(ctx context.Context, req SystemLogsRequest
 				Timestamp: time.Now().Add(-30 * time.Minute),
 			},
 			{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				Level:     "error",
 				Service:   "database",
 				Message:   "Connection timeout",
@@ -1890,7 +1821,7 @@ func (uc *adminUseCase) GetUserActivity(ctx context.Context, userID uuid.UUID, r
 		CreatedAt   time.Time `json:"created_at"`
 	}{
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Type:        "login",
 			Description: "User logged in",
 			IPAddress:   "192.168.1.1",
@@ -1899,7 +1830,7 @@ func (uc *adminUseCase) GetUserActivity(ctx context.Context, userID uuid.UUID, r
 			CreatedAt:   time.Now().AddDate(0, 0, -1),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Type:        "order",
 			Description: "Order placed: #ORD-001",
 			IPAddress:   "192.168.1.1",
@@ -1948,7 +1879,7 @@ func (uc *adminUseCase) GetProducts(ctx context.Context, req AdminProductsReques
 		UpdatedAt     time.Time              `json:"updated_at"`
 	}{
 		{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			Name:          "iPhone 15",
 			SKU:           "IPHONE15-001",
 			Price:         999.99,
@@ -1964,7 +1895,7 @@ func (uc *adminUseCase) GetProducts(ctx context.Context, req AdminProductsReques
 			UpdatedAt:     time.Now(),
 		},
 		{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			Name:          "MacBook Pro",
 			SKU:           "MBP-001",
 			Price:         1999.99,
@@ -2742,8 +2673,7 @@ func (uc *adminUseCase) BulkUpdateUsers(ctx context.Context, req BulkUserUpdateR
 				"is_active":  user.IsActive,
 			}
 
-			// TODO: Get admin ID from context
-			adminID := uuid.New() // Placeholder
+			adminID := entities.ActorFromContext(ctx).ID()
 			uc.CreateUserAuditLog(ctx, CreateUserAuditLogRequest{
 				UserID:      userID,
 				AdminID:     adminID,
@@ -2816,7 +2746,7 @@ func (uc *adminUseCase) BulkDeleteUsers(ctx context.Context, req BulkUserDeleteR
 			successCount++
 
 			// Create audit log
-			adminID := uuid.New() // Placeholder
+			adminID := entities.ActorFromContext(ctx).ID()
 			uc.CreateUserAuditLog(ctx, CreateUserAuditLogRequest{
 				UserID:      userID,
 				AdminID:     adminID,
@@ -2890,7 +2820,7 @@ func (uc *adminUseCase) BulkActivateUsers(ctx context.Context, req BulkUserActiv
 			successCount++
 
 			// Create audit log
-			adminID := uuid.New() // Placeholder
+			adminID := entities.ActorFromContext(ctx).ID()
 			uc.CreateUserAuditLog(ctx, CreateUserAuditLogRequest{
 				UserID:      userID,
 				AdminID:     adminID,
@@ -2960,7 +2890,7 @@ func (uc *adminUseCase) BulkDeactivateUsers(ctx context.Context, req BulkUserDea
 			successCount++
 
 			// Create audit log
-			adminID := uuid.New() // Placeholder
+			adminID := entities.ActorFromContext(ctx).ID()
 			uc.CreateUserAuditLog(ctx, CreateUserAuditLogRequest{
 				UserID:      userID,
 				AdminID:     adminID,
@@ -3030,7 +2960,7 @@ func (uc *adminUseCase) BulkUpdateUserRoles(ctx context.Context, req BulkUserRol
 			successCount++
 
 			// Create audit log
-			adminID := uuid.New() // Placeholder
+			adminID := entities.ActorFromContext(ctx).ID()
 			uc.CreateUserAuditLog(ctx, CreateUserAuditLogRequest{
 				UserID:      userID,
 				AdminID:     adminID,
@@ -3064,6 +2994,13 @@ func (uc *adminUseCase) BulkUpdateUserRoles(ctx context.Context, req BulkUserRol
 
 // SendUserNotification sends a notification to a specific user
 func (uc *adminUseCase) SendUserNotification(ctx context.Context, req UserNotificationRequest) (*UserNotificationResponse, error) {
+	if err := validateMessagePlaceholders(req.Title, req.Message); err != nil {
+		return nil, err
+	}
+	if err := uc.renderUserMessage(ctx, req.UserID, req.Data, req.Title, req.Message); err != nil {
+		return nil, err
+	}
+
 	// TODO: Implement notification service integration
 	notificationID := uuid.New()
 
@@ -3079,6 +3016,11 @@ func (uc *adminUseCase) SendUserNotification(ctx context.Context, req UserNotifi
 
 // SendBulkNotification sends notifications to multiple users
 func (uc *adminUseCase) SendBulkNotification(ctx context.Context, req BulkNotificationRequest) (*BulkNotificationResponse, error) {
+	// Placeholders that can never be filled in would go out as written to every user
+	if err := validateMessagePlaceholders(req.Title, req.Message); err != nil {
+		return nil, err
+	}
+
 	startTime := time.Now()
 	results := []BulkNotificationResult{}
 	successCount := 0
@@ -3134,6 +3076,13 @@ func (uc *adminUseCase) SendBulkNotification(ctx context.Context, req BulkNotifi
 
 // SendUserEmail sends an email to a specific user
 func (uc *adminUseCase) SendUserEmail(ctx context.Context, req UserEmailRequest) (*UserEmailResponse, error) {
+	if err := validateMessagePlaceholders(req.Subject, req.Body); err != nil {
+		return nil, err
+	}
+	if err := uc.renderUserMessage(ctx, req.UserID, req.Data, req.Subject, req.Body); err != nil {
+		return nil, err
+	}
+
 	// TODO: Implement email service integration
 	emailID := uuid.New()
 
@@ -3149,6 +3098,11 @@ func (uc *adminUseCase) SendUserEmail(ctx context.Context, req UserEmailRequest)
 
 // SendBulkEmail sends emails to multiple users
 func (uc *adminUseCase) SendBulkEmail(ctx context.Context, req BulkEmailRequest) (*BulkEmailResponse, error) {
+	// Placeholders that can never be filled in would go out as written to every user
+	if err := validateMessagePlaceholders(req.Subject, req.Body); err != nil {
+		return nil, err
+	}
+
 	startTime := time.Now()
 	results := []BulkEmailResult{}
 	successCount := 0
@@ -3202,6 +3156,17 @@ func (uc *adminUseCase) SendBulkEmail(ctx context.Context, req BulkEmailRequest)
 	}, nil
 }
 
+// renderUserMessage fills in a message's variables for a user, failing when any has no value
+func (uc *adminUseCase) renderUserMessage(ctx context.Context, userID uuid.UUID, data map[string]interface{}, texts ...string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// Order variables are left to data, since a message to many users is not about one order
+	_, err = renderMessage(messageTemplateData(user, nil, data), texts...)
+	return err
+}
+
 // CreateAnnouncement creates a new announcement
 func (uc *adminUseCase) CreateAnnouncement(ctx context.Context, req AnnouncementRequest) (*AnnouncementResponse, error) {
 	// TODO: Implement announcement storage
@@ -3218,7 +3183,7 @@ func (uc *adminUseCase) CreateAnnouncement(ctx context.Context, req Announcement
 		StartDate:   req.StartDate,
 		EndDate:     req.EndDate,
 		IsActive:    req.IsActive,
-		CreatedBy:   uuid.New(), // TODO: Get from context
+		CreatedBy:   entities.ActorFromContext(ctx).ID(),
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}, nil
@@ -3226,11 +3191,28 @@ func (uc *adminUseCase) CreateAnnouncement(ctx context.Context, req Announcement
 
 // CreateUserAuditLog creates an audit log entry
 func (uc *adminUseCase) CreateUserAuditLog(ctx context.Context, req CreateUserAuditLogRequest) error {
-	// TODO: Implement audit log storage
-	// For now, we'll just log to console
-	fmt.Printf("Audit Log: User %s, Action %s by Admin %s: %s\n",
-		req.UserID, req.Action, req.AdminID, req.Description)
-	return nil
+	resourceID := req.UserID.String()
+	log := &entities.AuditLog{
+		Action:     req.Action,
+		Resource:   "user",
+		ResourceID: &resourceID,
+		Level:      entities.LogLevelInfo,
+		Category:   entities.LogCategoryAdmin,
+		Message:    req.Description,
+		Details: map[string]interface{}{
+			"old_values": req.OldValues,
+			"new_values": req.NewValues,
+		},
+		IPAddress: req.IPAddress,
+		UserAgent: req.UserAgent,
+		Success:   true,
+	}
+	if req.AdminID != uuid.Nil {
+		log.UserID = &req.AdminID
+	}
+
+	// Request details left empty are filled in from the actor in ctx
+	return uc.auditRepo.Create(ctx, log)
 }
 
 // GetUserAuditLogs retrieves audit logs for users
@@ -4237,7 +4219,7 @@ func (uc *adminUseCase) GetSuspiciousLoginActivity(ctx context.Context, req Susp
 			riskScore := float64(len(failedLogins)) * 10 // Simple risk calculation
 			if riskScore >= req.MinRiskScore {
 				suspiciousActivities = append(suspiciousActivities, SuspiciousActivity{
-					ID:           uuid.New(),
+					ID:           ids.New(),
 					UserID:       user.ID,
 					UserEmail:    user.Email,
 					ActivityType: "multiple_failed_logins",
@@ -4260,7 +4242,7 @@ func (uc *adminUseCase) GetSuspiciousLoginActivity(ctx context.Context, req Susp
 			riskScore := float64(len(ipMap)) * 15
 			if riskScore >= req.MinRiskScore {
 				suspiciousActivities = append(suspiciousActivities, SuspiciousActivity{
-					ID:           uuid.New(),
+					ID:           ids.New(),
 					UserID:       user.ID,
 					UserEmail:    user.Email,
 					ActivityType: "unusual_ip_pattern",
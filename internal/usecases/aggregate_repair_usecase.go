@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// AggregateRepairUseCase defines the interface for finding and repairing denormalized aggregates
+// that drifted from their source tables
+type AggregateRepairUseCase interface {
+	// RepairAggregates checks the aggregates in batches, repairs the records that are off
+	// unless it is a dry run, and returns the run's report
+	RepairAggregates(ctx context.Context, req RepairAggregatesRequest) (*entities.AggregateRepairRun, error)
+	ListRepairRuns(ctx context.Context, limit, offset int) (*AggregateRepairRunsResponse, error)
+	GetRepairRun(ctx context.Context, id uuid.UUID) (*entities.AggregateRepairRun, error)
+}
+
+type aggregateRepairUseCase struct {
+	repairRepo         repositories.AggregateRepairRepository
+	userMetricsService services.UserMetricsService
+	batchSize          int
+	running            sync.Mutex
+}
+
+// NewAggregateRepairUseCase creates a new aggregate repair use case
+func NewAggregateRepairUseCase(
+	repairRepo repositories.AggregateRepairRepository,
+	userMetricsService services.UserMetricsService,
+	batchSize int,
+) AggregateRepairUseCase {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &aggregateRepairUseCase{
+		repairRepo:         repairRepo,
+		userMetricsService: userMetricsService,
+		batchSize:          batchSize,
+	}
+}
+
+// RepairAggregatesRequest represents a request to check denormalized aggregates
+type RepairAggregatesRequest struct {
+	Kinds   []entities.AggregateKind        `json:"kinds"`   // Every aggregate when empty
+	DryRun  bool                            `json:"dry_run"` // Only report discrepancies
+	Trigger entities.AggregateRepairTrigger `json:"-"`
+}
+
+// AggregateRepairRunsResponse represents a page of repair runs
+type AggregateRepairRunsResponse struct {
+	Runs       []*entities.AggregateRepairRun `json:"runs"`
+	Pagination *PaginationInfo                `json:"pagination"`
+}
+
+// RepairAggregates runs a check over the requested aggregates. The run is saved before it
+// starts and after each aggregate, so its progress can be followed from the run list.
+func (uc *aggregateRepairUseCase) RepairAggregates(ctx context.Context, req RepairAggregatesRequest) (*entities.AggregateRepairRun, error) {
+	kinds := req.Kinds
+	if len(kinds) == 0 {
+		kinds = entities.AggregateKinds
+	}
+	for _, kind := range kinds {
+		if !kind.IsValid() {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("unknown aggregate %q", kind))
+		}
+	}
+	trigger := req.Trigger
+	if trigger == "" {
+		trigger = entities.AggregateRepairTriggerManual
+	}
+
+	if !uc.running.TryLock() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "An aggregate repair is already running")
+	}
+	defer uc.running.Unlock()
+
+	run := entities.NewAggregateRepairRun(trigger, kinds, req.DryRun)
+	if err := uc.repairRepo.CreateRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create repair run: %w", err)
+	}
+
+	var failures []error
+	for _, kind := range kinds {
+		if err := uc.repairKind(ctx, run, kind); err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", kind, err))
+		}
+		if err := uc.repairRepo.UpdateRun(ctx, run); err != nil {
+			failures = append(failures, fmt.Errorf("failed to save repair run: %w", err))
+		}
+	}
+
+	err := errors.Join(failures...)
+	run.Finish(err)
+	if saveErr := uc.repairRepo.UpdateRun(ctx, run); saveErr != nil && err == nil {
+		err = fmt.Errorf("failed to save repair run: %w", saveErr)
+	}
+	return run, err
+}
+
+// repairKind checks every record of an aggregate in ID order, one batch at a time
+func (uc *aggregateRepairUseCase) repairKind(ctx context.Context, run *entities.AggregateRepairRun, kind entities.AggregateKind) error {
+	summary := run.Summary(kind)
+	afterID := uuid.Nil
+	for {
+		comparisons, err := uc.repairRepo.CompareAggregates(ctx, kind, afterID, uc.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to compare aggregates: %w", err)
+		}
+		if len(comparisons) == 0 {
+			return nil
+		}
+		summary.RecordsChecked += len(comparisons)
+		afterID = comparisons[len(comparisons)-1].RecordID
+
+		var offIDs []uuid.UUID
+		for _, comparison := range comparisons {
+			discrepancies := comparison.Discrepancies(kind)
+			if len(discrepancies) == 0 {
+				continue
+			}
+			offIDs = append(offIDs, comparison.RecordID)
+			summary.Discrepancies += len(discrepancies)
+			run.RecordDiscrepancies(discrepancies)
+		}
+		summary.RecordsOff += len(offIDs)
+
+		if len(offIDs) > 0 && !run.DryRun {
+			if err := uc.repairRepo.RepairAggregates(ctx, kind, offIDs); err != nil {
+				return fmt.Errorf("failed to repair aggregates: %w", err)
+			}
+			summary.RecordsRepaired += len(offIDs)
+			if kind == entities.AggregateCustomerMetrics {
+				uc.updateMembershipTiers(ctx, offIDs)
+			}
+		}
+
+		if len(comparisons) < uc.batchSize {
+			return nil
+		}
+	}
+}
+
+// updateMembershipTiers moves customers whose spend was repaired to the tier it earns
+func (uc *aggregateRepairUseCase) updateMembershipTiers(ctx context.Context, userIDs []uuid.UUID) {
+	if uc.userMetricsService == nil {
+		return
+	}
+	for _, userID := range userIDs {
+		if err := uc.userMetricsService.UpdateMembershipTier(ctx, userID); err != nil {
+			fmt.Printf("⚠️ Failed to update membership tier of user %s: %v\n", userID, err)
+		}
+	}
+}
+
+// ListRepairRuns lists repair runs, newest first
+func (uc *aggregateRepairUseCase) ListRepairRuns(ctx context.Context, limit, offset int) (*AggregateRepairRunsResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	runs, total, err := uc.repairRepo.ListRuns(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregateRepairRunsResponse{
+		Runs:       runs,
+		Pagination: NewPaginationInfoFromOffset(offset, limit, total),
+	}, nil
+}
+
+// GetRepairRun gets a repair run with the discrepancies it found
+func (uc *aggregateRepairUseCase) GetRepairRun(ctx context.Context, id uuid.UUID) (*entities.AggregateRepairRun, error) {
+	return uc.repairRepo.GetRun(ctx, id)
+}
@@ -3,10 +3,14 @@ package usecases
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +44,20 @@ type AnalyticsUseCase interface {
 	GetTopCategories(ctx context.Context, period string, limit int) ([]*TopCategoryResponse, error)
 	GetTopCategoriesPaginated(ctx context.Context, period string, page, limit int) (*TopCategoriesPaginatedResponse, error)
 	GetRecentOrders(ctx context.Context, limit int) ([]*RecentOrderResponse, error)
+
+	// Visitor sessions
+	StitchSession(ctx context.Context, sessionID string, userID uuid.UUID) error
+	GetVisitorFunnel(ctx context.Context, req VisitorFunnelRequest) (*VisitorFunnelResponse, error)
+	ApplyVisitorDataRetention(ctx context.Context) (*VisitorDataRetentionResult, error)
+}
+
+// VisitorDataRetention configures how long anonymous visitor tracking data is kept.
+// Zero disables the corresponding step.
+type VisitorDataRetention struct {
+	// AnonymizeAfterDays strips IPs and user agents and hashes session IDs of anonymous data older than this
+	AnonymizeAfterDays int
+	// RetentionDays deletes anonymous data older than this
+	RetentionDays int
 }
 
 type analyticsUseCase struct {
@@ -48,6 +66,7 @@ type analyticsUseCase struct {
 	productRepo   repositories.ProductRepository
 	userRepo      repositories.UserRepository
 	inventoryRepo repositories.InventoryRepository
+	retention     VisitorDataRetention
 }
 
 // NewAnalyticsUseCase creates a new analytics use case
@@ -57,6 +76,7 @@ func NewAnalyticsUseCase(
 	productRepo repositories.ProductRepository,
 	userRepo repositories.UserRepository,
 	inventoryRepo repositories.InventoryRepository,
+	retention VisitorDataRetention,
 ) AnalyticsUseCase {
 	return &analyticsUseCase{
 		analyticsRepo: analyticsRepo,
@@ -64,6 +84,7 @@ func NewAnalyticsUseCase(
 		productRepo:   productRepo,
 		userRepo:      userRepo,
 		inventoryRepo: inventoryRepo,
+		retention:     retention,
 	}
 }
 
@@ -95,6 +116,13 @@ type TrackPageViewRequest struct {
 	LoadTime    float64    `json:"load_time,omitempty"`
 }
 
+// VisitorFunnelRequest represents a request for a session-based conversion funnel
+type VisitorFunnelRequest struct {
+	Steps    []string  `json:"steps"`
+	DateFrom time.Time `json:"date_from"`
+	DateTo   time.Time `json:"date_to"`
+}
+
 type DashboardMetricsRequest struct {
 	DateFrom *time.Time `json:"date_from,omitempty"`
 	DateTo   *time.Time `json:"date_to,omitempty"`
@@ -380,6 +408,29 @@ type RecentOrderResponse struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+// VisitorFunnelStep represents one step of a visitor funnel
+type VisitorFunnelStep struct {
+	Step     string `json:"step"`
+	Sessions int64  `json:"sessions"`
+	// ConversionRate is the percentage of sessions from the previous step that reached this one
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+// VisitorFunnelResponse represents a session-based conversion funnel, including anonymous visitors
+type VisitorFunnelResponse struct {
+	DateFrom time.Time            `json:"date_from"`
+	DateTo   time.Time            `json:"date_to"`
+	Steps    []*VisitorFunnelStep `json:"steps"`
+	// ConversionRate is the percentage of sessions from the first step that reached the last one
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+// VisitorDataRetentionResult reports what a retention run did
+type VisitorDataRetentionResult struct {
+	AnonymizedRows int64 `json:"anonymized_rows"`
+	PurgedRows     int64 `json:"purged_rows"`
+}
+
 type InventoryReportResponse struct {
 	TotalProducts     int64   `json:"total_products"`
 	InStockProducts   int64   `json:"in_stock_products"`
@@ -402,7 +453,7 @@ func (uc *analyticsUseCase) TrackEvent(ctx context.Context, req TrackEventReques
 	}
 
 	event := &entities.AnalyticsEvent{
-		ID:         uuid.New(),
+		ID:         ids.New(),
 		UserID:     req.UserID,
 		SessionID:  req.SessionID,
 		EventType:  req.EventType,
@@ -477,9 +528,31 @@ func (uc *analyticsUseCase) TrackProductView(ctx context.Context, productID uuid
 
 // TrackAddToCart tracks add to cart event
 func (uc *analyticsUseCase) TrackAddToCart(ctx context.Context, productID uuid.UUID, userID *uuid.UUID, sessionID string, quantity int, price float64) error {
-	// Mock implementation for track add to cart
-	// In real implementation, this would store the add to cart event in database
-	return nil
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if price <= 0 {
+		price = product.GetCurrentPrice()
+	}
+
+	return uc.TrackEvent(ctx, TrackEventRequest{
+		UserID:    userID,
+		SessionID: sessionID,
+		EventType: entities.EventTypeAddToCart,
+		EventName: "add_to_cart",
+		Category:  "ecommerce",
+		Action:    "add",
+		Label:     product.Name,
+		Value:     price * float64(quantity),
+		Properties: map[string]interface{}{
+			"product_id":   productID.String(),
+			"product_name": product.Name,
+			"product_sku":  product.SKU,
+			"quantity":     quantity,
+			"price":        price,
+		},
+	})
 }
 
 // TrackPurchase tracks a purchase event
@@ -882,3 +955,95 @@ func (uc *analyticsUseCase) GenerateUserReport(ctx context.Context, req UserRepo
 	}
 	return response, nil
 }
+
+// isTrackableSession checks if a session ID identifies a real visitor session
+func isTrackableSession(sessionID string) bool {
+	return sessionID != "" && sessionID != "anonymous" && !strings.HasPrefix(sessionID, "anon_")
+}
+
+// StitchSession attributes the anonymous tracking data of a session to the user who signed in on it
+func (uc *analyticsUseCase) StitchSession(ctx context.Context, sessionID string, userID uuid.UUID) error {
+	if !isTrackableSession(sessionID) || userID == uuid.Nil {
+		return nil
+	}
+	if _, err := uc.analyticsRepo.StitchSession(ctx, sessionID, userID); err != nil {
+		return fmt.Errorf("failed to stitch session: %w", err)
+	}
+	return nil
+}
+
+// GetVisitorFunnel gets a conversion funnel counted by visitor session, so anonymous visitors are included
+func (uc *analyticsUseCase) GetVisitorFunnel(ctx context.Context, req VisitorFunnelRequest) (*VisitorFunnelResponse, error) {
+	if len(req.Steps) == 0 {
+		req.Steps = []string{
+			string(entities.EventTypeProductView),
+			string(entities.EventTypeAddToCart),
+			string(entities.EventTypeCheckout),
+			string(entities.EventTypePurchase),
+		}
+	}
+	if req.DateTo.IsZero() {
+		req.DateTo = time.Now()
+	}
+	if req.DateFrom.IsZero() {
+		req.DateFrom = req.DateTo.AddDate(0, 0, -30)
+	}
+	if req.DateFrom.After(req.DateTo) {
+		return nil, pkgErrors.InvalidInput("date_from must be before date_to")
+	}
+
+	funnel, err := uc.analyticsRepo.GetFunnelAnalysis(ctx, req.Steps, req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get funnel analysis: %w", err)
+	}
+
+	response := &VisitorFunnelResponse{
+		DateFrom:       req.DateFrom,
+		DateTo:         req.DateTo,
+		Steps:          make([]*VisitorFunnelStep, len(req.Steps)),
+		ConversionRate: funnel.ConversionRate,
+	}
+	for i, step := range req.Steps {
+		var sessions int64
+		if i < len(funnel.StepSessions) {
+			sessions = funnel.StepSessions[i]
+		}
+		rate := 100.0
+		if i > 0 {
+			rate = 0
+			if previous := response.Steps[i-1].Sessions; previous > 0 {
+				rate = float64(sessions) / float64(previous) * 100
+			}
+		}
+		response.Steps[i] = &VisitorFunnelStep{
+			Step:           step,
+			Sessions:       sessions,
+			ConversionRate: rate,
+		}
+	}
+	return response, nil
+}
+
+// ApplyVisitorDataRetention anonymizes and then purges old anonymous visitor data according to the retention policy
+func (uc *analyticsUseCase) ApplyVisitorDataRetention(ctx context.Context) (*VisitorDataRetentionResult, error) {
+	result := &VisitorDataRetentionResult{}
+	now := time.Now()
+
+	if uc.retention.AnonymizeAfterDays > 0 {
+		anonymized, err := uc.analyticsRepo.AnonymizeVisitorData(ctx, now.AddDate(0, 0, -uc.retention.AnonymizeAfterDays))
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize visitor data: %w", err)
+		}
+		result.AnonymizedRows = anonymized
+	}
+
+	if uc.retention.RetentionDays > 0 {
+		purged, err := uc.analyticsRepo.PurgeVisitorData(ctx, now.AddDate(0, 0, -uc.retention.RetentionDays))
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge visitor data: %w", err)
+		}
+		result.PurgedRows = purged
+	}
+
+	return result, nil
+}
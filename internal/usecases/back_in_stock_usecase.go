@@ -0,0 +1,224 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// BackInStockUseCase defines use cases for telling customers when products they want are back
+// in stock
+type BackInStockUseCase interface {
+	// Subscribe asks to be notified when an out-of-stock product is back in stock
+	Subscribe(ctx context.Context, userID, productID uuid.UUID) (*BackInStockSubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, userID, productID uuid.UUID) error
+	// NotifySubscribers notifies the subscribers of restocked products in batches sized to the
+	// stock, oldest subscription first, and returns how many were notified
+	NotifySubscribers(ctx context.Context) (int, error)
+}
+
+// BackInStockNotificationService interface for back in stock notifications
+type BackInStockNotificationService interface {
+	NotifyBackInStock(ctx context.Context, userID uuid.UUID, product *entities.Product) error
+}
+
+// BackInStockSettings configures how back in stock notifications are batched
+type BackInStockSettings struct {
+	// SubscribersPerUnit is how many subscribers are notified per unit in stock in one run, so a
+	// few restocked units don't send everyone after the same last items
+	SubscribersPerUnit int
+	// HoldDays holds notifications while a product has no more than its low stock threshold in
+	// stock and a purchase order is expected within this many days, so a returned unit or two
+	// doesn't announce a restock that is still on its way
+	HoldDays int
+}
+
+type backInStockUseCase struct {
+	subscriptionRepo    repositories.BackInStockRepository
+	productRepo         repositories.ProductRepository
+	restockEstimator    RestockEstimator
+	notificationService BackInStockNotificationService
+	settings            BackInStockSettings
+	clock               Clock
+}
+
+// NewBackInStockUseCase creates a new back in stock use case
+func NewBackInStockUseCase(
+	subscriptionRepo repositories.BackInStockRepository,
+	productRepo repositories.ProductRepository,
+	restockEstimator RestockEstimator,
+	notificationService BackInStockNotificationService,
+	settings BackInStockSettings,
+	clock Clock,
+) BackInStockUseCase {
+	if settings.SubscribersPerUnit <= 0 {
+		settings.SubscribersPerUnit = 1
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &backInStockUseCase{
+		subscriptionRepo:    subscriptionRepo,
+		productRepo:         productRepo,
+		restockEstimator:    restockEstimator,
+		notificationService: notificationService,
+		settings:            settings,
+		clock:               clock,
+	}
+}
+
+// BackInStockSubscriptionResponse represents a customer's back in stock subscription
+type BackInStockSubscriptionResponse struct {
+	ProductID      uuid.UUID  `json:"product_id"`
+	SubscribedAt   time.Time  `json:"subscribed_at"`
+	RestockETA     *time.Time `json:"restock_eta,omitempty"`
+	RestockMessage string     `json:"restock_message,omitempty"` // e.g. "Back in stock around Oct 24"
+}
+
+// Subscribe subscribes a customer to an out-of-stock product
+func (uc *backInStockUseCase) Subscribe(ctx context.Context, userID, productID uuid.UUID) (*BackInStockSubscriptionResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsDelisted() {
+		return nil, pkgErrors.InvalidInput("Product is no longer available")
+	}
+	if product.IsAvailable() {
+		return nil, pkgErrors.InvalidInput("Product is in stock")
+	}
+
+	if err := uc.subscriptionRepo.Subscribe(ctx, &entities.BackInStockSubscription{
+		ID:        ids.New(),
+		ProductID: productID,
+		UserID:    userID,
+		CreatedAt: uc.clock.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to product: %w", err)
+	}
+	subscription, err := uc.subscriptionRepo.GetPending(ctx, productID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if subscription == nil {
+		return nil, fmt.Errorf("subscription to product %s was not saved", productID)
+	}
+
+	response := &BackInStockSubscriptionResponse{
+		ProductID:    productID,
+		SubscribedAt: subscription.CreatedAt,
+	}
+	if estimate := uc.estimate(ctx, productID); estimate != nil {
+		response.RestockETA = &estimate.ExpectedAt
+		response.RestockMessage = estimate.Message()
+	}
+	return response, nil
+}
+
+// Unsubscribe removes a customer's subscription
+func (uc *backInStockUseCase) Unsubscribe(ctx context.Context, userID, productID uuid.UUID) error {
+	return uc.subscriptionRepo.Unsubscribe(ctx, productID, userID)
+}
+
+// NotifySubscribers notifies the next batch of subscribers of each restocked product
+func (uc *backInStockUseCase) NotifySubscribers(ctx context.Context) (int, error) {
+	productIDs, err := uc.subscriptionRepo.GetPendingProductIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get products with subscribers: %w", err)
+	}
+	if len(productIDs) == 0 {
+		return 0, nil
+	}
+
+	products, err := uc.productRepo.GetPriceAndStockByIDs(ctx, productIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get products: %w", err)
+	}
+	var restocked []*entities.Product
+	var restockedIDs []uuid.UUID
+	for _, product := range products {
+		if product.IsAvailable() {
+			restocked = append(restocked, product)
+			restockedIDs = append(restockedIDs, product.ID)
+		}
+	}
+	if len(restocked) == 0 {
+		return 0, nil
+	}
+
+	estimates := map[uuid.UUID]*entities.RestockEstimate{}
+	if uc.restockEstimator != nil {
+		if estimates, err = uc.restockEstimator.EstimateRestock(ctx, restockedIDs); err != nil {
+			return 0, err
+		}
+	}
+
+	holdUntil := entities.SnapshotDay(uc.clock.Now()).AddDate(0, 0, uc.settings.HoldDays)
+	notified := 0
+	for _, product := range restocked {
+		// A trickle of stock waits for the purchase order that is about to arrive
+		if estimate := estimates[product.ID]; estimate != nil && product.Stock <= product.LowStockThreshold &&
+			!estimate.ExpectedAt.After(holdUntil) {
+			continue
+		}
+
+		count, err := uc.notifyBatch(ctx, product)
+		notified += count
+		if err != nil {
+			return notified, err
+		}
+	}
+	return notified, nil
+}
+
+// notifyBatch notifies as many of a product's subscribers as its stock can serve
+func (uc *backInStockUseCase) notifyBatch(ctx context.Context, product *entities.Product) (int, error) {
+	subscriptions, err := uc.subscriptionRepo.GetPendingByProduct(ctx, product.ID, product.Stock*uc.settings.SubscribersPerUnit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get subscribers of product %s: %w", product.ID, err)
+	}
+	if len(subscriptions) == 0 {
+		return 0, nil
+	}
+	// Notifications name and link the product, which the stock lookup doesn't load
+	product, err = uc.productRepo.GetByID(ctx, product.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	var notifiedIDs []uuid.UUID
+	for _, subscription := range subscriptions {
+		if uc.notificationService != nil {
+			if err := uc.notificationService.NotifyBackInStock(ctx, subscription.UserID, product); err != nil {
+				fmt.Printf("❌ Failed to send back in stock notification for product %s: %v\n", product.ID, err)
+				continue
+			}
+		}
+		notifiedIDs = append(notifiedIDs, subscription.ID)
+	}
+
+	if err := uc.subscriptionRepo.MarkNotified(ctx, notifiedIDs); err != nil {
+		return 0, fmt.Errorf("failed to mark subscribers notified: %w", err)
+	}
+	return len(notifiedIDs), nil
+}
+
+// estimate returns a product's restock estimate, or nil when none is known
+func (uc *backInStockUseCase) estimate(ctx context.Context, productID uuid.UUID) *entities.RestockEstimate {
+	if uc.restockEstimator == nil {
+		return nil
+	}
+	estimates, err := uc.restockEstimator.EstimateRestock(ctx, []uuid.UUID{productID})
+	if err != nil {
+		fmt.Printf("❌ Failed to estimate restock of product %s: %v\n", productID, err)
+		return nil
+	}
+	return estimates[productID]
+}
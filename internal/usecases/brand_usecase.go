@@ -8,6 +8,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"ecom-golang-clean-architecture/pkg/utils"
 
 	"github.com/google/uuid"
@@ -131,7 +132,7 @@ func (uc *brandUseCase) CreateBrand(ctx context.Context, req CreateBrandRequest)
 
 	// Create brand
 	brand := &entities.Brand{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		Name:        strings.TrimSpace(req.Name),
 		Slug:        slug,
 		Description: strings.TrimSpace(req.Description),
@@ -9,6 +9,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
 	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -67,6 +68,7 @@ type cartUseCase struct {
 	cartRepo                repositories.CartRepository
 	productRepo             repositories.ProductRepository
 	simpleStockService      services.SimpleStockService
+	launchPolicy            ProductLaunchPolicy
 }
 
 // NewCartUseCase creates a new cart use case
@@ -74,11 +76,13 @@ func NewCartUseCase(
 	cartRepo repositories.CartRepository,
 	productRepo repositories.ProductRepository,
 	simpleStockService services.SimpleStockService,
+	launchPolicy ProductLaunchPolicy,
 ) CartUseCase {
 	return &cartUseCase{
 		cartRepo:                cartRepo,
 		productRepo:             productRepo,
 		simpleStockService:      simpleStockService,
+		launchPolicy:            launchPolicy,
 	}
 }
 
@@ -96,22 +100,23 @@ type UpdateCartItemRequest struct {
 
 // CartResponse represents cart response
 type CartResponse struct {
-	ID             uuid.UUID          `json:"id"`
-	UserID         *uuid.UUID         `json:"user_id,omitempty"` // Nullable for guest carts
-	SessionID      *string            `json:"session_id,omitempty"`
-	Items          []CartItemResponse `json:"items"`
-	ItemCount      int                `json:"item_count"`
-	Subtotal       float64            `json:"subtotal"`
-	TaxAmount      float64            `json:"tax_amount"`      // Added missing field
-	ShippingAmount float64            `json:"shipping_amount"` // Added missing field
-	Total          float64            `json:"total"`
-	Status         string             `json:"status"`
-	Currency       string             `json:"currency"`
-	Notes          string             `json:"notes,omitempty"`
-	ExpiresAt      *time.Time         `json:"expires_at,omitempty"`
-	IsGuest        bool               `json:"is_guest"` // Added helper field
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+	ID             uuid.UUID             `json:"id"`
+	UserID         *uuid.UUID            `json:"user_id,omitempty"` // Nullable for guest carts
+	SessionID      *string               `json:"session_id,omitempty"`
+	Items          []CartItemResponse    `json:"items"`
+	ItemCount      int                   `json:"item_count"`
+	Subtotal       float64               `json:"subtotal"`
+	TaxAmount      float64               `json:"tax_amount"`      // Added missing field
+	ShippingAmount float64               `json:"shipping_amount"` // Added missing field
+	Total          float64               `json:"total"`
+	Status         string                `json:"status"`
+	Currency       string                `json:"currency"`
+	DisplayTotals  *StorefrontCartTotals `json:"display_totals,omitempty"` // Totals re-priced in the shopper's storefront
+	Notes          string                `json:"notes,omitempty"`
+	ExpiresAt      *time.Time            `json:"expires_at,omitempty"`
+	IsGuest        bool                  `json:"is_guest"` // Added helper field
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
 }
 
 // CartItemResponse represents cart item response
@@ -131,7 +136,7 @@ func (uc *cartUseCase) GetCart(ctx context.Context, userID uuid.UUID) (*CartResp
 	if err != nil {
 		// Create new cart if not exists
 		cart = &entities.Cart{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			UserID:    &userID,
 			Items:     []entities.CartItem{},
 			Status:    "active",
@@ -149,7 +154,7 @@ func (uc *cartUseCase) GetCart(ctx context.Context, userID uuid.UUID) (*CartResp
 		}
 	}
 
-	return uc.toCartResponse(cart), nil
+	return uc.toCartResponse(ctx, cart), nil
 }
 
 // GetGuestCart gets guest cart by session ID
@@ -158,7 +163,7 @@ func (uc *cartUseCase) GetGuestCart(ctx context.Context, sessionID string) (*Car
 	if err != nil {
 		// Create new guest cart if not exists
 		cart = &entities.Cart{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			SessionID: &sessionID,
 			Items:     []entities.CartItem{},
 			Status:    "active",
@@ -176,7 +181,7 @@ func (uc *cartUseCase) GetGuestCart(ctx context.Context, sessionID string) (*Car
 		}
 	}
 
-	return uc.toCartResponse(cart), nil
+	return uc.toCartResponse(ctx, cart), nil
 }
 
 // AddToGuestCart adds item to guest cart
@@ -212,6 +217,13 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 		return nil, pkgErrors.InvalidInput("Product is not available for purchase")
 	}
 
+	// Products on a waitlist can only be bought once they launch or the customer's early access opens
+	if uc.launchPolicy != nil {
+		if err := uc.launchPolicy.CheckPurchaseAccess(ctx, &userID, product.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Use current product price (will be used when adding/updating cart items)
 	_ = product.Price // Suppress unused variable warning
 
@@ -225,7 +237,7 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 
 		// Create new cart only if not found
 		cart = &entities.Cart{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			UserID:    &userID,
 			Items:     []entities.CartItem{},
 			Status:    "active",
@@ -253,7 +265,7 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 
 			// Create new cart
 			cart = &entities.Cart{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				UserID:    &userID,
 				Items:     []entities.CartItem{},
 				Status:    "active",
@@ -281,7 +293,7 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 
 		// Create new cart
 		cart = &entities.Cart{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			UserID:    &userID,
 			Items:     []entities.CartItem{},
 			Status:    "active",
@@ -344,7 +356,7 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 
 		// Update existing item with current price and new quantity
 		existingItem.Quantity = totalQuantity
-		existingItem.Price = product.Price // Update to current price
+		existingItem.Price = product.GetCurrentPrice() // Update to current price
 		existingItem.CalculateTotal()      // Recalculate total
 		existingItem.UpdatedAt = time.Now()
 
@@ -354,11 +366,11 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 	} else {
 		// Add new item
 		cartItem := &entities.CartItem{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			CartID:    cart.ID,
 			ProductID: req.ProductID,
 			Quantity:  req.Quantity,
-			Price:     product.Price,
+			Price:     product.GetCurrentPrice(),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
@@ -376,7 +388,7 @@ func (uc *cartUseCase) addToCartInTransaction(ctx context.Context, userID uuid.U
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Failed to get updated cart")
 	}
 
-	return uc.toCartResponse(updatedCart), nil
+	return uc.toCartResponse(ctx, updatedCart), nil
 }
 
 // addToGuestCartInTransaction handles adding item to guest cart
@@ -392,7 +404,7 @@ func (uc *cartUseCase) addToGuestCartInTransaction(ctx context.Context, sessionI
 
 		// Create new cart only if not found
 		cart = &entities.Cart{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			SessionID: &sessionID,
 			Items:     []entities.CartItem{},
 			Status:    "active",
@@ -421,12 +433,21 @@ func (uc *cartUseCase) addToGuestCartInTransaction(ctx context.Context, sessionI
 		return nil, pkgErrors.InvalidInput("Product is not available for purchase")
 	}
 
+	// Guests can't buy products on a waitlist before they launch
+	if uc.launchPolicy != nil {
+		if err := uc.launchPolicy.CheckPurchaseAccess(ctx, nil, product.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check stock availability
 	if product.Stock < req.Quantity {
-		return nil, pkgErrors.InsufficientStock().
-			WithContext("product_id", req.ProductID).
-			WithContext("available_stock", product.Stock).
-			WithContext("requested_quantity", req.Quantity)
+		stockErr := pkgErrors.InsufficientStock().WithContext("product_id", req.ProductID)
+		// Only tell the shopper how many are left when the product's stock visibility shows it
+		if stock := product.DiscloseStock(); stock.Quantity != nil {
+			stockErr = stockErr.WithContext("available_stock", *stock.Quantity)
+		}
+		return nil, stockErr.WithContext("requested_quantity", req.Quantity)
 	}
 
 	// Check stock availability using simple stock service
@@ -474,7 +495,7 @@ func (uc *cartUseCase) addToGuestCartInTransaction(ctx context.Context, sessionI
 
 	if existingItem != nil {
 		existingItem.Quantity += req.Quantity
-		existingItem.Price = product.Price
+		existingItem.Price = product.GetCurrentPrice()
 		existingItem.CalculateTotal() // Recalculate total
 		existingItem.UpdatedAt = time.Now()
 		if err := uc.cartRepo.UpdateItem(ctx, existingItem); err != nil {
@@ -482,11 +503,11 @@ func (uc *cartUseCase) addToGuestCartInTransaction(ctx context.Context, sessionI
 		}
 	} else {
 		cartItem := &entities.CartItem{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			CartID:    cart.ID,
 			ProductID: req.ProductID,
 			Quantity:  req.Quantity,
-			Price:     product.Price,
+			Price:     product.GetCurrentPrice(),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
@@ -505,7 +526,7 @@ func (uc *cartUseCase) addToGuestCartInTransaction(ctx context.Context, sessionI
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Failed to get updated guest cart")
 	}
 
-	return uc.toCartResponse(updatedCart), nil
+	return uc.toCartResponse(ctx, updatedCart), nil
 }
 
 // UpdateCartItem updates cart item quantity
@@ -561,7 +582,7 @@ func (uc *cartUseCase) UpdateCartItem(ctx context.Context, userID uuid.UUID, req
 
 	// Update quantity and price
 	cartItem.Quantity = req.Quantity
-	cartItem.Price = product.Price // Update to current price
+	cartItem.Price = product.GetCurrentPrice() // Update to current price
 	cartItem.CalculateTotal()      // Recalculate total
 	cartItem.UpdatedAt = time.Now()
 
@@ -577,7 +598,7 @@ func (uc *cartUseCase) UpdateCartItem(ctx context.Context, userID uuid.UUID, req
 		return nil, err
 	}
 
-	return uc.toCartResponse(updatedCart), nil
+	return uc.toCartResponse(ctx, updatedCart), nil
 }
 
 // RemoveFromCart removes item from cart
@@ -599,7 +620,7 @@ func (uc *cartUseCase) RemoveFromCart(ctx context.Context, userID uuid.UUID, pro
 		return nil, err
 	}
 
-	return uc.toCartResponse(updatedCart), nil
+	return uc.toCartResponse(ctx, updatedCart), nil
 }
 
 // ClearCart clears all items from cart
@@ -614,7 +635,7 @@ func (uc *cartUseCase) ClearCart(ctx context.Context, userID uuid.UUID) error {
 }
 
 // toCartResponse converts cart entity to response
-func (uc *cartUseCase) toCartResponse(cart *entities.Cart) *CartResponse {
+func (uc *cartUseCase) toCartResponse(ctx context.Context, cart *entities.Cart) *CartResponse {
 	response := &CartResponse{
 		ID:             cart.ID,
 		UserID:         cart.UserID, // Now properly nullable
@@ -648,8 +669,11 @@ func (uc *cartUseCase) toCartResponse(cart *entities.Cart) *CartResponse {
 		// Add product info if available
 		if item.Product.ID != uuid.Nil {
 			response.Items[i].Product = uc.toProductResponse(&item.Product)
+			restrictStock(ctx, response.Items[i].Product, &item.Product)
+			applyStorefrontPrice(ctx, response.Items[i].Product)
 		}
 	}
+	response.DisplayTotals = storefrontCartTotals(ctx, cart)
 
 	return response
 }
@@ -700,12 +724,14 @@ func (uc *cartUseCase) toProductResponse(product *entities.Product) *ProductResp
 		HasDiscount:            product.HasDiscount(),
 		SaleDiscountPercentage: product.GetSaleDiscountPercentage(),
 		DiscountPercentage:     product.GetDiscountPercentage(),
-		Stock:                  product.Stock,
+		Stock:                  &product.Stock,
 		LowStockThreshold:      product.LowStockThreshold,
 		TrackQuantity:          product.TrackQuantity,
 		AllowBackorder:         product.AllowBackorder,
 		StockStatus:            product.StockStatus,
 		IsLowStock:             product.IsLowStock(),
+		StockVisibility:        product.StockVisibility,
+		StockDisplayThreshold:  product.StockDisplayThreshold,
 		Weight:                 product.Weight,
 		Dimensions:             toDimensionsResponse(product.Dimensions),
 		RequiresShipping:       product.RequiresShipping,
@@ -780,7 +806,7 @@ func (uc *cartUseCase) MergeGuestCartWithStrategy(ctx context.Context, userID uu
 				return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to convert guest cart to user cart")
 			}
 
-			return uc.toCartResponse(guestCart), nil
+			return uc.toCartResponse(ctx, guestCart), nil
 		}
 
 		// User cart exists, apply merge strategy
@@ -791,7 +817,7 @@ func (uc *cartUseCase) MergeGuestCartWithStrategy(ctx context.Context, userID uu
 			if err := txRepo.Update(txCtx, guestCart); err != nil {
 				return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to mark guest cart as abandoned")
 			}
-			return uc.toCartResponse(userCart), nil
+			return uc.toCartResponse(ctx, userCart), nil
 
 		case MergeStrategyReplace:
 			// Replace user cart with guest cart
@@ -803,7 +829,7 @@ func (uc *cartUseCase) MergeGuestCartWithStrategy(ctx context.Context, userID uu
 			// Move guest cart items to user cart
 			for _, guestItem := range guestCart.Items {
 				newItem := &entities.CartItem{
-					ID:        uuid.New(),
+					ID:        ids.New(),
 					CartID:    userCart.ID,
 					ProductID: guestItem.ProductID,
 					Quantity:  guestItem.Quantity,
@@ -836,7 +862,7 @@ func (uc *cartUseCase) MergeGuestCartWithStrategy(ctx context.Context, userID uu
 			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Failed to get updated user cart")
 		}
 
-		return uc.toCartResponse(updatedUserCart), nil
+		return uc.toCartResponse(ctx, updatedUserCart), nil
 	})
 
 	if err != nil {
@@ -872,7 +898,7 @@ func (uc *cartUseCase) mergeCartItems(ctx context.Context, userCart, guestCart *
 
 			// Update quantity and use current product price
 			existingItem.Quantity = newQuantity
-			existingItem.Price = product.Price // Use current price
+			existingItem.Price = product.GetCurrentPrice() // Use current price
 			existingItem.CalculateTotal()
 			existingItem.UpdatedAt = time.Now()
 
@@ -882,11 +908,11 @@ func (uc *cartUseCase) mergeCartItems(ctx context.Context, userCart, guestCart *
 		} else {
 			// Add new item to user cart
 			newItem := &entities.CartItem{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				CartID:    userCart.ID,
 				ProductID: guestItem.ProductID,
 				Quantity:  guestItem.Quantity,
-				Price:     product.Price, // Use current price
+				Price:     product.GetCurrentPrice(), // Use current price
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
 			}
@@ -911,7 +937,7 @@ func (uc *cartUseCase) mergeCartItems(ctx context.Context, userCart, guestCart *
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Failed to get updated user cart")
 	}
 
-	return uc.toCartResponse(updatedUserCart), nil
+	return uc.toCartResponse(ctx, updatedUserCart), nil
 }
 
 // getCartWithRepo gets cart using specific repository (for transaction support)
@@ -920,7 +946,7 @@ func (uc *cartUseCase) getCartWithRepo(ctx context.Context, repo repositories.Ca
 	if err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Cart not found")
 	}
-	return uc.toCartResponse(cart), nil
+	return uc.toCartResponse(ctx, cart), nil
 }
 
 // mergeCartItemsWithRepo merges guest cart items into user cart using specific repository
@@ -958,7 +984,7 @@ func (uc *cartUseCase) mergeCartItemsWithRepo(ctx context.Context, repo reposito
 
 			// Update quantity and use current product price (consistent with other merge method)
 			existingItem.Quantity = newQuantity
-			existingItem.Price = product.Price // Use current price instead of old guest price
+			existingItem.Price = product.GetCurrentPrice() // Use current price instead of old guest price
 			existingItem.CalculateTotal()
 			existingItem.UpdatedAt = time.Now()
 
@@ -968,11 +994,11 @@ func (uc *cartUseCase) mergeCartItemsWithRepo(ctx context.Context, repo reposito
 		} else {
 			// Add new item to user cart
 			newItem := &entities.CartItem{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				CartID:    userCart.ID,
 				ProductID: guestItem.ProductID,
 				Quantity:  guestItem.Quantity,
-				Price:     product.Price, // Use current price instead of old guest price
+				Price:     product.GetCurrentPrice(), // Use current price instead of old guest price
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
 			}
@@ -997,7 +1023,7 @@ func (uc *cartUseCase) mergeCartItemsWithRepo(ctx context.Context, repo reposito
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeCartNotFound, "Failed to get updated user cart")
 	}
 
-	return uc.toCartResponse(updatedUserCart), nil
+	return uc.toCartResponse(ctx, updatedUserCart), nil
 }
 
 // CheckMergeConflict checks for conflicts when merging guest cart with user cart
@@ -1018,7 +1044,7 @@ func (uc *cartUseCase) CheckMergeConflict(ctx context.Context, userID uuid.UUID,
 	}
 
 	conflict.GuestCartExists = true
-	conflict.GuestCart = uc.toCartResponse(guestCart)
+	conflict.GuestCart = uc.toCartResponse(ctx, guestCart)
 
 	// Check if user cart exists
 	userCart, err := uc.cartRepo.GetByUserID(ctx, userID)
@@ -1029,7 +1055,7 @@ func (uc *cartUseCase) CheckMergeConflict(ctx context.Context, userID uuid.UUID,
 	}
 
 	conflict.UserCartExists = true
-	conflict.UserCart = uc.toCartResponse(userCart)
+	conflict.UserCart = uc.toCartResponse(ctx, userCart)
 
 	// Check for conflicting items
 	conflictingItems := []ConflictingItem{}
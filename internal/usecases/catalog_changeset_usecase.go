@@ -0,0 +1,714 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// CatalogPreviewer shows the storefront with an unpublished catalog changeset applied.
+// It is implemented by the catalog changeset use case and consulted by the product read path.
+type CatalogPreviewer interface {
+	// PreviewProducts applies the staged edits of the changeset the preview token belongs to.
+	// Tokens of published or rolled back changesets no longer resolve.
+	PreviewProducts(ctx context.Context, token string, products []*entities.Product) error
+}
+
+// CatalogChangesetUseCase defines use cases for staged catalog changes
+type CatalogChangesetUseCase interface {
+	CatalogPreviewer
+
+	CreateChangeset(ctx context.Context, req CreateCatalogChangesetRequest) (*CatalogChangesetResponse, error)
+	GetChangeset(ctx context.Context, id uuid.UUID) (*CatalogChangesetResponse, error)
+	ListChangesets(ctx context.Context, req ListCatalogChangesetsRequest) (*CatalogChangesetsListResponse, error)
+	UpdateChangeset(ctx context.Context, id uuid.UUID, req UpdateCatalogChangesetRequest) (*CatalogChangesetResponse, error)
+	DeleteChangeset(ctx context.Context, id uuid.UUID) error
+
+	// Staged product edits
+	SaveChangesetItem(ctx context.Context, id, productID uuid.UUID, changes entities.CatalogProductChanges) (*CatalogChangesetItemResponse, error)
+	RemoveChangesetItem(ctx context.Context, id, productID uuid.UUID) error
+
+	// Publishing
+	ScheduleChangeset(ctx context.Context, id uuid.UUID, req ScheduleCatalogChangesetRequest) (*CatalogChangesetResponse, error)
+	UnscheduleChangeset(ctx context.Context, id uuid.UUID) (*CatalogChangesetResponse, error)
+	PublishChangeset(ctx context.Context, id uuid.UUID, publishedBy *uuid.UUID) (*CatalogChangesetResponse, error)
+	PublishDueChangesets(ctx context.Context) (int, error)
+	RollbackChangeset(ctx context.Context, id uuid.UUID, rolledBackBy *uuid.UUID) (*CatalogChangesetResponse, error)
+}
+
+type catalogChangesetUseCase struct {
+	changesetRepo repositories.CatalogChangesetRepository
+	productRepo   repositories.ProductRepository
+	localizer     ProductLocalizer
+}
+
+// NewCatalogChangesetUseCase creates a new catalog changeset use case
+func NewCatalogChangesetUseCase(
+	changesetRepo repositories.CatalogChangesetRepository,
+	productRepo repositories.ProductRepository,
+	localizer ProductLocalizer,
+) CatalogChangesetUseCase {
+	return &catalogChangesetUseCase{
+		changesetRepo: changesetRepo,
+		productRepo:   productRepo,
+		localizer:     localizer,
+	}
+}
+
+// CreateCatalogChangesetRequest represents a request to start a catalog changeset
+type CreateCatalogChangesetRequest struct {
+	Name        string     `json:"name" validate:"required,max=255"`
+	Description string     `json:"description"`
+	CreatedBy   *uuid.UUID `json:"-"`
+}
+
+// UpdateCatalogChangesetRequest represents a request to rename or describe a changeset
+type UpdateCatalogChangesetRequest struct {
+	Name        *string `json:"name" validate:"omitempty,max=255"`
+	Description *string `json:"description"`
+}
+
+// ScheduleCatalogChangesetRequest represents a request to publish a changeset at a set time
+type ScheduleCatalogChangesetRequest struct {
+	PublishAt time.Time `json:"publish_at" validate:"required"`
+}
+
+// ListCatalogChangesetsRequest represents a request to list catalog changesets
+type ListCatalogChangesetsRequest struct {
+	Status *entities.CatalogChangesetStatus `json:"status"`
+	Page   int                              `json:"page"`
+	Limit  int                              `json:"limit"`
+}
+
+// CatalogChangesetItemResponse represents the staged edits to one product.
+// Before and after are the product now and with the edits applied until the changeset is
+// published, and the product as it was published afterwards.
+type CatalogChangesetItemResponse struct {
+	ProductID   uuid.UUID                      `json:"product_id"`
+	ProductName string                         `json:"product_name"`
+	SKU         string                         `json:"sku"`
+	Changes     entities.CatalogProductChanges `json:"changes"`
+	Before      *entities.CatalogProductState  `json:"before,omitempty"`
+	After       *entities.CatalogProductState  `json:"after,omitempty"`
+	// Error explains why the edits cannot be applied to the product, or why rollback skipped it
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CatalogChangesetResponse represents a catalog changeset
+type CatalogChangesetResponse struct {
+	ID           uuid.UUID                       `json:"id"`
+	Name         string                          `json:"name"`
+	Description  string                          `json:"description"`
+	Status       entities.CatalogChangesetStatus `json:"status"`
+	ScheduledAt  *time.Time                      `json:"scheduled_at"`
+	PreviewToken string                          `json:"preview_token"`
+	ErrorMessage string                          `json:"error_message,omitempty"`
+	ItemCount    int64                           `json:"item_count"`
+	CreatedBy    *uuid.UUID                      `json:"created_by"`
+	PublishedBy  *uuid.UUID                      `json:"published_by"`
+	RolledBackBy *uuid.UUID                      `json:"rolled_back_by"`
+	PublishedAt  *time.Time                      `json:"published_at"`
+	RolledBackAt *time.Time                      `json:"rolled_back_at"`
+	CreatedAt    time.Time                       `json:"created_at"`
+	UpdatedAt    time.Time                       `json:"updated_at"`
+
+	Items []*CatalogChangesetItemResponse `json:"items,omitempty"`
+	// Skipped lists the products a rollback left alone because they were edited after publishing
+	Skipped []*CatalogChangesetItemResponse `json:"skipped,omitempty"`
+}
+
+// CatalogChangesetsListResponse represents a paginated list of catalog changesets
+type CatalogChangesetsListResponse struct {
+	Changesets []*CatalogChangesetResponse `json:"changesets"`
+	Pagination *PaginationInfo             `json:"pagination"`
+}
+
+// CreateChangeset starts an empty draft changeset with its own preview token
+func (uc *catalogChangesetUseCase) CreateChangeset(ctx context.Context, req CreateCatalogChangesetRequest) (*CatalogChangesetResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, pkgErrors.InvalidInput("name is required")
+	}
+
+	token, err := generatePreviewToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate preview token: %w", err)
+	}
+
+	changeset := &entities.CatalogChangeset{
+		ID:           ids.New(),
+		Name:         name,
+		Description:  req.Description,
+		Status:       entities.CatalogChangesetStatusDraft,
+		PreviewToken: token,
+		CreatedBy:    req.CreatedBy,
+	}
+	if err := uc.changesetRepo.Create(ctx, changeset); err != nil {
+		return nil, fmt.Errorf("failed to create catalog changeset: %w", err)
+	}
+
+	return toCatalogChangesetResponse(changeset, 0), nil
+}
+
+// GetChangeset gets a changeset with its staged product edits
+func (uc *catalogChangesetUseCase) GetChangeset(ctx context.Context, id uuid.UUID) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.changesetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toDetailedResponse(ctx, changeset)
+}
+
+// ListChangesets lists changesets, newest first
+func (uc *catalogChangesetUseCase) ListChangesets(ctx context.Context, req ListCatalogChangesetsRequest) (*CatalogChangesetsListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.CatalogChangesetFilters{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}
+
+	changesets, err := uc.changesetRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list catalog changesets: %w", err)
+	}
+
+	total, err := uc.changesetRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count catalog changesets: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(changesets))
+	for i, changeset := range changesets {
+		ids[i] = changeset.ID
+	}
+	itemCounts, err := uc.changesetRepo.CountItems(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count catalog changeset items: %w", err)
+	}
+
+	responses := make([]*CatalogChangesetResponse, len(changesets))
+	for i, changeset := range changesets {
+		responses[i] = toCatalogChangesetResponse(changeset, itemCounts[changeset.ID])
+	}
+
+	return &CatalogChangesetsListResponse{
+		Changesets: responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// UpdateChangeset renames or redescribes an unpublished changeset
+func (uc *catalogChangesetUseCase) UpdateChangeset(ctx context.Context, id uuid.UUID, req UpdateCatalogChangesetRequest) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.getEditableChangeset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, pkgErrors.InvalidInput("name cannot be empty")
+		}
+		changeset.Name = name
+	}
+	if req.Description != nil {
+		changeset.Description = *req.Description
+	}
+
+	if err := uc.changesetRepo.Update(ctx, changeset); err != nil {
+		return nil, fmt.Errorf("failed to update catalog changeset: %w", err)
+	}
+	return uc.toDetailedResponse(ctx, changeset)
+}
+
+// DeleteChangeset discards an unpublished changeset; published ones are kept for rollback and history
+func (uc *catalogChangesetUseCase) DeleteChangeset(ctx context.Context, id uuid.UUID) error {
+	if _, err := uc.getEditableChangeset(ctx, id); err != nil {
+		return err
+	}
+	return uc.changesetRepo.Delete(ctx, id)
+}
+
+// SaveChangesetItem stages edits to a product, replacing the product's earlier edits in the changeset.
+// The edits are checked against the product as it is now.
+func (uc *catalogChangesetUseCase) SaveChangesetItem(ctx context.Context, id, productID uuid.UUID, changes entities.CatalogProductChanges) (*CatalogChangesetItemResponse, error) {
+	changeset, err := uc.getEditableChangeset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := changes.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	response := newCatalogChangesetItemResponse(&entities.CatalogChangesetItem{ProductID: productID, Changes: changes}, product)
+	if response.Error != "" {
+		return nil, pkgErrors.InvalidInput(response.Error)
+	}
+
+	items, err := uc.changesetRepo.ListItems(ctx, changeset.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog changeset items: %w", err)
+	}
+	if len(items) >= entities.MaxCatalogChangesetItems && !containsChangesetItem(items, productID) {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("a changeset can change at most %d products", entities.MaxCatalogChangesetItems))
+	}
+
+	item := &entities.CatalogChangesetItem{
+		ID:          ids.New(),
+		ChangesetID: changeset.ID,
+		ProductID:   productID,
+		Changes:     changes,
+	}
+	if err := uc.changesetRepo.SaveItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to save catalog changeset item: %w", err)
+	}
+
+	response.UpdatedAt = time.Now()
+	return response, nil
+}
+
+// RemoveChangesetItem drops a product's staged edits from an unpublished changeset
+func (uc *catalogChangesetUseCase) RemoveChangesetItem(ctx context.Context, id, productID uuid.UUID) error {
+	if _, err := uc.getEditableChangeset(ctx, id); err != nil {
+		return err
+	}
+	return uc.changesetRepo.DeleteItem(ctx, id, productID)
+}
+
+// ScheduleChangeset sets the time the changeset goes live; the publish job picks it up then
+func (uc *catalogChangesetUseCase) ScheduleChangeset(ctx context.Context, id uuid.UUID, req ScheduleCatalogChangesetRequest) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.getEditableChangeset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !req.PublishAt.After(time.Now()) {
+		return nil, pkgErrors.InvalidInput("publish time must be in the future")
+	}
+
+	items, err := uc.changesetRepo.ListItems(ctx, changeset.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog changeset items: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, pkgErrors.InvalidInput("changeset has no product changes")
+	}
+
+	publishAt := req.PublishAt
+	changeset.Status = entities.CatalogChangesetStatusScheduled
+	changeset.ScheduledAt = &publishAt
+	changeset.ErrorMessage = ""
+	if err := uc.changesetRepo.Update(ctx, changeset); err != nil {
+		return nil, fmt.Errorf("failed to schedule catalog changeset: %w", err)
+	}
+	return uc.toDetailedResponse(ctx, changeset)
+}
+
+// UnscheduleChangeset moves a scheduled changeset back to draft
+func (uc *catalogChangesetUseCase) UnscheduleChangeset(ctx context.Context, id uuid.UUID) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.changesetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if changeset.Status != entities.CatalogChangesetStatusScheduled {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Catalog changeset is not scheduled")
+	}
+
+	changeset.Status = entities.CatalogChangesetStatusDraft
+	changeset.ScheduledAt = nil
+	if err := uc.changesetRepo.Update(ctx, changeset); err != nil {
+		return nil, fmt.Errorf("failed to unschedule catalog changeset: %w", err)
+	}
+	return uc.toDetailedResponse(ctx, changeset)
+}
+
+// PublishChangeset applies all of a changeset's edits now, in a single transaction.
+// Nothing is published if any product's edits no longer apply.
+func (uc *catalogChangesetUseCase) PublishChangeset(ctx context.Context, id uuid.UUID, publishedBy *uuid.UUID) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.getEditableChangeset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.publish(ctx, changeset, publishedBy); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Catalog changed while publishing the changeset, try again")
+		}
+		return nil, err
+	}
+	return uc.toDetailedResponse(ctx, changeset)
+}
+
+// PublishDueChangesets publishes scheduled changesets whose publish time has passed.
+// Changesets that cannot be published are marked failed; conflicts are retried on the next run.
+// It returns the number of changesets published.
+func (uc *catalogChangesetUseCase) PublishDueChangesets(ctx context.Context) (int, error) {
+	due, err := uc.changesetRepo.GetDue(ctx, time.Now(), 20)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due catalog changesets: %w", err)
+	}
+
+	published := 0
+	for _, changeset := range due {
+		if err := uc.publish(ctx, changeset, nil); err != nil {
+			fmt.Printf("❌ Failed to publish catalog changeset %s: %v\n", changeset.ID, err)
+			if err == entities.ErrConflict {
+				continue
+			}
+			changeset.Status = entities.CatalogChangesetStatusFailed
+			changeset.ErrorMessage = err.Error()
+			_ = uc.changesetRepo.Update(ctx, changeset)
+			continue
+		}
+		published++
+	}
+
+	if published > 0 {
+		fmt.Printf("✅ Published %d catalog changesets\n", published)
+	}
+	return published, nil
+}
+
+// RollbackChangeset restores the products a published changeset edited to how they were before.
+// Products edited again since are left alone and reported as skipped.
+func (uc *catalogChangesetUseCase) RollbackChangeset(ctx context.Context, id uuid.UUID, rolledBackBy *uuid.UUID) (*CatalogChangesetResponse, error) {
+	changeset, err := uc.changesetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !changeset.CanBeRolledBack() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Only published catalog changesets can be rolled back")
+	}
+
+	items, products, err := uc.getItemsWithProducts(ctx, changeset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("Rollback of %s", catalogChangesetReason(changeset))
+	var restored []*entities.Product
+	var entries []*entities.PriceHistory
+	var restoredItems []*entities.CatalogChangesetItem
+	var skipped []*CatalogChangesetItemResponse
+	for _, item := range items {
+		if item.Before == nil || item.After == nil {
+			continue
+		}
+
+		product, exists := products[item.ProductID]
+		if !exists {
+			skipped = append(skipped, &CatalogChangesetItemResponse{
+				ProductID: item.ProductID,
+				Changes:   item.Changes,
+				Error:     "product no longer exists",
+			})
+			continue
+		}
+		if !entities.NewCatalogProductState(product).Equal(*item.After) {
+			response := toPublishedChangesetItemResponse(item, product)
+			response.Error = "product changed since the changeset was published"
+			skipped = append(skipped, response)
+			continue
+		}
+
+		priceBefore := entities.NewPriceSnapshot(product)
+		item.Before.RestoreTo(product)
+		if priceAfter := entities.NewPriceSnapshot(product); !priceBefore.Equal(priceAfter) {
+			entries = append(entries, entities.NewPriceHistory(product.ID, priceBefore, priceAfter, rolledBackBy, entities.PriceChangeSourceChangeset, reason))
+		}
+		restored = append(restored, product)
+		restoredItems = append(restoredItems, item)
+	}
+
+	now := time.Now()
+	changeset.Status = entities.CatalogChangesetStatusRolledBack
+	changeset.RolledBackBy = rolledBackBy
+	changeset.RolledBackAt = &now
+
+	if err := uc.changesetRepo.Rollback(ctx, changeset, restored, entries); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Catalog changed while rolling back the changeset, try again")
+		}
+		return nil, fmt.Errorf("failed to roll back catalog changeset: %w", err)
+	}
+
+	for _, item := range restoredItems {
+		uc.flagOutdatedTranslations(ctx, item.ProductID, item.After.Content(), item.Before.Content())
+	}
+
+	response, err := uc.toDetailedResponse(ctx, changeset)
+	if err != nil {
+		return nil, err
+	}
+	response.Skipped = skipped
+	return response, nil
+}
+
+// PreviewProducts applies the staged edits of the changeset a preview token belongs to.
+// Edits that no longer apply to a product are left out of the preview.
+func (uc *catalogChangesetUseCase) PreviewProducts(ctx context.Context, token string, products []*entities.Product) error {
+	changeset, err := uc.changesetRepo.GetByPreviewToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !changeset.IsEditable() {
+		return entities.ErrCatalogChangesetNotFound
+	}
+
+	items, err := uc.changesetRepo.ListItems(ctx, changeset.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get catalog changeset items: %w", err)
+	}
+
+	changes := make(map[uuid.UUID]entities.CatalogProductChanges, len(items))
+	for _, item := range items {
+		changes[item.ProductID] = item.Changes
+	}
+
+	now := time.Now()
+	for _, product := range products {
+		productChanges, exists := changes[product.ID]
+		if !exists {
+			continue
+		}
+		staged := *product
+		if err := productChanges.ApplyTo(&staged, now); err != nil {
+			continue
+		}
+		*product = staged
+	}
+	return nil
+}
+
+// publish applies a changeset's edits and records each product's state before and after
+func (uc *catalogChangesetUseCase) publish(ctx context.Context, changeset *entities.CatalogChangeset, publishedBy *uuid.UUID) error {
+	items, products, err := uc.getItemsWithProducts(ctx, changeset.ID)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return pkgErrors.InvalidInput("changeset has no product changes")
+	}
+
+	now := time.Now()
+	reason := catalogChangesetReason(changeset)
+	edited := make([]*entities.Product, 0, len(items))
+	var entries []*entities.PriceHistory
+	var failures []string
+	for _, item := range items {
+		product, exists := products[item.ProductID]
+		if !exists {
+			failures = append(failures, fmt.Sprintf("%s: product no longer exists", item.ProductID))
+			continue
+		}
+
+		before := entities.NewCatalogProductState(product)
+		priceBefore := entities.NewPriceSnapshot(product)
+		if err := item.Changes.ApplyTo(product, now); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", product.SKU, err))
+			continue
+		}
+		after := entities.NewCatalogProductState(product)
+		item.Before = &before
+		item.After = &after
+
+		if priceAfter := entities.NewPriceSnapshot(product); !priceBefore.Equal(priceAfter) {
+			entries = append(entries, entities.NewPriceHistory(product.ID, priceBefore, priceAfter, publishedBy, entities.PriceChangeSourceChangeset, reason))
+		}
+		edited = append(edited, product)
+	}
+	if len(failures) > 0 {
+		return pkgErrors.InvalidInput(fmt.Sprintf("changeset cannot be published: %s", strings.Join(failures, "; ")))
+	}
+
+	status := changeset.Status
+	changeset.Status = entities.CatalogChangesetStatusPublished
+	changeset.PublishedBy = publishedBy
+	changeset.PublishedAt = &now
+	if err := uc.changesetRepo.Publish(ctx, changeset, items, edited, entries); err != nil {
+		changeset.Status, changeset.PublishedBy, changeset.PublishedAt = status, nil, nil
+		if err == entities.ErrConflict {
+			return err
+		}
+		return fmt.Errorf("failed to publish catalog changeset: %w", err)
+	}
+	changeset.ErrorMessage = ""
+
+	for _, item := range items {
+		uc.flagOutdatedTranslations(ctx, item.ProductID, item.Before.Content(), item.After.Content())
+	}
+	return nil
+}
+
+// flagOutdatedTranslations marks a product's translations outdated when its source content changed
+func (uc *catalogChangesetUseCase) flagOutdatedTranslations(ctx context.Context, productID uuid.UUID, before, after entities.ProductContent) {
+	if uc.localizer == nil || before == after {
+		return
+	}
+	if err := uc.localizer.MarkSourceChanged(ctx, productID); err != nil {
+		fmt.Printf("❌ Failed to flag outdated translations for product %s: %v\n", productID, err)
+	}
+}
+
+func (uc *catalogChangesetUseCase) getEditableChangeset(ctx context.Context, id uuid.UUID) (*entities.CatalogChangeset, error) {
+	changeset, err := uc.changesetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !changeset.IsEditable() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Catalog changeset has already been published")
+	}
+	return changeset, nil
+}
+
+// getItemsWithProducts loads a changeset's items and the products they edit, keyed by ID
+func (uc *catalogChangesetUseCase) getItemsWithProducts(ctx context.Context, changesetID uuid.UUID) ([]*entities.CatalogChangesetItem, map[uuid.UUID]*entities.Product, error) {
+	items, err := uc.changesetRepo.ListItems(ctx, changesetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get catalog changeset items: %w", err)
+	}
+
+	productIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+
+	products := make(map[uuid.UUID]*entities.Product, len(items))
+	if len(productIDs) == 0 {
+		return items, products, nil
+	}
+	loaded, err := uc.productRepo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	for _, product := range loaded {
+		products[product.ID] = product
+	}
+	return items, products, nil
+}
+
+// toDetailedResponse builds a changeset response with its items
+func (uc *catalogChangesetUseCase) toDetailedResponse(ctx context.Context, changeset *entities.CatalogChangeset) (*CatalogChangesetResponse, error) {
+	items, products, err := uc.getItemsWithProducts(ctx, changeset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toCatalogChangesetResponse(changeset, int64(len(items)))
+	response.Items = make([]*CatalogChangesetItemResponse, len(items))
+	for i, item := range items {
+		product := products[item.ProductID]
+		if changeset.IsEditable() {
+			response.Items[i] = newCatalogChangesetItemResponse(item, product)
+		} else {
+			response.Items[i] = toPublishedChangesetItemResponse(item, product)
+		}
+	}
+	return response, nil
+}
+
+// newCatalogChangesetItemResponse shows unpublished edits against the product as it is now
+func newCatalogChangesetItemResponse(item *entities.CatalogChangesetItem, product *entities.Product) *CatalogChangesetItemResponse {
+	response := &CatalogChangesetItemResponse{
+		ProductID: item.ProductID,
+		Changes:   item.Changes,
+		UpdatedAt: item.UpdatedAt,
+	}
+	if product == nil {
+		response.Error = "product no longer exists"
+		return response
+	}
+
+	response.ProductName = product.Name
+	response.SKU = product.SKU
+	before := entities.NewCatalogProductState(product)
+	response.Before = &before
+
+	staged := *product
+	if err := item.Changes.ApplyTo(&staged, time.Now()); err != nil {
+		response.Error = err.Error()
+		return response
+	}
+	after := entities.NewCatalogProductState(&staged)
+	response.After = &after
+	return response
+}
+
+// toPublishedChangesetItemResponse shows published edits with the states recorded at publishing
+func toPublishedChangesetItemResponse(item *entities.CatalogChangesetItem, product *entities.Product) *CatalogChangesetItemResponse {
+	response := &CatalogChangesetItemResponse{
+		ProductID: item.ProductID,
+		Changes:   item.Changes,
+		Before:    item.Before,
+		After:     item.After,
+		UpdatedAt: item.UpdatedAt,
+	}
+	if product != nil {
+		response.ProductName = product.Name
+		response.SKU = product.SKU
+	}
+	return response
+}
+
+func toCatalogChangesetResponse(changeset *entities.CatalogChangeset, itemCount int64) *CatalogChangesetResponse {
+	return &CatalogChangesetResponse{
+		ID:           changeset.ID,
+		Name:         changeset.Name,
+		Description:  changeset.Description,
+		Status:       changeset.Status,
+		ScheduledAt:  changeset.ScheduledAt,
+		PreviewToken: changeset.PreviewToken,
+		ErrorMessage: changeset.ErrorMessage,
+		ItemCount:    itemCount,
+		CreatedBy:    changeset.CreatedBy,
+		PublishedBy:  changeset.PublishedBy,
+		RolledBackBy: changeset.RolledBackBy,
+		PublishedAt:  changeset.PublishedAt,
+		RolledBackAt: changeset.RolledBackAt,
+		CreatedAt:    changeset.CreatedAt,
+		UpdatedAt:    changeset.UpdatedAt,
+	}
+}
+
+func containsChangesetItem(items []*entities.CatalogChangesetItem, productID uuid.UUID) bool {
+	for _, item := range items {
+		if item.ProductID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// catalogChangesetReason describes a changeset in the price history it writes
+func catalogChangesetReason(changeset *entities.CatalogChangeset) string {
+	return fmt.Sprintf("Catalog changeset %q", changeset.Name)
+}
+
+// generatePreviewToken generates an unguessable token for a storefront preview link
+func generatePreviewToken() (string, error) {
+	bytes := make([]byte, 24)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,321 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// CatalogVisibilityPolicy decides which products a viewer may see.
+// It is implemented by the catalog visibility use case and consulted by every
+// customer-facing catalog read (listing, search, recommendations, product detail).
+type CatalogVisibilityPolicy interface {
+	// HiddenProductIDs returns the products restricted away from the viewer; viewerID is nil for guests
+	HiddenProductIDs(ctx context.Context, viewerID *uuid.UUID) ([]uuid.UUID, error)
+
+	// CanViewProduct checks if the viewer may see a product
+	CanViewProduct(ctx context.Context, viewerID *uuid.UUID, productID uuid.UUID) (bool, error)
+}
+
+// CatalogVisibilityUseCase defines catalog visibility rule use cases
+type CatalogVisibilityUseCase interface {
+	CatalogVisibilityPolicy
+
+	// Admin operations
+	CreateRule(ctx context.Context, adminID uuid.UUID, req CreateVisibilityRuleRequest) (*VisibilityRuleResponse, error)
+	GetRule(ctx context.Context, ruleID uuid.UUID) (*VisibilityRuleResponse, error)
+	UpdateRule(ctx context.Context, ruleID uuid.UUID, req UpdateVisibilityRuleRequest) (*VisibilityRuleResponse, error)
+	DeleteRule(ctx context.Context, ruleID uuid.UUID) error
+	ListRules(ctx context.Context, req ListVisibilityRulesRequest) (*VisibilityRulesListResponse, error)
+
+	// PreviewHiddenProducts lists the products a given user (or a guest, when userID is nil) cannot see
+	PreviewHiddenProducts(ctx context.Context, userID *uuid.UUID) (*HiddenProductsResponse, error)
+}
+
+type catalogVisibilityUseCase struct {
+	ruleRepo     repositories.CatalogVisibilityRepository
+	productRepo  repositories.ProductRepository
+	categoryRepo repositories.CategoryRepository
+	userRepo     repositories.UserRepository
+	companyRepo  repositories.CompanyRepository
+}
+
+// NewCatalogVisibilityUseCase creates a new catalog visibility use case
+func NewCatalogVisibilityUseCase(
+	ruleRepo repositories.CatalogVisibilityRepository,
+	productRepo repositories.ProductRepository,
+	categoryRepo repositories.CategoryRepository,
+	userRepo repositories.UserRepository,
+	companyRepo repositories.CompanyRepository,
+) CatalogVisibilityUseCase {
+	return &catalogVisibilityUseCase{
+		ruleRepo:     ruleRepo,
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+		companyRepo:  companyRepo,
+	}
+}
+
+// CreateVisibilityRuleRequest represents a request to restrict a product or category to an audience
+type CreateVisibilityRuleRequest struct {
+	Name          string                      `json:"name" validate:"required,max=255"`
+	ProductID     *uuid.UUID                  `json:"product_id"`
+	CategoryID    *uuid.UUID                  `json:"category_id"`
+	Audience      entities.VisibilityAudience `json:"audience" validate:"required"`
+	AudienceValue string                      `json:"audience_value"` // Company ID, membership tier or customer segment
+	IsActive      *bool                       `json:"is_active"`      // Defaults to true
+}
+
+// UpdateVisibilityRuleRequest represents a request to update a visibility rule
+type UpdateVisibilityRuleRequest struct {
+	Name          *string                      `json:"name" validate:"omitempty,max=255"`
+	Audience      *entities.VisibilityAudience `json:"audience"`
+	AudienceValue *string                      `json:"audience_value"`
+	IsActive      *bool                        `json:"is_active"`
+}
+
+// ListVisibilityRulesRequest represents a request to list visibility rules
+type ListVisibilityRulesRequest struct {
+	ProductID  *uuid.UUID                   `json:"product_id" form:"product_id"`
+	CategoryID *uuid.UUID                   `json:"category_id" form:"category_id"`
+	Audience   *entities.VisibilityAudience `json:"audience" form:"audience"`
+	IsActive   *bool                        `json:"is_active" form:"is_active"`
+	Page       int                          `json:"page" form:"page"`
+	Limit      int                          `json:"limit" form:"limit"`
+}
+
+// VisibilityRuleResponse represents a visibility rule response
+type VisibilityRuleResponse struct {
+	*entities.CatalogVisibilityRule
+}
+
+// VisibilityRulesListResponse represents a paginated list of visibility rules
+type VisibilityRulesListResponse struct {
+	Rules      []*VisibilityRuleResponse `json:"rules"`
+	Pagination *PaginationInfo           `json:"pagination"`
+}
+
+// HiddenProductsResponse lists the products hidden from a viewer
+type HiddenProductsResponse struct {
+	UserID     *uuid.UUID  `json:"user_id"`
+	ProductIDs []uuid.UUID `json:"product_ids"`
+	Total      int         `json:"total"`
+}
+
+// CreateRule creates a visibility rule
+func (uc *catalogVisibilityUseCase) CreateRule(ctx context.Context, adminID uuid.UUID, req CreateVisibilityRuleRequest) (*VisibilityRuleResponse, error) {
+	rule := &entities.CatalogVisibilityRule{
+		ID:            ids.New(),
+		Name:          strings.TrimSpace(req.Name),
+		ProductID:     req.ProductID,
+		CategoryID:    req.CategoryID,
+		Audience:      req.Audience,
+		AudienceValue: strings.TrimSpace(req.AudienceValue),
+		IsActive:      true,
+		CreatedBy:     &adminID,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	if err := uc.validateReferences(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to create visibility rule: %w", err)
+	}
+
+	return &VisibilityRuleResponse{CatalogVisibilityRule: rule}, nil
+}
+
+// GetRule gets a visibility rule by ID
+func (uc *catalogVisibilityUseCase) GetRule(ctx context.Context, ruleID uuid.UUID) (*VisibilityRuleResponse, error) {
+	rule, err := uc.ruleRepo.GetByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	return &VisibilityRuleResponse{CatalogVisibilityRule: rule}, nil
+}
+
+// UpdateRule updates a visibility rule's audience, name or status. The target cannot be changed.
+func (uc *catalogVisibilityUseCase) UpdateRule(ctx context.Context, ruleID uuid.UUID, req UpdateVisibilityRuleRequest) (*VisibilityRuleResponse, error) {
+	rule, err := uc.ruleRepo.GetByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Audience != nil {
+		rule.Audience = *req.Audience
+	}
+	if req.AudienceValue != nil {
+		rule.AudienceValue = strings.TrimSpace(*req.AudienceValue)
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	if err := uc.validateReferences(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to update visibility rule: %w", err)
+	}
+
+	return &VisibilityRuleResponse{CatalogVisibilityRule: rule}, nil
+}
+
+// DeleteRule deletes a visibility rule
+func (uc *catalogVisibilityUseCase) DeleteRule(ctx context.Context, ruleID uuid.UUID) error {
+	return uc.ruleRepo.Delete(ctx, ruleID)
+}
+
+// ListRules lists visibility rules
+func (uc *catalogVisibilityUseCase) ListRules(ctx context.Context, req ListVisibilityRulesRequest) (*VisibilityRulesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.VisibilityRuleFilters{
+		ProductID:  req.ProductID,
+		CategoryID: req.CategoryID,
+		Audience:   req.Audience,
+		IsActive:   req.IsActive,
+		Limit:      limit,
+		Offset:     (page - 1) * limit,
+	}
+
+	rules, err := uc.ruleRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list visibility rules: %w", err)
+	}
+
+	total, err := uc.ruleRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count visibility rules: %w", err)
+	}
+
+	responses := make([]*VisibilityRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = &VisibilityRuleResponse{CatalogVisibilityRule: rule}
+	}
+
+	return &VisibilityRulesListResponse{
+		Rules:      responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// PreviewHiddenProducts lists the products a user or guest cannot see
+func (uc *catalogVisibilityUseCase) PreviewHiddenProducts(ctx context.Context, userID *uuid.UUID) (*HiddenProductsResponse, error) {
+	if userID != nil {
+		if _, err := uc.userRepo.GetByID(ctx, *userID); err != nil {
+			return nil, entities.ErrUserNotFound
+		}
+	}
+
+	ids, err := uc.HiddenProductIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+
+	return &HiddenProductsResponse{
+		UserID:     userID,
+		ProductIDs: ids,
+		Total:      len(ids),
+	}, nil
+}
+
+// HiddenProductIDs returns the products restricted away from the viewer
+func (uc *catalogVisibilityUseCase) HiddenProductIDs(ctx context.Context, viewerID *uuid.UUID) ([]uuid.UUID, error) {
+	viewer := uc.resolveViewer(ctx, viewerID)
+	ids, err := uc.ruleRepo.GetHiddenProductIDs(ctx, viewer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve catalog visibility: %w", err)
+	}
+	return ids, nil
+}
+
+// CanViewProduct checks if the viewer may see a product
+func (uc *catalogVisibilityUseCase) CanViewProduct(ctx context.Context, viewerID *uuid.UUID, productID uuid.UUID) (bool, error) {
+	hidden, err := uc.HiddenProductIDs(ctx, viewerID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range hidden {
+		if id == productID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// resolveViewer loads the attributes visibility rules are evaluated against.
+// Unknown or inactive users are treated as guests.
+func (uc *catalogVisibilityUseCase) resolveViewer(ctx context.Context, viewerID *uuid.UUID) *entities.CatalogViewer {
+	viewer := &entities.CatalogViewer{}
+	if viewerID == nil {
+		return viewer
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, *viewerID)
+	if err != nil || !user.IsActive {
+		return viewer
+	}
+
+	viewer.UserID = &user.ID
+	viewer.IsStaff = user.Role == entities.UserRoleAdmin || user.Role == entities.UserRoleModerator
+	viewer.MembershipTier = user.MembershipTier
+	viewer.CustomerSegment = user.GetCustomerSegment()
+
+	if member, err := uc.companyRepo.GetMemberByUserID(ctx, user.ID); err == nil &&
+		member.IsActive && member.Company != nil && member.Company.IsActive {
+		companyID := member.CompanyID
+		viewer.CompanyID = &companyID
+	}
+
+	return viewer
+}
+
+// validateReferences checks that the rule's product, category and company exist
+func (uc *catalogVisibilityUseCase) validateReferences(ctx context.Context, rule *entities.CatalogVisibilityRule) error {
+	if rule.ProductID != nil {
+		if _, err := uc.productRepo.GetByID(ctx, *rule.ProductID); err != nil {
+			return entities.ErrProductNotFound
+		}
+	}
+	if rule.CategoryID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *rule.CategoryID); err != nil {
+			return entities.ErrCategoryNotFound
+		}
+	}
+	if rule.Audience == entities.VisibilityAudienceCompany && rule.AudienceValue != "" {
+		companyID, _ := uuid.Parse(rule.AudienceValue)
+		if _, err := uc.companyRepo.GetByID(ctx, companyID); err != nil {
+			return entities.ErrCompanyNotFound
+		}
+	}
+	return nil
+}
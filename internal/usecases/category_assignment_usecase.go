@@ -0,0 +1,380 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// categoryAssignmentPreviewSampleSize is the number of changed products a preview lists
+	categoryAssignmentPreviewSampleSize = 20
+	// categoryAssignmentStaleAfter is how long an assignment may run before another server
+	// takes it over
+	categoryAssignmentStaleAfter = time.Hour
+)
+
+// CategoryAssignmentUseCase defines use cases for adding, removing and moving many products
+// across categories at once
+type CategoryAssignmentUseCase interface {
+	// PreviewAssignment counts the products an assignment would match and change, without saving
+	PreviewAssignment(ctx context.Context, req CategoryAssignmentRequest) (*CategoryAssignmentPreviewResponse, error)
+	// CreateAssignment applies small assignments right away and leaves larger ones pending for
+	// RunPendingAssignments
+	CreateAssignment(ctx context.Context, req CategoryAssignmentRequest) (*entities.CategoryAssignment, error)
+	GetAssignment(ctx context.Context, id uuid.UUID) (*entities.CategoryAssignment, error)
+	ListAssignments(ctx context.Context, req ListCategoryAssignmentsRequest) (*CategoryAssignmentsListResponse, error)
+	// RunPendingAssignments runs the pending assignments in the background, oldest first
+	RunPendingAssignments(ctx context.Context) (int, error)
+}
+
+type categoryAssignmentUseCase struct {
+	assignmentRepo      repositories.CategoryAssignmentRepository
+	categoryRepo        repositories.CategoryRepository
+	productCategoryRepo repositories.ProductCategoryRepository
+	events              services.EventRecorder
+}
+
+// NewCategoryAssignmentUseCase creates a new category assignment use case. Completed assignments
+// are published as category.products_changed events when events is set.
+func NewCategoryAssignmentUseCase(
+	assignmentRepo repositories.CategoryAssignmentRepository,
+	categoryRepo repositories.CategoryRepository,
+	productCategoryRepo repositories.ProductCategoryRepository,
+	events services.EventRecorder,
+) CategoryAssignmentUseCase {
+	return &categoryAssignmentUseCase{
+		assignmentRepo:      assignmentRepo,
+		categoryRepo:        categoryRepo,
+		productCategoryRepo: productCategoryRepo,
+		events:              events,
+	}
+}
+
+// CategoryAssignmentRequest represents a bulk add, remove or move of products. The same request
+// is used for the dry-run preview and for running the assignment.
+type CategoryAssignmentRequest struct {
+	Action           entities.CategoryAssignmentAction   `json:"action" validate:"required,oneof=add remove move"`
+	CategoryID       uuid.UUID                           `json:"category_id" validate:"required"`
+	SourceCategoryID *uuid.UUID                          `json:"source_category_id"` // Required to move products
+	MakePrimary      bool                                `json:"make_primary"`
+	Selector         entities.CategoryAssignmentSelector `json:"selector"`
+	RequestedBy      *uuid.UUID                          `json:"-"`
+}
+
+// ListCategoryAssignmentsRequest represents a request to list category assignments
+type ListCategoryAssignmentsRequest struct {
+	Status *entities.CategoryAssignmentStatus `json:"status"`
+	Page   int                                `json:"page"`
+	Limit  int                                `json:"limit"`
+}
+
+// CategoryAssignmentProduct identifies a product in an assignment preview
+type CategoryAssignmentProduct struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	SKU  string    `json:"sku"`
+}
+
+// CategoryAssignmentPreviewResponse counts the products an assignment would change
+type CategoryAssignmentPreviewResponse struct {
+	Action           entities.CategoryAssignmentAction `json:"action"`
+	CategoryID       uuid.UUID                         `json:"category_id"`
+	SourceCategoryID *uuid.UUID                        `json:"source_category_id,omitempty"`
+	MatchedCount     int                               `json:"matched_count"`
+	ChangedCount     int                               `json:"changed_count"`
+	UnchangedCount   int                               `json:"unchanged_count"`
+	RunsInBackground bool                              `json:"runs_in_background"`
+	Sample           []CategoryAssignmentProduct       `json:"sample"` // The first products that would change
+}
+
+// CategoryAssignmentsListResponse represents a paginated list of category assignments
+type CategoryAssignmentsListResponse struct {
+	Assignments []*entities.CategoryAssignment `json:"assignments"`
+	Pagination  *PaginationInfo                `json:"pagination"`
+}
+
+// categoryAssignmentPlan holds the products an assignment matches and the ones it changes
+type categoryAssignmentPlan struct {
+	matched []*entities.Product
+	changed []*entities.Product
+}
+
+// PreviewAssignment counts the products an assignment would match and change
+func (uc *categoryAssignmentUseCase) PreviewAssignment(ctx context.Context, req CategoryAssignmentRequest) (*CategoryAssignmentPreviewResponse, error) {
+	assignment, err := uc.newAssignment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := uc.planAssignment(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CategoryAssignmentPreviewResponse{
+		Action:           assignment.Action,
+		CategoryID:       assignment.CategoryID,
+		SourceCategoryID: assignment.SourceCategoryID,
+		MatchedCount:     len(plan.matched),
+		ChangedCount:     len(plan.changed),
+		UnchangedCount:   len(plan.matched) - len(plan.changed),
+		RunsInBackground: len(plan.matched) > entities.MaxInlineCategoryAssignmentProducts,
+		Sample:           []CategoryAssignmentProduct{},
+	}
+	for _, product := range plan.changed {
+		if len(response.Sample) == categoryAssignmentPreviewSampleSize {
+			break
+		}
+		response.Sample = append(response.Sample, CategoryAssignmentProduct{
+			ID:   product.ID,
+			Name: product.Name,
+			SKU:  product.SKU,
+		})
+	}
+	return response, nil
+}
+
+// CreateAssignment runs an assignment of up to MaxInlineCategoryAssignmentProducts products right
+// away. Larger assignments are saved pending and run by the background job; their products are
+// selected again when they run.
+func (uc *categoryAssignmentUseCase) CreateAssignment(ctx context.Context, req CategoryAssignmentRequest) (*entities.CategoryAssignment, error) {
+	assignment, err := uc.newAssignment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := uc.planAssignment(ctx, assignment)
+	if err != nil {
+		return nil, err
+	}
+	assignment.MatchedCount = len(plan.matched)
+
+	if len(plan.matched) > entities.MaxInlineCategoryAssignmentProducts {
+		if err := uc.assignmentRepo.Create(ctx, assignment); err != nil {
+			return nil, fmt.Errorf("failed to create category assignment: %w", err)
+		}
+		return assignment, nil
+	}
+
+	now := time.Now()
+	assignment.Status = entities.CategoryAssignmentStatusRunning
+	assignment.StartedAt = &now
+	if err := uc.assignmentRepo.Create(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to create category assignment: %w", err)
+	}
+	if err := uc.execute(ctx, assignment, plan); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// GetAssignment gets a category assignment
+func (uc *categoryAssignmentUseCase) GetAssignment(ctx context.Context, id uuid.UUID) (*entities.CategoryAssignment, error) {
+	return uc.assignmentRepo.GetByID(ctx, id)
+}
+
+// ListAssignments lists category assignments, newest first
+func (uc *categoryAssignmentUseCase) ListAssignments(ctx context.Context, req ListCategoryAssignmentsRequest) (*CategoryAssignmentsListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.CategoryAssignmentFilters{
+		Status: req.Status,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}
+
+	assignments, err := uc.assignmentRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category assignments: %w", err)
+	}
+
+	total, err := uc.assignmentRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count category assignments: %w", err)
+	}
+
+	return &CategoryAssignmentsListResponse{
+		Assignments: assignments,
+		Pagination:  NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// RunPendingAssignments runs pending assignments one at a time until none is left
+func (uc *categoryAssignmentUseCase) RunPendingAssignments(ctx context.Context) (int, error) {
+	completed := 0
+	for {
+		assignment, err := uc.assignmentRepo.ClaimNext(ctx, time.Now().Add(-categoryAssignmentStaleAfter))
+		if err != nil {
+			return completed, fmt.Errorf("failed to claim category assignment: %w", err)
+		}
+		if assignment == nil {
+			break
+		}
+
+		plan, err := uc.planAssignment(ctx, assignment)
+		if err == nil {
+			err = uc.execute(ctx, assignment, plan)
+		} else {
+			uc.fail(ctx, assignment, err)
+		}
+		if err != nil {
+			fmt.Printf("❌ Failed to run category assignment %s: %v\n", assignment.ID, err)
+			continue
+		}
+		completed++
+	}
+
+	if completed > 0 {
+		fmt.Printf("✅ Completed %d category assignments\n", completed)
+	}
+	return completed, nil
+}
+
+// newAssignment builds and validates an assignment from a request
+func (uc *categoryAssignmentUseCase) newAssignment(ctx context.Context, req CategoryAssignmentRequest) (*entities.CategoryAssignment, error) {
+	assignment := &entities.CategoryAssignment{
+		ID:               ids.New(),
+		Action:           req.Action,
+		CategoryID:       req.CategoryID,
+		SourceCategoryID: req.SourceCategoryID,
+		MakePrimary:      req.MakePrimary,
+		Selector:         req.Selector,
+		Status:           entities.CategoryAssignmentStatusPending,
+		RequestedBy:      req.RequestedBy,
+	}
+	if err := assignment.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if _, err := uc.categoryRepo.GetByID(ctx, assignment.CategoryID); err != nil {
+		return nil, entities.ErrCategoryNotFound
+	}
+	if assignment.SourceCategoryID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *assignment.SourceCategoryID); err != nil {
+			return nil, entities.ErrCategoryNotFound
+		}
+	}
+	return assignment, nil
+}
+
+// planAssignment selects the assignment's products and works out which of them it changes
+func (uc *categoryAssignmentUseCase) planAssignment(ctx context.Context, assignment *entities.CategoryAssignment) (*categoryAssignmentPlan, error) {
+	selector := assignment.Selector
+	filter := repositories.CategoryAssignmentProductFilter{
+		ProductIDs: selector.ProductIDs,
+		BrandID:    selector.BrandID,
+		VendorID:   selector.VendorID,
+		Status:     selector.Status,
+		Search:     selector.Search,
+		MinPrice:   selector.MinPrice,
+		MaxPrice:   selector.MaxPrice,
+		Limit:      entities.MaxCategoryAssignmentProducts + 1,
+	}
+	if selector.CategoryID != nil {
+		categoryIDs, err := uc.categoryRepo.GetCategoryTree(ctx, *selector.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category tree: %w", err)
+		}
+		if len(categoryIDs) == 0 {
+			return nil, entities.ErrCategoryNotFound
+		}
+		filter.CategoryIDs = categoryIDs
+	}
+
+	products, err := uc.assignmentRepo.GetMatchingProducts(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching products: %w", err)
+	}
+	if len(products) > entities.MaxCategoryAssignmentProducts {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("selection matches more than %d products, narrow it down", entities.MaxCategoryAssignmentProducts))
+	}
+
+	productIDs := make([]uuid.UUID, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+	assigned, err := uc.productCategoryRepo.GetCategoryIDsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+
+	plan := &categoryAssignmentPlan{matched: products}
+	for _, product := range products {
+		inCategory := containsUUID(assigned[product.ID], assignment.CategoryID)
+		var changes bool
+		switch assignment.Action {
+		case entities.CategoryAssignmentActionAdd:
+			// Making the category primary rewrites every product's primary flag
+			changes = !inCategory || assignment.MakePrimary
+		case entities.CategoryAssignmentActionRemove:
+			changes = inCategory
+		case entities.CategoryAssignmentActionMove:
+			changes = !inCategory || assignment.MakePrimary || containsUUID(assigned[product.ID], *assignment.SourceCategoryID)
+		}
+		if changes {
+			plan.changed = append(plan.changed, product)
+		}
+	}
+	return plan, nil
+}
+
+// execute applies a planned assignment, marks it completed and publishes the change
+func (uc *categoryAssignmentUseCase) execute(ctx context.Context, assignment *entities.CategoryAssignment, plan *categoryAssignmentPlan) error {
+	productIDs := make([]uuid.UUID, len(plan.changed))
+	for i, product := range plan.changed {
+		productIDs[i] = product.ID
+	}
+
+	if len(productIDs) > 0 {
+		if err := uc.assignmentRepo.Apply(ctx, assignment, productIDs); err != nil {
+			err = fmt.Errorf("failed to apply category assignment: %w", err)
+			uc.fail(ctx, assignment, err)
+			return err
+		}
+	}
+
+	now := time.Now()
+	assignment.Status = entities.CategoryAssignmentStatusCompleted
+	assignment.MatchedCount = len(plan.matched)
+	assignment.ChangedCount = len(productIDs)
+	assignment.ErrorMessage = ""
+	assignment.CompletedAt = &now
+	if err := uc.assignmentRepo.Update(ctx, assignment); err != nil {
+		return fmt.Errorf("category assignment was applied but could not be saved: %w", err)
+	}
+
+	if uc.events != nil && len(productIDs) > 0 {
+		uc.events.Record(ctx, entities.DomainEventCategoryProductsChanged, assignment.CategoryID,
+			entities.NewCategoryProductsChangedEventData(assignment, productIDs))
+	}
+	return nil
+}
+
+// fail marks an assignment failed with the error that stopped it
+func (uc *categoryAssignmentUseCase) fail(ctx context.Context, assignment *entities.CategoryAssignment, err error) {
+	now := time.Now()
+	assignment.Status = entities.CategoryAssignmentStatusFailed
+	assignment.ErrorMessage = err.Error()
+	assignment.CompletedAt = &now
+	_ = uc.assignmentRepo.Update(ctx, assignment)
+}
+
+// containsUUID checks if ids contains id
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
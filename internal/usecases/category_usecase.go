@@ -11,6 +11,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"ecom-golang-clean-architecture/pkg/utils"
 
 	"github.com/google/uuid"
@@ -81,15 +82,17 @@ type categoryUseCase struct {
 	productRepo         repositories.ProductRepository
 	productCategoryRepo repositories.ProductCategoryRepository
 	fileService         services.FileService
+	visibilityPolicy    CatalogVisibilityPolicy
 }
 
 // NewCategoryUseCase creates a new category use case
-func NewCategoryUseCase(categoryRepo repositories.CategoryRepository, productRepo repositories.ProductRepository, productCategoryRepo repositories.ProductCategoryRepository, fileService services.FileService) CategoryUseCase {
+func NewCategoryUseCase(categoryRepo repositories.CategoryRepository, productRepo repositories.ProductRepository, productCategoryRepo repositories.ProductCategoryRepository, fileService services.FileService, visibilityPolicy CatalogVisibilityPolicy) CategoryUseCase {
 	return &categoryUseCase{
 		categoryRepo:        categoryRepo,
 		productRepo:         productRepo,
 		productCategoryRepo: productCategoryRepo,
 		fileService:         fileService,
+		visibilityPolicy:    visibilityPolicy,
 	}
 }
 
@@ -150,14 +153,15 @@ type GetCategoriesResponse struct {
 
 // GetCategoryLandingPageRequest represents category landing page request
 type GetCategoryLandingPageRequest struct {
-	CategoryID                 uuid.UUID `json:"category_id"`
-	Page                       int       `json:"page"`
-	Limit                      int       `json:"limit"`
-	SortBy                     string    `json:"sort_by"`
-	SortOrder                  string    `json:"sort_order"`
-	IncludeSubcategoryProducts bool      `json:"include_subcategory_products"`
-	IncludeFeatured            bool      `json:"include_featured"`
-	FeaturedLimit              int       `json:"featured_limit"`
+	CategoryID                 uuid.UUID  `json:"category_id"`
+	Page                       int        `json:"page"`
+	Limit                      int        `json:"limit"`
+	SortBy                     string     `json:"sort_by"`
+	SortOrder                  string     `json:"sort_order"`
+	IncludeSubcategoryProducts bool       `json:"include_subcategory_products"`
+	IncludeFeatured            bool       `json:"include_featured"`
+	FeaturedLimit              int        `json:"featured_limit"`
+	ViewerID                   *uuid.UUID `json:"-"`
 }
 
 // CategoryResponse represents category response
@@ -392,7 +396,7 @@ func (uc *categoryUseCase) CreateCategory(ctx context.Context, req CreateCategor
 
 	// Create category
 	category := &entities.Category{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		Name:        req.Name,
 		Description: req.Description,
 		Slug:        req.Slug,
@@ -685,7 +689,33 @@ func (uc *categoryUseCase) GetCategoryLandingPage(ctx context.Context, req GetCa
 	var products []*entities.Product
 	var totalProducts int64
 
-	if req.IncludeSubcategoryProducts {
+	// Resolve products restricted away from the viewer
+	var hiddenIDs []uuid.UUID
+	if uc.visibilityPolicy != nil {
+		hiddenIDs, err = uc.visibilityPolicy.HiddenProductIDs(ctx, req.ViewerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(hiddenIDs) > 0 {
+		// Search excludes hidden products in the query so the total stays accurate
+		params := repositories.ProductSearchParams{
+			CategoryID: &req.CategoryID,
+			Limit:      req.Limit,
+			Offset:     offset,
+			ExcludeIDs: hiddenIDs,
+		}
+		products, err = uc.productRepo.Search(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		totalProducts, err = uc.productRepo.SearchCount(ctx, params)
+		if err != nil {
+			totalProducts = 0
+		}
+	} else if req.IncludeSubcategoryProducts {
 		// For now, just get products from the main category
 		// TODO: Implement multi-category product search including subcategories
 		products, err = uc.productRepo.GetByCategory(ctx, req.CategoryID, req.Limit, offset)
@@ -713,7 +743,7 @@ func (uc *categoryUseCase) GetCategoryLandingPage(ctx context.Context, req GetCa
 	// Convert products to response format
 	productResponses := make([]*ProductResponse, len(products))
 	for i, product := range products {
-		productResponses[i] = uc.toProductResponse(product)
+		productResponses[i] = uc.toProductResponse(ctx, product)
 	}
 
 	// Get featured products in this category if requested
@@ -726,9 +756,17 @@ func (uc *categoryUseCase) GetCategoryLandingPage(ctx context.Context, req GetCa
 
 		featuredProducts, err := uc.productRepo.GetFeaturedByCategory(ctx, req.CategoryID, featuredLimit)
 		if err == nil && len(featuredProducts) > 0 {
-			featuredProductResponses = make([]*ProductResponse, len(featuredProducts))
-			for i, product := range featuredProducts {
-				featuredProductResponses[i] = uc.toProductResponse(product)
+			hidden := make(map[uuid.UUID]bool, len(hiddenIDs))
+			for _, id := range hiddenIDs {
+				hidden[id] = true
+			}
+
+			featuredProductResponses = make([]*ProductResponse, 0, len(featuredProducts))
+			for _, product := range featuredProducts {
+				if hidden[product.ID] {
+					continue
+				}
+				featuredProductResponses = append(featuredProductResponses, uc.toProductResponse(ctx, product))
 			}
 		}
 	}
@@ -810,20 +848,22 @@ func (uc *categoryUseCase) toCategoryResponse(category *entities.Category) *Cate
 }
 
 // toProductResponse converts product entity to response
-func (uc *categoryUseCase) toProductResponse(product *entities.Product) *ProductResponse {
+func (uc *categoryUseCase) toProductResponse(ctx context.Context, product *entities.Product) *ProductResponse {
 	response := &ProductResponse{
-		ID:           product.ID,
-		Name:         product.Name,
-		Description:  product.Description,
-		SKU:          product.SKU,
-		Price:        product.Price,
-		SalePrice:    product.SalePrice,
-		ComparePrice: product.ComparePrice,
-		Stock:        product.Stock,
-		Status:       product.Status,
-		Weight:       product.Weight,
-		CreatedAt:    product.CreatedAt,
-		UpdatedAt:    product.UpdatedAt,
+		ID:                    product.ID,
+		Name:                  product.Name,
+		Description:           product.Description,
+		SKU:                   product.SKU,
+		Price:                 product.Price,
+		SalePrice:             product.SalePrice,
+		ComparePrice:          product.ComparePrice,
+		Stock:                 &product.Stock,
+		StockVisibility:       product.StockVisibility,
+		StockDisplayThreshold: product.StockDisplayThreshold,
+		Status:                product.Status,
+		Weight:                product.Weight,
+		CreatedAt:             product.CreatedAt,
+		UpdatedAt:             product.UpdatedAt,
 	}
 
 	// Set dimensions
@@ -879,6 +919,9 @@ func (uc *categoryUseCase) toProductResponse(product *entities.Product) *Product
 	response.IsAvailable = product.Status == entities.ProductStatusActive && product.Stock > 0
 	response.HasDiscount = product.HasDiscount() || product.IsOnSale()
 
+	restrictStock(ctx, response, product)
+	applyStorefrontPrice(ctx, response)
+
 	return response
 }
 
@@ -940,7 +983,7 @@ func (uc *categoryUseCase) BulkCreateCategories(ctx context.Context, req []Creat
 		}
 
 		category := &entities.Category{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        r.Name,
 			Description: r.Description,
 			Slug:        slug,
@@ -13,6 +13,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/services"
 	"ecom-golang-clean-architecture/internal/infrastructure/database"
 	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 )
 
 // PaymentUseCaseInterface interface for payment operations (to avoid conflict)
@@ -42,6 +43,14 @@ type CheckoutUseCase interface {
 type CreateNewCheckoutSessionRequest struct {
 	ShippingAddress AddressRequest         `json:"shipping_address" validate:"required"`
 	BillingAddress  *AddressRequest        `json:"billing_address"`
+
+	// Saved address book entries; when set they replace the inline addresses
+	ShippingAddressID *uuid.UUID `json:"shipping_address_id"`
+	BillingAddressID  *uuid.UUID `json:"billing_address_id"`
+
+	// Gift ships to the recipient at the shipping address; the buyer is billed
+	Gift *GiftRequest `json:"gift,omitempty"`
+
 	PaymentMethod   entities.PaymentMethod `json:"payment_method" validate:"required"`
 	Notes           string                 `json:"notes"`
 	TaxRate         float64                `json:"tax_rate" validate:"min=0,max=1"`
@@ -72,9 +81,13 @@ type checkoutUseCase struct {
 	cartRepo        repositories.CartRepository
 	orderRepo       repositories.OrderRepository
 	productRepo     repositories.ProductRepository
+	addressRepo     repositories.AddressRepository
 	stockService    services.SimpleStockService
 	orderService    services.OrderService
 	paymentUseCase  PaymentUseCaseInterface
+	companyPolicy   CompanyOrderPolicy
+	taxExemption    TaxExemptionPolicy
+	activityTracker ActivityTracker
 	txManager       *database.TransactionManager
 }
 
@@ -84,25 +97,38 @@ func NewCheckoutUseCase(
 	cartRepo repositories.CartRepository,
 	orderRepo repositories.OrderRepository,
 	productRepo repositories.ProductRepository,
+	addressRepo repositories.AddressRepository,
 	stockService services.SimpleStockService,
 	orderService services.OrderService,
 	paymentUseCase PaymentUseCaseInterface,
+	companyPolicy CompanyOrderPolicy,
+	taxExemption TaxExemptionPolicy,
+	activityTracker ActivityTracker,
 	txManager *database.TransactionManager,
 ) CheckoutUseCase {
 	return &checkoutUseCase{
-		checkoutRepo:   checkoutRepo,
-		cartRepo:       cartRepo,
-		orderRepo:      orderRepo,
-		productRepo:    productRepo,
-		stockService:   stockService,
-		orderService:   orderService,
-		paymentUseCase: paymentUseCase,
-		txManager:      txManager,
+		checkoutRepo:    checkoutRepo,
+		cartRepo:        cartRepo,
+		orderRepo:       orderRepo,
+		productRepo:     productRepo,
+		addressRepo:     addressRepo,
+		stockService:    stockService,
+		orderService:    orderService,
+		paymentUseCase:  paymentUseCase,
+		companyPolicy:   companyPolicy,
+		taxExemption:    taxExemption,
+		activityTracker: activityTracker,
+		txManager:       txManager,
 	}
 }
 
 // CreateCheckoutSession creates a checkout session for online payments
 func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uuid.UUID, req CreateNewCheckoutSessionRequest) (*NewCheckoutSessionResponse, error) {
+	// Pre-fill and re-validate saved addresses
+	if err := resolveCheckoutAddresses(ctx, uc.addressRepo, userID, uc.memberCompanyID(ctx, userID), req.ShippingAddressID, req.BillingAddressID, &req.ShippingAddress, &req.BillingAddress); err != nil {
+		return nil, err
+	}
+
 	// Validate request
 	if err := uc.validateCheckoutRequest(req); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInvalidInput, "Invalid checkout request")
@@ -112,6 +138,9 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 	if req.PaymentMethod == entities.PaymentMethodCash {
 		return nil, pkgErrors.InvalidInput("COD orders should use direct order creation")
 	}
+	if req.PaymentMethod == entities.PaymentMethodInvoice {
+		return nil, pkgErrors.InvalidInput("Invoice orders should use direct order creation")
+	}
 
 	// Get user's cart
 	cart, err := uc.cartRepo.GetByUserID(ctx, userID)
@@ -133,9 +162,27 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 		cart.Items, req.TaxRate, req.ShippingCost, req.DiscountAmount,
 	)
 
+	// Verified tax exemption certificates take the exempt items' tax off
+	taxExemption, err := applyTaxExemption(ctx, uc.taxExemption, userID, cart.Items, cartItemTaxClasses(cart.Items), req.TaxRate,
+		req.ShippingAddress.Country, req.ShippingAddress.State, &taxAmount, &total)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply tax exemption")
+	}
+
+	// Company orders over the buyer's spend limit must be approved before payment
+	if uc.companyPolicy != nil {
+		requiresApproval, err := uc.companyPolicy.RequiresApproval(ctx, userID, total)
+		if err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to check company spend limit")
+		}
+		if requiresApproval {
+			return nil, pkgErrors.InvalidInput("Order exceeds your company spend limit; place the order for approval before paying")
+		}
+	}
+
 	// Create checkout session
 	session := &entities.CheckoutSession{
-		ID:              uuid.New(),
+		ID:              ids.New(),
 		UserID:          userID,
 		CartID:          cart.ID,
 		CartItems:       cart.Items, // Snapshot
@@ -148,6 +195,7 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 		Currency:        "USD",
 		TaxRate:         req.TaxRate,
 		ShippingCost:    req.ShippingCost,
+		TaxExemption:    taxExemption,
 		Notes:           req.Notes,
 		Status:          entities.CheckoutSessionStatusActive,
 		CreatedAt:       time.Now(),
@@ -186,6 +234,25 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 		session.BillingAddress = session.ShippingAddress
 	}
 
+	// Re-validate addresses before they are used for the order
+	if err := session.ShippingAddress.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput("Invalid shipping address: " + err.Error())
+	}
+	if err := session.BillingAddress.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput("Invalid billing address: " + err.Error())
+	}
+	if len(req.ShippingAddress.DeliveryInstructions) > entities.MaxDeliveryInstructionsLength {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("Delivery instructions cannot exceed %d characters", entities.MaxDeliveryInstructionsLength))
+	}
+	session.DeliveryInstructions = req.ShippingAddress.DeliveryInstructions
+
+	if req.Gift != nil {
+		session.IsGift = true
+		session.GiftRecipientEmail = req.Gift.RecipientEmail
+		session.GiftMessage = req.Gift.Message
+		session.GiftWrap = req.Gift.Wrap
+	}
+
 	// Generate session ID and set expiration
 	session.GenerateSessionID()
 	session.SetExpiration(15) // 15 minutes for online payments
@@ -224,7 +291,7 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 		// Add items to temp order
 		for _, cartItem := range cart.Items {
 			orderItem := entities.OrderItem{
-				ID:          uuid.New(),
+				ID:          ids.New(),
 				OrderID:     tempOrder.ID,
 				ProductID:   cartItem.ProductID,
 				ProductName: cartItem.Product.Name,
@@ -300,6 +367,14 @@ func (uc *checkoutUseCase) CreateCheckoutSession(ctx context.Context, userID uui
 	return response, nil
 }
 
+// memberCompanyID returns the company whose shared addresses the user may use at checkout
+func (uc *checkoutUseCase) memberCompanyID(ctx context.Context, userID uuid.UUID) *uuid.UUID {
+	if uc.companyPolicy == nil {
+		return nil
+	}
+	return uc.companyPolicy.GetMemberCompanyID(ctx, userID)
+}
+
 // validateCheckoutRequest validates checkout request
 func (uc *checkoutUseCase) validateCheckoutRequest(req CreateNewCheckoutSessionRequest) error {
 	// Validate payment method
@@ -335,6 +410,12 @@ func (uc *checkoutUseCase) validateCheckoutRequest(req CreateNewCheckoutSessionR
 		return fmt.Errorf("discount amount cannot be negative")
 	}
 
+	if req.Gift != nil {
+		if err := req.Gift.validate(req.BillingAddress != nil); err != nil {
+			return fmt.Errorf("invalid gift options: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -347,7 +428,12 @@ func (uc *checkoutUseCase) CompleteCheckoutSession(ctx context.Context, sessionI
 	if err != nil {
 		return nil, err
 	}
-	return result.(*OrderResponse), nil
+
+	order := result.(*OrderResponse)
+	if order.User != nil {
+		trackOrderPlaced(ctx, uc.activityTracker, order.User.ID, order)
+	}
+	return order, nil
 }
 
 // completeCheckoutSessionInTransaction handles checkout completion in transaction
@@ -368,16 +454,9 @@ func (uc *checkoutUseCase) completeCheckoutSessionInTransaction(ctx context.Cont
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInsufficientStock, "Stock not available")
 	}
 
-	// Generate order number
-	orderNumber, err := uc.orderService.GenerateUniqueOrderNumber(ctx)
-	if err != nil {
-		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to generate order number")
-	}
-
 	// Create order from session
 	order := &entities.Order{
-		ID:             uuid.New(),
-		OrderNumber:    orderNumber,
+		ID:             ids.New(),
 		UserID:         session.UserID,
 		Status:         entities.OrderStatusConfirmed, // Confirmed because payment is already successful
 		PaymentStatus:  entities.PaymentStatusPaid,
@@ -400,11 +479,16 @@ func (uc *checkoutUseCase) completeCheckoutSessionInTransaction(ctx context.Cont
 	// Set addresses
 	order.ShippingAddress = session.ShippingAddress
 	order.BillingAddress = session.BillingAddress
+	order.DeliveryInstructions = session.DeliveryInstructions
+	order.IsGift = session.IsGift
+	order.GiftRecipientEmail = session.GiftRecipientEmail
+	order.GiftMessage = session.GiftMessage
+	order.GiftWrap = session.GiftWrap
 
 	// Create order items
 	for _, cartItem := range session.CartItems {
 		orderItem := entities.OrderItem{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			OrderID:     order.ID,
 			ProductID:   cartItem.ProductID,
 			ProductName: cartItem.Product.Name,
@@ -415,14 +499,23 @@ func (uc *checkoutUseCase) completeCheckoutSessionInTransaction(ctx context.Cont
 		}
 		order.Items = append(order.Items, orderItem)
 	}
+	order.ApplyTaxExemption(session.TaxExemption)
 
-	// Validate order
-	if err := order.Validate(); err != nil {
+	// Validate order; its number is assigned when it is saved
+	if err := order.ValidateDetails(); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInvalidInput, "Invalid order data")
 	}
 
-	// Save order
-	if err := uc.orderRepo.Create(ctx, order); err != nil {
+	// Link company orders; the spend limit was already checked when the session was created
+	if uc.companyPolicy != nil {
+		if err := uc.companyPolicy.PrepareOrder(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply company order policy")
+		}
+		order.ApprovalStatus = ""
+	}
+
+	// Number and save order
+	if err := uc.orderService.CreateOrder(ctx, order); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to create order")
 	}
 
@@ -461,7 +554,10 @@ func (uc *checkoutUseCase) CreateCODOrder(ctx context.Context, userID uuid.UUID,
 	if err != nil {
 		return nil, err
 	}
-	return result.(*OrderResponse), nil
+
+	order := result.(*OrderResponse)
+	trackOrderPlaced(ctx, uc.activityTracker, userID, order)
+	return order, nil
 }
 
 // createCODOrderInTransaction handles COD order creation in transaction
@@ -471,6 +567,16 @@ func (uc *checkoutUseCase) createCODOrderInTransaction(ctx context.Context, user
 		return nil, pkgErrors.InvalidInput("This method is only for COD orders")
 	}
 
+	// Pre-fill and re-validate saved addresses
+	if err := resolveCheckoutAddresses(ctx, uc.addressRepo, userID, uc.memberCompanyID(ctx, userID), req.ShippingAddressID, req.BillingAddressID, &req.ShippingAddress, &req.BillingAddress); err != nil {
+		return nil, err
+	}
+	if req.Gift != nil {
+		if err := req.Gift.validate(req.BillingAddress != nil); err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid gift options: " + err.Error())
+		}
+	}
+
 	// Get user's cart
 	cart, err := uc.cartRepo.GetByUserID(ctx, userID)
 	if err != nil {
@@ -491,16 +597,16 @@ func (uc *checkoutUseCase) createCODOrderInTransaction(ctx context.Context, user
 		cart.Items, req.TaxRate, req.ShippingCost, req.DiscountAmount,
 	)
 
-	// Generate order number
-	orderNumber, err := uc.orderService.GenerateUniqueOrderNumber(ctx)
+	// Verified tax exemption certificates take the exempt items' tax off
+	taxExemption, err := applyTaxExemption(ctx, uc.taxExemption, userID, cart.Items, cartItemTaxClasses(cart.Items), req.TaxRate,
+		req.ShippingAddress.Country, req.ShippingAddress.State, &taxAmount, &total)
 	if err != nil {
-		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to generate order number")
+		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply tax exemption")
 	}
 
 	// FIXED: Create order with proper COD status logic
 	order := &entities.Order{
-		ID:             uuid.New(),
-		OrderNumber:    orderNumber,
+		ID:             ids.New(),
 		UserID:         userID,
 		Status:         entities.OrderStatusConfirmed, // FIXED: COD orders should be confirmed immediately
 		PaymentStatus:  entities.PaymentStatusAwaitingPayment,
@@ -518,6 +624,16 @@ func (uc *checkoutUseCase) createCODOrderInTransaction(ctx context.Context, user
 		Version:        1,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
+
+		// Delivery instructions from the shipping address are passed on to the carrier
+		DeliveryInstructions: req.ShippingAddress.DeliveryInstructions,
+	}
+
+	if req.Gift != nil {
+		order.IsGift = true
+		order.GiftRecipientEmail = req.Gift.RecipientEmail
+		order.GiftMessage = req.Gift.Message
+		order.GiftWrap = req.Gift.Wrap
 	}
 
 	// Set addresses (same logic as before)
@@ -551,10 +667,18 @@ func (uc *checkoutUseCase) createCODOrderInTransaction(ctx context.Context, user
 		order.BillingAddress = order.ShippingAddress
 	}
 
+	// Re-validate addresses before the order is created
+	if err := order.ShippingAddress.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput("Invalid shipping address: " + err.Error())
+	}
+	if err := order.BillingAddress.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput("Invalid billing address: " + err.Error())
+	}
+
 	// Create order items
 	for _, cartItem := range cart.Items {
 		orderItem := entities.OrderItem{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			OrderID:     order.ID,
 			ProductID:   cartItem.ProductID,
 			ProductName: cartItem.Product.Name,
@@ -565,17 +689,31 @@ func (uc *checkoutUseCase) createCODOrderInTransaction(ctx context.Context, user
 		}
 		order.Items = append(order.Items, orderItem)
 	}
+	order.ApplyTaxExemption(taxExemption)
 
-	// Validate order
-	if err := order.Validate(); err != nil {
+	// Validate order; its number is assigned when it is saved
+	if err := order.ValidateDetails(); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInvalidInput, "Invalid order data")
 	}
 
-	// Save order
-	if err := uc.orderRepo.Create(ctx, order); err != nil {
+	// Link company orders and flag those over the buyer's spend limit for approval
+	if uc.companyPolicy != nil {
+		if err := uc.companyPolicy.PrepareOrder(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply company order policy")
+		}
+	}
+
+	// Number and save order
+	if err := uc.orderService.CreateOrder(ctx, order); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to create order")
 	}
 
+	if uc.companyPolicy != nil {
+		if err := uc.companyPolicy.RequestApproval(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to request order approval")
+		}
+	}
+
 	// FIXED: For COD, reduce stock immediately since order is confirmed
 	// This ensures consistent stock behavior for all payment methods
 	if err := uc.stockService.ReduceStock(ctx, cart.Items); err != nil {
@@ -646,37 +784,40 @@ func (uc *checkoutUseCase) toCheckoutSessionResponse(session *entities.CheckoutS
 // toOrderResponse converts order entity to response (simplified version)
 func toOrderResponse(order *entities.Order) *OrderResponse {
 	response := &OrderResponse{
-		ID:                order.ID,
-		OrderNumber:       order.OrderNumber,
-		Status:            order.Status,
-		FulfillmentStatus: order.FulfillmentStatus,
-		PaymentStatus:     order.PaymentStatus,
-		PaymentMethod:     order.PaymentMethod,
-		Priority:          order.Priority,
-		Source:            order.Source,
-		CustomerType:      order.CustomerType,
-		Subtotal:          order.Subtotal,
-		TaxAmount:         order.TaxAmount,
-		ShippingAmount:    order.ShippingAmount,
-		DiscountAmount:    order.DiscountAmount,
-		TipAmount:         order.TipAmount,
-		Total:             order.Total,
-		Currency:          order.Currency,
-		CustomerNotes:     order.CustomerNotes,
-		AdminNotes:        order.AdminNotes,
-		IsGift:            order.IsGift,
-		GiftMessage:       order.GiftMessage,
-		GiftWrap:          order.GiftWrap,
-		ItemCount:         len(order.Items),
-		CanBeCancelled:    order.CanBeCancelled(),
-		CanBeRefunded:     order.CanBeRefunded(),
-		CanBeShipped:      order.CanBeShipped(),
-		CanBeDelivered:    order.CanBeDelivered(),
-		IsShipped:         order.IsShipped(),
-		IsDelivered:       order.IsDelivered(),
-		HasTracking:       order.HasTracking(),
-		CreatedAt:         order.CreatedAt,
-		UpdatedAt:         order.UpdatedAt,
+		ID:                 order.ID,
+		OrderNumber:        order.OrderNumber,
+		Status:             order.Status,
+		FulfillmentStatus:  order.FulfillmentStatus,
+		PaymentStatus:      order.PaymentStatus,
+		PaymentMethod:      order.PaymentMethod,
+		Priority:           order.Priority,
+		Source:             order.Source,
+		CustomerType:       order.CustomerType,
+		CompanyID:          order.CompanyID,
+		ApprovalStatus:     order.ApprovalStatus,
+		Subtotal:           order.Subtotal,
+		TaxAmount:          order.TaxAmount,
+		ShippingAmount:     order.ShippingAmount,
+		DiscountAmount:     order.DiscountAmount,
+		TipAmount:          order.TipAmount,
+		Total:              order.Total,
+		Currency:           order.Currency,
+		CustomerNotes:      order.CustomerNotes,
+		AdminNotes:         order.AdminNotes,
+		IsGift:             order.IsGift,
+		GiftMessage:        order.GiftMessage,
+		GiftWrap:           order.GiftWrap,
+		GiftRecipientEmail: order.GiftRecipientEmail,
+		ItemCount:          len(order.Items),
+		CanBeCancelled:     order.CanBeCancelled(),
+		CanBeRefunded:      order.CanBeRefunded(),
+		CanBeShipped:       order.CanBeShipped(),
+		CanBeDelivered:     order.CanBeDelivered(),
+		IsShipped:          order.IsShipped(),
+		IsDelivered:        order.IsDelivered(),
+		HasTracking:        order.HasTracking(),
+		CreatedAt:          order.CreatedAt,
+		UpdatedAt:          order.UpdatedAt,
 	}
 
 	// Convert user
@@ -713,7 +854,7 @@ func toOrderResponse(order *entities.Order) *OrderResponse {
 				Description: item.Product.Description,
 				SKU:         item.Product.SKU,
 				Price:       item.Product.Price,
-				Stock:       item.Product.Stock,
+				Stock:       item.Product.DiscloseStock().Quantity,
 				Status:      item.Product.Status,
 				CreatedAt:   item.Product.CreatedAt,
 				UpdatedAt:   item.Product.UpdatedAt,
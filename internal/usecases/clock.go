@@ -0,0 +1,15 @@
+package usecases
+
+import "time"
+
+// Clock supplies the current time to time-based use cases so sandbox mode can move it forward
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the real wall clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
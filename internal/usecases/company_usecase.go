@@ -0,0 +1,1252 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// CompanyOrderPolicy applies company account rules to orders placed by company members
+type CompanyOrderPolicy interface {
+	// GetMemberCompanyID returns the active company of a user, or nil for individual customers
+	GetMemberCompanyID(ctx context.Context, userID uuid.UUID) *uuid.UUID
+
+	// RequiresApproval checks if an order total exceeds the user's company spend limit
+	RequiresApproval(ctx context.Context, userID uuid.UUID, total float64) (bool, error)
+
+	// PrepareOrder links a new order to the buyer's company and flags it for approval
+	// when it exceeds the buyer's spend limit. It must be called before the order is saved.
+	PrepareOrder(ctx context.Context, order *entities.Order) error
+
+	// RequestApproval creates the approval request for a saved order flagged by PrepareOrder
+	RequestApproval(ctx context.Context, order *entities.Order) error
+
+	// CheckNetTerms returns an error unless the user's company may check out on invoice
+	CheckNetTerms(ctx context.Context, userID uuid.UUID) error
+
+	// IssueOrderInvoice issues a net terms invoice for a saved invoice-paid order.
+	// Orders awaiting approval are invoiced once approved.
+	IssueOrderInvoice(ctx context.Context, order *entities.Order) error
+}
+
+// CompanyNotificationService interface for company invoice notifications
+type CompanyNotificationService interface {
+	NotifyInvoiceIssued(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error
+	NotifyInvoiceOverdue(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error
+}
+
+// CompanyUseCase defines company (B2B) account use cases
+type CompanyUseCase interface {
+	CompanyOrderPolicy
+
+	// Admin operations
+	CreateCompany(ctx context.Context, req CreateCompanyRequest) (*CompanyResponse, error)
+	GetCompany(ctx context.Context, id uuid.UUID) (*CompanyResponse, error)
+	UpdateCompany(ctx context.Context, id uuid.UUID, req UpdateCompanyRequest) (*CompanyResponse, error)
+	ListCompanies(ctx context.Context, req ListCompaniesRequest) (*CompaniesListResponse, error)
+	AddMember(ctx context.Context, companyID uuid.UUID, req AddCompanyMemberRequest) (*CompanyMemberResponse, error)
+	UpdateMember(ctx context.Context, companyID, memberID uuid.UUID, req UpdateCompanyMemberRequest) (*CompanyMemberResponse, error)
+	RemoveMember(ctx context.Context, companyID, memberID uuid.UUID) error
+	GenerateInvoice(ctx context.Context, companyID uuid.UUID, req GenerateCompanyInvoiceRequest) (*CompanyInvoiceResponse, error)
+	GetCompanyInvoices(ctx context.Context, companyID uuid.UUID, page, limit int) (*CompanyInvoicesListResponse, error)
+	GetInvoice(ctx context.Context, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error)
+	MarkInvoicePaid(ctx context.Context, adminID, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error)
+	RecordInvoicePayment(ctx context.Context, adminID, invoiceID uuid.UUID, req RecordInvoicePaymentRequest) (*CompanyInvoiceResponse, error)
+
+	// SendInvoiceReminders reminds company approvers about overdue invoices and returns how many invoices were reminded
+	SendInvoiceReminders(ctx context.Context) (int, error)
+
+	// Member operations
+	GetMyCompany(ctx context.Context, userID uuid.UUID) (*CompanyResponse, error)
+	GetCompanyAddresses(ctx context.Context, userID uuid.UUID) ([]*AddressResponse, error)
+	CreateCompanyAddress(ctx context.Context, userID uuid.UUID, req CreateAddressRequest) (*AddressResponse, error)
+	DeleteCompanyAddress(ctx context.Context, userID, addressID uuid.UUID) error
+	GetOrderApprovals(ctx context.Context, userID uuid.UUID, req ListOrderApprovalsRequest) (*OrderApprovalsListResponse, error)
+	ApproveOrder(ctx context.Context, userID, approvalID uuid.UUID, req DecideOrderApprovalRequest) (*OrderApprovalResponse, error)
+	RejectOrder(ctx context.Context, userID, approvalID uuid.UUID, req DecideOrderApprovalRequest) (*OrderApprovalResponse, error)
+	GetMyCompanyInvoices(ctx context.Context, userID uuid.UUID, page, limit int) (*CompanyInvoicesListResponse, error)
+	GetMyCompanyInvoice(ctx context.Context, userID, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error)
+}
+
+type companyUseCase struct {
+	companyRepo        repositories.CompanyRepository
+	approvalRepo       repositories.OrderApprovalRepository
+	invoiceRepo        repositories.CompanyInvoiceRepository
+	orderRepo          repositories.OrderRepository
+	addressRepo        repositories.AddressRepository
+	userRepo           repositories.UserRepository
+	simpleStockService services.SimpleStockService
+	orderEventService  services.OrderEventService
+
+	notificationService CompanyNotificationService
+}
+
+// NewCompanyUseCase creates a new company use case
+func NewCompanyUseCase(
+	companyRepo repositories.CompanyRepository,
+	approvalRepo repositories.OrderApprovalRepository,
+	invoiceRepo repositories.CompanyInvoiceRepository,
+	orderRepo repositories.OrderRepository,
+	addressRepo repositories.AddressRepository,
+	userRepo repositories.UserRepository,
+	simpleStockService services.SimpleStockService,
+	orderEventService services.OrderEventService,
+	notificationService CompanyNotificationService,
+) CompanyUseCase {
+	return &companyUseCase{
+		companyRepo:        companyRepo,
+		approvalRepo:       approvalRepo,
+		invoiceRepo:        invoiceRepo,
+		orderRepo:          orderRepo,
+		addressRepo:        addressRepo,
+		userRepo:           userRepo,
+		simpleStockService: simpleStockService,
+		orderEventService:  orderEventService,
+
+		notificationService: notificationService,
+	}
+}
+
+// CreateCompanyRequest represents a request to create a company account
+type CreateCompanyRequest struct {
+	Name              string                `json:"name" validate:"required,max=200"`
+	LegalName         string                `json:"legal_name"`
+	TaxID             string                `json:"tax_id"`
+	Email             string                `json:"email" validate:"omitempty,email"`
+	Phone             string                `json:"phone"`
+	PaymentTerms      entities.PaymentTerms `json:"payment_terms" validate:"omitempty,oneof=prepaid net_15 net_30 net_60"`
+	DefaultSpendLimit float64               `json:"default_spend_limit" validate:"min=0"`
+}
+
+// UpdateCompanyRequest represents a request to update a company account
+type UpdateCompanyRequest struct {
+	Name              *string                `json:"name"`
+	LegalName         *string                `json:"legal_name"`
+	TaxID             *string                `json:"tax_id"`
+	Email             *string                `json:"email"`
+	Phone             *string                `json:"phone"`
+	PaymentTerms      *entities.PaymentTerms `json:"payment_terms"`
+	DefaultSpendLimit *float64               `json:"default_spend_limit"`
+	IsActive          *bool                  `json:"is_active"`
+}
+
+// ListCompaniesRequest represents a request to list companies
+type ListCompaniesRequest struct {
+	Search   string `json:"search"`
+	IsActive *bool  `json:"is_active"`
+	Page     int    `json:"page"`
+	Limit    int    `json:"limit"`
+}
+
+// AddCompanyMemberRequest represents a request to add a user to a company
+type AddCompanyMemberRequest struct {
+	UserID     *uuid.UUID                 `json:"user_id"`
+	Email      string                     `json:"email"`
+	Role       entities.CompanyMemberRole `json:"role" validate:"required,oneof=admin approver buyer"`
+	SpendLimit *float64                   `json:"spend_limit" validate:"omitempty,min=0"`
+}
+
+// UpdateCompanyMemberRequest represents a request to update a company member
+type UpdateCompanyMemberRequest struct {
+	Role            *entities.CompanyMemberRole `json:"role"`
+	SpendLimit      *float64                    `json:"spend_limit"`
+	ClearSpendLimit bool                        `json:"clear_spend_limit"` // Fall back to the company default
+	IsActive        *bool                       `json:"is_active"`
+}
+
+// GenerateCompanyInvoiceRequest represents a request to generate a consolidated invoice
+type GenerateCompanyInvoiceRequest struct {
+	PeriodStart time.Time `json:"period_start" validate:"required"`
+	PeriodEnd   time.Time `json:"period_end" validate:"required"`
+}
+
+// RecordInvoicePaymentRequest represents an offline payment received against an invoice
+type RecordInvoicePaymentRequest struct {
+	Amount     float64                `json:"amount" validate:"required,gt=0"`
+	Method     entities.PaymentMethod `json:"method"` // Defaults to bank_transfer
+	Reference  string                 `json:"reference" validate:"max=255"`
+	Notes      string                 `json:"notes" validate:"max=1000"`
+	ReceivedAt *time.Time             `json:"received_at"` // Defaults to now
+}
+
+// ListOrderApprovalsRequest represents a request to list a company's order approvals
+type ListOrderApprovalsRequest struct {
+	Status *entities.OrderApprovalStatus `json:"status"`
+	Page   int                           `json:"page"`
+	Limit  int                           `json:"limit"`
+}
+
+// DecideOrderApprovalRequest represents an approver's decision on an order
+type DecideOrderApprovalRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+// CompanyResponse represents a company account
+type CompanyResponse struct {
+	ID                uuid.UUID                  `json:"id"`
+	Name              string                     `json:"name"`
+	LegalName         string                     `json:"legal_name"`
+	TaxID             string                     `json:"tax_id"`
+	Email             string                     `json:"email"`
+	Phone             string                     `json:"phone"`
+	PaymentTerms      entities.PaymentTerms      `json:"payment_terms"`
+	DefaultSpendLimit float64                    `json:"default_spend_limit"`
+	IsActive          bool                       `json:"is_active"`
+	Members           []*CompanyMemberResponse   `json:"members,omitempty"`
+	MyRole            entities.CompanyMemberRole `json:"my_role,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+}
+
+// CompanyMemberResponse represents a company member
+type CompanyMemberResponse struct {
+	ID                  uuid.UUID                  `json:"id"`
+	CompanyID           uuid.UUID                  `json:"company_id"`
+	UserID              uuid.UUID                  `json:"user_id"`
+	Email               string                     `json:"email,omitempty"`
+	FirstName           string                     `json:"first_name,omitempty"`
+	LastName            string                     `json:"last_name,omitempty"`
+	Role                entities.CompanyMemberRole `json:"role"`
+	SpendLimit          *float64                   `json:"spend_limit"`
+	EffectiveSpendLimit float64                    `json:"effective_spend_limit"`
+	IsActive            bool                       `json:"is_active"`
+	CreatedAt           time.Time                  `json:"created_at"`
+}
+
+// CompaniesListResponse represents a paginated list of companies
+type CompaniesListResponse struct {
+	Companies  []*CompanyResponse `json:"companies"`
+	Pagination *PaginationInfo    `json:"pagination"`
+}
+
+// OrderApprovalResponse represents an order approval request
+type OrderApprovalResponse struct {
+	ID             uuid.UUID                    `json:"id"`
+	OrderID        uuid.UUID                    `json:"order_id"`
+	OrderNumber    string                       `json:"order_number,omitempty"`
+	CompanyID      uuid.UUID                    `json:"company_id"`
+	RequestedBy    uuid.UUID                    `json:"requested_by"`
+	RequesterEmail string                       `json:"requester_email,omitempty"`
+	Status         entities.OrderApprovalStatus `json:"status"`
+	OrderTotal     float64                      `json:"order_total"`
+	SpendLimit     float64                      `json:"spend_limit"`
+	DecidedBy      *uuid.UUID                   `json:"decided_by"`
+	DecidedAt      *time.Time                   `json:"decided_at"`
+	Comment        string                       `json:"comment"`
+	CreatedAt      time.Time                    `json:"created_at"`
+}
+
+// OrderApprovalsListResponse represents a paginated list of order approvals
+type OrderApprovalsListResponse struct {
+	Approvals  []*OrderApprovalResponse `json:"approvals"`
+	Pagination *PaginationInfo          `json:"pagination"`
+}
+
+// CompanyInvoiceResponse represents a consolidated company invoice
+type CompanyInvoiceResponse struct {
+	ID            uuid.UUID                     `json:"id"`
+	InvoiceNumber string                        `json:"invoice_number"`
+	CompanyID     uuid.UUID                     `json:"company_id"`
+	CompanyName   string                        `json:"company_name,omitempty"`
+	PeriodStart   time.Time                     `json:"period_start"`
+	PeriodEnd     time.Time                     `json:"period_end"`
+	Subtotal      float64                       `json:"subtotal"`
+	TaxAmount     float64                       `json:"tax_amount"`
+	ShippingTotal float64                       `json:"shipping_total"`
+	DiscountTotal float64                       `json:"discount_total"`
+	Total         float64                       `json:"total"`
+	Currency      string                        `json:"currency"`
+	PaymentTerms  entities.PaymentTerms         `json:"payment_terms"`
+	Status        entities.CompanyInvoiceStatus `json:"status"`
+	IsOverdue     bool                          `json:"is_overdue"`
+	IssuedAt      time.Time                     `json:"issued_at"`
+	DueDate       time.Time                     `json:"due_date"`
+	PaidAt        *time.Time                    `json:"paid_at"`
+	Items         []entities.CompanyInvoiceItem `json:"items,omitempty"`
+
+	AmountPaid     float64                          `json:"amount_paid"`
+	Balance        float64                          `json:"balance"`
+	Payments       []entities.CompanyInvoicePayment `json:"payments,omitempty"`
+	LastReminderAt *time.Time                       `json:"last_reminder_at,omitempty"`
+	ReminderCount  int                              `json:"reminder_count"`
+}
+
+// CompanyInvoicesListResponse represents a paginated list of company invoices
+type CompanyInvoicesListResponse struct {
+	Invoices   []*CompanyInvoiceResponse `json:"invoices"`
+	Pagination *PaginationInfo           `json:"pagination"`
+}
+
+// CreateCompany creates a new company account
+func (uc *companyUseCase) CreateCompany(ctx context.Context, req CreateCompanyRequest) (*CompanyResponse, error) {
+	company := &entities.Company{
+		ID:                ids.New(),
+		Name:              strings.TrimSpace(req.Name),
+		LegalName:         req.LegalName,
+		TaxID:             req.TaxID,
+		Email:             req.Email,
+		Phone:             req.Phone,
+		PaymentTerms:      req.PaymentTerms,
+		DefaultSpendLimit: req.DefaultSpendLimit,
+		IsActive:          true,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	if company.PaymentTerms == "" {
+		company.PaymentTerms = entities.PaymentTermsPrepaid
+	}
+
+	if err := company.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.companyRepo.Create(ctx, company); err != nil {
+		return nil, fmt.Errorf("failed to create company: %w", err)
+	}
+
+	return uc.toCompanyResponse(company, nil), nil
+}
+
+// GetCompany gets a company with its members
+func (uc *companyUseCase) GetCompany(ctx context.Context, id uuid.UUID) (*CompanyResponse, error) {
+	company, err := uc.companyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.companyRepo.GetMembers(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company members: %w", err)
+	}
+
+	return uc.toCompanyResponse(company, members), nil
+}
+
+// UpdateCompany updates a company account
+func (uc *companyUseCase) UpdateCompany(ctx context.Context, id uuid.UUID, req UpdateCompanyRequest) (*CompanyResponse, error) {
+	company, err := uc.companyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		company.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.LegalName != nil {
+		company.LegalName = *req.LegalName
+	}
+	if req.TaxID != nil {
+		company.TaxID = *req.TaxID
+	}
+	if req.Email != nil {
+		company.Email = *req.Email
+	}
+	if req.Phone != nil {
+		company.Phone = *req.Phone
+	}
+	if req.PaymentTerms != nil {
+		company.PaymentTerms = *req.PaymentTerms
+	}
+	if req.DefaultSpendLimit != nil {
+		company.DefaultSpendLimit = *req.DefaultSpendLimit
+	}
+	if req.IsActive != nil {
+		company.IsActive = *req.IsActive
+	}
+
+	if err := company.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.companyRepo.Update(ctx, company); err != nil {
+		return nil, fmt.Errorf("failed to update company: %w", err)
+	}
+
+	return uc.GetCompany(ctx, id)
+}
+
+// ListCompanies lists company accounts
+func (uc *companyUseCase) ListCompanies(ctx context.Context, req ListCompaniesRequest) (*CompaniesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.CompanyFilters{
+		Search:   req.Search,
+		IsActive: req.IsActive,
+		Limit:    limit,
+		Offset:   (page - 1) * limit,
+	}
+
+	companies, err := uc.companyRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list companies: %w", err)
+	}
+
+	total, err := uc.companyRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count companies: %w", err)
+	}
+
+	responses := make([]*CompanyResponse, len(companies))
+	for i, company := range companies {
+		responses[i] = uc.toCompanyResponse(company, nil)
+	}
+
+	return &CompaniesListResponse{
+		Companies:  responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// AddMember adds a user to a company
+func (uc *companyUseCase) AddMember(ctx context.Context, companyID uuid.UUID, req AddCompanyMemberRequest) (*CompanyMemberResponse, error) {
+	company, err := uc.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Role.IsValid() {
+		return nil, pkgErrors.InvalidInput("Invalid member role")
+	}
+	if req.SpendLimit != nil && *req.SpendLimit < 0 {
+		return nil, pkgErrors.InvalidInput("Spend limit cannot be negative")
+	}
+
+	var user *entities.User
+	switch {
+	case req.UserID != nil:
+		user, err = uc.userRepo.GetByID(ctx, *req.UserID)
+	case req.Email != "":
+		user, err = uc.userRepo.GetByEmail(ctx, req.Email)
+	default:
+		return nil, pkgErrors.InvalidInput("user_id or email is required")
+	}
+	if err != nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	// A user can only belong to one company
+	if existing, err := uc.companyRepo.GetMemberByUserID(ctx, user.ID); err == nil {
+		if existing.CompanyID == companyID {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "User is already a member of this company")
+		}
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "User already belongs to another company")
+	} else if err != entities.ErrCompanyMemberNotFound {
+		return nil, err
+	}
+
+	member := &entities.CompanyMember{
+		ID:         ids.New(),
+		CompanyID:  companyID,
+		UserID:     user.ID,
+		Role:       req.Role,
+		SpendLimit: req.SpendLimit,
+		IsActive:   true,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+
+	if err := uc.companyRepo.AddMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add company member: %w", err)
+	}
+
+	member.User = user
+	return uc.toMemberResponse(member, company), nil
+}
+
+// UpdateMember updates a company member's role, spend limit or status
+func (uc *companyUseCase) UpdateMember(ctx context.Context, companyID, memberID uuid.UUID, req UpdateCompanyMemberRequest) (*CompanyMemberResponse, error) {
+	company, err := uc.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := uc.companyRepo.GetMemberByID(ctx, memberID)
+	if err != nil || member.CompanyID != companyID {
+		return nil, entities.ErrCompanyMemberNotFound
+	}
+
+	if req.Role != nil {
+		if !req.Role.IsValid() {
+			return nil, pkgErrors.InvalidInput("Invalid member role")
+		}
+		member.Role = *req.Role
+	}
+	if req.ClearSpendLimit {
+		member.SpendLimit = nil
+	} else if req.SpendLimit != nil {
+		if *req.SpendLimit < 0 {
+			return nil, pkgErrors.InvalidInput("Spend limit cannot be negative")
+		}
+		member.SpendLimit = req.SpendLimit
+	}
+	if req.IsActive != nil {
+		member.IsActive = *req.IsActive
+	}
+
+	if err := uc.companyRepo.UpdateMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to update company member: %w", err)
+	}
+
+	return uc.toMemberResponse(member, company), nil
+}
+
+// RemoveMember removes a user from a company
+func (uc *companyUseCase) RemoveMember(ctx context.Context, companyID, memberID uuid.UUID) error {
+	member, err := uc.companyRepo.GetMemberByID(ctx, memberID)
+	if err != nil || member.CompanyID != companyID {
+		return entities.ErrCompanyMemberNotFound
+	}
+
+	return uc.companyRepo.RemoveMember(ctx, memberID)
+}
+
+// GenerateInvoice consolidates all uninvoiced company orders in a period into one invoice
+func (uc *companyUseCase) GenerateInvoice(ctx context.Context, companyID uuid.UUID, req GenerateCompanyInvoiceRequest) (*CompanyInvoiceResponse, error) {
+	if !req.PeriodEnd.After(req.PeriodStart) {
+		return nil, pkgErrors.InvalidInput("Period end must be after period start")
+	}
+
+	company, err := uc.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := uc.invoiceRepo.GetUninvoicedOrders(ctx, companyID, req.PeriodStart, req.PeriodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company orders: %w", err)
+	}
+	if len(orders) == 0 {
+		return nil, pkgErrors.InvalidInput("No uninvoiced orders in the selected period")
+	}
+
+	invoice := entities.NewCompanyInvoice(company, generateCompanyInvoiceNumber(), req.PeriodStart, req.PeriodEnd, orders)
+	if err := uc.invoiceRepo.Create(ctx, invoice); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Some orders were invoiced concurrently, please retry")
+		}
+		return nil, fmt.Errorf("failed to create company invoice: %w", err)
+	}
+
+	fmt.Printf("✅ Generated invoice %s for company %s covering %d orders\n", invoice.InvoiceNumber, company.Name, len(orders))
+
+	invoice.Company = company
+	return uc.toInvoiceResponse(invoice), nil
+}
+
+// GetCompanyInvoices lists a company's consolidated invoices
+func (uc *companyUseCase) GetCompanyInvoices(ctx context.Context, companyID uuid.UUID, page, limit int) (*CompanyInvoicesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := uc.invoiceRepo.GetByCompanyID(ctx, companyID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company invoices: %w", err)
+	}
+
+	total, err := uc.invoiceRepo.CountByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count company invoices: %w", err)
+	}
+
+	responses := make([]*CompanyInvoiceResponse, len(invoices))
+	for i, invoice := range invoices {
+		responses[i] = uc.toInvoiceResponse(invoice)
+	}
+
+	return &CompanyInvoicesListResponse{
+		Invoices:   responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// GetInvoice gets a consolidated invoice with its items
+func (uc *companyUseCase) GetInvoice(ctx context.Context, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error) {
+	invoice, err := uc.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toInvoiceResponse(invoice), nil
+}
+
+// MarkInvoicePaid settles the outstanding balance of an invoice with a single offline payment
+func (uc *companyUseCase) MarkInvoicePaid(ctx context.Context, adminID, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error) {
+	invoice, err := uc.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status != entities.CompanyInvoiceStatusIssued {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("Invoice is %s and cannot be marked as paid", invoice.Status))
+	}
+
+	return uc.recordInvoicePayment(ctx, adminID, invoice, RecordInvoicePaymentRequest{
+		Amount: invoice.Balance(),
+		Notes:  "Marked as paid",
+	})
+}
+
+// RecordInvoicePayment records an offline payment against an invoice
+func (uc *companyUseCase) RecordInvoicePayment(ctx context.Context, adminID, invoiceID uuid.UUID, req RecordInvoicePaymentRequest) (*CompanyInvoiceResponse, error) {
+	invoice, err := uc.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.recordInvoicePayment(ctx, adminID, invoice, req)
+}
+
+// SendInvoiceReminders reminds company admins and approvers about overdue invoices
+func (uc *companyUseCase) SendInvoiceReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	invoices, err := uc.invoiceRepo.GetOverdue(ctx, now, 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get overdue invoices: %w", err)
+	}
+
+	reminded := 0
+	for _, invoice := range invoices {
+		if !invoice.NeedsReminder(now) {
+			continue
+		}
+
+		members, err := uc.companyRepo.GetMembers(ctx, invoice.CompanyID)
+		if err != nil {
+			fmt.Printf("❌ Failed to get members for overdue invoice %s: %v\n", invoice.InvoiceNumber, err)
+			continue
+		}
+
+		if uc.notificationService != nil {
+			for _, member := range members {
+				if !member.CanApprove() {
+					continue
+				}
+				if err := uc.notificationService.NotifyInvoiceOverdue(ctx, invoice, member.UserID); err != nil {
+					fmt.Printf("Failed to send overdue invoice reminder: %v\n", err)
+				}
+			}
+		}
+
+		invoice.MarkReminded(now)
+		if err := uc.invoiceRepo.Update(ctx, invoice); err != nil {
+			fmt.Printf("❌ Failed to record reminder for invoice %s: %v\n", invoice.InvoiceNumber, err)
+			continue
+		}
+		reminded++
+	}
+
+	if reminded > 0 {
+		fmt.Printf("✅ Sent overdue reminders for %d invoices\n", reminded)
+	}
+	return reminded, nil
+}
+
+// recordInvoicePayment applies an offline payment and settles the invoiced orders
+func (uc *companyUseCase) recordInvoicePayment(ctx context.Context, adminID uuid.UUID, invoice *entities.CompanyInvoice, req RecordInvoicePaymentRequest) (*CompanyInvoiceResponse, error) {
+	method := req.Method
+	if method == "" {
+		method = entities.PaymentMethodBankTransfer
+	}
+	switch method {
+	case entities.PaymentMethodBankTransfer, entities.PaymentMethodCash,
+		entities.PaymentMethodCreditCard, entities.PaymentMethodDebitCard:
+	default:
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("Unsupported offline payment method: %s", method))
+	}
+
+	receivedAt := time.Now()
+	if req.ReceivedAt != nil {
+		if req.ReceivedAt.After(receivedAt) {
+			return nil, pkgErrors.InvalidInput("Payment received date cannot be in the future")
+		}
+		receivedAt = *req.ReceivedAt
+	}
+
+	payment := &entities.CompanyInvoicePayment{
+		ID:         ids.New(),
+		Amount:     math.Round(req.Amount*100) / 100,
+		Method:     method,
+		Reference:  strings.TrimSpace(req.Reference),
+		Notes:      strings.TrimSpace(req.Notes),
+		ReceivedAt: receivedAt,
+		RecordedBy: adminID,
+	}
+
+	if err := invoice.RecordPayment(payment); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.invoiceRepo.RecordPayment(ctx, invoice, payment); err != nil {
+		return nil, fmt.Errorf("failed to record invoice payment: %w", err)
+	}
+
+	fmt.Printf("✅ Recorded %.2f payment on invoice %s (balance %.2f)\n", payment.Amount, invoice.InvoiceNumber, invoice.Balance())
+
+	return uc.toInvoiceResponse(invoice), nil
+}
+
+// GetMyCompany gets the company the user belongs to
+func (uc *companyUseCase) GetMyCompany(ctx context.Context, userID uuid.UUID) (*CompanyResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*entities.CompanyMember
+	if member.CanManage() {
+		members, err = uc.companyRepo.GetMembers(ctx, member.CompanyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get company members: %w", err)
+		}
+	}
+
+	response := uc.toCompanyResponse(member.Company, members)
+	response.MyRole = member.Role
+	return response, nil
+}
+
+// GetCompanyAddresses gets the shared address book of the user's company
+func (uc *companyUseCase) GetCompanyAddresses(ctx context.Context, userID uuid.UUID) ([]*AddressResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := uc.addressRepo.GetByCompanyID(ctx, member.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company addresses: %w", err)
+	}
+
+	responses := make([]*AddressResponse, len(addresses))
+	for i, address := range addresses {
+		responses[i] = toAddressResponse(address)
+	}
+	return responses, nil
+}
+
+// CreateCompanyAddress adds an address to the company's shared address book
+func (uc *companyUseCase) CreateCompanyAddress(ctx context.Context, userID uuid.UUID, req CreateAddressRequest) (*AddressResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.CanManage() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only company admins can manage shared addresses")
+	}
+
+	companyID := member.CompanyID
+	address := &entities.Address{
+		ID:                   ids.New(),
+		UserID:               userID,
+		CompanyID:            &companyID,
+		Type:                 req.Type,
+		FirstName:            req.FirstName,
+		LastName:             req.LastName,
+		Company:              req.Company,
+		Address1:             req.Address1,
+		Address2:             req.Address2,
+		City:                 req.City,
+		State:                req.State,
+		ZipCode:              req.ZipCode,
+		Country:              req.Country,
+		Phone:                req.Phone,
+		Label:                req.Label,
+		DeliveryInstructions: req.DeliveryInstructions,
+		IsActive:             true,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	if address.Label == "" {
+		address.Label = entities.AddressLabelWork
+	}
+	if address.Company == "" {
+		address.Company = member.Company.Name
+	}
+
+	if err := address.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.addressRepo.Create(ctx, address); err != nil {
+		return nil, fmt.Errorf("failed to create company address: %w", err)
+	}
+
+	return toAddressResponse(address), nil
+}
+
+// DeleteCompanyAddress removes an address from the company's shared address book
+func (uc *companyUseCase) DeleteCompanyAddress(ctx context.Context, userID, addressID uuid.UUID) error {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !member.CanManage() {
+		return pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only company admins can manage shared addresses")
+	}
+
+	address, err := uc.addressRepo.GetByID(ctx, addressID)
+	if err != nil || address.CompanyID == nil || *address.CompanyID != member.CompanyID {
+		return entities.ErrAddressNotFound
+	}
+
+	return uc.addressRepo.Delete(ctx, addressID)
+}
+
+// GetOrderApprovals lists the approval requests of the user's company.
+// Buyers only see their own requests; approvers and admins see all.
+func (uc *companyUseCase) GetOrderApprovals(ctx context.Context, userID uuid.UUID, req ListOrderApprovalsRequest) (*OrderApprovalsListResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestedBy *uuid.UUID
+	if !member.CanApprove() {
+		requestedBy = &userID
+	}
+
+	approvals, err := uc.approvalRepo.GetByCompanyID(ctx, member.CompanyID, req.Status, requestedBy, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order approvals: %w", err)
+	}
+
+	total, err := uc.approvalRepo.CountByCompanyID(ctx, member.CompanyID, req.Status, requestedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count order approvals: %w", err)
+	}
+
+	responses := make([]*OrderApprovalResponse, 0, len(approvals))
+	for _, approval := range approvals {
+		responses = append(responses, uc.toApprovalResponse(approval))
+	}
+
+	return &OrderApprovalsListResponse{
+		Approvals:  responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// ApproveOrder approves a company order so the buyer can proceed with payment
+func (uc *companyUseCase) ApproveOrder(ctx context.Context, userID, approvalID uuid.UUID, req DecideOrderApprovalRequest) (*OrderApprovalResponse, error) {
+	approval, order, err := uc.decide(ctx, userID, approvalID, entities.OrderApprovalStatusApproved, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	order.ApprovalStatus = entities.OrderApprovalStatusApproved
+	if order.PaymentMethod != entities.PaymentMethodInvoice {
+		order.SetPaymentTimeout(24) // Payment window starts once the order is approved
+	}
+	order.UpdatedAt = time.Now()
+
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+	if err := uc.approvalRepo.Update(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to update order approval: %w", err)
+	}
+
+	if err := uc.orderEventService.CreateEvent(ctx, order.ID, entities.OrderEventTypeCustom,
+		"Order approved", approvalComment("Order approved by company approver", req.Comment),
+		nil, &userID, true); err != nil {
+		// Note: Event creation failure is non-critical
+	}
+
+	// Net terms orders are invoiced once approved
+	if err := uc.IssueOrderInvoice(ctx, order); err != nil {
+		fmt.Printf("❌ Failed to issue invoice for approved order %s: %v\n", order.OrderNumber, err)
+	}
+
+	fmt.Printf("✅ Company order %s approved by %s\n", order.OrderNumber, userID)
+	return uc.toApprovalResponse(approval), nil
+}
+
+// RejectOrder rejects a company order and cancels it
+func (uc *companyUseCase) RejectOrder(ctx context.Context, userID, approvalID uuid.UUID, req DecideOrderApprovalRequest) (*OrderApprovalResponse, error) {
+	approval, order, err := uc.decide(ctx, userID, approvalID, entities.OrderApprovalStatusRejected, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	order.ApprovalStatus = entities.OrderApprovalStatusRejected
+	if err := order.TransitionTo(entities.OrderStatusCancelled); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	order.PaymentTimeout = nil
+
+	// Rejected orders were never paid - restore stock as for any unpaid cancellation
+	if !order.IsPaid() {
+		if err := uc.simpleStockService.RestoreStock(ctx, order.Items); err != nil {
+			fmt.Printf("❌ Failed to restore stock for rejected order %s: %v\n", order.OrderNumber, err)
+		}
+	}
+
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+	if err := uc.approvalRepo.Update(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to update order approval: %w", err)
+	}
+
+	if err := uc.orderEventService.CreateCancelledEvent(ctx, order.ID,
+		approvalComment("Order rejected by company approver", req.Comment), &userID); err != nil {
+		// Note: Event creation failure is non-critical
+	}
+
+	fmt.Printf("✅ Company order %s rejected by %s\n", order.OrderNumber, userID)
+	return uc.toApprovalResponse(approval), nil
+}
+
+// GetMyCompanyInvoices lists the invoices of the user's company
+func (uc *companyUseCase) GetMyCompanyInvoices(ctx context.Context, userID uuid.UUID, page, limit int) (*CompanyInvoicesListResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.CanApprove() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only company admins and approvers can view invoices")
+	}
+
+	return uc.GetCompanyInvoices(ctx, member.CompanyID, page, limit)
+}
+
+// GetMyCompanyInvoice gets an invoice of the user's company
+func (uc *companyUseCase) GetMyCompanyInvoice(ctx context.Context, userID, invoiceID uuid.UUID) (*CompanyInvoiceResponse, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.CanApprove() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only company admins and approvers can view invoices")
+	}
+
+	invoice, err := uc.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil || invoice.CompanyID != member.CompanyID {
+		return nil, entities.ErrCompanyInvoiceNotFound
+	}
+
+	return uc.toInvoiceResponse(invoice), nil
+}
+
+// GetMemberCompanyID returns the active company of a user, or nil for individual customers
+func (uc *companyUseCase) GetMemberCompanyID(ctx context.Context, userID uuid.UUID) *uuid.UUID {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil
+	}
+	return &member.CompanyID
+}
+
+// RequiresApproval checks if an order total exceeds the user's company spend limit
+func (uc *companyUseCase) RequiresApproval(ctx context.Context, userID uuid.UUID, total float64) (bool, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		if err == entities.ErrCompanyMemberNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return member.RequiresApproval(member.Company, total), nil
+}
+
+// PrepareOrder links a new order to the buyer's company and flags it for approval when needed
+func (uc *companyUseCase) PrepareOrder(ctx context.Context, order *entities.Order) error {
+	member, err := uc.getActiveMember(ctx, order.UserID)
+	if err != nil {
+		if err == entities.ErrCompanyMemberNotFound {
+			return nil
+		}
+		return err
+	}
+
+	companyID := member.CompanyID
+	order.CompanyID = &companyID
+	order.CustomerType = entities.CustomerTypeCorporate
+
+	// Net terms orders are paid against an invoice, not within the checkout payment window
+	if order.PaymentMethod == entities.PaymentMethodInvoice {
+		order.PaymentTimeout = nil
+	}
+
+	if member.RequiresApproval(member.Company, order.Total) {
+		order.ApprovalStatus = entities.OrderApprovalStatusPending
+		// The payment window starts once the order is approved
+		order.PaymentTimeout = nil
+	}
+
+	return nil
+}
+
+// RequestApproval creates the approval request for a saved order flagged by PrepareOrder
+func (uc *companyUseCase) RequestApproval(ctx context.Context, order *entities.Order) error {
+	if !order.IsAwaitingApproval() || order.CompanyID == nil {
+		return nil
+	}
+
+	member, err := uc.getActiveMember(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+
+	approval := &entities.OrderApproval{
+		ID:          ids.New(),
+		OrderID:     order.ID,
+		CompanyID:   *order.CompanyID,
+		RequestedBy: order.UserID,
+		Status:      entities.OrderApprovalStatusPending,
+		OrderTotal:  order.Total,
+		SpendLimit:  member.EffectiveSpendLimit(member.Company),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := uc.approvalRepo.Create(ctx, approval); err != nil {
+		return fmt.Errorf("failed to create order approval: %w", err)
+	}
+
+	if err := uc.orderEventService.CreateEvent(ctx, order.ID, entities.OrderEventTypeCustom,
+		"Approval requested",
+		fmt.Sprintf("Order total %.2f exceeds the buyer's spend limit of %.2f", approval.OrderTotal, approval.SpendLimit),
+		nil, &order.UserID, true); err != nil {
+		// Note: Event creation failure is non-critical
+	}
+
+	fmt.Printf("✅ Approval requested for company order %s\n", order.OrderNumber)
+	return nil
+}
+
+// CheckNetTerms returns an error unless the user's company may check out on invoice
+func (uc *companyUseCase) CheckNetTerms(ctx context.Context, userID uuid.UUID) error {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		if err == entities.ErrCompanyMemberNotFound {
+			return pkgErrors.New(pkgErrors.ErrCodeForbidden, "Invoice payment is only available to approved company accounts")
+		}
+		return err
+	}
+	if !member.Company.AllowsNetTerms() {
+		return pkgErrors.New(pkgErrors.ErrCodeForbidden, "Your company is not approved for invoice payment terms")
+	}
+	return nil
+}
+
+// IssueOrderInvoice issues a net terms invoice for a saved invoice-paid order
+func (uc *companyUseCase) IssueOrderInvoice(ctx context.Context, order *entities.Order) error {
+	if order.PaymentMethod != entities.PaymentMethodInvoice || order.CompanyID == nil ||
+		order.IsAwaitingApproval() || order.CompanyInvoiceID != nil {
+		return nil
+	}
+
+	company, err := uc.companyRepo.GetByID(ctx, *order.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	invoice := entities.NewCompanyInvoice(company, generateCompanyInvoiceNumber(), order.CreatedAt, order.CreatedAt, []*entities.Order{order})
+	if err := uc.invoiceRepo.Create(ctx, invoice); err != nil {
+		return fmt.Errorf("failed to create order invoice: %w", err)
+	}
+	// Keep the in-memory order in sync so later saves do not unlink the invoice
+	order.CompanyInvoiceID = &invoice.ID
+
+	if err := uc.orderEventService.CreateEvent(ctx, order.ID, entities.OrderEventTypeCustom,
+		"Invoice issued",
+		fmt.Sprintf("Invoice %s issued under %s terms, due %s", invoice.InvoiceNumber, invoice.PaymentTerms, invoice.DueDate.Format("2006-01-02")),
+		nil, nil, true); err != nil {
+		// Note: Event creation failure is non-critical
+	}
+
+	fmt.Printf("✅ Issued invoice %s for net terms order %s\n", invoice.InvoiceNumber, order.OrderNumber)
+
+	if uc.notificationService != nil {
+		go func(invoice entities.CompanyInvoice, userID uuid.UUID) {
+			if err := uc.notificationService.NotifyInvoiceIssued(context.Background(), &invoice, userID); err != nil {
+				fmt.Printf("Failed to send invoice issued notification: %v\n", err)
+			}
+		}(*invoice, order.UserID)
+	}
+
+	return nil
+}
+
+// decide validates that the user can decide on an approval and records the decision
+func (uc *companyUseCase) decide(ctx context.Context, userID, approvalID uuid.UUID, status entities.OrderApprovalStatus, comment string) (*entities.OrderApproval, *entities.Order, error) {
+	member, err := uc.getActiveMember(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !member.CanApprove() {
+		return nil, nil, pkgErrors.New(pkgErrors.ErrCodeForbidden, "Only company admins and approvers can decide on orders")
+	}
+
+	approval, err := uc.approvalRepo.GetByID(ctx, approvalID)
+	if err != nil || approval.CompanyID != member.CompanyID {
+		return nil, nil, entities.ErrOrderApprovalNotFound
+	}
+
+	if err := approval.Decide(status, userID, comment); err != nil {
+		return nil, nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, approval.OrderID)
+	if err != nil {
+		return nil, nil, entities.ErrOrderNotFound
+	}
+	if !order.IsAwaitingApproval() {
+		return nil, nil, pkgErrors.InvalidInput("Order is no longer awaiting approval")
+	}
+
+	return approval, order, nil
+}
+
+// getActiveMember gets the user's membership in an active company
+func (uc *companyUseCase) getActiveMember(ctx context.Context, userID uuid.UUID) (*entities.CompanyMember, error) {
+	member, err := uc.companyRepo.GetMemberByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.IsActive || member.Company == nil || !member.Company.IsActive {
+		return nil, entities.ErrCompanyMemberNotFound
+	}
+	return member, nil
+}
+
+// generateCompanyInvoiceNumber generates a unique consolidated invoice number
+func generateCompanyInvoiceNumber() string {
+	return fmt.Sprintf("CINV-%s-%s", time.Now().Format("20060102"), strings.ToUpper(uuid.New().String()[:8]))
+}
+
+// approvalComment appends the approver's comment to an event description
+func approvalComment(description, comment string) string {
+	if comment == "" {
+		return description
+	}
+	return description + ": " + comment
+}
+
+// toCompanyResponse converts a company entity to response
+func (uc *companyUseCase) toCompanyResponse(company *entities.Company, members []*entities.CompanyMember) *CompanyResponse {
+	response := &CompanyResponse{
+		ID:                company.ID,
+		Name:              company.Name,
+		LegalName:         company.LegalName,
+		TaxID:             company.TaxID,
+		Email:             company.Email,
+		Phone:             company.Phone,
+		PaymentTerms:      company.PaymentTerms,
+		DefaultSpendLimit: company.DefaultSpendLimit,
+		IsActive:          company.IsActive,
+		CreatedAt:         company.CreatedAt,
+		UpdatedAt:         company.UpdatedAt,
+	}
+
+	for _, member := range members {
+		response.Members = append(response.Members, uc.toMemberResponse(member, company))
+	}
+
+	return response
+}
+
+// toMemberResponse converts a company member entity to response
+func (uc *companyUseCase) toMemberResponse(member *entities.CompanyMember, company *entities.Company) *CompanyMemberResponse {
+	response := &CompanyMemberResponse{
+		ID:                  member.ID,
+		CompanyID:           member.CompanyID,
+		UserID:              member.UserID,
+		Role:                member.Role,
+		SpendLimit:          member.SpendLimit,
+		EffectiveSpendLimit: member.EffectiveSpendLimit(company),
+		IsActive:            member.IsActive,
+		CreatedAt:           member.CreatedAt,
+	}
+
+	if member.User != nil {
+		response.Email = member.User.Email
+		response.FirstName = member.User.FirstName
+		response.LastName = member.User.LastName
+	}
+
+	return response
+}
+
+// toApprovalResponse converts an order approval entity to response
+func (uc *companyUseCase) toApprovalResponse(approval *entities.OrderApproval) *OrderApprovalResponse {
+	response := &OrderApprovalResponse{
+		ID:          approval.ID,
+		OrderID:     approval.OrderID,
+		CompanyID:   approval.CompanyID,
+		RequestedBy: approval.RequestedBy,
+		Status:      approval.Status,
+		OrderTotal:  approval.OrderTotal,
+		SpendLimit:  approval.SpendLimit,
+		DecidedBy:   approval.DecidedBy,
+		DecidedAt:   approval.DecidedAt,
+		Comment:     approval.Comment,
+		CreatedAt:   approval.CreatedAt,
+	}
+
+	if approval.Order != nil {
+		response.OrderNumber = approval.Order.OrderNumber
+	}
+	if approval.Requester != nil {
+		response.RequesterEmail = approval.Requester.Email
+	}
+
+	return response
+}
+
+// toInvoiceResponse converts a company invoice entity to response
+func (uc *companyUseCase) toInvoiceResponse(invoice *entities.CompanyInvoice) *CompanyInvoiceResponse {
+	response := &CompanyInvoiceResponse{
+		ID:            invoice.ID,
+		InvoiceNumber: invoice.InvoiceNumber,
+		CompanyID:     invoice.CompanyID,
+		PeriodStart:   invoice.PeriodStart,
+		PeriodEnd:     invoice.PeriodEnd,
+		Subtotal:      invoice.Subtotal,
+		TaxAmount:     invoice.TaxAmount,
+		ShippingTotal: invoice.ShippingTotal,
+		DiscountTotal: invoice.DiscountTotal,
+		Total:         invoice.Total,
+		Currency:      invoice.Currency,
+		PaymentTerms:  invoice.PaymentTerms,
+		Status:        invoice.Status,
+		IsOverdue:     invoice.IsOverdue(),
+		IssuedAt:      invoice.IssuedAt,
+		DueDate:       invoice.DueDate,
+		PaidAt:        invoice.PaidAt,
+		Items:         invoice.Items,
+
+		AmountPaid:     invoice.AmountPaid,
+		Balance:        invoice.Balance(),
+		Payments:       invoice.Payments,
+		LastReminderAt: invoice.LastReminderAt,
+		ReminderCount:  invoice.ReminderCount,
+	}
+
+	if invoice.Company != nil {
+		response.CompanyName = invoice.Company.Name
+	}
+
+	return response
+}
@@ -7,6 +7,7 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"github.com/google/uuid"
 )
 
@@ -160,7 +161,7 @@ func (uc *couponUseCase) CreateCoupon(ctx context.Context, req CreateCouponReque
 
 	// Create coupon entity
 	coupon := &entities.Coupon{
-		ID:                uuid.New(),
+		ID:                ids.New(),
 		Code:              strings.ToUpper(req.Code),
 		Name:              req.Name,
 		Description:       req.Description,
@@ -327,7 +328,7 @@ func (uc *couponUseCase) ApplyCoupon(ctx context.Context, req ApplyCouponRequest
 
 	// Create usage record
 	usage := &entities.CouponUsage{
-		ID:             uuid.New(),
+		ID:             ids.New(),
 		CouponID:       validation.Coupon.ID,
 		UserID:         req.UserID,
 		OrderID:        req.OrderID,
@@ -432,7 +433,7 @@ func (uc *couponUseCase) GetActiveCoupons(ctx context.Context) ([]*CouponRespons
 	// Mock implementation for active coupons
 	coupons := []*CouponResponse{
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Code:        "SAVE20",
 			Type:        entities.CouponTypePercentage,
 			Value:       20.0,
@@ -443,7 +444,7 @@ func (uc *couponUseCase) GetActiveCoupons(ctx context.Context) ([]*CouponRespons
 			UpdatedAt:   time.Now(),
 		},
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Code:        "FREESHIP",
 			Type:        entities.CouponTypeFixed,
 			Value:       10.0,
@@ -463,7 +464,7 @@ func (uc *couponUseCase) GetUserCoupons(ctx context.Context, userID uuid.UUID) (
 	// Mock implementation for user coupons
 	coupons := []*CouponResponse{
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Code:        "WELCOME10",
 			Name:        "Welcome Coupon",
 			Type:        entities.CouponTypePercentage,
@@ -484,7 +485,7 @@ func (uc *couponUseCase) ListCoupons(ctx context.Context, req ListCouponsRequest
 	// Mock implementation for list coupons
 	coupons := []*CouponResponse{
 		{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Code:        "SAMPLE20",
 			Name:        "Sample Coupon",
 			Type:        entities.CouponTypePercentage,
@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// DataResidencyUseCase defines use cases for the data regions customers and their files are
+// kept in, and for which regions' customer data admins may export
+type DataResidencyUseCase interface {
+	GetRegions(ctx context.Context) *DataRegionsResponse
+	// SetUserRegion moves a user to another region. Files they uploaded before stay in the storage
+	// of their previous region.
+	SetUserRegion(ctx context.Context, userID uuid.UUID, req SetUserDataRegionRequest) (*entities.User, error)
+	// SetExportAccess sets the regions whose customer data a staff user may export; an empty
+	// list lets them export every region. Only regions the acting admin may export are granted.
+	SetExportAccess(ctx context.Context, userID uuid.UUID, req SetDataRegionAccessRequest) (*entities.User, error)
+	// TagUntaggedRecords tags users and files that have no region with the default region
+	TagUntaggedRecords(ctx context.Context) (int64, error)
+}
+
+type dataResidencyUseCase struct {
+	userRepo  repositories.UserRepository
+	fileRepo  repositories.FileRepository
+	residency services.DataResidencyService
+}
+
+// NewDataResidencyUseCase creates a new data residency use case
+func NewDataResidencyUseCase(userRepo repositories.UserRepository, fileRepo repositories.FileRepository, residency services.DataResidencyService) DataResidencyUseCase {
+	return &dataResidencyUseCase{
+		userRepo:  userRepo,
+		fileRepo:  fileRepo,
+		residency: residency,
+	}
+}
+
+// DataRegionsResponse lists the configured regions
+type DataRegionsResponse struct {
+	DefaultRegion string   `json:"default_region"`
+	Regions       []string `json:"regions"`
+}
+
+// SetUserDataRegionRequest represents a request to move a user to another region
+type SetUserDataRegionRequest struct {
+	DataRegion string `json:"data_region" validate:"required"`
+}
+
+// SetDataRegionAccessRequest represents the regions a staff user may export
+type SetDataRegionAccessRequest struct {
+	Regions []string `json:"regions"`
+}
+
+// GetRegions returns the configured regions
+func (uc *dataResidencyUseCase) GetRegions(ctx context.Context) *DataRegionsResponse {
+	return &DataRegionsResponse{
+		DefaultRegion: uc.residency.DefaultRegion(),
+		Regions:       uc.residency.Regions(),
+	}
+}
+
+// SetUserRegion sets a user's region
+func (uc *dataResidencyUseCase) SetUserRegion(ctx context.Context, userID uuid.UUID, req SetUserDataRegionRequest) (*entities.User, error) {
+	region := strings.ToLower(strings.TrimSpace(req.DataRegion))
+	if !uc.residency.IsRegion(region) {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("Unknown data region %q", req.DataRegion))
+	}
+	if err := uc.userRepo.SetDataRegion(ctx, userID, region); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+// SetExportAccess sets a staff user's export regions
+func (uc *dataResidencyUseCase) SetExportAccess(ctx context.Context, userID uuid.UUID, req SetDataRegionAccessRequest) (*entities.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != entities.UserRoleAdmin && user.Role != entities.UserRoleModerator {
+		return nil, pkgErrors.InvalidInput("Only admins and moderators have export access")
+	}
+
+	regions := []string{}
+	for _, region := range req.Regions {
+		region = strings.ToLower(strings.TrimSpace(region))
+		if !uc.residency.IsRegion(region) {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("Unknown data region %q", region))
+		}
+		if !containsString(regions, region) {
+			regions = append(regions, region)
+		}
+	}
+
+	// Admins only grant access to regions they may export themselves
+	if err := uc.residency.CanExport(ctx, nilIfEmpty(regions)); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.SetDataRegionAccess(ctx, userID, regions); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+// TagUntaggedRecords tags users created and files uploaded before data regions were set up, or
+// through paths that don't pick a region, such as the admin CLI
+func (uc *dataResidencyUseCase) TagUntaggedRecords(ctx context.Context) (int64, error) {
+	region := uc.residency.DefaultRegion()
+	users, err := uc.userRepo.TagUntaggedDataRegion(ctx, region)
+	if err != nil {
+		return 0, fmt.Errorf("failed to tag users with a data region: %w", err)
+	}
+	files, err := uc.fileRepo.TagUntaggedDataRegion(ctx, region)
+	if err != nil {
+		return users, fmt.Errorf("failed to tag files with a data region: %w", err)
+	}
+	return users + files, nil
+}
+
+// nilIfEmpty returns nil for an empty list, which stands for every region
+func nilIfEmpty(regions []string) []string {
+	if len(regions) == 0 {
+		return nil
+	}
+	return regions
+}
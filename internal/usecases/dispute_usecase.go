@@ -0,0 +1,433 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/internal/infrastructure/payment"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// disputeEvidenceReminderWindow is how long before the evidence deadline admins are reminded
+// about a dispute still awaiting a response
+const disputeEvidenceReminderWindow = 72 * time.Hour
+
+// DisputeUseCase defines use cases for disputes and chargebacks reported by payment providers
+type DisputeUseCase interface {
+	// RecordGatewayDispute creates or updates the dispute a provider webhook reported. Disputes
+	// that were just resolved charge the lost amount back on the payment and order.
+	RecordGatewayDispute(ctx context.Context, gateway string, gatewayDispute *payment.GatewayDispute) (*entities.Dispute, error)
+
+	// Admin operations
+	GetDisputes(ctx context.Context, req GetDisputesRequest) (*DisputesListResponse, error)
+	GetDispute(ctx context.Context, id uuid.UUID) (*entities.Dispute, error)
+	// RecordEvidenceSubmitted records that evidence was submitted to the provider
+	RecordEvidenceSubmitted(ctx context.Context, id uuid.UUID, req RecordDisputeEvidenceRequest) (*entities.Dispute, error)
+	GetDisputeMetrics(ctx context.Context, req DisputeMetricsRequest) (*DisputeMetricsResponse, error)
+
+	// SendEvidenceReminders alerts admins to disputes whose evidence is due soon and returns how
+	// many were reminded about
+	SendEvidenceReminders(ctx context.Context) (int, error)
+}
+
+// DisputeNotificationService interface for alerting admins about disputes
+type DisputeNotificationService interface {
+	NotifyDisputeOpened(ctx context.Context, dispute *entities.Dispute) error
+	NotifyDisputeEvidenceDue(ctx context.Context, dispute *entities.Dispute) error
+	NotifyDisputeResolved(ctx context.Context, dispute *entities.Dispute) error
+}
+
+type disputeUseCase struct {
+	disputeRepo         repositories.DisputeRepository
+	paymentRepo         repositories.PaymentRepository
+	orderRepo           repositories.OrderRepository
+	orderEventService   services.OrderEventService
+	notificationService DisputeNotificationService
+}
+
+// NewDisputeUseCase creates a new dispute use case
+func NewDisputeUseCase(
+	disputeRepo repositories.DisputeRepository,
+	paymentRepo repositories.PaymentRepository,
+	orderRepo repositories.OrderRepository,
+	orderEventService services.OrderEventService,
+	notificationService DisputeNotificationService,
+) DisputeUseCase {
+	return &disputeUseCase{
+		disputeRepo:         disputeRepo,
+		paymentRepo:         paymentRepo,
+		orderRepo:           orderRepo,
+		orderEventService:   orderEventService,
+		notificationService: notificationService,
+	}
+}
+
+// GetDisputesRequest represents filters for listing disputes
+type GetDisputesRequest struct {
+	Status  entities.DisputeStatus `form:"status" json:"status,omitempty"`
+	Gateway string                 `form:"gateway" json:"gateway,omitempty"`
+	OrderID *uuid.UUID             `form:"order_id" json:"order_id,omitempty"`
+	Limit   int                    `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset  int                    `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// DisputesListResponse represents a page of disputes
+type DisputesListResponse struct {
+	Disputes   []*entities.Dispute `json:"disputes"`
+	Total      int64               `json:"total"`
+	Pagination *PaginationInfo     `json:"pagination"`
+}
+
+// RecordDisputeEvidenceRequest represents evidence submitted to the provider for a dispute
+type RecordDisputeEvidenceRequest struct {
+	Notes string `json:"notes" validate:"required,max=5000"`
+}
+
+// DisputeMetricsRequest represents the period dispute metrics are computed for
+type DisputeMetricsRequest struct {
+	DateFrom *time.Time `json:"date_from,omitempty"`
+	DateTo   *time.Time `json:"date_to,omitempty"`
+}
+
+// DisputeMetricsResponse summarizes the disputes opened in a period. The dispute rate is the
+// share of paid payments that were disputed; the win rate is the share of decided disputes won.
+type DisputeMetricsResponse struct {
+	DateFrom time.Time `json:"date_from"`
+	DateTo   time.Time `json:"date_to"`
+	entities.DisputeStats
+	DisputeRate float64 `json:"dispute_rate"`
+	WinRate     float64 `json:"win_rate"`
+}
+
+// RecordGatewayDispute creates or updates a dispute from a provider webhook
+func (uc *disputeUseCase) RecordGatewayDispute(ctx context.Context, gateway string, gatewayDispute *payment.GatewayDispute) (*entities.Dispute, error) {
+	if gatewayDispute == nil || gatewayDispute.ID == "" {
+		return nil, fmt.Errorf("dispute ID is required")
+	}
+
+	dispute, err := uc.disputeRepo.GetByExternalID(ctx, gateway, gatewayDispute.ID)
+	if err == entities.ErrDisputeNotFound {
+		dispute, created, err := uc.openDispute(ctx, gateway, gatewayDispute)
+		if err != nil {
+			return nil, err
+		}
+		if !created || !dispute.IsResolved() {
+			return dispute, nil
+		}
+		// A dispute first seen after it was resolved is charged back right away
+		return dispute, uc.resolveDispute(ctx, dispute)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// ResolvedAt is set once the resolution has been applied, so a resolution whose chargeback
+	// failed is applied again when the provider retries
+	wasResolved := dispute.ResolvedAt != nil
+	applyGatewayDispute(dispute, gatewayDispute)
+	if !wasResolved && dispute.IsResolved() {
+		return dispute, uc.resolveDispute(ctx, dispute)
+	}
+	if err := uc.disputeRepo.Update(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("failed to update dispute: %w", err)
+	}
+	return dispute, nil
+}
+
+// openDispute records a dispute seen for the first time, links it to the disputed payment and
+// alerts admins. It reports false when the dispute had been recorded by a concurrent delivery.
+func (uc *disputeUseCase) openDispute(ctx context.Context, gateway string, gatewayDispute *payment.GatewayDispute) (*entities.Dispute, bool, error) {
+	now := time.Now()
+	dispute := &entities.Dispute{
+		ID:         ids.New(),
+		Gateway:    gateway,
+		ExternalID: gatewayDispute.ID,
+		OpenedAt:   now,
+	}
+	applyGatewayDispute(dispute, gatewayDispute)
+
+	if disputedPayment := uc.findDisputedPayment(ctx, gatewayDispute.TransactionIDs); disputedPayment != nil {
+		dispute.PaymentID = &disputedPayment.ID
+		dispute.OrderID = &disputedPayment.OrderID
+	} else {
+		fmt.Printf("⚠️ No payment found for %s dispute %s (transactions %v)\n", gateway, gatewayDispute.ID, gatewayDispute.TransactionIDs)
+	}
+
+	if err := uc.disputeRepo.Create(ctx, dispute); err != nil {
+		// Providers retry webhooks, so the same dispute may have been recorded concurrently
+		if existing, getErr := uc.disputeRepo.GetByExternalID(ctx, gateway, gatewayDispute.ID); getErr == nil {
+			return existing, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to create dispute: %w", err)
+	}
+
+	if dispute.OrderID != nil && uc.orderEventService != nil {
+		description := fmt.Sprintf("%s dispute of %.2f %s opened: %s", gateway, dispute.Amount, dispute.Currency, dispute.Reason)
+		if err := uc.orderEventService.CreateEvent(ctx, *dispute.OrderID, entities.OrderEventTypeDisputeOpened,
+			"Payment Disputed", description, disputeEventData(dispute), nil, false); err != nil {
+			fmt.Printf("❌ Failed to create dispute opened event: %v\n", err)
+		}
+	}
+	if uc.notificationService != nil {
+		if err := uc.notificationService.NotifyDisputeOpened(ctx, dispute); err != nil {
+			fmt.Printf("❌ Failed to send dispute notification: %v\n", err)
+		}
+	}
+
+	return dispute, true, nil
+}
+
+// resolveDispute applies the outcome of a resolved dispute. A lost dispute charges the disputed
+// amount back on the payment as a refund, and the order is marked refunded once its payment is.
+// The dispute is saved with its chargeback in one transaction, and only by the first delivery
+// that resolves it.
+func (uc *disputeUseCase) resolveDispute(ctx context.Context, dispute *entities.Dispute) error {
+	var chargedBack *entities.Payment
+	var order *entities.Order
+	var amount float64
+	if dispute.Status == entities.DisputeStatusLost && dispute.PaymentID != nil {
+		var err error
+		chargedBack, order, amount, err = uc.chargeBack(ctx, dispute)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	dispute.ResolvedAt = &now
+	if err := uc.disputeRepo.Resolve(ctx, dispute, chargedBack, order); err != nil {
+		dispute.ResolvedAt = nil
+		if err == entities.ErrConflict {
+			// A concurrent delivery resolved the dispute and charged it back
+			return nil
+		}
+		return fmt.Errorf("failed to resolve dispute: %w", err)
+	}
+
+	if chargedBack != nil {
+		if dispute.OrderID != nil && uc.orderEventService != nil {
+			if err := uc.orderEventService.CreateRefundedEvent(ctx, *dispute.OrderID, amount, "Chargeback: "+dispute.Reason, nil); err != nil {
+				fmt.Printf("❌ Failed to create chargeback refund event: %v\n", err)
+			}
+		}
+		fmt.Printf("✅ Charged back %.2f on payment %s for lost dispute %s\n", amount, chargedBack.ID, dispute.ExternalID)
+	}
+
+	if dispute.OrderID != nil && uc.orderEventService != nil {
+		description := fmt.Sprintf("%s dispute %s: %s", dispute.Gateway, dispute.ExternalID, dispute.Status)
+		if err := uc.orderEventService.CreateEvent(ctx, *dispute.OrderID, entities.OrderEventTypeDisputeResolved,
+			"Dispute Resolved", description, disputeEventData(dispute), nil, false); err != nil {
+			fmt.Printf("❌ Failed to create dispute resolved event: %v\n", err)
+		}
+	}
+	if uc.notificationService != nil {
+		if err := uc.notificationService.NotifyDisputeResolved(ctx, dispute); err != nil {
+			fmt.Printf("❌ Failed to send dispute resolved notification: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// chargeBack works out the funds lost to a dispute: the disputed payment with the amount
+// refunded, and its order when the payment is now fully refunded. It returns a nil payment when
+// nothing is left to charge back.
+func (uc *disputeUseCase) chargeBack(ctx context.Context, dispute *entities.Dispute) (*entities.Payment, *entities.Order, float64, error) {
+	disputedPayment, err := uc.paymentRepo.GetByID(ctx, *dispute.PaymentID)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to get disputed payment: %w", err)
+	}
+
+	amount := math.Min(dispute.Amount, disputedPayment.GetRemainingRefundAmount())
+	if amount <= 0 {
+		return nil, nil, 0, nil
+	}
+	if err := disputedPayment.AddRefund(amount); err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to charge back payment: %w", err)
+	}
+	disputedPayment.RefundReason = fmt.Sprintf("Chargeback: %s", dispute.Reason)
+
+	if disputedPayment.Status != entities.PaymentStatusRefunded {
+		return disputedPayment, nil, amount, nil
+	}
+	order, err := uc.orderRepo.GetByID(ctx, disputedPayment.OrderID)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to get order: %w", err)
+	}
+	order.PaymentStatus = entities.PaymentStatusRefunded
+	order.UpdatedAt = time.Now()
+	return disputedPayment, order, amount, nil
+}
+
+// findDisputedPayment finds the payment a provider's transaction IDs refer to
+func (uc *disputeUseCase) findDisputedPayment(ctx context.Context, transactionIDs []string) *entities.Payment {
+	for _, transactionID := range transactionIDs {
+		if found, err := uc.paymentRepo.GetByPaymentIntentID(ctx, transactionID); err == nil {
+			return found
+		}
+		if found, err := uc.paymentRepo.GetByTransactionID(ctx, transactionID); err == nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// GetDisputes lists disputes, most recently opened first
+func (uc *disputeUseCase) GetDisputes(ctx context.Context, req GetDisputesRequest) (*DisputesListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	disputes, total, err := uc.disputeRepo.List(ctx, repositories.DisputeFilters{
+		Status:  req.Status,
+		Gateway: req.Gateway,
+		OrderID: req.OrderID,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DisputesListResponse{
+		Disputes:   disputes,
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetDispute gets a dispute
+func (uc *disputeUseCase) GetDispute(ctx context.Context, id uuid.UUID) (*entities.Dispute, error) {
+	return uc.disputeRepo.GetByID(ctx, id)
+}
+
+// RecordEvidenceSubmitted records evidence submitted to the provider for a dispute that is still
+// open. The dispute stays open until the provider reports its outcome.
+func (uc *disputeUseCase) RecordEvidenceSubmitted(ctx context.Context, id uuid.UUID, req RecordDisputeEvidenceRequest) (*entities.Dispute, error) {
+	req.Notes = strings.TrimSpace(req.Notes)
+	if req.Notes == "" {
+		return nil, pkgErrors.InvalidInput("Evidence notes are required")
+	}
+	if len(req.Notes) > 5000 {
+		return nil, pkgErrors.InvalidInput("Evidence notes must be at most 5000 characters")
+	}
+
+	dispute, err := uc.disputeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dispute.IsResolved() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, fmt.Sprintf("Dispute is %s and no longer accepts evidence", dispute.Status))
+	}
+
+	now := time.Now()
+	dispute.EvidenceSubmittedAt = &now
+	dispute.EvidenceNotes = req.Notes
+	if err := uc.disputeRepo.Update(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("failed to update dispute: %w", err)
+	}
+	return dispute, nil
+}
+
+// GetDisputeMetrics reports dispute and win rates for disputes opened in a period, by default
+// the last 30 days
+func (uc *disputeUseCase) GetDisputeMetrics(ctx context.Context, req DisputeMetricsRequest) (*DisputeMetricsResponse, error) {
+	to := time.Now()
+	if req.DateTo != nil {
+		to = *req.DateTo
+	}
+	from := to.AddDate(0, 0, -30)
+	if req.DateFrom != nil {
+		from = *req.DateFrom
+	}
+	if !from.Before(to) {
+		return nil, pkgErrors.InvalidInput("date_from must be before date_to")
+	}
+
+	stats, err := uc.disputeRepo.GetStats(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute stats: %w", err)
+	}
+
+	response := &DisputeMetricsResponse{
+		DateFrom:     from,
+		DateTo:       to,
+		DisputeStats: *stats,
+	}
+	if stats.PaidPayments > 0 {
+		response.DisputeRate = float64(stats.Opened) / float64(stats.PaidPayments) * 100
+	}
+	if decided := stats.Won + stats.Lost; decided > 0 {
+		response.WinRate = float64(stats.Won) / float64(decided) * 100
+	}
+	return response, nil
+}
+
+// SendEvidenceReminders reminds admins once about each dispute whose evidence is due within
+// the reminder window
+func (uc *disputeUseCase) SendEvidenceReminders(ctx context.Context) (int, error) {
+	disputes, err := uc.disputeRepo.GetEvidenceDue(ctx, time.Now().Add(disputeEvidenceReminderWindow), 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get disputes with evidence due: %w", err)
+	}
+
+	reminded := 0
+	for _, dispute := range disputes {
+		if uc.notificationService != nil {
+			if err := uc.notificationService.NotifyDisputeEvidenceDue(ctx, dispute); err != nil {
+				fmt.Printf("❌ Failed to send evidence reminder for dispute %s: %v\n", dispute.ID, err)
+				continue
+			}
+		}
+		now := time.Now()
+		dispute.EvidenceReminderSentAt = &now
+		if err := uc.disputeRepo.Update(ctx, dispute); err != nil {
+			return reminded, fmt.Errorf("failed to update dispute %s: %w", dispute.ID, err)
+		}
+		reminded++
+	}
+	if reminded > 0 {
+		fmt.Printf("✅ Sent evidence reminders for %d disputes\n", reminded)
+	}
+	return reminded, nil
+}
+
+// applyGatewayDispute copies what the provider reported onto a dispute
+func applyGatewayDispute(dispute *entities.Dispute, gatewayDispute *payment.GatewayDispute) {
+	dispute.Amount = gatewayDispute.Amount
+	dispute.Currency = gatewayDispute.Currency
+	dispute.Reason = gatewayDispute.Reason
+	dispute.Status = entities.DisputeStatus(gatewayDispute.Status)
+	dispute.GatewayStatus = gatewayDispute.GatewayStatus
+	if gatewayDispute.EvidenceDueBy != nil {
+		dispute.EvidenceDueBy = gatewayDispute.EvidenceDueBy
+	}
+	if gatewayDispute.EvidenceSubmitted && dispute.EvidenceSubmittedAt == nil {
+		now := time.Now()
+		dispute.EvidenceSubmittedAt = &now
+	}
+}
+
+func disputeEventData(dispute *entities.Dispute) map[string]interface{} {
+	return map[string]interface{}{
+		"dispute_id":      dispute.ID,
+		"gateway":         dispute.Gateway,
+		"external_id":     dispute.ExternalID,
+		"amount":          dispute.Amount,
+		"currency":        dispute.Currency,
+		"reason":          dispute.Reason,
+		"status":          dispute.Status,
+		"evidence_due_by": dispute.EvidenceDueBy,
+	}
+}
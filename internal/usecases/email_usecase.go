@@ -8,6 +8,7 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -53,8 +54,12 @@ type emailUseCase struct {
 	userRepo         repositories.UserRepository
 	orderRepo        repositories.OrderRepository
 	productRepo      repositories.ProductRepository
+	priceDisplay     PriceDisplaySettings
 }
 
+// errEmailServiceUnavailable is returned when no email delivery backend is configured
+var errEmailServiceUnavailable = fmt.Errorf("email service not configured")
+
 // NewEmailUseCase creates a new email use case
 func NewEmailUseCase(
 	emailService services.EmailService,
@@ -64,6 +69,7 @@ func NewEmailUseCase(
 	userRepo repositories.UserRepository,
 	orderRepo repositories.OrderRepository,
 	productRepo repositories.ProductRepository,
+	priceDisplay PriceDisplaySettings,
 ) EmailUseCase {
 	return &emailUseCase{
 		emailService:     emailService,
@@ -73,6 +79,7 @@ func NewEmailUseCase(
 		userRepo:         userRepo,
 		orderRepo:        orderRepo,
 		productRepo:      productRepo,
+		priceDisplay:     priceDisplay,
 	}
 }
 
@@ -90,7 +97,7 @@ func (uc *emailUseCase) SendWelcomeEmail(ctx context.Context, userID uuid.UUID)
 		"email":      user.Email,
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "welcome", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "welcome", user.Email, user.GetFullName(), data)
 }
 
 // SendOrderConfirmationEmail sends order confirmation email
@@ -113,8 +120,9 @@ func (uc *emailUseCase) SendOrderConfirmationEmail(ctx context.Context, orderID
 		"total":        order.Total,
 		"items_count":  len(order.Items),
 	}
+	newOrderPriceDisplay(order, uc.priceDisplay).addAmounts(data, order)
 
-	return uc.emailService.SendTemplateEmail(ctx, "order_confirmation", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "order_confirmation", user.Email, user.GetFullName(), data)
 }
 
 // SendOrderShippedEmail sends order shipped email
@@ -137,7 +145,7 @@ func (uc *emailUseCase) SendOrderShippedEmail(ctx context.Context, orderID uuid.
 		"tracking_number": order.TrackingNumber,
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "order_shipped", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "order_shipped", user.Email, user.GetFullName(), data)
 }
 
 // SendOrderDeliveredEmail sends order delivered email
@@ -159,7 +167,7 @@ func (uc *emailUseCase) SendOrderDeliveredEmail(ctx context.Context, orderID uui
 		"first_name":   user.FirstName,
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "order_delivered", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "order_delivered", user.Email, user.GetFullName(), data)
 }
 
 // SendOrderCancelledEmail sends order cancelled email
@@ -181,8 +189,9 @@ func (uc *emailUseCase) SendOrderCancelledEmail(ctx context.Context, orderID uui
 		"first_name":   user.FirstName,
 		"total":        order.Total,
 	}
+	newOrderPriceDisplay(order, uc.priceDisplay).addAmounts(data, order)
 
-	return uc.emailService.SendTemplateEmail(ctx, "order_cancelled", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "order_cancelled", user.Email, user.GetFullName(), data)
 }
 
 // SendPasswordResetEmail sends password reset email
@@ -199,7 +208,15 @@ func (uc *emailUseCase) SendPasswordResetEmail(ctx context.Context, userID uuid.
 		"reset_url":   fmt.Sprintf("https://yoursite.com/reset-password?token=%s", resetToken),
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "password_reset", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "password_reset", user.Email, user.GetFullName(), data)
+}
+
+// sendTemplateEmail renders and sends a template email, failing cleanly when no email service is wired
+func (uc *emailUseCase) sendTemplateEmail(ctx context.Context, templateName, to, toName string, data map[string]interface{}) error {
+	if uc.emailService == nil {
+		return errEmailServiceUnavailable
+	}
+	return uc.emailService.SendTemplateEmail(ctx, templateName, to, toName, data)
 }
 
 // SendAbandonedCartEmail sends abandoned cart email
@@ -215,7 +232,7 @@ func (uc *emailUseCase) SendAbandonedCartEmail(ctx context.Context, userID uuid.
 		"cart_url":   "https://yoursite.com/cart",
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "abandoned_cart", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "abandoned_cart", user.Email, user.GetFullName(), data)
 }
 
 // SendReviewRequestEmail sends review request email
@@ -238,7 +255,7 @@ func (uc *emailUseCase) SendReviewRequestEmail(ctx context.Context, userID, orde
 		"review_url":   fmt.Sprintf("https://yoursite.com/orders/%s/review", order.ID),
 	}
 
-	return uc.emailService.SendTemplateEmail(ctx, "review_request", user.Email, user.GetFullName(), data)
+	return uc.sendTemplateEmail(ctx, "review_request", user.Email, user.GetFullName(), data)
 }
 
 // SendLowStockAlert sends low stock alert email to admins
@@ -258,7 +275,7 @@ func (uc *emailUseCase) SendLowStockAlert(ctx context.Context, productID uuid.UU
 
 	// Send to admin email (you should configure this)
 	adminEmail := "admin@yoursite.com"
-	return uc.emailService.SendTemplateEmail(ctx, "low_stock_alert", adminEmail, "Admin", data)
+	return uc.sendTemplateEmail(ctx, "low_stock_alert", adminEmail, "Admin", data)
 }
 
 // Request/Response types
@@ -353,7 +370,7 @@ type EmailResponse struct {
 // CreateTemplate creates an email template
 func (uc *emailUseCase) CreateTemplate(ctx context.Context, req CreateTemplateRequest) (*TemplateResponse, error) {
 	template := &entities.EmailTemplate{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		Name:        req.Name,
 		Type:        req.Type,
 		Subject:     req.Subject,
@@ -446,7 +463,7 @@ func (uc *emailUseCase) UpdateSubscriptions(ctx context.Context, userID uuid.UUI
 	if err != nil {
 		// Create new subscription if not exists
 		subscription = &entities.EmailSubscription{
-			ID:             uuid.New(),
+			ID:             ids.New(),
 			UserID:         userID,
 			Newsletter:     true,
 			Promotions:     true,
@@ -573,6 +590,9 @@ func (uc *emailUseCase) GetEmailHistory(ctx context.Context, userID uuid.UUID, o
 
 // RetryFailedEmails retries failed emails
 func (uc *emailUseCase) RetryFailedEmails(ctx context.Context) error {
+	if uc.emailService == nil {
+		return errEmailServiceUnavailable
+	}
 	return uc.emailService.RetryFailedEmails(ctx)
 }
 
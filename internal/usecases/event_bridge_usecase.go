@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+
+	"github.com/google/uuid"
+)
+
+// EventPublisher publishes domain events to a message broker
+type EventPublisher interface {
+	// Publish publishes an event and returns once the broker has acknowledged it
+	Publish(ctx context.Context, event entities.DomainEventEnvelope) error
+}
+
+// EventBridgeUseCase defines the interface for publishing the event outbox to a message broker
+type EventBridgeUseCase interface {
+	// PublishPendingEvents publishes unpublished outbox events and returns how many were published
+	PublishPendingEvents(ctx context.Context) (int, error)
+	// PurgePublishedEvents deletes events published longer ago than the retention period
+	PurgePublishedEvents(ctx context.Context) (int64, error)
+	GetEventBridgeStatus(ctx context.Context) (*EventBridgeStatusResponse, error)
+}
+
+type eventBridgeUseCase struct {
+	outboxRepo repositories.OutboxEventRepository
+	publisher  EventPublisher
+	broker     string
+	batchSize  int
+	retention  time.Duration
+}
+
+// NewEventBridgeUseCase creates a new event bridge use case
+func NewEventBridgeUseCase(
+	outboxRepo repositories.OutboxEventRepository,
+	publisher EventPublisher,
+	broker string,
+	batchSize int,
+	retentionDays int,
+) EventBridgeUseCase {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &eventBridgeUseCase{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		broker:     broker,
+		batchSize:  batchSize,
+		retention:  time.Duration(retentionDays) * 24 * time.Hour,
+	}
+}
+
+// EventBridgeStatusResponse represents the delivery state of the event outbox
+type EventBridgeStatusResponse struct {
+	Broker          string     `json:"broker"`
+	Pending         int64      `json:"pending"`
+	Failing         int64      `json:"failing"` // Pending events whose last publish attempt failed
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+	LastError       string     `json:"last_error,omitempty"`
+}
+
+// PublishPendingEvents publishes the outbox in the order events occurred. An event that fails
+// stays in the outbox and holds back the later events of the same aggregate until a later run
+// publishes it, so consumers see each aggregate's events in order. Events may be published more
+// than once when marking them published fails; consumers deduplicate on the event ID.
+func (uc *eventBridgeUseCase) PublishPendingEvents(ctx context.Context) (int, error) {
+	published := 0
+	blocked := make(map[uuid.UUID]bool)
+	for {
+		events, err := uc.outboxRepo.GetPending(ctx, uc.batchSize)
+		if err != nil {
+			return published, fmt.Errorf("failed to load pending events: %w", err)
+		}
+		if len(events) == 0 {
+			return published, nil
+		}
+
+		var publishedIDs []uuid.UUID
+		var unavailable error
+		for _, event := range events {
+			if blocked[event.AggregateID] {
+				continue
+			}
+
+			if err := uc.publisher.Publish(ctx, event.Envelope()); err != nil {
+				blocked[event.AggregateID] = true
+				event.MarkFailed(err)
+				if updateErr := uc.outboxRepo.Update(ctx, event); updateErr != nil {
+					fmt.Printf("❌ Failed to record publish failure of event %s: %v\n", event.ID, updateErr)
+				}
+				if resilience.IsUnavailable(err) {
+					// The broker is down; the remaining events would fail the same way
+					unavailable = err
+					break
+				}
+				continue
+			}
+			publishedIDs = append(publishedIDs, event.ID)
+		}
+
+		if err := uc.outboxRepo.MarkPublished(ctx, publishedIDs, time.Now()); err != nil {
+			return published, fmt.Errorf("failed to mark events published: %w", err)
+		}
+		published += len(publishedIDs)
+
+		if unavailable != nil {
+			return published, fmt.Errorf("event broker unavailable: %w", unavailable)
+		}
+		// Stop when the outbox is drained or only held-back events remain
+		if len(events) < uc.batchSize || len(publishedIDs) == 0 {
+			return published, nil
+		}
+	}
+}
+
+// PurgePublishedEvents deletes published events older than the retention period
+func (uc *eventBridgeUseCase) PurgePublishedEvents(ctx context.Context) (int64, error) {
+	return uc.outboxRepo.DeletePublishedBefore(ctx, time.Now().Add(-uc.retention))
+}
+
+// GetEventBridgeStatus gets the delivery state of the event outbox
+func (uc *eventBridgeUseCase) GetEventBridgeStatus(ctx context.Context) (*EventBridgeStatusResponse, error) {
+	stats, err := uc.outboxRepo.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EventBridgeStatusResponse{
+		Broker:          uc.broker,
+		Pending:         stats.Pending,
+		Failing:         stats.Failing,
+		OldestPendingAt: stats.OldestPendingAt,
+		LastError:       stats.LastError,
+	}, nil
+}
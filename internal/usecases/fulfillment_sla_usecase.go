@@ -0,0 +1,412 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+)
+
+// fulfillmentSLABatchSize caps how many orders one monitoring run starts tracking and checks
+const fulfillmentSLABatchSize = 2000
+
+// fulfillmentSLATrackedStatuses are the statuses an order starts being tracked in: confirmed and
+// on its way to the customer
+var fulfillmentSLATrackedStatuses = []entities.OrderStatus{
+	entities.OrderStatusConfirmed,
+	entities.OrderStatusProcessing,
+	entities.OrderStatusReadyToShip,
+	entities.OrderStatusShipped,
+	entities.OrderStatusOutForDelivery,
+}
+
+// fulfillmentSLAClosedStatuses end tracking
+var fulfillmentSLAClosedStatuses = map[entities.OrderStatus]bool{
+	entities.OrderStatusDelivered: true,
+	entities.OrderStatusCancelled: true,
+	entities.OrderStatusRefunded:  true,
+	entities.OrderStatusReturned:  true,
+	entities.OrderStatusExchanged: true,
+}
+
+// defaultStuckAfter is how long orders may stay in a status unless configured otherwise
+var defaultStuckAfter = map[entities.OrderStatus]time.Duration{
+	entities.OrderStatusConfirmed:      24 * time.Hour,
+	entities.OrderStatusProcessing:     48 * time.Hour,
+	entities.OrderStatusReadyToShip:    24 * time.Hour,
+	entities.OrderStatusShipped:        7 * 24 * time.Hour,
+	entities.OrderStatusOutForDelivery: 48 * time.Hour,
+}
+
+// FulfillmentSLAUseCase defines use cases for watching that orders ship within the fulfillment
+// SLA and don't stall on the way
+type FulfillmentSLAUseCase interface {
+	// MonitorOrders starts tracking newly confirmed orders, updates where every tracked order
+	// stands and alerts admins to orders at risk of shipping late, late or stuck in a status,
+	// returning how many orders they were alerted to
+	MonitorOrders(ctx context.Context) (int, error)
+	// GetSummary gets the on-time shipping rate and open order counts for the admin dashboard
+	GetSummary(ctx context.Context, req GetFulfillmentSLASummaryRequest) (*FulfillmentSLASummaryResponse, error)
+	// GetOrders lists tracked orders, by default those still open
+	GetOrders(ctx context.Context, req GetFulfillmentSLAOrdersRequest) (*FulfillmentSLAOrdersResponse, error)
+}
+
+// FulfillmentSLANotificationService interface for alerting admins about late orders
+type FulfillmentSLANotificationService interface {
+	NotifyFulfillmentSLAAlert(ctx context.Context, alert entities.FulfillmentSLAAlert, slas []*entities.OrderFulfillmentSLA) error
+}
+
+// FulfillmentSLASettings configures the fulfillment SLA
+type FulfillmentSLASettings struct {
+	// BusinessDays is how many business days after the day it was placed an order must ship
+	BusinessDays int
+	// Warning is how long before its ship-by time an unshipped order is at risk
+	Warning time.Duration
+	// Calendar tells business days from days off
+	Calendar entities.BusinessCalendar
+	// StuckAfter is how long an order may stay in a status before admins are alerted; statuses
+	// without a limit are never escalated
+	StuckAfter map[entities.OrderStatus]time.Duration
+}
+
+type fulfillmentSLAUseCase struct {
+	slaRepo             repositories.OrderSLARepository
+	notificationService FulfillmentSLANotificationService
+	settings            FulfillmentSLASettings
+	clock               Clock
+}
+
+// NewFulfillmentSLAUseCase creates a new fulfillment SLA use case
+func NewFulfillmentSLAUseCase(
+	slaRepo repositories.OrderSLARepository,
+	notificationService FulfillmentSLANotificationService,
+	settings FulfillmentSLASettings,
+	clock Clock,
+) FulfillmentSLAUseCase {
+	if settings.BusinessDays < 0 {
+		settings.BusinessDays = 0
+	}
+	if settings.Warning <= 0 {
+		settings.Warning = 12 * time.Hour
+	}
+	if len(settings.StuckAfter) == 0 {
+		settings.StuckAfter = defaultStuckAfter
+	}
+	if settings.Calendar.Location == nil {
+		settings.Calendar.Location = time.UTC
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &fulfillmentSLAUseCase{
+		slaRepo:             slaRepo,
+		notificationService: notificationService,
+		settings:            settings,
+		clock:               clock,
+	}
+}
+
+// GetFulfillmentSLASummaryRequest represents the period of the dashboard's on-time shipping rate
+type GetFulfillmentSLASummaryRequest struct {
+	// Days counts back from today, today included
+	Days int `form:"days" json:"days" validate:"min=1,max=365"`
+}
+
+// FulfillmentSLASummaryResponse represents the fulfillment SLA dashboard widgets
+type FulfillmentSLASummaryResponse struct {
+	BusinessDays  int                                `json:"business_days"`
+	WarningHours  float64                            `json:"warning_hours"`
+	Open          *repositories.FulfillmentSLACounts `json:"open"`
+	DateFrom      time.Time                          `json:"date_from"`
+	DateTo        time.Time                          `json:"date_to"`
+	Shipped       int64                              `json:"shipped"`
+	ShippedOnTime int64                              `json:"shipped_on_time"`
+	OnTimeRate    float64                            `json:"on_time_rate"` // Percentage of the orders shipped in the period that shipped in time
+	Daily         []*FulfillmentSLADayResponse       `json:"daily"`
+}
+
+// FulfillmentSLADayResponse represents the orders shipped on one day
+type FulfillmentSLADayResponse struct {
+	Date       string  `json:"date"`
+	Shipped    int64   `json:"shipped"`
+	OnTime     int64   `json:"on_time"`
+	OnTimeRate float64 `json:"on_time_rate"`
+}
+
+// GetFulfillmentSLAOrdersRequest represents filters for listing tracked orders
+type GetFulfillmentSLAOrdersRequest struct {
+	State entities.FulfillmentSLAState `form:"state" json:"state,omitempty"`
+	// Stuck lists open orders admins were alerted are stuck in their status
+	Stuck bool `form:"stuck" json:"stuck,omitempty"`
+	// All includes orders no longer tracked
+	All    bool `form:"all" json:"all,omitempty"`
+	Limit  int  `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset int  `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// FulfillmentSLAOrderResponse represents a tracked order
+type FulfillmentSLAOrderResponse struct {
+	*entities.OrderFulfillmentSLA
+	OrderNumber   string  `json:"order_number"`
+	Stuck         bool    `json:"stuck"`
+	HoursInStatus float64 `json:"hours_in_status"` // Hours the order has been in its current status
+}
+
+// FulfillmentSLAOrdersResponse represents a page of tracked orders
+type FulfillmentSLAOrdersResponse struct {
+	Orders     []*FulfillmentSLAOrderResponse `json:"orders"`
+	Total      int64                          `json:"total"`
+	Pagination *PaginationInfo                `json:"pagination"`
+}
+
+// MonitorOrders brings every tracked order's state up to date and alerts admins once per order
+// as it becomes at risk, late, or stuck in a status. Alerts of a kind are sent together.
+func (uc *fulfillmentSLAUseCase) MonitorOrders(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+
+	orders, err := uc.slaRepo.GetUntrackedOrders(ctx, fulfillmentSLATrackedStatuses, fulfillmentSLABatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get untracked orders: %w", err)
+	}
+	for _, order := range orders {
+		sla := &entities.OrderFulfillmentSLA{
+			OrderID:     order.ID,
+			State:       entities.FulfillmentSLAStateOnTrack,
+			ShipBy:      uc.settings.Calendar.ShipBy(order.CreatedAt, uc.settings.BusinessDays),
+			OrderStatus: order.Status,
+			StatusSince: orderStatusSince(order),
+		}
+		if err := uc.slaRepo.Create(ctx, sla); err != nil {
+			return 0, fmt.Errorf("failed to track order %s: %w", order.OrderNumber, err)
+		}
+	}
+
+	slas, err := uc.slaRepo.GetOpen(ctx, fulfillmentSLABatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tracked orders: %w", err)
+	}
+	alerts := make(map[entities.FulfillmentSLAAlert][]*entities.OrderFulfillmentSLA)
+	for _, sla := range slas {
+		changed, alert := uc.check(sla, now)
+		if !changed {
+			continue
+		}
+		if err := uc.slaRepo.Update(ctx, sla); err != nil {
+			return 0, fmt.Errorf("failed to update SLA of order %s: %w", sla.OrderID, err)
+		}
+		if alert != "" {
+			alerts[alert] = append(alerts[alert], sla)
+		}
+	}
+
+	alerted := 0
+	for _, alert := range []entities.FulfillmentSLAAlert{
+		entities.FulfillmentSLAAlertBreached,
+		entities.FulfillmentSLAAlertStuck,
+		entities.FulfillmentSLAAlertAtRisk,
+	} {
+		if len(alerts[alert]) == 0 || uc.notificationService == nil {
+			continue
+		}
+		if err := uc.notificationService.NotifyFulfillmentSLAAlert(ctx, alert, alerts[alert]); err != nil {
+			fmt.Printf("❌ Failed to alert admins to %d %s orders: %v\n", len(alerts[alert]), alert, err)
+			continue
+		}
+		alerted += len(alerts[alert])
+	}
+	return alerted, nil
+}
+
+// check updates where a tracked order stands at now, and reports whether anything changed and
+// what admins should be alerted to. An order is alerted to at most once per run; a late order
+// that also stalled is reported as late.
+func (uc *fulfillmentSLAUseCase) check(sla *entities.OrderFulfillmentSLA, now time.Time) (bool, entities.FulfillmentSLAAlert) {
+	order := sla.Order
+	if order == nil {
+		// The order was deleted
+		sla.State = entities.FulfillmentSLAStateCancelled
+		sla.ClosedAt = &now
+		return true, ""
+	}
+
+	changed := false
+	var alert entities.FulfillmentSLAAlert
+	if order.Status != sla.OrderStatus {
+		sla.OrderStatus = order.Status
+		sla.StatusSince = now
+		sla.EscalatedAt = nil
+		changed = true
+	}
+
+	previous := sla.State
+	if !previous.IsShipped() && previous != entities.FulfillmentSLAStateCancelled {
+		switch {
+		case order.ShippedAt != nil || order.IsShipped():
+			shippedAt := now
+			if order.ShippedAt != nil {
+				shippedAt = *order.ShippedAt
+			}
+			sla.ShippedAt = &shippedAt
+			sla.State = entities.FulfillmentSLAStateMet
+			if shippedAt.After(sla.ShipBy) {
+				sla.State = entities.FulfillmentSLAStateMissed
+			}
+		case fulfillmentSLAClosedStatuses[order.Status]:
+			sla.State = entities.FulfillmentSLAStateCancelled
+		case !now.Before(sla.ShipBy):
+			sla.State = entities.FulfillmentSLAStateBreached
+			if sla.BreachedAt == nil {
+				sla.BreachedAt = &now
+				alert = entities.FulfillmentSLAAlertBreached
+			}
+		case !now.Before(sla.ShipBy.Add(-uc.settings.Warning)):
+			sla.State = entities.FulfillmentSLAStateAtRisk
+			if previous == entities.FulfillmentSLAStateOnTrack {
+				alert = entities.FulfillmentSLAAlertAtRisk
+			}
+		default:
+			sla.State = entities.FulfillmentSLAStateOnTrack
+		}
+		changed = changed || sla.State != previous
+	}
+
+	if fulfillmentSLAClosedStatuses[order.Status] || order.IsArchived {
+		sla.ClosedAt = &now
+		return true, ""
+	}
+
+	if limit, ok := uc.settings.StuckAfter[order.Status]; ok && sla.EscalatedAt == nil && now.Sub(sla.StatusSince) >= limit {
+		sla.EscalatedAt = &now
+		changed = true
+		if alert == "" {
+			alert = entities.FulfillmentSLAAlertStuck
+		}
+	}
+	return changed, alert
+}
+
+// orderStatusSince is the best known time an order entered its status, for orders that weren't
+// watched changing to it
+func orderStatusSince(order *entities.Order) time.Time {
+	switch {
+	case order.Status == entities.OrderStatusProcessing && order.ProcessedAt != nil:
+		return *order.ProcessedAt
+	case order.Status == entities.OrderStatusReadyToShip && order.PackedAt != nil:
+		return *order.PackedAt
+	case order.Status == entities.OrderStatusShipped && order.ShippedAt != nil:
+		return *order.ShippedAt
+	default:
+		return order.UpdatedAt
+	}
+}
+
+// GetSummary gets the fulfillment SLA dashboard widgets
+func (uc *fulfillmentSLAUseCase) GetSummary(ctx context.Context, req GetFulfillmentSLASummaryRequest) (*FulfillmentSLASummaryResponse, error) {
+	if req.Days <= 0 {
+		req.Days = 30
+	}
+	if req.Days > 365 {
+		return nil, pkgErrors.InvalidInput("days must be at most 365")
+	}
+
+	loc := uc.settings.Calendar.Location
+	now := uc.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	from := today.AddDate(0, 0, 1-req.Days)
+	to := today.AddDate(0, 0, 1)
+
+	counts, err := uc.slaRepo.CountOpen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	days, err := uc.slaRepo.GetDailyShipped(ctx, from, to, loc.String())
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]*repositories.FulfillmentSLADay, len(days))
+	for _, day := range days {
+		byDate[day.Date] = day
+	}
+
+	response := &FulfillmentSLASummaryResponse{
+		BusinessDays: uc.settings.BusinessDays,
+		WarningHours: uc.settings.Warning.Hours(),
+		Open:         counts,
+		DateFrom:     from,
+		DateTo:       to,
+		Daily:        make([]*FulfillmentSLADayResponse, 0, req.Days),
+	}
+	// Every day is listed, with zeros when nothing shipped
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		entry := &FulfillmentSLADayResponse{Date: date}
+		if counted, ok := byDate[date]; ok {
+			entry.Shipped = counted.Shipped
+			entry.OnTime = counted.OnTime
+			entry.OnTimeRate = onTimeRate(counted.OnTime, counted.Shipped)
+		}
+		response.Daily = append(response.Daily, entry)
+		response.Shipped += entry.Shipped
+		response.ShippedOnTime += entry.OnTime
+	}
+	response.OnTimeRate = onTimeRate(response.ShippedOnTime, response.Shipped)
+	return response, nil
+}
+
+// onTimeRate is the percentage of shipped orders that shipped in time, to one decimal; 100 when
+// nothing shipped
+func onTimeRate(onTime, shipped int64) float64 {
+	if shipped == 0 {
+		return 100
+	}
+	return math.Round(float64(onTime)/float64(shipped)*1000) / 10
+}
+
+// GetOrders lists tracked orders
+func (uc *fulfillmentSLAUseCase) GetOrders(ctx context.Context, req GetFulfillmentSLAOrdersRequest) (*FulfillmentSLAOrdersResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+	if req.State != "" && !req.State.IsValid() {
+		return nil, pkgErrors.InvalidInput("state must be on_track, at_risk, breached, met, missed or cancelled")
+	}
+
+	slas, total, err := uc.slaRepo.List(ctx, repositories.FulfillmentSLAFilters{
+		State:  req.State,
+		Stuck:  req.Stuck,
+		Open:   !req.All || req.Stuck,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	response := &FulfillmentSLAOrdersResponse{
+		Orders:     make([]*FulfillmentSLAOrderResponse, len(slas)),
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}
+	for i, sla := range slas {
+		item := &FulfillmentSLAOrderResponse{
+			OrderFulfillmentSLA: sla,
+			Stuck:               sla.IsOpen() && sla.EscalatedAt != nil,
+		}
+		if sla.Order != nil {
+			item.OrderNumber = sla.Order.OrderNumber
+		}
+		if sla.IsOpen() {
+			item.HoursInStatus = math.Round(now.Sub(sla.StatusSince).Hours()*10) / 10
+		}
+		response.Orders[i] = item
+	}
+	return response, nil
+}
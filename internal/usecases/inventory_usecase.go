@@ -7,6 +7,8 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -38,6 +40,11 @@ type InventoryUseCase interface {
 	// Reporting
 	GetMovementReport(ctx context.Context, req MovementReportRequest) (*MovementReportResponse, error)
 	GetLowStockItems(ctx context.Context, req GetLowStockItemsRequest) (*LowStockItemsResponse, error)
+
+	// Point-in-time stock
+	CaptureInventorySnapshot(ctx context.Context) (*InventorySnapshotResponse, error)
+	GetStockAsOf(ctx context.Context, req GetStockAsOfRequest) (*StockAsOfResponse, error)
+	GetStockDeltas(ctx context.Context, req GetStockDeltasRequest) (*StockDeltasResponse, error)
 }
 
 // InventoryNotificationService interface for inventory notifications
@@ -49,6 +56,7 @@ type inventoryUseCase struct {
 	inventoryRepo       repositories.InventoryRepository
 	productRepo         repositories.ProductRepository
 	warehouseRepo       repositories.WarehouseRepository
+	snapshotRepo        repositories.InventorySnapshotRepository
 	notificationService InventoryNotificationService
 }
 
@@ -57,12 +65,14 @@ func NewInventoryUseCase(
 	inventoryRepo repositories.InventoryRepository,
 	productRepo repositories.ProductRepository,
 	warehouseRepo repositories.WarehouseRepository,
+	snapshotRepo repositories.InventorySnapshotRepository,
 	notificationService InventoryNotificationService,
 ) InventoryUseCase {
 	return &inventoryUseCase{
 		inventoryRepo:       inventoryRepo,
 		productRepo:         productRepo,
 		warehouseRepo:       warehouseRepo,
+		snapshotRepo:        snapshotRepo,
 		notificationService: notificationService,
 	}
 }
@@ -105,7 +115,7 @@ func (uc *inventoryUseCase) RecordMovement(ctx context.Context, req RecordMoveme
 
 	// Create movement record
 	movement := &entities.InventoryMovement{
-		ID:             uuid.New(),
+		ID:             ids.New(),
 		InventoryID:    inventory.ID,
 		Type:           entities.InventoryMovementType(req.Type),
 		Reason:         entities.InventoryMovementReason(req.Reason),
@@ -195,7 +205,7 @@ func (uc *inventoryUseCase) ReserveStock(ctx context.Context, productID, warehou
 		ReferenceType: &[]string{"order"}[0],
 		ReferenceID:   &orderID,
 		Notes:         fmt.Sprintf("Reserved for order %s", orderID.String()),
-		CreatedBy:     uuid.New(), // Should be system user
+		CreatedBy:     movementCreator(ctx),
 	}
 
 	_, err = uc.RecordMovement(ctx, req)
@@ -224,7 +234,7 @@ func (uc *inventoryUseCase) ReleaseReservation(ctx context.Context, productID, w
 		ReferenceType: &[]string{"order"}[0],
 		ReferenceID:   &orderID,
 		Notes:         fmt.Sprintf("Released from order %s", orderID.String()),
-		CreatedBy:     uuid.New(), // Should be system user
+		CreatedBy:     movementCreator(ctx),
 	}
 
 	_, err = uc.RecordMovement(ctx, req)
@@ -299,12 +309,12 @@ func (uc *inventoryUseCase) GetMovementReport(ctx context.Context, req MovementR
 			{
 				Date: time.Now().AddDate(0, 0, -1),
 				Product: &ProductResponse{
-					ID:   uuid.New(),
+					ID:   ids.New(),
 					Name: "iPhone 15",
 					SKU:  "IPHONE15-001",
 				},
 				Warehouse: &WarehouseResponse{
-					ID:   uuid.New(),
+					ID:   ids.New(),
 					Name: "Main Warehouse",
 					Code: "WH001",
 				},
@@ -540,7 +550,7 @@ func (uc *inventoryUseCase) CheckAndCreateAlerts(ctx context.Context, inventoryI
 	// Check for low stock
 	if inventory.IsLowStock() && !inventory.IsOutOfStock() {
 		alert := &entities.StockAlert{
-			ID:              uuid.New(),
+			ID:              ids.New(),
 			InventoryID:     inventoryID,
 			Type:            entities.StockAlertTypeLowStock,
 			Status:          entities.StockAlertStatusActive,
@@ -568,7 +578,7 @@ func (uc *inventoryUseCase) CheckAndCreateAlerts(ctx context.Context, inventoryI
 	// Check for out of stock
 	if inventory.IsOutOfStock() {
 		alert := &entities.StockAlert{
-			ID:              uuid.New(),
+			ID:              ids.New(),
 			InventoryID:     inventoryID,
 			Type:            entities.StockAlertTypeOutStock,
 			Status:          entities.StockAlertStatusActive,
@@ -585,7 +595,7 @@ func (uc *inventoryUseCase) CheckAndCreateAlerts(ctx context.Context, inventoryI
 	// Check for over stock
 	if inventory.IsOverStock() {
 		alert := &entities.StockAlert{
-			ID:              uuid.New(),
+			ID:              ids.New(),
 			InventoryID:     inventoryID,
 			Type:            entities.StockAlertTypeOverStock,
 			Status:          entities.StockAlertStatusActive,
@@ -745,7 +755,7 @@ func (uc *inventoryUseCase) TransferStock(ctx context.Context, req TransferStock
 	if err != nil {
 		// If inventory doesn't exist for destination warehouse, create it
 		toInventory = &entities.Inventory{
-			ID:                uuid.New(),
+			ID:                ids.New(),
 			ProductID:         req.ProductID,
 			WarehouseID:       req.ToWarehouseID,
 			QuantityOnHand:    0,
@@ -882,3 +892,115 @@ func (uc *inventoryUseCase) ResolveAlert(ctx context.Context, alertID uuid.UUID,
 
 	return nil
 }
+
+// CaptureInventorySnapshot snapshots current stock for today, replacing any snapshot already taken today
+func (uc *inventoryUseCase) CaptureInventorySnapshot(ctx context.Context) (*InventorySnapshotResponse, error) {
+	day := entities.SnapshotDay(time.Now())
+
+	captured, err := uc.snapshotRepo.Capture(ctx, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture inventory snapshot: %w", err)
+	}
+
+	return &InventorySnapshotResponse{
+		SnapshotDate: day,
+		Captured:     captured,
+	}, nil
+}
+
+// GetStockAsOf gets stock levels from the latest snapshot on or before the requested date
+func (uc *inventoryUseCase) GetStockAsOf(ctx context.Context, req GetStockAsOfRequest) (*StockAsOfResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := uc.findSnapshotDay(ctx, req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.snapshotRepo.GetStockOnDay(ctx, day, repositories.InventorySnapshotFilters{
+		WarehouseID: req.WarehouseID,
+		ProductID:   req.ProductID,
+		Limit:       limit,
+		Offset:      (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock levels: %w", err)
+	}
+
+	return &StockAsOfResponse{
+		AsOf:          entities.SnapshotDay(req.Date),
+		SnapshotDate:  day,
+		TotalQuantity: report.TotalQuantity,
+		TotalValue:    report.TotalValue,
+		Items:         report.Items,
+		Pagination:    NewPaginationInfo(page, limit, report.TotalItems),
+	}, nil
+}
+
+// GetStockDeltas gets the change in stock levels between the snapshots as of two dates
+func (uc *inventoryUseCase) GetStockDeltas(ctx context.Context, req GetStockDeltasRequest) (*StockDeltasResponse, error) {
+	if req.From.After(req.To) {
+		return nil, pkgErrors.InvalidInput("from date must not be after to date")
+	}
+
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDay, err := uc.findSnapshotDay(ctx, req.From)
+	if err != nil {
+		return nil, err
+	}
+	toDay, err := uc.findSnapshotDay(ctx, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.snapshotRepo.GetStockDeltas(ctx, fromDay, toDay, repositories.InventorySnapshotFilters{
+		WarehouseID: req.WarehouseID,
+		ProductID:   req.ProductID,
+		ChangedOnly: req.ChangedOnly,
+		Limit:       limit,
+		Offset:      (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock deltas: %w", err)
+	}
+
+	return &StockDeltasResponse{
+		From:             entities.SnapshotDay(req.From),
+		To:               entities.SnapshotDay(req.To),
+		FromSnapshotDate: fromDay,
+		ToSnapshotDate:   toDay,
+		QuantityChange:   report.QuantityChange,
+		ValueChange:      report.ValueChange,
+		Items:            report.Items,
+		Pagination:       NewPaginationInfo(page, limit, report.TotalItems),
+	}, nil
+}
+
+// findSnapshotDay finds the latest snapshot day on or before the given date
+func (uc *inventoryUseCase) findSnapshotDay(ctx context.Context, date time.Time) (time.Time, error) {
+	day, err := uc.snapshotRepo.GetLatestDay(ctx, entities.SnapshotDay(date))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to find inventory snapshot: %w", err)
+	}
+	if day == nil {
+		return time.Time{}, pkgErrors.New(pkgErrors.ErrCodeNotFound,
+			fmt.Sprintf("No inventory snapshot on or before %s", date.Format("2006-01-02")))
+	}
+	return *day, nil
+}
+
+// movementCreator returns the user a stock movement is recorded for. Reservations made outside a
+// request, e.g. by scheduled jobs, have no user, so they get a placeholder ID as before.
+func movementCreator(ctx context.Context) uuid.UUID {
+	if actor := entities.ActorFromContext(ctx); actor.IsAuthenticated() {
+		return actor.ID()
+	}
+	return uuid.New()
+}
@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
+
+	"github.com/google/uuid"
+)
+
+// JWTKeyActivationDelay is how long a new key is published before it signs tokens, so every
+// server has loaded it and services caching the JWKS have fetched it by then
+const JWTKeyActivationDelay = 10 * time.Minute
+
+// JWTKeyUseCase defines use cases for the rotating keys access tokens are signed with
+type JWTKeyUseCase interface {
+	// LoadKeys loads the stored keys into the key set, creating the first key when rotation is
+	// enabled and none is stored yet
+	LoadKeys(ctx context.Context) error
+	// RotateDueKeys creates the next key when the newest one is older than the rotation interval
+	// or uses another algorithm, then reloads the keys. It reports whether a key was created.
+	RotateDueKeys(ctx context.Context) (bool, error)
+	// RotateKeys creates the next key right away, e.g. when a key may have leaked
+	RotateKeys(ctx context.Context) ([]jwtkeys.KeyInfo, error)
+	ListKeys(ctx context.Context) []jwtkeys.KeyInfo
+	// JWKS returns the public keys other services verify tokens with
+	JWKS(ctx context.Context) jwtkeys.JSONWebKeySet
+}
+
+// JWTKeySettings configures how signing keys rotate. With HS256 and no rotation interval no key
+// is created, and tokens are signed with the static secret as before.
+type JWTKeySettings struct {
+	Algorithm        jwtkeys.Algorithm
+	RotationInterval time.Duration // Zero disables scheduled rotation
+	GracePeriod      time.Duration // How long a replaced key still verifies tokens
+	Secret           string        // Encrypts the stored private keys
+}
+
+// rotationEnabled checks if keys are managed rather than the static secret
+func (s JWTKeySettings) rotationEnabled() bool {
+	return s.Algorithm != jwtkeys.AlgorithmHS256 || s.RotationInterval > 0
+}
+
+type jwtKeyUseCase struct {
+	keyRepo  repositories.JWTSigningKeyRepository
+	keys     *jwtkeys.KeySet
+	settings JWTKeySettings
+}
+
+// NewJWTKeyUseCase creates a new JWT key use case that loads keys into keys
+func NewJWTKeyUseCase(keyRepo repositories.JWTSigningKeyRepository, keys *jwtkeys.KeySet, settings JWTKeySettings) JWTKeyUseCase {
+	return &jwtKeyUseCase{
+		keyRepo:  keyRepo,
+		keys:     keys,
+		settings: settings,
+	}
+}
+
+// LoadKeys loads the stored keys. Keys that no longer verify any token are deleted.
+func (uc *jwtKeyUseCase) LoadKeys(ctx context.Context) error {
+	stored, err := uc.keyRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load JWT signing keys: %w", err)
+	}
+	if len(stored) == 0 && uc.settings.rotationEnabled() {
+		if _, err := uc.createKey(ctx, time.Time{}); err != nil {
+			return err
+		}
+		if stored, err = uc.keyRepo.List(ctx); err != nil {
+			return fmt.Errorf("failed to load JWT signing keys: %w", err)
+		}
+	}
+
+	keys := make([]*jwtkeys.Key, 0, len(stored))
+	for _, record := range stored {
+		material, err := jwtkeys.Open(record.PrivateKey, uc.settings.Secret)
+		if err != nil {
+			fmt.Printf("⚠️ Skipping JWT signing key %s: %v\n", record.KeyID, err)
+			continue
+		}
+		key, err := jwtkeys.ParseKey(record.KeyID, jwtkeys.Algorithm(record.Algorithm), record.ActivatesAt, material)
+		if err != nil {
+			fmt.Printf("⚠️ Skipping JWT signing key %s: %v\n", record.KeyID, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	uc.keys.Replace(keys, uc.settings.GracePeriod)
+
+	// Keys replaced longer than the grace period ago are no longer listed
+	valid := map[string]bool{}
+	for _, info := range uc.keys.Keys() {
+		valid[info.ID] = true
+	}
+	var expired []uuid.UUID
+	for _, record := range stored {
+		if !valid[record.KeyID] && record.ActivatesAt.Before(time.Now()) {
+			expired = append(expired, record.ID)
+		}
+	}
+	if len(expired) > 0 {
+		if err := uc.keyRepo.Delete(ctx, expired); err != nil {
+			return fmt.Errorf("failed to delete expired JWT signing keys: %w", err)
+		}
+	}
+	return nil
+}
+
+// RotateDueKeys rotates when the newest key is due, then reloads the keys so keys created by
+// other servers are picked up too
+func (uc *jwtKeyUseCase) RotateDueKeys(ctx context.Context) (bool, error) {
+	rotated := false
+	if uc.settings.rotationEnabled() {
+		stored, err := uc.keyRepo.List(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to load JWT signing keys: %w", err)
+		}
+
+		var newest *entities.JWTSigningKey
+		if len(stored) > 0 {
+			newest = stored[len(stored)-1]
+		}
+		due := newest == nil || newest.Algorithm != string(uc.settings.Algorithm) ||
+			(uc.settings.RotationInterval > 0 && time.Since(newest.ActivatesAt) >= uc.settings.RotationInterval)
+		if due {
+			since := time.Time{}
+			if newest != nil {
+				since = newest.ActivatesAt.Add(time.Nanosecond)
+			}
+			if rotated, err = uc.createKey(ctx, since); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if err := uc.LoadKeys(ctx); err != nil {
+		return rotated, err
+	}
+	if rotated {
+		fmt.Printf("✅ Created a new JWT signing key, signing from %s\n", time.Now().Add(JWTKeyActivationDelay).Format(time.RFC3339))
+	}
+	return rotated, nil
+}
+
+// RotateKeys creates the next key right away and returns the keys
+func (uc *jwtKeyUseCase) RotateKeys(ctx context.Context) ([]jwtkeys.KeyInfo, error) {
+	if _, err := uc.createKey(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.LoadKeys(ctx); err != nil {
+		return nil, err
+	}
+	return uc.keys.Keys(), nil
+}
+
+// ListKeys lists the keys that still verify tokens
+func (uc *jwtKeyUseCase) ListKeys(ctx context.Context) []jwtkeys.KeyInfo {
+	return uc.keys.Keys()
+}
+
+// JWKS returns the published keys
+func (uc *jwtKeyUseCase) JWKS(ctx context.Context) jwtkeys.JSONWebKeySet {
+	return uc.keys.JWKS()
+}
+
+// createKey generates and stores a key that activates after JWTKeyActivationDelay, unless
+// another key activating at or after since was stored first
+func (uc *jwtKeyUseCase) createKey(ctx context.Context, since time.Time) (bool, error) {
+	activatesAt := time.Now().Add(JWTKeyActivationDelay)
+	key, err := jwtkeys.GenerateKey(uc.settings.Algorithm, activatesAt)
+	if err != nil {
+		return false, err
+	}
+	material, err := key.MarshalPrivate()
+	if err != nil {
+		return false, fmt.Errorf("failed to encode JWT signing key: %w", err)
+	}
+	sealed, err := jwtkeys.Seal(material, uc.settings.Secret)
+	if err != nil {
+		return false, fmt.Errorf("failed to encrypt JWT signing key: %w", err)
+	}
+
+	created, err := uc.keyRepo.CreateUnlessRotatedSince(ctx, &entities.JWTSigningKey{
+		ID:          ids.New(),
+		KeyID:       key.ID,
+		Algorithm:   string(key.Algorithm),
+		PrivateKey:  sealed,
+		ActivatesAt: activatesAt,
+	}, since)
+	if err != nil {
+		return false, fmt.Errorf("failed to save JWT signing key: %w", err)
+	}
+	return created, nil
+}
@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// ListingRanker orders a page of listing or search results for a viewer.
+// It is the seam for ranking strategies, so the preference-based ranker can later be
+// swapped or split by the experiment framework without touching the listing use cases.
+type ListingRanker interface {
+	Rank(ctx context.Context, viewerID *uuid.UUID, products []*entities.Product) (*RankedListing, error)
+}
+
+// RankedListing represents products in ranked order
+type RankedListing struct {
+	Products     []*entities.Product
+	Personalized bool // True when the order was adjusted for the viewer
+}
+
+// Affinity weights per signal source
+const (
+	preferredAffinityWeight = 5.0
+	historyInteractionLimit = 100
+)
+
+var interactionAffinityWeights = map[entities.InteractionType]float64{
+	entities.InteractionTypeView:      1,
+	entities.InteractionTypeCompare:   1,
+	entities.InteractionTypeWishlist:  2,
+	entities.InteractionTypeAddToCart: 3,
+	entities.InteractionTypePurchase:  4,
+}
+
+type preferenceListingRanker struct {
+	userPreferencesRepo repositories.UserPreferencesRepository
+	searchRepo          repositories.SearchRepository
+	recommendationRepo  repositories.RecommendationRepository
+	productCategoryRepo repositories.ProductCategoryRepository
+}
+
+// NewPreferenceListingRanker creates a ranker that boosts the viewer's preferred and
+// recently browsed brands and categories
+func NewPreferenceListingRanker(
+	userPreferencesRepo repositories.UserPreferencesRepository,
+	searchRepo repositories.SearchRepository,
+	recommendationRepo repositories.RecommendationRepository,
+	productCategoryRepo repositories.ProductCategoryRepository,
+) ListingRanker {
+	return &preferenceListingRanker{
+		userPreferencesRepo: userPreferencesRepo,
+		searchRepo:          searchRepo,
+		recommendationRepo:  recommendationRepo,
+		productCategoryRepo: productCategoryRepo,
+	}
+}
+
+// affinityProfile holds the viewer's brand and category affinity scores
+type affinityProfile struct {
+	brands     map[uuid.UUID]float64
+	categories map[uuid.UUID]float64
+	brandNames map[string]float64
+}
+
+func (p *affinityProfile) isEmpty() bool {
+	return len(p.brands) == 0 && len(p.categories) == 0 && len(p.brandNames) == 0
+}
+
+// Rank orders products by the viewer's affinity, keeping the original order for ties.
+// Guests and viewers who opted out of personalization get the original order.
+func (r *preferenceListingRanker) Rank(ctx context.Context, viewerID *uuid.UUID, products []*entities.Product) (*RankedListing, error) {
+	unranked := &RankedListing{Products: products}
+	if viewerID == nil || len(products) < 2 {
+		return unranked, nil
+	}
+
+	profile, err := r.buildProfile(ctx, *viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil || profile.isEmpty() {
+		return unranked, nil
+	}
+
+	productIDs := make([]uuid.UUID, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+	productCategories, err := r.productCategoryRepo.GetCategoryIDsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uuid.UUID]float64, len(products))
+	for _, product := range products {
+		var score float64
+		if product.BrandID != nil {
+			score += profile.brands[*product.BrandID]
+		}
+		if product.Brand != nil {
+			score += profile.brandNames[strings.ToLower(product.Brand.Name)]
+		}
+		for _, categoryID := range productCategories[product.ID] {
+			score += profile.categories[categoryID]
+		}
+		scores[product.ID] = score
+	}
+
+	ranked := make([]*entities.Product, len(products))
+	copy(ranked, products)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].ID] > scores[ranked[j].ID]
+	})
+
+	return &RankedListing{Products: ranked, Personalized: true}, nil
+}
+
+// buildProfile collects affinity from explicit search preferences and browsing history.
+// It returns nil when the viewer has not consented to personalization.
+func (r *preferenceListingRanker) buildProfile(ctx context.Context, userID uuid.UUID) (*affinityProfile, error) {
+	allowHistory := true
+	if prefs, err := r.userPreferencesRepo.GetByUserID(ctx, userID); err == nil {
+		if !prefs.AllowPersonalization {
+			return nil, nil
+		}
+		allowHistory = prefs.AllowDataCollection
+	} else if err != entities.ErrUserNotFound {
+		return nil, err
+	}
+
+	profile := &affinityProfile{
+		brands:     make(map[uuid.UUID]float64),
+		categories: make(map[uuid.UUID]float64),
+		brandNames: make(map[string]float64),
+	}
+
+	if searchPrefs, err := r.searchRepo.GetUserSearchPreferences(ctx, userID); err == nil {
+		if !searchPrefs.PersonalizedResults {
+			return nil, nil
+		}
+		for _, category := range searchPrefs.PreferredCategories {
+			if categoryID, err := uuid.Parse(category); err == nil {
+				profile.categories[categoryID] += preferredAffinityWeight
+			}
+		}
+		for _, brand := range searchPrefs.PreferredBrands {
+			if brandID, err := uuid.Parse(brand); err == nil {
+				profile.brands[brandID] += preferredAffinityWeight
+			} else if brand = strings.ToLower(strings.TrimSpace(brand)); brand != "" {
+				profile.brandNames[brand] += preferredAffinityWeight
+			}
+		}
+	}
+
+	if !allowHistory {
+		return profile, nil
+	}
+
+	interactions, err := r.recommendationRepo.GetUserInteractions(ctx, userID, historyInteractionLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := make(map[uuid.UUID]float64)
+	for _, interaction := range interactions {
+		weight, ok := interactionAffinityWeights[interaction.InteractionType]
+		if !ok {
+			continue
+		}
+		weights[interaction.ProductID] += weight
+		if interaction.Product.BrandID != nil {
+			profile.brands[*interaction.Product.BrandID] += weight
+		}
+	}
+	if len(weights) == 0 {
+		return profile, nil
+	}
+
+	historyProductIDs := make([]uuid.UUID, 0, len(weights))
+	for productID := range weights {
+		historyProductIDs = append(historyProductIDs, productID)
+	}
+	historyCategories, err := r.productCategoryRepo.GetCategoryIDsByProductIDs(ctx, historyProductIDs)
+	if err != nil {
+		return nil, err
+	}
+	for productID, categoryIDs := range historyCategories {
+		for _, categoryID := range categoryIDs {
+			profile.categories[categoryID] += weights[productID]
+		}
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,291 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/money"
+	"ecom-golang-clean-architecture/pkg/msgtemplate"
+
+	"github.com/google/uuid"
+)
+
+// Message channels
+const (
+	MessageChannelEmail        = "email"
+	MessageChannelNotification = "notification"
+)
+
+// MessageTemplateVariable is a variable filled in from the recipient or their order
+type MessageTemplateVariable struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"` // user or order
+	Description string `json:"description"`
+}
+
+// MessageTemplateVariables lists the variables every message can use. Anything else has to be
+// passed in the request's data.
+var MessageTemplateVariables = []MessageTemplateVariable{
+	{Name: "first_name", Source: "user", Description: "Recipient's first name"},
+	{Name: "last_name", Source: "user", Description: "Recipient's last name"},
+	{Name: "full_name", Source: "user", Description: "Recipient's first and last name"},
+	{Name: "email", Source: "user", Description: "Recipient's email address"},
+	{Name: "username", Source: "user", Description: "Recipient's display name"},
+	{Name: "phone", Source: "user", Description: "Recipient's phone number"},
+	{Name: "order_number", Source: "order", Description: "Order number"},
+	{Name: "order_status", Source: "order", Description: "Order status"},
+	{Name: "order_total", Source: "order", Description: "Order total in the order currency, e.g. 19.99"},
+	{Name: "currency", Source: "order", Description: "Order currency, e.g. USD"},
+	{Name: "order_date", Source: "order", Description: "Date the order was placed (YYYY-MM-DD)"},
+	{Name: "item_count", Source: "order", Description: "Number of items in the order"},
+	{Name: "tracking_number", Source: "order", Description: "Shipment tracking number"},
+	{Name: "tracking_url", Source: "order", Description: "Shipment tracking link"},
+	{Name: "estimated_delivery", Source: "order", Description: "Estimated delivery date (YYYY-MM-DD)"},
+}
+
+// MessageTemplateUseCase defines the interface for checking messages before they are sent
+type MessageTemplateUseCase interface {
+	PreviewMessage(ctx context.Context, adminID uuid.UUID, req PreviewMessageRequest) (*PreviewMessageResponse, error)
+}
+
+type messageTemplateUseCase struct {
+	userRepo          repositories.UserRepository
+	orderRepo         repositories.OrderRepository
+	emailTemplateRepo repositories.EmailTemplateRepository
+}
+
+// NewMessageTemplateUseCase creates a new message template use case
+func NewMessageTemplateUseCase(
+	userRepo repositories.UserRepository,
+	orderRepo repositories.OrderRepository,
+	emailTemplateRepo repositories.EmailTemplateRepository,
+) MessageTemplateUseCase {
+	return &messageTemplateUseCase{
+		userRepo:          userRepo,
+		orderRepo:         orderRepo,
+		emailTemplateRepo: emailTemplateRepo,
+	}
+}
+
+// PreviewMessageRequest represents a message to render before sending it
+type PreviewMessageRequest struct {
+	Channel  string                 `json:"channel" binding:"omitempty,oneof=email notification"` // Defaults to email
+	Template string                 `json:"template"`                                             // Stored email template to preview instead of subject and body
+	Subject  string                 `json:"subject"`                                              // Email subject or notification title
+	Body     string                 `json:"body"`
+	BodyHTML string                 `json:"body_html"`
+	UserID   *uuid.UUID             `json:"user_id"`  // Sample recipient, defaults to the admin previewing
+	OrderID  *uuid.UUID             `json:"order_id"` // Sample order, defaults to the recipient's latest order
+	Data     map[string]interface{} `json:"data"`     // Extra variables, as sent with the message
+}
+
+// PreviewMessageResponse represents a message rendered for a sample recipient
+type PreviewMessageResponse struct {
+	Channel   string                    `json:"channel"`
+	Subject   string                    `json:"subject"`
+	Body      string                    `json:"body"`
+	BodyHTML  string                    `json:"body_html,omitempty"`
+	UserID    uuid.UUID                 `json:"user_id"`
+	OrderID   *uuid.UUID                `json:"order_id,omitempty"`
+	Variables []string                  `json:"variables"` // Variables the message refers to
+	Missing   []string                  `json:"missing"`   // Variables with no value for the sample, left as written
+	Warnings  []string                  `json:"warnings"`
+	Sendable  bool                      `json:"sendable"` // Every placeholder was filled in
+	Available []MessageTemplateVariable `json:"available_variables"`
+}
+
+// PreviewMessage renders a message for a sample recipient and reports what would go out unfilled
+func (uc *messageTemplateUseCase) PreviewMessage(ctx context.Context, adminID uuid.UUID, req PreviewMessageRequest) (*PreviewMessageResponse, error) {
+	channel := req.Channel
+	if channel == "" {
+		channel = MessageChannelEmail
+	}
+
+	subject, body, bodyHTML := req.Subject, req.Body, req.BodyHTML
+	if req.Template != "" {
+		if channel != MessageChannelEmail {
+			return nil, pkgErrors.InvalidInput("templates can only be previewed as emails")
+		}
+		template, err := uc.emailTemplateRepo.GetByName(ctx, req.Template)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("email template %q not found or not active", req.Template))
+		}
+		subject, body, bodyHTML = template.Subject, template.BodyText, template.BodyHTML
+	}
+	if strings.TrimSpace(body) == "" && strings.TrimSpace(bodyHTML) == "" {
+		return nil, pkgErrors.InvalidInput("body or template is required")
+	}
+
+	userID := adminID
+	if req.UserID != nil {
+		userID = *req.UserID
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == entities.ErrUserNotFound {
+			return nil, pkgErrors.InvalidInput("sample user not found")
+		}
+		return nil, fmt.Errorf("failed to get sample user: %w", err)
+	}
+
+	var order *entities.Order
+	if req.OrderID != nil {
+		order, err = uc.orderRepo.GetByID(ctx, *req.OrderID)
+		if err != nil {
+			if err == entities.ErrOrderNotFound {
+				return nil, pkgErrors.InvalidInput("sample order not found")
+			}
+			return nil, fmt.Errorf("failed to get sample order: %w", err)
+		}
+	} else {
+		orders, err := uc.orderRepo.GetByUserID(ctx, user.ID, 1, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sample order: %w", err)
+		}
+		if len(orders) > 0 {
+			order = orders[0]
+		}
+	}
+
+	data := messageTemplateData(user, order, req.Data)
+	response := &PreviewMessageResponse{
+		Channel:   channel,
+		UserID:    user.ID,
+		Variables: []string{},
+		Missing:   []string{},
+		Warnings:  []string{},
+		Available: MessageTemplateVariables,
+	}
+	if order != nil {
+		response.OrderID = &order.ID
+	}
+
+	parts := []struct {
+		name string
+		text string
+		out  *string
+	}{
+		{"subject", subject, &response.Subject},
+		{"body", body, &response.Body},
+		{"body_html", bodyHTML, &response.BodyHTML},
+	}
+	seen := make(map[string]bool)
+	for _, part := range parts {
+		var missing []string
+		*part.out, missing = msgtemplate.Render(part.text, data)
+
+		for _, name := range msgtemplate.Variables(part.text) {
+			if !seen[msgtemplate.Key(name)] {
+				seen[msgtemplate.Key(name)] = true
+				response.Variables = append(response.Variables, name)
+			}
+		}
+		for _, name := range missing {
+			if !containsVariable(response.Missing, name) {
+				response.Missing = append(response.Missing, name)
+				response.Warnings = append(response.Warnings, missingVariableWarning(name, order))
+			}
+		}
+		for _, placeholder := range msgtemplate.Unsupported(part.text) {
+			response.Warnings = append(response.Warnings, fmt.Sprintf("%s in the %s is not a variable and will be sent as written", placeholder, part.name))
+		}
+		if msgtemplate.Unclosed(part.text) {
+			response.Warnings = append(response.Warnings, fmt.Sprintf("the %s opens a placeholder with {{ that is never closed", part.name))
+		}
+	}
+	response.Sendable = len(response.Warnings) == 0
+
+	return response, nil
+}
+
+// messageTemplateData builds the variables of a message to user, overridden by the request's data
+func messageTemplateData(user *entities.User, order *entities.Order, extra map[string]interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"first_name": user.FirstName,
+		"last_name":  user.LastName,
+		"full_name":  strings.TrimSpace(user.GetFullName()),
+		"email":      user.Email,
+		"phone":      user.Phone,
+	}
+	if user.Username != nil {
+		data["username"] = *user.Username
+	}
+
+	if order != nil {
+		data["order_number"] = order.OrderNumber
+		data["order_status"] = string(order.Status)
+		data["order_total"] = money.Format(money.ToMinor(order.Total, order.Currency), order.Currency)
+		data["currency"] = order.Currency
+		data["order_date"] = order.CreatedAt.Format("2006-01-02")
+		data["item_count"] = order.GetItemCount()
+		data["tracking_number"] = order.TrackingNumber
+		data["tracking_url"] = order.TrackingURL
+		if order.EstimatedDelivery != nil {
+			data["estimated_delivery"] = order.EstimatedDelivery.Format("2006-01-02")
+		}
+	}
+
+	for name, value := range extra {
+		data[name] = value
+	}
+	return data
+}
+
+// renderMessage fills in texts for one recipient and fails rather than let a placeholder go out
+// as written
+func renderMessage(data map[string]interface{}, texts ...string) ([]string, error) {
+	rendered := make([]string, len(texts))
+	var missing []string
+	for i, text := range texts {
+		var textMissing []string
+		rendered[i], textMissing = msgtemplate.Render(text, data)
+		for _, name := range textMissing {
+			if !containsVariable(missing, name) {
+				missing = append(missing, name)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("no value for template variables: %s", strings.Join(missing, ", ")))
+	}
+	return rendered, nil
+}
+
+// validateMessagePlaceholders rejects texts with placeholders that can never be filled in
+func validateMessagePlaceholders(texts ...string) error {
+	for _, text := range texts {
+		if unsupported := msgtemplate.Unsupported(text); len(unsupported) > 0 {
+			return pkgErrors.InvalidInput(fmt.Sprintf("unsupported template placeholders: %s", strings.Join(unsupported, ", ")))
+		}
+		if msgtemplate.Unclosed(text) {
+			return pkgErrors.InvalidInput("template has a {{ placeholder that is never closed")
+		}
+	}
+	return nil
+}
+
+func missingVariableWarning(name string, order *entities.Order) string {
+	for _, variable := range MessageTemplateVariables {
+		if msgtemplate.Key(variable.Name) != msgtemplate.Key(name) {
+			continue
+		}
+		if variable.Source == "order" && order == nil {
+			return fmt.Sprintf("{{%s}} has no value: the sample user has no orders", name)
+		}
+		return fmt.Sprintf("{{%s}} is empty for the sample %s", name, variable.Source)
+	}
+	return fmt.Sprintf("{{%s}} is not a known variable and has no value in data", name)
+}
+
+func containsVariable(names []string, name string) bool {
+	for _, n := range names {
+		if msgtemplate.Key(n) == msgtemplate.Key(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -52,12 +54,31 @@ type NotificationUseCase interface {
 	NotifyShippingUpdate(ctx context.Context, orderID uuid.UUID, trackingNumber string) error
 	NotifyLowStock(ctx context.Context, inventoryID uuid.UUID) error
 	NotifyReviewRequest(ctx context.Context, orderID uuid.UUID) error
+	NotifyQuoteStatusChanged(ctx context.Context, quote *entities.Quote) error
+	NotifyInvoiceIssued(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error
+	NotifyInvoiceOverdue(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error
+	NotifyOrderMessage(ctx context.Context, order *entities.Order, message *entities.OrderMessage) error
+	NotifyReviewReward(ctx context.Context, reward *entities.ReviewIncentiveReward) error
+	NotifyPaymentLink(ctx context.Context, link *entities.PaymentLink, url string) error
+	NotifyVendorApplicationStatusChanged(ctx context.Context, application *entities.VendorApplication) error
+	NotifyBackInStock(ctx context.Context, userID uuid.UUID, product *entities.Product) error
+	NotifyWaitlistAccess(ctx context.Context, userID uuid.UUID, product *entities.Product, launchAt time.Time, earlyAccess bool) error
+	NotifyTaxExemptionStatusChanged(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
+	NotifyTaxExemptionExpiring(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
 
 	// Admin-specific notifications
 	NotifyNewOrder(ctx context.Context, orderID uuid.UUID) error
 	NotifyPaymentFailed(ctx context.Context, paymentID uuid.UUID) error
 	NotifyNewUser(ctx context.Context, userID uuid.UUID) error
 	NotifyNewReview(ctx context.Context, reviewID uuid.UUID) error
+	NotifyNewQuoteRequest(ctx context.Context, quote *entities.Quote) error
+	NotifyNoteMention(ctx context.Context, note *entities.AdminNote, mentionedUserID uuid.UUID, subjectRef string) error
+	NotifyDisputeOpened(ctx context.Context, dispute *entities.Dispute) error
+	NotifyDisputeEvidenceDue(ctx context.Context, dispute *entities.Dispute) error
+	NotifyDisputeResolved(ctx context.Context, dispute *entities.Dispute) error
+	NotifyAdminTaskAssigned(ctx context.Context, task *entities.AdminTask) error
+	NotifyVendorApplicationSubmitted(ctx context.Context, application *entities.VendorApplication) error
+	NotifyFulfillmentSLAAlert(ctx context.Context, alert entities.FulfillmentSLAAlert, slas []*entities.OrderFulfillmentSLA) error
 }
 
 type notificationUseCase struct {
@@ -72,6 +93,13 @@ type notificationUseCase struct {
 	smsService       SMSService
 	pushService      PushService
 	websocketHub     WebSocketHub
+	returnLinks      ReturnLinkService
+	priceDisplay     PriceDisplaySettings
+}
+
+// ReturnLinkService makes the returns portal links sent when an order is delivered
+type ReturnLinkService interface {
+	ReturnLink(order *entities.Order) string
 }
 
 // WebSocketHub interface for real-time notifications
@@ -80,7 +108,9 @@ type WebSocketHub interface {
 	SendToAll(notification *entities.Notification)
 }
 
-// NewNotificationUseCase creates a new notification use case
+// NewNotificationUseCase creates a new notification use case. Delivered orders' notifications
+// carry a returns portal link unless returnLinks is nil. Order amounts are written as priceDisplay
+// says the customer is shown them.
 func NewNotificationUseCase(
 	notificationRepo repositories.NotificationRepository,
 	userRepo repositories.UserRepository,
@@ -93,6 +123,8 @@ func NewNotificationUseCase(
 	smsService SMSService,
 	pushService PushService,
 	websocketHub WebSocketHub,
+	returnLinks ReturnLinkService,
+	priceDisplay PriceDisplaySettings,
 ) NotificationUseCase {
 	return &notificationUseCase{
 		notificationRepo: notificationRepo,
@@ -106,6 +138,8 @@ func NewNotificationUseCase(
 		smsService:       smsService,
 		pushService:      pushService,
 		websocketHub:     websocketHub,
+		returnLinks:      returnLinks,
+		priceDisplay:     priceDisplay,
 	}
 }
 
@@ -350,7 +384,7 @@ func (uc *notificationUseCase) CreateNotification(ctx context.Context, req Creat
 	}
 
 	notification := &entities.Notification{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        req.UserID,
 		Type:          req.Type,
 		Category:      req.Category,
@@ -662,7 +696,7 @@ func (uc *notificationUseCase) ProcessQueue(ctx context.Context, limit int) erro
 // CreateTemplate creates a notification template
 func (uc *notificationUseCase) CreateTemplate(ctx context.Context, req CreateNotificationTemplateRequest) (*NotificationTemplateResponse, error) {
 	template := &entities.NotificationTemplate{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		Name:      req.Name,
 		Type:      req.Type,
 		Channel:   entities.NotificationChannelEmail, // Default channel, can be passed in request
@@ -861,19 +895,21 @@ func (uc *notificationUseCase) NotifyOrderCreated(ctx context.Context, orderID u
 		"total":        order.Total,
 		"items_count":  len(order.Items),
 	}
+	display := newOrderPriceDisplay(order, uc.priceDisplay)
+	display.addAmounts(data, order)
 	dataJSON, _ := json.Marshal(data)
 
 	// Create in-app notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryOrder) {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategoryOrder,
 			Priority:      entities.NotificationPriorityNormal,
 			Status:        entities.NotificationStatusPending,
 			Title:         "Đơn hàng đã được tạo",
-			Message:       fmt.Sprintf("Đơn hàng #%s của bạn đã được tạo thành công với tổng giá trị %.0f VND", order.OrderNumber, order.Total),
+			Message:       fmt.Sprintf("Đơn hàng #%s của bạn đã được tạo thành công với tổng giá trị %s", order.OrderNumber, display.format.Format(order.Total)),
 			Data:          string(dataJSON),
 			ReferenceType: "order",
 			ReferenceID:   &order.ID,
@@ -889,7 +925,7 @@ func (uc *notificationUseCase) NotifyOrderCreated(ctx context.Context, orderID u
 	// Create email notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryOrder) {
 		emailNotification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeEmail,
 			Category:      entities.NotificationCategoryOrder,
@@ -957,12 +993,18 @@ func (uc *notificationUseCase) NotifyOrderStatusChanged(ctx context.Context, ord
 		"new_status":   newStatus,
 		"total":        order.Total,
 	}
+	returnMessage := ""
+	if newStatus == "delivered" && uc.returnLinks != nil && order.CanBeRefunded() {
+		returnURL := uc.returnLinks.ReturnLink(order)
+		data["return_url"] = returnURL
+		returnMessage = fmt.Sprintf(". Cần đổi trả? Mở yêu cầu trả hàng tại: %s", returnURL)
+	}
 	dataJSON, _ := json.Marshal(data)
 
 	// Create in-app notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryOrder) {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategoryOrder,
@@ -986,14 +1028,14 @@ func (uc *notificationUseCase) NotifyOrderStatusChanged(ctx context.Context, ord
 	if newStatus == "shipped" || newStatus == "delivered" || newStatus == "cancelled" {
 		if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryOrder) {
 			emailNotification := &entities.Notification{
-				ID:            uuid.New(),
+				ID:            ids.New(),
 				UserID:        &user.ID,
 				Type:          entities.NotificationTypeEmail,
 				Category:      entities.NotificationCategoryOrder,
 				Priority:      entities.NotificationPriorityHigh,
 				Status:        entities.NotificationStatusPending,
 				Title:         fmt.Sprintf("Đơn hàng #%s - %s", order.OrderNumber, statusText),
-				Message:       fmt.Sprintf("Đơn hàng #%s của bạn đã được cập nhật trạng thái: %s", order.OrderNumber, statusText),
+				Message:       fmt.Sprintf("Đơn hàng #%s của bạn đã được cập nhật trạng thái: %s%s", order.OrderNumber, statusText, returnMessage),
 				Data:          string(dataJSON),
 				Recipient:     user.Email,
 				Subject:       fmt.Sprintf("Cập nhật đơn hàng #%s - %s", order.OrderNumber, statusText),
@@ -1010,6 +1052,25 @@ func (uc *notificationUseCase) NotifyOrderStatusChanged(ctx context.Context, ord
 		}
 	}
 
+	// Gift recipients hear when their gift is on its way and when it arrives
+	if order.HasGiftRecipient() {
+		switch newStatus {
+		case "shipped":
+			message := fmt.Sprintf("%s đã gửi tặng bạn một món quà. Đơn hàng #%s đã được giao cho đơn vị vận chuyển.", user.FirstName, order.OrderNumber)
+			if order.TrackingNumber != "" {
+				message += fmt.Sprintf(" Mã vận đơn: %s", order.TrackingNumber)
+			}
+			if err := uc.notifyGiftRecipient(ctx, order, user, "Món quà của bạn đang được giao", message); err != nil {
+				return err
+			}
+		case "delivered":
+			message := fmt.Sprintf("Món quà từ %s (đơn hàng #%s) đã được giao thành công.", user.FirstName, order.OrderNumber)
+			if err := uc.notifyGiftRecipient(ctx, order, user, "Món quà của bạn đã được giao", message); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1051,7 +1112,7 @@ func (uc *notificationUseCase) NotifyPaymentReceived(ctx context.Context, paymen
 	// Create in-app notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryPayment) {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategoryPayment,
@@ -1074,7 +1135,7 @@ func (uc *notificationUseCase) NotifyPaymentReceived(ctx context.Context, paymen
 	// Create email notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryPayment) {
 		emailNotification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeEmail,
 			Category:      entities.NotificationCategoryPayment,
@@ -1130,7 +1191,7 @@ func (uc *notificationUseCase) NotifyShippingUpdate(ctx context.Context, orderID
 	// Create in-app notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryShipping) {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategoryShipping,
@@ -1153,7 +1214,7 @@ func (uc *notificationUseCase) NotifyShippingUpdate(ctx context.Context, orderID
 	// Create email notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryShipping) {
 		emailNotification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeEmail,
 			Category:      entities.NotificationCategoryShipping,
@@ -1176,6 +1237,50 @@ func (uc *notificationUseCase) NotifyShippingUpdate(ctx context.Context, orderID
 		}
 	}
 
+	if order.HasGiftRecipient() {
+		message := fmt.Sprintf("Món quà từ %s (đơn hàng #%s) đã được giao cho đơn vị vận chuyển. Mã vận đơn: %s", user.FirstName, order.OrderNumber, trackingNumber)
+		if err := uc.notifyGiftRecipient(ctx, order, user, "Thông tin vận chuyển quà tặng", message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyGiftRecipient emails shipping news to a gift order's recipient. Recipients have no
+// account, so there are no preferences to check, and prices are left out of the email.
+func (uc *notificationUseCase) notifyGiftRecipient(ctx context.Context, order *entities.Order, buyer *entities.User, title, message string) error {
+	data := map[string]interface{}{
+		"order_number":    order.OrderNumber,
+		"from":            buyer.FirstName,
+		"gift_message":    order.GiftMessage,
+		"carrier":         order.Carrier,
+		"tracking_number": order.TrackingNumber,
+		"tracking_url":    order.TrackingURL,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		Type:          entities.NotificationTypeEmail,
+		Category:      entities.NotificationCategoryShipping,
+		Priority:      entities.NotificationPriorityNormal,
+		Status:        entities.NotificationStatusPending,
+		Title:         title,
+		Message:       message,
+		Data:          string(dataJSON),
+		Recipient:     order.GiftRecipientEmail,
+		Subject:       title,
+		Template:      "gift_shipping_update",
+		ReferenceType: "order",
+		ReferenceID:   &order.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create gift recipient notification: %w", err)
+	}
 	return nil
 }
 
@@ -1211,7 +1316,7 @@ func (uc *notificationUseCase) NotifyLowStock(ctx context.Context, inventoryID u
 
 	// Create system notification for admins
 	notification := &entities.Notification{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        nil, // System-wide notification
 		Type:          entities.NotificationTypeInApp,
 		Category:      entities.NotificationCategorySystem,
@@ -1263,7 +1368,7 @@ func (uc *notificationUseCase) NotifyReviewRequest(ctx context.Context, orderID
 	// Create in-app notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryReview) {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategoryReview,
@@ -1286,7 +1391,7 @@ func (uc *notificationUseCase) NotifyReviewRequest(ctx context.Context, orderID
 	// Create email notification
 	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryReview) {
 		emailNotification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &user.ID,
 			Type:          entities.NotificationTypeEmail,
 			Category:      entities.NotificationCategoryReview,
@@ -1436,7 +1541,7 @@ func (uc *notificationUseCase) NotifyNewOrder(ctx context.Context, orderID uuid.
 
 	// Create system notification for admins
 	notification := &entities.Notification{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        nil, // System-wide notification for admins
 		Type:          entities.NotificationTypeInApp,
 		Category:      entities.NotificationCategoryOrder,
@@ -1458,59 +1563,1220 @@ func (uc *notificationUseCase) NotifyNewOrder(ctx context.Context, orderID uuid.
 	return nil
 }
 
-// NotifyPaymentFailed sends notification to admins when a payment fails
-func (uc *notificationUseCase) NotifyPaymentFailed(ctx context.Context, paymentID uuid.UUID) error {
-	// Get payment details
-	payment, err := uc.paymentRepo.GetByID(ctx, paymentID)
-	if err != nil {
-		return fmt.Errorf("failed to get payment: %w", err)
-	}
-
-	// Get order details
-	order, err := uc.orderRepo.GetByID(ctx, payment.OrderID)
-	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
-	}
-
+// NotifyNewQuoteRequest sends notification to admins when a customer requests a quote
+func (uc *notificationUseCase) NotifyNewQuoteRequest(ctx context.Context, quote *entities.Quote) error {
 	// Get user details
-	user, err := uc.userRepo.GetByID(ctx, order.UserID)
+	user, err := uc.userRepo.GetByID(ctx, quote.UserID)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
 
 	// Create notification data
 	data := map[string]interface{}{
-		"payment_id":     payment.ID,
-		"order_id":       order.ID,
-		"order_number":   order.OrderNumber,
+		"quote_id":       quote.ID,
+		"quote_number":   quote.QuoteNumber,
 		"customer_id":    user.ID,
 		"customer_name":  user.FirstName + " " + user.LastName,
 		"customer_email": user.Email,
-		"amount":         payment.Amount,
-		"payment_method": payment.Method,
-		"failure_reason": payment.FailureReason,
+		"list_subtotal":  quote.ListSubtotal,
+		"items_count":    len(quote.Items),
 	}
 	dataJSON, _ := json.Marshal(data)
 
 	// Create system notification for admins
 	notification := &entities.Notification{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        nil, // System-wide notification for admins
 		Type:          entities.NotificationTypeInApp,
-		Category:      entities.NotificationCategoryPayment,
+		Category:      entities.NotificationCategoryOrder,
 		Priority:      entities.NotificationPriorityHigh,
 		Status:        entities.NotificationStatusPending,
-		Title:         "Thanh toán thất bại",
-		Message:       fmt.Sprintf("Thanh toán thất bại cho đơn hàng #%s (%.0f VND) từ khách hàng %s", order.OrderNumber, payment.Amount, user.FirstName+" "+user.LastName),
+		Title:         "Yêu cầu báo giá mới",
+		Message:       fmt.Sprintf("Yêu cầu báo giá mới #%s từ khách hàng %s với giá trị niêm yết %.0f VND", quote.QuoteNumber, user.FirstName+" "+user.LastName, quote.ListSubtotal),
 		Data:          string(dataJSON),
-		ReferenceType: "payment",
-		ReferenceID:   &payment.ID,
+		ReferenceType: "quote",
+		ReferenceID:   &quote.ID,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 
 	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
-		return fmt.Errorf("failed to create payment failed notification: %w", err)
+		return fmt.Errorf("failed to create new quote notification: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyNoteMention notifies an admin who was mentioned in an internal note on an order or customer
+func (uc *notificationUseCase) NotifyNoteMention(ctx context.Context, note *entities.AdminNote, mentionedUserID uuid.UUID, subjectRef string) error {
+	authorName := "Quản trị viên"
+	if note.Author != nil {
+		authorName = note.Author.GetFullName()
+	}
+
+	category := entities.NotificationCategoryOrder
+	message := fmt.Sprintf("%s đã nhắc đến bạn trong ghi chú về đơn hàng #%s", authorName, subjectRef)
+	if note.SubjectType == entities.AdminNoteSubjectCustomer {
+		category = entities.NotificationCategoryAccount
+		message = fmt.Sprintf("%s đã nhắc đến bạn trong ghi chú về khách hàng %s", authorName, subjectRef)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"note_id":      note.ID,
+		"subject_type": note.SubjectType,
+		"subject_id":   note.SubjectID,
+		"author_id":    note.AuthorID,
+		"body":         note.Body,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        &mentionedUserID,
+		Type:          entities.NotificationTypeInApp,
+		Category:      category,
+		Priority:      entities.NotificationPriorityNormal,
+		Status:        entities.NotificationStatusPending,
+		Title:         "Bạn được nhắc đến trong ghi chú nội bộ",
+		Message:       message,
+		Data:          string(dataJSON),
+		ReferenceType: string(note.SubjectType),
+		ReferenceID:   &note.SubjectID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create note mention notification: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyAdminTaskAssigned notifies an admin that an inbox task was assigned to them
+func (uc *notificationUseCase) NotifyAdminTaskAssigned(ctx context.Context, task *entities.AdminTask) error {
+	if task.AssigneeID == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"task_id":     task.ID,
+		"task_type":   task.Type,
+		"source_type": task.Type.SourceType(),
+		"source_id":   task.SourceID,
+		"due_at":      task.DueAt,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	priority := entities.NotificationPriorityNormal
+	if task.Priority >= entities.AdminTaskPriorityHigh {
+		priority = entities.NotificationPriorityHigh
+	}
+
+	message := fmt.Sprintf("Bạn được giao việc: %s", task.Title)
+	if task.DueAt != nil {
+		message = fmt.Sprintf("Bạn được giao việc: %s (hạn %s)", task.Title, task.DueAt.Format("02/01/2006 15:04"))
+	}
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        task.AssigneeID,
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategorySystem,
+		Priority:      priority,
+		Status:        entities.NotificationStatusPending,
+		Title:         "Công việc mới được giao",
+		Message:       message,
+		Data:          string(dataJSON),
+		ReferenceType: "admin_task",
+		ReferenceID:   &task.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create task assignment notification: %w", err)
+	}
+
+	return nil
+}
+
+// fulfillmentSLAAlertOrdersListed caps how many order numbers an SLA alert lists
+const fulfillmentSLAAlertOrdersListed = 5
+
+// NotifyFulfillmentSLAAlert notifies admins of orders at risk of shipping late, late, or stuck
+// in a status, in one notification per alert
+func (uc *notificationUseCase) NotifyFulfillmentSLAAlert(ctx context.Context, alert entities.FulfillmentSLAAlert, slas []*entities.OrderFulfillmentSLA) error {
+	if len(slas) == 0 {
+		return nil
+	}
+
+	orderIDs := make([]uuid.UUID, len(slas))
+	var listed []string
+	for i, sla := range slas {
+		orderIDs[i] = sla.OrderID
+		if i >= fulfillmentSLAAlertOrdersListed || sla.Order == nil {
+			continue
+		}
+		switch alert {
+		case entities.FulfillmentSLAAlertStuck:
+			listed = append(listed, fmt.Sprintf("#%s (%s từ %s)", sla.Order.OrderNumber,
+				sla.Order.GetStatusDisplayName(), sla.StatusSince.Format("02/01/2006 15:04")))
+		default:
+			listed = append(listed, fmt.Sprintf("#%s (hạn %s)", sla.Order.OrderNumber, sla.ShipBy.Format("02/01/2006 15:04")))
+		}
+	}
+	orders := strings.Join(listed, ", ")
+	if len(slas) > len(listed) {
+		orders += fmt.Sprintf(" và %d đơn khác", len(slas)-len(listed))
+	}
+
+	var title, message string
+	priority := entities.NotificationPriorityHigh
+	switch alert {
+	case entities.FulfillmentSLAAlertAtRisk:
+		title = "Đơn hàng sắp trễ hạn giao vận chuyển"
+		message = fmt.Sprintf("%d đơn hàng cần được giao cho đơn vị vận chuyển sắp tới hạn: %s", len(slas), orders)
+		priority = entities.NotificationPriorityNormal
+	case entities.FulfillmentSLAAlertBreached:
+		title = "Đơn hàng trễ hạn giao vận chuyển"
+		message = fmt.Sprintf("%d đơn hàng đã quá hạn giao cho đơn vị vận chuyển: %s", len(slas), orders)
+	case entities.FulfillmentSLAAlertStuck:
+		title = "Đơn hàng bị tồn đọng"
+		message = fmt.Sprintf("%d đơn hàng ở một trạng thái quá lâu: %s", len(slas), orders)
+	default:
+		return fmt.Errorf("unknown fulfillment SLA alert %q", alert)
+	}
+
+	data := map[string]interface{}{
+		"alert":     alert,
+		"order_ids": orderIDs,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        nil, // System-wide notification for admins
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategoryOrder,
+		Priority:      priority,
+		Status:        entities.NotificationStatusPending,
+		Title:         title,
+		Message:       message,
+		Data:          string(dataJSON),
+		ReferenceType: "fulfillment_sla",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create fulfillment SLA notification: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyVendorApplicationSubmitted notifies admins that a vendor application is waiting for review
+func (uc *notificationUseCase) NotifyVendorApplicationSubmitted(ctx context.Context, application *entities.VendorApplication) error {
+	data := map[string]interface{}{
+		"application_id": application.ID,
+		"vendor_id":      application.VendorID,
+		"legal_name":     application.LegalName,
+		"business_type":  application.BusinessType,
+		"country":        application.Country,
+		"submitted_at":   application.SubmittedAt,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        nil, // System-wide notification for admins
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategorySystem,
+		Priority:      entities.NotificationPriorityNormal,
+		Status:        entities.NotificationStatusPending,
+		Title:         "Hồ sơ nhà bán hàng mới",
+		Message:       fmt.Sprintf("%s đã gửi hồ sơ đăng ký nhà bán hàng, đang chờ duyệt", application.LegalName),
+		Data:          string(dataJSON),
+		ReferenceType: "vendor_application",
+		ReferenceID:   &application.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create vendor application notification: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyVendorApplicationStatusChanged notifies the applicant when their vendor application is
+// submitted, approved, rejected or sent back for changes
+func (uc *notificationUseCase) NotifyVendorApplicationStatusChanged(ctx context.Context, application *entities.VendorApplication) error {
+	user, err := uc.userRepo.GetByID(ctx, application.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	var title, message string
+	switch application.Status {
+	case entities.VendorApplicationStatusSubmitted:
+		title = "Đã nhận hồ sơ nhà bán hàng"
+		message = "Hồ sơ đăng ký nhà bán hàng của bạn đã được gửi và đang chờ duyệt"
+	case entities.VendorApplicationStatusChangesRequested:
+		title = "Hồ sơ nhà bán hàng cần bổ sung"
+		message = fmt.Sprintf("Vui lòng cập nhật hồ sơ nhà bán hàng và gửi lại: %s", application.ReviewNotes)
+	case entities.VendorApplicationStatusApproved:
+		title = "Hồ sơ nhà bán hàng đã được duyệt"
+		message = "Chúc mừng! Bạn đã có thể đăng bán sản phẩm trên sàn"
+	case entities.VendorApplicationStatusRejected:
+		title = "Hồ sơ nhà bán hàng bị từ chối"
+		message = fmt.Sprintf("Hồ sơ đăng ký nhà bán hàng của bạn không được duyệt: %s", application.ReviewNotes)
+	default:
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"application_id": application.ID,
+		"vendor_id":      application.VendorID,
+		"status":         application.Status,
+		"review_notes":   application.ReviewNotes,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryAccount) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryAccount,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			ReferenceType: "vendor_application",
+			ReferenceID:   &application.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryAccount) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryAccount,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       title,
+			Template:      fmt.Sprintf("vendor_application_%s", application.Status),
+			ReferenceType: "vendor_application",
+			ReferenceID:   &application.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyQuoteStatusChanged notifies the customer when their quote is priced, expires or changes status
+func (uc *notificationUseCase) NotifyQuoteStatusChanged(ctx context.Context, quote *entities.Quote) error {
+	// Get user details
+	user, err := uc.userRepo.GetByID(ctx, quote.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	var title, message string
+	switch quote.Status {
+	case entities.QuoteStatusQuoted:
+		title = "Báo giá đã sẵn sàng"
+		message = fmt.Sprintf("Báo giá #%s đã được phản hồi với tổng giá trị %.0f VND", quote.QuoteNumber, quote.QuotedSubtotal)
+		if quote.ExpiresAt != nil {
+			message += fmt.Sprintf(", có hiệu lực đến %s", quote.ExpiresAt.Format("02/01/2006"))
+		}
+	case entities.QuoteStatusAccepted:
+		title = "Báo giá đã được chấp nhận"
+		message = fmt.Sprintf("Bạn đã chấp nhận báo giá #%s. Hãy đặt hàng trước khi báo giá hết hạn.", quote.QuoteNumber)
+	case entities.QuoteStatusConverted:
+		title = "Báo giá đã chuyển thành đơn hàng"
+		message = fmt.Sprintf("Báo giá #%s đã được chuyển thành đơn hàng", quote.QuoteNumber)
+	case entities.QuoteStatusExpired:
+		title = "Báo giá đã hết hạn"
+		message = fmt.Sprintf("Báo giá #%s đã hết hạn. Vui lòng gửi yêu cầu báo giá mới nếu cần.", quote.QuoteNumber)
+	case entities.QuoteStatusCancelled:
+		title = "Báo giá đã bị hủy"
+		message = fmt.Sprintf("Báo giá #%s đã bị hủy", quote.QuoteNumber)
+	default:
+		title = "Cập nhật báo giá"
+		message = fmt.Sprintf("Báo giá #%s đã được cập nhật sang trạng thái: %s", quote.QuoteNumber, quote.Status)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"quote_id":        quote.ID,
+		"quote_number":    quote.QuoteNumber,
+		"status":          quote.Status,
+		"quoted_subtotal": quote.QuotedSubtotal,
+		"expires_at":      quote.ExpiresAt,
+		"order_id":        quote.OrderID,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	// Create in-app notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryOrder) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryOrder,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			ReferenceType: "quote",
+			ReferenceID:   &quote.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	// Create email notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryOrder) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryOrder,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       fmt.Sprintf("%s #%s", title, quote.QuoteNumber),
+			Template:      fmt.Sprintf("quote_%s", quote.Status),
+			ReferenceType: "quote",
+			ReferenceID:   &quote.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyPaymentLink sends a payment link to the customer's email address and phone number. The
+// customer may not have an account, so the notifications are addressed to the recipients only.
+func (uc *notificationUseCase) NotifyPaymentLink(ctx context.Context, link *entities.PaymentLink, url string) error {
+	title := "Yêu cầu thanh toán"
+	message := fmt.Sprintf("Vui lòng thanh toán %.2f %s cho \"%s\" tại %s trước %s",
+		link.Amount, link.Currency, link.Description, url, link.ExpiresAt.Format("02/01/2006 15:04"))
+
+	data := map[string]interface{}{
+		"payment_link_id": link.ID,
+		"url":             url,
+		"amount":          link.Amount,
+		"currency":        link.Currency,
+		"description":     link.Description,
+		"expires_at":      link.ExpiresAt,
+		"order_id":        link.OrderID,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	recipients := map[entities.NotificationType]string{
+		entities.NotificationTypeEmail: link.CustomerEmail,
+		entities.NotificationTypeSMS:   link.CustomerPhone,
+	}
+	for _, notificationType := range []entities.NotificationType{entities.NotificationTypeEmail, entities.NotificationTypeSMS} {
+		recipient := recipients[notificationType]
+		if recipient == "" {
+			continue
+		}
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			Type:          notificationType,
+			Category:      entities.NotificationCategoryPayment,
+			Priority:      entities.NotificationPriorityHigh,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			Recipient:     recipient,
+			Subject:       title,
+			Template:      "payment_link",
+			ReferenceType: "payment_link",
+			ReferenceID:   &link.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create payment link notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyInvoiceIssued notifies a company member that a net terms invoice was issued
+func (uc *notificationUseCase) NotifyInvoiceIssued(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error {
+	title := "Hóa đơn đã được phát hành"
+	message := fmt.Sprintf("Hóa đơn #%s với tổng giá trị %.0f VND đã được phát hành, hạn thanh toán %s",
+		invoice.InvoiceNumber, invoice.Total, invoice.DueDate.Format("02/01/2006"))
+	return uc.notifyInvoice(ctx, invoice, userID, title, message, "invoice_issued", entities.NotificationPriorityNormal)
+}
+
+// NotifyInvoiceOverdue reminds a company member that an invoice is past its due date
+func (uc *notificationUseCase) NotifyInvoiceOverdue(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID) error {
+	title := "Hóa đơn quá hạn thanh toán"
+	message := fmt.Sprintf("Hóa đơn #%s đã quá hạn thanh toán từ %s. Số tiền còn lại: %.0f VND",
+		invoice.InvoiceNumber, invoice.DueDate.Format("02/01/2006"), invoice.Balance())
+	return uc.notifyInvoice(ctx, invoice, userID, title, message, "invoice_overdue", entities.NotificationPriorityHigh)
+}
+
+// NotifyOrderMessage notifies the other side of an order conversation about a new message.
+// Store replies go to the customer; customer messages go to the admins.
+func (uc *notificationUseCase) NotifyOrderMessage(ctx context.Context, order *entities.Order, message *entities.OrderMessage) error {
+	body := message.Body
+	if body == "" {
+		body = fmt.Sprintf("(%d tệp đính kèm)", len(message.Attachments))
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"order_id":          order.ID,
+		"order_number":      order.OrderNumber,
+		"message_id":        message.ID,
+		"sender_type":       message.SenderType,
+		"body":              message.Body,
+		"attachments_count": len(message.Attachments),
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	if message.IsFromCustomer() {
+		return uc.notifyStoreOfOrderMessage(ctx, order, message, body, string(dataJSON))
+	}
+
+	// Get customer details
+	user, err := uc.userRepo.GetByID(ctx, order.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	title := "Tin nhắn mới từ cửa hàng"
+	text := fmt.Sprintf("Cửa hàng đã trả lời về đơn hàng #%s: %s", order.OrderNumber, body)
+
+	// Create in-app notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryOrder) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryOrder,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			ReferenceType: "order",
+			ReferenceID:   &order.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	// Create email notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryOrder) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryOrder,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       fmt.Sprintf("%s - đơn hàng #%s", title, order.OrderNumber),
+			Template:      "order_message",
+			ReferenceType: "order",
+			ReferenceID:   &order.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notifyStoreOfOrderMessage creates a system notification and emails the admins about a customer message
+func (uc *notificationUseCase) notifyStoreOfOrderMessage(ctx context.Context, order *entities.Order, message *entities.OrderMessage, body, data string) error {
+	customerName := "Khách hàng"
+	if message.Sender != nil {
+		customerName = message.Sender.GetFullName()
+	}
+
+	title := "Tin nhắn mới từ khách hàng"
+	text := fmt.Sprintf("%s đã gửi tin nhắn về đơn hàng #%s: %s", customerName, order.OrderNumber, body)
+
+	// Create system notification for admins
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        nil, // System-wide notification for admins
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategoryOrder,
+		Priority:      entities.NotificationPriorityNormal,
+		Status:        entities.NotificationStatusPending,
+		Title:         title,
+		Message:       text,
+		Data:          data,
+		ReferenceType: "order",
+		ReferenceID:   &order.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create order message notification: %w", err)
+	}
+
+	// Email the admins
+	admins, err := uc.userRepo.GetUsersByRole(ctx, entities.UserRoleAdmin, 50, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get admins: %w", err)
+	}
+	for _, admin := range admins {
+		if !admin.IsActive {
+			continue
+		}
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &admin.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryOrder,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          data,
+			Recipient:     admin.Email,
+			Subject:       fmt.Sprintf("%s - đơn hàng #%s", title, order.OrderNumber),
+			Template:      "order_message_admin",
+			ReferenceType: "order",
+			ReferenceID:   &order.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyReviewReward thanks a customer for an approved review and tells them the reward they received
+func (uc *notificationUseCase) NotifyReviewReward(ctx context.Context, reward *entities.ReviewIncentiveReward) error {
+	// Get customer details
+	user, err := uc.userRepo.GetByID(ctx, reward.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	title := "Cảm ơn bạn đã đánh giá"
+	text := fmt.Sprintf("Đánh giá của bạn đã được duyệt. Bạn nhận được %d điểm thưởng.", reward.Points)
+	if reward.CouponCode != "" {
+		text = fmt.Sprintf("Đánh giá của bạn đã được duyệt. Bạn nhận được mã giảm giá %s cho lần mua tiếp theo.", reward.CouponCode)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"review_id":   reward.ReviewID,
+		"product_id":  reward.ProductID,
+		"reward_type": reward.RewardType,
+		"points":      reward.Points,
+		"coupon_code": reward.CouponCode,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	// Create in-app notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryReview) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryReview,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			ReferenceType: "review",
+			ReferenceID:   &reward.ReviewID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	// Create email notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryReview) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryReview,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       title,
+			Template:      "review_reward",
+			ReferenceType: "review",
+			ReferenceID:   &reward.ReviewID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyBackInStock tells a customer who asked to be notified that a product is back in stock
+func (uc *notificationUseCase) NotifyBackInStock(ctx context.Context, userID uuid.UUID, product *entities.Product) error {
+	// Get customer details
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	title := "Sản phẩm đã có hàng trở lại"
+	text := fmt.Sprintf("%s đã có hàng trở lại. Đặt hàng ngay trước khi hết hàng!", product.Name)
+
+	// Create notification data
+	data := map[string]interface{}{
+		"product_id":   product.ID,
+		"product_name": product.Name,
+		"product_slug": product.Slug,
+		"price":        product.GetCurrentPrice(),
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notificationTypes := []entities.NotificationType{entities.NotificationTypeInApp, entities.NotificationTypeEmail}
+	for _, notificationType := range notificationTypes {
+		if !preferences.IsNotificationEnabled(notificationType, entities.NotificationCategoryInventory) {
+			continue
+		}
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          notificationType,
+			Category:      entities.NotificationCategoryInventory,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			ReferenceType: "product",
+			ReferenceID:   &product.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+		if notificationType == entities.NotificationTypeEmail {
+			notification.Recipient = user.Email
+			notification.Subject = title
+			notification.Template = "back_in_stock"
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create back in stock notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyWaitlistAccess tells a customer on a product's waitlist that they can now buy it
+func (uc *notificationUseCase) NotifyWaitlistAccess(ctx context.Context, userID uuid.UUID, product *entities.Product, launchAt time.Time, earlyAccess bool) error {
+	// Get customer details
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	title := "Sản phẩm bạn chờ đã mở bán"
+	text := fmt.Sprintf("%s đã mở bán. Đặt hàng ngay trước khi hết hàng!", product.Name)
+	if earlyAccess {
+		title = "Bạn được mua sớm sản phẩm đang chờ"
+		text = fmt.Sprintf("%s sẽ mở bán vào %s, nhưng bạn có thể đặt hàng ngay từ bây giờ!",
+			product.Name, launchAt.Format("02/01/2006 15:04"))
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"product_id":   product.ID,
+		"product_name": product.Name,
+		"product_slug": product.Slug,
+		"price":        product.GetCurrentPrice(),
+		"launch_at":    launchAt,
+		"early_access": earlyAccess,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notificationTypes := []entities.NotificationType{entities.NotificationTypeInApp, entities.NotificationTypeEmail}
+	for _, notificationType := range notificationTypes {
+		if !preferences.IsNotificationEnabled(notificationType, entities.NotificationCategoryInventory) {
+			continue
+		}
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          notificationType,
+			Category:      entities.NotificationCategoryInventory,
+			Priority:      entities.NotificationPriorityNormal,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       text,
+			Data:          string(dataJSON),
+			ReferenceType: "product",
+			ReferenceID:   &product.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+		if notificationType == entities.NotificationTypeEmail {
+			notification.Recipient = user.Email
+			notification.Subject = title
+			notification.Template = "waitlist_access"
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create waitlist notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notifyInvoice creates in-app and email notifications about a company invoice
+func (uc *notificationUseCase) notifyInvoice(ctx context.Context, invoice *entities.CompanyInvoice, userID uuid.UUID, title, message, template string, priority entities.NotificationPriority) error {
+	// Get user details
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"invoice_id":     invoice.ID,
+		"invoice_number": invoice.InvoiceNumber,
+		"total":          invoice.Total,
+		"balance":        invoice.Balance(),
+		"due_date":       invoice.DueDate,
+		"payment_terms":  invoice.PaymentTerms,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	// Create in-app notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryPayment) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryPayment,
+			Priority:      priority,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			ReferenceType: "company_invoice",
+			ReferenceID:   &invoice.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	// Create email notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryPayment) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryPayment,
+			Priority:      priority,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       fmt.Sprintf("%s #%s", title, invoice.InvoiceNumber),
+			Template:      template,
+			ReferenceType: "company_invoice",
+			ReferenceID:   &invoice.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyTaxExemptionStatusChanged tells a customer their tax exemption certificate was verified,
+// rejected or has expired
+func (uc *notificationUseCase) NotifyTaxExemptionStatusChanged(ctx context.Context, certificate *entities.TaxExemptionCertificate) error {
+	var title, message string
+	priority := entities.NotificationPriorityNormal
+	switch certificate.Status {
+	case entities.TaxExemptionStatusVerified:
+		title = "Giấy chứng nhận miễn thuế đã được xác minh"
+		message = fmt.Sprintf("Giấy chứng nhận miễn thuế số %s đã được xác minh và sẽ được áp dụng khi thanh toán", certificate.CertificateNumber)
+	case entities.TaxExemptionStatusRejected:
+		title = "Giấy chứng nhận miễn thuế bị từ chối"
+		message = fmt.Sprintf("Giấy chứng nhận miễn thuế số %s không được chấp nhận: %s", certificate.CertificateNumber, certificate.ReviewNotes)
+	case entities.TaxExemptionStatusExpired:
+		title = "Giấy chứng nhận miễn thuế đã hết hạn"
+		message = fmt.Sprintf("Giấy chứng nhận miễn thuế số %s đã hết hạn và không còn được áp dụng. Vui lòng tải lên giấy chứng nhận mới", certificate.CertificateNumber)
+		priority = entities.NotificationPriorityHigh
+	default:
+		return nil
+	}
+	return uc.notifyTaxExemption(ctx, certificate, title, message, fmt.Sprintf("tax_exemption_%s", certificate.Status), priority)
+}
+
+// NotifyTaxExemptionExpiring reminds a customer to upload a new tax exemption certificate before
+// theirs expires
+func (uc *notificationUseCase) NotifyTaxExemptionExpiring(ctx context.Context, certificate *entities.TaxExemptionCertificate) error {
+	if certificate.ExpiresAt == nil {
+		return nil
+	}
+	title := "Giấy chứng nhận miễn thuế sắp hết hạn"
+	message := fmt.Sprintf("Giấy chứng nhận miễn thuế số %s sẽ hết hạn vào %s. Vui lòng tải lên giấy chứng nhận mới để tiếp tục được miễn thuế",
+		certificate.CertificateNumber, certificate.ExpiresAt.Format("02/01/2006"))
+	return uc.notifyTaxExemption(ctx, certificate, title, message, "tax_exemption_expiring", entities.NotificationPriorityNormal)
+}
+
+// notifyTaxExemption sends a tax exemption notification to the certificate's customer
+func (uc *notificationUseCase) notifyTaxExemption(ctx context.Context, certificate *entities.TaxExemptionCertificate, title, message, template string, priority entities.NotificationPriority) error {
+	// Get user details
+	user, err := uc.userRepo.GetByID(ctx, certificate.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check user notification preferences
+	preferences, err := uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	if err != nil {
+		// Create default preferences if not found
+		if err := uc.notificationRepo.CreateDefaultPreferences(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to create default preferences: %w", err)
+		}
+		preferences, _ = uc.notificationRepo.GetUserPreferences(ctx, user.ID)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"certificate_id":     certificate.ID,
+		"certificate_number": certificate.CertificateNumber,
+		"status":             certificate.Status,
+		"expires_at":         certificate.ExpiresAt,
+		"review_notes":       certificate.ReviewNotes,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	// Create in-app notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeInApp, entities.NotificationCategoryAccount) {
+		notification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeInApp,
+			Category:      entities.NotificationCategoryAccount,
+			Priority:      priority,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			ReferenceType: "tax_exemption_certificate",
+			ReferenceID:   &certificate.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create in-app notification: %w", err)
+		}
+	}
+
+	// Create email notification
+	if preferences.IsNotificationEnabled(entities.NotificationTypeEmail, entities.NotificationCategoryAccount) {
+		emailNotification := &entities.Notification{
+			ID:            ids.New(),
+			UserID:        &user.ID,
+			Type:          entities.NotificationTypeEmail,
+			Category:      entities.NotificationCategoryAccount,
+			Priority:      priority,
+			Status:        entities.NotificationStatusPending,
+			Title:         title,
+			Message:       message,
+			Data:          string(dataJSON),
+			Recipient:     user.Email,
+			Subject:       title,
+			Template:      template,
+			ReferenceType: "tax_exemption_certificate",
+			ReferenceID:   &certificate.ID,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+
+		if err := uc.notificationRepo.Create(ctx, emailNotification); err != nil {
+			return fmt.Errorf("failed to create email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NotifyPaymentFailed sends notification to admins when a payment fails
+func (uc *notificationUseCase) NotifyPaymentFailed(ctx context.Context, paymentID uuid.UUID) error {
+	// Get payment details
+	payment, err := uc.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	// Get order details
+	order, err := uc.orderRepo.GetByID(ctx, payment.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Get user details
+	user, err := uc.userRepo.GetByID(ctx, order.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Create notification data
+	data := map[string]interface{}{
+		"payment_id":     payment.ID,
+		"order_id":       order.ID,
+		"order_number":   order.OrderNumber,
+		"customer_id":    user.ID,
+		"customer_name":  user.FirstName + " " + user.LastName,
+		"customer_email": user.Email,
+		"amount":         payment.Amount,
+		"payment_method": payment.Method,
+		"failure_reason": payment.FailureReason,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	// Create system notification for admins
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        nil, // System-wide notification for admins
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategoryPayment,
+		Priority:      entities.NotificationPriorityHigh,
+		Status:        entities.NotificationStatusPending,
+		Title:         "Thanh toán thất bại",
+		Message:       fmt.Sprintf("Thanh toán thất bại cho đơn hàng #%s (%.0f VND) từ khách hàng %s", order.OrderNumber, payment.Amount, user.FirstName+" "+user.LastName),
+		Data:          string(dataJSON),
+		ReferenceType: "payment",
+		ReferenceID:   &payment.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create payment failed notification: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyDisputeOpened notifies admins that a customer disputed a payment
+func (uc *notificationUseCase) NotifyDisputeOpened(ctx context.Context, dispute *entities.Dispute) error {
+	message := fmt.Sprintf("Khách hàng khiếu nại thanh toán %.2f %s qua %s (lý do: %s)",
+		dispute.Amount, dispute.Currency, dispute.Gateway, dispute.Reason)
+	if dispute.EvidenceDueBy != nil {
+		message += fmt.Sprintf(", hạn nộp bằng chứng %s", dispute.EvidenceDueBy.Format("02/01/2006 15:04"))
+	}
+	return uc.createDisputeNotification(ctx, dispute, "Khiếu nại thanh toán mới", message, entities.NotificationPriorityCritical)
+}
+
+// NotifyDisputeEvidenceDue reminds admins that the evidence for a dispute is due soon
+func (uc *notificationUseCase) NotifyDisputeEvidenceDue(ctx context.Context, dispute *entities.Dispute) error {
+	message := fmt.Sprintf("Khiếu nại %s (%.2f %s) cần nộp bằng chứng trước %s",
+		dispute.ExternalID, dispute.Amount, dispute.Currency, dispute.EvidenceDueBy.Format("02/01/2006 15:04"))
+	return uc.createDisputeNotification(ctx, dispute, "Sắp hết hạn nộp bằng chứng khiếu nại", message, entities.NotificationPriorityCritical)
+}
+
+// NotifyDisputeResolved notifies admins of the outcome of a dispute
+func (uc *notificationUseCase) NotifyDisputeResolved(ctx context.Context, dispute *entities.Dispute) error {
+	outcomes := map[entities.DisputeStatus]string{
+		entities.DisputeStatusWon:    "thắng",
+		entities.DisputeStatusLost:   "thua, tiền đã bị hoàn cho khách hàng",
+		entities.DisputeStatusClosed: "đã đóng",
+	}
+	message := fmt.Sprintf("Khiếu nại %s (%.2f %s) đã kết thúc: %s",
+		dispute.ExternalID, dispute.Amount, dispute.Currency, outcomes[dispute.Status])
+	return uc.createDisputeNotification(ctx, dispute, "Khiếu nại thanh toán đã kết thúc", message, entities.NotificationPriorityHigh)
+}
+
+// createDisputeNotification creates a system notification for admins about a dispute
+func (uc *notificationUseCase) createDisputeNotification(ctx context.Context, dispute *entities.Dispute, title, message string, priority entities.NotificationPriority) error {
+	data := map[string]interface{}{
+		"dispute_id":      dispute.ID,
+		"gateway":         dispute.Gateway,
+		"external_id":     dispute.ExternalID,
+		"payment_id":      dispute.PaymentID,
+		"order_id":        dispute.OrderID,
+		"amount":          dispute.Amount,
+		"currency":        dispute.Currency,
+		"reason":          dispute.Reason,
+		"status":          dispute.Status,
+		"evidence_due_by": dispute.EvidenceDueBy,
+	}
+	dataJSON, _ := json.Marshal(data)
+
+	notification := &entities.Notification{
+		ID:            ids.New(),
+		UserID:        nil, // System-wide notification for admins
+		Type:          entities.NotificationTypeInApp,
+		Category:      entities.NotificationCategoryPayment,
+		Priority:      priority,
+		Status:        entities.NotificationStatusPending,
+		Title:         title,
+		Message:       message,
+		Data:          string(dataJSON),
+		ReferenceType: "dispute",
+		ReferenceID:   &dispute.ID,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := uc.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create dispute notification: %w", err)
 	}
 
 	return nil
@@ -1549,7 +2815,7 @@ func (uc *notificationUseCase) NotifyNewUser(ctx context.Context, userID uuid.UU
 	// Create individual notifications for each admin
 	for _, admin := range adminUsers {
 		notification := &entities.Notification{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			UserID:        &admin.ID, // Send to specific admin user
 			Type:          entities.NotificationTypeInApp,
 			Category:      entities.NotificationCategorySystem,
@@ -1610,7 +2876,7 @@ func (uc *notificationUseCase) NotifyNewReview(ctx context.Context, reviewID uui
 
 	// Create system notification for admins
 	notification := &entities.Notification{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		UserID:        nil, // System-wide notification for admins
 		Type:          entities.NotificationTypeInApp,
 		Category:      entities.NotificationCategorySystem,
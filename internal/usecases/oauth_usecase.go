@@ -7,12 +7,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
-
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
 	"ecom-golang-clean-architecture/internal/infrastructure/config"
 	"ecom-golang-clean-architecture/internal/infrastructure/oauth"
+	"ecom-golang-clean-architecture/pkg/ids"
 )
 
 // JWTService defines JWT service interface
@@ -45,6 +45,8 @@ type oauthUseCase struct {
 	userRepo     repositories.UserRepository
 	oauthService *oauth.Service
 	jwtService   JWTService
+	residency    services.DataResidencyService
+	events       services.EventRecorder
 }
 
 // NewOAuthUseCase creates a new OAuth use case
@@ -52,11 +54,15 @@ func NewOAuthUseCase(
 	userRepo repositories.UserRepository,
 	oauthService *oauth.Service,
 	jwtService JWTService,
+	residency services.DataResidencyService,
+	events services.EventRecorder,
 ) OAuthUseCase {
 	return &oauthUseCase{
 		userRepo:     userRepo,
 		oauthService: oauthService,
 		jwtService:   jwtService,
+		residency:    residency,
+		events:       events,
 	}
 }
 
@@ -205,7 +211,7 @@ func (uc *oauthUseCase) updateUserOAuthInfo(ctx context.Context, user *entities.
 // createOAuthUser creates a new user from OAuth information
 func (uc *oauthUseCase) createOAuthUser(ctx context.Context, userInfo *config.OAuthUserInfo) (*entities.User, error) {
 	user := &entities.User{
-		ID:            uuid.New(),
+		ID:            ids.New(),
 		Email:         userInfo.Email,
 		FirstName:     userInfo.FirstName,
 		LastName:      userInfo.LastName,
@@ -215,6 +221,7 @@ func (uc *oauthUseCase) createOAuthUser(ctx context.Context, userInfo *config.OA
 		Avatar:        userInfo.Picture,
 		IsOAuthUser:   true,
 		EmailVerified: userInfo.Verified,
+		DataRegion:    uc.residency.RegionForCountry(entities.StorefrontFromContext(ctx).Country),
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
@@ -238,6 +245,10 @@ func (uc *oauthUseCase) createOAuthUser(ctx context.Context, userInfo *config.OA
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	if uc.events != nil {
+		uc.events.Record(ctx, entities.DomainEventUserRegistered, user.ID, entities.NewUserRegisteredEventData(user, string(userInfo.Provider)))
+	}
+
 	return user, nil
 }
 
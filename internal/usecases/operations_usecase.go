@@ -0,0 +1,333 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// OperationsUseCase defines the routine fixes operators run from the admin CLI instead of
+// editing the database by hand
+type OperationsUseCase interface {
+	CreateAdminUser(ctx context.Context, req CreateAdminUserRequest) (*UserResponse, error)
+	// ResetPassword sets a user's password and signs them out everywhere
+	ResetPassword(ctx context.Context, email, newPassword string) error
+	// GrantRole changes a user's role and returns the role they had before
+	GrantRole(ctx context.Context, email string, role entities.UserRole) (entities.UserRole, error)
+	// RequeueNotification puts a failed notification back in the delivery queue
+	RequeueNotification(ctx context.Context, id uuid.UUID) error
+	// RequeueFailedNotifications requeues up to limit failed notifications and returns how many
+	RequeueFailedNotifications(ctx context.Context, limit int) (int, error)
+	// RecalculateProductRatings rebuilds the rating summary of one product, or of every
+	// reviewed product when productID is nil
+	RecalculateProductRatings(ctx context.Context, productID *uuid.UUID) error
+	// ExpireStaleCheckouts marks active checkout sessions past their expiry as expired and
+	// returns how many
+	ExpireStaleCheckouts(ctx context.Context) (int, error)
+	// PasswordHashReport counts the stored password hashes by algorithm and how many still
+	// have to be upgraded to the current hash settings
+	PasswordHashReport(ctx context.Context) (*PasswordHashReport, error)
+}
+
+type operationsUseCase struct {
+	userRepo          repositories.UserRepository
+	userSessionRepo   repositories.UserSessionRepository
+	notificationRepo  repositories.NotificationRepository
+	productRatingRepo repositories.ProductRatingRepository
+	checkoutRepo      repositories.CheckoutSessionRepository
+	auditRepo         repositories.AuditRepository
+	passwordService   services.PasswordService
+	actor             string
+}
+
+// NewOperationsUseCase creates a new operations use case. actor names who runs the
+// operations in the audit log, e.g. the operator's login.
+func NewOperationsUseCase(
+	userRepo repositories.UserRepository,
+	userSessionRepo repositories.UserSessionRepository,
+	notificationRepo repositories.NotificationRepository,
+	productRatingRepo repositories.ProductRatingRepository,
+	checkoutRepo repositories.CheckoutSessionRepository,
+	auditRepo repositories.AuditRepository,
+	passwordService services.PasswordService,
+	actor string,
+) OperationsUseCase {
+	return &operationsUseCase{
+		userRepo:          userRepo,
+		userSessionRepo:   userSessionRepo,
+		notificationRepo:  notificationRepo,
+		productRatingRepo: productRatingRepo,
+		checkoutRepo:      checkoutRepo,
+		auditRepo:         auditRepo,
+		passwordService:   passwordService,
+		actor:             actor,
+	}
+}
+
+// CreateAdminUserRequest represents the details of a new admin account
+type CreateAdminUserRequest struct {
+	Email     string
+	Password  string
+	FirstName string
+	LastName  string
+}
+
+// PasswordHashReport shows how far users' password hashes have been upgraded. Outdated hashes
+// are replaced when their users next sign in.
+type PasswordHashReport struct {
+	Total       int            `json:"total"`
+	Current     int            `json:"current"`
+	Outdated    int            `json:"outdated"`     // Another algorithm or older parameters
+	ByAlgorithm map[string]int `json:"by_algorithm"` // "unknown" for unrecognized hashes
+}
+
+const (
+	// expireCheckoutsBatchSize is how many checkout sessions are expired per query
+	expireCheckoutsBatchSize = 500
+	// passwordHashReportBatchSize is how many password hashes are read per query
+	passwordHashReportBatchSize = 1000
+)
+
+// CreateAdminUser creates an active admin account with a verified email
+func (uc *operationsUseCase) CreateAdminUser(ctx context.Context, req CreateAdminUserRequest) (*UserResponse, error) {
+	email := strings.TrimSpace(req.Email)
+	if email == "" || req.FirstName == "" || req.LastName == "" {
+		return nil, pkgErrors.InvalidInput("email, first name and last name are required")
+	}
+	if err := validatePasswordComplexity(req.Password); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	exists, err := uc.userRepo.ExistsByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "A user with this email already exists, use grant-role to make them an admin")
+	}
+
+	hashedPassword, err := uc.passwordService.HashPassword(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	now := time.Now()
+	user := &entities.User{
+		ID:            ids.New(),
+		Email:         email,
+		Password:      hashedPassword,
+		FirstName:     req.FirstName,
+		LastName:      req.LastName,
+		Role:          entities.UserRoleAdmin,
+		Status:        entities.UserStatusActive,
+		IsActive:      true,
+		EmailVerified: true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	uc.audit(ctx, user.ID, "admin_user_created", "Admin account created", entities.SecuritySeverityHigh, nil)
+
+	return &UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Role:      user.Role,
+		IsActive:  user.IsActive,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}, nil
+}
+
+// ResetPassword sets a user's password and invalidates their sessions
+func (uc *operationsUseCase) ResetPassword(ctx context.Context, email, newPassword string) error {
+	if err := validatePasswordComplexity(newPassword); err != nil {
+		return pkgErrors.InvalidInput(err.Error())
+	}
+
+	user, err := uc.getUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := uc.passwordService.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	if err := uc.userSessionRepo.InvalidateUserSessions(ctx, user.ID); err != nil {
+		return fmt.Errorf("password was reset but sessions could not be invalidated: %w", err)
+	}
+
+	uc.audit(ctx, user.ID, "password_reset_by_operator", "Password reset by an operator", entities.SecuritySeverityHigh, nil)
+	return nil
+}
+
+// GrantRole changes a user's role
+func (uc *operationsUseCase) GrantRole(ctx context.Context, email string, role entities.UserRole) (entities.UserRole, error) {
+	switch role {
+	case entities.UserRoleCustomer, entities.UserRoleModerator, entities.UserRoleAdmin:
+	default:
+		return "", pkgErrors.InvalidInput(fmt.Sprintf("invalid role %q, use %s, %s or %s",
+			role, entities.UserRoleCustomer, entities.UserRoleModerator, entities.UserRoleAdmin))
+	}
+
+	user, err := uc.getUserByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	previous := user.Role
+	if previous == role {
+		return previous, nil
+	}
+
+	user.Role = role
+	user.UpdatedAt = time.Now()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return "", fmt.Errorf("failed to update role: %w", err)
+	}
+
+	uc.audit(ctx, user.ID, "role_changed_by_operator", fmt.Sprintf("Role changed from %s to %s", previous, role),
+		entities.SecuritySeverityHigh, map[string]interface{}{
+			"previous_role": previous,
+			"role":          role,
+		})
+	return previous, nil
+}
+
+// RequeueNotification requeues a notification that failed or got stuck processing
+func (uc *operationsUseCase) RequeueNotification(ctx context.Context, id uuid.UUID) error {
+	notification, err := uc.notificationRepo.GetByID(ctx, id)
+	if err != nil {
+		return pkgErrors.New(pkgErrors.ErrCodeNotFound, "Notification not found")
+	}
+	if !notification.CanRequeue() {
+		return pkgErrors.New(pkgErrors.ErrCodeConflict,
+			fmt.Sprintf("Notification is %s, only failed or processing notifications can be requeued", notification.Status))
+	}
+
+	notification.Requeue()
+	return uc.notificationRepo.Update(ctx, notification)
+}
+
+// RequeueFailedNotifications requeues the oldest failed notifications
+func (uc *operationsUseCase) RequeueFailedNotifications(ctx context.Context, limit int) (int, error) {
+	// Every failed notification, however many times it was retried
+	notifications, err := uc.notificationRepo.GetFailedNotifications(ctx, math.MaxInt32, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load failed notifications: %w", err)
+	}
+
+	requeued := 0
+	for _, notification := range notifications {
+		notification.Requeue()
+		if err := uc.notificationRepo.Update(ctx, notification); err != nil {
+			return requeued, fmt.Errorf("failed to requeue notification %s: %w", notification.ID, err)
+		}
+		requeued++
+	}
+	return requeued, nil
+}
+
+// RecalculateProductRatings rebuilds product rating summaries from their reviews
+func (uc *operationsUseCase) RecalculateProductRatings(ctx context.Context, productID *uuid.UUID) error {
+	if productID != nil {
+		return uc.productRatingRepo.RecalculateRating(ctx, *productID)
+	}
+	return uc.productRatingRepo.RecalculateAllRatings(ctx)
+}
+
+// ExpireStaleCheckouts expires stale checkout sessions in batches until none are left
+func (uc *operationsUseCase) ExpireStaleCheckouts(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		sessions, err := uc.checkoutRepo.GetExpiredSessions(ctx, expireCheckoutsBatchSize)
+		if err != nil {
+			return expired, fmt.Errorf("failed to load stale checkout sessions: %w", err)
+		}
+		if len(sessions) == 0 {
+			return expired, nil
+		}
+
+		ids := make([]uuid.UUID, len(sessions))
+		for i, session := range sessions {
+			ids[i] = session.ID
+		}
+		if err := uc.checkoutRepo.MarkAsExpired(ctx, ids); err != nil {
+			return expired, fmt.Errorf("failed to expire checkout sessions: %w", err)
+		}
+		expired += len(ids)
+
+		if len(sessions) < expireCheckoutsBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// PasswordHashReport reads every password hash in batches and classifies it
+func (uc *operationsUseCase) PasswordHashReport(ctx context.Context) (*PasswordHashReport, error) {
+	report := &PasswordHashReport{ByAlgorithm: map[string]int{}}
+	afterID := uuid.Nil
+	for {
+		users, err := uc.userRepo.ListPasswordHashes(ctx, afterID, passwordHashReportBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load password hashes: %w", err)
+		}
+
+		for _, user := range users {
+			algorithm := uc.passwordService.HashAlgorithm(user.Password)
+			if algorithm == "" {
+				algorithm = "unknown"
+			}
+			report.ByAlgorithm[algorithm]++
+			report.Total++
+			if uc.passwordService.NeedsRehash(user.Password) {
+				report.Outdated++
+			} else {
+				report.Current++
+			}
+		}
+
+		if len(users) < passwordHashReportBatchSize {
+			return report, nil
+		}
+		afterID = users[len(users)-1].ID
+	}
+}
+
+func (uc *operationsUseCase) getUserByEmail(ctx context.Context, email string) (*entities.User, error) {
+	user, err := uc.userRepo.GetByEmail(ctx, strings.TrimSpace(email))
+	if errors.Is(err, entities.ErrUserNotFound) {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeNotFound, "User not found")
+	}
+	return user, err
+}
+
+// audit records an operation in the security log. Failures are only reported, since the
+// operation itself has already been applied.
+func (uc *operationsUseCase) audit(ctx context.Context, userID uuid.UUID, event, description string, severity entities.SecuritySeverity, metadata map[string]interface{}) {
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["actor"] = uc.actor
+	if err := uc.auditRepo.LogSecurityEvent(ctx, &userID, event, description, severity, metadata); err != nil {
+		fmt.Printf("⚠️ Failed to audit %s for user %s: %v\n", event, userID, err)
+	}
+}
@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// OrderArchiveUseCase defines the interface for archiving old orders and restoring them on demand
+type OrderArchiveUseCase interface {
+	// ArchiveOldOrders archives the orders that are past the archive age and returns how many
+	// were archived
+	ArchiveOldOrders(ctx context.Context) (int, error)
+	// RestoreOrder moves an archived order's items and events back, so it can be worked on again
+	RestoreOrder(ctx context.Context, orderID uuid.UUID) error
+	// GetArchivedOrder gets an order's archived rows without restoring them
+	GetArchivedOrder(ctx context.Context, orderID uuid.UUID) (*entities.OrderArchive, error)
+	GetArchiveStatus(ctx context.Context) (*OrderArchiveStatusResponse, error)
+}
+
+type orderArchiveUseCase struct {
+	archiveRepo repositories.OrderArchiveRepository
+	afterYears  int
+	batchSize   int
+	running     sync.Mutex
+}
+
+// NewOrderArchiveUseCase creates a new order archive use case. Orders are archived afterYears
+// after they were created; 0 disables archiving, but archived orders can still be restored.
+func NewOrderArchiveUseCase(
+	archiveRepo repositories.OrderArchiveRepository,
+	afterYears int,
+	batchSize int,
+) OrderArchiveUseCase {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &orderArchiveUseCase{
+		archiveRepo: archiveRepo,
+		afterYears:  afterYears,
+		batchSize:   batchSize,
+	}
+}
+
+// OrderArchiveStatusResponse represents the archive settings and contents
+type OrderArchiveStatusResponse struct {
+	Enabled    bool       `json:"enabled"`
+	AfterYears int        `json:"after_years,omitempty"`
+	Cutoff     *time.Time `json:"cutoff,omitempty"` // Orders created and last updated before this are archived
+	entities.OrderArchiveTotals
+}
+
+// ArchiveOldOrders archives eligible orders in batches until none are left
+func (uc *orderArchiveUseCase) ArchiveOldOrders(ctx context.Context) (int, error) {
+	if uc.afterYears <= 0 {
+		return 0, pkgErrors.InvalidInput("Order archiving is disabled")
+	}
+	if !uc.running.TryLock() {
+		return 0, pkgErrors.New(pkgErrors.ErrCodeConflict, "Orders are already being archived")
+	}
+	defer uc.running.Unlock()
+
+	cutoff := uc.cutoff()
+	archived := 0
+	for {
+		orderIDs, err := uc.archiveRepo.FindArchivableOrderIDs(ctx, cutoff, entities.OrderArchivableStatuses, uc.batchSize)
+		if err != nil {
+			return archived, fmt.Errorf("failed to find orders to archive: %w", err)
+		}
+		if len(orderIDs) == 0 {
+			return archived, nil
+		}
+
+		count, err := uc.archiveRepo.ArchiveOrders(ctx, orderIDs)
+		if err != nil {
+			return archived, fmt.Errorf("failed to archive orders: %w", err)
+		}
+		archived += count
+
+		if len(orderIDs) < uc.batchSize {
+			return archived, nil
+		}
+	}
+}
+
+// RestoreOrder restores an archived order. Restoring updates the order, so it is only archived
+// again once it is past the archive age from now.
+func (uc *orderArchiveUseCase) RestoreOrder(ctx context.Context, orderID uuid.UUID) error {
+	return uc.archiveRepo.RestoreOrder(ctx, orderID)
+}
+
+// GetArchivedOrder gets an order's archived items and events
+func (uc *orderArchiveUseCase) GetArchivedOrder(ctx context.Context, orderID uuid.UUID) (*entities.OrderArchive, error) {
+	return uc.archiveRepo.GetArchive(ctx, orderID)
+}
+
+// GetArchiveStatus gets the archive age and what the archive holds
+func (uc *orderArchiveUseCase) GetArchiveStatus(ctx context.Context) (*OrderArchiveStatusResponse, error) {
+	totals, err := uc.archiveRepo.GetTotals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrderArchiveStatusResponse{
+		Enabled:            uc.afterYears > 0,
+		OrderArchiveTotals: *totals,
+	}
+	if response.Enabled {
+		cutoff := uc.cutoff()
+		response.AfterYears = uc.afterYears
+		response.Cutoff = &cutoff
+	}
+	return response, nil
+}
+
+func (uc *orderArchiveUseCase) cutoff() time.Time {
+	return time.Now().AddDate(-uc.afterYears, 0, 0)
+}
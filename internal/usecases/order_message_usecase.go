@@ -0,0 +1,260 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// OrderMessageUseCase defines use cases for the message thread between a customer and the store on an order
+type OrderMessageUseCase interface {
+	// Customer side
+	SendCustomerMessage(ctx context.Context, userID, orderID uuid.UUID, req SendOrderMessageRequest) (*OrderMessageResponse, error)
+	GetCustomerMessages(ctx context.Context, userID, orderID uuid.UUID) (*OrderMessagesResponse, error)
+	MarkReadByCustomer(ctx context.Context, userID, orderID uuid.UUID) error
+
+	// Store side
+	SendStoreMessage(ctx context.Context, adminID, orderID uuid.UUID, req SendOrderMessageRequest) (*OrderMessageResponse, error)
+	MarkReadByStore(ctx context.Context, orderID uuid.UUID) error
+
+	// GetOrderMessages returns an order's messages with the unread count for the given reader, without an ownership check
+	GetOrderMessages(ctx context.Context, orderID uuid.UUID, reader entities.OrderMessageSender) (*OrderMessagesResponse, error)
+}
+
+// OrderMessageNotificationService interface for order message notifications
+type OrderMessageNotificationService interface {
+	NotifyOrderMessage(ctx context.Context, order *entities.Order, message *entities.OrderMessage) error
+}
+
+type orderMessageUseCase struct {
+	messageRepo         repositories.OrderMessageRepository
+	orderRepo           repositories.OrderRepository
+	fileService         services.FileService
+	notificationService OrderMessageNotificationService
+}
+
+// NewOrderMessageUseCase creates a new order message use case
+func NewOrderMessageUseCase(
+	messageRepo repositories.OrderMessageRepository,
+	orderRepo repositories.OrderRepository,
+	fileService services.FileService,
+	notificationService OrderMessageNotificationService,
+) OrderMessageUseCase {
+	return &orderMessageUseCase{
+		messageRepo:         messageRepo,
+		orderRepo:           orderRepo,
+		fileService:         fileService,
+		notificationService: notificationService,
+	}
+}
+
+// SendOrderMessageRequest represents a request to send a message on an order
+type SendOrderMessageRequest struct {
+	Body string `json:"body" validate:"max=2000"`
+	// AttachmentIDs are IDs of files uploaded beforehand through the image or document upload endpoints
+	AttachmentIDs []string `json:"attachment_ids" validate:"max=5"`
+}
+
+// OrderMessageSenderResponse represents who sent a message
+type OrderMessageSenderResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// OrderMessageResponse represents a message on an order
+type OrderMessageResponse struct {
+	ID          uuid.UUID                         `json:"id"`
+	OrderID     uuid.UUID                         `json:"order_id"`
+	SenderType  entities.OrderMessageSender       `json:"sender_type"`
+	Sender      *OrderMessageSenderResponse       `json:"sender,omitempty"`
+	Body        string                            `json:"body"`
+	Attachments []entities.OrderMessageAttachment `json:"attachments"`
+	IsRead      bool                              `json:"is_read"`
+	ReadAt      *time.Time                        `json:"read_at,omitempty"`
+	CreatedAt   time.Time                         `json:"created_at"`
+}
+
+// OrderMessagesResponse represents an order's message thread
+type OrderMessagesResponse struct {
+	Messages    []*OrderMessageResponse `json:"messages"`
+	UnreadCount int                     `json:"unread_count"`
+}
+
+// SendCustomerMessage sends a message from the customer who placed the order
+func (uc *orderMessageUseCase) SendCustomerMessage(ctx context.Context, userID, orderID uuid.UUID, req SendOrderMessageRequest) (*OrderMessageResponse, error) {
+	order, err := uc.getCustomerOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.send(ctx, order, entities.OrderMessageSenderCustomer, userID, req)
+}
+
+// SendStoreMessage sends a message from the store to the customer
+func (uc *orderMessageUseCase) SendStoreMessage(ctx context.Context, adminID, orderID uuid.UUID, req SendOrderMessageRequest) (*OrderMessageResponse, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, entities.ErrOrderNotFound
+	}
+	return uc.send(ctx, order, entities.OrderMessageSenderStore, adminID, req)
+}
+
+// GetCustomerMessages gets the message thread of one of the customer's orders
+func (uc *orderMessageUseCase) GetCustomerMessages(ctx context.Context, userID, orderID uuid.UUID) (*OrderMessagesResponse, error) {
+	if _, err := uc.getCustomerOrder(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+	return uc.GetOrderMessages(ctx, orderID, entities.OrderMessageSenderCustomer)
+}
+
+// GetOrderMessages gets an order's message thread
+func (uc *orderMessageUseCase) GetOrderMessages(ctx context.Context, orderID uuid.UUID, reader entities.OrderMessageSender) (*OrderMessagesResponse, error) {
+	messages, err := uc.messageRepo.ListByOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order messages: %w", err)
+	}
+
+	response := &OrderMessagesResponse{
+		Messages: make([]*OrderMessageResponse, len(messages)),
+	}
+	for i, message := range messages {
+		response.Messages[i] = toOrderMessageResponse(message)
+		if message.Recipient() == reader && !message.IsRead() {
+			response.UnreadCount++
+		}
+	}
+	return response, nil
+}
+
+// MarkReadByCustomer marks the store's messages on the customer's order as read
+func (uc *orderMessageUseCase) MarkReadByCustomer(ctx context.Context, userID, orderID uuid.UUID) error {
+	if _, err := uc.getCustomerOrder(ctx, userID, orderID); err != nil {
+		return err
+	}
+	if _, err := uc.messageRepo.MarkRead(ctx, orderID, entities.OrderMessageSenderStore, time.Now()); err != nil {
+		return fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+	return nil
+}
+
+// MarkReadByStore marks the customer's messages on an order as read
+func (uc *orderMessageUseCase) MarkReadByStore(ctx context.Context, orderID uuid.UUID) error {
+	if _, err := uc.orderRepo.GetByID(ctx, orderID); err != nil {
+		return entities.ErrOrderNotFound
+	}
+	if _, err := uc.messageRepo.MarkRead(ctx, orderID, entities.OrderMessageSenderCustomer, time.Now()); err != nil {
+		return fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+	return nil
+}
+
+// send stores a message on the order and notifies the other side
+func (uc *orderMessageUseCase) send(ctx context.Context, order *entities.Order, senderType entities.OrderMessageSender, senderID uuid.UUID, req SendOrderMessageRequest) (*OrderMessageResponse, error) {
+	attachments, err := uc.resolveAttachments(ctx, senderType, senderID, req.AttachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &entities.OrderMessage{
+		OrderID:     order.ID,
+		SenderType:  senderType,
+		SenderID:    senderID,
+		Body:        strings.TrimSpace(req.Body),
+		Attachments: attachments,
+	}
+	if err := message.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.messageRepo.Create(ctx, message); err != nil {
+		return nil, fmt.Errorf("failed to create order message: %w", err)
+	}
+
+	created, err := uc.messageRepo.GetByID(ctx, message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.notificationService != nil {
+		go func(o entities.Order, m entities.OrderMessage) {
+			if err := uc.notificationService.NotifyOrderMessage(context.Background(), &o, &m); err != nil {
+				fmt.Printf("Failed to send order message notification: %v\n", err)
+			}
+		}(*order, *created)
+	}
+
+	return toOrderMessageResponse(created), nil
+}
+
+// resolveAttachments turns uploaded file IDs into attachment snapshots. Customers can only attach their own uploads.
+func (uc *orderMessageUseCase) resolveAttachments(ctx context.Context, senderType entities.OrderMessageSender, senderID uuid.UUID, fileIDs []string) ([]entities.OrderMessageAttachment, error) {
+	if len(fileIDs) > entities.MaxOrderMessageAttachments {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("message can have at most %d attachments", entities.MaxOrderMessageAttachments))
+	}
+
+	attachments := make([]entities.OrderMessageAttachment, 0, len(fileIDs))
+	seen := make(map[string]bool, len(fileIDs))
+	for _, fileID := range fileIDs {
+		if seen[fileID] {
+			continue
+		}
+		seen[fileID] = true
+
+		file, err := uc.fileService.GetFileUpload(ctx, fileID)
+		if err != nil || file == nil {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("attachment %s not found", fileID))
+		}
+		if senderType == entities.OrderMessageSenderCustomer && (file.UploadedBy == nil || *file.UploadedBy != senderID.String()) {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("attachment %s not found", fileID))
+		}
+
+		attachments = append(attachments, entities.OrderMessageAttachment{
+			FileID:      file.ID,
+			FileName:    file.OriginalName,
+			URL:         file.URL,
+			ContentType: file.ContentType,
+			FileSize:    file.FileSize,
+		})
+	}
+	return attachments, nil
+}
+
+// getCustomerOrder gets an order owned by the customer; other customers' orders are reported as not found
+func (uc *orderMessageUseCase) getCustomerOrder(ctx context.Context, userID, orderID uuid.UUID) (*entities.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil || order.UserID != userID {
+		return nil, entities.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// toOrderMessageResponse converts a message entity to its response
+func toOrderMessageResponse(message *entities.OrderMessage) *OrderMessageResponse {
+	response := &OrderMessageResponse{
+		ID:          message.ID,
+		OrderID:     message.OrderID,
+		SenderType:  message.SenderType,
+		Body:        message.Body,
+		Attachments: message.Attachments,
+		IsRead:      message.IsRead(),
+		ReadAt:      message.ReadAt,
+		CreatedAt:   message.CreatedAt,
+	}
+	if response.Attachments == nil {
+		response.Attachments = []entities.OrderMessageAttachment{}
+	}
+	if message.Sender != nil {
+		response.Sender = &OrderMessageSenderResponse{
+			ID:   message.Sender.ID,
+			Name: message.Sender.GetFullName(),
+		}
+	}
+	return response
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
@@ -11,6 +12,8 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/services"
 	"ecom-golang-clean-architecture/internal/infrastructure/database"
 	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/utils"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -19,6 +22,7 @@ import (
 // OrderUseCase defines order use cases
 type OrderUseCase interface {
 	CreateOrder(ctx context.Context, userID uuid.UUID, req CreateOrderRequest) (*OrderResponse, error)
+	CreateOrderFromQuote(ctx context.Context, userID uuid.UUID, quote *entities.Quote, req CreateOrderRequest) (*OrderResponse, error)
 	GetOrder(ctx context.Context, orderID uuid.UUID) (*OrderResponse, error)
 	GetOrderBySessionID(ctx context.Context, sessionID string, userID uuid.UUID) (*OrderResponse, error)
 	GetUserOrders(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*OrderResponse, error)
@@ -36,6 +40,12 @@ type OrderUseCase interface {
 
 	// Order events
 	GetOrderEvents(ctx context.Context, orderID uuid.UUID, publicOnly bool) ([]*entities.OrderEvent, error)
+
+	// Printable documents; gift receipts, and packing slips for gifts, carry no prices
+	GetMyOrderInvoicePDF(ctx context.Context, userID, orderID uuid.UUID) ([]byte, string, error)
+	GetMyGiftReceiptPDF(ctx context.Context, userID, orderID uuid.UUID) ([]byte, string, error)
+	GetPackingSlipPDF(ctx context.Context, orderID uuid.UUID) ([]byte, string, error)
+	GetGiftReceiptPDF(ctx context.Context, orderID uuid.UUID) ([]byte, string, error)
 }
 
 // NotificationService interface for order notifications
@@ -52,12 +62,18 @@ type orderUseCase struct {
 	paymentRepo             repositories.PaymentRepository
 	inventoryRepo           repositories.InventoryRepository
 	orderEventRepo          repositories.OrderEventRepository
+	addressRepo             repositories.AddressRepository
 	orderService            services.OrderService
 	simpleStockService      services.SimpleStockService
 	orderEventService       services.OrderEventService
 	userMetricsService      services.UserMetricsService
 	notificationService     NotificationService
+	companyPolicy           CompanyOrderPolicy
+	taxExemptionPolicy      TaxExemptionPolicy
+	activityTracker         ActivityTracker
 	txManager               *database.TransactionManager
+	orderMessageUseCase     OrderMessageUseCase
+	priceDisplay            PriceDisplaySettings
 }
 
 // NewOrderUseCase creates a new order use case
@@ -68,12 +84,18 @@ func NewOrderUseCase(
 	paymentRepo repositories.PaymentRepository,
 	inventoryRepo repositories.InventoryRepository,
 	orderEventRepo repositories.OrderEventRepository,
+	addressRepo repositories.AddressRepository,
 	orderService services.OrderService,
 	simpleStockService services.SimpleStockService,
 	orderEventService services.OrderEventService,
 	userMetricsService services.UserMetricsService,
 	notificationService NotificationService,
+	companyPolicy CompanyOrderPolicy,
+	taxExemptionPolicy TaxExemptionPolicy,
+	activityTracker ActivityTracker,
 	txManager *database.TransactionManager,
+	orderMessageUseCase OrderMessageUseCase,
+	priceDisplay PriceDisplaySettings,
 ) OrderUseCase {
 	return &orderUseCase{
 		orderRepo:               orderRepo,
@@ -82,12 +104,18 @@ func NewOrderUseCase(
 		paymentRepo:             paymentRepo,
 		inventoryRepo:           inventoryRepo,
 		orderEventRepo:          orderEventRepo,
+		addressRepo:             addressRepo,
 		orderService:            orderService,
 		simpleStockService:      simpleStockService,
 		orderEventService:       orderEventService,
 		userMetricsService:      userMetricsService,
 		notificationService:     notificationService,
+		companyPolicy:           companyPolicy,
+		taxExemptionPolicy:      taxExemptionPolicy,
+		activityTracker:         activityTracker,
 		txManager:               txManager,
+		orderMessageUseCase:     orderMessageUseCase,
+		priceDisplay:            priceDisplay,
 	}
 }
 
@@ -95,6 +123,14 @@ func NewOrderUseCase(
 type CreateOrderRequest struct {
 	ShippingAddress AddressRequest         `json:"shipping_address" validate:"required"`
 	BillingAddress  *AddressRequest        `json:"billing_address"`
+
+	// Saved address book entries; when set they replace the inline addresses
+	ShippingAddressID *uuid.UUID `json:"shipping_address_id"`
+	BillingAddressID  *uuid.UUID `json:"billing_address_id"`
+
+	// Gift ships to the recipient at the shipping address; the buyer is billed
+	Gift *GiftRequest `json:"gift,omitempty"`
+
 	PaymentMethod   entities.PaymentMethod `json:"payment_method" validate:"required"`
 	Notes           string                 `json:"notes"`
 	TaxRate         float64                `json:"tax_rate" validate:"min=0,max=1"`
@@ -150,6 +186,29 @@ type AddressRequest struct {
 	ZipCode   string `json:"zip_code" validate:"required"`
 	Country   string `json:"country" validate:"required"`
 	Phone     string `json:"phone"`
+
+	// DeliveryInstructions are passed on to the carrier (shipping address only)
+	DeliveryInstructions string `json:"delivery_instructions"`
+}
+
+// GiftRequest marks an order as a gift. The shipping address is the recipient's, so a billing
+// address for the buyer is required; shipping updates go to the recipient's email, without prices.
+type GiftRequest struct {
+	RecipientEmail string `json:"recipient_email" validate:"required,email"`
+	Message        string `json:"message" validate:"max=500"`
+	Wrap           bool   `json:"wrap"`
+}
+
+// validate checks a gift request. Without a billing address the buyer's address would default
+// to the recipient's.
+func (g *GiftRequest) validate(hasBillingAddress bool) error {
+	if g.RecipientEmail == "" {
+		return fmt.Errorf("gift recipient email is required")
+	}
+	if !hasBillingAddress {
+		return fmt.Errorf("gift orders need a billing address for the buyer")
+	}
+	return entities.ValidateGiftOptions(true, g.RecipientEmail, g.Message)
 }
 
 // OrderResponse represents order response
@@ -186,6 +245,7 @@ type OrderResponse struct {
 	IsGift               bool                       `json:"is_gift"`
 	GiftMessage          string                     `json:"gift_message"`
 	GiftWrap             bool                       `json:"gift_wrap"`
+	GiftRecipientEmail   string                     `json:"gift_recipient_email,omitempty"`
 	Payment              *PaymentResponse           `json:"payment"`
 	ItemCount            int                        `json:"item_count"`
 	CanBeCancelled       bool                       `json:"can_be_cancelled"`
@@ -197,6 +257,20 @@ type OrderResponse struct {
 	HasTracking          bool                       `json:"has_tracking"`
 	CreatedAt            time.Time                  `json:"created_at"`
 	UpdatedAt            time.Time                  `json:"updated_at"`
+
+	// Company (B2B) orders
+	CompanyID      *uuid.UUID                   `json:"company_id,omitempty"`
+	ApprovalStatus entities.OrderApprovalStatus `json:"approval_status,omitempty"`
+
+	// TaxExemption is the tax exemption certificate the order was placed with
+	TaxExemption *entities.OrderTaxExemption `json:"tax_exemption,omitempty"`
+
+	// Archived orders are listed without their items until they are restored
+	IsArchived bool       `json:"is_archived"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Messages is the conversation with the store; only included in order detail
+	Messages *OrderMessagesResponse `json:"messages,omitempty"`
 }
 
 // OrderItemResponse represents order item response
@@ -208,6 +282,7 @@ type OrderItemResponse struct {
 	Quantity    int              `json:"quantity"`
 	Price       float64          `json:"price"`
 	Total       float64          `json:"total"`
+	TaxExempt   bool             `json:"tax_exempt"`
 }
 
 // OrderAddressResponse represents order address response
@@ -228,12 +303,40 @@ type OrderAddressResponse struct {
 func (uc *orderUseCase) CreateOrder(ctx context.Context, userID uuid.UUID, req CreateOrderRequest) (*OrderResponse, error) {
 	// Execute the entire order creation in a transaction
 	result, err := uc.txManager.WithTransactionResult(ctx, func(tx *gorm.DB) (interface{}, error) {
-		return uc.createOrderInTransaction(ctx, tx, userID, req)
+		return uc.createOrderInTransaction(ctx, tx, userID, req, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order := result.(*OrderResponse)
+	trackOrderPlaced(ctx, uc.activityTracker, userID, order)
+	return order, nil
+}
+
+// CreateOrderFromQuote creates an order from an accepted quote with item prices locked at the quoted prices
+func (uc *orderUseCase) CreateOrderFromQuote(ctx context.Context, userID uuid.UUID, quote *entities.Quote, req CreateOrderRequest) (*OrderResponse, error) {
+	if quote == nil || len(quote.Items) == 0 {
+		return nil, pkgErrors.InvalidInput("Quote has no items")
+	}
+
+	result, err := uc.txManager.WithTransactionResult(ctx, func(tx *gorm.DB) (interface{}, error) {
+		return uc.createOrderInTransaction(ctx, tx, userID, req, quote)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*OrderResponse), nil
+
+	order := result.(*OrderResponse)
+	trackOrderPlaced(ctx, uc.activityTracker, userID, order)
+	return order, nil
+}
+
+// trackOrderPlaced records a placed order in the customer's activity stream
+func trackOrderPlaced(ctx context.Context, tracker ActivityTracker, userID uuid.UUID, order *OrderResponse) {
+	trackActivity(ctx, tracker, userID, entities.ActivityTypeOrderPlace,
+		fmt.Sprintf("Placed order %s", order.OrderNumber), "order", &order.ID,
+		map[string]interface{}{"order_number": order.OrderNumber, "total": order.Total})
 }
 
 // validateCreateOrderRequest validates the create order request
@@ -250,6 +353,12 @@ func (uc *orderUseCase) validateCreateOrderRequest(req CreateOrderRequest) error
 		}
 	}
 
+	if req.Gift != nil {
+		if err := req.Gift.validate(req.BillingAddress != nil); err != nil {
+			return fmt.Errorf("invalid gift options: %w", err)
+		}
+	}
+
 	// Validate payment method
 	validPaymentMethods := []entities.PaymentMethod{
 		entities.PaymentMethodCreditCard,
@@ -259,7 +368,8 @@ func (uc *orderUseCase) validateCreateOrderRequest(req CreateOrderRequest) error
 		entities.PaymentMethodApplePay,
 		entities.PaymentMethodGooglePay,
 		entities.PaymentMethodBankTransfer,
-		entities.PaymentMethodCash, // Cash on Delivery (COD)
+		entities.PaymentMethodCash,    // Cash on Delivery (COD)
+		entities.PaymentMethodInvoice, // Net terms, company accounts only
 	}
 	isValidPaymentMethod := false
 	for _, method := range validPaymentMethods {
@@ -367,34 +477,62 @@ func (uc *orderUseCase) validateAddress(addr AddressRequest, addressType string)
 		}
 	}
 
+	if len(addr.DeliveryInstructions) > entities.MaxDeliveryInstructionsLength {
+		return fmt.Errorf("%s address delivery instructions cannot exceed %d characters", addressType, entities.MaxDeliveryInstructionsLength)
+	}
+
 	return nil
 }
 
-// createOrderInTransaction handles order creation within a transaction
-func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.DB, userID uuid.UUID, req CreateOrderRequest) (*OrderResponse, error) {
+// createOrderInTransaction handles order creation within a transaction.
+// When a quote is given its items and quoted prices are used instead of the user's cart.
+func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.DB, userID uuid.UUID, req CreateOrderRequest, quote *entities.Quote) (*OrderResponse, error) {
+	// Pre-fill and re-validate saved addresses
+	if err := resolveCheckoutAddresses(ctx, uc.addressRepo, userID, uc.memberCompanyID(ctx, userID), req.ShippingAddressID, req.BillingAddressID, &req.ShippingAddress, &req.BillingAddress); err != nil {
+		return nil, err
+	}
+
 	// Validate request data
 	if err := uc.validateCreateOrderRequest(req); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInvalidInput, "Invalid order request")
 	}
 
-	// Get user's cart
-	cart, err := uc.cartRepo.GetByUserID(ctx, userID)
-	if err != nil {
-		return nil, pkgErrors.CartNotFound()
+	// Invoice checkout is reserved for companies approved for net terms
+	if req.PaymentMethod == entities.PaymentMethodInvoice {
+		if uc.companyPolicy == nil {
+			return nil, pkgErrors.InvalidInput("Invoice payment is not available")
+		}
+		if err := uc.companyPolicy.CheckNetTerms(ctx, userID); err != nil {
+			return nil, err
+		}
 	}
 
-	if cart.IsEmpty() {
-		return nil, pkgErrors.InvalidInput("Cart is empty")
+	// Get order lines from the quote or the user's cart
+	var cart *entities.Cart
+	var items []entities.CartItem
+	if quote != nil {
+		items = quote.ToCartItems()
+	} else {
+		var err error
+		cart, err = uc.cartRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, pkgErrors.CartNotFound()
+		}
+
+		if cart.IsEmpty() {
+			return nil, pkgErrors.InvalidInput("Cart is empty")
+		}
+		items = cart.Items
 	}
 
 	// Validate cart items
-	if err := uc.orderService.ValidateOrderItems(cart.Items); err != nil {
+	if err := uc.orderService.ValidateOrderItems(items); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInvalidInput, "Invalid cart items")
 	}
 
 	// Bulk check product availability to avoid N+1 queries
-	productIDs := make([]uuid.UUID, len(cart.Items))
-	for i, item := range cart.Items {
+	productIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
 		productIDs[i] = item.ProductID
 	}
 
@@ -404,7 +542,7 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 	}
 
 	// Validate products and stock availability
-	for _, item := range cart.Items {
+	for _, item := range items {
 		product, exists := products[item.ProductID]
 		if !exists {
 			return nil, pkgErrors.ProductNotFound().WithContext("product_id", item.ProductID)
@@ -427,26 +565,30 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 
 	// Calculate totals
 	subtotal, taxAmount, total := uc.orderService.CalculateOrderTotal(
-		cart.Items, req.TaxRate, req.ShippingCost, req.DiscountAmount,
+		items, req.TaxRate, req.ShippingCost, req.DiscountAmount,
 	)
 
-	// Generate unique order number
-	orderNumber, err := uc.orderService.GenerateUniqueOrderNumber(ctx)
+	// Verified tax exemption certificates take the exempt items' tax off
+	taxClasses := make(map[uuid.UUID]string, len(products))
+	for id, product := range products {
+		taxClasses[id] = product.TaxClass
+	}
+	taxExemption, err := applyTaxExemption(ctx, uc.taxExemptionPolicy, userID, items, taxClasses, req.TaxRate,
+		req.ShippingAddress.Country, req.ShippingAddress.State, &taxAmount, &total)
 	if err != nil {
-		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to generate order number")
+		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply tax exemption")
 	}
 
 	// Determine initial payment status based on payment method
 	initialPaymentStatus := entities.PaymentStatusPending
-	if req.PaymentMethod == entities.PaymentMethodCash {
-		// COD orders start with "awaiting_payment" status
+	if req.PaymentMethod == entities.PaymentMethodCash || req.PaymentMethod == entities.PaymentMethodInvoice {
+		// COD and net terms orders start with "awaiting_payment" status
 		initialPaymentStatus = entities.PaymentStatusAwaitingPayment
 	}
 
 	// Create order with reservation fields
 	order := &entities.Order{
-		ID:             uuid.New(),
-		OrderNumber:    orderNumber,
+		ID:             ids.New(),
 		UserID:         userID,
 		Status:         entities.OrderStatusPending,
 		PaymentStatus:  initialPaymentStatus,
@@ -472,6 +614,16 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 	// Set payment timeout
 	order.SetPaymentTimeout(24) // 24 hours for payment
 
+	// Delivery instructions from the shipping address are passed on to the carrier
+	order.DeliveryInstructions = req.ShippingAddress.DeliveryInstructions
+
+	if req.Gift != nil {
+		order.IsGift = true
+		order.GiftRecipientEmail = req.Gift.RecipientEmail
+		order.GiftMessage = req.Gift.Message
+		order.GiftWrap = req.Gift.Wrap
+	}
+
 	// Set addresses
 	order.ShippingAddress = &entities.OrderAddress{
 		FirstName: req.ShippingAddress.FirstName,
@@ -504,24 +656,28 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 	}
 
 	// Create order items using bulk data
-	for _, cartItem := range cart.Items {
+	for _, cartItem := range items {
 		product := products[cartItem.ProductID]
 
-		// Validate price consistency
-		if cartItem.Price != product.Price {
+		// Validate price consistency (sale price applies only inside its sale window)
+		currentPrice := product.GetCurrentPrice()
+		if quote != nil {
+			// Quoted prices are locked and take precedence over the catalog price
+			currentPrice = cartItem.Price
+		} else if cartItem.Price != currentPrice {
 			// Log warning but use current product price for order
 			// This handles price changes between cart and order creation
 		}
 
 		orderItem := entities.OrderItem{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			OrderID:     order.ID,
 			ProductID:   cartItem.ProductID,
 			ProductName: product.Name,
 			ProductSKU:  product.SKU,
 			Quantity:    cartItem.Quantity,
-			Price:       product.Price, // Use current product price
-			Total:       float64(cartItem.Quantity) * product.Price,
+			Price:       currentPrice, // Use current product price
+			Total:       float64(cartItem.Quantity) * currentPrice,
 			Weight:      getProductWeight(product.Weight), // Add weight from product
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
@@ -532,16 +688,35 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 
 	// Update order total weight
 	order.UpdateTotalWeight()
+	order.ApplyTaxExemption(taxExemption)
 
-	// Create order within transaction
-	if err := uc.orderRepo.Create(ctx, order); err != nil {
+	// Link company orders and flag those over the buyer's spend limit for approval
+	if uc.companyPolicy != nil {
+		if err := uc.companyPolicy.PrepareOrder(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to apply company order policy")
+		}
+	}
+
+	// Number and create the order
+	if err := uc.orderService.CreateOrder(ctx, order); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to create order")
 	}
 
+	if uc.companyPolicy != nil {
+		if err := uc.companyPolicy.RequestApproval(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to request order approval")
+		}
+
+		// Net terms orders complete without a charge; the balance is billed on an invoice
+		if err := uc.companyPolicy.IssueOrderInvoice(ctx, order); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to issue order invoice")
+		}
+	}
+
 	// For COD orders, create a pending payment record
 	if req.PaymentMethod == entities.PaymentMethodCash {
 		codPayment := &entities.Payment{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			OrderID:   order.ID,
 			UserID:    userID,
 			Amount:    total,
@@ -570,7 +745,7 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 
 	// For bank transfer, only check stock availability - stock will be reduced when payment is confirmed
 	// This is consistent with COD and other payment methods
-	if err := uc.simpleStockService.CheckStockAvailability(ctx, cart.Items); err != nil {
+	if err := uc.simpleStockService.CheckStockAvailability(ctx, items); err != nil {
 		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInsufficientStock, "Stock not available")
 	}
 	// Stock availability already checked above
@@ -579,14 +754,17 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 	}
 
 	// FIXED: Mark cart as converted and clear items atomically within transaction
-	cart.MarkAsConverted()
-	if err := uc.cartRepo.Update(ctx, cart); err != nil {
-		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to update cart status")
-	}
+	// Quote orders leave the cart untouched; it was already emptied when the quote was requested
+	if cart != nil {
+		cart.MarkAsConverted()
+		if err := uc.cartRepo.Update(ctx, cart); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to update cart status")
+		}
 
-	// FIXED: Clear cart items within transaction - if this fails, entire transaction should fail
-	if err := uc.cartRepo.ClearCart(ctx, cart.ID); err != nil {
-		return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to clear cart items")
+		// FIXED: Clear cart items within transaction - if this fails, entire transaction should fail
+		if err := uc.cartRepo.ClearCart(ctx, cart.ID); err != nil {
+			return nil, pkgErrors.Wrap(err, pkgErrors.ErrCodeInternalError, "Failed to clear cart items")
+		}
 	}
 
 	// Create events within transaction to ensure consistency
@@ -622,6 +800,14 @@ func (uc *orderUseCase) createOrderInTransaction(ctx context.Context, tx *gorm.D
 	return uc.toOrderResponse(createdOrder), nil
 }
 
+// memberCompanyID returns the company whose shared addresses the user may use at checkout
+func (uc *orderUseCase) memberCompanyID(ctx context.Context, userID uuid.UUID) *uuid.UUID {
+	if uc.companyPolicy == nil {
+		return nil
+	}
+	return uc.companyPolicy.GetMemberCompanyID(ctx, userID)
+}
+
 // getProductWeight safely extracts weight from product
 func getProductWeight(weight *float64) float64 {
 	if weight == nil {
@@ -654,7 +840,18 @@ func (uc *orderUseCase) GetOrder(ctx context.Context, orderID uuid.UUID) (*Order
 		return nil, entities.ErrOrderNotFound
 	}
 
-	return uc.toOrderResponse(order), nil
+	response := uc.toOrderResponse(order)
+	if uc.orderMessageUseCase != nil {
+		messages, err := uc.orderMessageUseCase.GetOrderMessages(ctx, order.ID, entities.OrderMessageSenderCustomer)
+		if err != nil {
+			// Log error but don't fail the request
+			fmt.Printf("❌ Failed to get order messages: %v\n", err)
+		} else {
+			response.Messages = messages
+		}
+	}
+
+	return response, nil
 }
 
 // GetOrderBySessionID gets an order by checkout session ID
@@ -769,6 +966,11 @@ func (uc *orderUseCase) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID
 		return nil, entities.ErrOrderNotFound
 	}
 
+	// Company orders awaiting approval can only be cancelled
+	if order.IsAwaitingApproval() && status != entities.OrderStatusCancelled {
+		return nil, entities.ErrOrderAwaitingApproval
+	}
+
 	oldStatus := order.Status
 
 	// Update fulfillment status based on order status
@@ -911,7 +1113,15 @@ func (uc *orderUseCase) CancelOrder(ctx context.Context, orderID uuid.UUID) (*Or
 
 	// Order cancelled successfully - no inventory release event needed with simple stock service
 
-	return uc.UpdateOrderStatus(ctx, orderID, entities.OrderStatusCancelled)
+	response, err := uc.UpdateOrderStatus(ctx, orderID, entities.OrderStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	trackActivity(ctx, uc.activityTracker, order.UserID, entities.ActivityTypeOrderCancel,
+		fmt.Sprintf("Cancelled order %s", order.OrderNumber), "order", &order.ID,
+		map[string]interface{}{"order_number": order.OrderNumber})
+	return response, nil
 }
 
 // GetOrders gets list of orders
@@ -990,6 +1200,8 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 		Priority:             order.Priority,
 		Source:               order.Source,
 		CustomerType:         order.CustomerType,
+		CompanyID:            order.CompanyID,
+		ApprovalStatus:       order.ApprovalStatus,
 		Subtotal:             order.Subtotal,
 		TaxAmount:            order.TaxAmount,
 		ShippingAmount:       order.ShippingAmount,
@@ -997,6 +1209,7 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 		TipAmount:            order.TipAmount,
 		Total:                order.Total,
 		Currency:             order.Currency,
+		TaxExemption:         order.TaxExemption,
 		ShippingMethod:       order.ShippingMethod,
 		TrackingNumber:       order.TrackingNumber,
 		TrackingURL:          order.TrackingURL,
@@ -1009,6 +1222,7 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 		IsGift:               order.IsGift,
 		GiftMessage:          order.GiftMessage,
 		GiftWrap:             order.GiftWrap,
+		GiftRecipientEmail:   order.GiftRecipientEmail,
 		ItemCount:            order.GetItemCount(),
 		CanBeCancelled:       order.CanBeCancelled(),
 		CanBeRefunded:        order.CanBeRefunded(),
@@ -1017,6 +1231,8 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 		IsShipped:            order.IsShipped(),
 		IsDelivered:          order.IsDelivered(),
 		HasTracking:          order.HasTracking(),
+		IsArchived:           order.IsArchived,
+		ArchivedAt:           order.ArchivedAt,
 		CreatedAt:            order.CreatedAt,
 		UpdatedAt:            order.UpdatedAt,
 	}
@@ -1068,6 +1284,7 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 			Quantity:    item.Quantity,
 			Price:       item.Price,
 			Total:       item.Total,
+			TaxExempt:   item.TaxExempt,
 		}
 
 		// Add product info if available
@@ -1080,7 +1297,7 @@ func (uc *orderUseCase) toOrderResponse(order *entities.Order) *OrderResponse {
 				Slug:        item.Product.Slug,
 				Price:       item.Product.Price,
 				CurrentPrice: item.Product.GetCurrentPrice(),
-				Stock:       item.Product.Stock,
+				Stock:       item.Product.DiscloseStock().Quantity,
 				Status:      item.Product.Status,
 				MainImage:   item.Product.GetMainImage(),
 			}
@@ -1258,3 +1475,222 @@ func (uc *orderUseCase) AddOrderNote(ctx context.Context, orderID uuid.UUID, req
 
 	return nil
 }
+
+// GetMyOrderInvoicePDF renders the invoice for one of the user's orders. Gift orders are
+// invoiced to the buyer like any other order.
+func (uc *orderUseCase) GetMyOrderInvoicePDF(ctx context.Context, userID, orderID uuid.UUID) ([]byte, string, error) {
+	order, err := uc.getPrintableOrder(ctx, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	if order.UserID != userID {
+		return nil, "", entities.ErrOrderNotFound
+	}
+	return renderOrderInvoicePDF(order, newOrderPriceDisplay(order, uc.priceDisplay)), fmt.Sprintf("invoice-%s.pdf", order.OrderNumber), nil
+}
+
+// GetMyGiftReceiptPDF renders the gift receipt for one of the user's gift orders, to pass on
+// to the recipient
+func (uc *orderUseCase) GetMyGiftReceiptPDF(ctx context.Context, userID, orderID uuid.UUID) ([]byte, string, error) {
+	order, err := uc.getPrintableOrder(ctx, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	if order.UserID != userID {
+		return nil, "", entities.ErrOrderNotFound
+	}
+	return giftReceiptPDF(order)
+}
+
+// GetPackingSlipPDF renders the packing slip that goes in an order's parcel
+func (uc *orderUseCase) GetPackingSlipPDF(ctx context.Context, orderID uuid.UUID) ([]byte, string, error) {
+	order, err := uc.getPrintableOrder(ctx, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	return renderPackingSlipPDF(order, newOrderPriceDisplay(order, uc.priceDisplay)), fmt.Sprintf("packing-slip-%s.pdf", order.OrderNumber), nil
+}
+
+// GetGiftReceiptPDF renders any gift order's gift receipt
+func (uc *orderUseCase) GetGiftReceiptPDF(ctx context.Context, orderID uuid.UUID) ([]byte, string, error) {
+	order, err := uc.getPrintableOrder(ctx, orderID)
+	if err != nil {
+		return nil, "", err
+	}
+	return giftReceiptPDF(order)
+}
+
+// getPrintableOrder gets an order with its items; archived orders have to be restored first
+func (uc *orderUseCase) getPrintableOrder(ctx context.Context, orderID uuid.UUID) (*entities.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, entities.ErrOrderNotFound
+	}
+	if order.IsArchived {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Order is archived; restore it to print its documents")
+	}
+	return order, nil
+}
+
+func giftReceiptPDF(order *entities.Order) ([]byte, string, error) {
+	if !order.IsGift {
+		return nil, "", pkgErrors.InvalidInput("Order is not a gift")
+	}
+	return renderGiftReceiptPDF(order), fmt.Sprintf("gift-receipt-%s.pdf", order.OrderNumber), nil
+}
+
+// renderOrderInvoicePDF renders an order's invoice with prices, billed to the buyer. Amounts
+// carry the currency code, as the PDF font can't draw every currency symbol.
+func renderOrderInvoicePDF(order *entities.Order, display orderPriceDisplay) []byte {
+	pdf := utils.NewTextPDF()
+	pdf.AddLine("INVOICE %s", order.OrderNumber)
+	pdf.AddLine("Date: %s", order.CreatedAt.Format("2006-01-02"))
+	pdf.AddLine("Payment: %s (%s)", order.PaymentMethod, order.PaymentStatus)
+	pdf.AddBlankLine()
+	addPDFAddress(pdf, "Bill to", order.BillingAddress)
+	addPDFAddress(pdf, "Ship to", order.ShippingAddress)
+	if order.IsGift {
+		pdf.AddLine("Gift for: %s", order.GiftRecipientEmail)
+	}
+	pdf.AddBlankLine()
+
+	addPDFItemPrices(pdf, order, display)
+	pdf.AddLine("Subtotal: %s", display.format.FormatWithCode(display.price(order.Subtotal)))
+	if order.DiscountAmount > 0 {
+		pdf.AddLine("Discount: %s", display.format.FormatWithCode(-display.price(order.DiscountAmount)))
+	}
+	pdf.AddLine("%s: %s", display.taxLabel(), display.format.FormatWithCode(order.TaxAmount))
+	pdf.AddLine("Shipping: %s", display.format.FormatWithCode(order.ShippingAmount))
+	if order.TipAmount > 0 {
+		pdf.AddLine("Tip: %s", display.format.FormatWithCode(order.TipAmount))
+	}
+	pdf.AddLine("Total: %s", display.format.FormatWithCode(order.Total))
+
+	return pdf.Bytes()
+}
+
+// addPDFItemPrices adds the table of an order's items with their prices as the customer is
+// shown them
+func addPDFItemPrices(pdf *utils.TextPDF, order *entities.Order, display orderPriceDisplay) {
+	pdf.AddLine("%-44s %-14s %5s %16s %16s", "Product", "SKU", "Qty", "Price", "Total")
+	pdf.AddLine(strings.Repeat("-", 99))
+	for _, item := range order.Items {
+		pdf.AddLine("%-44s %-14s %5d %16s %16s", truncatePDFText(item.ProductName, 44), item.ProductSKU, item.Quantity,
+			display.format.FormatWithCode(display.price(item.Price)), display.format.FormatWithCode(display.price(item.Total)))
+	}
+	pdf.AddLine(strings.Repeat("-", 99))
+}
+
+// renderPackingSlipPDF renders the slip packed with an order. Gift parcels go to the recipient,
+// so their slips leave out prices and carry the gift message instead.
+func renderPackingSlipPDF(order *entities.Order, display orderPriceDisplay) []byte {
+	pdf := utils.NewTextPDF()
+	pdf.AddLine("PACKING SLIP %s", order.OrderNumber)
+	pdf.AddLine("Order date: %s", order.CreatedAt.Format("2006-01-02"))
+	if order.ShippingMethod != "" {
+		pdf.AddLine("Shipping method: %s", order.ShippingMethod)
+	}
+	if order.TrackingNumber != "" {
+		pdf.AddLine("Tracking: %s %s", order.Carrier, order.TrackingNumber)
+	}
+	pdf.AddBlankLine()
+	addPDFAddress(pdf, "Ship to", order.ShippingAddress)
+	if order.DeliveryInstructions != "" {
+		pdf.AddLine("Delivery instructions: %s", order.DeliveryInstructions)
+	}
+	pdf.AddBlankLine()
+
+	if order.IsGift {
+		pdf.AddLine("GIFT - do not include prices or invoices in this parcel")
+		if order.GiftWrap {
+			pdf.AddLine("Gift wrap: yes")
+		}
+		pdf.AddBlankLine()
+		pdf.AddLine("%-60s %-20s %5s", "Product", "SKU", "Qty")
+		pdf.AddLine(strings.Repeat("-", 87))
+		for _, item := range order.Items {
+			pdf.AddLine("%-60s %-20s %5d", truncatePDFText(item.ProductName, 60), item.ProductSKU, item.Quantity)
+		}
+		pdf.AddLine(strings.Repeat("-", 87))
+		addPDFGiftMessage(pdf, order)
+		return pdf.Bytes()
+	}
+
+	addPDFItemPrices(pdf, order, display)
+	pdf.AddLine("Total: %s", display.format.FormatWithCode(order.Total))
+
+	return pdf.Bytes()
+}
+
+// renderGiftReceiptPDF renders a gift receipt: the items and the gift message, without prices
+func renderGiftReceiptPDF(order *entities.Order) []byte {
+	pdf := utils.NewTextPDF()
+	pdf.AddLine("GIFT RECEIPT")
+	pdf.AddLine("Order: %s", order.OrderNumber)
+	pdf.AddLine("Date: %s", order.CreatedAt.Format("2006-01-02"))
+	if order.ShippingAddress != nil {
+		pdf.AddLine("For: %s", order.ShippingAddress.GetFullName())
+	}
+	if order.BillingAddress != nil {
+		pdf.AddLine("From: %s", order.BillingAddress.GetFullName())
+	}
+	pdf.AddBlankLine()
+
+	pdf.AddLine("%-60s %-20s %5s", "Product", "SKU", "Qty")
+	pdf.AddLine(strings.Repeat("-", 87))
+	for _, item := range order.Items {
+		pdf.AddLine("%-60s %-20s %5d", truncatePDFText(item.ProductName, 60), item.ProductSKU, item.Quantity)
+	}
+	pdf.AddLine(strings.Repeat("-", 87))
+	addPDFGiftMessage(pdf, order)
+
+	pdf.AddBlankLine()
+	pdf.AddLine("To return or exchange an item, contact us with order number %s.", order.OrderNumber)
+
+	return pdf.Bytes()
+}
+
+func addPDFAddress(pdf *utils.TextPDF, label string, address *entities.OrderAddress) {
+	if address == nil {
+		return
+	}
+	pdf.AddLine("%s: %s", label, address.GetFullName())
+	if address.Company != "" {
+		pdf.AddLine("  %s", address.Company)
+	}
+	pdf.AddLine("  %s", address.Address1)
+	if address.Address2 != "" {
+		pdf.AddLine("  %s", address.Address2)
+	}
+	pdf.AddLine("  %s, %s %s, %s", address.City, address.State, address.ZipCode, address.Country)
+}
+
+func addPDFGiftMessage(pdf *utils.TextPDF, order *entities.Order) {
+	if order.GiftMessage == "" {
+		return
+	}
+	pdf.AddBlankLine()
+	pdf.AddLine("Message:")
+	for _, paragraph := range strings.Split(order.GiftMessage, "\n") {
+		// Wrap long lines to the page width
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			if line != "" && len(line)+1+len(word) > 95 {
+				pdf.AddLine("  %s", line)
+				line = ""
+			}
+			if line != "" {
+				line += " "
+			}
+			line += word
+		}
+		pdf.AddLine("  %s", line)
+	}
+}
+
+func truncatePDFText(text string, width int) string {
+	if len(text) > width {
+		return text[:width-3] + "..."
+	}
+	return text
+}
@@ -0,0 +1,832 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// PartnerFeedUseCase defines the price and availability feed that keeps affiliates and
+// price-comparison engines in sync with the catalog. Partners are sent only what changed, pushed
+// in batches to their webhook and readable with their API keys.
+type PartnerFeedUseCase interface {
+	CreatePartner(ctx context.Context, req CreatePartnerRequest) (*PartnerResponse, error)
+	ListPartners(ctx context.Context) ([]*PartnerResponse, error)
+	GetPartner(ctx context.Context, id uuid.UUID) (*PartnerResponse, error)
+	UpdatePartner(ctx context.Context, id uuid.UUID, req UpdatePartnerRequest) (*PartnerResponse, error)
+	DeletePartner(ctx context.Context, id uuid.UUID) error
+	CreatePartnerAPIKey(ctx context.Context, partnerID uuid.UUID, req CreatePartnerAPIKeyRequest) (*CreatedAPIKeyResponse, error)
+	UpdatePartnerAPIKey(ctx context.Context, partnerID, keyID uuid.UUID, req UpdatePartnerAPIKeyRequest) (*entities.APIKey, error)
+	RevokePartnerAPIKey(ctx context.Context, partnerID, keyID uuid.UUID) error
+	// GetPartnerAPIUsage gets the daily request counts of a partner's keys, for billing and
+	// reporting
+	GetPartnerAPIUsage(ctx context.Context, partnerID uuid.UUID, req GetAPIUsageRequest) (*PartnerAPIUsageResponse, error)
+
+	// AuthenticateAPIKey returns the key and its active partner when the key may be used for
+	// scope, or ErrAPIKeyInvalid
+	AuthenticateAPIKey(ctx context.Context, key string, scope entities.APIKeyScope) (*entities.APIKey, *entities.Partner, error)
+	// RatePlan returns the limits of an API key plan
+	RatePlan(plan entities.APIKeyPlan) entities.APIRatePlan
+	// RecordAPIKeyRequest counts a request against its key's daily quota and returns the key's
+	// quota for the day; the request is refused when Allowed isn't set
+	RecordAPIKeyRequest(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKeyQuota, error)
+	// GetAPIKeyUsage gets a key's quota for the day and its usage over the last days, for the
+	// key's owner
+	GetAPIKeyUsage(ctx context.Context, apiKey *entities.APIKey, days int) (*APIKeyUsageResponse, error)
+	// GetFeedChanges gets the changes after a cursor for a partner reading the feed
+	GetFeedChanges(ctx context.Context, partner *entities.Partner, after int64, limit int) (*PartnerFeedResponse, error)
+
+	// DeliverWebhooks sends each partner whose delivery is due its next batch of changes and
+	// returns how many batches were delivered
+	DeliverWebhooks(ctx context.Context) (int, error)
+	// PurgeChanges deletes changes older than the retention period
+	PurgeChanges(ctx context.Context) (int64, error)
+}
+
+// PartnerWebhookSender posts batches of the feed to partners' webhooks
+type PartnerWebhookSender interface {
+	// Send posts a body signed with secret and returns once the partner accepted it. A partner
+	// that is throttling deliveries returns how long it asked to wait.
+	Send(ctx context.Context, url, secret string, body []byte) (retryAfter time.Duration, err error)
+}
+
+// Limits of a partner's delivery controls
+const (
+	defaultPartnerBatchSize       = 100
+	maxPartnerBatchSize           = 1000
+	defaultPartnerIntervalSeconds = 60
+	minPartnerIntervalSeconds     = 10
+	maxPartnerIntervalSeconds     = 86400
+	partnerDeliveriesPerRun       = 50
+)
+
+// Days of API key usage that can be read at once
+const (
+	defaultAPIUsageDays = 30
+	maxAPIUsageDays     = 366
+)
+
+// defaultAPIRatePlans are the limits of plans the settings leave out
+var defaultAPIRatePlans = map[entities.APIKeyPlan]entities.APIRatePlan{
+	entities.APIKeyPlanFree:     {Plan: entities.APIKeyPlanFree, RequestsPerMinute: 10, DailyQuota: 1000},
+	entities.APIKeyPlanPartner:  {Plan: entities.APIKeyPlanPartner, RequestsPerMinute: 60, DailyQuota: 50000},
+	entities.APIKeyPlanInternal: {Plan: entities.APIKeyPlanInternal, RequestsPerMinute: 600},
+}
+
+// PartnerFeedSettings configures the partner feed
+type PartnerFeedSettings struct {
+	Currency string // Currency of the prices sent
+	// Retention is how long changes are kept for partners to catch up
+	Retention time.Duration
+	// SettleDelay holds changes back until they are this old, so a change still being saved
+	// when a batch is read isn't skipped by the cursor
+	SettleDelay time.Duration
+	// MaxBackoff caps the wait after failed deliveries
+	MaxBackoff time.Duration
+	// RatePlans are the limits of each API key plan
+	RatePlans map[entities.APIKeyPlan]entities.APIRatePlan
+}
+
+type partnerFeedUseCase struct {
+	partnerRepo repositories.PartnerRepository
+	apiKeyRepo  repositories.APIKeyRepository
+	usageRepo   repositories.APIKeyUsageRepository
+	feedRepo    repositories.PartnerFeedRepository
+	sender      PartnerWebhookSender
+	settings    PartnerFeedSettings
+	clock       Clock
+}
+
+// NewPartnerFeedUseCase creates a new partner feed use case. Webhooks aren't delivered when
+// sender is nil.
+func NewPartnerFeedUseCase(
+	partnerRepo repositories.PartnerRepository,
+	apiKeyRepo repositories.APIKeyRepository,
+	usageRepo repositories.APIKeyUsageRepository,
+	feedRepo repositories.PartnerFeedRepository,
+	sender PartnerWebhookSender,
+	settings PartnerFeedSettings,
+	clock Clock,
+) PartnerFeedUseCase {
+	if settings.Retention <= 0 {
+		settings.Retention = 30 * 24 * time.Hour
+	}
+	if settings.SettleDelay < 0 {
+		settings.SettleDelay = 0
+	}
+	if settings.MaxBackoff <= 0 {
+		settings.MaxBackoff = time.Hour
+	}
+	plans := make(map[entities.APIKeyPlan]entities.APIRatePlan, len(defaultAPIRatePlans))
+	for plan, limits := range defaultAPIRatePlans {
+		if configured, ok := settings.RatePlans[plan]; ok {
+			limits.RequestsPerMinute = configured.RequestsPerMinute
+			limits.DailyQuota = configured.DailyQuota
+		}
+		if limits.RequestsPerMinute < 1 {
+			limits.RequestsPerMinute = 1
+		}
+		if limits.DailyQuota < 0 {
+			limits.DailyQuota = 0
+		}
+		plans[plan] = limits
+	}
+	settings.RatePlans = plans
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &partnerFeedUseCase{
+		partnerRepo: partnerRepo,
+		apiKeyRepo:  apiKeyRepo,
+		usageRepo:   usageRepo,
+		feedRepo:    feedRepo,
+		sender:      sender,
+		settings:    settings,
+		clock:       clock,
+	}
+}
+
+// CreatePartnerRequest represents a request to add a partner
+type CreatePartnerRequest struct {
+	Name               string `json:"name" validate:"required"`
+	ContactEmail       string `json:"contact_email"`
+	WebhookURL         string `json:"webhook_url"`          // Leave empty for partners that only read the feed
+	BatchSize          int    `json:"batch_size"`           // Most changes per delivery, 100 by default
+	MinIntervalSeconds int    `json:"min_interval_seconds"` // Least time between deliveries, 60 by default
+}
+
+// UpdatePartnerRequest represents a request to change a partner; nil fields are kept
+type UpdatePartnerRequest struct {
+	Name                *string `json:"name"`
+	ContactEmail        *string `json:"contact_email"`
+	WebhookURL          *string `json:"webhook_url"`
+	BatchSize           *int    `json:"batch_size"`
+	MinIntervalSeconds  *int    `json:"min_interval_seconds"`
+	IsActive            *bool   `json:"is_active"`
+	RotateWebhookSecret bool    `json:"rotate_webhook_secret"` // Issue a new secret; the old one stops verifying at once
+}
+
+// CreatePartnerAPIKeyRequest represents a request to issue a partner an API key
+type CreatePartnerAPIKeyRequest struct {
+	Name string              `json:"name" validate:"required"`
+	Plan entities.APIKeyPlan `json:"plan"` // free, partner or internal; partner by default
+}
+
+// UpdatePartnerAPIKeyRequest represents a request to rename an API key or move it to another
+// plan; nil fields are kept
+type UpdatePartnerAPIKeyRequest struct {
+	Name *string              `json:"name"`
+	Plan *entities.APIKeyPlan `json:"plan"`
+}
+
+// GetAPIUsageRequest represents a request for the API usage of the days from From to To, the
+// last 30 days by default
+type GetAPIUsageRequest struct {
+	From string `form:"from"` // YYYY-MM-DD
+	To   string `form:"to"`   // YYYY-MM-DD
+}
+
+// PartnerResponse represents a partner with its API keys
+type PartnerResponse struct {
+	*entities.Partner
+	WebhookSecret string             `json:"webhook_secret,omitempty"` // Only returned when created or rotated
+	APIKeys       []*entities.APIKey `json:"api_keys"`
+}
+
+// CreatedAPIKeyResponse represents a new API key; the key is only ever returned here
+type CreatedAPIKeyResponse struct {
+	*entities.APIKey
+	Key string `json:"key"`
+}
+
+// APIKeyUsageResponse represents an API key's quota for the day and its usage over the last days
+type APIKeyUsageResponse struct {
+	APIKeyID uuid.UUID               `json:"api_key_id"`
+	Name     string                  `json:"name"`
+	Quota    *entities.APIKeyQuota   `json:"quota"`
+	Days     []*entities.APIKeyUsage `json:"days"` // Oldest first; days without requests are left out
+}
+
+// PartnerAPIUsageResponse represents the usage of a partner's API keys over a range of days
+type PartnerAPIUsageResponse struct {
+	PartnerID uuid.UUID             `json:"partner_id"`
+	From      time.Time             `json:"from"`
+	To        time.Time             `json:"to"`
+	Requests  int64                 `json:"requests"` // Requests served by all keys
+	Rejected  int64                 `json:"rejected"` // Requests refused over quota by all keys
+	Keys      []*APIKeyUsageSummary `json:"keys"`
+}
+
+// APIKeyUsageSummary represents one key's usage over a range of days
+type APIKeyUsageSummary struct {
+	APIKeyID uuid.UUID               `json:"api_key_id"`
+	Name     string                  `json:"name"`
+	Prefix   string                  `json:"prefix"`
+	Plan     entities.APIKeyPlan     `json:"plan"`
+	Requests int64                   `json:"requests"`
+	Rejected int64                   `json:"rejected"`
+	Days     []*entities.APIKeyUsage `json:"days"` // Oldest first; days without requests are left out
+}
+
+// PartnerFeedResponse represents a page of the feed read by a partner
+type PartnerFeedResponse struct {
+	Currency string               `json:"currency"`
+	Changes  []*PartnerFeedChange `json:"changes"`
+	Cursor   int64                `json:"cursor"` // Pass as after to read on
+	HasMore  bool                 `json:"has_more"`
+}
+
+// PartnerWebhookBatch is the body of a webhook delivery
+type PartnerWebhookBatch struct {
+	ID           uuid.UUID            `json:"id"` // Partners deduplicate on it, as a batch may be sent again
+	PartnerID    uuid.UUID            `json:"partner_id"`
+	Currency     string               `json:"currency"`
+	FromSequence int64                `json:"from_sequence"`
+	ToSequence   int64                `json:"to_sequence"`
+	SentAt       time.Time            `json:"sent_at"`
+	Changes      []*PartnerFeedChange `json:"changes"`
+}
+
+// PartnerFeedChange is the latest price or availability of a product, with what it was before
+// the first change the batch covers
+type PartnerFeedChange struct {
+	ProductID            uuid.UUID                      `json:"product_id"`
+	SKU                  string                         `json:"sku"`
+	Type                 entities.PartnerFeedChangeType `json:"type"`
+	Price                *float64                       `json:"price,omitempty"`
+	CurrentPrice         *float64                       `json:"current_price,omitempty"`
+	PreviousCurrentPrice *float64                       `json:"previous_current_price,omitempty"`
+	Availability         entities.PartnerAvailability   `json:"availability,omitempty"`
+	PreviousAvailability entities.PartnerAvailability   `json:"previous_availability,omitempty"`
+	ChangedAt            time.Time                      `json:"changed_at"`
+}
+
+// CreatePartner adds a partner. Its webhook starts with the changes made from now on.
+func (uc *partnerFeedUseCase) CreatePartner(ctx context.Context, req CreatePartnerRequest) (*PartnerResponse, error) {
+	partner := &entities.Partner{
+		ID:                 ids.New(),
+		Name:               strings.TrimSpace(req.Name),
+		ContactEmail:       strings.TrimSpace(req.ContactEmail),
+		WebhookURL:         strings.TrimSpace(req.WebhookURL),
+		BatchSize:          req.BatchSize,
+		MinIntervalSeconds: req.MinIntervalSeconds,
+		IsActive:           true,
+	}
+	if partner.BatchSize == 0 {
+		partner.BatchSize = defaultPartnerBatchSize
+	}
+	if partner.MinIntervalSeconds == 0 {
+		partner.MinIntervalSeconds = defaultPartnerIntervalSeconds
+	}
+	if err := validatePartner(partner); err != nil {
+		return nil, err
+	}
+
+	secret, err := entities.GeneratePartnerWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	partner.WebhookSecret = secret
+	if partner.Cursor, err = uc.feedRepo.LatestSequence(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := uc.partnerRepo.Create(ctx, partner); err != nil {
+		return nil, err
+	}
+	return &PartnerResponse{Partner: partner, WebhookSecret: secret, APIKeys: []*entities.APIKey{}}, nil
+}
+
+// ListPartners lists partners with their API keys
+func (uc *partnerFeedUseCase) ListPartners(ctx context.Context) ([]*PartnerResponse, error) {
+	partners, err := uc.partnerRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]*PartnerResponse, len(partners))
+	for i, partner := range partners {
+		if responses[i], err = uc.toPartnerResponse(ctx, partner); err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+// GetPartner gets a partner with its API keys
+func (uc *partnerFeedUseCase) GetPartner(ctx context.Context, id uuid.UUID) (*PartnerResponse, error) {
+	partner, err := uc.partnerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toPartnerResponse(ctx, partner)
+}
+
+// UpdatePartner changes a partner's details and delivery controls. A partner that is
+// reactivated or given a new webhook is sent its next batch right away.
+func (uc *partnerFeedUseCase) UpdatePartner(ctx context.Context, id uuid.UUID, req UpdatePartnerRequest) (*PartnerResponse, error) {
+	partner, err := uc.partnerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resume := false
+	if req.Name != nil {
+		partner.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.ContactEmail != nil {
+		partner.ContactEmail = strings.TrimSpace(*req.ContactEmail)
+	}
+	if req.WebhookURL != nil && strings.TrimSpace(*req.WebhookURL) != partner.WebhookURL {
+		partner.WebhookURL = strings.TrimSpace(*req.WebhookURL)
+		resume = true
+	}
+	if req.BatchSize != nil {
+		partner.BatchSize = *req.BatchSize
+	}
+	if req.MinIntervalSeconds != nil {
+		partner.MinIntervalSeconds = *req.MinIntervalSeconds
+	}
+	if req.IsActive != nil {
+		resume = resume || *req.IsActive && !partner.IsActive
+		partner.IsActive = *req.IsActive
+	}
+	if err := validatePartner(partner); err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if req.RotateWebhookSecret {
+		if secret, err = entities.GeneratePartnerWebhookSecret(); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		partner.WebhookSecret = secret
+	}
+	if resume {
+		partner.NextDeliveryAt = nil
+		partner.FailedDeliveries = 0
+		partner.LastError = ""
+	}
+
+	if err := uc.partnerRepo.Update(ctx, partner); err != nil {
+		return nil, err
+	}
+	response, err := uc.toPartnerResponse(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	response.WebhookSecret = secret
+	return response, nil
+}
+
+// DeletePartner deletes a partner and revokes its API keys
+func (uc *partnerFeedUseCase) DeletePartner(ctx context.Context, id uuid.UUID) error {
+	return uc.partnerRepo.Delete(ctx, id)
+}
+
+// CreatePartnerAPIKey issues a partner a key scoped to the feed
+func (uc *partnerFeedUseCase) CreatePartnerAPIKey(ctx context.Context, partnerID uuid.UUID, req CreatePartnerAPIKeyRequest) (*CreatedAPIKeyResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, pkgErrors.InvalidInput("name is required")
+	}
+	plan := req.Plan
+	if plan == "" {
+		plan = entities.APIKeyPlanPartner
+	}
+	if !entities.IsValidAPIKeyPlan(plan) {
+		return nil, pkgErrors.InvalidInput("plan must be free, partner or internal")
+	}
+	if _, err := uc.partnerRepo.GetByID(ctx, partnerID); err != nil {
+		return nil, err
+	}
+
+	key, prefix, err := entities.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	apiKey := &entities.APIKey{
+		ID:        ids.New(),
+		PartnerID: &partnerID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   entities.HashAPIKey(key),
+		Scopes:    []entities.APIKeyScope{entities.APIKeyScopePartnerFeed},
+		Plan:      plan,
+	}
+	if err := uc.apiKeyRepo.Create(ctx, apiKey); err != nil {
+		return nil, err
+	}
+	return &CreatedAPIKeyResponse{APIKey: apiKey, Key: key}, nil
+}
+
+// UpdatePartnerAPIKey renames one of a partner's keys or moves it to another plan. A new plan's
+// limits apply from the key's next request, to what it already used that day.
+func (uc *partnerFeedUseCase) UpdatePartnerAPIKey(ctx context.Context, partnerID, keyID uuid.UUID, req UpdatePartnerAPIKeyRequest) (*entities.APIKey, error) {
+	key, err := uc.getPartnerAPIKey(ctx, partnerID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, pkgErrors.InvalidInput("name is required")
+		}
+		key.Name = name
+	}
+	if req.Plan != nil {
+		if !entities.IsValidAPIKeyPlan(*req.Plan) {
+			return nil, pkgErrors.InvalidInput("plan must be free, partner or internal")
+		}
+		key.Plan = *req.Plan
+	}
+	if err := uc.apiKeyRepo.Update(ctx, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// RevokePartnerAPIKey revokes one of a partner's keys
+func (uc *partnerFeedUseCase) RevokePartnerAPIKey(ctx context.Context, partnerID, keyID uuid.UUID) error {
+	key, err := uc.getPartnerAPIKey(ctx, partnerID, keyID)
+	if err != nil {
+		return err
+	}
+	if !key.IsActive() {
+		return nil
+	}
+	now := uc.clock.Now()
+	key.RevokedAt = &now
+	return uc.apiKeyRepo.Update(ctx, key)
+}
+
+// AuthenticateAPIKey looks a key up by its hash and checks its scope and partner
+func (uc *partnerFeedUseCase) AuthenticateAPIKey(ctx context.Context, key string, scope entities.APIKeyScope) (*entities.APIKey, *entities.Partner, error) {
+	apiKey, err := uc.apiKeyRepo.GetByHash(ctx, entities.HashAPIKey(key))
+	if err != nil {
+		if err == entities.ErrAPIKeyNotFound {
+			return nil, nil, entities.ErrAPIKeyInvalid
+		}
+		return nil, nil, err
+	}
+	if !apiKey.IsActive() || !apiKey.HasScope(scope) || apiKey.PartnerID == nil {
+		return nil, nil, entities.ErrAPIKeyInvalid
+	}
+
+	partner, err := uc.partnerRepo.GetByID(ctx, *apiKey.PartnerID)
+	if err != nil {
+		if err == entities.ErrPartnerNotFound {
+			return nil, nil, entities.ErrAPIKeyInvalid
+		}
+		return nil, nil, err
+	}
+	if !partner.IsActive {
+		return nil, nil, entities.ErrAPIKeyInvalid
+	}
+
+	// Last use is only kept to the minute, so reading the feed doesn't write on every request
+	now := uc.clock.Now()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= time.Minute {
+		if err := uc.apiKeyRepo.MarkUsed(ctx, apiKey.ID, now); err == nil {
+			apiKey.LastUsedAt = &now
+		}
+	}
+	return apiKey, partner, nil
+}
+
+// RatePlan returns the limits of a plan, or of the partner plan for keys from before plans
+func (uc *partnerFeedUseCase) RatePlan(plan entities.APIKeyPlan) entities.APIRatePlan {
+	if limits, ok := uc.settings.RatePlans[plan]; ok {
+		return limits
+	}
+	return uc.settings.RatePlans[entities.APIKeyPlanPartner]
+}
+
+// RecordAPIKeyRequest counts a request on the key's UTC day. Requests over the quota are
+// refused and counted apart, so they don't use up the next day's quota or get billed.
+func (uc *partnerFeedUseCase) RecordAPIKeyRequest(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKeyQuota, error) {
+	limits := uc.RatePlan(apiKey.Plan)
+	day := entities.APIUsageDay(uc.clock.Now())
+	usage, allowed, err := uc.usageRepo.RecordRequest(ctx, apiKey.ID, day, limits.DailyQuota)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record API key usage: %w", err)
+	}
+	quota := entities.NewAPIKeyQuota(limits, day, usage.Requests)
+	quota.Allowed = allowed
+	return quota, nil
+}
+
+// GetAPIKeyUsage gets a key's quota for the day and its usage over the last days, today included
+func (uc *partnerFeedUseCase) GetAPIKeyUsage(ctx context.Context, apiKey *entities.APIKey, days int) (*APIKeyUsageResponse, error) {
+	if days <= 0 {
+		days = defaultAPIUsageDays
+	}
+	if days > maxAPIUsageDays {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("days must be at most %d", maxAPIUsageDays))
+	}
+
+	today := entities.APIUsageDay(uc.clock.Now())
+	usage, err := uc.usageRepo.ListByKeys(ctx, []uuid.UUID{apiKey.ID}, today.AddDate(0, 0, 1-days), today)
+	if err != nil {
+		return nil, err
+	}
+	var used int64
+	if len(usage) > 0 && usage[len(usage)-1].Day.Equal(today) {
+		used = usage[len(usage)-1].Requests
+	}
+	return &APIKeyUsageResponse{
+		APIKeyID: apiKey.ID,
+		Name:     apiKey.Name,
+		Quota:    entities.NewAPIKeyQuota(uc.RatePlan(apiKey.Plan), today, used),
+		Days:     usage,
+	}, nil
+}
+
+// GetPartnerAPIUsage gets the usage of each of a partner's keys over a range of days, revoked
+// keys included
+func (uc *partnerFeedUseCase) GetPartnerAPIUsage(ctx context.Context, partnerID uuid.UUID, req GetAPIUsageRequest) (*PartnerAPIUsageResponse, error) {
+	if _, err := uc.partnerRepo.GetByID(ctx, partnerID); err != nil {
+		return nil, err
+	}
+
+	to := entities.APIUsageDay(uc.clock.Now())
+	if req.To != "" {
+		parsed, err := time.Parse("2006-01-02", req.To)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("to must be a date (YYYY-MM-DD)")
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, 1-defaultAPIUsageDays)
+	if req.From != "" {
+		parsed, err := time.Parse("2006-01-02", req.From)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("from must be a date (YYYY-MM-DD)")
+		}
+		from = parsed
+	}
+	if from.After(to) {
+		return nil, pkgErrors.InvalidInput("from must not be after to")
+	}
+	if to.Sub(from) >= maxAPIUsageDays*24*time.Hour {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("at most %d days can be read at once", maxAPIUsageDays))
+	}
+
+	keys, err := uc.apiKeyRepo.ListByPartner(ctx, partnerID)
+	if err != nil {
+		return nil, err
+	}
+	keyIDs := make([]uuid.UUID, len(keys))
+	summaries := make(map[uuid.UUID]*APIKeyUsageSummary, len(keys))
+	response := &PartnerAPIUsageResponse{
+		PartnerID: partnerID,
+		From:      from,
+		To:        to,
+		Keys:      make([]*APIKeyUsageSummary, len(keys)),
+	}
+	for i, key := range keys {
+		keyIDs[i] = key.ID
+		response.Keys[i] = &APIKeyUsageSummary{
+			APIKeyID: key.ID,
+			Name:     key.Name,
+			Prefix:   key.Prefix,
+			Plan:     key.Plan,
+			Days:     []*entities.APIKeyUsage{},
+		}
+		summaries[key.ID] = response.Keys[i]
+	}
+
+	usage, err := uc.usageRepo.ListByKeys(ctx, keyIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, day := range usage {
+		summary := summaries[day.APIKeyID]
+		summary.Requests += day.Requests
+		summary.Rejected += day.Rejected
+		summary.Days = append(summary.Days, day)
+		response.Requests += day.Requests
+		response.Rejected += day.Rejected
+	}
+	return response, nil
+}
+
+// GetFeedChanges gets up to limit changes after a cursor, each product's changes merged into one
+func (uc *partnerFeedUseCase) GetFeedChanges(ctx context.Context, partner *entities.Partner, after int64, limit int) (*PartnerFeedResponse, error) {
+	if after < 0 {
+		return nil, pkgErrors.InvalidInput("after must not be negative")
+	}
+	if limit <= 0 {
+		limit = partner.BatchSize
+	}
+	if limit > maxPartnerBatchSize {
+		limit = maxPartnerBatchSize
+	}
+
+	changes, err := uc.feedRepo.GetChangesAfter(ctx, after, uc.clock.Now().Add(-uc.settings.SettleDelay), limit)
+	if err != nil {
+		return nil, err
+	}
+	response := &PartnerFeedResponse{
+		Currency: uc.settings.Currency,
+		Changes:  mergePartnerFeedChanges(changes),
+		Cursor:   after,
+		HasMore:  len(changes) == limit,
+	}
+	if len(changes) > 0 {
+		response.Cursor = changes[len(changes)-1].Sequence
+	}
+	return response, nil
+}
+
+// DeliverWebhooks delivers the next batch to each partner that is due. A partner's cursor only
+// moves on once it accepted the batch, so a failed batch is sent again, after a backoff that
+// doubles with each failure.
+func (uc *partnerFeedUseCase) DeliverWebhooks(ctx context.Context) (int, error) {
+	if uc.sender == nil {
+		return 0, nil
+	}
+
+	now := uc.clock.Now()
+	partners, err := uc.partnerRepo.GetDueForDelivery(ctx, now, partnerDeliveriesPerRun)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load partners due for delivery: %w", err)
+	}
+
+	delivered := 0
+	for _, partner := range partners {
+		sent, err := uc.deliver(ctx, partner, now)
+		if err != nil {
+			fmt.Printf("❌ Failed to deliver partner feed to %s: %v\n", partner.Name, err)
+		}
+		if sent {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+func (uc *partnerFeedUseCase) deliver(ctx context.Context, partner *entities.Partner, now time.Time) (bool, error) {
+	interval := time.Duration(partner.MinIntervalSeconds) * time.Second
+	changes, err := uc.feedRepo.GetChangesAfter(ctx, partner.Cursor, now.Add(-uc.settings.SettleDelay), partner.BatchSize)
+	if err != nil {
+		return false, err
+	}
+	if len(changes) == 0 {
+		next := now.Add(interval)
+		partner.NextDeliveryAt = &next
+		return false, uc.partnerRepo.Update(ctx, partner)
+	}
+
+	last := changes[len(changes)-1].Sequence
+	body, err := json.Marshal(PartnerWebhookBatch{
+		ID:           partnerBatchID(partner.ID, partner.Cursor, last),
+		PartnerID:    partner.ID,
+		Currency:     uc.settings.Currency,
+		FromSequence: changes[0].Sequence,
+		ToSequence:   last,
+		SentAt:       now,
+		Changes:      mergePartnerFeedChanges(changes),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	retryAfter, sendErr := uc.sender.Send(ctx, partner.WebhookURL, partner.WebhookSecret, body)
+	if sendErr != nil {
+		partner.FailedDeliveries++
+		partner.LastError = sendErr.Error()
+		backoff := interval << (partner.FailedDeliveries - 1)
+		if backoff <= 0 || backoff > uc.settings.MaxBackoff {
+			backoff = uc.settings.MaxBackoff
+		}
+		if retryAfter > backoff {
+			backoff = retryAfter
+		}
+		next := now.Add(backoff)
+		partner.NextDeliveryAt = &next
+		if err := uc.partnerRepo.Update(ctx, partner); err != nil {
+			return false, err
+		}
+		return false, sendErr
+	}
+
+	partner.Cursor = last
+	partner.LastDeliveredAt = &now
+	partner.FailedDeliveries = 0
+	partner.LastError = ""
+	next := now.Add(interval)
+	partner.NextDeliveryAt = &next
+	return true, uc.partnerRepo.Update(ctx, partner)
+}
+
+// PurgeChanges deletes changes older than the retention period
+func (uc *partnerFeedUseCase) PurgeChanges(ctx context.Context) (int64, error) {
+	return uc.feedRepo.DeleteBefore(ctx, uc.clock.Now().Add(-uc.settings.Retention))
+}
+
+// getPartnerAPIKey gets one of a partner's keys
+func (uc *partnerFeedUseCase) getPartnerAPIKey(ctx context.Context, partnerID, keyID uuid.UUID) (*entities.APIKey, error) {
+	key, err := uc.apiKeyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.PartnerID == nil || *key.PartnerID != partnerID {
+		return nil, entities.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+func (uc *partnerFeedUseCase) toPartnerResponse(ctx context.Context, partner *entities.Partner) (*PartnerResponse, error) {
+	keys, err := uc.apiKeyRepo.ListByPartner(ctx, partner.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &PartnerResponse{Partner: partner, APIKeys: keys}, nil
+}
+
+// validatePartner checks a partner's name, webhook URL and delivery controls
+func validatePartner(partner *entities.Partner) error {
+	if partner.Name == "" {
+		return pkgErrors.InvalidInput("name is required")
+	}
+	if partner.WebhookURL != "" {
+		parsed, err := url.Parse(partner.WebhookURL)
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return pkgErrors.InvalidInput("webhook_url must be an http or https URL")
+		}
+	}
+	if partner.BatchSize < 1 || partner.BatchSize > maxPartnerBatchSize {
+		return pkgErrors.InvalidInput(fmt.Sprintf("batch_size must be between 1 and %d", maxPartnerBatchSize))
+	}
+	if partner.MinIntervalSeconds < minPartnerIntervalSeconds || partner.MinIntervalSeconds > maxPartnerIntervalSeconds {
+		return pkgErrors.InvalidInput(fmt.Sprintf("min_interval_seconds must be between %d and %d", minPartnerIntervalSeconds, maxPartnerIntervalSeconds))
+	}
+	return nil
+}
+
+// mergePartnerFeedChanges merges the changes of each product and type into its latest values
+// and the previous values before the first, ordered by their latest change. Availability that
+// changed back to what it was is left out.
+func mergePartnerFeedChanges(changes []*entities.PartnerFeedChange) []*PartnerFeedChange {
+	type key struct {
+		productID  uuid.UUID
+		changeType entities.PartnerFeedChangeType
+	}
+	merged := make(map[key]*PartnerFeedChange)
+	var order []key
+	for _, change := range changes {
+		k := key{change.ProductID, change.Type}
+		if existing, ok := merged[k]; ok {
+			existing.SKU = change.SKU
+			existing.Price = change.Price
+			existing.CurrentPrice = change.CurrentPrice
+			existing.Availability = change.Availability
+			existing.ChangedAt = change.ChangedAt
+			for i, o := range order {
+				if o == k {
+					order = append(order[:i], order[i+1:]...)
+					break
+				}
+			}
+		} else {
+			merged[k] = &PartnerFeedChange{
+				ProductID:            change.ProductID,
+				SKU:                  change.SKU,
+				Type:                 change.Type,
+				Price:                change.Price,
+				CurrentPrice:         change.CurrentPrice,
+				PreviousCurrentPrice: change.PreviousCurrentPrice,
+				Availability:         change.Availability,
+				PreviousAvailability: change.PreviousAvailability,
+				ChangedAt:            change.ChangedAt,
+			}
+		}
+		order = append(order, k)
+	}
+
+	result := make([]*PartnerFeedChange, 0, len(order))
+	for _, k := range order {
+		change := merged[k]
+		if change.Type == entities.PartnerFeedChangeAvailability && change.Availability == change.PreviousAvailability {
+			continue
+		}
+		result = append(result, change)
+	}
+	return result
+}
+
+// partnerBatchID derives a batch's ID from the partner and the changes it covers, so the same
+// changes sent again after a failure keep their batch ID
+func partnerBatchID(partnerID uuid.UUID, after, last int64) uuid.UUID {
+	return uuid.NewSHA1(partnerID, []byte(fmt.Sprintf("%d-%d", after, last)))
+}
@@ -0,0 +1,498 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// paymentLinkCheckoutReuse is how long a checkout session opened from a link is handed out again
+// instead of opening a new one. Stripe sessions stay open for 24 hours.
+const paymentLinkCheckoutReuse = 23 * time.Hour
+
+// PaymentLinkUseCase defines use cases for pay-by-link: single-use links that let a customer pay
+// an order, a converted quote or an arbitrary amount through a hosted Stripe checkout page
+type PaymentLinkUseCase interface {
+	// Admin operations
+	CreatePaymentLink(ctx context.Context, req CreatePaymentLinkRequest) (*PaymentLinkResponse, error)
+	GetPaymentLinks(ctx context.Context, req GetPaymentLinksRequest) (*PaymentLinksListResponse, error)
+	GetPaymentLink(ctx context.Context, id uuid.UUID) (*PaymentLinkResponse, error)
+	SendPaymentLink(ctx context.Context, id uuid.UUID, req SendPaymentLinkRequest) (*PaymentLinkResponse, error)
+	CancelPaymentLink(ctx context.Context, id uuid.UUID) (*PaymentLinkResponse, error)
+
+	// Customer operations, addressed by the link's token
+	GetPublicPaymentLink(ctx context.Context, token string) (*PublicPaymentLinkResponse, error)
+	// StartCheckout returns the URL of the Stripe checkout page for the link
+	StartCheckout(ctx context.Context, token string) (string, error)
+
+	// ExpirePaymentLinks marks links whose validity has lapsed as expired and returns how many were expired
+	ExpirePaymentLinks(ctx context.Context) (int64, error)
+}
+
+// PaymentLinkNotificationService interface for sending payment links to customers
+type PaymentLinkNotificationService interface {
+	NotifyPaymentLink(ctx context.Context, link *entities.PaymentLink, url string) error
+}
+
+type paymentLinkUseCase struct {
+	paymentLinkRepo     repositories.PaymentLinkRepository
+	orderRepo           repositories.OrderRepository
+	quoteRepo           repositories.QuoteRepository
+	paymentUseCase      PaymentUseCase
+	stripeService       PaymentGatewayService
+	notificationService PaymentLinkNotificationService
+	baseURL             string
+	defaultValidity     time.Duration
+}
+
+// NewPaymentLinkUseCase creates a new payment link use case. Links point to baseURL followed by
+// their token and are valid for defaultValidity unless created with an explicit expiry.
+func NewPaymentLinkUseCase(
+	paymentLinkRepo repositories.PaymentLinkRepository,
+	orderRepo repositories.OrderRepository,
+	quoteRepo repositories.QuoteRepository,
+	paymentUseCase PaymentUseCase,
+	stripeService PaymentGatewayService,
+	notificationService PaymentLinkNotificationService,
+	baseURL string,
+	defaultValidity time.Duration,
+) PaymentLinkUseCase {
+	if defaultValidity <= 0 {
+		defaultValidity = entities.DefaultPaymentLinkValidityHours * time.Hour
+	}
+	return &paymentLinkUseCase{
+		paymentLinkRepo:     paymentLinkRepo,
+		orderRepo:           orderRepo,
+		quoteRepo:           quoteRepo,
+		paymentUseCase:      paymentUseCase,
+		stripeService:       stripeService,
+		notificationService: notificationService,
+		baseURL:             strings.TrimSuffix(baseURL, "/"),
+		defaultValidity:     defaultValidity,
+	}
+}
+
+// CreatePaymentLinkRequest represents a request to create a payment link. Exactly one of
+// OrderID, QuoteID and Amount sets what is paid; a quote must have been converted to an order.
+type CreatePaymentLinkRequest struct {
+	OrderID        *uuid.UUID `json:"order_id,omitempty"`
+	QuoteID        *uuid.UUID `json:"quote_id,omitempty"`
+	Amount         float64    `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	Currency       string     `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Description    string     `json:"description,omitempty" validate:"max=500"`
+	CustomerEmail  string     `json:"customer_email,omitempty"`
+	CustomerPhone  string     `json:"customer_phone,omitempty"`
+	ExpiresInHours int        `json:"expires_in_hours,omitempty" validate:"omitempty,min=1,max=720"`
+	// Send sends the link to the customer's email address and phone number right away
+	Send bool `json:"send"`
+}
+
+// GetPaymentLinksRequest represents filters for listing payment links
+type GetPaymentLinksRequest struct {
+	Status  entities.PaymentLinkStatus `form:"status" json:"status,omitempty"`
+	OrderID *uuid.UUID                 `form:"order_id" json:"order_id,omitempty"`
+	QuoteID *uuid.UUID                 `form:"quote_id" json:"quote_id,omitempty"`
+	Limit   int                        `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset  int                        `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// SendPaymentLinkRequest represents a request to send a link again. Fields that are set replace
+// the link's customer email address and phone number.
+type SendPaymentLinkRequest struct {
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// PaymentLinkResponse represents a payment link with its URL
+type PaymentLinkResponse struct {
+	*entities.PaymentLink
+	URL string `json:"url"`
+}
+
+// PaymentLinksListResponse represents a page of payment links
+type PaymentLinksListResponse struct {
+	PaymentLinks []*PaymentLinkResponse `json:"payment_links"`
+	Total        int64                  `json:"total"`
+	Pagination   *PaginationInfo        `json:"pagination"`
+}
+
+// PublicPaymentLinkResponse is what the customer sees before paying a link
+type PublicPaymentLinkResponse struct {
+	Amount      float64                    `json:"amount"`
+	Currency    string                     `json:"currency"`
+	Description string                     `json:"description"`
+	OrderNumber string                     `json:"order_number,omitempty"`
+	Status      entities.PaymentLinkStatus `json:"status"`
+	ExpiresAt   time.Time                  `json:"expires_at"`
+	Payable     bool                       `json:"payable"`
+}
+
+// CreatePaymentLink creates a payment link and optionally sends it to the customer
+func (uc *paymentLinkUseCase) CreatePaymentLink(ctx context.Context, req CreatePaymentLinkRequest) (*PaymentLinkResponse, error) {
+	req.CustomerEmail = strings.TrimSpace(req.CustomerEmail)
+	req.CustomerPhone = strings.TrimSpace(req.CustomerPhone)
+	if err := entities.ValidatePaymentLinkRecipients(req.CustomerEmail, req.CustomerPhone); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	if req.ExpiresInHours < 0 || req.ExpiresInHours > 720 {
+		return nil, pkgErrors.InvalidInput("expires_in_hours must be between 1 and 720, or omitted for the default validity")
+	}
+	if req.Amount < 0 {
+		return nil, pkgErrors.InvalidInput("amount must be greater than 0, or omitted for an order or quote")
+	}
+	if len(req.Description) > 500 {
+		return nil, pkgErrors.InvalidInput("description must be at most 500 characters")
+	}
+
+	targets := 0
+	for _, set := range []bool{req.OrderID != nil, req.QuoteID != nil, req.Amount != 0} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, pkgErrors.InvalidInput("Exactly one of order_id, quote_id and amount is required")
+	}
+
+	token, err := entities.GeneratePaymentLinkToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate payment link token: %w", err)
+	}
+
+	now := time.Now()
+	validity := uc.defaultValidity
+	if req.ExpiresInHours > 0 {
+		validity = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+	link := &entities.PaymentLink{
+		ID:            ids.New(),
+		Token:         token,
+		QuoteID:       req.QuoteID,
+		Amount:        req.Amount,
+		Currency:      strings.ToUpper(req.Currency),
+		Description:   strings.TrimSpace(req.Description),
+		CustomerEmail: req.CustomerEmail,
+		CustomerPhone: req.CustomerPhone,
+		Status:        entities.PaymentLinkStatusActive,
+		ExpiresAt:     now.Add(validity),
+		CreatedBy:     entities.ActorFromContext(ctx).ID(),
+	}
+
+	if req.QuoteID != nil {
+		quote, err := uc.quoteRepo.GetByID(ctx, *req.QuoteID)
+		if err != nil {
+			return nil, err
+		}
+		if quote.OrderID == nil {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Quote must be converted to an order before it can be paid by link")
+		}
+		req.OrderID = quote.OrderID
+	}
+
+	if req.OrderID != nil {
+		order, err := uc.orderRepo.GetByID(ctx, *req.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPaymentLinkOrder(order); err != nil {
+			return nil, err
+		}
+		link.OrderID = &order.ID
+		link.Amount = order.Total
+		link.Currency = order.Currency
+		if link.Description == "" {
+			link.Description = fmt.Sprintf("Payment for Order %s", order.OrderNumber)
+		}
+		if link.CustomerEmail == "" && order.User.Email != "" {
+			link.CustomerEmail = order.User.Email
+		}
+	} else if link.Description == "" {
+		return nil, pkgErrors.InvalidInput("A description is required for payment links without an order")
+	}
+	if link.Currency == "" {
+		link.Currency = "USD"
+	}
+
+	if err := uc.paymentLinkRepo.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("failed to create payment link: %w", err)
+	}
+
+	if req.Send {
+		if err := uc.send(ctx, link); err != nil {
+			return nil, err
+		}
+	}
+	return uc.toResponse(link), nil
+}
+
+// GetPaymentLinks lists payment links, newest first
+func (uc *paymentLinkUseCase) GetPaymentLinks(ctx context.Context, req GetPaymentLinksRequest) (*PaymentLinksListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	links, total, err := uc.paymentLinkRepo.List(ctx, repositories.PaymentLinkFilters{
+		Status:  req.Status,
+		OrderID: req.OrderID,
+		QuoteID: req.QuoteID,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*PaymentLinkResponse, len(links))
+	for i, link := range links {
+		responses[i] = uc.toResponse(link)
+	}
+	return &PaymentLinksListResponse{
+		PaymentLinks: responses,
+		Total:        total,
+		Pagination:   NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetPaymentLink gets a payment link
+func (uc *paymentLinkUseCase) GetPaymentLink(ctx context.Context, id uuid.UUID) (*PaymentLinkResponse, error) {
+	link, err := uc.paymentLinkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toResponse(link), nil
+}
+
+// SendPaymentLink sends a link that can still be paid to the customer, optionally to a different
+// email address or phone number
+func (uc *paymentLinkUseCase) SendPaymentLink(ctx context.Context, id uuid.UUID, req SendPaymentLinkRequest) (*PaymentLinkResponse, error) {
+	req.Email = strings.TrimSpace(req.Email)
+	req.Phone = strings.TrimSpace(req.Phone)
+	if err := entities.ValidatePaymentLinkRecipients(req.Email, req.Phone); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	link, err := uc.paymentLinkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := link.CheckPayable(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if req.Email != "" {
+		link.CustomerEmail = req.Email
+	}
+	if req.Phone != "" {
+		link.CustomerPhone = req.Phone
+	}
+	if err := uc.send(ctx, link); err != nil {
+		return nil, err
+	}
+	return uc.toResponse(link), nil
+}
+
+// CancelPaymentLink cancels a link that has not been paid
+func (uc *paymentLinkUseCase) CancelPaymentLink(ctx context.Context, id uuid.UUID) (*PaymentLinkResponse, error) {
+	link, err := uc.paymentLinkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.paymentLinkRepo.Cancel(ctx, id, time.Now()); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, fmt.Sprintf("Payment link is %s and can't be cancelled", link.Status))
+		}
+		return nil, err
+	}
+
+	return uc.GetPaymentLink(ctx, id)
+}
+
+// GetPublicPaymentLink gets what a customer needs to see before paying a link
+func (uc *paymentLinkUseCase) GetPublicPaymentLink(ctx context.Context, token string) (*PublicPaymentLinkResponse, error) {
+	link, err := uc.paymentLinkRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PublicPaymentLinkResponse{
+		Amount:      link.Amount,
+		Currency:    link.Currency,
+		Description: link.Description,
+		Status:      link.Status,
+		ExpiresAt:   link.ExpiresAt,
+		Payable:     link.CheckPayable(time.Now()) == nil,
+	}
+	if link.Status == entities.PaymentLinkStatusActive && link.IsExpired(time.Now()) {
+		response.Status = entities.PaymentLinkStatusExpired
+	}
+	if link.OrderID != nil {
+		order, err := uc.orderRepo.GetByID(ctx, *link.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		response.OrderNumber = order.OrderNumber
+		response.Amount = order.Total
+		response.Currency = order.Currency
+	}
+	return response, nil
+}
+
+// StartCheckout opens a Stripe checkout session for a link that can still be paid. While a
+// session opened from the link is still open, the same session is handed out again, so a link
+// can't be paid twice through separate sessions.
+func (uc *paymentLinkUseCase) StartCheckout(ctx context.Context, token string) (string, error) {
+	link, err := uc.paymentLinkRepo.GetByToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if err := link.CheckPayable(now); err != nil {
+		return "", err
+	}
+	staleBefore := now.Add(-paymentLinkCheckoutReuse)
+	if link.CheckoutURL != "" && link.CheckoutStartedAt != nil && link.CheckoutStartedAt.After(staleBefore) {
+		return link.CheckoutURL, nil
+	}
+
+	var order *entities.Order
+	if link.OrderID != nil {
+		order, err = uc.orderRepo.GetByID(ctx, *link.OrderID)
+		if err != nil {
+			return "", err
+		}
+		if err := checkPaymentLinkOrder(order); err != nil {
+			return "", err
+		}
+	}
+
+	if err := uc.paymentLinkRepo.ClaimCheckout(ctx, link.ID, staleBefore, now); err != nil {
+		if err == entities.ErrConflict {
+			return "", pkgErrors.New(pkgErrors.ErrCodeConflict, "Checkout for this payment link is already being opened, please try again shortly")
+		}
+		return "", err
+	}
+
+	sessionID, sessionURL, err := uc.openCheckoutSession(ctx, link, order)
+	if err != nil {
+		_ = uc.paymentLinkRepo.ReleaseCheckout(ctx, link.ID)
+		return "", err
+	}
+	if err := uc.paymentLinkRepo.SetCheckoutSession(ctx, link.ID, sessionID, sessionURL); err != nil {
+		return "", fmt.Errorf("failed to save checkout session: %w", err)
+	}
+	return sessionURL, nil
+}
+
+// ExpirePaymentLinks expires active links whose validity has lapsed
+func (uc *paymentLinkUseCase) ExpirePaymentLinks(ctx context.Context) (int64, error) {
+	expired, err := uc.paymentLinkRepo.ExpireActive(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire payment links: %w", err)
+	}
+	if expired > 0 {
+		fmt.Printf("✅ Expired %d payment links\n", expired)
+	}
+	return expired, nil
+}
+
+// openCheckoutSession opens a checkout session for the link. Order links go through the regular
+// order checkout, so the order's payment is confirmed by the payment webhook as usual.
+func (uc *paymentLinkUseCase) openCheckoutSession(ctx context.Context, link *entities.PaymentLink, order *entities.Order) (string, string, error) {
+	successURL := uc.linkURL(link) + "?status=success&session_id={CHECKOUT_SESSION_ID}"
+	cancelURL := uc.linkURL(link) + "?status=cancelled"
+
+	if order != nil {
+		resp, err := uc.paymentUseCase.CreateCheckoutSession(ctx, CreateCheckoutSessionRequest{
+			OrderID:     order.ID,
+			Amount:      order.Total,
+			Currency:    order.Currency,
+			Description: link.Description,
+			SuccessURL:  successURL,
+			CancelURL:   cancelURL,
+			Metadata: map[string]interface{}{
+				"payment_link_id": link.ID.String(),
+			},
+		})
+		if err != nil {
+			return "", "", err
+		}
+		return resp.SessionID, resp.SessionURL, nil
+	}
+
+	if uc.stripeService == nil {
+		return "", "", pkgErrors.ServiceUnavailable("Stripe service not configured")
+	}
+	resp, err := uc.stripeService.CreateCheckoutSession(ctx, CheckoutSessionRequest{
+		Amount:      link.Amount,
+		Currency:    link.Currency,
+		Description: link.Description,
+		SuccessURL:  successURL,
+		CancelURL:   cancelURL,
+		Metadata: map[string]string{
+			"payment_link_id": link.ID.String(),
+		},
+	})
+	if err != nil {
+		if resilience.IsUnavailable(err) {
+			err = pkgErrors.ServiceUnavailable("Payment provider is temporarily unavailable, please try again shortly").WithCause(err)
+		}
+		return "", "", err
+	}
+	if !resp.Success {
+		return "", "", fmt.Errorf("checkout session creation failed: %s", resp.Message)
+	}
+	return resp.SessionID, resp.SessionURL, nil
+}
+
+// send sends the link to the customer and records where and when it was sent
+func (uc *paymentLinkUseCase) send(ctx context.Context, link *entities.PaymentLink) error {
+	if link.CustomerEmail == "" && link.CustomerPhone == "" {
+		return pkgErrors.InvalidInput("A customer email or phone number is required to send a payment link")
+	}
+	if err := uc.notificationService.NotifyPaymentLink(ctx, link, uc.linkURL(link)); err != nil {
+		return fmt.Errorf("failed to send payment link: %w", err)
+	}
+
+	now := time.Now()
+	if err := uc.paymentLinkRepo.MarkSent(ctx, link.ID, link.CustomerEmail, link.CustomerPhone, now); err != nil {
+		return fmt.Errorf("failed to record payment link delivery: %w", err)
+	}
+	link.LastSentAt = &now
+	return nil
+}
+
+func (uc *paymentLinkUseCase) linkURL(link *entities.PaymentLink) string {
+	return uc.baseURL + "/" + link.Token
+}
+
+func (uc *paymentLinkUseCase) toResponse(link *entities.PaymentLink) *PaymentLinkResponse {
+	return &PaymentLinkResponse{PaymentLink: link, URL: uc.linkURL(link)}
+}
+
+// checkPaymentLinkOrder checks that an order can still be paid by link
+func checkPaymentLinkOrder(order *entities.Order) error {
+	if order.PaymentStatus == entities.PaymentStatusPaid {
+		return entities.ErrOrderAlreadyPaid
+	}
+	if order.Status != entities.OrderStatusPending {
+		return pkgErrors.New(pkgErrors.ErrCodeConflict, fmt.Sprintf("Order is %s and can't be paid by link", order.Status))
+	}
+	if order.IsAwaitingApproval() {
+		return entities.ErrOrderAwaitingApproval
+	}
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/services"
 	"ecom-golang-clean-architecture/internal/infrastructure/database"
 	"ecom-golang-clean-architecture/internal/infrastructure/payment"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -23,6 +26,17 @@ type PaymentGatewayService interface {
 	CreateCheckoutSession(ctx context.Context, req payment.CheckoutSessionRequest) (*payment.CheckoutSessionResponse, error)
 }
 
+// WebhookParser is implemented by gateways that can verify and decode webhook payloads
+type WebhookParser interface {
+	HandleWebhook(ctx context.Context, payload []byte, signature string) (*payment.WebhookEvent, error)
+}
+
+// WebhookEventFetcher is implemented by gateways that can retrieve past webhook events from
+// their API
+type WebhookEventFetcher interface {
+	FetchWebhookEvent(ctx context.Context, eventID string) (*payment.WebhookEvent, error)
+}
+
 // Type aliases for convenience
 type PaymentGatewayRequest = payment.PaymentGatewayRequest
 type PaymentGatewayResponse = payment.PaymentGatewayResponse
@@ -54,6 +68,8 @@ type PaymentUseCase interface {
 
 	// Webhooks
 	HandleWebhook(ctx context.Context, provider string, payload []byte, signature string) error
+	// ReplayWebhookEvent fetches a past event from the provider and processes it again
+	ReplayWebhookEvent(ctx context.Context, provider, eventID string) error
 
 	// Payment confirmation (fallback method)
 	ConfirmPaymentSuccess(ctx context.Context, orderID, userID uuid.UUID, sessionID string) error
@@ -78,6 +94,10 @@ type paymentUseCase struct {
 	userMetricsService services.UserMetricsService
 	txManager          *database.TransactionManager
 	simpleStockService services.SimpleStockService
+	events             services.EventRecorder
+	paymentLinkRepo    repositories.PaymentLinkRepository
+	disputeUseCase     DisputeUseCase
+	marketplaceFees    services.MarketplaceFeeService
 }
 
 // NewPaymentUseCase creates a new payment use case
@@ -93,6 +113,10 @@ func NewPaymentUseCase(
 	userMetricsService services.UserMetricsService,
 	txManager *database.TransactionManager,
 	simpleStockService services.SimpleStockService,
+	events services.EventRecorder,
+	paymentLinkRepo repositories.PaymentLinkRepository,
+	disputeUseCase DisputeUseCase,
+	marketplaceFees services.MarketplaceFeeService,
 ) PaymentUseCase {
 	return &paymentUseCase{
 		paymentRepo:        paymentRepo,
@@ -106,6 +130,10 @@ func NewPaymentUseCase(
 		userMetricsService: userMetricsService,
 		txManager:          txManager,
 		simpleStockService: simpleStockService,
+		events:             events,
+		paymentLinkRepo:    paymentLinkRepo,
+		disputeUseCase:     disputeUseCase,
+		marketplaceFees:    marketplaceFees,
 	}
 }
 
@@ -305,6 +333,9 @@ type PaymentReportSummary struct {
 	FailedPayments     int64   `json:"failed_payments"`
 	RefundAmount       float64 `json:"refund_amount"`
 	SuccessRate        float64 `json:"success_rate"`
+
+	// Disputes opened in the report period
+	Disputes *DisputeMetricsResponse `json:"disputes,omitempty"`
 }
 
 // ProcessPayment processes a payment for an order
@@ -315,6 +346,10 @@ func (uc *paymentUseCase) ProcessPayment(ctx context.Context, req ProcessPayment
 		return nil, entities.ErrOrderNotFound
 	}
 
+	if order.IsAwaitingApproval() {
+		return nil, entities.ErrOrderAwaitingApproval
+	}
+
 	// Validate payment amount (allow partial payments)
 	if req.Amount <= 0 {
 		return nil, fmt.Errorf("payment amount must be greater than 0")
@@ -391,7 +426,7 @@ func (uc *paymentUseCase) ProcessPayment(ctx context.Context, req ProcessPayment
 
 	// Create payment record
 	payment := &entities.Payment{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		OrderID:   req.OrderID,
 		UserID:    order.UserID,
 		Amount:    req.Amount,
@@ -492,6 +527,11 @@ func (uc *paymentUseCase) ProcessPayment(ctx context.Context, req ProcessPayment
 			return nil, fmt.Errorf("failed to update payment after gateway error: %w", updateErr)
 		}
 
+		// The provider was skipped or too slow; the payment stays pending and can be retried
+		if resilience.IsUnavailable(err) {
+			return nil, pkgErrors.ServiceUnavailable("Payment provider is temporarily unavailable, please try again shortly").WithCause(err)
+		}
+
 		return nil, fmt.Errorf("payment processing failed: %w", err)
 	}
 
@@ -554,10 +594,18 @@ func (uc *paymentUseCase) ProcessPayment(ctx context.Context, req ProcessPayment
 	if err := uc.paymentRepo.Update(ctx, payment); err != nil {
 		return nil, err
 	}
+	uc.recordPaymentCaptured(ctx, payment)
 
 	return uc.toPaymentResponse(payment), nil
 }
 
+// recordPaymentCaptured records a payment.captured event for a payment that was just marked paid
+func (uc *paymentUseCase) recordPaymentCaptured(ctx context.Context, payment *entities.Payment) {
+	if uc.events != nil && payment.Status == entities.PaymentStatusPaid {
+		uc.events.Record(ctx, entities.DomainEventPaymentCaptured, payment.ID, entities.NewPaymentCapturedEventData(payment))
+	}
+}
+
 // GetPayment gets a payment by ID
 func (uc *paymentUseCase) GetPayment(ctx context.Context, id uuid.UUID) (*PaymentResponse, error) {
 	payment, err := uc.paymentRepo.GetByID(ctx, id)
@@ -603,6 +651,7 @@ func (uc *paymentUseCase) updatePaymentStatusInTransaction(ctx context.Context,
 		return nil, err
 	}
 
+	wasPaid := payment.Status == entities.PaymentStatusPaid
 	payment.Status = status
 	if transactionID != "" {
 		payment.TransactionID = transactionID
@@ -618,6 +667,9 @@ func (uc *paymentUseCase) updatePaymentStatusInTransaction(ctx context.Context,
 	if err := uc.paymentRepo.Update(ctx, payment); err != nil {
 		return nil, err
 	}
+	if !wasPaid {
+		uc.recordPaymentCaptured(ctx, payment)
+	}
 
 	// Sync order payment status
 	order, err := uc.orderRepo.GetByID(ctx, payment.OrderID)
@@ -686,7 +738,7 @@ func (uc *paymentUseCase) ProcessRefund(ctx context.Context, req ProcessRefundRe
 
 	// Create refund entity
 	refund := &entities.Refund{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		PaymentID:   req.PaymentID,
 		OrderID:     req.OrderID,
 		Amount:      req.Amount,
@@ -793,6 +845,7 @@ func (uc *paymentUseCase) processApprovedRefund(ctx context.Context, payment *en
 	refundReq := RefundGatewayRequest{
 		TransactionID: payment.TransactionID,
 		Amount:        refund.Amount,
+		Currency:      payment.Currency,
 		Reason:        string(refund.Reason),
 	}
 
@@ -854,6 +907,15 @@ func (uc *paymentUseCase) processApprovedRefund(ctx context.Context, payment *en
 		return nil, err
 	}
 
+	// Take the refund back from the vendors whose items were in the order
+	if uc.marketplaceFees != nil {
+		if order, err := uc.orderRepo.GetByID(ctx, payment.OrderID); err == nil {
+			if err := uc.marketplaceFees.RecordRefund(ctx, order, refund); err != nil {
+				fmt.Printf("❌ Failed to record vendor refunds for refund %s: %v\n", refund.ID, err)
+			}
+		}
+	}
+
 	return uc.mapRefundToResponse(refund), nil
 }
 
@@ -958,18 +1020,51 @@ func (uc *paymentUseCase) HandleWebhook(ctx context.Context, provider string, pa
 
 // handleStripeWebhook processes Stripe webhook events
 func (uc *paymentUseCase) handleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
-	// Cast to StripeService to access HandleWebhook method
-	stripeService, ok := uc.stripeService.(*payment.StripeService)
+	// The real Stripe service and the sandbox simulator both decode webhooks
+	parser, ok := uc.stripeService.(WebhookParser)
 	if !ok {
 		return fmt.Errorf("stripe service not properly configured")
 	}
 
 	// Parse webhook event
-	webhookEvent, err := stripeService.HandleWebhook(ctx, payload, signature)
+	webhookEvent, err := parser.HandleWebhook(ctx, payload, signature)
 	if err != nil {
 		return fmt.Errorf("failed to parse stripe webhook: %v", err)
 	}
 
+	return uc.processStripeWebhookEvent(ctx, webhookEvent)
+}
+
+// ReplayWebhookEvent processes a past webhook event again, e.g. one that failed or never
+// arrived. Event handlers skip payments that are already settled, so replays are safe.
+func (uc *paymentUseCase) ReplayWebhookEvent(ctx context.Context, provider, eventID string) error {
+	var gateway PaymentGatewayService
+	switch provider {
+	case "stripe":
+		gateway = uc.stripeService
+	case "paypal":
+		gateway = uc.paypalService
+	default:
+		return pkgErrors.InvalidInput(fmt.Sprintf("replaying webhooks is not supported for %s", provider))
+	}
+
+	fetcher, ok := gateway.(WebhookEventFetcher)
+	if !ok {
+		return fmt.Errorf("%s service cannot fetch webhook events", provider)
+	}
+	webhookEvent, err := fetcher.FetchWebhookEvent(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s event %s: %w", provider, eventID, err)
+	}
+
+	if provider == "paypal" {
+		return uc.processPayPalWebhookEvent(ctx, webhookEvent)
+	}
+	return uc.processStripeWebhookEvent(ctx, webhookEvent)
+}
+
+// processStripeWebhookEvent dispatches a decoded Stripe event to its handler
+func (uc *paymentUseCase) processStripeWebhookEvent(ctx context.Context, webhookEvent *payment.WebhookEvent) error {
 	// Process different event types
 	switch webhookEvent.Type {
 	case "checkout.session.completed":
@@ -978,6 +1073,9 @@ func (uc *paymentUseCase) handleStripeWebhook(ctx context.Context, payload []byt
 		return uc.handlePaymentIntentSucceeded(ctx, webhookEvent)
 	case "payment_intent.payment_failed":
 		return uc.handlePaymentIntentFailed(ctx, webhookEvent)
+	case "charge.dispute.created", "charge.dispute.updated", "charge.dispute.closed",
+		"charge.dispute.funds_withdrawn", "charge.dispute.funds_reinstated":
+		return uc.handleDispute(ctx, "stripe", webhookEvent)
 	default:
 		// Log unknown event types but don't fail
 		fmt.Printf("Received unknown Stripe webhook event: %s\n", webhookEvent.Type)
@@ -995,12 +1093,58 @@ func (uc *paymentUseCase) handleCheckoutSessionCompleted(ctx context.Context, ev
 		return fmt.Errorf("missing session_id in webhook data")
 	}
 
-	fmt.Printf("🔍 Looking for payment with session ID: %s\n", sessionID)
+	// Sessions opened from a payment link for an arbitrary amount have no order or payment to confirm
+	var link *entities.PaymentLink
+	if uc.paymentLinkRepo != nil {
+		if found, err := uc.paymentLinkRepo.GetByStripeSessionID(ctx, sessionID); err == nil {
+			link = found
+		} else if err != entities.ErrPaymentLinkNotFound {
+			return fmt.Errorf("failed to get payment link for session %s: %w", sessionID, err)
+		}
+	}
 
-	// Execute payment confirmation in a transaction
-	return uc.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
-		return uc.confirmPaymentInTransaction(ctx, sessionID)
-	})
+	if link == nil || link.OrderID != nil {
+		fmt.Printf("🔍 Looking for payment with session ID: %s\n", sessionID)
+
+		// Execute payment confirmation in a transaction
+		err := uc.txManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+			return uc.confirmPaymentInTransaction(ctx, sessionID)
+		})
+		if err == nil {
+			uc.recordPaymentIntent(ctx, sessionID, event)
+		}
+		if err != nil || link == nil {
+			return err
+		}
+	}
+
+	// If marking the link fails, the provider retries the webhook. Confirming skips a payment that
+	// is already paid, so the retry only marks the link.
+	if err := uc.paymentLinkRepo.MarkPaid(ctx, link.ID, time.Now()); err != nil && err != entities.ErrConflict {
+		return fmt.Errorf("failed to mark payment link %s as paid: %w", link.ID, err)
+	}
+	fmt.Printf("✅ Payment link %s paid\n", link.ID)
+	return nil
+}
+
+// recordPaymentIntent stores the payment intent behind a checkout session on its payment, which
+// is how the payment's charge is matched when Stripe balance transactions are reconciled
+func (uc *paymentUseCase) recordPaymentIntent(ctx context.Context, sessionID string, event *payment.WebhookEvent) {
+	paymentIntentID, _ := event.Data["payment_intent_id"].(string)
+	if paymentIntentID == "" {
+		return
+	}
+	sessionPayment, err := uc.paymentRepo.GetByExternalID(ctx, sessionID)
+	if err != nil {
+		sessionPayment, err = uc.paymentRepo.GetByCustomSessionID(ctx, sessionID)
+	}
+	if err != nil || sessionPayment.PaymentIntentID == paymentIntentID {
+		return
+	}
+	sessionPayment.PaymentIntentID = paymentIntentID
+	if err := uc.paymentRepo.Update(ctx, sessionPayment); err != nil {
+		fmt.Printf("❌ Failed to record payment intent %s: %v\n", paymentIntentID, err)
+	}
 }
 
 // confirmPaymentInTransaction handles payment confirmation within a transaction
@@ -1033,6 +1177,7 @@ func (uc *paymentUseCase) confirmPaymentInTransaction(ctx context.Context, sessi
 		fmt.Printf("❌ Failed to update payment status: %v\n", err)
 		return fmt.Errorf("failed to update payment status: %v", err)
 	}
+	uc.recordPaymentCaptured(ctx, payment)
 
 	fmt.Printf("✅ Payment status updated to: %s\n", payment.Status)
 
@@ -1146,10 +1291,14 @@ func (uc *paymentUseCase) handlePaymentIntentSucceeded(ctx context.Context, even
 	}
 
 	// Update payment status
+	wasPaid := payment.Status == entities.PaymentStatusPaid
 	payment.MarkAsProcessed(paymentIntentID)
 	if err := uc.paymentRepo.Update(ctx, payment); err != nil {
 		return fmt.Errorf("failed to update payment status: %v", err)
 	}
+	if !wasPaid {
+		uc.recordPaymentCaptured(ctx, payment)
+	}
 
 	// Update order status
 	order, err := uc.orderRepo.GetByID(ctx, payment.OrderID)
@@ -1259,8 +1408,47 @@ func (uc *paymentUseCase) handlePaymentIntentFailed(ctx context.Context, event *
 
 // handlePayPalWebhook processes PayPal webhook events
 func (uc *paymentUseCase) handlePayPalWebhook(ctx context.Context, payload []byte, signature string) error {
-	// TODO: Implement PayPal webhook handling
-	return fmt.Errorf("paypal webhook handling not implemented yet")
+	parser, ok := uc.paypalService.(WebhookParser)
+	if !ok {
+		return fmt.Errorf("paypal service not properly configured")
+	}
+
+	webhookEvent, err := parser.HandleWebhook(ctx, payload, signature)
+	if err != nil {
+		return fmt.Errorf("failed to parse paypal webhook: %v", err)
+	}
+
+	return uc.processPayPalWebhookEvent(ctx, webhookEvent)
+}
+
+// processPayPalWebhookEvent dispatches a decoded PayPal event to its handler. PayPal payments are
+// captured synchronously, so only disputes arrive by webhook.
+func (uc *paymentUseCase) processPayPalWebhookEvent(ctx context.Context, webhookEvent *payment.WebhookEvent) error {
+	switch webhookEvent.Type {
+	case "CUSTOMER.DISPUTE.CREATED", "CUSTOMER.DISPUTE.UPDATED", "CUSTOMER.DISPUTE.RESOLVED":
+		return uc.handleDispute(ctx, "paypal", webhookEvent)
+	default:
+		fmt.Printf("Received unknown PayPal webhook event: %s\n", webhookEvent.Type)
+		return nil
+	}
+}
+
+// handleDispute records a dispute or chargeback reported by a provider
+func (uc *paymentUseCase) handleDispute(ctx context.Context, gateway string, event *payment.WebhookEvent) error {
+	if event.Dispute == nil {
+		return fmt.Errorf("missing dispute in %s webhook data", gateway)
+	}
+	if uc.disputeUseCase == nil {
+		fmt.Printf("⚠️ Dispute tracking not configured, ignoring %s event %s\n", gateway, event.Type)
+		return nil
+	}
+
+	dispute, err := uc.disputeUseCase.RecordGatewayDispute(ctx, gateway, event.Dispute)
+	if err != nil {
+		return fmt.Errorf("failed to record %s dispute %s: %w", gateway, event.Dispute.ID, err)
+	}
+	fmt.Printf("✅ Recorded %s dispute %s: %s\n", gateway, dispute.ExternalID, dispute.Status)
+	return nil
 }
 
 // CreateCheckoutSession creates a Stripe checkout session for hosted payment page
@@ -1282,6 +1470,14 @@ func (uc *paymentUseCase) CreateCheckoutSession(ctx context.Context, req CreateC
 		}, fmt.Errorf("order status is %s, expected pending", order.Status)
 	}
 
+	// Company orders over the buyer's spend limit cannot be paid until approved
+	if order.IsAwaitingApproval() {
+		return &CreateCheckoutSessionResponse{
+			Success: false,
+			Message: "Order is awaiting company approval",
+		}, entities.ErrOrderAwaitingApproval
+	}
+
 	// Convert metadata to string map
 	metadata := make(map[string]string)
 	for k, v := range req.Metadata {
@@ -1332,6 +1528,9 @@ func (uc *paymentUseCase) CreateCheckoutSession(ctx context.Context, req CreateC
 
 	checkoutResp, err := uc.stripeService.CreateCheckoutSession(ctx, checkoutReq)
 	if err != nil {
+		if resilience.IsUnavailable(err) {
+			err = pkgErrors.ServiceUnavailable("Payment provider is temporarily unavailable, please try again shortly").WithCause(err)
+		}
 		return &CreateCheckoutSessionResponse{
 			Success: false,
 			Message: fmt.Sprintf("Failed to create checkout session: %v", err),
@@ -1365,7 +1564,7 @@ func (uc *paymentUseCase) CreateCheckoutSession(ctx context.Context, req CreateC
 	} else {
 		// Create new payment record
 		paymentEntity := &entities.Payment{
-			ID:            uuid.New(),
+			ID:            ids.New(),
 			OrderID:       req.OrderID,
 			UserID:        order.UserID,
 			Amount:        order.Total, // Use order total
@@ -1400,6 +1599,15 @@ func (uc *paymentUseCase) CreateCheckoutSession(ctx context.Context, req CreateC
 func (uc *paymentUseCase) GetPaymentReport(ctx context.Context, req PaymentReportRequest) (*PaymentReportResponse, error) {
 	// This is a placeholder implementation
 	// In a real implementation, you would generate the report from the database
+	var disputes *DisputeMetricsResponse
+	if uc.disputeUseCase != nil {
+		metrics, err := uc.disputeUseCase.GetDisputeMetrics(ctx, DisputeMetricsRequest{DateFrom: req.DateFrom, DateTo: req.DateTo})
+		if err != nil {
+			return nil, err
+		}
+		disputes = metrics
+	}
+
 	return &PaymentReportResponse{
 		ReportType:  req.ReportType,
 		ReportID:    uuid.New(),
@@ -1412,6 +1620,7 @@ func (uc *paymentUseCase) GetPaymentReport(ctx context.Context, req PaymentRepor
 			FailedPayments:     0,
 			RefundAmount:       0,
 			SuccessRate:        0,
+			Disputes:           disputes,
 		},
 		Data: []map[string]interface{}{},
 	}, nil
@@ -1632,6 +1841,7 @@ func (uc *paymentUseCase) ConfirmPaymentSuccess(ctx context.Context, orderID, us
 		fmt.Printf("❌ Failed to update payment status: %v\n", err)
 		return fmt.Errorf("failed to update payment status: %v", err)
 	}
+	uc.recordPaymentCaptured(ctx, payment)
 
 	fmt.Printf("✅ Payment status updated to: %s\n", payment.Status)
 
@@ -0,0 +1,1321 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/money"
+	"ecom-golang-clean-architecture/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// PlatformCSVUseCase defines use cases for CSV files in the layouts other commerce platforms
+// export and import, for merchants moving their store to or from us
+type PlatformCSVUseCase interface {
+	ExportProducts(ctx context.Context, platform string) (*PlatformCSVFile, error)
+	ExportOrders(ctx context.Context, req ExportPlatformOrdersRequest) (*PlatformCSVFile, error)
+	ImportProducts(ctx context.Context, req ImportPlatformProductsRequest) (*ImportPlatformProductsResponse, error)
+}
+
+// CSV platforms
+const (
+	CSVPlatformShopify     = "shopify"
+	CSVPlatformWooCommerce = "woocommerce"
+)
+
+// Platform product import actions
+const (
+	PlatformImportActionCreate = "create"
+	PlatformImportActionUpdate = "update"
+	PlatformImportActionSkip   = "skip"
+	PlatformImportActionError  = "error"
+)
+
+// platformCSVBatchSize is how many products or orders are loaded at a time when exporting
+const platformCSVBatchSize = 500
+
+type platformCSVUseCase struct {
+	productUseCase      ProductUseCase
+	productRepo         repositories.ProductRepository
+	categoryRepo        repositories.CategoryRepository
+	productCategoryRepo repositories.ProductCategoryRepository
+	brandRepo           repositories.BrandRepository
+	orderRepo           repositories.OrderRepository
+	residency           services.DataResidencyService
+}
+
+// NewPlatformCSVUseCase creates a new platform CSV use case
+func NewPlatformCSVUseCase(
+	productUseCase ProductUseCase,
+	productRepo repositories.ProductRepository,
+	categoryRepo repositories.CategoryRepository,
+	productCategoryRepo repositories.ProductCategoryRepository,
+	brandRepo repositories.BrandRepository,
+	orderRepo repositories.OrderRepository,
+	residency services.DataResidencyService,
+) PlatformCSVUseCase {
+	return &platformCSVUseCase{
+		productUseCase:      productUseCase,
+		productRepo:         productRepo,
+		categoryRepo:        categoryRepo,
+		productCategoryRepo: productCategoryRepo,
+		brandRepo:           brandRepo,
+		orderRepo:           orderRepo,
+		residency:           residency,
+	}
+}
+
+// PlatformCSVFile is an exported CSV file
+type PlatformCSVFile struct {
+	FileName string
+	Data     []byte
+}
+
+// ExportPlatformOrdersRequest represents a request for an order CSV
+type ExportPlatformOrdersRequest struct {
+	Platform string
+	Status   *entities.OrderStatus
+	DateFrom *time.Time
+	DateTo   *time.Time
+}
+
+// ImportPlatformProductsRequest represents an uploaded product CSV
+type ImportPlatformProductsRequest struct {
+	Platform string
+	Data     []byte
+	// DryRun validates the file and reports what would be imported without saving anything
+	DryRun bool
+	// UpdateExisting updates products whose SKU already exists instead of skipping them
+	UpdateExisting bool
+	// DefaultCategoryID is used for products whose category is missing or not found
+	DefaultCategoryID *uuid.UUID
+}
+
+// PlatformProductImportResult reports what happened, or would happen, to one product in the file
+type PlatformProductImportResult struct {
+	Line      int        `json:"line"` // First line of the product in the file
+	SKU       string     `json:"sku"`
+	Name      string     `json:"name"`
+	Action    string     `json:"action"` // create, update, skip or error
+	ProductID *uuid.UUID `json:"product_id,omitempty"`
+	Errors    []string   `json:"errors"`
+	Warnings  []string   `json:"warnings"`
+}
+
+// ImportPlatformProductsResponse reports the outcome of a product CSV import. In a dry run the
+// counts are what the import would do.
+type ImportPlatformProductsResponse struct {
+	Platform string                         `json:"platform"`
+	DryRun   bool                           `json:"dry_run"`
+	Total    int                            `json:"total"`
+	Created  int                            `json:"created"`
+	Updated  int                            `json:"updated"`
+	Skipped  int                            `json:"skipped"`
+	Failed   int                            `json:"failed"`
+	Products []*PlatformProductImportResult `json:"products"`
+	Errors   []string                       `json:"errors"` // Lines that could not be read
+}
+
+// shopifyProductColumns is the Shopify product CSV layout
+var shopifyProductColumns = []string{
+	"Handle", "Title", "Body (HTML)", "Vendor", "Product Category", "Type", "Tags", "Published",
+	"Option1 Name", "Option1 Value", "Variant SKU", "Variant Grams", "Variant Inventory Tracker",
+	"Variant Inventory Qty", "Variant Inventory Policy", "Variant Fulfillment Service", "Variant Price",
+	"Variant Compare At Price", "Variant Requires Shipping", "Variant Taxable", "Variant Barcode",
+	"Image Src", "Image Position", "Image Alt Text", "Gift Card", "SEO Title", "SEO Description",
+	"Variant Weight Unit", "Cost per item", "Status",
+}
+
+// wooCommerceProductColumns is the WooCommerce product CSV importer layout
+var wooCommerceProductColumns = []string{
+	"ID", "Type", "SKU", "Name", "Published", "Is featured?", "Visibility in catalog", "Short description",
+	"Description", "Date sale price starts", "Date sale price ends", "Tax status", "Tax class", "In stock?",
+	"Stock", "Low stock amount", "Backorders allowed?", "Weight (kg)", "Length (cm)", "Width (cm)",
+	"Height (cm)", "Sale price", "Regular price", "Categories", "Tags", "Shipping class", "Images", "Brands",
+}
+
+// shopifyOrderColumns is the Shopify order export layout, one line per order item
+var shopifyOrderColumns = []string{
+	"Name", "Email", "Financial Status", "Paid at", "Fulfillment Status", "Fulfilled at", "Currency",
+	"Subtotal", "Shipping", "Taxes", "Total", "Discount Code", "Discount Amount", "Shipping Method",
+	"Created at", "Lineitem quantity", "Lineitem name", "Lineitem price", "Lineitem sku",
+	"Lineitem requires shipping", "Lineitem fulfillment status", "Billing Name", "Billing Street",
+	"Billing Address1", "Billing Address2", "Billing Company", "Billing City", "Billing Zip",
+	"Billing Province", "Billing Country", "Billing Phone", "Shipping Name", "Shipping Street",
+	"Shipping Address1", "Shipping Address2", "Shipping Company", "Shipping City", "Shipping Zip",
+	"Shipping Province", "Shipping Country", "Shipping Phone", "Notes", "Cancelled at", "Payment Method",
+	"Payment Reference", "Refunded Amount", "Id", "Tags", "Source",
+}
+
+// wooCommerceOrderColumns is the order layout of WooCommerce's order export and import tools, one
+// line per order followed by a line_item_N column per item
+var wooCommerceOrderColumns = []string{
+	"order_id", "order_number", "order_date", "status", "shipping_total", "tax_total", "discount_total",
+	"order_total", "order_currency", "payment_method", "payment_method_title", "transaction_id",
+	"customer_email", "billing_first_name", "billing_last_name", "billing_company", "billing_email",
+	"billing_phone", "billing_address_1", "billing_address_2", "billing_postcode", "billing_city",
+	"billing_state", "billing_country", "shipping_first_name", "shipping_last_name", "shipping_company",
+	"shipping_phone", "shipping_address_1", "shipping_address_2", "shipping_postcode", "shipping_city",
+	"shipping_state", "shipping_country", "shipping_method", "customer_note", "coupon_items",
+}
+
+// ExportProducts builds a product CSV in the platform's layout
+func (uc *platformCSVUseCase) ExportProducts(ctx context.Context, platform string) (*PlatformCSVFile, error) {
+	if err := validateCSVPlatform(platform); err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.loadCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]string
+	for offset := 0; ; offset += platformCSVBatchSize {
+		products, err := uc.productRepo.List(ctx, platformCSVBatchSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+
+		productIDs := make([]uuid.UUID, len(products))
+		for i, product := range products {
+			productIDs[i] = product.ID
+		}
+		categoryIDs, err := uc.productCategoryRepo.GetCategoryIDsByProductIDs(ctx, productIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product categories: %w", err)
+		}
+
+		for _, product := range products {
+			var productCategories []*entities.Category
+			for _, id := range categoryIDs[product.ID] {
+				if category := categories[id]; category != nil {
+					productCategories = append(productCategories, category)
+				}
+			}
+			if platform == CSVPlatformShopify {
+				rows = append(rows, shopifyProductRows(product, productCategories)...)
+			} else {
+				rows = append(rows, wooCommerceProductRow(product, productCategories, categories))
+			}
+		}
+
+		if len(products) < platformCSVBatchSize {
+			break
+		}
+	}
+
+	columns := shopifyProductColumns
+	if platform == CSVPlatformWooCommerce {
+		columns = wooCommerceProductColumns
+	}
+	data, err := writePlatformCSV(columns, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &PlatformCSVFile{
+		FileName: fmt.Sprintf("products_%s_%s.csv", platform, time.Now().Format("20060102")),
+		Data:     data,
+	}, nil
+}
+
+// loadCategories loads every category by ID, for category names and paths
+func (uc *platformCSVUseCase) loadCategories(ctx context.Context) (map[uuid.UUID]*entities.Category, error) {
+	categories := make(map[uuid.UUID]*entities.Category)
+	for offset := 0; ; offset += platformCSVBatchSize {
+		batch, err := uc.categoryRepo.List(ctx, platformCSVBatchSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		for _, category := range batch {
+			categories[category.ID] = category
+		}
+		if len(batch) < platformCSVBatchSize {
+			return categories, nil
+		}
+	}
+}
+
+// shopifyProductRows writes a product as its default variant, with a line for each further image
+func shopifyProductRows(product *entities.Product, categories []*entities.Category) []map[string]string {
+	price, compareAt := product.Price, product.ComparePrice
+	if product.IsOnSale() {
+		price, compareAt = *product.SalePrice, &product.Price
+	}
+
+	row := map[string]string{
+		"Handle":                      product.Slug,
+		"Title":                       product.Name,
+		"Body (HTML)":                 product.Description,
+		"Tags":                        joinProductTags(product.Tags),
+		"Published":                   csvBool(product.Status == entities.ProductStatusActive && product.Visibility == entities.ProductVisibilityVisible, "TRUE", "FALSE"),
+		"Option1 Name":                "Title",
+		"Option1 Value":               "Default Title",
+		"Variant SKU":                 product.SKU,
+		"Variant Inventory Qty":       strconv.Itoa(product.Stock),
+		"Variant Inventory Policy":    csvBool(product.AllowBackorder, "continue", "deny"),
+		"Variant Fulfillment Service": "manual",
+		"Variant Price":               csvDecimal(price),
+		"Variant Compare At Price":    csvOptionalDecimal(compareAt),
+		"Variant Requires Shipping":   csvBool(product.RequiresShipping, "TRUE", "FALSE"),
+		"Variant Taxable":             "TRUE",
+		"Gift Card":                   "FALSE",
+		"SEO Title":                   product.MetaTitle,
+		"SEO Description":             product.MetaDescription,
+		"Variant Weight Unit":         "kg",
+		"Cost per item":               csvOptionalDecimal(product.CostPrice),
+		"Status":                      shopifyProductStatus(product.Status),
+	}
+	if product.Brand != nil {
+		row["Vendor"] = product.Brand.Name
+	}
+	if len(categories) > 0 {
+		row["Type"] = categories[0].Name
+	}
+	if product.TrackQuantity {
+		row["Variant Inventory Tracker"] = "shopify"
+	}
+	if product.Weight != nil {
+		row["Variant Grams"] = strconv.FormatInt(int64(math.Round(*product.Weight*1000)), 10)
+	}
+
+	rows := []map[string]string{row}
+	for i, image := range product.Images {
+		imageRow := row
+		if i > 0 {
+			imageRow = map[string]string{"Handle": product.Slug}
+			rows = append(rows, imageRow)
+		}
+		imageRow["Image Src"] = image.URL
+		imageRow["Image Position"] = strconv.Itoa(i + 1)
+		imageRow["Image Alt Text"] = image.AltText
+	}
+	return rows
+}
+
+// wooCommerceProductRow writes a product as a simple product
+func wooCommerceProductRow(product *entities.Product, categories []*entities.Category, allCategories map[uuid.UUID]*entities.Category) map[string]string {
+	published := "0"
+	switch product.Status {
+	case entities.ProductStatusActive:
+		published = "1"
+	case entities.ProductStatusDraft:
+		published = "-1"
+	}
+	productType := "simple"
+	if product.ProductType == entities.ProductTypeGrouped || product.ProductType == entities.ProductTypeExternal {
+		productType = string(product.ProductType)
+	}
+	taxClass := product.TaxClass
+	if taxClass == "standard" {
+		taxClass = ""
+	}
+
+	row := map[string]string{
+		"Type":                   productType,
+		"SKU":                    product.SKU,
+		"Name":                   product.Name,
+		"Published":              published,
+		"Is featured?":           csvBool(product.Featured, "1", "0"),
+		"Visibility in catalog":  csvBool(product.Visibility == entities.ProductVisibilityVisible, "visible", "hidden"),
+		"Short description":      product.ShortDescription,
+		"Description":            product.Description,
+		"Date sale price starts": csvDate(product.SaleStartDate),
+		"Date sale price ends":   csvDate(product.SaleEndDate),
+		"Tax status":             "taxable",
+		"Tax class":              taxClass,
+		"In stock?":              csvBool(product.Stock > 0 || !product.TrackQuantity, "1", csvBool(product.AllowBackorder, "backorder", "0")),
+		"Low stock amount":       strconv.Itoa(product.LowStockThreshold),
+		"Backorders allowed?":    csvBool(product.AllowBackorder, "1", "0"),
+		"Weight (kg)":            csvOptionalDecimal(product.Weight),
+		"Sale price":             csvOptionalDecimal(product.SalePrice),
+		"Regular price":          csvDecimal(product.Price),
+		"Tags":                   joinProductTags(product.Tags),
+		"Shipping class":         product.ShippingClass,
+	}
+	if product.TrackQuantity {
+		row["Stock"] = strconv.Itoa(product.Stock)
+	}
+	if product.Dimensions != nil {
+		row["Length (cm)"] = csvDecimal(product.Dimensions.Length)
+		row["Width (cm)"] = csvDecimal(product.Dimensions.Width)
+		row["Height (cm)"] = csvDecimal(product.Dimensions.Height)
+	}
+	if product.Brand != nil {
+		row["Brands"] = product.Brand.Name
+	}
+
+	paths := make([]string, len(categories))
+	for i, category := range categories {
+		paths[i] = categoryPath(category, allCategories)
+	}
+	row["Categories"] = strings.Join(paths, ", ")
+
+	images := make([]string, len(product.Images))
+	for i, image := range product.Images {
+		images[i] = image.URL
+	}
+	row["Images"] = strings.Join(images, ", ")
+	return row
+}
+
+// categoryPath returns a category with its parents, e.g. "Clothing > Shirts"
+func categoryPath(category *entities.Category, categories map[uuid.UUID]*entities.Category) string {
+	names := []string{category.Name}
+	// The depth cap stops a parent cycle from looping forever
+	for depth := 0; category.ParentID != nil && depth < 10; depth++ {
+		category = categories[*category.ParentID]
+		if category == nil {
+			break
+		}
+		names = append([]string{category.Name}, names...)
+	}
+	return strings.Join(names, " > ")
+}
+
+func shopifyProductStatus(status entities.ProductStatus) string {
+	switch status {
+	case entities.ProductStatusActive:
+		return "active"
+	case entities.ProductStatusDiscontinued, entities.ProductStatusArchived:
+		return "archived"
+	default:
+		return "draft"
+	}
+}
+
+// ExportOrders builds an order CSV in the platform's layout, limited to the orders of customers
+// in the data regions the admin may export
+func (uc *platformCSVUseCase) ExportOrders(ctx context.Context, req ExportPlatformOrdersRequest) (*PlatformCSVFile, error) {
+	if err := validateCSVPlatform(req.Platform); err != nil {
+		return nil, err
+	}
+	regions, err := uc.residency.ExportRegions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*entities.Order
+	for offset := 0; ; offset += platformCSVBatchSize {
+		batch, err := uc.orderRepo.Search(ctx, repositories.OrderSearchParams{
+			Status:      req.Status,
+			StartDate:   req.DateFrom,
+			EndDate:     req.DateTo,
+			DataRegions: regions,
+			SortBy:      "created_at",
+			SortOrder:   "asc",
+			Limit:       platformCSVBatchSize,
+			Offset:      offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search orders: %w", err)
+		}
+		orders = append(orders, batch...)
+		if len(batch) < platformCSVBatchSize {
+			break
+		}
+	}
+
+	var (
+		columns []string
+		rows    []map[string]string
+	)
+	if req.Platform == CSVPlatformShopify {
+		columns = shopifyOrderColumns
+		for _, order := range orders {
+			rows = append(rows, shopifyOrderRows(order)...)
+		}
+	} else {
+		columns = wooCommerceOrderColumns
+		maxItems := 0
+		for _, order := range orders {
+			rows = append(rows, wooCommerceOrderRow(order))
+			if len(order.Items) > maxItems {
+				maxItems = len(order.Items)
+			}
+		}
+		for i := 1; i <= maxItems; i++ {
+			columns = append(columns, fmt.Sprintf("line_item_%d", i))
+		}
+	}
+
+	data, err := writePlatformCSV(columns, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &PlatformCSVFile{
+		FileName: fmt.Sprintf("orders_%s_%s.csv", req.Platform, time.Now().Format("20060102")),
+		Data:     data,
+	}, nil
+}
+
+// shopifyOrderRows writes a line per order item; like Shopify, only the first line carries the
+// order's totals and addresses
+func shopifyOrderRows(order *entities.Order) []map[string]string {
+	row := map[string]string{
+		"Name":               order.OrderNumber,
+		"Email":              order.User.Email,
+		"Financial Status":   shopifyFinancialStatus(order.PaymentStatus),
+		"Fulfillment Status": csvBool(isFulfilledOrder(order), "fulfilled", "unfulfilled"),
+		"Fulfilled at":       csvTime(order.ShippedAt),
+		"Currency":           order.Currency,
+		"Subtotal":           csvAmount(order.Subtotal, order.Currency),
+		"Shipping":           csvAmount(order.ShippingAmount, order.Currency),
+		"Taxes":              csvAmount(order.TaxAmount, order.Currency),
+		"Total":              csvAmount(order.Total, order.Currency),
+		"Discount Code":      strings.Join(parseJSONList(order.CouponCodes), ", "),
+		"Discount Amount":    csvAmount(order.DiscountAmount, order.Currency),
+		"Shipping Method":    order.ShippingMethod,
+		"Created at":         csvTime(&order.CreatedAt),
+		"Notes":              order.CustomerNotes,
+		"Payment Method":     string(order.PaymentMethod),
+		"Id":                 order.ID.String(),
+		"Tags":               strings.Join(parseJSONList(order.Tags), ", "),
+		"Source":             string(order.Source),
+	}
+	if order.Status == entities.OrderStatusCancelled {
+		row["Cancelled at"] = csvTime(&order.UpdatedAt)
+	}
+
+	refunded := 0.0
+	for _, payment := range order.Payments {
+		refunded += payment.RefundAmount
+		if payment.IsSuccessful() && row["Paid at"] == "" {
+			row["Paid at"] = csvTime(payment.ProcessedAt)
+			row["Payment Reference"] = payment.TransactionID
+		}
+	}
+	row["Refunded Amount"] = csvAmount(refunded, order.Currency)
+
+	addShopifyAddress(row, "Billing", order.BillingAddress)
+	addShopifyAddress(row, "Shipping", order.ShippingAddress)
+
+	if len(order.Items) == 0 {
+		return []map[string]string{row}
+	}
+	rows := make([]map[string]string, len(order.Items))
+	for i, item := range order.Items {
+		itemRow := row
+		if i > 0 {
+			itemRow = map[string]string{"Name": order.OrderNumber, "Email": order.User.Email}
+		}
+		itemRow["Lineitem quantity"] = strconv.Itoa(item.Quantity)
+		itemRow["Lineitem name"] = item.ProductName
+		itemRow["Lineitem price"] = csvAmount(item.Price, order.Currency)
+		itemRow["Lineitem sku"] = item.ProductSKU
+		itemRow["Lineitem requires shipping"] = csvBool(item.Product.ID == uuid.Nil || item.Product.RequiresShipping, "true", "false")
+		itemRow["Lineitem fulfillment status"] = csvBool(isFulfilledOrder(order), "fulfilled", "pending")
+		rows[i] = itemRow
+	}
+	return rows
+}
+
+func addShopifyAddress(row map[string]string, prefix string, address *entities.OrderAddress) {
+	if address == nil {
+		return
+	}
+	row[prefix+" Name"] = strings.TrimSpace(address.GetFullName())
+	row[prefix+" Street"] = strings.TrimSpace(address.Address1 + " " + address.Address2)
+	row[prefix+" Address1"] = address.Address1
+	row[prefix+" Address2"] = address.Address2
+	row[prefix+" Company"] = address.Company
+	row[prefix+" City"] = address.City
+	row[prefix+" Zip"] = address.ZipCode
+	row[prefix+" Province"] = address.State
+	row[prefix+" Country"] = address.Country
+	row[prefix+" Phone"] = address.Phone
+}
+
+func shopifyFinancialStatus(status entities.PaymentStatus) string {
+	switch status {
+	case entities.PaymentStatusPaid:
+		return "paid"
+	case entities.PaymentStatusPartiallyPaid:
+		return "partially_paid"
+	case entities.PaymentStatusRefunded:
+		return "refunded"
+	case entities.PaymentStatusFailed, entities.PaymentStatusCancelled:
+		return "voided"
+	default:
+		return "pending"
+	}
+}
+
+// wooCommerceOrderRow writes an order with each item in a line_item_N column
+func wooCommerceOrderRow(order *entities.Order) map[string]string {
+	row := map[string]string{
+		"order_id":             order.ID.String(),
+		"order_number":         order.OrderNumber,
+		"order_date":           order.CreatedAt.Format("2006-01-02 15:04:05"),
+		"status":               wooCommerceOrderStatus(order),
+		"shipping_total":       csvAmount(order.ShippingAmount, order.Currency),
+		"tax_total":            csvAmount(order.TaxAmount, order.Currency),
+		"discount_total":       csvAmount(order.DiscountAmount, order.Currency),
+		"order_total":          csvAmount(order.Total, order.Currency),
+		"order_currency":       order.Currency,
+		"payment_method":       wooCommercePaymentMethod(order.PaymentMethod),
+		"payment_method_title": wooCommercePaymentMethodTitle(order.PaymentMethod),
+		"customer_email":       order.User.Email,
+		"billing_email":        order.User.Email,
+		"shipping_method":      order.ShippingMethod,
+		"customer_note":        order.CustomerNotes,
+	}
+	for _, payment := range order.Payments {
+		if payment.IsSuccessful() {
+			row["transaction_id"] = payment.TransactionID
+			break
+		}
+	}
+
+	coupons := parseJSONList(order.CouponCodes)
+	for i, code := range coupons {
+		coupons[i] = "code:" + code
+	}
+	row["coupon_items"] = strings.Join(coupons, ";")
+
+	addWooCommerceAddress(row, "billing", order.BillingAddress)
+	addWooCommerceAddress(row, "shipping", order.ShippingAddress)
+
+	for i, item := range order.Items {
+		row[fmt.Sprintf("line_item_%d", i+1)] = fmt.Sprintf("name:%s|sku:%s|quantity:%d|total:%s|sub_total:%s",
+			item.ProductName, item.ProductSKU, item.Quantity,
+			csvAmount(item.Total, order.Currency), csvAmount(item.Total, order.Currency))
+	}
+	return row
+}
+
+func addWooCommerceAddress(row map[string]string, prefix string, address *entities.OrderAddress) {
+	if address == nil {
+		return
+	}
+	row[prefix+"_first_name"] = address.FirstName
+	row[prefix+"_last_name"] = address.LastName
+	row[prefix+"_company"] = address.Company
+	row[prefix+"_phone"] = address.Phone
+	row[prefix+"_address_1"] = address.Address1
+	row[prefix+"_address_2"] = address.Address2
+	row[prefix+"_postcode"] = address.ZipCode
+	row[prefix+"_city"] = address.City
+	row[prefix+"_state"] = address.State
+	row[prefix+"_country"] = address.Country
+}
+
+func wooCommerceOrderStatus(order *entities.Order) string {
+	switch order.Status {
+	case entities.OrderStatusDraft:
+		return "checkout-draft"
+	case entities.OrderStatusPending:
+		switch order.PaymentStatus {
+		case entities.PaymentStatusFailed:
+			return "failed"
+		case entities.PaymentStatusAwaitingPayment:
+			return "on-hold"
+		}
+		return "pending"
+	case entities.OrderStatusShipped, entities.OrderStatusOutForDelivery, entities.OrderStatusDelivered, entities.OrderStatusExchanged:
+		return "completed"
+	case entities.OrderStatusCancelled:
+		return "cancelled"
+	case entities.OrderStatusRefunded, entities.OrderStatusReturned:
+		return "refunded"
+	default:
+		return "processing"
+	}
+}
+
+func wooCommercePaymentMethod(method entities.PaymentMethod) string {
+	switch method {
+	case entities.PaymentMethodBankTransfer:
+		return "bacs"
+	case entities.PaymentMethodCash:
+		return "cod"
+	case entities.PaymentMethodPayPal:
+		return "paypal"
+	case entities.PaymentMethodCreditCard, entities.PaymentMethodDebitCard, entities.PaymentMethodStripe,
+		entities.PaymentMethodApplePay, entities.PaymentMethodGooglePay:
+		return "stripe"
+	default:
+		return string(method)
+	}
+}
+
+// wooCommercePaymentMethodTitle returns the payment method as shoppers saw it, e.g. "Bank transfer"
+func wooCommercePaymentMethodTitle(method entities.PaymentMethod) string {
+	title := strings.ReplaceAll(string(method), "_", " ")
+	if title == "" {
+		return ""
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+func isFulfilledOrder(order *entities.Order) bool {
+	return order.FulfillmentStatus == entities.FulfillmentStatusShipped || order.FulfillmentStatus == entities.FulfillmentStatusDelivered
+}
+
+// platformProduct is a product read from a platform CSV, before it is matched to our catalog
+type platformProduct struct {
+	result     *PlatformProductImportResult
+	request    CreateProductRequest
+	hasVariant bool
+	category   string // Most specific category name
+	brand      string
+}
+
+func (p *platformProduct) warn(format string, args ...interface{}) {
+	p.result.Warnings = append(p.result.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (p *platformProduct) fail(format string, args ...interface{}) {
+	p.result.Errors = append(p.result.Errors, fmt.Sprintf(format, args...))
+}
+
+// ImportProducts imports products from a Shopify or WooCommerce product CSV. Products are matched
+// to ours by SKU; in a dry run every product is validated and reported without saving anything.
+func (uc *platformCSVUseCase) ImportProducts(ctx context.Context, req ImportPlatformProductsRequest) (*ImportPlatformProductsResponse, error) {
+	var (
+		products []*platformProduct
+		errs     []string
+		err      error
+	)
+	switch req.Platform {
+	case CSVPlatformShopify:
+		products, errs, err = parseShopifyProducts(req.Data)
+	case CSVPlatformWooCommerce:
+		products, errs, err = parseWooCommerceProducts(req.Data)
+	default:
+		return nil, pkgErrors.InvalidInput("platform must be shopify or woocommerce")
+	}
+	if err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if req.DefaultCategoryID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *req.DefaultCategoryID); err != nil {
+			return nil, pkgErrors.InvalidInput("default category not found")
+		}
+	}
+
+	response := &ImportPlatformProductsResponse{
+		Platform: req.Platform,
+		DryRun:   req.DryRun,
+		Total:    len(products),
+		Products: make([]*PlatformProductImportResult, 0, len(products)),
+		Errors:   errs,
+	}
+	lookups := &platformLookups{uc: uc, categories: map[string]*uuid.UUID{}, brands: map[string]*uuid.UUID{}}
+	seenSKUs := make(map[string]int)
+	for _, product := range products {
+		uc.importProduct(ctx, req, product, lookups, seenSKUs)
+
+		switch product.result.Action {
+		case PlatformImportActionCreate:
+			response.Created++
+		case PlatformImportActionUpdate:
+			response.Updated++
+		case PlatformImportActionSkip:
+			response.Skipped++
+		default:
+			response.Failed++
+		}
+		response.Products = append(response.Products, product.result)
+	}
+
+	if response.Errors == nil {
+		response.Errors = []string{}
+	}
+	return response, nil
+}
+
+// importProduct validates a product and, unless this is a dry run, creates or updates it
+func (uc *platformCSVUseCase) importProduct(ctx context.Context, req ImportPlatformProductsRequest, product *platformProduct, lookups *platformLookups, seenSKUs map[string]int) {
+	result := product.result
+	result.SKU = product.request.SKU
+	result.Name = product.request.Name
+
+	if product.request.SKU == "" {
+		product.fail("SKU is required")
+	} else if line, ok := seenSKUs[strings.ToLower(product.request.SKU)]; ok {
+		product.fail("SKU %s is already used on line %d", product.request.SKU, line)
+	} else {
+		seenSKUs[strings.ToLower(product.request.SKU)] = result.Line
+	}
+	if product.request.Name == "" {
+		product.fail("name is required")
+	}
+	if product.request.Price <= 0 {
+		product.fail("price must be greater than 0")
+	}
+
+	if categoryID := lookups.category(ctx, product.category); categoryID != nil {
+		product.request.CategoryID = *categoryID
+	} else if req.DefaultCategoryID != nil {
+		if product.category != "" {
+			product.warn("category %q not found, using the default category", product.category)
+		}
+		product.request.CategoryID = *req.DefaultCategoryID
+	} else if product.category != "" {
+		product.fail("category %q not found; create it or pass default_category_id", product.category)
+	} else {
+		product.fail("no category; pass default_category_id")
+	}
+
+	if product.brand != "" {
+		if brandID := lookups.brand(ctx, product.brand); brandID != nil {
+			product.request.BrandID = brandID
+		} else {
+			product.warn("brand %q not found and left empty", product.brand)
+		}
+	}
+
+	var existing *entities.Product
+	if product.request.SKU != "" {
+		existing, _ = uc.productRepo.GetBySKU(ctx, product.request.SKU)
+	}
+
+	switch {
+	case len(result.Errors) > 0:
+		result.Action = PlatformImportActionError
+		return
+	case existing != nil && !req.UpdateExisting:
+		result.Action = PlatformImportActionSkip
+		result.ProductID = &existing.ID
+		product.warn("SKU already exists; pass update_existing to update it")
+		return
+	case existing != nil:
+		result.Action = PlatformImportActionUpdate
+		result.ProductID = &existing.ID
+	default:
+		result.Action = PlatformImportActionCreate
+	}
+	if req.DryRun {
+		return
+	}
+
+	if existing != nil {
+		if _, err := uc.productUseCase.UpdateProduct(ctx, existing.ID, toUpdateProductRequest(product.request)); err != nil {
+			result.Action = PlatformImportActionError
+			product.fail("failed to update product: %v", err)
+		}
+		return
+	}
+	created, err := uc.productUseCase.CreateProduct(ctx, product.request)
+	if err != nil {
+		result.Action = PlatformImportActionError
+		product.fail("failed to create product: %v", err)
+		return
+	}
+	result.ProductID = &created.ID
+}
+
+// toUpdateProductRequest updates the fields platform files carry, leaving the rest of the product as it is
+func toUpdateProductRequest(req CreateProductRequest) UpdateProductRequest {
+	update := UpdateProductRequest{
+		Name:             &req.Name,
+		Description:      &req.Description,
+		ShortDescription: &req.ShortDescription,
+		MetaTitle:        &req.MetaTitle,
+		MetaDescription:  &req.MetaDescription,
+		Featured:         &req.Featured,
+		Price:            &req.Price,
+		ComparePrice:     req.ComparePrice,
+		CostPrice:        req.CostPrice,
+		SalePrice:        req.SalePrice,
+		SaleStartDate:    req.SaleStartDate,
+		SaleEndDate:      req.SaleEndDate,
+		Stock:            &req.Stock,
+		TrackQuantity:    &req.TrackQuantity,
+		AllowBackorder:   &req.AllowBackorder,
+		Weight:           req.Weight,
+		Dimensions:       req.Dimensions,
+		RequiresShipping: &req.RequiresShipping,
+		CategoryID:       &req.CategoryID,
+		BrandID:          req.BrandID,
+		Images:           req.Images,
+		Tags:             req.Tags,
+		Status:           &req.Status,
+	}
+	if req.Visibility != "" {
+		update.Visibility = &req.Visibility
+	}
+	if req.ShippingClass != "" {
+		update.ShippingClass = &req.ShippingClass
+	}
+	if req.TaxClass != "" {
+		update.TaxClass = &req.TaxClass
+	}
+	return update
+}
+
+// platformLookups resolves category and brand names to IDs once per import
+type platformLookups struct {
+	uc         *platformCSVUseCase
+	categories map[string]*uuid.UUID
+	brands     map[string]*uuid.UUID
+}
+
+// category finds a category by the slug of its name
+func (l *platformLookups) category(ctx context.Context, name string) *uuid.UUID {
+	slug := utils.GenerateSlug(name)
+	if slug == "" {
+		return nil
+	}
+	if id, ok := l.categories[slug]; ok {
+		return id
+	}
+	var id *uuid.UUID
+	if category, err := l.uc.categoryRepo.GetBySlug(ctx, slug); err == nil && category != nil {
+		id = &category.ID
+	}
+	l.categories[slug] = id
+	return id
+}
+
+// brand finds a brand by the slug of its name
+func (l *platformLookups) brand(ctx context.Context, name string) *uuid.UUID {
+	slug := utils.GenerateSlug(name)
+	if slug == "" {
+		return nil
+	}
+	if id, ok := l.brands[slug]; ok {
+		return id
+	}
+	var id *uuid.UUID
+	if brand, err := l.uc.brandRepo.GetBySlug(ctx, slug); err == nil && brand != nil {
+		id = &brand.ID
+	}
+	l.brands[slug] = id
+	return id
+}
+
+// parseShopifyProducts reads a Shopify product CSV. A product spans the lines sharing its Handle:
+// the first carries the product and its first variant, the others further variants and images.
+func parseShopifyProducts(data []byte) ([]*platformProduct, []string, error) {
+	reader, err := newPlatformCSVReader(data, "Handle")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		products []*platformProduct
+		byHandle = make(map[string]*platformProduct)
+		errs     []string
+	)
+	for {
+		record, line, err := reader.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		handle := record.get("Handle")
+		if handle == "" {
+			errs = append(errs, fmt.Sprintf("line %d: missing Handle", line))
+			continue
+		}
+		product := byHandle[handle]
+		if product == nil {
+			product = newPlatformProduct(line)
+			product.request.Slug = handle
+			byHandle[handle] = product
+			products = append(products, product)
+		}
+		req := &product.request
+
+		if title := record.get("Title"); title != "" && req.Name == "" {
+			req.Name = title
+			req.Description = record.get("Body (HTML)")
+			req.MetaTitle = record.get("SEO Title")
+			req.MetaDescription = record.get("SEO Description")
+			req.Tags = splitCSVList(record.get("Tags"), ",")
+			product.brand = record.get("Vendor")
+			product.category = record.get("Type")
+			if product.category == "" {
+				product.category = lastCategory(record.get("Product Category"))
+			}
+
+			switch status := strings.ToLower(record.get("Status")); status {
+			case "active", "draft", "archived":
+				req.Status = entities.ProductStatus(status)
+			case "":
+				if strings.EqualFold(record.get("Published"), "true") {
+					req.Status = entities.ProductStatusActive
+				}
+			default:
+				product.warn("line %d: unknown Status %q, imported as draft", line, status)
+			}
+			if strings.EqualFold(record.get("Gift Card"), "true") {
+				req.IsDigital = true
+				req.RequiresShipping = false
+			}
+		}
+
+		if sku, price := record.get("Variant SKU"), record.get("Variant Price"); sku != "" || price != "" {
+			if product.hasVariant {
+				product.warn("line %d: variant %s not imported; products are imported with their first variant", line, sku)
+			} else {
+				product.hasVariant = true
+				req.SKU = sku
+				req.Price = record.float(product, "Variant Price")
+				req.ComparePrice = record.optionalFloat(product, "Variant Compare At Price")
+				req.CostPrice = record.optionalFloat(product, "Cost per item")
+				req.Stock = int(record.float(product, "Variant Inventory Qty"))
+				req.TrackQuantity = record.get("Variant Inventory Tracker") != ""
+				req.AllowBackorder = strings.EqualFold(record.get("Variant Inventory Policy"), "continue")
+				if !req.IsDigital {
+					req.RequiresShipping = !strings.EqualFold(record.get("Variant Requires Shipping"), "false")
+				}
+				if grams := record.float(product, "Variant Grams"); grams > 0 {
+					weight := grams / 1000
+					req.Weight = &weight
+				}
+				if req.ComparePrice != nil && *req.ComparePrice <= req.Price {
+					req.ComparePrice = nil
+				}
+			}
+		}
+
+		if src := record.get("Image Src"); src != "" {
+			product.addImage(line, src, record.get("Image Alt Text"))
+		}
+	}
+	return products, errs, nil
+}
+
+// parseWooCommerceProducts reads a WooCommerce product CSV, one line per product. Variations
+// have a Parent and are not imported.
+func parseWooCommerceProducts(data []byte) ([]*platformProduct, []string, error) {
+	reader, err := newPlatformCSVReader(data, "SKU")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		products []*platformProduct
+		errs     []string
+	)
+	for {
+		record, line, err := reader.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		productType := strings.ToLower(record.get("Type"))
+		if strings.Contains(productType, "variation") {
+			errs = append(errs, fmt.Sprintf("line %d: variation %s not imported; products are imported without their variations", line, record.get("SKU")))
+			continue
+		}
+
+		product := newPlatformProduct(line)
+		req := &product.request
+		req.SKU = record.get("SKU")
+		req.Name = record.get("Name")
+		req.ShortDescription = record.get("Short description")
+		req.Description = record.get("Description")
+		req.Featured = record.get("Is featured?") == "1"
+		req.Price = record.float(product, "Regular price")
+		req.SalePrice = record.optionalFloat(product, "Sale price")
+		req.SaleStartDate = record.date(product, "Date sale price starts")
+		req.SaleEndDate = record.date(product, "Date sale price ends")
+		req.TaxClass = record.get("Tax class")
+		req.ShippingClass = record.get("Shipping class")
+		req.Tags = splitCSVList(record.get("Tags"), ",")
+		req.AllowBackorder = record.get("Backorders allowed?") == "1" || record.get("Backorders allowed?") == "notify"
+		req.LowStockThreshold = int(record.float(product, "Low stock amount"))
+		req.Weight = record.optionalFloat(product, "Weight (kg)")
+		if brands := splitCSVList(record.get("Brands"), ","); len(brands) > 0 {
+			product.brand = brands[0]
+		}
+
+		// Products without a Stock do not have their stock managed
+		if record.get("Stock") != "" {
+			req.Stock = int(record.float(product, "Stock"))
+			req.TrackQuantity = true
+		}
+
+		length, width, height := record.float(product, "Length (cm)"), record.float(product, "Width (cm)"), record.float(product, "Height (cm)")
+		if length > 0 && width > 0 && height > 0 {
+			req.Dimensions = &DimensionsRequest{Length: length, Width: width, Height: height}
+		}
+
+		switch record.get("Published") {
+		case "1":
+			req.Status = entities.ProductStatusActive
+		case "0":
+			req.Status = entities.ProductStatusActive
+			req.Visibility = entities.ProductVisibilityPrivate
+		}
+		if record.get("Visibility in catalog") == "hidden" {
+			req.Visibility = entities.ProductVisibilityHidden
+		}
+
+		switch productType {
+		case "", "simple":
+		case "grouped", "external":
+			req.ProductType = entities.ProductType(productType)
+		case "variable":
+			product.warn("line %d: variable product imported without its variations", line)
+		default:
+			product.warn("line %d: unknown Type %q, imported as a simple product", line, productType)
+		}
+
+		if categories := splitCSVList(record.get("Categories"), ","); len(categories) > 0 {
+			product.category = lastCategory(categories[0])
+			if len(categories) > 1 {
+				product.warn("line %d: only the first category, %s, is assigned", line, categories[0])
+			}
+		}
+		for _, src := range splitCSVList(record.get("Images"), ",") {
+			product.addImage(line, src, "")
+		}
+
+		products = append(products, product)
+	}
+	return products, errs, nil
+}
+
+func newPlatformProduct(line int) *platformProduct {
+	return &platformProduct{
+		result: &PlatformProductImportResult{
+			Line:     line,
+			Errors:   []string{},
+			Warnings: []string{},
+		},
+		request: CreateProductRequest{
+			Status:           entities.ProductStatusDraft,
+			RequiresShipping: true,
+		},
+	}
+}
+
+// addImage adds an image unless its URL is invalid or already added
+func (p *platformProduct) addImage(line int, src, altText string) {
+	if parsed, err := url.ParseRequestURI(src); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		p.warn("line %d: invalid image URL %q skipped", line, src)
+		return
+	}
+	for _, image := range p.request.Images {
+		if image.URL == src {
+			return
+		}
+	}
+	p.request.Images = append(p.request.Images, ProductImageRequest{
+		URL:      src,
+		AltText:  altText,
+		Position: len(p.request.Images),
+	})
+}
+
+// platformCSVReader reads a CSV by column name
+type platformCSVReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	line    int
+}
+
+// newPlatformCSVReader reads the header, which must have the required column
+func newPlatformCSVReader(data []byte, required string) (*platformCSVReader, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+	if _, ok := columns[strings.ToLower(required)]; !ok {
+		return nil, fmt.Errorf("CSV must have a %s column", required)
+	}
+	return &platformCSVReader{reader: reader, columns: columns, line: 1}, nil
+}
+
+// next returns the next record with its line number
+func (r *platformCSVReader) next() (*platformCSVRecord, int, error) {
+	values, err := r.reader.Read()
+	if err == io.EOF {
+		return nil, 0, err
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	line, _ := r.reader.FieldPos(0)
+	return &platformCSVRecord{columns: r.columns, values: values, line: line}, line, nil
+}
+
+// platformCSVRecord is a CSV line read by column name
+type platformCSVRecord struct {
+	columns map[string]int
+	values  []string
+	line    int
+}
+
+// get returns the trimmed value of a column, empty when the file does not have it
+func (r *platformCSVRecord) get(column string) string {
+	i, ok := r.columns[strings.ToLower(column)]
+	if !ok || i >= len(r.values) {
+		return ""
+	}
+	return strings.TrimSpace(r.values[i])
+}
+
+// float parses a number column, warning about values that are not numbers
+func (r *platformCSVRecord) float(product *platformProduct, column string) float64 {
+	value := r.optionalFloat(product, column)
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func (r *platformCSVRecord) optionalFloat(product *platformProduct, column string) *float64 {
+	raw := r.get(column)
+	if raw == "" {
+		return nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		product.warn("line %d: %s %q is not a number and was ignored", r.line, column, raw)
+		return nil
+	}
+	return &value
+}
+
+// date parses a YYYY-MM-DD date column, with or without a time
+func (r *platformCSVRecord) date(product *platformProduct, column string) *time.Time {
+	raw := r.get(column)
+	if raw == "" {
+		return nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if value, err := time.Parse(layout, raw); err == nil {
+			return &value
+		}
+	}
+	product.warn("line %d: %s %q is not a date and was ignored", r.line, column, raw)
+	return nil
+}
+
+// writePlatformCSV writes rows in the column order, leaving out values with no column
+func writePlatformCSV(columns []string, rows []map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(columns); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = row[column]
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func validateCSVPlatform(platform string) error {
+	if platform != CSVPlatformShopify && platform != CSVPlatformWooCommerce {
+		return pkgErrors.InvalidInput("platform must be shopify or woocommerce")
+	}
+	return nil
+}
+
+// lastCategory returns the most specific category of a path such as "Clothing > Shirts"
+func lastCategory(path string) string {
+	parts := strings.Split(path, ">")
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
+// splitCSVList splits a list column, dropping empty values
+func splitCSVList(value, separator string) []string {
+	var values []string
+	for _, part := range strings.Split(value, separator) {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// parseJSONList reads the JSON arrays orders keep coupon codes and tags in
+func parseJSONList(value string) []string {
+	var values []string
+	if value != "" {
+		_ = json.Unmarshal([]byte(value), &values)
+	}
+	return values
+}
+
+func joinProductTags(tags []entities.ProductTag) string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func csvBool(value bool, yes, no string) string {
+	if value {
+		return yes
+	}
+	return no
+}
+
+func csvDecimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+func csvOptionalDecimal(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return csvDecimal(*value)
+}
+
+// csvAmount formats an order amount with its currency's number of decimals
+func csvAmount(amount float64, currency string) string {
+	return money.Format(money.ToMinor(amount, currency), currency)
+}
+
+func csvTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format("2006-01-02 15:04:05 -0700")
+}
+
+func csvDate(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format("2006-01-02")
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/pkg/money"
+)
+
+// PriceDisplaySettings configures how order amounts are written in emails and invoices
+type PriceDisplaySettings struct {
+	// TaxDisplay decides by ship-to country whether prices are shown with tax included
+	TaxDisplay entities.TaxDisplayRule
+	// DefaultLocale formats the amounts of customers without a language
+	DefaultLocale string
+}
+
+// orderPriceDisplay writes an order's amounts the way its customer shops: in the format of their
+// language and, when their ship-to country sees prices with tax included, with item prices gross
+type orderPriceDisplay struct {
+	format       money.CurrencyFormat
+	taxInclusive bool
+	taxRate      float64 // Tax charged as a fraction of the discounted subtotal
+}
+
+func newOrderPriceDisplay(order *entities.Order, settings PriceDisplaySettings) orderPriceDisplay {
+	locale := order.User.Language
+	if locale == "" {
+		locale = settings.DefaultLocale
+	}
+	country := ""
+	if order.ShippingAddress != nil {
+		country = order.ShippingAddress.Country
+	}
+
+	display := orderPriceDisplay{
+		format:       money.FormatFor(order.Currency, locale),
+		taxInclusive: settings.TaxDisplay.IsInclusive(country),
+	}
+	if taxable := order.Subtotal - order.DiscountAmount; taxable > 0 {
+		display.taxRate = order.TaxAmount / taxable
+	}
+	return display
+}
+
+// price returns a net amount as it is shown, with its share of the tax added when tax is
+// included. Gross subtotal less gross discount is then the net amounts plus the tax.
+func (d orderPriceDisplay) price(amount float64) float64 {
+	if d.taxInclusive {
+		return amount * (1 + d.taxRate)
+	}
+	return amount
+}
+
+// taxLabel names the tax line, which is part of the subtotal when tax is included
+func (d orderPriceDisplay) taxLabel() string {
+	if d.taxInclusive {
+		return "Includes tax"
+	}
+	return "Tax"
+}
+
+// addAmounts adds an order's amounts, as the customer is shown them, to the data of an email or
+// notification. The total is the same either way; only how the subtotal and tax read changes.
+func (d orderPriceDisplay) addAmounts(data map[string]interface{}, order *entities.Order) {
+	subtotal := money.FromMinor(money.ToMinor(d.price(order.Subtotal), order.Currency), order.Currency)
+	data["currency"] = order.Currency
+	data["subtotal"] = subtotal
+	data["tax_amount"] = order.TaxAmount
+	data["shipping_amount"] = order.ShippingAmount
+	data["prices_include_tax"] = d.taxInclusive
+	data["tax_label"] = d.taxLabel()
+	data["formatted_subtotal"] = d.format.Format(subtotal)
+	if order.DiscountAmount > 0 {
+		data["formatted_discount"] = d.format.Format(-d.price(order.DiscountAmount))
+	}
+	data["formatted_tax"] = d.format.Format(order.TaxAmount)
+	data["formatted_shipping"] = d.format.Format(order.ShippingAmount)
+	data["formatted_total"] = d.format.Format(order.Total)
+}
@@ -0,0 +1,899 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// PricingUseCase defines the interface for price scheduling and price history
+type PricingUseCase interface {
+	// Scheduled price changes
+	SchedulePriceChange(ctx context.Context, productID uuid.UUID, req SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error)
+	CancelScheduledPriceChange(ctx context.Context, id uuid.UUID, cancelledBy *uuid.UUID) error
+	GetProductScheduledPriceChanges(ctx context.Context, productID uuid.UUID) ([]*ScheduledPriceChangeResponse, error)
+	ListScheduledPriceChanges(ctx context.Context, req ListScheduledPriceChangesRequest) (*ScheduledPriceChangesListResponse, error)
+	ApplyDuePriceChanges(ctx context.Context) (int, error)
+
+	// Sale windows
+	RevertExpiredSales(ctx context.Context) (int, error)
+
+	// Price history
+	RecordPriceChange(ctx context.Context, productID uuid.UUID, before, after entities.PriceSnapshot, changedBy *uuid.UUID, source entities.PriceChangeSource, reason string) error
+	GetPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) (*PriceHistoryListResponse, error)
+	GetPriceClaim(ctx context.Context, productID uuid.UUID) (*PriceClaimResponse, error)
+
+	// Bulk price updates
+	PreviewBulkPriceUpdate(ctx context.Context, req BulkPriceUpdateRequest) (*BulkPriceUpdatePreviewResponse, error)
+	ApplyBulkPriceUpdate(ctx context.Context, req BulkPriceUpdateRequest) (*BulkPriceUpdateResponse, error)
+	RollbackBulkPriceUpdate(ctx context.Context, id uuid.UUID, rolledBackBy *uuid.UUID) (*BulkPriceUpdateResponse, error)
+	GetBulkPriceUpdate(ctx context.Context, id uuid.UUID) (*BulkPriceUpdateResponse, error)
+	ListBulkPriceUpdates(ctx context.Context, page, limit int) (*BulkPriceUpdatesListResponse, error)
+}
+
+type pricingUseCase struct {
+	productRepo         repositories.ProductRepository
+	categoryRepo        repositories.CategoryRepository
+	priceHistoryRepo    repositories.PriceHistoryRepository
+	scheduledChangeRepo repositories.ScheduledPriceChangeRepository
+	bulkUpdateRepo      repositories.BulkPriceUpdateRepository
+}
+
+// NewPricingUseCase creates a new pricing use case
+func NewPricingUseCase(
+	productRepo repositories.ProductRepository,
+	categoryRepo repositories.CategoryRepository,
+	priceHistoryRepo repositories.PriceHistoryRepository,
+	scheduledChangeRepo repositories.ScheduledPriceChangeRepository,
+	bulkUpdateRepo repositories.BulkPriceUpdateRepository,
+) PricingUseCase {
+	return &pricingUseCase{
+		productRepo:         productRepo,
+		categoryRepo:        categoryRepo,
+		priceHistoryRepo:    priceHistoryRepo,
+		scheduledChangeRepo: scheduledChangeRepo,
+		bulkUpdateRepo:      bulkUpdateRepo,
+	}
+}
+
+// SchedulePriceChangeRequest represents a request to schedule a future price change
+type SchedulePriceChangeRequest struct {
+	NewPrice      *float64   `json:"new_price" validate:"omitempty,gt=0"`
+	NewSalePrice  *float64   `json:"new_sale_price" validate:"omitempty,gt=0"`
+	SaleStartDate *time.Time `json:"sale_start_date"`
+	SaleEndDate   *time.Time `json:"sale_end_date"`
+	ClearSale     bool       `json:"clear_sale"`
+	EffectiveAt   time.Time  `json:"effective_at" validate:"required"`
+	Reason        string     `json:"reason" validate:"required"`
+	CreatedBy     *uuid.UUID `json:"-"`
+}
+
+// ListScheduledPriceChangesRequest represents a request to list scheduled price changes
+type ListScheduledPriceChangesRequest struct {
+	ProductID *uuid.UUID                           `json:"product_id"`
+	Status    *entities.ScheduledPriceChangeStatus `json:"status"`
+	Page      int                                  `json:"page"`
+	Limit     int                                  `json:"limit"`
+}
+
+// ScheduledPriceChangeResponse represents a scheduled price change
+type ScheduledPriceChangeResponse struct {
+	ID            uuid.UUID                           `json:"id"`
+	ProductID     uuid.UUID                           `json:"product_id"`
+	ProductName   string                              `json:"product_name,omitempty"`
+	NewPrice      *float64                            `json:"new_price"`
+	NewSalePrice  *float64                            `json:"new_sale_price"`
+	SaleStartDate *time.Time                          `json:"sale_start_date"`
+	SaleEndDate   *time.Time                          `json:"sale_end_date"`
+	ClearSale     bool                                `json:"clear_sale"`
+	EffectiveAt   time.Time                           `json:"effective_at"`
+	Status        entities.ScheduledPriceChangeStatus `json:"status"`
+	Reason        string                              `json:"reason"`
+	CreatedBy     *uuid.UUID                          `json:"created_by"`
+	AppliedAt     *time.Time                          `json:"applied_at"`
+	CancelledAt   *time.Time                          `json:"cancelled_at"`
+	ErrorMessage  string                              `json:"error_message,omitempty"`
+	CreatedAt     time.Time                           `json:"created_at"`
+}
+
+// ScheduledPriceChangesListResponse represents a paginated list of scheduled price changes
+type ScheduledPriceChangesListResponse struct {
+	Changes    []*ScheduledPriceChangeResponse `json:"changes"`
+	Pagination *PaginationInfo                 `json:"pagination"`
+}
+
+// PriceHistoryResponse represents a price history entry
+type PriceHistoryResponse struct {
+	ID                uuid.UUID                  `json:"id"`
+	ProductID         uuid.UUID                  `json:"product_id"`
+	ChangedBy         *uuid.UUID                 `json:"changed_by"`
+	ChangedByEmail    string                     `json:"changed_by_email,omitempty"`
+	OldPrice          float64                    `json:"old_price"`
+	NewPrice          float64                    `json:"new_price"`
+	OldSalePrice      *float64                   `json:"old_sale_price"`
+	NewSalePrice      *float64                   `json:"new_sale_price"`
+	NewSaleStartDate  *time.Time                 `json:"new_sale_start_date"`
+	NewSaleEndDate    *time.Time                 `json:"new_sale_end_date"`
+	OldEffectivePrice float64                    `json:"old_effective_price"`
+	NewEffectivePrice float64                    `json:"new_effective_price"`
+	Source            entities.PriceChangeSource `json:"source"`
+	Reason            string                     `json:"reason"`
+	CreatedAt         time.Time                  `json:"created_at"`
+}
+
+// PriceHistoryListResponse represents a paginated price history
+type PriceHistoryListResponse struct {
+	History    []*PriceHistoryResponse `json:"history"`
+	Pagination *PaginationInfo         `json:"pagination"`
+}
+
+// PricePoint represents the effective price at a point in time
+type PricePoint struct {
+	Price     float64   `json:"price"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// PriceClaimResponse represents the data needed for a "was/now" price claim
+type PriceClaimResponse struct {
+	ProductID    uuid.UUID `json:"product_id"`
+	CurrentPrice float64   `json:"current_price"`
+	RegularPrice float64   `json:"regular_price"`
+	// PriorPrice is the lowest price applied in the reference window before now.
+	// Storefronts must use this as the "was" price when advertising a reduction.
+	PriorPrice       float64      `json:"prior_price"`
+	IsReduced        bool         `json:"is_reduced"`
+	ReductionPercent float64      `json:"reduction_percent"`
+	WindowDays       int          `json:"window_days"`
+	PricePoints      []PricePoint `json:"price_points"`
+}
+
+// BulkPriceUpdateRequest represents a rule-based price change for many products.
+// The same request is used for the dry-run preview and for applying the change.
+type BulkPriceUpdateRequest struct {
+	Name            string                       `json:"name"`
+	Reason          string                       `json:"reason"`
+	CategoryID      *uuid.UUID                   `json:"category_id"`
+	BrandID         *uuid.UUID                   `json:"brand_id"`
+	ProductIDs      []uuid.UUID                  `json:"product_ids"`
+	MinPrice        *float64                     `json:"min_price" validate:"omitempty,gte=0"`
+	MaxPrice        *float64                     `json:"max_price" validate:"omitempty,gte=0"`
+	Adjustment      entities.BulkPriceAdjustment `json:"adjustment" validate:"required,oneof=percent fixed"`
+	Value           float64                      `json:"value"`
+	RoundTo         *float64                     `json:"round_to" validate:"omitempty,gte=0,lt=1"`
+	AdjustSalePrice bool                         `json:"adjust_sale_price"`
+	AppliedBy       *uuid.UUID                   `json:"-"`
+}
+
+// BulkPriceChangeItem represents the old and new pricing of one product in a bulk update
+type BulkPriceChangeItem struct {
+	ProductID     uuid.UUID `json:"product_id"`
+	ProductName   string    `json:"product_name"`
+	SKU           string    `json:"sku"`
+	OldPrice      float64   `json:"old_price"`
+	NewPrice      float64   `json:"new_price"`
+	OldSalePrice  *float64  `json:"old_sale_price"`
+	NewSalePrice  *float64  `json:"new_sale_price"`
+	ChangePercent float64   `json:"change_percent"`
+	Error         string    `json:"error,omitempty"` // Why the product is skipped
+}
+
+// BulkPriceUpdatePreviewResponse lists the price changes a bulk update would make
+type BulkPriceUpdatePreviewResponse struct {
+	Rule           entities.BulkPriceRule `json:"rule"`
+	MatchedCount   int                    `json:"matched_count"`
+	ChangedCount   int                    `json:"changed_count"`
+	UnchangedCount int                    `json:"unchanged_count"`
+	SkippedCount   int                    `json:"skipped_count"`
+	Changes        []*BulkPriceChangeItem `json:"changes"`
+	Skipped        []*BulkPriceChangeItem `json:"skipped"`
+}
+
+// BulkPriceUpdateResponse represents an applied bulk price update
+type BulkPriceUpdateResponse struct {
+	ID           uuid.UUID                      `json:"id"`
+	Name         string                         `json:"name"`
+	Reason       string                         `json:"reason"`
+	Rule         entities.BulkPriceRule         `json:"rule"`
+	Status       entities.BulkPriceUpdateStatus `json:"status"`
+	ProductCount int                            `json:"product_count"`
+	AppliedBy    *uuid.UUID                     `json:"applied_by"`
+	RolledBackBy *uuid.UUID                     `json:"rolled_back_by"`
+	RolledBackAt *time.Time                     `json:"rolled_back_at"`
+	CreatedAt    time.Time                      `json:"created_at"`
+	Changes      []*BulkPriceChangeItem         `json:"changes,omitempty"`
+	// Skipped lists products left unchanged: invalid new prices on apply, later price edits on rollback
+	Skipped []*BulkPriceChangeItem `json:"skipped,omitempty"`
+}
+
+// BulkPriceUpdatesListResponse represents a paginated list of bulk price updates
+type BulkPriceUpdatesListResponse struct {
+	Updates    []*BulkPriceUpdateResponse `json:"updates"`
+	Pagination *PaginationInfo            `json:"pagination"`
+}
+
+// SchedulePriceChange schedules a future price change for a product
+func (uc *pricingUseCase) SchedulePriceChange(ctx context.Context, productID uuid.UUID, req SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	if !req.EffectiveAt.After(time.Now()) {
+		return nil, pkgErrors.InvalidInput("Effective date must be in the future")
+	}
+
+	change := &entities.ScheduledPriceChange{
+		ID:            ids.New(),
+		ProductID:     productID,
+		NewPrice:      req.NewPrice,
+		NewSalePrice:  req.NewSalePrice,
+		SaleStartDate: req.SaleStartDate,
+		SaleEndDate:   req.SaleEndDate,
+		ClearSale:     req.ClearSale,
+		EffectiveAt:   req.EffectiveAt,
+		Status:        entities.ScheduledPriceChangeStatusPending,
+		Reason:        req.Reason,
+		CreatedBy:     req.CreatedBy,
+	}
+
+	if err := change.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	// Validate the resulting pricing against the current product so that
+	// obviously invalid schedules are rejected up front
+	preview := *product
+	if err := change.ApplyTo(&preview); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.scheduledChangeRepo.Create(ctx, change); err != nil {
+		return nil, fmt.Errorf("failed to schedule price change: %w", err)
+	}
+
+	change.Product = product
+	return uc.toScheduledPriceChangeResponse(change), nil
+}
+
+// CancelScheduledPriceChange cancels a pending scheduled price change
+func (uc *pricingUseCase) CancelScheduledPriceChange(ctx context.Context, id uuid.UUID, cancelledBy *uuid.UUID) error {
+	change, err := uc.scheduledChangeRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !change.IsPending() {
+		return pkgErrors.New(pkgErrors.ErrCodeConflict, "Only pending price changes can be cancelled")
+	}
+
+	now := time.Now()
+	change.Status = entities.ScheduledPriceChangeStatusCancelled
+	change.CancelledAt = &now
+	change.CancelledBy = cancelledBy
+
+	return uc.scheduledChangeRepo.Update(ctx, change)
+}
+
+// GetProductScheduledPriceChanges gets all scheduled price changes for a product
+func (uc *pricingUseCase) GetProductScheduledPriceChanges(ctx context.Context, productID uuid.UUID) ([]*ScheduledPriceChangeResponse, error) {
+	changes, err := uc.scheduledChangeRepo.GetByProductID(ctx, productID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled price changes: %w", err)
+	}
+
+	responses := make([]*ScheduledPriceChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = uc.toScheduledPriceChangeResponse(change)
+	}
+	return responses, nil
+}
+
+// ListScheduledPriceChanges lists scheduled price changes across products
+func (uc *pricingUseCase) ListScheduledPriceChanges(ctx context.Context, req ListScheduledPriceChangesRequest) (*ScheduledPriceChangesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.ScheduledPriceChangeFilters{
+		ProductID: req.ProductID,
+		Status:    req.Status,
+		Limit:     limit,
+		Offset:    (page - 1) * limit,
+	}
+
+	changes, err := uc.scheduledChangeRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled price changes: %w", err)
+	}
+
+	total, err := uc.scheduledChangeRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count scheduled price changes: %w", err)
+	}
+
+	responses := make([]*ScheduledPriceChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = uc.toScheduledPriceChangeResponse(change)
+	}
+
+	return &ScheduledPriceChangesListResponse{
+		Changes:    responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// ApplyDuePriceChanges applies all pending price changes whose effective date has passed.
+// It returns the number of changes applied successfully.
+func (uc *pricingUseCase) ApplyDuePriceChanges(ctx context.Context) (int, error) {
+	due, err := uc.scheduledChangeRepo.GetDue(ctx, time.Now(), 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due price changes: %w", err)
+	}
+
+	applied := 0
+	for _, change := range due {
+		if err := uc.applyScheduledChange(ctx, change); err != nil {
+			fmt.Printf("❌ Failed to apply scheduled price change %s: %v\n", change.ID, err)
+			change.Status = entities.ScheduledPriceChangeStatusFailed
+			change.AppliedAt = nil
+			change.ErrorMessage = err.Error()
+			_ = uc.scheduledChangeRepo.Update(ctx, change)
+			continue
+		}
+		applied++
+	}
+
+	if applied > 0 {
+		fmt.Printf("✅ Applied %d scheduled price changes\n", applied)
+	}
+	return applied, nil
+}
+
+// applyScheduledChange applies a single scheduled change and logs it in price history
+func (uc *pricingUseCase) applyScheduledChange(ctx context.Context, change *entities.ScheduledPriceChange) error {
+	product, err := uc.productRepo.GetByID(ctx, change.ProductID)
+	if err != nil {
+		return entities.ErrProductNotFound
+	}
+
+	before := entities.NewPriceSnapshot(product)
+	if err := change.ApplyTo(product); err != nil {
+		return err
+	}
+	after := entities.NewPriceSnapshot(product)
+
+	now := time.Now()
+	product.UpdatedAt = now
+	change.Status = entities.ScheduledPriceChangeStatusApplied
+	change.AppliedAt = &now
+	change.ErrorMessage = ""
+
+	var entry *entities.PriceHistory
+	if !before.Equal(after) {
+		entry = entities.NewPriceHistory(product.ID, before, after, change.CreatedBy, entities.PriceChangeSourceScheduled, change.Reason)
+		entry.ScheduledChangeID = &change.ID
+	}
+
+	// The product's price, the change and its history entry are saved together, so a failed run
+	// neither leaves a price without history nor applies the change twice
+	if err := uc.scheduledChangeRepo.Apply(ctx, change, product, entry); err != nil {
+		return fmt.Errorf("failed to apply price change: %w", err)
+	}
+	return nil
+}
+
+// RevertExpiredSales clears sale pricing from products whose sale window has ended
+// and logs the reversion in price history. It returns the number of products reverted.
+func (uc *pricingUseCase) RevertExpiredSales(ctx context.Context) (int, error) {
+	now := time.Now()
+	products, err := uc.productRepo.GetWithExpiredSales(ctx, now, 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get products with expired sales: %w", err)
+	}
+
+	reverted := 0
+	for _, product := range products {
+		if !product.HasSaleEnded(now) {
+			continue
+		}
+
+		before := entities.NewPriceSnapshot(product)
+		product.ClearSale()
+		product.UpdatedAt = now
+		entry := entities.NewPriceHistory(product.ID, before, entities.NewPriceSnapshot(product), nil, entities.PriceChangeSourceSystem, "Sale window ended")
+
+		if err := uc.productRepo.RevertExpiredSale(ctx, product, entry); err != nil {
+			if err == entities.ErrConflict {
+				// The sale was changed or cleared since it was read
+				continue
+			}
+			fmt.Printf("❌ Failed to revert expired sale for product %s: %v\n", product.ID, err)
+			continue
+		}
+		reverted++
+	}
+
+	if reverted > 0 {
+		fmt.Printf("✅ Reverted %d expired sales\n", reverted)
+	}
+	return reverted, nil
+}
+
+// RecordPriceChange logs a price change if the pricing actually changed
+func (uc *pricingUseCase) RecordPriceChange(ctx context.Context, productID uuid.UUID, before, after entities.PriceSnapshot, changedBy *uuid.UUID, source entities.PriceChangeSource, reason string) error {
+	if before.Equal(after) {
+		return nil
+	}
+	entry := entities.NewPriceHistory(productID, before, after, changedBy, source, reason)
+	return uc.priceHistoryRepo.Create(ctx, entry)
+}
+
+// GetPriceHistory gets the full price change log for a product
+func (uc *pricingUseCase) GetPriceHistory(ctx context.Context, productID uuid.UUID, page, limit int) (*PriceHistoryListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.priceHistoryRepo.GetByProductID(ctx, productID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+
+	total, err := uc.priceHistoryRepo.CountByProductID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count price history: %w", err)
+	}
+
+	responses := make([]*PriceHistoryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = toPriceHistoryResponse(entry)
+	}
+
+	return &PriceHistoryListResponse{
+		History:    responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// GetPriceClaim computes the "was/now" price data for a product using the
+// lowest price applied during the reference window
+func (uc *pricingUseCase) GetPriceClaim(ctx context.Context, productID uuid.UUID) (*PriceClaimResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+	if !product.IsVisible() {
+		return nil, entities.ErrProductNotFound
+	}
+
+	now := time.Now()
+	windowStart := now.Add(-entities.PriceReferenceWindow)
+	currentPrice := product.GetCurrentPrice()
+
+	entries, err := uc.priceHistoryRepo.GetByProductIDSince(ctx, productID, windowStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+
+	// Price in force at the start of the window
+	priceAtStart := currentPrice
+	if previous, err := uc.priceHistoryRepo.GetLatestBefore(ctx, productID, windowStart); err == nil {
+		priceAtStart = previous.NewSnapshot().EffectivePriceAt(windowStart)
+	} else if len(entries) > 0 {
+		priceAtStart = entries[0].OldEffectivePrice
+	}
+
+	// The current price is excluded from the reference so a reduction is
+	// compared against prices that were applied before it
+	var prior []*entities.PriceHistory
+	if len(entries) > 0 {
+		prior = entries[:len(entries)-1]
+	}
+	priorPrice := entities.LowestPriceInWindow(prior, priceAtStart)
+
+	points := make([]PricePoint, 0, len(entries)+1)
+	points = append(points, PricePoint{Price: priceAtStart, ChangedAt: windowStart})
+	for _, entry := range entries {
+		points = append(points, PricePoint{Price: entry.NewEffectivePrice, ChangedAt: entry.CreatedAt})
+	}
+
+	response := &PriceClaimResponse{
+		ProductID:    productID,
+		CurrentPrice: currentPrice,
+		RegularPrice: product.Price,
+		PriorPrice:   priorPrice,
+		WindowDays:   int(entities.PriceReferenceWindow.Hours() / 24),
+		PricePoints:  points,
+	}
+	if currentPrice < priorPrice {
+		response.IsReduced = true
+		response.ReductionPercent = ((priorPrice - currentPrice) / priorPrice) * 100
+	}
+
+	return response, nil
+}
+
+// bulkPricePlan holds the computed changes of a bulk price rule
+type bulkPricePlan struct {
+	rule      entities.BulkPriceRule
+	matched   int
+	unchanged int
+	products  []*entities.Product
+	entries   []*entities.PriceHistory
+	changes   []*BulkPriceChangeItem
+	skipped   []*BulkPriceChangeItem
+}
+
+// PreviewBulkPriceUpdate computes a bulk price update without saving anything
+func (uc *pricingUseCase) PreviewBulkPriceUpdate(ctx context.Context, req BulkPriceUpdateRequest) (*BulkPriceUpdatePreviewResponse, error) {
+	plan, err := uc.planBulkPriceUpdate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BulkPriceUpdatePreviewResponse{
+		Rule:           plan.rule,
+		MatchedCount:   plan.matched,
+		ChangedCount:   len(plan.changes),
+		UnchangedCount: plan.unchanged,
+		SkippedCount:   len(plan.skipped),
+		Changes:        plan.changes,
+		Skipped:        plan.skipped,
+	}, nil
+}
+
+// ApplyBulkPriceUpdate applies a bulk price update in a single transaction. Products whose new
+// price would be invalid are skipped and reported; every other change is logged in price history.
+func (uc *pricingUseCase) ApplyBulkPriceUpdate(ctx context.Context, req BulkPriceUpdateRequest) (*BulkPriceUpdateResponse, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, pkgErrors.InvalidInput("name is required")
+	}
+
+	plan, err := uc.planBulkPriceUpdate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.changes) == 0 {
+		return nil, pkgErrors.InvalidInput("rule does not change the price of any product")
+	}
+
+	update := &entities.BulkPriceUpdate{
+		ID:           ids.New(),
+		Name:         strings.TrimSpace(req.Name),
+		Reason:       req.Reason,
+		Rule:         plan.rule,
+		Status:       entities.BulkPriceUpdateStatusApplied,
+		ProductCount: len(plan.changes),
+		AppliedBy:    req.AppliedBy,
+	}
+	for _, entry := range plan.entries {
+		entry.Reason = bulkPriceUpdateReason(update)
+	}
+
+	if err := uc.bulkUpdateRepo.Apply(ctx, update, plan.products, plan.entries); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Product prices changed while applying the bulk update, preview it again")
+		}
+		return nil, fmt.Errorf("failed to apply bulk price update: %w", err)
+	}
+
+	response := toBulkPriceUpdateResponse(update)
+	response.Changes = plan.changes
+	response.Skipped = plan.skipped
+	return response, nil
+}
+
+// RollbackBulkPriceUpdate restores the prices a bulk update replaced, using its price history
+// entries. Products repriced again since the bulk update are left alone and reported as skipped.
+func (uc *pricingUseCase) RollbackBulkPriceUpdate(ctx context.Context, id uuid.UUID, rolledBackBy *uuid.UUID) (*BulkPriceUpdateResponse, error) {
+	update, err := uc.bulkUpdateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !update.CanBeRolledBack() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Bulk price update has already been rolled back")
+	}
+
+	entries, products, err := uc.getBulkPriceUpdateEntries(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored []*entities.Product
+	var reversals []*entities.PriceHistory
+	changes := make([]*BulkPriceChangeItem, 0, len(entries))
+	var skipped []*BulkPriceChangeItem
+	for _, entry := range entries {
+		product, exists := products[entry.ProductID]
+		if !exists {
+			skipped = append(skipped, &BulkPriceChangeItem{
+				ProductID: entry.ProductID,
+				OldPrice:  entry.NewPrice,
+				NewPrice:  entry.OldPrice,
+				Error:     "product no longer exists",
+			})
+			continue
+		}
+
+		before := entities.NewPriceSnapshot(product)
+		item := newBulkPriceChangeItem(product, before.Price, entry.OldPrice, before.SalePrice, entry.OldSalePrice)
+		if !before.Equal(entry.NewSnapshot()) {
+			item.Error = "price changed since the bulk update"
+			skipped = append(skipped, item)
+			continue
+		}
+
+		product.Price = entry.OldPrice
+		product.SalePrice = entry.OldSalePrice
+		after := entities.NewPriceSnapshot(product)
+
+		reversal := entities.NewPriceHistory(product.ID, before, after, rolledBackBy, entities.PriceChangeSourceBulk,
+			fmt.Sprintf("Rollback of %s", bulkPriceUpdateReason(update)))
+		restored = append(restored, product)
+		reversals = append(reversals, reversal)
+		changes = append(changes, item)
+	}
+
+	now := time.Now()
+	update.Status = entities.BulkPriceUpdateStatusRolledBack
+	update.RolledBackBy = rolledBackBy
+	update.RolledBackAt = &now
+
+	if err := uc.bulkUpdateRepo.Rollback(ctx, update, restored, reversals); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Product prices changed while rolling back the bulk update, try again")
+		}
+		return nil, fmt.Errorf("failed to roll back bulk price update: %w", err)
+	}
+
+	response := toBulkPriceUpdateResponse(update)
+	response.Changes = changes
+	response.Skipped = skipped
+	return response, nil
+}
+
+// GetBulkPriceUpdate gets a bulk price update with the price changes it made
+func (uc *pricingUseCase) GetBulkPriceUpdate(ctx context.Context, id uuid.UUID) (*BulkPriceUpdateResponse, error) {
+	update, err := uc.bulkUpdateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, products, err := uc.getBulkPriceUpdateEntries(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toBulkPriceUpdateResponse(update)
+	response.Changes = make([]*BulkPriceChangeItem, len(entries))
+	for i, entry := range entries {
+		item := newBulkPriceChangeItem(&entities.Product{ID: entry.ProductID}, entry.OldPrice, entry.NewPrice, entry.OldSalePrice, entry.NewSalePrice)
+		if product, exists := products[entry.ProductID]; exists {
+			item.ProductName = product.Name
+			item.SKU = product.SKU
+		}
+		response.Changes[i] = item
+	}
+	return response, nil
+}
+
+// ListBulkPriceUpdates lists bulk price updates, newest first
+func (uc *pricingUseCase) ListBulkPriceUpdates(ctx context.Context, page, limit int) (*BulkPriceUpdatesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := uc.bulkUpdateRepo.List(ctx, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bulk price updates: %w", err)
+	}
+
+	total, err := uc.bulkUpdateRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bulk price updates: %w", err)
+	}
+
+	responses := make([]*BulkPriceUpdateResponse, len(updates))
+	for i, update := range updates {
+		responses[i] = toBulkPriceUpdateResponse(update)
+	}
+
+	return &BulkPriceUpdatesListResponse{
+		Updates:    responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// planBulkPriceUpdate selects the products matched by the rule and computes their new prices
+func (uc *pricingUseCase) planBulkPriceUpdate(ctx context.Context, req BulkPriceUpdateRequest) (*bulkPricePlan, error) {
+	rule := entities.BulkPriceRule{
+		CategoryID:      req.CategoryID,
+		BrandID:         req.BrandID,
+		ProductIDs:      req.ProductIDs,
+		MinPrice:        req.MinPrice,
+		MaxPrice:        req.MaxPrice,
+		Adjustment:      req.Adjustment,
+		Value:           req.Value,
+		RoundTo:         req.RoundTo,
+		AdjustSalePrice: req.AdjustSalePrice,
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	filter := repositories.BulkPriceProductFilter{
+		BrandID:    rule.BrandID,
+		ProductIDs: rule.ProductIDs,
+		MinPrice:   rule.MinPrice,
+		MaxPrice:   rule.MaxPrice,
+		Limit:      entities.MaxBulkPriceUpdateProducts + 1,
+	}
+	if rule.CategoryID != nil {
+		categoryIDs, err := uc.categoryRepo.GetCategoryTree(ctx, *rule.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get category tree: %w", err)
+		}
+		if len(categoryIDs) == 0 {
+			return nil, entities.ErrCategoryNotFound
+		}
+		filter.CategoryIDs = categoryIDs
+	}
+
+	products, err := uc.bulkUpdateRepo.GetMatchingProducts(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching products: %w", err)
+	}
+	if len(products) > entities.MaxBulkPriceUpdateProducts {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("rule matches more than %d products, narrow it down", entities.MaxBulkPriceUpdateProducts))
+	}
+
+	plan := &bulkPricePlan{
+		rule:    rule,
+		matched: len(products),
+		changes: make([]*BulkPriceChangeItem, 0, len(products)),
+		skipped: make([]*BulkPriceChangeItem, 0),
+	}
+	for _, product := range products {
+		before := entities.NewPriceSnapshot(product)
+		applyErr := rule.ApplyTo(product)
+		after := entities.NewPriceSnapshot(product)
+
+		item := newBulkPriceChangeItem(product, before.Price, after.Price, before.SalePrice, after.SalePrice)
+		if applyErr != nil {
+			item.Error = applyErr.Error()
+			plan.skipped = append(plan.skipped, item)
+			continue
+		}
+		if before.Equal(after) {
+			plan.unchanged++
+			continue
+		}
+
+		plan.products = append(plan.products, product)
+		plan.entries = append(plan.entries, entities.NewPriceHistory(product.ID, before, after, req.AppliedBy, entities.PriceChangeSourceBulk, ""))
+		plan.changes = append(plan.changes, item)
+	}
+
+	return plan, nil
+}
+
+// getBulkPriceUpdateEntries gets the history entries of a bulk update and the products they changed
+func (uc *pricingUseCase) getBulkPriceUpdateEntries(ctx context.Context, id uuid.UUID) ([]*entities.PriceHistory, map[uuid.UUID]*entities.Product, error) {
+	entries, err := uc.priceHistoryRepo.GetByBulkUpdateID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bulk price update history: %w", err)
+	}
+
+	productIDs := make([]uuid.UUID, len(entries))
+	for i, entry := range entries {
+		productIDs[i] = entry.ProductID
+	}
+	products, err := uc.productRepo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	productMap := make(map[uuid.UUID]*entities.Product, len(products))
+	for _, product := range products {
+		productMap[product.ID] = product
+	}
+	return entries, productMap, nil
+}
+
+func bulkPriceUpdateReason(update *entities.BulkPriceUpdate) string {
+	reason := fmt.Sprintf("bulk price update %q", update.Name)
+	if update.Reason != "" {
+		reason += ": " + update.Reason
+	}
+	return reason
+}
+
+func newBulkPriceChangeItem(product *entities.Product, oldPrice, newPrice float64, oldSalePrice, newSalePrice *float64) *BulkPriceChangeItem {
+	item := &BulkPriceChangeItem{
+		ProductID:    product.ID,
+		ProductName:  product.Name,
+		SKU:          product.SKU,
+		OldPrice:     oldPrice,
+		NewPrice:     newPrice,
+		OldSalePrice: oldSalePrice,
+		NewSalePrice: newSalePrice,
+	}
+	if oldPrice > 0 {
+		item.ChangePercent = math.Round((newPrice-oldPrice)/oldPrice*10000) / 100
+	}
+	return item
+}
+
+func toBulkPriceUpdateResponse(update *entities.BulkPriceUpdate) *BulkPriceUpdateResponse {
+	return &BulkPriceUpdateResponse{
+		ID:           update.ID,
+		Name:         update.Name,
+		Reason:       update.Reason,
+		Rule:         update.Rule,
+		Status:       update.Status,
+		ProductCount: update.ProductCount,
+		AppliedBy:    update.AppliedBy,
+		RolledBackBy: update.RolledBackBy,
+		RolledBackAt: update.RolledBackAt,
+		CreatedAt:    update.CreatedAt,
+	}
+}
+
+func (uc *pricingUseCase) toScheduledPriceChangeResponse(change *entities.ScheduledPriceChange) *ScheduledPriceChangeResponse {
+	response := &ScheduledPriceChangeResponse{
+		ID:            change.ID,
+		ProductID:     change.ProductID,
+		NewPrice:      change.NewPrice,
+		NewSalePrice:  change.NewSalePrice,
+		SaleStartDate: change.SaleStartDate,
+		SaleEndDate:   change.SaleEndDate,
+		ClearSale:     change.ClearSale,
+		EffectiveAt:   change.EffectiveAt,
+		Status:        change.Status,
+		Reason:        change.Reason,
+		CreatedBy:     change.CreatedBy,
+		AppliedAt:     change.AppliedAt,
+		CancelledAt:   change.CancelledAt,
+		ErrorMessage:  change.ErrorMessage,
+		CreatedAt:     change.CreatedAt,
+	}
+	if change.Product != nil {
+		response.ProductName = change.Product.Name
+	}
+	return response
+}
+
+func toPriceHistoryResponse(entry *entities.PriceHistory) *PriceHistoryResponse {
+	response := &PriceHistoryResponse{
+		ID:                entry.ID,
+		ProductID:         entry.ProductID,
+		ChangedBy:         entry.ChangedBy,
+		OldPrice:          entry.OldPrice,
+		NewPrice:          entry.NewPrice,
+		OldSalePrice:      entry.OldSalePrice,
+		NewSalePrice:      entry.NewSalePrice,
+		NewSaleStartDate:  entry.NewSaleStartDate,
+		NewSaleEndDate:    entry.NewSaleEndDate,
+		OldEffectivePrice: entry.OldEffectivePrice,
+		NewEffectivePrice: entry.NewEffectivePrice,
+		Source:            entry.Source,
+		Reason:            entry.Reason,
+		CreatedAt:         entry.CreatedAt,
+	}
+	if entry.User != nil {
+		response.ChangedByEmail = entry.User.Email
+	}
+	return response
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"fmt"
 	"time"
 
@@ -157,7 +158,7 @@ func (uc *productComparisonUseCase) GetComparison(ctx context.Context, id uuid.U
 		return nil, fmt.Errorf("comparison not found: %w", err)
 	}
 
-	return uc.mapComparisonToResponse(comparison), nil
+	return uc.mapComparisonToResponse(ctx, comparison), nil
 }
 
 // GetUserComparison gets user's comparison
@@ -167,7 +168,7 @@ func (uc *productComparisonUseCase) GetUserComparison(ctx context.Context, userI
 		return nil, fmt.Errorf("user comparison not found: %w", err)
 	}
 
-	return uc.mapComparisonToResponse(comparison), nil
+	return uc.mapComparisonToResponse(ctx, comparison), nil
 }
 
 // GetSessionComparison gets session's comparison
@@ -177,7 +178,7 @@ func (uc *productComparisonUseCase) GetSessionComparison(ctx context.Context, se
 		return nil, fmt.Errorf("session comparison not found: %w", err)
 	}
 
-	return uc.mapComparisonToResponse(comparison), nil
+	return uc.mapComparisonToResponse(ctx, comparison), nil
 }
 
 // UpdateComparison updates a comparison
@@ -263,7 +264,7 @@ func (uc *productComparisonUseCase) ClearComparison(ctx context.Context, compari
 }
 
 // Helper method to map comparison entity to response
-func (uc *productComparisonUseCase) mapComparisonToResponse(comparison *entities.ProductComparison) *ProductComparisonResponse {
+func (uc *productComparisonUseCase) mapComparisonToResponse(ctx context.Context, comparison *entities.ProductComparison) *ProductComparisonResponse {
 	response := &ProductComparisonResponse{
 		ID:        comparison.ID,
 		UserID:    comparison.UserID,
@@ -277,7 +278,7 @@ func (uc *productComparisonUseCase) mapComparisonToResponse(comparison *entities
 	for i, item := range comparison.Items {
 		response.Products[i] = ProductComparisonItemResponse{
 			Position: item.Position,
-			Product:  uc.mapProductToResponse(&item.Product),
+			Product:  uc.mapProductToResponse(ctx, &item.Product),
 		}
 	}
 
@@ -285,7 +286,7 @@ func (uc *productComparisonUseCase) mapComparisonToResponse(comparison *entities
 }
 
 // mapProductToResponse converts product entity to response
-func (uc *productComparisonUseCase) mapProductToResponse(product *entities.Product) *ProductResponse {
+func (uc *productComparisonUseCase) mapProductToResponse(ctx context.Context, product *entities.Product) *ProductResponse {
 	if product == nil {
 		return nil
 	}
@@ -317,26 +318,28 @@ func (uc *productComparisonUseCase) mapProductToResponse(product *entities.Produ
 			}
 			return 0
 		}(),
-		Stock:             product.Stock,
-		LowStockThreshold: product.LowStockThreshold,
-		TrackQuantity:     product.TrackQuantity,
-		AllowBackorder:    product.AllowBackorder,
-		StockStatus:       product.StockStatus,
-		IsLowStock:        product.IsLowStock(),
-		Weight:            product.Weight,
-		RequiresShipping:  product.RequiresShipping,
-		ShippingClass:     product.ShippingClass,
-		TaxClass:          product.TaxClass,
-		CountryOfOrigin:   product.CountryOfOrigin,
-		Status:            product.Status,
-		ProductType:       product.ProductType,
-		IsDigital:         product.IsDigital,
-		IsAvailable:       product.IsAvailable(),
-		HasDiscount:       product.HasDiscount(),
-		HasVariants:       product.HasVariants(),
-		MainImage:         product.GetMainImage(),
-		CreatedAt:         product.CreatedAt,
-		UpdatedAt:         product.UpdatedAt,
+		Stock:                 &product.Stock,
+		StockVisibility:       product.StockVisibility,
+		StockDisplayThreshold: product.StockDisplayThreshold,
+		LowStockThreshold:     product.LowStockThreshold,
+		TrackQuantity:         product.TrackQuantity,
+		AllowBackorder:        product.AllowBackorder,
+		StockStatus:           product.StockStatus,
+		IsLowStock:            product.IsLowStock(),
+		Weight:                product.Weight,
+		RequiresShipping:      product.RequiresShipping,
+		ShippingClass:         product.ShippingClass,
+		TaxClass:              product.TaxClass,
+		CountryOfOrigin:       product.CountryOfOrigin,
+		Status:                product.Status,
+		ProductType:           product.ProductType,
+		IsDigital:             product.IsDigital,
+		IsAvailable:           product.IsAvailable(),
+		HasDiscount:           product.HasDiscount(),
+		HasVariants:           product.HasVariants(),
+		MainImage:             product.GetMainImage(),
+		CreatedAt:             product.CreatedAt,
+		UpdatedAt:             product.UpdatedAt,
 	}
 
 	// Convert category using ProductCategory many-to-many (get primary category)
@@ -380,6 +383,9 @@ func (uc *productComparisonUseCase) mapProductToResponse(product *entities.Produ
 		})
 	}
 
+	restrictStock(ctx, response, product)
+	applyStorefrontPrice(ctx, response)
+
 	return response
 }
 
@@ -404,7 +410,7 @@ func (uc *productComparisonUseCase) CompareProducts(ctx context.Context, product
 
 	// Create comparison response
 	comparisonResponse := &ProductComparisonResponse{
-		ID:       uuid.New(), // Temporary ID
+		ID:       ids.New(), // Temporary ID
 		Name:     "Product Comparison",
 		Products: make([]ProductComparisonItemResponse, len(products)),
 	}
@@ -412,12 +418,12 @@ func (uc *productComparisonUseCase) CompareProducts(ctx context.Context, product
 	for i, product := range products {
 		comparisonResponse.Products[i] = ProductComparisonItemResponse{
 			Position: i,
-			Product:  uc.mapProductToResponse(product),
+			Product:  uc.mapProductToResponse(ctx, product),
 		}
 	}
 
 	// Generate comparison matrix
-	matrix := uc.generateComparisonMatrix(products)
+	matrix := uc.generateComparisonMatrix(ctx, products)
 	attributes := uc.getComparisonAttributes()
 
 	return &ComparisonMatrixResponse{
@@ -441,11 +447,11 @@ func (uc *productComparisonUseCase) GetComparisonMatrix(ctx context.Context, com
 	}
 
 	// Generate comparison matrix
-	matrix := uc.generateComparisonMatrix(products)
+	matrix := uc.generateComparisonMatrix(ctx, products)
 	attributes := uc.getComparisonAttributes()
 
 	return &ComparisonMatrixResponse{
-		Comparison: uc.mapComparisonToResponse(comparison),
+		Comparison: uc.mapComparisonToResponse(ctx, comparison),
 		Matrix:     matrix,
 		Attributes: attributes,
 	}, nil
@@ -460,14 +466,14 @@ func (uc *productComparisonUseCase) GetPopularComparedProducts(ctx context.Conte
 
 	responses := make([]*ProductResponse, len(products))
 	for i, product := range products {
-		responses[i] = uc.mapProductToResponse(&product)
+		responses[i] = uc.mapProductToResponse(ctx, &product)
 	}
 
 	return responses, nil
 }
 
 // generateComparisonMatrix generates a comparison matrix for products
-func (uc *productComparisonUseCase) generateComparisonMatrix(products []*entities.Product) map[string]interface{} {
+func (uc *productComparisonUseCase) generateComparisonMatrix(ctx context.Context, products []*entities.Product) map[string]interface{} {
 	matrix := make(map[string]interface{})
 
 	if len(products) == 0 {
@@ -480,7 +486,7 @@ func (uc *productComparisonUseCase) generateComparisonMatrix(products []*entitie
 	matrix["current_prices"] = make([]float64, len(products))
 	matrix["categories"] = make([]string, len(products))
 	matrix["brands"] = make([]string, len(products))
-	matrix["stock"] = make([]int, len(products))
+	matrix["stock"] = make([]*int, len(products)) // Nil where the product's stock visibility withholds it
 	matrix["stock_status"] = make([]string, len(products))
 	matrix["ratings"] = make([]float64, len(products))
 	matrix["is_on_sale"] = make([]bool, len(products))
@@ -501,8 +507,15 @@ func (uc *productComparisonUseCase) generateComparisonMatrix(products []*entitie
 		} else {
 			matrix["brands"].([]string)[i] = ""
 		}
-		matrix["stock"].([]int)[i] = product.Stock
-		matrix["stock_status"].([]string)[i] = string(product.StockStatus)
+		// Shoppers only see what the product's stock visibility discloses, as in product responses
+		quantity := product.Stock
+		stock, stockStatus := &quantity, product.StockStatus
+		if !entities.ActorFromContext(ctx).IsStaff() {
+			disclosure := product.DiscloseStock()
+			stock, stockStatus = disclosure.Quantity, disclosedStockStatus(product.StockStatus, disclosure)
+		}
+		matrix["stock"].([]*int)[i] = stock
+		matrix["stock_status"].([]string)[i] = string(stockStatus)
 		matrix["ratings"].([]float64)[i] = 0 // TODO: Calculate from reviews
 		matrix["is_on_sale"].([]bool)[i] = product.IsOnSale()
 		matrix["sale_discount"].([]float64)[i] = product.GetSaleDiscountPercentage()
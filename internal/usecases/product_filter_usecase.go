@@ -58,6 +58,9 @@ type AdvancedFilterRequest struct {
 	// Filter options
 	IncludeFacets bool `json:"include_facets"`
 	FacetLimit    int  `json:"facet_limit"`
+
+	// ViewerID is the signed-in customer browsing the catalog, nil for guests
+	ViewerID *uuid.UUID `json:"-"`
 }
 
 // FilteredProductResponse represents filtered product response
@@ -122,6 +125,7 @@ type productFilterUseCase struct {
 	filterRepo          repositories.ProductFilterRepository
 	productRepo         repositories.ProductRepository
 	productCategoryRepo repositories.ProductCategoryRepository
+	visibilityPolicy    CatalogVisibilityPolicy
 }
 
 // NewProductFilterUseCase creates a new product filter use case
@@ -129,11 +133,13 @@ func NewProductFilterUseCase(
 	filterRepo repositories.ProductFilterRepository,
 	productRepo repositories.ProductRepository,
 	productCategoryRepo repositories.ProductCategoryRepository,
+	visibilityPolicy CatalogVisibilityPolicy,
 ) ProductFilterUseCase {
 	return &productFilterUseCase{
 		filterRepo:          filterRepo,
 		productRepo:         productRepo,
 		productCategoryRepo: productCategoryRepo,
+		visibilityPolicy:    visibilityPolicy,
 	}
 }
 
@@ -153,6 +159,15 @@ func (uc *productFilterUseCase) FilterProducts(ctx context.Context, req Advanced
 	params.Limit = limit
 	params.Offset = offset
 
+	// Hide products restricted away from the viewer
+	if uc.visibilityPolicy != nil {
+		hiddenIDs, err := uc.visibilityPolicy.HiddenProductIDs(ctx, req.ViewerID)
+		if err != nil {
+			return nil, err
+		}
+		params.ExcludeIDs = hiddenIDs
+	}
+
 	result, err := uc.filterRepo.FilterProducts(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get products: %w", err)
@@ -162,7 +177,7 @@ func (uc *productFilterUseCase) FilterProducts(ctx context.Context, req Advanced
 	// Convert products to response format using the proper mapping function
 	convertedProducts := make([]*ProductResponse, len(products))
 	for i, product := range products {
-		convertedProducts[i] = uc.mapProductToResponse(product)
+		convertedProducts[i] = uc.mapProductToResponse(ctx, product)
 	}
 
 	// Create pagination context
@@ -429,7 +444,7 @@ func (uc *productFilterUseCase) convertToRepositoryParams(req AdvancedFilterRequ
 }
 
 // Helper method to map product to response
-func (uc *productFilterUseCase) mapProductToResponse(product *entities.Product) *ProductResponse {
+func (uc *productFilterUseCase) mapProductToResponse(ctx context.Context, product *entities.Product) *ProductResponse {
 	if product == nil {
 		return nil
 	}
@@ -456,7 +471,9 @@ func (uc *productFilterUseCase) mapProductToResponse(product *entities.Product)
 		HasDiscount:            product.HasDiscount(),
 		SaleDiscountPercentage: product.GetSaleDiscountPercentage(),
 		DiscountPercentage:     product.GetDiscountPercentage(),
-		Stock:                  product.Stock,
+		Stock:                  &product.Stock,
+		StockVisibility:        product.StockVisibility,
+		StockDisplayThreshold:  product.StockDisplayThreshold,
 		LowStockThreshold:      product.LowStockThreshold,
 		TrackQuantity:          product.TrackQuantity,
 		AllowBackorder:         product.AllowBackorder,
@@ -518,6 +535,9 @@ func (uc *productFilterUseCase) mapProductToResponse(product *entities.Product)
 		})
 	}
 
+	restrictStock(ctx, response, product)
+	applyStorefrontPrice(ctx, response)
+
 	return response
 }
 
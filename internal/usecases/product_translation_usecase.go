@@ -0,0 +1,804 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ProductLocalizer serves product content in the reader's locale and tracks translations falling behind the source.
+// It is implemented by the product translation use case and consulted by the product read path.
+type ProductLocalizer interface {
+	// LocalizeProducts replaces each product's name, descriptions and SEO fields with its translation in the locale.
+	// Each field falls back from the exact locale ("pt-br") to its language ("pt") and then to the source content.
+	LocalizeProducts(ctx context.Context, locale string, products []*ProductResponse) error
+
+	// MarkSourceChanged flags the product's complete translations as outdated
+	MarkSourceChanged(ctx context.Context, productID uuid.UUID) error
+}
+
+// ProductTranslationUseCase defines use cases for per-locale product content
+type ProductTranslationUseCase interface {
+	ProductLocalizer
+
+	GetProductTranslations(ctx context.Context, productID uuid.UUID) (*ProductTranslationsResponse, error)
+	SaveProductTranslation(ctx context.Context, productID uuid.UUID, locale string, req SaveProductTranslationRequest) (*entities.ProductTranslation, error)
+	DeleteProductTranslation(ctx context.Context, productID uuid.UUID, locale string) error
+	GetTranslationSummary(ctx context.Context) (*ProductTranslationSummaryResponse, error)
+
+	// Translation files for translators
+	ExportTranslations(ctx context.Context, req ExportProductTranslationsRequest) (*ProductTranslationFile, error)
+	ImportTranslations(ctx context.Context, req ImportProductTranslationsRequest) (*ImportProductTranslationsResponse, error)
+}
+
+// Translation file formats
+const (
+	TranslationFileFormatCSV   = "csv"
+	TranslationFileFormatXLIFF = "xliff"
+)
+
+// productTranslationExportBatchSize is how many products are loaded at a time when exporting
+const productTranslationExportBatchSize = 500
+
+type productTranslationUseCase struct {
+	translationRepo  repositories.ProductTranslationRepository
+	productRepo      repositories.ProductRepository
+	sourceLocale     string
+	supportedLocales []string
+}
+
+// NewProductTranslationUseCase creates a new product translation use case.
+// sourceLocale is the language of the product content itself; the other supported locales can be translated to.
+func NewProductTranslationUseCase(
+	translationRepo repositories.ProductTranslationRepository,
+	productRepo repositories.ProductRepository,
+	sourceLocale string,
+	supportedLocales []string,
+) ProductTranslationUseCase {
+	uc := &productTranslationUseCase{
+		translationRepo: translationRepo,
+		productRepo:     productRepo,
+		sourceLocale:    entities.NormalizeLocale(sourceLocale),
+	}
+	for _, locale := range supportedLocales {
+		locale = entities.NormalizeLocale(locale)
+		if locale != "" && locale != uc.sourceLocale {
+			uc.supportedLocales = append(uc.supportedLocales, locale)
+		}
+	}
+	return uc
+}
+
+// SaveProductTranslationRequest represents a product's content in one locale; empty fields fall back to the source
+type SaveProductTranslationRequest struct {
+	Name             string `json:"name" validate:"max=255"`
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	MetaTitle        string `json:"meta_title" validate:"max=255"`
+	MetaDescription  string `json:"meta_description"`
+	Keywords         string `json:"keywords"`
+}
+
+// ProductLocaleTranslation represents a product's translation status in one locale
+type ProductLocaleTranslation struct {
+	Locale      string                            `json:"locale"`
+	Status      entities.ProductTranslationStatus `json:"status"`
+	Translation *entities.ProductTranslation      `json:"translation,omitempty"`
+}
+
+// ProductTranslationsResponse represents a product's source content and its translations in every supported locale
+type ProductTranslationsResponse struct {
+	ProductID    uuid.UUID                   `json:"product_id"`
+	SourceLocale string                      `json:"source_locale"`
+	Source       entities.ProductContent     `json:"source"`
+	Translations []*ProductLocaleTranslation `json:"translations"`
+}
+
+// LocaleTranslationSummary represents how much of the catalog is translated in a locale
+type LocaleTranslationSummary struct {
+	Locale               string  `json:"locale"`
+	Complete             int64   `json:"complete"`
+	Outdated             int64   `json:"outdated"`
+	Missing              int64   `json:"missing"`
+	CompletionPercentage float64 `json:"completion_percentage"`
+}
+
+// ProductTranslationSummaryResponse represents translation progress across the catalog
+type ProductTranslationSummaryResponse struct {
+	SourceLocale  string                      `json:"source_locale"`
+	TotalProducts int64                       `json:"total_products"`
+	Locales       []*LocaleTranslationSummary `json:"locales"`
+}
+
+// ExportProductTranslationsRequest represents a request for a translation file
+type ExportProductTranslationsRequest struct {
+	Locale string `json:"locale" validate:"required"`
+	Format string `json:"format" validate:"required,oneof=csv xliff"`
+	// Statuses limits the file to products with these statuses in the locale, e.g. missing and outdated; empty exports all
+	Statuses []entities.ProductTranslationStatus `json:"statuses"`
+}
+
+// ProductTranslationFile is an exported translation file
+type ProductTranslationFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// ImportProductTranslationsRequest represents an uploaded translation file
+type ImportProductTranslationsRequest struct {
+	// Locale is required for CSV files; XLIFF files default to their target-language
+	Locale string
+	Format string
+	Data   []byte
+}
+
+// ImportProductTranslationsResponse reports the outcome of a translation import
+type ImportProductTranslationsResponse struct {
+	Locale   string   `json:"locale"`
+	Imported int      `json:"imported"`
+	Skipped  int      `json:"skipped"`
+	Errors   []string `json:"errors"`
+}
+
+// productTranslationField maps a translatable field between products, translations and responses
+type productTranslationField struct {
+	name   string
+	source func(*entities.Product) string
+	get    func(*entities.ProductTranslation) string
+	set    func(*entities.ProductTranslation, string)
+	apply  func(*ProductResponse, string)
+}
+
+var productTranslationFields = []productTranslationField{
+	{
+		name:   "name",
+		source: func(p *entities.Product) string { return p.Name },
+		get:    func(t *entities.ProductTranslation) string { return t.Name },
+		set:    func(t *entities.ProductTranslation, v string) { t.Name = v },
+		apply:  func(r *ProductResponse, v string) { r.Name = v },
+	},
+	{
+		name:   "short_description",
+		source: func(p *entities.Product) string { return p.ShortDescription },
+		get:    func(t *entities.ProductTranslation) string { return t.ShortDescription },
+		set:    func(t *entities.ProductTranslation, v string) { t.ShortDescription = v },
+		apply:  func(r *ProductResponse, v string) { r.ShortDescription = v },
+	},
+	{
+		name:   "description",
+		source: func(p *entities.Product) string { return p.Description },
+		get:    func(t *entities.ProductTranslation) string { return t.Description },
+		set:    func(t *entities.ProductTranslation, v string) { t.Description = v },
+		apply:  func(r *ProductResponse, v string) { r.Description = v },
+	},
+	{
+		name:   "meta_title",
+		source: func(p *entities.Product) string { return p.MetaTitle },
+		get:    func(t *entities.ProductTranslation) string { return t.MetaTitle },
+		set:    func(t *entities.ProductTranslation, v string) { t.MetaTitle = v },
+		apply:  func(r *ProductResponse, v string) { r.MetaTitle = v },
+	},
+	{
+		name:   "meta_description",
+		source: func(p *entities.Product) string { return p.MetaDescription },
+		get:    func(t *entities.ProductTranslation) string { return t.MetaDescription },
+		set:    func(t *entities.ProductTranslation, v string) { t.MetaDescription = v },
+		apply:  func(r *ProductResponse, v string) { r.MetaDescription = v },
+	},
+	{
+		name:   "keywords",
+		source: func(p *entities.Product) string { return p.Keywords },
+		get:    func(t *entities.ProductTranslation) string { return t.Keywords },
+		set:    func(t *entities.ProductTranslation, v string) { t.Keywords = v },
+		apply:  func(r *ProductResponse, v string) { r.Keywords = v },
+	},
+}
+
+// findProductTranslationField finds a translatable field by name
+func findProductTranslationField(name string) (productTranslationField, bool) {
+	for _, field := range productTranslationFields {
+		if field.name == name {
+			return field, true
+		}
+	}
+	return productTranslationField{}, false
+}
+
+// LocalizeProducts serves product content in the locale, falling back field by field
+func (uc *productTranslationUseCase) LocalizeProducts(ctx context.Context, locale string, products []*ProductResponse) error {
+	chain := uc.localeChain(locale)
+	for _, product := range products {
+		product.Locale = uc.sourceLocale
+	}
+	if len(chain) == 0 || len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]uuid.UUID, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+	translations, err := uc.translationRepo.ListByProducts(ctx, productIDs, chain)
+	if err != nil {
+		return fmt.Errorf("failed to get product translations: %w", err)
+	}
+
+	byProduct := make(map[uuid.UUID]map[string]*entities.ProductTranslation)
+	for _, translation := range translations {
+		if byProduct[translation.ProductID] == nil {
+			byProduct[translation.ProductID] = make(map[string]*entities.ProductTranslation)
+		}
+		byProduct[translation.ProductID][translation.Locale] = translation
+	}
+
+	for _, product := range products {
+		// Apply the least specific locale first so more specific translations win field by field
+		for i := len(chain) - 1; i >= 0; i-- {
+			translation := byProduct[product.ID][chain[i]]
+			if translation == nil {
+				continue
+			}
+			for _, field := range productTranslationFields {
+				if value := field.get(translation); value != "" {
+					field.apply(product, value)
+				}
+			}
+			product.Locale = chain[i]
+		}
+	}
+	return nil
+}
+
+// localeChain returns the supported locales to try for a requested locale, most specific first.
+// It is empty when the source content should be served.
+func (uc *productTranslationUseCase) localeChain(locale string) []string {
+	locale = entities.NormalizeLocale(locale)
+	candidates := []string{locale}
+	if i := strings.Index(locale, "-"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+
+	var chain []string
+	for _, candidate := range candidates {
+		if candidate == uc.sourceLocale {
+			break
+		}
+		if uc.isSupported(candidate) {
+			chain = append(chain, candidate)
+		}
+	}
+	return chain
+}
+
+// isSupported checks if products can be translated to a locale
+func (uc *productTranslationUseCase) isSupported(locale string) bool {
+	for _, supported := range uc.supportedLocales {
+		if supported == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLocale normalizes a translation locale and checks it is supported
+func (uc *productTranslationUseCase) validateLocale(locale string) (string, error) {
+	locale = entities.NormalizeLocale(locale)
+	if locale == uc.sourceLocale {
+		return "", pkgErrors.InvalidInput(fmt.Sprintf("%s is the source locale; edit the product itself instead", locale))
+	}
+	if !uc.isSupported(locale) {
+		return "", pkgErrors.InvalidInput(fmt.Sprintf("unsupported locale %q, supported locales are: %s", locale, strings.Join(uc.supportedLocales, ", ")))
+	}
+	return locale, nil
+}
+
+// MarkSourceChanged flags the product's complete translations as outdated
+func (uc *productTranslationUseCase) MarkSourceChanged(ctx context.Context, productID uuid.UUID) error {
+	return uc.translationRepo.MarkOutdated(ctx, productID)
+}
+
+// GetProductTranslations gets a product's translation status in every supported locale
+func (uc *productTranslationUseCase) GetProductTranslations(ctx context.Context, productID uuid.UUID) (*ProductTranslationsResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	translations, err := uc.translationRepo.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product translations: %w", err)
+	}
+	byLocale := make(map[string]*entities.ProductTranslation, len(translations))
+	for _, translation := range translations {
+		byLocale[translation.Locale] = translation
+	}
+
+	response := &ProductTranslationsResponse{
+		ProductID:    product.ID,
+		SourceLocale: uc.sourceLocale,
+		Source:       product.TranslatableContent(),
+		Translations: make([]*ProductLocaleTranslation, 0, len(uc.supportedLocales)),
+	}
+	for _, locale := range uc.supportedLocales {
+		item := &ProductLocaleTranslation{
+			Locale: locale,
+			Status: entities.ProductTranslationStatusMissing,
+		}
+		if translation := byLocale[locale]; translation != nil {
+			item.Status = translation.Status
+			item.Translation = translation
+		}
+		response.Translations = append(response.Translations, item)
+	}
+	return response, nil
+}
+
+// SaveProductTranslation creates or replaces a product's translation in a locale and marks it complete
+func (uc *productTranslationUseCase) SaveProductTranslation(ctx context.Context, productID uuid.UUID, locale string, req SaveProductTranslationRequest) (*entities.ProductTranslation, error) {
+	locale, err := uc.validateLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	translation := &entities.ProductTranslation{
+		ProductID:        productID,
+		Locale:           locale,
+		Name:             strings.TrimSpace(req.Name),
+		ShortDescription: strings.TrimSpace(req.ShortDescription),
+		Description:      strings.TrimSpace(req.Description),
+		MetaTitle:        strings.TrimSpace(req.MetaTitle),
+		MetaDescription:  strings.TrimSpace(req.MetaDescription),
+		Keywords:         strings.TrimSpace(req.Keywords),
+	}
+	if err := uc.save(ctx, translation); err != nil {
+		return nil, err
+	}
+	return uc.translationRepo.Get(ctx, productID, locale)
+}
+
+// save validates a translation and stores it as complete
+func (uc *productTranslationUseCase) save(ctx context.Context, translation *entities.ProductTranslation) error {
+	if err := translation.Validate(); err != nil {
+		return pkgErrors.InvalidInput(err.Error())
+	}
+	translation.Status = entities.ProductTranslationStatusComplete
+	translation.TranslatedAt = time.Now()
+	translation.UpdatedAt = translation.TranslatedAt
+	if err := uc.translationRepo.Save(ctx, translation); err != nil {
+		return fmt.Errorf("failed to save product translation: %w", err)
+	}
+	return nil
+}
+
+// DeleteProductTranslation deletes a product's translation in a locale
+func (uc *productTranslationUseCase) DeleteProductTranslation(ctx context.Context, productID uuid.UUID, locale string) error {
+	locale, err := uc.validateLocale(locale)
+	if err != nil {
+		return err
+	}
+	return uc.translationRepo.Delete(ctx, productID, locale)
+}
+
+// GetTranslationSummary gets how much of the catalog is translated in each supported locale
+func (uc *productTranslationUseCase) GetTranslationSummary(ctx context.Context) (*ProductTranslationSummaryResponse, error) {
+	total, err := uc.productRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products: %w", err)
+	}
+	counts, err := uc.translationRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count product translations: %w", err)
+	}
+
+	response := &ProductTranslationSummaryResponse{
+		SourceLocale:  uc.sourceLocale,
+		TotalProducts: total,
+		Locales:       make([]*LocaleTranslationSummary, 0, len(uc.supportedLocales)),
+	}
+	for _, locale := range uc.supportedLocales {
+		summary := &LocaleTranslationSummary{Locale: locale}
+		for _, count := range counts {
+			if count.Locale != locale {
+				continue
+			}
+			switch count.Status {
+			case entities.ProductTranslationStatusComplete:
+				summary.Complete = count.Count
+			case entities.ProductTranslationStatusOutdated:
+				summary.Outdated = count.Count
+			}
+		}
+		if missing := total - summary.Complete - summary.Outdated; missing > 0 {
+			summary.Missing = missing
+		}
+		if total > 0 {
+			summary.CompletionPercentage = float64(summary.Complete) / float64(total) * 100
+		}
+		response.Locales = append(response.Locales, summary)
+	}
+	return response, nil
+}
+
+// productTranslationExportItem is a product with its translation in the exported locale
+type productTranslationExportItem struct {
+	product     *entities.Product
+	translation *entities.ProductTranslation
+	status      entities.ProductTranslationStatus
+}
+
+// ExportTranslations builds a CSV or XLIFF file of the catalog's source content and translations in a locale
+func (uc *productTranslationUseCase) ExportTranslations(ctx context.Context, req ExportProductTranslationsRequest) (*ProductTranslationFile, error) {
+	locale, err := uc.validateLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := uc.loadExportItems(ctx, locale, req.Statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("product_translations_%s_%s", locale, time.Now().Format("20060102"))
+	switch req.Format {
+	case TranslationFileFormatCSV:
+		data, err := uc.buildCSV(items)
+		if err != nil {
+			return nil, err
+		}
+		return &ProductTranslationFile{FileName: fileName + ".csv", ContentType: "text/csv", Data: data}, nil
+	case TranslationFileFormatXLIFF:
+		data, err := uc.buildXLIFF(locale, items)
+		if err != nil {
+			return nil, err
+		}
+		return &ProductTranslationFile{FileName: fileName + ".xlf", ContentType: "application/x-xliff+xml", Data: data}, nil
+	default:
+		return nil, pkgErrors.InvalidInput("format must be csv or xliff")
+	}
+}
+
+// loadExportItems loads every product with its translation in the locale, keeping only the requested statuses
+func (uc *productTranslationUseCase) loadExportItems(ctx context.Context, locale string, statuses []entities.ProductTranslationStatus) ([]*productTranslationExportItem, error) {
+	wanted := make(map[entities.ProductTranslationStatus]bool, len(statuses))
+	for _, status := range statuses {
+		wanted[status] = true
+	}
+
+	var items []*productTranslationExportItem
+	for offset := 0; ; offset += productTranslationExportBatchSize {
+		products, err := uc.productRepo.List(ctx, productTranslationExportBatchSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+
+		productIDs := make([]uuid.UUID, len(products))
+		for i, product := range products {
+			productIDs[i] = product.ID
+		}
+		translations, err := uc.translationRepo.ListByProducts(ctx, productIDs, []string{locale})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get product translations: %w", err)
+		}
+		byProduct := make(map[uuid.UUID]*entities.ProductTranslation, len(translations))
+		for _, translation := range translations {
+			byProduct[translation.ProductID] = translation
+		}
+
+		for _, product := range products {
+			item := &productTranslationExportItem{
+				product:     product,
+				translation: byProduct[product.ID],
+				status:      entities.ProductTranslationStatusMissing,
+			}
+			if item.translation != nil {
+				item.status = item.translation.Status
+			}
+			if len(wanted) == 0 || wanted[item.status] {
+				items = append(items, item)
+			}
+		}
+
+		if len(products) < productTranslationExportBatchSize {
+			return items, nil
+		}
+	}
+}
+
+// buildCSV writes one row per product with a source and a translation column for each field
+func (uc *productTranslationUseCase) buildCSV(items []*productTranslationExportItem) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	header := []string{"product_id", "sku", "status"}
+	for _, field := range productTranslationFields {
+		header = append(header, "source_"+field.name, field.name)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, item := range items {
+		row := []string{item.product.ID.String(), item.product.SKU, string(item.status)}
+		for _, field := range productTranslationFields {
+			translated := ""
+			if item.translation != nil {
+				translated = field.get(item.translation)
+			}
+			row = append(row, field.source(item.product), translated)
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// xliffDocument is an XLIFF 1.2 file with one trans-unit per product field, identified as "<product id>/<field>"
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"xliff"`
+	Xmlns   string    `xml:"xmlns,attr,omitempty"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID     string      `xml:"id,attr"`
+	Source string      `xml:"source"`
+	Target xliffTarget `xml:"target"`
+}
+
+type xliffTarget struct {
+	// State tells translation tools which units need work
+	State string `xml:"state,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// xliffStates maps translation statuses to XLIFF target states
+var xliffStates = map[entities.ProductTranslationStatus]string{
+	entities.ProductTranslationStatusMissing:  "needs-translation",
+	entities.ProductTranslationStatusOutdated: "needs-review-translation",
+	entities.ProductTranslationStatusComplete: "translated",
+}
+
+// buildXLIFF writes a trans-unit for every field with source content
+func (uc *productTranslationUseCase) buildXLIFF(locale string, items []*productTranslationExportItem) ([]byte, error) {
+	document := xliffDocument{
+		Xmlns:   "urn:oasis:names:tc:xliff:document:1.2",
+		Version: "1.2",
+		File: xliffFile{
+			Original:       "products",
+			SourceLanguage: uc.sourceLocale,
+			TargetLanguage: locale,
+			Datatype:       "plaintext",
+		},
+	}
+
+	for _, item := range items {
+		for _, field := range productTranslationFields {
+			source := field.source(item.product)
+			if source == "" {
+				continue
+			}
+			unit := xliffUnit{
+				ID:     item.product.ID.String() + "/" + field.name,
+				Source: source,
+				Target: xliffTarget{State: xliffStates[item.status]},
+			}
+			if item.translation != nil {
+				unit.Target.Value = field.get(item.translation)
+			}
+			document.File.Units = append(document.File.Units, unit)
+		}
+	}
+
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to write XLIFF: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// importedTranslation collects the translated fields for one product from a file
+type importedTranslation struct {
+	productID uuid.UUID
+	fields    map[string]string
+}
+
+// importedTranslations collects translated fields per product, keeping products in file order
+type importedTranslations struct {
+	items     []*importedTranslation
+	byProduct map[uuid.UUID]*importedTranslation
+}
+
+// product returns the product's collected fields, adding the product if it is new
+func (t *importedTranslations) product(productID uuid.UUID) *importedTranslation {
+	if t.byProduct == nil {
+		t.byProduct = make(map[uuid.UUID]*importedTranslation)
+	}
+	imported := t.byProduct[productID]
+	if imported == nil {
+		imported = &importedTranslation{productID: productID, fields: make(map[string]string)}
+		t.byProduct[productID] = imported
+		t.items = append(t.items, imported)
+	}
+	return imported
+}
+
+// add records a translated field; empty values leave the stored translation unchanged
+func (t *importedTranslations) add(productID uuid.UUID, field, value string) {
+	imported := t.product(productID)
+	if value = strings.TrimSpace(value); value != "" {
+		imported.fields[field] = value
+	}
+}
+
+// ImportTranslations imports a CSV or XLIFF file. Non-empty translated fields replace the stored ones,
+// and each imported product's translation is marked complete.
+func (uc *productTranslationUseCase) ImportTranslations(ctx context.Context, req ImportProductTranslationsRequest) (*ImportProductTranslationsResponse, error) {
+	var (
+		imports []*importedTranslation
+		errs    []string
+		locale  = req.Locale
+		err     error
+	)
+	switch req.Format {
+	case TranslationFileFormatCSV:
+		imports, errs, err = parseTranslationCSV(req.Data)
+	case TranslationFileFormatXLIFF:
+		var fileLocale string
+		imports, fileLocale, errs, err = parseTranslationXLIFF(req.Data)
+		if locale == "" {
+			locale = fileLocale
+		}
+	default:
+		return nil, pkgErrors.InvalidInput("format must be csv or xliff")
+	}
+	if err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	locale, err = uc.validateLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportProductTranslationsResponse{
+		Locale: locale,
+		Errors: errs,
+	}
+	for _, imported := range imports {
+		if len(imported.fields) == 0 {
+			response.Skipped++
+			continue
+		}
+		if _, err := uc.productRepo.GetByID(ctx, imported.productID); err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("product %s: not found", imported.productID))
+			continue
+		}
+
+		translation, err := uc.translationRepo.Get(ctx, imported.productID, locale)
+		if err != nil {
+			translation = &entities.ProductTranslation{ProductID: imported.productID, Locale: locale}
+		}
+		for name, value := range imported.fields {
+			field, _ := findProductTranslationField(name)
+			field.set(translation, value)
+		}
+
+		if err := uc.save(ctx, translation); err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("product %s: %v", imported.productID, err))
+			continue
+		}
+		response.Imported++
+	}
+
+	if response.Errors == nil {
+		response.Errors = []string{}
+	}
+	return response, nil
+}
+
+// parseTranslationCSV reads a file in the export layout; source_ columns and unknown columns are ignored
+func parseTranslationCSV(data []byte) ([]*importedTranslation, []string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	productColumn := -1
+	fieldColumns := make(map[int]string)
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if column == "product_id" {
+			productColumn = i
+		} else if _, ok := findProductTranslationField(column); ok {
+			fieldColumns[i] = column
+		}
+	}
+	if productColumn < 0 {
+		return nil, nil, fmt.Errorf("CSV must have a product_id column")
+	}
+
+	var (
+		imports importedTranslations
+		errs    []string
+	)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		if productColumn >= len(record) {
+			errs = append(errs, fmt.Sprintf("row %d: missing product_id", line))
+			continue
+		}
+		productID, err := uuid.Parse(strings.TrimSpace(record[productColumn]))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("row %d: invalid product_id", line))
+			continue
+		}
+		imports.product(productID)
+		for i, field := range fieldColumns {
+			if i < len(record) {
+				imports.add(productID, field, record[i])
+			}
+		}
+	}
+	return imports.items, errs, nil
+}
+
+// parseTranslationXLIFF reads the trans-units of an XLIFF 1.2 file and returns its target-language
+func parseTranslationXLIFF(data []byte) ([]*importedTranslation, string, []string, error) {
+	var document xliffDocument
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, "", nil, fmt.Errorf("failed to read XLIFF: %w", err)
+	}
+
+	var (
+		imports importedTranslations
+		errs    []string
+	)
+	for _, unit := range document.File.Units {
+		id, fieldName, ok := strings.Cut(unit.ID, "/")
+		productID, err := uuid.Parse(id)
+		if _, known := findProductTranslationField(fieldName); !ok || err != nil || !known {
+			errs = append(errs, fmt.Sprintf("trans-unit %q: unknown id", unit.ID))
+			continue
+		}
+		imports.add(productID, fieldName, unit.Target.Value)
+	}
+	return imports.items, document.File.TargetLanguage, errs, nil
+}
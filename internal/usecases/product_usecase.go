@@ -8,6 +8,8 @@ import (
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 	"ecom-golang-clean-architecture/pkg/utils"
 
 	"github.com/google/uuid"
@@ -33,6 +35,9 @@ type CreateProductRequest struct {
 	ComparePrice *float64 `json:"compare_price" validate:"omitempty,gt=0"`
 	CostPrice    *float64 `json:"cost_price" validate:"omitempty,gt=0"`
 
+	// Minimum Advertised Price (MAP) floor
+	MinAdvertisedPrice *float64 `json:"min_advertised_price" validate:"omitempty,gt=0"`
+
 	// Sale Pricing
 	SalePrice     *float64   `json:"sale_price" validate:"omitempty,gt=0"`
 	SaleStartDate *time.Time `json:"sale_start_date"`
@@ -44,6 +49,10 @@ type CreateProductRequest struct {
 	TrackQuantity     bool `json:"track_quantity"`
 	AllowBackorder    bool `json:"allow_backorder"`
 
+	// Stock visibility - exact, low_stock or hidden; exact when empty
+	StockVisibility       entities.StockVisibility `json:"stock_visibility"`
+	StockDisplayThreshold *int                     `json:"stock_display_threshold" validate:"omitempty,min=0"`
+
 	// Physical Properties
 	Weight     *float64           `json:"weight" validate:"omitempty,gt=0"`
 	Dimensions *DimensionsRequest `json:"dimensions"`
@@ -73,12 +82,22 @@ type CreateProductRequest struct {
 type GetProductsRequest struct {
 	Limit  int `json:"limit" validate:"min=1,max=100"`
 	Offset int `json:"offset" validate:"min=0"`
+
+	// ViewerID is the signed-in customer browsing the catalog, nil for guests
+	ViewerID *uuid.UUID `json:"-"`
+	// Personalize ranks the page by the viewer's preferences and browsing history
+	Personalize bool `json:"-"`
+	// Locale is the reader's preferred locale for product content; empty serves the source content
+	Locale string `json:"-"`
+	// PreviewToken shows the products with an unpublished catalog changeset applied
+	PreviewToken string `json:"-"`
 }
 
 // GetProductsResponse represents paginated products response
 type GetProductsResponse struct {
-	Products   []*ProductResponse `json:"products"`
-	Pagination *PaginationInfo    `json:"pagination"`
+	Products     []*ProductResponse `json:"products"`
+	Pagination   *PaginationInfo    `json:"pagination"`
+	Personalized bool               `json:"personalized"`
 }
 
 // FeaturedProductsPaginatedResponse represents paginated featured products
@@ -111,6 +130,12 @@ type SearchProductsRequest struct {
 	SortOrder  string                  `json:"sort_order"`
 	Limit      int                     `json:"limit" validate:"min=1,max=100"`
 	Offset     int                     `json:"offset" validate:"min=0"`
+
+	// ViewerID is the signed-in customer browsing the catalog, nil for guests
+	ViewerID *uuid.UUID `json:"-"`
+	// Personalize ranks the page by the viewer's preferences and browsing history;
+	// only honoured when no explicit sort was requested
+	Personalize bool `json:"-"`
 }
 
 type DimensionsRequest struct {
@@ -151,10 +176,20 @@ type ProductVariantAttributeRequest struct {
 	TermID      uuid.UUID `json:"term_id" validate:"required"`
 }
 
+// ChangeProductLifecycleRequest represents a request to move a product through its lifecycle
+type ChangeProductLifecycleRequest struct {
+	Status entities.ProductStatus `json:"status" validate:"required,oneof=draft active inactive discontinued archived"`
+	// ReplacementProductID is suggested first to customers visiting a discontinued or archived product
+	ReplacementProductID *uuid.UUID `json:"replacement_product_id"`
+}
+
 // SearchSuggestionsRequest represents search suggestions request
 type SearchSuggestionsRequest struct {
 	Query string `json:"query" validate:"required,min=1"`
 	Limit int    `json:"limit" validate:"min=1,max=50"`
+
+	// ViewerID is the signed-in customer browsing the catalog, nil for guests
+	ViewerID *uuid.UUID `json:"-"`
 }
 
 // SearchSuggestionsResponse represents search suggestions response
@@ -172,19 +207,53 @@ type SearchHistoryResponse struct {
 	History []string `json:"history"`
 }
 
+// MaxPriceAvailabilityBatch caps the number of products in one price and availability request
+const MaxPriceAvailabilityBatch = 100
+
+// ProductPriceAvailability represents the volatile price and stock data of a product
+type ProductPriceAvailability struct {
+	ProductID          uuid.UUID            `json:"product_id"`
+	Price              float64              `json:"price"`
+	ComparePrice       *float64             `json:"compare_price"`
+	SalePrice          *float64             `json:"sale_price"`
+	SaleStartDate      *time.Time           `json:"sale_start_date"`
+	SaleEndDate        *time.Time           `json:"sale_end_date"`
+	CurrentPrice       float64              `json:"current_price"`
+	IsOnSale           bool                 `json:"is_on_sale"`
+	DiscountPercentage float64              `json:"discount_percentage"`
+	StockStatus        entities.StockStatus `json:"stock_status"`
+	IsAvailable        bool                 `json:"is_available"`
+	IsLowStock         bool                 `json:"is_low_stock"`
+	StockMessage       string               `json:"stock_message,omitempty"`
+	RestockETA         *time.Time           `json:"restock_eta,omitempty"`
+	RestockMessage     string               `json:"restock_message,omitempty"`
+	AllowBackorder     bool                 `json:"allow_backorder"`
+	NoLongerAvailable  bool                 `json:"no_longer_available"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+}
+
+// PriceAvailabilityResponse represents the price and availability of a batch of products
+type PriceAvailabilityResponse struct {
+	Products []*ProductPriceAvailability `json:"products"`
+	// NotFound lists the requested products that do not exist or are not on sale to the viewer
+	NotFound []uuid.UUID `json:"not_found"`
+	// NextChangeAt is the earliest upcoming sale start or end; prices change then without any edit
+	NextChangeAt *time.Time `json:"next_change_at,omitempty"`
+}
+
 // Response structs are defined in types.go
 
 // ProductUseCase defines product use cases
 type ProductUseCase interface {
 	CreateProduct(ctx context.Context, req CreateProductRequest) (*ProductResponse, error)
-	GetProduct(ctx context.Context, id uuid.UUID) (*ProductResponse, error)
+	GetProduct(ctx context.Context, id uuid.UUID, viewerID *uuid.UUID, locale, previewToken string) (*ProductResponse, error)
 	UpdateProduct(ctx context.Context, id uuid.UUID, req UpdateProductRequest) (*ProductResponse, error)
 	PatchProduct(ctx context.Context, id uuid.UUID, req PatchProductRequest) (*ProductResponse, error)
 	DeleteProduct(ctx context.Context, id uuid.UUID) error
 	GetProducts(ctx context.Context, req GetProductsRequest) (*GetProductsResponse, error)
 	SearchProducts(ctx context.Context, req SearchProductsRequest) ([]*ProductResponse, error)
 	SearchProductsPaginated(ctx context.Context, req SearchProductsRequest) (*GetProductsResponse, error)
-	GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int) (*GetProductsResponse, error)
+	GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int, viewerID *uuid.UUID) (*GetProductsResponse, error)
 	UpdateStock(ctx context.Context, productID uuid.UUID, stock int) error
 
 	// Search autocomplete and suggestions
@@ -193,9 +262,17 @@ type ProductUseCase interface {
 	GetSearchHistory(ctx context.Context, userID uuid.UUID, limit int) (*SearchHistoryResponse, error)
 
 	// Paginated product methods
-	GetFeaturedProductsPaginated(ctx context.Context, page, limit int) (*FeaturedProductsPaginatedResponse, error)
-	GetTrendingProductsPaginated(ctx context.Context, page, limit int) (*TrendingProductsPaginatedResponse, error)
-	GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int) (*RelatedProductsPaginatedResponse, error)
+	GetFeaturedProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*FeaturedProductsPaginatedResponse, error)
+	GetTrendingProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*TrendingProductsPaginatedResponse, error)
+	GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int, viewerID *uuid.UUID) (*RelatedProductsPaginatedResponse, error)
+
+	// Lifecycle
+	ChangeProductLifecycle(ctx context.Context, id uuid.UUID, req ChangeProductLifecycleRequest) (*ProductResponse, error)
+	GetProductAlternatives(ctx context.Context, id uuid.UUID, limit int, viewerID *uuid.UUID) ([]*ProductResponse, error)
+
+	// GetPriceAvailability returns only the price and stock data of a batch of products, for frontends
+	// that cache the full product content for long and refresh the volatile data separately
+	GetPriceAvailability(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) (*PriceAvailabilityResponse, error)
 }
 
 type productUseCase struct {
@@ -207,6 +284,14 @@ type productUseCase struct {
 	cartRepo            repositories.CartRepository
 	inventoryRepo       repositories.InventoryRepository
 	warehouseRepo       repositories.WarehouseRepository
+	priceHistoryRepo    repositories.PriceHistoryRepository
+	orderRepo           repositories.OrderRepository
+	vendorRepo          repositories.VendorRepository
+	visibilityPolicy    CatalogVisibilityPolicy
+	listingRanker       ListingRanker
+	localizer           ProductLocalizer
+	previewer           CatalogPreviewer
+	restockEstimator    RestockEstimator
 }
 
 // NewProductUseCase creates a new product use case
@@ -219,6 +304,14 @@ func NewProductUseCase(
 	cartRepo repositories.CartRepository,
 	inventoryRepo repositories.InventoryRepository,
 	warehouseRepo repositories.WarehouseRepository,
+	priceHistoryRepo repositories.PriceHistoryRepository,
+	orderRepo repositories.OrderRepository,
+	vendorRepo repositories.VendorRepository,
+	visibilityPolicy CatalogVisibilityPolicy,
+	listingRanker ListingRanker,
+	localizer ProductLocalizer,
+	previewer CatalogPreviewer,
+	restockEstimator RestockEstimator,
 ) ProductUseCase {
 	return &productUseCase{
 		productRepo:         productRepo,
@@ -229,6 +322,14 @@ func NewProductUseCase(
 		cartRepo:            cartRepo,
 		inventoryRepo:       inventoryRepo,
 		warehouseRepo:       warehouseRepo,
+		priceHistoryRepo:    priceHistoryRepo,
+		orderRepo:           orderRepo,
+		vendorRepo:          vendorRepo,
+		visibilityPolicy:    visibilityPolicy,
+		listingRanker:       listingRanker,
+		localizer:           localizer,
+		previewer:           previewer,
+		restockEstimator:    restockEstimator,
 	}
 }
 
@@ -250,6 +351,9 @@ type UpdateProductRequest struct {
 	ComparePrice *float64 `json:"compare_price" validate:"omitempty,gt=0"`
 	CostPrice    *float64 `json:"cost_price" validate:"omitempty,gt=0"`
 
+	// Minimum Advertised Price (MAP) floor
+	MinAdvertisedPrice *float64 `json:"min_advertised_price" validate:"omitempty,gt=0"`
+
 	// Sale Pricing
 	SalePrice     *float64   `json:"sale_price" validate:"omitempty,gt=0"`
 	SaleStartDate *time.Time `json:"sale_start_date"`
@@ -261,6 +365,10 @@ type UpdateProductRequest struct {
 	TrackQuantity     *bool `json:"track_quantity"`
 	AllowBackorder    *bool `json:"allow_backorder"`
 
+	// Stock visibility
+	StockVisibility       *entities.StockVisibility `json:"stock_visibility"`
+	StockDisplayThreshold *int                      `json:"stock_display_threshold" validate:"omitempty,min=0"`
+
 	// Physical Properties
 	Weight     *float64           `json:"weight" validate:"omitempty,gt=0"`
 	Dimensions *DimensionsRequest `json:"dimensions"`
@@ -285,6 +393,10 @@ type UpdateProductRequest struct {
 	Status      *entities.ProductStatus `json:"status"`
 	ProductType *entities.ProductType   `json:"product_type"`
 	IsDigital   *bool                   `json:"is_digital"`
+
+	// Price change audit
+	PriceChangeReason string     `json:"price_change_reason"`
+	UpdatedBy         *uuid.UUID `json:"-"`
 }
 
 // PatchProductRequest for PATCH operations - only updates provided fields
@@ -306,6 +418,9 @@ type PatchProductRequest struct {
 	ComparePrice *float64 `json:"compare_price" validate:"omitempty,gt=0"`
 	CostPrice    *float64 `json:"cost_price" validate:"omitempty,gt=0"`
 
+	// Minimum Advertised Price (MAP) floor
+	MinAdvertisedPrice *float64 `json:"min_advertised_price" validate:"omitempty,gt=0"`
+
 	// Sale Pricing
 	SalePrice     *float64   `json:"sale_price" validate:"omitempty,gt=0"`
 	SaleStartDate *time.Time `json:"sale_start_date"`
@@ -317,6 +432,10 @@ type PatchProductRequest struct {
 	TrackQuantity     *bool `json:"track_quantity"`
 	AllowBackorder    *bool `json:"allow_backorder"`
 
+	// Stock visibility
+	StockVisibility       *entities.StockVisibility `json:"stock_visibility"`
+	StockDisplayThreshold *int                      `json:"stock_display_threshold" validate:"omitempty,min=0"`
+
 	// Physical Properties
 	Weight     *float64           `json:"weight" validate:"omitempty,gt=0"`
 	Dimensions *DimensionsRequest `json:"dimensions"`
@@ -341,6 +460,10 @@ type PatchProductRequest struct {
 	Status      *entities.ProductStatus `json:"status"`
 	ProductType *entities.ProductType   `json:"product_type"`
 	IsDigital   *bool                   `json:"is_digital"`
+
+	// Price change audit
+	PriceChangeReason string     `json:"price_change_reason"`
+	UpdatedBy         *uuid.UUID `json:"-"`
 }
 
 // CreateProduct creates a new product
@@ -381,7 +504,7 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 
 	// Create product
 	product := &entities.Product{
-		ID:               uuid.New(),
+		ID:               ids.New(),
 		Name:             req.Name,
 		Description:      req.Description,
 		ShortDescription: req.ShortDescription,
@@ -400,6 +523,9 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 		ComparePrice: req.ComparePrice,
 		CostPrice:    req.CostPrice,
 
+		// MAP floor
+		MinAdvertisedPrice: req.MinAdvertisedPrice,
+
 		// Sale Pricing
 		SalePrice:     req.SalePrice,
 		SaleStartDate: req.SaleStartDate,
@@ -411,6 +537,10 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 		TrackQuantity:     req.TrackQuantity,
 		AllowBackorder:    req.AllowBackorder,
 
+		// Stock visibility
+		StockVisibility:       req.StockVisibility,
+		StockDisplayThreshold: req.StockDisplayThreshold,
+
 		// Physical Properties
 		Weight: req.Weight,
 
@@ -442,9 +572,18 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 	if product.Status == "" {
 		product.Status = entities.ProductStatusDraft
 	}
+	if !entities.IsValidProductStatus(product.Status) {
+		return nil, pkgErrors.InvalidInput("invalid product status")
+	}
 	if product.LowStockThreshold == 0 {
 		product.LowStockThreshold = 5
 	}
+	if product.StockVisibility == "" {
+		product.StockVisibility = entities.StockVisibilityExact
+	}
+	if !entities.IsValidStockVisibility(product.StockVisibility) {
+		return nil, pkgErrors.InvalidInput("invalid stock visibility")
+	}
 	if product.TaxClass == "" {
 		product.TaxClass = "standard"
 	}
@@ -457,6 +596,11 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 		}
 	}
 
+	// Enforce MAP floor
+	if err := product.ValidateMinAdvertisedPrice(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
 	// Update stock status based on current stock
 	product.UpdateStockStatus()
 
@@ -516,16 +660,51 @@ func (uc *productUseCase) CreateProduct(ctx context.Context, req CreateProductRe
 	return uc.toProductResponse(updatedProduct), nil
 }
 
-// GetProduct gets a product by ID
-func (uc *productUseCase) GetProduct(ctx context.Context, id uuid.UUID) (*ProductResponse, error) {
+// GetProduct gets a product by ID, with its content in the requested locale where translated.
+// A preview token shows the product with an unpublished catalog changeset applied.
+func (uc *productUseCase) GetProduct(ctx context.Context, id uuid.UUID, viewerID *uuid.UUID, locale, previewToken string) (*ProductResponse, error) {
 	product, err := uc.productRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, entities.ErrProductNotFound
 	}
+	if err := uc.preview(ctx, previewToken, []*entities.Product{product}); err != nil {
+		return nil, err
+	}
+
+	// Products restricted away from the viewer are reported as not found
+	if uc.visibilityPolicy != nil {
+		visible, err := uc.visibilityPolicy.CanViewProduct(ctx, viewerID, id)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, entities.ErrProductNotFound
+		}
+	}
+
+	response := uc.toProductResponse(product)
+	restrictStock(ctx, response, product)
+	applyStorefrontPrice(ctx, response)
+
+	// Delisted products keep their URL; suggest what to buy instead
+	if product.IsDelisted() {
+		if alternatives, err := uc.findAlternatives(ctx, product, defaultAlternativesLimit, viewerID); err == nil {
+			response.Alternatives = alternatives
+		}
+	}
 
-	return uc.toProductResponse(product), nil
+	uc.addRestockEstimates(ctx, []*ProductResponse{response})
+	uc.localize(ctx, locale, append([]*ProductResponse{response}, response.Alternatives...))
+
+	return response, nil
 }
 
+// productNoLongerAvailableMessage is shown on the page of a discontinued or archived product
+const productNoLongerAvailableMessage = "This product is no longer available. See the alternatives below."
+
+// defaultAlternativesLimit is the number of alternatives shown on the page of a delisted product
+const defaultAlternativesLimit = 4
+
 // UpdateProduct updates a product with improved business logic
 func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req UpdateProductRequest) (*ProductResponse, error) {
 	// Get existing product
@@ -536,6 +715,8 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 
 	// Track what needs to be updated
 	hasChanges := false
+	priceBefore := entities.NewPriceSnapshot(product)
+	contentBefore := product.TranslatableContent()
 
 	// Update basic fields only if they are provided
 	if req.Name != nil {
@@ -588,6 +769,14 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 		hasChanges = true
 	}
 
+	if req.MinAdvertisedPrice != nil {
+		if *req.MinAdvertisedPrice <= 0 {
+			return nil, fmt.Errorf("minimum advertised price must be greater than 0")
+		}
+		product.MinAdvertisedPrice = req.MinAdvertisedPrice
+		hasChanges = true
+	}
+
 	if req.Stock != nil {
 		if *req.Stock < 0 {
 			return nil, fmt.Errorf("stock cannot be negative")
@@ -622,7 +811,9 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 	}
 
 	if req.Status != nil {
-		product.Status = *req.Status
+		if err := uc.changeProductStatus(ctx, product, *req.Status); err != nil {
+			return nil, err
+		}
 		hasChanges = true
 	}
 
@@ -728,6 +919,13 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 		}
 	}
 
+	// Enforce MAP floor on any price edit
+	if hasChanges && (req.Price != nil || req.SalePrice != nil || req.MinAdvertisedPrice != nil) {
+		if err := product.ValidateMinAdvertisedPrice(); err != nil {
+			return nil, pkgErrors.InvalidInput(err.Error())
+		}
+	}
+
 	// Handle Inventory Management
 	if req.LowStockThreshold != nil {
 		if *req.LowStockThreshold < 0 {
@@ -747,6 +945,22 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 		hasChanges = true
 	}
 
+	if req.StockVisibility != nil {
+		if !entities.IsValidStockVisibility(*req.StockVisibility) {
+			return nil, pkgErrors.InvalidInput("invalid stock visibility")
+		}
+		product.StockVisibility = *req.StockVisibility
+		hasChanges = true
+	}
+
+	if req.StockDisplayThreshold != nil {
+		if *req.StockDisplayThreshold < 0 {
+			return nil, pkgErrors.InvalidInput("stock display threshold cannot be negative")
+		}
+		product.StockDisplayThreshold = req.StockDisplayThreshold
+		hasChanges = true
+	}
+
 	// Handle Shipping and Tax
 	if req.RequiresShipping != nil {
 		product.RequiresShipping = *req.RequiresShipping
@@ -805,9 +1019,10 @@ func (uc *productUseCase) UpdateProduct(ctx context.Context, id uuid.UUID, req U
 	// Only update product if there were actual changes to basic fields
 	if hasChanges {
 		product.UpdatedAt = time.Now()
-		if err := uc.productRepo.Update(ctx, product); err != nil {
+		if err := uc.saveEdit(ctx, product, priceBefore, req.UpdatedBy, req.PriceChangeReason); err != nil {
 			return nil, fmt.Errorf("failed to update product: %w", err)
 		}
+		uc.flagOutdatedTranslations(ctx, product, contentBefore)
 	}
 
 	// Return updated product with fresh data - force fresh reload from database
@@ -829,6 +1044,8 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 	}
 
 	var hasChanges bool
+	priceBefore := entities.NewPriceSnapshot(product)
+	contentBefore := product.TranslatableContent()
 
 	// Basic field updates - only if provided
 	if req.Name != nil {
@@ -868,6 +1085,14 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 		hasChanges = true
 	}
 
+	if req.MinAdvertisedPrice != nil {
+		if *req.MinAdvertisedPrice <= 0 {
+			return nil, fmt.Errorf("minimum advertised price must be greater than 0")
+		}
+		product.MinAdvertisedPrice = req.MinAdvertisedPrice
+		hasChanges = true
+	}
+
 	if req.Stock != nil {
 		if *req.Stock < 0 {
 			return nil, fmt.Errorf("stock cannot be negative")
@@ -902,7 +1127,9 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 	}
 
 	if req.Status != nil {
-		product.Status = *req.Status
+		if err := uc.changeProductStatus(ctx, product, *req.Status); err != nil {
+			return nil, err
+		}
 		hasChanges = true
 	}
 
@@ -985,6 +1212,13 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 		}
 	}
 
+	// Enforce MAP floor on any price edit
+	if hasChanges && (req.Price != nil || req.SalePrice != nil || req.MinAdvertisedPrice != nil) {
+		if err := product.ValidateMinAdvertisedPrice(); err != nil {
+			return nil, pkgErrors.InvalidInput(err.Error())
+		}
+	}
+
 	// Handle Inventory Management
 	if req.LowStockThreshold != nil {
 		if *req.LowStockThreshold < 0 {
@@ -1004,6 +1238,22 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 		hasChanges = true
 	}
 
+	if req.StockVisibility != nil {
+		if !entities.IsValidStockVisibility(*req.StockVisibility) {
+			return nil, pkgErrors.InvalidInput("invalid stock visibility")
+		}
+		product.StockVisibility = *req.StockVisibility
+		hasChanges = true
+	}
+
+	if req.StockDisplayThreshold != nil {
+		if *req.StockDisplayThreshold < 0 {
+			return nil, pkgErrors.InvalidInput("stock display threshold cannot be negative")
+		}
+		product.StockDisplayThreshold = req.StockDisplayThreshold
+		hasChanges = true
+	}
+
 	// Handle Shipping and Tax
 	if req.RequiresShipping != nil {
 		product.RequiresShipping = *req.RequiresShipping
@@ -1072,9 +1322,10 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 	// Only update product if there were actual changes
 	if hasChanges {
 		product.UpdatedAt = time.Now()
-		if err := uc.productRepo.Update(ctx, product); err != nil {
+		if err := uc.saveEdit(ctx, product, priceBefore, req.UpdatedBy, req.PriceChangeReason); err != nil {
 			return nil, fmt.Errorf("failed to update product: %w", err)
 		}
+		uc.flagOutdatedTranslations(ctx, product, contentBefore)
 	}
 
 	// Return updated product with fresh data
@@ -1086,6 +1337,211 @@ func (uc *productUseCase) PatchProduct(ctx context.Context, id uuid.UUID, req Pa
 	return uc.toProductResponse(updatedProduct), nil
 }
 
+// saveEdit saves a manual edit of a product. When the edit changed its pricing, the price
+// history entry is written in the same transaction, as lowest prior price claims rely on it.
+func (uc *productUseCase) saveEdit(ctx context.Context, product *entities.Product, before entities.PriceSnapshot, changedBy *uuid.UUID, reason string) error {
+	after := entities.NewPriceSnapshot(product)
+	if uc.priceHistoryRepo == nil || before.Equal(after) {
+		return uc.productRepo.Update(ctx, product)
+	}
+	entry := entities.NewPriceHistory(product.ID, before, after, changedBy, entities.PriceChangeSourceManual, reason)
+	return uc.productRepo.UpdateWithPriceHistory(ctx, product, entry)
+}
+
+// flagOutdatedTranslations marks the product's translations as outdated when an edit changed its source content
+func (uc *productUseCase) flagOutdatedTranslations(ctx context.Context, product *entities.Product, before entities.ProductContent) {
+	if uc.localizer == nil || product.TranslatableContent() == before {
+		return
+	}
+	if err := uc.localizer.MarkSourceChanged(ctx, product.ID); err != nil {
+		fmt.Printf("❌ Failed to flag outdated translations for product %s: %v\n", product.ID, err)
+	}
+}
+
+// GetPriceAvailability gets the price and stock data of a batch of products. Products that are
+// drafts, inactive, private or restricted away from the viewer are reported as not found.
+func (uc *productUseCase) GetPriceAvailability(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) (*PriceAvailabilityResponse, error) {
+	if len(ids) == 0 {
+		return nil, pkgErrors.InvalidInput("at least one product ID is required")
+	}
+
+	// Keep the request order, without duplicates
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) > MaxPriceAvailabilityBatch {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("at most %d product IDs are allowed", MaxPriceAvailabilityBatch))
+	}
+
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[uuid.UUID]bool, len(hiddenIDs))
+	for _, id := range hiddenIDs {
+		hidden[id] = true
+	}
+
+	products, err := uc.productRepo.GetPriceAndStockByIDs(ctx, unique)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	byID := make(map[uuid.UUID]*entities.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+
+	now := time.Now()
+	response := &PriceAvailabilityResponse{
+		Products: make([]*ProductPriceAvailability, 0, len(unique)),
+		NotFound: []uuid.UUID{},
+	}
+	for _, id := range unique {
+		product, exists := byID[id]
+		if !exists || hidden[id] || !isPubliclyListed(product) {
+			response.NotFound = append(response.NotFound, id)
+			continue
+		}
+
+		stock := product.DiscloseStock()
+		response.Products = append(response.Products, &ProductPriceAvailability{
+			ProductID:          product.ID,
+			Price:              product.Price,
+			ComparePrice:       product.ComparePrice,
+			SalePrice:          product.SalePrice,
+			SaleStartDate:      product.SaleStartDate,
+			SaleEndDate:        product.SaleEndDate,
+			CurrentPrice:       product.GetCurrentPrice(),
+			IsOnSale:           product.IsOnSale(),
+			DiscountPercentage: product.GetDiscountPercentage(),
+			StockStatus:        disclosedStockStatus(product.StockStatus, stock),
+			IsAvailable:        product.IsAvailable(),
+			IsLowStock:         stock.IsLowStock,
+			StockMessage:       stock.Message,
+			AllowBackorder:     product.AllowBackorder,
+			NoLongerAvailable:  product.IsDelisted(),
+			UpdatedAt:          product.UpdatedAt,
+		})
+
+		if product.SalePrice == nil {
+			continue
+		}
+		for _, boundary := range []*time.Time{product.SaleStartDate, product.SaleEndDate} {
+			if boundary != nil && boundary.After(now) && (response.NextChangeAt == nil || boundary.Before(*response.NextChangeAt)) {
+				next := *boundary
+				response.NextChangeAt = &next
+			}
+		}
+	}
+
+	// Tell shoppers when out-of-stock products are expected back
+	var outOfStockIDs []uuid.UUID
+	for _, product := range response.Products {
+		if !product.IsAvailable {
+			outOfStockIDs = append(outOfStockIDs, product.ProductID)
+		}
+	}
+	estimates := uc.restockEstimates(ctx, outOfStockIDs)
+	for _, product := range response.Products {
+		if estimate := estimates[product.ProductID]; estimate != nil {
+			product.RestockETA = &estimate.ExpectedAt
+			product.RestockMessage = estimate.Message()
+		}
+	}
+
+	return response, nil
+}
+
+// isPubliclyListed checks if a product's page is open to shoppers: active or delisted, and not private
+func isPubliclyListed(product *entities.Product) bool {
+	if product.Visibility == entities.ProductVisibilityPrivate {
+		return false
+	}
+	return product.Status == entities.ProductStatusActive || product.IsDelisted()
+}
+
+// preview applies the unpublished catalog changeset a preview token belongs to
+func (uc *productUseCase) preview(ctx context.Context, token string, products []*entities.Product) error {
+	if token == "" || uc.previewer == nil {
+		return nil
+	}
+	return uc.previewer.PreviewProducts(ctx, token, products)
+}
+
+// localize serves product content in the reader's locale; failures keep the source content
+func (uc *productUseCase) localize(ctx context.Context, locale string, products []*ProductResponse) {
+	if uc.localizer == nil {
+		return
+	}
+	if err := uc.localizer.LocalizeProducts(ctx, locale, products); err != nil {
+		fmt.Printf("❌ Failed to localize products: %v\n", err)
+	}
+}
+
+// addRestockEstimates tells shoppers when out-of-stock products are expected back in stock
+func (uc *productUseCase) addRestockEstimates(ctx context.Context, products []*ProductResponse) {
+	var productIDs []uuid.UUID
+	for _, product := range products {
+		if product.StockStatus == entities.StockStatusOutOfStock || product.StockStatus == entities.StockStatusOnBackorder {
+			productIDs = append(productIDs, product.ID)
+		}
+	}
+
+	estimates := uc.restockEstimates(ctx, productIDs)
+	for _, product := range products {
+		if estimate := estimates[product.ID]; estimate != nil {
+			product.RestockETA = &estimate.ExpectedAt
+			product.RestockMessage = estimate.Message()
+		}
+	}
+}
+
+// restockEstimates looks up restock estimates, leaving them out when they can't be worked out
+func (uc *productUseCase) restockEstimates(ctx context.Context, productIDs []uuid.UUID) map[uuid.UUID]*entities.RestockEstimate {
+	if uc.restockEstimator == nil || len(productIDs) == 0 {
+		return nil
+	}
+	estimates, err := uc.restockEstimator.EstimateRestock(ctx, productIDs)
+	if err != nil {
+		fmt.Printf("❌ Failed to estimate restock dates: %v\n", err)
+		return nil
+	}
+	return estimates
+}
+
+// restrictStock cuts the stock a response shows down to what the product's stock visibility lets
+// customers see, so competitors can't read stock levels off the storefront. Staff see exact stock.
+func restrictStock(ctx context.Context, response *ProductResponse, product *entities.Product) {
+	disclosure := product.DiscloseStock()
+	response.StockMessage = disclosure.Message
+	if entities.ActorFromContext(ctx).IsStaff() {
+		return
+	}
+
+	response.Stock = disclosure.Quantity
+	response.IsLowStock = disclosure.IsLowStock
+	response.StockStatus = disclosedStockStatus(response.StockStatus, disclosure)
+	for i, variant := range response.Variants {
+		if variant.Stock != nil {
+			response.Variants[i].Stock = entities.DiscloseStock(product.StockVisibility, *variant.Stock,
+				product.GetStockDisplayThreshold(), product.TrackQuantity).Quantity
+		}
+	}
+}
+
+// disclosedStockStatus keeps a low stock status only when customers are told the stock is low
+func disclosedStockStatus(status entities.StockStatus, disclosure entities.StockDisclosure) entities.StockStatus {
+	if status == entities.StockStatusLowStock && !disclosure.IsLowStock {
+		return entities.StockStatusInStock
+	}
+	return status
+}
+
 // replaceProductImages completely replaces all product images with new ones
 func (uc *productUseCase) replaceProductImages(ctx context.Context, productID uuid.UUID, images []ProductImageRequest) error {
 	fmt.Printf("DEBUG: replaceProductImages called for productID: %s with %d new images\n", productID.String(), len(images))
@@ -1125,7 +1581,7 @@ func (uc *productUseCase) replaceProductImages(ctx context.Context, productID uu
 		var newImages []*entities.ProductImage
 		for i, imgReq := range images {
 			image := &entities.ProductImage{
-				ID:        uuid.New(),
+				ID:        ids.New(),
 				ProductID: productID,
 				URL:       imgReq.URL,
 				AltText:   imgReq.AltText,
@@ -1226,23 +1682,48 @@ func (uc *productUseCase) DeleteProduct(ctx context.Context, id uuid.UUID) error
 
 // GetProducts gets list of products with pagination
 func (uc *productUseCase) GetProducts(ctx context.Context, req GetProductsRequest) (*GetProductsResponse, error) {
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := repositories.ProductSearchParams{
+		SortBy:     "created_at",
+		SortOrder:  "desc",
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		ExcludeIDs: hiddenIDs,
+	}
+
 	// Get total count
-	total, err := uc.productRepo.Count(ctx)
+	total, err := uc.productRepo.SearchCount(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get products
-	products, err := uc.productRepo.List(ctx, req.Limit, req.Offset)
+	products, err := uc.productRepo.Search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.preview(ctx, req.PreviewToken, products); err != nil {
+		return nil, err
+	}
+
+	personalized := false
+	if req.Personalize {
+		products, personalized = uc.rankForViewer(ctx, req.ViewerID, products)
+	}
 
 	// Convert to responses
 	responses := make([]*ProductResponse, len(products))
 	for i, product := range products {
 		responses[i] = uc.toProductResponse(product)
+		restrictStock(ctx, responses[i], product)
+		applyStorefrontPrice(ctx, responses[i])
 	}
+	uc.addRestockEstimates(ctx, responses)
+	uc.localize(ctx, req.Locale, responses)
 
 	// Create pagination context
 	context := &EcommercePaginationContext{
@@ -1265,17 +1746,29 @@ func (uc *productUseCase) GetProducts(ctx context.Context, req GetProductsReques
 			"page":  pagination.Page,
 			"limit": pagination.Limit,
 		}
+		if req.Locale != "" {
+			cacheParams["locale"] = req.Locale
+		}
+		if req.PreviewToken != "" {
+			cacheParams["preview_token"] = req.PreviewToken
+		}
 		pagination.CacheKey = GenerateCacheKey("products", "", cacheParams)
 	}
 
 	return &GetProductsResponse{
-		Products:   responses,
-		Pagination: pagination,
+		Products:     responses,
+		Pagination:   pagination,
+		Personalized: personalized,
 	}, nil
 }
 
 // SearchProducts searches products (same as original)
 func (uc *productUseCase) SearchProducts(ctx context.Context, req SearchProductsRequest) ([]*ProductResponse, error) {
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repositories.ProductSearchParams{
 		Query:      req.Query,
 		CategoryID: req.CategoryID,
@@ -1287,6 +1780,7 @@ func (uc *productUseCase) SearchProducts(ctx context.Context, req SearchProducts
 		SortOrder:  req.SortOrder,
 		Limit:      req.Limit,
 		Offset:     req.Offset,
+		ExcludeIDs: hiddenIDs,
 	}
 
 	products, err := uc.productRepo.Search(ctx, params)
@@ -1297,11 +1791,34 @@ func (uc *productUseCase) SearchProducts(ctx context.Context, req SearchProducts
 	responses := make([]*ProductResponse, len(products))
 	for i, product := range products {
 		responses[i] = uc.toProductResponse(product)
+		restrictStock(ctx, responses[i], product)
+		applyStorefrontPrice(ctx, responses[i])
 	}
+	uc.addRestockEstimates(ctx, responses)
 
 	return responses, nil
 }
 
+// hiddenProductIDs returns the products hidden from the viewer by catalog visibility rules
+func (uc *productUseCase) hiddenProductIDs(ctx context.Context, viewerID *uuid.UUID) ([]uuid.UUID, error) {
+	if uc.visibilityPolicy == nil {
+		return nil, nil
+	}
+	return uc.visibilityPolicy.HiddenProductIDs(ctx, viewerID)
+}
+
+// rankForViewer orders a page of products for the viewer; ranking failures keep the original order
+func (uc *productUseCase) rankForViewer(ctx context.Context, viewerID *uuid.UUID, products []*entities.Product) ([]*entities.Product, bool) {
+	if uc.listingRanker == nil {
+		return products, false
+	}
+	ranked, err := uc.listingRanker.Rank(ctx, viewerID, products)
+	if err != nil {
+		return products, false
+	}
+	return ranked.Products, ranked.Personalized
+}
+
 // calculateDiscountPercentage calculates the discount percentage for a product
 // Uses the new unified discount logic from entity
 func (uc *productUseCase) calculateDiscountPercentage(product *entities.Product) float64 {
@@ -1310,6 +1827,11 @@ func (uc *productUseCase) calculateDiscountPercentage(product *entities.Product)
 
 // SearchProductsPaginated searches products with pagination info
 func (uc *productUseCase) SearchProductsPaginated(ctx context.Context, req SearchProductsRequest) (*GetProductsResponse, error) {
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repositories.ProductSearchParams{
 		Query:      req.Query,
 		CategoryID: req.CategoryID,
@@ -1321,6 +1843,7 @@ func (uc *productUseCase) SearchProductsPaginated(ctx context.Context, req Searc
 		SortOrder:  req.SortOrder,
 		Limit:      req.Limit,
 		Offset:     req.Offset,
+		ExcludeIDs: hiddenIDs,
 	}
 
 	// Get total count using the new SearchCount method
@@ -1335,10 +1858,18 @@ func (uc *productUseCase) SearchProductsPaginated(ctx context.Context, req Searc
 		return nil, err
 	}
 
+	personalized := false
+	if req.Personalize {
+		products, personalized = uc.rankForViewer(ctx, req.ViewerID, products)
+	}
+
 	responses := make([]*ProductResponse, len(products))
 	for i, product := range products {
 		responses[i] = uc.toProductResponse(product)
+		restrictStock(ctx, responses[i], product)
+		applyStorefrontPrice(ctx, responses[i])
 	}
+	uc.addRestockEstimates(ctx, responses)
 
 	// Create pagination context
 	context := &EcommercePaginationContext{
@@ -1369,21 +1900,37 @@ func (uc *productUseCase) SearchProductsPaginated(ctx context.Context, req Searc
 	}
 
 	return &GetProductsResponse{
-		Products:   responses,
-		Pagination: pagination,
+		Products:     responses,
+		Pagination:   pagination,
+		Personalized: personalized,
 	}, nil
 }
 
 // GetProductsByCategory gets products by category with pagination
-func (uc *productUseCase) GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int) (*GetProductsResponse, error) {
+func (uc *productUseCase) GetProductsByCategory(ctx context.Context, categoryID uuid.UUID, limit, offset int, viewerID *uuid.UUID) (*GetProductsResponse, error) {
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Search within the category and its subcategories
+	params := repositories.ProductSearchParams{
+		CategoryID: &categoryID,
+		SortBy:     "created_at",
+		SortOrder:  "desc",
+		Limit:      limit,
+		Offset:     offset,
+		ExcludeIDs: hiddenIDs,
+	}
+
 	// Get products
-	products, err := uc.productRepo.GetByCategory(ctx, categoryID, limit, offset)
+	products, err := uc.productRepo.Search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get total count for the category
-	total, err := uc.productRepo.CountByCategory(ctx, categoryID)
+	total, err := uc.productRepo.SearchCount(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1392,7 +1939,10 @@ func (uc *productUseCase) GetProductsByCategory(ctx context.Context, categoryID
 	responses := make([]*ProductResponse, len(products))
 	for i, product := range products {
 		responses[i] = uc.toProductResponse(product)
+		restrictStock(ctx, responses[i], product)
+		applyStorefrontPrice(ctx, responses[i])
 	}
+	uc.addRestockEstimates(ctx, responses)
 
 	// Create pagination context
 	context := &EcommercePaginationContext{
@@ -1457,6 +2007,9 @@ func (uc *productUseCase) toProductResponse(product *entities.Product) *ProductR
 		ComparePrice: product.ComparePrice,
 		CostPrice:    product.CostPrice,
 
+		// MAP floor
+		MinAdvertisedPrice: product.MinAdvertisedPrice,
+
 		// Sale Pricing
 		SalePrice:     product.SalePrice,
 		SaleStartDate: product.SaleStartDate,
@@ -1471,13 +2024,17 @@ func (uc *productUseCase) toProductResponse(product *entities.Product) *ProductR
 		DiscountPercentage:     product.GetDiscountPercentage(),
 
 		// Inventory
-		Stock:             product.Stock,
+		Stock:             &product.Stock,
 		LowStockThreshold: product.LowStockThreshold,
 		TrackQuantity:     product.TrackQuantity,
 		AllowBackorder:    product.AllowBackorder,
 		StockStatus:       product.StockStatus,
 		IsLowStock:        product.IsLowStock(),
 
+		// Stock visibility
+		StockVisibility:       product.StockVisibility,
+		StockDisplayThreshold: product.StockDisplayThreshold,
+
 		// Physical Properties
 		Weight: product.Weight,
 
@@ -1495,10 +2052,20 @@ func (uc *productUseCase) toProductResponse(product *entities.Product) *ProductR
 		HasVariants: product.HasVariants(),
 		MainImage:   product.GetMainImage(),
 
+		// Lifecycle
+		NoLongerAvailable:    product.IsDelisted(),
+		ReplacementProductID: product.ReplacementProductID,
+		DiscontinuedAt:       product.DiscontinuedAt,
+		ArchivedAt:           product.ArchivedAt,
+
 		CreatedAt: product.CreatedAt,
 		UpdatedAt: product.UpdatedAt,
 	}
 
+	if response.NoLongerAvailable {
+		response.AvailabilityMessage = productNoLongerAvailableMessage
+	}
+
 	if product.Dimensions != nil {
 		response.Dimensions = &DimensionsResponse{
 			Length: product.Dimensions.Length,
@@ -1576,7 +2143,7 @@ func (uc *productUseCase) toProductResponse(product *entities.Product) *ProductR
 			Price:        variant.Price,
 			ComparePrice: variant.ComparePrice,
 			CostPrice:    variant.CostPrice,
-			Stock:        variant.Stock,
+			Stock:        &variant.Stock,
 			Weight:       variant.Weight,
 			Image:        variant.Image,
 			Position:     variant.Position,
@@ -1649,6 +2216,25 @@ func (uc *productUseCase) GetSearchSuggestions(ctx context.Context, req SearchSu
 		return nil, err
 	}
 
+	// Drop product suggestions the viewer is not allowed to see
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(hiddenIDs) > 0 && suggestions != nil {
+		hidden := make(map[uuid.UUID]bool, len(hiddenIDs))
+		for _, id := range hiddenIDs {
+			hidden[id] = true
+		}
+		visible := suggestions.Products[:0]
+		for _, product := range suggestions.Products {
+			if !hidden[product.ID] {
+				visible = append(visible, product)
+			}
+		}
+		suggestions.Products = visible
+	}
+
 	return &SearchSuggestionsResponse{
 		Suggestions: suggestions,
 	}, nil
@@ -1716,7 +2302,7 @@ func (uc *productUseCase) createInitialInventory(ctx context.Context, product *e
 	// If no warehouses exist, create a default one
 	if defaultWarehouse == nil {
 		defaultWarehouse = &entities.Warehouse{
-			ID:          uuid.New(),
+			ID:          ids.New(),
 			Name:        "Main Warehouse",
 			Code:        "MAIN",
 			Description: "Default warehouse",
@@ -1736,7 +2322,7 @@ func (uc *productUseCase) createInitialInventory(ctx context.Context, product *e
 
 	// Create initial inventory record
 	inventory := &entities.Inventory{
-		ID:                uuid.New(),
+		ID:                ids.New(),
 		ProductID:         product.ID,
 		WarehouseID:       defaultWarehouse.ID,
 		QuantityOnHand:    product.Stock,
@@ -1754,11 +2340,12 @@ func (uc *productUseCase) createInitialInventory(ctx context.Context, product *e
 }
 
 // GetFeaturedProductsPaginated gets featured products with pagination
-func (uc *productUseCase) GetFeaturedProductsPaginated(ctx context.Context, page, limit int) (*FeaturedProductsPaginatedResponse, error) {
+func (uc *productUseCase) GetFeaturedProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*FeaturedProductsPaginatedResponse, error) {
 	// Get featured products using existing GetProducts method with featured filter
 	req := GetProductsRequest{
-		Limit:  limit * 10, // Get more to simulate featured products
-		Offset: 0,
+		Limit:    limit * 10, // Get more to simulate featured products
+		Offset:   0,
+		ViewerID: viewerID,
 	}
 
 	// Get all products and filter featured ones (in real implementation, this would be optimized)
@@ -1804,11 +2391,12 @@ func (uc *productUseCase) GetFeaturedProductsPaginated(ctx context.Context, page
 }
 
 // GetTrendingProductsPaginated gets trending products with pagination
-func (uc *productUseCase) GetTrendingProductsPaginated(ctx context.Context, page, limit int) (*TrendingProductsPaginatedResponse, error) {
+func (uc *productUseCase) GetTrendingProductsPaginated(ctx context.Context, page, limit int, viewerID *uuid.UUID) (*TrendingProductsPaginatedResponse, error) {
 	// Get trending products (in real implementation, this would be based on analytics)
 	req := GetProductsRequest{
-		Limit:  limit * 10, // Get more to simulate trending products
-		Offset: 0,
+		Limit:    limit * 10, // Get more to simulate trending products
+		Offset:   0,
+		ViewerID: viewerID,
 	}
 
 	// Get all products and sort by popularity (mock implementation)
@@ -1849,12 +2437,24 @@ func (uc *productUseCase) GetTrendingProductsPaginated(ctx context.Context, page
 }
 
 // GetRelatedProductsPaginated gets related products with pagination
-func (uc *productUseCase) GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int) (*RelatedProductsPaginatedResponse, error) {
+func (uc *productUseCase) GetRelatedProductsPaginated(ctx context.Context, productID uuid.UUID, page, limit int, viewerID *uuid.UUID) (*RelatedProductsPaginatedResponse, error) {
+	// Related products are not offered for a product the viewer cannot see
+	if uc.visibilityPolicy != nil {
+		visible, err := uc.visibilityPolicy.CanViewProduct(ctx, viewerID, productID)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, entities.ErrProductNotFound
+		}
+	}
+
 	// Get all products and filter related ones (in real implementation, this would be optimized)
 	// Note: Product.CategoryID removed - related products logic simplified
 	req := GetProductsRequest{
-		Limit:  limit * 10, // Get more to simulate related products
-		Offset: 0,
+		Limit:    limit * 10, // Get more to simulate related products
+		Offset:   0,
+		ViewerID: viewerID,
 	}
 
 	allProductsResponse, err := uc.GetProducts(ctx, req)
@@ -1900,3 +2500,150 @@ func (uc *productUseCase) GetRelatedProductsPaginated(ctx context.Context, produ
 		ProductID:  productID,
 	}, nil
 }
+
+// ChangeProductLifecycle moves a product to another lifecycle status, optionally naming a replacement
+func (uc *productUseCase) ChangeProductLifecycle(ctx context.Context, id uuid.UUID, req ChangeProductLifecycleRequest) (*ProductResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	if req.ReplacementProductID != nil {
+		if req.Status != entities.ProductStatusDiscontinued && req.Status != entities.ProductStatusArchived {
+			return nil, pkgErrors.InvalidInput("replacement product can only be set when discontinuing or archiving a product")
+		}
+		if *req.ReplacementProductID == product.ID {
+			return nil, pkgErrors.InvalidInput("product cannot replace itself")
+		}
+		replacement, err := uc.productRepo.GetByID(ctx, *req.ReplacementProductID)
+		if err != nil {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeNotFound, "Replacement product not found")
+		}
+		if !replacement.IsVisible() {
+			return nil, pkgErrors.InvalidInput("replacement product must be active and visible")
+		}
+	}
+
+	if err := uc.changeProductStatus(ctx, product, req.Status); err != nil {
+		return nil, err
+	}
+	if req.ReplacementProductID != nil {
+		product.ReplacementProductID = req.ReplacementProductID
+	}
+
+	product.UpdatedAt = time.Now()
+	if err := uc.productRepo.Update(ctx, product); err != nil {
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	updatedProduct, err := uc.productRepo.GetByID(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toProductResponse(updatedProduct), nil
+}
+
+// GetProductAlternatives gets products to suggest instead of the given one
+func (uc *productUseCase) GetProductAlternatives(ctx context.Context, id uuid.UUID, limit int, viewerID *uuid.UUID) ([]*ProductResponse, error) {
+	if limit <= 0 || limit > 20 {
+		limit = defaultAlternativesLimit
+	}
+
+	product, err := uc.productRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	if uc.visibilityPolicy != nil {
+		visible, err := uc.visibilityPolicy.CanViewProduct(ctx, viewerID, id)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, entities.ErrProductNotFound
+		}
+	}
+
+	return uc.findAlternatives(ctx, product, limit, viewerID)
+}
+
+// changeProductStatus validates and applies a lifecycle status change. A product cannot be
+// archived while orders containing it are still waiting to ship.
+func (uc *productUseCase) changeProductStatus(ctx context.Context, product *entities.Product, status entities.ProductStatus) error {
+	if product.Status == status {
+		return nil
+	}
+	if !entities.IsValidProductStatus(status) {
+		return pkgErrors.InvalidInput(fmt.Sprintf("invalid product status: %s", status))
+	}
+	if !product.CanTransitionTo(status) {
+		return pkgErrors.InvalidInput(fmt.Sprintf("cannot change product status from %s to %s", product.Status, status))
+	}
+
+	if status == entities.ProductStatusArchived && uc.orderRepo != nil {
+		openOrders, err := uc.orderRepo.CountPendingFulfillmentByProduct(ctx, product.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count open orders: %w", err)
+		}
+		if openOrders > 0 {
+			return pkgErrors.New(pkgErrors.ErrCodeConflict,
+				fmt.Sprintf("Product has %d orders pending fulfillment and cannot be archived", openOrders))
+		}
+	}
+
+	// Vendors can only publish once their application is approved
+	if status == entities.ProductStatusActive && product.VendorID != nil && uc.vendorRepo != nil {
+		vendor, err := uc.vendorRepo.GetByID(ctx, *product.VendorID)
+		if err != nil {
+			return fmt.Errorf("failed to get product vendor: %w", err)
+		}
+		if !vendor.IsActive() {
+			return pkgErrors.New(pkgErrors.ErrCodeConflict,
+				fmt.Sprintf("Product cannot be published while its vendor is %s", vendor.Status))
+		}
+	}
+
+	return product.TransitionTo(status, time.Now())
+}
+
+// findAlternatives returns the replacement product, if any, followed by similar visible products
+func (uc *productUseCase) findAlternatives(ctx context.Context, product *entities.Product, limit int, viewerID *uuid.UUID) ([]*ProductResponse, error) {
+	hiddenIDs, err := uc.hiddenProductIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[uuid.UUID]bool, len(hiddenIDs))
+	for _, id := range hiddenIDs {
+		hidden[id] = true
+	}
+
+	candidates := make([]*entities.Product, 0, limit+1)
+	if product.ReplacementProductID != nil {
+		if replacement, err := uc.productRepo.GetByID(ctx, *product.ReplacementProductID); err == nil && replacement.IsVisible() {
+			candidates = append(candidates, replacement)
+		}
+	}
+
+	similar, err := uc.productRepo.GetAlternatives(ctx, product, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alternative products: %w", err)
+	}
+	candidates = append(candidates, similar...)
+
+	alternatives := make([]*ProductResponse, 0, limit)
+	seen := make(map[uuid.UUID]bool, len(candidates))
+	for _, candidate := range candidates {
+		if len(alternatives) == limit {
+			break
+		}
+		if seen[candidate.ID] || hidden[candidate.ID] {
+			continue
+		}
+		seen[candidate.ID] = true
+		alternative := uc.toProductResponse(candidate)
+		restrictStock(ctx, alternative, candidate)
+		applyStorefrontPrice(ctx, alternative)
+		alternatives = append(alternatives, alternative)
+	}
+	return alternatives, nil
+}
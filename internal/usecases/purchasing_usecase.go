@@ -0,0 +1,456 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// RestockEstimator works out when out-of-stock products are expected back in stock.
+// It is implemented by the purchasing use case and consulted by the product read path and by
+// back in stock notifications.
+type RestockEstimator interface {
+	// EstimateRestock returns the estimate of each product that has stock on an open purchase
+	// order. Purchase orders overdue for delivery give no estimate.
+	EstimateRestock(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]*entities.RestockEstimate, error)
+}
+
+// PurchasingUseCase defines use cases for suppliers and the purchase orders that restock products
+type PurchasingUseCase interface {
+	RestockEstimator
+
+	// Suppliers
+	CreateSupplier(ctx context.Context, req CreateSupplierRequest) (*entities.Supplier, error)
+	UpdateSupplier(ctx context.Context, id uuid.UUID, req UpdateSupplierRequest) (*entities.Supplier, error)
+	ListSuppliers(ctx context.Context, req ListSuppliersRequest) (*SuppliersListResponse, error)
+
+	// Purchase orders
+	CreatePurchaseOrder(ctx context.Context, req CreatePurchaseOrderRequest) (*entities.PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, req ListPurchaseOrdersRequest) (*PurchaseOrdersListResponse, error)
+	// UpdateExpectedDate records the delivery date the supplier confirmed or moved
+	UpdateExpectedDate(ctx context.Context, id uuid.UUID, req UpdatePurchaseOrderExpectedDateRequest) (*entities.PurchaseOrder, error)
+	// ReceivePurchaseOrder adds the received units to stock. Without items every outstanding unit
+	// is received.
+	ReceivePurchaseOrder(ctx context.Context, id uuid.UUID, req ReceivePurchaseOrderRequest) (*entities.PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error)
+}
+
+type purchasingUseCase struct {
+	supplierRepo      repositories.SupplierRepository
+	purchaseOrderRepo repositories.PurchaseOrderRepository
+	productRepo       repositories.ProductRepository
+	clock             Clock
+}
+
+// NewPurchasingUseCase creates a new purchasing use case
+func NewPurchasingUseCase(
+	supplierRepo repositories.SupplierRepository,
+	purchaseOrderRepo repositories.PurchaseOrderRepository,
+	productRepo repositories.ProductRepository,
+	clock Clock,
+) PurchasingUseCase {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &purchasingUseCase{
+		supplierRepo:      supplierRepo,
+		purchaseOrderRepo: purchaseOrderRepo,
+		productRepo:       productRepo,
+		clock:             clock,
+	}
+}
+
+// CreateSupplierRequest represents a request to create a supplier
+type CreateSupplierRequest struct {
+	Code          string `json:"code" validate:"required"`
+	Name          string `json:"name" validate:"required"`
+	ContactPerson string `json:"contact_person"`
+	Email         string `json:"email" validate:"omitempty,email"`
+	Phone         string `json:"phone"`
+	Country       string `json:"country"`
+	LeadTimeDays  *int   `json:"lead_time_days" validate:"omitempty,min=0"` // Days from ordering to delivery; 7 when unset
+	IsPreferred   bool   `json:"is_preferred"`
+}
+
+// UpdateSupplierRequest represents a request to update a supplier
+type UpdateSupplierRequest struct {
+	Name          *string `json:"name"`
+	ContactPerson *string `json:"contact_person"`
+	Email         *string `json:"email" validate:"omitempty,email"`
+	Phone         *string `json:"phone"`
+	LeadTimeDays  *int    `json:"lead_time_days" validate:"omitempty,min=0"`
+	IsActive      *bool   `json:"is_active"`
+	IsPreferred   *bool   `json:"is_preferred"`
+}
+
+// ListSuppliersRequest represents a request to list suppliers
+type ListSuppliersRequest struct {
+	ActiveOnly bool `json:"active_only"`
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+}
+
+// SuppliersListResponse represents a paginated list of suppliers
+type SuppliersListResponse struct {
+	Suppliers  []*entities.Supplier `json:"suppliers"`
+	Pagination *PaginationInfo      `json:"pagination"`
+}
+
+// CreatePurchaseOrderRequest represents a request to order stock from a supplier
+type CreatePurchaseOrderRequest struct {
+	SupplierID uuid.UUID                        `json:"supplier_id" validate:"required"`
+	OrderedAt  *time.Time                       `json:"ordered_at"`  // Now when unset
+	ExpectedAt *time.Time                       `json:"expected_at"` // The supplier's lead time applies when unset
+	Notes      string                           `json:"notes"`
+	Items      []CreatePurchaseOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+	CreatedBy  uuid.UUID                        `json:"-"`
+}
+
+// CreatePurchaseOrderItemRequest represents a product ordered on a purchase order
+type CreatePurchaseOrderItemRequest struct {
+	ProductID uuid.UUID `json:"product_id" validate:"required"`
+	Quantity  int       `json:"quantity" validate:"required,min=1"`
+	UnitCost  float64   `json:"unit_cost" validate:"min=0"`
+}
+
+// ListPurchaseOrdersRequest represents a request to list purchase orders
+type ListPurchaseOrdersRequest struct {
+	Status     *entities.PurchaseOrderStatus `json:"status"`
+	SupplierID *uuid.UUID                    `json:"supplier_id"`
+	ProductID  *uuid.UUID                    `json:"product_id"`
+	Page       int                           `json:"page"`
+	Limit      int                           `json:"limit"`
+}
+
+// PurchaseOrdersListResponse represents a paginated list of purchase orders
+type PurchaseOrdersListResponse struct {
+	PurchaseOrders []*entities.PurchaseOrder `json:"purchase_orders"`
+	Pagination     *PaginationInfo           `json:"pagination"`
+}
+
+// UpdatePurchaseOrderExpectedDateRequest represents a request to change the expected delivery date
+type UpdatePurchaseOrderExpectedDateRequest struct {
+	ExpectedAt *time.Time `json:"expected_at"` // Unset to fall back to the supplier's lead time
+}
+
+// ReceivePurchaseOrderRequest represents a delivery received on a purchase order
+type ReceivePurchaseOrderRequest struct {
+	Items      []ReceivePurchaseOrderItemRequest `json:"items" validate:"dive"`
+	ReceivedBy uuid.UUID                         `json:"-"`
+}
+
+// ReceivePurchaseOrderItemRequest represents the units received of a purchase order item
+type ReceivePurchaseOrderItemRequest struct {
+	ItemID   uuid.UUID `json:"item_id" validate:"required"`
+	Quantity int       `json:"quantity" validate:"required,min=1"`
+}
+
+// CreateSupplier creates a supplier
+func (uc *purchasingUseCase) CreateSupplier(ctx context.Context, req CreateSupplierRequest) (*entities.Supplier, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	exists, err := uc.supplierRepo.ExistsByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check supplier code: %w", err)
+	}
+	if exists {
+		return nil, entities.ErrSupplierExists
+	}
+
+	supplier := &entities.Supplier{
+		ID:            ids.New(),
+		Code:          code,
+		Name:          strings.TrimSpace(req.Name),
+		ContactPerson: req.ContactPerson,
+		Email:         req.Email,
+		Phone:         req.Phone,
+		Country:       req.Country,
+		LeadTimeDays:  7,
+		IsActive:      true,
+		IsPreferred:   req.IsPreferred,
+	}
+	if req.LeadTimeDays != nil {
+		supplier.LeadTimeDays = *req.LeadTimeDays
+	}
+	if supplier.Country == "" {
+		supplier.Country = "USA"
+	}
+	if err := supplier.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.supplierRepo.Create(ctx, supplier); err != nil {
+		return nil, fmt.Errorf("failed to create supplier: %w", err)
+	}
+	return supplier, nil
+}
+
+// UpdateSupplier updates a supplier
+func (uc *purchasingUseCase) UpdateSupplier(ctx context.Context, id uuid.UUID, req UpdateSupplierRequest) (*entities.Supplier, error) {
+	supplier, err := uc.supplierRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		supplier.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.ContactPerson != nil {
+		supplier.ContactPerson = *req.ContactPerson
+	}
+	if req.Email != nil {
+		supplier.Email = *req.Email
+	}
+	if req.Phone != nil {
+		supplier.Phone = *req.Phone
+	}
+	if req.LeadTimeDays != nil {
+		supplier.LeadTimeDays = *req.LeadTimeDays
+	}
+	if req.IsActive != nil {
+		supplier.IsActive = *req.IsActive
+	}
+	if req.IsPreferred != nil {
+		supplier.IsPreferred = *req.IsPreferred
+	}
+	if err := supplier.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.supplierRepo.Update(ctx, supplier); err != nil {
+		return nil, fmt.Errorf("failed to update supplier: %w", err)
+	}
+	return supplier, nil
+}
+
+// ListSuppliers lists suppliers by name
+func (uc *purchasingUseCase) ListSuppliers(ctx context.Context, req ListSuppliersRequest) (*SuppliersListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers, err := uc.supplierRepo.List(ctx, req.ActiveOnly, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suppliers: %w", err)
+	}
+	total, err := uc.supplierRepo.Count(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count suppliers: %w", err)
+	}
+
+	return &SuppliersListResponse{
+		Suppliers:  suppliers,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// CreatePurchaseOrder creates an open purchase order
+func (uc *purchasingUseCase) CreatePurchaseOrder(ctx context.Context, req CreatePurchaseOrderRequest) (*entities.PurchaseOrder, error) {
+	supplier, err := uc.supplierRepo.GetByID(ctx, req.SupplierID)
+	if err != nil {
+		return nil, err
+	}
+	if !supplier.IsActive {
+		return nil, pkgErrors.InvalidInput("Supplier is not active")
+	}
+
+	order := &entities.PurchaseOrder{
+		ID:         ids.New(),
+		Number:     generatePurchaseOrderNumber(),
+		SupplierID: supplier.ID,
+		Status:     entities.PurchaseOrderStatusOpen,
+		OrderedAt:  uc.clock.Now(),
+		ExpectedAt: req.ExpectedAt,
+		Notes:      req.Notes,
+		CreatedBy:  req.CreatedBy,
+	}
+	if req.OrderedAt != nil {
+		order.OrderedAt = *req.OrderedAt
+	}
+	for _, item := range req.Items {
+		if _, err := uc.productRepo.GetByID(ctx, item.ProductID); err != nil {
+			return nil, err
+		}
+		order.Items = append(order.Items, entities.PurchaseOrderItem{
+			ID:              ids.New(),
+			PurchaseOrderID: order.ID,
+			ProductID:       item.ProductID,
+			Quantity:        item.Quantity,
+			UnitCost:        item.UnitCost,
+		})
+	}
+	if err := order.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.purchaseOrderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to create purchase order: %w", err)
+	}
+	return uc.purchaseOrderRepo.GetByID(ctx, order.ID)
+}
+
+// GetPurchaseOrder gets a purchase order
+func (uc *purchasingUseCase) GetPurchaseOrder(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	return uc.purchaseOrderRepo.GetByID(ctx, id)
+}
+
+// ListPurchaseOrders lists purchase orders, newest first
+func (uc *purchasingUseCase) ListPurchaseOrders(ctx context.Context, req ListPurchaseOrdersRequest) (*PurchaseOrdersListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.PurchaseOrderFilters{
+		Status:     req.Status,
+		SupplierID: req.SupplierID,
+		ProductID:  req.ProductID,
+		Limit:      limit,
+		Offset:     (page - 1) * limit,
+	}
+
+	orders, err := uc.purchaseOrderRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purchase orders: %w", err)
+	}
+	total, err := uc.purchaseOrderRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count purchase orders: %w", err)
+	}
+
+	return &PurchaseOrdersListResponse{
+		PurchaseOrders: orders,
+		Pagination:     NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// UpdateExpectedDate changes the expected delivery date of an open purchase order
+func (uc *purchasingUseCase) UpdateExpectedDate(ctx context.Context, id uuid.UUID, req UpdatePurchaseOrderExpectedDateRequest) (*entities.PurchaseOrder, error) {
+	order, err := uc.purchaseOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !order.IsOpen() {
+		return nil, entities.ErrPurchaseOrderNotOpen
+	}
+	if req.ExpectedAt != nil && req.ExpectedAt.Before(order.OrderedAt) {
+		return nil, pkgErrors.InvalidInput("Expected date cannot be before the order date")
+	}
+
+	order.ExpectedAt = req.ExpectedAt
+	if err := uc.purchaseOrderRepo.UpdateExpectedAt(ctx, order.ID, req.ExpectedAt); err != nil {
+		return nil, fmt.Errorf("failed to update purchase order: %w", err)
+	}
+	return order, nil
+}
+
+// ReceivePurchaseOrder receives a delivery on an open purchase order
+func (uc *purchasingUseCase) ReceivePurchaseOrder(ctx context.Context, id uuid.UUID, req ReceivePurchaseOrderRequest) (*entities.PurchaseOrder, error) {
+	order, err := uc.purchaseOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !order.IsOpen() {
+		return nil, entities.ErrPurchaseOrderNotOpen
+	}
+
+	received := make(map[uuid.UUID]int)
+	if len(req.Items) == 0 {
+		for _, item := range order.Items {
+			received[item.ID] = item.RemainingQuantity()
+		}
+	}
+	for _, receipt := range req.Items {
+		item := findPurchaseOrderItem(order, receipt.ItemID)
+		if item == nil {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("Item %s is not on this purchase order", receipt.ItemID))
+		}
+		received[item.ID] += receipt.Quantity
+		if received[item.ID] > item.RemainingQuantity() {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("Only %d units of item %s are outstanding", item.RemainingQuantity(), item.ID))
+		}
+	}
+
+	if err := uc.purchaseOrderRepo.Receive(ctx, order, received, req.ReceivedBy); err != nil {
+		if err == entities.ErrPurchaseOrderNotOpen {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to receive purchase order: %w", err)
+	}
+	return uc.purchaseOrderRepo.GetByID(ctx, order.ID)
+}
+
+// CancelPurchaseOrder cancels an open purchase order. Units already received stay in stock.
+func (uc *purchasingUseCase) CancelPurchaseOrder(ctx context.Context, id uuid.UUID) (*entities.PurchaseOrder, error) {
+	updated, err := uc.purchaseOrderRepo.UpdateStatus(ctx, id, entities.PurchaseOrderStatusCancelled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel purchase order: %w", err)
+	}
+	order, err := uc.purchaseOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, entities.ErrPurchaseOrderNotOpen
+	}
+	return order, nil
+}
+
+// EstimateRestock picks the earliest expected arrival of each product's open purchase orders
+func (uc *purchasingUseCase) EstimateRestock(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]*entities.RestockEstimate, error) {
+	estimates := make(map[uuid.UUID]*entities.RestockEstimate)
+	if len(productIDs) == 0 {
+		return estimates, nil
+	}
+
+	items, err := uc.purchaseOrderRepo.GetOpenItemsByProducts(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open purchase orders: %w", err)
+	}
+
+	today := entities.SnapshotDay(uc.clock.Now())
+	for _, item := range items {
+		if item.PurchaseOrder == nil {
+			continue
+		}
+		arrival := entities.SnapshotDay(item.PurchaseOrder.ExpectedArrival())
+		// An overdue delivery says nothing reliable about when it arrives
+		if arrival.Before(today) {
+			continue
+		}
+		if current, ok := estimates[item.ProductID]; ok && !arrival.Before(current.ExpectedAt) {
+			continue
+		}
+		estimates[item.ProductID] = &entities.RestockEstimate{
+			ProductID:       item.ProductID,
+			ExpectedAt:      arrival,
+			Quantity:        item.RemainingQuantity(),
+			PurchaseOrderID: item.PurchaseOrderID,
+		}
+	}
+	return estimates, nil
+}
+
+// findPurchaseOrderItem finds an item of a purchase order by ID
+func findPurchaseOrderItem(order *entities.PurchaseOrder, itemID uuid.UUID) *entities.PurchaseOrderItem {
+	for i := range order.Items {
+		if order.Items[i].ID == itemID {
+			return &order.Items[i]
+		}
+	}
+	return nil
+}
+
+// generatePurchaseOrderNumber generates a purchase order number
+func generatePurchaseOrderNumber() string {
+	return fmt.Sprintf("PO-%s-%s", time.Now().Format("20060102"), strings.ToUpper(uuid.New().String()[:8]))
+}
@@ -0,0 +1,586 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// QuoteUseCase defines quote request (RFQ) use cases
+type QuoteUseCase interface {
+	// Customer operations
+	RequestQuote(ctx context.Context, userID uuid.UUID, req RequestQuoteRequest) (*QuoteResponse, error)
+	GetMyQuotes(ctx context.Context, userID uuid.UUID, req ListQuotesRequest) (*QuotesListResponse, error)
+	GetMyQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error)
+	GetMyQuotePDF(ctx context.Context, userID, quoteID uuid.UUID) ([]byte, string, error)
+	AcceptQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error)
+	RejectQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error)
+	CancelQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error)
+	ConvertQuoteToOrder(ctx context.Context, userID, quoteID uuid.UUID, req CreateOrderRequest) (*OrderResponse, error)
+
+	// Admin operations
+	ListQuotes(ctx context.Context, req ListQuotesRequest) (*QuotesListResponse, error)
+	GetQuote(ctx context.Context, quoteID uuid.UUID) (*QuoteResponse, error)
+	GetQuotePDF(ctx context.Context, quoteID uuid.UUID) ([]byte, string, error)
+	RespondToQuote(ctx context.Context, adminID, quoteID uuid.UUID, req RespondToQuoteRequest) (*QuoteResponse, error)
+	DeclineQuote(ctx context.Context, quoteID uuid.UUID, req DeclineQuoteRequest) (*QuoteResponse, error)
+
+	// ExpireQuotes marks quotes whose validity has lapsed as expired and returns how many were expired
+	ExpireQuotes(ctx context.Context) (int, error)
+}
+
+// QuoteNotificationService interface for quote notifications
+type QuoteNotificationService interface {
+	NotifyNewQuoteRequest(ctx context.Context, quote *entities.Quote) error
+	NotifyQuoteStatusChanged(ctx context.Context, quote *entities.Quote) error
+}
+
+type quoteUseCase struct {
+	quoteRepo           repositories.QuoteRepository
+	cartRepo            repositories.CartRepository
+	orderUseCase        OrderUseCase
+	companyPolicy       CompanyOrderPolicy
+	notificationService QuoteNotificationService
+}
+
+// NewQuoteUseCase creates a new quote use case
+func NewQuoteUseCase(
+	quoteRepo repositories.QuoteRepository,
+	cartRepo repositories.CartRepository,
+	orderUseCase OrderUseCase,
+	companyPolicy CompanyOrderPolicy,
+	notificationService QuoteNotificationService,
+) QuoteUseCase {
+	return &quoteUseCase{
+		quoteRepo:           quoteRepo,
+		cartRepo:            cartRepo,
+		orderUseCase:        orderUseCase,
+		companyPolicy:       companyPolicy,
+		notificationService: notificationService,
+	}
+}
+
+// RequestQuoteRequest represents a request to turn the cart into a quote request
+type RequestQuoteRequest struct {
+	Notes string `json:"notes" validate:"max=2000"`
+}
+
+// ListQuotesRequest represents a request to list quotes
+type ListQuotesRequest struct {
+	Status *entities.QuoteStatus `json:"status" form:"status"`
+	Page   int                   `json:"page" form:"page"`
+	Limit  int                   `json:"limit" form:"limit"`
+}
+
+// QuoteItemPriceRequest represents the quoted unit price for a quote item
+type QuoteItemPriceRequest struct {
+	ItemID uuid.UUID `json:"item_id" validate:"required"`
+	Price  float64   `json:"price" validate:"required,gt=0"`
+}
+
+// RespondToQuoteRequest represents an admin's pricing response to a quote
+type RespondToQuoteRequest struct {
+	Items      []QuoteItemPriceRequest `json:"items" validate:"required,min=1,dive"`
+	ExpiresAt  *time.Time              `json:"expires_at"` // Defaults to DefaultQuoteValidityDays from now
+	AdminNotes string                  `json:"admin_notes" validate:"max=2000"`
+}
+
+// DeclineQuoteRequest represents an admin declining to price a quote
+type DeclineQuoteRequest struct {
+	Reason string `json:"reason" validate:"max=2000"`
+}
+
+// QuoteItemResponse represents a quote item in responses
+type QuoteItemResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	ProductSKU  string    `json:"product_sku"`
+	Quantity    int       `json:"quantity"`
+	ListPrice   float64   `json:"list_price"`
+	QuotedPrice float64   `json:"quoted_price"`
+	Total       float64   `json:"total"`
+}
+
+// QuoteResponse represents a quote in responses
+type QuoteResponse struct {
+	ID             uuid.UUID            `json:"id"`
+	QuoteNumber    string               `json:"quote_number"`
+	UserID         uuid.UUID            `json:"user_id"`
+	CustomerName   string               `json:"customer_name,omitempty"`
+	CustomerEmail  string               `json:"customer_email,omitempty"`
+	CompanyID      *uuid.UUID           `json:"company_id,omitempty"`
+	Status         entities.QuoteStatus `json:"status"`
+	Items          []*QuoteItemResponse `json:"items"`
+	ListSubtotal   float64              `json:"list_subtotal"`
+	QuotedSubtotal float64              `json:"quoted_subtotal"`
+	Savings        float64              `json:"savings"`
+	Currency       string               `json:"currency"`
+	CustomerNotes  string               `json:"customer_notes,omitempty"`
+	AdminNotes     string               `json:"admin_notes,omitempty"`
+	RespondedAt    *time.Time           `json:"responded_at,omitempty"`
+	ExpiresAt      *time.Time           `json:"expires_at,omitempty"`
+	DecidedAt      *time.Time           `json:"decided_at,omitempty"`
+	OrderID        *uuid.UUID           `json:"order_id,omitempty"`
+	ConvertedAt    *time.Time           `json:"converted_at,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+// QuotesListResponse represents a paginated list of quotes
+type QuotesListResponse struct {
+	Quotes     []*QuoteResponse `json:"quotes"`
+	Pagination *PaginationInfo  `json:"pagination"`
+}
+
+// RequestQuote converts the user's cart into a quote request and empties the cart
+func (uc *quoteUseCase) RequestQuote(ctx context.Context, userID uuid.UUID, req RequestQuoteRequest) (*QuoteResponse, error) {
+	cart, err := uc.cartRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, pkgErrors.CartNotFound()
+	}
+	if cart.IsEmpty() {
+		return nil, pkgErrors.InvalidInput("Cart is empty")
+	}
+
+	for _, item := range cart.Items {
+		if !item.Product.IsAvailable() {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeProductNotAvailable, "Product not available").
+				WithContext("product_id", item.ProductID).
+				WithContext("product_name", item.Product.Name)
+		}
+	}
+
+	var companyID *uuid.UUID
+	if uc.companyPolicy != nil {
+		companyID = uc.companyPolicy.GetMemberCompanyID(ctx, userID)
+	}
+
+	quote := entities.NewQuoteFromCart(cart, userID, generateQuoteNumber(), companyID, strings.TrimSpace(req.Notes))
+	if err := uc.quoteRepo.Create(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to create quote: %w", err)
+	}
+
+	// The cart contents now live on the quote
+	if err := uc.cartRepo.ClearCart(ctx, cart.ID); err != nil {
+		fmt.Printf("❌ Failed to clear cart %s after quote %s was requested: %v\n", cart.ID, quote.QuoteNumber, err)
+	}
+
+	fmt.Printf("✅ Quote %s requested by user %s with %d items\n", quote.QuoteNumber, userID, len(quote.Items))
+
+	if uc.notificationService != nil {
+		go func(quote entities.Quote) {
+			if err := uc.notificationService.NotifyNewQuoteRequest(context.Background(), &quote); err != nil {
+				fmt.Printf("Failed to send new quote notification to admin: %v\n", err)
+			}
+		}(*quote)
+	}
+
+	return uc.toQuoteResponse(quote), nil
+}
+
+// GetMyQuotes lists the user's quotes
+func (uc *quoteUseCase) GetMyQuotes(ctx context.Context, userID uuid.UUID, req ListQuotesRequest) (*QuotesListResponse, error) {
+	return uc.listQuotes(ctx, &userID, req)
+}
+
+// GetMyQuote gets one of the user's quotes
+func (uc *quoteUseCase) GetMyQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toQuoteResponse(quote), nil
+}
+
+// GetMyQuotePDF renders one of the user's quotes as a PDF
+func (uc *quoteUseCase) GetMyQuotePDF(ctx context.Context, userID, quoteID uuid.UUID) ([]byte, string, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, "", err
+	}
+	return renderQuotePDF(quote), quotePDFFilename(quote), nil
+}
+
+// AcceptQuote accepts the quoted prices
+func (uc *quoteUseCase) AcceptQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := quote.Accept(); err != nil {
+		if err == entities.ErrQuoteExpired {
+			return nil, err
+		}
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, err.Error())
+	}
+
+	if err := uc.quoteRepo.Update(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to accept quote: %w", err)
+	}
+
+	uc.notifyStatusChanged(quote)
+	return uc.toQuoteResponse(quote), nil
+}
+
+// RejectQuote declines the quoted prices
+func (uc *quoteUseCase) RejectQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := quote.Reject(); err != nil {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, err.Error())
+	}
+
+	if err := uc.quoteRepo.Update(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to reject quote: %w", err)
+	}
+
+	return uc.toQuoteResponse(quote), nil
+}
+
+// CancelQuote withdraws a quote request that has not been accepted yet
+func (uc *quoteUseCase) CancelQuote(ctx context.Context, userID, quoteID uuid.UUID) (*QuoteResponse, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !quote.CanCancel() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, fmt.Sprintf("Quote cannot be cancelled in %s status", quote.Status))
+	}
+
+	if err := uc.quoteRepo.TransitionStatus(ctx, quote.ID, quote.Status, entities.QuoteStatusCancelled); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Quote was updated concurrently, please retry")
+		}
+		return nil, fmt.Errorf("failed to cancel quote: %w", err)
+	}
+	quote.Status = entities.QuoteStatusCancelled
+
+	return uc.toQuoteResponse(quote), nil
+}
+
+// ConvertQuoteToOrder places an order for an accepted quote at the quoted prices
+func (uc *quoteUseCase) ConvertQuoteToOrder(ctx context.Context, userID, quoteID uuid.UUID, req CreateOrderRequest) (*OrderResponse, error) {
+	quote, err := uc.getOwnedQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.Status == entities.QuoteStatusAccepted && quote.IsExpired() {
+		return nil, entities.ErrQuoteExpired
+	}
+	if !quote.CanConvert() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Only accepted quotes can be converted to an order")
+	}
+
+	// Claim the quote first so concurrent requests cannot place two orders for it
+	if err := uc.quoteRepo.TransitionStatus(ctx, quote.ID, entities.QuoteStatusAccepted, entities.QuoteStatusConverted); err != nil {
+		if err == entities.ErrConflict {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Quote has already been converted")
+		}
+		return nil, fmt.Errorf("failed to claim quote: %w", err)
+	}
+
+	order, err := uc.orderUseCase.CreateOrderFromQuote(ctx, userID, quote, req)
+	if err != nil {
+		if revertErr := uc.quoteRepo.TransitionStatus(ctx, quote.ID, entities.QuoteStatusConverted, entities.QuoteStatusAccepted); revertErr != nil {
+			fmt.Printf("❌ Failed to release quote %s after order creation failed: %v\n", quote.QuoteNumber, revertErr)
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	quote.Status = entities.QuoteStatusConverted
+	quote.OrderID = &order.ID
+	quote.ConvertedAt = &now
+	if err := uc.quoteRepo.Update(ctx, quote); err != nil {
+		fmt.Printf("❌ Failed to link quote %s to order %s: %v\n", quote.QuoteNumber, order.OrderNumber, err)
+	}
+
+	fmt.Printf("✅ Quote %s converted to order %s\n", quote.QuoteNumber, order.OrderNumber)
+
+	uc.notifyStatusChanged(quote)
+	return order, nil
+}
+
+// ListQuotes lists all quotes for admins
+func (uc *quoteUseCase) ListQuotes(ctx context.Context, req ListQuotesRequest) (*QuotesListResponse, error) {
+	return uc.listQuotes(ctx, nil, req)
+}
+
+// GetQuote gets a quote by ID
+func (uc *quoteUseCase) GetQuote(ctx context.Context, quoteID uuid.UUID) (*QuoteResponse, error) {
+	quote, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toQuoteResponse(quote), nil
+}
+
+// GetQuotePDF renders any quote as a PDF
+func (uc *quoteUseCase) GetQuotePDF(ctx context.Context, quoteID uuid.UUID) ([]byte, string, error) {
+	quote, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		return nil, "", err
+	}
+	return renderQuotePDF(quote), quotePDFFilename(quote), nil
+}
+
+// RespondToQuote prices a quote request with custom per-item pricing and an expiry
+func (uc *quoteUseCase) RespondToQuote(ctx context.Context, adminID, quoteID uuid.UUID, req RespondToQuoteRequest) (*QuoteResponse, error) {
+	quote, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uuid.UUID]float64, len(req.Items))
+	for _, item := range req.Items {
+		prices[item.ItemID] = item.Price
+	}
+	if len(prices) != len(quote.Items) {
+		return nil, pkgErrors.InvalidInput("A quoted price is required for every quote item")
+	}
+
+	expiresAt := time.Now().AddDate(0, 0, entities.DefaultQuoteValidityDays)
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	if err := quote.Respond(prices, expiresAt, strings.TrimSpace(req.AdminNotes), adminID); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.quoteRepo.Update(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to save quote response: %w", err)
+	}
+
+	fmt.Printf("✅ Quote %s priced at %.2f (list %.2f), valid until %s\n", quote.QuoteNumber, quote.QuotedSubtotal, quote.ListSubtotal, expiresAt.Format(time.RFC3339))
+
+	uc.notifyStatusChanged(quote)
+	return uc.toQuoteResponse(quote), nil
+}
+
+// DeclineQuote cancels a quote request the store will not price
+func (uc *quoteUseCase) DeclineQuote(ctx context.Context, quoteID uuid.UUID, req DeclineQuoteRequest) (*QuoteResponse, error) {
+	quote, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !quote.CanCancel() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, fmt.Sprintf("Quote cannot be declined in %s status", quote.Status))
+	}
+
+	quote.Status = entities.QuoteStatusCancelled
+	if reason := strings.TrimSpace(req.Reason); reason != "" {
+		quote.AdminNotes = reason
+	}
+	if err := uc.quoteRepo.Update(ctx, quote); err != nil {
+		return nil, fmt.Errorf("failed to decline quote: %w", err)
+	}
+
+	uc.notifyStatusChanged(quote)
+	return uc.toQuoteResponse(quote), nil
+}
+
+// ExpireQuotes marks quoted and accepted quotes past their expiry as expired
+func (uc *quoteUseCase) ExpireQuotes(ctx context.Context) (int, error) {
+	quotes, err := uc.quoteRepo.GetExpired(ctx, time.Now(), 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired quotes: %w", err)
+	}
+
+	expired := 0
+	for _, quote := range quotes {
+		if err := uc.quoteRepo.TransitionStatus(ctx, quote.ID, quote.Status, entities.QuoteStatusExpired); err != nil {
+			if err != entities.ErrConflict {
+				fmt.Printf("❌ Failed to expire quote %s: %v\n", quote.QuoteNumber, err)
+			}
+			continue
+		}
+		quote.Status = entities.QuoteStatusExpired
+		uc.notifyStatusChanged(quote)
+		expired++
+	}
+
+	if expired > 0 {
+		fmt.Printf("✅ Expired %d quotes\n", expired)
+	}
+	return expired, nil
+}
+
+// listQuotes lists quotes, optionally restricted to a single user
+func (uc *quoteUseCase) listQuotes(ctx context.Context, userID *uuid.UUID, req ListQuotesRequest) (*QuotesListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := repositories.QuoteFilters{
+		UserID: userID,
+		Status: req.Status,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}
+
+	quotes, err := uc.quoteRepo.List(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quotes: %w", err)
+	}
+
+	total, err := uc.quoteRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count quotes: %w", err)
+	}
+
+	responses := make([]*QuoteResponse, len(quotes))
+	for i, quote := range quotes {
+		responses[i] = uc.toQuoteResponse(quote)
+	}
+
+	return &QuotesListResponse{
+		Quotes:     responses,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// getOwnedQuote gets a quote and hides it from users other than its requester
+func (uc *quoteUseCase) getOwnedQuote(ctx context.Context, userID, quoteID uuid.UUID) (*entities.Quote, error) {
+	quote, err := uc.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.UserID != userID {
+		return nil, entities.ErrQuoteNotFound
+	}
+	return quote, nil
+}
+
+// notifyStatusChanged sends the customer a quote status notification in the background
+func (uc *quoteUseCase) notifyStatusChanged(quote *entities.Quote) {
+	if uc.notificationService == nil {
+		return
+	}
+	go func(quote entities.Quote) {
+		if err := uc.notificationService.NotifyQuoteStatusChanged(context.Background(), &quote); err != nil {
+			fmt.Printf("Failed to send quote status notification: %v\n", err)
+		}
+	}(*quote)
+}
+
+func (uc *quoteUseCase) toQuoteResponse(quote *entities.Quote) *QuoteResponse {
+	response := &QuoteResponse{
+		ID:             quote.ID,
+		QuoteNumber:    quote.QuoteNumber,
+		UserID:         quote.UserID,
+		CompanyID:      quote.CompanyID,
+		Status:         quote.Status,
+		Items:          make([]*QuoteItemResponse, len(quote.Items)),
+		ListSubtotal:   quote.ListSubtotal,
+		QuotedSubtotal: quote.QuotedSubtotal,
+		Currency:       quote.Currency,
+		CustomerNotes:  quote.CustomerNotes,
+		AdminNotes:     quote.AdminNotes,
+		RespondedAt:    quote.RespondedAt,
+		ExpiresAt:      quote.ExpiresAt,
+		DecidedAt:      quote.DecidedAt,
+		OrderID:        quote.OrderID,
+		ConvertedAt:    quote.ConvertedAt,
+		CreatedAt:      quote.CreatedAt,
+		UpdatedAt:      quote.UpdatedAt,
+	}
+
+	if quote.QuotedSubtotal > 0 {
+		response.Savings = quote.ListSubtotal - quote.QuotedSubtotal
+	}
+
+	if quote.User != nil {
+		response.CustomerName = strings.TrimSpace(quote.User.FirstName + " " + quote.User.LastName)
+		response.CustomerEmail = quote.User.Email
+	}
+
+	for i, item := range quote.Items {
+		response.Items[i] = &QuoteItemResponse{
+			ID:          item.ID,
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			ProductSKU:  item.ProductSKU,
+			Quantity:    item.Quantity,
+			ListPrice:   item.ListPrice,
+			QuotedPrice: item.QuotedPrice,
+			Total:       item.Total,
+		}
+	}
+
+	return response
+}
+
+// renderQuotePDF renders a quote as a printable PDF document
+func renderQuotePDF(quote *entities.Quote) []byte {
+	pdf := utils.NewTextPDF()
+	pdf.AddLine("QUOTE %s", quote.QuoteNumber)
+	pdf.AddLine("Status: %s", strings.ToUpper(string(quote.Status)))
+	pdf.AddLine("Requested: %s", quote.CreatedAt.Format("2006-01-02"))
+	if quote.ExpiresAt != nil {
+		pdf.AddLine("Valid until: %s", quote.ExpiresAt.Format("2006-01-02 15:04 MST"))
+	}
+	if quote.User != nil {
+		pdf.AddLine("Customer: %s %s <%s>", quote.User.FirstName, quote.User.LastName, quote.User.Email)
+	}
+	pdf.AddBlankLine()
+
+	pdf.AddLine("%-40s %-14s %5s %12s %12s %12s", "Product", "SKU", "Qty", "List", "Quoted", "Total")
+	pdf.AddLine(strings.Repeat("-", 100))
+	for _, item := range quote.Items {
+		name := item.ProductName
+		if len(name) > 40 {
+			name = name[:37] + "..."
+		}
+		quoted, total := "-", "-"
+		if item.QuotedPrice > 0 {
+			quoted = fmt.Sprintf("%.2f", item.QuotedPrice)
+			total = fmt.Sprintf("%.2f", item.Total)
+		}
+		pdf.AddLine("%-40s %-14s %5d %12.2f %12s %12s", name, item.ProductSKU, item.Quantity, item.ListPrice, quoted, total)
+	}
+	pdf.AddLine(strings.Repeat("-", 100))
+	pdf.AddLine("List subtotal: %.2f %s", quote.ListSubtotal, quote.Currency)
+	if quote.QuotedSubtotal > 0 {
+		pdf.AddLine("Quoted subtotal: %.2f %s", quote.QuotedSubtotal, quote.Currency)
+		pdf.AddLine("You save: %.2f %s", quote.ListSubtotal-quote.QuotedSubtotal, quote.Currency)
+	}
+	pdf.AddLine("Taxes and shipping are calculated when the quote is converted to an order.")
+
+	if quote.CustomerNotes != "" {
+		pdf.AddBlankLine()
+		pdf.AddLine("Customer notes: %s", quote.CustomerNotes)
+	}
+	if quote.AdminNotes != "" {
+		pdf.AddBlankLine()
+		pdf.AddLine("Notes: %s", quote.AdminNotes)
+	}
+
+	return pdf.Bytes()
+}
+
+func quotePDFFilename(quote *entities.Quote) string {
+	return fmt.Sprintf("quote-%s.pdf", quote.QuoteNumber)
+}
+
+// generateQuoteNumber generates a unique quote number
+func generateQuoteNumber() string {
+	return fmt.Sprintf("QT-%s-%s", time.Now().Format("20060102"), strings.ToUpper(uuid.New().String()[:8]))
+}
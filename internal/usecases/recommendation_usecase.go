@@ -14,6 +14,7 @@ type RecommendationUseCase struct {
 	recommendationRepo repositories.RecommendationRepository
 	productRepo        repositories.ProductRepository
 	userRepo           repositories.UserRepository
+	visibilityPolicy   CatalogVisibilityPolicy
 }
 
 // NewRecommendationUseCase creates a new recommendation use case
@@ -21,16 +22,105 @@ func NewRecommendationUseCase(
 	recommendationRepo repositories.RecommendationRepository,
 	productRepo repositories.ProductRepository,
 	userRepo repositories.UserRepository,
+	visibilityPolicy CatalogVisibilityPolicy,
 ) *RecommendationUseCase {
 	return &RecommendationUseCase{
 		recommendationRepo: recommendationRepo,
 		productRepo:        productRepo,
 		userRepo:           userRepo,
+		visibilityPolicy:   visibilityPolicy,
 	}
 }
 
 // GetRecommendations gets recommendations based on request
 func (uc *RecommendationUseCase) GetRecommendations(ctx context.Context, req *entities.RecommendationRequest) (*entities.RecommendationResponse, error) {
+	if uc.visibilityPolicy == nil {
+		response, err := uc.generateRecommendations(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.restrictStock(ctx, response.Products); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	// Recommendations are not offered for a product the viewer cannot see
+	if req.ProductID != nil {
+		visible, err := uc.visibilityPolicy.CanViewProduct(ctx, req.ViewerID, *req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, entities.ErrProductNotFound
+		}
+	}
+
+	response, err := uc.generateRecommendations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	hiddenIDs, err := uc.visibilityPolicy.HiddenProductIDs(ctx, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(hiddenIDs) > 0 {
+		hidden := make(map[uuid.UUID]bool, len(hiddenIDs))
+		for _, id := range hiddenIDs {
+			hidden[id] = true
+		}
+		visible := make([]entities.ProductListItem, 0, len(response.Products))
+		for _, product := range response.Products {
+			if !hidden[product.ID] {
+				visible = append(visible, product)
+			}
+		}
+		response.Products = visible
+		response.TotalCount = len(visible)
+	}
+
+	if err := uc.restrictStock(ctx, response.Products); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// restrictStock cuts the stock recommended products show down to what each product's stock
+// visibility lets customers see, as product responses do. Staff see exact stock.
+func (uc *RecommendationUseCase) restrictStock(ctx context.Context, products []entities.ProductListItem) error {
+	if len(products) == 0 || entities.ActorFromContext(ctx).IsStaff() {
+		return nil
+	}
+
+	productIDs := make([]uuid.UUID, len(products))
+	for i, product := range products {
+		productIDs[i] = product.ID
+	}
+	loaded, err := uc.productRepo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get recommended products: %w", err)
+	}
+	byID := make(map[uuid.UUID]*entities.Product, len(loaded))
+	for _, product := range loaded {
+		byID[product.ID] = product
+	}
+
+	for i := range products {
+		product := byID[products[i].ID]
+		if product == nil {
+			products[i].Stock = nil
+			continue
+		}
+		disclosure := product.DiscloseStock()
+		products[i].Stock = disclosure.Quantity
+		products[i].StockStatus = string(disclosedStockStatus(entities.StockStatus(products[i].StockStatus), disclosure))
+	}
+	return nil
+}
+
+// generateRecommendations dispatches to the algorithm for the requested recommendation type
+func (uc *RecommendationUseCase) generateRecommendations(ctx context.Context, req *entities.RecommendationRequest) (*entities.RecommendationResponse, error) {
 	switch req.Type {
 	case entities.RecommendationTypeRelated:
 		return uc.getRelatedProducts(ctx, req)
@@ -135,7 +225,7 @@ func (uc *RecommendationUseCase) getFrequentlyBoughtTogether(ctx context.Context
 // getPersonalizedRecommendations gets personalized recommendations for a user
 func (uc *RecommendationUseCase) getPersonalizedRecommendations(ctx context.Context, req *entities.RecommendationRequest) (*entities.RecommendationResponse, error) {
 	if req.UserID == nil {
-		return uc.getTrendingRecommendations(ctx, req) // Fallback to trending for anonymous users
+		return uc.getSessionRecommendations(ctx, req)
 	}
 
 	products, err := uc.recommendationRepo.GeneratePersonalizedRecommendations(ctx, *req.UserID, req.Limit)
@@ -153,6 +243,30 @@ func (uc *RecommendationUseCase) getPersonalizedRecommendations(ctx context.Cont
 	}, nil
 }
 
+// getSessionRecommendations personalizes recommendations for an anonymous visitor from their session's browsing
+func (uc *RecommendationUseCase) getSessionRecommendations(ctx context.Context, req *entities.RecommendationRequest) (*entities.RecommendationResponse, error) {
+	if req.SessionID == nil || *req.SessionID == "" {
+		return uc.getTrendingRecommendations(ctx, req) // Fallback to trending for anonymous users
+	}
+
+	products, err := uc.recommendationRepo.GenerateSessionRecommendations(ctx, *req.SessionID, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session recommendations: %w", err)
+	}
+	if len(products) == 0 {
+		return uc.getTrendingRecommendations(ctx, req) // Nothing browsed yet in this session
+	}
+
+	return &entities.RecommendationResponse{
+		Type:            entities.RecommendationTypePersonalized,
+		Products:        products,
+		Reason:          "Recommended for you based on what you have browsed",
+		ConfidenceScore: 0.75,
+		Algorithm:       "session_affinity",
+		TotalCount:      len(products),
+	}, nil
+}
+
 // getTrendingRecommendations gets trending products
 func (uc *RecommendationUseCase) getTrendingRecommendations(ctx context.Context, req *entities.RecommendationRequest) (*entities.RecommendationResponse, error) {
 	period := "weekly" // Default period
@@ -0,0 +1,126 @@
+package usecases
+
+import "strings"
+
+// defaultReportLocale labels report columns when neither the request, the admin's export profile
+// nor their language picks a supported locale
+const defaultReportLocale = "en"
+
+// reportHeaders are the column headers of report files by locale, keyed by column
+var reportHeaders = map[string]map[string]string{
+	"en": {
+		"order_number":       "Order number",
+		"created_at":         "Created at",
+		"status":             "Status",
+		"payment_status":     "Payment status",
+		"customer_email":     "Customer email",
+		"customer_id":        "Customer ID",
+		"subtotal":           "Subtotal",
+		"tax":                "Tax",
+		"shipping":           "Shipping",
+		"discount":           "Discount",
+		"total":              "Total",
+		"currency":           "Currency",
+		"sku":                "SKU",
+		"name":               "Name",
+		"units_sold":         "Units sold",
+		"revenue":            "Revenue",
+		"orders":             "Orders",
+		"email":              "Email",
+		"first_name":         "First name",
+		"last_name":          "Last name",
+		"role":               "Role",
+		"registered_at":      "Registered at",
+		"user_id":            "User ID",
+		"warehouse":          "Warehouse",
+		"quantity_on_hand":   "On hand",
+		"quantity_reserved":  "Reserved",
+		"quantity_available": "Available",
+		"reorder_level":      "Reorder level",
+		"payment_id":         "Payment ID",
+		"method":             "Method",
+		"gateway":            "Gateway",
+		"amount":             "Amount",
+		"date":               "Date",
+		"charge_count":       "Charges (count)",
+		"refund_count":       "Refunds (count)",
+		"payout_count":       "Payouts (count)",
+		"charges":            "Charges",
+		"refunds":            "Refunds",
+		"disputes":           "Disputes",
+		"adjustments":        "Adjustments",
+		"fees":               "Fees",
+		"net":                "Net",
+		"payouts":            "Payouts",
+		"matched_count":      "Matched",
+		"mismatch_count":     "Mismatched",
+		"missing_count":      "Missing",
+	},
+	"vi": {
+		"order_number":       "Mã đơn hàng",
+		"created_at":         "Thời gian tạo",
+		"status":             "Trạng thái",
+		"payment_status":     "Trạng thái thanh toán",
+		"customer_email":     "Email khách hàng",
+		"customer_id":        "Mã khách hàng",
+		"subtotal":           "Tạm tính",
+		"tax":                "Thuế",
+		"shipping":           "Phí vận chuyển",
+		"discount":           "Giảm giá",
+		"total":              "Tổng cộng",
+		"currency":           "Tiền tệ",
+		"sku":                "SKU",
+		"name":               "Tên",
+		"units_sold":         "Số lượng đã bán",
+		"revenue":            "Doanh thu",
+		"orders":             "Số đơn hàng",
+		"email":              "Email",
+		"first_name":         "Tên",
+		"last_name":          "Họ",
+		"role":               "Vai trò",
+		"registered_at":      "Thời gian đăng ký",
+		"user_id":            "Mã người dùng",
+		"warehouse":          "Kho",
+		"quantity_on_hand":   "Tồn kho",
+		"quantity_reserved":  "Đã giữ",
+		"quantity_available": "Có thể bán",
+		"reorder_level":      "Mức đặt hàng lại",
+		"payment_id":         "Mã thanh toán",
+		"method":             "Phương thức",
+		"gateway":            "Cổng thanh toán",
+		"amount":             "Số tiền",
+		"date":               "Ngày",
+		"charge_count":       "Số giao dịch thu",
+		"refund_count":       "Số giao dịch hoàn tiền",
+		"payout_count":       "Số lần chi trả",
+		"charges":            "Tiền thu",
+		"refunds":            "Tiền hoàn",
+		"disputes":           "Tranh chấp",
+		"adjustments":        "Điều chỉnh",
+		"fees":               "Phí",
+		"net":                "Thực nhận",
+		"payouts":            "Chi trả",
+		"matched_count":      "Khớp",
+		"mismatch_count":     "Không khớp",
+		"missing_count":      "Thiếu",
+	},
+}
+
+// normalizeReportLocale reduces a locale such as "vi-VN" to the language its headers are kept
+// under, and reports whether report headers exist for it
+func normalizeReportLocale(locale string) (string, bool) {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		locale = locale[:i]
+	}
+	_, ok := reportHeaders[locale]
+	return locale, ok
+}
+
+// reportHeader returns a column's header in the locale; columns without one keep their key
+func reportHeader(locale, column string) string {
+	if header, ok := reportHeaders[locale][column]; ok {
+		return header
+	}
+	return column
+}
@@ -0,0 +1,615 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ReportUseCase defines the interface for generating report files and handing them out through
+// signed, time-limited download URLs
+type ReportUseCase interface {
+	GenerateReport(ctx context.Context, req GenerateReportRequest) (*ReportResponse, error)
+	GetReports(ctx context.Context, req GetReportsRequest) (*ReportsListResponse, error)
+	GetReport(ctx context.Context, id uuid.UUID) (*ReportResponse, error)
+	// DeleteReport deletes a report, which revokes every download URL issued for it
+	DeleteReport(ctx context.Context, id uuid.UUID) error
+	// DownloadReport checks a download URL and the caller's role, records the download in the
+	// audit trail and returns the report with its file
+	DownloadReport(ctx context.Context, req DownloadReportRequest) (*entities.Report, error)
+
+	// Export profiles are the calling admin's saved layouts, one per report type
+	GetExportProfiles(ctx context.Context) ([]*entities.ExportProfile, error)
+	SaveExportProfile(ctx context.Context, req SaveExportProfileRequest) (*entities.ExportProfile, error)
+	DeleteExportProfile(ctx context.Context, reportType entities.ReportType) error
+}
+
+type reportUseCase struct {
+	reportRepo          repositories.ReportRepository
+	auditRepo           repositories.AuditRepository
+	userPreferencesRepo repositories.UserPreferencesRepository
+	residency           services.DataResidencyService
+	downloadSecret      string
+	downloadTTL         time.Duration
+	maxRows             int
+	pseudonymizer       *entities.Pseudonymizer
+}
+
+// NewReportUseCase creates a new report use case. Download URLs are signed with downloadSecret
+// and stay valid for downloadTTL. Anonymized reports take customer IDs' pseudonyms from
+// pseudonymizer.
+func NewReportUseCase(
+	reportRepo repositories.ReportRepository,
+	auditRepo repositories.AuditRepository,
+	userPreferencesRepo repositories.UserPreferencesRepository,
+	residency services.DataResidencyService,
+	downloadSecret string,
+	downloadTTL time.Duration,
+	maxRows int,
+	pseudonymizer *entities.Pseudonymizer,
+) ReportUseCase {
+	if downloadTTL <= 0 {
+		downloadTTL = 15 * time.Minute
+	}
+	if maxRows <= 0 {
+		maxRows = 100000
+	}
+	return &reportUseCase{
+		reportRepo:          reportRepo,
+		auditRepo:           auditRepo,
+		userPreferencesRepo: userPreferencesRepo,
+		residency:           residency,
+		downloadSecret:      downloadSecret,
+		downloadTTL:         downloadTTL,
+		maxRows:             maxRows,
+		pseudonymizer:       pseudonymizer,
+	}
+}
+
+// GenerateReportRequest represents a request to generate a report. The period runs from
+// DateFrom up to, but not including, DateTo. Layout options left empty are taken from the admin's
+// export profile for the report type.
+type GenerateReportRequest struct {
+	Type     entities.ReportType   `json:"type" validate:"required,oneof=sales products users inventory payments settlements"`
+	Format   entities.ReportFormat `json:"format,omitempty" validate:"omitempty,oneof=csv"`
+	DateFrom time.Time             `json:"date_from"`
+	DateTo   time.Time             `json:"date_to"`
+
+	Columns   []string                 `json:"columns,omitempty"`   // Column keys in file order
+	Locale    string                   `json:"locale,omitempty"`    // en, vi or keys
+	Delimiter entities.ExportDelimiter `json:"delimiter,omitempty"` // comma, semicolon or tab
+	Encoding  entities.ExportEncoding  `json:"encoding,omitempty"`  // utf-8 or utf-8-bom
+
+	// Anonymize replaces customer IDs with pseudonyms and leaves names, emails and other
+	// personal data out, for analytics
+	Anonymize bool `json:"anonymize,omitempty"`
+}
+
+// SaveExportProfileRequest represents the layout an admin saves for a report type
+type SaveExportProfileRequest struct {
+	Type      entities.ReportType      `json:"-"`
+	Columns   []string                 `json:"columns,omitempty"`
+	Locale    string                   `json:"locale,omitempty"`
+	Delimiter entities.ExportDelimiter `json:"delimiter,omitempty"`
+	Encoding  entities.ExportEncoding  `json:"encoding,omitempty"`
+}
+
+// GetReportsRequest represents filters for listing reports
+type GetReportsRequest struct {
+	Type      entities.ReportType   `form:"type" json:"type,omitempty"`
+	Status    entities.ReportStatus `form:"status" json:"status,omitempty"`
+	CreatedBy *uuid.UUID            `form:"created_by" json:"created_by,omitempty"`
+	DateFrom  *time.Time            `form:"date_from" json:"date_from,omitempty"`
+	DateTo    *time.Time            `form:"date_to" json:"date_to,omitempty"`
+	Limit     int                   `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset    int                   `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// DownloadReportRequest is a download URL's report ID, expiry and signature
+type DownloadReportRequest struct {
+	ReportID  uuid.UUID
+	Expires   string
+	Signature string
+}
+
+// ReportResponse represents a report with a fresh download URL
+type ReportResponse struct {
+	*entities.Report
+	DownloadURL       string     `json:"download_url,omitempty"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+}
+
+// ReportsListResponse represents a page of reports
+type ReportsListResponse struct {
+	Reports    []*ReportResponse `json:"reports"`
+	Total      int64             `json:"total"`
+	Pagination *PaginationInfo   `json:"pagination"`
+}
+
+// GenerateReport queries the report's rows and stores them as a CSV file. A failed query is
+// stored as a failed report, so it shows up in the report list.
+func (uc *reportUseCase) GenerateReport(ctx context.Context, req GenerateReportRequest) (*ReportResponse, error) {
+	if req.Format == "" {
+		req.Format = entities.ReportFormatCSV
+	}
+	if req.Format != entities.ReportFormatCSV {
+		return nil, pkgErrors.InvalidInput("Only CSV reports can be generated")
+	}
+	if _, ok := reportTitles[req.Type]; !ok {
+		return nil, pkgErrors.InvalidInput("Invalid report type")
+	}
+	if req.Type != entities.ReportTypeInventory && (req.DateFrom.IsZero() || !req.DateTo.After(req.DateFrom)) {
+		return nil, pkgErrors.InvalidInput("date_from and a later date_to are required")
+	}
+
+	report := &entities.Report{
+		Type:       req.Type,
+		Format:     req.Format,
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+		Anonymized: req.Anonymize,
+		CreatedBy:  entities.ActorFromContext(ctx).ID(),
+	}
+	if err := uc.applyLayout(ctx, report, req); err != nil {
+		return nil, err
+	}
+	if report.Anonymized {
+		if err := anonymizeReportColumns(report); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reports with customer data only cover the regions the admin may export
+	if customerDataReports[req.Type] {
+		regions, err := uc.residency.ExportRegions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		report.DataRegions = regions
+	}
+
+	table, err := uc.reportRepo.GetTable(ctx, req.Type, req.DateFrom, req.DateTo, report.DataRegions, uc.maxRows)
+	if err == nil {
+		if report.Anonymized {
+			table = anonymizeReportTable(table, report.Type, uc.pseudonymizer)
+		}
+		report.Content, err = writeReportCSV(table, report)
+	}
+	now := time.Now()
+	report.CompletedAt = &now
+	if err != nil {
+		report.Status = entities.ReportStatusFailed
+		report.Error = err.Error()
+		if createErr := uc.reportRepo.Create(ctx, report); createErr != nil {
+			return nil, fmt.Errorf("failed to save failed report: %w", createErr)
+		}
+		return nil, fmt.Errorf("failed to generate report: %w", err)
+	}
+
+	report.Status = entities.ReportStatusCompleted
+	report.FileName = reportFileName(report)
+	report.ContentType = "text/csv; charset=utf-8"
+	report.Size = int64(len(report.Content))
+	report.RowCount = len(table.Rows)
+	if err := uc.reportRepo.Create(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to save report: %w", err)
+	}
+
+	message := fmt.Sprintf("Generated %s report %s", report.Type, report.FileName)
+	if report.Anonymized {
+		message = fmt.Sprintf("Generated anonymized %s report %s", report.Type, report.FileName)
+	}
+	uc.audit(ctx, report, "report_generate", message)
+	return uc.toResponse(report), nil
+}
+
+// GetReports lists reports, newest first
+func (uc *reportUseCase) GetReports(ctx context.Context, req GetReportsRequest) (*ReportsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	reports, total, err := uc.reportRepo.List(ctx, repositories.GeneratedReportFilters{
+		Type:      req.Type,
+		Status:    req.Status,
+		CreatedBy: req.CreatedBy,
+		DateFrom:  req.DateFrom,
+		DateTo:    req.DateTo,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*ReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = uc.toResponse(report)
+	}
+	return &ReportsListResponse{
+		Reports:    responses,
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetReport gets a report with a fresh download URL
+func (uc *reportUseCase) GetReport(ctx context.Context, id uuid.UUID) (*ReportResponse, error) {
+	report, err := uc.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toResponse(report), nil
+}
+
+// DeleteReport deletes a report and its file
+func (uc *reportUseCase) DeleteReport(ctx context.Context, id uuid.UUID) error {
+	report, err := uc.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.reportRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.audit(ctx, report, "report_delete", fmt.Sprintf("Deleted %s report %s", report.Type, report.FileName))
+	return nil
+}
+
+// DownloadReport serves a report only to admins holding a valid download URL, and a report with
+// customer data only to admins who may export all of its regions. Refused attempts are logged as
+// security events; the file is only returned once the download is logged.
+func (uc *reportUseCase) DownloadReport(ctx context.Context, req DownloadReportRequest) (*entities.Report, error) {
+	actor := entities.ActorFromContext(ctx)
+	if !actor.IsAuthenticated() || actor.Role != entities.UserRoleAdmin {
+		uc.auditRefusal(ctx, req.ReportID, entities.ErrForbidden)
+		return nil, entities.ErrForbidden
+	}
+	if err := entities.VerifyReportDownload(uc.downloadSecret, req.ReportID, req.Expires, req.Signature, time.Now()); err != nil {
+		uc.auditRefusal(ctx, req.ReportID, err)
+		return nil, err
+	}
+
+	report, err := uc.reportRepo.GetByID(ctx, req.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsDownloadable() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Report has no file to download")
+	}
+	if customerDataReports[report.Type] {
+		if err := uc.residency.CanExport(ctx, report.DataRegions); err != nil {
+			uc.auditRefusal(ctx, req.ReportID, err)
+			return nil, err
+		}
+	}
+
+	resourceID := report.ID.String()
+	err = uc.auditRepo.Create(ctx, &entities.AuditLog{
+		Action:     "report_download",
+		Resource:   "report",
+		ResourceID: &resourceID,
+		Level:      entities.LogLevelInfo,
+		Category:   entities.LogCategoryAdmin,
+		Message:    fmt.Sprintf("Downloaded %s report %s", report.Type, report.FileName),
+		Details: map[string]interface{}{
+			"type":      report.Type,
+			"file_name": report.FileName,
+			"size":      report.Size,
+			"row_count": report.RowCount,
+		},
+		Success: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record report download: %w", err)
+	}
+	return report, nil
+}
+
+// GetExportProfiles lists the calling admin's export profiles
+func (uc *reportUseCase) GetExportProfiles(ctx context.Context) ([]*entities.ExportProfile, error) {
+	return uc.reportRepo.ListExportProfiles(ctx, entities.ActorFromContext(ctx).ID())
+}
+
+// SaveExportProfile creates or replaces the calling admin's export profile for a report type
+func (uc *reportUseCase) SaveExportProfile(ctx context.Context, req SaveExportProfileRequest) (*entities.ExportProfile, error) {
+	profile := &entities.ExportProfile{
+		UserID:     entities.ActorFromContext(ctx).ID(),
+		ReportType: req.Type,
+		Columns:    req.Columns,
+		Delimiter:  req.Delimiter,
+		Encoding:   req.Encoding,
+	}
+	if req.Locale != "" {
+		locale, err := reportLocale(req.Locale)
+		if err != nil {
+			return nil, err
+		}
+		profile.Locale = locale
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	if err := uc.reportRepo.SaveExportProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// DeleteExportProfile deletes the calling admin's export profile for a report type
+func (uc *reportUseCase) DeleteExportProfile(ctx context.Context, reportType entities.ReportType) error {
+	return uc.reportRepo.DeleteExportProfile(ctx, entities.ActorFromContext(ctx).ID(), reportType)
+}
+
+// applyLayout sets the report's columns, header locale, delimiter and encoding. Each option is
+// taken from the request, then the admin's export profile; the headers otherwise follow the
+// admin's language if the reports have headers in it.
+func (uc *reportUseCase) applyLayout(ctx context.Context, report *entities.Report, req GenerateReportRequest) error {
+	profile := &entities.ExportProfile{}
+	if report.CreatedBy != uuid.Nil {
+		saved, err := uc.reportRepo.GetExportProfile(ctx, report.CreatedBy, req.Type)
+		if err != nil && !errors.Is(err, entities.ErrExportProfileNotFound) {
+			return err
+		}
+		if saved != nil {
+			profile = saved
+		}
+	}
+
+	report.Columns = profile.Columns
+	if len(req.Columns) > 0 {
+		report.Columns = req.Columns
+	}
+	if err := entities.ValidateReportColumns(req.Type, report.Columns); err != nil {
+		return pkgErrors.InvalidInput(err.Error())
+	}
+
+	switch {
+	case req.Locale != "":
+		locale, err := reportLocale(req.Locale)
+		if err != nil {
+			return err
+		}
+		report.Locale = locale
+	case profile.Locale != "":
+		report.Locale = profile.Locale
+	default:
+		report.Locale = defaultReportLocale
+		if report.CreatedBy != uuid.Nil {
+			if preferences, err := uc.userPreferencesRepo.GetByUserID(ctx, report.CreatedBy); err == nil {
+				if locale, ok := normalizeReportLocale(preferences.Language); ok {
+					report.Locale = locale
+				}
+			}
+		}
+	}
+
+	report.Delimiter = firstNonEmpty(req.Delimiter, profile.Delimiter, entities.ExportDelimiterComma)
+	if !report.Delimiter.IsValid() {
+		return pkgErrors.InvalidInput("delimiter must be comma, semicolon or tab")
+	}
+	report.Encoding = firstNonEmpty(req.Encoding, profile.Encoding, entities.ExportEncodingUTF8)
+	if !report.Encoding.IsValid() {
+		return pkgErrors.InvalidInput("encoding must be utf-8 or utf-8-bom")
+	}
+	return nil
+}
+
+// reportLocale checks that reports have headers in the locale, or that it asks for column keys
+func reportLocale(locale string) (string, error) {
+	if locale == entities.ExportHeaderKeys {
+		return locale, nil
+	}
+	normalized, ok := normalizeReportLocale(locale)
+	if !ok {
+		return "", pkgErrors.InvalidInput(fmt.Sprintf("Reports have no headers in locale %q; use en, vi or keys", locale))
+	}
+	return normalized, nil
+}
+
+// firstNonEmpty returns the first value that is set
+func firstNonEmpty[T ~string](values ...T) T {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// toResponse signs a download URL for a completed report
+func (uc *reportUseCase) toResponse(report *entities.Report) *ReportResponse {
+	response := &ReportResponse{Report: report}
+	if report.IsDownloadable() {
+		expiresAt := time.Now().Add(uc.downloadTTL).Truncate(time.Second)
+		response.DownloadURL = fmt.Sprintf("/api/v1/admin/reports/%s/download?expires=%d&signature=%s",
+			report.ID, expiresAt.Unix(), entities.SignReportDownload(uc.downloadSecret, report.ID, expiresAt))
+		response.DownloadExpiresAt = &expiresAt
+	}
+	return response
+}
+
+// audit records a report action. Generating and deleting succeed even if the log can't be written.
+func (uc *reportUseCase) audit(ctx context.Context, report *entities.Report, action, message string) {
+	resourceID := report.ID.String()
+	_ = uc.auditRepo.Create(ctx, &entities.AuditLog{
+		Action:     action,
+		Resource:   "report",
+		ResourceID: &resourceID,
+		Level:      entities.LogLevelInfo,
+		Category:   entities.LogCategoryAdmin,
+		Message:    message,
+		Details: map[string]interface{}{
+			"type":      report.Type,
+			"file_name": report.FileName,
+		},
+		Success: true,
+	})
+}
+
+// auditRefusal records a download that was refused
+func (uc *reportUseCase) auditRefusal(ctx context.Context, reportID uuid.UUID, reason error) {
+	resourceID := reportID.String()
+	message := reason.Error()
+	_ = uc.auditRepo.Create(ctx, &entities.AuditLog{
+		Action:       "report_download",
+		Resource:     "report",
+		ResourceID:   &resourceID,
+		Level:        entities.LogLevelWarning,
+		Category:     entities.LogCategorySecurity,
+		Message:      "Refused report download",
+		Success:      false,
+		ErrorMessage: &message,
+	})
+}
+
+// customerDataReports are the report types with customers' personal data, whose rows are limited
+// to the data regions the admin generating them may export
+var customerDataReports = map[entities.ReportType]bool{
+	entities.ReportTypeSales:    true,
+	entities.ReportTypeUsers:    true,
+	entities.ReportTypePayments: true,
+}
+
+// reportTitles name the report types in file names
+var reportTitles = map[entities.ReportType]string{
+	entities.ReportTypeSales:       "sales",
+	entities.ReportTypeProducts:    "product-sales",
+	entities.ReportTypeUsers:       "new-users",
+	entities.ReportTypeInventory:   "inventory",
+	entities.ReportTypePayments:    "payments",
+	entities.ReportTypeSettlements: "settlements",
+}
+
+func reportFileName(report *entities.Report) string {
+	title := reportTitles[report.Type]
+	if report.Anonymized {
+		title += "-anonymized"
+	}
+	if report.Type == entities.ReportTypeInventory {
+		return fmt.Sprintf("%s_%s.csv", title, report.CompletedAt.UTC().Format("20060102"))
+	}
+	return fmt.Sprintf("%s_%s_%s.csv", title,
+		report.DateFrom.UTC().Format("20060102"), report.DateTo.UTC().Format("20060102"))
+}
+
+// anonymizeReportColumns leaves the columns anonymization strips out of an anonymized report's
+// layout, so saved layouts can be reused
+func anonymizeReportColumns(report *entities.Report) error {
+	if len(report.Columns) == 0 {
+		return nil
+	}
+	rules := entities.ReportAnonymization[report.Type]
+	columns := make([]string, 0, len(report.Columns))
+	for _, column := range report.Columns {
+		if rules[column] != entities.AnonymizeStrip {
+			columns = append(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		return pkgErrors.InvalidInput("Every column of the layout holds personal data; choose columns an anonymized report can keep")
+	}
+	report.Columns = columns
+	return nil
+}
+
+// anonymizeReportTable returns the table without the columns anonymization strips, and with
+// customer IDs replaced by their pseudonyms
+func anonymizeReportTable(table *entities.ReportTable, reportType entities.ReportType, pseudonymizer *entities.Pseudonymizer) *entities.ReportTable {
+	rules := entities.ReportAnonymization[reportType]
+	if len(rules) == 0 {
+		return table
+	}
+
+	var keep, pseudonymize []int
+	anonymized := &entities.ReportTable{}
+	for i, column := range table.Columns {
+		switch rules[column] {
+		case entities.AnonymizeStrip:
+			continue
+		case entities.AnonymizePseudonymize:
+			pseudonymize = append(pseudonymize, len(keep))
+		}
+		keep = append(keep, i)
+		anonymized.Columns = append(anonymized.Columns, column)
+	}
+
+	anonymized.Rows = make([][]string, len(table.Rows))
+	for r, row := range table.Rows {
+		values := make([]string, len(keep))
+		for i, index := range keep {
+			values[i] = row[index]
+		}
+		for _, i := range pseudonymize {
+			values[i] = pseudonymizer.Pseudonym(values[i])
+		}
+		anonymized.Rows[r] = values
+	}
+	return anonymized
+}
+
+// utf8BOM is the byte order mark that tells Excel a CSV file is UTF-8
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// writeReportCSV writes the table in the report's layout: its columns in its order, headed in its
+// locale, separated by its delimiter
+func writeReportCSV(table *entities.ReportTable, report *entities.Report) ([]byte, error) {
+	columns := []string(report.Columns)
+	if len(columns) == 0 {
+		columns = table.Columns
+	}
+	positions := make(map[string]int, len(table.Columns))
+	for i, column := range table.Columns {
+		positions[column] = i
+	}
+	indexes := make([]int, len(columns))
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		index, ok := positions[column]
+		if !ok {
+			return nil, fmt.Errorf("report query has no %s column", column)
+		}
+		indexes[i] = index
+		headers[i] = column
+		if report.Locale != entities.ExportHeaderKeys {
+			headers[i] = reportHeader(report.Locale, column)
+		}
+	}
+
+	var buf bytes.Buffer
+	if report.Encoding == entities.ExportEncodingUTF8BOM {
+		buf.Write(utf8BOM)
+	}
+	w := csv.NewWriter(&buf)
+	w.Comma = report.Delimiter.Rune()
+	if err := w.Write(headers); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(indexes))
+	for _, row := range table.Rows {
+		for i, index := range indexes {
+			record[i] = row[index]
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,454 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// returnPortalMaxPhotos is how many photos a customer can send with a return
+const returnPortalMaxPhotos = 5
+
+// ReturnPortalUseCase defines use cases for the returns portal: signed links in delivery emails
+// that let a customer return items of that one order without signing in
+type ReturnPortalUseCase interface {
+	// ReturnLink returns the portal URL for a delivered order, valid for the return window
+	ReturnLink(order *entities.Order) string
+
+	// Customer operations, addressed by the link's token
+	GetPortal(ctx context.Context, token string) (*ReturnPortalResponse, error)
+	CreateReturn(ctx context.Context, token string, req CreatePortalReturnRequest) (*PortalReturnResponse, error)
+	// GetReturnLabel renders the shipping label of an approved return, generating it first if
+	// the return was approved by an admin
+	GetReturnLabel(ctx context.Context, token string, returnID uuid.UUID) ([]byte, string, error)
+}
+
+// ReturnPortalSettings configures the returns portal
+type ReturnPortalSettings struct {
+	Secret  string        // Signs return links
+	BaseURL string        // Storefront page return links point to, followed by the token
+	Window  time.Duration // How long after delivery items can be returned
+	// AutoApproveReasons are the reasons returns are approved for right away, with a label
+	AutoApproveReasons []entities.ReturnReason
+	Carrier            string // Carrier named on return labels
+	ReturnAddress      string // Where returned parcels are sent
+}
+
+type returnPortalUseCase struct {
+	shippingRepo repositories.ShippingRepository
+	orderRepo    repositories.OrderRepository
+	settings     ReturnPortalSettings
+	clock        Clock
+}
+
+// NewReturnPortalUseCase creates a new returns portal use case. A nil clock uses the system clock.
+func NewReturnPortalUseCase(
+	shippingRepo repositories.ShippingRepository,
+	orderRepo repositories.OrderRepository,
+	settings ReturnPortalSettings,
+	clock Clock,
+) ReturnPortalUseCase {
+	if settings.Window <= 0 {
+		settings.Window = 30 * 24 * time.Hour
+	}
+	settings.BaseURL = strings.TrimSuffix(settings.BaseURL, "/")
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &returnPortalUseCase{
+		shippingRepo: shippingRepo,
+		orderRepo:    orderRepo,
+		settings:     settings,
+		clock:        clock,
+	}
+}
+
+// CreatePortalReturnRequest represents a customer's return of items of the link's order
+type CreatePortalReturnRequest struct {
+	Items       []ReturnItemRequest   `json:"items" validate:"required,min=1,dive"`
+	Reason      entities.ReturnReason `json:"reason" validate:"required"`
+	Description string                `json:"description" validate:"max=1000"`
+	// Photos are the URLs of the photos uploaded with the request
+	Photos []string `json:"-"`
+}
+
+// ReturnPortalResponse represents the order a return link is for
+type ReturnPortalResponse struct {
+	OrderNumber string                 `json:"order_number"`
+	OrderedAt   time.Time              `json:"ordered_at"`
+	DeliveredAt *time.Time             `json:"delivered_at,omitempty"`
+	ReturnBy    *time.Time             `json:"return_by,omitempty"`
+	Returnable  bool                   `json:"returnable"` // Whether new returns can be opened
+	Items       []ReturnPortalItem     `json:"items"`
+	Reasons     []ReturnReasonOption   `json:"reasons"`
+	MaxPhotos   int                    `json:"max_photos"`
+	Returns     []PortalReturnResponse `json:"returns"`
+
+	// CustomerID is the order's customer, who photos are uploaded for
+	CustomerID uuid.UUID `json:"-"`
+}
+
+// ReturnPortalItem represents an item of the order and how much of it can still be returned
+type ReturnPortalItem struct {
+	ProductID   uuid.UUID `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	ProductSKU  string    `json:"product_sku"`
+	Price       float64   `json:"price"`
+	Quantity    int       `json:"quantity"`
+	Returnable  int       `json:"returnable"` // Ordered less already returned
+}
+
+// ReturnReasonOption represents a reason customers can pick
+type ReturnReasonOption struct {
+	Reason entities.ReturnReason `json:"reason"`
+	// AutoApproved is whether returns for the reason are approved right away, with a label
+	AutoApproved bool `json:"auto_approved"`
+}
+
+// PortalReturnResponse represents a return as the customer sees it
+type PortalReturnResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	ReturnNumber   string                `json:"return_number"`
+	Status         entities.ReturnStatus `json:"status"`
+	Reason         entities.ReturnReason `json:"reason"`
+	Description    string                `json:"description"`
+	Items          []ReturnItemResponse  `json:"items"`
+	RefundAmount   float64               `json:"refund_amount"`
+	Photos         []string              `json:"photos"`
+	LabelAvailable bool                  `json:"label_available"`
+	TrackingNumber string                `json:"tracking_number,omitempty"`
+	RequestedAt    time.Time             `json:"requested_at"`
+}
+
+// ReturnLink returns the portal URL for a delivered order. The link expires with the return
+// window, counted from delivery.
+func (uc *returnPortalUseCase) ReturnLink(order *entities.Order) string {
+	token := entities.SignReturnLink(uc.settings.Secret, order.ID, uc.returnBy(order))
+	return fmt.Sprintf("%s/%s", uc.settings.BaseURL, token)
+}
+
+// GetPortal gets the link's order with what can be returned and the returns already opened
+func (uc *returnPortalUseCase) GetPortal(ctx context.Context, token string) (*ReturnPortalResponse, error) {
+	order, err := uc.getOrder(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	returns, err := uc.shippingRepo.GetReturnsByOrder(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	returnBy := uc.returnBy(order)
+	response := &ReturnPortalResponse{
+		OrderNumber: order.OrderNumber,
+		OrderedAt:   order.CreatedAt,
+		DeliveredAt: order.ActualDelivery,
+		Returnable:  uc.checkReturnable(order) == nil,
+		MaxPhotos:   returnPortalMaxPhotos,
+		Returns:     make([]PortalReturnResponse, 0, len(returns)),
+		CustomerID:  order.UserID,
+	}
+	if order.ActualDelivery != nil {
+		response.ReturnBy = &returnBy
+	}
+
+	returned := returnedQuantities(returns)
+	for _, item := range order.Items {
+		returnable := item.Quantity - returned[item.ProductID]
+		if returnable < 0 {
+			returnable = 0
+		}
+		returned[item.ProductID] -= item.Quantity - returnable
+		response.Items = append(response.Items, ReturnPortalItem{
+			ProductID:   item.ProductID,
+			ProductName: item.ProductName,
+			ProductSKU:  item.ProductSKU,
+			Price:       item.Price,
+			Quantity:    item.Quantity,
+			Returnable:  returnable,
+		})
+	}
+	for _, reason := range []entities.ReturnReason{
+		entities.ReturnReasonDefective, entities.ReturnReasonDamaged, entities.ReturnReasonWrongItem,
+		entities.ReturnReasonNotAsDescribed, entities.ReturnReasonSizeIssue, entities.ReturnReasonChangedMind,
+		entities.ReturnReasonOther,
+	} {
+		response.Reasons = append(response.Reasons, ReturnReasonOption{
+			Reason:       reason,
+			AutoApproved: uc.isAutoApproved(reason),
+		})
+	}
+	for _, ret := range returns {
+		response.Returns = append(response.Returns, *toPortalReturnResponse(ret, order))
+	}
+	return response, nil
+}
+
+// CreateReturn opens a return for items of the link's order. Returns for a reason that qualifies
+// for auto-approval are approved and get their label right away; the others wait for an admin.
+func (uc *returnPortalUseCase) CreateReturn(ctx context.Context, token string, req CreatePortalReturnRequest) (*PortalReturnResponse, error) {
+	if !req.Reason.IsValid() {
+		return nil, pkgErrors.InvalidInput("Invalid return reason")
+	}
+	if len(req.Items) == 0 {
+		return nil, pkgErrors.InvalidInput("At least one item is required")
+	}
+	if len(req.Description) > 1000 {
+		return nil, pkgErrors.InvalidInput("Description must be at most 1000 characters")
+	}
+	if len(req.Photos) > returnPortalMaxPhotos {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("At most %d photos can be sent", returnPortalMaxPhotos))
+	}
+
+	order, err := uc.getOrder(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkReturnable(order); err != nil {
+		return nil, err
+	}
+	returns, err := uc.shippingRepo.GetReturnsByOrder(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Quantities are checked against what was ordered less what is already being returned
+	ordered := make(map[uuid.UUID]*entities.OrderItem)
+	remaining := make(map[uuid.UUID]int)
+	for i := range order.Items {
+		item := &order.Items[i]
+		if ordered[item.ProductID] == nil {
+			ordered[item.ProductID] = item
+		}
+		remaining[item.ProductID] += item.Quantity
+	}
+	for productID, quantity := range returnedQuantities(returns) {
+		remaining[productID] -= quantity
+	}
+
+	now := uc.clock.Now()
+	ret := &entities.Return{
+		ID:           ids.New(),
+		OrderID:      order.ID,
+		UserID:       order.UserID,
+		ReturnNumber: generateReturnNumber(now),
+		Reason:       req.Reason,
+		Status:       entities.ReturnStatusRequested,
+		Description:  strings.TrimSpace(req.Description),
+		Photos:       req.Photos,
+		RequestedAt:  now,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	for _, itemReq := range req.Items {
+		item := ordered[itemReq.ProductID]
+		if item == nil {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("Product %s is not part of this order", itemReq.ProductID))
+		}
+		if itemReq.Quantity <= 0 || itemReq.Quantity > remaining[itemReq.ProductID] {
+			return nil, pkgErrors.InvalidInput(fmt.Sprintf("At most %d of %s can be returned", max(remaining[itemReq.ProductID], 0), item.ProductName))
+		}
+		remaining[itemReq.ProductID] -= itemReq.Quantity
+
+		total := item.Price * float64(itemReq.Quantity)
+		ret.Items = append(ret.Items, entities.ReturnItem{
+			ID:           ids.New(),
+			ReturnID:     ret.ID,
+			ProductID:    item.ProductID,
+			Quantity:     itemReq.Quantity,
+			UnitPrice:    item.Price,
+			TotalPrice:   total,
+			Reason:       req.Reason,
+			RefundAmount: total,
+		})
+		ret.RefundAmount += total
+	}
+
+	if uc.isAutoApproved(req.Reason) {
+		ret.Status = entities.ReturnStatusApproved
+		ret.ApprovedAt = &now
+		uc.generateLabel(ret, now)
+	}
+
+	if err := uc.shippingRepo.CreateReturn(ctx, ret); err != nil {
+		return nil, err
+	}
+	return toPortalReturnResponse(ret, order), nil
+}
+
+// GetReturnLabel renders the label of one of the link's order's approved returns
+func (uc *returnPortalUseCase) GetReturnLabel(ctx context.Context, token string, returnID uuid.UUID) ([]byte, string, error) {
+	order, err := uc.getOrder(ctx, token)
+	if err != nil {
+		return nil, "", err
+	}
+	returns, err := uc.shippingRepo.GetReturnsByOrder(ctx, order.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var ret *entities.Return
+	for _, candidate := range returns {
+		if candidate.ID == returnID {
+			ret = candidate
+			break
+		}
+	}
+	if ret == nil {
+		return nil, "", entities.ErrReturnNotFound
+	}
+	if ret.LabelCreatedAt == nil {
+		if !ret.IsApproved() {
+			return nil, "", pkgErrors.New(pkgErrors.ErrCodeConflict, "Return has not been approved yet")
+		}
+		uc.generateLabel(ret, uc.clock.Now())
+		if err := uc.shippingRepo.UpdateReturn(ctx, ret); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return uc.renderLabel(ret, order), fmt.Sprintf("return-label-%s.pdf", ret.ReturnNumber), nil
+}
+
+// getOrder gets the order a token is for
+func (uc *returnPortalUseCase) getOrder(ctx context.Context, token string) (*entities.Order, error) {
+	orderID, err := entities.VerifyReturnLink(uc.settings.Secret, token, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if err == entities.ErrOrderNotFound {
+			return nil, entities.ErrReturnLinkInvalid
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// checkReturnable checks that new returns can be opened for the order: it was paid and
+// delivered, and the return window hasn't passed
+func (uc *returnPortalUseCase) checkReturnable(order *entities.Order) error {
+	if order.Status != entities.OrderStatusDelivered || !order.CanBeRefunded() || order.IsArchived {
+		return entities.ErrOrderCannotBeReturned
+	}
+	if uc.clock.Now().After(uc.returnBy(order)) {
+		return entities.ErrReturnLinkExpired
+	}
+	return nil
+}
+
+// returnBy is when the order's return window ends; orders without a delivery time count from now
+func (uc *returnPortalUseCase) returnBy(order *entities.Order) time.Time {
+	deliveredAt := uc.clock.Now()
+	if order.ActualDelivery != nil {
+		deliveredAt = *order.ActualDelivery
+	}
+	return deliveredAt.Add(uc.settings.Window)
+}
+
+func (uc *returnPortalUseCase) isAutoApproved(reason entities.ReturnReason) bool {
+	for _, autoApproved := range uc.settings.AutoApproveReasons {
+		if autoApproved == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// generateLabel gives the return the tracking number its label is printed with
+func (uc *returnPortalUseCase) generateLabel(ret *entities.Return, now time.Time) {
+	ret.TrackingNumber = fmt.Sprintf("RT%s%s", now.Format("060102"), strings.ToUpper(strings.ReplaceAll(ids.New().String(), "-", "")[:10]))
+	ret.LabelCreatedAt = &now
+}
+
+// renderLabel renders a return's label: the parcel goes from the order's shipping address to the
+// return address
+func (uc *returnPortalUseCase) renderLabel(ret *entities.Return, order *entities.Order) []byte {
+	pdf := utils.NewTextPDF()
+	pdf.AddLine("RETURN LABEL %s", ret.ReturnNumber)
+	if uc.settings.Carrier != "" {
+		pdf.AddLine("Carrier: %s", uc.settings.Carrier)
+	}
+	pdf.AddLine("Tracking: %s", ret.TrackingNumber)
+	pdf.AddLine("Created: %s", ret.LabelCreatedAt.Format("2006-01-02"))
+	pdf.AddBlankLine()
+	addPDFAddress(pdf, "From", order.ShippingAddress)
+	pdf.AddLine("To: %s", uc.settings.ReturnAddress)
+	pdf.AddBlankLine()
+
+	names := make(map[uuid.UUID]string)
+	for _, item := range order.Items {
+		names[item.ProductID] = item.ProductName
+	}
+	pdf.AddLine("Order: %s", order.OrderNumber)
+	pdf.AddLine("Reason: %s", ret.Reason)
+	pdf.AddLine("%-70s %5s", "Product", "Qty")
+	pdf.AddLine(strings.Repeat("-", 76))
+	for _, item := range ret.Items {
+		pdf.AddLine("%-70s %5d", truncatePDFText(names[item.ProductID], 70), item.Quantity)
+	}
+	pdf.AddLine(strings.Repeat("-", 76))
+	pdf.AddBlankLine()
+	pdf.AddLine("Pack the items above and attach this label to the parcel.")
+
+	return pdf.Bytes()
+}
+
+// returnedQuantities sums the quantities of each product in returns that weren't rejected or cancelled
+func returnedQuantities(returns []*entities.Return) map[uuid.UUID]int {
+	quantities := make(map[uuid.UUID]int)
+	for _, ret := range returns {
+		if ret.IsWithdrawn() {
+			continue
+		}
+		for _, item := range ret.Items {
+			quantities[item.ProductID] += item.Quantity
+		}
+	}
+	return quantities
+}
+
+func toPortalReturnResponse(ret *entities.Return, order *entities.Order) *PortalReturnResponse {
+	names := make(map[uuid.UUID]string)
+	for _, item := range order.Items {
+		names[item.ProductID] = item.ProductName
+	}
+	response := &PortalReturnResponse{
+		ID:             ret.ID,
+		ReturnNumber:   ret.ReturnNumber,
+		Status:         ret.Status,
+		Reason:         ret.Reason,
+		Description:    ret.Description,
+		Items:          make([]ReturnItemResponse, len(ret.Items)),
+		RefundAmount:   ret.RefundAmount,
+		Photos:         ret.Photos,
+		LabelAvailable: ret.LabelCreatedAt != nil || ret.IsApproved(),
+		TrackingNumber: ret.TrackingNumber,
+		RequestedAt:    ret.RequestedAt,
+	}
+	for i, item := range ret.Items {
+		response.Items[i] = ReturnItemResponse{
+			ID:           item.ID,
+			ProductID:    item.ProductID,
+			ProductName:  names[item.ProductID],
+			Quantity:     item.Quantity,
+			RefundAmount: item.RefundAmount,
+		}
+	}
+	return response
+}
+
+// generateReturnNumber generates a return (RMA) number
+func generateReturnNumber(now time.Time) string {
+	return fmt.Sprintf("RMA-%s-%s", now.Format("20060102"), strings.ToUpper(uuid.New().String()[:8]))
+}
@@ -0,0 +1,420 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// ReviewIncentiveUseCase defines use cases for rewarding customers whose reviews are approved
+type ReviewIncentiveUseCase interface {
+	GetProgram(ctx context.Context) (*entities.ReviewIncentiveProgram, error)
+	UpdateProgram(ctx context.Context, adminID uuid.UUID, req UpdateReviewIncentiveProgramRequest) (*entities.ReviewIncentiveProgram, error)
+	ListRewards(ctx context.Context, page, limit int) (*ReviewIncentiveRewardsListResponse, error)
+	GetReport(ctx context.Context, req ReviewIncentiveReportRequest) (*ReviewIncentiveReportResponse, error)
+
+	// RewardApprovedReview grants the program's reward for an approved review. It returns nil without an error
+	// when the program is inactive or the review is not eligible.
+	RewardApprovedReview(ctx context.Context, reviewID uuid.UUID) (*entities.ReviewIncentiveReward, error)
+}
+
+// ReviewIncentiveService grants rewards for approved reviews
+type ReviewIncentiveService interface {
+	RewardApprovedReview(ctx context.Context, reviewID uuid.UUID) (*entities.ReviewIncentiveReward, error)
+}
+
+// ReviewIncentiveNotificationService interface for review reward notifications
+type ReviewIncentiveNotificationService interface {
+	NotifyReviewReward(ctx context.Context, reward *entities.ReviewIncentiveReward) error
+}
+
+type reviewIncentiveUseCase struct {
+	incentiveRepo       repositories.ReviewIncentiveRepository
+	reviewRepo          repositories.ReviewRepository
+	userRepo            repositories.UserRepository
+	notificationService ReviewIncentiveNotificationService
+}
+
+// NewReviewIncentiveUseCase creates a new review incentive use case
+func NewReviewIncentiveUseCase(
+	incentiveRepo repositories.ReviewIncentiveRepository,
+	reviewRepo repositories.ReviewRepository,
+	userRepo repositories.UserRepository,
+	notificationService ReviewIncentiveNotificationService,
+) ReviewIncentiveUseCase {
+	return &reviewIncentiveUseCase{
+		incentiveRepo:       incentiveRepo,
+		reviewRepo:          reviewRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// UpdateReviewIncentiveProgramRequest represents a partial update of the review incentive program
+type UpdateReviewIncentiveProgramRequest struct {
+	IsActive   *bool                               `json:"is_active"`
+	RewardType *entities.ReviewIncentiveRewardType `json:"reward_type"`
+	Points     *int                                `json:"points"`
+
+	CouponType  *entities.CouponType `json:"coupon_type"`
+	CouponValue *float64             `json:"coupon_value"`
+	// CouponMaxDiscount and CouponMinOrderAmount are cleared by sending 0
+	CouponMaxDiscount    *float64 `json:"coupon_max_discount"`
+	CouponMinOrderAmount *float64 `json:"coupon_min_order_amount"`
+	CouponValidDays      *int     `json:"coupon_valid_days"`
+
+	RequireVerifiedPurchase  *bool `json:"require_verified_purchase"`
+	MinCommentLength         *int  `json:"min_comment_length"`
+	MinAccountAgeDays        *int  `json:"min_account_age_days"`
+	MaxRewardsPerUserMonthly *int  `json:"max_rewards_per_user_monthly"`
+}
+
+// ReviewIncentiveRewardsListResponse represents a page of granted rewards
+type ReviewIncentiveRewardsListResponse struct {
+	Rewards    []*entities.ReviewIncentiveReward `json:"rewards"`
+	Pagination *PaginationInfo                   `json:"pagination"`
+}
+
+// ReviewIncentiveReportRequest represents a request for the program report
+type ReviewIncentiveReportRequest struct {
+	// DateFrom defaults to when the program was activated, or 30 days ago
+	DateFrom *time.Time `json:"date_from"`
+	DateTo   *time.Time `json:"date_to"`
+}
+
+// ReviewIncentiveReportResponse compares review volume during a period with the equally long period before it
+type ReviewIncentiveReportResponse struct {
+	DateFrom     time.Time `json:"date_from"`
+	DateTo       time.Time `json:"date_to"`
+	BaselineFrom time.Time `json:"baseline_from"`
+	BaselineTo   time.Time `json:"baseline_to"`
+
+	Reviews              int64   `json:"reviews"`
+	BaselineReviews      int64   `json:"baseline_reviews"`
+	DailyReviews         float64 `json:"daily_reviews"`
+	BaselineDailyReviews float64 `json:"baseline_daily_reviews"`
+	// UpliftPercentage is the change in review volume against the baseline; omitted when the baseline had no reviews
+	UpliftPercentage *float64 `json:"uplift_percentage,omitempty"`
+	// IncrementalReviews estimates the reviews attributable to the program: volume above the baseline
+	IncrementalReviews int64 `json:"incremental_reviews"`
+
+	RewardedReviews int64 `json:"rewarded_reviews"`
+	PointsGranted   int64 `json:"points_granted"`
+	CouponsIssued   int64 `json:"coupons_issued"`
+	CouponsRedeemed int64 `json:"coupons_redeemed"`
+}
+
+// GetProgram gets the review incentive program, or the inactive defaults if it was never configured
+func (uc *reviewIncentiveUseCase) GetProgram(ctx context.Context) (*entities.ReviewIncentiveProgram, error) {
+	program, err := uc.incentiveRepo.GetProgram(ctx)
+	if err == entities.ErrReviewIncentiveProgramNotFound {
+		return &entities.ReviewIncentiveProgram{
+			RewardType:               entities.ReviewIncentiveRewardLoyaltyPoints,
+			Points:                   50,
+			CouponType:               entities.CouponTypePercentage,
+			CouponValue:              5,
+			CouponValidDays:          30,
+			RequireVerifiedPurchase:  true,
+			MinCommentLength:         30,
+			MaxRewardsPerUserMonthly: 5,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review incentive program: %w", err)
+	}
+	return program, nil
+}
+
+// UpdateProgram updates the review incentive program
+func (uc *reviewIncentiveUseCase) UpdateProgram(ctx context.Context, adminID uuid.UUID, req UpdateReviewIncentiveProgramRequest) (*entities.ReviewIncentiveProgram, error) {
+	program, err := uc.GetProgram(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wasActive := program.IsActive
+
+	if req.IsActive != nil {
+		program.IsActive = *req.IsActive
+	}
+	if req.RewardType != nil {
+		program.RewardType = *req.RewardType
+	}
+	if req.Points != nil {
+		program.Points = *req.Points
+	}
+	if req.CouponType != nil {
+		program.CouponType = *req.CouponType
+	}
+	if req.CouponValue != nil {
+		program.CouponValue = *req.CouponValue
+	}
+	if req.CouponMaxDiscount != nil {
+		program.CouponMaxDiscount = positiveOrNil(*req.CouponMaxDiscount)
+	}
+	if req.CouponMinOrderAmount != nil {
+		program.CouponMinOrderAmount = positiveOrNil(*req.CouponMinOrderAmount)
+	}
+	if req.CouponValidDays != nil {
+		program.CouponValidDays = *req.CouponValidDays
+	}
+	if req.RequireVerifiedPurchase != nil {
+		program.RequireVerifiedPurchase = *req.RequireVerifiedPurchase
+	}
+	if req.MinCommentLength != nil {
+		program.MinCommentLength = *req.MinCommentLength
+	}
+	if req.MinAccountAgeDays != nil {
+		program.MinAccountAgeDays = *req.MinAccountAgeDays
+	}
+	if req.MaxRewardsPerUserMonthly != nil {
+		program.MaxRewardsPerUserMonthly = *req.MaxRewardsPerUserMonthly
+	}
+
+	if err := program.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if program.IsActive && !wasActive {
+		now := time.Now()
+		program.ActivatedAt = &now
+	}
+	program.UpdatedBy = &adminID
+
+	if err := uc.incentiveRepo.SaveProgram(ctx, program); err != nil {
+		return nil, fmt.Errorf("failed to save review incentive program: %w", err)
+	}
+	return program, nil
+}
+
+// RewardApprovedReview grants the program's reward for an approved review if it passes the fraud guards
+func (uc *reviewIncentiveUseCase) RewardApprovedReview(ctx context.Context, reviewID uuid.UUID) (*entities.ReviewIncentiveReward, error) {
+	program, err := uc.GetProgram(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !program.IsActive {
+		return nil, nil
+	}
+
+	review, err := uc.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, entities.ErrReviewNotFound
+	}
+
+	reason, err := uc.checkEligibility(ctx, program, review)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		fmt.Printf("Review %s not rewarded: %s\n", review.ID, reason)
+		return nil, nil
+	}
+
+	reward := &entities.ReviewIncentiveReward{
+		ReviewID:   review.ID,
+		UserID:     review.UserID,
+		ProductID:  review.ProductID,
+		RewardType: program.RewardType,
+	}
+	var coupon *entities.Coupon
+	if program.RewardType == entities.ReviewIncentiveRewardCoupon {
+		coupon = newReviewRewardCoupon(program, review)
+	} else {
+		reward.Points = program.Points
+	}
+
+	if err := uc.incentiveRepo.Grant(ctx, reward, coupon); err != nil {
+		return nil, fmt.Errorf("failed to grant review reward: %w", err)
+	}
+
+	if uc.notificationService != nil {
+		go func(r entities.ReviewIncentiveReward) {
+			if err := uc.notificationService.NotifyReviewReward(context.Background(), &r); err != nil {
+				fmt.Printf("Failed to send review reward notification: %v\n", err)
+			}
+		}(*reward)
+	}
+
+	return reward, nil
+}
+
+// checkEligibility applies the program's fraud guards and returns why a review is not eligible, or "" if it is
+func (uc *reviewIncentiveUseCase) checkEligibility(ctx context.Context, program *entities.ReviewIncentiveProgram, review *entities.Review) (string, error) {
+	if !review.IsApproved() {
+		return "review is not approved", nil
+	}
+	if program.ActivatedAt != nil && review.CreatedAt.Before(*program.ActivatedAt) {
+		return "review was written before the program started", nil
+	}
+	if program.RequireVerifiedPurchase && !review.IsVerified {
+		return "review is not from a verified purchase", nil
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(review.Comment)) < program.MinCommentLength {
+		return "review comment is too short", nil
+	}
+
+	rewarded, err := uc.incentiveRepo.HasReward(ctx, review.UserID, review.ProductID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check existing rewards: %w", err)
+	}
+	if rewarded {
+		return "customer was already rewarded for this product", nil
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, review.UserID)
+	if err != nil {
+		return "", entities.ErrUserNotFound
+	}
+	if user.Status != entities.UserStatusActive {
+		return "account is not active", nil
+	}
+	if program.MinAccountAgeDays > 0 && time.Since(user.CreatedAt) < time.Duration(program.MinAccountAgeDays)*24*time.Hour {
+		return "account is too new", nil
+	}
+
+	if program.MaxRewardsPerUserMonthly > 0 {
+		recent, err := uc.incentiveRepo.CountUserRewardsSince(ctx, review.UserID, time.Now().AddDate(0, 0, -30))
+		if err != nil {
+			return "", fmt.Errorf("failed to count recent rewards: %w", err)
+		}
+		if recent >= int64(program.MaxRewardsPerUserMonthly) {
+			return "customer reached the monthly reward limit", nil
+		}
+	}
+
+	return "", nil
+}
+
+// ListRewards lists granted rewards, newest first
+func (uc *reviewIncentiveUseCase) ListRewards(ctx context.Context, page, limit int) (*ReviewIncentiveRewardsListResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, total, err := uc.incentiveRepo.ListRewards(ctx, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review rewards: %w", err)
+	}
+
+	return &ReviewIncentiveRewardsListResponse{
+		Rewards:    rewards,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
+// GetReport reports review volume during a period against the equally long period before it, with the rewards granted
+func (uc *reviewIncentiveUseCase) GetReport(ctx context.Context, req ReviewIncentiveReportRequest) (*ReviewIncentiveReportResponse, error) {
+	to := time.Now()
+	if req.DateTo != nil {
+		to = *req.DateTo
+	}
+	from := to.AddDate(0, 0, -30)
+	if req.DateFrom != nil {
+		from = *req.DateFrom
+	} else {
+		program, err := uc.GetProgram(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if program.ActivatedAt != nil && program.ActivatedAt.Before(to) {
+			from = *program.ActivatedAt
+		}
+	}
+	if !from.Before(to) {
+		return nil, pkgErrors.InvalidInput("date_from must be before date_to")
+	}
+
+	period := to.Sub(from)
+	report := &ReviewIncentiveReportResponse{
+		DateFrom:     from,
+		DateTo:       to,
+		BaselineFrom: from.Add(-period),
+		BaselineTo:   from,
+	}
+
+	var err error
+	if report.Reviews, err = uc.incentiveRepo.CountReviewsCreated(ctx, from, to); err != nil {
+		return nil, fmt.Errorf("failed to count reviews: %w", err)
+	}
+	if report.BaselineReviews, err = uc.incentiveRepo.CountReviewsCreated(ctx, report.BaselineFrom, report.BaselineTo); err != nil {
+		return nil, fmt.Errorf("failed to count baseline reviews: %w", err)
+	}
+
+	days := period.Hours() / 24
+	report.DailyReviews = float64(report.Reviews) / days
+	report.BaselineDailyReviews = float64(report.BaselineReviews) / days
+	if report.BaselineReviews > 0 {
+		uplift := float64(report.Reviews-report.BaselineReviews) / float64(report.BaselineReviews) * 100
+		report.UpliftPercentage = &uplift
+	}
+	if report.Reviews > report.BaselineReviews {
+		report.IncrementalReviews = report.Reviews - report.BaselineReviews
+	}
+
+	stats, err := uc.incentiveRepo.GetRewardStats(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reward stats: %w", err)
+	}
+	report.RewardedReviews = stats.Rewards
+	report.PointsGranted = stats.PointsGranted
+	report.CouponsIssued = stats.CouponsIssued
+	report.CouponsRedeemed = stats.CouponsRedeemed
+
+	return report, nil
+}
+
+// newReviewRewardCoupon builds a single-use coupon restricted to the reviewer
+func newReviewRewardCoupon(program *entities.ReviewIncentiveProgram, review *entities.Review) *entities.Coupon {
+	usageLimit := 1
+	expiresAt := time.Now().AddDate(0, 0, program.CouponValidDays)
+	coupon := &entities.Coupon{
+		ID:                ids.New(),
+		Code:              "REVIEW-" + strings.ToUpper(strings.ReplaceAll(uuid.New().String(), "-", "")[:10]),
+		Name:              "Review reward",
+		Description:       fmt.Sprintf("Issued for approved review %s", review.ID),
+		Type:              program.CouponType,
+		Value:             program.CouponValue,
+		MaxDiscount:       program.CouponMaxDiscount,
+		MinOrderAmount:    program.CouponMinOrderAmount,
+		UsageLimit:        &usageLimit,
+		UsageLimitPerUser: &usageLimit,
+		Applicability:     entities.CouponApplicabilityUsers,
+		ExpiresAt:         &expiresAt,
+		Status:            entities.CouponStatusActive,
+		IsPublic:          false,
+	}
+	if program.UpdatedBy != nil {
+		coupon.CreatedBy = *program.UpdatedBy
+	}
+	return coupon
+}
+
+// positiveOrNil returns nil for zero or negative amounts, which clear an optional limit
+func positiveOrNil(value float64) *float64 {
+	if value <= 0 {
+		return nil
+	}
+	return &value
+}
+
+// rewardApprovedReview grants the review incentive in the background
+func rewardApprovedReview(service ReviewIncentiveService, reviewID uuid.UUID) {
+	if service == nil {
+		return
+	}
+	go func() {
+		if _, err := service.RewardApprovedReview(context.Background(), reviewID); err != nil {
+			fmt.Printf("Failed to grant review incentive: %v\n", err)
+		}
+	}()
+}
@@ -0,0 +1,513 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ReviewModerationUseCase defines use cases for tuning which reviews are published right away
+type ReviewModerationUseCase interface {
+	GetSettings(ctx context.Context) (*entities.ReviewModerationSettings, error)
+	UpdateSettings(ctx context.Context, adminID uuid.UUID, req UpdateReviewModerationSettingsRequest) (*entities.ReviewModerationSettings, error)
+	// TestSettings applies changed settings to recent reviews without saving them
+	TestSettings(ctx context.Context, req TestReviewModerationSettingsRequest) (*ReviewModerationTestResponse, error)
+
+	ReviewModerationService
+}
+
+// ReviewModerationService moderates reviews as customers write them
+type ReviewModerationService interface {
+	// CheckReviewLimit returns ErrReviewLimitReached when a customer already wrote as many reviews
+	// in the last 24 hours as allowed
+	CheckReviewLimit(ctx context.Context, userID uuid.UUID) error
+	// ModerateReview decides whether a review is published right away or held for an admin
+	ModerateReview(ctx context.Context, input ReviewModerationInput) (*ReviewModeration, error)
+}
+
+type reviewModerationUseCase struct {
+	moderationRepo repositories.ReviewModerationRepository
+}
+
+// NewReviewModerationUseCase creates a new review moderation use case
+func NewReviewModerationUseCase(moderationRepo repositories.ReviewModerationRepository) ReviewModerationUseCase {
+	return &reviewModerationUseCase{
+		moderationRepo: moderationRepo,
+	}
+}
+
+const (
+	// defaultReviewsPerDay caps the reviews a customer writes a day until the settings are saved
+	defaultReviewsPerDay = 10
+	// defaultReviewModerationTestDays is how many days of reviews settings are tested on by default
+	defaultReviewModerationTestDays = 7
+	maxReviewModerationTestDays     = 90
+	// defaultReviewModerationTestLimit is how many of the most recent reviews are tested by default
+	defaultReviewModerationTestLimit = 500
+	maxReviewModerationTestLimit     = 1000
+)
+
+// defaultReviewBannedWords are the words and phrases reviews are flagged for until the settings are saved
+var defaultReviewBannedWords = []string{
+	"fake", "spam", "bot", "paid", "advertisement", "promo",
+	"discount code", "coupon", "free shipping", "click here",
+	"visit my", "check out my", "follow me", "subscribe",
+	"buy now", "limited time", "special offer", "contact me",
+	"email me", "whatsapp", "telegram", "instagram", "facebook",
+}
+
+// ReviewModerationInput is a review as the customer wrote it
+type ReviewModerationInput struct {
+	UserID     uuid.UUID
+	Rating     int
+	Title      string // Empty when the customer left the title out
+	Comment    string
+	IsVerified bool
+}
+
+// ReviewModeration is whether a review is published right away, and why it is held if not
+type ReviewModeration struct {
+	Status entities.ReviewStatus           `json:"status"`
+	Flags  []entities.ReviewModerationFlag `json:"flags,omitempty"`
+	// TrustScore is only worked out for verified purchases held to a trust score
+	TrustScore *int `json:"trust_score,omitempty"`
+}
+
+// UpdateReviewModerationSettingsRequest represents a partial update of the review moderation settings
+type UpdateReviewModerationSettingsRequest struct {
+	AutoApproveVerified  *bool `json:"auto_approve_verified"`
+	MinTrustScore        *int  `json:"min_trust_score"`
+	AutoApproveByContent *bool `json:"auto_approve_by_content"`
+
+	FlagLinks        *bool `json:"flag_links"`
+	FlagPhoneNumbers *bool `json:"flag_phone_numbers"`
+	// BannedWords replaces the banned words and phrases; they match whole words, ignoring case and punctuation
+	BannedWords *[]string `json:"banned_words"`
+
+	MaxReviewsPerDay *int `json:"max_reviews_per_day"`
+}
+
+// TestReviewModerationSettingsRequest represents settings changes to try on recent reviews
+type TestReviewModerationSettingsRequest struct {
+	UpdateReviewModerationSettingsRequest
+	Days  int `json:"days"`  // Days of reviews to test, 7 by default and at most 90
+	Limit int `json:"limit"` // Most recent reviews to test, 500 by default and at most 1000
+}
+
+// ReviewModerationTestResponse shows how changed settings would have treated recent reviews
+type ReviewModerationTestResponse struct {
+	Settings *entities.ReviewModerationSettings `json:"settings"` // The settings tested
+	From     time.Time                          `json:"from"`
+	Tested   int                                `json:"tested"`
+
+	Approved    int `json:"approved"`     // Would be published right away
+	Held        int `json:"held"`         // Would be held for an admin
+	Flagged     int `json:"flagged"`      // Held because a heuristic flagged them
+	RateLimited int `json:"rate_limited"` // Would be refused over the daily limit
+	Changed     int `json:"changed"`      // Would be treated differently than under the saved settings
+
+	// Reviews are the reviews that would be flagged, refused or treated differently, newest first
+	Reviews []*ReviewModerationTestResult `json:"reviews"`
+}
+
+// ReviewModerationTestResult is how the tested settings would have treated a review
+type ReviewModerationTestResult struct {
+	ReviewID      uuid.UUID             `json:"review_id"`
+	ProductID     uuid.UUID             `json:"product_id"`
+	UserID        uuid.UUID             `json:"user_id"`
+	Rating        int                   `json:"rating"`
+	Title         string                `json:"title"`
+	Comment       string                `json:"comment"`
+	IsVerified    bool                  `json:"is_verified"`
+	CurrentStatus entities.ReviewStatus `json:"current_status"`
+	CreatedAt     time.Time             `json:"created_at"`
+
+	ReviewModeration
+	RateLimited bool `json:"rate_limited"`
+	// SavedStatus is how the saved settings treat the review; empty when refused over the limit
+	SavedStatus entities.ReviewStatus `json:"saved_status,omitempty"`
+	Changed     bool                  `json:"changed"`
+}
+
+// GetSettings gets the review moderation settings, or the defaults if they were never saved
+func (uc *reviewModerationUseCase) GetSettings(ctx context.Context) (*entities.ReviewModerationSettings, error) {
+	settings, err := uc.moderationRepo.GetSettings(ctx)
+	if err == entities.ErrReviewModerationSettingsNotFound {
+		return &entities.ReviewModerationSettings{
+			AutoApproveVerified:  true,
+			AutoApproveByContent: true,
+			FlagLinks:            true,
+			FlagPhoneNumbers:     true,
+			BannedWords:          append([]string(nil), defaultReviewBannedWords...),
+			MaxReviewsPerDay:     defaultReviewsPerDay,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review moderation settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateSettings updates the review moderation settings
+func (uc *reviewModerationUseCase) UpdateSettings(ctx context.Context, adminID uuid.UUID, req UpdateReviewModerationSettingsRequest) (*entities.ReviewModerationSettings, error) {
+	settings, err := uc.changedSettings(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	settings.UpdatedBy = &adminID
+
+	if err := uc.moderationRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save review moderation settings: %w", err)
+	}
+	return settings, nil
+}
+
+// changedSettings applies changes to the saved settings and validates the result
+func (uc *reviewModerationUseCase) changedSettings(ctx context.Context, req UpdateReviewModerationSettingsRequest) (*entities.ReviewModerationSettings, error) {
+	settings, err := uc.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AutoApproveVerified != nil {
+		settings.AutoApproveVerified = *req.AutoApproveVerified
+	}
+	if req.MinTrustScore != nil {
+		settings.MinTrustScore = *req.MinTrustScore
+	}
+	if req.AutoApproveByContent != nil {
+		settings.AutoApproveByContent = *req.AutoApproveByContent
+	}
+	if req.FlagLinks != nil {
+		settings.FlagLinks = *req.FlagLinks
+	}
+	if req.FlagPhoneNumbers != nil {
+		settings.FlagPhoneNumbers = *req.FlagPhoneNumbers
+	}
+	if req.BannedWords != nil {
+		settings.BannedWords = normalizeBannedWords(*req.BannedWords)
+	}
+	if req.MaxReviewsPerDay != nil {
+		settings.MaxReviewsPerDay = *req.MaxReviewsPerDay
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	return settings, nil
+}
+
+// normalizeBannedWords matches banned words the way review content is matched, dropping empty and repeated ones
+func normalizeBannedWords(words []string) []string {
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(words))
+	for _, word := range words {
+		word = entities.NormalizeReviewWords(word)
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		normalized = append(normalized, word)
+	}
+	return normalized
+}
+
+// CheckReviewLimit checks that a customer may write another review today
+func (uc *reviewModerationUseCase) CheckReviewLimit(ctx context.Context, userID uuid.UUID) error {
+	settings, err := uc.GetSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if settings.MaxReviewsPerDay == 0 {
+		return nil
+	}
+
+	count, err := uc.moderationRepo.CountUserReviewsSince(ctx, userID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return fmt.Errorf("failed to count recent reviews: %w", err)
+	}
+	if count >= int64(settings.MaxReviewsPerDay) {
+		return entities.ErrReviewLimitReached
+	}
+	return nil
+}
+
+// ModerateReview moderates a review with the saved settings
+func (uc *reviewModerationUseCase) ModerateReview(ctx context.Context, input ReviewModerationInput) (*ReviewModeration, error) {
+	settings, err := uc.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return moderateReview(settings, input, func() (int, error) {
+		return uc.trustScore(ctx, input.UserID)
+	})
+}
+
+// trustScore works out a customer's reviewer trust score as of now
+func (uc *reviewModerationUseCase) trustScore(ctx context.Context, userID uuid.UUID) (int, error) {
+	stats, err := uc.moderationRepo.GetReviewerStats(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get reviewer stats: %w", err)
+	}
+	return stats.TrustScore(time.Now()), nil
+}
+
+// moderateReview applies the settings to a review. Flagged reviews are held; verified purchases
+// of trusted reviewers are published; others are published when they have enough content for
+// their rating. The trust score is only worked out when it decides the outcome.
+func moderateReview(settings *entities.ReviewModerationSettings, input ReviewModerationInput, trustScore func() (int, error)) (*ReviewModeration, error) {
+	flags := settings.Check(input.Title, input.Comment)
+	if pattern := reviewSpamPattern(input.Title, input.Comment); pattern != "" {
+		flags = append(flags, entities.ReviewModerationFlag{Rule: entities.ReviewModerationRuleSpamPattern, Match: pattern})
+	}
+	if len(flags) > 0 {
+		return &ReviewModeration{Status: entities.ReviewStatusPending, Flags: flags}, nil
+	}
+
+	moderation := &ReviewModeration{Status: entities.ReviewStatusPending}
+	if input.IsVerified && settings.AutoApproveVerified {
+		if settings.MinTrustScore == 0 {
+			moderation.Status = entities.ReviewStatusApproved
+			return moderation, nil
+		}
+		score, err := trustScore()
+		if err != nil {
+			return nil, err
+		}
+		moderation.TrustScore = &score
+		if score >= settings.MinTrustScore {
+			moderation.Status = entities.ReviewStatusApproved
+			return moderation, nil
+		}
+	}
+
+	if settings.AutoApproveByContent && hasEnoughReviewContent(input.Rating, input.Title, input.Comment) {
+		moderation.Status = entities.ReviewStatusApproved
+	}
+	return moderation, nil
+}
+
+// hasEnoughReviewContent checks that a review says enough for its rating to be trusted without a
+// look: a short title or comment for 4-5 stars, and a longer comment for 3 stars and lower
+func hasEnoughReviewContent(rating int, title, comment string) bool {
+	commentLength := len(strings.TrimSpace(comment))
+	switch {
+	case rating >= 4:
+		return commentLength >= 10 || len(strings.TrimSpace(title)) >= 5
+	case rating == 3:
+		return commentLength >= 20
+	default:
+		return commentLength >= 30
+	}
+}
+
+// reviewSpamPattern returns the spam pattern a review shows, or "" if it shows none
+func reviewSpamPattern(title, comment string) string {
+	content := strings.ToLower(comment + " " + title)
+
+	// Very short content says nothing
+	if len(strings.TrimSpace(content)) < 5 {
+		return "too short"
+	}
+
+	// The same word repeated more than 3 times
+	words := strings.Fields(content)
+	if len(words) > 5 {
+		wordCount := make(map[string]int)
+		for _, word := range words {
+			if len(word) > 3 { // Only count meaningful words
+				wordCount[word]++
+				if wordCount[word] > 3 {
+					return "repeated word: " + word
+				}
+			}
+		}
+	}
+
+	// More than 50% uppercase
+	if len(comment) > 10 {
+		upperCount := 0
+		for _, char := range comment {
+			if char >= 'A' && char <= 'Z' {
+				upperCount++
+			}
+		}
+		if float64(upperCount)/float64(len(comment)) > 0.5 {
+			return "excessive capitals"
+		}
+	}
+
+	// More than 20% punctuation
+	if len(content) > 10 {
+		punctCount := 0
+		for _, char := range content {
+			if char == '!' || char == '?' || char == '.' {
+				punctCount++
+			}
+		}
+		if float64(punctCount)/float64(len(content)) > 0.2 {
+			return "excessive punctuation"
+		}
+	}
+
+	return ""
+}
+
+// TestSettings applies changed settings and the saved ones to recent reviews, as if each review
+// were being written again. Trust scores are as of now, and rate limits only count the reviews tested.
+func (uc *reviewModerationUseCase) TestSettings(ctx context.Context, req TestReviewModerationSettingsRequest) (*ReviewModerationTestResponse, error) {
+	if req.Days <= 0 {
+		req.Days = defaultReviewModerationTestDays
+	}
+	if req.Days > maxReviewModerationTestDays {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("days must be at most %d", maxReviewModerationTestDays))
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultReviewModerationTestLimit
+	}
+	if req.Limit > maxReviewModerationTestLimit {
+		req.Limit = maxReviewModerationTestLimit
+	}
+
+	saved, err := uc.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tested, err := uc.changedSettings(ctx, req.UpdateReviewModerationSettingsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reviews from the day before count towards the daily limit of the first ones tested
+	from := time.Now().AddDate(0, 0, -req.Days)
+	reviews, err := uc.moderationRepo.ListReviewsSince(ctx, from.Add(-24*time.Hour), req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent reviews: %w", err)
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		return reviews[i].CreatedAt.Before(reviews[j].CreatedAt)
+	})
+	testedLimited := reviewsOverLimit(reviews, tested.MaxReviewsPerDay)
+	savedLimited := reviewsOverLimit(reviews, saved.MaxReviewsPerDay)
+
+	scores := make(map[uuid.UUID]int)
+	trustScore := func(userID uuid.UUID) func() (int, error) {
+		return func() (int, error) {
+			if score, ok := scores[userID]; ok {
+				return score, nil
+			}
+			score, err := uc.trustScore(ctx, userID)
+			if err != nil {
+				return 0, err
+			}
+			scores[userID] = score
+			return score, nil
+		}
+	}
+
+	response := &ReviewModerationTestResponse{
+		Settings: tested,
+		From:     from,
+		Reviews:  make([]*ReviewModerationTestResult, 0),
+	}
+	for i := len(reviews) - 1; i >= 0; i-- {
+		review := reviews[i]
+		if review.CreatedAt.Before(from) {
+			continue
+		}
+		input := ReviewModerationInput{
+			UserID:     review.UserID,
+			Rating:     review.Rating,
+			Title:      review.Title,
+			Comment:    review.Comment,
+			IsVerified: review.IsVerified,
+		}
+		// Titles filled in for customers who left them out were not written by them
+		if input.Title == defaultReviewTitle(review.Rating) {
+			input.Title = ""
+		}
+
+		result := &ReviewModerationTestResult{
+			ReviewID:      review.ID,
+			ProductID:     review.ProductID,
+			UserID:        review.UserID,
+			Rating:        review.Rating,
+			Title:         review.Title,
+			Comment:       review.Comment,
+			IsVerified:    review.IsVerified,
+			CurrentStatus: review.Status,
+			CreatedAt:     review.CreatedAt,
+			RateLimited:   testedLimited[review.ID],
+		}
+		if !result.RateLimited {
+			moderation, err := moderateReview(tested, input, trustScore(review.UserID))
+			if err != nil {
+				return nil, err
+			}
+			result.ReviewModeration = *moderation
+		}
+		if !savedLimited[review.ID] {
+			moderation, err := moderateReview(saved, input, trustScore(review.UserID))
+			if err != nil {
+				return nil, err
+			}
+			result.SavedStatus = moderation.Status
+		}
+		result.Changed = result.Status != result.SavedStatus
+
+		response.Tested++
+		switch {
+		case result.RateLimited:
+			response.RateLimited++
+		case result.Status == entities.ReviewStatusApproved:
+			response.Approved++
+		default:
+			response.Held++
+			if len(result.Flags) > 0 {
+				response.Flagged++
+			}
+		}
+		if result.Changed {
+			response.Changed++
+		}
+		if result.RateLimited || len(result.Flags) > 0 || result.Changed {
+			response.Reviews = append(response.Reviews, result)
+		}
+	}
+
+	return response, nil
+}
+
+// reviewsOverLimit returns the reviews, sorted oldest first, that a daily limit would have refused.
+// Refused reviews don't count towards the limit of the ones after them.
+func reviewsOverLimit(reviews []*entities.Review, maxPerDay int) map[uuid.UUID]bool {
+	limited := make(map[uuid.UUID]bool)
+	if maxPerDay == 0 {
+		return limited
+	}
+
+	accepted := make(map[uuid.UUID][]time.Time)
+	for _, review := range reviews {
+		dayStart := review.CreatedAt.Add(-24 * time.Hour)
+		recent := 0
+		for _, at := range accepted[review.UserID] {
+			if at.After(dayStart) {
+				recent++
+			}
+		}
+		if recent >= maxPerDay {
+			limited[review.ID] = true
+			continue
+		}
+		accepted[review.UserID] = append(accepted[review.UserID], review.CreatedAt)
+	}
+	return limited
+}
@@ -2,12 +2,19 @@ package usecases
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -23,6 +30,7 @@ type ReviewUseCase interface {
 	VoteReview(ctx context.Context, userID, reviewID uuid.UUID, voteType entities.ReviewVoteType) error
 	RemoveVote(ctx context.Context, userID, reviewID uuid.UUID) error
 	GetProductRatingSummary(ctx context.Context, productID uuid.UUID) (*ProductRatingSummaryResponse, error)
+	GetProductReviewSummary(ctx context.Context, productID uuid.UUID) (*ProductReviewSummaryResponse, error)
 
 	// Admin operations
 	ApproveReview(ctx context.Context, reviewID uuid.UUID) error
@@ -44,6 +52,9 @@ type reviewUseCase struct {
 	orderRepo           repositories.OrderRepository
 	userRepo            repositories.UserRepository
 	notificationService ReviewNotificationService
+	activityTracker     ActivityTracker
+	incentiveService    ReviewIncentiveService
+	moderationService   ReviewModerationService
 }
 
 // NewReviewUseCase creates a new review use case
@@ -55,6 +66,9 @@ func NewReviewUseCase(
 	orderRepo repositories.OrderRepository,
 	userRepo repositories.UserRepository,
 	notificationService ReviewNotificationService,
+	activityTracker ActivityTracker,
+	incentiveService ReviewIncentiveService,
+	moderationService ReviewModerationService,
 ) ReviewUseCase {
 	return &reviewUseCase{
 		reviewRepo:          reviewRepo,
@@ -64,6 +78,9 @@ func NewReviewUseCase(
 		orderRepo:           orderRepo,
 		userRepo:            userRepo,
 		notificationService: notificationService,
+		activityTracker:     activityTracker,
+		incentiveService:    incentiveService,
+		moderationService:   moderationService,
 	}
 }
 
@@ -75,6 +92,11 @@ type CreateReviewRequest struct {
 	Title     string     `json:"title" validate:"max=200"`    // Optional title
 	Comment   string     `json:"comment" validate:"max=2000"` // Optional comment
 	Images    []string   `json:"images"`
+
+	// Optional 1-5 ratings for individual aspects of the purchase
+	QualityRating  *int `json:"quality_rating,omitempty" validate:"omitempty,min=1,max=5"`
+	ValueRating    *int `json:"value_rating,omitempty" validate:"omitempty,min=1,max=5"`
+	ShippingRating *int `json:"shipping_rating,omitempty" validate:"omitempty,min=1,max=5"`
 }
 
 // UpdateReviewRequest represents update review request
@@ -83,16 +105,42 @@ type UpdateReviewRequest struct {
 	Title   *string  `json:"title" validate:"omitempty,max=200"`
 	Comment *string  `json:"comment" validate:"omitempty,max=2000"`
 	Images  []string `json:"images"`
+
+	QualityRating  *int `json:"quality_rating,omitempty" validate:"omitempty,min=1,max=5"`
+	ValueRating    *int `json:"value_rating,omitempty" validate:"omitempty,min=1,max=5"`
+	ShippingRating *int `json:"shipping_rating,omitempty" validate:"omitempty,min=1,max=5"`
+}
+
+// Review listing sorts
+const (
+	ReviewSortMostRecent    = "most_recent"
+	ReviewSortMostHelpful   = "most_helpful"
+	ReviewSortHighestRating = "highest_rating"
+	ReviewSortLowestRating  = "lowest_rating"
+)
+
+// reviewSorts maps each review listing sort to the column and direction it orders by
+var reviewSorts = map[string][2]string{
+	ReviewSortMostRecent:    {"created_at", "desc"},
+	ReviewSortMostHelpful:   {"helpful_count", "desc"},
+	ReviewSortHighestRating: {"rating", "desc"},
+	ReviewSortLowestRating:  {"rating", "asc"},
 }
 
 // GetReviewsRequest represents get reviews request
 type GetReviewsRequest struct {
 	Rating     *int   `json:"rating"`
+	Ratings    []int  `json:"ratings"` // Any of these star ratings
 	IsVerified *bool  `json:"is_verified"`
+	Sort       string `json:"sort"`       // most_recent, most_helpful, highest_rating, lowest_rating; overrides sort_by and sort_order
 	SortBy     string `json:"sort_by"`    // created_at, rating, helpful_count
 	SortOrder  string `json:"sort_order"` // asc, desc
 	Limit      int    `json:"limit" validate:"min=1,max=100"`
 	Offset     int    `json:"offset" validate:"min=0"`
+	// Cursor continues from the next_cursor of a previous page instead of using Offset
+	Cursor string `json:"cursor"`
+	// ViewerID is the signed-in shopper, whose own votes are returned with the reviews
+	ViewerID *uuid.UUID `json:"-"`
 }
 
 // ReviewResponse represents review response
@@ -110,6 +158,9 @@ type ReviewResponse struct {
 	HelpfulCount      int                      `json:"helpful_count"`
 	NotHelpfulCount   int                      `json:"not_helpful_count"`
 	HelpfulPercentage float64                  `json:"helpful_percentage"`
+	QualityRating     *int                     `json:"quality_rating,omitempty"`
+	ValueRating       *int                     `json:"value_rating,omitempty"`
+	ShippingRating    *int                     `json:"shipping_rating,omitempty"`
 	Images            []ReviewImageResponse    `json:"images"`
 	UserVote          *entities.ReviewVoteType `json:"user_vote,omitempty"`
 	CreatedAt         time.Time                `json:"created_at"`
@@ -154,8 +205,45 @@ type ProductRatingSummaryResponse struct {
 	RatingCounts       map[int]int     `json:"rating_counts"`
 }
 
+// ReviewKeywordResponse represents a keyword that stands out in a product's positive or negative reviews
+type ReviewKeywordResponse struct {
+	Keyword string `json:"keyword"`
+	// ReviewCount is the number of reviews of that sentiment mentioning the keyword
+	ReviewCount int `json:"review_count"`
+}
+
+// ProductReviewSummaryResponse represents a summary of a product's approved reviews
+type ProductReviewSummaryResponse struct {
+	ProductID          uuid.UUID       `json:"product_id"`
+	AverageRating      float64         `json:"average_rating"`
+	TotalReviews       int             `json:"total_reviews"`
+	RatingCounts       map[int]int     `json:"rating_counts"`
+	RatingDistribution map[int]float64 `json:"rating_distribution"`
+
+	// Positive reviews are rated 4-5 stars, negative reviews 1-2 stars
+	PositiveKeywords    []ReviewKeywordResponse `json:"positive_keywords"`
+	NegativeKeywords    []ReviewKeywordResponse `json:"negative_keywords"`
+	MostHelpfulPositive *ReviewResponse         `json:"most_helpful_positive,omitempty"`
+	MostHelpfulNegative *ReviewResponse         `json:"most_helpful_negative,omitempty"`
+
+	DimensionRatings []entities.ReviewDimensionRating `json:"dimension_ratings"`
+}
+
+const (
+	// reviewSummaryMaxReviews caps how many reviews are read to extract keywords, most helpful first
+	reviewSummaryMaxReviews = 500
+	// reviewSummaryMaxKeywords caps the keywords returned per sentiment
+	reviewSummaryMaxKeywords = 10
+	// reviewKeywordMinReviews is how many reviews must mention a word before it counts as a keyword
+	reviewKeywordMinReviews = 2
+)
+
 // CreateReview creates a new review
 func (uc *reviewUseCase) CreateReview(ctx context.Context, userID uuid.UUID, req CreateReviewRequest) (*ReviewResponse, error) {
+	if err := validateDimensionRatings(req.QualityRating, req.ValueRating, req.ShippingRating); err != nil {
+		return nil, err
+	}
+
 	// Check if product exists
 	_, err := uc.productRepo.GetByID(ctx, req.ProductID)
 	if err != nil {
@@ -170,6 +258,11 @@ func (uc *reviewUseCase) CreateReview(ctx context.Context, userID uuid.UUID, req
 		return uc.updateExistingReview(ctx, userID, existingReview, req)
 	}
 
+	// Customers may only write so many reviews a day
+	if err := uc.moderationService.CheckReviewLimit(ctx, userID); err != nil {
+		return nil, err
+	}
+
 	// Verify order if provided
 	var isVerified bool
 	if req.OrderID != nil {
@@ -191,40 +284,30 @@ func (uc *reviewUseCase) CreateReview(ctx context.Context, userID uuid.UUID, req
 	// Generate default title if not provided
 	title := req.Title
 	if title == "" {
-		switch req.Rating {
-		case 5:
-			title = "Excellent!"
-		case 4:
-			title = "Very Good"
-		case 3:
-			title = "Good"
-		case 2:
-			title = "Fair"
-		case 1:
-			title = "Poor"
-		default:
-			title = "Review"
-		}
+		title = defaultReviewTitle(req.Rating)
 	}
 
-	// Smart auto-approval logic for optimal UX
-	status := uc.determineReviewStatus(req.Rating, req.Comment, req.Title, isVerified)
-
 	// Create review
 	review := &entities.Review{
-		ID:         uuid.New(),
+		ID:         ids.New(),
 		UserID:     userID,
 		ProductID:  req.ProductID,
 		OrderID:    req.OrderID,
 		Rating:     req.Rating,
 		Title:      title,
 		Comment:    req.Comment,
-		Status:     status,
 		IsVerified: isVerified,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
+
+		QualityRating:  req.QualityRating,
+		ValueRating:    req.ValueRating,
+		ShippingRating: req.ShippingRating,
 	}
 
+	// Smart auto-approval logic for optimal UX, judged on what the customer wrote
+	uc.moderateReview(ctx, review, req.Title)
+
 	if err := uc.reviewRepo.Create(ctx, review); err != nil {
 		return nil, err
 	}
@@ -240,8 +323,15 @@ func (uc *reviewUseCase) CreateReview(ctx context.Context, userID uuid.UUID, req
 
 		// Award loyalty points for approved reviews
 		uc.awardReviewLoyaltyPoints(ctx, userID, req.Rating, len(strings.TrimSpace(req.Comment)), isVerified)
+
+		// Grant the review incentive if the program is running
+		rewardApprovedReview(uc.incentiveService, review.ID)
 	}
 
+	trackActivity(ctx, uc.activityTracker, userID, entities.ActivityTypeReviewCreate,
+		"Posted a review", "review", &review.ID,
+		map[string]interface{}{"product_id": req.ProductID, "rating": req.Rating})
+
 	// Get the created review with relationships
 	createdReview, err := uc.reviewRepo.GetByID(ctx, review.ID)
 	if err != nil {
@@ -276,6 +366,15 @@ func (uc *reviewUseCase) updateExistingReview(ctx context.Context, userID uuid.U
 	if req.Title != "" {
 		existingReview.Title = req.Title
 	}
+	if req.QualityRating != nil {
+		existingReview.QualityRating = req.QualityRating
+	}
+	if req.ValueRating != nil {
+		existingReview.ValueRating = req.ValueRating
+	}
+	if req.ShippingRating != nil {
+		existingReview.ShippingRating = req.ShippingRating
+	}
 
 	// Handle comments: Allow multiple comments by appending new ones with timestamps
 	// This allows customers to add follow-up comments about their experience
@@ -292,8 +391,7 @@ func (uc *reviewUseCase) updateExistingReview(ctx context.Context, userID uuid.U
 
 	// Re-evaluate approval status with new content
 	isVerified := existingReview.IsVerified
-	newStatus := uc.determineReviewStatus(existingReview.Rating, existingReview.Comment, existingReview.Title, isVerified)
-	existingReview.Status = newStatus
+	uc.moderateReview(ctx, existingReview, existingReview.Title)
 	existingReview.UpdatedAt = time.Now()
 
 	// Update in database
@@ -313,134 +411,50 @@ func (uc *reviewUseCase) updateExistingReview(ctx context.Context, userID uuid.U
 		if !uc.isSimilarContent(originalComment, existingReview.Comment) || originalRating != existingReview.Rating {
 			uc.awardReviewLoyaltyPointsForUpdate(ctx, userID, req.Rating, len(strings.TrimSpace(req.Comment)), isVerified)
 		}
+
+		rewardApprovedReview(uc.incentiveService, existingReview.ID)
 	}
 
 	return uc.toReviewResponse(existingReview, nil), nil
 }
 
-// determineReviewStatus determines if a review should be auto-approved based on business rules
-// Business Logic: Balance UX with quality control - auto-approve legitimate reviews, flag suspicious ones
-func (uc *reviewUseCase) determineReviewStatus(rating int, comment, title string, isVerified bool) entities.ReviewStatus {
-	// Priority 1: Check for suspicious content first (spam, inappropriate language)
-	if uc.isSuspiciousContent(comment, title) {
-		return entities.ReviewStatusPending
-	}
-
-	// Priority 2: Auto-approve verified purchases (customers who actually bought the product)
-	if isVerified {
-		return entities.ReviewStatusApproved
-	}
-
-	// Priority 3: Auto-approve positive reviews with content (4-5 stars)
-	if rating >= 4 {
-		// Require some content for high ratings to prevent fake reviews
-		if len(strings.TrimSpace(comment)) >= 10 || len(strings.TrimSpace(title)) >= 5 {
-			return entities.ReviewStatusApproved
-		}
-		// Rating-only positive reviews need manual review
-		return entities.ReviewStatusPending
-	}
-
-	// Priority 4: Auto-approve neutral reviews with meaningful content (3 stars)
-	if rating == 3 {
-		// Require meaningful content for neutral reviews
-		if len(strings.TrimSpace(comment)) >= 20 {
-			return entities.ReviewStatusApproved
-		}
-		return entities.ReviewStatusPending
-	}
-
-	// For negative reviews (1-2 stars), require substantial content
-	if rating <= 2 {
-		// Require detailed explanation for negative reviews to prevent spam
-		if len(strings.TrimSpace(comment)) >= 30 {
-			return entities.ReviewStatusApproved
-		}
-		// Short or empty negative reviews need manual review
-		return entities.ReviewStatusPending
-	}
-
-	// Default to pending for edge cases
-	return entities.ReviewStatusPending
+// moderateReview decides if a review is auto-approved or held for an admin, and records why it
+// was held. title is the title the customer wrote, empty when one was filled in for them.
+func (uc *reviewUseCase) moderateReview(ctx context.Context, review *entities.Review, title string) {
+	moderation, err := uc.moderationService.ModerateReview(ctx, ReviewModerationInput{
+		UserID:     review.UserID,
+		Rating:     review.Rating,
+		Title:      title,
+		Comment:    review.Comment,
+		IsVerified: review.IsVerified,
+	})
+	if err != nil {
+		// Hold the review rather than publish it unchecked
+		fmt.Printf("❌ Failed to moderate review, holding it for an admin: %v\n", err)
+		review.Status = entities.ReviewStatusPending
+		review.ModerationFlags = nil
+		return
+	}
+	review.Status = moderation.Status
+	review.ModerationFlags = moderation.Flags
 }
 
-// isSuspiciousContent checks for suspicious patterns in review content
-func (uc *reviewUseCase) isSuspiciousContent(comment, title string) bool {
-	suspiciousWords := []string{
-		"fake", "spam", "bot", "paid", "advertisement", "promo",
-		"discount code", "coupon", "free shipping", "click here",
-		"visit my", "check out my", "follow me", "subscribe",
-		"buy now", "limited time", "special offer", "contact me",
-		"email me", "whatsapp", "telegram", "instagram", "facebook",
-		"www.", "http", ".com", ".net", ".org", "@",
-	}
-
-	content := strings.ToLower(comment + " " + title)
-
-	// Check for suspicious keywords
-	for _, word := range suspiciousWords {
-		if strings.Contains(content, word) {
-			return true
-		}
-	}
-
-	// Check for excessive repetition (spam pattern)
-	words := strings.Fields(content)
-	if len(words) > 5 {
-		wordCount := make(map[string]int)
-		for _, word := range words {
-			if len(word) > 3 { // Only count meaningful words
-				wordCount[word]++
-				if wordCount[word] > 3 { // Same word repeated more than 3 times
-					return true
-				}
-			}
-		}
-	}
-
-	// Check for excessive capitalization (spam pattern)
-	if len(comment) > 10 {
-		upperCount := 0
-		for _, char := range comment {
-			if char >= 'A' && char <= 'Z' {
-				upperCount++
-			}
-		}
-		if float64(upperCount)/float64(len(comment)) > 0.5 { // More than 50% uppercase
-			return true
-		}
-	}
-
-	// Check for excessive punctuation (spam pattern)
-	if len(content) > 10 {
-		punctCount := 0
-		for _, char := range content {
-			if char == '!' || char == '?' || char == '.' {
-				punctCount++
-			}
-		}
-		if float64(punctCount)/float64(len(content)) > 0.2 { // More than 20% punctuation
-			return true
-		}
+// defaultReviewTitle is the title of reviews written without one
+func defaultReviewTitle(rating int) string {
+	switch rating {
+	case 5:
+		return "Excellent!"
+	case 4:
+		return "Very Good"
+	case 3:
+		return "Good"
+	case 2:
+		return "Fair"
+	case 1:
+		return "Poor"
+	default:
+		return "Review"
 	}
-
-	// Check for very short content with extreme ratings (potential fake)
-	if len(strings.TrimSpace(content)) < 5 {
-		return true // Very short content is suspicious
-	}
-
-	// Check for number patterns (phone numbers, etc.)
-	digitCount := 0
-	for _, char := range content {
-		if char >= '0' && char <= '9' {
-			digitCount++
-		}
-	}
-	if digitCount > 8 { // Likely contains phone number or similar
-		return true
-	}
-
-	return false
 }
 
 // isSimilarContent checks if two content strings are similar (for edit detection)
@@ -548,6 +562,7 @@ func (uc *reviewUseCase) awardReviewLoyaltyPoints(ctx context.Context, userID uu
 			user.LoyaltyPoints += points
 			if err := uc.userRepo.Update(ctx, user); err == nil {
 				fmt.Printf("✅ Awarded %d loyalty points for review\n", points)
+				uc.trackPointsEarned(ctx, userID, points, "review")
 			}
 		}
 	}
@@ -581,11 +596,19 @@ func (uc *reviewUseCase) awardReviewLoyaltyPointsForUpdate(ctx context.Context,
 			user.LoyaltyPoints += points
 			if err := uc.userRepo.Update(ctx, user); err == nil {
 				fmt.Printf("✅ Awarded %d loyalty points for review update\n", points)
+				uc.trackPointsEarned(ctx, userID, points, "review_update")
 			}
 		}
 	}
 }
 
+// trackPointsEarned records awarded loyalty points in the user's activity stream
+func (uc *reviewUseCase) trackPointsEarned(ctx context.Context, userID uuid.UUID, points int, reason string) {
+	trackActivity(ctx, uc.activityTracker, userID, entities.ActivityTypePointsEarned,
+		fmt.Sprintf("Earned %d loyalty points", points), "user", &userID,
+		map[string]interface{}{"points": points, "reason": reason})
+}
+
 // GetReview gets a review by ID
 func (uc *reviewUseCase) GetReview(ctx context.Context, reviewID uuid.UUID) (*ReviewResponse, error) {
 	review, err := uc.reviewRepo.GetByID(ctx, reviewID)
@@ -605,23 +628,45 @@ func (uc *reviewUseCase) GetProductReviews(ctx context.Context, productID uuid.U
 	if req.Limit > 100 {
 		req.Limit = 100
 	}
+	if req.Sort != "" {
+		sortBy, ok := reviewSorts[req.Sort]
+		if !ok {
+			return nil, pkgErrors.InvalidInput("sort must be one of most_recent, most_helpful, highest_rating, lowest_rating")
+		}
+		req.SortBy, req.SortOrder = sortBy[0], sortBy[1]
+	}
 	if req.SortBy == "" {
 		req.SortBy = "created_at"
 	}
 	if req.SortOrder == "" {
 		req.SortOrder = "desc"
 	}
+	for _, rating := range req.Ratings {
+		if rating < 1 || rating > 5 {
+			return nil, pkgErrors.InvalidInput("ratings must be between 1 and 5")
+		}
+	}
 
 	approvedStatus := entities.ReviewStatusApproved
 	filter := entities.ReviewFilter{
 		ProductID:  &productID,
 		Rating:     req.Rating,
+		Ratings:    req.Ratings,
 		IsVerified: req.IsVerified,
 		Status:     &approvedStatus,
 		SortBy:     req.SortBy,
 		SortOrder:  req.SortOrder,
-		Limit:      req.Limit,
-		Offset:     req.Offset,
+		// One extra review tells whether there is a next page
+		Limit:  req.Limit + 1,
+		Offset: req.Offset,
+	}
+	if req.Cursor != "" {
+		after, err := decodeReviewCursor(req.Cursor, req.SortBy, req.SortOrder)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+		req.Offset = 0
 	}
 
 	reviews, err := uc.reviewRepo.Search(ctx, filter)
@@ -634,9 +679,30 @@ func (uc *reviewUseCase) GetProductReviews(ctx context.Context, productID uuid.U
 		return nil, err
 	}
 
+	hasMore := len(reviews) > req.Limit
+	if hasMore {
+		reviews = reviews[:req.Limit]
+	}
+
+	var votes map[uuid.UUID]*entities.ReviewVote
+	if req.ViewerID != nil && len(reviews) > 0 {
+		reviewIDs := make([]uuid.UUID, len(reviews))
+		for i, review := range reviews {
+			reviewIDs[i] = review.ID
+		}
+		votes, err = uc.reviewVoteRepo.GetUserVotesForReviews(ctx, *req.ViewerID, reviewIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get review votes: %w", err)
+		}
+	}
+
 	responses := make([]*ReviewResponse, len(reviews))
 	for i, review := range reviews {
-		responses[i] = uc.toReviewResponse(review, nil)
+		var userVote *entities.ReviewVoteType
+		if vote, ok := votes[review.ID]; ok {
+			userVote = &vote.VoteType
+		}
+		responses[i] = uc.toReviewResponse(review, userVote)
 	}
 
 	// Create pagination info using enhanced function
@@ -653,7 +719,17 @@ func (uc *reviewUseCase) GetProductReviews(ctx context.Context, productID uuid.U
 		pagination.PageSizes = []int{5, 10, 20} // Smaller sizes for detailed content
 
 		// Check if cursor pagination should be used
-		pagination.UseCursor = ShouldUseCursorPagination(totalCount, context.EntityType)
+		pagination.UseCursor = req.Cursor != "" || ShouldUseCursorPagination(totalCount, context.EntityType)
+
+		// A cursor page only knows whether more reviews follow it
+		if req.Cursor != "" {
+			pagination.HasPrev = true
+		}
+		pagination.HasNext = hasMore
+		if hasMore {
+			nextCursor := encodeReviewCursor(reviews[len(reviews)-1], req.SortBy, req.SortOrder)
+			pagination.NextCursor = &nextCursor
+		}
 
 		// Generate cache key
 		cacheParams := map[string]interface{}{
@@ -663,11 +739,18 @@ func (uc *reviewUseCase) GetProductReviews(ctx context.Context, productID uuid.U
 		if req.Rating != nil {
 			cacheParams["rating"] = *req.Rating
 		}
+		if len(req.Ratings) > 0 {
+			cacheParams["ratings"] = req.Ratings
+		}
 		if req.IsVerified != nil {
 			cacheParams["is_verified"] = *req.IsVerified
 		}
 		if req.SortBy != "" {
 			cacheParams["sort_by"] = req.SortBy
+			cacheParams["sort_order"] = req.SortOrder
+		}
+		if req.Cursor != "" {
+			cacheParams["cursor"] = req.Cursor
 		}
 		pagination.CacheKey = GenerateCacheKey("reviews", "", cacheParams)
 	}
@@ -718,6 +801,123 @@ func (uc *reviewUseCase) GetProductRatingSummary(ctx context.Context, productID
 	}, nil
 }
 
+// GetProductReviewSummary summarizes a product's approved reviews: the star histogram, keywords that stand out
+// in positive and negative reviews, the most helpful review of each sentiment and per-dimension ratings
+func (uc *reviewUseCase) GetProductReviewSummary(ctx context.Context, productID uuid.UUID) (*ProductReviewSummaryResponse, error) {
+	if _, err := uc.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, entities.ErrProductNotFound
+	}
+
+	stats, err := uc.reviewRepo.GetReviewStats(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review stats: %w", err)
+	}
+
+	dimensions, err := uc.reviewRepo.GetDimensionRatings(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dimension ratings: %w", err)
+	}
+
+	approved := entities.ReviewStatusApproved
+	reviews, err := uc.reviewRepo.GetByProductID(ctx, productID, entities.ReviewFilter{
+		Status:    &approved,
+		SortBy:    "helpful_count",
+		SortOrder: "desc",
+		Limit:     reviewSummaryMaxReviews,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	summary := &ProductReviewSummaryResponse{
+		ProductID:          productID,
+		AverageRating:      stats.AverageRating,
+		TotalReviews:       stats.TotalReviews,
+		RatingCounts:       map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+		RatingDistribution: map[int]float64{1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+		DimensionRatings:   dimensions,
+	}
+	for rating, count := range stats.RatingCounts {
+		summary.RatingCounts[rating] = count
+	}
+	for rating, percentage := range stats.RatingDistribution {
+		summary.RatingDistribution[rating] = percentage
+	}
+
+	// Reviews come most helpful first, so the first of each sentiment is its most helpful
+	var positive, negative []*entities.Review
+	for _, review := range reviews {
+		switch {
+		case review.IsPositive():
+			if summary.MostHelpfulPositive == nil {
+				summary.MostHelpfulPositive = uc.toReviewResponse(review, nil)
+			}
+			positive = append(positive, review)
+		case review.IsNegative():
+			if summary.MostHelpfulNegative == nil {
+				summary.MostHelpfulNegative = uc.toReviewResponse(review, nil)
+			}
+			negative = append(negative, review)
+		}
+	}
+
+	positiveTerms, negativeTerms := countReviewTerms(positive), countReviewTerms(negative)
+	summary.PositiveKeywords = topReviewKeywords(positiveTerms, len(positive), negativeTerms, len(negative))
+	summary.NegativeKeywords = topReviewKeywords(negativeTerms, len(negative), positiveTerms, len(positive))
+
+	return summary, nil
+}
+
+// encodeReviewCursor points at review in a listing sorted by sortBy and sortOrder. The sort is
+// part of the cursor so that it cannot be used with another one.
+func encodeReviewCursor(review *entities.Review, sortBy, sortOrder string) string {
+	sortValue := 0
+	switch sortBy {
+	case "rating":
+		sortValue = review.Rating
+	case "helpful_count":
+		sortValue = review.HelpfulCount
+	}
+	cursor := fmt.Sprintf("%s:%s:%d:%d:%s", sortBy, sortOrder, sortValue, review.CreatedAt.UnixNano(), review.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+// decodeReviewCursor reads a cursor made by encodeReviewCursor for the same sort
+func decodeReviewCursor(cursor, sortBy, sortOrder string) (*entities.ReviewCursor, error) {
+	invalid := pkgErrors.InvalidInput("invalid cursor")
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 5 {
+		return nil, invalid
+	}
+	if parts[0] != sortBy || parts[1] != sortOrder {
+		return nil, pkgErrors.InvalidInput("cursor belongs to a different sort")
+	}
+
+	sortValue, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, invalid
+	}
+	createdAt, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	id, err := uuid.Parse(parts[4])
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &entities.ReviewCursor{
+		SortValue: sortValue,
+		CreatedAt: time.Unix(0, createdAt),
+		ID:        id,
+	}, nil
+}
+
 // toReviewResponse converts review entity to response
 func (uc *reviewUseCase) toReviewResponse(review *entities.Review, userVote *entities.ReviewVoteType) *ReviewResponse {
 	response := &ReviewResponse{
@@ -732,6 +932,9 @@ func (uc *reviewUseCase) toReviewResponse(review *entities.Review, userVote *ent
 		HelpfulCount:      review.HelpfulCount,
 		NotHelpfulCount:   review.NotHelpfulCount,
 		HelpfulPercentage: review.GetHelpfulPercentage(),
+		QualityRating:     review.QualityRating,
+		ValueRating:       review.ValueRating,
+		ShippingRating:    review.ShippingRating,
 		UserVote:          userVote,
 		CreatedAt:         review.CreatedAt,
 		UpdatedAt:         review.UpdatedAt,
@@ -779,6 +982,10 @@ func (uc *reviewUseCase) toReviewResponse(review *entities.Review, userVote *ent
 
 // UpdateReview updates an existing review
 func (uc *reviewUseCase) UpdateReview(ctx context.Context, userID, reviewID uuid.UUID, req UpdateReviewRequest) (*ReviewResponse, error) {
+	if err := validateDimensionRatings(req.QualityRating, req.ValueRating, req.ShippingRating); err != nil {
+		return nil, err
+	}
+
 	// Get existing review
 	review, err := uc.reviewRepo.GetByID(ctx, reviewID)
 	if err != nil {
@@ -809,23 +1016,29 @@ func (uc *reviewUseCase) UpdateReview(ctx context.Context, userID, reviewID uuid
 	if req.Comment != nil {
 		review.Comment = *req.Comment
 	}
+	if req.QualityRating != nil {
+		review.QualityRating = req.QualityRating
+	}
+	if req.ValueRating != nil {
+		review.ValueRating = req.ValueRating
+	}
+	if req.ShippingRating != nil {
+		review.ShippingRating = req.ShippingRating
+	}
 
-	// Check if verified purchase status (for better approval logic)
-	isVerified := review.IsVerified
-
-	// Smart re-approval logic for edits
-	newStatus := uc.determineReviewStatus(review.Rating, review.Comment, review.Title, isVerified)
-
-	// If only minor changes (same rating, similar content), keep approved status
-	if review.Status == entities.ReviewStatusApproved &&
+	// Only minor changes (same rating, similar content) keep approved status
+	minorEdit := review.Status == entities.ReviewStatusApproved &&
 		originalRating == review.Rating &&
 		uc.isSimilarContent(originalComment, review.Comment) &&
-		uc.isSimilarContent(originalTitle, review.Title) {
+		uc.isSimilarContent(originalTitle, review.Title)
+
+	// Smart re-approval logic for edits
+	uc.moderateReview(ctx, review, review.Title)
+	if minorEdit {
 		// Keep approved status for minor edits
-		newStatus = entities.ReviewStatusApproved
+		review.Status = entities.ReviewStatusApproved
+		review.ModerationFlags = nil
 	}
-
-	review.Status = newStatus
 	review.UpdatedAt = time.Now()
 
 	if err := uc.reviewRepo.Update(ctx, review); err != nil {
@@ -839,6 +1052,8 @@ func (uc *reviewUseCase) UpdateReview(ctx context.Context, userID, reviewID uuid
 		} else {
 			fmt.Printf("✅ Product rating updated after review edit\n")
 		}
+
+		rewardApprovedReview(uc.incentiveService, review.ID)
 	}
 
 	return uc.toReviewResponse(review, nil), nil
@@ -984,6 +1199,8 @@ func (uc *reviewUseCase) ApproveReview(ctx context.Context, reviewID uuid.UUID)
 		fmt.Printf("✅ Product rating updated after review approval\n")
 	}
 
+	rewardApprovedReview(uc.incentiveService, review.ID)
+
 	return nil
 }
 
@@ -1106,3 +1323,84 @@ func (uc *reviewUseCase) GetPendingReviews(ctx context.Context, req GetReviewsRe
 		Pagination: pagination,
 	}, nil
 }
+
+// validateDimensionRatings checks that optional dimension ratings are between 1 and 5
+func validateDimensionRatings(ratings ...*int) error {
+	for _, rating := range ratings {
+		if !entities.IsValidDimensionRating(rating) {
+			return pkgErrors.InvalidInput("quality, value and shipping ratings must be between 1 and 5")
+		}
+	}
+	return nil
+}
+
+// reviewKeywordStopwords are common words that say nothing about a product
+var reviewKeywordStopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "but": true, "not": true, "you": true, "all": true,
+	"any": true, "can": true, "had": true, "has": true, "have": true, "her": true, "his": true, "him": true,
+	"was": true, "were": true, "one": true, "our": true, "out": true, "its": true, "it's": true, "this": true,
+	"that": true, "with": true, "they": true, "them": true, "then": true, "than": true, "there": true,
+	"their": true, "what": true, "when": true, "where": true, "which": true, "who": true, "will": true,
+	"would": true, "could": true, "should": true, "been": true, "being": true, "from": true, "into": true,
+	"just": true, "also": true, "very": true, "really": true, "much": true, "more": true, "most": true,
+	"some": true, "such": true, "only": true, "own": true, "same": true, "too": true, "about": true,
+	"after": true, "before": true, "again": true, "did": true, "does": true, "doing": true, "get": true,
+	"got": true, "how": true, "because": true, "while": true, "these": true, "those": true, "here": true,
+	"your": true, "yours": true, "mine": true, "she": true,
+	"product": true, "item": true, "bought": true, "buy": true, "update": true,
+	"của": true, "và": true, "này": true, "cho": true, "được": true, "với": true, "một": true,
+	"những": true, "các": true, "thì": true, "nhưng": true, "cũng": true, "sản": true, "phẩm": true,
+	"hàng": true, "mua": true, "rất": true, "mình": true, "như": true, "khi": true, "đến": true,
+}
+
+// reviewTerms returns the distinct keywords in a review's title and comment
+func reviewTerms(review *entities.Review) map[string]bool {
+	terms := make(map[string]bool)
+	words := strings.FieldsFunc(strings.ToLower(review.Title+" "+review.Comment), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+	for _, word := range words {
+		word = strings.Trim(word, "'")
+		if utf8.RuneCountInString(word) < 3 || reviewKeywordStopwords[word] {
+			continue
+		}
+		terms[word] = true
+	}
+	return terms
+}
+
+// countReviewTerms counts how many reviews mention each keyword
+func countReviewTerms(reviews []*entities.Review) map[string]int {
+	counts := make(map[string]int)
+	for _, review := range reviews {
+		for term := range reviewTerms(review) {
+			counts[term]++
+		}
+	}
+	return counts
+}
+
+// topReviewKeywords picks the keywords mentioned by a larger share of one sentiment's reviews than of the other's
+func topReviewKeywords(counts map[string]int, total int, otherCounts map[string]int, otherTotal int) []ReviewKeywordResponse {
+	keywords := make([]ReviewKeywordResponse, 0)
+	for term, count := range counts {
+		if count < reviewKeywordMinReviews {
+			continue
+		}
+		if otherTotal > 0 && float64(otherCounts[term])/float64(otherTotal) >= float64(count)/float64(total) {
+			continue
+		}
+		keywords = append(keywords, ReviewKeywordResponse{Keyword: term, ReviewCount: count})
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].ReviewCount != keywords[j].ReviewCount {
+			return keywords[i].ReviewCount > keywords[j].ReviewCount
+		}
+		return keywords[i].Keyword < keywords[j].Keyword
+	})
+	if len(keywords) > reviewSummaryMaxKeywords {
+		keywords = keywords[:reviewSummaryMaxKeywords]
+	}
+	return keywords
+}
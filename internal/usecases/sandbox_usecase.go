@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/infrastructure/payment"
+	"ecom-golang-clean-architecture/internal/infrastructure/sandbox"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+)
+
+// CapturedEmail is an email held in the sandbox mailbox
+type CapturedEmail = sandbox.CapturedEmail
+
+// SandboxJobRunner runs registered background jobs on demand
+type SandboxJobRunner interface {
+	JobNames() []string
+	RunNow(ctx context.Context, name string) error
+}
+
+// SandboxResetter is implemented by simulators that keep state between requests
+type SandboxResetter interface {
+	Reset()
+}
+
+// SandboxUseCase defines the interface for sandbox mode tooling
+type SandboxUseCase interface {
+	GetInfo(ctx context.Context) *SandboxInfoResponse
+
+	// Mailbox
+	ListEmails(ctx context.Context, to string) *SandboxMailboxResponse
+	GetEmail(ctx context.Context, id string) (*CapturedEmail, error)
+	ClearMailbox(ctx context.Context)
+
+	// Payment webhooks
+	TriggerWebhook(ctx context.Context, provider string, req TriggerWebhookRequest) (*TriggerWebhookResponse, error)
+
+	// Clock and jobs
+	GetClock(ctx context.Context) *SandboxClockResponse
+	AdvanceClock(ctx context.Context, req AdvanceClockRequest) (*SandboxClockResponse, error)
+	ResetClock(ctx context.Context) *SandboxClockResponse
+	RunJob(ctx context.Context, name string) (*RunJobResponse, error)
+
+	// Reset clears the mailbox, rewinds the clock and restarts simulator ID sequences
+	Reset(ctx context.Context) *SandboxInfoResponse
+}
+
+type sandboxUseCase struct {
+	clock          *sandbox.Clock
+	mailbox        *sandbox.Mailbox
+	paymentUseCase PaymentUseCase
+	jobRunner      SandboxJobRunner
+	resetters      []SandboxResetter
+}
+
+// NewSandboxUseCase creates a new sandbox use case
+func NewSandboxUseCase(
+	clock *sandbox.Clock,
+	mailbox *sandbox.Mailbox,
+	paymentUseCase PaymentUseCase,
+	jobRunner SandboxJobRunner,
+	resetters ...SandboxResetter,
+) SandboxUseCase {
+	return &sandboxUseCase{
+		clock:          clock,
+		mailbox:        mailbox,
+		paymentUseCase: paymentUseCase,
+		jobRunner:      jobRunner,
+		resetters:      resetters,
+	}
+}
+
+// SandboxInfoResponse describes the sandbox environment
+type SandboxInfoResponse struct {
+	MagicCards     []payment.MagicCard  `json:"magic_cards"`
+	WebhookEvents  []string             `json:"webhook_events"`
+	Jobs           []string             `json:"jobs"`
+	Clock          SandboxClockResponse `json:"clock"`
+	CapturedEmails int                  `json:"captured_emails"`
+}
+
+// SandboxMailboxResponse represents captured emails
+type SandboxMailboxResponse struct {
+	Emails []*CapturedEmail `json:"emails"`
+	Total  int              `json:"total"`
+}
+
+// TriggerWebhookRequest represents a simulated gateway webhook
+type TriggerWebhookRequest struct {
+	ID   string                 `json:"id"`
+	Type string                 `json:"type" validate:"required"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// TriggerWebhookResponse reports how a simulated webhook was processed
+type TriggerWebhookResponse struct {
+	Provider string `json:"provider"`
+	Type     string `json:"type"`
+	Payload  string `json:"payload"`
+}
+
+// SandboxClockResponse represents the sandbox clock
+type SandboxClockResponse struct {
+	Now           time.Time `json:"now"`
+	Offset        string    `json:"offset"`
+	OffsetSeconds int64     `json:"offset_seconds"`
+}
+
+// AdvanceClockRequest represents a request to move the sandbox clock forward
+type AdvanceClockRequest struct {
+	Duration string `json:"duration" validate:"required"` // Go duration such as "90m" or "72h"
+}
+
+// RunJobResponse reports the outcome of a manually triggered job
+type RunJobResponse struct {
+	Job        string    `json:"job"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	RanAt      time.Time `json:"ran_at"`
+}
+
+// sandboxWebhookEvents lists the webhook types the payment use case acts on
+var sandboxWebhookEvents = []string{
+	"checkout.session.completed",
+	"payment_intent.succeeded",
+	"payment_intent.payment_failed",
+}
+
+// GetInfo returns magic cards, webhook types, jobs and current sandbox state
+func (uc *sandboxUseCase) GetInfo(ctx context.Context) *SandboxInfoResponse {
+	return &SandboxInfoResponse{
+		MagicCards:     payment.MagicCards,
+		WebhookEvents:  sandboxWebhookEvents,
+		Jobs:           uc.jobRunner.JobNames(),
+		Clock:          *uc.GetClock(ctx),
+		CapturedEmails: len(uc.mailbox.List("")),
+	}
+}
+
+// ListEmails returns captured emails, newest first, optionally for a single recipient
+func (uc *sandboxUseCase) ListEmails(ctx context.Context, to string) *SandboxMailboxResponse {
+	emails := uc.mailbox.List(to)
+	return &SandboxMailboxResponse{
+		Emails: emails,
+		Total:  len(emails),
+	}
+}
+
+// GetEmail returns a captured email
+func (uc *sandboxUseCase) GetEmail(ctx context.Context, id string) (*CapturedEmail, error) {
+	email, ok := uc.mailbox.Get(id)
+	if !ok {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeNotFound, "Captured email not found")
+	}
+	return email, nil
+}
+
+// ClearMailbox removes all captured emails
+func (uc *sandboxUseCase) ClearMailbox(ctx context.Context) {
+	uc.mailbox.Clear()
+}
+
+// TriggerWebhook feeds a simulated webhook through the regular payment webhook pipeline
+func (uc *sandboxUseCase) TriggerWebhook(ctx context.Context, provider string, req TriggerWebhookRequest) (*TriggerWebhookResponse, error) {
+	provider = strings.ToLower(provider)
+	if provider != string(payment.PaymentProviderStripe) && provider != string(payment.PaymentProviderPayPal) {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("unsupported payment provider: %s", provider))
+	}
+	if strings.TrimSpace(req.Type) == "" {
+		return nil, pkgErrors.InvalidInput("webhook type is required")
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("invalid webhook data: %v", err))
+	}
+
+	if err := uc.paymentUseCase.HandleWebhook(ctx, provider, payload, "sandbox"); err != nil {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("webhook processing failed: %v", err))
+	}
+
+	return &TriggerWebhookResponse{
+		Provider: provider,
+		Type:     req.Type,
+		Payload:  string(payload),
+	}, nil
+}
+
+// GetClock returns the sandbox clock
+func (uc *sandboxUseCase) GetClock(ctx context.Context) *SandboxClockResponse {
+	offset := uc.clock.Offset()
+	return &SandboxClockResponse{
+		Now:           uc.clock.Now(),
+		Offset:        offset.String(),
+		OffsetSeconds: int64(offset / time.Second),
+	}
+}
+
+// AdvanceClock moves the sandbox clock forward
+func (uc *sandboxUseCase) AdvanceClock(ctx context.Context, req AdvanceClockRequest) (*SandboxClockResponse, error) {
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("invalid duration %q: use a value such as 90m or 72h", req.Duration))
+	}
+	if duration <= 0 {
+		return nil, pkgErrors.InvalidInput("duration must be positive")
+	}
+
+	uc.clock.Advance(duration)
+	return uc.GetClock(ctx), nil
+}
+
+// ResetClock returns the sandbox clock to real time
+func (uc *sandboxUseCase) ResetClock(ctx context.Context) *SandboxClockResponse {
+	uc.clock.Reset()
+	return uc.GetClock(ctx)
+}
+
+// RunJob runs a background job immediately, against the sandbox clock
+func (uc *sandboxUseCase) RunJob(ctx context.Context, name string) (*RunJobResponse, error) {
+	registered := false
+	for _, jobName := range uc.jobRunner.JobNames() {
+		if jobName == name {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeNotFound, fmt.Sprintf("job %s is not registered", name))
+	}
+
+	started := time.Now()
+	response := &RunJobResponse{
+		Job:     name,
+		Success: true,
+		RanAt:   uc.clock.Now(),
+	}
+	if err := uc.jobRunner.RunNow(ctx, name); err != nil {
+		response.Success = false
+		response.Error = err.Error()
+	}
+	response.DurationMs = time.Since(started).Milliseconds()
+
+	return response, nil
+}
+
+// Reset returns the sandbox to a clean state
+func (uc *sandboxUseCase) Reset(ctx context.Context) *SandboxInfoResponse {
+	uc.mailbox.Clear()
+	uc.clock.Reset()
+	for _, resetter := range uc.resetters {
+		resetter.Reset()
+	}
+	return uc.GetInfo(ctx)
+}
@@ -72,14 +72,16 @@ type searchUseCase struct {
 	searchRepo          repositories.SearchRepository
 	productRepo         repositories.ProductRepository
 	productCategoryRepo repositories.ProductCategoryRepository
+	visibilityPolicy    CatalogVisibilityPolicy
 }
 
 // NewSearchUseCase creates a new search use case
-func NewSearchUseCase(searchRepo repositories.SearchRepository, productRepo repositories.ProductRepository, productCategoryRepo repositories.ProductCategoryRepository) SearchUseCase {
+func NewSearchUseCase(searchRepo repositories.SearchRepository, productRepo repositories.ProductRepository, productCategoryRepo repositories.ProductCategoryRepository, visibilityPolicy CatalogVisibilityPolicy) SearchUseCase {
 	return &searchUseCase{
 		searchRepo:          searchRepo,
 		productRepo:         productRepo,
 		productCategoryRepo: productCategoryRepo,
+		visibilityPolicy:    visibilityPolicy,
 	}
 }
 
@@ -419,6 +421,15 @@ func (uc *searchUseCase) FullTextSearch(ctx context.Context, req FullTextSearchR
 		Offset:    offset,
 	}
 
+	// Hide products restricted away from the viewer
+	if uc.visibilityPolicy != nil {
+		hiddenIDs, err := uc.visibilityPolicy.HiddenProductIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		params.ExcludeIDs = hiddenIDs
+	}
+
 	// Perform search
 	products, total, err := uc.searchRepo.FullTextSearch(ctx, params)
 	if err != nil {
@@ -428,7 +439,7 @@ func (uc *searchUseCase) FullTextSearch(ctx context.Context, req FullTextSearchR
 	// Convert to response format
 	productResponses := make([]*ProductResponse, len(products))
 	for i, product := range products {
-		productResponses[i] = uc.toProductResponse(product)
+		productResponses[i] = uc.toProductResponse(ctx, product)
 	}
 
 	// Calculate pagination
@@ -510,42 +521,44 @@ func (uc *searchUseCase) FullTextSearch(ctx context.Context, req FullTextSearchR
 }
 
 // Helper method to convert product to response
-func (uc *searchUseCase) toProductResponse(product *entities.Product) *ProductResponse {
+func (uc *searchUseCase) toProductResponse(ctx context.Context, product *entities.Product) *ProductResponse {
 	// Use the existing product usecase conversion logic
 	// For now, create a simplified response for search results
 	response := &ProductResponse{
-		ID:                product.ID,
-		Name:              product.Name,
-		Description:       product.Description,
-		ShortDescription:  product.ShortDescription,
-		SKU:               product.SKU,
-		Slug:              product.Slug,
-		MetaTitle:         product.MetaTitle,
-		MetaDescription:   product.MetaDescription,
-		Keywords:          product.Keywords,
-		Featured:          product.Featured,
-		Visibility:        product.Visibility,
-		Price:             product.Price,
-		ComparePrice:      product.ComparePrice,
-		CostPrice:         product.CostPrice,
-		SalePrice:         product.SalePrice,
-		SaleStartDate:     product.SaleStartDate,
-		SaleEndDate:       product.SaleEndDate,
-		Stock:             product.Stock,
-		LowStockThreshold: product.LowStockThreshold,
-		TrackQuantity:     product.TrackQuantity,
-		AllowBackorder:    product.AllowBackorder,
-		StockStatus:       product.StockStatus,
-		Weight:            product.Weight,
-		RequiresShipping:  product.RequiresShipping,
-		ShippingClass:     product.ShippingClass,
-		TaxClass:          product.TaxClass,
-		CountryOfOrigin:   product.CountryOfOrigin,
-		Status:            product.Status,
-		ProductType:       product.ProductType,
-		IsDigital:         product.IsDigital,
-		CreatedAt:         product.CreatedAt,
-		UpdatedAt:         product.UpdatedAt,
+		ID:                    product.ID,
+		Name:                  product.Name,
+		Description:           product.Description,
+		ShortDescription:      product.ShortDescription,
+		SKU:                   product.SKU,
+		Slug:                  product.Slug,
+		MetaTitle:             product.MetaTitle,
+		MetaDescription:       product.MetaDescription,
+		Keywords:              product.Keywords,
+		Featured:              product.Featured,
+		Visibility:            product.Visibility,
+		Price:                 product.Price,
+		ComparePrice:          product.ComparePrice,
+		CostPrice:             product.CostPrice,
+		SalePrice:             product.SalePrice,
+		SaleStartDate:         product.SaleStartDate,
+		SaleEndDate:           product.SaleEndDate,
+		Stock:                 &product.Stock,
+		StockVisibility:       product.StockVisibility,
+		StockDisplayThreshold: product.StockDisplayThreshold,
+		LowStockThreshold:     product.LowStockThreshold,
+		TrackQuantity:         product.TrackQuantity,
+		AllowBackorder:        product.AllowBackorder,
+		StockStatus:           product.StockStatus,
+		Weight:                product.Weight,
+		RequiresShipping:      product.RequiresShipping,
+		ShippingClass:         product.ShippingClass,
+		TaxClass:              product.TaxClass,
+		CountryOfOrigin:       product.CountryOfOrigin,
+		Status:                product.Status,
+		ProductType:           product.ProductType,
+		IsDigital:             product.IsDigital,
+		CreatedAt:             product.CreatedAt,
+		UpdatedAt:             product.UpdatedAt,
 	}
 
 	// Calculate computed fields using unified price logic
@@ -602,6 +615,9 @@ func (uc *searchUseCase) toProductResponse(product *entities.Product) *ProductRe
 		})
 	}
 
+	restrictStock(ctx, response, product)
+	applyStorefrontPrice(ctx, response)
+
 	return response
 }
 
@@ -1371,6 +1387,21 @@ func (uc *searchUseCase) EnhancedSearch(ctx context.Context, req *EnhancedSearch
 		DynamicFacets: req.DynamicFacets,
 	}
 
+	// Hide products restricted away from the viewer
+	if uc.visibilityPolicy != nil {
+		var viewerID *uuid.UUID
+		if req.UserID != nil {
+			if parsedUUID, err := uuid.Parse(*req.UserID); err == nil {
+				viewerID = &parsedUUID
+			}
+		}
+		hiddenIDs, err := uc.visibilityPolicy.HiddenProductIDs(ctx, viewerID)
+		if err != nil {
+			return nil, err
+		}
+		params.ExcludeIDs = hiddenIDs
+	}
+
 	// Perform enhanced search
 	products, total, facets, err := uc.searchRepo.EnhancedSearch(ctx, params)
 	if err != nil {
@@ -1380,7 +1411,7 @@ func (uc *searchUseCase) EnhancedSearch(ctx context.Context, req *EnhancedSearch
 	// Convert products to response format
 	var productResponses []ProductResponse
 	for _, product := range products {
-		productResponses = append(productResponses, *uc.toProductResponse(product))
+		productResponses = append(productResponses, *uc.toProductResponse(ctx, product))
 	}
 
 	// Calculate pagination
@@ -0,0 +1,615 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/payment"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// settlementMatchGrace widens the window of gateway transactions a day's records are looked up
+// in, so a payment recorded just after midnight for a charge made just before is not reported
+// as missing at the gateway
+const settlementMatchGrace = time.Hour
+
+// BalanceTransactionLister is implemented by gateways that can list the movements of their
+// balance, such as Stripe's balance transactions
+type BalanceTransactionLister interface {
+	ListBalanceTransactions(ctx context.Context, from, to time.Time) ([]*payment.BalanceTransaction, error)
+	ListPayoutTransactions(ctx context.Context, payoutID string) ([]*payment.BalanceTransaction, error)
+}
+
+// SettlementUseCase defines use cases for reconciling what payment gateways settled with the
+// payments, refunds and disputes we recorded
+type SettlementUseCase interface {
+	// ReconcileDay reconciles a gateway's balance transactions of a day (UTC) and replaces its
+	// settlements for that day, one per currency
+	ReconcileDay(ctx context.Context, gateway string, date time.Time) (*ReconcileSettlementResponse, error)
+
+	// Admin operations
+	GetSettlements(ctx context.Context, req GetSettlementsRequest) (*SettlementsListResponse, error)
+	GetSettlement(ctx context.Context, id uuid.UUID) (*entities.Settlement, error)
+	GetSettlementItems(ctx context.Context, id uuid.UUID, req GetSettlementItemsRequest) (*SettlementItemsListResponse, error)
+}
+
+type settlementUseCase struct {
+	settlementRepo repositories.SettlementRepository
+	paymentRepo    repositories.PaymentRepository
+	disputeRepo    repositories.DisputeRepository
+	stripeService  PaymentGatewayService
+}
+
+// NewSettlementUseCase creates a new settlement use case
+func NewSettlementUseCase(
+	settlementRepo repositories.SettlementRepository,
+	paymentRepo repositories.PaymentRepository,
+	disputeRepo repositories.DisputeRepository,
+	stripeService PaymentGatewayService,
+) SettlementUseCase {
+	return &settlementUseCase{
+		settlementRepo: settlementRepo,
+		paymentRepo:    paymentRepo,
+		disputeRepo:    disputeRepo,
+		stripeService:  stripeService,
+	}
+}
+
+// ReconcileSettlementRequest represents the day to reconcile
+type ReconcileSettlementRequest struct {
+	Gateway string `json:"gateway" validate:"omitempty,oneof=stripe"`
+	Date    string `json:"date" validate:"required"` // YYYY-MM-DD
+}
+
+// ReconcileSettlementResponse represents the settlements of a reconciled day
+type ReconcileSettlementResponse struct {
+	Gateway     string                 `json:"gateway"`
+	Date        string                 `json:"date"`
+	Settlements []*entities.Settlement `json:"settlements"`
+	// Discrepancies counts mismatches and missing records across all currencies
+	Discrepancies int `json:"discrepancies"`
+}
+
+// GetSettlementsRequest represents filters for listing settlements
+type GetSettlementsRequest struct {
+	Gateway  string                    `form:"gateway" json:"gateway,omitempty"`
+	Currency string                    `form:"currency" json:"currency,omitempty"`
+	Status   entities.SettlementStatus `form:"status" json:"status,omitempty"`
+	DateFrom string                    `form:"date_from" json:"date_from,omitempty"` // YYYY-MM-DD
+	DateTo   string                    `form:"date_to" json:"date_to,omitempty"`     // YYYY-MM-DD, inclusive
+	Limit    int                       `form:"limit" json:"limit" validate:"min=1,max=100"`
+	Offset   int                       `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// SettlementsListResponse represents a page of settlements
+type SettlementsListResponse struct {
+	Settlements []*entities.Settlement `json:"settlements"`
+	Total       int64                  `json:"total"`
+	Pagination  *PaginationInfo        `json:"pagination"`
+}
+
+// GetSettlementItemsRequest represents filters for listing the items of a settlement
+type GetSettlementItemsRequest struct {
+	Status entities.SettlementItemStatus `form:"status" json:"status,omitempty"`
+	// Discrepancies keeps only mismatches and missing records
+	Discrepancies bool `form:"discrepancies" json:"discrepancies,omitempty"`
+	Limit         int  `form:"limit" json:"limit" validate:"min=1,max=500"`
+	Offset        int  `form:"offset" json:"offset" validate:"min=0"`
+}
+
+// SettlementItemsListResponse represents a page of settlement items
+type SettlementItemsListResponse struct {
+	Items      []*entities.SettlementItem `json:"items"`
+	Total      int64                      `json:"total"`
+	Pagination *PaginationInfo            `json:"pagination"`
+}
+
+// ReconcileDay reconciles a gateway's balance transactions of a day with our records. Charges,
+// refunds and disputes are matched by the gateway's IDs and compared in minor units of the
+// currency the customer paid in; payouts are checked against the transactions they paid out.
+// Payments and refunds we completed that day without a gateway transaction are reported too.
+func (uc *settlementUseCase) ReconcileDay(ctx context.Context, gateway string, date time.Time) (*ReconcileSettlementResponse, error) {
+	if gateway == "" {
+		gateway = "stripe"
+	}
+	if gateway != "stripe" {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("settlement reconciliation is not supported for %s", gateway))
+	}
+	lister, ok := uc.stripeService.(BalanceTransactionLister)
+	if !ok {
+		return nil, fmt.Errorf("%s service cannot list balance transactions", gateway)
+	}
+
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	transactions, err := lister.ListBalanceTransactions(ctx, from.Add(-settlementMatchGrace), to.Add(settlementMatchGrace))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &settlementReconciliation{
+		uc:              uc,
+		lister:          lister,
+		gateway:         gateway,
+		date:            from,
+		settlements:     make(map[string]*entities.Settlement),
+		gatewayIDs:      make(map[string]bool),
+		matchedPayments: make(map[uuid.UUID]bool),
+		matchedRefunds:  make(map[uuid.UUID]bool),
+		generatedAt:     time.Now(),
+	}
+	for _, txn := range transactions {
+		r.gatewayIDs[txn.ID] = true
+		if txn.SourceID != "" {
+			r.gatewayIDs[txn.SourceID] = true
+		}
+		if txn.PaymentIntentID != "" {
+			r.gatewayIDs[txn.PaymentIntentID] = true
+		}
+	}
+
+	for _, txn := range transactions {
+		if txn.CreatedAt.Before(from) || !txn.CreatedAt.Before(to) {
+			continue
+		}
+		if err := r.addTransaction(ctx, txn); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.addMissingAtGateway(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	settlements := r.finish()
+	if err := uc.settlementRepo.ReplaceDay(ctx, gateway, from, settlements); err != nil {
+		return nil, fmt.Errorf("failed to save settlements: %w", err)
+	}
+
+	response := &ReconcileSettlementResponse{
+		Gateway:     gateway,
+		Date:        from.Format("2006-01-02"),
+		Settlements: settlements,
+	}
+	for _, settlement := range settlements {
+		response.Discrepancies += settlement.MismatchCount + settlement.MissingCount
+		// Items are listed per settlement, the summary is enough here
+		settlement.Items = nil
+	}
+	return response, nil
+}
+
+// settlementReconciliation accumulates the settlements of one day while its transactions are
+// matched
+type settlementReconciliation struct {
+	uc      *settlementUseCase
+	lister  BalanceTransactionLister
+	gateway string
+	date    time.Time
+
+	settlements map[string]*entities.Settlement // By currency
+	// gatewayIDs holds the IDs of every transaction listed, their sources and payment intents
+	gatewayIDs      map[string]bool
+	matchedPayments map[uuid.UUID]bool
+	matchedRefunds  map[uuid.UUID]bool
+	generatedAt     time.Time
+}
+
+// settlement gets the settlement of a currency, creating it on first use
+func (r *settlementReconciliation) settlement(currency string) *entities.Settlement {
+	currency = strings.ToUpper(currency)
+	settlement, ok := r.settlements[currency]
+	if !ok {
+		settlement = &entities.Settlement{
+			Gateway:     r.gateway,
+			Date:        r.date,
+			Currency:    currency,
+			Exponent:    money.Exponent(currency),
+			GeneratedAt: r.generatedAt,
+		}
+		r.settlements[currency] = settlement
+	}
+	return settlement
+}
+
+// addTransaction matches a balance transaction and adds it to the settlement of its currency
+func (r *settlementReconciliation) addTransaction(ctx context.Context, txn *payment.BalanceTransaction) error {
+	item := entities.SettlementItem{
+		Type:            settlementItemType(txn),
+		Status:          entities.SettlementItemStatusUnmatched,
+		TransactionID:   txn.ID,
+		SourceID:        txn.SourceID,
+		Amount:          txn.Amount,
+		Fee:             txn.Fee,
+		Net:             txn.Net,
+		GatewayCurrency: txn.SourceCurrency,
+		GatewayAmount:   txn.SourceAmount,
+		Note:            txn.Description,
+		OccurredAt:      txn.CreatedAt,
+	}
+	if item.GatewayCurrency == "" {
+		item.GatewayCurrency = txn.Currency
+		item.GatewayAmount = abs64(txn.Amount)
+	}
+
+	var err error
+	switch item.Type {
+	case entities.SettlementItemTypeCharge:
+		err = r.matchCharge(ctx, txn, &item)
+	case entities.SettlementItemTypeRefund:
+		err = r.matchRefund(ctx, txn, &item)
+	case entities.SettlementItemTypeDispute:
+		err = r.matchDispute(ctx, txn, &item)
+	case entities.SettlementItemTypePayout:
+		err = r.matchPayout(ctx, txn, &item)
+	}
+	if err != nil {
+		return err
+	}
+
+	settlement := r.settlement(txn.Currency)
+	switch item.Type {
+	case entities.SettlementItemTypeCharge:
+		settlement.ChargeCount++
+		settlement.ChargeAmount += txn.Amount
+	case entities.SettlementItemTypeRefund:
+		settlement.RefundCount++
+		settlement.RefundAmount += txn.Amount
+	case entities.SettlementItemTypeDispute:
+		settlement.DisputeAmount += txn.Amount
+	case entities.SettlementItemTypeAdjustment:
+		settlement.AdjustmentAmount += txn.Amount
+	case entities.SettlementItemTypePayout:
+		settlement.PayoutCount++
+		settlement.PayoutAmount += txn.Amount
+	}
+	if item.Type != entities.SettlementItemTypePayout {
+		settlement.FeeAmount += txn.Fee
+		settlement.NetAmount += txn.Net
+	}
+	settlement.Items = append(settlement.Items, item)
+	return nil
+}
+
+// matchCharge matches a charge with the payment recorded for its payment intent or charge
+func (r *settlementReconciliation) matchCharge(ctx context.Context, txn *payment.BalanceTransaction, item *entities.SettlementItem) error {
+	paymentRecord, err := r.findPayment(ctx, txn)
+	if err != nil {
+		return err
+	}
+	if paymentRecord == nil {
+		item.Status = entities.SettlementItemStatusMissingRecord
+		item.Note = "No payment recorded for this charge"
+		return nil
+	}
+
+	item.PaymentID = &paymentRecord.ID
+	r.matchedPayments[paymentRecord.ID] = true
+	compareSettlementAmounts(item, paymentRecord.Currency, paymentRecord.Amount)
+	return nil
+}
+
+// findPayment finds the payment of a charge, returning nil when none was recorded
+func (r *settlementReconciliation) findPayment(ctx context.Context, txn *payment.BalanceTransaction) (*entities.Payment, error) {
+	if txn.PaymentIntentID != "" {
+		paymentRecord, err := r.uc.paymentRepo.GetByPaymentIntentID(ctx, txn.PaymentIntentID)
+		if err == nil {
+			return paymentRecord, nil
+		}
+		if err != entities.ErrPaymentNotFound {
+			return nil, err
+		}
+	}
+
+	// Payments made without Checkout record the payment intent or charge as their transaction
+	for _, id := range []string{txn.PaymentIntentID, txn.SourceID} {
+		if id == "" {
+			continue
+		}
+		paymentRecord, err := r.uc.paymentRepo.GetByTransactionID(ctx, id)
+		if err == nil {
+			return paymentRecord, nil
+		}
+		if err != entities.ErrPaymentNotFound {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// matchRefund matches a refund with the refund recorded for it
+func (r *settlementReconciliation) matchRefund(ctx context.Context, txn *payment.BalanceTransaction, item *entities.SettlementItem) error {
+	refund, err := r.uc.paymentRepo.GetRefundByTransactionID(ctx, txn.SourceID)
+	if err == entities.ErrRefundNotFound {
+		item.Status = entities.SettlementItemStatusMissingRecord
+		item.Note = "No refund recorded for this gateway refund"
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	item.RefundID = &refund.ID
+	item.PaymentID = &refund.PaymentID
+	r.matchedRefunds[refund.ID] = true
+
+	// Refunds are made in the currency of their payment
+	paymentRecord, err := r.uc.paymentRepo.GetByID(ctx, refund.PaymentID)
+	if err != nil {
+		return err
+	}
+	compareSettlementAmounts(item, paymentRecord.Currency, refund.Amount)
+	return nil
+}
+
+// matchDispute matches a chargeback or its reversal with the dispute recorded from webhooks
+func (r *settlementReconciliation) matchDispute(ctx context.Context, txn *payment.BalanceTransaction, item *entities.SettlementItem) error {
+	dispute, err := r.uc.disputeRepo.GetByExternalID(ctx, r.gateway, txn.SourceID)
+	if err == entities.ErrDisputeNotFound {
+		item.Status = entities.SettlementItemStatusMissingRecord
+		item.Note = "No dispute recorded for this chargeback"
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	item.DisputeID = &dispute.ID
+	item.PaymentID = dispute.PaymentID
+	compareSettlementAmounts(item, dispute.Currency, dispute.Amount)
+	return nil
+}
+
+// matchPayout checks that a payout paid out the net of the transactions it settled
+func (r *settlementReconciliation) matchPayout(ctx context.Context, txn *payment.BalanceTransaction, item *entities.SettlementItem) error {
+	if txn.SourceID == "" {
+		return nil
+	}
+
+	paid, err := r.lister.ListPayoutTransactions(ctx, txn.SourceID)
+	if err != nil {
+		// Manual payouts cannot be broken down into the transactions they paid out
+		item.Note = fmt.Sprintf("Payout transactions unavailable: %v", err)
+		return nil
+	}
+
+	var net int64
+	for _, paidTxn := range paid {
+		if paidTxn.ID != txn.ID {
+			net += paidTxn.Net
+		}
+	}
+
+	item.RecordedCurrency = txn.Currency
+	item.RecordedAmount = net
+	if net == -txn.Amount {
+		item.Status = entities.SettlementItemStatusMatched
+		return nil
+	}
+	item.Status = entities.SettlementItemStatusAmountMismatch
+	item.Note = fmt.Sprintf("Payout of %s %s, its transactions net %s %s",
+		money.Format(-txn.Amount, txn.Currency), txn.Currency, money.Format(net, txn.Currency), txn.Currency)
+	return nil
+}
+
+// addMissingAtGateway reports the payments and refunds completed during the day that the
+// gateway has no transaction for
+func (r *settlementReconciliation) addMissingAtGateway(ctx context.Context, from, to time.Time) error {
+	payments, err := r.uc.paymentRepo.GetProcessedByGateway(ctx, r.gateway, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to get payments: %w", err)
+	}
+	for _, paymentRecord := range payments {
+		if r.matchedPayments[paymentRecord.ID] ||
+			r.gatewayIDs[paymentRecord.PaymentIntentID] || r.gatewayIDs[paymentRecord.TransactionID] {
+			continue
+		}
+		paymentID := paymentRecord.ID
+		sourceID := paymentRecord.PaymentIntentID
+		if sourceID == "" {
+			sourceID = paymentRecord.TransactionID
+		}
+		r.addMissingItem(entities.SettlementItem{
+			Type:             entities.SettlementItemTypeCharge,
+			SourceID:         sourceID,
+			PaymentID:        &paymentID,
+			RecordedCurrency: strings.ToUpper(paymentRecord.Currency),
+			RecordedAmount:   money.ToMinor(paymentRecord.Amount, paymentRecord.Currency),
+			Note:             "Payment recorded as paid but the gateway has no charge for it",
+			OccurredAt:       *paymentRecord.ProcessedAt,
+		})
+	}
+
+	refunds, err := r.uc.paymentRepo.GetCompletedRefundsByGateway(ctx, r.gateway, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to get refunds: %w", err)
+	}
+	for _, refund := range refunds {
+		if r.matchedRefunds[refund.ID] || r.gatewayIDs[refund.TransactionID] {
+			continue
+		}
+		currency := "USD"
+		if refund.Payment != nil {
+			currency = refund.Payment.Currency
+		}
+		refundID, paymentID := refund.ID, refund.PaymentID
+		r.addMissingItem(entities.SettlementItem{
+			Type:             entities.SettlementItemTypeRefund,
+			SourceID:         refund.TransactionID,
+			PaymentID:        &paymentID,
+			RefundID:         &refundID,
+			RecordedCurrency: strings.ToUpper(currency),
+			RecordedAmount:   money.ToMinor(refund.Amount, currency),
+			Note:             "Refund recorded as completed but the gateway has no refund for it",
+			OccurredAt:       *refund.ProcessedAt,
+		})
+	}
+	return nil
+}
+
+// addMissingItem adds a record the gateway has no transaction for to the settlement of its
+// currency
+func (r *settlementReconciliation) addMissingItem(item entities.SettlementItem) {
+	item.Status = entities.SettlementItemStatusMissingAtGateway
+	settlement := r.settlement(item.RecordedCurrency)
+	settlement.Items = append(settlement.Items, item)
+}
+
+// finish counts the outcome of every settlement and returns them ordered by currency
+func (r *settlementReconciliation) finish() []*entities.Settlement {
+	settlements := make([]*entities.Settlement, 0, len(r.settlements))
+	for _, settlement := range r.settlements {
+		for i := range settlement.Items {
+			switch settlement.Items[i].Status {
+			case entities.SettlementItemStatusMatched:
+				settlement.MatchedCount++
+			case entities.SettlementItemStatusAmountMismatch, entities.SettlementItemStatusCurrencyMismatch:
+				settlement.MismatchCount++
+			case entities.SettlementItemStatusMissingRecord, entities.SettlementItemStatusMissingAtGateway:
+				settlement.MissingCount++
+			}
+		}
+
+		settlement.Status = entities.SettlementStatusReconciled
+		if settlement.MismatchCount > 0 || settlement.MissingCount > 0 {
+			settlement.Status = entities.SettlementStatusDiscrepancies
+		}
+		settlements = append(settlements, settlement)
+	}
+
+	sort.Slice(settlements, func(i, j int) bool {
+		return settlements[i].Currency < settlements[j].Currency
+	})
+	return settlements
+}
+
+// settlementItemType classifies a balance transaction
+func settlementItemType(txn *payment.BalanceTransaction) entities.SettlementItemType {
+	switch txn.Type {
+	case "charge", "payment":
+		return entities.SettlementItemTypeCharge
+	case "refund", "payment_refund":
+		return entities.SettlementItemTypeRefund
+	case "payout", "payout_cancel", "payout_failure":
+		return entities.SettlementItemTypePayout
+	}
+	// Chargebacks and their reversals are adjustments whose source is the dispute
+	if strings.HasPrefix(txn.SourceID, "dp_") || strings.HasPrefix(txn.SourceID, "du_") {
+		return entities.SettlementItemTypeDispute
+	}
+	return entities.SettlementItemTypeAdjustment
+}
+
+// compareSettlementAmounts compares what the gateway moved with the amount we recorded, both in
+// minor units of their own currency
+func compareSettlementAmounts(item *entities.SettlementItem, currency string, amount float64) {
+	item.RecordedCurrency = strings.ToUpper(currency)
+	item.RecordedAmount = money.ToMinor(amount, currency)
+
+	switch {
+	case item.RecordedCurrency != item.GatewayCurrency:
+		item.Status = entities.SettlementItemStatusCurrencyMismatch
+		item.Note = fmt.Sprintf("Gateway settled %s, we recorded %s", item.GatewayCurrency, item.RecordedCurrency)
+	case item.RecordedAmount != abs64(item.GatewayAmount):
+		item.Status = entities.SettlementItemStatusAmountMismatch
+		item.Note = fmt.Sprintf("Gateway settled %s %s, we recorded %s %s",
+			money.Format(abs64(item.GatewayAmount), item.GatewayCurrency), item.GatewayCurrency,
+			money.Format(item.RecordedAmount, item.RecordedCurrency), item.RecordedCurrency)
+	default:
+		item.Status = entities.SettlementItemStatusMatched
+		item.Note = ""
+	}
+}
+
+func abs64(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// GetSettlements lists settlements
+func (uc *settlementUseCase) GetSettlements(ctx context.Context, req GetSettlementsRequest) (*SettlementsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	filters := repositories.SettlementFilters{
+		Gateway:  req.Gateway,
+		Currency: strings.ToUpper(req.Currency),
+		Status:   req.Status,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	}
+	if req.DateFrom != "" {
+		date, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_from, expected YYYY-MM-DD")
+		}
+		filters.DateFrom = &date
+	}
+	if req.DateTo != "" {
+		date, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_to, expected YYYY-MM-DD")
+		}
+		filters.DateTo = &date
+	}
+
+	settlements, total, err := uc.settlementRepo.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SettlementsListResponse{
+		Settlements: settlements,
+		Total:       total,
+		Pagination:  NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetSettlement gets a settlement
+func (uc *settlementUseCase) GetSettlement(ctx context.Context, id uuid.UUID) (*entities.Settlement, error) {
+	return uc.settlementRepo.GetByID(ctx, id)
+}
+
+// GetSettlementItems lists the items of a settlement
+func (uc *settlementUseCase) GetSettlementItems(ctx context.Context, id uuid.UUID, req GetSettlementItemsRequest) (*SettlementItemsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 500 {
+		req.Limit = 100
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	if _, err := uc.settlementRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	items, total, err := uc.settlementRepo.GetItems(ctx, id, repositories.SettlementItemFilters{
+		Status:            req.Status,
+		DiscrepanciesOnly: req.Discrepancies,
+		Limit:             req.Limit,
+		Offset:            req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SettlementItemsListResponse{
+		Items:      items,
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
@@ -2,12 +2,19 @@ package usecases
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	"ecom-golang-clean-architecture/pkg/cache"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
 
 	"github.com/google/uuid"
 )
@@ -17,6 +24,7 @@ type ShippingUseCase interface {
 	// Shipping Methods
 	GetShippingMethods(ctx context.Context, req GetShippingMethodsRequest) ([]*ShippingMethodResponse, error)
 	CalculateShippingCost(ctx context.Context, req CalculateShippingRequest) (*ShippingCostResponse, error)
+	EstimateShipping(ctx context.Context, req ShippingEstimateRequest) (*ShippingEstimateResponse, error)
 
 	// Shipments
 	CreateShipment(ctx context.Context, req CreateShipmentRequest) (*ShipmentResponse, error)
@@ -40,25 +48,49 @@ type ShippingUseCase interface {
 type shippingUseCase struct {
 	shippingRepo         repositories.ShippingRepository
 	orderRepo            repositories.OrderRepository
+	cartRepo             repositories.CartRepository
+	productRepo          repositories.ProductRepository
 	distanceService      services.DistanceService
 	compatibilityService services.ShippingCompatibilityService
+	estimateCache        cache.Cache
+	estimateSettings     ShippingEstimateSettings
+}
+
+// ShippingEstimateSettings configures shipping estimates
+type ShippingEstimateSettings struct {
+	OriginAddress string        // Address parcels ship from
+	CacheTTL      time.Duration // How long an estimate for the same cart and destination is reused
 }
 
 // NewShippingUseCase creates a new shipping use case
 func NewShippingUseCase(
 	shippingRepo repositories.ShippingRepository,
 	orderRepo repositories.OrderRepository,
+	cartRepo repositories.CartRepository,
+	productRepo repositories.ProductRepository,
 	distanceService services.DistanceService,
 	compatibilityService services.ShippingCompatibilityService,
+	estimateCache cache.Cache,
+	estimateSettings ShippingEstimateSettings,
 ) ShippingUseCase {
+	if estimateSettings.OriginAddress == "" {
+		estimateSettings.OriginAddress = defaultShippingOrigin
+	}
 	return &shippingUseCase{
 		shippingRepo:         shippingRepo,
 		orderRepo:            orderRepo,
+		cartRepo:             cartRepo,
+		productRepo:          productRepo,
 		distanceService:      distanceService,
 		compatibilityService: compatibilityService,
+		estimateCache:        estimateCache,
+		estimateSettings:     estimateSettings,
 	}
 }
 
+// defaultShippingOrigin is the warehouse parcels ship from when none is configured
+const defaultShippingOrigin = "New York, NY, USA"
+
 // Request/Response types
 type GetShippingMethodsRequest struct {
 	ZoneID      *uuid.UUID `json:"zone_id"`
@@ -111,21 +143,23 @@ type DistanceBasedShippingRequest struct {
 
 // Response types
 type ShippingMethodResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Cost        float64   `json:"cost"`
-	MinWeight   float64   `json:"min_weight"`
-	MaxWeight   float64   `json:"max_weight"`
-	IsActive    bool      `json:"is_active"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          uuid.UUID         `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Cost        float64           `json:"cost"`
+	DisplayCost *StorefrontAmount `json:"display_cost,omitempty"` // Cost in the shopper's storefront currency
+	MinWeight   float64           `json:"min_weight"`
+	MaxWeight   float64           `json:"max_weight"`
+	IsActive    bool              `json:"is_active"`
+	CreatedAt   time.Time         `json:"created_at"`
 }
 
 type ShippingCostResponse struct {
-	MethodID      uuid.UUID `json:"method_id"`
-	MethodName    string    `json:"method_name"`
-	Cost          float64   `json:"cost"`
-	EstimatedDays int       `json:"estimated_days"`
+	MethodID      uuid.UUID         `json:"method_id"`
+	MethodName    string            `json:"method_name"`
+	Cost          float64           `json:"cost"`
+	DisplayCost   *StorefrontAmount `json:"display_cost,omitempty"`
+	EstimatedDays int               `json:"estimated_days"`
 }
 
 type DistanceBasedShippingResponse struct {
@@ -146,22 +180,24 @@ type DistanceShippingOption struct {
 }
 
 type ShipmentResponse struct {
-	ID                uuid.UUID               `json:"id"`
-	OrderID           uuid.UUID               `json:"order_id"`
-	ShippingMethodID  uuid.UUID               `json:"shipping_method_id"`
-	TrackingNumber    string                  `json:"tracking_number"`
-	Carrier           string                  `json:"carrier"`
-	Status            entities.ShipmentStatus `json:"status"`
-	Weight            float64                 `json:"weight"`
-	Dimensions        string                  `json:"dimensions"`
-	PackageCount      int                     `json:"package_count"`
-	InsuranceValue    float64                 `json:"insurance_value"`
-	ShippedAt         *time.Time              `json:"shipped_at"`
-	ActualDelivery    *time.Time              `json:"actual_delivery"`
-	EstimatedDelivery *time.Time              `json:"estimated_delivery"`
-	TrackingEvents    []ShipmentTrackingEvent `json:"tracking_events"`
-	CreatedAt         time.Time               `json:"created_at"`
-	UpdatedAt         time.Time               `json:"updated_at"`
+	ID                  uuid.UUID               `json:"id"`
+	OrderID             uuid.UUID               `json:"order_id"`
+	ShippingMethodID    uuid.UUID               `json:"shipping_method_id"`
+	TrackingNumber      string                  `json:"tracking_number"`
+	Carrier             string                  `json:"carrier"`
+	Status              entities.ShipmentStatus `json:"status"`
+	Weight              float64                 `json:"weight"`
+	Dimensions          string                  `json:"dimensions"`
+	PackageCount        int                     `json:"package_count"`
+	InsuranceValue      float64                 `json:"insurance_value"`
+	ShippedAt           *time.Time              `json:"shipped_at"`
+	ActualDelivery      *time.Time              `json:"actual_delivery"`
+	EstimatedDelivery   *time.Time              `json:"estimated_delivery"`
+	ToAddress           string                  `json:"to_address"`
+	SpecialInstructions string                  `json:"special_instructions"` // Customer delivery instructions for the carrier
+	TrackingEvents      []ShipmentTrackingEvent `json:"tracking_events"`
+	CreatedAt           time.Time               `json:"created_at"`
+	UpdatedAt           time.Time               `json:"updated_at"`
 }
 
 type ShipmentTrackingEvent struct {
@@ -213,6 +249,7 @@ func (uc *shippingUseCase) GetShippingMethods(ctx context.Context, req GetShippi
 			Name:        method.Name,
 			Description: method.Description,
 			Cost:        method.BaseCost,
+			DisplayCost: storefrontAmount(ctx, method.BaseCost),
 			MinWeight:   0, // Would come from shipping rates
 			MaxWeight:   method.MaxWeight,
 			IsActive:    method.IsActive,
@@ -255,6 +292,7 @@ func (uc *shippingUseCase) CalculateShippingCost(ctx context.Context, req Calcul
 		MethodID:      method.ID,
 		MethodName:    method.Name,
 		Cost:          cost,
+		DisplayCost:   storefrontAmount(ctx, cost),
 		EstimatedDays: method.MaxDeliveryDays,
 	}, nil
 }
@@ -269,7 +307,7 @@ func (uc *shippingUseCase) CreateShipment(ctx context.Context, req CreateShipmen
 
 	// Create shipment
 	shipment := &entities.Shipment{
-		ID:                uuid.New(),
+		ID:                ids.New(),
 		OrderID:           req.OrderID,
 		ShippingMethodID:  req.ShippingMethod,
 		TrackingNumber:    req.TrackingNumber,
@@ -282,6 +320,13 @@ func (uc *shippingUseCase) CreateShipment(ctx context.Context, req CreateShipmen
 		EstimatedDelivery: req.EstimatedDelivery,
 		CreatedAt:         time.Now(),
 		UpdatedAt:         time.Now(),
+
+		// Surface the customer's delivery instructions to the carrier
+		SpecialInstructions: order.DeliveryInstructions,
+	}
+
+	if order.ShippingAddress != nil {
+		shipment.ToAddress = order.ShippingAddress.GetFullAddress()
 	}
 
 	if err := uc.shippingRepo.CreateShipment(ctx, shipment); err != nil {
@@ -379,7 +424,7 @@ func (uc *shippingUseCase) CreateReturn(ctx context.Context, req CreateReturnReq
 
 	// Create return
 	returnEntity := &entities.Return{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		OrderID:     req.OrderID,
 		Status:      entities.ReturnStatusRequested,
 		Reason:      req.Reason,
@@ -391,7 +436,7 @@ func (uc *shippingUseCase) CreateReturn(ctx context.Context, req CreateReturnReq
 	// Create return items
 	for _, item := range req.Items {
 		returnItem := entities.ReturnItem{
-			ID:        uuid.New(),
+			ID:        ids.New(),
 			ReturnID:  returnEntity.ID,
 			ProductID: item.ProductID,
 			Quantity:  item.Quantity,
@@ -451,6 +496,9 @@ func (uc *shippingUseCase) toShipmentResponse(shipment *entities.Shipment) *Ship
 		EstimatedDelivery: shipment.EstimatedDelivery,
 		CreatedAt:         shipment.CreatedAt,
 		UpdatedAt:         shipment.UpdatedAt,
+
+		ToAddress:           shipment.ToAddress,
+		SpecialInstructions: shipment.SpecialInstructions,
 	}
 }
 
@@ -486,7 +534,7 @@ func (uc *shippingUseCase) CalculateDistanceBasedShipping(ctx context.Context, r
 	// Set default from address if not provided
 	fromAddress := req.FromAddress
 	if fromAddress == "" {
-		fromAddress = "New York, NY, USA" // Default warehouse location
+		fromAddress = uc.estimateSettings.OriginAddress // Default warehouse location
 	}
 
 	// Determine destination address
@@ -749,3 +797,237 @@ func (uc *shippingUseCase) ValidateShippingAddress(ctx context.Context, req Vali
 
 	return response, nil
 }
+
+// ShippingEstimateRequest asks for the shipping options of the shopper's cart, or of a single
+// product, to a destination without starting checkout
+type ShippingEstimateRequest struct {
+	ProductID *uuid.UUID `form:"-"` // Estimate one product instead of the cart, e.g. on product pages
+	Quantity  int        `form:"quantity"`
+	Country   string     `form:"country"` // Defaults to the storefront ship-to country
+	State     string     `form:"state"`
+	City      string     `form:"city"`
+	ZipCode   string     `form:"zip_code"`
+	UserID    *uuid.UUID `form:"-"`
+	SessionID string     `form:"-"`
+}
+
+// ShippingEstimateResponse lists the shipping options of a cart to a destination
+type ShippingEstimateResponse struct {
+	Destination string                   `json:"destination"`
+	ItemCount   int                      `json:"item_count"`
+	Weight      float64                  `json:"weight"` // kg
+	Subtotal    float64                  `json:"subtotal"`
+	Distance    float64                  `json:"distance_km"`
+	Options     []ShippingEstimateOption `json:"options"`
+	Cached      bool                     `json:"cached"`
+	EstimatedAt time.Time                `json:"estimated_at"`
+}
+
+// ShippingEstimateOption is a shipping method available for an estimate and what it costs
+type ShippingEstimateOption struct {
+	MethodID        uuid.UUID         `json:"method_id"`
+	MethodName      string            `json:"method_name"`
+	Carrier         string            `json:"carrier"`
+	Type            string            `json:"type"`
+	Cost            float64           `json:"cost"`
+	DisplayCost     *StorefrontAmount `json:"display_cost,omitempty"`
+	IsFree          bool              `json:"is_free"`
+	MinDeliveryDays int               `json:"min_delivery_days"`
+	MaxDeliveryDays int               `json:"max_delivery_days"`
+}
+
+// shippingEstimateItem is a line of the cart or product being estimated
+type shippingEstimateItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Price     float64
+	Weight    float64 // kg per unit
+}
+
+// EstimateShipping returns the shipping methods and prices of a cart, or of a single product, to a
+// destination. Estimates are cached by cart signature and destination, so repeated estimates on
+// product and cart pages don't recompute distances or call carriers again.
+func (uc *shippingUseCase) EstimateShipping(ctx context.Context, req ShippingEstimateRequest) (*ShippingEstimateResponse, error) {
+	items, err := uc.shippingEstimateItems(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, pkgErrors.InvalidInput("cart is empty")
+	}
+
+	destination := &entities.Address{
+		Country:  strings.ToUpper(strings.TrimSpace(req.Country)),
+		State:    strings.TrimSpace(req.State),
+		City:     strings.TrimSpace(req.City),
+		ZipCode:  strings.TrimSpace(req.ZipCode),
+		Type:     entities.AddressTypeShipping,
+		IsActive: true,
+	}
+	if destination.Country == "" {
+		destination.Country = entities.StorefrontFromContext(ctx).Country
+	}
+	if destination.Country == "" {
+		return nil, pkgErrors.InvalidInput("country is required")
+	}
+
+	key := shippingEstimateKey(items, destination)
+	var estimate ShippingEstimateResponse
+	if uc.estimateCache != nil && uc.estimateCache.Get(ctx, key, &estimate) == nil {
+		estimate.Cached = true
+	} else {
+		computed, err := uc.computeShippingEstimate(ctx, items, destination)
+		if err != nil {
+			return nil, err
+		}
+		estimate = *computed
+		if uc.estimateCache != nil && uc.estimateSettings.CacheTTL > 0 {
+			_ = uc.estimateCache.Set(ctx, key, estimate, uc.estimateSettings.CacheTTL)
+		}
+	}
+
+	// Display costs depend on the shopper's storefront, so they are added after the cache and
+	// on a copy of the cached options
+	options := make([]ShippingEstimateOption, len(estimate.Options))
+	for i, option := range estimate.Options {
+		option.DisplayCost = storefrontAmount(ctx, option.Cost)
+		options[i] = option
+	}
+	estimate.Options = options
+	return &estimate, nil
+}
+
+// shippingEstimateItems collects the product asked for, or the shopper's cart
+func (uc *shippingUseCase) shippingEstimateItems(ctx context.Context, req ShippingEstimateRequest) ([]shippingEstimateItem, error) {
+	if req.ProductID != nil {
+		product, err := uc.productRepo.GetByID(ctx, *req.ProductID)
+		if err != nil {
+			return nil, entities.ErrProductNotFound
+		}
+		quantity := req.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+		return []shippingEstimateItem{{
+			ProductID: product.ID,
+			Quantity:  quantity,
+			Price:     product.GetCurrentPrice(),
+			Weight:    productWeight(product),
+		}}, nil
+	}
+
+	var cart *entities.Cart
+	var err error
+	if req.UserID != nil {
+		cart, err = uc.cartRepo.GetByUserID(ctx, *req.UserID)
+	} else if req.SessionID != "" {
+		cart, err = uc.cartRepo.GetBySessionID(ctx, req.SessionID)
+	}
+	if err != nil && err != entities.ErrCartNotFound {
+		return nil, err
+	}
+	if cart == nil {
+		return nil, nil
+	}
+
+	items := make([]shippingEstimateItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, shippingEstimateItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Weight:    productWeight(&item.Product),
+		})
+	}
+	return items, nil
+}
+
+// computeShippingEstimate prices every shipping method that can deliver the items to the destination
+func (uc *shippingUseCase) computeShippingEstimate(ctx context.Context, items []shippingEstimateItem, destination *entities.Address) (*ShippingEstimateResponse, error) {
+	estimate := &ShippingEstimateResponse{
+		Destination: shippingDestinationLabel(destination),
+		Options:     []ShippingEstimateOption{},
+		EstimatedAt: time.Now(),
+	}
+	for _, item := range items {
+		estimate.ItemCount += item.Quantity
+		estimate.Weight += item.Weight * float64(item.Quantity)
+		estimate.Subtotal += item.Price * float64(item.Quantity)
+	}
+
+	distance, err := uc.distanceService.CalculateDistanceByAddress(ctx, uc.estimateSettings.OriginAddress, estimate.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate distance: %w", err)
+	}
+	estimate.Distance = distance
+
+	methods, err := uc.shippingRepo.GetShippingMethods(ctx, nil, &estimate.Weight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipping methods: %w", err)
+	}
+
+	for _, method := range methods {
+		if err := uc.compatibilityService.ValidateShippingMethodForAddress(ctx, method, destination); err != nil {
+			continue
+		}
+		if estimate.Weight > 0 {
+			if err := uc.compatibilityService.ValidateShippingConstraints(ctx, method, estimate.Weight, nil); err != nil {
+				continue
+			}
+		}
+
+		cost := method.CalculateCost(estimate.Weight, distance, estimate.Subtotal)
+		if cost < 0 {
+			continue
+		}
+		estimate.Options = append(estimate.Options, ShippingEstimateOption{
+			MethodID:        method.ID,
+			MethodName:      method.Name,
+			Carrier:         method.Carrier,
+			Type:            string(method.Type),
+			Cost:            cost,
+			IsFree:          cost == 0,
+			MinDeliveryDays: method.MinDeliveryDays,
+			MaxDeliveryDays: method.MaxDeliveryDays,
+		})
+	}
+
+	sort.SliceStable(estimate.Options, func(i, j int) bool {
+		return estimate.Options[i].Cost < estimate.Options[j].Cost
+	})
+	return estimate, nil
+}
+
+// shippingEstimateKey builds the cache key of an estimate from its cart signature, made of the
+// items with their quantities, prices and weights, and its destination
+func shippingEstimateKey(items []shippingEstimateItem, destination *entities.Address) string {
+	lines := make([]string, len(items))
+	for i, item := range items {
+		lines[i] = fmt.Sprintf("%s:%d:%.2f:%.3f", item.ProductID, item.Quantity, item.Price, item.Weight)
+	}
+	sort.Strings(lines)
+
+	hash := sha256.New()
+	hash.Write([]byte(strings.Join(lines, ",")))
+	hash.Write([]byte(strings.ToLower(shippingDestinationLabel(destination))))
+	return cache.NewCacheKeyBuilder("shipping_estimate").Build(hex.EncodeToString(hash.Sum(nil)))
+}
+
+// shippingDestinationLabel formats a destination the way the distance service reads addresses
+func shippingDestinationLabel(destination *entities.Address) string {
+	var parts []string
+	for _, part := range []string{destination.City, destination.State, destination.ZipCode, destination.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// productWeight returns a product's weight in kg, or 0 when it has none
+func productWeight(product *entities.Product) float64 {
+	if product.Weight == nil {
+		return 0
+	}
+	return *product.Weight
+}
@@ -0,0 +1,376 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// StorefrontUseCase defines the currency, locale and ship-to country shoppers browse in
+type StorefrontUseCase interface {
+	ResolveStorefront(ctx context.Context, requested entities.Storefront, acceptLanguage string, userID *uuid.UUID) entities.Storefront
+	GetStorefront(ctx context.Context, userID *uuid.UUID, sessionID string) *StorefrontResponse
+	SwitchStorefront(ctx context.Context, userID *uuid.UUID, sessionID string, req SwitchStorefrontRequest) (*StorefrontResponse, error)
+}
+
+// StorefrontSettings configures the currencies, locales and tax display shoppers can browse with
+type StorefrontSettings struct {
+	BaseCurrency   string
+	DefaultLocale  string
+	DefaultCountry string
+	Locales        []string
+	// CurrencyRates are the units of each other currency shoppers can pick per unit of BaseCurrency
+	CurrencyRates map[string]float64
+	// TaxRates are estimated tax percents by ship-to country
+	TaxRates map[string]float64
+	// TaxDisplay decides by ship-to country whether shoppers see prices with tax included
+	TaxDisplay entities.TaxDisplayRule
+}
+
+type storefrontUseCase struct {
+	userRepo    repositories.UserRepository
+	addressRepo repositories.AddressRepository
+	cartRepo    repositories.CartRepository
+	settings    StorefrontSettings
+}
+
+// NewStorefrontUseCase creates a new storefront use case
+func NewStorefrontUseCase(
+	userRepo repositories.UserRepository,
+	addressRepo repositories.AddressRepository,
+	cartRepo repositories.CartRepository,
+	settings StorefrontSettings,
+) StorefrontUseCase {
+	settings.BaseCurrency = strings.ToUpper(settings.BaseCurrency)
+	settings.DefaultCountry = strings.ToUpper(settings.DefaultCountry)
+	return &storefrontUseCase{
+		userRepo:    userRepo,
+		addressRepo: addressRepo,
+		cartRepo:    cartRepo,
+		settings:    settings,
+	}
+}
+
+// SwitchStorefrontRequest changes the shopper's storefront; empty fields keep the current value
+type SwitchStorefrontRequest struct {
+	Currency      string `json:"currency"`
+	Locale        string `json:"locale"`
+	Country       string `json:"country"`
+	SaveToProfile bool   `json:"save_to_profile"` // Also keep the currency and locale on the signed-in user's profile
+}
+
+// StorefrontResponse represents the shopper's storefront and what they can switch to
+type StorefrontResponse struct {
+	Currency     string                `json:"currency"`
+	Locale       string                `json:"locale"`
+	Country      string                `json:"country"`
+	BaseCurrency string                `json:"base_currency"`
+	ExchangeRate float64               `json:"exchange_rate"`
+	TaxRate      float64               `json:"tax_rate"` // Percent
+	TaxInclusive bool                  `json:"tax_inclusive"`
+	Format       money.CurrencyFormat  `json:"format"` // How amounts are written in the currency and locale
+	Currencies   []string              `json:"currencies"`
+	Locales      []string              `json:"locales"`
+	Cart         *StorefrontCartTotals `json:"cart,omitempty"`
+}
+
+// StorefrontCartTotals are a cart's totals re-priced in the shopper's storefront. When tax is
+// included the subtotal is gross and the estimated tax is the part of it that is tax; otherwise
+// the subtotal is net and the tax is added to the total.
+type StorefrontCartTotals struct {
+	Currency          string  `json:"currency"`
+	ItemCount         int     `json:"item_count"`
+	Subtotal          float64 `json:"subtotal"`
+	EstimatedTax      float64 `json:"estimated_tax"`
+	TaxRate           float64 `json:"tax_rate"` // Percent
+	TaxIncluded       bool    `json:"tax_included"`
+	Shipping          float64 `json:"shipping"`
+	Total             float64 `json:"total"`
+	FormattedSubtotal string  `json:"formatted_subtotal"`
+	FormattedTax      string  `json:"formatted_tax"`
+	FormattedShipping string  `json:"formatted_shipping"`
+	FormattedTotal    string  `json:"formatted_total"`
+}
+
+// StorefrontPriceResponse is a product's price as the shopper is shown it
+type StorefrontPriceResponse struct {
+	Currency               string   `json:"currency"`
+	Price                  float64  `json:"price"`
+	CurrentPrice           float64  `json:"current_price"`
+	OriginalPrice          *float64 `json:"original_price"`
+	TaxIncluded            bool     `json:"tax_included"`
+	TaxRate                float64  `json:"tax_rate"` // Percent
+	FormattedPrice         string   `json:"formatted_price"`
+	FormattedCurrentPrice  string   `json:"formatted_current_price"`
+	FormattedOriginalPrice *string  `json:"formatted_original_price"`
+}
+
+// StorefrontAmount is an amount in the shopper's storefront currency
+type StorefrontAmount struct {
+	Currency  string  `json:"currency"`
+	Amount    float64 `json:"amount"`
+	Formatted string  `json:"formatted"`
+}
+
+// ResolveStorefront works out a shopper's storefront from what the request asked for, then the
+// signed-in user's profile, then the store defaults. Unsupported choices are ignored.
+func (uc *storefrontUseCase) ResolveStorefront(ctx context.Context, requested entities.Storefront, acceptLanguage string, userID *uuid.UUID) entities.Storefront {
+	currency := uc.supportedCurrency(requested.Currency)
+	locale := uc.supportedLocale(requested.Locale)
+	country := supportedCountry(requested.Country)
+
+	if userID != nil && (currency == "" || locale == "" || country == "") {
+		if user, err := uc.userRepo.GetByID(ctx, *userID); err == nil {
+			if currency == "" {
+				currency = uc.supportedCurrency(user.Currency)
+			}
+			if locale == "" {
+				locale = uc.supportedLocale(user.Language)
+			}
+		}
+		if country == "" {
+			if address, err := uc.addressRepo.GetDefaultByUserID(ctx, *userID, entities.AddressTypeShipping); err == nil && address != nil {
+				country = supportedCountry(address.Country)
+			}
+		}
+	}
+
+	if currency == "" {
+		currency = uc.settings.BaseCurrency
+	}
+	if locale == "" {
+		locale = uc.supportedLocale(acceptLanguage)
+	}
+	if locale == "" {
+		locale = uc.settings.DefaultLocale
+	}
+	if country == "" {
+		country = uc.settings.DefaultCountry
+	}
+	return uc.storefront(currency, locale, country)
+}
+
+// GetStorefront gets the shopper's storefront with their cart re-priced in it
+func (uc *storefrontUseCase) GetStorefront(ctx context.Context, userID *uuid.UUID, sessionID string) *StorefrontResponse {
+	storefront := entities.StorefrontFromContext(ctx)
+	if !storefront.IsSet() {
+		storefront = uc.storefront(uc.settings.BaseCurrency, uc.settings.DefaultLocale, uc.settings.DefaultCountry)
+	}
+	return uc.toStorefrontResponse(ctx, storefront, userID, sessionID)
+}
+
+// SwitchStorefront changes the shopper's currency, locale or ship-to country and re-prices their
+// cart. The caller remembers the choice for the next requests.
+func (uc *storefrontUseCase) SwitchStorefront(ctx context.Context, userID *uuid.UUID, sessionID string, req SwitchStorefrontRequest) (*StorefrontResponse, error) {
+	current := entities.StorefrontFromContext(ctx)
+	if !current.IsSet() {
+		current = uc.storefront(uc.settings.BaseCurrency, uc.settings.DefaultLocale, uc.settings.DefaultCountry)
+	}
+
+	currency, locale, country := current.Currency, current.Locale, current.Country
+	if req.Currency != "" {
+		if currency = uc.supportedCurrency(req.Currency); currency == "" {
+			return nil, pkgErrors.InvalidInput("unsupported currency: " + req.Currency)
+		}
+	}
+	if req.Locale != "" {
+		if locale = uc.supportedLocale(req.Locale); locale == "" {
+			return nil, pkgErrors.InvalidInput("unsupported locale: " + req.Locale)
+		}
+	}
+	if req.Country != "" {
+		if country = supportedCountry(req.Country); country == "" {
+			return nil, pkgErrors.InvalidInput("country must be a two letter ISO code")
+		}
+	}
+
+	if req.SaveToProfile && userID != nil {
+		user, err := uc.userRepo.GetByID(ctx, *userID)
+		if err != nil {
+			return nil, err
+		}
+		user.Currency = currency
+		user.Language = locale
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	storefront := uc.storefront(currency, locale, country)
+	return uc.toStorefrontResponse(entities.ContextWithStorefront(ctx, storefront), storefront, userID, sessionID), nil
+}
+
+// storefront builds a storefront with the exchange and tax rates of its currency and country
+func (uc *storefrontUseCase) storefront(currency, locale, country string) entities.Storefront {
+	storefront := entities.Storefront{
+		Currency:     currency,
+		Locale:       locale,
+		Country:      country,
+		BaseCurrency: uc.settings.BaseCurrency,
+		ExchangeRate: 1,
+		TaxRate:      uc.settings.TaxRates[country] / 100,
+		TaxInclusive: uc.settings.TaxDisplay.IsInclusive(country),
+	}
+	if currency != uc.settings.BaseCurrency {
+		storefront.ExchangeRate = uc.settings.CurrencyRates[currency]
+	}
+	return storefront
+}
+
+// supportedCurrency returns the currency code when shoppers can pick it, or "" when they can't
+func (uc *storefrontUseCase) supportedCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == uc.settings.BaseCurrency {
+		return currency
+	}
+	if rate, ok := uc.settings.CurrencyRates[currency]; ok && rate > 0 {
+		return currency
+	}
+	return ""
+}
+
+// supportedLocale returns the supported locale matching a requested locale or its language, so
+// "vi-VN" reads in "vi", or "" when none matches
+func (uc *storefrontUseCase) supportedLocale(locale string) string {
+	locale, _, _ = strings.Cut(locale, ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return ""
+	}
+	language, _, _ := strings.Cut(locale, "-")
+	for _, candidate := range []string{locale, language} {
+		for _, supported := range uc.settings.Locales {
+			if strings.EqualFold(supported, candidate) {
+				return supported
+			}
+		}
+	}
+	return ""
+}
+
+// supportedCountry returns an upper case two letter country code, or "" for anything else
+func supportedCountry(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+		return ""
+	}
+	return country
+}
+
+func (uc *storefrontUseCase) toStorefrontResponse(ctx context.Context, storefront entities.Storefront, userID *uuid.UUID, sessionID string) *StorefrontResponse {
+	currencies := []string{uc.settings.BaseCurrency}
+	for currency, rate := range uc.settings.CurrencyRates {
+		if rate > 0 && currency != uc.settings.BaseCurrency {
+			currencies = append(currencies, currency)
+		}
+	}
+	sort.Strings(currencies[1:])
+
+	response := &StorefrontResponse{
+		Currency:     storefront.Currency,
+		Locale:       storefront.Locale,
+		Country:      storefront.Country,
+		BaseCurrency: storefront.BaseCurrency,
+		ExchangeRate: storefront.ExchangeRate,
+		TaxRate:      storefront.TaxRate * 100,
+		TaxInclusive: storefront.TaxInclusive,
+		Format:       storefront.CurrencyFormat(),
+		Currencies:   currencies,
+		Locales:      uc.settings.Locales,
+	}
+
+	var cart *entities.Cart
+	var err error
+	if userID != nil {
+		cart, err = uc.cartRepo.GetByUserID(ctx, *userID)
+	} else if sessionID != "" {
+		cart, err = uc.cartRepo.GetBySessionID(ctx, sessionID)
+	}
+	if err == nil && cart != nil {
+		response.Cart = storefrontCartTotals(ctx, cart)
+	}
+	return response
+}
+
+// storefrontCartTotals re-prices a cart in the request's storefront, or returns nil outside one.
+// Tax is estimated at the ship-to country's rate.
+func storefrontCartTotals(ctx context.Context, cart *entities.Cart) *StorefrontCartTotals {
+	storefront := entities.StorefrontFromContext(ctx)
+	if !storefront.IsSet() {
+		return nil
+	}
+
+	subtotal := cart.GetTotal()
+	tax := subtotal * storefront.TaxRate
+	total := subtotal + tax + cart.ShippingAmount
+	if storefront.TaxInclusive {
+		subtotal += tax
+	}
+	totals := &StorefrontCartTotals{
+		Currency:     storefront.Currency,
+		ItemCount:    cart.GetItemCount(),
+		Subtotal:     storefront.Convert(subtotal),
+		EstimatedTax: storefront.Convert(tax),
+		TaxRate:      storefront.TaxRate * 100,
+		TaxIncluded:  storefront.TaxInclusive,
+		Shipping:     storefront.Convert(cart.ShippingAmount),
+		Total:        storefront.Convert(total),
+	}
+
+	format := storefront.CurrencyFormat()
+	totals.FormattedSubtotal = format.Format(totals.Subtotal)
+	totals.FormattedTax = format.Format(totals.EstimatedTax)
+	totals.FormattedShipping = format.Format(totals.Shipping)
+	totals.FormattedTotal = format.Format(totals.Total)
+	return totals
+}
+
+// applyStorefrontPrice adds the product's price in the request's storefront to a response
+func applyStorefrontPrice(ctx context.Context, response *ProductResponse) {
+	storefront := entities.StorefrontFromContext(ctx)
+	if !storefront.IsSet() {
+		return
+	}
+
+	price := &StorefrontPriceResponse{
+		Currency:     storefront.Currency,
+		Price:        storefront.DisplayAmount(response.Price),
+		CurrentPrice: storefront.DisplayAmount(response.CurrentPrice),
+		TaxIncluded:  storefront.TaxInclusive,
+		TaxRate:      storefront.TaxRate * 100,
+	}
+
+	format := storefront.CurrencyFormat()
+	price.FormattedPrice = format.Format(price.Price)
+	price.FormattedCurrentPrice = format.Format(price.CurrentPrice)
+	if response.OriginalPrice != nil {
+		original := storefront.DisplayAmount(*response.OriginalPrice)
+		formatted := format.Format(original)
+		price.OriginalPrice = &original
+		price.FormattedOriginalPrice = &formatted
+	}
+	response.DisplayPrice = price
+}
+
+// storefrontAmount converts a base currency amount to the request's storefront, or returns nil
+// outside one
+func storefrontAmount(ctx context.Context, amount float64) *StorefrontAmount {
+	storefront := entities.StorefrontFromContext(ctx)
+	if !storefront.IsSet() {
+		return nil
+	}
+	converted := storefront.Convert(amount)
+	return &StorefrontAmount{
+		Currency:  storefront.Currency,
+		Amount:    converted,
+		Formatted: storefront.CurrencyFormat().Format(converted),
+	}
+}
@@ -0,0 +1,348 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+)
+
+const (
+	// providerCheckTimeout bounds each provider health check
+	providerCheckTimeout = 5 * time.Second
+	// jobOverdueRuns is how many intervals a job may go without succeeding before it is overdue
+	jobOverdueRuns = 3
+)
+
+// processStartedAt is when this instance started, for uptime and jobs that never succeeded
+var processStartedAt = time.Now()
+
+// JobStateSource reports the state of the registered background jobs
+type JobStateSource interface {
+	States() []entities.JobState
+}
+
+// EmailRetryQueue reports how many emails are held back for retry while SMTP is unavailable
+type EmailRetryQueue interface {
+	QueueLength() int
+}
+
+// HealthChecker is implemented by external providers that can check they are usable
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// ProviderCheck is an external provider whose health the system status reports
+type ProviderCheck struct {
+	Name    string
+	Breaker string // Circuit breaker guarding calls to the provider, if any
+	Checker HealthChecker
+}
+
+// SystemStatusUseCase defines the interface for the operational status of the system
+type SystemStatusUseCase interface {
+	// GetSystemStatus reports queue depths, background job states, webhook delivery failures
+	// and provider health, with the issues an operator should look at
+	GetSystemStatus(ctx context.Context) (*SystemStatusResponse, error)
+}
+
+type systemStatusUseCase struct {
+	notificationRepo repositories.NotificationRepository
+	outboxRepo       repositories.OutboxEventRepository
+	partnerRepo      repositories.PartnerRepository
+	jobs             JobStateSource
+	emailQueue       EmailRetryQueue
+	breakers         *resilience.Registry
+	providers        []ProviderCheck
+}
+
+// NewSystemStatusUseCase creates a new system status use case
+func NewSystemStatusUseCase(
+	notificationRepo repositories.NotificationRepository,
+	outboxRepo repositories.OutboxEventRepository,
+	partnerRepo repositories.PartnerRepository,
+	jobs JobStateSource,
+	emailQueue EmailRetryQueue,
+	breakers *resilience.Registry,
+	providers []ProviderCheck,
+) SystemStatusUseCase {
+	return &systemStatusUseCase{
+		notificationRepo: notificationRepo,
+		outboxRepo:       outboxRepo,
+		partnerRepo:      partnerRepo,
+		jobs:             jobs,
+		emailQueue:       emailQueue,
+		breakers:         breakers,
+		providers:        providers,
+	}
+}
+
+// SystemStatus values
+const (
+	SystemStatusOK       = "ok"
+	SystemStatusDegraded = "degraded"
+)
+
+// SystemStatusResponse represents the operational status of the system
+type SystemStatusResponse struct {
+	Status        string                  `json:"status"` // ok, or degraded when there are issues
+	Issues        []string                `json:"issues"`
+	CheckedAt     time.Time               `json:"checked_at"`
+	StartedAt     time.Time               `json:"started_at"`
+	Notifications NotificationQueueStatus `json:"notifications"`
+	Outbox        OutboxStatus            `json:"outbox"`
+	Jobs          []BackgroundJobStatus   `json:"jobs"`
+	Webhooks      WebhookDeliveryStatus   `json:"webhooks"`
+	Providers     []ProviderStatus        `json:"providers"`
+}
+
+// NotificationQueueStatus represents the backlog of notifications and emails waiting to be sent
+type NotificationQueueStatus struct {
+	Pending         int64 `json:"pending"`
+	Processing      int64 `json:"processing"`
+	Failed          int64 `json:"failed"`
+	EmailRetryQueue int   `json:"email_retry_queue"` // Emails held back while SMTP is unavailable
+}
+
+// OutboxStatus represents the domain events not yet published to the event broker
+type OutboxStatus struct {
+	Pending         int64      `json:"pending"`
+	Failing         int64      `json:"failing"`
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+	LastError       string     `json:"last_error,omitempty"`
+}
+
+// BackgroundJobStatus represents the state of a background job
+type BackgroundJobStatus struct {
+	entities.JobState
+	Overdue bool `json:"overdue"` // No successful run for several intervals
+}
+
+// WebhookDeliveryStatus represents the delivery of partner feed webhooks
+type WebhookDeliveryStatus struct {
+	Endpoints int                     `json:"endpoints"` // Active partners with a webhook
+	Failing   int                     `json:"failing"`   // Of those, partners whose last delivery failed
+	Failures  []WebhookFailureSummary `json:"failures"`
+}
+
+// WebhookFailureSummary represents a partner webhook whose deliveries are failing
+type WebhookFailureSummary struct {
+	PartnerID        string     `json:"partner_id"`
+	PartnerName      string     `json:"partner_name"`
+	FailedDeliveries int        `json:"failed_deliveries"` // Consecutive failed deliveries
+	LastError        string     `json:"last_error"`
+	LastDeliveredAt  *time.Time `json:"last_delivered_at,omitempty"`
+	NextDeliveryAt   *time.Time `json:"next_delivery_at,omitempty"`
+}
+
+// ProviderStatus represents the health of an external provider
+type ProviderStatus struct {
+	Name      string `json:"name"`
+	Healthy   bool   `json:"healthy"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+	Breaker   string `json:"breaker,omitempty"` // State of the circuit breaker guarding the provider
+}
+
+// GetSystemStatus reports queue depths, background job states, webhook delivery failures and
+// provider health
+func (uc *systemStatusUseCase) GetSystemStatus(ctx context.Context) (*SystemStatusResponse, error) {
+	now := time.Now()
+	status := &SystemStatusResponse{
+		Issues:    []string{},
+		CheckedAt: now,
+		StartedAt: processStartedAt,
+	}
+
+	// Provider checks talk to external services, so they run while the database is queried
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		status.Providers = uc.checkProviders(ctx)
+	}()
+
+	notifications, err := uc.getNotificationQueue(ctx)
+	if err != nil {
+		wg.Wait()
+		return nil, fmt.Errorf("failed to get notification queue: %w", err)
+	}
+	status.Notifications = *notifications
+
+	outbox, err := uc.outboxRepo.GetStats(ctx)
+	if err != nil {
+		wg.Wait()
+		return nil, fmt.Errorf("failed to get outbox stats: %w", err)
+	}
+	status.Outbox = OutboxStatus{
+		Pending:         outbox.Pending,
+		Failing:         outbox.Failing,
+		OldestPendingAt: outbox.OldestPendingAt,
+		LastError:       outbox.LastError,
+	}
+
+	webhooks, err := uc.getWebhookDeliveries(ctx)
+	if err != nil {
+		wg.Wait()
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	status.Webhooks = *webhooks
+
+	status.Jobs = []BackgroundJobStatus{}
+	if uc.jobs != nil {
+		for _, state := range uc.jobs.States() {
+			status.Jobs = append(status.Jobs, BackgroundJobStatus{
+				JobState: state,
+				Overdue:  state.IsOverdue(now, processStartedAt, jobOverdueRuns),
+			})
+		}
+	}
+
+	wg.Wait()
+	status.Issues = systemStatusIssues(status, uc.openBreakers())
+	status.Status = SystemStatusOK
+	if len(status.Issues) > 0 {
+		status.Status = SystemStatusDegraded
+	}
+	return status, nil
+}
+
+// getNotificationQueue counts notifications by delivery status and the emails held for retry
+func (uc *systemStatusUseCase) getNotificationQueue(ctx context.Context) (*NotificationQueueStatus, error) {
+	pending, err := uc.notificationRepo.GetPendingCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	processing, err := uc.notificationRepo.GetProcessingCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := uc.notificationRepo.GetFailedCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &NotificationQueueStatus{
+		Pending:    pending,
+		Processing: processing,
+		Failed:     failed,
+	}
+	if uc.emailQueue != nil {
+		queue.EmailRetryQueue = uc.emailQueue.QueueLength()
+	}
+	return queue, nil
+}
+
+// getWebhookDeliveries summarizes the webhook deliveries of active partners
+func (uc *systemStatusUseCase) getWebhookDeliveries(ctx context.Context) (*WebhookDeliveryStatus, error) {
+	partners, err := uc.partnerRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := &WebhookDeliveryStatus{Failures: []WebhookFailureSummary{}}
+	for _, partner := range partners {
+		if !partner.IsActive || !partner.HasWebhook() {
+			continue
+		}
+		webhooks.Endpoints++
+		if partner.FailedDeliveries == 0 {
+			continue
+		}
+		webhooks.Failing++
+		webhooks.Failures = append(webhooks.Failures, WebhookFailureSummary{
+			PartnerID:        partner.ID.String(),
+			PartnerName:      partner.Name,
+			FailedDeliveries: partner.FailedDeliveries,
+			LastError:        partner.LastError,
+			LastDeliveredAt:  partner.LastDeliveredAt,
+			NextDeliveryAt:   partner.NextDeliveryAt,
+		})
+	}
+	return webhooks, nil
+}
+
+// checkProviders checks every provider at once, each bounded by providerCheckTimeout
+func (uc *systemStatusUseCase) checkProviders(ctx context.Context) []ProviderStatus {
+	breakerStates := make(map[string]string)
+	if uc.breakers != nil {
+		for _, snapshot := range uc.breakers.Snapshots() {
+			breakerStates[snapshot.Name] = snapshot.State
+		}
+	}
+
+	statuses := make([]ProviderStatus, len(uc.providers))
+	var wg sync.WaitGroup
+	for i, provider := range uc.providers {
+		wg.Add(1)
+		go func(i int, provider ProviderCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, providerCheckTimeout)
+			defer cancel()
+
+			startedAt := time.Now()
+			err := provider.Checker.CheckHealth(checkCtx)
+			statuses[i] = ProviderStatus{
+				Name:      provider.Name,
+				Healthy:   err == nil,
+				LatencyMs: time.Since(startedAt).Milliseconds(),
+				Breaker:   breakerStates[provider.Breaker],
+			}
+			if err != nil {
+				statuses[i].Error = err.Error()
+			}
+		}(i, provider)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// openBreakers returns the names of the circuit breakers that are turning calls away
+func (uc *systemStatusUseCase) openBreakers() []string {
+	var open []string
+	if uc.breakers == nil {
+		return open
+	}
+	for _, snapshot := range uc.breakers.Snapshots() {
+		if snapshot.State == resilience.StateOpen.String() {
+			open = append(open, snapshot.Name)
+		}
+	}
+	return open
+}
+
+// systemStatusIssues lists what in a status needs an operator's attention. A backlog of pending
+// notifications alone is not an issue; failing deliveries and jobs are.
+func systemStatusIssues(status *SystemStatusResponse, openBreakers []string) []string {
+	issues := []string{}
+	for _, provider := range status.Providers {
+		if !provider.Healthy {
+			issues = append(issues, fmt.Sprintf("%s provider is unhealthy: %s", provider.Name, provider.Error))
+		}
+	}
+	for _, name := range openBreakers {
+		issues = append(issues, fmt.Sprintf("circuit breaker %s is open", name))
+	}
+	if status.Notifications.EmailRetryQueue > 0 {
+		issues = append(issues, fmt.Sprintf("%d emails are waiting for SMTP to recover", status.Notifications.EmailRetryQueue))
+	}
+	if status.Outbox.Failing > 0 {
+		issues = append(issues, fmt.Sprintf("%d outbox events failed to publish: %s", status.Outbox.Failing, status.Outbox.LastError))
+	}
+	for _, job := range status.Jobs {
+		switch {
+		case job.LastError != "":
+			issues = append(issues, fmt.Sprintf("job %s failed on its last run: %s", job.Name, job.LastError))
+		case job.Overdue:
+			issues = append(issues, fmt.Sprintf("job %s has not succeeded in %d intervals", job.Name, jobOverdueRuns))
+		}
+	}
+	for _, failure := range status.Webhooks.Failures {
+		issues = append(issues, fmt.Sprintf("webhook of partner %s failed %d deliveries in a row: %s", failure.PartnerName, failure.FailedDeliveries, failure.LastError))
+	}
+	return issues
+}
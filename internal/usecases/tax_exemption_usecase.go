@@ -0,0 +1,455 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// TaxExemptionNotificationService interface for tax exemption notifications
+type TaxExemptionNotificationService interface {
+	// NotifyTaxExemptionStatusChanged tells a customer their certificate was verified, rejected or has expired
+	NotifyTaxExemptionStatusChanged(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
+	// NotifyTaxExemptionExpiring reminds a customer to upload a new certificate before theirs expires
+	NotifyTaxExemptionExpiring(ctx context.Context, certificate *entities.TaxExemptionCertificate) error
+}
+
+// TaxExemptionPolicy applies verified tax exemption certificates when order tax is calculated
+type TaxExemptionPolicy interface {
+	// ApplyExemption finds the customer's certificate for the shipping address that exempts the
+	// most of the items, and returns the exemption with the tax it takes off, or nil when no
+	// certificate applies. taxClasses maps the items' products to their tax classes.
+	ApplyExemption(ctx context.Context, userID uuid.UUID, items []entities.CartItem, taxClasses map[uuid.UUID]string, taxRate, taxAmount float64, country, state string) (*entities.OrderTaxExemption, error)
+}
+
+// TaxExemptionUseCase defines the interface for tax exemption certificates and their review
+type TaxExemptionUseCase interface {
+	TaxExemptionPolicy
+
+	// Customer
+	UploadCertificate(ctx context.Context, userID uuid.UUID, req UploadTaxExemptionRequest, file multipart.File, header *multipart.FileHeader) (*entities.TaxExemptionCertificate, error)
+	GetMyCertificates(ctx context.Context, userID uuid.UUID) ([]*entities.TaxExemptionCertificate, error)
+	DeleteMyCertificate(ctx context.Context, userID, id uuid.UUID) error
+
+	// Review
+	GetCertificates(ctx context.Context, req GetTaxExemptionsRequest) (*TaxExemptionsListResponse, error)
+	GetCertificate(ctx context.Context, id uuid.UUID) (*entities.TaxExemptionCertificate, error)
+	VerifyCertificate(ctx context.Context, id, reviewerID uuid.UUID, req VerifyTaxExemptionRequest) (*entities.TaxExemptionCertificate, error)
+	RejectCertificate(ctx context.Context, id, reviewerID uuid.UUID, req RejectTaxExemptionRequest) (*entities.TaxExemptionCertificate, error)
+
+	// SendExpiryReminders reminds customers whose certificates expire soon, expires lapsed
+	// certificates and returns how many customers were notified
+	SendExpiryReminders(ctx context.Context) (int, error)
+}
+
+// TaxExemptionSettings configures tax exemption expiry reminders
+type TaxExemptionSettings struct {
+	// ReminderDays is how many days before a certificate expires its customer is reminded
+	ReminderDays int
+}
+
+type taxExemptionUseCase struct {
+	certificateRepo     repositories.TaxExemptionRepository
+	fileService         services.FileService
+	orderService        services.OrderService
+	notificationService TaxExemptionNotificationService
+	settings            TaxExemptionSettings
+}
+
+// NewTaxExemptionUseCase creates a new tax exemption use case
+func NewTaxExemptionUseCase(
+	certificateRepo repositories.TaxExemptionRepository,
+	fileService services.FileService,
+	orderService services.OrderService,
+	notificationService TaxExemptionNotificationService,
+	settings TaxExemptionSettings,
+) TaxExemptionUseCase {
+	return &taxExemptionUseCase{
+		certificateRepo:     certificateRepo,
+		fileService:         fileService,
+		orderService:        orderService,
+		notificationService: notificationService,
+		settings:            settings,
+	}
+}
+
+// UploadTaxExemptionRequest represents the details of an uploaded tax exemption certificate
+type UploadTaxExemptionRequest struct {
+	Type              entities.TaxExemptionType `form:"type" binding:"required"`
+	CertificateNumber string                    `form:"certificate_number" binding:"required,max=100"`
+	Country           string                    `form:"country" binding:"required,len=2"` // ISO 3166-1 alpha-2
+	State             string                    `form:"state" binding:"max=100"`          // Empty for the whole country
+	TaxClasses        string                    `form:"tax_classes"`                      // Comma separated; empty for all
+	ExpiresAt         string                    `form:"expires_at"`                       // YYYY-MM-DD; empty if it does not expire
+}
+
+// GetTaxExemptionsRequest represents filters for the review queue
+type GetTaxExemptionsRequest struct {
+	Status entities.TaxExemptionStatus `form:"status" json:"status,omitempty"`
+	UserID *uuid.UUID                  `form:"user_id" json:"user_id,omitempty"`
+	Limit  int                         `form:"limit" json:"limit"`
+	Offset int                         `form:"offset" json:"offset"`
+}
+
+// TaxExemptionsListResponse represents a page of tax exemption certificates
+type TaxExemptionsListResponse struct {
+	Certificates []*entities.TaxExemptionCertificate `json:"certificates"`
+	Total        int64                               `json:"total"`
+	Pagination   *PaginationInfo                     `json:"pagination"`
+}
+
+// VerifyTaxExemptionRequest represents an admin verifying a certificate, correcting what the
+// customer entered from the document where needed
+type VerifyTaxExemptionRequest struct {
+	ExpiresAt  *time.Time `json:"expires_at"`
+	State      *string    `json:"state"`
+	TaxClasses []string   `json:"tax_classes"` // Replaces the customer's tax classes when set
+	Notes      string     `json:"notes" binding:"max=2000"`
+}
+
+// RejectTaxExemptionRequest represents an admin rejecting or revoking a certificate
+type RejectTaxExemptionRequest struct {
+	Notes string `json:"notes" binding:"required,max=2000"`
+}
+
+// UploadCertificate uploads a certificate document through the file service, which scans it, and
+// adds the certificate for review
+func (uc *taxExemptionUseCase) UploadCertificate(ctx context.Context, userID uuid.UUID, req UploadTaxExemptionRequest, file multipart.File, header *multipart.FileHeader) (*entities.TaxExemptionCertificate, error) {
+	certificate := &entities.TaxExemptionCertificate{
+		ID:                ids.New(),
+		UserID:            userID,
+		Type:              req.Type,
+		CertificateNumber: strings.TrimSpace(req.CertificateNumber),
+		Country:           strings.ToUpper(strings.TrimSpace(req.Country)),
+		State:             strings.TrimSpace(req.State),
+		TaxClasses:        parseTaxClasses(req.TaxClasses),
+		Status:            entities.TaxExemptionStatusPending,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse("2006-01-02", req.ExpiresAt)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("expires_at must be a date in YYYY-MM-DD format")
+		}
+		if !expiresAt.After(time.Now()) {
+			return nil, pkgErrors.InvalidInput("certificate has already expired")
+		}
+		certificate.ExpiresAt = &expiresAt
+	}
+	if err := certificate.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.fileService.ValidateFile(header, entities.DefaultKYCDocumentConfig()); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	uploadedBy := userID.String()
+	upload, err := uc.fileService.UploadFile(ctx, &entities.FileUploadRequest{
+		File:       file,
+		Header:     header,
+		Category:   "tax-exemption-certificates",
+		UploadType: entities.FileUploadTypeUser,
+		UploadedBy: &uploadedBy,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "security validation failed") {
+			return nil, pkgErrors.InvalidInput(err.Error())
+		}
+		return nil, fmt.Errorf("failed to upload certificate: %w", err)
+	}
+
+	certificate.FileID = upload.ID
+	certificate.FileName = header.Filename
+	certificate.ContentType = upload.ContentType
+	certificate.FileSize = upload.FileSize
+	certificate.URL = upload.URL
+	if err := uc.certificateRepo.Create(ctx, certificate); err != nil {
+		if deleteErr := uc.fileService.DeleteFile(ctx, upload.ID); deleteErr != nil {
+			fmt.Printf("Warning: failed to cleanup tax exemption certificate after database error: %v\n", deleteErr)
+		}
+		return nil, fmt.Errorf("failed to save tax exemption certificate: %w", err)
+	}
+
+	return certificate, nil
+}
+
+// GetMyCertificates lists a customer's certificates, newest first
+func (uc *taxExemptionUseCase) GetMyCertificates(ctx context.Context, userID uuid.UUID) ([]*entities.TaxExemptionCertificate, error) {
+	return uc.certificateRepo.ListByUser(ctx, userID)
+}
+
+// DeleteMyCertificate withdraws a customer's certificate and deletes its document. Orders placed
+// with it keep their record of the exemption.
+func (uc *taxExemptionUseCase) DeleteMyCertificate(ctx context.Context, userID, id uuid.UUID) error {
+	certificate, err := uc.certificateRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if certificate.UserID != userID {
+		return entities.ErrTaxExemptionNotFound
+	}
+
+	if err := uc.certificateRepo.Delete(ctx, certificate.ID); err != nil {
+		return fmt.Errorf("failed to delete tax exemption certificate: %w", err)
+	}
+	if err := uc.fileService.DeleteFile(ctx, certificate.FileID); err != nil {
+		fmt.Printf("Warning: failed to delete tax exemption certificate file %s: %v\n", certificate.FileID, err)
+	}
+	return nil
+}
+
+// GetCertificates lists certificates, oldest first
+func (uc *taxExemptionUseCase) GetCertificates(ctx context.Context, req GetTaxExemptionsRequest) (*TaxExemptionsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	certificates, total, err := uc.certificateRepo.List(ctx, repositories.TaxExemptionFilters{
+		Status: req.Status,
+		UserID: req.UserID,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaxExemptionsListResponse{
+		Certificates: certificates,
+		Total:        total,
+		Pagination:   NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetCertificate gets a certificate
+func (uc *taxExemptionUseCase) GetCertificate(ctx context.Context, id uuid.UUID) (*entities.TaxExemptionCertificate, error) {
+	return uc.certificateRepo.GetByID(ctx, id)
+}
+
+// VerifyCertificate verifies a pending certificate, which applies it at checkout
+func (uc *taxExemptionUseCase) VerifyCertificate(ctx context.Context, id, reviewerID uuid.UUID, req VerifyTaxExemptionRequest) (*entities.TaxExemptionCertificate, error) {
+	certificate, err := uc.certificateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if certificate.Status != entities.TaxExemptionStatusPending {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict,
+			fmt.Sprintf("Only pending certificates can be verified, this one is %s", certificate.Status))
+	}
+
+	now := time.Now()
+	if req.ExpiresAt != nil {
+		certificate.ExpiresAt = req.ExpiresAt
+	}
+	if req.State != nil {
+		certificate.State = strings.TrimSpace(*req.State)
+	}
+	if req.TaxClasses != nil {
+		certificate.TaxClasses = parseTaxClasses(strings.Join(req.TaxClasses, ","))
+	}
+	if certificate.IsExpired(now) {
+		return nil, pkgErrors.InvalidInput("certificate has already expired")
+	}
+	if err := certificate.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	return uc.review(ctx, certificate, reviewerID, entities.TaxExemptionStatusVerified, req.Notes, now)
+}
+
+// RejectCertificate rejects a pending certificate, or revokes a verified one
+func (uc *taxExemptionUseCase) RejectCertificate(ctx context.Context, id, reviewerID uuid.UUID, req RejectTaxExemptionRequest) (*entities.TaxExemptionCertificate, error) {
+	if strings.TrimSpace(req.Notes) == "" {
+		return nil, pkgErrors.InvalidInput("notes are required to reject a certificate")
+	}
+
+	certificate, err := uc.certificateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if certificate.Status != entities.TaxExemptionStatusPending && certificate.Status != entities.TaxExemptionStatusVerified {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict,
+			fmt.Sprintf("Only pending or verified certificates can be rejected, this one is %s", certificate.Status))
+	}
+
+	return uc.review(ctx, certificate, reviewerID, entities.TaxExemptionStatusRejected, req.Notes, time.Now())
+}
+
+// review records a decision on a certificate and notifies the customer
+func (uc *taxExemptionUseCase) review(ctx context.Context, certificate *entities.TaxExemptionCertificate, reviewerID uuid.UUID, status entities.TaxExemptionStatus, notes string, now time.Time) (*entities.TaxExemptionCertificate, error) {
+	certificate.Status = status
+	certificate.ReviewNotes = strings.TrimSpace(notes)
+	certificate.ReviewedBy = &reviewerID
+	certificate.ReviewedAt = &now
+	certificate.UpdatedAt = now
+	if err := uc.certificateRepo.Update(ctx, certificate); err != nil {
+		return nil, fmt.Errorf("failed to save tax exemption review: %w", err)
+	}
+
+	uc.notifyStatusChanged(ctx, certificate)
+	return certificate, nil
+}
+
+// ApplyExemption finds the customer's certificate for the shipping address that exempts the most
+// of the items and returns the exemption it gives
+func (uc *taxExemptionUseCase) ApplyExemption(ctx context.Context, userID uuid.UUID, items []entities.CartItem, taxClasses map[uuid.UUID]string, taxRate, taxAmount float64, country, state string) (*entities.OrderTaxExemption, error) {
+	if taxAmount <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	certificates, err := uc.certificateRepo.ListActiveByUser(ctx, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tax exemption certificates: %w", err)
+	}
+
+	var best *entities.OrderTaxExemption
+	for _, certificate := range certificates {
+		if !certificate.IsActive(now) || !certificate.Covers(country, state) {
+			continue
+		}
+
+		exemption := &entities.OrderTaxExemption{
+			CertificateID:     certificate.ID,
+			CertificateNumber: certificate.CertificateNumber,
+			Type:              certificate.Type,
+			Country:           certificate.Country,
+			State:             certificate.State,
+			ExpiresAt:         certificate.ExpiresAt,
+			VerifiedAt:        certificate.ReviewedAt,
+		}
+		var taxable []entities.CartItem
+		for _, item := range items {
+			if certificate.ExemptsTaxClass(taxClasses[item.ProductID]) {
+				exemption.ExemptProductIDs = append(exemption.ExemptProductIDs, item.ProductID)
+				exemption.ExemptSubtotal += item.GetSubtotal()
+			} else {
+				taxable = append(taxable, item)
+			}
+		}
+		if len(exemption.ExemptProductIDs) == 0 {
+			continue
+		}
+
+		// Tax on the remaining items, rounded as the order tax is
+		_, taxableTax, _ := uc.orderService.CalculateOrderTotal(taxable, taxRate, 0, 0)
+		exemption.ExemptSubtotal = math.Round(exemption.ExemptSubtotal*100) / 100
+		exemption.ExemptTax = math.Round((taxAmount-taxableTax)*100) / 100
+
+		if best == nil || exemption.ExemptTax > best.ExemptTax {
+			best = exemption
+		}
+	}
+
+	return best, nil
+}
+
+// SendExpiryReminders reminds customers whose certificates expire within the reminder days and
+// expires certificates that have lapsed
+func (uc *taxExemptionUseCase) SendExpiryReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	remindBefore := now.AddDate(0, 0, uc.settings.ReminderDays)
+	certificates, err := uc.certificateRepo.ListDueForExpiryNotice(ctx, now, remindBefore, 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expiring tax exemption certificates: %w", err)
+	}
+
+	notified := 0
+	for _, certificate := range certificates {
+		if certificate.IsExpired(now) {
+			certificate.Status = entities.TaxExemptionStatusExpired
+			certificate.UpdatedAt = now
+			if err := uc.certificateRepo.Update(ctx, certificate); err != nil {
+				fmt.Printf("❌ Failed to expire tax exemption certificate %s: %v\n", certificate.ID, err)
+				continue
+			}
+			uc.notifyStatusChanged(ctx, certificate)
+			notified++
+			continue
+		}
+
+		certificate.ReminderSentAt = &now
+		certificate.UpdatedAt = now
+		if err := uc.certificateRepo.Update(ctx, certificate); err != nil {
+			fmt.Printf("❌ Failed to record reminder for tax exemption certificate %s: %v\n", certificate.ID, err)
+			continue
+		}
+		if uc.notificationService != nil {
+			if err := uc.notificationService.NotifyTaxExemptionExpiring(ctx, certificate); err != nil {
+				fmt.Printf("Failed to send tax exemption expiry reminder: %v\n", err)
+			}
+		}
+		notified++
+	}
+
+	if notified > 0 {
+		fmt.Printf("✅ Sent tax exemption expiry notices for %d certificates\n", notified)
+	}
+	return notified, nil
+}
+
+func (uc *taxExemptionUseCase) notifyStatusChanged(ctx context.Context, certificate *entities.TaxExemptionCertificate) {
+	if uc.notificationService == nil {
+		return
+	}
+	if err := uc.notificationService.NotifyTaxExemptionStatusChanged(ctx, certificate); err != nil {
+		fmt.Printf("❌ Failed to notify customer of tax exemption certificate %s: %v\n", certificate.ID, err)
+	}
+}
+
+// parseTaxClasses splits comma separated tax classes, dropping blanks and duplicates
+func parseTaxClasses(value string) []string {
+	var taxClasses []string
+	seen := make(map[string]bool)
+	for _, taxClass := range strings.Split(value, ",") {
+		taxClass = strings.ToLower(strings.TrimSpace(taxClass))
+		if taxClass == "" || seen[taxClass] {
+			continue
+		}
+		seen[taxClass] = true
+		taxClasses = append(taxClasses, taxClass)
+	}
+	return taxClasses
+}
+
+// applyTaxExemption applies the customer's tax exemption, if any, to order totals and returns it
+// to record on the order
+func applyTaxExemption(ctx context.Context, policy TaxExemptionPolicy, userID uuid.UUID, items []entities.CartItem, taxClasses map[uuid.UUID]string, taxRate float64, country, state string, taxAmount, total *float64) (*entities.OrderTaxExemption, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	exemption, err := policy.ApplyExemption(ctx, userID, items, taxClasses, taxRate, *taxAmount, country, state)
+	if err != nil || exemption == nil {
+		return nil, err
+	}
+
+	*taxAmount = math.Round((*taxAmount-exemption.ExemptTax)*100) / 100
+	*total = math.Max(math.Round((*total-exemption.ExemptTax)*100)/100, 0)
+	return exemption, nil
+}
+
+// cartItemTaxClasses maps cart items' products to their tax classes
+func cartItemTaxClasses(items []entities.CartItem) map[uuid.UUID]string {
+	taxClasses := make(map[uuid.UUID]string, len(items))
+	for _, item := range items {
+		taxClasses[item.ProductID] = item.Product.TaxClass
+	}
+	return taxClasses
+}
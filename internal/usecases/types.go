@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,9 @@ type ProductResponse struct {
 	ShortDescription string    `json:"short_description"`
 	SKU              string    `json:"sku"`
 
+	// Locale is the language the name, descriptions and SEO fields are served in
+	Locale string `json:"locale,omitempty"`
+
 	// SEO and Metadata
 	Slug            string                     `json:"slug"`
 	MetaTitle       string                     `json:"meta_title"`
@@ -30,6 +34,9 @@ type ProductResponse struct {
 	ComparePrice *float64 `json:"compare_price"`
 	CostPrice    *float64 `json:"cost_price"`
 
+	// MAP floor
+	MinAdvertisedPrice *float64 `json:"min_advertised_price"`
+
 	// Sale Pricing
 	SalePrice     *float64   `json:"sale_price"`
 	SaleStartDate *time.Time `json:"sale_start_date"`
@@ -43,13 +50,23 @@ type ProductResponse struct {
 	SaleDiscountPercentage float64  `json:"sale_discount_percentage"` // Sale-specific discount percentage
 	DiscountPercentage     float64  `json:"discount_percentage"`      // Effective discount percentage (sale or compare)
 
+	// Price in the shopper's storefront currency, with tax when their country shows it included
+	DisplayPrice *StorefrontPriceResponse `json:"display_price,omitempty"`
+
 	// Inventory
-	Stock             int                  `json:"stock"`
+	Stock             *int                 `json:"stock"` // Nil when the product's stock visibility withholds it
 	LowStockThreshold int                  `json:"low_stock_threshold"`
 	TrackQuantity     bool                 `json:"track_quantity"`
 	AllowBackorder    bool                 `json:"allow_backorder"`
 	StockStatus       entities.StockStatus `json:"stock_status"`
 	IsLowStock        bool                 `json:"is_low_stock"`
+	StockMessage      string               `json:"stock_message,omitempty"`   // e.g. "Only 3 left in stock"
+	RestockETA        *time.Time           `json:"restock_eta,omitempty"`     // When an out-of-stock product is expected back
+	RestockMessage    string               `json:"restock_message,omitempty"` // e.g. "Back in stock around Oct 24"
+
+	// Stock visibility
+	StockVisibility       entities.StockVisibility `json:"stock_visibility"`
+	StockDisplayThreshold *int                     `json:"stock_display_threshold"`
 
 	// Physical Properties
 	Weight     *float64            `json:"weight"`
@@ -79,6 +96,14 @@ type ProductResponse struct {
 	HasVariants bool                   `json:"has_variants"`
 	MainImage   string                 `json:"main_image"`
 
+	// Lifecycle
+	NoLongerAvailable    bool               `json:"no_longer_available"` // Discontinued or archived
+	AvailabilityMessage  string             `json:"availability_message,omitempty"`
+	ReplacementProductID *uuid.UUID         `json:"replacement_product_id,omitempty"`
+	DiscontinuedAt       *time.Time         `json:"discontinued_at,omitempty"`
+	ArchivedAt           *time.Time         `json:"archived_at,omitempty"`
+	Alternatives         []*ProductResponse `json:"alternatives,omitempty"` // Set on the detail page of products no longer available
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -135,7 +160,7 @@ type ProductVariantResponse struct {
 	Price        float64                           `json:"price"`
 	ComparePrice *float64                          `json:"compare_price"`
 	CostPrice    *float64                          `json:"cost_price"`
-	Stock        int                               `json:"stock"`
+	Stock        *int                              `json:"stock"`
 	Weight       *float64                          `json:"weight"`
 	Dimensions   *DimensionsResponse               `json:"dimensions"`
 	Image        string                            `json:"image"`
@@ -404,6 +429,54 @@ type DateRangeResponse struct {
 	To   time.Time `json:"to"`
 }
 
+// InventorySnapshotResponse represents the result of capturing an inventory snapshot
+type InventorySnapshotResponse struct {
+	SnapshotDate time.Time `json:"snapshot_date"`
+	Captured     int64     `json:"captured"`
+}
+
+// GetStockAsOfRequest represents request for stock levels as of a past date
+type GetStockAsOfRequest struct {
+	Date        time.Time  `json:"date"`
+	WarehouseID *uuid.UUID `json:"warehouse_id"`
+	ProductID   *uuid.UUID `json:"product_id"`
+	Page        int        `json:"page"`
+	Limit       int        `json:"limit"`
+}
+
+// StockAsOfResponse represents stock levels as of a past date
+type StockAsOfResponse struct {
+	AsOf          time.Time                        `json:"as_of"`
+	SnapshotDate  time.Time                        `json:"snapshot_date"` // Latest snapshot on or before as_of
+	TotalQuantity int64                            `json:"total_quantity"`
+	TotalValue    float64                          `json:"total_value"`
+	Items         []repositories.StockSnapshotItem `json:"items"`
+	Pagination    *PaginationInfo                  `json:"pagination"`
+}
+
+// GetStockDeltasRequest represents request for stock changes between two dates
+type GetStockDeltasRequest struct {
+	From        time.Time  `json:"from"`
+	To          time.Time  `json:"to"`
+	WarehouseID *uuid.UUID `json:"warehouse_id"`
+	ProductID   *uuid.UUID `json:"product_id"`
+	ChangedOnly bool       `json:"changed_only"`
+	Page        int        `json:"page"`
+	Limit       int        `json:"limit"`
+}
+
+// StockDeltasResponse represents stock changes between two dates
+type StockDeltasResponse struct {
+	From             time.Time                     `json:"from"`
+	To               time.Time                     `json:"to"`
+	FromSnapshotDate time.Time                     `json:"from_snapshot_date"`
+	ToSnapshotDate   time.Time                     `json:"to_snapshot_date"`
+	QuantityChange   int64                         `json:"quantity_change"`
+	ValueChange      float64                       `json:"value_change"`
+	Items            []repositories.StockDeltaItem `json:"items"`
+	Pagination       *PaginationInfo               `json:"pagination"`
+}
+
 // PaginationResponse represents pagination response (alias for consistency)
 type PaginationResponse = PaginationInfo
 
@@ -10,11 +10,20 @@ import (
 	"ecom-golang-clean-architecture/internal/domain/entities"
 	"ecom-golang-clean-architecture/internal/domain/repositories"
 	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/jwtkeys"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+// ActivityTracker records notable user events for the user's own activity stream.
+// It is implemented by the user use case and injected into the use cases that emit events.
+type ActivityTracker interface {
+	TrackUserActivity(ctx context.Context, userID uuid.UUID, activityType string, description string, entityType string, entityID *uuid.UUID, metadata map[string]interface{}) error
+}
+
 // UserUseCase defines user use cases
 type UserUseCase interface {
 	Register(ctx context.Context, req RegisterRequest) (*UserResponse, error)
@@ -37,6 +46,7 @@ type UserUseCase interface {
 	InvalidateAllSessions(ctx context.Context, userID uuid.UUID) error
 	GetUserActivity(ctx context.Context, userID uuid.UUID, limit, offset int) (*UserActivityResponse, error)
 	TrackUserActivity(ctx context.Context, userID uuid.UUID, activityType string, description string, entityType string, entityID *uuid.UUID, metadata map[string]interface{}) error
+	GetActivityStream(ctx context.Context, userID uuid.UUID, req GetActivityStreamRequest) (*ActivityStreamResponse, error)
 	GetUserStats(ctx context.Context, userID uuid.UUID) (*UserStatsResponse, error)
 
 	// User preferences methods
@@ -102,7 +112,9 @@ type userUseCase struct {
 	passwordService      services.PasswordService
 	gmailService         GmailService
 	notificationService  UserNotificationService
-	jwtSecret            string
+	jwtKeys              *jwtkeys.KeySet
+	residency            services.DataResidencyService
+	events               services.EventRecorder
 }
 
 // GmailService interface for email operations
@@ -128,7 +140,9 @@ func NewUserUseCase(
 	passwordService services.PasswordService,
 	gmailService GmailService,
 	notificationService UserNotificationService,
-	jwtSecret string,
+	jwtKeys *jwtkeys.KeySet,
+	residency services.DataResidencyService,
+	events services.EventRecorder,
 ) UserUseCase {
 	return &userUseCase{
 		userRepo:             userRepo,
@@ -142,7 +156,9 @@ func NewUserUseCase(
 		passwordService:      passwordService,
 		gmailService:         gmailService,
 		notificationService:  notificationService,
-		jwtSecret:            jwtSecret,
+		jwtKeys:              jwtKeys,
+		residency:            residency,
+		events:               events,
 	}
 }
 
@@ -258,6 +274,30 @@ type UserActivityResponse struct {
 	Pagination PaginationInfo      `json:"pagination"`
 }
 
+// GetActivityStreamRequest represents a request for the user's own activity stream
+type GetActivityStreamRequest struct {
+	Types []entities.ActivityType `json:"types"` // Limit to these notable types; all notable types when empty
+	Page  int                     `json:"page"`
+	Limit int                     `json:"limit"`
+}
+
+// ActivityStreamResponse represents a paginated activity stream
+type ActivityStreamResponse struct {
+	Activities []*ActivityStreamItem `json:"activities"`
+	Pagination *PaginationInfo       `json:"pagination"`
+}
+
+// ActivityStreamItem represents an event in the user's activity stream
+type ActivityStreamItem struct {
+	ID          uuid.UUID              `json:"id"`
+	Type        entities.ActivityType  `json:"type"`
+	Description string                 `json:"description"`
+	EntityType  string                 `json:"entity_type"`
+	EntityID    *uuid.UUID             `json:"entity_id"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
 // UserActivityItem represents user activity item
 type UserActivityItem struct {
 	ID          uuid.UUID             `json:"id"`
@@ -394,7 +434,7 @@ type LoginResponse struct {
 // Register registers a new user
 func (uc *userUseCase) Register(ctx context.Context, req RegisterRequest) (*UserResponse, error) {
 	// Validate password complexity
-	if err := uc.validatePasswordComplexity(req.Password); err != nil {
+	if err := validatePasswordComplexity(req.Password); err != nil {
 		return nil, err
 	}
 
@@ -425,24 +465,29 @@ func (uc *userUseCase) Register(ctx context.Context, req RegisterRequest) (*User
 		return nil, err
 	}
 
-	// Create user
+	// Create user, keeping their data in the region of the country they shop from
 	user := &entities.User{
-		ID:        uuid.New(),
-		Email:     req.Email,
-		Password:  hashedPassword,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Phone:     req.Phone,
-		Role:      entities.UserRoleCustomer,
-		IsActive:  true,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:         ids.New(),
+		Email:      req.Email,
+		Password:   hashedPassword,
+		FirstName:  req.FirstName,
+		LastName:   req.LastName,
+		Phone:      req.Phone,
+		Role:       entities.UserRoleCustomer,
+		IsActive:   true,
+		DataRegion: uc.residency.RegionForCountry(entities.StorefrontFromContext(ctx).Country),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
 	}
 
 	if err := uc.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
 
+	if uc.events != nil {
+		uc.events.Record(ctx, entities.DomainEventUserRegistered, user.ID, entities.NewUserRegisteredEventData(user, "email"))
+	}
+
 	// Send email verification automatically after registration
 	go func() {
 		if err := uc.SendEmailVerification(context.Background(), user.ID); err != nil {
@@ -465,7 +510,7 @@ func (uc *userUseCase) Register(ctx context.Context, req RegisterRequest) (*User
 }
 
 // validatePasswordComplexity validates password complexity requirements
-func (uc *userUseCase) validatePasswordComplexity(password string) error {
+func validatePasswordComplexity(password string) error {
 	if len(password) < 8 {
 		return fmt.Errorf("password must be at least 8 characters long")
 	}
@@ -600,6 +645,21 @@ func (uc *userUseCase) resetFailedLoginAttempts(ctx context.Context, email strin
 	return nil
 }
 
+// rehashPassword replaces a user's password hash with one made with the current settings. A
+// failure only postpones the upgrade to the next login.
+func (uc *userUseCase) rehashPassword(ctx context.Context, user *entities.User, password string) {
+	hashedPassword, err := uc.passwordService.HashPassword(password)
+	if err != nil {
+		fmt.Printf("Failed to rehash password of user %s: %v\n", user.ID, err)
+		return
+	}
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		fmt.Printf("Failed to save rehashed password of user %s: %v\n", user.ID, err)
+		return
+	}
+	user.Password = hashedPassword
+}
+
 // Login authenticates a user
 func (uc *userUseCase) Login(ctx context.Context, req LoginRequest) (*LoginResponse, error) {
 	// Check rate limiting for this email
@@ -640,6 +700,11 @@ func (uc *userUseCase) Login(ctx context.Context, req LoginRequest) (*LoginRespo
 		return nil, entities.ErrInvalidCredentials
 	}
 
+	// Upgrade hashes made with an older algorithm or parameters while the password is at hand
+	if uc.passwordService.NeedsRehash(user.Password) {
+		uc.rehashPassword(ctx, user, req.Password)
+	}
+
 	// Reset failed login attempts on successful login
 	_ = uc.resetFailedLoginAttempts(ctx, req.Email)
 
@@ -657,7 +722,7 @@ func (uc *userUseCase) Login(ctx context.Context, req LoginRequest) (*LoginRespo
 
 	// Create user session with enhanced tracking
 	session := &entities.UserSession{
-		ID:           uuid.New(),
+		ID:           ids.New(),
 		UserID:       user.ID,
 		SessionToken: token,
 		DeviceInfo:   req.DeviceInfo,
@@ -752,7 +817,7 @@ func (uc *userUseCase) ChangePassword(ctx context.Context, userID uuid.UUID, req
 	}
 
 	// Validate new password complexity
-	if err := uc.validatePasswordComplexity(req.NewPassword); err != nil {
+	if err := validatePasswordComplexity(req.NewPassword); err != nil {
 		return err
 	}
 
@@ -815,8 +880,7 @@ func (uc *userUseCase) generateJWTToken(user *entities.User) (string, error) {
 		"jti":     uuid.New().String(),                // JWT ID for token tracking
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(uc.jwtSecret))
+	return uc.jwtKeys.Sign(claims)
 }
 
 // toUserResponse converts user entity to response (includes user metrics)
@@ -968,7 +1032,7 @@ func (uc *userUseCase) GetUserActivity(ctx context.Context, userID uuid.UUID, li
 // TrackUserActivity tracks user activity
 func (uc *userUseCase) TrackUserActivity(ctx context.Context, userID uuid.UUID, activityType string, description string, entityType string, entityID *uuid.UUID, metadata map[string]interface{}) error {
 	activity := &entities.UserActivity{
-		ID:          uuid.New(),
+		ID:          ids.New(),
 		UserID:      userID,
 		Type:        entities.ActivityType(activityType),
 		Description: description,
@@ -977,16 +1041,79 @@ func (uc *userUseCase) TrackUserActivity(ctx context.Context, userID uuid.UUID,
 		CreatedAt:   time.Now(),
 	}
 
-	// Convert metadata to JSON string if provided
+	// Metadata is stored as JSON; the column is jsonb so it must never be empty
+	activity.Metadata = "{}"
 	if metadata != nil {
-		// In a real implementation, you'd marshal this to JSON
-		// For now, we'll leave it empty
-		activity.Metadata = ""
+		metadataJSON, err := json.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal activity metadata: %w", err)
+		}
+		activity.Metadata = string(metadataJSON)
 	}
 
 	return uc.userActivityRepo.Create(ctx, activity)
 }
 
+// trackActivity records an activity stream event; tracking failures never fail the caller
+func trackActivity(ctx context.Context, tracker ActivityTracker, userID uuid.UUID, activityType entities.ActivityType, description, entityType string, entityID *uuid.UUID, metadata map[string]interface{}) {
+	if tracker == nil {
+		return
+	}
+	if err := tracker.TrackUserActivity(ctx, userID, string(activityType), description, entityType, entityID, metadata); err != nil {
+		fmt.Printf("Warning: failed to track %s activity for user %s: %v\n", activityType, userID, err)
+	}
+}
+
+// GetActivityStream gets the user's notable events (orders, reviews, points, wishlist, support), newest first
+func (uc *userUseCase) GetActivityStream(ctx context.Context, userID uuid.UUID, req GetActivityStreamRequest) (*ActivityStreamResponse, error) {
+	page, limit, err := ValidateAndNormalizePagination(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	types := entities.NotableActivityTypes
+	if len(req.Types) > 0 {
+		for _, activityType := range req.Types {
+			if !entities.IsNotableActivityType(activityType) {
+				return nil, pkgErrors.InvalidInput(fmt.Sprintf("Unsupported activity type: %s", activityType))
+			}
+		}
+		types = req.Types
+	}
+
+	offset := (page - 1) * limit
+	activities, err := uc.userActivityRepo.GetByUserIDAndTypes(ctx, userID, types, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.userActivityRepo.CountByUserIDAndTypes(ctx, userID, types)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*ActivityStreamItem, len(activities))
+	for i, activity := range activities {
+		item := &ActivityStreamItem{
+			ID:          activity.ID,
+			Type:        activity.Type,
+			Description: activity.Description,
+			EntityType:  activity.EntityType,
+			EntityID:    activity.EntityID,
+			CreatedAt:   activity.CreatedAt,
+		}
+		if activity.Metadata != "" {
+			_ = json.Unmarshal([]byte(activity.Metadata), &item.Metadata)
+		}
+		items[i] = item
+	}
+
+	return &ActivityStreamResponse{
+		Activities: items,
+		Pagination: NewPaginationInfo(page, limit, total),
+	}, nil
+}
+
 // GetUserStats gets user statistics
 func (uc *userUseCase) GetUserStats(ctx context.Context, userID uuid.UUID) (*UserStatsResponse, error) {
 	user, err := uc.userRepo.GetByID(ctx, userID)
@@ -1048,7 +1175,7 @@ func (uc *userUseCase) GetUserPreferences(ctx context.Context, userID uuid.UUID)
 		// If preferences don't exist, create default ones
 		if err == entities.ErrUserNotFound {
 			defaultPreferences := &entities.UserPreferences{
-				ID:                 uuid.New(),
+				ID:                 ids.New(),
 				UserID:             userID,
 				Theme:              "system",
 				Language:           "en",
@@ -1242,7 +1369,7 @@ func (uc *userUseCase) SendEmailVerification(ctx context.Context, userID uuid.UU
 	} else {
 		// Create new verification record
 		verification := &entities.UserVerification{
-			ID:               uuid.New(),
+			ID:               ids.New(),
 			UserID:           userID,
 			VerificationType: "email",
 			VerificationCode: token,
@@ -1419,9 +1546,7 @@ func (uc *userUseCase) Logout(ctx context.Context, token string) error {
 func (uc *userUseCase) RefreshToken(ctx context.Context, refreshToken string) (*RefreshTokenResponse, error) {
 	// Parse and validate refresh token
 	claims := jwt.MapClaims{}
-	token, err := jwt.ParseWithClaims(refreshToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(uc.jwtSecret), nil
-	})
+	token, err := uc.jwtKeys.Parse(refreshToken, claims)
 
 	if err != nil || !token.Valid {
 		return nil, fmt.Errorf("invalid refresh token")
@@ -1481,7 +1606,7 @@ func (uc *userUseCase) ForgotPassword(ctx context.Context, req ForgotPasswordReq
 	// Create password reset record
 	expiresAt := time.Now().Add(1 * time.Hour) // 1 hour expiry
 	passwordReset := &entities.PasswordReset{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		UserID:    user.ID,
 		Token:     resetToken,
 		ExpiresAt: expiresAt,
@@ -1570,8 +1695,7 @@ func (uc *userUseCase) generateRefreshToken(user *entities.User) (string, error)
 		"iat":     time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(uc.jwtSecret))
+	return uc.jwtKeys.Sign(claims)
 }
 
 // ResendVerification resends email verification
@@ -1602,7 +1726,7 @@ func (uc *userUseCase) ResendVerification(ctx context.Context, req ResendVerific
 func (uc *userUseCase) TrackSearch(ctx context.Context, req TrackSearchRequest) error {
 	// Create search history entry
 	searchHistory := &entities.UserSearchHistory{
-		ID:        uuid.New(),
+		ID:        ids.New(),
 		UserID:    req.UserID,
 		Query:     req.Query,
 		Results:   req.Results,
@@ -1669,7 +1793,7 @@ func (uc *userUseCase) GetPopularSearches(ctx context.Context, userID uuid.UUID,
 func (uc *userUseCase) CreateSavedSearch(ctx context.Context, req CreateSavedSearchRequest) (*SavedSearchResponse, error) {
 	// Create saved search entity
 	savedSearch := &entities.SavedSearch{
-		ID:           uuid.New(),
+		ID:           ids.New(),
 		UserID:       req.UserID,
 		Name:         req.Name,
 		Query:        req.Query,
@@ -1744,7 +1868,7 @@ func (uc *userUseCase) GetPersonalization(ctx context.Context, userID uuid.UUID)
 	// TODO: Implement personalization retrieval from repository
 	// For now, return default personalization
 	return &PersonalizationResponse{
-		ID:                  uuid.New(),
+		ID:                  ids.New(),
 		UserID:              userID,
 		CategoryPreferences: make(map[string]float64),
 		BrandPreferences:    make(map[string]float64),
@@ -2561,7 +2685,7 @@ func (uc *userUseCase) logLoginAttemptEnhanced(ctx context.Context, email string
 	}
 
 	loginHistory := &entities.UserLoginHistory{
-		ID:         uuid.New(),
+		ID:         ids.New(),
 		UserID:     userID,
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
@@ -0,0 +1,418 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// VendorApplicationNotificationService interface for vendor application notifications
+type VendorApplicationNotificationService interface {
+	NotifyVendorApplicationSubmitted(ctx context.Context, application *entities.VendorApplication) error
+	NotifyVendorApplicationStatusChanged(ctx context.Context, application *entities.VendorApplication) error
+}
+
+// VendorApplicationUseCase defines the interface for vendor onboarding and its review
+type VendorApplicationUseCase interface {
+	// Applicant
+	Apply(ctx context.Context, userID uuid.UUID, req VendorApplicationRequest) (*entities.VendorApplication, error)
+	GetMyApplication(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error)
+	UpdateMyApplication(ctx context.Context, userID uuid.UUID, req VendorApplicationRequest) (*entities.VendorApplication, error)
+	UploadDocument(ctx context.Context, userID uuid.UUID, documentType entities.VendorDocumentType, file multipart.File, header *multipart.FileHeader) (*entities.VendorDocument, error)
+	DeleteDocument(ctx context.Context, userID, documentID uuid.UUID) error
+	SubmitMyApplication(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error)
+
+	// Review
+	GetApplications(ctx context.Context, req GetVendorApplicationsRequest) (*VendorApplicationsListResponse, error)
+	GetApplication(ctx context.Context, id uuid.UUID) (*entities.VendorApplication, error)
+	ApproveApplication(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error)
+	RejectApplication(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error)
+	RequestApplicationChanges(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error)
+}
+
+type vendorApplicationUseCase struct {
+	vendorRepo          repositories.VendorRepository
+	applicationRepo     repositories.VendorApplicationRepository
+	fileService         services.FileService
+	notificationService VendorApplicationNotificationService
+}
+
+// NewVendorApplicationUseCase creates a new vendor application use case
+func NewVendorApplicationUseCase(
+	vendorRepo repositories.VendorRepository,
+	applicationRepo repositories.VendorApplicationRepository,
+	fileService services.FileService,
+	notificationService VendorApplicationNotificationService,
+) VendorApplicationUseCase {
+	return &vendorApplicationUseCase{
+		vendorRepo:          vendorRepo,
+		applicationRepo:     applicationRepo,
+		fileService:         fileService,
+		notificationService: notificationService,
+	}
+}
+
+// VendorBusinessDetails are the business details of a vendor application
+type VendorBusinessDetails struct {
+	LegalName          string                      `json:"legal_name" binding:"required,max=255"`
+	BusinessType       entities.VendorBusinessType `json:"business_type" binding:"required,oneof=individual company"`
+	RegistrationNumber string                      `json:"registration_number" binding:"max=100"`
+	TaxID              string                      `json:"tax_id" binding:"max=100"`
+	Country            string                      `json:"country" binding:"required,len=2"` // ISO 3166-1 alpha-2
+	Address            string                      `json:"address" binding:"required,max=500"`
+	City               string                      `json:"city" binding:"required,max=100"`
+	PostalCode         string                      `json:"postal_code" binding:"max=20"`
+	Website            string                      `json:"website" binding:"omitempty,url"`
+	Description        string                      `json:"description" binding:"max=2000"`
+}
+
+// VendorApplicationRequest represents an account's application to sell on the marketplace
+type VendorApplicationRequest struct {
+	StoreName string `json:"store_name" binding:"required,max=200"` // Name shoppers see
+	Email     string `json:"email" binding:"required,email"`
+	Phone     string `json:"phone"`
+	VendorBusinessDetails
+}
+
+// GetVendorApplicationsRequest represents filters for the review queue
+type GetVendorApplicationsRequest struct {
+	Status entities.VendorApplicationStatus `form:"status" json:"status,omitempty"`
+	Limit  int                              `form:"limit" json:"limit"`
+	Offset int                              `form:"offset" json:"offset"`
+}
+
+// VendorApplicationsListResponse represents a page of vendor applications
+type VendorApplicationsListResponse struct {
+	Applications []*entities.VendorApplication `json:"applications"`
+	Total        int64                         `json:"total"`
+	Pagination   *PaginationInfo               `json:"pagination"`
+}
+
+// ReviewVendorApplicationRequest represents a reviewer's decision notes
+type ReviewVendorApplicationRequest struct {
+	Notes string `json:"notes" binding:"max=2000"` // Required when rejecting or requesting changes
+}
+
+// Apply creates a draft application with a pending vendor for an account
+func (uc *vendorApplicationUseCase) Apply(ctx context.Context, userID uuid.UUID, req VendorApplicationRequest) (*entities.VendorApplication, error) {
+	if _, err := uc.vendorRepo.GetByUserID(ctx, userID); err == nil {
+		return nil, entities.ErrVendorApplicationExists
+	} else if err != entities.ErrVendorNotFound {
+		return nil, err
+	}
+
+	slug, err := uc.uniqueSlug(ctx, req.StoreName)
+	if err != nil {
+		return nil, err
+	}
+
+	vendor := &entities.Vendor{
+		ID:        ids.New(),
+		Name:      strings.TrimSpace(req.StoreName),
+		Slug:      slug,
+		Email:     strings.TrimSpace(req.Email),
+		Phone:     req.Phone,
+		Status:    entities.VendorStatusPending,
+		UserID:    &userID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	application := &entities.VendorApplication{
+		ID:        ids.New(),
+		VendorID:  vendor.ID,
+		UserID:    userID,
+		Status:    entities.VendorApplicationStatusDraft,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	applyBusinessDetails(application, req.VendorBusinessDetails)
+
+	if err := uc.applicationRepo.Create(ctx, application, vendor); err != nil {
+		return nil, fmt.Errorf("failed to create vendor application: %w", err)
+	}
+
+	application.Vendor = vendor
+	return application, nil
+}
+
+// uniqueSlug makes a vendor slug from a store name, numbering it if it is taken
+func (uc *vendorApplicationUseCase) uniqueSlug(ctx context.Context, name string) (string, error) {
+	base := utils.GenerateSlug(name)
+	if base == "" {
+		return "", pkgErrors.InvalidInput("store name must contain letters or numbers")
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		_, err := uc.vendorRepo.GetBySlug(ctx, slug)
+		if err == entities.ErrVendorNotFound {
+			return slug, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func applyBusinessDetails(application *entities.VendorApplication, details VendorBusinessDetails) {
+	application.LegalName = strings.TrimSpace(details.LegalName)
+	application.BusinessType = details.BusinessType
+	application.RegistrationNumber = strings.TrimSpace(details.RegistrationNumber)
+	application.TaxID = strings.TrimSpace(details.TaxID)
+	application.Country = strings.ToUpper(details.Country)
+	application.Address = strings.TrimSpace(details.Address)
+	application.City = strings.TrimSpace(details.City)
+	application.PostalCode = strings.TrimSpace(details.PostalCode)
+	application.Website = strings.TrimSpace(details.Website)
+	application.Description = strings.TrimSpace(details.Description)
+}
+
+// GetMyApplication gets an account's application
+func (uc *vendorApplicationUseCase) GetMyApplication(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error) {
+	return uc.applicationRepo.GetByUserID(ctx, userID)
+}
+
+// UpdateMyApplication replaces the details of a draft or returned application
+func (uc *vendorApplicationUseCase) UpdateMyApplication(ctx context.Context, userID uuid.UUID, req VendorApplicationRequest) (*entities.VendorApplication, error) {
+	application, err := uc.editableApplication(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyBusinessDetails(application, req.VendorBusinessDetails)
+	application.UpdatedAt = time.Now()
+	if err := uc.applicationRepo.Update(ctx, application); err != nil {
+		return nil, fmt.Errorf("failed to update vendor application: %w", err)
+	}
+
+	vendor := application.Vendor
+	vendor.Name = strings.TrimSpace(req.StoreName)
+	vendor.Email = strings.TrimSpace(req.Email)
+	vendor.Phone = req.Phone
+	vendor.UpdatedAt = time.Now()
+	if err := uc.vendorRepo.Update(ctx, vendor); err != nil {
+		return nil, fmt.Errorf("failed to update vendor: %w", err)
+	}
+
+	return application, nil
+}
+
+// editableApplication gets an account's application if it can still be changed
+func (uc *vendorApplicationUseCase) editableApplication(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error) {
+	application, err := uc.applicationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !application.IsEditable() {
+		return nil, entities.ErrVendorApplicationLocked
+	}
+	return application, nil
+}
+
+// UploadDocument uploads a KYC document through the file service, which scans it, and adds it
+// to an account's application
+func (uc *vendorApplicationUseCase) UploadDocument(ctx context.Context, userID uuid.UUID, documentType entities.VendorDocumentType, file multipart.File, header *multipart.FileHeader) (*entities.VendorDocument, error) {
+	if !entities.IsValidVendorDocumentType(documentType) {
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("invalid document type: %s", documentType))
+	}
+
+	application, err := uc.editableApplication(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.fileService.ValidateFile(header, entities.DefaultKYCDocumentConfig()); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	uploadedBy := userID.String()
+	upload, err := uc.fileService.UploadFile(ctx, &entities.FileUploadRequest{
+		File:       file,
+		Header:     header,
+		Category:   "vendor-documents",
+		UploadType: entities.FileUploadTypeUser,
+		UploadedBy: &uploadedBy,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "security validation failed") {
+			return nil, pkgErrors.InvalidInput(err.Error())
+		}
+		return nil, fmt.Errorf("failed to upload document: %w", err)
+	}
+
+	document := &entities.VendorDocument{
+		ID:            ids.New(),
+		ApplicationID: application.ID,
+		Type:          documentType,
+		FileID:        upload.ID,
+		FileName:      header.Filename,
+		ContentType:   upload.ContentType,
+		FileSize:      upload.FileSize,
+		URL:           upload.URL,
+		CreatedAt:     time.Now(),
+	}
+	if err := uc.applicationRepo.AddDocument(ctx, document); err != nil {
+		if deleteErr := uc.fileService.DeleteFile(ctx, upload.ID); deleteErr != nil {
+			fmt.Printf("Warning: failed to cleanup vendor document after database error: %v\n", deleteErr)
+		}
+		return nil, fmt.Errorf("failed to save vendor document: %w", err)
+	}
+
+	return document, nil
+}
+
+// DeleteDocument removes a document from an account's application and deletes its file
+func (uc *vendorApplicationUseCase) DeleteDocument(ctx context.Context, userID, documentID uuid.UUID) error {
+	application, err := uc.editableApplication(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	document, err := uc.applicationRepo.GetDocument(ctx, application.ID, documentID)
+	if err != nil {
+		return err
+	}
+	if err := uc.applicationRepo.DeleteDocument(ctx, document.ID); err != nil {
+		return fmt.Errorf("failed to delete vendor document: %w", err)
+	}
+	if err := uc.fileService.DeleteFile(ctx, document.FileID); err != nil {
+		fmt.Printf("Warning: failed to delete vendor document file %s: %v\n", document.FileID, err)
+	}
+	return nil
+}
+
+// SubmitMyApplication sends an account's application for review
+func (uc *vendorApplicationUseCase) SubmitMyApplication(ctx context.Context, userID uuid.UUID) (*entities.VendorApplication, error) {
+	application, err := uc.editableApplication(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := application.MissingDocumentTypes(); len(missing) > 0 {
+		names := make([]string, len(missing))
+		for i, documentType := range missing {
+			names[i] = string(documentType)
+		}
+		return nil, pkgErrors.InvalidInput(fmt.Sprintf("missing required documents: %s", strings.Join(names, ", ")))
+	}
+	if application.BusinessType == entities.VendorBusinessTypeCompany && application.RegistrationNumber == "" {
+		return nil, pkgErrors.InvalidInput("registration_number is required for companies")
+	}
+
+	now := time.Now()
+	application.Status = entities.VendorApplicationStatusSubmitted
+	application.SubmittedAt = &now
+	application.UpdatedAt = now
+	if err := uc.applicationRepo.Update(ctx, application); err != nil {
+		return nil, fmt.Errorf("failed to submit vendor application: %w", err)
+	}
+
+	if uc.notificationService != nil {
+		if err := uc.notificationService.NotifyVendorApplicationSubmitted(ctx, application); err != nil {
+			fmt.Printf("❌ Failed to notify admins of vendor application: %v\n", err)
+		}
+		uc.notifyStatusChanged(ctx, application)
+	}
+
+	return application, nil
+}
+
+// GetApplications lists applications, oldest submission first
+func (uc *vendorApplicationUseCase) GetApplications(ctx context.Context, req GetVendorApplicationsRequest) (*VendorApplicationsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	applications, total, err := uc.applicationRepo.List(ctx, repositories.VendorApplicationFilters{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VendorApplicationsListResponse{
+		Applications: applications,
+		Total:        total,
+		Pagination:   NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetApplication gets an application with its documents
+func (uc *vendorApplicationUseCase) GetApplication(ctx context.Context, id uuid.UUID) (*entities.VendorApplication, error) {
+	return uc.applicationRepo.GetByID(ctx, id)
+}
+
+// ApproveApplication approves an application, which lets its vendor publish products
+func (uc *vendorApplicationUseCase) ApproveApplication(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error) {
+	return uc.review(ctx, id, reviewerID, entities.VendorApplicationStatusApproved, entities.VendorStatusActive, req.Notes)
+}
+
+// RejectApplication rejects an application
+func (uc *vendorApplicationUseCase) RejectApplication(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error) {
+	if strings.TrimSpace(req.Notes) == "" {
+		return nil, pkgErrors.InvalidInput("notes are required to reject an application")
+	}
+	return uc.review(ctx, id, reviewerID, entities.VendorApplicationStatusRejected, entities.VendorStatusRejected, req.Notes)
+}
+
+// RequestApplicationChanges sends an application back to the applicant
+func (uc *vendorApplicationUseCase) RequestApplicationChanges(ctx context.Context, id, reviewerID uuid.UUID, req ReviewVendorApplicationRequest) (*entities.VendorApplication, error) {
+	if strings.TrimSpace(req.Notes) == "" {
+		return nil, pkgErrors.InvalidInput("notes are required to request changes")
+	}
+	return uc.review(ctx, id, reviewerID, entities.VendorApplicationStatusChangesRequested, entities.VendorStatusPending, req.Notes)
+}
+
+// review records a decision on a submitted application and notifies the applicant
+func (uc *vendorApplicationUseCase) review(ctx context.Context, id, reviewerID uuid.UUID, status entities.VendorApplicationStatus, vendorStatus entities.VendorStatus, notes string) (*entities.VendorApplication, error) {
+	application, err := uc.applicationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status != entities.VendorApplicationStatusSubmitted {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict,
+			fmt.Sprintf("Only submitted applications can be reviewed, this one is %s", application.Status))
+	}
+
+	now := time.Now()
+	application.Status = status
+	application.ReviewNotes = strings.TrimSpace(notes)
+	application.ReviewedBy = &reviewerID
+	application.ReviewedAt = &now
+	application.UpdatedAt = now
+	if err := uc.applicationRepo.SaveReview(ctx, application, vendorStatus); err != nil {
+		return nil, fmt.Errorf("failed to save vendor application review: %w", err)
+	}
+	if application.Vendor != nil {
+		application.Vendor.Status = vendorStatus
+	}
+
+	uc.notifyStatusChanged(ctx, application)
+	return application, nil
+}
+
+func (uc *vendorApplicationUseCase) notifyStatusChanged(ctx context.Context, application *entities.VendorApplication) {
+	if uc.notificationService == nil {
+		return
+	}
+	if err := uc.notificationService.NotifyVendorApplicationStatusChanged(ctx, application); err != nil {
+		fmt.Printf("❌ Failed to notify applicant of vendor application %s: %v\n", application.ID, err)
+	}
+}
@@ -0,0 +1,684 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/domain/services"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+	"ecom-golang-clean-architecture/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Vendor statement periods
+const (
+	StatementPeriodDay   = "day"
+	StatementPeriodWeek  = "week"
+	StatementPeriodMonth = "month"
+)
+
+// VendorUseCase defines the interface for marketplace vendors, their commissions and statements
+type VendorUseCase interface {
+	CreateVendor(ctx context.Context, req CreateVendorRequest) (*entities.Vendor, error)
+	GetVendor(ctx context.Context, id uuid.UUID) (*entities.Vendor, error)
+	GetVendors(ctx context.Context, req GetVendorsRequest) (*VendorsListResponse, error)
+	UpdateVendor(ctx context.Context, id uuid.UUID, req UpdateVendorRequest) (*entities.Vendor, error)
+	AssignProducts(ctx context.Context, vendorID uuid.UUID, req AssignVendorProductsRequest) (*AssignVendorProductsResponse, error)
+
+	GetCategoryCommissions(ctx context.Context) ([]*entities.CategoryCommission, error)
+	SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, req SetCommissionRequest) (*entities.CategoryCommission, error)
+	DeleteCategoryCommission(ctx context.Context, categoryID uuid.UUID) error
+	GetVendorCommissions(ctx context.Context, vendorID uuid.UUID) ([]*entities.VendorCommission, error)
+	SetVendorCommission(ctx context.Context, vendorID uuid.UUID, req SetVendorCommissionRequest) (*entities.VendorCommission, error)
+	DeleteVendorCommission(ctx context.Context, vendorID, id uuid.UUID) error
+	ResolveVendorCommission(ctx context.Context, vendorID uuid.UUID, categoryID *uuid.UUID) (*services.ResolvedCommission, error)
+
+	GetOrderVendorOrders(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error)
+	SplitOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error)
+	GetVendorStatement(ctx context.Context, vendorID uuid.UUID, req GetVendorStatementRequest) (*VendorStatementResponse, error)
+
+	// Vendor-facing
+	GetMyVendor(ctx context.Context, userID uuid.UUID) (*entities.Vendor, error)
+	GetMyVendorOrders(ctx context.Context, userID uuid.UUID, req GetVendorOrdersRequest) (*VendorOrdersListResponse, error)
+	GetMyStatement(ctx context.Context, userID uuid.UUID, req GetVendorStatementRequest) (*VendorStatementResponse, error)
+}
+
+type vendorUseCase struct {
+	vendorRepo      repositories.VendorRepository
+	vendorOrderRepo repositories.VendorOrderRepository
+	userRepo        repositories.UserRepository
+	categoryRepo    repositories.CategoryRepository
+	orderRepo       repositories.OrderRepository
+	fees            services.MarketplaceFeeService
+}
+
+// NewVendorUseCase creates a new vendor use case
+func NewVendorUseCase(
+	vendorRepo repositories.VendorRepository,
+	vendorOrderRepo repositories.VendorOrderRepository,
+	userRepo repositories.UserRepository,
+	categoryRepo repositories.CategoryRepository,
+	orderRepo repositories.OrderRepository,
+	fees services.MarketplaceFeeService,
+) VendorUseCase {
+	return &vendorUseCase{
+		vendorRepo:      vendorRepo,
+		vendorOrderRepo: vendorOrderRepo,
+		userRepo:        userRepo,
+		categoryRepo:    categoryRepo,
+		orderRepo:       orderRepo,
+		fees:            fees,
+	}
+}
+
+// CreateVendorRequest represents a request to create a vendor
+type CreateVendorRequest struct {
+	Name   string     `json:"name" binding:"required,max=200"`
+	Slug   string     `json:"slug"` // Generated from the name when empty
+	Email  string     `json:"email" binding:"required,email"`
+	Phone  string     `json:"phone"`
+	UserID *uuid.UUID `json:"user_id"` // Account that signs in for the vendor
+}
+
+// UpdateVendorRequest represents a request to update a vendor
+type UpdateVendorRequest struct {
+	Name   *string                `json:"name"`
+	Email  *string                `json:"email" binding:"omitempty,email"`
+	Phone  *string                `json:"phone"`
+	Status *entities.VendorStatus `json:"status" binding:"omitempty,oneof=active suspended"`
+	UserID *uuid.UUID             `json:"user_id"`
+}
+
+// GetVendorsRequest represents filters for listing vendors
+type GetVendorsRequest struct {
+	Status entities.VendorStatus `form:"status" json:"status,omitempty"`
+	Search string                `form:"search" json:"search,omitempty"`
+	Limit  int                   `form:"limit" json:"limit"`
+	Offset int                   `form:"offset" json:"offset"`
+}
+
+// VendorsListResponse represents a page of vendors
+type VendorsListResponse struct {
+	Vendors    []*entities.Vendor `json:"vendors"`
+	Total      int64              `json:"total"`
+	Pagination *PaginationInfo    `json:"pagination"`
+}
+
+// AssignVendorProductsRequest represents products to be sold by a vendor
+type AssignVendorProductsRequest struct {
+	ProductIDs []uuid.UUID `json:"product_ids" binding:"required,min=1,max=500"`
+}
+
+// AssignVendorProductsResponse represents the result of assigning products to a vendor
+type AssignVendorProductsResponse struct {
+	VendorID uuid.UUID `json:"vendor_id"`
+	Assigned int64     `json:"assigned"`
+	NotFound int       `json:"not_found"`
+}
+
+// SetCommissionRequest represents a commission rate and fixed fee
+type SetCommissionRequest struct {
+	Rate     float64 `json:"rate" binding:"min=0,max=100"` // Percent of the item total
+	FixedFee float64 `json:"fixed_fee" binding:"min=0"`    // Per unit sold, in the order currency
+}
+
+// SetVendorCommissionRequest represents a vendor's commission override
+type SetVendorCommissionRequest struct {
+	CategoryID *uuid.UUID `json:"category_id"` // Leave out to override every category
+	Rate       float64    `json:"rate" binding:"min=0,max=100"`
+	FixedFee   float64    `json:"fixed_fee" binding:"min=0"`
+}
+
+// GetVendorOrdersRequest represents filters for listing a vendor's orders
+type GetVendorOrdersRequest struct {
+	DateFrom string `form:"date_from" json:"date_from,omitempty"` // YYYY-MM-DD
+	DateTo   string `form:"date_to" json:"date_to,omitempty"`     // YYYY-MM-DD, inclusive
+	Limit    int    `form:"limit" json:"limit"`
+	Offset   int    `form:"offset" json:"offset"`
+}
+
+// VendorOrdersListResponse represents a page of vendor orders
+type VendorOrdersListResponse struct {
+	VendorOrders []*entities.VendorOrder `json:"vendor_orders"`
+	Total        int64                   `json:"total"`
+	Pagination   *PaginationInfo         `json:"pagination"`
+}
+
+// GetVendorStatementRequest represents the range and granularity of a vendor statement
+type GetVendorStatementRequest struct {
+	DateFrom string `form:"date_from" json:"date_from,omitempty"` // YYYY-MM-DD, defaults to the start of the month 11 months ago
+	DateTo   string `form:"date_to" json:"date_to,omitempty"`     // YYYY-MM-DD, inclusive, defaults to today
+	Period   string `form:"period" json:"period,omitempty"`       // day, week or month (default)
+}
+
+// VendorStatementResponse summarizes what a vendor sold and is paid, per period and currency.
+// Amounts are integer minor units of the line's currency.
+type VendorStatementResponse struct {
+	VendorID   uuid.UUID              `json:"vendor_id"`
+	VendorName string                 `json:"vendor_name"`
+	Period     string                 `json:"period"`
+	DateFrom   string                 `json:"date_from"`
+	DateTo     string                 `json:"date_to"`
+	Periods    []*VendorStatementLine `json:"periods"`
+	Totals     []*VendorStatementLine `json:"totals"` // One per currency, over the whole range
+}
+
+// VendorStatementLine is a vendor's sales and payout in one currency over one period. Sales
+// count when the order is placed, refunds when they are made, so a refund can fall in a later
+// period than its sale.
+type VendorStatementLine struct {
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	PeriodEnd   *time.Time `json:"period_end,omitempty"` // Exclusive
+	Currency    string     `json:"currency"`
+	Exponent    int        `json:"exponent"`
+	OrderCount  int        `json:"order_count"`
+	ItemCount   int        `json:"item_count"`
+	GrossSales  int64      `json:"gross_sales"`
+	Commissions int64      `json:"commissions"`
+	FixedFees   int64      `json:"fixed_fees"`
+	RefundCount int        `json:"refund_count"`
+	Refunds     int64      `json:"refunds"`
+	// CommissionRefunded is the commission given back on refunds
+	CommissionRefunded int64 `json:"commission_refunded"`
+	// RefundClawbacks is what refunds took back from the vendor: refunds less commission refunded
+	RefundClawbacks int64 `json:"refund_clawbacks"`
+	NetPayout       int64 `json:"net_payout"`
+}
+
+// CreateVendor creates a vendor
+func (uc *vendorUseCase) CreateVendor(ctx context.Context, req CreateVendorRequest) (*entities.Vendor, error) {
+	slug := strings.TrimSpace(req.Slug)
+	if slug == "" {
+		slug = utils.GenerateSlug(req.Name)
+	}
+	if err := utils.ValidateSlug(slug); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+	if _, err := uc.vendorRepo.GetBySlug(ctx, slug); err == nil {
+		return nil, entities.ErrVendorExists
+	} else if err != entities.ErrVendorNotFound {
+		return nil, err
+	}
+
+	if req.UserID != nil {
+		if err := uc.checkVendorUser(ctx, *req.UserID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	vendor := &entities.Vendor{
+		ID:        ids.New(),
+		Name:      strings.TrimSpace(req.Name),
+		Slug:      slug,
+		Email:     strings.TrimSpace(req.Email),
+		Phone:     req.Phone,
+		Status:    entities.VendorStatusActive,
+		UserID:    req.UserID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := uc.vendorRepo.Create(ctx, vendor); err != nil {
+		return nil, fmt.Errorf("failed to create vendor: %w", err)
+	}
+	return vendor, nil
+}
+
+// GetVendor gets a vendor
+func (uc *vendorUseCase) GetVendor(ctx context.Context, id uuid.UUID) (*entities.Vendor, error) {
+	return uc.vendorRepo.GetByID(ctx, id)
+}
+
+// GetVendors lists vendors
+func (uc *vendorUseCase) GetVendors(ctx context.Context, req GetVendorsRequest) (*VendorsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	vendors, total, err := uc.vendorRepo.List(ctx, repositories.VendorFilters{
+		Status: req.Status,
+		Search: strings.TrimSpace(req.Search),
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VendorsListResponse{
+		Vendors:    vendors,
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// UpdateVendor updates a vendor
+func (uc *vendorUseCase) UpdateVendor(ctx context.Context, id uuid.UUID, req UpdateVendorRequest) (*entities.Vendor, error) {
+	vendor, err := uc.vendorRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, pkgErrors.InvalidInput("name cannot be empty")
+		}
+		vendor.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Email != nil {
+		vendor.Email = strings.TrimSpace(*req.Email)
+	}
+	if req.Phone != nil {
+		vendor.Phone = *req.Phone
+	}
+	if req.Status != nil && *req.Status != vendor.Status {
+		if vendor.IsUnderReview() {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict,
+				fmt.Sprintf("Vendor is %s; its status follows the review of its application", vendor.Status))
+		}
+		vendor.Status = *req.Status
+	}
+	if req.UserID != nil {
+		if err := uc.checkVendorUser(ctx, *req.UserID, &vendor.ID); err != nil {
+			return nil, err
+		}
+		vendor.UserID = req.UserID
+	}
+
+	vendor.UpdatedAt = time.Now()
+	if err := uc.vendorRepo.Update(ctx, vendor); err != nil {
+		return nil, fmt.Errorf("failed to update vendor: %w", err)
+	}
+	return vendor, nil
+}
+
+// checkVendorUser checks that an account exists and signs in for no other vendor
+func (uc *vendorUseCase) checkVendorUser(ctx context.Context, userID uuid.UUID, vendorID *uuid.UUID) error {
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		if err == entities.ErrUserNotFound {
+			return pkgErrors.InvalidInput("user not found")
+		}
+		return err
+	}
+
+	existing, err := uc.vendorRepo.GetByUserID(ctx, userID)
+	if err == entities.ErrVendorNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if vendorID == nil || existing.ID != *vendorID {
+		return pkgErrors.New(pkgErrors.ErrCodeConflict, "User already signs in for another vendor")
+	}
+	return nil
+}
+
+// AssignProducts makes a vendor the seller of products. Orders placed before keep the vendor
+// they were split for. Vendors that are not active can only be given unpublished products.
+func (uc *vendorUseCase) AssignProducts(ctx context.Context, vendorID uuid.UUID, req AssignVendorProductsRequest) (*AssignVendorProductsResponse, error) {
+	vendor, err := uc.vendorRepo.GetByID(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+	if !vendor.IsActive() {
+		published, err := uc.vendorRepo.CountPublishedProducts(ctx, req.ProductIDs)
+		if err != nil {
+			return nil, err
+		}
+		if published > 0 {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict,
+				fmt.Sprintf("%d of the products are published and the vendor is %s; unpublish them first", published, vendor.Status))
+		}
+	}
+
+	assigned, err := uc.vendorRepo.AssignProducts(ctx, &vendorID, req.ProductIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign products: %w", err)
+	}
+
+	return &AssignVendorProductsResponse{
+		VendorID: vendorID,
+		Assigned: assigned,
+		NotFound: len(req.ProductIDs) - int(assigned),
+	}, nil
+}
+
+// GetCategoryCommissions lists the categories with their own commission
+func (uc *vendorUseCase) GetCategoryCommissions(ctx context.Context) ([]*entities.CategoryCommission, error) {
+	return uc.vendorRepo.ListCategoryCommissions(ctx)
+}
+
+// SetCategoryCommission sets the commission of a category and the subcategories without their own
+func (uc *vendorUseCase) SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, req SetCommissionRequest) (*entities.CategoryCommission, error) {
+	if err := validateCommission(req.Rate, req.FixedFee); err != nil {
+		return nil, err
+	}
+	if _, err := uc.categoryRepo.GetByID(ctx, categoryID); err != nil {
+		return nil, entities.ErrCategoryNotFound
+	}
+
+	commission := &entities.CategoryCommission{
+		ID:         ids.New(),
+		CategoryID: categoryID,
+		Rate:       req.Rate,
+		FixedFee:   req.FixedFee,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := uc.vendorRepo.SaveCategoryCommission(ctx, commission); err != nil {
+		return nil, fmt.Errorf("failed to save category commission: %w", err)
+	}
+	return commission, nil
+}
+
+// DeleteCategoryCommission removes the commission of a category, which then inherits its parent's
+func (uc *vendorUseCase) DeleteCategoryCommission(ctx context.Context, categoryID uuid.UUID) error {
+	return uc.vendorRepo.DeleteCategoryCommission(ctx, categoryID)
+}
+
+// GetVendorCommissions lists a vendor's commission overrides
+func (uc *vendorUseCase) GetVendorCommissions(ctx context.Context, vendorID uuid.UUID) ([]*entities.VendorCommission, error) {
+	if _, err := uc.vendorRepo.GetByID(ctx, vendorID); err != nil {
+		return nil, err
+	}
+	return uc.vendorRepo.ListVendorCommissions(ctx, vendorID)
+}
+
+// SetVendorCommission sets a vendor's commission override for a category or all categories
+func (uc *vendorUseCase) SetVendorCommission(ctx context.Context, vendorID uuid.UUID, req SetVendorCommissionRequest) (*entities.VendorCommission, error) {
+	if err := validateCommission(req.Rate, req.FixedFee); err != nil {
+		return nil, err
+	}
+	if _, err := uc.vendorRepo.GetByID(ctx, vendorID); err != nil {
+		return nil, err
+	}
+	if req.CategoryID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *req.CategoryID); err != nil {
+			return nil, entities.ErrCategoryNotFound
+		}
+	}
+
+	commission := &entities.VendorCommission{
+		ID:         ids.New(),
+		VendorID:   vendorID,
+		CategoryID: req.CategoryID,
+		Rate:       req.Rate,
+		FixedFee:   req.FixedFee,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := uc.vendorRepo.SaveVendorCommission(ctx, commission); err != nil {
+		return nil, fmt.Errorf("failed to save vendor commission: %w", err)
+	}
+	return commission, nil
+}
+
+// DeleteVendorCommission removes one of a vendor's commission overrides
+func (uc *vendorUseCase) DeleteVendorCommission(ctx context.Context, vendorID, id uuid.UUID) error {
+	return uc.vendorRepo.DeleteVendorCommission(ctx, vendorID, id)
+}
+
+// ResolveVendorCommission finds the commission a vendor's items in a category are charged
+func (uc *vendorUseCase) ResolveVendorCommission(ctx context.Context, vendorID uuid.UUID, categoryID *uuid.UUID) (*services.ResolvedCommission, error) {
+	if _, err := uc.vendorRepo.GetByID(ctx, vendorID); err != nil {
+		return nil, err
+	}
+	if categoryID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, *categoryID); err != nil {
+			return nil, entities.ErrCategoryNotFound
+		}
+	}
+	return uc.fees.ResolveCommission(ctx, vendorID, categoryID)
+}
+
+func validateCommission(rate, fixedFee float64) error {
+	if rate < 0 || rate > 100 {
+		return pkgErrors.InvalidInput("rate must be a percent between 0 and 100")
+	}
+	if fixedFee < 0 {
+		return pkgErrors.InvalidInput("fixed_fee cannot be negative")
+	}
+	return nil
+}
+
+// GetOrderVendorOrders gets the vendor orders an order was split into
+func (uc *vendorUseCase) GetOrderVendorOrders(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error) {
+	if _, err := uc.orderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return uc.vendorOrderRepo.GetByOrderID(ctx, orderID)
+}
+
+// SplitOrder splits an order between its vendors if that failed when it was placed
+func (uc *vendorUseCase) SplitOrder(ctx context.Context, orderID uuid.UUID) ([]*entities.VendorOrder, error) {
+	order, err := uc.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.fees.SplitOrder(ctx, order)
+}
+
+// GetMyVendor gets the vendor an account signs in for
+func (uc *vendorUseCase) GetMyVendor(ctx context.Context, userID uuid.UUID) (*entities.Vendor, error) {
+	vendor, err := uc.vendorRepo.GetByUserID(ctx, userID)
+	if err == entities.ErrVendorNotFound {
+		return nil, entities.ErrNotVendor
+	}
+	return vendor, err
+}
+
+// GetMyVendorOrders lists the orders of the vendor an account signs in for
+func (uc *vendorUseCase) GetMyVendorOrders(ctx context.Context, userID uuid.UUID, req GetVendorOrdersRequest) (*VendorOrdersListResponse, error) {
+	vendor, err := uc.GetMyVendor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	filters := repositories.VendorOrderFilters{
+		VendorID: &vendor.ID,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	}
+	if req.DateFrom != "" {
+		date, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_from, expected YYYY-MM-DD")
+		}
+		filters.DateFrom = &date
+	}
+	if req.DateTo != "" {
+		date, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_to, expected YYYY-MM-DD")
+		}
+		date = date.AddDate(0, 0, 1)
+		filters.DateTo = &date
+	}
+
+	vendorOrders, total, err := uc.vendorOrderRepo.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VendorOrdersListResponse{
+		VendorOrders: vendorOrders,
+		Total:        total,
+		Pagination:   NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetMyStatement gets the statement of the vendor an account signs in for
+func (uc *vendorUseCase) GetMyStatement(ctx context.Context, userID uuid.UUID, req GetVendorStatementRequest) (*VendorStatementResponse, error) {
+	vendor, err := uc.GetMyVendor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.statement(ctx, vendor, req)
+}
+
+// GetVendorStatement gets a vendor's statement
+func (uc *vendorUseCase) GetVendorStatement(ctx context.Context, vendorID uuid.UUID, req GetVendorStatementRequest) (*VendorStatementResponse, error) {
+	vendor, err := uc.vendorRepo.GetByID(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.statement(ctx, vendor, req)
+}
+
+// statement sums a vendor's paid orders and refunds per period and currency. Days are UTC.
+func (uc *vendorUseCase) statement(ctx context.Context, vendor *entities.Vendor, req GetVendorStatementRequest) (*VendorStatementResponse, error) {
+	period := req.Period
+	if period == "" {
+		period = StatementPeriodMonth
+	}
+	if period != StatementPeriodDay && period != StatementPeriodWeek && period != StatementPeriodMonth {
+		return nil, pkgErrors.InvalidInput("period must be day, week or month")
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -11, 0)
+	to := today
+	if req.DateFrom != "" {
+		date, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_from, expected YYYY-MM-DD")
+		}
+		from = date
+	}
+	if req.DateTo != "" {
+		date, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return nil, pkgErrors.InvalidInput("Invalid date_to, expected YYYY-MM-DD")
+		}
+		to = date
+	}
+	if to.Before(from) {
+		return nil, pkgErrors.InvalidInput("date_to is before date_from")
+	}
+	end := to.AddDate(0, 0, 1)
+
+	vendorOrders, err := uc.vendorOrderRepo.ListPaid(ctx, vendor.ID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor orders: %w", err)
+	}
+	refunds, err := uc.vendorOrderRepo.ListRefunds(ctx, vendor.ID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor refunds: %w", err)
+	}
+
+	lines := make(map[string]*VendorStatementLine)
+	totals := make(map[string]*VendorStatementLine)
+	line := func(at time.Time, currency string, exponent int) (*VendorStatementLine, *VendorStatementLine) {
+		start := statementPeriodStart(at.UTC(), period)
+		key := start.Format(time.RFC3339) + "|" + currency
+		periodLine := lines[key]
+		if periodLine == nil {
+			periodEnd := statementPeriodEnd(start, period)
+			periodLine = &VendorStatementLine{PeriodStart: &start, PeriodEnd: &periodEnd, Currency: currency, Exponent: exponent}
+			lines[key] = periodLine
+		}
+		total := totals[currency]
+		if total == nil {
+			total = &VendorStatementLine{Currency: currency, Exponent: exponent}
+			totals[currency] = total
+		}
+		return periodLine, total
+	}
+
+	for _, vendorOrder := range vendorOrders {
+		periodLine, total := line(vendorOrder.CreatedAt, vendorOrder.Currency, vendorOrder.Exponent)
+		for _, l := range []*VendorStatementLine{periodLine, total} {
+			l.OrderCount++
+			l.ItemCount += vendorOrder.ItemCount
+			l.GrossSales += vendorOrder.GrossAmount
+			l.Commissions += vendorOrder.CommissionAmount
+			l.FixedFees += vendorOrder.FixedFeeAmount
+		}
+	}
+	for _, refund := range refunds {
+		periodLine, total := line(refund.CreatedAt, refund.Currency, refund.Exponent)
+		for _, l := range []*VendorStatementLine{periodLine, total} {
+			l.RefundCount++
+			l.Refunds += refund.Amount
+			l.CommissionRefunded += refund.CommissionRefunded
+		}
+	}
+
+	response := &VendorStatementResponse{
+		VendorID:   vendor.ID,
+		VendorName: vendor.Name,
+		Period:     period,
+		DateFrom:   from.Format("2006-01-02"),
+		DateTo:     to.Format("2006-01-02"),
+		Periods:    make([]*VendorStatementLine, 0, len(lines)),
+		Totals:     make([]*VendorStatementLine, 0, len(totals)),
+	}
+	for _, l := range lines {
+		response.Periods = append(response.Periods, l.finish())
+	}
+	for _, l := range totals {
+		response.Totals = append(response.Totals, l.finish())
+	}
+	sort.Slice(response.Periods, func(i, j int) bool {
+		a, b := response.Periods[i], response.Periods[j]
+		if !a.PeriodStart.Equal(*b.PeriodStart) {
+			return a.PeriodStart.Before(*b.PeriodStart)
+		}
+		return a.Currency < b.Currency
+	})
+	sort.Slice(response.Totals, func(i, j int) bool {
+		return response.Totals[i].Currency < response.Totals[j].Currency
+	})
+
+	return response, nil
+}
+
+// finish works out the clawbacks and payout of a statement line
+func (l *VendorStatementLine) finish() *VendorStatementLine {
+	l.RefundClawbacks = l.Refunds - l.CommissionRefunded
+	l.NetPayout = l.GrossSales - l.Commissions - l.FixedFees - l.RefundClawbacks
+	return l
+}
+
+// statementPeriodStart is the start of the day, week (from Monday) or month a time falls in
+func statementPeriodStart(at time.Time, period string) time.Time {
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case StatementPeriodWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case StatementPeriodMonth:
+		return time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// statementPeriodEnd is the start of the period after the one starting at start
+func statementPeriodEnd(start time.Time, period string) time.Time {
+	switch period {
+	case StatementPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case StatementPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
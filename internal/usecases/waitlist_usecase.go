@@ -0,0 +1,638 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+	"ecom-golang-clean-architecture/pkg/ids"
+
+	"github.com/google/uuid"
+)
+
+// waitlistsPerRun caps how many waitlists send a wave in one run
+const waitlistsPerRun = 20
+
+// WaitlistNotificationService interface for product waitlist notifications
+type WaitlistNotificationService interface {
+	// NotifyWaitlistAccess tells a customer on a waitlist they can buy the product, before its
+	// launch at launchAt when earlyAccess is set
+	NotifyWaitlistAccess(ctx context.Context, userID uuid.UUID, product *entities.Product, launchAt time.Time, earlyAccess bool) error
+}
+
+// ProductLaunchPolicy keeps products from being bought before they launch
+type ProductLaunchPolicy interface {
+	// CheckPurchaseAccess returns an error when the customer, nil for guests, may not buy the
+	// product yet because it has not launched and their early access has not opened
+	CheckPurchaseAccess(ctx context.Context, userID *uuid.UUID, productID uuid.UUID) error
+}
+
+// WaitlistUseCase defines the interface for pre-launch product waitlists
+type WaitlistUseCase interface {
+	ProductLaunchPolicy
+
+	// Customer
+	JoinWaitlist(ctx context.Context, userID, productID uuid.UUID) (*WaitlistEntryResponse, error)
+	LeaveWaitlist(ctx context.Context, userID, productID uuid.UUID) error
+	GetMyWaitlists(ctx context.Context, userID uuid.UUID) ([]*WaitlistEntryResponse, error)
+
+	// Admin
+	CreateWaitlist(ctx context.Context, adminID uuid.UUID, req CreateWaitlistRequest) (*WaitlistResponse, error)
+	UpdateWaitlist(ctx context.Context, id uuid.UUID, req UpdateWaitlistRequest) (*WaitlistResponse, error)
+	GetWaitlists(ctx context.Context, req GetWaitlistsRequest) (*WaitlistsListResponse, error)
+	// GetWaitlist gets a waitlist with its demand by membership tier and customer segment
+	GetWaitlist(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error)
+	// LaunchNow opens a waitlist's product to everyone now
+	LaunchNow(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error)
+	CancelWaitlist(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error)
+
+	// SendWaves notifies the next wave of customers whose access has opened on every waitlist
+	// that is due, and returns how many were notified
+	SendWaves(ctx context.Context) (int, error)
+}
+
+// WaitlistSettings configures the waves of new waitlists
+type WaitlistSettings struct {
+	DefaultWaveSize            int
+	DefaultWaveIntervalMinutes int
+}
+
+type waitlistUseCase struct {
+	waitlistRepo        repositories.WaitlistRepository
+	productRepo         repositories.ProductRepository
+	userRepo            repositories.UserRepository
+	notificationService WaitlistNotificationService
+	settings            WaitlistSettings
+	clock               Clock
+}
+
+// NewWaitlistUseCase creates a new waitlist use case
+func NewWaitlistUseCase(
+	waitlistRepo repositories.WaitlistRepository,
+	productRepo repositories.ProductRepository,
+	userRepo repositories.UserRepository,
+	notificationService WaitlistNotificationService,
+	settings WaitlistSettings,
+	clock Clock,
+) WaitlistUseCase {
+	if settings.DefaultWaveSize <= 0 {
+		settings.DefaultWaveSize = 500
+	}
+	if settings.DefaultWaveIntervalMinutes <= 0 {
+		settings.DefaultWaveIntervalMinutes = 15
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &waitlistUseCase{
+		waitlistRepo:        waitlistRepo,
+		productRepo:         productRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		settings:            settings,
+		clock:               clock,
+	}
+}
+
+// CreateWaitlistRequest represents an admin opening a waitlist for an upcoming product
+type CreateWaitlistRequest struct {
+	ProductID           uuid.UUID                      `json:"product_id" binding:"required"`
+	LaunchAt            time.Time                      `json:"launch_at" binding:"required"`
+	WaveSize            int                            `json:"wave_size" binding:"min=0"`             // Default WAITLIST_WAVE_SIZE
+	WaveIntervalMinutes int                            `json:"wave_interval_minutes" binding:"min=0"` // Default WAITLIST_WAVE_INTERVAL_MINUTES
+	EarlyAccess         []entities.WaitlistEarlyAccess `json:"early_access"`
+}
+
+// UpdateWaitlistRequest represents changes to a waitlist. The launch time and early access can
+// only change before access opens.
+type UpdateWaitlistRequest struct {
+	LaunchAt            *time.Time                     `json:"launch_at"`
+	WaveSize            *int                           `json:"wave_size"`
+	WaveIntervalMinutes *int                           `json:"wave_interval_minutes"`
+	EarlyAccess         []entities.WaitlistEarlyAccess `json:"early_access"` // Replaces the windows when set; [] removes them
+}
+
+// GetWaitlistsRequest represents filters for listing waitlists
+type GetWaitlistsRequest struct {
+	Status entities.WaitlistStatus `form:"status" json:"status,omitempty"`
+	Limit  int                     `form:"limit" json:"limit"`
+	Offset int                     `form:"offset" json:"offset"`
+}
+
+// WaitlistResponse represents a waitlist with the demand for its product
+type WaitlistResponse struct {
+	*entities.ProductWaitlist
+	ProductName   string                 `json:"product_name"`
+	ProductSKU    string                 `json:"product_sku"`
+	ProductStatus entities.ProductStatus `json:"product_status"`
+	Stock         int                    `json:"stock"`
+	Joined        int64                  `json:"joined"`
+	Notified      int64                  `json:"notified"`
+	Shortfall     int64                  `json:"shortfall"` // Customers on the list beyond the units in stock
+
+	// Demand breakdown, on a single waitlist only
+	ByMembershipTier  []WaitlistDemandGroup `json:"by_membership_tier,omitempty"`
+	ByCustomerSegment []WaitlistDemandGroup `json:"by_customer_segment,omitempty"`
+}
+
+// WaitlistDemandGroup counts a waitlist's customers in a membership tier or segment
+type WaitlistDemandGroup struct {
+	Value    string    `json:"value"`
+	Joined   int64     `json:"joined"`
+	Notified int64     `json:"notified"`
+	AccessAt time.Time `json:"access_at"` // When its early access opens, or the launch
+}
+
+// WaitlistsListResponse represents a page of waitlists
+type WaitlistsListResponse struct {
+	Waitlists  []*WaitlistResponse `json:"waitlists"`
+	Total      int64               `json:"total"`
+	Pagination *PaginationInfo     `json:"pagination"`
+}
+
+// WaitlistEntryResponse represents a customer's place on a product's waitlist
+type WaitlistEntryResponse struct {
+	ProductID   uuid.UUID               `json:"product_id"`
+	ProductName string                  `json:"product_name"`
+	Status      entities.WaitlistStatus `json:"status"`
+	LaunchAt    time.Time               `json:"launch_at"`
+	AccessAt    time.Time               `json:"access_at"` // When the customer can buy the product
+	EarlyAccess bool                    `json:"early_access"`
+	JoinedAt    time.Time               `json:"joined_at"`
+	NotifiedAt  *time.Time              `json:"notified_at,omitempty"`
+}
+
+// CheckPurchaseAccess lets anyone buy a product without an active waitlist or after its launch,
+// and customers on its waitlist once their early access opens
+func (uc *waitlistUseCase) CheckPurchaseAccess(ctx context.Context, userID *uuid.UUID, productID uuid.UUID) error {
+	waitlist, err := uc.waitlistRepo.GetByProduct(ctx, productID)
+	if err != nil {
+		if err == entities.ErrWaitlistNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to get product waitlist: %w", err)
+	}
+	now := uc.clock.Now()
+	if !waitlist.IsActive() || !now.Before(waitlist.LaunchAt) {
+		return nil
+	}
+
+	if userID != nil {
+		entry, err := uc.waitlistRepo.GetEntry(ctx, waitlist.ID, *userID)
+		if err != nil {
+			return fmt.Errorf("failed to get waitlist entry: %w", err)
+		}
+		if entry != nil {
+			accessAt := waitlist.AccessAt(entry.MembershipTier, entry.CustomerSegment)
+			if !now.Before(accessAt) {
+				return nil
+			}
+			if accessAt.Before(waitlist.LaunchAt) {
+				return pkgErrors.InvalidInput(fmt.Sprintf("Your early access to this product opens on %s", formatLaunchTime(accessAt)))
+			}
+		}
+	}
+	return pkgErrors.InvalidInput(fmt.Sprintf("Product launches on %s", formatLaunchTime(waitlist.LaunchAt)))
+}
+
+// JoinWaitlist adds a customer to a product's waitlist, keeping their place when they already joined
+func (uc *waitlistUseCase) JoinWaitlist(ctx context.Context, userID, productID uuid.UUID) (*WaitlistEntryResponse, error) {
+	waitlist, err := uc.waitlistRepo.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.clock.Now()
+	if !waitlist.IsActive() {
+		return nil, pkgErrors.InvalidInput("Waitlist is closed")
+	}
+	if !now.Before(waitlist.LaunchAt) {
+		return nil, pkgErrors.InvalidInput("Product has already launched")
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.waitlistRepo.Join(ctx, &entities.WaitlistEntry{
+		ID:              ids.New(),
+		WaitlistID:      waitlist.ID,
+		ProductID:       productID,
+		UserID:          userID,
+		MembershipTier:  user.MembershipTier,
+		CustomerSegment: user.GetCustomerSegment(),
+		CreatedAt:       now,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+	entry, err := uc.waitlistRepo.GetEntry(ctx, waitlist.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("waitlist entry for product %s was not saved", productID)
+	}
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	return newWaitlistEntryResponse(entry, waitlist, product.Name), nil
+}
+
+// LeaveWaitlist removes a customer from a product's waitlist
+func (uc *waitlistUseCase) LeaveWaitlist(ctx context.Context, userID, productID uuid.UUID) error {
+	waitlist, err := uc.waitlistRepo.GetByProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	return uc.waitlistRepo.Leave(ctx, waitlist.ID, userID)
+}
+
+// GetMyWaitlists lists the waitlists a customer is on, newest first
+func (uc *waitlistUseCase) GetMyWaitlists(ctx context.Context, userID uuid.UUID) ([]*WaitlistEntryResponse, error) {
+	entries, err := uc.waitlistRepo.ListEntriesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(entries))
+	for _, entry := range entries {
+		productIDs = append(productIDs, entry.ProductID)
+	}
+	products, err := uc.productRepo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	productNames := make(map[uuid.UUID]string, len(products))
+	for _, product := range products {
+		productNames[product.ID] = product.Name
+	}
+
+	responses := make([]*WaitlistEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		waitlist, err := uc.waitlistRepo.GetByID(ctx, entry.WaitlistID)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, newWaitlistEntryResponse(entry, waitlist, productNames[entry.ProductID]))
+	}
+	return responses, nil
+}
+
+// CreateWaitlist opens a waitlist for a product that has not launched
+func (uc *waitlistUseCase) CreateWaitlist(ctx context.Context, adminID uuid.UUID, req CreateWaitlistRequest) (*WaitlistResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsDelisted() {
+		return nil, pkgErrors.InvalidInput("Product is no longer available")
+	}
+	if !req.LaunchAt.After(uc.clock.Now()) {
+		return nil, pkgErrors.InvalidInput("Launch time must be in the future")
+	}
+	if _, err := uc.waitlistRepo.GetByProduct(ctx, req.ProductID); err == nil {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Product already has a waitlist")
+	} else if err != entities.ErrWaitlistNotFound {
+		return nil, err
+	}
+
+	waitlist := &entities.ProductWaitlist{
+		ID:                  ids.New(),
+		ProductID:           req.ProductID,
+		Status:              entities.WaitlistStatusOpen,
+		LaunchAt:            req.LaunchAt,
+		EarlyAccess:         req.EarlyAccess,
+		WaveSize:            req.WaveSize,
+		WaveIntervalMinutes: req.WaveIntervalMinutes,
+		CreatedBy:           &adminID,
+	}
+	if waitlist.EarlyAccess == nil {
+		waitlist.EarlyAccess = []entities.WaitlistEarlyAccess{}
+	}
+	if waitlist.WaveSize == 0 {
+		waitlist.WaveSize = uc.settings.DefaultWaveSize
+	}
+	if waitlist.WaveIntervalMinutes == 0 {
+		waitlist.WaveIntervalMinutes = uc.settings.DefaultWaveIntervalMinutes
+	}
+	if err := waitlist.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.waitlistRepo.Create(ctx, waitlist); err != nil {
+		return nil, fmt.Errorf("failed to create waitlist: %w", err)
+	}
+	return uc.GetWaitlist(ctx, waitlist.ID)
+}
+
+// UpdateWaitlist changes a waitlist's launch, early access or waves
+func (uc *waitlistUseCase) UpdateWaitlist(ctx context.Context, id uuid.UUID, req UpdateWaitlistRequest) (*WaitlistResponse, error) {
+	waitlist, err := uc.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !waitlist.IsActive() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Waitlist is closed")
+	}
+
+	if req.LaunchAt != nil || req.EarlyAccess != nil {
+		// Customers who were told their access opened keep it
+		if waitlist.Status != entities.WaitlistStatusOpen {
+			return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Launch time and early access can't change once access has opened")
+		}
+		if req.LaunchAt != nil {
+			if !req.LaunchAt.After(uc.clock.Now()) {
+				return nil, pkgErrors.InvalidInput("Launch time must be in the future")
+			}
+			waitlist.LaunchAt = *req.LaunchAt
+		}
+		if req.EarlyAccess != nil {
+			waitlist.EarlyAccess = req.EarlyAccess
+		}
+	}
+	if req.WaveSize != nil {
+		waitlist.WaveSize = *req.WaveSize
+	}
+	if req.WaveIntervalMinutes != nil {
+		waitlist.WaveIntervalMinutes = *req.WaveIntervalMinutes
+	}
+	if err := waitlist.Validate(); err != nil {
+		return nil, pkgErrors.InvalidInput(err.Error())
+	}
+
+	if err := uc.waitlistRepo.Update(ctx, waitlist); err != nil {
+		return nil, fmt.Errorf("failed to update waitlist: %w", err)
+	}
+	return uc.GetWaitlist(ctx, id)
+}
+
+// GetWaitlists lists waitlists, soonest launch first, with the demand for each product
+func (uc *waitlistUseCase) GetWaitlists(ctx context.Context, req GetWaitlistsRequest) (*WaitlistsListResponse, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	waitlists, total, err := uc.waitlistRepo.List(ctx, repositories.WaitlistFilters{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	responses, err := uc.toResponses(ctx, waitlists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WaitlistsListResponse{
+		Waitlists:  responses,
+		Total:      total,
+		Pagination: NewPaginationInfoFromOffset(req.Offset, req.Limit, total),
+	}, nil
+}
+
+// GetWaitlist gets a waitlist with its demand by membership tier and customer segment
+func (uc *waitlistUseCase) GetWaitlist(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error) {
+	waitlist, err := uc.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := uc.toResponses(ctx, []*entities.ProductWaitlist{waitlist})
+	if err != nil {
+		return nil, err
+	}
+	response := responses[0]
+
+	demand, err := uc.waitlistRepo.GetDemand(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist demand: %w", err)
+	}
+	tiers := map[string]*WaitlistDemandGroup{}
+	segments := map[string]*WaitlistDemandGroup{}
+	for _, row := range demand {
+		tier := tiers[row.MembershipTier]
+		if tier == nil {
+			tier = &WaitlistDemandGroup{Value: row.MembershipTier, AccessAt: waitlist.AccessAt(row.MembershipTier, "")}
+			tiers[row.MembershipTier] = tier
+		}
+		tier.Joined += row.Joined
+		tier.Notified += row.Notified
+
+		segment := segments[row.CustomerSegment]
+		if segment == nil {
+			segment = &WaitlistDemandGroup{Value: row.CustomerSegment, AccessAt: waitlist.AccessAt("", row.CustomerSegment)}
+			segments[row.CustomerSegment] = segment
+		}
+		segment.Joined += row.Joined
+		segment.Notified += row.Notified
+	}
+	response.ByMembershipTier = sortedDemandGroups(tiers)
+	response.ByCustomerSegment = sortedDemandGroups(segments)
+	return response, nil
+}
+
+// LaunchNow moves a waitlist's launch to now, so the next run starts notifying everyone
+func (uc *waitlistUseCase) LaunchNow(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error) {
+	waitlist, err := uc.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !waitlist.IsActive() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Waitlist is closed")
+	}
+
+	now := uc.clock.Now()
+	if waitlist.LaunchAt.After(now) {
+		waitlist.LaunchAt = now
+		if err := waitlist.Validate(); err != nil {
+			return nil, pkgErrors.InvalidInput(err.Error())
+		}
+		waitlist.NextWaveAt = nil
+		if err := uc.waitlistRepo.Update(ctx, waitlist); err != nil {
+			return nil, fmt.Errorf("failed to launch waitlist: %w", err)
+		}
+	}
+	return uc.GetWaitlist(ctx, id)
+}
+
+// CancelWaitlist calls off a launch; customers on the list are not notified
+func (uc *waitlistUseCase) CancelWaitlist(ctx context.Context, id uuid.UUID) (*WaitlistResponse, error) {
+	waitlist, err := uc.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !waitlist.IsActive() {
+		return nil, pkgErrors.New(pkgErrors.ErrCodeConflict, "Waitlist is closed")
+	}
+
+	waitlist.Status = entities.WaitlistStatusCancelled
+	waitlist.NextWaveAt = nil
+	if err := uc.waitlistRepo.Update(ctx, waitlist); err != nil {
+		return nil, fmt.Errorf("failed to cancel waitlist: %w", err)
+	}
+	return uc.GetWaitlist(ctx, id)
+}
+
+// SendWaves sends the next wave of every waitlist that is due
+func (uc *waitlistUseCase) SendWaves(ctx context.Context) (int, error) {
+	now := uc.clock.Now()
+	waitlists, err := uc.waitlistRepo.GetDueForWave(ctx, now, waitlistsPerRun)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get waitlists due for a wave: %w", err)
+	}
+
+	notified := 0
+	for _, waitlist := range waitlists {
+		count, err := uc.sendWave(ctx, waitlist, now)
+		notified += count
+		if err != nil {
+			return notified, err
+		}
+	}
+	return notified, nil
+}
+
+// sendWave notifies up to a wave of a waitlist's customers whose access has opened, and marks the
+// waitlist launched once it is open to everyone and nobody is left to notify
+func (uc *waitlistUseCase) sendWave(ctx context.Context, waitlist *entities.ProductWaitlist, now time.Time) (int, error) {
+	audiences := waitlist.OpenAudiences(now)
+	entries, err := uc.waitlistRepo.GetEntriesForWave(ctx, waitlist.ID, audiences, waitlist.WaveSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get customers of waitlist %s: %w", waitlist.ID, err)
+	}
+
+	if len(entries) == 0 {
+		switch {
+		case audiences.Everyone:
+			waitlist.Status = entities.WaitlistStatusLaunched
+			waitlist.LaunchedAt = &now
+			waitlist.NextWaveAt = nil
+		case waitlist.Status == entities.WaitlistStatusOpen:
+			waitlist.Status = entities.WaitlistStatusLaunching
+		default:
+			return 0, nil
+		}
+		if err := uc.waitlistRepo.Update(ctx, waitlist); err != nil {
+			return 0, fmt.Errorf("failed to update waitlist: %w", err)
+		}
+		return 0, nil
+	}
+
+	product, err := uc.productRepo.GetByID(ctx, waitlist.ProductID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get product: %w", err)
+	}
+	// Nobody is told to buy a product that can't be bought yet
+	if product.Status != entities.ProductStatusActive {
+		return 0, nil
+	}
+
+	earlyAccess := now.Before(waitlist.LaunchAt)
+	var notifiedIDs []uuid.UUID
+	for _, entry := range entries {
+		if uc.notificationService != nil {
+			if err := uc.notificationService.NotifyWaitlistAccess(ctx, entry.UserID, product, waitlist.LaunchAt, earlyAccess); err != nil {
+				fmt.Printf("❌ Failed to send waitlist notification for product %s: %v\n", product.ID, err)
+				continue
+			}
+		}
+		notifiedIDs = append(notifiedIDs, entry.ID)
+	}
+
+	wave := waitlist.WavesSent + 1
+	if err := uc.waitlistRepo.MarkNotified(ctx, notifiedIDs, wave, now); err != nil {
+		return 0, fmt.Errorf("failed to mark waitlist customers notified: %w", err)
+	}
+	nextWaveAt := now.Add(time.Duration(waitlist.WaveIntervalMinutes) * time.Minute)
+	waitlist.Status = entities.WaitlistStatusLaunching
+	waitlist.WavesSent = wave
+	waitlist.NextWaveAt = &nextWaveAt
+	if err := uc.waitlistRepo.Update(ctx, waitlist); err != nil {
+		return len(notifiedIDs), fmt.Errorf("failed to update waitlist: %w", err)
+	}
+	return len(notifiedIDs), nil
+}
+
+// toResponses adds each waitlist's product and how many customers joined and were notified
+func (uc *waitlistUseCase) toResponses(ctx context.Context, waitlists []*entities.ProductWaitlist) ([]*WaitlistResponse, error) {
+	waitlistIDs := make([]uuid.UUID, 0, len(waitlists))
+	productIDs := make([]uuid.UUID, 0, len(waitlists))
+	for _, waitlist := range waitlists {
+		waitlistIDs = append(waitlistIDs, waitlist.ID)
+		productIDs = append(productIDs, waitlist.ProductID)
+	}
+
+	counts, err := uc.waitlistRepo.CountEntries(ctx, waitlistIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count waitlist customers: %w", err)
+	}
+	products, err := uc.productRepo.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	productsByID := make(map[uuid.UUID]*entities.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+	}
+
+	responses := make([]*WaitlistResponse, 0, len(waitlists))
+	for _, waitlist := range waitlists {
+		count := counts[waitlist.ID]
+		response := &WaitlistResponse{
+			ProductWaitlist: waitlist,
+			Joined:          count.Joined,
+			Notified:        count.Notified,
+		}
+		if product := productsByID[waitlist.ProductID]; product != nil {
+			response.ProductName = product.Name
+			response.ProductSKU = product.SKU
+			response.ProductStatus = product.Status
+			response.Stock = product.Stock
+		}
+		response.Shortfall = max(count.Joined-int64(response.Stock), 0)
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+func newWaitlistEntryResponse(entry *entities.WaitlistEntry, waitlist *entities.ProductWaitlist, productName string) *WaitlistEntryResponse {
+	accessAt := waitlist.AccessAt(entry.MembershipTier, entry.CustomerSegment)
+	return &WaitlistEntryResponse{
+		ProductID:   entry.ProductID,
+		ProductName: productName,
+		Status:      waitlist.Status,
+		LaunchAt:    waitlist.LaunchAt,
+		AccessAt:    accessAt,
+		EarlyAccess: accessAt.Before(waitlist.LaunchAt),
+		JoinedAt:    entry.CreatedAt,
+		NotifiedAt:  entry.NotifiedAt,
+	}
+}
+
+func sortedDemandGroups(groups map[string]*WaitlistDemandGroup) []WaitlistDemandGroup {
+	sorted := make([]WaitlistDemandGroup, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, *group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Joined != sorted[j].Joined {
+			return sorted[i].Joined > sorted[j].Joined
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+	return sorted
+}
+
+// formatLaunchTime formats a launch or early access time for shoppers
+func formatLaunchTime(t time.Time) string {
+	return t.UTC().Format("Jan 2, 2006 15:04 UTC")
+}
@@ -0,0 +1,267 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"ecom-golang-clean-architecture/internal/domain/entities"
+	"ecom-golang-clean-architecture/internal/domain/repositories"
+	"ecom-golang-clean-architecture/internal/infrastructure/resilience"
+	pkgErrors "ecom-golang-clean-architecture/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// WarehouseTarget loads exported rows into a data warehouse
+type WarehouseTarget interface {
+	// EnsureTable creates the table, or adds the columns of the schema it is missing
+	EnsureTable(ctx context.Context, schema entities.WarehouseTableSchema) error
+	// Load appends rows to the table
+	Load(ctx context.Context, schema entities.WarehouseTableSchema, rows []entities.WarehouseRow) error
+}
+
+// WarehouseSyncUseCase defines the interface for exporting tables to the data warehouse
+type WarehouseSyncUseCase interface {
+	// SyncTables exports the rows changed since the last run of every table and returns how
+	// many rows were exported
+	SyncTables(ctx context.Context) (int, error)
+	GetWarehouseSyncStatus(ctx context.Context) (*WarehouseSyncStatusResponse, error)
+	// ResetTable clears a table's watermark so the next run exports all of its rows again
+	ResetTable(ctx context.Context, table entities.WarehouseTable) error
+}
+
+type warehouseSyncUseCase struct {
+	syncRepo  repositories.WarehouseSyncRepository
+	target    WarehouseTarget
+	name      string
+	batchSize int
+	lag       time.Duration
+	// pseudonymizer anonymizes the export when set
+	pseudonymizer *entities.Pseudonymizer
+	running       sync.Mutex
+}
+
+// NewWarehouseSyncUseCase creates a new warehouse sync use case. With a pseudonymizer, customer
+// IDs are exported as pseudonyms and other personal data is left out.
+func NewWarehouseSyncUseCase(
+	syncRepo repositories.WarehouseSyncRepository,
+	target WarehouseTarget,
+	name string,
+	batchSize int,
+	lagSeconds int,
+	pseudonymizer *entities.Pseudonymizer,
+) WarehouseSyncUseCase {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &warehouseSyncUseCase{
+		syncRepo:  syncRepo,
+		target:    target,
+		name:      name,
+		batchSize: batchSize,
+		lag:       time.Duration(lagSeconds) * time.Second,
+
+		pseudonymizer: pseudonymizer,
+	}
+}
+
+// WarehouseSyncStatusResponse represents the export state of every table
+type WarehouseSyncStatusResponse struct {
+	Target     string                       `json:"target"`
+	Anonymized bool                         `json:"anonymized"` // Customer IDs are pseudonyms and other personal data is left out
+	Tables     []WarehouseTableSyncResponse `json:"tables"`
+}
+
+// WarehouseTableSyncResponse represents the export state of a table
+type WarehouseTableSyncResponse struct {
+	Table           entities.WarehouseTable      `json:"table"`
+	Status          entities.WarehouseSyncStatus `json:"status"`
+	WatermarkAt     *time.Time                   `json:"watermark_at,omitempty"` // Rows changed up to this time have been exported
+	LastRunAt       *time.Time                   `json:"last_run_at,omitempty"`
+	LastSuccessAt   *time.Time                   `json:"last_success_at,omitempty"`
+	LastError       string                       `json:"last_error,omitempty"`
+	RowsLastRun     int                          `json:"rows_last_run"`
+	RowsTotal       int64                        `json:"rows_total"`
+	Columns         int                          `json:"columns"`
+	SchemaUpdatedAt *time.Time                   `json:"schema_updated_at,omitempty"`
+}
+
+// SyncTables exports every table, continuing with the others when one fails. Progress is
+// saved after each batch, so a failed run resumes where it stopped.
+func (uc *warehouseSyncUseCase) SyncTables(ctx context.Context) (int, error) {
+	if !uc.running.TryLock() {
+		return 0, pkgErrors.New(pkgErrors.ErrCodeConflict, "A warehouse sync is already running")
+	}
+	defer uc.running.Unlock()
+
+	states, err := uc.loadStates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	var failures []error
+	for _, schema := range uc.schemas() {
+		state := states[schema.Table]
+		rows, err := uc.syncTable(ctx, schema, state)
+		exported += rows
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", schema.Table, err))
+			if resilience.IsUnavailable(err) {
+				// The warehouse is down; the remaining tables would fail the same way
+				break
+			}
+		}
+	}
+	return exported, errors.Join(failures...)
+}
+
+// syncTable exports the rows of one table changed since its watermark
+func (uc *warehouseSyncUseCase) syncTable(ctx context.Context, schema entities.WarehouseTableSchema, state *entities.WarehouseSyncState) (int, error) {
+	startedAt := time.Now()
+	state.LastRunAt = &startedAt
+	state.RowsLastRun = 0
+
+	err := uc.exportTable(ctx, schema, state, startedAt)
+	if err != nil {
+		state.MarkFailed(err)
+	} else {
+		state.MarkSucceeded(time.Now())
+	}
+	if saveErr := uc.syncRepo.SaveState(ctx, state); saveErr != nil && err == nil {
+		err = fmt.Errorf("failed to save sync state: %w", saveErr)
+	}
+	return state.RowsLastRun, err
+}
+
+func (uc *warehouseSyncUseCase) exportTable(ctx context.Context, schema entities.WarehouseTableSchema, state *entities.WarehouseSyncState, startedAt time.Time) error {
+	if fingerprint := schema.Fingerprint(); state.SchemaFingerprint != fingerprint {
+		if err := uc.target.EnsureTable(ctx, schema); err != nil {
+			return fmt.Errorf("failed to update warehouse table: %w", err)
+		}
+		state.SchemaFingerprint = fingerprint
+		state.SchemaUpdatedAt = &startedAt
+	}
+
+	until := startedAt.Add(-uc.lag)
+	for {
+		rows, err := uc.syncRepo.GetChangedRows(ctx, schema, state.WatermarkAt, state.WatermarkID, until, uc.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to read changed rows: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		// The watermark is read before anonymizing, which may replace the id
+		last := rows[len(rows)-1]
+		updatedAt, _ := last["updated_at"].(time.Time)
+		lastID, err := uuid.Parse(fmt.Sprint(last["id"]))
+		if err != nil {
+			return fmt.Errorf("invalid row id %v: %w", last["id"], err)
+		}
+
+		syncedAt := time.Now().UTC()
+		for _, row := range rows {
+			if uc.pseudonymizer != nil {
+				entities.AnonymizeWarehouseRow(schema.Table, row, uc.pseudonymizer)
+			}
+			row[entities.WarehouseSyncedAtColumn] = syncedAt
+		}
+		if err := uc.target.Load(ctx, schema, rows); err != nil {
+			return fmt.Errorf("failed to load rows: %w", err)
+		}
+
+		state.Advance(updatedAt, lastID, len(rows))
+		if err := uc.syncRepo.SaveState(ctx, state); err != nil {
+			return fmt.Errorf("failed to save sync state: %w", err)
+		}
+
+		if len(rows) < uc.batchSize {
+			return nil
+		}
+	}
+}
+
+// GetWarehouseSyncStatus gets the export state of every table
+func (uc *warehouseSyncUseCase) GetWarehouseSyncStatus(ctx context.Context) (*WarehouseSyncStatusResponse, error) {
+	states, err := uc.loadStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarehouseSyncStatusResponse{
+		Target:     uc.name,
+		Anonymized: uc.pseudonymizer != nil,
+		Tables:     make([]WarehouseTableSyncResponse, 0, len(entities.WarehouseSchemas)),
+	}
+	for _, schema := range uc.schemas() {
+		state := states[schema.Table]
+		response.Tables = append(response.Tables, WarehouseTableSyncResponse{
+			Table:           schema.Table,
+			Status:          state.Status,
+			WatermarkAt:     state.WatermarkAt,
+			LastRunAt:       state.LastRunAt,
+			LastSuccessAt:   state.LastSuccessAt,
+			LastError:       state.LastError,
+			RowsLastRun:     state.RowsLastRun,
+			RowsTotal:       state.RowsTotal,
+			Columns:         len(schema.Columns),
+			SchemaUpdatedAt: state.SchemaUpdatedAt,
+		})
+	}
+	return response, nil
+}
+
+// ResetTable clears a table's watermark, e.g. to backfill a column added to the export
+func (uc *warehouseSyncUseCase) ResetTable(ctx context.Context, table entities.WarehouseTable) error {
+	if _, ok := entities.GetWarehouseSchema(table); !ok {
+		return pkgErrors.New(pkgErrors.ErrCodeNotFound, "Unknown warehouse table")
+	}
+	if !uc.running.TryLock() {
+		return pkgErrors.New(pkgErrors.ErrCodeConflict, "A warehouse sync is running, try again when it finishes")
+	}
+	defer uc.running.Unlock()
+
+	states, err := uc.loadStates(ctx)
+	if err != nil {
+		return err
+	}
+	state := states[table]
+	state.Reset()
+	return uc.syncRepo.SaveState(ctx, state)
+}
+
+// schemas returns the exported tables' schemas, without the columns anonymization leaves out
+func (uc *warehouseSyncUseCase) schemas() []entities.WarehouseTableSchema {
+	if uc.pseudonymizer == nil {
+		return entities.WarehouseSchemas
+	}
+	schemas := make([]entities.WarehouseTableSchema, len(entities.WarehouseSchemas))
+	for i, schema := range entities.WarehouseSchemas {
+		schemas[i] = schema.Anonymized()
+	}
+	return schemas
+}
+
+// loadStates loads the state of every exported table, creating the states of new tables
+func (uc *warehouseSyncUseCase) loadStates(ctx context.Context) (map[entities.WarehouseTable]*entities.WarehouseSyncState, error) {
+	saved, err := uc.syncRepo.GetStates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sync states: %w", err)
+	}
+
+	states := make(map[entities.WarehouseTable]*entities.WarehouseSyncState, len(entities.WarehouseSchemas))
+	for _, state := range saved {
+		states[state.Table] = state
+	}
+	for _, schema := range entities.WarehouseSchemas {
+		if states[schema.Table] == nil {
+			states[schema.Table] = entities.NewWarehouseSyncState(schema.Table)
+		}
+	}
+	return states, nil
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"ecom-golang-clean-architecture/internal/domain/entities"
@@ -23,6 +24,7 @@ type wishlistUseCase struct {
 	wishlistRepo        repositories.WishlistRepository
 	productRepo         repositories.ProductRepository
 	productCategoryRepo repositories.ProductCategoryRepository
+	activityTracker     ActivityTracker
 }
 
 // NewWishlistUseCase creates a new wishlist use case
@@ -30,11 +32,13 @@ func NewWishlistUseCase(
 	wishlistRepo repositories.WishlistRepository,
 	productRepo repositories.ProductRepository,
 	productCategoryRepo repositories.ProductCategoryRepository,
+	activityTracker ActivityTracker,
 ) WishlistUseCase {
 	return &wishlistUseCase{
 		wishlistRepo:        wishlistRepo,
 		productRepo:         productRepo,
 		productCategoryRepo: productCategoryRepo,
+		activityTracker:     activityTracker,
 	}
 }
 
@@ -60,7 +64,7 @@ type WishlistResponse struct {
 // AddToWishlist adds a product to user's wishlist
 func (uc *wishlistUseCase) AddToWishlist(ctx context.Context, userID, productID uuid.UUID) error {
 	// Check if product exists
-	_, err := uc.productRepo.GetByID(ctx, productID)
+	product, err := uc.productRepo.GetByID(ctx, productID)
 	if err != nil {
 		return entities.ErrProductNotFound
 	}
@@ -74,7 +78,13 @@ func (uc *wishlistUseCase) AddToWishlist(ctx context.Context, userID, productID
 		return entities.ErrConflict // Already in wishlist
 	}
 
-	return uc.wishlistRepo.AddToWishlist(ctx, userID, productID)
+	if err := uc.wishlistRepo.AddToWishlist(ctx, userID, productID); err != nil {
+		return err
+	}
+
+	trackActivity(ctx, uc.activityTracker, userID, entities.ActivityTypeWishlistAdd,
+		fmt.Sprintf("Added %s to wishlist", product.Name), "product", &productID, nil)
+	return nil
 }
 
 // RemoveFromWishlist removes a product from user's wishlist
@@ -88,7 +98,13 @@ func (uc *wishlistUseCase) RemoveFromWishlist(ctx context.Context, userID, produ
 		return entities.ErrWishlistItemNotFound
 	}
 
-	return uc.wishlistRepo.RemoveFromWishlist(ctx, userID, productID)
+	if err := uc.wishlistRepo.RemoveFromWishlist(ctx, userID, productID); err != nil {
+		return err
+	}
+
+	trackActivity(ctx, uc.activityTracker, userID, entities.ActivityTypeWishlistRemove,
+		"Removed a product from wishlist", "product", &productID, nil)
+	return nil
 }
 
 // GetWishlist gets user's wishlist with pagination
@@ -117,17 +133,19 @@ func (uc *wishlistUseCase) GetWishlist(ctx context.Context, userID uuid.UUID, re
 	items := make([]*WishlistItemResponse, len(wishlistItems))
 	for i, item := range wishlistItems {
 		productResponse := &ProductResponse{
-			ID:          item.Product.ID,
-			Name:        item.Product.Name,
-			Description: item.Product.Description,
-			SKU:         item.Product.SKU,
-			Price:       item.Product.Price,
-			Stock:       item.Product.Stock,
-			Status:      item.Product.Status,
-			IsDigital:   item.Product.IsDigital,
-			Weight:      item.Product.Weight,
-			CreatedAt:   item.Product.CreatedAt,
-			UpdatedAt:   item.Product.UpdatedAt,
+			ID:                    item.Product.ID,
+			Name:                  item.Product.Name,
+			Description:           item.Product.Description,
+			SKU:                   item.Product.SKU,
+			Price:                 item.Product.Price,
+			Stock:                 &item.Product.Stock,
+			StockVisibility:       item.Product.StockVisibility,
+			StockDisplayThreshold: item.Product.StockDisplayThreshold,
+			Status:                item.Product.Status,
+			IsDigital:             item.Product.IsDigital,
+			Weight:                item.Product.Weight,
+			CreatedAt:             item.Product.CreatedAt,
+			UpdatedAt:             item.Product.UpdatedAt,
 		}
 
 		// Add category using ProductCategory many-to-many (get primary category)
@@ -167,6 +185,8 @@ func (uc *wishlistUseCase) GetWishlist(ctx context.Context, userID uuid.UUID, re
 			productResponse.Tags = tags
 		}
 
+		restrictStock(ctx, productResponse, &item.Product)
+
 		items[i] = &WishlistItemResponse{
 			ID:      item.ID,
 			Product: *productResponse,
@@ -194,7 +214,13 @@ func (uc *wishlistUseCase) IsInWishlist(ctx context.Context, userID, productID u
 
 // ClearWishlist removes all items from user's wishlist
 func (uc *wishlistUseCase) ClearWishlist(ctx context.Context, userID uuid.UUID) error {
-	return uc.wishlistRepo.ClearWishlist(ctx, userID)
+	if err := uc.wishlistRepo.ClearWishlist(ctx, userID); err != nil {
+		return err
+	}
+
+	trackActivity(ctx, uc.activityTracker, userID, entities.ActivityTypeWishlistRemove,
+		"Cleared wishlist", "wishlist", nil, nil)
+	return nil
 }
 
 // GetWishlistCount gets the total count of items in user's wishlist
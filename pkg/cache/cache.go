@@ -99,7 +99,7 @@ func (c *MemoryCache) Get(ctx context.Context, key string, dest interface{}) err
 	
 	// Check expiration
 	if !item.Expiration.IsZero() && time.Now().After(item.Expiration) {
-		delete(c.data, key)
+		// Expired items are removed by cleanup; deleting here would write under the read lock
 		return fmt.Errorf("key expired: %s", key)
 	}
 	
@@ -128,7 +128,6 @@ func (c *MemoryCache) Exists(ctx context.Context, key string) (bool, error) {
 	
 	// Check expiration
 	if !item.Expiration.IsZero() && time.Now().After(item.Expiration) {
-		delete(c.data, key)
 		return false, nil
 	}
 	
@@ -177,8 +176,6 @@ func (c *MemoryCache) GetMultiple(ctx context.Context, keys []string) (map[strin
 			// Check expiration
 			if item.Expiration.IsZero() || now.Before(item.Expiration) {
 				result[key] = item.Value
-			} else {
-				delete(c.data, key)
 			}
 		}
 	}
@@ -49,6 +49,7 @@ const (
 	ErrCodeNotFound         ErrorCode = "NOT_FOUND"
 	ErrCodeConflict         ErrorCode = "CONFLICT"
 	ErrCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
+	ErrCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
 
 	// Concurrency error codes
 	ErrCodeConcurrencyConflict ErrorCode = "CONCURRENCY_CONFLICT"
@@ -151,6 +152,9 @@ func getDefaultStatusCode(code ErrorCode) int {
 	case ErrCodeConcurrencyConflict, ErrCodeResourceLocked:
 		return http.StatusConflict
 
+	case ErrCodeServiceUnavailable:
+		return http.StatusServiceUnavailable
+
 	default:
 		return http.StatusInternalServerError
 	}
@@ -210,3 +214,7 @@ func InternalError(message string) *AppError {
 func ConcurrencyConflict(message string) *AppError {
 	return New(ErrCodeConcurrencyConflict, message)
 }
+
+func ServiceUnavailable(message string) *AppError {
+	return New(ErrCodeServiceUnavailable, message)
+}
@@ -0,0 +1,17 @@
+package ids
+
+import (
+	"github.com/google/uuid"
+)
+
+// New returns a new record ID. IDs are UUIDv7: the first 48 bits are the creation time in
+// milliseconds, so IDs created later sort after earlier ones and new rows are appended to the
+// end of primary key indexes instead of being scattered over them. They are formatted like any
+// other UUID.
+//
+// The time prefix makes IDs partly predictable, so use uuid.New for tokens, session IDs and
+// anything else that must not be guessable.
+func New() uuid.UUID {
+	// NewV7 only fails when the system's random source does, like uuid.New
+	return uuid.Must(uuid.NewV7())
+}
@@ -0,0 +1,180 @@
+// Package jwtkeys signs and verifies JWTs with a set of rotating keys. Each key has an ID that
+// is written to the kid header of the tokens it signs, so tokens signed by a previous key keep
+// verifying for a grace period after a newer key takes over.
+package jwtkeys
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algorithm is a JWT signing algorithm
+type Algorithm string
+
+const (
+	AlgorithmHS256 Algorithm = "HS256" // Shared secret; the key is not published in the JWKS
+	AlgorithmRS256 Algorithm = "RS256" // 2048-bit RSA
+	AlgorithmEdDSA Algorithm = "EdDSA" // Ed25519
+)
+
+const (
+	hmacSecretLength = 32
+	rsaKeyBits       = 2048
+	keyIDLength      = 8
+)
+
+// Key is a signing key and the window it is used in
+type Key struct {
+	ID          string // Empty for the legacy secret, whose tokens carry no kid
+	Algorithm   Algorithm
+	ActivatesAt time.Time // New tokens are signed with the newest key activated by now
+	ExpiresAt   time.Time // Tokens signed with the key are rejected after; zero while no newer key is active
+
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// NewLegacyKey wraps the static HS256 secret tokens were signed with before keys rotated
+func NewLegacyKey(secret string) *Key {
+	return &Key{
+		Algorithm: AlgorithmHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+}
+
+// GenerateKey creates a key with a random ID
+func GenerateKey(algorithm Algorithm, activatesAt time.Time) (*Key, error) {
+	id := make([]byte, keyIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate key ID: %w", err)
+	}
+	key := &Key{ID: hex.EncodeToString(id), Algorithm: algorithm, ActivatesAt: activatesAt}
+
+	switch algorithm {
+	case AlgorithmHS256:
+		secret := make([]byte, hmacSecretLength)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate secret: %w", err)
+		}
+		key.signKey, key.verifyKey = secret, secret
+	case AlgorithmRS256:
+		private, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		key.signKey, key.verifyKey = private, &private.PublicKey
+	case AlgorithmEdDSA:
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate Ed25519 key: %w", err)
+		}
+		key.signKey, key.verifyKey = private, public
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	return key, nil
+}
+
+// ParseKey restores a key from the private material returned by MarshalPrivate
+func ParseKey(id string, algorithm Algorithm, activatesAt time.Time, material []byte) (*Key, error) {
+	key := &Key{ID: id, Algorithm: algorithm, ActivatesAt: activatesAt}
+
+	if algorithm == AlgorithmHS256 {
+		key.signKey, key.verifyKey = material, material
+		return key, nil
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(material)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != AlgorithmRS256 {
+			return nil, fmt.Errorf("RSA key stored for %s", algorithm)
+		}
+		key.signKey, key.verifyKey = private, &private.PublicKey
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("Ed25519 key stored for %s", algorithm)
+		}
+		key.signKey, key.verifyKey = private, private.Public()
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", private)
+	}
+	return key, nil
+}
+
+// MarshalPrivate returns the key's private material: the secret for HS256, PKCS #8 DER otherwise
+func (k *Key) MarshalPrivate() ([]byte, error) {
+	if k.Algorithm == AlgorithmHS256 {
+		return k.signKey.([]byte), nil
+	}
+	return x509.MarshalPKCS8PrivateKey(k.signKey)
+}
+
+// signingMethod returns the JWT signing method of the key's algorithm
+func (k *Key) signingMethod() jwt.SigningMethod {
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodHS256
+}
+
+// Seal encrypts private key material for storage with AES-256-GCM, keyed by a hash of secret
+func Seal(material []byte, secret string) (string, error) {
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, material, nil)), nil
+}
+
+// Open decrypts material sealed with the same secret
+func Open(sealed, secret string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sealed key: %w", err)
+	}
+	gcm, err := newGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("sealed key is too short")
+	}
+	material, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt sealed key, was the secret changed?")
+	}
+	return material, nil
+}
+
+func newGCM(secret string) (cipher.AEAD, error) {
+	hash := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
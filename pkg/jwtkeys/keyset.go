@@ -0,0 +1,214 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrUnknownKey is returned for tokens signed by a key that is not in the set
+	ErrUnknownKey = errors.New("token signed by an unknown key")
+	// ErrKeyExpired is returned for tokens signed by a key whose grace period has ended
+	ErrKeyExpired = errors.New("token signed by an expired key")
+)
+
+// validMethods are the algorithms tokens may be signed with; a key only verifies its own
+var validMethods = []string{string(AlgorithmHS256), string(AlgorithmRS256), string(AlgorithmEdDSA)}
+
+// KeySet holds the legacy secret and the rotating keys. It is safe for concurrent use, and
+// Replace swaps the keys while requests are being served.
+type KeySet struct {
+	mu     sync.RWMutex
+	legacy *Key
+	keys   []*Key // Oldest first, the legacy secret included
+}
+
+// NewKeySet creates a key set that signs with the legacy secret until Replace adds keys
+func NewKeySet(legacySecret string) *KeySet {
+	legacy := NewLegacyKey(legacySecret)
+	return &KeySet{legacy: legacy, keys: []*Key{legacy}}
+}
+
+// Replace sets the rotating keys. Each key stays valid for grace after the next one activates,
+// and so does the legacy secret after the first key activates, so tokens signed before a
+// rotation keep working until they expire.
+func (s *KeySet) Replace(keys []*Key, grace time.Duration) {
+	// Keys are copied, as their expiry is set here while requests may be reading the old ones
+	sorted := []*Key{copyKey(s.legacy)}
+	for _, key := range keys {
+		sorted = append(sorted, copyKey(key))
+	}
+	sort.SliceStable(sorted[1:], func(i, j int) bool {
+		return sorted[i+1].ActivatesAt.Before(sorted[j+1].ActivatesAt)
+	})
+
+	now := time.Now()
+	for i, key := range sorted {
+		key.ExpiresAt = time.Time{}
+		// The first newer key that is already active retires this one
+		for _, next := range sorted[i+1:] {
+			if !next.ActivatesAt.After(now) {
+				key.ExpiresAt = next.ActivatesAt.Add(grace)
+				break
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.keys = sorted
+	s.mu.Unlock()
+}
+
+func copyKey(key *Key) *Key {
+	copied := *key
+	return &copied
+}
+
+// Sign signs claims with the newest active key, writing its ID to the kid header
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := s.signingKey()
+	token := jwt.NewWithClaims(key.signingMethod(), claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.signKey)
+}
+
+// Parse parses and verifies a token signed by any valid key in the set
+func (s *KeySet) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, s.Keyfunc, jwt.WithValidMethods(validMethods))
+}
+
+// Keyfunc looks up the key of a token by its kid header, for jwt.Parse
+func (s *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, key := range s.keys {
+		if key.ID != kid {
+			continue
+		}
+		if token.Method.Alg() != string(key.Algorithm) {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
+		}
+		if !key.ExpiresAt.IsZero() && time.Now().After(key.ExpiresAt) {
+			return nil, ErrKeyExpired
+		}
+		return key.verifyKey, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// signingKey returns the newest key activated by now
+func (s *KeySet) signingKey() *Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	signing := s.keys[0]
+	for _, key := range s.keys[1:] {
+		if !key.ActivatesAt.After(now) {
+			signing = key
+		}
+	}
+	return signing
+}
+
+// KeyInfo describes a key without its material
+type KeyInfo struct {
+	ID          string     `json:"kid"`
+	Algorithm   Algorithm  `json:"alg"`
+	ActivatesAt *time.Time `json:"activates_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Signing     bool       `json:"signing"` // New tokens are signed with it
+	Legacy      bool       `json:"legacy"`  // The static JWT secret
+}
+
+// Keys describes the keys that still verify tokens, oldest first
+func (s *KeySet) Keys() []KeyInfo {
+	signing := s.signingKey()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := time.Now()
+	infos := make([]KeyInfo, 0, len(s.keys))
+	for _, key := range s.keys {
+		if !key.ExpiresAt.IsZero() && now.After(key.ExpiresAt) {
+			continue
+		}
+		info := KeyInfo{
+			ID:        key.ID,
+			Algorithm: key.Algorithm,
+			Signing:   key.ID == signing.ID,
+			Legacy:    key.ID == "",
+		}
+		if !key.ActivatesAt.IsZero() {
+			activatesAt := key.ActivatesAt
+			info.ActivatesAt = &activatesAt
+		}
+		if !key.ExpiresAt.IsZero() {
+			expiresAt := key.ExpiresAt
+			info.ExpiresAt = &expiresAt
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// JSONWebKey is a public key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA exponent
+	Curve     string `json:"crv,omitempty"` // OKP curve
+	X         string `json:"x,omitempty"`   // OKP public key
+}
+
+// JSONWebKeySet is a JWKS document
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys that verify tokens, including keys that activate soon, so
+// services fetching it can verify tokens as soon as a new key signs them. HS256 keys are
+// secret and never published.
+func (s *KeySet) JWKS() JSONWebKeySet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, key := range s.keys {
+		if !key.ExpiresAt.IsZero() && now.After(key.ExpiresAt) {
+			continue
+		}
+		jwk := JSONWebKey{KeyID: key.ID, Use: "sig", Algorithm: string(key.Algorithm)}
+		switch public := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
@@ -0,0 +1,153 @@
+package money
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Symbol positions of a CurrencyFormat
+const (
+	SymbolBefore = "before"
+	SymbolAfter  = "after"
+)
+
+// CurrencyFormat describes how amounts of a currency are written for shoppers of a locale, e.g.
+// "$1,234.50" in en and "1.234,50 €" in de
+type CurrencyFormat struct {
+	Currency         string `json:"currency"`
+	Locale           string `json:"locale"`
+	Symbol           string `json:"symbol"`
+	SymbolPosition   string `json:"symbol_position"` // before or after the amount
+	SymbolSpace      bool   `json:"symbol_space"`    // Whether a space separates the symbol and the amount
+	DecimalPlaces    int    `json:"decimal_places"`
+	DecimalSeparator string `json:"decimal_separator"`
+	GroupSeparator   string `json:"group_separator"`
+}
+
+// currencySymbols are the symbols of currencies shoppers commonly pick; others are written with
+// their ISO code
+var currencySymbols = map[string]string{
+	"AUD": "A$", "CAD": "CA$", "CNY": "¥", "EUR": "€", "GBP": "£", "HKD": "HK$", "INR": "₹",
+	"JPY": "¥", "KRW": "₩", "NZD": "NZ$", "SGD": "S$", "THB": "฿", "USD": "$", "VND": "₫",
+}
+
+// localeFormat is how a locale writes numbers and where it puts the currency symbol
+type localeFormat struct {
+	decimal, group string
+	position       string
+	space          bool
+}
+
+var englishFormat = localeFormat{decimal: ".", group: ",", position: SymbolBefore}
+
+// localeFormats are keyed by full locale first, then by language
+var localeFormats = map[string]localeFormat{
+	"en":    englishFormat,
+	"en-IE": englishFormat,
+	"ja":    englishFormat,
+	"ko":    englishFormat,
+	"zh":    englishFormat,
+	"th":    englishFormat,
+	"de":    {decimal: ",", group: ".", position: SymbolAfter, space: true},
+	"de-CH": {decimal: ".", group: "'", position: SymbolBefore, space: true},
+	"es":    {decimal: ",", group: ".", position: SymbolAfter, space: true},
+	"fr":    {decimal: ",", group: " ", position: SymbolAfter, space: true},
+	"it":    {decimal: ",", group: ".", position: SymbolAfter, space: true},
+	"nl":    {decimal: ",", group: ".", position: SymbolBefore, space: true},
+	"pl":    {decimal: ",", group: " ", position: SymbolAfter, space: true},
+	"pt":    {decimal: ",", group: ".", position: SymbolAfter, space: true},
+	"pt-BR": {decimal: ",", group: ".", position: SymbolBefore, space: true},
+	"sv":    {decimal: ",", group: " ", position: SymbolAfter, space: true},
+	"vi":    {decimal: ",", group: ".", position: SymbolAfter, space: true},
+}
+
+// FormatFor returns how amounts of the currency are written in the locale. Locales are matched
+// in full, then by language, and fall back to English conventions.
+func FormatFor(currency, locale string) CurrencyFormat {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+
+	conventions, ok := localeFormats[locale]
+	if !ok {
+		language, _, _ := strings.Cut(locale, "-")
+		if conventions, ok = localeFormats[strings.ToLower(language)]; !ok {
+			conventions = englishFormat
+		}
+	}
+
+	symbol, ok := currencySymbols[currency]
+	if !ok {
+		symbol = currency
+	}
+	return CurrencyFormat{
+		Currency:         currency,
+		Locale:           locale,
+		Symbol:           symbol,
+		SymbolPosition:   conventions.position,
+		SymbolSpace:      conventions.space || !ok,
+		DecimalPlaces:    Exponent(currency),
+		DecimalSeparator: conventions.decimal,
+		GroupSeparator:   conventions.group,
+	}
+}
+
+// Format writes an amount with the currency symbol, e.g. "1.234,50 €"
+func (f CurrencyFormat) Format(amount float64) string {
+	return f.withUnit(amount, f.Symbol, f.SymbolSpace)
+}
+
+// FormatWithCode writes an amount with the ISO code in place of the symbol, e.g. "1.234,50 EUR",
+// for documents whose fonts can't draw every symbol
+func (f CurrencyFormat) FormatWithCode(amount float64) string {
+	return f.withUnit(amount, f.Currency, true)
+}
+
+// Number writes an amount rounded to the currency's decimal places with the locale's
+// separators and no symbol, e.g. "1.234,50"
+func (f CurrencyFormat) Number(amount float64) string {
+	minor := int64(math.Round(amount * math.Pow10(f.DecimalPlaces)))
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	digits := strconv.FormatInt(minor, 10)
+	if len(digits) <= f.DecimalPlaces {
+		digits = strings.Repeat("0", f.DecimalPlaces-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-f.DecimalPlaces], digits[len(digits)-f.DecimalPlaces:]
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(f.GroupSeparator)
+		}
+		b.WriteRune(digit)
+	}
+	if fraction != "" {
+		b.WriteString(f.DecimalSeparator)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// withUnit writes the unit on the locale's side of the amount, keeping a minus sign in front so
+// refunds read "-$5.00"
+func (f CurrencyFormat) withUnit(amount float64, unit string, space bool) string {
+	number := f.Number(amount)
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	separator := ""
+	if space {
+		separator = " "
+	}
+	if f.SymbolPosition == SymbolAfter {
+		return sign + number + separator + unit
+	}
+	return sign + unit + separator + number
+}
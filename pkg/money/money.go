@@ -0,0 +1,67 @@
+// Package money converts amounts between decimal values and the integer minor units payment
+// providers and ledgers work in. Currencies differ in their number of decimals, so amounts are
+// only compared or summed in minor units of one currency.
+package money
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// zeroDecimalCurrencies have no minor unit: 1000 VND is sent to providers as 1000
+var zeroDecimalCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "JPY": true, "KMF": true,
+	"KRW": true, "MGA": true, "PYG": true, "RWF": true, "UGX": true, "VND": true,
+	"VUV": true, "XAF": true, "XOF": true, "XPF": true,
+}
+
+// threeDecimalCurrencies have a minor unit of a thousandth
+var threeDecimalCurrencies = map[string]bool{
+	"BHD": true, "JOD": true, "KWD": true, "OMR": true, "TND": true,
+}
+
+// Exponent returns the number of decimals of a currency's minor unit, 2 unless the ISO code is
+// known to differ
+func Exponent(currency string) int {
+	currency = strings.ToUpper(currency)
+	switch {
+	case zeroDecimalCurrencies[currency]:
+		return 0
+	case threeDecimalCurrencies[currency]:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// ToMinor converts a decimal amount to minor units of the currency, rounding to the nearest
+// unit so that 19.99 USD is 1999 rather than 1998
+func ToMinor(amount float64, currency string) int64 {
+	return int64(math.Round(amount * math.Pow10(Exponent(currency))))
+}
+
+// FromMinor converts minor units of the currency to a decimal amount
+func FromMinor(minor int64, currency string) float64 {
+	return float64(minor) / math.Pow10(Exponent(currency))
+}
+
+// Format formats minor units of the currency as a decimal string with the currency's number
+// of decimals, e.g. 1999 USD as "19.99" and 1999 VND as "1999"
+func Format(minor int64, currency string) string {
+	exponent := Exponent(currency)
+	if exponent == 0 {
+		return strconv.FormatInt(minor, 10)
+	}
+
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+	digits := strconv.FormatInt(minor, 10)
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
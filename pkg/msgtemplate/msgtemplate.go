@@ -0,0 +1,93 @@
+// Package msgtemplate fills in the {{variable}} placeholders of email and notification templates.
+// A placeholder is written {{first_name}} or, Go template style, {{.first_name}}. Variable names
+// match without regard to case or underscores, so {{.FirstName}} and {{first_name}} are the same
+// variable.
+package msgtemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{\{(.*?)\}\}`)
+	variablePattern    = regexp.MustCompile(`^\.?([A-Za-z_][A-Za-z0-9_]*)$`)
+)
+
+// Key returns the name variables are matched by, e.g. "firstname" for "FirstName" and "first_name"
+func Key(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, "."), "_", ""))
+}
+
+// Variables returns the variables text refers to, as first written, in the order they appear
+func Variables(text string) []string {
+	var variables []string
+	seen := make(map[string]bool)
+	for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+		name, ok := variableName(match[1])
+		if !ok || seen[Key(name)] {
+			continue
+		}
+		seen[Key(name)] = true
+		variables = append(variables, name)
+	}
+	return variables
+}
+
+// Unsupported returns the placeholders in text that are not a plain variable, e.g. {{if .paid}}
+// or {{.order.number}}, which are never filled in
+func Unsupported(text string) []string {
+	var placeholders []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+		if _, ok := variableName(match[1]); !ok {
+			placeholders = append(placeholders, match[0])
+		}
+	}
+	return placeholders
+}
+
+// Unclosed reports whether text opens a placeholder it never closes
+func Unclosed(text string) bool {
+	return strings.Contains(placeholderPattern.ReplaceAllString(text, ""), "{{")
+}
+
+// Render fills in the placeholders of text from data. Placeholders of variables with no value in
+// data, or an empty one, are left as written and their variables returned as missing.
+func Render(text string, data map[string]interface{}) (string, []string) {
+	values := make(map[string]string, len(data))
+	for name, value := range data {
+		if value == nil {
+			continue
+		}
+		if s := fmt.Sprintf("%v", value); s != "" {
+			values[Key(name)] = s
+		}
+	}
+
+	var missing []string
+	seen := make(map[string]bool)
+	rendered := placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name, ok := variableName(placeholder[2 : len(placeholder)-2])
+		if !ok {
+			return placeholder
+		}
+		if value, ok := values[Key(name)]; ok {
+			return value
+		}
+		if !seen[Key(name)] {
+			seen[Key(name)] = true
+			missing = append(missing, name)
+		}
+		return placeholder
+	})
+	return rendered, missing
+}
+
+func variableName(expression string) (string, bool) {
+	match := variablePattern.FindStringSubmatch(strings.TrimSpace(expression))
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
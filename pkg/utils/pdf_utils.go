@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pdfPageWidth    = 612 // US Letter in points
+	pdfPageHeight   = 792
+	pdfMargin       = 50
+	pdfFontSize     = 8
+	pdfLineHeight   = 11
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+// TextPDF builds a simple monospaced, text-only PDF document.
+// It is intended for plain documents such as quotes and invoices.
+type TextPDF struct {
+	lines []string
+}
+
+// NewTextPDF creates an empty text PDF document
+func NewTextPDF() *TextPDF {
+	return &TextPDF{}
+}
+
+// AddLine appends a line of text; non-ASCII characters are replaced since only the standard font is embedded
+func (p *TextPDF) AddLine(format string, args ...interface{}) {
+	text := format
+	if len(args) > 0 {
+		text = fmt.Sprintf(format, args...)
+	}
+	p.lines = append(p.lines, text)
+}
+
+// AddBlankLine appends an empty line
+func (p *TextPDF) AddBlankLine() {
+	p.lines = append(p.lines, "")
+}
+
+// Bytes renders the document, paginating lines across as many pages as needed
+func (p *TextPDF) Bytes() []byte {
+	pages := make([][]string, 0)
+	for start := 0; start < len(p.lines); start += pdfLinesPerPage {
+		end := start + pdfLinesPerPage
+		if end > len(p.lines) {
+			end = len(p.lines)
+		}
+		pages = append(pages, p.lines[start:end])
+	}
+	if len(pages) == 0 {
+		pages = append(pages, []string{})
+	}
+
+	// Object layout: 1 catalog, 2 page tree, 3 font, then a page and content stream per page
+	var objects []string
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+i*2)
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range pages {
+		contentRef := 5 + i*2
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, contentRef,
+		))
+
+		var content bytes.Buffer
+		content.WriteString("BT\n")
+		fmt.Fprintf(&content, "/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDFText(line))
+		}
+		content.WriteString("ET")
+		objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xrefOffset)
+
+	return buf.Bytes()
+}
+
+// escapePDFText escapes a string for use in a PDF literal string
+func escapePDFText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteString("    ")
+		case r < 32:
+			// Drop control characters
+		case r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}